@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
 	"ppgroup.ppgroup.com/internal/config"
+	"ppgroup.ppgroup.com/internal/jobs"
+	"ppgroup.ppgroup.com/internal/repositories"
 	"ppgroup.ppgroup.com/internal/routers"
 	"ppgroup.ppgroup.com/internal/services"
 )
@@ -25,11 +28,70 @@ func Server() *gin.Engine {
 		panic("failed to connect database" + err.Error())
 	}
 
+	// The market statistics view depends on the listings table, so an outdated view is
+	// dropped before migrations and rebuilt after them
+	if err := repositories.DropStaleMarketStatsViewRepo(db.Client); err != nil {
+		panic(err.Error())
+	}
+
 	// Run migrations
 	if err := db.Migrate(ctx); err != nil {
 		panic("failed to run migrations: " + err.Error())
 	}
 
+	// Prices in every currency are converted through the base currency
+	if err := repositories.EnsureBaseCurrencyRepo(db.Client, configVars.BaseCurrency); err != nil {
+		panic("failed to set up base currency: " + err.Error())
+	}
+
+	// Keep normalized address keys in sync and fill them in for older rows
+	db.Client.Listing.Use(repositories.AddressKeyHook())
+	db.Client.Property.Use(repositories.AddressKeyHook())
+	if err := repositories.BackfillAddressKeysRepo(db.Client); err != nil {
+		panic("failed to backfill address keys: " + err.Error())
+	}
+
+	// Apply the one-off data migrations that have not run yet
+	if err := repositories.RunMigrationsRepo(db.Client, repositories.Migrations); err != nil {
+		panic(err.Error())
+	}
+
+	// Link listings to the neighborhood containing their coordinates
+	db.Client.Listing.Use(repositories.ListingNeighborhoodHook())
+
+	// Version listings so concurrent edits can be detected
+	db.Client.Listing.Use(repositories.ListingVersionHook())
+
+	// Close pending reviews of listings that leave the moderation queue
+	db.Client.Listing.Use(repositories.ListingReviewHook())
+
+	// Hold back listings published before their publish_at
+	db.Client.Listing.Use(repositories.ListingScheduleHook())
+
+	// Give published listings an expiry date
+	listingTerm := time.Duration(configVars.ListingTermDays) * 24 * time.Hour
+	db.Client.Listing.Use(repositories.ListingExpiryHook(listingTerm))
+
+	// Keep the closing data of sold listings consistent
+	db.Client.Listing.Use(repositories.ListingSaleHook())
+
+	// Record status transitions and time on the market. Registered last so it sees the
+	// status the other hooks settled on.
+	db.Client.Listing.Use(repositories.ListingStatusHook())
+	if err := repositories.BackfillListingStatusEventsRepo(db.Client); err != nil {
+		panic("failed to backfill listing status history: " + err.Error())
+	}
+
+	// Apply due schedules and archive expired listings once every hook is in place
+	jobs.StartListingSchedule(ctx, db.Client, configVars)
+	jobs.StartListingExpiration(ctx, db.Client, configVars)
+
+	// Precompute market statistics when the view changed and keep them fresh
+	if err := repositories.CreateMarketStatsViewRepo(db.Client); err != nil {
+		panic(err.Error())
+	}
+	jobs.StartMarketStatsRefresh(ctx, db.Client, configVars)
+
 	// Initialize ImageService with Cloudinary
 	imageService := services.NewImageService(
 		configVars.CloudinaryCloudName,
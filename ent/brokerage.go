@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/brokerage"
+)
+
+// Brokerage is the model entity for the Brokerage schema.
+type Brokerage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// LicenseNumber holds the value of the "license_number" field.
+	LicenseNumber string `json:"license_number"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address"`
+	// City holds the value of the "city" field.
+	City string `json:"city"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// ZipCode holds the value of the "zip_code" field.
+	ZipCode string `json:"zip_code"`
+	// Phone holds the value of the "phone" field.
+	Phone string `json:"phone,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Website holds the value of the "website" field.
+	Website string `json:"website,omitempty"`
+	// LogoURL holds the value of the "logo_url" field.
+	LogoURL string `json:"logo_url,omitempty"`
+	// PrimaryColor holds the value of the "primary_color" field.
+	PrimaryColor string `json:"primary_color,omitempty"`
+	// SecondaryColor holds the value of the "secondary_color" field.
+	SecondaryColor string `json:"secondary_color,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BrokerageQuery when eager-loading is set.
+	Edges        BrokerageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BrokerageEdges holds the relations/edges for other nodes in the graph.
+type BrokerageEdges struct {
+	// Teams holds the value of the teams edge.
+	Teams []*Team `json:"teams,omitempty"`
+	// Realtors holds the value of the realtors edge.
+	Realtors []*Realtor `json:"realtors,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TeamsOrErr returns the Teams value or an error if the edge
+// was not loaded in eager-loading.
+func (e BrokerageEdges) TeamsOrErr() ([]*Team, error) {
+	if e.loadedTypes[0] {
+		return e.Teams, nil
+	}
+	return nil, &NotLoadedError{edge: "teams"}
+}
+
+// RealtorsOrErr returns the Realtors value or an error if the edge
+// was not loaded in eager-loading.
+func (e BrokerageEdges) RealtorsOrErr() ([]*Realtor, error) {
+	if e.loadedTypes[1] {
+		return e.Realtors, nil
+	}
+	return nil, &NotLoadedError{edge: "realtors"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Brokerage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case brokerage.FieldName, brokerage.FieldLicenseNumber, brokerage.FieldAddress, brokerage.FieldCity, brokerage.FieldState, brokerage.FieldZipCode, brokerage.FieldPhone, brokerage.FieldEmail, brokerage.FieldWebsite, brokerage.FieldLogoURL, brokerage.FieldPrimaryColor, brokerage.FieldSecondaryColor:
+			values[i] = new(sql.NullString)
+		case brokerage.FieldCreateTime, brokerage.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case brokerage.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Brokerage fields.
+func (_m *Brokerage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case brokerage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case brokerage.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case brokerage.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case brokerage.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case brokerage.FieldLicenseNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field license_number", values[i])
+			} else if value.Valid {
+				_m.LicenseNumber = value.String
+			}
+		case brokerage.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = value.String
+			}
+		case brokerage.FieldCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field city", values[i])
+			} else if value.Valid {
+				_m.City = value.String
+			}
+		case brokerage.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case brokerage.FieldZipCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field zip_code", values[i])
+			} else if value.Valid {
+				_m.ZipCode = value.String
+			}
+		case brokerage.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				_m.Phone = value.String
+			}
+		case brokerage.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case brokerage.FieldWebsite:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field website", values[i])
+			} else if value.Valid {
+				_m.Website = value.String
+			}
+		case brokerage.FieldLogoURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field logo_url", values[i])
+			} else if value.Valid {
+				_m.LogoURL = value.String
+			}
+		case brokerage.FieldPrimaryColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field primary_color", values[i])
+			} else if value.Valid {
+				_m.PrimaryColor = value.String
+			}
+		case brokerage.FieldSecondaryColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secondary_color", values[i])
+			} else if value.Valid {
+				_m.SecondaryColor = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Brokerage.
+// This includes values selected through modifiers, order, etc.
+func (_m *Brokerage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTeams queries the "teams" edge of the Brokerage entity.
+func (_m *Brokerage) QueryTeams() *TeamQuery {
+	return NewBrokerageClient(_m.config).QueryTeams(_m)
+}
+
+// QueryRealtors queries the "realtors" edge of the Brokerage entity.
+func (_m *Brokerage) QueryRealtors() *RealtorQuery {
+	return NewBrokerageClient(_m.config).QueryRealtors(_m)
+}
+
+// Update returns a builder for updating this Brokerage.
+// Note that you need to call Brokerage.Unwrap() before calling this method if this Brokerage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Brokerage) Update() *BrokerageUpdateOne {
+	return NewBrokerageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Brokerage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Brokerage) Unwrap() *Brokerage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Brokerage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Brokerage) String() string {
+	var builder strings.Builder
+	builder.WriteString("Brokerage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("license_number=")
+	builder.WriteString(_m.LicenseNumber)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("city=")
+	builder.WriteString(_m.City)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("zip_code=")
+	builder.WriteString(_m.ZipCode)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(_m.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("website=")
+	builder.WriteString(_m.Website)
+	builder.WriteString(", ")
+	builder.WriteString("logo_url=")
+	builder.WriteString(_m.LogoURL)
+	builder.WriteString(", ")
+	builder.WriteString("primary_color=")
+	builder.WriteString(_m.PrimaryColor)
+	builder.WriteString(", ")
+	builder.WriteString("secondary_color=")
+	builder.WriteString(_m.SecondaryColor)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Brokerages is a parsable slice of Brokerage.
+type Brokerages []*Brokerage
@@ -0,0 +1,1118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package brokerage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldName, v))
+}
+
+// LicenseNumber applies equality check predicate on the "license_number" field. It's identical to LicenseNumberEQ.
+func LicenseNumber(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldLicenseNumber, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldAddress, v))
+}
+
+// City applies equality check predicate on the "city" field. It's identical to CityEQ.
+func City(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldCity, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldState, v))
+}
+
+// ZipCode applies equality check predicate on the "zip_code" field. It's identical to ZipCodeEQ.
+func ZipCode(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldZipCode, v))
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldPhone, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldEmail, v))
+}
+
+// Website applies equality check predicate on the "website" field. It's identical to WebsiteEQ.
+func Website(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldWebsite, v))
+}
+
+// LogoURL applies equality check predicate on the "logo_url" field. It's identical to LogoURLEQ.
+func LogoURL(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldLogoURL, v))
+}
+
+// PrimaryColor applies equality check predicate on the "primary_color" field. It's identical to PrimaryColorEQ.
+func PrimaryColor(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldPrimaryColor, v))
+}
+
+// SecondaryColor applies equality check predicate on the "secondary_color" field. It's identical to SecondaryColorEQ.
+func SecondaryColor(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldSecondaryColor, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldName, v))
+}
+
+// LicenseNumberEQ applies the EQ predicate on the "license_number" field.
+func LicenseNumberEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldLicenseNumber, v))
+}
+
+// LicenseNumberNEQ applies the NEQ predicate on the "license_number" field.
+func LicenseNumberNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldLicenseNumber, v))
+}
+
+// LicenseNumberIn applies the In predicate on the "license_number" field.
+func LicenseNumberIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldLicenseNumber, vs...))
+}
+
+// LicenseNumberNotIn applies the NotIn predicate on the "license_number" field.
+func LicenseNumberNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldLicenseNumber, vs...))
+}
+
+// LicenseNumberGT applies the GT predicate on the "license_number" field.
+func LicenseNumberGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldLicenseNumber, v))
+}
+
+// LicenseNumberGTE applies the GTE predicate on the "license_number" field.
+func LicenseNumberGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldLicenseNumber, v))
+}
+
+// LicenseNumberLT applies the LT predicate on the "license_number" field.
+func LicenseNumberLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldLicenseNumber, v))
+}
+
+// LicenseNumberLTE applies the LTE predicate on the "license_number" field.
+func LicenseNumberLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldLicenseNumber, v))
+}
+
+// LicenseNumberContains applies the Contains predicate on the "license_number" field.
+func LicenseNumberContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldLicenseNumber, v))
+}
+
+// LicenseNumberHasPrefix applies the HasPrefix predicate on the "license_number" field.
+func LicenseNumberHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldLicenseNumber, v))
+}
+
+// LicenseNumberHasSuffix applies the HasSuffix predicate on the "license_number" field.
+func LicenseNumberHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldLicenseNumber, v))
+}
+
+// LicenseNumberEqualFold applies the EqualFold predicate on the "license_number" field.
+func LicenseNumberEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldLicenseNumber, v))
+}
+
+// LicenseNumberContainsFold applies the ContainsFold predicate on the "license_number" field.
+func LicenseNumberContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldLicenseNumber, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// CityEQ applies the EQ predicate on the "city" field.
+func CityEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldCity, v))
+}
+
+// CityNEQ applies the NEQ predicate on the "city" field.
+func CityNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldCity, v))
+}
+
+// CityIn applies the In predicate on the "city" field.
+func CityIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldCity, vs...))
+}
+
+// CityNotIn applies the NotIn predicate on the "city" field.
+func CityNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldCity, vs...))
+}
+
+// CityGT applies the GT predicate on the "city" field.
+func CityGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldCity, v))
+}
+
+// CityGTE applies the GTE predicate on the "city" field.
+func CityGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldCity, v))
+}
+
+// CityLT applies the LT predicate on the "city" field.
+func CityLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldCity, v))
+}
+
+// CityLTE applies the LTE predicate on the "city" field.
+func CityLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldCity, v))
+}
+
+// CityContains applies the Contains predicate on the "city" field.
+func CityContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldCity, v))
+}
+
+// CityHasPrefix applies the HasPrefix predicate on the "city" field.
+func CityHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldCity, v))
+}
+
+// CityHasSuffix applies the HasSuffix predicate on the "city" field.
+func CityHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldCity, v))
+}
+
+// CityEqualFold applies the EqualFold predicate on the "city" field.
+func CityEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldCity, v))
+}
+
+// CityContainsFold applies the ContainsFold predicate on the "city" field.
+func CityContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldCity, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateIsNil applies the IsNil predicate on the "state" field.
+func StateIsNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIsNull(FieldState))
+}
+
+// StateNotNil applies the NotNil predicate on the "state" field.
+func StateNotNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotNull(FieldState))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldState, v))
+}
+
+// ZipCodeEQ applies the EQ predicate on the "zip_code" field.
+func ZipCodeEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldZipCode, v))
+}
+
+// ZipCodeNEQ applies the NEQ predicate on the "zip_code" field.
+func ZipCodeNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldZipCode, v))
+}
+
+// ZipCodeIn applies the In predicate on the "zip_code" field.
+func ZipCodeIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldZipCode, vs...))
+}
+
+// ZipCodeNotIn applies the NotIn predicate on the "zip_code" field.
+func ZipCodeNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldZipCode, vs...))
+}
+
+// ZipCodeGT applies the GT predicate on the "zip_code" field.
+func ZipCodeGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldZipCode, v))
+}
+
+// ZipCodeGTE applies the GTE predicate on the "zip_code" field.
+func ZipCodeGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldZipCode, v))
+}
+
+// ZipCodeLT applies the LT predicate on the "zip_code" field.
+func ZipCodeLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldZipCode, v))
+}
+
+// ZipCodeLTE applies the LTE predicate on the "zip_code" field.
+func ZipCodeLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldZipCode, v))
+}
+
+// ZipCodeContains applies the Contains predicate on the "zip_code" field.
+func ZipCodeContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldZipCode, v))
+}
+
+// ZipCodeHasPrefix applies the HasPrefix predicate on the "zip_code" field.
+func ZipCodeHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldZipCode, v))
+}
+
+// ZipCodeHasSuffix applies the HasSuffix predicate on the "zip_code" field.
+func ZipCodeHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldZipCode, v))
+}
+
+// ZipCodeEqualFold applies the EqualFold predicate on the "zip_code" field.
+func ZipCodeEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldZipCode, v))
+}
+
+// ZipCodeContainsFold applies the ContainsFold predicate on the "zip_code" field.
+func ZipCodeContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldZipCode, v))
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneIsNil applies the IsNil predicate on the "phone" field.
+func PhoneIsNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIsNull(FieldPhone))
+}
+
+// PhoneNotNil applies the NotNil predicate on the "phone" field.
+func PhoneNotNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotNull(FieldPhone))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldPhone, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIsNull(FieldEmail))
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotNull(FieldEmail))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// WebsiteEQ applies the EQ predicate on the "website" field.
+func WebsiteEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldWebsite, v))
+}
+
+// WebsiteNEQ applies the NEQ predicate on the "website" field.
+func WebsiteNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldWebsite, v))
+}
+
+// WebsiteIn applies the In predicate on the "website" field.
+func WebsiteIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldWebsite, vs...))
+}
+
+// WebsiteNotIn applies the NotIn predicate on the "website" field.
+func WebsiteNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldWebsite, vs...))
+}
+
+// WebsiteGT applies the GT predicate on the "website" field.
+func WebsiteGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldWebsite, v))
+}
+
+// WebsiteGTE applies the GTE predicate on the "website" field.
+func WebsiteGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldWebsite, v))
+}
+
+// WebsiteLT applies the LT predicate on the "website" field.
+func WebsiteLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldWebsite, v))
+}
+
+// WebsiteLTE applies the LTE predicate on the "website" field.
+func WebsiteLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldWebsite, v))
+}
+
+// WebsiteContains applies the Contains predicate on the "website" field.
+func WebsiteContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldWebsite, v))
+}
+
+// WebsiteHasPrefix applies the HasPrefix predicate on the "website" field.
+func WebsiteHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldWebsite, v))
+}
+
+// WebsiteHasSuffix applies the HasSuffix predicate on the "website" field.
+func WebsiteHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldWebsite, v))
+}
+
+// WebsiteIsNil applies the IsNil predicate on the "website" field.
+func WebsiteIsNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIsNull(FieldWebsite))
+}
+
+// WebsiteNotNil applies the NotNil predicate on the "website" field.
+func WebsiteNotNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotNull(FieldWebsite))
+}
+
+// WebsiteEqualFold applies the EqualFold predicate on the "website" field.
+func WebsiteEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldWebsite, v))
+}
+
+// WebsiteContainsFold applies the ContainsFold predicate on the "website" field.
+func WebsiteContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldWebsite, v))
+}
+
+// LogoURLEQ applies the EQ predicate on the "logo_url" field.
+func LogoURLEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldLogoURL, v))
+}
+
+// LogoURLNEQ applies the NEQ predicate on the "logo_url" field.
+func LogoURLNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldLogoURL, v))
+}
+
+// LogoURLIn applies the In predicate on the "logo_url" field.
+func LogoURLIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldLogoURL, vs...))
+}
+
+// LogoURLNotIn applies the NotIn predicate on the "logo_url" field.
+func LogoURLNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldLogoURL, vs...))
+}
+
+// LogoURLGT applies the GT predicate on the "logo_url" field.
+func LogoURLGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldLogoURL, v))
+}
+
+// LogoURLGTE applies the GTE predicate on the "logo_url" field.
+func LogoURLGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldLogoURL, v))
+}
+
+// LogoURLLT applies the LT predicate on the "logo_url" field.
+func LogoURLLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldLogoURL, v))
+}
+
+// LogoURLLTE applies the LTE predicate on the "logo_url" field.
+func LogoURLLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldLogoURL, v))
+}
+
+// LogoURLContains applies the Contains predicate on the "logo_url" field.
+func LogoURLContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldLogoURL, v))
+}
+
+// LogoURLHasPrefix applies the HasPrefix predicate on the "logo_url" field.
+func LogoURLHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldLogoURL, v))
+}
+
+// LogoURLHasSuffix applies the HasSuffix predicate on the "logo_url" field.
+func LogoURLHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldLogoURL, v))
+}
+
+// LogoURLIsNil applies the IsNil predicate on the "logo_url" field.
+func LogoURLIsNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIsNull(FieldLogoURL))
+}
+
+// LogoURLNotNil applies the NotNil predicate on the "logo_url" field.
+func LogoURLNotNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotNull(FieldLogoURL))
+}
+
+// LogoURLEqualFold applies the EqualFold predicate on the "logo_url" field.
+func LogoURLEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldLogoURL, v))
+}
+
+// LogoURLContainsFold applies the ContainsFold predicate on the "logo_url" field.
+func LogoURLContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldLogoURL, v))
+}
+
+// PrimaryColorEQ applies the EQ predicate on the "primary_color" field.
+func PrimaryColorEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldPrimaryColor, v))
+}
+
+// PrimaryColorNEQ applies the NEQ predicate on the "primary_color" field.
+func PrimaryColorNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldPrimaryColor, v))
+}
+
+// PrimaryColorIn applies the In predicate on the "primary_color" field.
+func PrimaryColorIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldPrimaryColor, vs...))
+}
+
+// PrimaryColorNotIn applies the NotIn predicate on the "primary_color" field.
+func PrimaryColorNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldPrimaryColor, vs...))
+}
+
+// PrimaryColorGT applies the GT predicate on the "primary_color" field.
+func PrimaryColorGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldPrimaryColor, v))
+}
+
+// PrimaryColorGTE applies the GTE predicate on the "primary_color" field.
+func PrimaryColorGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldPrimaryColor, v))
+}
+
+// PrimaryColorLT applies the LT predicate on the "primary_color" field.
+func PrimaryColorLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldPrimaryColor, v))
+}
+
+// PrimaryColorLTE applies the LTE predicate on the "primary_color" field.
+func PrimaryColorLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldPrimaryColor, v))
+}
+
+// PrimaryColorContains applies the Contains predicate on the "primary_color" field.
+func PrimaryColorContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldPrimaryColor, v))
+}
+
+// PrimaryColorHasPrefix applies the HasPrefix predicate on the "primary_color" field.
+func PrimaryColorHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldPrimaryColor, v))
+}
+
+// PrimaryColorHasSuffix applies the HasSuffix predicate on the "primary_color" field.
+func PrimaryColorHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldPrimaryColor, v))
+}
+
+// PrimaryColorIsNil applies the IsNil predicate on the "primary_color" field.
+func PrimaryColorIsNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIsNull(FieldPrimaryColor))
+}
+
+// PrimaryColorNotNil applies the NotNil predicate on the "primary_color" field.
+func PrimaryColorNotNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotNull(FieldPrimaryColor))
+}
+
+// PrimaryColorEqualFold applies the EqualFold predicate on the "primary_color" field.
+func PrimaryColorEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldPrimaryColor, v))
+}
+
+// PrimaryColorContainsFold applies the ContainsFold predicate on the "primary_color" field.
+func PrimaryColorContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldPrimaryColor, v))
+}
+
+// SecondaryColorEQ applies the EQ predicate on the "secondary_color" field.
+func SecondaryColorEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEQ(FieldSecondaryColor, v))
+}
+
+// SecondaryColorNEQ applies the NEQ predicate on the "secondary_color" field.
+func SecondaryColorNEQ(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNEQ(FieldSecondaryColor, v))
+}
+
+// SecondaryColorIn applies the In predicate on the "secondary_color" field.
+func SecondaryColorIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIn(FieldSecondaryColor, vs...))
+}
+
+// SecondaryColorNotIn applies the NotIn predicate on the "secondary_color" field.
+func SecondaryColorNotIn(vs ...string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotIn(FieldSecondaryColor, vs...))
+}
+
+// SecondaryColorGT applies the GT predicate on the "secondary_color" field.
+func SecondaryColorGT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGT(FieldSecondaryColor, v))
+}
+
+// SecondaryColorGTE applies the GTE predicate on the "secondary_color" field.
+func SecondaryColorGTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldGTE(FieldSecondaryColor, v))
+}
+
+// SecondaryColorLT applies the LT predicate on the "secondary_color" field.
+func SecondaryColorLT(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLT(FieldSecondaryColor, v))
+}
+
+// SecondaryColorLTE applies the LTE predicate on the "secondary_color" field.
+func SecondaryColorLTE(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldLTE(FieldSecondaryColor, v))
+}
+
+// SecondaryColorContains applies the Contains predicate on the "secondary_color" field.
+func SecondaryColorContains(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContains(FieldSecondaryColor, v))
+}
+
+// SecondaryColorHasPrefix applies the HasPrefix predicate on the "secondary_color" field.
+func SecondaryColorHasPrefix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasPrefix(FieldSecondaryColor, v))
+}
+
+// SecondaryColorHasSuffix applies the HasSuffix predicate on the "secondary_color" field.
+func SecondaryColorHasSuffix(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldHasSuffix(FieldSecondaryColor, v))
+}
+
+// SecondaryColorIsNil applies the IsNil predicate on the "secondary_color" field.
+func SecondaryColorIsNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldIsNull(FieldSecondaryColor))
+}
+
+// SecondaryColorNotNil applies the NotNil predicate on the "secondary_color" field.
+func SecondaryColorNotNil() predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldNotNull(FieldSecondaryColor))
+}
+
+// SecondaryColorEqualFold applies the EqualFold predicate on the "secondary_color" field.
+func SecondaryColorEqualFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldEqualFold(FieldSecondaryColor, v))
+}
+
+// SecondaryColorContainsFold applies the ContainsFold predicate on the "secondary_color" field.
+func SecondaryColorContainsFold(v string) predicate.Brokerage {
+	return predicate.Brokerage(sql.FieldContainsFold(FieldSecondaryColor, v))
+}
+
+// HasTeams applies the HasEdge predicate on the "teams" edge.
+func HasTeams() predicate.Brokerage {
+	return predicate.Brokerage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TeamsTable, TeamsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamsWith applies the HasEdge predicate on the "teams" edge with a given conditions (other predicates).
+func HasTeamsWith(preds ...predicate.Team) predicate.Brokerage {
+	return predicate.Brokerage(func(s *sql.Selector) {
+		step := newTeamsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRealtors applies the HasEdge predicate on the "realtors" edge.
+func HasRealtors() predicate.Brokerage {
+	return predicate.Brokerage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RealtorsTable, RealtorsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRealtorsWith applies the HasEdge predicate on the "realtors" edge with a given conditions (other predicates).
+func HasRealtorsWith(preds ...predicate.Realtor) predicate.Brokerage {
+	return predicate.Brokerage(func(s *sql.Selector) {
+		step := newRealtorsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Brokerage) predicate.Brokerage {
+	return predicate.Brokerage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Brokerage) predicate.Brokerage {
+	return predicate.Brokerage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Brokerage) predicate.Brokerage {
+	return predicate.Brokerage(sql.NotPredicates(p))
+}
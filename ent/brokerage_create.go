@@ -0,0 +1,1499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/brokerage"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/team"
+)
+
+// BrokerageCreate is the builder for creating a Brokerage entity.
+type BrokerageCreate struct {
+	config
+	mutation *BrokerageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *BrokerageCreate) SetCreateTime(v time.Time) *BrokerageCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *BrokerageCreate) SetNillableCreateTime(v *time.Time) *BrokerageCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *BrokerageCreate) SetUpdateTime(v time.Time) *BrokerageCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *BrokerageCreate) SetNillableUpdateTime(v *time.Time) *BrokerageCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BrokerageCreate) SetName(v string) *BrokerageCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetLicenseNumber sets the "license_number" field.
+func (_c *BrokerageCreate) SetLicenseNumber(v string) *BrokerageCreate {
+	_c.mutation.SetLicenseNumber(v)
+	return _c
+}
+
+// SetAddress sets the "address" field.
+func (_c *BrokerageCreate) SetAddress(v string) *BrokerageCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetCity sets the "city" field.
+func (_c *BrokerageCreate) SetCity(v string) *BrokerageCreate {
+	_c.mutation.SetCity(v)
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *BrokerageCreate) SetState(v string) *BrokerageCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *BrokerageCreate) SetNillableState(v *string) *BrokerageCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetZipCode sets the "zip_code" field.
+func (_c *BrokerageCreate) SetZipCode(v string) *BrokerageCreate {
+	_c.mutation.SetZipCode(v)
+	return _c
+}
+
+// SetPhone sets the "phone" field.
+func (_c *BrokerageCreate) SetPhone(v string) *BrokerageCreate {
+	_c.mutation.SetPhone(v)
+	return _c
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_c *BrokerageCreate) SetNillablePhone(v *string) *BrokerageCreate {
+	if v != nil {
+		_c.SetPhone(*v)
+	}
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *BrokerageCreate) SetEmail(v string) *BrokerageCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_c *BrokerageCreate) SetNillableEmail(v *string) *BrokerageCreate {
+	if v != nil {
+		_c.SetEmail(*v)
+	}
+	return _c
+}
+
+// SetWebsite sets the "website" field.
+func (_c *BrokerageCreate) SetWebsite(v string) *BrokerageCreate {
+	_c.mutation.SetWebsite(v)
+	return _c
+}
+
+// SetNillableWebsite sets the "website" field if the given value is not nil.
+func (_c *BrokerageCreate) SetNillableWebsite(v *string) *BrokerageCreate {
+	if v != nil {
+		_c.SetWebsite(*v)
+	}
+	return _c
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (_c *BrokerageCreate) SetLogoURL(v string) *BrokerageCreate {
+	_c.mutation.SetLogoURL(v)
+	return _c
+}
+
+// SetNillableLogoURL sets the "logo_url" field if the given value is not nil.
+func (_c *BrokerageCreate) SetNillableLogoURL(v *string) *BrokerageCreate {
+	if v != nil {
+		_c.SetLogoURL(*v)
+	}
+	return _c
+}
+
+// SetPrimaryColor sets the "primary_color" field.
+func (_c *BrokerageCreate) SetPrimaryColor(v string) *BrokerageCreate {
+	_c.mutation.SetPrimaryColor(v)
+	return _c
+}
+
+// SetNillablePrimaryColor sets the "primary_color" field if the given value is not nil.
+func (_c *BrokerageCreate) SetNillablePrimaryColor(v *string) *BrokerageCreate {
+	if v != nil {
+		_c.SetPrimaryColor(*v)
+	}
+	return _c
+}
+
+// SetSecondaryColor sets the "secondary_color" field.
+func (_c *BrokerageCreate) SetSecondaryColor(v string) *BrokerageCreate {
+	_c.mutation.SetSecondaryColor(v)
+	return _c
+}
+
+// SetNillableSecondaryColor sets the "secondary_color" field if the given value is not nil.
+func (_c *BrokerageCreate) SetNillableSecondaryColor(v *string) *BrokerageCreate {
+	if v != nil {
+		_c.SetSecondaryColor(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BrokerageCreate) SetID(v uuid.UUID) *BrokerageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BrokerageCreate) SetNillableID(v *uuid.UUID) *BrokerageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddTeamIDs adds the "teams" edge to the Team entity by IDs.
+func (_c *BrokerageCreate) AddTeamIDs(ids ...uuid.UUID) *BrokerageCreate {
+	_c.mutation.AddTeamIDs(ids...)
+	return _c
+}
+
+// AddTeams adds the "teams" edges to the Team entity.
+func (_c *BrokerageCreate) AddTeams(v ...*Team) *BrokerageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTeamIDs(ids...)
+}
+
+// AddRealtorIDs adds the "realtors" edge to the Realtor entity by IDs.
+func (_c *BrokerageCreate) AddRealtorIDs(ids ...uuid.UUID) *BrokerageCreate {
+	_c.mutation.AddRealtorIDs(ids...)
+	return _c
+}
+
+// AddRealtors adds the "realtors" edges to the Realtor entity.
+func (_c *BrokerageCreate) AddRealtors(v ...*Realtor) *BrokerageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRealtorIDs(ids...)
+}
+
+// Mutation returns the BrokerageMutation object of the builder.
+func (_c *BrokerageCreate) Mutation() *BrokerageMutation {
+	return _c.mutation
+}
+
+// Save creates the Brokerage in the database.
+func (_c *BrokerageCreate) Save(ctx context.Context) (*Brokerage, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BrokerageCreate) SaveX(ctx context.Context) *Brokerage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BrokerageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BrokerageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BrokerageCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if brokerage.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized brokerage.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := brokerage.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if brokerage.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized brokerage.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := brokerage.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if brokerage.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized brokerage.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := brokerage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BrokerageCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Brokerage.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Brokerage.update_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Brokerage.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := brokerage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Brokerage.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LicenseNumber(); !ok {
+		return &ValidationError{Name: "license_number", err: errors.New(`ent: missing required field "Brokerage.license_number"`)}
+	}
+	if v, ok := _c.mutation.LicenseNumber(); ok {
+		if err := brokerage.LicenseNumberValidator(v); err != nil {
+			return &ValidationError{Name: "license_number", err: fmt.Errorf(`ent: validator failed for field "Brokerage.license_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "Brokerage.address"`)}
+	}
+	if v, ok := _c.mutation.Address(); ok {
+		if err := brokerage.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Brokerage.address": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.City(); !ok {
+		return &ValidationError{Name: "city", err: errors.New(`ent: missing required field "Brokerage.city"`)}
+	}
+	if v, ok := _c.mutation.City(); ok {
+		if err := brokerage.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Brokerage.city": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.State(); ok {
+		if err := brokerage.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Brokerage.state": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ZipCode(); !ok {
+		return &ValidationError{Name: "zip_code", err: errors.New(`ent: missing required field "Brokerage.zip_code"`)}
+	}
+	if v, ok := _c.mutation.ZipCode(); ok {
+		if err := brokerage.ZipCodeValidator(v); err != nil {
+			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Brokerage.zip_code": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Phone(); ok {
+		if err := brokerage.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Brokerage.phone": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := brokerage.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Brokerage.email": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Website(); ok {
+		if err := brokerage.WebsiteValidator(v); err != nil {
+			return &ValidationError{Name: "website", err: fmt.Errorf(`ent: validator failed for field "Brokerage.website": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LogoURL(); ok {
+		if err := brokerage.LogoURLValidator(v); err != nil {
+			return &ValidationError{Name: "logo_url", err: fmt.Errorf(`ent: validator failed for field "Brokerage.logo_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PrimaryColor(); ok {
+		if err := brokerage.PrimaryColorValidator(v); err != nil {
+			return &ValidationError{Name: "primary_color", err: fmt.Errorf(`ent: validator failed for field "Brokerage.primary_color": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SecondaryColor(); ok {
+		if err := brokerage.SecondaryColorValidator(v); err != nil {
+			return &ValidationError{Name: "secondary_color", err: fmt.Errorf(`ent: validator failed for field "Brokerage.secondary_color": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BrokerageCreate) sqlSave(ctx context.Context) (*Brokerage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BrokerageCreate) createSpec() (*Brokerage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Brokerage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(brokerage.Table, sqlgraph.NewFieldSpec(brokerage.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(brokerage.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(brokerage.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(brokerage.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.LicenseNumber(); ok {
+		_spec.SetField(brokerage.FieldLicenseNumber, field.TypeString, value)
+		_node.LicenseNumber = value
+	}
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(brokerage.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := _c.mutation.City(); ok {
+		_spec.SetField(brokerage.FieldCity, field.TypeString, value)
+		_node.City = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(brokerage.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.ZipCode(); ok {
+		_spec.SetField(brokerage.FieldZipCode, field.TypeString, value)
+		_node.ZipCode = value
+	}
+	if value, ok := _c.mutation.Phone(); ok {
+		_spec.SetField(brokerage.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(brokerage.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.Website(); ok {
+		_spec.SetField(brokerage.FieldWebsite, field.TypeString, value)
+		_node.Website = value
+	}
+	if value, ok := _c.mutation.LogoURL(); ok {
+		_spec.SetField(brokerage.FieldLogoURL, field.TypeString, value)
+		_node.LogoURL = value
+	}
+	if value, ok := _c.mutation.PrimaryColor(); ok {
+		_spec.SetField(brokerage.FieldPrimaryColor, field.TypeString, value)
+		_node.PrimaryColor = value
+	}
+	if value, ok := _c.mutation.SecondaryColor(); ok {
+		_spec.SetField(brokerage.FieldSecondaryColor, field.TypeString, value)
+		_node.SecondaryColor = value
+	}
+	if nodes := _c.mutation.TeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.TeamsTable,
+			Columns: []string{brokerage.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RealtorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.RealtorsTable,
+			Columns: []string{brokerage.RealtorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Brokerage.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BrokerageUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BrokerageCreate) OnConflict(opts ...sql.ConflictOption) *BrokerageUpsertOne {
+	_c.conflict = opts
+	return &BrokerageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Brokerage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BrokerageCreate) OnConflictColumns(columns ...string) *BrokerageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BrokerageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BrokerageUpsertOne is the builder for "upsert"-ing
+	//  one Brokerage node.
+	BrokerageUpsertOne struct {
+		create *BrokerageCreate
+	}
+
+	// BrokerageUpsert is the "OnConflict" setter.
+	BrokerageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BrokerageUpsert) SetUpdateTime(v time.Time) *BrokerageUpsert {
+	u.Set(brokerage.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdateUpdateTime() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldUpdateTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *BrokerageUpsert) SetName(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdateName() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldName)
+	return u
+}
+
+// SetLicenseNumber sets the "license_number" field.
+func (u *BrokerageUpsert) SetLicenseNumber(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldLicenseNumber, v)
+	return u
+}
+
+// UpdateLicenseNumber sets the "license_number" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdateLicenseNumber() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldLicenseNumber)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *BrokerageUpsert) SetAddress(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdateAddress() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldAddress)
+	return u
+}
+
+// SetCity sets the "city" field.
+func (u *BrokerageUpsert) SetCity(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldCity, v)
+	return u
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdateCity() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldCity)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *BrokerageUpsert) SetState(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdateState() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldState)
+	return u
+}
+
+// ClearState clears the value of the "state" field.
+func (u *BrokerageUpsert) ClearState() *BrokerageUpsert {
+	u.SetNull(brokerage.FieldState)
+	return u
+}
+
+// SetZipCode sets the "zip_code" field.
+func (u *BrokerageUpsert) SetZipCode(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldZipCode, v)
+	return u
+}
+
+// UpdateZipCode sets the "zip_code" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdateZipCode() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldZipCode)
+	return u
+}
+
+// SetPhone sets the "phone" field.
+func (u *BrokerageUpsert) SetPhone(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldPhone, v)
+	return u
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdatePhone() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldPhone)
+	return u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *BrokerageUpsert) ClearPhone() *BrokerageUpsert {
+	u.SetNull(brokerage.FieldPhone)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *BrokerageUpsert) SetEmail(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdateEmail() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldEmail)
+	return u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *BrokerageUpsert) ClearEmail() *BrokerageUpsert {
+	u.SetNull(brokerage.FieldEmail)
+	return u
+}
+
+// SetWebsite sets the "website" field.
+func (u *BrokerageUpsert) SetWebsite(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldWebsite, v)
+	return u
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdateWebsite() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldWebsite)
+	return u
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *BrokerageUpsert) ClearWebsite() *BrokerageUpsert {
+	u.SetNull(brokerage.FieldWebsite)
+	return u
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (u *BrokerageUpsert) SetLogoURL(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldLogoURL, v)
+	return u
+}
+
+// UpdateLogoURL sets the "logo_url" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdateLogoURL() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldLogoURL)
+	return u
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (u *BrokerageUpsert) ClearLogoURL() *BrokerageUpsert {
+	u.SetNull(brokerage.FieldLogoURL)
+	return u
+}
+
+// SetPrimaryColor sets the "primary_color" field.
+func (u *BrokerageUpsert) SetPrimaryColor(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldPrimaryColor, v)
+	return u
+}
+
+// UpdatePrimaryColor sets the "primary_color" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdatePrimaryColor() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldPrimaryColor)
+	return u
+}
+
+// ClearPrimaryColor clears the value of the "primary_color" field.
+func (u *BrokerageUpsert) ClearPrimaryColor() *BrokerageUpsert {
+	u.SetNull(brokerage.FieldPrimaryColor)
+	return u
+}
+
+// SetSecondaryColor sets the "secondary_color" field.
+func (u *BrokerageUpsert) SetSecondaryColor(v string) *BrokerageUpsert {
+	u.Set(brokerage.FieldSecondaryColor, v)
+	return u
+}
+
+// UpdateSecondaryColor sets the "secondary_color" field to the value that was provided on create.
+func (u *BrokerageUpsert) UpdateSecondaryColor() *BrokerageUpsert {
+	u.SetExcluded(brokerage.FieldSecondaryColor)
+	return u
+}
+
+// ClearSecondaryColor clears the value of the "secondary_color" field.
+func (u *BrokerageUpsert) ClearSecondaryColor() *BrokerageUpsert {
+	u.SetNull(brokerage.FieldSecondaryColor)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Brokerage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(brokerage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BrokerageUpsertOne) UpdateNewValues() *BrokerageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(brokerage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(brokerage.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Brokerage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BrokerageUpsertOne) Ignore() *BrokerageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BrokerageUpsertOne) DoNothing() *BrokerageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BrokerageCreate.OnConflict
+// documentation for more info.
+func (u *BrokerageUpsertOne) Update(set func(*BrokerageUpsert)) *BrokerageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BrokerageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BrokerageUpsertOne) SetUpdateTime(v time.Time) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdateUpdateTime() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *BrokerageUpsertOne) SetName(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdateName() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetLicenseNumber sets the "license_number" field.
+func (u *BrokerageUpsertOne) SetLicenseNumber(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetLicenseNumber(v)
+	})
+}
+
+// UpdateLicenseNumber sets the "license_number" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdateLicenseNumber() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateLicenseNumber()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *BrokerageUpsertOne) SetAddress(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdateAddress() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *BrokerageUpsertOne) SetCity(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdateCity() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *BrokerageUpsertOne) SetState(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdateState() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateState()
+	})
+}
+
+// ClearState clears the value of the "state" field.
+func (u *BrokerageUpsertOne) ClearState() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearState()
+	})
+}
+
+// SetZipCode sets the "zip_code" field.
+func (u *BrokerageUpsertOne) SetZipCode(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetZipCode(v)
+	})
+}
+
+// UpdateZipCode sets the "zip_code" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdateZipCode() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateZipCode()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *BrokerageUpsertOne) SetPhone(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdatePhone() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *BrokerageUpsertOne) ClearPhone() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *BrokerageUpsertOne) SetEmail(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdateEmail() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *BrokerageUpsertOne) ClearEmail() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetWebsite sets the "website" field.
+func (u *BrokerageUpsertOne) SetWebsite(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetWebsite(v)
+	})
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdateWebsite() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateWebsite()
+	})
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *BrokerageUpsertOne) ClearWebsite() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearWebsite()
+	})
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (u *BrokerageUpsertOne) SetLogoURL(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetLogoURL(v)
+	})
+}
+
+// UpdateLogoURL sets the "logo_url" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdateLogoURL() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateLogoURL()
+	})
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (u *BrokerageUpsertOne) ClearLogoURL() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearLogoURL()
+	})
+}
+
+// SetPrimaryColor sets the "primary_color" field.
+func (u *BrokerageUpsertOne) SetPrimaryColor(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetPrimaryColor(v)
+	})
+}
+
+// UpdatePrimaryColor sets the "primary_color" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdatePrimaryColor() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdatePrimaryColor()
+	})
+}
+
+// ClearPrimaryColor clears the value of the "primary_color" field.
+func (u *BrokerageUpsertOne) ClearPrimaryColor() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearPrimaryColor()
+	})
+}
+
+// SetSecondaryColor sets the "secondary_color" field.
+func (u *BrokerageUpsertOne) SetSecondaryColor(v string) *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetSecondaryColor(v)
+	})
+}
+
+// UpdateSecondaryColor sets the "secondary_color" field to the value that was provided on create.
+func (u *BrokerageUpsertOne) UpdateSecondaryColor() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateSecondaryColor()
+	})
+}
+
+// ClearSecondaryColor clears the value of the "secondary_color" field.
+func (u *BrokerageUpsertOne) ClearSecondaryColor() *BrokerageUpsertOne {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearSecondaryColor()
+	})
+}
+
+// Exec executes the query.
+func (u *BrokerageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BrokerageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BrokerageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BrokerageUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BrokerageUpsertOne.ID is not supported by MySQL driver. Use BrokerageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BrokerageUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BrokerageCreateBulk is the builder for creating many Brokerage entities in bulk.
+type BrokerageCreateBulk struct {
+	config
+	err      error
+	builders []*BrokerageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Brokerage entities in the database.
+func (_c *BrokerageCreateBulk) Save(ctx context.Context) ([]*Brokerage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Brokerage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BrokerageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BrokerageCreateBulk) SaveX(ctx context.Context) []*Brokerage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BrokerageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BrokerageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Brokerage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BrokerageUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BrokerageCreateBulk) OnConflict(opts ...sql.ConflictOption) *BrokerageUpsertBulk {
+	_c.conflict = opts
+	return &BrokerageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Brokerage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BrokerageCreateBulk) OnConflictColumns(columns ...string) *BrokerageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BrokerageUpsertBulk{
+		create: _c,
+	}
+}
+
+// BrokerageUpsertBulk is the builder for "upsert"-ing
+// a bulk of Brokerage nodes.
+type BrokerageUpsertBulk struct {
+	create *BrokerageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Brokerage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(brokerage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BrokerageUpsertBulk) UpdateNewValues() *BrokerageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(brokerage.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(brokerage.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Brokerage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BrokerageUpsertBulk) Ignore() *BrokerageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BrokerageUpsertBulk) DoNothing() *BrokerageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BrokerageCreateBulk.OnConflict
+// documentation for more info.
+func (u *BrokerageUpsertBulk) Update(set func(*BrokerageUpsert)) *BrokerageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BrokerageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BrokerageUpsertBulk) SetUpdateTime(v time.Time) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdateUpdateTime() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *BrokerageUpsertBulk) SetName(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdateName() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetLicenseNumber sets the "license_number" field.
+func (u *BrokerageUpsertBulk) SetLicenseNumber(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetLicenseNumber(v)
+	})
+}
+
+// UpdateLicenseNumber sets the "license_number" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdateLicenseNumber() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateLicenseNumber()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *BrokerageUpsertBulk) SetAddress(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdateAddress() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *BrokerageUpsertBulk) SetCity(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdateCity() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *BrokerageUpsertBulk) SetState(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdateState() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateState()
+	})
+}
+
+// ClearState clears the value of the "state" field.
+func (u *BrokerageUpsertBulk) ClearState() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearState()
+	})
+}
+
+// SetZipCode sets the "zip_code" field.
+func (u *BrokerageUpsertBulk) SetZipCode(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetZipCode(v)
+	})
+}
+
+// UpdateZipCode sets the "zip_code" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdateZipCode() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateZipCode()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *BrokerageUpsertBulk) SetPhone(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdatePhone() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (u *BrokerageUpsertBulk) ClearPhone() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearPhone()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *BrokerageUpsertBulk) SetEmail(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdateEmail() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *BrokerageUpsertBulk) ClearEmail() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetWebsite sets the "website" field.
+func (u *BrokerageUpsertBulk) SetWebsite(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetWebsite(v)
+	})
+}
+
+// UpdateWebsite sets the "website" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdateWebsite() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateWebsite()
+	})
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (u *BrokerageUpsertBulk) ClearWebsite() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearWebsite()
+	})
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (u *BrokerageUpsertBulk) SetLogoURL(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetLogoURL(v)
+	})
+}
+
+// UpdateLogoURL sets the "logo_url" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdateLogoURL() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateLogoURL()
+	})
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (u *BrokerageUpsertBulk) ClearLogoURL() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearLogoURL()
+	})
+}
+
+// SetPrimaryColor sets the "primary_color" field.
+func (u *BrokerageUpsertBulk) SetPrimaryColor(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetPrimaryColor(v)
+	})
+}
+
+// UpdatePrimaryColor sets the "primary_color" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdatePrimaryColor() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdatePrimaryColor()
+	})
+}
+
+// ClearPrimaryColor clears the value of the "primary_color" field.
+func (u *BrokerageUpsertBulk) ClearPrimaryColor() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearPrimaryColor()
+	})
+}
+
+// SetSecondaryColor sets the "secondary_color" field.
+func (u *BrokerageUpsertBulk) SetSecondaryColor(v string) *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.SetSecondaryColor(v)
+	})
+}
+
+// UpdateSecondaryColor sets the "secondary_color" field to the value that was provided on create.
+func (u *BrokerageUpsertBulk) UpdateSecondaryColor() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.UpdateSecondaryColor()
+	})
+}
+
+// ClearSecondaryColor clears the value of the "secondary_color" field.
+func (u *BrokerageUpsertBulk) ClearSecondaryColor() *BrokerageUpsertBulk {
+	return u.Update(func(s *BrokerageUpsert) {
+		s.ClearSecondaryColor()
+	})
+}
+
+// Exec executes the query.
+func (u *BrokerageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BrokerageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BrokerageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BrokerageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
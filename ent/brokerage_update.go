@@ -0,0 +1,1221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/brokerage"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/team"
+)
+
+// BrokerageUpdate is the builder for updating Brokerage entities.
+type BrokerageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BrokerageMutation
+}
+
+// Where appends a list predicates to the BrokerageUpdate builder.
+func (_u *BrokerageUpdate) Where(ps ...predicate.Brokerage) *BrokerageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *BrokerageUpdate) SetUpdateTime(v time.Time) *BrokerageUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BrokerageUpdate) SetName(v string) *BrokerageUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillableName(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLicenseNumber sets the "license_number" field.
+func (_u *BrokerageUpdate) SetLicenseNumber(v string) *BrokerageUpdate {
+	_u.mutation.SetLicenseNumber(v)
+	return _u
+}
+
+// SetNillableLicenseNumber sets the "license_number" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillableLicenseNumber(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetLicenseNumber(*v)
+	}
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *BrokerageUpdate) SetAddress(v string) *BrokerageUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillableAddress(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *BrokerageUpdate) SetCity(v string) *BrokerageUpdate {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillableCity(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BrokerageUpdate) SetState(v string) *BrokerageUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillableState(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BrokerageUpdate) ClearState() *BrokerageUpdate {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetZipCode sets the "zip_code" field.
+func (_u *BrokerageUpdate) SetZipCode(v string) *BrokerageUpdate {
+	_u.mutation.SetZipCode(v)
+	return _u
+}
+
+// SetNillableZipCode sets the "zip_code" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillableZipCode(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetZipCode(*v)
+	}
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *BrokerageUpdate) SetPhone(v string) *BrokerageUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillablePhone(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *BrokerageUpdate) ClearPhone() *BrokerageUpdate {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *BrokerageUpdate) SetEmail(v string) *BrokerageUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillableEmail(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *BrokerageUpdate) ClearEmail() *BrokerageUpdate {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetWebsite sets the "website" field.
+func (_u *BrokerageUpdate) SetWebsite(v string) *BrokerageUpdate {
+	_u.mutation.SetWebsite(v)
+	return _u
+}
+
+// SetNillableWebsite sets the "website" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillableWebsite(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetWebsite(*v)
+	}
+	return _u
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (_u *BrokerageUpdate) ClearWebsite() *BrokerageUpdate {
+	_u.mutation.ClearWebsite()
+	return _u
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (_u *BrokerageUpdate) SetLogoURL(v string) *BrokerageUpdate {
+	_u.mutation.SetLogoURL(v)
+	return _u
+}
+
+// SetNillableLogoURL sets the "logo_url" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillableLogoURL(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetLogoURL(*v)
+	}
+	return _u
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (_u *BrokerageUpdate) ClearLogoURL() *BrokerageUpdate {
+	_u.mutation.ClearLogoURL()
+	return _u
+}
+
+// SetPrimaryColor sets the "primary_color" field.
+func (_u *BrokerageUpdate) SetPrimaryColor(v string) *BrokerageUpdate {
+	_u.mutation.SetPrimaryColor(v)
+	return _u
+}
+
+// SetNillablePrimaryColor sets the "primary_color" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillablePrimaryColor(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetPrimaryColor(*v)
+	}
+	return _u
+}
+
+// ClearPrimaryColor clears the value of the "primary_color" field.
+func (_u *BrokerageUpdate) ClearPrimaryColor() *BrokerageUpdate {
+	_u.mutation.ClearPrimaryColor()
+	return _u
+}
+
+// SetSecondaryColor sets the "secondary_color" field.
+func (_u *BrokerageUpdate) SetSecondaryColor(v string) *BrokerageUpdate {
+	_u.mutation.SetSecondaryColor(v)
+	return _u
+}
+
+// SetNillableSecondaryColor sets the "secondary_color" field if the given value is not nil.
+func (_u *BrokerageUpdate) SetNillableSecondaryColor(v *string) *BrokerageUpdate {
+	if v != nil {
+		_u.SetSecondaryColor(*v)
+	}
+	return _u
+}
+
+// ClearSecondaryColor clears the value of the "secondary_color" field.
+func (_u *BrokerageUpdate) ClearSecondaryColor() *BrokerageUpdate {
+	_u.mutation.ClearSecondaryColor()
+	return _u
+}
+
+// AddTeamIDs adds the "teams" edge to the Team entity by IDs.
+func (_u *BrokerageUpdate) AddTeamIDs(ids ...uuid.UUID) *BrokerageUpdate {
+	_u.mutation.AddTeamIDs(ids...)
+	return _u
+}
+
+// AddTeams adds the "teams" edges to the Team entity.
+func (_u *BrokerageUpdate) AddTeams(v ...*Team) *BrokerageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTeamIDs(ids...)
+}
+
+// AddRealtorIDs adds the "realtors" edge to the Realtor entity by IDs.
+func (_u *BrokerageUpdate) AddRealtorIDs(ids ...uuid.UUID) *BrokerageUpdate {
+	_u.mutation.AddRealtorIDs(ids...)
+	return _u
+}
+
+// AddRealtors adds the "realtors" edges to the Realtor entity.
+func (_u *BrokerageUpdate) AddRealtors(v ...*Realtor) *BrokerageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRealtorIDs(ids...)
+}
+
+// Mutation returns the BrokerageMutation object of the builder.
+func (_u *BrokerageUpdate) Mutation() *BrokerageMutation {
+	return _u.mutation
+}
+
+// ClearTeams clears all "teams" edges to the Team entity.
+func (_u *BrokerageUpdate) ClearTeams() *BrokerageUpdate {
+	_u.mutation.ClearTeams()
+	return _u
+}
+
+// RemoveTeamIDs removes the "teams" edge to Team entities by IDs.
+func (_u *BrokerageUpdate) RemoveTeamIDs(ids ...uuid.UUID) *BrokerageUpdate {
+	_u.mutation.RemoveTeamIDs(ids...)
+	return _u
+}
+
+// RemoveTeams removes "teams" edges to Team entities.
+func (_u *BrokerageUpdate) RemoveTeams(v ...*Team) *BrokerageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTeamIDs(ids...)
+}
+
+// ClearRealtors clears all "realtors" edges to the Realtor entity.
+func (_u *BrokerageUpdate) ClearRealtors() *BrokerageUpdate {
+	_u.mutation.ClearRealtors()
+	return _u
+}
+
+// RemoveRealtorIDs removes the "realtors" edge to Realtor entities by IDs.
+func (_u *BrokerageUpdate) RemoveRealtorIDs(ids ...uuid.UUID) *BrokerageUpdate {
+	_u.mutation.RemoveRealtorIDs(ids...)
+	return _u
+}
+
+// RemoveRealtors removes "realtors" edges to Realtor entities.
+func (_u *BrokerageUpdate) RemoveRealtors(v ...*Realtor) *BrokerageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRealtorIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BrokerageUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BrokerageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BrokerageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BrokerageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BrokerageUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if brokerage.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized brokerage.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := brokerage.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BrokerageUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := brokerage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Brokerage.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LicenseNumber(); ok {
+		if err := brokerage.LicenseNumberValidator(v); err != nil {
+			return &ValidationError{Name: "license_number", err: fmt.Errorf(`ent: validator failed for field "Brokerage.license_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Address(); ok {
+		if err := brokerage.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Brokerage.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.City(); ok {
+		if err := brokerage.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Brokerage.city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := brokerage.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Brokerage.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ZipCode(); ok {
+		if err := brokerage.ZipCodeValidator(v); err != nil {
+			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Brokerage.zip_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phone(); ok {
+		if err := brokerage.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Brokerage.phone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := brokerage.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Brokerage.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Website(); ok {
+		if err := brokerage.WebsiteValidator(v); err != nil {
+			return &ValidationError{Name: "website", err: fmt.Errorf(`ent: validator failed for field "Brokerage.website": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LogoURL(); ok {
+		if err := brokerage.LogoURLValidator(v); err != nil {
+			return &ValidationError{Name: "logo_url", err: fmt.Errorf(`ent: validator failed for field "Brokerage.logo_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PrimaryColor(); ok {
+		if err := brokerage.PrimaryColorValidator(v); err != nil {
+			return &ValidationError{Name: "primary_color", err: fmt.Errorf(`ent: validator failed for field "Brokerage.primary_color": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SecondaryColor(); ok {
+		if err := brokerage.SecondaryColorValidator(v); err != nil {
+			return &ValidationError{Name: "secondary_color", err: fmt.Errorf(`ent: validator failed for field "Brokerage.secondary_color": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BrokerageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(brokerage.Table, brokerage.Columns, sqlgraph.NewFieldSpec(brokerage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(brokerage.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(brokerage.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LicenseNumber(); ok {
+		_spec.SetField(brokerage.FieldLicenseNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(brokerage.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(brokerage.FieldCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(brokerage.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(brokerage.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.ZipCode(); ok {
+		_spec.SetField(brokerage.FieldZipCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(brokerage.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(brokerage.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(brokerage.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(brokerage.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Website(); ok {
+		_spec.SetField(brokerage.FieldWebsite, field.TypeString, value)
+	}
+	if _u.mutation.WebsiteCleared() {
+		_spec.ClearField(brokerage.FieldWebsite, field.TypeString)
+	}
+	if value, ok := _u.mutation.LogoURL(); ok {
+		_spec.SetField(brokerage.FieldLogoURL, field.TypeString, value)
+	}
+	if _u.mutation.LogoURLCleared() {
+		_spec.ClearField(brokerage.FieldLogoURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrimaryColor(); ok {
+		_spec.SetField(brokerage.FieldPrimaryColor, field.TypeString, value)
+	}
+	if _u.mutation.PrimaryColorCleared() {
+		_spec.ClearField(brokerage.FieldPrimaryColor, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecondaryColor(); ok {
+		_spec.SetField(brokerage.FieldSecondaryColor, field.TypeString, value)
+	}
+	if _u.mutation.SecondaryColorCleared() {
+		_spec.ClearField(brokerage.FieldSecondaryColor, field.TypeString)
+	}
+	if _u.mutation.TeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.TeamsTable,
+			Columns: []string{brokerage.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTeamsIDs(); len(nodes) > 0 && !_u.mutation.TeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.TeamsTable,
+			Columns: []string{brokerage.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.TeamsTable,
+			Columns: []string{brokerage.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RealtorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.RealtorsTable,
+			Columns: []string{brokerage.RealtorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRealtorsIDs(); len(nodes) > 0 && !_u.mutation.RealtorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.RealtorsTable,
+			Columns: []string{brokerage.RealtorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RealtorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.RealtorsTable,
+			Columns: []string{brokerage.RealtorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{brokerage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BrokerageUpdateOne is the builder for updating a single Brokerage entity.
+type BrokerageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BrokerageMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *BrokerageUpdateOne) SetUpdateTime(v time.Time) *BrokerageUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BrokerageUpdateOne) SetName(v string) *BrokerageUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillableName(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLicenseNumber sets the "license_number" field.
+func (_u *BrokerageUpdateOne) SetLicenseNumber(v string) *BrokerageUpdateOne {
+	_u.mutation.SetLicenseNumber(v)
+	return _u
+}
+
+// SetNillableLicenseNumber sets the "license_number" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillableLicenseNumber(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetLicenseNumber(*v)
+	}
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *BrokerageUpdateOne) SetAddress(v string) *BrokerageUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillableAddress(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *BrokerageUpdateOne) SetCity(v string) *BrokerageUpdateOne {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillableCity(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *BrokerageUpdateOne) SetState(v string) *BrokerageUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillableState(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// ClearState clears the value of the "state" field.
+func (_u *BrokerageUpdateOne) ClearState() *BrokerageUpdateOne {
+	_u.mutation.ClearState()
+	return _u
+}
+
+// SetZipCode sets the "zip_code" field.
+func (_u *BrokerageUpdateOne) SetZipCode(v string) *BrokerageUpdateOne {
+	_u.mutation.SetZipCode(v)
+	return _u
+}
+
+// SetNillableZipCode sets the "zip_code" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillableZipCode(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetZipCode(*v)
+	}
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *BrokerageUpdateOne) SetPhone(v string) *BrokerageUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillablePhone(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *BrokerageUpdateOne) ClearPhone() *BrokerageUpdateOne {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *BrokerageUpdateOne) SetEmail(v string) *BrokerageUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillableEmail(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (_u *BrokerageUpdateOne) ClearEmail() *BrokerageUpdateOne {
+	_u.mutation.ClearEmail()
+	return _u
+}
+
+// SetWebsite sets the "website" field.
+func (_u *BrokerageUpdateOne) SetWebsite(v string) *BrokerageUpdateOne {
+	_u.mutation.SetWebsite(v)
+	return _u
+}
+
+// SetNillableWebsite sets the "website" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillableWebsite(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetWebsite(*v)
+	}
+	return _u
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (_u *BrokerageUpdateOne) ClearWebsite() *BrokerageUpdateOne {
+	_u.mutation.ClearWebsite()
+	return _u
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (_u *BrokerageUpdateOne) SetLogoURL(v string) *BrokerageUpdateOne {
+	_u.mutation.SetLogoURL(v)
+	return _u
+}
+
+// SetNillableLogoURL sets the "logo_url" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillableLogoURL(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetLogoURL(*v)
+	}
+	return _u
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (_u *BrokerageUpdateOne) ClearLogoURL() *BrokerageUpdateOne {
+	_u.mutation.ClearLogoURL()
+	return _u
+}
+
+// SetPrimaryColor sets the "primary_color" field.
+func (_u *BrokerageUpdateOne) SetPrimaryColor(v string) *BrokerageUpdateOne {
+	_u.mutation.SetPrimaryColor(v)
+	return _u
+}
+
+// SetNillablePrimaryColor sets the "primary_color" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillablePrimaryColor(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetPrimaryColor(*v)
+	}
+	return _u
+}
+
+// ClearPrimaryColor clears the value of the "primary_color" field.
+func (_u *BrokerageUpdateOne) ClearPrimaryColor() *BrokerageUpdateOne {
+	_u.mutation.ClearPrimaryColor()
+	return _u
+}
+
+// SetSecondaryColor sets the "secondary_color" field.
+func (_u *BrokerageUpdateOne) SetSecondaryColor(v string) *BrokerageUpdateOne {
+	_u.mutation.SetSecondaryColor(v)
+	return _u
+}
+
+// SetNillableSecondaryColor sets the "secondary_color" field if the given value is not nil.
+func (_u *BrokerageUpdateOne) SetNillableSecondaryColor(v *string) *BrokerageUpdateOne {
+	if v != nil {
+		_u.SetSecondaryColor(*v)
+	}
+	return _u
+}
+
+// ClearSecondaryColor clears the value of the "secondary_color" field.
+func (_u *BrokerageUpdateOne) ClearSecondaryColor() *BrokerageUpdateOne {
+	_u.mutation.ClearSecondaryColor()
+	return _u
+}
+
+// AddTeamIDs adds the "teams" edge to the Team entity by IDs.
+func (_u *BrokerageUpdateOne) AddTeamIDs(ids ...uuid.UUID) *BrokerageUpdateOne {
+	_u.mutation.AddTeamIDs(ids...)
+	return _u
+}
+
+// AddTeams adds the "teams" edges to the Team entity.
+func (_u *BrokerageUpdateOne) AddTeams(v ...*Team) *BrokerageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTeamIDs(ids...)
+}
+
+// AddRealtorIDs adds the "realtors" edge to the Realtor entity by IDs.
+func (_u *BrokerageUpdateOne) AddRealtorIDs(ids ...uuid.UUID) *BrokerageUpdateOne {
+	_u.mutation.AddRealtorIDs(ids...)
+	return _u
+}
+
+// AddRealtors adds the "realtors" edges to the Realtor entity.
+func (_u *BrokerageUpdateOne) AddRealtors(v ...*Realtor) *BrokerageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRealtorIDs(ids...)
+}
+
+// Mutation returns the BrokerageMutation object of the builder.
+func (_u *BrokerageUpdateOne) Mutation() *BrokerageMutation {
+	return _u.mutation
+}
+
+// ClearTeams clears all "teams" edges to the Team entity.
+func (_u *BrokerageUpdateOne) ClearTeams() *BrokerageUpdateOne {
+	_u.mutation.ClearTeams()
+	return _u
+}
+
+// RemoveTeamIDs removes the "teams" edge to Team entities by IDs.
+func (_u *BrokerageUpdateOne) RemoveTeamIDs(ids ...uuid.UUID) *BrokerageUpdateOne {
+	_u.mutation.RemoveTeamIDs(ids...)
+	return _u
+}
+
+// RemoveTeams removes "teams" edges to Team entities.
+func (_u *BrokerageUpdateOne) RemoveTeams(v ...*Team) *BrokerageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTeamIDs(ids...)
+}
+
+// ClearRealtors clears all "realtors" edges to the Realtor entity.
+func (_u *BrokerageUpdateOne) ClearRealtors() *BrokerageUpdateOne {
+	_u.mutation.ClearRealtors()
+	return _u
+}
+
+// RemoveRealtorIDs removes the "realtors" edge to Realtor entities by IDs.
+func (_u *BrokerageUpdateOne) RemoveRealtorIDs(ids ...uuid.UUID) *BrokerageUpdateOne {
+	_u.mutation.RemoveRealtorIDs(ids...)
+	return _u
+}
+
+// RemoveRealtors removes "realtors" edges to Realtor entities.
+func (_u *BrokerageUpdateOne) RemoveRealtors(v ...*Realtor) *BrokerageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRealtorIDs(ids...)
+}
+
+// Where appends a list predicates to the BrokerageUpdate builder.
+func (_u *BrokerageUpdateOne) Where(ps ...predicate.Brokerage) *BrokerageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BrokerageUpdateOne) Select(field string, fields ...string) *BrokerageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Brokerage entity.
+func (_u *BrokerageUpdateOne) Save(ctx context.Context) (*Brokerage, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BrokerageUpdateOne) SaveX(ctx context.Context) *Brokerage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BrokerageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BrokerageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BrokerageUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if brokerage.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized brokerage.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := brokerage.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BrokerageUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := brokerage.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Brokerage.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LicenseNumber(); ok {
+		if err := brokerage.LicenseNumberValidator(v); err != nil {
+			return &ValidationError{Name: "license_number", err: fmt.Errorf(`ent: validator failed for field "Brokerage.license_number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Address(); ok {
+		if err := brokerage.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Brokerage.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.City(); ok {
+		if err := brokerage.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Brokerage.city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := brokerage.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Brokerage.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ZipCode(); ok {
+		if err := brokerage.ZipCodeValidator(v); err != nil {
+			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Brokerage.zip_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Phone(); ok {
+		if err := brokerage.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Brokerage.phone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := brokerage.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Brokerage.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Website(); ok {
+		if err := brokerage.WebsiteValidator(v); err != nil {
+			return &ValidationError{Name: "website", err: fmt.Errorf(`ent: validator failed for field "Brokerage.website": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LogoURL(); ok {
+		if err := brokerage.LogoURLValidator(v); err != nil {
+			return &ValidationError{Name: "logo_url", err: fmt.Errorf(`ent: validator failed for field "Brokerage.logo_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PrimaryColor(); ok {
+		if err := brokerage.PrimaryColorValidator(v); err != nil {
+			return &ValidationError{Name: "primary_color", err: fmt.Errorf(`ent: validator failed for field "Brokerage.primary_color": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SecondaryColor(); ok {
+		if err := brokerage.SecondaryColorValidator(v); err != nil {
+			return &ValidationError{Name: "secondary_color", err: fmt.Errorf(`ent: validator failed for field "Brokerage.secondary_color": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BrokerageUpdateOne) sqlSave(ctx context.Context) (_node *Brokerage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(brokerage.Table, brokerage.Columns, sqlgraph.NewFieldSpec(brokerage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Brokerage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, brokerage.FieldID)
+		for _, f := range fields {
+			if !brokerage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != brokerage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(brokerage.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(brokerage.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LicenseNumber(); ok {
+		_spec.SetField(brokerage.FieldLicenseNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(brokerage.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(brokerage.FieldCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(brokerage.FieldState, field.TypeString, value)
+	}
+	if _u.mutation.StateCleared() {
+		_spec.ClearField(brokerage.FieldState, field.TypeString)
+	}
+	if value, ok := _u.mutation.ZipCode(); ok {
+		_spec.SetField(brokerage.FieldZipCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(brokerage.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(brokerage.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(brokerage.FieldEmail, field.TypeString, value)
+	}
+	if _u.mutation.EmailCleared() {
+		_spec.ClearField(brokerage.FieldEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Website(); ok {
+		_spec.SetField(brokerage.FieldWebsite, field.TypeString, value)
+	}
+	if _u.mutation.WebsiteCleared() {
+		_spec.ClearField(brokerage.FieldWebsite, field.TypeString)
+	}
+	if value, ok := _u.mutation.LogoURL(); ok {
+		_spec.SetField(brokerage.FieldLogoURL, field.TypeString, value)
+	}
+	if _u.mutation.LogoURLCleared() {
+		_spec.ClearField(brokerage.FieldLogoURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.PrimaryColor(); ok {
+		_spec.SetField(brokerage.FieldPrimaryColor, field.TypeString, value)
+	}
+	if _u.mutation.PrimaryColorCleared() {
+		_spec.ClearField(brokerage.FieldPrimaryColor, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecondaryColor(); ok {
+		_spec.SetField(brokerage.FieldSecondaryColor, field.TypeString, value)
+	}
+	if _u.mutation.SecondaryColorCleared() {
+		_spec.ClearField(brokerage.FieldSecondaryColor, field.TypeString)
+	}
+	if _u.mutation.TeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.TeamsTable,
+			Columns: []string{brokerage.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTeamsIDs(); len(nodes) > 0 && !_u.mutation.TeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.TeamsTable,
+			Columns: []string{brokerage.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.TeamsTable,
+			Columns: []string{brokerage.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RealtorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.RealtorsTable,
+			Columns: []string{brokerage.RealtorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRealtorsIDs(); len(nodes) > 0 && !_u.mutation.RealtorsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.RealtorsTable,
+			Columns: []string{brokerage.RealtorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RealtorsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   brokerage.RealtorsTable,
+			Columns: []string{brokerage.RealtorsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Brokerage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{brokerage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -16,9 +16,26 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"ppgroup.ppgroup.com/ent/brokerage"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/exchangerate"
 	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/listingrenewal"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/listingstatusevent"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/notification"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/pointofinterest"
+	"ppgroup.ppgroup.com/ent/property"
 	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+	"ppgroup.ppgroup.com/ent/team"
 	"ppgroup.ppgroup.com/ent/user"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -26,10 +43,40 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Brokerage is the client for interacting with the Brokerage builders.
+	Brokerage *BrokerageClient
+	// DocumentDownload is the client for interacting with the DocumentDownload builders.
+	DocumentDownload *DocumentDownloadClient
+	// ExchangeRate is the client for interacting with the ExchangeRate builders.
+	ExchangeRate *ExchangeRateClient
 	// Listing is the client for interacting with the Listing builders.
 	Listing *ListingClient
+	// ListingDocument is the client for interacting with the ListingDocument builders.
+	ListingDocument *ListingDocumentClient
+	// ListingInquiry is the client for interacting with the ListingInquiry builders.
+	ListingInquiry *ListingInquiryClient
+	// ListingRenewal is the client for interacting with the ListingRenewal builders.
+	ListingRenewal *ListingRenewalClient
+	// ListingReview is the client for interacting with the ListingReview builders.
+	ListingReview *ListingReviewClient
+	// ListingStatusEvent is the client for interacting with the ListingStatusEvent builders.
+	ListingStatusEvent *ListingStatusEventClient
+	// Neighborhood is the client for interacting with the Neighborhood builders.
+	Neighborhood *NeighborhoodClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// Offer is the client for interacting with the Offer builders.
+	Offer *OfferClient
+	// PointOfInterest is the client for interacting with the PointOfInterest builders.
+	PointOfInterest *PointOfInterestClient
+	// Property is the client for interacting with the Property builders.
+	Property *PropertyClient
 	// Realtor is the client for interacting with the Realtor builders.
 	Realtor *RealtorClient
+	// RealtorInvite is the client for interacting with the RealtorInvite builders.
+	RealtorInvite *RealtorInviteClient
+	// Team is the client for interacting with the Team builders.
+	Team *TeamClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -43,8 +90,23 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Brokerage = NewBrokerageClient(c.config)
+	c.DocumentDownload = NewDocumentDownloadClient(c.config)
+	c.ExchangeRate = NewExchangeRateClient(c.config)
 	c.Listing = NewListingClient(c.config)
+	c.ListingDocument = NewListingDocumentClient(c.config)
+	c.ListingInquiry = NewListingInquiryClient(c.config)
+	c.ListingRenewal = NewListingRenewalClient(c.config)
+	c.ListingReview = NewListingReviewClient(c.config)
+	c.ListingStatusEvent = NewListingStatusEventClient(c.config)
+	c.Neighborhood = NewNeighborhoodClient(c.config)
+	c.Notification = NewNotificationClient(c.config)
+	c.Offer = NewOfferClient(c.config)
+	c.PointOfInterest = NewPointOfInterestClient(c.config)
+	c.Property = NewPropertyClient(c.config)
 	c.Realtor = NewRealtorClient(c.config)
+	c.RealtorInvite = NewRealtorInviteClient(c.config)
+	c.Team = NewTeamClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -136,11 +198,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Listing: NewListingClient(cfg),
-		Realtor: NewRealtorClient(cfg),
-		User:    NewUserClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Brokerage:          NewBrokerageClient(cfg),
+		DocumentDownload:   NewDocumentDownloadClient(cfg),
+		ExchangeRate:       NewExchangeRateClient(cfg),
+		Listing:            NewListingClient(cfg),
+		ListingDocument:    NewListingDocumentClient(cfg),
+		ListingInquiry:     NewListingInquiryClient(cfg),
+		ListingRenewal:     NewListingRenewalClient(cfg),
+		ListingReview:      NewListingReviewClient(cfg),
+		ListingStatusEvent: NewListingStatusEventClient(cfg),
+		Neighborhood:       NewNeighborhoodClient(cfg),
+		Notification:       NewNotificationClient(cfg),
+		Offer:              NewOfferClient(cfg),
+		PointOfInterest:    NewPointOfInterestClient(cfg),
+		Property:           NewPropertyClient(cfg),
+		Realtor:            NewRealtorClient(cfg),
+		RealtorInvite:      NewRealtorInviteClient(cfg),
+		Team:               NewTeamClient(cfg),
+		User:               NewUserClient(cfg),
 	}, nil
 }
 
@@ -158,18 +235,33 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Listing: NewListingClient(cfg),
-		Realtor: NewRealtorClient(cfg),
-		User:    NewUserClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Brokerage:          NewBrokerageClient(cfg),
+		DocumentDownload:   NewDocumentDownloadClient(cfg),
+		ExchangeRate:       NewExchangeRateClient(cfg),
+		Listing:            NewListingClient(cfg),
+		ListingDocument:    NewListingDocumentClient(cfg),
+		ListingInquiry:     NewListingInquiryClient(cfg),
+		ListingRenewal:     NewListingRenewalClient(cfg),
+		ListingReview:      NewListingReviewClient(cfg),
+		ListingStatusEvent: NewListingStatusEventClient(cfg),
+		Neighborhood:       NewNeighborhoodClient(cfg),
+		Notification:       NewNotificationClient(cfg),
+		Offer:              NewOfferClient(cfg),
+		PointOfInterest:    NewPointOfInterestClient(cfg),
+		Property:           NewPropertyClient(cfg),
+		Realtor:            NewRealtorClient(cfg),
+		RealtorInvite:      NewRealtorInviteClient(cfg),
+		Team:               NewTeamClient(cfg),
+		User:               NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Listing.
+//		Brokerage.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -191,26 +283,66 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Listing.Use(hooks...)
-	c.Realtor.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Brokerage, c.DocumentDownload, c.ExchangeRate, c.Listing, c.ListingDocument,
+		c.ListingInquiry, c.ListingRenewal, c.ListingReview, c.ListingStatusEvent,
+		c.Neighborhood, c.Notification, c.Offer, c.PointOfInterest, c.Property,
+		c.Realtor, c.RealtorInvite, c.Team, c.User,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Listing.Intercept(interceptors...)
-	c.Realtor.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Brokerage, c.DocumentDownload, c.ExchangeRate, c.Listing, c.ListingDocument,
+		c.ListingInquiry, c.ListingRenewal, c.ListingReview, c.ListingStatusEvent,
+		c.Neighborhood, c.Notification, c.Offer, c.PointOfInterest, c.Property,
+		c.Realtor, c.RealtorInvite, c.Team, c.User,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BrokerageMutation:
+		return c.Brokerage.mutate(ctx, m)
+	case *DocumentDownloadMutation:
+		return c.DocumentDownload.mutate(ctx, m)
+	case *ExchangeRateMutation:
+		return c.ExchangeRate.mutate(ctx, m)
 	case *ListingMutation:
 		return c.Listing.mutate(ctx, m)
+	case *ListingDocumentMutation:
+		return c.ListingDocument.mutate(ctx, m)
+	case *ListingInquiryMutation:
+		return c.ListingInquiry.mutate(ctx, m)
+	case *ListingRenewalMutation:
+		return c.ListingRenewal.mutate(ctx, m)
+	case *ListingReviewMutation:
+		return c.ListingReview.mutate(ctx, m)
+	case *ListingStatusEventMutation:
+		return c.ListingStatusEvent.mutate(ctx, m)
+	case *NeighborhoodMutation:
+		return c.Neighborhood.mutate(ctx, m)
+	case *NotificationMutation:
+		return c.Notification.mutate(ctx, m)
+	case *OfferMutation:
+		return c.Offer.mutate(ctx, m)
+	case *PointOfInterestMutation:
+		return c.PointOfInterest.mutate(ctx, m)
+	case *PropertyMutation:
+		return c.Property.mutate(ctx, m)
 	case *RealtorMutation:
 		return c.Realtor.mutate(ctx, m)
+	case *RealtorInviteMutation:
+		return c.RealtorInvite.mutate(ctx, m)
+	case *TeamMutation:
+		return c.Team.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -218,107 +350,107 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
-// ListingClient is a client for the Listing schema.
-type ListingClient struct {
+// BrokerageClient is a client for the Brokerage schema.
+type BrokerageClient struct {
 	config
 }
 
-// NewListingClient returns a client for the Listing from the given config.
-func NewListingClient(c config) *ListingClient {
-	return &ListingClient{config: c}
+// NewBrokerageClient returns a client for the Brokerage from the given config.
+func NewBrokerageClient(c config) *BrokerageClient {
+	return &BrokerageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `listing.Hooks(f(g(h())))`.
-func (c *ListingClient) Use(hooks ...Hook) {
-	c.hooks.Listing = append(c.hooks.Listing, hooks...)
+// A call to `Use(f, g, h)` equals to `brokerage.Hooks(f(g(h())))`.
+func (c *BrokerageClient) Use(hooks ...Hook) {
+	c.hooks.Brokerage = append(c.hooks.Brokerage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `listing.Intercept(f(g(h())))`.
-func (c *ListingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Listing = append(c.inters.Listing, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `brokerage.Intercept(f(g(h())))`.
+func (c *BrokerageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Brokerage = append(c.inters.Brokerage, interceptors...)
 }
 
-// Create returns a builder for creating a Listing entity.
-func (c *ListingClient) Create() *ListingCreate {
-	mutation := newListingMutation(c.config, OpCreate)
-	return &ListingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Brokerage entity.
+func (c *BrokerageClient) Create() *BrokerageCreate {
+	mutation := newBrokerageMutation(c.config, OpCreate)
+	return &BrokerageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Listing entities.
-func (c *ListingClient) CreateBulk(builders ...*ListingCreate) *ListingCreateBulk {
-	return &ListingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Brokerage entities.
+func (c *BrokerageClient) CreateBulk(builders ...*BrokerageCreate) *BrokerageCreateBulk {
+	return &BrokerageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ListingClient) MapCreateBulk(slice any, setFunc func(*ListingCreate, int)) *ListingCreateBulk {
+func (c *BrokerageClient) MapCreateBulk(slice any, setFunc func(*BrokerageCreate, int)) *BrokerageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ListingCreateBulk{err: fmt.Errorf("calling to ListingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BrokerageCreateBulk{err: fmt.Errorf("calling to BrokerageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ListingCreate, rv.Len())
+	builders := make([]*BrokerageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ListingCreateBulk{config: c.config, builders: builders}
+	return &BrokerageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Listing.
-func (c *ListingClient) Update() *ListingUpdate {
-	mutation := newListingMutation(c.config, OpUpdate)
-	return &ListingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Brokerage.
+func (c *BrokerageClient) Update() *BrokerageUpdate {
+	mutation := newBrokerageMutation(c.config, OpUpdate)
+	return &BrokerageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ListingClient) UpdateOne(l *Listing) *ListingUpdateOne {
-	mutation := newListingMutation(c.config, OpUpdateOne, withListing(l))
-	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BrokerageClient) UpdateOne(_m *Brokerage) *BrokerageUpdateOne {
+	mutation := newBrokerageMutation(c.config, OpUpdateOne, withBrokerage(_m))
+	return &BrokerageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ListingClient) UpdateOneID(id uuid.UUID) *ListingUpdateOne {
-	mutation := newListingMutation(c.config, OpUpdateOne, withListingID(id))
-	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BrokerageClient) UpdateOneID(id uuid.UUID) *BrokerageUpdateOne {
+	mutation := newBrokerageMutation(c.config, OpUpdateOne, withBrokerageID(id))
+	return &BrokerageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Listing.
-func (c *ListingClient) Delete() *ListingDelete {
-	mutation := newListingMutation(c.config, OpDelete)
-	return &ListingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Brokerage.
+func (c *BrokerageClient) Delete() *BrokerageDelete {
+	mutation := newBrokerageMutation(c.config, OpDelete)
+	return &BrokerageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ListingClient) DeleteOne(l *Listing) *ListingDeleteOne {
-	return c.DeleteOneID(l.ID)
+func (c *BrokerageClient) DeleteOne(_m *Brokerage) *BrokerageDeleteOne {
+	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ListingClient) DeleteOneID(id uuid.UUID) *ListingDeleteOne {
-	builder := c.Delete().Where(listing.ID(id))
+func (c *BrokerageClient) DeleteOneID(id uuid.UUID) *BrokerageDeleteOne {
+	builder := c.Delete().Where(brokerage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ListingDeleteOne{builder}
+	return &BrokerageDeleteOne{builder}
 }
 
-// Query returns a query builder for Listing.
-func (c *ListingClient) Query() *ListingQuery {
-	return &ListingQuery{
+// Query returns a query builder for Brokerage.
+func (c *BrokerageClient) Query() *BrokerageQuery {
+	return &BrokerageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeListing},
+		ctx:    &QueryContext{Type: TypeBrokerage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Listing entity by its id.
-func (c *ListingClient) Get(ctx context.Context, id uuid.UUID) (*Listing, error) {
-	return c.Query().Where(listing.ID(id)).Only(ctx)
+// Get returns a Brokerage entity by its id.
+func (c *BrokerageClient) Get(ctx context.Context, id uuid.UUID) (*Brokerage, error) {
+	return c.Query().Where(brokerage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ListingClient) GetX(ctx context.Context, id uuid.UUID) *Listing {
+func (c *BrokerageClient) GetX(ctx context.Context, id uuid.UUID) *Brokerage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -326,148 +458,165 @@ func (c *ListingClient) GetX(ctx context.Context, id uuid.UUID) *Listing {
 	return obj
 }
 
-// QueryRealtor queries the realtor edge of a Listing.
-func (c *ListingClient) QueryRealtor(l *Listing) *RealtorQuery {
+// QueryTeams queries the teams edge of a Brokerage.
+func (c *BrokerageClient) QueryTeams(_m *Brokerage) *TeamQuery {
+	query := (&TeamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(brokerage.Table, brokerage.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, brokerage.TeamsTable, brokerage.TeamsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRealtors queries the realtors edge of a Brokerage.
+func (c *BrokerageClient) QueryRealtors(_m *Brokerage) *RealtorQuery {
 	query := (&RealtorClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := l.ID
+		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.From(brokerage.Table, brokerage.FieldID, id),
 			sqlgraph.To(realtor.Table, realtor.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, listing.RealtorTable, listing.RealtorColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, brokerage.RealtorsTable, brokerage.RealtorsColumn),
 		)
-		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ListingClient) Hooks() []Hook {
-	return c.hooks.Listing
+func (c *BrokerageClient) Hooks() []Hook {
+	hooks := c.hooks.Brokerage
+	return append(hooks[:len(hooks):len(hooks)], brokerage.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ListingClient) Interceptors() []Interceptor {
-	return c.inters.Listing
+func (c *BrokerageClient) Interceptors() []Interceptor {
+	return c.inters.Brokerage
 }
 
-func (c *ListingClient) mutate(ctx context.Context, m *ListingMutation) (Value, error) {
+func (c *BrokerageClient) mutate(ctx context.Context, m *BrokerageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ListingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BrokerageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ListingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BrokerageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BrokerageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ListingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BrokerageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Listing mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Brokerage mutation op: %q", m.Op())
 	}
 }
 
-// RealtorClient is a client for the Realtor schema.
-type RealtorClient struct {
+// DocumentDownloadClient is a client for the DocumentDownload schema.
+type DocumentDownloadClient struct {
 	config
 }
 
-// NewRealtorClient returns a client for the Realtor from the given config.
-func NewRealtorClient(c config) *RealtorClient {
-	return &RealtorClient{config: c}
+// NewDocumentDownloadClient returns a client for the DocumentDownload from the given config.
+func NewDocumentDownloadClient(c config) *DocumentDownloadClient {
+	return &DocumentDownloadClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `realtor.Hooks(f(g(h())))`.
-func (c *RealtorClient) Use(hooks ...Hook) {
-	c.hooks.Realtor = append(c.hooks.Realtor, hooks...)
+// A call to `Use(f, g, h)` equals to `documentdownload.Hooks(f(g(h())))`.
+func (c *DocumentDownloadClient) Use(hooks ...Hook) {
+	c.hooks.DocumentDownload = append(c.hooks.DocumentDownload, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `realtor.Intercept(f(g(h())))`.
-func (c *RealtorClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Realtor = append(c.inters.Realtor, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `documentdownload.Intercept(f(g(h())))`.
+func (c *DocumentDownloadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DocumentDownload = append(c.inters.DocumentDownload, interceptors...)
 }
 
-// Create returns a builder for creating a Realtor entity.
-func (c *RealtorClient) Create() *RealtorCreate {
-	mutation := newRealtorMutation(c.config, OpCreate)
-	return &RealtorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DocumentDownload entity.
+func (c *DocumentDownloadClient) Create() *DocumentDownloadCreate {
+	mutation := newDocumentDownloadMutation(c.config, OpCreate)
+	return &DocumentDownloadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Realtor entities.
-func (c *RealtorClient) CreateBulk(builders ...*RealtorCreate) *RealtorCreateBulk {
-	return &RealtorCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DocumentDownload entities.
+func (c *DocumentDownloadClient) CreateBulk(builders ...*DocumentDownloadCreate) *DocumentDownloadCreateBulk {
+	return &DocumentDownloadCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RealtorClient) MapCreateBulk(slice any, setFunc func(*RealtorCreate, int)) *RealtorCreateBulk {
+func (c *DocumentDownloadClient) MapCreateBulk(slice any, setFunc func(*DocumentDownloadCreate, int)) *DocumentDownloadCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RealtorCreateBulk{err: fmt.Errorf("calling to RealtorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DocumentDownloadCreateBulk{err: fmt.Errorf("calling to DocumentDownloadClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RealtorCreate, rv.Len())
+	builders := make([]*DocumentDownloadCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RealtorCreateBulk{config: c.config, builders: builders}
+	return &DocumentDownloadCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Realtor.
-func (c *RealtorClient) Update() *RealtorUpdate {
-	mutation := newRealtorMutation(c.config, OpUpdate)
-	return &RealtorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DocumentDownload.
+func (c *DocumentDownloadClient) Update() *DocumentDownloadUpdate {
+	mutation := newDocumentDownloadMutation(c.config, OpUpdate)
+	return &DocumentDownloadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RealtorClient) UpdateOne(r *Realtor) *RealtorUpdateOne {
-	mutation := newRealtorMutation(c.config, OpUpdateOne, withRealtor(r))
-	return &RealtorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DocumentDownloadClient) UpdateOne(_m *DocumentDownload) *DocumentDownloadUpdateOne {
+	mutation := newDocumentDownloadMutation(c.config, OpUpdateOne, withDocumentDownload(_m))
+	return &DocumentDownloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RealtorClient) UpdateOneID(id uuid.UUID) *RealtorUpdateOne {
-	mutation := newRealtorMutation(c.config, OpUpdateOne, withRealtorID(id))
-	return &RealtorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DocumentDownloadClient) UpdateOneID(id uuid.UUID) *DocumentDownloadUpdateOne {
+	mutation := newDocumentDownloadMutation(c.config, OpUpdateOne, withDocumentDownloadID(id))
+	return &DocumentDownloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Realtor.
-func (c *RealtorClient) Delete() *RealtorDelete {
-	mutation := newRealtorMutation(c.config, OpDelete)
-	return &RealtorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DocumentDownload.
+func (c *DocumentDownloadClient) Delete() *DocumentDownloadDelete {
+	mutation := newDocumentDownloadMutation(c.config, OpDelete)
+	return &DocumentDownloadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RealtorClient) DeleteOne(r *Realtor) *RealtorDeleteOne {
-	return c.DeleteOneID(r.ID)
+func (c *DocumentDownloadClient) DeleteOne(_m *DocumentDownload) *DocumentDownloadDeleteOne {
+	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RealtorClient) DeleteOneID(id uuid.UUID) *RealtorDeleteOne {
-	builder := c.Delete().Where(realtor.ID(id))
+func (c *DocumentDownloadClient) DeleteOneID(id uuid.UUID) *DocumentDownloadDeleteOne {
+	builder := c.Delete().Where(documentdownload.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RealtorDeleteOne{builder}
+	return &DocumentDownloadDeleteOne{builder}
 }
 
-// Query returns a query builder for Realtor.
-func (c *RealtorClient) Query() *RealtorQuery {
-	return &RealtorQuery{
+// Query returns a query builder for DocumentDownload.
+func (c *DocumentDownloadClient) Query() *DocumentDownloadQuery {
+	return &DocumentDownloadQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRealtor},
+		ctx:    &QueryContext{Type: TypeDocumentDownload},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Realtor entity by its id.
-func (c *RealtorClient) Get(ctx context.Context, id uuid.UUID) (*Realtor, error) {
-	return c.Query().Where(realtor.ID(id)).Only(ctx)
+// Get returns a DocumentDownload entity by its id.
+func (c *DocumentDownloadClient) Get(ctx context.Context, id uuid.UUID) (*DocumentDownload, error) {
+	return c.Query().Where(documentdownload.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RealtorClient) GetX(ctx context.Context, id uuid.UUID) *Realtor {
+func (c *DocumentDownloadClient) GetX(ctx context.Context, id uuid.UUID) *DocumentDownload {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -475,148 +624,148 @@ func (c *RealtorClient) GetX(ctx context.Context, id uuid.UUID) *Realtor {
 	return obj
 }
 
-// QueryListings queries the listings edge of a Realtor.
-func (c *RealtorClient) QueryListings(r *Realtor) *ListingQuery {
-	query := (&ListingClient{config: c.config}).Query()
+// QueryDocument queries the document edge of a DocumentDownload.
+func (c *DocumentDownloadClient) QueryDocument(_m *DocumentDownload) *ListingDocumentQuery {
+	query := (&ListingDocumentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
+		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(realtor.Table, realtor.FieldID, id),
-			sqlgraph.To(listing.Table, listing.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, realtor.ListingsTable, realtor.ListingsColumn),
+			sqlgraph.From(documentdownload.Table, documentdownload.FieldID, id),
+			sqlgraph.To(listingdocument.Table, listingdocument.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, documentdownload.DocumentTable, documentdownload.DocumentColumn),
 		)
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *RealtorClient) Hooks() []Hook {
-	return c.hooks.Realtor
+func (c *DocumentDownloadClient) Hooks() []Hook {
+	return c.hooks.DocumentDownload
 }
 
 // Interceptors returns the client interceptors.
-func (c *RealtorClient) Interceptors() []Interceptor {
-	return c.inters.Realtor
+func (c *DocumentDownloadClient) Interceptors() []Interceptor {
+	return c.inters.DocumentDownload
 }
 
-func (c *RealtorClient) mutate(ctx context.Context, m *RealtorMutation) (Value, error) {
+func (c *DocumentDownloadClient) mutate(ctx context.Context, m *DocumentDownloadMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RealtorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DocumentDownloadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RealtorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DocumentDownloadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RealtorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DocumentDownloadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RealtorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DocumentDownloadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Realtor mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DocumentDownload mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// ExchangeRateClient is a client for the ExchangeRate schema.
+type ExchangeRateClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewExchangeRateClient returns a client for the ExchangeRate from the given config.
+func NewExchangeRateClient(c config) *ExchangeRateClient {
+	return &ExchangeRateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `exchangerate.Hooks(f(g(h())))`.
+func (c *ExchangeRateClient) Use(hooks ...Hook) {
+	c.hooks.ExchangeRate = append(c.hooks.ExchangeRate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `exchangerate.Intercept(f(g(h())))`.
+func (c *ExchangeRateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExchangeRate = append(c.inters.ExchangeRate, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ExchangeRate entity.
+func (c *ExchangeRateClient) Create() *ExchangeRateCreate {
+	mutation := newExchangeRateMutation(c.config, OpCreate)
+	return &ExchangeRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ExchangeRate entities.
+func (c *ExchangeRateClient) CreateBulk(builders ...*ExchangeRateCreate) *ExchangeRateCreateBulk {
+	return &ExchangeRateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *ExchangeRateClient) MapCreateBulk(slice any, setFunc func(*ExchangeRateCreate, int)) *ExchangeRateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ExchangeRateCreateBulk{err: fmt.Errorf("calling to ExchangeRateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*ExchangeRateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &ExchangeRateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ExchangeRate.
+func (c *ExchangeRateClient) Update() *ExchangeRateUpdate {
+	mutation := newExchangeRateMutation(c.config, OpUpdate)
+	return &ExchangeRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExchangeRateClient) UpdateOne(_m *ExchangeRate) *ExchangeRateUpdateOne {
+	mutation := newExchangeRateMutation(c.config, OpUpdateOne, withExchangeRate(_m))
+	return &ExchangeRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id uuid.UUID) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExchangeRateClient) UpdateOneID(id uuid.UUID) *ExchangeRateUpdateOne {
+	mutation := newExchangeRateMutation(c.config, OpUpdateOne, withExchangeRateID(id))
+	return &ExchangeRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ExchangeRate.
+func (c *ExchangeRateClient) Delete() *ExchangeRateDelete {
+	mutation := newExchangeRateMutation(c.config, OpDelete)
+	return &ExchangeRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
-	return c.DeleteOneID(u.ID)
+func (c *ExchangeRateClient) DeleteOne(_m *ExchangeRate) *ExchangeRateDeleteOne {
+	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id uuid.UUID) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *ExchangeRateClient) DeleteOneID(id uuid.UUID) *ExchangeRateDeleteOne {
+	builder := c.Delete().Where(exchangerate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &ExchangeRateDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for ExchangeRate.
+func (c *ExchangeRateClient) Query() *ExchangeRateQuery {
+	return &ExchangeRateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeExchangeRate},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id uuid.UUID) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a ExchangeRate entity by its id.
+func (c *ExchangeRateClient) Get(ctx context.Context, id uuid.UUID) (*ExchangeRate, error) {
+	return c.Query().Where(exchangerate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
+func (c *ExchangeRateClient) GetX(ctx context.Context, id uuid.UUID) *ExchangeRate {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -625,36 +774,2666 @@ func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
 }
 
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *ExchangeRateClient) Hooks() []Hook {
+	hooks := c.hooks.ExchangeRate
+	return append(hooks[:len(hooks):len(hooks)], exchangerate.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *ExchangeRateClient) Interceptors() []Interceptor {
+	return c.inters.ExchangeRate
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *ExchangeRateClient) mutate(ctx context.Context, m *ExchangeRateMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExchangeRateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExchangeRateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ExchangeRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ExchangeRateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ExchangeRate mutation op: %q", m.Op())
 	}
 }
 
-// hooks and interceptors per client, for fast access.
-type (
-	hooks struct {
-		Listing, Realtor, User []ent.Hook
+// ListingClient is a client for the Listing schema.
+type ListingClient struct {
+	config
+}
+
+// NewListingClient returns a client for the Listing from the given config.
+func NewListingClient(c config) *ListingClient {
+	return &ListingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `listing.Hooks(f(g(h())))`.
+func (c *ListingClient) Use(hooks ...Hook) {
+	c.hooks.Listing = append(c.hooks.Listing, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `listing.Intercept(f(g(h())))`.
+func (c *ListingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Listing = append(c.inters.Listing, interceptors...)
+}
+
+// Create returns a builder for creating a Listing entity.
+func (c *ListingClient) Create() *ListingCreate {
+	mutation := newListingMutation(c.config, OpCreate)
+	return &ListingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Listing entities.
+func (c *ListingClient) CreateBulk(builders ...*ListingCreate) *ListingCreateBulk {
+	return &ListingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ListingClient) MapCreateBulk(slice any, setFunc func(*ListingCreate, int)) *ListingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ListingCreateBulk{err: fmt.Errorf("calling to ListingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	inters struct {
-		Listing, Realtor, User []ent.Interceptor
+	builders := make([]*ListingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
 	}
-)
+	return &ListingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Listing.
+func (c *ListingClient) Update() *ListingUpdate {
+	mutation := newListingMutation(c.config, OpUpdate)
+	return &ListingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ListingClient) UpdateOne(_m *Listing) *ListingUpdateOne {
+	mutation := newListingMutation(c.config, OpUpdateOne, withListing(_m))
+	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ListingClient) UpdateOneID(id uuid.UUID) *ListingUpdateOne {
+	mutation := newListingMutation(c.config, OpUpdateOne, withListingID(id))
+	return &ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Listing.
+func (c *ListingClient) Delete() *ListingDelete {
+	mutation := newListingMutation(c.config, OpDelete)
+	return &ListingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ListingClient) DeleteOne(_m *Listing) *ListingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ListingClient) DeleteOneID(id uuid.UUID) *ListingDeleteOne {
+	builder := c.Delete().Where(listing.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ListingDeleteOne{builder}
+}
+
+// Query returns a query builder for Listing.
+func (c *ListingClient) Query() *ListingQuery {
+	return &ListingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeListing},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Listing entity by its id.
+func (c *ListingClient) Get(ctx context.Context, id uuid.UUID) (*Listing, error) {
+	return c.Query().Where(listing.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ListingClient) GetX(ctx context.Context, id uuid.UUID) *Listing {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRealtor queries the realtor edge of a Listing.
+func (c *ListingClient) QueryRealtor(_m *Listing) *RealtorQuery {
+	query := (&RealtorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(realtor.Table, realtor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listing.RealtorTable, listing.RealtorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProperty queries the property edge of a Listing.
+func (c *ListingClient) QueryProperty(_m *Listing) *PropertyQuery {
+	query := (&PropertyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(property.Table, property.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listing.PropertyTable, listing.PropertyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNeighborhood queries the neighborhood edge of a Listing.
+func (c *ListingClient) QueryNeighborhood(_m *Listing) *NeighborhoodQuery {
+	query := (&NeighborhoodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(neighborhood.Table, neighborhood.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listing.NeighborhoodTable, listing.NeighborhoodColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBuyerRealtor queries the buyer_realtor edge of a Listing.
+func (c *ListingClient) QueryBuyerRealtor(_m *Listing) *RealtorQuery {
+	query := (&RealtorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(realtor.Table, realtor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listing.BuyerRealtorTable, listing.BuyerRealtorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRenewals queries the renewals edge of a Listing.
+func (c *ListingClient) QueryRenewals(_m *Listing) *ListingRenewalQuery {
+	query := (&ListingRenewalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(listingrenewal.Table, listingrenewal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.RenewalsTable, listing.RenewalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDocuments queries the documents edge of a Listing.
+func (c *ListingClient) QueryDocuments(_m *Listing) *ListingDocumentQuery {
+	query := (&ListingDocumentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(listingdocument.Table, listingdocument.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.DocumentsTable, listing.DocumentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInquiries queries the inquiries edge of a Listing.
+func (c *ListingClient) QueryInquiries(_m *Listing) *ListingInquiryQuery {
+	query := (&ListingInquiryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(listinginquiry.Table, listinginquiry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.InquiriesTable, listing.InquiriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviews queries the reviews edge of a Listing.
+func (c *ListingClient) QueryReviews(_m *Listing) *ListingReviewQuery {
+	query := (&ListingReviewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(listingreview.Table, listingreview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.ReviewsTable, listing.ReviewsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOffers queries the offers edge of a Listing.
+func (c *ListingClient) QueryOffers(_m *Listing) *OfferQuery {
+	query := (&OfferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(offer.Table, offer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.OffersTable, listing.OffersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStatusEvents queries the status_events edge of a Listing.
+func (c *ListingClient) QueryStatusEvents(_m *Listing) *ListingStatusEventQuery {
+	query := (&ListingStatusEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, id),
+			sqlgraph.To(listingstatusevent.Table, listingstatusevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.StatusEventsTable, listing.StatusEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ListingClient) Hooks() []Hook {
+	hooks := c.hooks.Listing
+	return append(hooks[:len(hooks):len(hooks)], listing.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ListingClient) Interceptors() []Interceptor {
+	return c.inters.Listing
+}
+
+func (c *ListingClient) mutate(ctx context.Context, m *ListingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ListingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ListingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ListingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ListingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Listing mutation op: %q", m.Op())
+	}
+}
+
+// ListingDocumentClient is a client for the ListingDocument schema.
+type ListingDocumentClient struct {
+	config
+}
+
+// NewListingDocumentClient returns a client for the ListingDocument from the given config.
+func NewListingDocumentClient(c config) *ListingDocumentClient {
+	return &ListingDocumentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `listingdocument.Hooks(f(g(h())))`.
+func (c *ListingDocumentClient) Use(hooks ...Hook) {
+	c.hooks.ListingDocument = append(c.hooks.ListingDocument, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `listingdocument.Intercept(f(g(h())))`.
+func (c *ListingDocumentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ListingDocument = append(c.inters.ListingDocument, interceptors...)
+}
+
+// Create returns a builder for creating a ListingDocument entity.
+func (c *ListingDocumentClient) Create() *ListingDocumentCreate {
+	mutation := newListingDocumentMutation(c.config, OpCreate)
+	return &ListingDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ListingDocument entities.
+func (c *ListingDocumentClient) CreateBulk(builders ...*ListingDocumentCreate) *ListingDocumentCreateBulk {
+	return &ListingDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ListingDocumentClient) MapCreateBulk(slice any, setFunc func(*ListingDocumentCreate, int)) *ListingDocumentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ListingDocumentCreateBulk{err: fmt.Errorf("calling to ListingDocumentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ListingDocumentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ListingDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ListingDocument.
+func (c *ListingDocumentClient) Update() *ListingDocumentUpdate {
+	mutation := newListingDocumentMutation(c.config, OpUpdate)
+	return &ListingDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ListingDocumentClient) UpdateOne(_m *ListingDocument) *ListingDocumentUpdateOne {
+	mutation := newListingDocumentMutation(c.config, OpUpdateOne, withListingDocument(_m))
+	return &ListingDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ListingDocumentClient) UpdateOneID(id uuid.UUID) *ListingDocumentUpdateOne {
+	mutation := newListingDocumentMutation(c.config, OpUpdateOne, withListingDocumentID(id))
+	return &ListingDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ListingDocument.
+func (c *ListingDocumentClient) Delete() *ListingDocumentDelete {
+	mutation := newListingDocumentMutation(c.config, OpDelete)
+	return &ListingDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ListingDocumentClient) DeleteOne(_m *ListingDocument) *ListingDocumentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ListingDocumentClient) DeleteOneID(id uuid.UUID) *ListingDocumentDeleteOne {
+	builder := c.Delete().Where(listingdocument.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ListingDocumentDeleteOne{builder}
+}
+
+// Query returns a query builder for ListingDocument.
+func (c *ListingDocumentClient) Query() *ListingDocumentQuery {
+	return &ListingDocumentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeListingDocument},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ListingDocument entity by its id.
+func (c *ListingDocumentClient) Get(ctx context.Context, id uuid.UUID) (*ListingDocument, error) {
+	return c.Query().Where(listingdocument.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ListingDocumentClient) GetX(ctx context.Context, id uuid.UUID) *ListingDocument {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryListing queries the listing edge of a ListingDocument.
+func (c *ListingDocumentClient) QueryListing(_m *ListingDocument) *ListingQuery {
+	query := (&ListingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listingdocument.Table, listingdocument.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listingdocument.ListingTable, listingdocument.ListingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDownloads queries the downloads edge of a ListingDocument.
+func (c *ListingDocumentClient) QueryDownloads(_m *ListingDocument) *DocumentDownloadQuery {
+	query := (&DocumentDownloadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listingdocument.Table, listingdocument.FieldID, id),
+			sqlgraph.To(documentdownload.Table, documentdownload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listingdocument.DownloadsTable, listingdocument.DownloadsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ListingDocumentClient) Hooks() []Hook {
+	hooks := c.hooks.ListingDocument
+	return append(hooks[:len(hooks):len(hooks)], listingdocument.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ListingDocumentClient) Interceptors() []Interceptor {
+	return c.inters.ListingDocument
+}
+
+func (c *ListingDocumentClient) mutate(ctx context.Context, m *ListingDocumentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ListingDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ListingDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ListingDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ListingDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ListingDocument mutation op: %q", m.Op())
+	}
+}
+
+// ListingInquiryClient is a client for the ListingInquiry schema.
+type ListingInquiryClient struct {
+	config
+}
+
+// NewListingInquiryClient returns a client for the ListingInquiry from the given config.
+func NewListingInquiryClient(c config) *ListingInquiryClient {
+	return &ListingInquiryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `listinginquiry.Hooks(f(g(h())))`.
+func (c *ListingInquiryClient) Use(hooks ...Hook) {
+	c.hooks.ListingInquiry = append(c.hooks.ListingInquiry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `listinginquiry.Intercept(f(g(h())))`.
+func (c *ListingInquiryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ListingInquiry = append(c.inters.ListingInquiry, interceptors...)
+}
+
+// Create returns a builder for creating a ListingInquiry entity.
+func (c *ListingInquiryClient) Create() *ListingInquiryCreate {
+	mutation := newListingInquiryMutation(c.config, OpCreate)
+	return &ListingInquiryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ListingInquiry entities.
+func (c *ListingInquiryClient) CreateBulk(builders ...*ListingInquiryCreate) *ListingInquiryCreateBulk {
+	return &ListingInquiryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ListingInquiryClient) MapCreateBulk(slice any, setFunc func(*ListingInquiryCreate, int)) *ListingInquiryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ListingInquiryCreateBulk{err: fmt.Errorf("calling to ListingInquiryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ListingInquiryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ListingInquiryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ListingInquiry.
+func (c *ListingInquiryClient) Update() *ListingInquiryUpdate {
+	mutation := newListingInquiryMutation(c.config, OpUpdate)
+	return &ListingInquiryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ListingInquiryClient) UpdateOne(_m *ListingInquiry) *ListingInquiryUpdateOne {
+	mutation := newListingInquiryMutation(c.config, OpUpdateOne, withListingInquiry(_m))
+	return &ListingInquiryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ListingInquiryClient) UpdateOneID(id uuid.UUID) *ListingInquiryUpdateOne {
+	mutation := newListingInquiryMutation(c.config, OpUpdateOne, withListingInquiryID(id))
+	return &ListingInquiryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ListingInquiry.
+func (c *ListingInquiryClient) Delete() *ListingInquiryDelete {
+	mutation := newListingInquiryMutation(c.config, OpDelete)
+	return &ListingInquiryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ListingInquiryClient) DeleteOne(_m *ListingInquiry) *ListingInquiryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ListingInquiryClient) DeleteOneID(id uuid.UUID) *ListingInquiryDeleteOne {
+	builder := c.Delete().Where(listinginquiry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ListingInquiryDeleteOne{builder}
+}
+
+// Query returns a query builder for ListingInquiry.
+func (c *ListingInquiryClient) Query() *ListingInquiryQuery {
+	return &ListingInquiryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeListingInquiry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ListingInquiry entity by its id.
+func (c *ListingInquiryClient) Get(ctx context.Context, id uuid.UUID) (*ListingInquiry, error) {
+	return c.Query().Where(listinginquiry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ListingInquiryClient) GetX(ctx context.Context, id uuid.UUID) *ListingInquiry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryListing queries the listing edge of a ListingInquiry.
+func (c *ListingInquiryClient) QueryListing(_m *ListingInquiry) *ListingQuery {
+	query := (&ListingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listinginquiry.Table, listinginquiry.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listinginquiry.ListingTable, listinginquiry.ListingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ListingInquiry.
+func (c *ListingInquiryClient) QueryUser(_m *ListingInquiry) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listinginquiry.Table, listinginquiry.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listinginquiry.UserTable, listinginquiry.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ListingInquiryClient) Hooks() []Hook {
+	hooks := c.hooks.ListingInquiry
+	return append(hooks[:len(hooks):len(hooks)], listinginquiry.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ListingInquiryClient) Interceptors() []Interceptor {
+	return c.inters.ListingInquiry
+}
+
+func (c *ListingInquiryClient) mutate(ctx context.Context, m *ListingInquiryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ListingInquiryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ListingInquiryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ListingInquiryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ListingInquiryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ListingInquiry mutation op: %q", m.Op())
+	}
+}
+
+// ListingRenewalClient is a client for the ListingRenewal schema.
+type ListingRenewalClient struct {
+	config
+}
+
+// NewListingRenewalClient returns a client for the ListingRenewal from the given config.
+func NewListingRenewalClient(c config) *ListingRenewalClient {
+	return &ListingRenewalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `listingrenewal.Hooks(f(g(h())))`.
+func (c *ListingRenewalClient) Use(hooks ...Hook) {
+	c.hooks.ListingRenewal = append(c.hooks.ListingRenewal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `listingrenewal.Intercept(f(g(h())))`.
+func (c *ListingRenewalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ListingRenewal = append(c.inters.ListingRenewal, interceptors...)
+}
+
+// Create returns a builder for creating a ListingRenewal entity.
+func (c *ListingRenewalClient) Create() *ListingRenewalCreate {
+	mutation := newListingRenewalMutation(c.config, OpCreate)
+	return &ListingRenewalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ListingRenewal entities.
+func (c *ListingRenewalClient) CreateBulk(builders ...*ListingRenewalCreate) *ListingRenewalCreateBulk {
+	return &ListingRenewalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ListingRenewalClient) MapCreateBulk(slice any, setFunc func(*ListingRenewalCreate, int)) *ListingRenewalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ListingRenewalCreateBulk{err: fmt.Errorf("calling to ListingRenewalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ListingRenewalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ListingRenewalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ListingRenewal.
+func (c *ListingRenewalClient) Update() *ListingRenewalUpdate {
+	mutation := newListingRenewalMutation(c.config, OpUpdate)
+	return &ListingRenewalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ListingRenewalClient) UpdateOne(_m *ListingRenewal) *ListingRenewalUpdateOne {
+	mutation := newListingRenewalMutation(c.config, OpUpdateOne, withListingRenewal(_m))
+	return &ListingRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ListingRenewalClient) UpdateOneID(id uuid.UUID) *ListingRenewalUpdateOne {
+	mutation := newListingRenewalMutation(c.config, OpUpdateOne, withListingRenewalID(id))
+	return &ListingRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ListingRenewal.
+func (c *ListingRenewalClient) Delete() *ListingRenewalDelete {
+	mutation := newListingRenewalMutation(c.config, OpDelete)
+	return &ListingRenewalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ListingRenewalClient) DeleteOne(_m *ListingRenewal) *ListingRenewalDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ListingRenewalClient) DeleteOneID(id uuid.UUID) *ListingRenewalDeleteOne {
+	builder := c.Delete().Where(listingrenewal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ListingRenewalDeleteOne{builder}
+}
+
+// Query returns a query builder for ListingRenewal.
+func (c *ListingRenewalClient) Query() *ListingRenewalQuery {
+	return &ListingRenewalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeListingRenewal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ListingRenewal entity by its id.
+func (c *ListingRenewalClient) Get(ctx context.Context, id uuid.UUID) (*ListingRenewal, error) {
+	return c.Query().Where(listingrenewal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ListingRenewalClient) GetX(ctx context.Context, id uuid.UUID) *ListingRenewal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryListing queries the listing edge of a ListingRenewal.
+func (c *ListingRenewalClient) QueryListing(_m *ListingRenewal) *ListingQuery {
+	query := (&ListingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listingrenewal.Table, listingrenewal.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listingrenewal.ListingTable, listingrenewal.ListingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ListingRenewalClient) Hooks() []Hook {
+	return c.hooks.ListingRenewal
+}
+
+// Interceptors returns the client interceptors.
+func (c *ListingRenewalClient) Interceptors() []Interceptor {
+	return c.inters.ListingRenewal
+}
+
+func (c *ListingRenewalClient) mutate(ctx context.Context, m *ListingRenewalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ListingRenewalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ListingRenewalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ListingRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ListingRenewalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ListingRenewal mutation op: %q", m.Op())
+	}
+}
+
+// ListingReviewClient is a client for the ListingReview schema.
+type ListingReviewClient struct {
+	config
+}
+
+// NewListingReviewClient returns a client for the ListingReview from the given config.
+func NewListingReviewClient(c config) *ListingReviewClient {
+	return &ListingReviewClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `listingreview.Hooks(f(g(h())))`.
+func (c *ListingReviewClient) Use(hooks ...Hook) {
+	c.hooks.ListingReview = append(c.hooks.ListingReview, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `listingreview.Intercept(f(g(h())))`.
+func (c *ListingReviewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ListingReview = append(c.inters.ListingReview, interceptors...)
+}
+
+// Create returns a builder for creating a ListingReview entity.
+func (c *ListingReviewClient) Create() *ListingReviewCreate {
+	mutation := newListingReviewMutation(c.config, OpCreate)
+	return &ListingReviewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ListingReview entities.
+func (c *ListingReviewClient) CreateBulk(builders ...*ListingReviewCreate) *ListingReviewCreateBulk {
+	return &ListingReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ListingReviewClient) MapCreateBulk(slice any, setFunc func(*ListingReviewCreate, int)) *ListingReviewCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ListingReviewCreateBulk{err: fmt.Errorf("calling to ListingReviewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ListingReviewCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ListingReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ListingReview.
+func (c *ListingReviewClient) Update() *ListingReviewUpdate {
+	mutation := newListingReviewMutation(c.config, OpUpdate)
+	return &ListingReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ListingReviewClient) UpdateOne(_m *ListingReview) *ListingReviewUpdateOne {
+	mutation := newListingReviewMutation(c.config, OpUpdateOne, withListingReview(_m))
+	return &ListingReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ListingReviewClient) UpdateOneID(id uuid.UUID) *ListingReviewUpdateOne {
+	mutation := newListingReviewMutation(c.config, OpUpdateOne, withListingReviewID(id))
+	return &ListingReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ListingReview.
+func (c *ListingReviewClient) Delete() *ListingReviewDelete {
+	mutation := newListingReviewMutation(c.config, OpDelete)
+	return &ListingReviewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ListingReviewClient) DeleteOne(_m *ListingReview) *ListingReviewDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ListingReviewClient) DeleteOneID(id uuid.UUID) *ListingReviewDeleteOne {
+	builder := c.Delete().Where(listingreview.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ListingReviewDeleteOne{builder}
+}
+
+// Query returns a query builder for ListingReview.
+func (c *ListingReviewClient) Query() *ListingReviewQuery {
+	return &ListingReviewQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeListingReview},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ListingReview entity by its id.
+func (c *ListingReviewClient) Get(ctx context.Context, id uuid.UUID) (*ListingReview, error) {
+	return c.Query().Where(listingreview.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ListingReviewClient) GetX(ctx context.Context, id uuid.UUID) *ListingReview {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryListing queries the listing edge of a ListingReview.
+func (c *ListingReviewClient) QueryListing(_m *ListingReview) *ListingQuery {
+	query := (&ListingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listingreview.Table, listingreview.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listingreview.ListingTable, listingreview.ListingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ListingReviewClient) Hooks() []Hook {
+	hooks := c.hooks.ListingReview
+	return append(hooks[:len(hooks):len(hooks)], listingreview.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ListingReviewClient) Interceptors() []Interceptor {
+	return c.inters.ListingReview
+}
+
+func (c *ListingReviewClient) mutate(ctx context.Context, m *ListingReviewMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ListingReviewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ListingReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ListingReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ListingReviewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ListingReview mutation op: %q", m.Op())
+	}
+}
+
+// ListingStatusEventClient is a client for the ListingStatusEvent schema.
+type ListingStatusEventClient struct {
+	config
+}
+
+// NewListingStatusEventClient returns a client for the ListingStatusEvent from the given config.
+func NewListingStatusEventClient(c config) *ListingStatusEventClient {
+	return &ListingStatusEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `listingstatusevent.Hooks(f(g(h())))`.
+func (c *ListingStatusEventClient) Use(hooks ...Hook) {
+	c.hooks.ListingStatusEvent = append(c.hooks.ListingStatusEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `listingstatusevent.Intercept(f(g(h())))`.
+func (c *ListingStatusEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ListingStatusEvent = append(c.inters.ListingStatusEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ListingStatusEvent entity.
+func (c *ListingStatusEventClient) Create() *ListingStatusEventCreate {
+	mutation := newListingStatusEventMutation(c.config, OpCreate)
+	return &ListingStatusEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ListingStatusEvent entities.
+func (c *ListingStatusEventClient) CreateBulk(builders ...*ListingStatusEventCreate) *ListingStatusEventCreateBulk {
+	return &ListingStatusEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ListingStatusEventClient) MapCreateBulk(slice any, setFunc func(*ListingStatusEventCreate, int)) *ListingStatusEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ListingStatusEventCreateBulk{err: fmt.Errorf("calling to ListingStatusEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ListingStatusEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ListingStatusEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ListingStatusEvent.
+func (c *ListingStatusEventClient) Update() *ListingStatusEventUpdate {
+	mutation := newListingStatusEventMutation(c.config, OpUpdate)
+	return &ListingStatusEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ListingStatusEventClient) UpdateOne(_m *ListingStatusEvent) *ListingStatusEventUpdateOne {
+	mutation := newListingStatusEventMutation(c.config, OpUpdateOne, withListingStatusEvent(_m))
+	return &ListingStatusEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ListingStatusEventClient) UpdateOneID(id uuid.UUID) *ListingStatusEventUpdateOne {
+	mutation := newListingStatusEventMutation(c.config, OpUpdateOne, withListingStatusEventID(id))
+	return &ListingStatusEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ListingStatusEvent.
+func (c *ListingStatusEventClient) Delete() *ListingStatusEventDelete {
+	mutation := newListingStatusEventMutation(c.config, OpDelete)
+	return &ListingStatusEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ListingStatusEventClient) DeleteOne(_m *ListingStatusEvent) *ListingStatusEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ListingStatusEventClient) DeleteOneID(id uuid.UUID) *ListingStatusEventDeleteOne {
+	builder := c.Delete().Where(listingstatusevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ListingStatusEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ListingStatusEvent.
+func (c *ListingStatusEventClient) Query() *ListingStatusEventQuery {
+	return &ListingStatusEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeListingStatusEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ListingStatusEvent entity by its id.
+func (c *ListingStatusEventClient) Get(ctx context.Context, id uuid.UUID) (*ListingStatusEvent, error) {
+	return c.Query().Where(listingstatusevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ListingStatusEventClient) GetX(ctx context.Context, id uuid.UUID) *ListingStatusEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryListing queries the listing edge of a ListingStatusEvent.
+func (c *ListingStatusEventClient) QueryListing(_m *ListingStatusEvent) *ListingQuery {
+	query := (&ListingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listingstatusevent.Table, listingstatusevent.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listingstatusevent.ListingTable, listingstatusevent.ListingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ListingStatusEventClient) Hooks() []Hook {
+	return c.hooks.ListingStatusEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ListingStatusEventClient) Interceptors() []Interceptor {
+	return c.inters.ListingStatusEvent
+}
+
+func (c *ListingStatusEventClient) mutate(ctx context.Context, m *ListingStatusEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ListingStatusEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ListingStatusEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ListingStatusEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ListingStatusEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ListingStatusEvent mutation op: %q", m.Op())
+	}
+}
+
+// NeighborhoodClient is a client for the Neighborhood schema.
+type NeighborhoodClient struct {
+	config
+}
+
+// NewNeighborhoodClient returns a client for the Neighborhood from the given config.
+func NewNeighborhoodClient(c config) *NeighborhoodClient {
+	return &NeighborhoodClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `neighborhood.Hooks(f(g(h())))`.
+func (c *NeighborhoodClient) Use(hooks ...Hook) {
+	c.hooks.Neighborhood = append(c.hooks.Neighborhood, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `neighborhood.Intercept(f(g(h())))`.
+func (c *NeighborhoodClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Neighborhood = append(c.inters.Neighborhood, interceptors...)
+}
+
+// Create returns a builder for creating a Neighborhood entity.
+func (c *NeighborhoodClient) Create() *NeighborhoodCreate {
+	mutation := newNeighborhoodMutation(c.config, OpCreate)
+	return &NeighborhoodCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Neighborhood entities.
+func (c *NeighborhoodClient) CreateBulk(builders ...*NeighborhoodCreate) *NeighborhoodCreateBulk {
+	return &NeighborhoodCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NeighborhoodClient) MapCreateBulk(slice any, setFunc func(*NeighborhoodCreate, int)) *NeighborhoodCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NeighborhoodCreateBulk{err: fmt.Errorf("calling to NeighborhoodClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NeighborhoodCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NeighborhoodCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Neighborhood.
+func (c *NeighborhoodClient) Update() *NeighborhoodUpdate {
+	mutation := newNeighborhoodMutation(c.config, OpUpdate)
+	return &NeighborhoodUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NeighborhoodClient) UpdateOne(_m *Neighborhood) *NeighborhoodUpdateOne {
+	mutation := newNeighborhoodMutation(c.config, OpUpdateOne, withNeighborhood(_m))
+	return &NeighborhoodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NeighborhoodClient) UpdateOneID(id uuid.UUID) *NeighborhoodUpdateOne {
+	mutation := newNeighborhoodMutation(c.config, OpUpdateOne, withNeighborhoodID(id))
+	return &NeighborhoodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Neighborhood.
+func (c *NeighborhoodClient) Delete() *NeighborhoodDelete {
+	mutation := newNeighborhoodMutation(c.config, OpDelete)
+	return &NeighborhoodDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NeighborhoodClient) DeleteOne(_m *Neighborhood) *NeighborhoodDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NeighborhoodClient) DeleteOneID(id uuid.UUID) *NeighborhoodDeleteOne {
+	builder := c.Delete().Where(neighborhood.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NeighborhoodDeleteOne{builder}
+}
+
+// Query returns a query builder for Neighborhood.
+func (c *NeighborhoodClient) Query() *NeighborhoodQuery {
+	return &NeighborhoodQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNeighborhood},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Neighborhood entity by its id.
+func (c *NeighborhoodClient) Get(ctx context.Context, id uuid.UUID) (*Neighborhood, error) {
+	return c.Query().Where(neighborhood.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NeighborhoodClient) GetX(ctx context.Context, id uuid.UUID) *Neighborhood {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryListings queries the listings edge of a Neighborhood.
+func (c *NeighborhoodClient) QueryListings(_m *Neighborhood) *ListingQuery {
+	query := (&ListingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(neighborhood.Table, neighborhood.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, neighborhood.ListingsTable, neighborhood.ListingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NeighborhoodClient) Hooks() []Hook {
+	hooks := c.hooks.Neighborhood
+	return append(hooks[:len(hooks):len(hooks)], neighborhood.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *NeighborhoodClient) Interceptors() []Interceptor {
+	return c.inters.Neighborhood
+}
+
+func (c *NeighborhoodClient) mutate(ctx context.Context, m *NeighborhoodMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NeighborhoodCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NeighborhoodUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NeighborhoodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NeighborhoodDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Neighborhood mutation op: %q", m.Op())
+	}
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(_m *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(_m))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id uuid.UUID) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(_m *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id uuid.UUID) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id uuid.UUID) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id uuid.UUID) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	hooks := c.hooks.Notification
+	return append(hooks[:len(hooks):len(hooks)], notification.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
+// OfferClient is a client for the Offer schema.
+type OfferClient struct {
+	config
+}
+
+// NewOfferClient returns a client for the Offer from the given config.
+func NewOfferClient(c config) *OfferClient {
+	return &OfferClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `offer.Hooks(f(g(h())))`.
+func (c *OfferClient) Use(hooks ...Hook) {
+	c.hooks.Offer = append(c.hooks.Offer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `offer.Intercept(f(g(h())))`.
+func (c *OfferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Offer = append(c.inters.Offer, interceptors...)
+}
+
+// Create returns a builder for creating a Offer entity.
+func (c *OfferClient) Create() *OfferCreate {
+	mutation := newOfferMutation(c.config, OpCreate)
+	return &OfferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Offer entities.
+func (c *OfferClient) CreateBulk(builders ...*OfferCreate) *OfferCreateBulk {
+	return &OfferCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OfferClient) MapCreateBulk(slice any, setFunc func(*OfferCreate, int)) *OfferCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OfferCreateBulk{err: fmt.Errorf("calling to OfferClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OfferCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OfferCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Offer.
+func (c *OfferClient) Update() *OfferUpdate {
+	mutation := newOfferMutation(c.config, OpUpdate)
+	return &OfferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OfferClient) UpdateOne(_m *Offer) *OfferUpdateOne {
+	mutation := newOfferMutation(c.config, OpUpdateOne, withOffer(_m))
+	return &OfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OfferClient) UpdateOneID(id uuid.UUID) *OfferUpdateOne {
+	mutation := newOfferMutation(c.config, OpUpdateOne, withOfferID(id))
+	return &OfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Offer.
+func (c *OfferClient) Delete() *OfferDelete {
+	mutation := newOfferMutation(c.config, OpDelete)
+	return &OfferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OfferClient) DeleteOne(_m *Offer) *OfferDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OfferClient) DeleteOneID(id uuid.UUID) *OfferDeleteOne {
+	builder := c.Delete().Where(offer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OfferDeleteOne{builder}
+}
+
+// Query returns a query builder for Offer.
+func (c *OfferClient) Query() *OfferQuery {
+	return &OfferQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOffer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Offer entity by its id.
+func (c *OfferClient) Get(ctx context.Context, id uuid.UUID) (*Offer, error) {
+	return c.Query().Where(offer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OfferClient) GetX(ctx context.Context, id uuid.UUID) *Offer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryListing queries the listing edge of a Offer.
+func (c *OfferClient) QueryListing(_m *Offer) *ListingQuery {
+	query := (&ListingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(offer.Table, offer.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, offer.ListingTable, offer.ListingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBuyer queries the buyer edge of a Offer.
+func (c *OfferClient) QueryBuyer(_m *Offer) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(offer.Table, offer.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, offer.BuyerTable, offer.BuyerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrevious queries the previous edge of a Offer.
+func (c *OfferClient) QueryPrevious(_m *Offer) *OfferQuery {
+	query := (&OfferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(offer.Table, offer.FieldID, id),
+			sqlgraph.To(offer.Table, offer.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, offer.PreviousTable, offer.PreviousColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCounters queries the counters edge of a Offer.
+func (c *OfferClient) QueryCounters(_m *Offer) *OfferQuery {
+	query := (&OfferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(offer.Table, offer.FieldID, id),
+			sqlgraph.To(offer.Table, offer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, offer.CountersTable, offer.CountersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OfferClient) Hooks() []Hook {
+	hooks := c.hooks.Offer
+	return append(hooks[:len(hooks):len(hooks)], offer.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OfferClient) Interceptors() []Interceptor {
+	return c.inters.Offer
+}
+
+func (c *OfferClient) mutate(ctx context.Context, m *OfferMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OfferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OfferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OfferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OfferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Offer mutation op: %q", m.Op())
+	}
+}
+
+// PointOfInterestClient is a client for the PointOfInterest schema.
+type PointOfInterestClient struct {
+	config
+}
+
+// NewPointOfInterestClient returns a client for the PointOfInterest from the given config.
+func NewPointOfInterestClient(c config) *PointOfInterestClient {
+	return &PointOfInterestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pointofinterest.Hooks(f(g(h())))`.
+func (c *PointOfInterestClient) Use(hooks ...Hook) {
+	c.hooks.PointOfInterest = append(c.hooks.PointOfInterest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pointofinterest.Intercept(f(g(h())))`.
+func (c *PointOfInterestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PointOfInterest = append(c.inters.PointOfInterest, interceptors...)
+}
+
+// Create returns a builder for creating a PointOfInterest entity.
+func (c *PointOfInterestClient) Create() *PointOfInterestCreate {
+	mutation := newPointOfInterestMutation(c.config, OpCreate)
+	return &PointOfInterestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PointOfInterest entities.
+func (c *PointOfInterestClient) CreateBulk(builders ...*PointOfInterestCreate) *PointOfInterestCreateBulk {
+	return &PointOfInterestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PointOfInterestClient) MapCreateBulk(slice any, setFunc func(*PointOfInterestCreate, int)) *PointOfInterestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PointOfInterestCreateBulk{err: fmt.Errorf("calling to PointOfInterestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PointOfInterestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PointOfInterestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PointOfInterest.
+func (c *PointOfInterestClient) Update() *PointOfInterestUpdate {
+	mutation := newPointOfInterestMutation(c.config, OpUpdate)
+	return &PointOfInterestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PointOfInterestClient) UpdateOne(_m *PointOfInterest) *PointOfInterestUpdateOne {
+	mutation := newPointOfInterestMutation(c.config, OpUpdateOne, withPointOfInterest(_m))
+	return &PointOfInterestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PointOfInterestClient) UpdateOneID(id uuid.UUID) *PointOfInterestUpdateOne {
+	mutation := newPointOfInterestMutation(c.config, OpUpdateOne, withPointOfInterestID(id))
+	return &PointOfInterestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PointOfInterest.
+func (c *PointOfInterestClient) Delete() *PointOfInterestDelete {
+	mutation := newPointOfInterestMutation(c.config, OpDelete)
+	return &PointOfInterestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PointOfInterestClient) DeleteOne(_m *PointOfInterest) *PointOfInterestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PointOfInterestClient) DeleteOneID(id uuid.UUID) *PointOfInterestDeleteOne {
+	builder := c.Delete().Where(pointofinterest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PointOfInterestDeleteOne{builder}
+}
+
+// Query returns a query builder for PointOfInterest.
+func (c *PointOfInterestClient) Query() *PointOfInterestQuery {
+	return &PointOfInterestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePointOfInterest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PointOfInterest entity by its id.
+func (c *PointOfInterestClient) Get(ctx context.Context, id uuid.UUID) (*PointOfInterest, error) {
+	return c.Query().Where(pointofinterest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PointOfInterestClient) GetX(ctx context.Context, id uuid.UUID) *PointOfInterest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PointOfInterestClient) Hooks() []Hook {
+	hooks := c.hooks.PointOfInterest
+	return append(hooks[:len(hooks):len(hooks)], pointofinterest.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PointOfInterestClient) Interceptors() []Interceptor {
+	return c.inters.PointOfInterest
+}
+
+func (c *PointOfInterestClient) mutate(ctx context.Context, m *PointOfInterestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PointOfInterestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PointOfInterestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PointOfInterestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PointOfInterestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PointOfInterest mutation op: %q", m.Op())
+	}
+}
+
+// PropertyClient is a client for the Property schema.
+type PropertyClient struct {
+	config
+}
+
+// NewPropertyClient returns a client for the Property from the given config.
+func NewPropertyClient(c config) *PropertyClient {
+	return &PropertyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `property.Hooks(f(g(h())))`.
+func (c *PropertyClient) Use(hooks ...Hook) {
+	c.hooks.Property = append(c.hooks.Property, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `property.Intercept(f(g(h())))`.
+func (c *PropertyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Property = append(c.inters.Property, interceptors...)
+}
+
+// Create returns a builder for creating a Property entity.
+func (c *PropertyClient) Create() *PropertyCreate {
+	mutation := newPropertyMutation(c.config, OpCreate)
+	return &PropertyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Property entities.
+func (c *PropertyClient) CreateBulk(builders ...*PropertyCreate) *PropertyCreateBulk {
+	return &PropertyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PropertyClient) MapCreateBulk(slice any, setFunc func(*PropertyCreate, int)) *PropertyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PropertyCreateBulk{err: fmt.Errorf("calling to PropertyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PropertyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PropertyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Property.
+func (c *PropertyClient) Update() *PropertyUpdate {
+	mutation := newPropertyMutation(c.config, OpUpdate)
+	return &PropertyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PropertyClient) UpdateOne(_m *Property) *PropertyUpdateOne {
+	mutation := newPropertyMutation(c.config, OpUpdateOne, withProperty(_m))
+	return &PropertyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PropertyClient) UpdateOneID(id uuid.UUID) *PropertyUpdateOne {
+	mutation := newPropertyMutation(c.config, OpUpdateOne, withPropertyID(id))
+	return &PropertyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Property.
+func (c *PropertyClient) Delete() *PropertyDelete {
+	mutation := newPropertyMutation(c.config, OpDelete)
+	return &PropertyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PropertyClient) DeleteOne(_m *Property) *PropertyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PropertyClient) DeleteOneID(id uuid.UUID) *PropertyDeleteOne {
+	builder := c.Delete().Where(property.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PropertyDeleteOne{builder}
+}
+
+// Query returns a query builder for Property.
+func (c *PropertyClient) Query() *PropertyQuery {
+	return &PropertyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProperty},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Property entity by its id.
+func (c *PropertyClient) Get(ctx context.Context, id uuid.UUID) (*Property, error) {
+	return c.Query().Where(property.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PropertyClient) GetX(ctx context.Context, id uuid.UUID) *Property {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryListings queries the listings edge of a Property.
+func (c *PropertyClient) QueryListings(_m *Property) *ListingQuery {
+	query := (&ListingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(property.Table, property.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, property.ListingsTable, property.ListingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PropertyClient) Hooks() []Hook {
+	hooks := c.hooks.Property
+	return append(hooks[:len(hooks):len(hooks)], property.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PropertyClient) Interceptors() []Interceptor {
+	return c.inters.Property
+}
+
+func (c *PropertyClient) mutate(ctx context.Context, m *PropertyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PropertyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PropertyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PropertyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PropertyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Property mutation op: %q", m.Op())
+	}
+}
+
+// RealtorClient is a client for the Realtor schema.
+type RealtorClient struct {
+	config
+}
+
+// NewRealtorClient returns a client for the Realtor from the given config.
+func NewRealtorClient(c config) *RealtorClient {
+	return &RealtorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `realtor.Hooks(f(g(h())))`.
+func (c *RealtorClient) Use(hooks ...Hook) {
+	c.hooks.Realtor = append(c.hooks.Realtor, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `realtor.Intercept(f(g(h())))`.
+func (c *RealtorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Realtor = append(c.inters.Realtor, interceptors...)
+}
+
+// Create returns a builder for creating a Realtor entity.
+func (c *RealtorClient) Create() *RealtorCreate {
+	mutation := newRealtorMutation(c.config, OpCreate)
+	return &RealtorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Realtor entities.
+func (c *RealtorClient) CreateBulk(builders ...*RealtorCreate) *RealtorCreateBulk {
+	return &RealtorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RealtorClient) MapCreateBulk(slice any, setFunc func(*RealtorCreate, int)) *RealtorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RealtorCreateBulk{err: fmt.Errorf("calling to RealtorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RealtorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RealtorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Realtor.
+func (c *RealtorClient) Update() *RealtorUpdate {
+	mutation := newRealtorMutation(c.config, OpUpdate)
+	return &RealtorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RealtorClient) UpdateOne(_m *Realtor) *RealtorUpdateOne {
+	mutation := newRealtorMutation(c.config, OpUpdateOne, withRealtor(_m))
+	return &RealtorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RealtorClient) UpdateOneID(id uuid.UUID) *RealtorUpdateOne {
+	mutation := newRealtorMutation(c.config, OpUpdateOne, withRealtorID(id))
+	return &RealtorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Realtor.
+func (c *RealtorClient) Delete() *RealtorDelete {
+	mutation := newRealtorMutation(c.config, OpDelete)
+	return &RealtorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RealtorClient) DeleteOne(_m *Realtor) *RealtorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RealtorClient) DeleteOneID(id uuid.UUID) *RealtorDeleteOne {
+	builder := c.Delete().Where(realtor.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RealtorDeleteOne{builder}
+}
+
+// Query returns a query builder for Realtor.
+func (c *RealtorClient) Query() *RealtorQuery {
+	return &RealtorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRealtor},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Realtor entity by its id.
+func (c *RealtorClient) Get(ctx context.Context, id uuid.UUID) (*Realtor, error) {
+	return c.Query().Where(realtor.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RealtorClient) GetX(ctx context.Context, id uuid.UUID) *Realtor {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryListings queries the listings edge of a Realtor.
+func (c *RealtorClient) QueryListings(_m *Realtor) *ListingQuery {
+	query := (&ListingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, realtor.ListingsTable, realtor.ListingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBuyerSideSales queries the buyer_side_sales edge of a Realtor.
+func (c *RealtorClient) QueryBuyerSideSales(_m *Realtor) *ListingQuery {
+	query := (&ListingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, id),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, realtor.BuyerSideSalesTable, realtor.BuyerSideSalesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Realtor.
+func (c *RealtorClient) QueryUser(_m *Realtor) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, realtor.UserTable, realtor.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvites queries the invites edge of a Realtor.
+func (c *RealtorClient) QueryInvites(_m *Realtor) *RealtorInviteQuery {
+	query := (&RealtorInviteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, id),
+			sqlgraph.To(realtorinvite.Table, realtorinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, realtor.InvitesTable, realtor.InvitesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBrokerage queries the brokerage edge of a Realtor.
+func (c *RealtorClient) QueryBrokerage(_m *Realtor) *BrokerageQuery {
+	query := (&BrokerageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, id),
+			sqlgraph.To(brokerage.Table, brokerage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, realtor.BrokerageTable, realtor.BrokerageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTeam queries the team edge of a Realtor.
+func (c *RealtorClient) QueryTeam(_m *Realtor) *TeamQuery {
+	query := (&TeamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, realtor.TeamTable, realtor.TeamColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLedTeams queries the led_teams edge of a Realtor.
+func (c *RealtorClient) QueryLedTeams(_m *Realtor) *TeamQuery {
+	query := (&TeamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, realtor.LedTeamsTable, realtor.LedTeamsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RealtorClient) Hooks() []Hook {
+	hooks := c.hooks.Realtor
+	return append(hooks[:len(hooks):len(hooks)], realtor.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RealtorClient) Interceptors() []Interceptor {
+	return c.inters.Realtor
+}
+
+func (c *RealtorClient) mutate(ctx context.Context, m *RealtorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RealtorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RealtorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RealtorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RealtorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Realtor mutation op: %q", m.Op())
+	}
+}
+
+// RealtorInviteClient is a client for the RealtorInvite schema.
+type RealtorInviteClient struct {
+	config
+}
+
+// NewRealtorInviteClient returns a client for the RealtorInvite from the given config.
+func NewRealtorInviteClient(c config) *RealtorInviteClient {
+	return &RealtorInviteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `realtorinvite.Hooks(f(g(h())))`.
+func (c *RealtorInviteClient) Use(hooks ...Hook) {
+	c.hooks.RealtorInvite = append(c.hooks.RealtorInvite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `realtorinvite.Intercept(f(g(h())))`.
+func (c *RealtorInviteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RealtorInvite = append(c.inters.RealtorInvite, interceptors...)
+}
+
+// Create returns a builder for creating a RealtorInvite entity.
+func (c *RealtorInviteClient) Create() *RealtorInviteCreate {
+	mutation := newRealtorInviteMutation(c.config, OpCreate)
+	return &RealtorInviteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RealtorInvite entities.
+func (c *RealtorInviteClient) CreateBulk(builders ...*RealtorInviteCreate) *RealtorInviteCreateBulk {
+	return &RealtorInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RealtorInviteClient) MapCreateBulk(slice any, setFunc func(*RealtorInviteCreate, int)) *RealtorInviteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RealtorInviteCreateBulk{err: fmt.Errorf("calling to RealtorInviteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RealtorInviteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RealtorInviteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RealtorInvite.
+func (c *RealtorInviteClient) Update() *RealtorInviteUpdate {
+	mutation := newRealtorInviteMutation(c.config, OpUpdate)
+	return &RealtorInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RealtorInviteClient) UpdateOne(_m *RealtorInvite) *RealtorInviteUpdateOne {
+	mutation := newRealtorInviteMutation(c.config, OpUpdateOne, withRealtorInvite(_m))
+	return &RealtorInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RealtorInviteClient) UpdateOneID(id uuid.UUID) *RealtorInviteUpdateOne {
+	mutation := newRealtorInviteMutation(c.config, OpUpdateOne, withRealtorInviteID(id))
+	return &RealtorInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RealtorInvite.
+func (c *RealtorInviteClient) Delete() *RealtorInviteDelete {
+	mutation := newRealtorInviteMutation(c.config, OpDelete)
+	return &RealtorInviteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RealtorInviteClient) DeleteOne(_m *RealtorInvite) *RealtorInviteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RealtorInviteClient) DeleteOneID(id uuid.UUID) *RealtorInviteDeleteOne {
+	builder := c.Delete().Where(realtorinvite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RealtorInviteDeleteOne{builder}
+}
+
+// Query returns a query builder for RealtorInvite.
+func (c *RealtorInviteClient) Query() *RealtorInviteQuery {
+	return &RealtorInviteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRealtorInvite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RealtorInvite entity by its id.
+func (c *RealtorInviteClient) Get(ctx context.Context, id uuid.UUID) (*RealtorInvite, error) {
+	return c.Query().Where(realtorinvite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RealtorInviteClient) GetX(ctx context.Context, id uuid.UUID) *RealtorInvite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRealtor queries the realtor edge of a RealtorInvite.
+func (c *RealtorInviteClient) QueryRealtor(_m *RealtorInvite) *RealtorQuery {
+	query := (&RealtorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtorinvite.Table, realtorinvite.FieldID, id),
+			sqlgraph.To(realtor.Table, realtor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, realtorinvite.RealtorTable, realtorinvite.RealtorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RealtorInviteClient) Hooks() []Hook {
+	hooks := c.hooks.RealtorInvite
+	return append(hooks[:len(hooks):len(hooks)], realtorinvite.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RealtorInviteClient) Interceptors() []Interceptor {
+	return c.inters.RealtorInvite
+}
+
+func (c *RealtorInviteClient) mutate(ctx context.Context, m *RealtorInviteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RealtorInviteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RealtorInviteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RealtorInviteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RealtorInviteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RealtorInvite mutation op: %q", m.Op())
+	}
+}
+
+// TeamClient is a client for the Team schema.
+type TeamClient struct {
+	config
+}
+
+// NewTeamClient returns a client for the Team from the given config.
+func NewTeamClient(c config) *TeamClient {
+	return &TeamClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `team.Hooks(f(g(h())))`.
+func (c *TeamClient) Use(hooks ...Hook) {
+	c.hooks.Team = append(c.hooks.Team, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `team.Intercept(f(g(h())))`.
+func (c *TeamClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Team = append(c.inters.Team, interceptors...)
+}
+
+// Create returns a builder for creating a Team entity.
+func (c *TeamClient) Create() *TeamCreate {
+	mutation := newTeamMutation(c.config, OpCreate)
+	return &TeamCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Team entities.
+func (c *TeamClient) CreateBulk(builders ...*TeamCreate) *TeamCreateBulk {
+	return &TeamCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamClient) MapCreateBulk(slice any, setFunc func(*TeamCreate, int)) *TeamCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamCreateBulk{err: fmt.Errorf("calling to TeamClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Team.
+func (c *TeamClient) Update() *TeamUpdate {
+	mutation := newTeamMutation(c.config, OpUpdate)
+	return &TeamUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamClient) UpdateOne(_m *Team) *TeamUpdateOne {
+	mutation := newTeamMutation(c.config, OpUpdateOne, withTeam(_m))
+	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamClient) UpdateOneID(id uuid.UUID) *TeamUpdateOne {
+	mutation := newTeamMutation(c.config, OpUpdateOne, withTeamID(id))
+	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Team.
+func (c *TeamClient) Delete() *TeamDelete {
+	mutation := newTeamMutation(c.config, OpDelete)
+	return &TeamDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamClient) DeleteOne(_m *Team) *TeamDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamClient) DeleteOneID(id uuid.UUID) *TeamDeleteOne {
+	builder := c.Delete().Where(team.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamDeleteOne{builder}
+}
+
+// Query returns a query builder for Team.
+func (c *TeamClient) Query() *TeamQuery {
+	return &TeamQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeam},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Team entity by its id.
+func (c *TeamClient) Get(ctx context.Context, id uuid.UUID) (*Team, error) {
+	return c.Query().Where(team.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamClient) GetX(ctx context.Context, id uuid.UUID) *Team {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBrokerage queries the brokerage edge of a Team.
+func (c *TeamClient) QueryBrokerage(_m *Team) *BrokerageQuery {
+	query := (&BrokerageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(brokerage.Table, brokerage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, team.BrokerageTable, team.BrokerageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLead queries the lead edge of a Team.
+func (c *TeamClient) QueryLead(_m *Team) *RealtorQuery {
+	query := (&RealtorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(realtor.Table, realtor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, team.LeadTable, team.LeadColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a Team.
+func (c *TeamClient) QueryMembers(_m *Team) *RealtorQuery {
+	query := (&RealtorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(realtor.Table, realtor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, team.MembersTable, team.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TeamClient) Hooks() []Hook {
+	hooks := c.hooks.Team
+	return append(hooks[:len(hooks):len(hooks)], team.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamClient) Interceptors() []Interceptor {
+	return c.inters.Team
+}
+
+func (c *TeamClient) mutate(ctx context.Context, m *TeamMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Team mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id uuid.UUID) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id uuid.UUID) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id uuid.UUID) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryInquiries queries the inquiries edge of a User.
+func (c *UserClient) QueryInquiries(_m *User) *ListingInquiryQuery {
+	query := (&ListingInquiryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(listinginquiry.Table, listinginquiry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.InquiriesTable, user.InquiriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOffers queries the offers edge of a User.
+func (c *UserClient) QueryOffers(_m *User) *OfferQuery {
+	query := (&OfferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(offer.Table, offer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OffersTable, user.OffersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRealtor queries the realtor edge of a User.
+func (c *UserClient) QueryRealtor(_m *User) *RealtorQuery {
+	query := (&RealtorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(realtor.Table, realtor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.RealtorTable, user.RealtorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		Brokerage, DocumentDownload, ExchangeRate, Listing, ListingDocument,
+		ListingInquiry, ListingRenewal, ListingReview, ListingStatusEvent,
+		Neighborhood, Notification, Offer, PointOfInterest, Property, Realtor,
+		RealtorInvite, Team, User []ent.Hook
+	}
+	inters struct {
+		Brokerage, DocumentDownload, ExchangeRate, Listing, ListingDocument,
+		ListingInquiry, ListingRenewal, ListingReview, ListingStatusEvent,
+		Neighborhood, Notification, Offer, PointOfInterest, Property, Realtor,
+		RealtorInvite, Team, User []ent.Interceptor
+	}
+)
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
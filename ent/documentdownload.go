@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+)
+
+// DocumentDownload is the model entity for the DocumentDownload schema.
+type DocumentDownload struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// DocumentID holds the value of the "document_id" field.
+	DocumentID uuid.UUID `json:"document_id,omitempty"`
+	// UserEmail holds the value of the "user_email" field.
+	UserEmail string `json:"user_email,omitempty"`
+	// IPAddress holds the value of the "ip_address" field.
+	IPAddress string `json:"ip_address,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// DownloadedAt holds the value of the "downloaded_at" field.
+	DownloadedAt time.Time `json:"downloaded_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DocumentDownloadQuery when eager-loading is set.
+	Edges        DocumentDownloadEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DocumentDownloadEdges holds the relations/edges for other nodes in the graph.
+type DocumentDownloadEdges struct {
+	// Document holds the value of the document edge.
+	Document *ListingDocument `json:"document,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DocumentOrErr returns the Document value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DocumentDownloadEdges) DocumentOrErr() (*ListingDocument, error) {
+	if e.Document != nil {
+		return e.Document, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: listingdocument.Label}
+	}
+	return nil, &NotLoadedError{edge: "document"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DocumentDownload) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case documentdownload.FieldUserEmail, documentdownload.FieldIPAddress, documentdownload.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case documentdownload.FieldDownloadedAt:
+			values[i] = new(sql.NullTime)
+		case documentdownload.FieldID, documentdownload.FieldDocumentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DocumentDownload fields.
+func (_m *DocumentDownload) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case documentdownload.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case documentdownload.FieldDocumentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value != nil {
+				_m.DocumentID = *value
+			}
+		case documentdownload.FieldUserEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_email", values[i])
+			} else if value.Valid {
+				_m.UserEmail = value.String
+			}
+		case documentdownload.FieldIPAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip_address", values[i])
+			} else if value.Valid {
+				_m.IPAddress = value.String
+			}
+		case documentdownload.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case documentdownload.FieldDownloadedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field downloaded_at", values[i])
+			} else if value.Valid {
+				_m.DownloadedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DocumentDownload.
+// This includes values selected through modifiers, order, etc.
+func (_m *DocumentDownload) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDocument queries the "document" edge of the DocumentDownload entity.
+func (_m *DocumentDownload) QueryDocument() *ListingDocumentQuery {
+	return NewDocumentDownloadClient(_m.config).QueryDocument(_m)
+}
+
+// Update returns a builder for updating this DocumentDownload.
+// Note that you need to call DocumentDownload.Unwrap() before calling this method if this DocumentDownload
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DocumentDownload) Update() *DocumentDownloadUpdateOne {
+	return NewDocumentDownloadClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DocumentDownload entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DocumentDownload) Unwrap() *DocumentDownload {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DocumentDownload is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DocumentDownload) String() string {
+	var builder strings.Builder
+	builder.WriteString("DocumentDownload(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("document_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocumentID))
+	builder.WriteString(", ")
+	builder.WriteString("user_email=")
+	builder.WriteString(_m.UserEmail)
+	builder.WriteString(", ")
+	builder.WriteString("ip_address=")
+	builder.WriteString(_m.IPAddress)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("downloaded_at=")
+	builder.WriteString(_m.DownloadedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DocumentDownloads is a parsable slice of DocumentDownload.
+type DocumentDownloads []*DocumentDownload
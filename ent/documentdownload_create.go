@@ -0,0 +1,811 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+)
+
+// DocumentDownloadCreate is the builder for creating a DocumentDownload entity.
+type DocumentDownloadCreate struct {
+	config
+	mutation *DocumentDownloadMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *DocumentDownloadCreate) SetDocumentID(v uuid.UUID) *DocumentDownloadCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetUserEmail sets the "user_email" field.
+func (_c *DocumentDownloadCreate) SetUserEmail(v string) *DocumentDownloadCreate {
+	_c.mutation.SetUserEmail(v)
+	return _c
+}
+
+// SetNillableUserEmail sets the "user_email" field if the given value is not nil.
+func (_c *DocumentDownloadCreate) SetNillableUserEmail(v *string) *DocumentDownloadCreate {
+	if v != nil {
+		_c.SetUserEmail(*v)
+	}
+	return _c
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_c *DocumentDownloadCreate) SetIPAddress(v string) *DocumentDownloadCreate {
+	_c.mutation.SetIPAddress(v)
+	return _c
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_c *DocumentDownloadCreate) SetNillableIPAddress(v *string) *DocumentDownloadCreate {
+	if v != nil {
+		_c.SetIPAddress(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *DocumentDownloadCreate) SetUserAgent(v string) *DocumentDownloadCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *DocumentDownloadCreate) SetNillableUserAgent(v *string) *DocumentDownloadCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetDownloadedAt sets the "downloaded_at" field.
+func (_c *DocumentDownloadCreate) SetDownloadedAt(v time.Time) *DocumentDownloadCreate {
+	_c.mutation.SetDownloadedAt(v)
+	return _c
+}
+
+// SetNillableDownloadedAt sets the "downloaded_at" field if the given value is not nil.
+func (_c *DocumentDownloadCreate) SetNillableDownloadedAt(v *time.Time) *DocumentDownloadCreate {
+	if v != nil {
+		_c.SetDownloadedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DocumentDownloadCreate) SetID(v uuid.UUID) *DocumentDownloadCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DocumentDownloadCreate) SetNillableID(v *uuid.UUID) *DocumentDownloadCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetDocument sets the "document" edge to the ListingDocument entity.
+func (_c *DocumentDownloadCreate) SetDocument(v *ListingDocument) *DocumentDownloadCreate {
+	return _c.SetDocumentID(v.ID)
+}
+
+// Mutation returns the DocumentDownloadMutation object of the builder.
+func (_c *DocumentDownloadCreate) Mutation() *DocumentDownloadMutation {
+	return _c.mutation
+}
+
+// Save creates the DocumentDownload in the database.
+func (_c *DocumentDownloadCreate) Save(ctx context.Context) (*DocumentDownload, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DocumentDownloadCreate) SaveX(ctx context.Context) *DocumentDownload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentDownloadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentDownloadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DocumentDownloadCreate) defaults() {
+	if _, ok := _c.mutation.DownloadedAt(); !ok {
+		v := documentdownload.DefaultDownloadedAt()
+		_c.mutation.SetDownloadedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := documentdownload.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DocumentDownloadCreate) check() error {
+	if _, ok := _c.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "DocumentDownload.document_id"`)}
+	}
+	if v, ok := _c.mutation.UserEmail(); ok {
+		if err := documentdownload.UserEmailValidator(v); err != nil {
+			return &ValidationError{Name: "user_email", err: fmt.Errorf(`ent: validator failed for field "DocumentDownload.user_email": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.IPAddress(); ok {
+		if err := documentdownload.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "DocumentDownload.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UserAgent(); ok {
+		if err := documentdownload.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "DocumentDownload.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DownloadedAt(); !ok {
+		return &ValidationError{Name: "downloaded_at", err: errors.New(`ent: missing required field "DocumentDownload.downloaded_at"`)}
+	}
+	if len(_c.mutation.DocumentIDs()) == 0 {
+		return &ValidationError{Name: "document", err: errors.New(`ent: missing required edge "DocumentDownload.document"`)}
+	}
+	return nil
+}
+
+func (_c *DocumentDownloadCreate) sqlSave(ctx context.Context) (*DocumentDownload, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DocumentDownloadCreate) createSpec() (*DocumentDownload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DocumentDownload{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(documentdownload.Table, sqlgraph.NewFieldSpec(documentdownload.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserEmail(); ok {
+		_spec.SetField(documentdownload.FieldUserEmail, field.TypeString, value)
+		_node.UserEmail = value
+	}
+	if value, ok := _c.mutation.IPAddress(); ok {
+		_spec.SetField(documentdownload.FieldIPAddress, field.TypeString, value)
+		_node.IPAddress = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(documentdownload.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.DownloadedAt(); ok {
+		_spec.SetField(documentdownload.FieldDownloadedAt, field.TypeTime, value)
+		_node.DownloadedAt = value
+	}
+	if nodes := _c.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   documentdownload.DocumentTable,
+			Columns: []string{documentdownload.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DocumentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DocumentDownload.Create().
+//		SetDocumentID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentDownloadUpsert) {
+//			SetDocumentID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DocumentDownloadCreate) OnConflict(opts ...sql.ConflictOption) *DocumentDownloadUpsertOne {
+	_c.conflict = opts
+	return &DocumentDownloadUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DocumentDownload.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DocumentDownloadCreate) OnConflictColumns(columns ...string) *DocumentDownloadUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DocumentDownloadUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DocumentDownloadUpsertOne is the builder for "upsert"-ing
+	//  one DocumentDownload node.
+	DocumentDownloadUpsertOne struct {
+		create *DocumentDownloadCreate
+	}
+
+	// DocumentDownloadUpsert is the "OnConflict" setter.
+	DocumentDownloadUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentDownloadUpsert) SetDocumentID(v uuid.UUID) *DocumentDownloadUpsert {
+	u.Set(documentdownload.FieldDocumentID, v)
+	return u
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentDownloadUpsert) UpdateDocumentID() *DocumentDownloadUpsert {
+	u.SetExcluded(documentdownload.FieldDocumentID)
+	return u
+}
+
+// SetUserEmail sets the "user_email" field.
+func (u *DocumentDownloadUpsert) SetUserEmail(v string) *DocumentDownloadUpsert {
+	u.Set(documentdownload.FieldUserEmail, v)
+	return u
+}
+
+// UpdateUserEmail sets the "user_email" field to the value that was provided on create.
+func (u *DocumentDownloadUpsert) UpdateUserEmail() *DocumentDownloadUpsert {
+	u.SetExcluded(documentdownload.FieldUserEmail)
+	return u
+}
+
+// ClearUserEmail clears the value of the "user_email" field.
+func (u *DocumentDownloadUpsert) ClearUserEmail() *DocumentDownloadUpsert {
+	u.SetNull(documentdownload.FieldUserEmail)
+	return u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *DocumentDownloadUpsert) SetIPAddress(v string) *DocumentDownloadUpsert {
+	u.Set(documentdownload.FieldIPAddress, v)
+	return u
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *DocumentDownloadUpsert) UpdateIPAddress() *DocumentDownloadUpsert {
+	u.SetExcluded(documentdownload.FieldIPAddress)
+	return u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *DocumentDownloadUpsert) ClearIPAddress() *DocumentDownloadUpsert {
+	u.SetNull(documentdownload.FieldIPAddress)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *DocumentDownloadUpsert) SetUserAgent(v string) *DocumentDownloadUpsert {
+	u.Set(documentdownload.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *DocumentDownloadUpsert) UpdateUserAgent() *DocumentDownloadUpsert {
+	u.SetExcluded(documentdownload.FieldUserAgent)
+	return u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *DocumentDownloadUpsert) ClearUserAgent() *DocumentDownloadUpsert {
+	u.SetNull(documentdownload.FieldUserAgent)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DocumentDownload.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(documentdownload.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentDownloadUpsertOne) UpdateNewValues() *DocumentDownloadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(documentdownload.FieldID)
+		}
+		if _, exists := u.create.mutation.DownloadedAt(); exists {
+			s.SetIgnore(documentdownload.FieldDownloadedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DocumentDownload.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DocumentDownloadUpsertOne) Ignore() *DocumentDownloadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentDownloadUpsertOne) DoNothing() *DocumentDownloadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentDownloadCreate.OnConflict
+// documentation for more info.
+func (u *DocumentDownloadUpsertOne) Update(set func(*DocumentDownloadUpsert)) *DocumentDownloadUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentDownloadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentDownloadUpsertOne) SetDocumentID(v uuid.UUID) *DocumentDownloadUpsertOne {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentDownloadUpsertOne) UpdateDocumentID() *DocumentDownloadUpsertOne {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetUserEmail sets the "user_email" field.
+func (u *DocumentDownloadUpsertOne) SetUserEmail(v string) *DocumentDownloadUpsertOne {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.SetUserEmail(v)
+	})
+}
+
+// UpdateUserEmail sets the "user_email" field to the value that was provided on create.
+func (u *DocumentDownloadUpsertOne) UpdateUserEmail() *DocumentDownloadUpsertOne {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.UpdateUserEmail()
+	})
+}
+
+// ClearUserEmail clears the value of the "user_email" field.
+func (u *DocumentDownloadUpsertOne) ClearUserEmail() *DocumentDownloadUpsertOne {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.ClearUserEmail()
+	})
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *DocumentDownloadUpsertOne) SetIPAddress(v string) *DocumentDownloadUpsertOne {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *DocumentDownloadUpsertOne) UpdateIPAddress() *DocumentDownloadUpsertOne {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *DocumentDownloadUpsertOne) ClearIPAddress() *DocumentDownloadUpsertOne {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *DocumentDownloadUpsertOne) SetUserAgent(v string) *DocumentDownloadUpsertOne {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *DocumentDownloadUpsertOne) UpdateUserAgent() *DocumentDownloadUpsertOne {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *DocumentDownloadUpsertOne) ClearUserAgent() *DocumentDownloadUpsertOne {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentDownloadUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentDownloadCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentDownloadUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DocumentDownloadUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DocumentDownloadUpsertOne.ID is not supported by MySQL driver. Use DocumentDownloadUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DocumentDownloadUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DocumentDownloadCreateBulk is the builder for creating many DocumentDownload entities in bulk.
+type DocumentDownloadCreateBulk struct {
+	config
+	err      error
+	builders []*DocumentDownloadCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DocumentDownload entities in the database.
+func (_c *DocumentDownloadCreateBulk) Save(ctx context.Context) ([]*DocumentDownload, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DocumentDownload, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DocumentDownloadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DocumentDownloadCreateBulk) SaveX(ctx context.Context) []*DocumentDownload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentDownloadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentDownloadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DocumentDownload.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DocumentDownloadUpsert) {
+//			SetDocumentID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DocumentDownloadCreateBulk) OnConflict(opts ...sql.ConflictOption) *DocumentDownloadUpsertBulk {
+	_c.conflict = opts
+	return &DocumentDownloadUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DocumentDownload.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DocumentDownloadCreateBulk) OnConflictColumns(columns ...string) *DocumentDownloadUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DocumentDownloadUpsertBulk{
+		create: _c,
+	}
+}
+
+// DocumentDownloadUpsertBulk is the builder for "upsert"-ing
+// a bulk of DocumentDownload nodes.
+type DocumentDownloadUpsertBulk struct {
+	create *DocumentDownloadCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DocumentDownload.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(documentdownload.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DocumentDownloadUpsertBulk) UpdateNewValues() *DocumentDownloadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(documentdownload.FieldID)
+			}
+			if _, exists := b.mutation.DownloadedAt(); exists {
+				s.SetIgnore(documentdownload.FieldDownloadedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DocumentDownload.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DocumentDownloadUpsertBulk) Ignore() *DocumentDownloadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DocumentDownloadUpsertBulk) DoNothing() *DocumentDownloadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DocumentDownloadCreateBulk.OnConflict
+// documentation for more info.
+func (u *DocumentDownloadUpsertBulk) Update(set func(*DocumentDownloadUpsert)) *DocumentDownloadUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DocumentDownloadUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (u *DocumentDownloadUpsertBulk) SetDocumentID(v uuid.UUID) *DocumentDownloadUpsertBulk {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.SetDocumentID(v)
+	})
+}
+
+// UpdateDocumentID sets the "document_id" field to the value that was provided on create.
+func (u *DocumentDownloadUpsertBulk) UpdateDocumentID() *DocumentDownloadUpsertBulk {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.UpdateDocumentID()
+	})
+}
+
+// SetUserEmail sets the "user_email" field.
+func (u *DocumentDownloadUpsertBulk) SetUserEmail(v string) *DocumentDownloadUpsertBulk {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.SetUserEmail(v)
+	})
+}
+
+// UpdateUserEmail sets the "user_email" field to the value that was provided on create.
+func (u *DocumentDownloadUpsertBulk) UpdateUserEmail() *DocumentDownloadUpsertBulk {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.UpdateUserEmail()
+	})
+}
+
+// ClearUserEmail clears the value of the "user_email" field.
+func (u *DocumentDownloadUpsertBulk) ClearUserEmail() *DocumentDownloadUpsertBulk {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.ClearUserEmail()
+	})
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (u *DocumentDownloadUpsertBulk) SetIPAddress(v string) *DocumentDownloadUpsertBulk {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.SetIPAddress(v)
+	})
+}
+
+// UpdateIPAddress sets the "ip_address" field to the value that was provided on create.
+func (u *DocumentDownloadUpsertBulk) UpdateIPAddress() *DocumentDownloadUpsertBulk {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.UpdateIPAddress()
+	})
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (u *DocumentDownloadUpsertBulk) ClearIPAddress() *DocumentDownloadUpsertBulk {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.ClearIPAddress()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *DocumentDownloadUpsertBulk) SetUserAgent(v string) *DocumentDownloadUpsertBulk {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *DocumentDownloadUpsertBulk) UpdateUserAgent() *DocumentDownloadUpsertBulk {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (u *DocumentDownloadUpsertBulk) ClearUserAgent() *DocumentDownloadUpsertBulk {
+	return u.Update(func(s *DocumentDownloadUpsert) {
+		s.ClearUserAgent()
+	})
+}
+
+// Exec executes the query.
+func (u *DocumentDownloadUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DocumentDownloadCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DocumentDownloadCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DocumentDownloadUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// DocumentDownloadDelete is the builder for deleting a DocumentDownload entity.
+type DocumentDownloadDelete struct {
+	config
+	hooks    []Hook
+	mutation *DocumentDownloadMutation
+}
+
+// Where appends a list predicates to the DocumentDownloadDelete builder.
+func (_d *DocumentDownloadDelete) Where(ps ...predicate.DocumentDownload) *DocumentDownloadDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DocumentDownloadDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DocumentDownloadDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DocumentDownloadDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(documentdownload.Table, sqlgraph.NewFieldSpec(documentdownload.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DocumentDownloadDeleteOne is the builder for deleting a single DocumentDownload entity.
+type DocumentDownloadDeleteOne struct {
+	_d *DocumentDownloadDelete
+}
+
+// Where appends a list predicates to the DocumentDownloadDelete builder.
+func (_d *DocumentDownloadDeleteOne) Where(ps ...predicate.DocumentDownload) *DocumentDownloadDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DocumentDownloadDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{documentdownload.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DocumentDownloadDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,493 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// DocumentDownloadUpdate is the builder for updating DocumentDownload entities.
+type DocumentDownloadUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DocumentDownloadMutation
+}
+
+// Where appends a list predicates to the DocumentDownloadUpdate builder.
+func (_u *DocumentDownloadUpdate) Where(ps ...predicate.DocumentDownload) *DocumentDownloadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *DocumentDownloadUpdate) SetDocumentID(v uuid.UUID) *DocumentDownloadUpdate {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *DocumentDownloadUpdate) SetNillableDocumentID(v *uuid.UUID) *DocumentDownloadUpdate {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetUserEmail sets the "user_email" field.
+func (_u *DocumentDownloadUpdate) SetUserEmail(v string) *DocumentDownloadUpdate {
+	_u.mutation.SetUserEmail(v)
+	return _u
+}
+
+// SetNillableUserEmail sets the "user_email" field if the given value is not nil.
+func (_u *DocumentDownloadUpdate) SetNillableUserEmail(v *string) *DocumentDownloadUpdate {
+	if v != nil {
+		_u.SetUserEmail(*v)
+	}
+	return _u
+}
+
+// ClearUserEmail clears the value of the "user_email" field.
+func (_u *DocumentDownloadUpdate) ClearUserEmail() *DocumentDownloadUpdate {
+	_u.mutation.ClearUserEmail()
+	return _u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_u *DocumentDownloadUpdate) SetIPAddress(v string) *DocumentDownloadUpdate {
+	_u.mutation.SetIPAddress(v)
+	return _u
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_u *DocumentDownloadUpdate) SetNillableIPAddress(v *string) *DocumentDownloadUpdate {
+	if v != nil {
+		_u.SetIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (_u *DocumentDownloadUpdate) ClearIPAddress() *DocumentDownloadUpdate {
+	_u.mutation.ClearIPAddress()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *DocumentDownloadUpdate) SetUserAgent(v string) *DocumentDownloadUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *DocumentDownloadUpdate) SetNillableUserAgent(v *string) *DocumentDownloadUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *DocumentDownloadUpdate) ClearUserAgent() *DocumentDownloadUpdate {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetDocument sets the "document" edge to the ListingDocument entity.
+func (_u *DocumentDownloadUpdate) SetDocument(v *ListingDocument) *DocumentDownloadUpdate {
+	return _u.SetDocumentID(v.ID)
+}
+
+// Mutation returns the DocumentDownloadMutation object of the builder.
+func (_u *DocumentDownloadUpdate) Mutation() *DocumentDownloadMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the ListingDocument entity.
+func (_u *DocumentDownloadUpdate) ClearDocument() *DocumentDownloadUpdate {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DocumentDownloadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentDownloadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DocumentDownloadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentDownloadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentDownloadUpdate) check() error {
+	if v, ok := _u.mutation.UserEmail(); ok {
+		if err := documentdownload.UserEmailValidator(v); err != nil {
+			return &ValidationError{Name: "user_email", err: fmt.Errorf(`ent: validator failed for field "DocumentDownload.user_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IPAddress(); ok {
+		if err := documentdownload.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "DocumentDownload.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := documentdownload.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "DocumentDownload.user_agent": %w`, err)}
+		}
+	}
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DocumentDownload.document"`)
+	}
+	return nil
+}
+
+func (_u *DocumentDownloadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documentdownload.Table, documentdownload.Columns, sqlgraph.NewFieldSpec(documentdownload.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserEmail(); ok {
+		_spec.SetField(documentdownload.FieldUserEmail, field.TypeString, value)
+	}
+	if _u.mutation.UserEmailCleared() {
+		_spec.ClearField(documentdownload.FieldUserEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.IPAddress(); ok {
+		_spec.SetField(documentdownload.FieldIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.IPAddressCleared() {
+		_spec.ClearField(documentdownload.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(documentdownload.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(documentdownload.FieldUserAgent, field.TypeString)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   documentdownload.DocumentTable,
+			Columns: []string{documentdownload.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   documentdownload.DocumentTable,
+			Columns: []string{documentdownload.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documentdownload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DocumentDownloadUpdateOne is the builder for updating a single DocumentDownload entity.
+type DocumentDownloadUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DocumentDownloadMutation
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *DocumentDownloadUpdateOne) SetDocumentID(v uuid.UUID) *DocumentDownloadUpdateOne {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *DocumentDownloadUpdateOne) SetNillableDocumentID(v *uuid.UUID) *DocumentDownloadUpdateOne {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetUserEmail sets the "user_email" field.
+func (_u *DocumentDownloadUpdateOne) SetUserEmail(v string) *DocumentDownloadUpdateOne {
+	_u.mutation.SetUserEmail(v)
+	return _u
+}
+
+// SetNillableUserEmail sets the "user_email" field if the given value is not nil.
+func (_u *DocumentDownloadUpdateOne) SetNillableUserEmail(v *string) *DocumentDownloadUpdateOne {
+	if v != nil {
+		_u.SetUserEmail(*v)
+	}
+	return _u
+}
+
+// ClearUserEmail clears the value of the "user_email" field.
+func (_u *DocumentDownloadUpdateOne) ClearUserEmail() *DocumentDownloadUpdateOne {
+	_u.mutation.ClearUserEmail()
+	return _u
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (_u *DocumentDownloadUpdateOne) SetIPAddress(v string) *DocumentDownloadUpdateOne {
+	_u.mutation.SetIPAddress(v)
+	return _u
+}
+
+// SetNillableIPAddress sets the "ip_address" field if the given value is not nil.
+func (_u *DocumentDownloadUpdateOne) SetNillableIPAddress(v *string) *DocumentDownloadUpdateOne {
+	if v != nil {
+		_u.SetIPAddress(*v)
+	}
+	return _u
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (_u *DocumentDownloadUpdateOne) ClearIPAddress() *DocumentDownloadUpdateOne {
+	_u.mutation.ClearIPAddress()
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *DocumentDownloadUpdateOne) SetUserAgent(v string) *DocumentDownloadUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *DocumentDownloadUpdateOne) SetNillableUserAgent(v *string) *DocumentDownloadUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (_u *DocumentDownloadUpdateOne) ClearUserAgent() *DocumentDownloadUpdateOne {
+	_u.mutation.ClearUserAgent()
+	return _u
+}
+
+// SetDocument sets the "document" edge to the ListingDocument entity.
+func (_u *DocumentDownloadUpdateOne) SetDocument(v *ListingDocument) *DocumentDownloadUpdateOne {
+	return _u.SetDocumentID(v.ID)
+}
+
+// Mutation returns the DocumentDownloadMutation object of the builder.
+func (_u *DocumentDownloadUpdateOne) Mutation() *DocumentDownloadMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the ListingDocument entity.
+func (_u *DocumentDownloadUpdateOne) ClearDocument() *DocumentDownloadUpdateOne {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// Where appends a list predicates to the DocumentDownloadUpdate builder.
+func (_u *DocumentDownloadUpdateOne) Where(ps ...predicate.DocumentDownload) *DocumentDownloadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DocumentDownloadUpdateOne) Select(field string, fields ...string) *DocumentDownloadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DocumentDownload entity.
+func (_u *DocumentDownloadUpdateOne) Save(ctx context.Context) (*DocumentDownload, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentDownloadUpdateOne) SaveX(ctx context.Context) *DocumentDownload {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DocumentDownloadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentDownloadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentDownloadUpdateOne) check() error {
+	if v, ok := _u.mutation.UserEmail(); ok {
+		if err := documentdownload.UserEmailValidator(v); err != nil {
+			return &ValidationError{Name: "user_email", err: fmt.Errorf(`ent: validator failed for field "DocumentDownload.user_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IPAddress(); ok {
+		if err := documentdownload.IPAddressValidator(v); err != nil {
+			return &ValidationError{Name: "ip_address", err: fmt.Errorf(`ent: validator failed for field "DocumentDownload.ip_address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserAgent(); ok {
+		if err := documentdownload.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "DocumentDownload.user_agent": %w`, err)}
+		}
+	}
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DocumentDownload.document"`)
+	}
+	return nil
+}
+
+func (_u *DocumentDownloadUpdateOne) sqlSave(ctx context.Context) (_node *DocumentDownload, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documentdownload.Table, documentdownload.Columns, sqlgraph.NewFieldSpec(documentdownload.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DocumentDownload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, documentdownload.FieldID)
+		for _, f := range fields {
+			if !documentdownload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != documentdownload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserEmail(); ok {
+		_spec.SetField(documentdownload.FieldUserEmail, field.TypeString, value)
+	}
+	if _u.mutation.UserEmailCleared() {
+		_spec.ClearField(documentdownload.FieldUserEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.IPAddress(); ok {
+		_spec.SetField(documentdownload.FieldIPAddress, field.TypeString, value)
+	}
+	if _u.mutation.IPAddressCleared() {
+		_spec.ClearField(documentdownload.FieldIPAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(documentdownload.FieldUserAgent, field.TypeString, value)
+	}
+	if _u.mutation.UserAgentCleared() {
+		_spec.ClearField(documentdownload.FieldUserAgent, field.TypeString)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   documentdownload.DocumentTable,
+			Columns: []string{documentdownload.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   documentdownload.DocumentTable,
+			Columns: []string{documentdownload.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DocumentDownload{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documentdownload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
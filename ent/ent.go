@@ -12,8 +12,23 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"ppgroup.ppgroup.com/ent/brokerage"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/exchangerate"
 	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/listingrenewal"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/listingstatusevent"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/notification"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/pointofinterest"
+	"ppgroup.ppgroup.com/ent/property"
 	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+	"ppgroup.ppgroup.com/ent/team"
 	"ppgroup.ppgroup.com/ent/user"
 )
 
@@ -72,15 +87,30 @@ var (
 )
 
 // checkColumn checks if the column exists in the given table.
-func checkColumn(table, column string) error {
+func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			listing.Table: listing.ValidColumn,
-			realtor.Table: realtor.ValidColumn,
-			user.Table:    user.ValidColumn,
+			brokerage.Table:          brokerage.ValidColumn,
+			documentdownload.Table:   documentdownload.ValidColumn,
+			exchangerate.Table:       exchangerate.ValidColumn,
+			listing.Table:            listing.ValidColumn,
+			listingdocument.Table:    listingdocument.ValidColumn,
+			listinginquiry.Table:     listinginquiry.ValidColumn,
+			listingrenewal.Table:     listingrenewal.ValidColumn,
+			listingreview.Table:      listingreview.ValidColumn,
+			listingstatusevent.Table: listingstatusevent.ValidColumn,
+			neighborhood.Table:       neighborhood.ValidColumn,
+			notification.Table:       notification.ValidColumn,
+			offer.Table:              offer.ValidColumn,
+			pointofinterest.Table:    pointofinterest.ValidColumn,
+			property.Table:           property.ValidColumn,
+			realtor.Table:            realtor.ValidColumn,
+			realtorinvite.Table:      realtorinvite.ValidColumn,
+			team.Table:               team.ValidColumn,
+			user.Table:               user.ValidColumn,
 		})
 	})
-	return columnCheck(table, column)
+	return columnCheck(t, c)
 }
 
 // Asc applies the given fields in ASC order.
@@ -0,0 +1,143 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent/exchangerate"
+)
+
+// ExchangeRate is the model entity for the ExchangeRate schema.
+type ExchangeRate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Rate holds the value of the "rate" field.
+	Rate         decimal.Decimal `json:"rate,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExchangeRate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldRate:
+			values[i] = new(decimal.Decimal)
+		case exchangerate.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case exchangerate.FieldCreateTime, exchangerate.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case exchangerate.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExchangeRate fields.
+func (_m *ExchangeRate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exchangerate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case exchangerate.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case exchangerate.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case exchangerate.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case exchangerate.FieldRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field rate", values[i])
+			} else if value != nil {
+				_m.Rate = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExchangeRate.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExchangeRate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExchangeRate.
+// Note that you need to call ExchangeRate.Unwrap() before calling this method if this ExchangeRate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExchangeRate) Update() *ExchangeRateUpdateOne {
+	return NewExchangeRateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExchangeRate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExchangeRate) Unwrap() *ExchangeRate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExchangeRate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExchangeRate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExchangeRate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rate))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExchangeRates is a parsable slice of ExchangeRate.
+type ExchangeRates []*ExchangeRate
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exchangerate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Rate applies equality check predicate on the "rate" field. It's identical to RateEQ.
+func Rate(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldRate, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// RateEQ applies the EQ predicate on the "rate" field.
+func RateEQ(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldEQ(FieldRate, v))
+}
+
+// RateNEQ applies the NEQ predicate on the "rate" field.
+func RateNEQ(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNEQ(FieldRate, v))
+}
+
+// RateIn applies the In predicate on the "rate" field.
+func RateIn(vs ...decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldIn(FieldRate, vs...))
+}
+
+// RateNotIn applies the NotIn predicate on the "rate" field.
+func RateNotIn(vs ...decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldNotIn(FieldRate, vs...))
+}
+
+// RateGT applies the GT predicate on the "rate" field.
+func RateGT(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGT(FieldRate, v))
+}
+
+// RateGTE applies the GTE predicate on the "rate" field.
+func RateGTE(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldGTE(FieldRate, v))
+}
+
+// RateLT applies the LT predicate on the "rate" field.
+func RateLT(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLT(FieldRate, v))
+}
+
+// RateLTE applies the LTE predicate on the "rate" field.
+func RateLTE(v decimal.Decimal) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.FieldLTE(FieldRate, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExchangeRate) predicate.ExchangeRate {
+	return predicate.ExchangeRate(sql.NotPredicates(p))
+}
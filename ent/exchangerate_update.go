@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent/exchangerate"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ExchangeRateUpdate is the builder for updating ExchangeRate entities.
+type ExchangeRateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExchangeRateMutation
+}
+
+// Where appends a list predicates to the ExchangeRateUpdate builder.
+func (_u *ExchangeRateUpdate) Where(ps ...predicate.ExchangeRate) *ExchangeRateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ExchangeRateUpdate) SetUpdateTime(v time.Time) *ExchangeRateUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *ExchangeRateUpdate) SetCurrency(v string) *ExchangeRateUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *ExchangeRateUpdate) SetNillableCurrency(v *string) *ExchangeRateUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *ExchangeRateUpdate) SetRate(v decimal.Decimal) *ExchangeRateUpdate {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *ExchangeRateUpdate) SetNillableRate(v *decimal.Decimal) *ExchangeRateUpdate {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *ExchangeRateUpdate) AddRate(v decimal.Decimal) *ExchangeRateUpdate {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (_u *ExchangeRateUpdate) Mutation() *ExchangeRateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExchangeRateUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExchangeRateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExchangeRateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExchangeRateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExchangeRateUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if exchangerate.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized exchangerate.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := exchangerate.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExchangeRateUpdate) check() error {
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := exchangerate.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.currency": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExchangeRateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exchangerate.Table, exchangerate.Columns, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(exchangerate.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(exchangerate.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(exchangerate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(exchangerate.FieldRate, field.TypeFloat64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExchangeRateUpdateOne is the builder for updating a single ExchangeRate entity.
+type ExchangeRateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExchangeRateMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ExchangeRateUpdateOne) SetUpdateTime(v time.Time) *ExchangeRateUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *ExchangeRateUpdateOne) SetCurrency(v string) *ExchangeRateUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *ExchangeRateUpdateOne) SetNillableCurrency(v *string) *ExchangeRateUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetRate sets the "rate" field.
+func (_u *ExchangeRateUpdateOne) SetRate(v decimal.Decimal) *ExchangeRateUpdateOne {
+	_u.mutation.ResetRate()
+	_u.mutation.SetRate(v)
+	return _u
+}
+
+// SetNillableRate sets the "rate" field if the given value is not nil.
+func (_u *ExchangeRateUpdateOne) SetNillableRate(v *decimal.Decimal) *ExchangeRateUpdateOne {
+	if v != nil {
+		_u.SetRate(*v)
+	}
+	return _u
+}
+
+// AddRate adds value to the "rate" field.
+func (_u *ExchangeRateUpdateOne) AddRate(v decimal.Decimal) *ExchangeRateUpdateOne {
+	_u.mutation.AddRate(v)
+	return _u
+}
+
+// Mutation returns the ExchangeRateMutation object of the builder.
+func (_u *ExchangeRateUpdateOne) Mutation() *ExchangeRateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExchangeRateUpdate builder.
+func (_u *ExchangeRateUpdateOne) Where(ps ...predicate.ExchangeRate) *ExchangeRateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExchangeRateUpdateOne) Select(field string, fields ...string) *ExchangeRateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExchangeRate entity.
+func (_u *ExchangeRateUpdateOne) Save(ctx context.Context) (*ExchangeRate, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExchangeRateUpdateOne) SaveX(ctx context.Context) *ExchangeRate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExchangeRateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExchangeRateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExchangeRateUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if exchangerate.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized exchangerate.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := exchangerate.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExchangeRateUpdateOne) check() error {
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := exchangerate.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "ExchangeRate.currency": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExchangeRateUpdateOne) sqlSave(ctx context.Context) (_node *ExchangeRate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exchangerate.Table, exchangerate.Columns, sqlgraph.NewFieldSpec(exchangerate.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExchangeRate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exchangerate.FieldID)
+		for _, f := range fields {
+			if !exchangerate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exchangerate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(exchangerate.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(exchangerate.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Rate(); ok {
+		_spec.SetField(exchangerate.FieldRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRate(); ok {
+		_spec.AddField(exchangerate.FieldRate, field.TypeFloat64, value)
+	}
+	_node = &ExchangeRate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exchangerate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,42 @@ import (
 	"ppgroup.ppgroup.com/ent"
 )
 
+// The BrokerageFunc type is an adapter to allow the use of ordinary
+// function as Brokerage mutator.
+type BrokerageFunc func(context.Context, *ent.BrokerageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BrokerageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BrokerageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BrokerageMutation", m)
+}
+
+// The DocumentDownloadFunc type is an adapter to allow the use of ordinary
+// function as DocumentDownload mutator.
+type DocumentDownloadFunc func(context.Context, *ent.DocumentDownloadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DocumentDownloadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DocumentDownloadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DocumentDownloadMutation", m)
+}
+
+// The ExchangeRateFunc type is an adapter to allow the use of ordinary
+// function as ExchangeRate mutator.
+type ExchangeRateFunc func(context.Context, *ent.ExchangeRateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExchangeRateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExchangeRateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExchangeRateMutation", m)
+}
+
 // The ListingFunc type is an adapter to allow the use of ordinary
 // function as Listing mutator.
 type ListingFunc func(context.Context, *ent.ListingMutation) (ent.Value, error)
@@ -21,6 +57,126 @@ func (f ListingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ListingMutation", m)
 }
 
+// The ListingDocumentFunc type is an adapter to allow the use of ordinary
+// function as ListingDocument mutator.
+type ListingDocumentFunc func(context.Context, *ent.ListingDocumentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ListingDocumentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ListingDocumentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ListingDocumentMutation", m)
+}
+
+// The ListingInquiryFunc type is an adapter to allow the use of ordinary
+// function as ListingInquiry mutator.
+type ListingInquiryFunc func(context.Context, *ent.ListingInquiryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ListingInquiryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ListingInquiryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ListingInquiryMutation", m)
+}
+
+// The ListingRenewalFunc type is an adapter to allow the use of ordinary
+// function as ListingRenewal mutator.
+type ListingRenewalFunc func(context.Context, *ent.ListingRenewalMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ListingRenewalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ListingRenewalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ListingRenewalMutation", m)
+}
+
+// The ListingReviewFunc type is an adapter to allow the use of ordinary
+// function as ListingReview mutator.
+type ListingReviewFunc func(context.Context, *ent.ListingReviewMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ListingReviewFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ListingReviewMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ListingReviewMutation", m)
+}
+
+// The ListingStatusEventFunc type is an adapter to allow the use of ordinary
+// function as ListingStatusEvent mutator.
+type ListingStatusEventFunc func(context.Context, *ent.ListingStatusEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ListingStatusEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ListingStatusEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ListingStatusEventMutation", m)
+}
+
+// The NeighborhoodFunc type is an adapter to allow the use of ordinary
+// function as Neighborhood mutator.
+type NeighborhoodFunc func(context.Context, *ent.NeighborhoodMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NeighborhoodFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NeighborhoodMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NeighborhoodMutation", m)
+}
+
+// The NotificationFunc type is an adapter to allow the use of ordinary
+// function as Notification mutator.
+type NotificationFunc func(context.Context, *ent.NotificationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NotificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NotificationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NotificationMutation", m)
+}
+
+// The OfferFunc type is an adapter to allow the use of ordinary
+// function as Offer mutator.
+type OfferFunc func(context.Context, *ent.OfferMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OfferFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OfferMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OfferMutation", m)
+}
+
+// The PointOfInterestFunc type is an adapter to allow the use of ordinary
+// function as PointOfInterest mutator.
+type PointOfInterestFunc func(context.Context, *ent.PointOfInterestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PointOfInterestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PointOfInterestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PointOfInterestMutation", m)
+}
+
+// The PropertyFunc type is an adapter to allow the use of ordinary
+// function as Property mutator.
+type PropertyFunc func(context.Context, *ent.PropertyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PropertyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PropertyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PropertyMutation", m)
+}
+
 // The RealtorFunc type is an adapter to allow the use of ordinary
 // function as Realtor mutator.
 type RealtorFunc func(context.Context, *ent.RealtorMutation) (ent.Value, error)
@@ -33,6 +189,30 @@ func (f RealtorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RealtorMutation", m)
 }
 
+// The RealtorInviteFunc type is an adapter to allow the use of ordinary
+// function as RealtorInvite mutator.
+type RealtorInviteFunc func(context.Context, *ent.RealtorInviteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RealtorInviteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RealtorInviteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RealtorInviteMutation", m)
+}
+
+// The TeamFunc type is an adapter to allow the use of ordinary
+// function as Team mutator.
+type TeamFunc func(context.Context, *ent.TeamMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TeamFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TeamMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TeamMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -13,8 +13,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/property"
 	"ppgroup.ppgroup.com/ent/realtor"
-	"ppgroup.ppgroup.com/ent/schema"
+	"ppgroup.ppgroup.com/ent/schematype"
 )
 
 // Listing is the model entity for the Listing schema.
@@ -30,16 +32,32 @@ type Listing struct {
 	Title string `json:"title,omitempty"`
 	// Address holds the value of the "address" field.
 	Address string `json:"address,omitempty"`
+	// AddressKey holds the value of the "address_key" field.
+	AddressKey string `json:"address_key,omitempty"`
 	// City holds the value of the "city" field.
 	City string `json:"city,omitempty"`
 	// State holds the value of the "state" field.
 	State string `json:"state,omitempty"`
 	// ZipCode holds the value of the "zip_code" field.
 	ZipCode string `json:"zip_code,omitempty"`
+	// Country holds the value of the "country" field.
+	Country string `json:"country,omitempty"`
+	// Latitude holds the value of the "latitude" field.
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude *float64 `json:"longitude,omitempty"`
+	// NeighborhoodID holds the value of the "neighborhood_id" field.
+	NeighborhoodID uuid.UUID `json:"neighborhood_id,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// SourceLocale holds the value of the "source_locale" field.
+	SourceLocale string `json:"source_locale,omitempty"`
+	// Translations holds the value of the "translations" field.
+	Translations map[string]schematype.Translation `json:"translations,omitempty"`
 	// Price holds the value of the "price" field.
 	Price decimal.Decimal `json:"price,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
 	// Bedroom holds the value of the "bedroom" field.
 	Bedroom int `json:"bedroom,omitempty"`
 	// Bathroom holds the value of the "bathroom" field.
@@ -48,6 +66,10 @@ type Listing struct {
 	Garage int `json:"garage,omitempty"`
 	// Sqft holds the value of the "sqft" field.
 	Sqft int `json:"sqft,omitempty"`
+	// Sqm holds the value of the "sqm" field.
+	Sqm int `json:"sqm,omitempty"`
+	// AreaUnit holds the value of the "area_unit" field.
+	AreaUnit listing.AreaUnit `json:"area_unit,omitempty"`
 	// TypeOfProperty holds the value of the "type_of_property" field.
 	TypeOfProperty listing.TypeOfProperty `json:"type_of_property,omitempty"`
 	// Status holds the value of the "status" field.
@@ -58,10 +80,48 @@ type Listing struct {
 	Pool bool `json:"pool,omitempty"`
 	// YearBuilt holds the value of the "year_built" field.
 	YearBuilt int `json:"year_built,omitempty"`
+	// AnnualPropertyTax holds the value of the "annual_property_tax" field.
+	AnnualPropertyTax *decimal.Decimal `json:"annual_property_tax,omitempty"`
+	// HoaDues holds the value of the "hoa_dues" field.
+	HoaDues *decimal.Decimal `json:"hoa_dues,omitempty"`
+	// HoaFrequency holds the value of the "hoa_frequency" field.
+	HoaFrequency *listing.HoaFrequency `json:"hoa_frequency,omitempty"`
+	// HoaName holds the value of the "hoa_name" field.
+	HoaName string `json:"hoa_name,omitempty"`
+	// SpecialAssessments holds the value of the "special_assessments" field.
+	SpecialAssessments *decimal.Decimal `json:"special_assessments,omitempty"`
+	// MonthlyUtilities holds the value of the "monthly_utilities" field.
+	MonthlyUtilities *decimal.Decimal `json:"monthly_utilities,omitempty"`
 	// Media holds the value of the "media" field.
-	Media []schema.Media `json:"media,omitempty"`
+	Media []schematype.Media `json:"media,omitempty"`
 	// RealtorID holds the value of the "realtor_id" field.
 	RealtorID uuid.UUID `json:"realtor_id,omitempty"`
+	// PropertyID holds the value of the "property_id" field.
+	PropertyID uuid.UUID `json:"property_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// ExpiryReminderSentAt holds the value of the "expiry_reminder_sent_at" field.
+	ExpiryReminderSentAt *time.Time `json:"expiry_reminder_sent_at,omitempty"`
+	// Expired holds the value of the "expired" field.
+	Expired bool `json:"expired,omitempty"`
+	// ListedAt holds the value of the "listed_at" field.
+	ListedAt *time.Time `json:"listed_at,omitempty"`
+	// OnMarketSince holds the value of the "on_market_since" field.
+	OnMarketSince *time.Time `json:"on_market_since,omitempty"`
+	// MarketSeconds holds the value of the "market_seconds" field.
+	MarketSeconds int64 `json:"market_seconds,omitempty"`
+	// SoldPrice holds the value of the "sold_price" field.
+	SoldPrice *decimal.Decimal `json:"sold_price,omitempty"`
+	// CloseDate holds the value of the "close_date" field.
+	CloseDate *time.Time `json:"close_date,omitempty"`
+	// BuyerRealtorID holds the value of the "buyer_realtor_id" field.
+	BuyerRealtorID *uuid.UUID `json:"buyer_realtor_id,omitempty"`
+	// PublishAt holds the value of the "publish_at" field.
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	// UnpublishAt holds the value of the "unpublish_at" field.
+	UnpublishAt *time.Time `json:"unpublish_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ListingQuery when eager-loading is set.
 	Edges        ListingEdges `json:"edges"`
@@ -72,9 +132,27 @@ type Listing struct {
 type ListingEdges struct {
 	// Realtor holds the value of the realtor edge.
 	Realtor *Realtor `json:"realtor,omitempty"`
+	// Property holds the value of the property edge.
+	Property *Property `json:"property,omitempty"`
+	// Neighborhood holds the value of the neighborhood edge.
+	Neighborhood *Neighborhood `json:"neighborhood,omitempty"`
+	// BuyerRealtor holds the value of the buyer_realtor edge.
+	BuyerRealtor *Realtor `json:"buyer_realtor,omitempty"`
+	// Renewals holds the value of the renewals edge.
+	Renewals []*ListingRenewal `json:"renewals,omitempty"`
+	// Documents holds the value of the documents edge.
+	Documents []*ListingDocument `json:"documents,omitempty"`
+	// Inquiries holds the value of the inquiries edge.
+	Inquiries []*ListingInquiry `json:"inquiries,omitempty"`
+	// Reviews holds the value of the reviews edge.
+	Reviews []*ListingReview `json:"reviews,omitempty"`
+	// Offers holds the value of the offers edge.
+	Offers []*Offer `json:"offers,omitempty"`
+	// StatusEvents holds the value of the status_events edge.
+	StatusEvents []*ListingStatusEvent `json:"status_events,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [10]bool
 }
 
 // RealtorOrErr returns the Realtor value or an error if the edge
@@ -88,26 +166,117 @@ func (e ListingEdges) RealtorOrErr() (*Realtor, error) {
 	return nil, &NotLoadedError{edge: "realtor"}
 }
 
+// PropertyOrErr returns the Property value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingEdges) PropertyOrErr() (*Property, error) {
+	if e.Property != nil {
+		return e.Property, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: property.Label}
+	}
+	return nil, &NotLoadedError{edge: "property"}
+}
+
+// NeighborhoodOrErr returns the Neighborhood value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingEdges) NeighborhoodOrErr() (*Neighborhood, error) {
+	if e.Neighborhood != nil {
+		return e.Neighborhood, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: neighborhood.Label}
+	}
+	return nil, &NotLoadedError{edge: "neighborhood"}
+}
+
+// BuyerRealtorOrErr returns the BuyerRealtor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingEdges) BuyerRealtorOrErr() (*Realtor, error) {
+	if e.BuyerRealtor != nil {
+		return e.BuyerRealtor, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: realtor.Label}
+	}
+	return nil, &NotLoadedError{edge: "buyer_realtor"}
+}
+
+// RenewalsOrErr returns the Renewals value or an error if the edge
+// was not loaded in eager-loading.
+func (e ListingEdges) RenewalsOrErr() ([]*ListingRenewal, error) {
+	if e.loadedTypes[4] {
+		return e.Renewals, nil
+	}
+	return nil, &NotLoadedError{edge: "renewals"}
+}
+
+// DocumentsOrErr returns the Documents value or an error if the edge
+// was not loaded in eager-loading.
+func (e ListingEdges) DocumentsOrErr() ([]*ListingDocument, error) {
+	if e.loadedTypes[5] {
+		return e.Documents, nil
+	}
+	return nil, &NotLoadedError{edge: "documents"}
+}
+
+// InquiriesOrErr returns the Inquiries value or an error if the edge
+// was not loaded in eager-loading.
+func (e ListingEdges) InquiriesOrErr() ([]*ListingInquiry, error) {
+	if e.loadedTypes[6] {
+		return e.Inquiries, nil
+	}
+	return nil, &NotLoadedError{edge: "inquiries"}
+}
+
+// ReviewsOrErr returns the Reviews value or an error if the edge
+// was not loaded in eager-loading.
+func (e ListingEdges) ReviewsOrErr() ([]*ListingReview, error) {
+	if e.loadedTypes[7] {
+		return e.Reviews, nil
+	}
+	return nil, &NotLoadedError{edge: "reviews"}
+}
+
+// OffersOrErr returns the Offers value or an error if the edge
+// was not loaded in eager-loading.
+func (e ListingEdges) OffersOrErr() ([]*Offer, error) {
+	if e.loadedTypes[8] {
+		return e.Offers, nil
+	}
+	return nil, &NotLoadedError{edge: "offers"}
+}
+
+// StatusEventsOrErr returns the StatusEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e ListingEdges) StatusEventsOrErr() ([]*ListingStatusEvent, error) {
+	if e.loadedTypes[9] {
+		return e.StatusEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "status_events"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Listing) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case listing.FieldMedia:
+		case listing.FieldAnnualPropertyTax, listing.FieldHoaDues, listing.FieldSpecialAssessments, listing.FieldMonthlyUtilities, listing.FieldSoldPrice:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case listing.FieldBuyerRealtorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case listing.FieldTranslations, listing.FieldMedia:
 			values[i] = new([]byte)
 		case listing.FieldPrice:
 			values[i] = new(decimal.Decimal)
-		case listing.FieldPool:
+		case listing.FieldPool, listing.FieldExpired:
 			values[i] = new(sql.NullBool)
-		case listing.FieldBathroom:
+		case listing.FieldLatitude, listing.FieldLongitude, listing.FieldBathroom:
 			values[i] = new(sql.NullFloat64)
-		case listing.FieldBedroom, listing.FieldGarage, listing.FieldSqft, listing.FieldLotSize, listing.FieldYearBuilt:
+		case listing.FieldBedroom, listing.FieldGarage, listing.FieldSqft, listing.FieldSqm, listing.FieldLotSize, listing.FieldYearBuilt, listing.FieldMarketSeconds, listing.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case listing.FieldTitle, listing.FieldAddress, listing.FieldCity, listing.FieldState, listing.FieldZipCode, listing.FieldDescription, listing.FieldTypeOfProperty, listing.FieldStatus:
+		case listing.FieldTitle, listing.FieldAddress, listing.FieldAddressKey, listing.FieldCity, listing.FieldState, listing.FieldZipCode, listing.FieldCountry, listing.FieldDescription, listing.FieldSourceLocale, listing.FieldCurrency, listing.FieldAreaUnit, listing.FieldTypeOfProperty, listing.FieldStatus, listing.FieldHoaFrequency, listing.FieldHoaName:
 			values[i] = new(sql.NullString)
-		case listing.FieldCreateTime, listing.FieldUpdateTime:
+		case listing.FieldCreateTime, listing.FieldUpdateTime, listing.FieldExpiresAt, listing.FieldExpiryReminderSentAt, listing.FieldListedAt, listing.FieldOnMarketSince, listing.FieldCloseDate, listing.FieldPublishAt, listing.FieldUnpublishAt:
 			values[i] = new(sql.NullTime)
-		case listing.FieldID, listing.FieldRealtorID:
+		case listing.FieldID, listing.FieldNeighborhoodID, listing.FieldRealtorID, listing.FieldPropertyID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -118,7 +287,7 @@ func (*Listing) scanValues(columns []string) ([]any, error) {
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
 // to the Listing fields.
-func (l *Listing) assignValues(columns []string, values []any) error {
+func (_m *Listing) assignValues(columns []string, values []any) error {
 	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
@@ -128,121 +297,226 @@ func (l *Listing) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field id", values[i])
 			} else if value != nil {
-				l.ID = *value
+				_m.ID = *value
 			}
 		case listing.FieldCreateTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field create_time", values[i])
 			} else if value.Valid {
-				l.CreateTime = value.Time
+				_m.CreateTime = value.Time
 			}
 		case listing.FieldUpdateTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field update_time", values[i])
 			} else if value.Valid {
-				l.UpdateTime = value.Time
+				_m.UpdateTime = value.Time
 			}
 		case listing.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
 			} else if value.Valid {
-				l.Title = value.String
+				_m.Title = value.String
 			}
 		case listing.FieldAddress:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field address", values[i])
 			} else if value.Valid {
-				l.Address = value.String
+				_m.Address = value.String
+			}
+		case listing.FieldAddressKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_key", values[i])
+			} else if value.Valid {
+				_m.AddressKey = value.String
 			}
 		case listing.FieldCity:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field city", values[i])
 			} else if value.Valid {
-				l.City = value.String
+				_m.City = value.String
 			}
 		case listing.FieldState:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field state", values[i])
 			} else if value.Valid {
-				l.State = value.String
+				_m.State = value.String
 			}
 		case listing.FieldZipCode:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field zip_code", values[i])
 			} else if value.Valid {
-				l.ZipCode = value.String
+				_m.ZipCode = value.String
+			}
+		case listing.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				_m.Country = value.String
+			}
+		case listing.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				_m.Latitude = new(float64)
+				*_m.Latitude = value.Float64
+			}
+		case listing.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				_m.Longitude = new(float64)
+				*_m.Longitude = value.Float64
+			}
+		case listing.FieldNeighborhoodID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field neighborhood_id", values[i])
+			} else if value != nil {
+				_m.NeighborhoodID = *value
 			}
 		case listing.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
 			} else if value.Valid {
-				l.Description = value.String
+				_m.Description = value.String
+			}
+		case listing.FieldSourceLocale:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_locale", values[i])
+			} else if value.Valid {
+				_m.SourceLocale = value.String
+			}
+		case listing.FieldTranslations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field translations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Translations); err != nil {
+					return fmt.Errorf("unmarshal field translations: %w", err)
+				}
 			}
 		case listing.FieldPrice:
 			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field price", values[i])
 			} else if value != nil {
-				l.Price = *value
+				_m.Price = *value
+			}
+		case listing.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
 			}
 		case listing.FieldBedroom:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field bedroom", values[i])
 			} else if value.Valid {
-				l.Bedroom = int(value.Int64)
+				_m.Bedroom = int(value.Int64)
 			}
 		case listing.FieldBathroom:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field bathroom", values[i])
 			} else if value.Valid {
-				l.Bathroom = value.Float64
+				_m.Bathroom = value.Float64
 			}
 		case listing.FieldGarage:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field garage", values[i])
 			} else if value.Valid {
-				l.Garage = int(value.Int64)
+				_m.Garage = int(value.Int64)
 			}
 		case listing.FieldSqft:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field sqft", values[i])
 			} else if value.Valid {
-				l.Sqft = int(value.Int64)
+				_m.Sqft = int(value.Int64)
+			}
+		case listing.FieldSqm:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sqm", values[i])
+			} else if value.Valid {
+				_m.Sqm = int(value.Int64)
+			}
+		case listing.FieldAreaUnit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field area_unit", values[i])
+			} else if value.Valid {
+				_m.AreaUnit = listing.AreaUnit(value.String)
 			}
 		case listing.FieldTypeOfProperty:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field type_of_property", values[i])
 			} else if value.Valid {
-				l.TypeOfProperty = listing.TypeOfProperty(value.String)
+				_m.TypeOfProperty = listing.TypeOfProperty(value.String)
 			}
 		case listing.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
-				l.Status = listing.Status(value.String)
+				_m.Status = listing.Status(value.String)
 			}
 		case listing.FieldLotSize:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field lot_size", values[i])
 			} else if value.Valid {
-				l.LotSize = int(value.Int64)
+				_m.LotSize = int(value.Int64)
 			}
 		case listing.FieldPool:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field pool", values[i])
 			} else if value.Valid {
-				l.Pool = value.Bool
+				_m.Pool = value.Bool
 			}
 		case listing.FieldYearBuilt:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field year_built", values[i])
 			} else if value.Valid {
-				l.YearBuilt = int(value.Int64)
+				_m.YearBuilt = int(value.Int64)
+			}
+		case listing.FieldAnnualPropertyTax:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field annual_property_tax", values[i])
+			} else if value.Valid {
+				_m.AnnualPropertyTax = new(decimal.Decimal)
+				*_m.AnnualPropertyTax = *value.S.(*decimal.Decimal)
+			}
+		case listing.FieldHoaDues:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field hoa_dues", values[i])
+			} else if value.Valid {
+				_m.HoaDues = new(decimal.Decimal)
+				*_m.HoaDues = *value.S.(*decimal.Decimal)
+			}
+		case listing.FieldHoaFrequency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hoa_frequency", values[i])
+			} else if value.Valid {
+				_m.HoaFrequency = new(listing.HoaFrequency)
+				*_m.HoaFrequency = listing.HoaFrequency(value.String)
+			}
+		case listing.FieldHoaName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hoa_name", values[i])
+			} else if value.Valid {
+				_m.HoaName = value.String
+			}
+		case listing.FieldSpecialAssessments:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field special_assessments", values[i])
+			} else if value.Valid {
+				_m.SpecialAssessments = new(decimal.Decimal)
+				*_m.SpecialAssessments = *value.S.(*decimal.Decimal)
+			}
+		case listing.FieldMonthlyUtilities:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_utilities", values[i])
+			} else if value.Valid {
+				_m.MonthlyUtilities = new(decimal.Decimal)
+				*_m.MonthlyUtilities = *value.S.(*decimal.Decimal)
 			}
 		case listing.FieldMedia:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field media", values[i])
 			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &l.Media); err != nil {
+				if err := json.Unmarshal(*value, &_m.Media); err != nil {
 					return fmt.Errorf("unmarshal field media: %w", err)
 				}
 			}
@@ -250,10 +524,97 @@ func (l *Listing) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field realtor_id", values[i])
 			} else if value != nil {
-				l.RealtorID = *value
+				_m.RealtorID = *value
+			}
+		case listing.FieldPropertyID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field property_id", values[i])
+			} else if value != nil {
+				_m.PropertyID = *value
+			}
+		case listing.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case listing.FieldExpiryReminderSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_reminder_sent_at", values[i])
+			} else if value.Valid {
+				_m.ExpiryReminderSentAt = new(time.Time)
+				*_m.ExpiryReminderSentAt = value.Time
+			}
+		case listing.FieldExpired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field expired", values[i])
+			} else if value.Valid {
+				_m.Expired = value.Bool
+			}
+		case listing.FieldListedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field listed_at", values[i])
+			} else if value.Valid {
+				_m.ListedAt = new(time.Time)
+				*_m.ListedAt = value.Time
+			}
+		case listing.FieldOnMarketSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field on_market_since", values[i])
+			} else if value.Valid {
+				_m.OnMarketSince = new(time.Time)
+				*_m.OnMarketSince = value.Time
+			}
+		case listing.FieldMarketSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field market_seconds", values[i])
+			} else if value.Valid {
+				_m.MarketSeconds = value.Int64
+			}
+		case listing.FieldSoldPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field sold_price", values[i])
+			} else if value.Valid {
+				_m.SoldPrice = new(decimal.Decimal)
+				*_m.SoldPrice = *value.S.(*decimal.Decimal)
+			}
+		case listing.FieldCloseDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field close_date", values[i])
+			} else if value.Valid {
+				_m.CloseDate = new(time.Time)
+				*_m.CloseDate = value.Time
+			}
+		case listing.FieldBuyerRealtorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_realtor_id", values[i])
+			} else if value.Valid {
+				_m.BuyerRealtorID = new(uuid.UUID)
+				*_m.BuyerRealtorID = *value.S.(*uuid.UUID)
+			}
+		case listing.FieldPublishAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field publish_at", values[i])
+			} else if value.Valid {
+				_m.PublishAt = new(time.Time)
+				*_m.PublishAt = value.Time
+			}
+		case listing.FieldUnpublishAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field unpublish_at", values[i])
+			} else if value.Valid {
+				_m.UnpublishAt = new(time.Time)
+				*_m.UnpublishAt = value.Time
+			}
+		case listing.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
 			}
 		default:
-			l.selectValues.Set(columns[i], values[i])
+			_m.selectValues.Set(columns[i], values[i])
 		}
 	}
 	return nil
@@ -261,97 +622,261 @@ func (l *Listing) assignValues(columns []string, values []any) error {
 
 // Value returns the ent.Value that was dynamically selected and assigned to the Listing.
 // This includes values selected through modifiers, order, etc.
-func (l *Listing) Value(name string) (ent.Value, error) {
-	return l.selectValues.Get(name)
+func (_m *Listing) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
 }
 
 // QueryRealtor queries the "realtor" edge of the Listing entity.
-func (l *Listing) QueryRealtor() *RealtorQuery {
-	return NewListingClient(l.config).QueryRealtor(l)
+func (_m *Listing) QueryRealtor() *RealtorQuery {
+	return NewListingClient(_m.config).QueryRealtor(_m)
+}
+
+// QueryProperty queries the "property" edge of the Listing entity.
+func (_m *Listing) QueryProperty() *PropertyQuery {
+	return NewListingClient(_m.config).QueryProperty(_m)
+}
+
+// QueryNeighborhood queries the "neighborhood" edge of the Listing entity.
+func (_m *Listing) QueryNeighborhood() *NeighborhoodQuery {
+	return NewListingClient(_m.config).QueryNeighborhood(_m)
+}
+
+// QueryBuyerRealtor queries the "buyer_realtor" edge of the Listing entity.
+func (_m *Listing) QueryBuyerRealtor() *RealtorQuery {
+	return NewListingClient(_m.config).QueryBuyerRealtor(_m)
+}
+
+// QueryRenewals queries the "renewals" edge of the Listing entity.
+func (_m *Listing) QueryRenewals() *ListingRenewalQuery {
+	return NewListingClient(_m.config).QueryRenewals(_m)
+}
+
+// QueryDocuments queries the "documents" edge of the Listing entity.
+func (_m *Listing) QueryDocuments() *ListingDocumentQuery {
+	return NewListingClient(_m.config).QueryDocuments(_m)
+}
+
+// QueryInquiries queries the "inquiries" edge of the Listing entity.
+func (_m *Listing) QueryInquiries() *ListingInquiryQuery {
+	return NewListingClient(_m.config).QueryInquiries(_m)
+}
+
+// QueryReviews queries the "reviews" edge of the Listing entity.
+func (_m *Listing) QueryReviews() *ListingReviewQuery {
+	return NewListingClient(_m.config).QueryReviews(_m)
+}
+
+// QueryOffers queries the "offers" edge of the Listing entity.
+func (_m *Listing) QueryOffers() *OfferQuery {
+	return NewListingClient(_m.config).QueryOffers(_m)
+}
+
+// QueryStatusEvents queries the "status_events" edge of the Listing entity.
+func (_m *Listing) QueryStatusEvents() *ListingStatusEventQuery {
+	return NewListingClient(_m.config).QueryStatusEvents(_m)
 }
 
 // Update returns a builder for updating this Listing.
 // Note that you need to call Listing.Unwrap() before calling this method if this Listing
 // was returned from a transaction, and the transaction was committed or rolled back.
-func (l *Listing) Update() *ListingUpdateOne {
-	return NewListingClient(l.config).UpdateOne(l)
+func (_m *Listing) Update() *ListingUpdateOne {
+	return NewListingClient(_m.config).UpdateOne(_m)
 }
 
 // Unwrap unwraps the Listing entity that was returned from a transaction after it was closed,
 // so that all future queries will be executed through the driver which created the transaction.
-func (l *Listing) Unwrap() *Listing {
-	_tx, ok := l.config.driver.(*txDriver)
+func (_m *Listing) Unwrap() *Listing {
+	_tx, ok := _m.config.driver.(*txDriver)
 	if !ok {
 		panic("ent: Listing is not a transactional entity")
 	}
-	l.config.driver = _tx.drv
-	return l
+	_m.config.driver = _tx.drv
+	return _m
 }
 
 // String implements the fmt.Stringer.
-func (l *Listing) String() string {
+func (_m *Listing) String() string {
 	var builder strings.Builder
 	builder.WriteString("Listing(")
-	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("create_time=")
-	builder.WriteString(l.CreateTime.Format(time.ANSIC))
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("update_time=")
-	builder.WriteString(l.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("title=")
-	builder.WriteString(l.Title)
+	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
 	builder.WriteString("address=")
-	builder.WriteString(l.Address)
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("address_key=")
+	builder.WriteString(_m.AddressKey)
 	builder.WriteString(", ")
 	builder.WriteString("city=")
-	builder.WriteString(l.City)
+	builder.WriteString(_m.City)
 	builder.WriteString(", ")
 	builder.WriteString("state=")
-	builder.WriteString(l.State)
+	builder.WriteString(_m.State)
 	builder.WriteString(", ")
 	builder.WriteString("zip_code=")
-	builder.WriteString(l.ZipCode)
+	builder.WriteString(_m.ZipCode)
+	builder.WriteString(", ")
+	builder.WriteString("country=")
+	builder.WriteString(_m.Country)
+	builder.WriteString(", ")
+	if v := _m.Latitude; v != nil {
+		builder.WriteString("latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Longitude; v != nil {
+		builder.WriteString("longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("neighborhood_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NeighborhoodID))
 	builder.WriteString(", ")
 	builder.WriteString("description=")
-	builder.WriteString(l.Description)
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("source_locale=")
+	builder.WriteString(_m.SourceLocale)
+	builder.WriteString(", ")
+	builder.WriteString("translations=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Translations))
 	builder.WriteString(", ")
 	builder.WriteString("price=")
-	builder.WriteString(fmt.Sprintf("%v", l.Price))
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
 	builder.WriteString(", ")
 	builder.WriteString("bedroom=")
-	builder.WriteString(fmt.Sprintf("%v", l.Bedroom))
+	builder.WriteString(fmt.Sprintf("%v", _m.Bedroom))
 	builder.WriteString(", ")
 	builder.WriteString("bathroom=")
-	builder.WriteString(fmt.Sprintf("%v", l.Bathroom))
+	builder.WriteString(fmt.Sprintf("%v", _m.Bathroom))
 	builder.WriteString(", ")
 	builder.WriteString("garage=")
-	builder.WriteString(fmt.Sprintf("%v", l.Garage))
+	builder.WriteString(fmt.Sprintf("%v", _m.Garage))
 	builder.WriteString(", ")
 	builder.WriteString("sqft=")
-	builder.WriteString(fmt.Sprintf("%v", l.Sqft))
+	builder.WriteString(fmt.Sprintf("%v", _m.Sqft))
+	builder.WriteString(", ")
+	builder.WriteString("sqm=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sqm))
+	builder.WriteString(", ")
+	builder.WriteString("area_unit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AreaUnit))
 	builder.WriteString(", ")
 	builder.WriteString("type_of_property=")
-	builder.WriteString(fmt.Sprintf("%v", l.TypeOfProperty))
+	builder.WriteString(fmt.Sprintf("%v", _m.TypeOfProperty))
 	builder.WriteString(", ")
 	builder.WriteString("status=")
-	builder.WriteString(fmt.Sprintf("%v", l.Status))
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("lot_size=")
-	builder.WriteString(fmt.Sprintf("%v", l.LotSize))
+	builder.WriteString(fmt.Sprintf("%v", _m.LotSize))
 	builder.WriteString(", ")
 	builder.WriteString("pool=")
-	builder.WriteString(fmt.Sprintf("%v", l.Pool))
+	builder.WriteString(fmt.Sprintf("%v", _m.Pool))
 	builder.WriteString(", ")
 	builder.WriteString("year_built=")
-	builder.WriteString(fmt.Sprintf("%v", l.YearBuilt))
+	builder.WriteString(fmt.Sprintf("%v", _m.YearBuilt))
+	builder.WriteString(", ")
+	if v := _m.AnnualPropertyTax; v != nil {
+		builder.WriteString("annual_property_tax=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.HoaDues; v != nil {
+		builder.WriteString("hoa_dues=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.HoaFrequency; v != nil {
+		builder.WriteString("hoa_frequency=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("hoa_name=")
+	builder.WriteString(_m.HoaName)
+	builder.WriteString(", ")
+	if v := _m.SpecialAssessments; v != nil {
+		builder.WriteString("special_assessments=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MonthlyUtilities; v != nil {
+		builder.WriteString("monthly_utilities=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("media=")
-	builder.WriteString(fmt.Sprintf("%v", l.Media))
+	builder.WriteString(fmt.Sprintf("%v", _m.Media))
 	builder.WriteString(", ")
 	builder.WriteString("realtor_id=")
-	builder.WriteString(fmt.Sprintf("%v", l.RealtorID))
+	builder.WriteString(fmt.Sprintf("%v", _m.RealtorID))
+	builder.WriteString(", ")
+	builder.WriteString("property_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PropertyID))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiryReminderSentAt; v != nil {
+		builder.WriteString("expiry_reminder_sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expired=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Expired))
+	builder.WriteString(", ")
+	if v := _m.ListedAt; v != nil {
+		builder.WriteString("listed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.OnMarketSince; v != nil {
+		builder.WriteString("on_market_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("market_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MarketSeconds))
+	builder.WriteString(", ")
+	if v := _m.SoldPrice; v != nil {
+		builder.WriteString("sold_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CloseDate; v != nil {
+		builder.WriteString("close_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.BuyerRealtorID; v != nil {
+		builder.WriteString("buyer_realtor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PublishAt; v != nil {
+		builder.WriteString("publish_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UnpublishAt; v != nil {
+		builder.WriteString("unpublish_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -24,16 +25,32 @@ const (
 	FieldTitle = "title"
 	// FieldAddress holds the string denoting the address field in the database.
 	FieldAddress = "address"
+	// FieldAddressKey holds the string denoting the address_key field in the database.
+	FieldAddressKey = "address_key"
 	// FieldCity holds the string denoting the city field in the database.
 	FieldCity = "city"
 	// FieldState holds the string denoting the state field in the database.
 	FieldState = "state"
 	// FieldZipCode holds the string denoting the zip_code field in the database.
 	FieldZipCode = "zip_code"
+	// FieldCountry holds the string denoting the country field in the database.
+	FieldCountry = "country"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
+	// FieldNeighborhoodID holds the string denoting the neighborhood_id field in the database.
+	FieldNeighborhoodID = "neighborhood_id"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldSourceLocale holds the string denoting the source_locale field in the database.
+	FieldSourceLocale = "source_locale"
+	// FieldTranslations holds the string denoting the translations field in the database.
+	FieldTranslations = "translations"
 	// FieldPrice holds the string denoting the price field in the database.
 	FieldPrice = "price"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// FieldBedroom holds the string denoting the bedroom field in the database.
 	FieldBedroom = "bedroom"
 	// FieldBathroom holds the string denoting the bathroom field in the database.
@@ -42,6 +59,10 @@ const (
 	FieldGarage = "garage"
 	// FieldSqft holds the string denoting the sqft field in the database.
 	FieldSqft = "sqft"
+	// FieldSqm holds the string denoting the sqm field in the database.
+	FieldSqm = "sqm"
+	// FieldAreaUnit holds the string denoting the area_unit field in the database.
+	FieldAreaUnit = "area_unit"
 	// FieldTypeOfProperty holds the string denoting the type_of_property field in the database.
 	FieldTypeOfProperty = "type_of_property"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -52,12 +73,68 @@ const (
 	FieldPool = "pool"
 	// FieldYearBuilt holds the string denoting the year_built field in the database.
 	FieldYearBuilt = "year_built"
+	// FieldAnnualPropertyTax holds the string denoting the annual_property_tax field in the database.
+	FieldAnnualPropertyTax = "annual_property_tax"
+	// FieldHoaDues holds the string denoting the hoa_dues field in the database.
+	FieldHoaDues = "hoa_dues"
+	// FieldHoaFrequency holds the string denoting the hoa_frequency field in the database.
+	FieldHoaFrequency = "hoa_frequency"
+	// FieldHoaName holds the string denoting the hoa_name field in the database.
+	FieldHoaName = "hoa_name"
+	// FieldSpecialAssessments holds the string denoting the special_assessments field in the database.
+	FieldSpecialAssessments = "special_assessments"
+	// FieldMonthlyUtilities holds the string denoting the monthly_utilities field in the database.
+	FieldMonthlyUtilities = "monthly_utilities"
 	// FieldMedia holds the string denoting the media field in the database.
 	FieldMedia = "media"
 	// FieldRealtorID holds the string denoting the realtor_id field in the database.
 	FieldRealtorID = "realtor_id"
+	// FieldPropertyID holds the string denoting the property_id field in the database.
+	FieldPropertyID = "property_id"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldExpiryReminderSentAt holds the string denoting the expiry_reminder_sent_at field in the database.
+	FieldExpiryReminderSentAt = "expiry_reminder_sent_at"
+	// FieldExpired holds the string denoting the expired field in the database.
+	FieldExpired = "expired"
+	// FieldListedAt holds the string denoting the listed_at field in the database.
+	FieldListedAt = "listed_at"
+	// FieldOnMarketSince holds the string denoting the on_market_since field in the database.
+	FieldOnMarketSince = "on_market_since"
+	// FieldMarketSeconds holds the string denoting the market_seconds field in the database.
+	FieldMarketSeconds = "market_seconds"
+	// FieldSoldPrice holds the string denoting the sold_price field in the database.
+	FieldSoldPrice = "sold_price"
+	// FieldCloseDate holds the string denoting the close_date field in the database.
+	FieldCloseDate = "close_date"
+	// FieldBuyerRealtorID holds the string denoting the buyer_realtor_id field in the database.
+	FieldBuyerRealtorID = "buyer_realtor_id"
+	// FieldPublishAt holds the string denoting the publish_at field in the database.
+	FieldPublishAt = "publish_at"
+	// FieldUnpublishAt holds the string denoting the unpublish_at field in the database.
+	FieldUnpublishAt = "unpublish_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// EdgeRealtor holds the string denoting the realtor edge name in mutations.
 	EdgeRealtor = "realtor"
+	// EdgeProperty holds the string denoting the property edge name in mutations.
+	EdgeProperty = "property"
+	// EdgeNeighborhood holds the string denoting the neighborhood edge name in mutations.
+	EdgeNeighborhood = "neighborhood"
+	// EdgeBuyerRealtor holds the string denoting the buyer_realtor edge name in mutations.
+	EdgeBuyerRealtor = "buyer_realtor"
+	// EdgeRenewals holds the string denoting the renewals edge name in mutations.
+	EdgeRenewals = "renewals"
+	// EdgeDocuments holds the string denoting the documents edge name in mutations.
+	EdgeDocuments = "documents"
+	// EdgeInquiries holds the string denoting the inquiries edge name in mutations.
+	EdgeInquiries = "inquiries"
+	// EdgeReviews holds the string denoting the reviews edge name in mutations.
+	EdgeReviews = "reviews"
+	// EdgeOffers holds the string denoting the offers edge name in mutations.
+	EdgeOffers = "offers"
+	// EdgeStatusEvents holds the string denoting the status_events edge name in mutations.
+	EdgeStatusEvents = "status_events"
 	// Table holds the table name of the listing in the database.
 	Table = "listings"
 	// RealtorTable is the table that holds the realtor relation/edge.
@@ -67,6 +144,69 @@ const (
 	RealtorInverseTable = "realtors"
 	// RealtorColumn is the table column denoting the realtor relation/edge.
 	RealtorColumn = "realtor_id"
+	// PropertyTable is the table that holds the property relation/edge.
+	PropertyTable = "listings"
+	// PropertyInverseTable is the table name for the Property entity.
+	// It exists in this package in order to avoid circular dependency with the "property" package.
+	PropertyInverseTable = "properties"
+	// PropertyColumn is the table column denoting the property relation/edge.
+	PropertyColumn = "property_id"
+	// NeighborhoodTable is the table that holds the neighborhood relation/edge.
+	NeighborhoodTable = "listings"
+	// NeighborhoodInverseTable is the table name for the Neighborhood entity.
+	// It exists in this package in order to avoid circular dependency with the "neighborhood" package.
+	NeighborhoodInverseTable = "neighborhoods"
+	// NeighborhoodColumn is the table column denoting the neighborhood relation/edge.
+	NeighborhoodColumn = "neighborhood_id"
+	// BuyerRealtorTable is the table that holds the buyer_realtor relation/edge.
+	BuyerRealtorTable = "listings"
+	// BuyerRealtorInverseTable is the table name for the Realtor entity.
+	// It exists in this package in order to avoid circular dependency with the "realtor" package.
+	BuyerRealtorInverseTable = "realtors"
+	// BuyerRealtorColumn is the table column denoting the buyer_realtor relation/edge.
+	BuyerRealtorColumn = "buyer_realtor_id"
+	// RenewalsTable is the table that holds the renewals relation/edge.
+	RenewalsTable = "listing_renewals"
+	// RenewalsInverseTable is the table name for the ListingRenewal entity.
+	// It exists in this package in order to avoid circular dependency with the "listingrenewal" package.
+	RenewalsInverseTable = "listing_renewals"
+	// RenewalsColumn is the table column denoting the renewals relation/edge.
+	RenewalsColumn = "listing_id"
+	// DocumentsTable is the table that holds the documents relation/edge.
+	DocumentsTable = "listing_documents"
+	// DocumentsInverseTable is the table name for the ListingDocument entity.
+	// It exists in this package in order to avoid circular dependency with the "listingdocument" package.
+	DocumentsInverseTable = "listing_documents"
+	// DocumentsColumn is the table column denoting the documents relation/edge.
+	DocumentsColumn = "listing_id"
+	// InquiriesTable is the table that holds the inquiries relation/edge.
+	InquiriesTable = "listing_inquiries"
+	// InquiriesInverseTable is the table name for the ListingInquiry entity.
+	// It exists in this package in order to avoid circular dependency with the "listinginquiry" package.
+	InquiriesInverseTable = "listing_inquiries"
+	// InquiriesColumn is the table column denoting the inquiries relation/edge.
+	InquiriesColumn = "listing_id"
+	// ReviewsTable is the table that holds the reviews relation/edge.
+	ReviewsTable = "listing_reviews"
+	// ReviewsInverseTable is the table name for the ListingReview entity.
+	// It exists in this package in order to avoid circular dependency with the "listingreview" package.
+	ReviewsInverseTable = "listing_reviews"
+	// ReviewsColumn is the table column denoting the reviews relation/edge.
+	ReviewsColumn = "listing_id"
+	// OffersTable is the table that holds the offers relation/edge.
+	OffersTable = "offers"
+	// OffersInverseTable is the table name for the Offer entity.
+	// It exists in this package in order to avoid circular dependency with the "offer" package.
+	OffersInverseTable = "offers"
+	// OffersColumn is the table column denoting the offers relation/edge.
+	OffersColumn = "listing_id"
+	// StatusEventsTable is the table that holds the status_events relation/edge.
+	StatusEventsTable = "listing_status_events"
+	// StatusEventsInverseTable is the table name for the ListingStatusEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "listingstatusevent" package.
+	StatusEventsInverseTable = "listing_status_events"
+	// StatusEventsColumn is the table column denoting the status_events relation/edge.
+	StatusEventsColumn = "listing_id"
 )
 
 // Columns holds all SQL columns for listing fields.
@@ -76,22 +216,51 @@ var Columns = []string{
 	FieldUpdateTime,
 	FieldTitle,
 	FieldAddress,
+	FieldAddressKey,
 	FieldCity,
 	FieldState,
 	FieldZipCode,
+	FieldCountry,
+	FieldLatitude,
+	FieldLongitude,
+	FieldNeighborhoodID,
 	FieldDescription,
+	FieldSourceLocale,
+	FieldTranslations,
 	FieldPrice,
+	FieldCurrency,
 	FieldBedroom,
 	FieldBathroom,
 	FieldGarage,
 	FieldSqft,
+	FieldSqm,
+	FieldAreaUnit,
 	FieldTypeOfProperty,
 	FieldStatus,
 	FieldLotSize,
 	FieldPool,
 	FieldYearBuilt,
+	FieldAnnualPropertyTax,
+	FieldHoaDues,
+	FieldHoaFrequency,
+	FieldHoaName,
+	FieldSpecialAssessments,
+	FieldMonthlyUtilities,
 	FieldMedia,
 	FieldRealtorID,
+	FieldPropertyID,
+	FieldExpiresAt,
+	FieldExpiryReminderSentAt,
+	FieldExpired,
+	FieldListedAt,
+	FieldOnMarketSince,
+	FieldMarketSeconds,
+	FieldSoldPrice,
+	FieldCloseDate,
+	FieldBuyerRealtorID,
+	FieldPublishAt,
+	FieldUnpublishAt,
+	FieldVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -104,7 +273,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "ppgroup.ppgroup.com/ent/runtime"
 var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -115,12 +291,30 @@ var (
 	TitleValidator func(string) error
 	// AddressValidator is a validator for the "address" field. It is called by the builders before save.
 	AddressValidator func(string) error
+	// AddressKeyValidator is a validator for the "address_key" field. It is called by the builders before save.
+	AddressKeyValidator func(string) error
 	// CityValidator is a validator for the "city" field. It is called by the builders before save.
 	CityValidator func(string) error
 	// StateValidator is a validator for the "state" field. It is called by the builders before save.
 	StateValidator func(string) error
 	// ZipCodeValidator is a validator for the "zip_code" field. It is called by the builders before save.
 	ZipCodeValidator func(string) error
+	// DefaultCountry holds the default value on creation for the "country" field.
+	DefaultCountry string
+	// CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	CountryValidator func(string) error
+	// LatitudeValidator is a validator for the "latitude" field. It is called by the builders before save.
+	LatitudeValidator func(float64) error
+	// LongitudeValidator is a validator for the "longitude" field. It is called by the builders before save.
+	LongitudeValidator func(float64) error
+	// DefaultSourceLocale holds the default value on creation for the "source_locale" field.
+	DefaultSourceLocale string
+	// SourceLocaleValidator is a validator for the "source_locale" field. It is called by the builders before save.
+	SourceLocaleValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
 	// BedroomValidator is a validator for the "bedroom" field. It is called by the builders before save.
 	BedroomValidator func(int) error
 	// BathroomValidator is a validator for the "bathroom" field. It is called by the builders before save.
@@ -129,14 +323,54 @@ var (
 	GarageValidator func(int) error
 	// SqftValidator is a validator for the "sqft" field. It is called by the builders before save.
 	SqftValidator func(int) error
+	// SqmValidator is a validator for the "sqm" field. It is called by the builders before save.
+	SqmValidator func(int) error
 	// LotSizeValidator is a validator for the "lot_size" field. It is called by the builders before save.
 	LotSizeValidator func(int) error
 	// YearBuiltValidator is a validator for the "year_built" field. It is called by the builders before save.
 	YearBuiltValidator func(int) error
+	// HoaNameValidator is a validator for the "hoa_name" field. It is called by the builders before save.
+	HoaNameValidator func(string) error
+	// DefaultExpired holds the default value on creation for the "expired" field.
+	DefaultExpired bool
+	// DefaultMarketSeconds holds the default value on creation for the "market_seconds" field.
+	DefaultMarketSeconds int64
+	// MarketSecondsValidator is a validator for the "market_seconds" field. It is called by the builders before save.
+	MarketSecondsValidator func(int64) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// AreaUnit defines the type for the "area_unit" enum field.
+type AreaUnit string
+
+// AreaUnitSqft is the default value of the AreaUnit enum.
+const DefaultAreaUnit = AreaUnitSqft
+
+// AreaUnit values.
+const (
+	AreaUnitSqft AreaUnit = "sqft"
+	AreaUnitSqm  AreaUnit = "sqm"
+)
+
+func (au AreaUnit) String() string {
+	return string(au)
+}
+
+// AreaUnitValidator is a validator for the "area_unit" field enum values. It is called by the builders before save.
+func AreaUnitValidator(au AreaUnit) error {
+	switch au {
+	case AreaUnitSqft, AreaUnitSqm:
+		return nil
+	default:
+		return fmt.Errorf("listing: invalid enum value for area_unit field: %q", au)
+	}
+}
+
 // TypeOfProperty defines the type for the "type_of_property" enum field.
 type TypeOfProperty string
 
@@ -174,7 +408,11 @@ const DefaultStatus = StatusDRAFT
 // Status values.
 const (
 	StatusDRAFT     Status = "DRAFT"
+	StatusIN_REVIEW Status = "IN_REVIEW"
+	StatusSCHEDULED Status = "SCHEDULED"
 	StatusPUBLISHED Status = "PUBLISHED"
+	StatusPENDING   Status = "PENDING"
+	StatusSOLD      Status = "SOLD"
 	StatusARCHIVED  Status = "ARCHIVED"
 )
 
@@ -185,13 +423,37 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusDRAFT, StatusPUBLISHED, StatusARCHIVED:
+	case StatusDRAFT, StatusIN_REVIEW, StatusSCHEDULED, StatusPUBLISHED, StatusPENDING, StatusSOLD, StatusARCHIVED:
 		return nil
 	default:
 		return fmt.Errorf("listing: invalid enum value for status field: %q", s)
 	}
 }
 
+// HoaFrequency defines the type for the "hoa_frequency" enum field.
+type HoaFrequency string
+
+// HoaFrequency values.
+const (
+	HoaFrequencyMONTHLY   HoaFrequency = "MONTHLY"
+	HoaFrequencyQUARTERLY HoaFrequency = "QUARTERLY"
+	HoaFrequencyANNUALLY  HoaFrequency = "ANNUALLY"
+)
+
+func (hf HoaFrequency) String() string {
+	return string(hf)
+}
+
+// HoaFrequencyValidator is a validator for the "hoa_frequency" field enum values. It is called by the builders before save.
+func HoaFrequencyValidator(hf HoaFrequency) error {
+	switch hf {
+	case HoaFrequencyMONTHLY, HoaFrequencyQUARTERLY, HoaFrequencyANNUALLY:
+		return nil
+	default:
+		return fmt.Errorf("listing: invalid enum value for hoa_frequency field: %q", hf)
+	}
+}
+
 // OrderOption defines the ordering options for the Listing queries.
 type OrderOption func(*sql.Selector)
 
@@ -220,6 +482,11 @@ func ByAddress(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAddress, opts...).ToFunc()
 }
 
+// ByAddressKey orders the results by the address_key field.
+func ByAddressKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressKey, opts...).ToFunc()
+}
+
 // ByCity orders the results by the city field.
 func ByCity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCity, opts...).ToFunc()
@@ -235,16 +502,46 @@ func ByZipCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldZipCode, opts...).ToFunc()
 }
 
+// ByCountry orders the results by the country field.
+func ByCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountry, opts...).ToFunc()
+}
+
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
+// ByNeighborhoodID orders the results by the neighborhood_id field.
+func ByNeighborhoodID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNeighborhoodID, opts...).ToFunc()
+}
+
 // ByDescription orders the results by the description field.
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// BySourceLocale orders the results by the source_locale field.
+func BySourceLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceLocale, opts...).ToFunc()
+}
+
 // ByPrice orders the results by the price field.
 func ByPrice(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPrice, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
 // ByBedroom orders the results by the bedroom field.
 func ByBedroom(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBedroom, opts...).ToFunc()
@@ -265,6 +562,16 @@ func BySqft(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSqft, opts...).ToFunc()
 }
 
+// BySqm orders the results by the sqm field.
+func BySqm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSqm, opts...).ToFunc()
+}
+
+// ByAreaUnit orders the results by the area_unit field.
+func ByAreaUnit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAreaUnit, opts...).ToFunc()
+}
+
 // ByTypeOfProperty orders the results by the type_of_property field.
 func ByTypeOfProperty(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTypeOfProperty, opts...).ToFunc()
@@ -290,17 +597,217 @@ func ByYearBuilt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldYearBuilt, opts...).ToFunc()
 }
 
+// ByAnnualPropertyTax orders the results by the annual_property_tax field.
+func ByAnnualPropertyTax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnualPropertyTax, opts...).ToFunc()
+}
+
+// ByHoaDues orders the results by the hoa_dues field.
+func ByHoaDues(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHoaDues, opts...).ToFunc()
+}
+
+// ByHoaFrequency orders the results by the hoa_frequency field.
+func ByHoaFrequency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHoaFrequency, opts...).ToFunc()
+}
+
+// ByHoaName orders the results by the hoa_name field.
+func ByHoaName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHoaName, opts...).ToFunc()
+}
+
+// BySpecialAssessments orders the results by the special_assessments field.
+func BySpecialAssessments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpecialAssessments, opts...).ToFunc()
+}
+
+// ByMonthlyUtilities orders the results by the monthly_utilities field.
+func ByMonthlyUtilities(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyUtilities, opts...).ToFunc()
+}
+
 // ByRealtorID orders the results by the realtor_id field.
 func ByRealtorID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRealtorID, opts...).ToFunc()
 }
 
+// ByPropertyID orders the results by the property_id field.
+func ByPropertyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPropertyID, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByExpiryReminderSentAt orders the results by the expiry_reminder_sent_at field.
+func ByExpiryReminderSentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiryReminderSentAt, opts...).ToFunc()
+}
+
+// ByExpired orders the results by the expired field.
+func ByExpired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpired, opts...).ToFunc()
+}
+
+// ByListedAt orders the results by the listed_at field.
+func ByListedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListedAt, opts...).ToFunc()
+}
+
+// ByOnMarketSince orders the results by the on_market_since field.
+func ByOnMarketSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnMarketSince, opts...).ToFunc()
+}
+
+// ByMarketSeconds orders the results by the market_seconds field.
+func ByMarketSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMarketSeconds, opts...).ToFunc()
+}
+
+// BySoldPrice orders the results by the sold_price field.
+func BySoldPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoldPrice, opts...).ToFunc()
+}
+
+// ByCloseDate orders the results by the close_date field.
+func ByCloseDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCloseDate, opts...).ToFunc()
+}
+
+// ByBuyerRealtorID orders the results by the buyer_realtor_id field.
+func ByBuyerRealtorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyerRealtorID, opts...).ToFunc()
+}
+
+// ByPublishAt orders the results by the publish_at field.
+func ByPublishAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishAt, opts...).ToFunc()
+}
+
+// ByUnpublishAt orders the results by the unpublish_at field.
+func ByUnpublishAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnpublishAt, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByRealtorField orders the results by realtor field.
 func ByRealtorField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newRealtorStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByPropertyField orders the results by property field.
+func ByPropertyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPropertyStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByNeighborhoodField orders the results by neighborhood field.
+func ByNeighborhoodField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNeighborhoodStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBuyerRealtorField orders the results by buyer_realtor field.
+func ByBuyerRealtorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBuyerRealtorStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRenewalsCount orders the results by renewals count.
+func ByRenewalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRenewalsStep(), opts...)
+	}
+}
+
+// ByRenewals orders the results by renewals terms.
+func ByRenewals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRenewalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDocumentsCount orders the results by documents count.
+func ByDocumentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDocumentsStep(), opts...)
+	}
+}
+
+// ByDocuments orders the results by documents terms.
+func ByDocuments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDocumentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInquiriesCount orders the results by inquiries count.
+func ByInquiriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInquiriesStep(), opts...)
+	}
+}
+
+// ByInquiries orders the results by inquiries terms.
+func ByInquiries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInquiriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReviewsCount orders the results by reviews count.
+func ByReviewsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReviewsStep(), opts...)
+	}
+}
+
+// ByReviews orders the results by reviews terms.
+func ByReviews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOffersCount orders the results by offers count.
+func ByOffersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOffersStep(), opts...)
+	}
+}
+
+// ByOffers orders the results by offers terms.
+func ByOffers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOffersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatusEventsCount orders the results by status_events count.
+func ByStatusEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatusEventsStep(), opts...)
+	}
+}
+
+// ByStatusEvents orders the results by status_events terms.
+func ByStatusEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatusEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRealtorStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -308,3 +815,66 @@ func newRealtorStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, RealtorTable, RealtorColumn),
 	)
 }
+func newPropertyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PropertyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PropertyTable, PropertyColumn),
+	)
+}
+func newNeighborhoodStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NeighborhoodInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, NeighborhoodTable, NeighborhoodColumn),
+	)
+}
+func newBuyerRealtorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BuyerRealtorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BuyerRealtorTable, BuyerRealtorColumn),
+	)
+}
+func newRenewalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RenewalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RenewalsTable, RenewalsColumn),
+	)
+}
+func newDocumentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DocumentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DocumentsTable, DocumentsColumn),
+	)
+}
+func newInquiriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InquiriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InquiriesTable, InquiriesColumn),
+	)
+}
+func newReviewsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
+	)
+}
+func newOffersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OffersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OffersTable, OffersColumn),
+	)
+}
+func newStatusEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatusEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatusEventsTable, StatusEventsColumn),
+	)
+}
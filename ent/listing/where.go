@@ -77,6 +77,11 @@ func Address(v string) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldAddress, v))
 }
 
+// AddressKey applies equality check predicate on the "address_key" field. It's identical to AddressKeyEQ.
+func AddressKey(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldAddressKey, v))
+}
+
 // City applies equality check predicate on the "city" field. It's identical to CityEQ.
 func City(v string) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldCity, v))
@@ -92,16 +97,46 @@ func ZipCode(v string) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldZipCode, v))
 }
 
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldCountry, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldLongitude, v))
+}
+
+// NeighborhoodID applies equality check predicate on the "neighborhood_id" field. It's identical to NeighborhoodIDEQ.
+func NeighborhoodID(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldNeighborhoodID, v))
+}
+
 // Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
 func Description(v string) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldDescription, v))
 }
 
+// SourceLocale applies equality check predicate on the "source_locale" field. It's identical to SourceLocaleEQ.
+func SourceLocale(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldSourceLocale, v))
+}
+
 // Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
 func Price(v decimal.Decimal) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldPrice, v))
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldCurrency, v))
+}
+
 // Bedroom applies equality check predicate on the "bedroom" field. It's identical to BedroomEQ.
 func Bedroom(v int) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldBedroom, v))
@@ -122,6 +157,11 @@ func Sqft(v int) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldSqft, v))
 }
 
+// Sqm applies equality check predicate on the "sqm" field. It's identical to SqmEQ.
+func Sqm(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldSqm, v))
+}
+
 // LotSize applies equality check predicate on the "lot_size" field. It's identical to LotSizeEQ.
 func LotSize(v int) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldLotSize, v))
@@ -137,11 +177,101 @@ func YearBuilt(v int) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldYearBuilt, v))
 }
 
+// AnnualPropertyTax applies equality check predicate on the "annual_property_tax" field. It's identical to AnnualPropertyTaxEQ.
+func AnnualPropertyTax(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldAnnualPropertyTax, v))
+}
+
+// HoaDues applies equality check predicate on the "hoa_dues" field. It's identical to HoaDuesEQ.
+func HoaDues(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldHoaDues, v))
+}
+
+// HoaName applies equality check predicate on the "hoa_name" field. It's identical to HoaNameEQ.
+func HoaName(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldHoaName, v))
+}
+
+// SpecialAssessments applies equality check predicate on the "special_assessments" field. It's identical to SpecialAssessmentsEQ.
+func SpecialAssessments(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldSpecialAssessments, v))
+}
+
+// MonthlyUtilities applies equality check predicate on the "monthly_utilities" field. It's identical to MonthlyUtilitiesEQ.
+func MonthlyUtilities(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldMonthlyUtilities, v))
+}
+
 // RealtorID applies equality check predicate on the "realtor_id" field. It's identical to RealtorIDEQ.
 func RealtorID(v uuid.UUID) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldRealtorID, v))
 }
 
+// PropertyID applies equality check predicate on the "property_id" field. It's identical to PropertyIDEQ.
+func PropertyID(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldPropertyID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiryReminderSentAt applies equality check predicate on the "expiry_reminder_sent_at" field. It's identical to ExpiryReminderSentAtEQ.
+func ExpiryReminderSentAt(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldExpiryReminderSentAt, v))
+}
+
+// Expired applies equality check predicate on the "expired" field. It's identical to ExpiredEQ.
+func Expired(v bool) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldExpired, v))
+}
+
+// ListedAt applies equality check predicate on the "listed_at" field. It's identical to ListedAtEQ.
+func ListedAt(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldListedAt, v))
+}
+
+// OnMarketSince applies equality check predicate on the "on_market_since" field. It's identical to OnMarketSinceEQ.
+func OnMarketSince(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldOnMarketSince, v))
+}
+
+// MarketSeconds applies equality check predicate on the "market_seconds" field. It's identical to MarketSecondsEQ.
+func MarketSeconds(v int64) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldMarketSeconds, v))
+}
+
+// SoldPrice applies equality check predicate on the "sold_price" field. It's identical to SoldPriceEQ.
+func SoldPrice(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldSoldPrice, v))
+}
+
+// CloseDate applies equality check predicate on the "close_date" field. It's identical to CloseDateEQ.
+func CloseDate(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldCloseDate, v))
+}
+
+// BuyerRealtorID applies equality check predicate on the "buyer_realtor_id" field. It's identical to BuyerRealtorIDEQ.
+func BuyerRealtorID(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldBuyerRealtorID, v))
+}
+
+// PublishAt applies equality check predicate on the "publish_at" field. It's identical to PublishAtEQ.
+func PublishAt(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldPublishAt, v))
+}
+
+// UnpublishAt applies equality check predicate on the "unpublish_at" field. It's identical to UnpublishAtEQ.
+func UnpublishAt(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldUnpublishAt, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldVersion, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldCreateTime, v))
@@ -352,6 +482,81 @@ func AddressContainsFold(v string) predicate.Listing {
 	return predicate.Listing(sql.FieldContainsFold(FieldAddress, v))
 }
 
+// AddressKeyEQ applies the EQ predicate on the "address_key" field.
+func AddressKeyEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldAddressKey, v))
+}
+
+// AddressKeyNEQ applies the NEQ predicate on the "address_key" field.
+func AddressKeyNEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldAddressKey, v))
+}
+
+// AddressKeyIn applies the In predicate on the "address_key" field.
+func AddressKeyIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldAddressKey, vs...))
+}
+
+// AddressKeyNotIn applies the NotIn predicate on the "address_key" field.
+func AddressKeyNotIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldAddressKey, vs...))
+}
+
+// AddressKeyGT applies the GT predicate on the "address_key" field.
+func AddressKeyGT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldAddressKey, v))
+}
+
+// AddressKeyGTE applies the GTE predicate on the "address_key" field.
+func AddressKeyGTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldAddressKey, v))
+}
+
+// AddressKeyLT applies the LT predicate on the "address_key" field.
+func AddressKeyLT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldAddressKey, v))
+}
+
+// AddressKeyLTE applies the LTE predicate on the "address_key" field.
+func AddressKeyLTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldAddressKey, v))
+}
+
+// AddressKeyContains applies the Contains predicate on the "address_key" field.
+func AddressKeyContains(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContains(FieldAddressKey, v))
+}
+
+// AddressKeyHasPrefix applies the HasPrefix predicate on the "address_key" field.
+func AddressKeyHasPrefix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasPrefix(FieldAddressKey, v))
+}
+
+// AddressKeyHasSuffix applies the HasSuffix predicate on the "address_key" field.
+func AddressKeyHasSuffix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasSuffix(FieldAddressKey, v))
+}
+
+// AddressKeyIsNil applies the IsNil predicate on the "address_key" field.
+func AddressKeyIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldAddressKey))
+}
+
+// AddressKeyNotNil applies the NotNil predicate on the "address_key" field.
+func AddressKeyNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldAddressKey))
+}
+
+// AddressKeyEqualFold applies the EqualFold predicate on the "address_key" field.
+func AddressKeyEqualFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEqualFold(FieldAddressKey, v))
+}
+
+// AddressKeyContainsFold applies the ContainsFold predicate on the "address_key" field.
+func AddressKeyContainsFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContainsFold(FieldAddressKey, v))
+}
+
 // CityEQ applies the EQ predicate on the "city" field.
 func CityEQ(v string) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldCity, v))
@@ -547,6 +752,201 @@ func ZipCodeContainsFold(v string) predicate.Listing {
 	return predicate.Listing(sql.FieldContainsFold(FieldZipCode, v))
 }
 
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldCountry, v))
+}
+
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldCountry, v))
+}
+
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldCountry, vs...))
+}
+
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldCountry, vs...))
+}
+
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldCountry, v))
+}
+
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldCountry, v))
+}
+
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldCountry, v))
+}
+
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldCountry, v))
+}
+
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContains(FieldCountry, v))
+}
+
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasPrefix(FieldCountry, v))
+}
+
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasSuffix(FieldCountry, v))
+}
+
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEqualFold(FieldCountry, v))
+}
+
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContainsFold(FieldCountry, v))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LatitudeIsNil applies the IsNil predicate on the "latitude" field.
+func LatitudeIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldLatitude))
+}
+
+// LatitudeNotNil applies the NotNil predicate on the "latitude" field.
+func LatitudeNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldLatitude))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldLongitude, v))
+}
+
+// LongitudeIsNil applies the IsNil predicate on the "longitude" field.
+func LongitudeIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldLongitude))
+}
+
+// LongitudeNotNil applies the NotNil predicate on the "longitude" field.
+func LongitudeNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldLongitude))
+}
+
+// NeighborhoodIDEQ applies the EQ predicate on the "neighborhood_id" field.
+func NeighborhoodIDEQ(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldNeighborhoodID, v))
+}
+
+// NeighborhoodIDNEQ applies the NEQ predicate on the "neighborhood_id" field.
+func NeighborhoodIDNEQ(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldNeighborhoodID, v))
+}
+
+// NeighborhoodIDIn applies the In predicate on the "neighborhood_id" field.
+func NeighborhoodIDIn(vs ...uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldNeighborhoodID, vs...))
+}
+
+// NeighborhoodIDNotIn applies the NotIn predicate on the "neighborhood_id" field.
+func NeighborhoodIDNotIn(vs ...uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldNeighborhoodID, vs...))
+}
+
+// NeighborhoodIDIsNil applies the IsNil predicate on the "neighborhood_id" field.
+func NeighborhoodIDIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldNeighborhoodID))
+}
+
+// NeighborhoodIDNotNil applies the NotNil predicate on the "neighborhood_id" field.
+func NeighborhoodIDNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldNeighborhoodID))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldDescription, v))
@@ -622,6 +1022,81 @@ func DescriptionContainsFold(v string) predicate.Listing {
 	return predicate.Listing(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// SourceLocaleEQ applies the EQ predicate on the "source_locale" field.
+func SourceLocaleEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldSourceLocale, v))
+}
+
+// SourceLocaleNEQ applies the NEQ predicate on the "source_locale" field.
+func SourceLocaleNEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldSourceLocale, v))
+}
+
+// SourceLocaleIn applies the In predicate on the "source_locale" field.
+func SourceLocaleIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldSourceLocale, vs...))
+}
+
+// SourceLocaleNotIn applies the NotIn predicate on the "source_locale" field.
+func SourceLocaleNotIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldSourceLocale, vs...))
+}
+
+// SourceLocaleGT applies the GT predicate on the "source_locale" field.
+func SourceLocaleGT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldSourceLocale, v))
+}
+
+// SourceLocaleGTE applies the GTE predicate on the "source_locale" field.
+func SourceLocaleGTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldSourceLocale, v))
+}
+
+// SourceLocaleLT applies the LT predicate on the "source_locale" field.
+func SourceLocaleLT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldSourceLocale, v))
+}
+
+// SourceLocaleLTE applies the LTE predicate on the "source_locale" field.
+func SourceLocaleLTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldSourceLocale, v))
+}
+
+// SourceLocaleContains applies the Contains predicate on the "source_locale" field.
+func SourceLocaleContains(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContains(FieldSourceLocale, v))
+}
+
+// SourceLocaleHasPrefix applies the HasPrefix predicate on the "source_locale" field.
+func SourceLocaleHasPrefix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasPrefix(FieldSourceLocale, v))
+}
+
+// SourceLocaleHasSuffix applies the HasSuffix predicate on the "source_locale" field.
+func SourceLocaleHasSuffix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasSuffix(FieldSourceLocale, v))
+}
+
+// SourceLocaleEqualFold applies the EqualFold predicate on the "source_locale" field.
+func SourceLocaleEqualFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEqualFold(FieldSourceLocale, v))
+}
+
+// SourceLocaleContainsFold applies the ContainsFold predicate on the "source_locale" field.
+func SourceLocaleContainsFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContainsFold(FieldSourceLocale, v))
+}
+
+// TranslationsIsNil applies the IsNil predicate on the "translations" field.
+func TranslationsIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldTranslations))
+}
+
+// TranslationsNotNil applies the NotNil predicate on the "translations" field.
+func TranslationsNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldTranslations))
+}
+
 // PriceEQ applies the EQ predicate on the "price" field.
 func PriceEQ(v decimal.Decimal) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldPrice, v))
@@ -662,6 +1137,71 @@ func PriceLTE(v decimal.Decimal) predicate.Listing {
 	return predicate.Listing(sql.FieldLTE(FieldPrice, v))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContainsFold(FieldCurrency, v))
+}
+
 // BedroomEQ applies the EQ predicate on the "bedroom" field.
 func BedroomEQ(v int) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldBedroom, v))
@@ -832,6 +1372,76 @@ func SqftLTE(v int) predicate.Listing {
 	return predicate.Listing(sql.FieldLTE(FieldSqft, v))
 }
 
+// SqmEQ applies the EQ predicate on the "sqm" field.
+func SqmEQ(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldSqm, v))
+}
+
+// SqmNEQ applies the NEQ predicate on the "sqm" field.
+func SqmNEQ(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldSqm, v))
+}
+
+// SqmIn applies the In predicate on the "sqm" field.
+func SqmIn(vs ...int) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldSqm, vs...))
+}
+
+// SqmNotIn applies the NotIn predicate on the "sqm" field.
+func SqmNotIn(vs ...int) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldSqm, vs...))
+}
+
+// SqmGT applies the GT predicate on the "sqm" field.
+func SqmGT(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldSqm, v))
+}
+
+// SqmGTE applies the GTE predicate on the "sqm" field.
+func SqmGTE(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldSqm, v))
+}
+
+// SqmLT applies the LT predicate on the "sqm" field.
+func SqmLT(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldSqm, v))
+}
+
+// SqmLTE applies the LTE predicate on the "sqm" field.
+func SqmLTE(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldSqm, v))
+}
+
+// SqmIsNil applies the IsNil predicate on the "sqm" field.
+func SqmIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldSqm))
+}
+
+// SqmNotNil applies the NotNil predicate on the "sqm" field.
+func SqmNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldSqm))
+}
+
+// AreaUnitEQ applies the EQ predicate on the "area_unit" field.
+func AreaUnitEQ(v AreaUnit) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldAreaUnit, v))
+}
+
+// AreaUnitNEQ applies the NEQ predicate on the "area_unit" field.
+func AreaUnitNEQ(v AreaUnit) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldAreaUnit, v))
+}
+
+// AreaUnitIn applies the In predicate on the "area_unit" field.
+func AreaUnitIn(vs ...AreaUnit) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldAreaUnit, vs...))
+}
+
+// AreaUnitNotIn applies the NotIn predicate on the "area_unit" field.
+func AreaUnitNotIn(vs ...AreaUnit) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldAreaUnit, vs...))
+}
+
 // TypeOfPropertyEQ applies the EQ predicate on the "type_of_property" field.
 func TypeOfPropertyEQ(v TypeOfProperty) predicate.Listing {
 	return predicate.Listing(sql.FieldEQ(FieldTypeOfProperty, v))
@@ -982,24 +1592,329 @@ func YearBuiltLTE(v int) predicate.Listing {
 	return predicate.Listing(sql.FieldLTE(FieldYearBuilt, v))
 }
 
-// MediaIsNil applies the IsNil predicate on the "media" field.
-func MediaIsNil() predicate.Listing {
-	return predicate.Listing(sql.FieldIsNull(FieldMedia))
+// AnnualPropertyTaxEQ applies the EQ predicate on the "annual_property_tax" field.
+func AnnualPropertyTaxEQ(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldAnnualPropertyTax, v))
 }
 
-// MediaNotNil applies the NotNil predicate on the "media" field.
-func MediaNotNil() predicate.Listing {
-	return predicate.Listing(sql.FieldNotNull(FieldMedia))
+// AnnualPropertyTaxNEQ applies the NEQ predicate on the "annual_property_tax" field.
+func AnnualPropertyTaxNEQ(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldAnnualPropertyTax, v))
 }
 
-// RealtorIDEQ applies the EQ predicate on the "realtor_id" field.
-func RealtorIDEQ(v uuid.UUID) predicate.Listing {
-	return predicate.Listing(sql.FieldEQ(FieldRealtorID, v))
+// AnnualPropertyTaxIn applies the In predicate on the "annual_property_tax" field.
+func AnnualPropertyTaxIn(vs ...decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldAnnualPropertyTax, vs...))
 }
 
-// RealtorIDNEQ applies the NEQ predicate on the "realtor_id" field.
-func RealtorIDNEQ(v uuid.UUID) predicate.Listing {
-	return predicate.Listing(sql.FieldNEQ(FieldRealtorID, v))
+// AnnualPropertyTaxNotIn applies the NotIn predicate on the "annual_property_tax" field.
+func AnnualPropertyTaxNotIn(vs ...decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldAnnualPropertyTax, vs...))
+}
+
+// AnnualPropertyTaxGT applies the GT predicate on the "annual_property_tax" field.
+func AnnualPropertyTaxGT(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldAnnualPropertyTax, v))
+}
+
+// AnnualPropertyTaxGTE applies the GTE predicate on the "annual_property_tax" field.
+func AnnualPropertyTaxGTE(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldAnnualPropertyTax, v))
+}
+
+// AnnualPropertyTaxLT applies the LT predicate on the "annual_property_tax" field.
+func AnnualPropertyTaxLT(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldAnnualPropertyTax, v))
+}
+
+// AnnualPropertyTaxLTE applies the LTE predicate on the "annual_property_tax" field.
+func AnnualPropertyTaxLTE(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldAnnualPropertyTax, v))
+}
+
+// AnnualPropertyTaxIsNil applies the IsNil predicate on the "annual_property_tax" field.
+func AnnualPropertyTaxIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldAnnualPropertyTax))
+}
+
+// AnnualPropertyTaxNotNil applies the NotNil predicate on the "annual_property_tax" field.
+func AnnualPropertyTaxNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldAnnualPropertyTax))
+}
+
+// HoaDuesEQ applies the EQ predicate on the "hoa_dues" field.
+func HoaDuesEQ(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldHoaDues, v))
+}
+
+// HoaDuesNEQ applies the NEQ predicate on the "hoa_dues" field.
+func HoaDuesNEQ(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldHoaDues, v))
+}
+
+// HoaDuesIn applies the In predicate on the "hoa_dues" field.
+func HoaDuesIn(vs ...decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldHoaDues, vs...))
+}
+
+// HoaDuesNotIn applies the NotIn predicate on the "hoa_dues" field.
+func HoaDuesNotIn(vs ...decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldHoaDues, vs...))
+}
+
+// HoaDuesGT applies the GT predicate on the "hoa_dues" field.
+func HoaDuesGT(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldHoaDues, v))
+}
+
+// HoaDuesGTE applies the GTE predicate on the "hoa_dues" field.
+func HoaDuesGTE(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldHoaDues, v))
+}
+
+// HoaDuesLT applies the LT predicate on the "hoa_dues" field.
+func HoaDuesLT(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldHoaDues, v))
+}
+
+// HoaDuesLTE applies the LTE predicate on the "hoa_dues" field.
+func HoaDuesLTE(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldHoaDues, v))
+}
+
+// HoaDuesIsNil applies the IsNil predicate on the "hoa_dues" field.
+func HoaDuesIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldHoaDues))
+}
+
+// HoaDuesNotNil applies the NotNil predicate on the "hoa_dues" field.
+func HoaDuesNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldHoaDues))
+}
+
+// HoaFrequencyEQ applies the EQ predicate on the "hoa_frequency" field.
+func HoaFrequencyEQ(v HoaFrequency) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldHoaFrequency, v))
+}
+
+// HoaFrequencyNEQ applies the NEQ predicate on the "hoa_frequency" field.
+func HoaFrequencyNEQ(v HoaFrequency) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldHoaFrequency, v))
+}
+
+// HoaFrequencyIn applies the In predicate on the "hoa_frequency" field.
+func HoaFrequencyIn(vs ...HoaFrequency) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldHoaFrequency, vs...))
+}
+
+// HoaFrequencyNotIn applies the NotIn predicate on the "hoa_frequency" field.
+func HoaFrequencyNotIn(vs ...HoaFrequency) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldHoaFrequency, vs...))
+}
+
+// HoaFrequencyIsNil applies the IsNil predicate on the "hoa_frequency" field.
+func HoaFrequencyIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldHoaFrequency))
+}
+
+// HoaFrequencyNotNil applies the NotNil predicate on the "hoa_frequency" field.
+func HoaFrequencyNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldHoaFrequency))
+}
+
+// HoaNameEQ applies the EQ predicate on the "hoa_name" field.
+func HoaNameEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldHoaName, v))
+}
+
+// HoaNameNEQ applies the NEQ predicate on the "hoa_name" field.
+func HoaNameNEQ(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldHoaName, v))
+}
+
+// HoaNameIn applies the In predicate on the "hoa_name" field.
+func HoaNameIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldHoaName, vs...))
+}
+
+// HoaNameNotIn applies the NotIn predicate on the "hoa_name" field.
+func HoaNameNotIn(vs ...string) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldHoaName, vs...))
+}
+
+// HoaNameGT applies the GT predicate on the "hoa_name" field.
+func HoaNameGT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldHoaName, v))
+}
+
+// HoaNameGTE applies the GTE predicate on the "hoa_name" field.
+func HoaNameGTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldHoaName, v))
+}
+
+// HoaNameLT applies the LT predicate on the "hoa_name" field.
+func HoaNameLT(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldHoaName, v))
+}
+
+// HoaNameLTE applies the LTE predicate on the "hoa_name" field.
+func HoaNameLTE(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldHoaName, v))
+}
+
+// HoaNameContains applies the Contains predicate on the "hoa_name" field.
+func HoaNameContains(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContains(FieldHoaName, v))
+}
+
+// HoaNameHasPrefix applies the HasPrefix predicate on the "hoa_name" field.
+func HoaNameHasPrefix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasPrefix(FieldHoaName, v))
+}
+
+// HoaNameHasSuffix applies the HasSuffix predicate on the "hoa_name" field.
+func HoaNameHasSuffix(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldHasSuffix(FieldHoaName, v))
+}
+
+// HoaNameIsNil applies the IsNil predicate on the "hoa_name" field.
+func HoaNameIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldHoaName))
+}
+
+// HoaNameNotNil applies the NotNil predicate on the "hoa_name" field.
+func HoaNameNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldHoaName))
+}
+
+// HoaNameEqualFold applies the EqualFold predicate on the "hoa_name" field.
+func HoaNameEqualFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldEqualFold(FieldHoaName, v))
+}
+
+// HoaNameContainsFold applies the ContainsFold predicate on the "hoa_name" field.
+func HoaNameContainsFold(v string) predicate.Listing {
+	return predicate.Listing(sql.FieldContainsFold(FieldHoaName, v))
+}
+
+// SpecialAssessmentsEQ applies the EQ predicate on the "special_assessments" field.
+func SpecialAssessmentsEQ(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldSpecialAssessments, v))
+}
+
+// SpecialAssessmentsNEQ applies the NEQ predicate on the "special_assessments" field.
+func SpecialAssessmentsNEQ(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldSpecialAssessments, v))
+}
+
+// SpecialAssessmentsIn applies the In predicate on the "special_assessments" field.
+func SpecialAssessmentsIn(vs ...decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldSpecialAssessments, vs...))
+}
+
+// SpecialAssessmentsNotIn applies the NotIn predicate on the "special_assessments" field.
+func SpecialAssessmentsNotIn(vs ...decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldSpecialAssessments, vs...))
+}
+
+// SpecialAssessmentsGT applies the GT predicate on the "special_assessments" field.
+func SpecialAssessmentsGT(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldSpecialAssessments, v))
+}
+
+// SpecialAssessmentsGTE applies the GTE predicate on the "special_assessments" field.
+func SpecialAssessmentsGTE(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldSpecialAssessments, v))
+}
+
+// SpecialAssessmentsLT applies the LT predicate on the "special_assessments" field.
+func SpecialAssessmentsLT(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldSpecialAssessments, v))
+}
+
+// SpecialAssessmentsLTE applies the LTE predicate on the "special_assessments" field.
+func SpecialAssessmentsLTE(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldSpecialAssessments, v))
+}
+
+// SpecialAssessmentsIsNil applies the IsNil predicate on the "special_assessments" field.
+func SpecialAssessmentsIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldSpecialAssessments))
+}
+
+// SpecialAssessmentsNotNil applies the NotNil predicate on the "special_assessments" field.
+func SpecialAssessmentsNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldSpecialAssessments))
+}
+
+// MonthlyUtilitiesEQ applies the EQ predicate on the "monthly_utilities" field.
+func MonthlyUtilitiesEQ(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldMonthlyUtilities, v))
+}
+
+// MonthlyUtilitiesNEQ applies the NEQ predicate on the "monthly_utilities" field.
+func MonthlyUtilitiesNEQ(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldMonthlyUtilities, v))
+}
+
+// MonthlyUtilitiesIn applies the In predicate on the "monthly_utilities" field.
+func MonthlyUtilitiesIn(vs ...decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldMonthlyUtilities, vs...))
+}
+
+// MonthlyUtilitiesNotIn applies the NotIn predicate on the "monthly_utilities" field.
+func MonthlyUtilitiesNotIn(vs ...decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldMonthlyUtilities, vs...))
+}
+
+// MonthlyUtilitiesGT applies the GT predicate on the "monthly_utilities" field.
+func MonthlyUtilitiesGT(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldMonthlyUtilities, v))
+}
+
+// MonthlyUtilitiesGTE applies the GTE predicate on the "monthly_utilities" field.
+func MonthlyUtilitiesGTE(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldMonthlyUtilities, v))
+}
+
+// MonthlyUtilitiesLT applies the LT predicate on the "monthly_utilities" field.
+func MonthlyUtilitiesLT(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldMonthlyUtilities, v))
+}
+
+// MonthlyUtilitiesLTE applies the LTE predicate on the "monthly_utilities" field.
+func MonthlyUtilitiesLTE(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldMonthlyUtilities, v))
+}
+
+// MonthlyUtilitiesIsNil applies the IsNil predicate on the "monthly_utilities" field.
+func MonthlyUtilitiesIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldMonthlyUtilities))
+}
+
+// MonthlyUtilitiesNotNil applies the NotNil predicate on the "monthly_utilities" field.
+func MonthlyUtilitiesNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldMonthlyUtilities))
+}
+
+// MediaIsNil applies the IsNil predicate on the "media" field.
+func MediaIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldMedia))
+}
+
+// MediaNotNil applies the NotNil predicate on the "media" field.
+func MediaNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldMedia))
+}
+
+// RealtorIDEQ applies the EQ predicate on the "realtor_id" field.
+func RealtorIDEQ(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldRealtorID, v))
+}
+
+// RealtorIDNEQ applies the NEQ predicate on the "realtor_id" field.
+func RealtorIDNEQ(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldRealtorID, v))
 }
 
 // RealtorIDIn applies the In predicate on the "realtor_id" field.
@@ -1012,6 +1927,556 @@ func RealtorIDNotIn(vs ...uuid.UUID) predicate.Listing {
 	return predicate.Listing(sql.FieldNotIn(FieldRealtorID, vs...))
 }
 
+// PropertyIDEQ applies the EQ predicate on the "property_id" field.
+func PropertyIDEQ(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldPropertyID, v))
+}
+
+// PropertyIDNEQ applies the NEQ predicate on the "property_id" field.
+func PropertyIDNEQ(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldPropertyID, v))
+}
+
+// PropertyIDIn applies the In predicate on the "property_id" field.
+func PropertyIDIn(vs ...uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldPropertyID, vs...))
+}
+
+// PropertyIDNotIn applies the NotIn predicate on the "property_id" field.
+func PropertyIDNotIn(vs ...uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldPropertyID, vs...))
+}
+
+// PropertyIDIsNil applies the IsNil predicate on the "property_id" field.
+func PropertyIDIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldPropertyID))
+}
+
+// PropertyIDNotNil applies the NotNil predicate on the "property_id" field.
+func PropertyIDNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldPropertyID))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// ExpiryReminderSentAtEQ applies the EQ predicate on the "expiry_reminder_sent_at" field.
+func ExpiryReminderSentAtEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldExpiryReminderSentAt, v))
+}
+
+// ExpiryReminderSentAtNEQ applies the NEQ predicate on the "expiry_reminder_sent_at" field.
+func ExpiryReminderSentAtNEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldExpiryReminderSentAt, v))
+}
+
+// ExpiryReminderSentAtIn applies the In predicate on the "expiry_reminder_sent_at" field.
+func ExpiryReminderSentAtIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldExpiryReminderSentAt, vs...))
+}
+
+// ExpiryReminderSentAtNotIn applies the NotIn predicate on the "expiry_reminder_sent_at" field.
+func ExpiryReminderSentAtNotIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldExpiryReminderSentAt, vs...))
+}
+
+// ExpiryReminderSentAtGT applies the GT predicate on the "expiry_reminder_sent_at" field.
+func ExpiryReminderSentAtGT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldExpiryReminderSentAt, v))
+}
+
+// ExpiryReminderSentAtGTE applies the GTE predicate on the "expiry_reminder_sent_at" field.
+func ExpiryReminderSentAtGTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldExpiryReminderSentAt, v))
+}
+
+// ExpiryReminderSentAtLT applies the LT predicate on the "expiry_reminder_sent_at" field.
+func ExpiryReminderSentAtLT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldExpiryReminderSentAt, v))
+}
+
+// ExpiryReminderSentAtLTE applies the LTE predicate on the "expiry_reminder_sent_at" field.
+func ExpiryReminderSentAtLTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldExpiryReminderSentAt, v))
+}
+
+// ExpiryReminderSentAtIsNil applies the IsNil predicate on the "expiry_reminder_sent_at" field.
+func ExpiryReminderSentAtIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldExpiryReminderSentAt))
+}
+
+// ExpiryReminderSentAtNotNil applies the NotNil predicate on the "expiry_reminder_sent_at" field.
+func ExpiryReminderSentAtNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldExpiryReminderSentAt))
+}
+
+// ExpiredEQ applies the EQ predicate on the "expired" field.
+func ExpiredEQ(v bool) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldExpired, v))
+}
+
+// ExpiredNEQ applies the NEQ predicate on the "expired" field.
+func ExpiredNEQ(v bool) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldExpired, v))
+}
+
+// ListedAtEQ applies the EQ predicate on the "listed_at" field.
+func ListedAtEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldListedAt, v))
+}
+
+// ListedAtNEQ applies the NEQ predicate on the "listed_at" field.
+func ListedAtNEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldListedAt, v))
+}
+
+// ListedAtIn applies the In predicate on the "listed_at" field.
+func ListedAtIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldListedAt, vs...))
+}
+
+// ListedAtNotIn applies the NotIn predicate on the "listed_at" field.
+func ListedAtNotIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldListedAt, vs...))
+}
+
+// ListedAtGT applies the GT predicate on the "listed_at" field.
+func ListedAtGT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldListedAt, v))
+}
+
+// ListedAtGTE applies the GTE predicate on the "listed_at" field.
+func ListedAtGTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldListedAt, v))
+}
+
+// ListedAtLT applies the LT predicate on the "listed_at" field.
+func ListedAtLT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldListedAt, v))
+}
+
+// ListedAtLTE applies the LTE predicate on the "listed_at" field.
+func ListedAtLTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldListedAt, v))
+}
+
+// ListedAtIsNil applies the IsNil predicate on the "listed_at" field.
+func ListedAtIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldListedAt))
+}
+
+// ListedAtNotNil applies the NotNil predicate on the "listed_at" field.
+func ListedAtNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldListedAt))
+}
+
+// OnMarketSinceEQ applies the EQ predicate on the "on_market_since" field.
+func OnMarketSinceEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldOnMarketSince, v))
+}
+
+// OnMarketSinceNEQ applies the NEQ predicate on the "on_market_since" field.
+func OnMarketSinceNEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldOnMarketSince, v))
+}
+
+// OnMarketSinceIn applies the In predicate on the "on_market_since" field.
+func OnMarketSinceIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldOnMarketSince, vs...))
+}
+
+// OnMarketSinceNotIn applies the NotIn predicate on the "on_market_since" field.
+func OnMarketSinceNotIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldOnMarketSince, vs...))
+}
+
+// OnMarketSinceGT applies the GT predicate on the "on_market_since" field.
+func OnMarketSinceGT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldOnMarketSince, v))
+}
+
+// OnMarketSinceGTE applies the GTE predicate on the "on_market_since" field.
+func OnMarketSinceGTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldOnMarketSince, v))
+}
+
+// OnMarketSinceLT applies the LT predicate on the "on_market_since" field.
+func OnMarketSinceLT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldOnMarketSince, v))
+}
+
+// OnMarketSinceLTE applies the LTE predicate on the "on_market_since" field.
+func OnMarketSinceLTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldOnMarketSince, v))
+}
+
+// OnMarketSinceIsNil applies the IsNil predicate on the "on_market_since" field.
+func OnMarketSinceIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldOnMarketSince))
+}
+
+// OnMarketSinceNotNil applies the NotNil predicate on the "on_market_since" field.
+func OnMarketSinceNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldOnMarketSince))
+}
+
+// MarketSecondsEQ applies the EQ predicate on the "market_seconds" field.
+func MarketSecondsEQ(v int64) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldMarketSeconds, v))
+}
+
+// MarketSecondsNEQ applies the NEQ predicate on the "market_seconds" field.
+func MarketSecondsNEQ(v int64) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldMarketSeconds, v))
+}
+
+// MarketSecondsIn applies the In predicate on the "market_seconds" field.
+func MarketSecondsIn(vs ...int64) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldMarketSeconds, vs...))
+}
+
+// MarketSecondsNotIn applies the NotIn predicate on the "market_seconds" field.
+func MarketSecondsNotIn(vs ...int64) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldMarketSeconds, vs...))
+}
+
+// MarketSecondsGT applies the GT predicate on the "market_seconds" field.
+func MarketSecondsGT(v int64) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldMarketSeconds, v))
+}
+
+// MarketSecondsGTE applies the GTE predicate on the "market_seconds" field.
+func MarketSecondsGTE(v int64) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldMarketSeconds, v))
+}
+
+// MarketSecondsLT applies the LT predicate on the "market_seconds" field.
+func MarketSecondsLT(v int64) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldMarketSeconds, v))
+}
+
+// MarketSecondsLTE applies the LTE predicate on the "market_seconds" field.
+func MarketSecondsLTE(v int64) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldMarketSeconds, v))
+}
+
+// SoldPriceEQ applies the EQ predicate on the "sold_price" field.
+func SoldPriceEQ(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldSoldPrice, v))
+}
+
+// SoldPriceNEQ applies the NEQ predicate on the "sold_price" field.
+func SoldPriceNEQ(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldSoldPrice, v))
+}
+
+// SoldPriceIn applies the In predicate on the "sold_price" field.
+func SoldPriceIn(vs ...decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldSoldPrice, vs...))
+}
+
+// SoldPriceNotIn applies the NotIn predicate on the "sold_price" field.
+func SoldPriceNotIn(vs ...decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldSoldPrice, vs...))
+}
+
+// SoldPriceGT applies the GT predicate on the "sold_price" field.
+func SoldPriceGT(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldSoldPrice, v))
+}
+
+// SoldPriceGTE applies the GTE predicate on the "sold_price" field.
+func SoldPriceGTE(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldSoldPrice, v))
+}
+
+// SoldPriceLT applies the LT predicate on the "sold_price" field.
+func SoldPriceLT(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldSoldPrice, v))
+}
+
+// SoldPriceLTE applies the LTE predicate on the "sold_price" field.
+func SoldPriceLTE(v decimal.Decimal) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldSoldPrice, v))
+}
+
+// SoldPriceIsNil applies the IsNil predicate on the "sold_price" field.
+func SoldPriceIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldSoldPrice))
+}
+
+// SoldPriceNotNil applies the NotNil predicate on the "sold_price" field.
+func SoldPriceNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldSoldPrice))
+}
+
+// CloseDateEQ applies the EQ predicate on the "close_date" field.
+func CloseDateEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldCloseDate, v))
+}
+
+// CloseDateNEQ applies the NEQ predicate on the "close_date" field.
+func CloseDateNEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldCloseDate, v))
+}
+
+// CloseDateIn applies the In predicate on the "close_date" field.
+func CloseDateIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldCloseDate, vs...))
+}
+
+// CloseDateNotIn applies the NotIn predicate on the "close_date" field.
+func CloseDateNotIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldCloseDate, vs...))
+}
+
+// CloseDateGT applies the GT predicate on the "close_date" field.
+func CloseDateGT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldCloseDate, v))
+}
+
+// CloseDateGTE applies the GTE predicate on the "close_date" field.
+func CloseDateGTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldCloseDate, v))
+}
+
+// CloseDateLT applies the LT predicate on the "close_date" field.
+func CloseDateLT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldCloseDate, v))
+}
+
+// CloseDateLTE applies the LTE predicate on the "close_date" field.
+func CloseDateLTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldCloseDate, v))
+}
+
+// CloseDateIsNil applies the IsNil predicate on the "close_date" field.
+func CloseDateIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldCloseDate))
+}
+
+// CloseDateNotNil applies the NotNil predicate on the "close_date" field.
+func CloseDateNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldCloseDate))
+}
+
+// BuyerRealtorIDEQ applies the EQ predicate on the "buyer_realtor_id" field.
+func BuyerRealtorIDEQ(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldBuyerRealtorID, v))
+}
+
+// BuyerRealtorIDNEQ applies the NEQ predicate on the "buyer_realtor_id" field.
+func BuyerRealtorIDNEQ(v uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldBuyerRealtorID, v))
+}
+
+// BuyerRealtorIDIn applies the In predicate on the "buyer_realtor_id" field.
+func BuyerRealtorIDIn(vs ...uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldBuyerRealtorID, vs...))
+}
+
+// BuyerRealtorIDNotIn applies the NotIn predicate on the "buyer_realtor_id" field.
+func BuyerRealtorIDNotIn(vs ...uuid.UUID) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldBuyerRealtorID, vs...))
+}
+
+// BuyerRealtorIDIsNil applies the IsNil predicate on the "buyer_realtor_id" field.
+func BuyerRealtorIDIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldBuyerRealtorID))
+}
+
+// BuyerRealtorIDNotNil applies the NotNil predicate on the "buyer_realtor_id" field.
+func BuyerRealtorIDNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldBuyerRealtorID))
+}
+
+// PublishAtEQ applies the EQ predicate on the "publish_at" field.
+func PublishAtEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldPublishAt, v))
+}
+
+// PublishAtNEQ applies the NEQ predicate on the "publish_at" field.
+func PublishAtNEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldPublishAt, v))
+}
+
+// PublishAtIn applies the In predicate on the "publish_at" field.
+func PublishAtIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldPublishAt, vs...))
+}
+
+// PublishAtNotIn applies the NotIn predicate on the "publish_at" field.
+func PublishAtNotIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldPublishAt, vs...))
+}
+
+// PublishAtGT applies the GT predicate on the "publish_at" field.
+func PublishAtGT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldPublishAt, v))
+}
+
+// PublishAtGTE applies the GTE predicate on the "publish_at" field.
+func PublishAtGTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldPublishAt, v))
+}
+
+// PublishAtLT applies the LT predicate on the "publish_at" field.
+func PublishAtLT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldPublishAt, v))
+}
+
+// PublishAtLTE applies the LTE predicate on the "publish_at" field.
+func PublishAtLTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldPublishAt, v))
+}
+
+// PublishAtIsNil applies the IsNil predicate on the "publish_at" field.
+func PublishAtIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldPublishAt))
+}
+
+// PublishAtNotNil applies the NotNil predicate on the "publish_at" field.
+func PublishAtNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldPublishAt))
+}
+
+// UnpublishAtEQ applies the EQ predicate on the "unpublish_at" field.
+func UnpublishAtEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldUnpublishAt, v))
+}
+
+// UnpublishAtNEQ applies the NEQ predicate on the "unpublish_at" field.
+func UnpublishAtNEQ(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldUnpublishAt, v))
+}
+
+// UnpublishAtIn applies the In predicate on the "unpublish_at" field.
+func UnpublishAtIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldUnpublishAt, vs...))
+}
+
+// UnpublishAtNotIn applies the NotIn predicate on the "unpublish_at" field.
+func UnpublishAtNotIn(vs ...time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldUnpublishAt, vs...))
+}
+
+// UnpublishAtGT applies the GT predicate on the "unpublish_at" field.
+func UnpublishAtGT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldUnpublishAt, v))
+}
+
+// UnpublishAtGTE applies the GTE predicate on the "unpublish_at" field.
+func UnpublishAtGTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldUnpublishAt, v))
+}
+
+// UnpublishAtLT applies the LT predicate on the "unpublish_at" field.
+func UnpublishAtLT(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldUnpublishAt, v))
+}
+
+// UnpublishAtLTE applies the LTE predicate on the "unpublish_at" field.
+func UnpublishAtLTE(v time.Time) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldUnpublishAt, v))
+}
+
+// UnpublishAtIsNil applies the IsNil predicate on the "unpublish_at" field.
+func UnpublishAtIsNil() predicate.Listing {
+	return predicate.Listing(sql.FieldIsNull(FieldUnpublishAt))
+}
+
+// UnpublishAtNotNil applies the NotNil predicate on the "unpublish_at" field.
+func UnpublishAtNotNil() predicate.Listing {
+	return predicate.Listing(sql.FieldNotNull(FieldUnpublishAt))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Listing {
+	return predicate.Listing(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Listing {
+	return predicate.Listing(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Listing {
+	return predicate.Listing(sql.FieldLTE(FieldVersion, v))
+}
+
 // HasRealtor applies the HasEdge predicate on the "realtor" edge.
 func HasRealtor() predicate.Listing {
 	return predicate.Listing(func(s *sql.Selector) {
@@ -1035,6 +2500,213 @@ func HasRealtorWith(preds ...predicate.Realtor) predicate.Listing {
 	})
 }
 
+// HasProperty applies the HasEdge predicate on the "property" edge.
+func HasProperty() predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PropertyTable, PropertyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPropertyWith applies the HasEdge predicate on the "property" edge with a given conditions (other predicates).
+func HasPropertyWith(preds ...predicate.Property) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := newPropertyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNeighborhood applies the HasEdge predicate on the "neighborhood" edge.
+func HasNeighborhood() predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, NeighborhoodTable, NeighborhoodColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNeighborhoodWith applies the HasEdge predicate on the "neighborhood" edge with a given conditions (other predicates).
+func HasNeighborhoodWith(preds ...predicate.Neighborhood) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := newNeighborhoodStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBuyerRealtor applies the HasEdge predicate on the "buyer_realtor" edge.
+func HasBuyerRealtor() predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BuyerRealtorTable, BuyerRealtorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBuyerRealtorWith applies the HasEdge predicate on the "buyer_realtor" edge with a given conditions (other predicates).
+func HasBuyerRealtorWith(preds ...predicate.Realtor) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := newBuyerRealtorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRenewals applies the HasEdge predicate on the "renewals" edge.
+func HasRenewals() predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RenewalsTable, RenewalsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRenewalsWith applies the HasEdge predicate on the "renewals" edge with a given conditions (other predicates).
+func HasRenewalsWith(preds ...predicate.ListingRenewal) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := newRenewalsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDocuments applies the HasEdge predicate on the "documents" edge.
+func HasDocuments() predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DocumentsTable, DocumentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDocumentsWith applies the HasEdge predicate on the "documents" edge with a given conditions (other predicates).
+func HasDocumentsWith(preds ...predicate.ListingDocument) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := newDocumentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInquiries applies the HasEdge predicate on the "inquiries" edge.
+func HasInquiries() predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InquiriesTable, InquiriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInquiriesWith applies the HasEdge predicate on the "inquiries" edge with a given conditions (other predicates).
+func HasInquiriesWith(preds ...predicate.ListingInquiry) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := newInquiriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviews applies the HasEdge predicate on the "reviews" edge.
+func HasReviews() predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewsWith applies the HasEdge predicate on the "reviews" edge with a given conditions (other predicates).
+func HasReviewsWith(preds ...predicate.ListingReview) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := newReviewsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOffers applies the HasEdge predicate on the "offers" edge.
+func HasOffers() predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OffersTable, OffersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOffersWith applies the HasEdge predicate on the "offers" edge with a given conditions (other predicates).
+func HasOffersWith(preds ...predicate.Offer) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := newOffersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatusEvents applies the HasEdge predicate on the "status_events" edge.
+func HasStatusEvents() predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusEventsTable, StatusEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusEventsWith applies the HasEdge predicate on the "status_events" edge with a given conditions (other predicates).
+func HasStatusEventsWith(preds ...predicate.ListingStatusEvent) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		step := newStatusEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Listing) predicate.Listing {
 	return predicate.Listing(sql.AndPredicates(predicates...))
@@ -8,13 +8,23 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/listingrenewal"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/listingstatusevent"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/property"
 	"ppgroup.ppgroup.com/ent/realtor"
-	"ppgroup.ppgroup.com/ent/schema"
+	"ppgroup.ppgroup.com/ent/schematype"
 )
 
 // ListingCreate is the builder for creating a Listing entity.
@@ -22,225 +32,731 @@ type ListingCreate struct {
 	config
 	mutation *ListingMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateTime sets the "create_time" field.
-func (lc *ListingCreate) SetCreateTime(t time.Time) *ListingCreate {
-	lc.mutation.SetCreateTime(t)
-	return lc
+func (_c *ListingCreate) SetCreateTime(v time.Time) *ListingCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
 }
 
 // SetNillableCreateTime sets the "create_time" field if the given value is not nil.
-func (lc *ListingCreate) SetNillableCreateTime(t *time.Time) *ListingCreate {
-	if t != nil {
-		lc.SetCreateTime(*t)
+func (_c *ListingCreate) SetNillableCreateTime(v *time.Time) *ListingCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
 	}
-	return lc
+	return _c
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (lc *ListingCreate) SetUpdateTime(t time.Time) *ListingCreate {
-	lc.mutation.SetUpdateTime(t)
-	return lc
+func (_c *ListingCreate) SetUpdateTime(v time.Time) *ListingCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
 }
 
 // SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
-func (lc *ListingCreate) SetNillableUpdateTime(t *time.Time) *ListingCreate {
-	if t != nil {
-		lc.SetUpdateTime(*t)
+func (_c *ListingCreate) SetNillableUpdateTime(v *time.Time) *ListingCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
 	}
-	return lc
+	return _c
 }
 
 // SetTitle sets the "title" field.
-func (lc *ListingCreate) SetTitle(s string) *ListingCreate {
-	lc.mutation.SetTitle(s)
-	return lc
+func (_c *ListingCreate) SetTitle(v string) *ListingCreate {
+	_c.mutation.SetTitle(v)
+	return _c
 }
 
 // SetAddress sets the "address" field.
-func (lc *ListingCreate) SetAddress(s string) *ListingCreate {
-	lc.mutation.SetAddress(s)
-	return lc
+func (_c *ListingCreate) SetAddress(v string) *ListingCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetAddressKey sets the "address_key" field.
+func (_c *ListingCreate) SetAddressKey(v string) *ListingCreate {
+	_c.mutation.SetAddressKey(v)
+	return _c
+}
+
+// SetNillableAddressKey sets the "address_key" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableAddressKey(v *string) *ListingCreate {
+	if v != nil {
+		_c.SetAddressKey(*v)
+	}
+	return _c
 }
 
 // SetCity sets the "city" field.
-func (lc *ListingCreate) SetCity(s string) *ListingCreate {
-	lc.mutation.SetCity(s)
-	return lc
+func (_c *ListingCreate) SetCity(v string) *ListingCreate {
+	_c.mutation.SetCity(v)
+	return _c
 }
 
 // SetState sets the "state" field.
-func (lc *ListingCreate) SetState(s string) *ListingCreate {
-	lc.mutation.SetState(s)
-	return lc
+func (_c *ListingCreate) SetState(v string) *ListingCreate {
+	_c.mutation.SetState(v)
+	return _c
 }
 
 // SetZipCode sets the "zip_code" field.
-func (lc *ListingCreate) SetZipCode(s string) *ListingCreate {
-	lc.mutation.SetZipCode(s)
-	return lc
+func (_c *ListingCreate) SetZipCode(v string) *ListingCreate {
+	_c.mutation.SetZipCode(v)
+	return _c
+}
+
+// SetCountry sets the "country" field.
+func (_c *ListingCreate) SetCountry(v string) *ListingCreate {
+	_c.mutation.SetCountry(v)
+	return _c
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableCountry(v *string) *ListingCreate {
+	if v != nil {
+		_c.SetCountry(*v)
+	}
+	return _c
+}
+
+// SetLatitude sets the "latitude" field.
+func (_c *ListingCreate) SetLatitude(v float64) *ListingCreate {
+	_c.mutation.SetLatitude(v)
+	return _c
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableLatitude(v *float64) *ListingCreate {
+	if v != nil {
+		_c.SetLatitude(*v)
+	}
+	return _c
+}
+
+// SetLongitude sets the "longitude" field.
+func (_c *ListingCreate) SetLongitude(v float64) *ListingCreate {
+	_c.mutation.SetLongitude(v)
+	return _c
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableLongitude(v *float64) *ListingCreate {
+	if v != nil {
+		_c.SetLongitude(*v)
+	}
+	return _c
+}
+
+// SetNeighborhoodID sets the "neighborhood_id" field.
+func (_c *ListingCreate) SetNeighborhoodID(v uuid.UUID) *ListingCreate {
+	_c.mutation.SetNeighborhoodID(v)
+	return _c
+}
+
+// SetNillableNeighborhoodID sets the "neighborhood_id" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableNeighborhoodID(v *uuid.UUID) *ListingCreate {
+	if v != nil {
+		_c.SetNeighborhoodID(*v)
+	}
+	return _c
 }
 
 // SetDescription sets the "description" field.
-func (lc *ListingCreate) SetDescription(s string) *ListingCreate {
-	lc.mutation.SetDescription(s)
-	return lc
+func (_c *ListingCreate) SetDescription(v string) *ListingCreate {
+	_c.mutation.SetDescription(v)
+	return _c
 }
 
 // SetNillableDescription sets the "description" field if the given value is not nil.
-func (lc *ListingCreate) SetNillableDescription(s *string) *ListingCreate {
-	if s != nil {
-		lc.SetDescription(*s)
+func (_c *ListingCreate) SetNillableDescription(v *string) *ListingCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetSourceLocale sets the "source_locale" field.
+func (_c *ListingCreate) SetSourceLocale(v string) *ListingCreate {
+	_c.mutation.SetSourceLocale(v)
+	return _c
+}
+
+// SetNillableSourceLocale sets the "source_locale" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableSourceLocale(v *string) *ListingCreate {
+	if v != nil {
+		_c.SetSourceLocale(*v)
 	}
-	return lc
+	return _c
+}
+
+// SetTranslations sets the "translations" field.
+func (_c *ListingCreate) SetTranslations(v map[string]schematype.Translation) *ListingCreate {
+	_c.mutation.SetTranslations(v)
+	return _c
 }
 
 // SetPrice sets the "price" field.
-func (lc *ListingCreate) SetPrice(d decimal.Decimal) *ListingCreate {
-	lc.mutation.SetPrice(d)
-	return lc
+func (_c *ListingCreate) SetPrice(v decimal.Decimal) *ListingCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *ListingCreate) SetCurrency(v string) *ListingCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableCurrency(v *string) *ListingCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
 }
 
 // SetBedroom sets the "bedroom" field.
-func (lc *ListingCreate) SetBedroom(i int) *ListingCreate {
-	lc.mutation.SetBedroom(i)
-	return lc
+func (_c *ListingCreate) SetBedroom(v int) *ListingCreate {
+	_c.mutation.SetBedroom(v)
+	return _c
 }
 
 // SetBathroom sets the "bathroom" field.
-func (lc *ListingCreate) SetBathroom(f float64) *ListingCreate {
-	lc.mutation.SetBathroom(f)
-	return lc
+func (_c *ListingCreate) SetBathroom(v float64) *ListingCreate {
+	_c.mutation.SetBathroom(v)
+	return _c
 }
 
 // SetGarage sets the "garage" field.
-func (lc *ListingCreate) SetGarage(i int) *ListingCreate {
-	lc.mutation.SetGarage(i)
-	return lc
+func (_c *ListingCreate) SetGarage(v int) *ListingCreate {
+	_c.mutation.SetGarage(v)
+	return _c
 }
 
 // SetNillableGarage sets the "garage" field if the given value is not nil.
-func (lc *ListingCreate) SetNillableGarage(i *int) *ListingCreate {
-	if i != nil {
-		lc.SetGarage(*i)
+func (_c *ListingCreate) SetNillableGarage(v *int) *ListingCreate {
+	if v != nil {
+		_c.SetGarage(*v)
 	}
-	return lc
+	return _c
 }
 
 // SetSqft sets the "sqft" field.
-func (lc *ListingCreate) SetSqft(i int) *ListingCreate {
-	lc.mutation.SetSqft(i)
-	return lc
+func (_c *ListingCreate) SetSqft(v int) *ListingCreate {
+	_c.mutation.SetSqft(v)
+	return _c
+}
+
+// SetSqm sets the "sqm" field.
+func (_c *ListingCreate) SetSqm(v int) *ListingCreate {
+	_c.mutation.SetSqm(v)
+	return _c
+}
+
+// SetNillableSqm sets the "sqm" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableSqm(v *int) *ListingCreate {
+	if v != nil {
+		_c.SetSqm(*v)
+	}
+	return _c
+}
+
+// SetAreaUnit sets the "area_unit" field.
+func (_c *ListingCreate) SetAreaUnit(v listing.AreaUnit) *ListingCreate {
+	_c.mutation.SetAreaUnit(v)
+	return _c
+}
+
+// SetNillableAreaUnit sets the "area_unit" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableAreaUnit(v *listing.AreaUnit) *ListingCreate {
+	if v != nil {
+		_c.SetAreaUnit(*v)
+	}
+	return _c
 }
 
 // SetTypeOfProperty sets the "type_of_property" field.
-func (lc *ListingCreate) SetTypeOfProperty(lop listing.TypeOfProperty) *ListingCreate {
-	lc.mutation.SetTypeOfProperty(lop)
-	return lc
+func (_c *ListingCreate) SetTypeOfProperty(v listing.TypeOfProperty) *ListingCreate {
+	_c.mutation.SetTypeOfProperty(v)
+	return _c
 }
 
 // SetNillableTypeOfProperty sets the "type_of_property" field if the given value is not nil.
-func (lc *ListingCreate) SetNillableTypeOfProperty(lop *listing.TypeOfProperty) *ListingCreate {
-	if lop != nil {
-		lc.SetTypeOfProperty(*lop)
+func (_c *ListingCreate) SetNillableTypeOfProperty(v *listing.TypeOfProperty) *ListingCreate {
+	if v != nil {
+		_c.SetTypeOfProperty(*v)
 	}
-	return lc
+	return _c
 }
 
 // SetStatus sets the "status" field.
-func (lc *ListingCreate) SetStatus(l listing.Status) *ListingCreate {
-	lc.mutation.SetStatus(l)
-	return lc
+func (_c *ListingCreate) SetStatus(v listing.Status) *ListingCreate {
+	_c.mutation.SetStatus(v)
+	return _c
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (lc *ListingCreate) SetNillableStatus(l *listing.Status) *ListingCreate {
-	if l != nil {
-		lc.SetStatus(*l)
+func (_c *ListingCreate) SetNillableStatus(v *listing.Status) *ListingCreate {
+	if v != nil {
+		_c.SetStatus(*v)
 	}
-	return lc
+	return _c
 }
 
 // SetLotSize sets the "lot_size" field.
-func (lc *ListingCreate) SetLotSize(i int) *ListingCreate {
-	lc.mutation.SetLotSize(i)
-	return lc
+func (_c *ListingCreate) SetLotSize(v int) *ListingCreate {
+	_c.mutation.SetLotSize(v)
+	return _c
 }
 
 // SetNillableLotSize sets the "lot_size" field if the given value is not nil.
-func (lc *ListingCreate) SetNillableLotSize(i *int) *ListingCreate {
-	if i != nil {
-		lc.SetLotSize(*i)
+func (_c *ListingCreate) SetNillableLotSize(v *int) *ListingCreate {
+	if v != nil {
+		_c.SetLotSize(*v)
 	}
-	return lc
+	return _c
 }
 
 // SetPool sets the "pool" field.
-func (lc *ListingCreate) SetPool(b bool) *ListingCreate {
-	lc.mutation.SetPool(b)
-	return lc
+func (_c *ListingCreate) SetPool(v bool) *ListingCreate {
+	_c.mutation.SetPool(v)
+	return _c
 }
 
 // SetNillablePool sets the "pool" field if the given value is not nil.
-func (lc *ListingCreate) SetNillablePool(b *bool) *ListingCreate {
-	if b != nil {
-		lc.SetPool(*b)
+func (_c *ListingCreate) SetNillablePool(v *bool) *ListingCreate {
+	if v != nil {
+		_c.SetPool(*v)
 	}
-	return lc
+	return _c
 }
 
 // SetYearBuilt sets the "year_built" field.
-func (lc *ListingCreate) SetYearBuilt(i int) *ListingCreate {
-	lc.mutation.SetYearBuilt(i)
-	return lc
+func (_c *ListingCreate) SetYearBuilt(v int) *ListingCreate {
+	_c.mutation.SetYearBuilt(v)
+	return _c
+}
+
+// SetAnnualPropertyTax sets the "annual_property_tax" field.
+func (_c *ListingCreate) SetAnnualPropertyTax(v decimal.Decimal) *ListingCreate {
+	_c.mutation.SetAnnualPropertyTax(v)
+	return _c
+}
+
+// SetNillableAnnualPropertyTax sets the "annual_property_tax" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableAnnualPropertyTax(v *decimal.Decimal) *ListingCreate {
+	if v != nil {
+		_c.SetAnnualPropertyTax(*v)
+	}
+	return _c
+}
+
+// SetHoaDues sets the "hoa_dues" field.
+func (_c *ListingCreate) SetHoaDues(v decimal.Decimal) *ListingCreate {
+	_c.mutation.SetHoaDues(v)
+	return _c
+}
+
+// SetNillableHoaDues sets the "hoa_dues" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableHoaDues(v *decimal.Decimal) *ListingCreate {
+	if v != nil {
+		_c.SetHoaDues(*v)
+	}
+	return _c
+}
+
+// SetHoaFrequency sets the "hoa_frequency" field.
+func (_c *ListingCreate) SetHoaFrequency(v listing.HoaFrequency) *ListingCreate {
+	_c.mutation.SetHoaFrequency(v)
+	return _c
+}
+
+// SetNillableHoaFrequency sets the "hoa_frequency" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableHoaFrequency(v *listing.HoaFrequency) *ListingCreate {
+	if v != nil {
+		_c.SetHoaFrequency(*v)
+	}
+	return _c
+}
+
+// SetHoaName sets the "hoa_name" field.
+func (_c *ListingCreate) SetHoaName(v string) *ListingCreate {
+	_c.mutation.SetHoaName(v)
+	return _c
+}
+
+// SetNillableHoaName sets the "hoa_name" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableHoaName(v *string) *ListingCreate {
+	if v != nil {
+		_c.SetHoaName(*v)
+	}
+	return _c
+}
+
+// SetSpecialAssessments sets the "special_assessments" field.
+func (_c *ListingCreate) SetSpecialAssessments(v decimal.Decimal) *ListingCreate {
+	_c.mutation.SetSpecialAssessments(v)
+	return _c
+}
+
+// SetNillableSpecialAssessments sets the "special_assessments" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableSpecialAssessments(v *decimal.Decimal) *ListingCreate {
+	if v != nil {
+		_c.SetSpecialAssessments(*v)
+	}
+	return _c
+}
+
+// SetMonthlyUtilities sets the "monthly_utilities" field.
+func (_c *ListingCreate) SetMonthlyUtilities(v decimal.Decimal) *ListingCreate {
+	_c.mutation.SetMonthlyUtilities(v)
+	return _c
+}
+
+// SetNillableMonthlyUtilities sets the "monthly_utilities" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableMonthlyUtilities(v *decimal.Decimal) *ListingCreate {
+	if v != nil {
+		_c.SetMonthlyUtilities(*v)
+	}
+	return _c
 }
 
 // SetMedia sets the "media" field.
-func (lc *ListingCreate) SetMedia(s []schema.Media) *ListingCreate {
-	lc.mutation.SetMedia(s)
-	return lc
+func (_c *ListingCreate) SetMedia(v []schematype.Media) *ListingCreate {
+	_c.mutation.SetMedia(v)
+	return _c
 }
 
 // SetRealtorID sets the "realtor_id" field.
-func (lc *ListingCreate) SetRealtorID(u uuid.UUID) *ListingCreate {
-	lc.mutation.SetRealtorID(u)
-	return lc
+func (_c *ListingCreate) SetRealtorID(v uuid.UUID) *ListingCreate {
+	_c.mutation.SetRealtorID(v)
+	return _c
+}
+
+// SetPropertyID sets the "property_id" field.
+func (_c *ListingCreate) SetPropertyID(v uuid.UUID) *ListingCreate {
+	_c.mutation.SetPropertyID(v)
+	return _c
+}
+
+// SetNillablePropertyID sets the "property_id" field if the given value is not nil.
+func (_c *ListingCreate) SetNillablePropertyID(v *uuid.UUID) *ListingCreate {
+	if v != nil {
+		_c.SetPropertyID(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ListingCreate) SetExpiresAt(v time.Time) *ListingCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableExpiresAt(v *time.Time) *ListingCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetExpiryReminderSentAt sets the "expiry_reminder_sent_at" field.
+func (_c *ListingCreate) SetExpiryReminderSentAt(v time.Time) *ListingCreate {
+	_c.mutation.SetExpiryReminderSentAt(v)
+	return _c
+}
+
+// SetNillableExpiryReminderSentAt sets the "expiry_reminder_sent_at" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableExpiryReminderSentAt(v *time.Time) *ListingCreate {
+	if v != nil {
+		_c.SetExpiryReminderSentAt(*v)
+	}
+	return _c
+}
+
+// SetExpired sets the "expired" field.
+func (_c *ListingCreate) SetExpired(v bool) *ListingCreate {
+	_c.mutation.SetExpired(v)
+	return _c
+}
+
+// SetNillableExpired sets the "expired" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableExpired(v *bool) *ListingCreate {
+	if v != nil {
+		_c.SetExpired(*v)
+	}
+	return _c
+}
+
+// SetListedAt sets the "listed_at" field.
+func (_c *ListingCreate) SetListedAt(v time.Time) *ListingCreate {
+	_c.mutation.SetListedAt(v)
+	return _c
+}
+
+// SetNillableListedAt sets the "listed_at" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableListedAt(v *time.Time) *ListingCreate {
+	if v != nil {
+		_c.SetListedAt(*v)
+	}
+	return _c
+}
+
+// SetOnMarketSince sets the "on_market_since" field.
+func (_c *ListingCreate) SetOnMarketSince(v time.Time) *ListingCreate {
+	_c.mutation.SetOnMarketSince(v)
+	return _c
+}
+
+// SetNillableOnMarketSince sets the "on_market_since" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableOnMarketSince(v *time.Time) *ListingCreate {
+	if v != nil {
+		_c.SetOnMarketSince(*v)
+	}
+	return _c
+}
+
+// SetMarketSeconds sets the "market_seconds" field.
+func (_c *ListingCreate) SetMarketSeconds(v int64) *ListingCreate {
+	_c.mutation.SetMarketSeconds(v)
+	return _c
+}
+
+// SetNillableMarketSeconds sets the "market_seconds" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableMarketSeconds(v *int64) *ListingCreate {
+	if v != nil {
+		_c.SetMarketSeconds(*v)
+	}
+	return _c
+}
+
+// SetSoldPrice sets the "sold_price" field.
+func (_c *ListingCreate) SetSoldPrice(v decimal.Decimal) *ListingCreate {
+	_c.mutation.SetSoldPrice(v)
+	return _c
+}
+
+// SetNillableSoldPrice sets the "sold_price" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableSoldPrice(v *decimal.Decimal) *ListingCreate {
+	if v != nil {
+		_c.SetSoldPrice(*v)
+	}
+	return _c
+}
+
+// SetCloseDate sets the "close_date" field.
+func (_c *ListingCreate) SetCloseDate(v time.Time) *ListingCreate {
+	_c.mutation.SetCloseDate(v)
+	return _c
+}
+
+// SetNillableCloseDate sets the "close_date" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableCloseDate(v *time.Time) *ListingCreate {
+	if v != nil {
+		_c.SetCloseDate(*v)
+	}
+	return _c
+}
+
+// SetBuyerRealtorID sets the "buyer_realtor_id" field.
+func (_c *ListingCreate) SetBuyerRealtorID(v uuid.UUID) *ListingCreate {
+	_c.mutation.SetBuyerRealtorID(v)
+	return _c
+}
+
+// SetNillableBuyerRealtorID sets the "buyer_realtor_id" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableBuyerRealtorID(v *uuid.UUID) *ListingCreate {
+	if v != nil {
+		_c.SetBuyerRealtorID(*v)
+	}
+	return _c
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (_c *ListingCreate) SetPublishAt(v time.Time) *ListingCreate {
+	_c.mutation.SetPublishAt(v)
+	return _c
+}
+
+// SetNillablePublishAt sets the "publish_at" field if the given value is not nil.
+func (_c *ListingCreate) SetNillablePublishAt(v *time.Time) *ListingCreate {
+	if v != nil {
+		_c.SetPublishAt(*v)
+	}
+	return _c
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (_c *ListingCreate) SetUnpublishAt(v time.Time) *ListingCreate {
+	_c.mutation.SetUnpublishAt(v)
+	return _c
+}
+
+// SetNillableUnpublishAt sets the "unpublish_at" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableUnpublishAt(v *time.Time) *ListingCreate {
+	if v != nil {
+		_c.SetUnpublishAt(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *ListingCreate) SetVersion(v int) *ListingCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *ListingCreate) SetNillableVersion(v *int) *ListingCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
 }
 
 // SetID sets the "id" field.
-func (lc *ListingCreate) SetID(u uuid.UUID) *ListingCreate {
-	lc.mutation.SetID(u)
-	return lc
+func (_c *ListingCreate) SetID(v uuid.UUID) *ListingCreate {
+	_c.mutation.SetID(v)
+	return _c
 }
 
 // SetNillableID sets the "id" field if the given value is not nil.
-func (lc *ListingCreate) SetNillableID(u *uuid.UUID) *ListingCreate {
-	if u != nil {
-		lc.SetID(*u)
+func (_c *ListingCreate) SetNillableID(v *uuid.UUID) *ListingCreate {
+	if v != nil {
+		_c.SetID(*v)
 	}
-	return lc
+	return _c
 }
 
 // SetRealtor sets the "realtor" edge to the Realtor entity.
-func (lc *ListingCreate) SetRealtor(r *Realtor) *ListingCreate {
-	return lc.SetRealtorID(r.ID)
+func (_c *ListingCreate) SetRealtor(v *Realtor) *ListingCreate {
+	return _c.SetRealtorID(v.ID)
+}
+
+// SetProperty sets the "property" edge to the Property entity.
+func (_c *ListingCreate) SetProperty(v *Property) *ListingCreate {
+	return _c.SetPropertyID(v.ID)
+}
+
+// SetNeighborhood sets the "neighborhood" edge to the Neighborhood entity.
+func (_c *ListingCreate) SetNeighborhood(v *Neighborhood) *ListingCreate {
+	return _c.SetNeighborhoodID(v.ID)
+}
+
+// SetBuyerRealtor sets the "buyer_realtor" edge to the Realtor entity.
+func (_c *ListingCreate) SetBuyerRealtor(v *Realtor) *ListingCreate {
+	return _c.SetBuyerRealtorID(v.ID)
+}
+
+// AddRenewalIDs adds the "renewals" edge to the ListingRenewal entity by IDs.
+func (_c *ListingCreate) AddRenewalIDs(ids ...uuid.UUID) *ListingCreate {
+	_c.mutation.AddRenewalIDs(ids...)
+	return _c
+}
+
+// AddRenewals adds the "renewals" edges to the ListingRenewal entity.
+func (_c *ListingCreate) AddRenewals(v ...*ListingRenewal) *ListingCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRenewalIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the ListingDocument entity by IDs.
+func (_c *ListingCreate) AddDocumentIDs(ids ...uuid.UUID) *ListingCreate {
+	_c.mutation.AddDocumentIDs(ids...)
+	return _c
+}
+
+// AddDocuments adds the "documents" edges to the ListingDocument entity.
+func (_c *ListingCreate) AddDocuments(v ...*ListingDocument) *ListingCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDocumentIDs(ids...)
+}
+
+// AddInquiryIDs adds the "inquiries" edge to the ListingInquiry entity by IDs.
+func (_c *ListingCreate) AddInquiryIDs(ids ...uuid.UUID) *ListingCreate {
+	_c.mutation.AddInquiryIDs(ids...)
+	return _c
+}
+
+// AddInquiries adds the "inquiries" edges to the ListingInquiry entity.
+func (_c *ListingCreate) AddInquiries(v ...*ListingInquiry) *ListingCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInquiryIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the ListingReview entity by IDs.
+func (_c *ListingCreate) AddReviewIDs(ids ...uuid.UUID) *ListingCreate {
+	_c.mutation.AddReviewIDs(ids...)
+	return _c
+}
+
+// AddReviews adds the "reviews" edges to the ListingReview entity.
+func (_c *ListingCreate) AddReviews(v ...*ListingReview) *ListingCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReviewIDs(ids...)
+}
+
+// AddOfferIDs adds the "offers" edge to the Offer entity by IDs.
+func (_c *ListingCreate) AddOfferIDs(ids ...uuid.UUID) *ListingCreate {
+	_c.mutation.AddOfferIDs(ids...)
+	return _c
+}
+
+// AddOffers adds the "offers" edges to the Offer entity.
+func (_c *ListingCreate) AddOffers(v ...*Offer) *ListingCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOfferIDs(ids...)
+}
+
+// AddStatusEventIDs adds the "status_events" edge to the ListingStatusEvent entity by IDs.
+func (_c *ListingCreate) AddStatusEventIDs(ids ...uuid.UUID) *ListingCreate {
+	_c.mutation.AddStatusEventIDs(ids...)
+	return _c
+}
+
+// AddStatusEvents adds the "status_events" edges to the ListingStatusEvent entity.
+func (_c *ListingCreate) AddStatusEvents(v ...*ListingStatusEvent) *ListingCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStatusEventIDs(ids...)
 }
 
 // Mutation returns the ListingMutation object of the builder.
-func (lc *ListingCreate) Mutation() *ListingMutation {
-	return lc.mutation
+func (_c *ListingCreate) Mutation() *ListingMutation {
+	return _c.mutation
 }
 
 // Save creates the Listing in the database.
-func (lc *ListingCreate) Save(ctx context.Context) (*Listing, error) {
-	lc.defaults()
-	return withHooks(ctx, lc.sqlSave, lc.mutation, lc.hooks)
+func (_c *ListingCreate) Save(ctx context.Context) (*Listing, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (lc *ListingCreate) SaveX(ctx context.Context) *Listing {
-	v, err := lc.Save(ctx)
+func (_c *ListingCreate) SaveX(ctx context.Context) *Listing {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -248,166 +764,285 @@ func (lc *ListingCreate) SaveX(ctx context.Context) *Listing {
 }
 
 // Exec executes the query.
-func (lc *ListingCreate) Exec(ctx context.Context) error {
-	_, err := lc.Save(ctx)
+func (_c *ListingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (lc *ListingCreate) ExecX(ctx context.Context) {
-	if err := lc.Exec(ctx); err != nil {
+func (_c *ListingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (lc *ListingCreate) defaults() {
-	if _, ok := lc.mutation.CreateTime(); !ok {
+func (_c *ListingCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if listing.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized listing.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := listing.DefaultCreateTime()
-		lc.mutation.SetCreateTime(v)
+		_c.mutation.SetCreateTime(v)
 	}
-	if _, ok := lc.mutation.UpdateTime(); !ok {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if listing.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listing.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := listing.DefaultUpdateTime()
-		lc.mutation.SetUpdateTime(v)
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Country(); !ok {
+		v := listing.DefaultCountry
+		_c.mutation.SetCountry(v)
+	}
+	if _, ok := _c.mutation.SourceLocale(); !ok {
+		v := listing.DefaultSourceLocale
+		_c.mutation.SetSourceLocale(v)
 	}
-	if _, ok := lc.mutation.TypeOfProperty(); !ok {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := listing.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.AreaUnit(); !ok {
+		v := listing.DefaultAreaUnit
+		_c.mutation.SetAreaUnit(v)
+	}
+	if _, ok := _c.mutation.TypeOfProperty(); !ok {
 		v := listing.DefaultTypeOfProperty
-		lc.mutation.SetTypeOfProperty(v)
+		_c.mutation.SetTypeOfProperty(v)
 	}
-	if _, ok := lc.mutation.Status(); !ok {
+	if _, ok := _c.mutation.Status(); !ok {
 		v := listing.DefaultStatus
-		lc.mutation.SetStatus(v)
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Expired(); !ok {
+		v := listing.DefaultExpired
+		_c.mutation.SetExpired(v)
+	}
+	if _, ok := _c.mutation.MarketSeconds(); !ok {
+		v := listing.DefaultMarketSeconds
+		_c.mutation.SetMarketSeconds(v)
 	}
-	if _, ok := lc.mutation.ID(); !ok {
+	if _, ok := _c.mutation.Version(); !ok {
+		v := listing.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if listing.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized listing.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := listing.DefaultID()
-		lc.mutation.SetID(v)
+		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (lc *ListingCreate) check() error {
-	if _, ok := lc.mutation.CreateTime(); !ok {
+func (_c *ListingCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
 		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Listing.create_time"`)}
 	}
-	if _, ok := lc.mutation.UpdateTime(); !ok {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
 		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Listing.update_time"`)}
 	}
-	if _, ok := lc.mutation.Title(); !ok {
+	if _, ok := _c.mutation.Title(); !ok {
 		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Listing.title"`)}
 	}
-	if v, ok := lc.mutation.Title(); ok {
+	if v, ok := _c.mutation.Title(); ok {
 		if err := listing.TitleValidator(v); err != nil {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Listing.title": %w`, err)}
 		}
 	}
-	if _, ok := lc.mutation.Address(); !ok {
+	if _, ok := _c.mutation.Address(); !ok {
 		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "Listing.address"`)}
 	}
-	if v, ok := lc.mutation.Address(); ok {
+	if v, ok := _c.mutation.Address(); ok {
 		if err := listing.AddressValidator(v); err != nil {
 			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Listing.address": %w`, err)}
 		}
 	}
-	if _, ok := lc.mutation.City(); !ok {
+	if v, ok := _c.mutation.AddressKey(); ok {
+		if err := listing.AddressKeyValidator(v); err != nil {
+			return &ValidationError{Name: "address_key", err: fmt.Errorf(`ent: validator failed for field "Listing.address_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.City(); !ok {
 		return &ValidationError{Name: "city", err: errors.New(`ent: missing required field "Listing.city"`)}
 	}
-	if v, ok := lc.mutation.City(); ok {
+	if v, ok := _c.mutation.City(); ok {
 		if err := listing.CityValidator(v); err != nil {
 			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Listing.city": %w`, err)}
 		}
 	}
-	if _, ok := lc.mutation.State(); !ok {
+	if _, ok := _c.mutation.State(); !ok {
 		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Listing.state"`)}
 	}
-	if v, ok := lc.mutation.State(); ok {
+	if v, ok := _c.mutation.State(); ok {
 		if err := listing.StateValidator(v); err != nil {
 			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Listing.state": %w`, err)}
 		}
 	}
-	if _, ok := lc.mutation.ZipCode(); !ok {
+	if _, ok := _c.mutation.ZipCode(); !ok {
 		return &ValidationError{Name: "zip_code", err: errors.New(`ent: missing required field "Listing.zip_code"`)}
 	}
-	if v, ok := lc.mutation.ZipCode(); ok {
+	if v, ok := _c.mutation.ZipCode(); ok {
 		if err := listing.ZipCodeValidator(v); err != nil {
 			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Listing.zip_code": %w`, err)}
 		}
 	}
-	if _, ok := lc.mutation.Price(); !ok {
+	if _, ok := _c.mutation.Country(); !ok {
+		return &ValidationError{Name: "country", err: errors.New(`ent: missing required field "Listing.country"`)}
+	}
+	if v, ok := _c.mutation.Country(); ok {
+		if err := listing.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "Listing.country": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Latitude(); ok {
+		if err := listing.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "Listing.latitude": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Longitude(); ok {
+		if err := listing.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "Listing.longitude": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SourceLocale(); !ok {
+		return &ValidationError{Name: "source_locale", err: errors.New(`ent: missing required field "Listing.source_locale"`)}
+	}
+	if v, ok := _c.mutation.SourceLocale(); ok {
+		if err := listing.SourceLocaleValidator(v); err != nil {
+			return &ValidationError{Name: "source_locale", err: fmt.Errorf(`ent: validator failed for field "Listing.source_locale": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
 		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Listing.price"`)}
 	}
-	if _, ok := lc.mutation.Bedroom(); !ok {
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Listing.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := listing.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Listing.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Bedroom(); !ok {
 		return &ValidationError{Name: "bedroom", err: errors.New(`ent: missing required field "Listing.bedroom"`)}
 	}
-	if v, ok := lc.mutation.Bedroom(); ok {
+	if v, ok := _c.mutation.Bedroom(); ok {
 		if err := listing.BedroomValidator(v); err != nil {
 			return &ValidationError{Name: "bedroom", err: fmt.Errorf(`ent: validator failed for field "Listing.bedroom": %w`, err)}
 		}
 	}
-	if _, ok := lc.mutation.Bathroom(); !ok {
+	if _, ok := _c.mutation.Bathroom(); !ok {
 		return &ValidationError{Name: "bathroom", err: errors.New(`ent: missing required field "Listing.bathroom"`)}
 	}
-	if v, ok := lc.mutation.Bathroom(); ok {
+	if v, ok := _c.mutation.Bathroom(); ok {
 		if err := listing.BathroomValidator(v); err != nil {
 			return &ValidationError{Name: "bathroom", err: fmt.Errorf(`ent: validator failed for field "Listing.bathroom": %w`, err)}
 		}
 	}
-	if v, ok := lc.mutation.Garage(); ok {
+	if v, ok := _c.mutation.Garage(); ok {
 		if err := listing.GarageValidator(v); err != nil {
 			return &ValidationError{Name: "garage", err: fmt.Errorf(`ent: validator failed for field "Listing.garage": %w`, err)}
 		}
 	}
-	if _, ok := lc.mutation.Sqft(); !ok {
+	if _, ok := _c.mutation.Sqft(); !ok {
 		return &ValidationError{Name: "sqft", err: errors.New(`ent: missing required field "Listing.sqft"`)}
 	}
-	if v, ok := lc.mutation.Sqft(); ok {
+	if v, ok := _c.mutation.Sqft(); ok {
 		if err := listing.SqftValidator(v); err != nil {
 			return &ValidationError{Name: "sqft", err: fmt.Errorf(`ent: validator failed for field "Listing.sqft": %w`, err)}
 		}
 	}
-	if _, ok := lc.mutation.TypeOfProperty(); !ok {
+	if v, ok := _c.mutation.Sqm(); ok {
+		if err := listing.SqmValidator(v); err != nil {
+			return &ValidationError{Name: "sqm", err: fmt.Errorf(`ent: validator failed for field "Listing.sqm": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AreaUnit(); !ok {
+		return &ValidationError{Name: "area_unit", err: errors.New(`ent: missing required field "Listing.area_unit"`)}
+	}
+	if v, ok := _c.mutation.AreaUnit(); ok {
+		if err := listing.AreaUnitValidator(v); err != nil {
+			return &ValidationError{Name: "area_unit", err: fmt.Errorf(`ent: validator failed for field "Listing.area_unit": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TypeOfProperty(); !ok {
 		return &ValidationError{Name: "type_of_property", err: errors.New(`ent: missing required field "Listing.type_of_property"`)}
 	}
-	if v, ok := lc.mutation.TypeOfProperty(); ok {
+	if v, ok := _c.mutation.TypeOfProperty(); ok {
 		if err := listing.TypeOfPropertyValidator(v); err != nil {
 			return &ValidationError{Name: "type_of_property", err: fmt.Errorf(`ent: validator failed for field "Listing.type_of_property": %w`, err)}
 		}
 	}
-	if _, ok := lc.mutation.Status(); !ok {
+	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Listing.status"`)}
 	}
-	if v, ok := lc.mutation.Status(); ok {
+	if v, ok := _c.mutation.Status(); ok {
 		if err := listing.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Listing.status": %w`, err)}
 		}
 	}
-	if v, ok := lc.mutation.LotSize(); ok {
+	if v, ok := _c.mutation.LotSize(); ok {
 		if err := listing.LotSizeValidator(v); err != nil {
 			return &ValidationError{Name: "lot_size", err: fmt.Errorf(`ent: validator failed for field "Listing.lot_size": %w`, err)}
 		}
 	}
-	if _, ok := lc.mutation.YearBuilt(); !ok {
+	if _, ok := _c.mutation.YearBuilt(); !ok {
 		return &ValidationError{Name: "year_built", err: errors.New(`ent: missing required field "Listing.year_built"`)}
 	}
-	if v, ok := lc.mutation.YearBuilt(); ok {
+	if v, ok := _c.mutation.YearBuilt(); ok {
 		if err := listing.YearBuiltValidator(v); err != nil {
 			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Listing.year_built": %w`, err)}
 		}
 	}
-	if _, ok := lc.mutation.RealtorID(); !ok {
+	if v, ok := _c.mutation.HoaFrequency(); ok {
+		if err := listing.HoaFrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "hoa_frequency", err: fmt.Errorf(`ent: validator failed for field "Listing.hoa_frequency": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.HoaName(); ok {
+		if err := listing.HoaNameValidator(v); err != nil {
+			return &ValidationError{Name: "hoa_name", err: fmt.Errorf(`ent: validator failed for field "Listing.hoa_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RealtorID(); !ok {
 		return &ValidationError{Name: "realtor_id", err: errors.New(`ent: missing required field "Listing.realtor_id"`)}
 	}
-	if len(lc.mutation.RealtorIDs()) == 0 {
+	if _, ok := _c.mutation.Expired(); !ok {
+		return &ValidationError{Name: "expired", err: errors.New(`ent: missing required field "Listing.expired"`)}
+	}
+	if _, ok := _c.mutation.MarketSeconds(); !ok {
+		return &ValidationError{Name: "market_seconds", err: errors.New(`ent: missing required field "Listing.market_seconds"`)}
+	}
+	if v, ok := _c.mutation.MarketSeconds(); ok {
+		if err := listing.MarketSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "market_seconds", err: fmt.Errorf(`ent: validator failed for field "Listing.market_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Listing.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := listing.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Listing.version": %w`, err)}
+		}
+	}
+	if len(_c.mutation.RealtorIDs()) == 0 {
 		return &ValidationError{Name: "realtor", err: errors.New(`ent: missing required edge "Listing.realtor"`)}
 	}
 	return nil
 }
 
-func (lc *ListingCreate) sqlSave(ctx context.Context) (*Listing, error) {
-	if err := lc.check(); err != nil {
+func (_c *ListingCreate) sqlSave(ctx context.Context) (*Listing, error) {
+	if err := _c.check(); err != nil {
 		return nil, err
 	}
-	_node, _spec := lc.createSpec()
-	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -420,97 +1055,202 @@ func (lc *ListingCreate) sqlSave(ctx context.Context) (*Listing, error) {
 			return nil, err
 		}
 	}
-	lc.mutation.id = &_node.ID
-	lc.mutation.done = true
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
 	return _node, nil
 }
 
-func (lc *ListingCreate) createSpec() (*Listing, *sqlgraph.CreateSpec) {
+func (_c *ListingCreate) createSpec() (*Listing, *sqlgraph.CreateSpec) {
 	var (
-		_node = &Listing{config: lc.config}
+		_node = &Listing{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(listing.Table, sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID))
 	)
-	if id, ok := lc.mutation.ID(); ok {
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
-	if value, ok := lc.mutation.CreateTime(); ok {
+	if value, ok := _c.mutation.CreateTime(); ok {
 		_spec.SetField(listing.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
 	}
-	if value, ok := lc.mutation.UpdateTime(); ok {
+	if value, ok := _c.mutation.UpdateTime(); ok {
 		_spec.SetField(listing.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
-	if value, ok := lc.mutation.Title(); ok {
+	if value, ok := _c.mutation.Title(); ok {
 		_spec.SetField(listing.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
-	if value, ok := lc.mutation.Address(); ok {
+	if value, ok := _c.mutation.Address(); ok {
 		_spec.SetField(listing.FieldAddress, field.TypeString, value)
 		_node.Address = value
 	}
-	if value, ok := lc.mutation.City(); ok {
+	if value, ok := _c.mutation.AddressKey(); ok {
+		_spec.SetField(listing.FieldAddressKey, field.TypeString, value)
+		_node.AddressKey = value
+	}
+	if value, ok := _c.mutation.City(); ok {
 		_spec.SetField(listing.FieldCity, field.TypeString, value)
 		_node.City = value
 	}
-	if value, ok := lc.mutation.State(); ok {
+	if value, ok := _c.mutation.State(); ok {
 		_spec.SetField(listing.FieldState, field.TypeString, value)
 		_node.State = value
 	}
-	if value, ok := lc.mutation.ZipCode(); ok {
+	if value, ok := _c.mutation.ZipCode(); ok {
 		_spec.SetField(listing.FieldZipCode, field.TypeString, value)
 		_node.ZipCode = value
 	}
-	if value, ok := lc.mutation.Description(); ok {
+	if value, ok := _c.mutation.Country(); ok {
+		_spec.SetField(listing.FieldCountry, field.TypeString, value)
+		_node.Country = value
+	}
+	if value, ok := _c.mutation.Latitude(); ok {
+		_spec.SetField(listing.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = &value
+	}
+	if value, ok := _c.mutation.Longitude(); ok {
+		_spec.SetField(listing.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
 		_spec.SetField(listing.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
-	if value, ok := lc.mutation.Price(); ok {
+	if value, ok := _c.mutation.SourceLocale(); ok {
+		_spec.SetField(listing.FieldSourceLocale, field.TypeString, value)
+		_node.SourceLocale = value
+	}
+	if value, ok := _c.mutation.Translations(); ok {
+		_spec.SetField(listing.FieldTranslations, field.TypeJSON, value)
+		_node.Translations = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
 		_spec.SetField(listing.FieldPrice, field.TypeFloat64, value)
 		_node.Price = value
 	}
-	if value, ok := lc.mutation.Bedroom(); ok {
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(listing.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Bedroom(); ok {
 		_spec.SetField(listing.FieldBedroom, field.TypeInt, value)
 		_node.Bedroom = value
 	}
-	if value, ok := lc.mutation.Bathroom(); ok {
+	if value, ok := _c.mutation.Bathroom(); ok {
 		_spec.SetField(listing.FieldBathroom, field.TypeFloat64, value)
 		_node.Bathroom = value
 	}
-	if value, ok := lc.mutation.Garage(); ok {
+	if value, ok := _c.mutation.Garage(); ok {
 		_spec.SetField(listing.FieldGarage, field.TypeInt, value)
 		_node.Garage = value
 	}
-	if value, ok := lc.mutation.Sqft(); ok {
+	if value, ok := _c.mutation.Sqft(); ok {
 		_spec.SetField(listing.FieldSqft, field.TypeInt, value)
 		_node.Sqft = value
 	}
-	if value, ok := lc.mutation.TypeOfProperty(); ok {
+	if value, ok := _c.mutation.Sqm(); ok {
+		_spec.SetField(listing.FieldSqm, field.TypeInt, value)
+		_node.Sqm = value
+	}
+	if value, ok := _c.mutation.AreaUnit(); ok {
+		_spec.SetField(listing.FieldAreaUnit, field.TypeEnum, value)
+		_node.AreaUnit = value
+	}
+	if value, ok := _c.mutation.TypeOfProperty(); ok {
 		_spec.SetField(listing.FieldTypeOfProperty, field.TypeEnum, value)
 		_node.TypeOfProperty = value
 	}
-	if value, ok := lc.mutation.Status(); ok {
+	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(listing.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
-	if value, ok := lc.mutation.LotSize(); ok {
+	if value, ok := _c.mutation.LotSize(); ok {
 		_spec.SetField(listing.FieldLotSize, field.TypeInt, value)
 		_node.LotSize = value
 	}
-	if value, ok := lc.mutation.Pool(); ok {
+	if value, ok := _c.mutation.Pool(); ok {
 		_spec.SetField(listing.FieldPool, field.TypeBool, value)
 		_node.Pool = value
 	}
-	if value, ok := lc.mutation.YearBuilt(); ok {
+	if value, ok := _c.mutation.YearBuilt(); ok {
 		_spec.SetField(listing.FieldYearBuilt, field.TypeInt, value)
 		_node.YearBuilt = value
 	}
-	if value, ok := lc.mutation.Media(); ok {
+	if value, ok := _c.mutation.AnnualPropertyTax(); ok {
+		_spec.SetField(listing.FieldAnnualPropertyTax, field.TypeFloat64, value)
+		_node.AnnualPropertyTax = &value
+	}
+	if value, ok := _c.mutation.HoaDues(); ok {
+		_spec.SetField(listing.FieldHoaDues, field.TypeFloat64, value)
+		_node.HoaDues = &value
+	}
+	if value, ok := _c.mutation.HoaFrequency(); ok {
+		_spec.SetField(listing.FieldHoaFrequency, field.TypeEnum, value)
+		_node.HoaFrequency = &value
+	}
+	if value, ok := _c.mutation.HoaName(); ok {
+		_spec.SetField(listing.FieldHoaName, field.TypeString, value)
+		_node.HoaName = value
+	}
+	if value, ok := _c.mutation.SpecialAssessments(); ok {
+		_spec.SetField(listing.FieldSpecialAssessments, field.TypeFloat64, value)
+		_node.SpecialAssessments = &value
+	}
+	if value, ok := _c.mutation.MonthlyUtilities(); ok {
+		_spec.SetField(listing.FieldMonthlyUtilities, field.TypeFloat64, value)
+		_node.MonthlyUtilities = &value
+	}
+	if value, ok := _c.mutation.Media(); ok {
 		_spec.SetField(listing.FieldMedia, field.TypeJSON, value)
 		_node.Media = value
 	}
-	if nodes := lc.mutation.RealtorIDs(); len(nodes) > 0 {
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(listing.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.ExpiryReminderSentAt(); ok {
+		_spec.SetField(listing.FieldExpiryReminderSentAt, field.TypeTime, value)
+		_node.ExpiryReminderSentAt = &value
+	}
+	if value, ok := _c.mutation.Expired(); ok {
+		_spec.SetField(listing.FieldExpired, field.TypeBool, value)
+		_node.Expired = value
+	}
+	if value, ok := _c.mutation.ListedAt(); ok {
+		_spec.SetField(listing.FieldListedAt, field.TypeTime, value)
+		_node.ListedAt = &value
+	}
+	if value, ok := _c.mutation.OnMarketSince(); ok {
+		_spec.SetField(listing.FieldOnMarketSince, field.TypeTime, value)
+		_node.OnMarketSince = &value
+	}
+	if value, ok := _c.mutation.MarketSeconds(); ok {
+		_spec.SetField(listing.FieldMarketSeconds, field.TypeInt64, value)
+		_node.MarketSeconds = value
+	}
+	if value, ok := _c.mutation.SoldPrice(); ok {
+		_spec.SetField(listing.FieldSoldPrice, field.TypeFloat64, value)
+		_node.SoldPrice = &value
+	}
+	if value, ok := _c.mutation.CloseDate(); ok {
+		_spec.SetField(listing.FieldCloseDate, field.TypeTime, value)
+		_node.CloseDate = &value
+	}
+	if value, ok := _c.mutation.PublishAt(); ok {
+		_spec.SetField(listing.FieldPublishAt, field.TypeTime, value)
+		_node.PublishAt = &value
+	}
+	if value, ok := _c.mutation.UnpublishAt(); ok {
+		_spec.SetField(listing.FieldUnpublishAt, field.TypeTime, value)
+		_node.UnpublishAt = &value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(listing.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if nodes := _c.mutation.RealtorIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -527,89 +1267,3293 @@ func (lc *ListingCreate) createSpec() (*Listing, *sqlgraph.CreateSpec) {
 		_node.RealtorID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	return _node, _spec
-}
-
-// ListingCreateBulk is the builder for creating many Listing entities in bulk.
-type ListingCreateBulk struct {
-	config
-	err      error
-	builders []*ListingCreate
+	if nodes := _c.mutation.PropertyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.PropertyTable,
+			Columns: []string{listing.PropertyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(property.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PropertyID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NeighborhoodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.NeighborhoodTable,
+			Columns: []string{listing.NeighborhoodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(neighborhood.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.NeighborhoodID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BuyerRealtorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.BuyerRealtorTable,
+			Columns: []string{listing.BuyerRealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BuyerRealtorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RenewalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.RenewalsTable,
+			Columns: []string{listing.RenewalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingrenewal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.DocumentsTable,
+			Columns: []string{listing.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InquiriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.InquiriesTable,
+			Columns: []string{listing.InquiriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listinginquiry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.ReviewsTable,
+			Columns: []string{listing.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingreview.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OffersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.OffersTable,
+			Columns: []string{listing.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.StatusEventsTable,
+			Columns: []string{listing.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
 }
 
-// Save creates the Listing entities in the database.
-func (lcb *ListingCreateBulk) Save(ctx context.Context) ([]*Listing, error) {
-	if lcb.err != nil {
-		return nil, lcb.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
-	nodes := make([]*Listing, len(lcb.builders))
-	mutators := make([]Mutator, len(lcb.builders))
-	for i := range lcb.builders {
-		func(i int, root context.Context) {
-			builder := lcb.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*ListingMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, lcb.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Listing.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingCreate) OnConflict(opts ...sql.ConflictOption) *ListingUpsertOne {
+	_c.conflict = opts
+	return &ListingUpsertOne{
+		create: _c,
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, lcb.builders[0].mutation); err != nil {
-			return nil, err
-		}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingCreate) OnConflictColumns(columns ...string) *ListingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingUpsertOne{
+		create: _c,
 	}
-	return nodes, nil
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (lcb *ListingCreateBulk) SaveX(ctx context.Context) []*Listing {
-	v, err := lcb.Save(ctx)
-	if err != nil {
-		panic(err)
+type (
+	// ListingUpsertOne is the builder for "upsert"-ing
+	//  one Listing node.
+	ListingUpsertOne struct {
+		create *ListingCreate
 	}
-	return v
+
+	// ListingUpsert is the "OnConflict" setter.
+	ListingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingUpsert) SetUpdateTime(v time.Time) *ListingUpsert {
+	u.Set(listing.FieldUpdateTime, v)
+	return u
 }
 
-// Exec executes the query.
-func (lcb *ListingCreateBulk) Exec(ctx context.Context) error {
-	_, err := lcb.Save(ctx)
-	return err
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateUpdateTime() *ListingUpsert {
+	u.SetExcluded(listing.FieldUpdateTime)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ListingUpsert) SetTitle(v string) *ListingUpsert {
+	u.Set(listing.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateTitle() *ListingUpsert {
+	u.SetExcluded(listing.FieldTitle)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *ListingUpsert) SetAddress(v string) *ListingUpsert {
+	u.Set(listing.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateAddress() *ListingUpsert {
+	u.SetExcluded(listing.FieldAddress)
+	return u
+}
+
+// SetAddressKey sets the "address_key" field.
+func (u *ListingUpsert) SetAddressKey(v string) *ListingUpsert {
+	u.Set(listing.FieldAddressKey, v)
+	return u
+}
+
+// UpdateAddressKey sets the "address_key" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateAddressKey() *ListingUpsert {
+	u.SetExcluded(listing.FieldAddressKey)
+	return u
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (u *ListingUpsert) ClearAddressKey() *ListingUpsert {
+	u.SetNull(listing.FieldAddressKey)
+	return u
+}
+
+// SetCity sets the "city" field.
+func (u *ListingUpsert) SetCity(v string) *ListingUpsert {
+	u.Set(listing.FieldCity, v)
+	return u
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateCity() *ListingUpsert {
+	u.SetExcluded(listing.FieldCity)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *ListingUpsert) SetState(v string) *ListingUpsert {
+	u.Set(listing.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateState() *ListingUpsert {
+	u.SetExcluded(listing.FieldState)
+	return u
+}
+
+// SetZipCode sets the "zip_code" field.
+func (u *ListingUpsert) SetZipCode(v string) *ListingUpsert {
+	u.Set(listing.FieldZipCode, v)
+	return u
+}
+
+// UpdateZipCode sets the "zip_code" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateZipCode() *ListingUpsert {
+	u.SetExcluded(listing.FieldZipCode)
+	return u
+}
+
+// SetCountry sets the "country" field.
+func (u *ListingUpsert) SetCountry(v string) *ListingUpsert {
+	u.Set(listing.FieldCountry, v)
+	return u
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateCountry() *ListingUpsert {
+	u.SetExcluded(listing.FieldCountry)
+	return u
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *ListingUpsert) SetLatitude(v float64) *ListingUpsert {
+	u.Set(listing.FieldLatitude, v)
+	return u
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateLatitude() *ListingUpsert {
+	u.SetExcluded(listing.FieldLatitude)
+	return u
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *ListingUpsert) AddLatitude(v float64) *ListingUpsert {
+	u.Add(listing.FieldLatitude, v)
+	return u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (u *ListingUpsert) ClearLatitude() *ListingUpsert {
+	u.SetNull(listing.FieldLatitude)
+	return u
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *ListingUpsert) SetLongitude(v float64) *ListingUpsert {
+	u.Set(listing.FieldLongitude, v)
+	return u
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateLongitude() *ListingUpsert {
+	u.SetExcluded(listing.FieldLongitude)
+	return u
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *ListingUpsert) AddLongitude(v float64) *ListingUpsert {
+	u.Add(listing.FieldLongitude, v)
+	return u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (u *ListingUpsert) ClearLongitude() *ListingUpsert {
+	u.SetNull(listing.FieldLongitude)
+	return u
+}
+
+// SetNeighborhoodID sets the "neighborhood_id" field.
+func (u *ListingUpsert) SetNeighborhoodID(v uuid.UUID) *ListingUpsert {
+	u.Set(listing.FieldNeighborhoodID, v)
+	return u
+}
+
+// UpdateNeighborhoodID sets the "neighborhood_id" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateNeighborhoodID() *ListingUpsert {
+	u.SetExcluded(listing.FieldNeighborhoodID)
+	return u
+}
+
+// ClearNeighborhoodID clears the value of the "neighborhood_id" field.
+func (u *ListingUpsert) ClearNeighborhoodID() *ListingUpsert {
+	u.SetNull(listing.FieldNeighborhoodID)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ListingUpsert) SetDescription(v string) *ListingUpsert {
+	u.Set(listing.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateDescription() *ListingUpsert {
+	u.SetExcluded(listing.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ListingUpsert) ClearDescription() *ListingUpsert {
+	u.SetNull(listing.FieldDescription)
+	return u
+}
+
+// SetSourceLocale sets the "source_locale" field.
+func (u *ListingUpsert) SetSourceLocale(v string) *ListingUpsert {
+	u.Set(listing.FieldSourceLocale, v)
+	return u
+}
+
+// UpdateSourceLocale sets the "source_locale" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateSourceLocale() *ListingUpsert {
+	u.SetExcluded(listing.FieldSourceLocale)
+	return u
+}
+
+// SetTranslations sets the "translations" field.
+func (u *ListingUpsert) SetTranslations(v map[string]schematype.Translation) *ListingUpsert {
+	u.Set(listing.FieldTranslations, v)
+	return u
+}
+
+// UpdateTranslations sets the "translations" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateTranslations() *ListingUpsert {
+	u.SetExcluded(listing.FieldTranslations)
+	return u
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (u *ListingUpsert) ClearTranslations() *ListingUpsert {
+	u.SetNull(listing.FieldTranslations)
+	return u
+}
+
+// SetPrice sets the "price" field.
+func (u *ListingUpsert) SetPrice(v decimal.Decimal) *ListingUpsert {
+	u.Set(listing.FieldPrice, v)
+	return u
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ListingUpsert) UpdatePrice() *ListingUpsert {
+	u.SetExcluded(listing.FieldPrice)
+	return u
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ListingUpsert) AddPrice(v decimal.Decimal) *ListingUpsert {
+	u.Add(listing.FieldPrice, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ListingUpsert) SetCurrency(v string) *ListingUpsert {
+	u.Set(listing.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateCurrency() *ListingUpsert {
+	u.SetExcluded(listing.FieldCurrency)
+	return u
+}
+
+// SetBedroom sets the "bedroom" field.
+func (u *ListingUpsert) SetBedroom(v int) *ListingUpsert {
+	u.Set(listing.FieldBedroom, v)
+	return u
+}
+
+// UpdateBedroom sets the "bedroom" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateBedroom() *ListingUpsert {
+	u.SetExcluded(listing.FieldBedroom)
+	return u
+}
+
+// AddBedroom adds v to the "bedroom" field.
+func (u *ListingUpsert) AddBedroom(v int) *ListingUpsert {
+	u.Add(listing.FieldBedroom, v)
+	return u
+}
+
+// SetBathroom sets the "bathroom" field.
+func (u *ListingUpsert) SetBathroom(v float64) *ListingUpsert {
+	u.Set(listing.FieldBathroom, v)
+	return u
+}
+
+// UpdateBathroom sets the "bathroom" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateBathroom() *ListingUpsert {
+	u.SetExcluded(listing.FieldBathroom)
+	return u
+}
+
+// AddBathroom adds v to the "bathroom" field.
+func (u *ListingUpsert) AddBathroom(v float64) *ListingUpsert {
+	u.Add(listing.FieldBathroom, v)
+	return u
+}
+
+// SetGarage sets the "garage" field.
+func (u *ListingUpsert) SetGarage(v int) *ListingUpsert {
+	u.Set(listing.FieldGarage, v)
+	return u
+}
+
+// UpdateGarage sets the "garage" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateGarage() *ListingUpsert {
+	u.SetExcluded(listing.FieldGarage)
+	return u
+}
+
+// AddGarage adds v to the "garage" field.
+func (u *ListingUpsert) AddGarage(v int) *ListingUpsert {
+	u.Add(listing.FieldGarage, v)
+	return u
+}
+
+// ClearGarage clears the value of the "garage" field.
+func (u *ListingUpsert) ClearGarage() *ListingUpsert {
+	u.SetNull(listing.FieldGarage)
+	return u
+}
+
+// SetSqft sets the "sqft" field.
+func (u *ListingUpsert) SetSqft(v int) *ListingUpsert {
+	u.Set(listing.FieldSqft, v)
+	return u
+}
+
+// UpdateSqft sets the "sqft" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateSqft() *ListingUpsert {
+	u.SetExcluded(listing.FieldSqft)
+	return u
+}
+
+// AddSqft adds v to the "sqft" field.
+func (u *ListingUpsert) AddSqft(v int) *ListingUpsert {
+	u.Add(listing.FieldSqft, v)
+	return u
+}
+
+// SetSqm sets the "sqm" field.
+func (u *ListingUpsert) SetSqm(v int) *ListingUpsert {
+	u.Set(listing.FieldSqm, v)
+	return u
+}
+
+// UpdateSqm sets the "sqm" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateSqm() *ListingUpsert {
+	u.SetExcluded(listing.FieldSqm)
+	return u
+}
+
+// AddSqm adds v to the "sqm" field.
+func (u *ListingUpsert) AddSqm(v int) *ListingUpsert {
+	u.Add(listing.FieldSqm, v)
+	return u
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (u *ListingUpsert) ClearSqm() *ListingUpsert {
+	u.SetNull(listing.FieldSqm)
+	return u
+}
+
+// SetAreaUnit sets the "area_unit" field.
+func (u *ListingUpsert) SetAreaUnit(v listing.AreaUnit) *ListingUpsert {
+	u.Set(listing.FieldAreaUnit, v)
+	return u
+}
+
+// UpdateAreaUnit sets the "area_unit" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateAreaUnit() *ListingUpsert {
+	u.SetExcluded(listing.FieldAreaUnit)
+	return u
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (u *ListingUpsert) SetTypeOfProperty(v listing.TypeOfProperty) *ListingUpsert {
+	u.Set(listing.FieldTypeOfProperty, v)
+	return u
+}
+
+// UpdateTypeOfProperty sets the "type_of_property" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateTypeOfProperty() *ListingUpsert {
+	u.SetExcluded(listing.FieldTypeOfProperty)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingUpsert) SetStatus(v listing.Status) *ListingUpsert {
+	u.Set(listing.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateStatus() *ListingUpsert {
+	u.SetExcluded(listing.FieldStatus)
+	return u
+}
+
+// SetLotSize sets the "lot_size" field.
+func (u *ListingUpsert) SetLotSize(v int) *ListingUpsert {
+	u.Set(listing.FieldLotSize, v)
+	return u
+}
+
+// UpdateLotSize sets the "lot_size" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateLotSize() *ListingUpsert {
+	u.SetExcluded(listing.FieldLotSize)
+	return u
+}
+
+// AddLotSize adds v to the "lot_size" field.
+func (u *ListingUpsert) AddLotSize(v int) *ListingUpsert {
+	u.Add(listing.FieldLotSize, v)
+	return u
+}
+
+// ClearLotSize clears the value of the "lot_size" field.
+func (u *ListingUpsert) ClearLotSize() *ListingUpsert {
+	u.SetNull(listing.FieldLotSize)
+	return u
+}
+
+// SetPool sets the "pool" field.
+func (u *ListingUpsert) SetPool(v bool) *ListingUpsert {
+	u.Set(listing.FieldPool, v)
+	return u
+}
+
+// UpdatePool sets the "pool" field to the value that was provided on create.
+func (u *ListingUpsert) UpdatePool() *ListingUpsert {
+	u.SetExcluded(listing.FieldPool)
+	return u
+}
+
+// ClearPool clears the value of the "pool" field.
+func (u *ListingUpsert) ClearPool() *ListingUpsert {
+	u.SetNull(listing.FieldPool)
+	return u
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (u *ListingUpsert) SetYearBuilt(v int) *ListingUpsert {
+	u.Set(listing.FieldYearBuilt, v)
+	return u
+}
+
+// UpdateYearBuilt sets the "year_built" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateYearBuilt() *ListingUpsert {
+	u.SetExcluded(listing.FieldYearBuilt)
+	return u
+}
+
+// AddYearBuilt adds v to the "year_built" field.
+func (u *ListingUpsert) AddYearBuilt(v int) *ListingUpsert {
+	u.Add(listing.FieldYearBuilt, v)
+	return u
+}
+
+// SetAnnualPropertyTax sets the "annual_property_tax" field.
+func (u *ListingUpsert) SetAnnualPropertyTax(v decimal.Decimal) *ListingUpsert {
+	u.Set(listing.FieldAnnualPropertyTax, v)
+	return u
+}
+
+// UpdateAnnualPropertyTax sets the "annual_property_tax" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateAnnualPropertyTax() *ListingUpsert {
+	u.SetExcluded(listing.FieldAnnualPropertyTax)
+	return u
+}
+
+// AddAnnualPropertyTax adds v to the "annual_property_tax" field.
+func (u *ListingUpsert) AddAnnualPropertyTax(v decimal.Decimal) *ListingUpsert {
+	u.Add(listing.FieldAnnualPropertyTax, v)
+	return u
+}
+
+// ClearAnnualPropertyTax clears the value of the "annual_property_tax" field.
+func (u *ListingUpsert) ClearAnnualPropertyTax() *ListingUpsert {
+	u.SetNull(listing.FieldAnnualPropertyTax)
+	return u
+}
+
+// SetHoaDues sets the "hoa_dues" field.
+func (u *ListingUpsert) SetHoaDues(v decimal.Decimal) *ListingUpsert {
+	u.Set(listing.FieldHoaDues, v)
+	return u
+}
+
+// UpdateHoaDues sets the "hoa_dues" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateHoaDues() *ListingUpsert {
+	u.SetExcluded(listing.FieldHoaDues)
+	return u
+}
+
+// AddHoaDues adds v to the "hoa_dues" field.
+func (u *ListingUpsert) AddHoaDues(v decimal.Decimal) *ListingUpsert {
+	u.Add(listing.FieldHoaDues, v)
+	return u
+}
+
+// ClearHoaDues clears the value of the "hoa_dues" field.
+func (u *ListingUpsert) ClearHoaDues() *ListingUpsert {
+	u.SetNull(listing.FieldHoaDues)
+	return u
+}
+
+// SetHoaFrequency sets the "hoa_frequency" field.
+func (u *ListingUpsert) SetHoaFrequency(v listing.HoaFrequency) *ListingUpsert {
+	u.Set(listing.FieldHoaFrequency, v)
+	return u
+}
+
+// UpdateHoaFrequency sets the "hoa_frequency" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateHoaFrequency() *ListingUpsert {
+	u.SetExcluded(listing.FieldHoaFrequency)
+	return u
+}
+
+// ClearHoaFrequency clears the value of the "hoa_frequency" field.
+func (u *ListingUpsert) ClearHoaFrequency() *ListingUpsert {
+	u.SetNull(listing.FieldHoaFrequency)
+	return u
+}
+
+// SetHoaName sets the "hoa_name" field.
+func (u *ListingUpsert) SetHoaName(v string) *ListingUpsert {
+	u.Set(listing.FieldHoaName, v)
+	return u
+}
+
+// UpdateHoaName sets the "hoa_name" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateHoaName() *ListingUpsert {
+	u.SetExcluded(listing.FieldHoaName)
+	return u
+}
+
+// ClearHoaName clears the value of the "hoa_name" field.
+func (u *ListingUpsert) ClearHoaName() *ListingUpsert {
+	u.SetNull(listing.FieldHoaName)
+	return u
+}
+
+// SetSpecialAssessments sets the "special_assessments" field.
+func (u *ListingUpsert) SetSpecialAssessments(v decimal.Decimal) *ListingUpsert {
+	u.Set(listing.FieldSpecialAssessments, v)
+	return u
+}
+
+// UpdateSpecialAssessments sets the "special_assessments" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateSpecialAssessments() *ListingUpsert {
+	u.SetExcluded(listing.FieldSpecialAssessments)
+	return u
+}
+
+// AddSpecialAssessments adds v to the "special_assessments" field.
+func (u *ListingUpsert) AddSpecialAssessments(v decimal.Decimal) *ListingUpsert {
+	u.Add(listing.FieldSpecialAssessments, v)
+	return u
+}
+
+// ClearSpecialAssessments clears the value of the "special_assessments" field.
+func (u *ListingUpsert) ClearSpecialAssessments() *ListingUpsert {
+	u.SetNull(listing.FieldSpecialAssessments)
+	return u
+}
+
+// SetMonthlyUtilities sets the "monthly_utilities" field.
+func (u *ListingUpsert) SetMonthlyUtilities(v decimal.Decimal) *ListingUpsert {
+	u.Set(listing.FieldMonthlyUtilities, v)
+	return u
+}
+
+// UpdateMonthlyUtilities sets the "monthly_utilities" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateMonthlyUtilities() *ListingUpsert {
+	u.SetExcluded(listing.FieldMonthlyUtilities)
+	return u
+}
+
+// AddMonthlyUtilities adds v to the "monthly_utilities" field.
+func (u *ListingUpsert) AddMonthlyUtilities(v decimal.Decimal) *ListingUpsert {
+	u.Add(listing.FieldMonthlyUtilities, v)
+	return u
+}
+
+// ClearMonthlyUtilities clears the value of the "monthly_utilities" field.
+func (u *ListingUpsert) ClearMonthlyUtilities() *ListingUpsert {
+	u.SetNull(listing.FieldMonthlyUtilities)
+	return u
+}
+
+// SetMedia sets the "media" field.
+func (u *ListingUpsert) SetMedia(v []schematype.Media) *ListingUpsert {
+	u.Set(listing.FieldMedia, v)
+	return u
+}
+
+// UpdateMedia sets the "media" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateMedia() *ListingUpsert {
+	u.SetExcluded(listing.FieldMedia)
+	return u
+}
+
+// ClearMedia clears the value of the "media" field.
+func (u *ListingUpsert) ClearMedia() *ListingUpsert {
+	u.SetNull(listing.FieldMedia)
+	return u
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (u *ListingUpsert) SetRealtorID(v uuid.UUID) *ListingUpsert {
+	u.Set(listing.FieldRealtorID, v)
+	return u
+}
+
+// UpdateRealtorID sets the "realtor_id" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateRealtorID() *ListingUpsert {
+	u.SetExcluded(listing.FieldRealtorID)
+	return u
+}
+
+// SetPropertyID sets the "property_id" field.
+func (u *ListingUpsert) SetPropertyID(v uuid.UUID) *ListingUpsert {
+	u.Set(listing.FieldPropertyID, v)
+	return u
+}
+
+// UpdatePropertyID sets the "property_id" field to the value that was provided on create.
+func (u *ListingUpsert) UpdatePropertyID() *ListingUpsert {
+	u.SetExcluded(listing.FieldPropertyID)
+	return u
+}
+
+// ClearPropertyID clears the value of the "property_id" field.
+func (u *ListingUpsert) ClearPropertyID() *ListingUpsert {
+	u.SetNull(listing.FieldPropertyID)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ListingUpsert) SetExpiresAt(v time.Time) *ListingUpsert {
+	u.Set(listing.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateExpiresAt() *ListingUpsert {
+	u.SetExcluded(listing.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ListingUpsert) ClearExpiresAt() *ListingUpsert {
+	u.SetNull(listing.FieldExpiresAt)
+	return u
+}
+
+// SetExpiryReminderSentAt sets the "expiry_reminder_sent_at" field.
+func (u *ListingUpsert) SetExpiryReminderSentAt(v time.Time) *ListingUpsert {
+	u.Set(listing.FieldExpiryReminderSentAt, v)
+	return u
+}
+
+// UpdateExpiryReminderSentAt sets the "expiry_reminder_sent_at" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateExpiryReminderSentAt() *ListingUpsert {
+	u.SetExcluded(listing.FieldExpiryReminderSentAt)
+	return u
+}
+
+// ClearExpiryReminderSentAt clears the value of the "expiry_reminder_sent_at" field.
+func (u *ListingUpsert) ClearExpiryReminderSentAt() *ListingUpsert {
+	u.SetNull(listing.FieldExpiryReminderSentAt)
+	return u
+}
+
+// SetExpired sets the "expired" field.
+func (u *ListingUpsert) SetExpired(v bool) *ListingUpsert {
+	u.Set(listing.FieldExpired, v)
+	return u
+}
+
+// UpdateExpired sets the "expired" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateExpired() *ListingUpsert {
+	u.SetExcluded(listing.FieldExpired)
+	return u
+}
+
+// SetListedAt sets the "listed_at" field.
+func (u *ListingUpsert) SetListedAt(v time.Time) *ListingUpsert {
+	u.Set(listing.FieldListedAt, v)
+	return u
+}
+
+// UpdateListedAt sets the "listed_at" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateListedAt() *ListingUpsert {
+	u.SetExcluded(listing.FieldListedAt)
+	return u
+}
+
+// ClearListedAt clears the value of the "listed_at" field.
+func (u *ListingUpsert) ClearListedAt() *ListingUpsert {
+	u.SetNull(listing.FieldListedAt)
+	return u
+}
+
+// SetOnMarketSince sets the "on_market_since" field.
+func (u *ListingUpsert) SetOnMarketSince(v time.Time) *ListingUpsert {
+	u.Set(listing.FieldOnMarketSince, v)
+	return u
+}
+
+// UpdateOnMarketSince sets the "on_market_since" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateOnMarketSince() *ListingUpsert {
+	u.SetExcluded(listing.FieldOnMarketSince)
+	return u
+}
+
+// ClearOnMarketSince clears the value of the "on_market_since" field.
+func (u *ListingUpsert) ClearOnMarketSince() *ListingUpsert {
+	u.SetNull(listing.FieldOnMarketSince)
+	return u
+}
+
+// SetMarketSeconds sets the "market_seconds" field.
+func (u *ListingUpsert) SetMarketSeconds(v int64) *ListingUpsert {
+	u.Set(listing.FieldMarketSeconds, v)
+	return u
+}
+
+// UpdateMarketSeconds sets the "market_seconds" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateMarketSeconds() *ListingUpsert {
+	u.SetExcluded(listing.FieldMarketSeconds)
+	return u
+}
+
+// AddMarketSeconds adds v to the "market_seconds" field.
+func (u *ListingUpsert) AddMarketSeconds(v int64) *ListingUpsert {
+	u.Add(listing.FieldMarketSeconds, v)
+	return u
+}
+
+// SetSoldPrice sets the "sold_price" field.
+func (u *ListingUpsert) SetSoldPrice(v decimal.Decimal) *ListingUpsert {
+	u.Set(listing.FieldSoldPrice, v)
+	return u
+}
+
+// UpdateSoldPrice sets the "sold_price" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateSoldPrice() *ListingUpsert {
+	u.SetExcluded(listing.FieldSoldPrice)
+	return u
+}
+
+// AddSoldPrice adds v to the "sold_price" field.
+func (u *ListingUpsert) AddSoldPrice(v decimal.Decimal) *ListingUpsert {
+	u.Add(listing.FieldSoldPrice, v)
+	return u
+}
+
+// ClearSoldPrice clears the value of the "sold_price" field.
+func (u *ListingUpsert) ClearSoldPrice() *ListingUpsert {
+	u.SetNull(listing.FieldSoldPrice)
+	return u
+}
+
+// SetCloseDate sets the "close_date" field.
+func (u *ListingUpsert) SetCloseDate(v time.Time) *ListingUpsert {
+	u.Set(listing.FieldCloseDate, v)
+	return u
+}
+
+// UpdateCloseDate sets the "close_date" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateCloseDate() *ListingUpsert {
+	u.SetExcluded(listing.FieldCloseDate)
+	return u
+}
+
+// ClearCloseDate clears the value of the "close_date" field.
+func (u *ListingUpsert) ClearCloseDate() *ListingUpsert {
+	u.SetNull(listing.FieldCloseDate)
+	return u
+}
+
+// SetBuyerRealtorID sets the "buyer_realtor_id" field.
+func (u *ListingUpsert) SetBuyerRealtorID(v uuid.UUID) *ListingUpsert {
+	u.Set(listing.FieldBuyerRealtorID, v)
+	return u
+}
+
+// UpdateBuyerRealtorID sets the "buyer_realtor_id" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateBuyerRealtorID() *ListingUpsert {
+	u.SetExcluded(listing.FieldBuyerRealtorID)
+	return u
+}
+
+// ClearBuyerRealtorID clears the value of the "buyer_realtor_id" field.
+func (u *ListingUpsert) ClearBuyerRealtorID() *ListingUpsert {
+	u.SetNull(listing.FieldBuyerRealtorID)
+	return u
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *ListingUpsert) SetPublishAt(v time.Time) *ListingUpsert {
+	u.Set(listing.FieldPublishAt, v)
+	return u
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *ListingUpsert) UpdatePublishAt() *ListingUpsert {
+	u.SetExcluded(listing.FieldPublishAt)
+	return u
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (u *ListingUpsert) ClearPublishAt() *ListingUpsert {
+	u.SetNull(listing.FieldPublishAt)
+	return u
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (u *ListingUpsert) SetUnpublishAt(v time.Time) *ListingUpsert {
+	u.Set(listing.FieldUnpublishAt, v)
+	return u
+}
+
+// UpdateUnpublishAt sets the "unpublish_at" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateUnpublishAt() *ListingUpsert {
+	u.SetExcluded(listing.FieldUnpublishAt)
+	return u
+}
+
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (u *ListingUpsert) ClearUnpublishAt() *ListingUpsert {
+	u.SetNull(listing.FieldUnpublishAt)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *ListingUpsert) SetVersion(v int) *ListingUpsert {
+	u.Set(listing.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ListingUpsert) UpdateVersion() *ListingUpsert {
+	u.SetExcluded(listing.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ListingUpsert) AddVersion(v int) *ListingUpsert {
+	u.Add(listing.FieldVersion, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listing.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingUpsertOne) UpdateNewValues() *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(listing.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(listing.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ListingUpsertOne) Ignore() *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingUpsertOne) DoNothing() *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingCreate.OnConflict
+// documentation for more info.
+func (u *ListingUpsertOne) Update(set func(*ListingUpsert)) *ListingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingUpsertOne) SetUpdateTime(v time.Time) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateUpdateTime() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ListingUpsertOne) SetTitle(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateTitle() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *ListingUpsertOne) SetAddress(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateAddress() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetAddressKey sets the "address_key" field.
+func (u *ListingUpsertOne) SetAddressKey(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetAddressKey(v)
+	})
+}
+
+// UpdateAddressKey sets the "address_key" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateAddressKey() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateAddressKey()
+	})
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (u *ListingUpsertOne) ClearAddressKey() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearAddressKey()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *ListingUpsertOne) SetCity(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateCity() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *ListingUpsertOne) SetState(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateState() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetZipCode sets the "zip_code" field.
+func (u *ListingUpsertOne) SetZipCode(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetZipCode(v)
+	})
+}
+
+// UpdateZipCode sets the "zip_code" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateZipCode() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateZipCode()
+	})
+}
+
+// SetCountry sets the "country" field.
+func (u *ListingUpsertOne) SetCountry(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateCountry() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *ListingUpsertOne) SetLatitude(v float64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *ListingUpsertOne) AddLatitude(v float64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateLatitude() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (u *ListingUpsertOne) ClearLatitude() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *ListingUpsertOne) SetLongitude(v float64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *ListingUpsertOne) AddLongitude(v float64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateLongitude() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (u *ListingUpsertOne) ClearLongitude() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearLongitude()
+	})
+}
+
+// SetNeighborhoodID sets the "neighborhood_id" field.
+func (u *ListingUpsertOne) SetNeighborhoodID(v uuid.UUID) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetNeighborhoodID(v)
+	})
+}
+
+// UpdateNeighborhoodID sets the "neighborhood_id" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateNeighborhoodID() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateNeighborhoodID()
+	})
+}
+
+// ClearNeighborhoodID clears the value of the "neighborhood_id" field.
+func (u *ListingUpsertOne) ClearNeighborhoodID() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearNeighborhoodID()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ListingUpsertOne) SetDescription(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateDescription() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ListingUpsertOne) ClearDescription() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSourceLocale sets the "source_locale" field.
+func (u *ListingUpsertOne) SetSourceLocale(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSourceLocale(v)
+	})
+}
+
+// UpdateSourceLocale sets the "source_locale" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateSourceLocale() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSourceLocale()
+	})
+}
+
+// SetTranslations sets the "translations" field.
+func (u *ListingUpsertOne) SetTranslations(v map[string]schematype.Translation) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetTranslations(v)
+	})
+}
+
+// UpdateTranslations sets the "translations" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateTranslations() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateTranslations()
+	})
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (u *ListingUpsertOne) ClearTranslations() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearTranslations()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *ListingUpsertOne) SetPrice(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ListingUpsertOne) AddPrice(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdatePrice() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ListingUpsertOne) SetCurrency(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateCurrency() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetBedroom sets the "bedroom" field.
+func (u *ListingUpsertOne) SetBedroom(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetBedroom(v)
+	})
+}
+
+// AddBedroom adds v to the "bedroom" field.
+func (u *ListingUpsertOne) AddBedroom(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddBedroom(v)
+	})
+}
+
+// UpdateBedroom sets the "bedroom" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateBedroom() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateBedroom()
+	})
+}
+
+// SetBathroom sets the "bathroom" field.
+func (u *ListingUpsertOne) SetBathroom(v float64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetBathroom(v)
+	})
+}
+
+// AddBathroom adds v to the "bathroom" field.
+func (u *ListingUpsertOne) AddBathroom(v float64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddBathroom(v)
+	})
+}
+
+// UpdateBathroom sets the "bathroom" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateBathroom() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateBathroom()
+	})
+}
+
+// SetGarage sets the "garage" field.
+func (u *ListingUpsertOne) SetGarage(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetGarage(v)
+	})
+}
+
+// AddGarage adds v to the "garage" field.
+func (u *ListingUpsertOne) AddGarage(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddGarage(v)
+	})
+}
+
+// UpdateGarage sets the "garage" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateGarage() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateGarage()
+	})
+}
+
+// ClearGarage clears the value of the "garage" field.
+func (u *ListingUpsertOne) ClearGarage() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearGarage()
+	})
+}
+
+// SetSqft sets the "sqft" field.
+func (u *ListingUpsertOne) SetSqft(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSqft(v)
+	})
+}
+
+// AddSqft adds v to the "sqft" field.
+func (u *ListingUpsertOne) AddSqft(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddSqft(v)
+	})
+}
+
+// UpdateSqft sets the "sqft" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateSqft() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSqft()
+	})
+}
+
+// SetSqm sets the "sqm" field.
+func (u *ListingUpsertOne) SetSqm(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSqm(v)
+	})
+}
+
+// AddSqm adds v to the "sqm" field.
+func (u *ListingUpsertOne) AddSqm(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddSqm(v)
+	})
+}
+
+// UpdateSqm sets the "sqm" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateSqm() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSqm()
+	})
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (u *ListingUpsertOne) ClearSqm() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearSqm()
+	})
+}
+
+// SetAreaUnit sets the "area_unit" field.
+func (u *ListingUpsertOne) SetAreaUnit(v listing.AreaUnit) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetAreaUnit(v)
+	})
+}
+
+// UpdateAreaUnit sets the "area_unit" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateAreaUnit() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateAreaUnit()
+	})
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (u *ListingUpsertOne) SetTypeOfProperty(v listing.TypeOfProperty) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetTypeOfProperty(v)
+	})
+}
+
+// UpdateTypeOfProperty sets the "type_of_property" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateTypeOfProperty() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateTypeOfProperty()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingUpsertOne) SetStatus(v listing.Status) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateStatus() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetLotSize sets the "lot_size" field.
+func (u *ListingUpsertOne) SetLotSize(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetLotSize(v)
+	})
+}
+
+// AddLotSize adds v to the "lot_size" field.
+func (u *ListingUpsertOne) AddLotSize(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddLotSize(v)
+	})
+}
+
+// UpdateLotSize sets the "lot_size" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateLotSize() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateLotSize()
+	})
+}
+
+// ClearLotSize clears the value of the "lot_size" field.
+func (u *ListingUpsertOne) ClearLotSize() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearLotSize()
+	})
+}
+
+// SetPool sets the "pool" field.
+func (u *ListingUpsertOne) SetPool(v bool) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPool(v)
+	})
+}
+
+// UpdatePool sets the "pool" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdatePool() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePool()
+	})
+}
+
+// ClearPool clears the value of the "pool" field.
+func (u *ListingUpsertOne) ClearPool() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearPool()
+	})
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (u *ListingUpsertOne) SetYearBuilt(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetYearBuilt(v)
+	})
+}
+
+// AddYearBuilt adds v to the "year_built" field.
+func (u *ListingUpsertOne) AddYearBuilt(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddYearBuilt(v)
+	})
+}
+
+// UpdateYearBuilt sets the "year_built" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateYearBuilt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateYearBuilt()
+	})
+}
+
+// SetAnnualPropertyTax sets the "annual_property_tax" field.
+func (u *ListingUpsertOne) SetAnnualPropertyTax(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetAnnualPropertyTax(v)
+	})
+}
+
+// AddAnnualPropertyTax adds v to the "annual_property_tax" field.
+func (u *ListingUpsertOne) AddAnnualPropertyTax(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddAnnualPropertyTax(v)
+	})
+}
+
+// UpdateAnnualPropertyTax sets the "annual_property_tax" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateAnnualPropertyTax() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateAnnualPropertyTax()
+	})
+}
+
+// ClearAnnualPropertyTax clears the value of the "annual_property_tax" field.
+func (u *ListingUpsertOne) ClearAnnualPropertyTax() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearAnnualPropertyTax()
+	})
+}
+
+// SetHoaDues sets the "hoa_dues" field.
+func (u *ListingUpsertOne) SetHoaDues(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetHoaDues(v)
+	})
+}
+
+// AddHoaDues adds v to the "hoa_dues" field.
+func (u *ListingUpsertOne) AddHoaDues(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddHoaDues(v)
+	})
+}
+
+// UpdateHoaDues sets the "hoa_dues" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateHoaDues() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateHoaDues()
+	})
+}
+
+// ClearHoaDues clears the value of the "hoa_dues" field.
+func (u *ListingUpsertOne) ClearHoaDues() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearHoaDues()
+	})
+}
+
+// SetHoaFrequency sets the "hoa_frequency" field.
+func (u *ListingUpsertOne) SetHoaFrequency(v listing.HoaFrequency) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetHoaFrequency(v)
+	})
+}
+
+// UpdateHoaFrequency sets the "hoa_frequency" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateHoaFrequency() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateHoaFrequency()
+	})
+}
+
+// ClearHoaFrequency clears the value of the "hoa_frequency" field.
+func (u *ListingUpsertOne) ClearHoaFrequency() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearHoaFrequency()
+	})
+}
+
+// SetHoaName sets the "hoa_name" field.
+func (u *ListingUpsertOne) SetHoaName(v string) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetHoaName(v)
+	})
+}
+
+// UpdateHoaName sets the "hoa_name" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateHoaName() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateHoaName()
+	})
+}
+
+// ClearHoaName clears the value of the "hoa_name" field.
+func (u *ListingUpsertOne) ClearHoaName() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearHoaName()
+	})
+}
+
+// SetSpecialAssessments sets the "special_assessments" field.
+func (u *ListingUpsertOne) SetSpecialAssessments(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSpecialAssessments(v)
+	})
+}
+
+// AddSpecialAssessments adds v to the "special_assessments" field.
+func (u *ListingUpsertOne) AddSpecialAssessments(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddSpecialAssessments(v)
+	})
+}
+
+// UpdateSpecialAssessments sets the "special_assessments" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateSpecialAssessments() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSpecialAssessments()
+	})
+}
+
+// ClearSpecialAssessments clears the value of the "special_assessments" field.
+func (u *ListingUpsertOne) ClearSpecialAssessments() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearSpecialAssessments()
+	})
+}
+
+// SetMonthlyUtilities sets the "monthly_utilities" field.
+func (u *ListingUpsertOne) SetMonthlyUtilities(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetMonthlyUtilities(v)
+	})
+}
+
+// AddMonthlyUtilities adds v to the "monthly_utilities" field.
+func (u *ListingUpsertOne) AddMonthlyUtilities(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddMonthlyUtilities(v)
+	})
+}
+
+// UpdateMonthlyUtilities sets the "monthly_utilities" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateMonthlyUtilities() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateMonthlyUtilities()
+	})
+}
+
+// ClearMonthlyUtilities clears the value of the "monthly_utilities" field.
+func (u *ListingUpsertOne) ClearMonthlyUtilities() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearMonthlyUtilities()
+	})
+}
+
+// SetMedia sets the "media" field.
+func (u *ListingUpsertOne) SetMedia(v []schematype.Media) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetMedia(v)
+	})
+}
+
+// UpdateMedia sets the "media" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateMedia() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateMedia()
+	})
+}
+
+// ClearMedia clears the value of the "media" field.
+func (u *ListingUpsertOne) ClearMedia() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearMedia()
+	})
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (u *ListingUpsertOne) SetRealtorID(v uuid.UUID) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetRealtorID(v)
+	})
+}
+
+// UpdateRealtorID sets the "realtor_id" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateRealtorID() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateRealtorID()
+	})
+}
+
+// SetPropertyID sets the "property_id" field.
+func (u *ListingUpsertOne) SetPropertyID(v uuid.UUID) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPropertyID(v)
+	})
+}
+
+// UpdatePropertyID sets the "property_id" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdatePropertyID() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePropertyID()
+	})
+}
+
+// ClearPropertyID clears the value of the "property_id" field.
+func (u *ListingUpsertOne) ClearPropertyID() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearPropertyID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ListingUpsertOne) SetExpiresAt(v time.Time) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateExpiresAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ListingUpsertOne) ClearExpiresAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetExpiryReminderSentAt sets the "expiry_reminder_sent_at" field.
+func (u *ListingUpsertOne) SetExpiryReminderSentAt(v time.Time) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetExpiryReminderSentAt(v)
+	})
+}
+
+// UpdateExpiryReminderSentAt sets the "expiry_reminder_sent_at" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateExpiryReminderSentAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateExpiryReminderSentAt()
+	})
+}
+
+// ClearExpiryReminderSentAt clears the value of the "expiry_reminder_sent_at" field.
+func (u *ListingUpsertOne) ClearExpiryReminderSentAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearExpiryReminderSentAt()
+	})
+}
+
+// SetExpired sets the "expired" field.
+func (u *ListingUpsertOne) SetExpired(v bool) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetExpired(v)
+	})
+}
+
+// UpdateExpired sets the "expired" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateExpired() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateExpired()
+	})
+}
+
+// SetListedAt sets the "listed_at" field.
+func (u *ListingUpsertOne) SetListedAt(v time.Time) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetListedAt(v)
+	})
+}
+
+// UpdateListedAt sets the "listed_at" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateListedAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateListedAt()
+	})
+}
+
+// ClearListedAt clears the value of the "listed_at" field.
+func (u *ListingUpsertOne) ClearListedAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearListedAt()
+	})
+}
+
+// SetOnMarketSince sets the "on_market_since" field.
+func (u *ListingUpsertOne) SetOnMarketSince(v time.Time) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetOnMarketSince(v)
+	})
+}
+
+// UpdateOnMarketSince sets the "on_market_since" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateOnMarketSince() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateOnMarketSince()
+	})
+}
+
+// ClearOnMarketSince clears the value of the "on_market_since" field.
+func (u *ListingUpsertOne) ClearOnMarketSince() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearOnMarketSince()
+	})
+}
+
+// SetMarketSeconds sets the "market_seconds" field.
+func (u *ListingUpsertOne) SetMarketSeconds(v int64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetMarketSeconds(v)
+	})
+}
+
+// AddMarketSeconds adds v to the "market_seconds" field.
+func (u *ListingUpsertOne) AddMarketSeconds(v int64) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddMarketSeconds(v)
+	})
+}
+
+// UpdateMarketSeconds sets the "market_seconds" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateMarketSeconds() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateMarketSeconds()
+	})
+}
+
+// SetSoldPrice sets the "sold_price" field.
+func (u *ListingUpsertOne) SetSoldPrice(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSoldPrice(v)
+	})
+}
+
+// AddSoldPrice adds v to the "sold_price" field.
+func (u *ListingUpsertOne) AddSoldPrice(v decimal.Decimal) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddSoldPrice(v)
+	})
+}
+
+// UpdateSoldPrice sets the "sold_price" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateSoldPrice() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSoldPrice()
+	})
+}
+
+// ClearSoldPrice clears the value of the "sold_price" field.
+func (u *ListingUpsertOne) ClearSoldPrice() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearSoldPrice()
+	})
+}
+
+// SetCloseDate sets the "close_date" field.
+func (u *ListingUpsertOne) SetCloseDate(v time.Time) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCloseDate(v)
+	})
+}
+
+// UpdateCloseDate sets the "close_date" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateCloseDate() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCloseDate()
+	})
+}
+
+// ClearCloseDate clears the value of the "close_date" field.
+func (u *ListingUpsertOne) ClearCloseDate() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearCloseDate()
+	})
+}
+
+// SetBuyerRealtorID sets the "buyer_realtor_id" field.
+func (u *ListingUpsertOne) SetBuyerRealtorID(v uuid.UUID) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetBuyerRealtorID(v)
+	})
+}
+
+// UpdateBuyerRealtorID sets the "buyer_realtor_id" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateBuyerRealtorID() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateBuyerRealtorID()
+	})
+}
+
+// ClearBuyerRealtorID clears the value of the "buyer_realtor_id" field.
+func (u *ListingUpsertOne) ClearBuyerRealtorID() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearBuyerRealtorID()
+	})
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *ListingUpsertOne) SetPublishAt(v time.Time) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPublishAt(v)
+	})
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdatePublishAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePublishAt()
+	})
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (u *ListingUpsertOne) ClearPublishAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearPublishAt()
+	})
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (u *ListingUpsertOne) SetUnpublishAt(v time.Time) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetUnpublishAt(v)
+	})
+}
+
+// UpdateUnpublishAt sets the "unpublish_at" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateUnpublishAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateUnpublishAt()
+	})
+}
+
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (u *ListingUpsertOne) ClearUnpublishAt() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearUnpublishAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ListingUpsertOne) SetVersion(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ListingUpsertOne) AddVersion(v int) *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ListingUpsertOne) UpdateVersion() *ListingUpsertOne {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ListingUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ListingUpsertOne.ID is not supported by MySQL driver. Use ListingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ListingUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ListingCreateBulk is the builder for creating many Listing entities in bulk.
+type ListingCreateBulk struct {
+	config
+	err      error
+	builders []*ListingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Listing entities in the database.
+func (_c *ListingCreateBulk) Save(ctx context.Context) ([]*Listing, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Listing, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ListingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ListingCreateBulk) SaveX(ctx context.Context) []*Listing {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Listing.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingCreateBulk) OnConflict(opts ...sql.ConflictOption) *ListingUpsertBulk {
+	_c.conflict = opts
+	return &ListingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingCreateBulk) OnConflictColumns(columns ...string) *ListingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingUpsertBulk{
+		create: _c,
+	}
+}
+
+// ListingUpsertBulk is the builder for "upsert"-ing
+// a bulk of Listing nodes.
+type ListingUpsertBulk struct {
+	create *ListingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listing.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingUpsertBulk) UpdateNewValues() *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(listing.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(listing.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Listing.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ListingUpsertBulk) Ignore() *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingUpsertBulk) DoNothing() *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingCreateBulk.OnConflict
+// documentation for more info.
+func (u *ListingUpsertBulk) Update(set func(*ListingUpsert)) *ListingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingUpsertBulk) SetUpdateTime(v time.Time) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateUpdateTime() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ListingUpsertBulk) SetTitle(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateTitle() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *ListingUpsertBulk) SetAddress(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateAddress() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetAddressKey sets the "address_key" field.
+func (u *ListingUpsertBulk) SetAddressKey(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetAddressKey(v)
+	})
+}
+
+// UpdateAddressKey sets the "address_key" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateAddressKey() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateAddressKey()
+	})
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (u *ListingUpsertBulk) ClearAddressKey() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearAddressKey()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *ListingUpsertBulk) SetCity(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateCity() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *ListingUpsertBulk) SetState(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateState() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetZipCode sets the "zip_code" field.
+func (u *ListingUpsertBulk) SetZipCode(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetZipCode(v)
+	})
+}
+
+// UpdateZipCode sets the "zip_code" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateZipCode() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateZipCode()
+	})
+}
+
+// SetCountry sets the "country" field.
+func (u *ListingUpsertBulk) SetCountry(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateCountry() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *ListingUpsertBulk) SetLatitude(v float64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *ListingUpsertBulk) AddLatitude(v float64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateLatitude() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (u *ListingUpsertBulk) ClearLatitude() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *ListingUpsertBulk) SetLongitude(v float64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *ListingUpsertBulk) AddLongitude(v float64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateLongitude() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (u *ListingUpsertBulk) ClearLongitude() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearLongitude()
+	})
+}
+
+// SetNeighborhoodID sets the "neighborhood_id" field.
+func (u *ListingUpsertBulk) SetNeighborhoodID(v uuid.UUID) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetNeighborhoodID(v)
+	})
+}
+
+// UpdateNeighborhoodID sets the "neighborhood_id" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateNeighborhoodID() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateNeighborhoodID()
+	})
+}
+
+// ClearNeighborhoodID clears the value of the "neighborhood_id" field.
+func (u *ListingUpsertBulk) ClearNeighborhoodID() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearNeighborhoodID()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *ListingUpsertBulk) SetDescription(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateDescription() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ListingUpsertBulk) ClearDescription() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetSourceLocale sets the "source_locale" field.
+func (u *ListingUpsertBulk) SetSourceLocale(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSourceLocale(v)
+	})
+}
+
+// UpdateSourceLocale sets the "source_locale" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateSourceLocale() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSourceLocale()
+	})
+}
+
+// SetTranslations sets the "translations" field.
+func (u *ListingUpsertBulk) SetTranslations(v map[string]schematype.Translation) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetTranslations(v)
+	})
+}
+
+// UpdateTranslations sets the "translations" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateTranslations() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateTranslations()
+	})
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (u *ListingUpsertBulk) ClearTranslations() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearTranslations()
+	})
+}
+
+// SetPrice sets the "price" field.
+func (u *ListingUpsertBulk) SetPrice(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPrice(v)
+	})
+}
+
+// AddPrice adds v to the "price" field.
+func (u *ListingUpsertBulk) AddPrice(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddPrice(v)
+	})
+}
+
+// UpdatePrice sets the "price" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdatePrice() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePrice()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *ListingUpsertBulk) SetCurrency(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateCurrency() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetBedroom sets the "bedroom" field.
+func (u *ListingUpsertBulk) SetBedroom(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetBedroom(v)
+	})
+}
+
+// AddBedroom adds v to the "bedroom" field.
+func (u *ListingUpsertBulk) AddBedroom(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddBedroom(v)
+	})
+}
+
+// UpdateBedroom sets the "bedroom" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateBedroom() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateBedroom()
+	})
+}
+
+// SetBathroom sets the "bathroom" field.
+func (u *ListingUpsertBulk) SetBathroom(v float64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetBathroom(v)
+	})
+}
+
+// AddBathroom adds v to the "bathroom" field.
+func (u *ListingUpsertBulk) AddBathroom(v float64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddBathroom(v)
+	})
+}
+
+// UpdateBathroom sets the "bathroom" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateBathroom() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateBathroom()
+	})
+}
+
+// SetGarage sets the "garage" field.
+func (u *ListingUpsertBulk) SetGarage(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetGarage(v)
+	})
+}
+
+// AddGarage adds v to the "garage" field.
+func (u *ListingUpsertBulk) AddGarage(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddGarage(v)
+	})
+}
+
+// UpdateGarage sets the "garage" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateGarage() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateGarage()
+	})
+}
+
+// ClearGarage clears the value of the "garage" field.
+func (u *ListingUpsertBulk) ClearGarage() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearGarage()
+	})
+}
+
+// SetSqft sets the "sqft" field.
+func (u *ListingUpsertBulk) SetSqft(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSqft(v)
+	})
+}
+
+// AddSqft adds v to the "sqft" field.
+func (u *ListingUpsertBulk) AddSqft(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddSqft(v)
+	})
+}
+
+// UpdateSqft sets the "sqft" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateSqft() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSqft()
+	})
+}
+
+// SetSqm sets the "sqm" field.
+func (u *ListingUpsertBulk) SetSqm(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSqm(v)
+	})
+}
+
+// AddSqm adds v to the "sqm" field.
+func (u *ListingUpsertBulk) AddSqm(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddSqm(v)
+	})
+}
+
+// UpdateSqm sets the "sqm" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateSqm() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSqm()
+	})
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (u *ListingUpsertBulk) ClearSqm() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearSqm()
+	})
+}
+
+// SetAreaUnit sets the "area_unit" field.
+func (u *ListingUpsertBulk) SetAreaUnit(v listing.AreaUnit) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetAreaUnit(v)
+	})
+}
+
+// UpdateAreaUnit sets the "area_unit" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateAreaUnit() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateAreaUnit()
+	})
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (u *ListingUpsertBulk) SetTypeOfProperty(v listing.TypeOfProperty) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetTypeOfProperty(v)
+	})
+}
+
+// UpdateTypeOfProperty sets the "type_of_property" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateTypeOfProperty() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateTypeOfProperty()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingUpsertBulk) SetStatus(v listing.Status) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateStatus() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetLotSize sets the "lot_size" field.
+func (u *ListingUpsertBulk) SetLotSize(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetLotSize(v)
+	})
+}
+
+// AddLotSize adds v to the "lot_size" field.
+func (u *ListingUpsertBulk) AddLotSize(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddLotSize(v)
+	})
+}
+
+// UpdateLotSize sets the "lot_size" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateLotSize() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateLotSize()
+	})
+}
+
+// ClearLotSize clears the value of the "lot_size" field.
+func (u *ListingUpsertBulk) ClearLotSize() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearLotSize()
+	})
+}
+
+// SetPool sets the "pool" field.
+func (u *ListingUpsertBulk) SetPool(v bool) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPool(v)
+	})
+}
+
+// UpdatePool sets the "pool" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdatePool() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePool()
+	})
+}
+
+// ClearPool clears the value of the "pool" field.
+func (u *ListingUpsertBulk) ClearPool() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearPool()
+	})
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (u *ListingUpsertBulk) SetYearBuilt(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetYearBuilt(v)
+	})
+}
+
+// AddYearBuilt adds v to the "year_built" field.
+func (u *ListingUpsertBulk) AddYearBuilt(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddYearBuilt(v)
+	})
+}
+
+// UpdateYearBuilt sets the "year_built" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateYearBuilt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateYearBuilt()
+	})
+}
+
+// SetAnnualPropertyTax sets the "annual_property_tax" field.
+func (u *ListingUpsertBulk) SetAnnualPropertyTax(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetAnnualPropertyTax(v)
+	})
+}
+
+// AddAnnualPropertyTax adds v to the "annual_property_tax" field.
+func (u *ListingUpsertBulk) AddAnnualPropertyTax(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddAnnualPropertyTax(v)
+	})
+}
+
+// UpdateAnnualPropertyTax sets the "annual_property_tax" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateAnnualPropertyTax() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateAnnualPropertyTax()
+	})
+}
+
+// ClearAnnualPropertyTax clears the value of the "annual_property_tax" field.
+func (u *ListingUpsertBulk) ClearAnnualPropertyTax() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearAnnualPropertyTax()
+	})
+}
+
+// SetHoaDues sets the "hoa_dues" field.
+func (u *ListingUpsertBulk) SetHoaDues(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetHoaDues(v)
+	})
+}
+
+// AddHoaDues adds v to the "hoa_dues" field.
+func (u *ListingUpsertBulk) AddHoaDues(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddHoaDues(v)
+	})
+}
+
+// UpdateHoaDues sets the "hoa_dues" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateHoaDues() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateHoaDues()
+	})
+}
+
+// ClearHoaDues clears the value of the "hoa_dues" field.
+func (u *ListingUpsertBulk) ClearHoaDues() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearHoaDues()
+	})
+}
+
+// SetHoaFrequency sets the "hoa_frequency" field.
+func (u *ListingUpsertBulk) SetHoaFrequency(v listing.HoaFrequency) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetHoaFrequency(v)
+	})
+}
+
+// UpdateHoaFrequency sets the "hoa_frequency" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateHoaFrequency() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateHoaFrequency()
+	})
+}
+
+// ClearHoaFrequency clears the value of the "hoa_frequency" field.
+func (u *ListingUpsertBulk) ClearHoaFrequency() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearHoaFrequency()
+	})
+}
+
+// SetHoaName sets the "hoa_name" field.
+func (u *ListingUpsertBulk) SetHoaName(v string) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetHoaName(v)
+	})
+}
+
+// UpdateHoaName sets the "hoa_name" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateHoaName() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateHoaName()
+	})
+}
+
+// ClearHoaName clears the value of the "hoa_name" field.
+func (u *ListingUpsertBulk) ClearHoaName() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearHoaName()
+	})
+}
+
+// SetSpecialAssessments sets the "special_assessments" field.
+func (u *ListingUpsertBulk) SetSpecialAssessments(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSpecialAssessments(v)
+	})
+}
+
+// AddSpecialAssessments adds v to the "special_assessments" field.
+func (u *ListingUpsertBulk) AddSpecialAssessments(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddSpecialAssessments(v)
+	})
+}
+
+// UpdateSpecialAssessments sets the "special_assessments" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateSpecialAssessments() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSpecialAssessments()
+	})
+}
+
+// ClearSpecialAssessments clears the value of the "special_assessments" field.
+func (u *ListingUpsertBulk) ClearSpecialAssessments() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearSpecialAssessments()
+	})
+}
+
+// SetMonthlyUtilities sets the "monthly_utilities" field.
+func (u *ListingUpsertBulk) SetMonthlyUtilities(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetMonthlyUtilities(v)
+	})
+}
+
+// AddMonthlyUtilities adds v to the "monthly_utilities" field.
+func (u *ListingUpsertBulk) AddMonthlyUtilities(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddMonthlyUtilities(v)
+	})
+}
+
+// UpdateMonthlyUtilities sets the "monthly_utilities" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateMonthlyUtilities() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateMonthlyUtilities()
+	})
+}
+
+// ClearMonthlyUtilities clears the value of the "monthly_utilities" field.
+func (u *ListingUpsertBulk) ClearMonthlyUtilities() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearMonthlyUtilities()
+	})
+}
+
+// SetMedia sets the "media" field.
+func (u *ListingUpsertBulk) SetMedia(v []schematype.Media) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetMedia(v)
+	})
+}
+
+// UpdateMedia sets the "media" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateMedia() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateMedia()
+	})
+}
+
+// ClearMedia clears the value of the "media" field.
+func (u *ListingUpsertBulk) ClearMedia() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearMedia()
+	})
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (u *ListingUpsertBulk) SetRealtorID(v uuid.UUID) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetRealtorID(v)
+	})
+}
+
+// UpdateRealtorID sets the "realtor_id" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateRealtorID() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateRealtorID()
+	})
+}
+
+// SetPropertyID sets the "property_id" field.
+func (u *ListingUpsertBulk) SetPropertyID(v uuid.UUID) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPropertyID(v)
+	})
+}
+
+// UpdatePropertyID sets the "property_id" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdatePropertyID() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePropertyID()
+	})
+}
+
+// ClearPropertyID clears the value of the "property_id" field.
+func (u *ListingUpsertBulk) ClearPropertyID() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearPropertyID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ListingUpsertBulk) SetExpiresAt(v time.Time) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateExpiresAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *ListingUpsertBulk) ClearExpiresAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetExpiryReminderSentAt sets the "expiry_reminder_sent_at" field.
+func (u *ListingUpsertBulk) SetExpiryReminderSentAt(v time.Time) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetExpiryReminderSentAt(v)
+	})
+}
+
+// UpdateExpiryReminderSentAt sets the "expiry_reminder_sent_at" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateExpiryReminderSentAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateExpiryReminderSentAt()
+	})
+}
+
+// ClearExpiryReminderSentAt clears the value of the "expiry_reminder_sent_at" field.
+func (u *ListingUpsertBulk) ClearExpiryReminderSentAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearExpiryReminderSentAt()
+	})
+}
+
+// SetExpired sets the "expired" field.
+func (u *ListingUpsertBulk) SetExpired(v bool) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetExpired(v)
+	})
+}
+
+// UpdateExpired sets the "expired" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateExpired() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateExpired()
+	})
+}
+
+// SetListedAt sets the "listed_at" field.
+func (u *ListingUpsertBulk) SetListedAt(v time.Time) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetListedAt(v)
+	})
+}
+
+// UpdateListedAt sets the "listed_at" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateListedAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateListedAt()
+	})
+}
+
+// ClearListedAt clears the value of the "listed_at" field.
+func (u *ListingUpsertBulk) ClearListedAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearListedAt()
+	})
+}
+
+// SetOnMarketSince sets the "on_market_since" field.
+func (u *ListingUpsertBulk) SetOnMarketSince(v time.Time) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetOnMarketSince(v)
+	})
+}
+
+// UpdateOnMarketSince sets the "on_market_since" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateOnMarketSince() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateOnMarketSince()
+	})
+}
+
+// ClearOnMarketSince clears the value of the "on_market_since" field.
+func (u *ListingUpsertBulk) ClearOnMarketSince() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearOnMarketSince()
+	})
+}
+
+// SetMarketSeconds sets the "market_seconds" field.
+func (u *ListingUpsertBulk) SetMarketSeconds(v int64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetMarketSeconds(v)
+	})
+}
+
+// AddMarketSeconds adds v to the "market_seconds" field.
+func (u *ListingUpsertBulk) AddMarketSeconds(v int64) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddMarketSeconds(v)
+	})
+}
+
+// UpdateMarketSeconds sets the "market_seconds" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateMarketSeconds() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateMarketSeconds()
+	})
+}
+
+// SetSoldPrice sets the "sold_price" field.
+func (u *ListingUpsertBulk) SetSoldPrice(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetSoldPrice(v)
+	})
+}
+
+// AddSoldPrice adds v to the "sold_price" field.
+func (u *ListingUpsertBulk) AddSoldPrice(v decimal.Decimal) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddSoldPrice(v)
+	})
+}
+
+// UpdateSoldPrice sets the "sold_price" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateSoldPrice() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateSoldPrice()
+	})
+}
+
+// ClearSoldPrice clears the value of the "sold_price" field.
+func (u *ListingUpsertBulk) ClearSoldPrice() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearSoldPrice()
+	})
+}
+
+// SetCloseDate sets the "close_date" field.
+func (u *ListingUpsertBulk) SetCloseDate(v time.Time) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetCloseDate(v)
+	})
+}
+
+// UpdateCloseDate sets the "close_date" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateCloseDate() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateCloseDate()
+	})
+}
+
+// ClearCloseDate clears the value of the "close_date" field.
+func (u *ListingUpsertBulk) ClearCloseDate() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearCloseDate()
+	})
+}
+
+// SetBuyerRealtorID sets the "buyer_realtor_id" field.
+func (u *ListingUpsertBulk) SetBuyerRealtorID(v uuid.UUID) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetBuyerRealtorID(v)
+	})
+}
+
+// UpdateBuyerRealtorID sets the "buyer_realtor_id" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateBuyerRealtorID() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateBuyerRealtorID()
+	})
+}
+
+// ClearBuyerRealtorID clears the value of the "buyer_realtor_id" field.
+func (u *ListingUpsertBulk) ClearBuyerRealtorID() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearBuyerRealtorID()
+	})
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (u *ListingUpsertBulk) SetPublishAt(v time.Time) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetPublishAt(v)
+	})
+}
+
+// UpdatePublishAt sets the "publish_at" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdatePublishAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdatePublishAt()
+	})
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (u *ListingUpsertBulk) ClearPublishAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearPublishAt()
+	})
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (u *ListingUpsertBulk) SetUnpublishAt(v time.Time) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetUnpublishAt(v)
+	})
+}
+
+// UpdateUnpublishAt sets the "unpublish_at" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateUnpublishAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateUnpublishAt()
+	})
+}
+
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (u *ListingUpsertBulk) ClearUnpublishAt() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.ClearUnpublishAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *ListingUpsertBulk) SetVersion(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *ListingUpsertBulk) AddVersion(v int) *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *ListingUpsertBulk) UpdateVersion() *ListingUpsertBulk {
+	return u.Update(func(s *ListingUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ListingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (lcb *ListingCreateBulk) ExecX(ctx context.Context) {
-	if err := lcb.Exec(ctx); err != nil {
+func (u *ListingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -20,56 +20,56 @@ type ListingDelete struct {
 }
 
 // Where appends a list predicates to the ListingDelete builder.
-func (ld *ListingDelete) Where(ps ...predicate.Listing) *ListingDelete {
-	ld.mutation.Where(ps...)
-	return ld
+func (_d *ListingDelete) Where(ps ...predicate.Listing) *ListingDelete {
+	_d.mutation.Where(ps...)
+	return _d
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (ld *ListingDelete) Exec(ctx context.Context) (int, error) {
-	return withHooks(ctx, ld.sqlExec, ld.mutation, ld.hooks)
+func (_d *ListingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ld *ListingDelete) ExecX(ctx context.Context) int {
-	n, err := ld.Exec(ctx)
+func (_d *ListingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
 	if err != nil {
 		panic(err)
 	}
 	return n
 }
 
-func (ld *ListingDelete) sqlExec(ctx context.Context) (int, error) {
+func (_d *ListingDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(listing.Table, sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID))
-	if ps := ld.mutation.predicates; len(ps) > 0 {
+	if ps := _d.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	affected, err := sqlgraph.DeleteNodes(ctx, ld.driver, _spec)
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
 		err = &ConstraintError{msg: err.Error(), wrap: err}
 	}
-	ld.mutation.done = true
+	_d.mutation.done = true
 	return affected, err
 }
 
 // ListingDeleteOne is the builder for deleting a single Listing entity.
 type ListingDeleteOne struct {
-	ld *ListingDelete
+	_d *ListingDelete
 }
 
 // Where appends a list predicates to the ListingDelete builder.
-func (ldo *ListingDeleteOne) Where(ps ...predicate.Listing) *ListingDeleteOne {
-	ldo.ld.mutation.Where(ps...)
-	return ldo
+func (_d *ListingDeleteOne) Where(ps ...predicate.Listing) *ListingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
 }
 
 // Exec executes the deletion query.
-func (ldo *ListingDeleteOne) Exec(ctx context.Context) error {
-	n, err := ldo.ld.Exec(ctx)
+func (_d *ListingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
 	switch {
 	case err != nil:
 		return err
@@ -81,8 +81,8 @@ func (ldo *ListingDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ldo *ListingDeleteOne) ExecX(ctx context.Context) {
-	if err := ldo.Exec(ctx); err != nil {
+func (_d *ListingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
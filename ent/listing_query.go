@@ -4,71 +4,92 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/listingrenewal"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/listingstatusevent"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/offer"
 	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/property"
 	"ppgroup.ppgroup.com/ent/realtor"
 )
 
 // ListingQuery is the builder for querying Listing entities.
 type ListingQuery struct {
 	config
-	ctx         *QueryContext
-	order       []listing.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Listing
-	withRealtor *RealtorQuery
+	ctx              *QueryContext
+	order            []listing.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Listing
+	withRealtor      *RealtorQuery
+	withProperty     *PropertyQuery
+	withNeighborhood *NeighborhoodQuery
+	withBuyerRealtor *RealtorQuery
+	withRenewals     *ListingRenewalQuery
+	withDocuments    *ListingDocumentQuery
+	withInquiries    *ListingInquiryQuery
+	withReviews      *ListingReviewQuery
+	withOffers       *OfferQuery
+	withStatusEvents *ListingStatusEventQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
 }
 
 // Where adds a new predicate for the ListingQuery builder.
-func (lq *ListingQuery) Where(ps ...predicate.Listing) *ListingQuery {
-	lq.predicates = append(lq.predicates, ps...)
-	return lq
+func (_q *ListingQuery) Where(ps ...predicate.Listing) *ListingQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
 }
 
 // Limit the number of records to be returned by this query.
-func (lq *ListingQuery) Limit(limit int) *ListingQuery {
-	lq.ctx.Limit = &limit
-	return lq
+func (_q *ListingQuery) Limit(limit int) *ListingQuery {
+	_q.ctx.Limit = &limit
+	return _q
 }
 
 // Offset to start from.
-func (lq *ListingQuery) Offset(offset int) *ListingQuery {
-	lq.ctx.Offset = &offset
-	return lq
+func (_q *ListingQuery) Offset(offset int) *ListingQuery {
+	_q.ctx.Offset = &offset
+	return _q
 }
 
 // Unique configures the query builder to filter duplicate records on query.
 // By default, unique is set to true, and can be disabled using this method.
-func (lq *ListingQuery) Unique(unique bool) *ListingQuery {
-	lq.ctx.Unique = &unique
-	return lq
+func (_q *ListingQuery) Unique(unique bool) *ListingQuery {
+	_q.ctx.Unique = &unique
+	return _q
 }
 
 // Order specifies how the records should be ordered.
-func (lq *ListingQuery) Order(o ...listing.OrderOption) *ListingQuery {
-	lq.order = append(lq.order, o...)
-	return lq
+func (_q *ListingQuery) Order(o ...listing.OrderOption) *ListingQuery {
+	_q.order = append(_q.order, o...)
+	return _q
 }
 
 // QueryRealtor chains the current query on the "realtor" edge.
-func (lq *ListingQuery) QueryRealtor() *RealtorQuery {
-	query := (&RealtorClient{config: lq.config}).Query()
+func (_q *ListingQuery) QueryRealtor() *RealtorQuery {
+	query := (&RealtorClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
-		if err := lq.prepareQuery(ctx); err != nil {
+		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		selector := lq.sqlQuery(ctx)
+		selector := _q.sqlQuery(ctx)
 		if err := selector.Err(); err != nil {
 			return nil, err
 		}
@@ -77,7 +98,205 @@ func (lq *ListingQuery) QueryRealtor() *RealtorQuery {
 			sqlgraph.To(realtor.Table, realtor.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, true, listing.RealtorTable, listing.RealtorColumn),
 		)
-		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProperty chains the current query on the "property" edge.
+func (_q *ListingQuery) QueryProperty() *PropertyQuery {
+	query := (&PropertyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, selector),
+			sqlgraph.To(property.Table, property.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listing.PropertyTable, listing.PropertyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNeighborhood chains the current query on the "neighborhood" edge.
+func (_q *ListingQuery) QueryNeighborhood() *NeighborhoodQuery {
+	query := (&NeighborhoodClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, selector),
+			sqlgraph.To(neighborhood.Table, neighborhood.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listing.NeighborhoodTable, listing.NeighborhoodColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBuyerRealtor chains the current query on the "buyer_realtor" edge.
+func (_q *ListingQuery) QueryBuyerRealtor() *RealtorQuery {
+	query := (&RealtorClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, selector),
+			sqlgraph.To(realtor.Table, realtor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listing.BuyerRealtorTable, listing.BuyerRealtorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRenewals chains the current query on the "renewals" edge.
+func (_q *ListingQuery) QueryRenewals() *ListingRenewalQuery {
+	query := (&ListingRenewalClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, selector),
+			sqlgraph.To(listingrenewal.Table, listingrenewal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.RenewalsTable, listing.RenewalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDocuments chains the current query on the "documents" edge.
+func (_q *ListingQuery) QueryDocuments() *ListingDocumentQuery {
+	query := (&ListingDocumentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, selector),
+			sqlgraph.To(listingdocument.Table, listingdocument.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.DocumentsTable, listing.DocumentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInquiries chains the current query on the "inquiries" edge.
+func (_q *ListingQuery) QueryInquiries() *ListingInquiryQuery {
+	query := (&ListingInquiryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, selector),
+			sqlgraph.To(listinginquiry.Table, listinginquiry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.InquiriesTable, listing.InquiriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReviews chains the current query on the "reviews" edge.
+func (_q *ListingQuery) QueryReviews() *ListingReviewQuery {
+	query := (&ListingReviewClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, selector),
+			sqlgraph.To(listingreview.Table, listingreview.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.ReviewsTable, listing.ReviewsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOffers chains the current query on the "offers" edge.
+func (_q *ListingQuery) QueryOffers() *OfferQuery {
+	query := (&OfferClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, selector),
+			sqlgraph.To(offer.Table, offer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.OffersTable, listing.OffersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStatusEvents chains the current query on the "status_events" edge.
+func (_q *ListingQuery) QueryStatusEvents() *ListingStatusEventQuery {
+	query := (&ListingStatusEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listing.Table, listing.FieldID, selector),
+			sqlgraph.To(listingstatusevent.Table, listingstatusevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listing.StatusEventsTable, listing.StatusEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
 	}
 	return query
@@ -85,8 +304,8 @@ func (lq *ListingQuery) QueryRealtor() *RealtorQuery {
 
 // First returns the first Listing entity from the query.
 // Returns a *NotFoundError when no Listing was found.
-func (lq *ListingQuery) First(ctx context.Context) (*Listing, error) {
-	nodes, err := lq.Limit(1).All(setContextOp(ctx, lq.ctx, ent.OpQueryFirst))
+func (_q *ListingQuery) First(ctx context.Context) (*Listing, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -97,8 +316,8 @@ func (lq *ListingQuery) First(ctx context.Context) (*Listing, error) {
 }
 
 // FirstX is like First, but panics if an error occurs.
-func (lq *ListingQuery) FirstX(ctx context.Context) *Listing {
-	node, err := lq.First(ctx)
+func (_q *ListingQuery) FirstX(ctx context.Context) *Listing {
+	node, err := _q.First(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
 	}
@@ -107,9 +326,9 @@ func (lq *ListingQuery) FirstX(ctx context.Context) *Listing {
 
 // FirstID returns the first Listing ID from the query.
 // Returns a *NotFoundError when no Listing ID was found.
-func (lq *ListingQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+func (_q *ListingQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = lq.Limit(1).IDs(setContextOp(ctx, lq.ctx, ent.OpQueryFirstID)); err != nil {
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -120,8 +339,8 @@ func (lq *ListingQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
 }
 
 // FirstIDX is like FirstID, but panics if an error occurs.
-func (lq *ListingQuery) FirstIDX(ctx context.Context) uuid.UUID {
-	id, err := lq.FirstID(ctx)
+func (_q *ListingQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
 	}
@@ -131,8 +350,8 @@ func (lq *ListingQuery) FirstIDX(ctx context.Context) uuid.UUID {
 // Only returns a single Listing entity found by the query, ensuring it only returns one.
 // Returns a *NotSingularError when more than one Listing entity is found.
 // Returns a *NotFoundError when no Listing entities are found.
-func (lq *ListingQuery) Only(ctx context.Context) (*Listing, error) {
-	nodes, err := lq.Limit(2).All(setContextOp(ctx, lq.ctx, ent.OpQueryOnly))
+func (_q *ListingQuery) Only(ctx context.Context) (*Listing, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -147,8 +366,8 @@ func (lq *ListingQuery) Only(ctx context.Context) (*Listing, error) {
 }
 
 // OnlyX is like Only, but panics if an error occurs.
-func (lq *ListingQuery) OnlyX(ctx context.Context) *Listing {
-	node, err := lq.Only(ctx)
+func (_q *ListingQuery) OnlyX(ctx context.Context) *Listing {
+	node, err := _q.Only(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -158,9 +377,9 @@ func (lq *ListingQuery) OnlyX(ctx context.Context) *Listing {
 // OnlyID is like Only, but returns the only Listing ID in the query.
 // Returns a *NotSingularError when more than one Listing ID is found.
 // Returns a *NotFoundError when no entities are found.
-func (lq *ListingQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+func (_q *ListingQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = lq.Limit(2).IDs(setContextOp(ctx, lq.ctx, ent.OpQueryOnlyID)); err != nil {
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -175,8 +394,8 @@ func (lq *ListingQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 }
 
 // OnlyIDX is like OnlyID, but panics if an error occurs.
-func (lq *ListingQuery) OnlyIDX(ctx context.Context) uuid.UUID {
-	id, err := lq.OnlyID(ctx)
+func (_q *ListingQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -184,18 +403,18 @@ func (lq *ListingQuery) OnlyIDX(ctx context.Context) uuid.UUID {
 }
 
 // All executes the query and returns a list of Listings.
-func (lq *ListingQuery) All(ctx context.Context) ([]*Listing, error) {
-	ctx = setContextOp(ctx, lq.ctx, ent.OpQueryAll)
-	if err := lq.prepareQuery(ctx); err != nil {
+func (_q *ListingQuery) All(ctx context.Context) ([]*Listing, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
 	qr := querierAll[[]*Listing, *ListingQuery]()
-	return withInterceptors[[]*Listing](ctx, lq, qr, lq.inters)
+	return withInterceptors[[]*Listing](ctx, _q, qr, _q.inters)
 }
 
 // AllX is like All, but panics if an error occurs.
-func (lq *ListingQuery) AllX(ctx context.Context) []*Listing {
-	nodes, err := lq.All(ctx)
+func (_q *ListingQuery) AllX(ctx context.Context) []*Listing {
+	nodes, err := _q.All(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -203,20 +422,20 @@ func (lq *ListingQuery) AllX(ctx context.Context) []*Listing {
 }
 
 // IDs executes the query and returns a list of Listing IDs.
-func (lq *ListingQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
-	if lq.ctx.Unique == nil && lq.path != nil {
-		lq.Unique(true)
+func (_q *ListingQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
 	}
-	ctx = setContextOp(ctx, lq.ctx, ent.OpQueryIDs)
-	if err = lq.Select(listing.FieldID).Scan(ctx, &ids); err != nil {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(listing.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
 	return ids, nil
 }
 
 // IDsX is like IDs, but panics if an error occurs.
-func (lq *ListingQuery) IDsX(ctx context.Context) []uuid.UUID {
-	ids, err := lq.IDs(ctx)
+func (_q *ListingQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -224,17 +443,17 @@ func (lq *ListingQuery) IDsX(ctx context.Context) []uuid.UUID {
 }
 
 // Count returns the count of the given query.
-func (lq *ListingQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, lq.ctx, ent.OpQueryCount)
-	if err := lq.prepareQuery(ctx); err != nil {
+func (_q *ListingQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return withInterceptors[int](ctx, lq, querierCount[*ListingQuery](), lq.inters)
+	return withInterceptors[int](ctx, _q, querierCount[*ListingQuery](), _q.inters)
 }
 
 // CountX is like Count, but panics if an error occurs.
-func (lq *ListingQuery) CountX(ctx context.Context) int {
-	count, err := lq.Count(ctx)
+func (_q *ListingQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -242,9 +461,9 @@ func (lq *ListingQuery) CountX(ctx context.Context) int {
 }
 
 // Exist returns true if the query has elements in the graph.
-func (lq *ListingQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, lq.ctx, ent.OpQueryExist)
-	switch _, err := lq.FirstID(ctx); {
+func (_q *ListingQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
 	case err != nil:
@@ -255,8 +474,8 @@ func (lq *ListingQuery) Exist(ctx context.Context) (bool, error) {
 }
 
 // ExistX is like Exist, but panics if an error occurs.
-func (lq *ListingQuery) ExistX(ctx context.Context) bool {
-	exist, err := lq.Exist(ctx)
+func (_q *ListingQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -265,32 +484,140 @@ func (lq *ListingQuery) ExistX(ctx context.Context) bool {
 
 // Clone returns a duplicate of the ListingQuery builder, including all associated steps. It can be
 // used to prepare common query builders and use them differently after the clone is made.
-func (lq *ListingQuery) Clone() *ListingQuery {
-	if lq == nil {
+func (_q *ListingQuery) Clone() *ListingQuery {
+	if _q == nil {
 		return nil
 	}
 	return &ListingQuery{
-		config:      lq.config,
-		ctx:         lq.ctx.Clone(),
-		order:       append([]listing.OrderOption{}, lq.order...),
-		inters:      append([]Interceptor{}, lq.inters...),
-		predicates:  append([]predicate.Listing{}, lq.predicates...),
-		withRealtor: lq.withRealtor.Clone(),
+		config:           _q.config,
+		ctx:              _q.ctx.Clone(),
+		order:            append([]listing.OrderOption{}, _q.order...),
+		inters:           append([]Interceptor{}, _q.inters...),
+		predicates:       append([]predicate.Listing{}, _q.predicates...),
+		withRealtor:      _q.withRealtor.Clone(),
+		withProperty:     _q.withProperty.Clone(),
+		withNeighborhood: _q.withNeighborhood.Clone(),
+		withBuyerRealtor: _q.withBuyerRealtor.Clone(),
+		withRenewals:     _q.withRenewals.Clone(),
+		withDocuments:    _q.withDocuments.Clone(),
+		withInquiries:    _q.withInquiries.Clone(),
+		withReviews:      _q.withReviews.Clone(),
+		withOffers:       _q.withOffers.Clone(),
+		withStatusEvents: _q.withStatusEvents.Clone(),
 		// clone intermediate query.
-		sql:  lq.sql.Clone(),
-		path: lq.path,
+		sql:  _q.sql.Clone(),
+		path: _q.path,
 	}
 }
 
 // WithRealtor tells the query-builder to eager-load the nodes that are connected to
 // the "realtor" edge. The optional arguments are used to configure the query builder of the edge.
-func (lq *ListingQuery) WithRealtor(opts ...func(*RealtorQuery)) *ListingQuery {
-	query := (&RealtorClient{config: lq.config}).Query()
+func (_q *ListingQuery) WithRealtor(opts ...func(*RealtorQuery)) *ListingQuery {
+	query := (&RealtorClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRealtor = query
+	return _q
+}
+
+// WithProperty tells the query-builder to eager-load the nodes that are connected to
+// the "property" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingQuery) WithProperty(opts ...func(*PropertyQuery)) *ListingQuery {
+	query := (&PropertyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withProperty = query
+	return _q
+}
+
+// WithNeighborhood tells the query-builder to eager-load the nodes that are connected to
+// the "neighborhood" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingQuery) WithNeighborhood(opts ...func(*NeighborhoodQuery)) *ListingQuery {
+	query := (&NeighborhoodClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNeighborhood = query
+	return _q
+}
+
+// WithBuyerRealtor tells the query-builder to eager-load the nodes that are connected to
+// the "buyer_realtor" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingQuery) WithBuyerRealtor(opts ...func(*RealtorQuery)) *ListingQuery {
+	query := (&RealtorClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBuyerRealtor = query
+	return _q
+}
+
+// WithRenewals tells the query-builder to eager-load the nodes that are connected to
+// the "renewals" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingQuery) WithRenewals(opts ...func(*ListingRenewalQuery)) *ListingQuery {
+	query := (&ListingRenewalClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRenewals = query
+	return _q
+}
+
+// WithDocuments tells the query-builder to eager-load the nodes that are connected to
+// the "documents" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingQuery) WithDocuments(opts ...func(*ListingDocumentQuery)) *ListingQuery {
+	query := (&ListingDocumentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDocuments = query
+	return _q
+}
+
+// WithInquiries tells the query-builder to eager-load the nodes that are connected to
+// the "inquiries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingQuery) WithInquiries(opts ...func(*ListingInquiryQuery)) *ListingQuery {
+	query := (&ListingInquiryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInquiries = query
+	return _q
+}
+
+// WithReviews tells the query-builder to eager-load the nodes that are connected to
+// the "reviews" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingQuery) WithReviews(opts ...func(*ListingReviewQuery)) *ListingQuery {
+	query := (&ListingReviewClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	lq.withRealtor = query
-	return lq
+	_q.withReviews = query
+	return _q
+}
+
+// WithOffers tells the query-builder to eager-load the nodes that are connected to
+// the "offers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingQuery) WithOffers(opts ...func(*OfferQuery)) *ListingQuery {
+	query := (&OfferClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOffers = query
+	return _q
+}
+
+// WithStatusEvents tells the query-builder to eager-load the nodes that are connected to
+// the "status_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingQuery) WithStatusEvents(opts ...func(*ListingStatusEventQuery)) *ListingQuery {
+	query := (&ListingStatusEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStatusEvents = query
+	return _q
 }
 
 // GroupBy is used to group vertices by one or more fields/columns.
@@ -307,10 +634,10 @@ func (lq *ListingQuery) WithRealtor(opts ...func(*RealtorQuery)) *ListingQuery {
 //		GroupBy(listing.FieldCreateTime).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-func (lq *ListingQuery) GroupBy(field string, fields ...string) *ListingGroupBy {
-	lq.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &ListingGroupBy{build: lq}
-	grbuild.flds = &lq.ctx.Fields
+func (_q *ListingQuery) GroupBy(field string, fields ...string) *ListingGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ListingGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = listing.Label
 	grbuild.scan = grbuild.Scan
 	return grbuild
@@ -328,81 +655,159 @@ func (lq *ListingQuery) GroupBy(field string, fields ...string) *ListingGroupBy
 //	client.Listing.Query().
 //		Select(listing.FieldCreateTime).
 //		Scan(ctx, &v)
-func (lq *ListingQuery) Select(fields ...string) *ListingSelect {
-	lq.ctx.Fields = append(lq.ctx.Fields, fields...)
-	sbuild := &ListingSelect{ListingQuery: lq}
+func (_q *ListingQuery) Select(fields ...string) *ListingSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ListingSelect{ListingQuery: _q}
 	sbuild.label = listing.Label
-	sbuild.flds, sbuild.scan = &lq.ctx.Fields, sbuild.Scan
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
 	return sbuild
 }
 
 // Aggregate returns a ListingSelect configured with the given aggregations.
-func (lq *ListingQuery) Aggregate(fns ...AggregateFunc) *ListingSelect {
-	return lq.Select().Aggregate(fns...)
+func (_q *ListingQuery) Aggregate(fns ...AggregateFunc) *ListingSelect {
+	return _q.Select().Aggregate(fns...)
 }
 
-func (lq *ListingQuery) prepareQuery(ctx context.Context) error {
-	for _, inter := range lq.inters {
+func (_q *ListingQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
 		if inter == nil {
 			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
 		}
 		if trv, ok := inter.(Traverser); ok {
-			if err := trv.Traverse(ctx, lq); err != nil {
+			if err := trv.Traverse(ctx, _q); err != nil {
 				return err
 			}
 		}
 	}
-	for _, f := range lq.ctx.Fields {
+	for _, f := range _q.ctx.Fields {
 		if !listing.ValidColumn(f) {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
-	if lq.path != nil {
-		prev, err := lq.path(ctx)
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
 		if err != nil {
 			return err
 		}
-		lq.sql = prev
+		_q.sql = prev
+	}
+	if listing.Policy == nil {
+		return errors.New("ent: uninitialized listing.Policy (forgotten import ent/runtime?)")
+	}
+	if err := listing.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
 	}
 	return nil
 }
 
-func (lq *ListingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Listing, error) {
+func (_q *ListingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Listing, error) {
 	var (
 		nodes       = []*Listing{}
-		_spec       = lq.querySpec()
-		loadedTypes = [1]bool{
-			lq.withRealtor != nil,
+		_spec       = _q.querySpec()
+		loadedTypes = [10]bool{
+			_q.withRealtor != nil,
+			_q.withProperty != nil,
+			_q.withNeighborhood != nil,
+			_q.withBuyerRealtor != nil,
+			_q.withRenewals != nil,
+			_q.withDocuments != nil,
+			_q.withInquiries != nil,
+			_q.withReviews != nil,
+			_q.withOffers != nil,
+			_q.withStatusEvents != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Listing).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []any) error {
-		node := &Listing{config: lq.config}
+		node := &Listing{config: _q.config}
 		nodes = append(nodes, node)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, lq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
-	if query := lq.withRealtor; query != nil {
-		if err := lq.loadRealtor(ctx, query, nodes, nil,
+	if query := _q.withRealtor; query != nil {
+		if err := _q.loadRealtor(ctx, query, nodes, nil,
 			func(n *Listing, e *Realtor) { n.Edges.Realtor = e }); err != nil {
 			return nil, err
 		}
 	}
+	if query := _q.withProperty; query != nil {
+		if err := _q.loadProperty(ctx, query, nodes, nil,
+			func(n *Listing, e *Property) { n.Edges.Property = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNeighborhood; query != nil {
+		if err := _q.loadNeighborhood(ctx, query, nodes, nil,
+			func(n *Listing, e *Neighborhood) { n.Edges.Neighborhood = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBuyerRealtor; query != nil {
+		if err := _q.loadBuyerRealtor(ctx, query, nodes, nil,
+			func(n *Listing, e *Realtor) { n.Edges.BuyerRealtor = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRenewals; query != nil {
+		if err := _q.loadRenewals(ctx, query, nodes,
+			func(n *Listing) { n.Edges.Renewals = []*ListingRenewal{} },
+			func(n *Listing, e *ListingRenewal) { n.Edges.Renewals = append(n.Edges.Renewals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDocuments; query != nil {
+		if err := _q.loadDocuments(ctx, query, nodes,
+			func(n *Listing) { n.Edges.Documents = []*ListingDocument{} },
+			func(n *Listing, e *ListingDocument) { n.Edges.Documents = append(n.Edges.Documents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInquiries; query != nil {
+		if err := _q.loadInquiries(ctx, query, nodes,
+			func(n *Listing) { n.Edges.Inquiries = []*ListingInquiry{} },
+			func(n *Listing, e *ListingInquiry) { n.Edges.Inquiries = append(n.Edges.Inquiries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReviews; query != nil {
+		if err := _q.loadReviews(ctx, query, nodes,
+			func(n *Listing) { n.Edges.Reviews = []*ListingReview{} },
+			func(n *Listing, e *ListingReview) { n.Edges.Reviews = append(n.Edges.Reviews, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOffers; query != nil {
+		if err := _q.loadOffers(ctx, query, nodes,
+			func(n *Listing) { n.Edges.Offers = []*Offer{} },
+			func(n *Listing, e *Offer) { n.Edges.Offers = append(n.Edges.Offers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStatusEvents; query != nil {
+		if err := _q.loadStatusEvents(ctx, query, nodes,
+			func(n *Listing) { n.Edges.StatusEvents = []*ListingStatusEvent{} },
+			func(n *Listing, e *ListingStatusEvent) { n.Edges.StatusEvents = append(n.Edges.StatusEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
-func (lq *ListingQuery) loadRealtor(ctx context.Context, query *RealtorQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *Realtor)) error {
+func (_q *ListingQuery) loadRealtor(ctx context.Context, query *RealtorQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *Realtor)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*Listing)
 	for i := range nodes {
@@ -431,25 +836,298 @@ func (lq *ListingQuery) loadRealtor(ctx context.Context, query *RealtorQuery, no
 	}
 	return nil
 }
+func (_q *ListingQuery) loadProperty(ctx context.Context, query *PropertyQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *Property)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Listing)
+	for i := range nodes {
+		fk := nodes[i].PropertyID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(property.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "property_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ListingQuery) loadNeighborhood(ctx context.Context, query *NeighborhoodQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *Neighborhood)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Listing)
+	for i := range nodes {
+		fk := nodes[i].NeighborhoodID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(neighborhood.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "neighborhood_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ListingQuery) loadBuyerRealtor(ctx context.Context, query *RealtorQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *Realtor)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Listing)
+	for i := range nodes {
+		if nodes[i].BuyerRealtorID == nil {
+			continue
+		}
+		fk := *nodes[i].BuyerRealtorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(realtor.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "buyer_realtor_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ListingQuery) loadRenewals(ctx context.Context, query *ListingRenewalQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *ListingRenewal)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Listing)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(listingrenewal.FieldListingID)
+	}
+	query.Where(predicate.ListingRenewal(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(listing.RenewalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ListingID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "listing_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ListingQuery) loadDocuments(ctx context.Context, query *ListingDocumentQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *ListingDocument)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Listing)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(listingdocument.FieldListingID)
+	}
+	query.Where(predicate.ListingDocument(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(listing.DocumentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ListingID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "listing_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ListingQuery) loadInquiries(ctx context.Context, query *ListingInquiryQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *ListingInquiry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Listing)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(listinginquiry.FieldListingID)
+	}
+	query.Where(predicate.ListingInquiry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(listing.InquiriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ListingID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "listing_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ListingQuery) loadReviews(ctx context.Context, query *ListingReviewQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *ListingReview)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Listing)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(listingreview.FieldListingID)
+	}
+	query.Where(predicate.ListingReview(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(listing.ReviewsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ListingID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "listing_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ListingQuery) loadOffers(ctx context.Context, query *OfferQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *Offer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Listing)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(offer.FieldListingID)
+	}
+	query.Where(predicate.Offer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(listing.OffersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ListingID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "listing_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ListingQuery) loadStatusEvents(ctx context.Context, query *ListingStatusEventQuery, nodes []*Listing, init func(*Listing), assign func(*Listing, *ListingStatusEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Listing)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(listingstatusevent.FieldListingID)
+	}
+	query.Where(predicate.ListingStatusEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(listing.StatusEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ListingID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "listing_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
-func (lq *ListingQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := lq.querySpec()
-	_spec.Node.Columns = lq.ctx.Fields
-	if len(lq.ctx.Fields) > 0 {
-		_spec.Unique = lq.ctx.Unique != nil && *lq.ctx.Unique
+func (_q *ListingQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
 	}
-	return sqlgraph.CountNodes(ctx, lq.driver, _spec)
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
 }
 
-func (lq *ListingQuery) querySpec() *sqlgraph.QuerySpec {
+func (_q *ListingQuery) querySpec() *sqlgraph.QuerySpec {
 	_spec := sqlgraph.NewQuerySpec(listing.Table, listing.Columns, sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID))
-	_spec.From = lq.sql
-	if unique := lq.ctx.Unique; unique != nil {
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
-	} else if lq.path != nil {
+	} else if _q.path != nil {
 		_spec.Unique = true
 	}
-	if fields := lq.ctx.Fields; len(fields) > 0 {
+	if fields := _q.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, listing.FieldID)
 		for i := range fields {
@@ -457,24 +1135,33 @@ func (lq *ListingQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
-		if lq.withRealtor != nil {
+		if _q.withRealtor != nil {
 			_spec.Node.AddColumnOnce(listing.FieldRealtorID)
 		}
+		if _q.withProperty != nil {
+			_spec.Node.AddColumnOnce(listing.FieldPropertyID)
+		}
+		if _q.withNeighborhood != nil {
+			_spec.Node.AddColumnOnce(listing.FieldNeighborhoodID)
+		}
+		if _q.withBuyerRealtor != nil {
+			_spec.Node.AddColumnOnce(listing.FieldBuyerRealtorID)
+		}
 	}
-	if ps := lq.predicates; len(ps) > 0 {
+	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if limit := lq.ctx.Limit; limit != nil {
+	if limit := _q.ctx.Limit; limit != nil {
 		_spec.Limit = *limit
 	}
-	if offset := lq.ctx.Offset; offset != nil {
+	if offset := _q.ctx.Offset; offset != nil {
 		_spec.Offset = *offset
 	}
-	if ps := lq.order; len(ps) > 0 {
+	if ps := _q.order; len(ps) > 0 {
 		_spec.Order = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
@@ -484,38 +1171,67 @@ func (lq *ListingQuery) querySpec() *sqlgraph.QuerySpec {
 	return _spec
 }
 
-func (lq *ListingQuery) sqlQuery(ctx context.Context) *sql.Selector {
-	builder := sql.Dialect(lq.driver.Dialect())
+func (_q *ListingQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
 	t1 := builder.Table(listing.Table)
-	columns := lq.ctx.Fields
+	columns := _q.ctx.Fields
 	if len(columns) == 0 {
 		columns = listing.Columns
 	}
 	selector := builder.Select(t1.Columns(columns...)...).From(t1)
-	if lq.sql != nil {
-		selector = lq.sql
+	if _q.sql != nil {
+		selector = _q.sql
 		selector.Select(selector.Columns(columns...)...)
 	}
-	if lq.ctx.Unique != nil && *lq.ctx.Unique {
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
-	for _, p := range lq.predicates {
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
 		p(selector)
 	}
-	for _, p := range lq.order {
+	for _, p := range _q.order {
 		p(selector)
 	}
-	if offset := lq.ctx.Offset; offset != nil {
+	if offset := _q.ctx.Offset; offset != nil {
 		// limit is mandatory for offset clause. We start
 		// with default value, and override it below if needed.
 		selector.Offset(*offset).Limit(math.MaxInt32)
 	}
-	if limit := lq.ctx.Limit; limit != nil {
+	if limit := _q.ctx.Limit; limit != nil {
 		selector.Limit(*limit)
 	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *ListingQuery) ForUpdate(opts ...sql.LockOption) *ListingQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *ListingQuery) ForShare(opts ...sql.LockOption) *ListingQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // ListingGroupBy is the group-by builder for Listing entities.
 type ListingGroupBy struct {
 	selector
@@ -523,41 +1239,41 @@ type ListingGroupBy struct {
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
-func (lgb *ListingGroupBy) Aggregate(fns ...AggregateFunc) *ListingGroupBy {
-	lgb.fns = append(lgb.fns, fns...)
-	return lgb
+func (_g *ListingGroupBy) Aggregate(fns ...AggregateFunc) *ListingGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
 }
 
 // Scan applies the selector query and scans the result into the given value.
-func (lgb *ListingGroupBy) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, lgb.build.ctx, ent.OpQueryGroupBy)
-	if err := lgb.build.prepareQuery(ctx); err != nil {
+func (_g *ListingGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
 		return err
 	}
-	return scanWithInterceptors[*ListingQuery, *ListingGroupBy](ctx, lgb.build, lgb, lgb.build.inters, v)
+	return scanWithInterceptors[*ListingQuery, *ListingGroupBy](ctx, _g.build, _g, _g.build.inters, v)
 }
 
-func (lgb *ListingGroupBy) sqlScan(ctx context.Context, root *ListingQuery, v any) error {
+func (_g *ListingGroupBy) sqlScan(ctx context.Context, root *ListingQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
-	aggregation := make([]string, 0, len(lgb.fns))
-	for _, fn := range lgb.fns {
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
 		aggregation = append(aggregation, fn(selector))
 	}
 	if len(selector.SelectedColumns()) == 0 {
-		columns := make([]string, 0, len(*lgb.flds)+len(lgb.fns))
-		for _, f := range *lgb.flds {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
 			columns = append(columns, selector.C(f))
 		}
 		columns = append(columns, aggregation...)
 		selector.Select(columns...)
 	}
-	selector.GroupBy(selector.Columns(*lgb.flds...)...)
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
 	if err := selector.Err(); err != nil {
 		return err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := lgb.build.driver.Query(ctx, query, args, rows); err != nil {
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -571,27 +1287,27 @@ type ListingSelect struct {
 }
 
 // Aggregate adds the given aggregation functions to the selector query.
-func (ls *ListingSelect) Aggregate(fns ...AggregateFunc) *ListingSelect {
-	ls.fns = append(ls.fns, fns...)
-	return ls
+func (_s *ListingSelect) Aggregate(fns ...AggregateFunc) *ListingSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
 }
 
 // Scan applies the selector query and scans the result into the given value.
-func (ls *ListingSelect) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, ls.ctx, ent.OpQuerySelect)
-	if err := ls.prepareQuery(ctx); err != nil {
+func (_s *ListingSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
 		return err
 	}
-	return scanWithInterceptors[*ListingQuery, *ListingSelect](ctx, ls.ListingQuery, ls, ls.inters, v)
+	return scanWithInterceptors[*ListingQuery, *ListingSelect](ctx, _s.ListingQuery, _s, _s.inters, v)
 }
 
-func (ls *ListingSelect) sqlScan(ctx context.Context, root *ListingQuery, v any) error {
+func (_s *ListingSelect) sqlScan(ctx context.Context, root *ListingQuery, v any) error {
 	selector := root.sqlQuery(ctx)
-	aggregation := make([]string, 0, len(ls.fns))
-	for _, fn := range ls.fns {
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
 		aggregation = append(aggregation, fn(selector))
 	}
-	switch n := len(*ls.selector.flds); {
+	switch n := len(*_s.selector.flds); {
 	case n == 0 && len(aggregation) > 0:
 		selector.Select(aggregation...)
 	case n != 0 && len(aggregation) > 0:
@@ -599,7 +1315,7 @@ func (ls *ListingSelect) sqlScan(ctx context.Context, root *ListingQuery, v any)
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := ls.driver.Query(ctx, query, args, rows); err != nil {
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -15,9 +15,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/listingrenewal"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/listingstatusevent"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/offer"
 	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/property"
 	"ppgroup.ppgroup.com/ent/realtor"
-	"ppgroup.ppgroup.com/ent/schema"
+	"ppgroup.ppgroup.com/ent/schematype"
 )
 
 // ListingUpdate is the builder for updating Listing entities.
@@ -28,1225 +36,1659 @@ type ListingUpdate struct {
 }
 
 // Where appends a list predicates to the ListingUpdate builder.
-func (lu *ListingUpdate) Where(ps ...predicate.Listing) *ListingUpdate {
-	lu.mutation.Where(ps...)
-	return lu
+func (_u *ListingUpdate) Where(ps ...predicate.Listing) *ListingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (lu *ListingUpdate) SetUpdateTime(t time.Time) *ListingUpdate {
-	lu.mutation.SetUpdateTime(t)
-	return lu
+func (_u *ListingUpdate) SetUpdateTime(v time.Time) *ListingUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
 }
 
 // SetTitle sets the "title" field.
-func (lu *ListingUpdate) SetTitle(s string) *ListingUpdate {
-	lu.mutation.SetTitle(s)
-	return lu
+func (_u *ListingUpdate) SetTitle(v string) *ListingUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
 }
 
 // SetNillableTitle sets the "title" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableTitle(s *string) *ListingUpdate {
-	if s != nil {
-		lu.SetTitle(*s)
+func (_u *ListingUpdate) SetNillableTitle(v *string) *ListingUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
 	}
-	return lu
+	return _u
 }
 
 // SetAddress sets the "address" field.
-func (lu *ListingUpdate) SetAddress(s string) *ListingUpdate {
-	lu.mutation.SetAddress(s)
-	return lu
+func (_u *ListingUpdate) SetAddress(v string) *ListingUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
 }
 
 // SetNillableAddress sets the "address" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableAddress(s *string) *ListingUpdate {
-	if s != nil {
-		lu.SetAddress(*s)
+func (_u *ListingUpdate) SetNillableAddress(v *string) *ListingUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
 	}
-	return lu
+	return _u
+}
+
+// SetAddressKey sets the "address_key" field.
+func (_u *ListingUpdate) SetAddressKey(v string) *ListingUpdate {
+	_u.mutation.SetAddressKey(v)
+	return _u
+}
+
+// SetNillableAddressKey sets the "address_key" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableAddressKey(v *string) *ListingUpdate {
+	if v != nil {
+		_u.SetAddressKey(*v)
+	}
+	return _u
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (_u *ListingUpdate) ClearAddressKey() *ListingUpdate {
+	_u.mutation.ClearAddressKey()
+	return _u
 }
 
 // SetCity sets the "city" field.
-func (lu *ListingUpdate) SetCity(s string) *ListingUpdate {
-	lu.mutation.SetCity(s)
-	return lu
+func (_u *ListingUpdate) SetCity(v string) *ListingUpdate {
+	_u.mutation.SetCity(v)
+	return _u
 }
 
 // SetNillableCity sets the "city" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableCity(s *string) *ListingUpdate {
-	if s != nil {
-		lu.SetCity(*s)
+func (_u *ListingUpdate) SetNillableCity(v *string) *ListingUpdate {
+	if v != nil {
+		_u.SetCity(*v)
 	}
-	return lu
+	return _u
 }
 
 // SetState sets the "state" field.
-func (lu *ListingUpdate) SetState(s string) *ListingUpdate {
-	lu.mutation.SetState(s)
-	return lu
+func (_u *ListingUpdate) SetState(v string) *ListingUpdate {
+	_u.mutation.SetState(v)
+	return _u
 }
 
 // SetNillableState sets the "state" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableState(s *string) *ListingUpdate {
-	if s != nil {
-		lu.SetState(*s)
+func (_u *ListingUpdate) SetNillableState(v *string) *ListingUpdate {
+	if v != nil {
+		_u.SetState(*v)
 	}
-	return lu
+	return _u
 }
 
 // SetZipCode sets the "zip_code" field.
-func (lu *ListingUpdate) SetZipCode(s string) *ListingUpdate {
-	lu.mutation.SetZipCode(s)
-	return lu
+func (_u *ListingUpdate) SetZipCode(v string) *ListingUpdate {
+	_u.mutation.SetZipCode(v)
+	return _u
 }
 
 // SetNillableZipCode sets the "zip_code" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableZipCode(s *string) *ListingUpdate {
-	if s != nil {
-		lu.SetZipCode(*s)
+func (_u *ListingUpdate) SetNillableZipCode(v *string) *ListingUpdate {
+	if v != nil {
+		_u.SetZipCode(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *ListingUpdate) SetCountry(v string) *ListingUpdate {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableCountry(v *string) *ListingUpdate {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *ListingUpdate) SetLatitude(v float64) *ListingUpdate {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableLatitude(v *float64) *ListingUpdate {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *ListingUpdate) AddLatitude(v float64) *ListingUpdate {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *ListingUpdate) ClearLatitude() *ListingUpdate {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *ListingUpdate) SetLongitude(v float64) *ListingUpdate {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableLongitude(v *float64) *ListingUpdate {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *ListingUpdate) AddLongitude(v float64) *ListingUpdate {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *ListingUpdate) ClearLongitude() *ListingUpdate {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetNeighborhoodID sets the "neighborhood_id" field.
+func (_u *ListingUpdate) SetNeighborhoodID(v uuid.UUID) *ListingUpdate {
+	_u.mutation.SetNeighborhoodID(v)
+	return _u
+}
+
+// SetNillableNeighborhoodID sets the "neighborhood_id" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableNeighborhoodID(v *uuid.UUID) *ListingUpdate {
+	if v != nil {
+		_u.SetNeighborhoodID(*v)
 	}
-	return lu
+	return _u
+}
+
+// ClearNeighborhoodID clears the value of the "neighborhood_id" field.
+func (_u *ListingUpdate) ClearNeighborhoodID() *ListingUpdate {
+	_u.mutation.ClearNeighborhoodID()
+	return _u
 }
 
 // SetDescription sets the "description" field.
-func (lu *ListingUpdate) SetDescription(s string) *ListingUpdate {
-	lu.mutation.SetDescription(s)
-	return lu
+func (_u *ListingUpdate) SetDescription(v string) *ListingUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
 }
 
 // SetNillableDescription sets the "description" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableDescription(s *string) *ListingUpdate {
-	if s != nil {
-		lu.SetDescription(*s)
+func (_u *ListingUpdate) SetNillableDescription(v *string) *ListingUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
 	}
-	return lu
+	return _u
 }
 
 // ClearDescription clears the value of the "description" field.
-func (lu *ListingUpdate) ClearDescription() *ListingUpdate {
-	lu.mutation.ClearDescription()
-	return lu
+func (_u *ListingUpdate) ClearDescription() *ListingUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSourceLocale sets the "source_locale" field.
+func (_u *ListingUpdate) SetSourceLocale(v string) *ListingUpdate {
+	_u.mutation.SetSourceLocale(v)
+	return _u
+}
+
+// SetNillableSourceLocale sets the "source_locale" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableSourceLocale(v *string) *ListingUpdate {
+	if v != nil {
+		_u.SetSourceLocale(*v)
+	}
+	return _u
+}
+
+// SetTranslations sets the "translations" field.
+func (_u *ListingUpdate) SetTranslations(v map[string]schematype.Translation) *ListingUpdate {
+	_u.mutation.SetTranslations(v)
+	return _u
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (_u *ListingUpdate) ClearTranslations() *ListingUpdate {
+	_u.mutation.ClearTranslations()
+	return _u
 }
 
 // SetPrice sets the "price" field.
-func (lu *ListingUpdate) SetPrice(d decimal.Decimal) *ListingUpdate {
-	lu.mutation.ResetPrice()
-	lu.mutation.SetPrice(d)
-	return lu
+func (_u *ListingUpdate) SetPrice(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillablePrice(d *decimal.Decimal) *ListingUpdate {
-	if d != nil {
-		lu.SetPrice(*d)
+func (_u *ListingUpdate) SetNillablePrice(v *decimal.Decimal) *ListingUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
 	}
-	return lu
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *ListingUpdate) AddPrice(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
 }
 
-// AddPrice adds d to the "price" field.
-func (lu *ListingUpdate) AddPrice(d decimal.Decimal) *ListingUpdate {
-	lu.mutation.AddPrice(d)
-	return lu
+// SetCurrency sets the "currency" field.
+func (_u *ListingUpdate) SetCurrency(v string) *ListingUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableCurrency(v *string) *ListingUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
 }
 
 // SetBedroom sets the "bedroom" field.
-func (lu *ListingUpdate) SetBedroom(i int) *ListingUpdate {
-	lu.mutation.ResetBedroom()
-	lu.mutation.SetBedroom(i)
-	return lu
+func (_u *ListingUpdate) SetBedroom(v int) *ListingUpdate {
+	_u.mutation.ResetBedroom()
+	_u.mutation.SetBedroom(v)
+	return _u
 }
 
 // SetNillableBedroom sets the "bedroom" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableBedroom(i *int) *ListingUpdate {
-	if i != nil {
-		lu.SetBedroom(*i)
+func (_u *ListingUpdate) SetNillableBedroom(v *int) *ListingUpdate {
+	if v != nil {
+		_u.SetBedroom(*v)
 	}
-	return lu
+	return _u
 }
 
-// AddBedroom adds i to the "bedroom" field.
-func (lu *ListingUpdate) AddBedroom(i int) *ListingUpdate {
-	lu.mutation.AddBedroom(i)
-	return lu
+// AddBedroom adds value to the "bedroom" field.
+func (_u *ListingUpdate) AddBedroom(v int) *ListingUpdate {
+	_u.mutation.AddBedroom(v)
+	return _u
 }
 
 // SetBathroom sets the "bathroom" field.
-func (lu *ListingUpdate) SetBathroom(f float64) *ListingUpdate {
-	lu.mutation.ResetBathroom()
-	lu.mutation.SetBathroom(f)
-	return lu
+func (_u *ListingUpdate) SetBathroom(v float64) *ListingUpdate {
+	_u.mutation.ResetBathroom()
+	_u.mutation.SetBathroom(v)
+	return _u
 }
 
 // SetNillableBathroom sets the "bathroom" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableBathroom(f *float64) *ListingUpdate {
-	if f != nil {
-		lu.SetBathroom(*f)
+func (_u *ListingUpdate) SetNillableBathroom(v *float64) *ListingUpdate {
+	if v != nil {
+		_u.SetBathroom(*v)
 	}
-	return lu
+	return _u
 }
 
-// AddBathroom adds f to the "bathroom" field.
-func (lu *ListingUpdate) AddBathroom(f float64) *ListingUpdate {
-	lu.mutation.AddBathroom(f)
-	return lu
+// AddBathroom adds value to the "bathroom" field.
+func (_u *ListingUpdate) AddBathroom(v float64) *ListingUpdate {
+	_u.mutation.AddBathroom(v)
+	return _u
 }
 
 // SetGarage sets the "garage" field.
-func (lu *ListingUpdate) SetGarage(i int) *ListingUpdate {
-	lu.mutation.ResetGarage()
-	lu.mutation.SetGarage(i)
-	return lu
+func (_u *ListingUpdate) SetGarage(v int) *ListingUpdate {
+	_u.mutation.ResetGarage()
+	_u.mutation.SetGarage(v)
+	return _u
 }
 
 // SetNillableGarage sets the "garage" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableGarage(i *int) *ListingUpdate {
-	if i != nil {
-		lu.SetGarage(*i)
+func (_u *ListingUpdate) SetNillableGarage(v *int) *ListingUpdate {
+	if v != nil {
+		_u.SetGarage(*v)
 	}
-	return lu
+	return _u
 }
 
-// AddGarage adds i to the "garage" field.
-func (lu *ListingUpdate) AddGarage(i int) *ListingUpdate {
-	lu.mutation.AddGarage(i)
-	return lu
+// AddGarage adds value to the "garage" field.
+func (_u *ListingUpdate) AddGarage(v int) *ListingUpdate {
+	_u.mutation.AddGarage(v)
+	return _u
 }
 
 // ClearGarage clears the value of the "garage" field.
-func (lu *ListingUpdate) ClearGarage() *ListingUpdate {
-	lu.mutation.ClearGarage()
-	return lu
+func (_u *ListingUpdate) ClearGarage() *ListingUpdate {
+	_u.mutation.ClearGarage()
+	return _u
 }
 
 // SetSqft sets the "sqft" field.
-func (lu *ListingUpdate) SetSqft(i int) *ListingUpdate {
-	lu.mutation.ResetSqft()
-	lu.mutation.SetSqft(i)
-	return lu
+func (_u *ListingUpdate) SetSqft(v int) *ListingUpdate {
+	_u.mutation.ResetSqft()
+	_u.mutation.SetSqft(v)
+	return _u
 }
 
 // SetNillableSqft sets the "sqft" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableSqft(i *int) *ListingUpdate {
-	if i != nil {
-		lu.SetSqft(*i)
+func (_u *ListingUpdate) SetNillableSqft(v *int) *ListingUpdate {
+	if v != nil {
+		_u.SetSqft(*v)
+	}
+	return _u
+}
+
+// AddSqft adds value to the "sqft" field.
+func (_u *ListingUpdate) AddSqft(v int) *ListingUpdate {
+	_u.mutation.AddSqft(v)
+	return _u
+}
+
+// SetSqm sets the "sqm" field.
+func (_u *ListingUpdate) SetSqm(v int) *ListingUpdate {
+	_u.mutation.ResetSqm()
+	_u.mutation.SetSqm(v)
+	return _u
+}
+
+// SetNillableSqm sets the "sqm" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableSqm(v *int) *ListingUpdate {
+	if v != nil {
+		_u.SetSqm(*v)
 	}
-	return lu
+	return _u
+}
+
+// AddSqm adds value to the "sqm" field.
+func (_u *ListingUpdate) AddSqm(v int) *ListingUpdate {
+	_u.mutation.AddSqm(v)
+	return _u
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (_u *ListingUpdate) ClearSqm() *ListingUpdate {
+	_u.mutation.ClearSqm()
+	return _u
+}
+
+// SetAreaUnit sets the "area_unit" field.
+func (_u *ListingUpdate) SetAreaUnit(v listing.AreaUnit) *ListingUpdate {
+	_u.mutation.SetAreaUnit(v)
+	return _u
 }
 
-// AddSqft adds i to the "sqft" field.
-func (lu *ListingUpdate) AddSqft(i int) *ListingUpdate {
-	lu.mutation.AddSqft(i)
-	return lu
+// SetNillableAreaUnit sets the "area_unit" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableAreaUnit(v *listing.AreaUnit) *ListingUpdate {
+	if v != nil {
+		_u.SetAreaUnit(*v)
+	}
+	return _u
 }
 
 // SetTypeOfProperty sets the "type_of_property" field.
-func (lu *ListingUpdate) SetTypeOfProperty(lop listing.TypeOfProperty) *ListingUpdate {
-	lu.mutation.SetTypeOfProperty(lop)
-	return lu
+func (_u *ListingUpdate) SetTypeOfProperty(v listing.TypeOfProperty) *ListingUpdate {
+	_u.mutation.SetTypeOfProperty(v)
+	return _u
 }
 
 // SetNillableTypeOfProperty sets the "type_of_property" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableTypeOfProperty(lop *listing.TypeOfProperty) *ListingUpdate {
-	if lop != nil {
-		lu.SetTypeOfProperty(*lop)
+func (_u *ListingUpdate) SetNillableTypeOfProperty(v *listing.TypeOfProperty) *ListingUpdate {
+	if v != nil {
+		_u.SetTypeOfProperty(*v)
 	}
-	return lu
+	return _u
 }
 
 // SetStatus sets the "status" field.
-func (lu *ListingUpdate) SetStatus(l listing.Status) *ListingUpdate {
-	lu.mutation.SetStatus(l)
-	return lu
+func (_u *ListingUpdate) SetStatus(v listing.Status) *ListingUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
 }
 
 // SetNillableStatus sets the "status" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableStatus(l *listing.Status) *ListingUpdate {
-	if l != nil {
-		lu.SetStatus(*l)
+func (_u *ListingUpdate) SetNillableStatus(v *listing.Status) *ListingUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
 	}
-	return lu
+	return _u
 }
 
 // SetLotSize sets the "lot_size" field.
-func (lu *ListingUpdate) SetLotSize(i int) *ListingUpdate {
-	lu.mutation.ResetLotSize()
-	lu.mutation.SetLotSize(i)
-	return lu
+func (_u *ListingUpdate) SetLotSize(v int) *ListingUpdate {
+	_u.mutation.ResetLotSize()
+	_u.mutation.SetLotSize(v)
+	return _u
 }
 
 // SetNillableLotSize sets the "lot_size" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableLotSize(i *int) *ListingUpdate {
-	if i != nil {
-		lu.SetLotSize(*i)
+func (_u *ListingUpdate) SetNillableLotSize(v *int) *ListingUpdate {
+	if v != nil {
+		_u.SetLotSize(*v)
 	}
-	return lu
+	return _u
 }
 
-// AddLotSize adds i to the "lot_size" field.
-func (lu *ListingUpdate) AddLotSize(i int) *ListingUpdate {
-	lu.mutation.AddLotSize(i)
-	return lu
+// AddLotSize adds value to the "lot_size" field.
+func (_u *ListingUpdate) AddLotSize(v int) *ListingUpdate {
+	_u.mutation.AddLotSize(v)
+	return _u
 }
 
 // ClearLotSize clears the value of the "lot_size" field.
-func (lu *ListingUpdate) ClearLotSize() *ListingUpdate {
-	lu.mutation.ClearLotSize()
-	return lu
+func (_u *ListingUpdate) ClearLotSize() *ListingUpdate {
+	_u.mutation.ClearLotSize()
+	return _u
 }
 
 // SetPool sets the "pool" field.
-func (lu *ListingUpdate) SetPool(b bool) *ListingUpdate {
-	lu.mutation.SetPool(b)
-	return lu
+func (_u *ListingUpdate) SetPool(v bool) *ListingUpdate {
+	_u.mutation.SetPool(v)
+	return _u
 }
 
 // SetNillablePool sets the "pool" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillablePool(b *bool) *ListingUpdate {
-	if b != nil {
-		lu.SetPool(*b)
+func (_u *ListingUpdate) SetNillablePool(v *bool) *ListingUpdate {
+	if v != nil {
+		_u.SetPool(*v)
 	}
-	return lu
+	return _u
 }
 
 // ClearPool clears the value of the "pool" field.
-func (lu *ListingUpdate) ClearPool() *ListingUpdate {
-	lu.mutation.ClearPool()
-	return lu
+func (_u *ListingUpdate) ClearPool() *ListingUpdate {
+	_u.mutation.ClearPool()
+	return _u
 }
 
 // SetYearBuilt sets the "year_built" field.
-func (lu *ListingUpdate) SetYearBuilt(i int) *ListingUpdate {
-	lu.mutation.ResetYearBuilt()
-	lu.mutation.SetYearBuilt(i)
-	return lu
+func (_u *ListingUpdate) SetYearBuilt(v int) *ListingUpdate {
+	_u.mutation.ResetYearBuilt()
+	_u.mutation.SetYearBuilt(v)
+	return _u
 }
 
 // SetNillableYearBuilt sets the "year_built" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableYearBuilt(i *int) *ListingUpdate {
-	if i != nil {
-		lu.SetYearBuilt(*i)
+func (_u *ListingUpdate) SetNillableYearBuilt(v *int) *ListingUpdate {
+	if v != nil {
+		_u.SetYearBuilt(*v)
 	}
-	return lu
+	return _u
 }
 
-// AddYearBuilt adds i to the "year_built" field.
-func (lu *ListingUpdate) AddYearBuilt(i int) *ListingUpdate {
-	lu.mutation.AddYearBuilt(i)
-	return lu
+// AddYearBuilt adds value to the "year_built" field.
+func (_u *ListingUpdate) AddYearBuilt(v int) *ListingUpdate {
+	_u.mutation.AddYearBuilt(v)
+	return _u
 }
 
-// SetMedia sets the "media" field.
-func (lu *ListingUpdate) SetMedia(s []schema.Media) *ListingUpdate {
-	lu.mutation.SetMedia(s)
-	return lu
+// SetAnnualPropertyTax sets the "annual_property_tax" field.
+func (_u *ListingUpdate) SetAnnualPropertyTax(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.ResetAnnualPropertyTax()
+	_u.mutation.SetAnnualPropertyTax(v)
+	return _u
 }
 
-// AppendMedia appends s to the "media" field.
-func (lu *ListingUpdate) AppendMedia(s []schema.Media) *ListingUpdate {
-	lu.mutation.AppendMedia(s)
-	return lu
+// SetNillableAnnualPropertyTax sets the "annual_property_tax" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableAnnualPropertyTax(v *decimal.Decimal) *ListingUpdate {
+	if v != nil {
+		_u.SetAnnualPropertyTax(*v)
+	}
+	return _u
 }
 
-// ClearMedia clears the value of the "media" field.
-func (lu *ListingUpdate) ClearMedia() *ListingUpdate {
-	lu.mutation.ClearMedia()
-	return lu
+// AddAnnualPropertyTax adds value to the "annual_property_tax" field.
+func (_u *ListingUpdate) AddAnnualPropertyTax(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.AddAnnualPropertyTax(v)
+	return _u
 }
 
-// SetRealtorID sets the "realtor_id" field.
-func (lu *ListingUpdate) SetRealtorID(u uuid.UUID) *ListingUpdate {
-	lu.mutation.SetRealtorID(u)
-	return lu
+// ClearAnnualPropertyTax clears the value of the "annual_property_tax" field.
+func (_u *ListingUpdate) ClearAnnualPropertyTax() *ListingUpdate {
+	_u.mutation.ClearAnnualPropertyTax()
+	return _u
 }
 
-// SetNillableRealtorID sets the "realtor_id" field if the given value is not nil.
-func (lu *ListingUpdate) SetNillableRealtorID(u *uuid.UUID) *ListingUpdate {
-	if u != nil {
-		lu.SetRealtorID(*u)
+// SetHoaDues sets the "hoa_dues" field.
+func (_u *ListingUpdate) SetHoaDues(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.ResetHoaDues()
+	_u.mutation.SetHoaDues(v)
+	return _u
+}
+
+// SetNillableHoaDues sets the "hoa_dues" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableHoaDues(v *decimal.Decimal) *ListingUpdate {
+	if v != nil {
+		_u.SetHoaDues(*v)
 	}
-	return lu
+	return _u
 }
 
-// SetRealtor sets the "realtor" edge to the Realtor entity.
-func (lu *ListingUpdate) SetRealtor(r *Realtor) *ListingUpdate {
-	return lu.SetRealtorID(r.ID)
+// AddHoaDues adds value to the "hoa_dues" field.
+func (_u *ListingUpdate) AddHoaDues(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.AddHoaDues(v)
+	return _u
 }
 
-// Mutation returns the ListingMutation object of the builder.
-func (lu *ListingUpdate) Mutation() *ListingMutation {
-	return lu.mutation
+// ClearHoaDues clears the value of the "hoa_dues" field.
+func (_u *ListingUpdate) ClearHoaDues() *ListingUpdate {
+	_u.mutation.ClearHoaDues()
+	return _u
 }
 
-// ClearRealtor clears the "realtor" edge to the Realtor entity.
-func (lu *ListingUpdate) ClearRealtor() *ListingUpdate {
-	lu.mutation.ClearRealtor()
-	return lu
+// SetHoaFrequency sets the "hoa_frequency" field.
+func (_u *ListingUpdate) SetHoaFrequency(v listing.HoaFrequency) *ListingUpdate {
+	_u.mutation.SetHoaFrequency(v)
+	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (lu *ListingUpdate) Save(ctx context.Context) (int, error) {
-	lu.defaults()
-	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+// SetNillableHoaFrequency sets the "hoa_frequency" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableHoaFrequency(v *listing.HoaFrequency) *ListingUpdate {
+	if v != nil {
+		_u.SetHoaFrequency(*v)
+	}
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (lu *ListingUpdate) SaveX(ctx context.Context) int {
-	affected, err := lu.Save(ctx)
-	if err != nil {
-		panic(err)
+// ClearHoaFrequency clears the value of the "hoa_frequency" field.
+func (_u *ListingUpdate) ClearHoaFrequency() *ListingUpdate {
+	_u.mutation.ClearHoaFrequency()
+	return _u
+}
+
+// SetHoaName sets the "hoa_name" field.
+func (_u *ListingUpdate) SetHoaName(v string) *ListingUpdate {
+	_u.mutation.SetHoaName(v)
+	return _u
+}
+
+// SetNillableHoaName sets the "hoa_name" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableHoaName(v *string) *ListingUpdate {
+	if v != nil {
+		_u.SetHoaName(*v)
 	}
-	return affected
+	return _u
 }
 
-// Exec executes the query.
-func (lu *ListingUpdate) Exec(ctx context.Context) error {
-	_, err := lu.Save(ctx)
-	return err
+// ClearHoaName clears the value of the "hoa_name" field.
+func (_u *ListingUpdate) ClearHoaName() *ListingUpdate {
+	_u.mutation.ClearHoaName()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (lu *ListingUpdate) ExecX(ctx context.Context) {
-	if err := lu.Exec(ctx); err != nil {
-		panic(err)
+// SetSpecialAssessments sets the "special_assessments" field.
+func (_u *ListingUpdate) SetSpecialAssessments(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.ResetSpecialAssessments()
+	_u.mutation.SetSpecialAssessments(v)
+	return _u
+}
+
+// SetNillableSpecialAssessments sets the "special_assessments" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableSpecialAssessments(v *decimal.Decimal) *ListingUpdate {
+	if v != nil {
+		_u.SetSpecialAssessments(*v)
 	}
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (lu *ListingUpdate) defaults() {
-	if _, ok := lu.mutation.UpdateTime(); !ok {
-		v := listing.UpdateDefaultUpdateTime()
-		lu.mutation.SetUpdateTime(v)
+// AddSpecialAssessments adds value to the "special_assessments" field.
+func (_u *ListingUpdate) AddSpecialAssessments(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.AddSpecialAssessments(v)
+	return _u
+}
+
+// ClearSpecialAssessments clears the value of the "special_assessments" field.
+func (_u *ListingUpdate) ClearSpecialAssessments() *ListingUpdate {
+	_u.mutation.ClearSpecialAssessments()
+	return _u
+}
+
+// SetMonthlyUtilities sets the "monthly_utilities" field.
+func (_u *ListingUpdate) SetMonthlyUtilities(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.ResetMonthlyUtilities()
+	_u.mutation.SetMonthlyUtilities(v)
+	return _u
+}
+
+// SetNillableMonthlyUtilities sets the "monthly_utilities" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableMonthlyUtilities(v *decimal.Decimal) *ListingUpdate {
+	if v != nil {
+		_u.SetMonthlyUtilities(*v)
 	}
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (lu *ListingUpdate) check() error {
-	if v, ok := lu.mutation.Title(); ok {
-		if err := listing.TitleValidator(v); err != nil {
-			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Listing.title": %w`, err)}
-		}
+// AddMonthlyUtilities adds value to the "monthly_utilities" field.
+func (_u *ListingUpdate) AddMonthlyUtilities(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.AddMonthlyUtilities(v)
+	return _u
+}
+
+// ClearMonthlyUtilities clears the value of the "monthly_utilities" field.
+func (_u *ListingUpdate) ClearMonthlyUtilities() *ListingUpdate {
+	_u.mutation.ClearMonthlyUtilities()
+	return _u
+}
+
+// SetMedia sets the "media" field.
+func (_u *ListingUpdate) SetMedia(v []schematype.Media) *ListingUpdate {
+	_u.mutation.SetMedia(v)
+	return _u
+}
+
+// AppendMedia appends value to the "media" field.
+func (_u *ListingUpdate) AppendMedia(v []schematype.Media) *ListingUpdate {
+	_u.mutation.AppendMedia(v)
+	return _u
+}
+
+// ClearMedia clears the value of the "media" field.
+func (_u *ListingUpdate) ClearMedia() *ListingUpdate {
+	_u.mutation.ClearMedia()
+	return _u
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (_u *ListingUpdate) SetRealtorID(v uuid.UUID) *ListingUpdate {
+	_u.mutation.SetRealtorID(v)
+	return _u
+}
+
+// SetNillableRealtorID sets the "realtor_id" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableRealtorID(v *uuid.UUID) *ListingUpdate {
+	if v != nil {
+		_u.SetRealtorID(*v)
 	}
-	if v, ok := lu.mutation.Address(); ok {
-		if err := listing.AddressValidator(v); err != nil {
-			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Listing.address": %w`, err)}
-		}
+	return _u
+}
+
+// SetPropertyID sets the "property_id" field.
+func (_u *ListingUpdate) SetPropertyID(v uuid.UUID) *ListingUpdate {
+	_u.mutation.SetPropertyID(v)
+	return _u
+}
+
+// SetNillablePropertyID sets the "property_id" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillablePropertyID(v *uuid.UUID) *ListingUpdate {
+	if v != nil {
+		_u.SetPropertyID(*v)
 	}
-	if v, ok := lu.mutation.City(); ok {
-		if err := listing.CityValidator(v); err != nil {
-			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Listing.city": %w`, err)}
-		}
+	return _u
+}
+
+// ClearPropertyID clears the value of the "property_id" field.
+func (_u *ListingUpdate) ClearPropertyID() *ListingUpdate {
+	_u.mutation.ClearPropertyID()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ListingUpdate) SetExpiresAt(v time.Time) *ListingUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableExpiresAt(v *time.Time) *ListingUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
 	}
-	if v, ok := lu.mutation.State(); ok {
-		if err := listing.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Listing.state": %w`, err)}
-		}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ListingUpdate) ClearExpiresAt() *ListingUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetExpiryReminderSentAt sets the "expiry_reminder_sent_at" field.
+func (_u *ListingUpdate) SetExpiryReminderSentAt(v time.Time) *ListingUpdate {
+	_u.mutation.SetExpiryReminderSentAt(v)
+	return _u
+}
+
+// SetNillableExpiryReminderSentAt sets the "expiry_reminder_sent_at" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableExpiryReminderSentAt(v *time.Time) *ListingUpdate {
+	if v != nil {
+		_u.SetExpiryReminderSentAt(*v)
 	}
-	if v, ok := lu.mutation.ZipCode(); ok {
-		if err := listing.ZipCodeValidator(v); err != nil {
-			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Listing.zip_code": %w`, err)}
-		}
+	return _u
+}
+
+// ClearExpiryReminderSentAt clears the value of the "expiry_reminder_sent_at" field.
+func (_u *ListingUpdate) ClearExpiryReminderSentAt() *ListingUpdate {
+	_u.mutation.ClearExpiryReminderSentAt()
+	return _u
+}
+
+// SetExpired sets the "expired" field.
+func (_u *ListingUpdate) SetExpired(v bool) *ListingUpdate {
+	_u.mutation.SetExpired(v)
+	return _u
+}
+
+// SetNillableExpired sets the "expired" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableExpired(v *bool) *ListingUpdate {
+	if v != nil {
+		_u.SetExpired(*v)
 	}
-	if v, ok := lu.mutation.Bedroom(); ok {
-		if err := listing.BedroomValidator(v); err != nil {
-			return &ValidationError{Name: "bedroom", err: fmt.Errorf(`ent: validator failed for field "Listing.bedroom": %w`, err)}
-		}
+	return _u
+}
+
+// SetListedAt sets the "listed_at" field.
+func (_u *ListingUpdate) SetListedAt(v time.Time) *ListingUpdate {
+	_u.mutation.SetListedAt(v)
+	return _u
+}
+
+// SetNillableListedAt sets the "listed_at" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableListedAt(v *time.Time) *ListingUpdate {
+	if v != nil {
+		_u.SetListedAt(*v)
 	}
-	if v, ok := lu.mutation.Bathroom(); ok {
-		if err := listing.BathroomValidator(v); err != nil {
-			return &ValidationError{Name: "bathroom", err: fmt.Errorf(`ent: validator failed for field "Listing.bathroom": %w`, err)}
-		}
+	return _u
+}
+
+// ClearListedAt clears the value of the "listed_at" field.
+func (_u *ListingUpdate) ClearListedAt() *ListingUpdate {
+	_u.mutation.ClearListedAt()
+	return _u
+}
+
+// SetOnMarketSince sets the "on_market_since" field.
+func (_u *ListingUpdate) SetOnMarketSince(v time.Time) *ListingUpdate {
+	_u.mutation.SetOnMarketSince(v)
+	return _u
+}
+
+// SetNillableOnMarketSince sets the "on_market_since" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableOnMarketSince(v *time.Time) *ListingUpdate {
+	if v != nil {
+		_u.SetOnMarketSince(*v)
 	}
-	if v, ok := lu.mutation.Garage(); ok {
-		if err := listing.GarageValidator(v); err != nil {
-			return &ValidationError{Name: "garage", err: fmt.Errorf(`ent: validator failed for field "Listing.garage": %w`, err)}
-		}
+	return _u
+}
+
+// ClearOnMarketSince clears the value of the "on_market_since" field.
+func (_u *ListingUpdate) ClearOnMarketSince() *ListingUpdate {
+	_u.mutation.ClearOnMarketSince()
+	return _u
+}
+
+// SetMarketSeconds sets the "market_seconds" field.
+func (_u *ListingUpdate) SetMarketSeconds(v int64) *ListingUpdate {
+	_u.mutation.ResetMarketSeconds()
+	_u.mutation.SetMarketSeconds(v)
+	return _u
+}
+
+// SetNillableMarketSeconds sets the "market_seconds" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableMarketSeconds(v *int64) *ListingUpdate {
+	if v != nil {
+		_u.SetMarketSeconds(*v)
 	}
-	if v, ok := lu.mutation.Sqft(); ok {
-		if err := listing.SqftValidator(v); err != nil {
-			return &ValidationError{Name: "sqft", err: fmt.Errorf(`ent: validator failed for field "Listing.sqft": %w`, err)}
-		}
+	return _u
+}
+
+// AddMarketSeconds adds value to the "market_seconds" field.
+func (_u *ListingUpdate) AddMarketSeconds(v int64) *ListingUpdate {
+	_u.mutation.AddMarketSeconds(v)
+	return _u
+}
+
+// SetSoldPrice sets the "sold_price" field.
+func (_u *ListingUpdate) SetSoldPrice(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.ResetSoldPrice()
+	_u.mutation.SetSoldPrice(v)
+	return _u
+}
+
+// SetNillableSoldPrice sets the "sold_price" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableSoldPrice(v *decimal.Decimal) *ListingUpdate {
+	if v != nil {
+		_u.SetSoldPrice(*v)
 	}
-	if v, ok := lu.mutation.TypeOfProperty(); ok {
-		if err := listing.TypeOfPropertyValidator(v); err != nil {
-			return &ValidationError{Name: "type_of_property", err: fmt.Errorf(`ent: validator failed for field "Listing.type_of_property": %w`, err)}
-		}
+	return _u
+}
+
+// AddSoldPrice adds value to the "sold_price" field.
+func (_u *ListingUpdate) AddSoldPrice(v decimal.Decimal) *ListingUpdate {
+	_u.mutation.AddSoldPrice(v)
+	return _u
+}
+
+// ClearSoldPrice clears the value of the "sold_price" field.
+func (_u *ListingUpdate) ClearSoldPrice() *ListingUpdate {
+	_u.mutation.ClearSoldPrice()
+	return _u
+}
+
+// SetCloseDate sets the "close_date" field.
+func (_u *ListingUpdate) SetCloseDate(v time.Time) *ListingUpdate {
+	_u.mutation.SetCloseDate(v)
+	return _u
+}
+
+// SetNillableCloseDate sets the "close_date" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableCloseDate(v *time.Time) *ListingUpdate {
+	if v != nil {
+		_u.SetCloseDate(*v)
 	}
-	if v, ok := lu.mutation.Status(); ok {
-		if err := listing.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Listing.status": %w`, err)}
-		}
+	return _u
+}
+
+// ClearCloseDate clears the value of the "close_date" field.
+func (_u *ListingUpdate) ClearCloseDate() *ListingUpdate {
+	_u.mutation.ClearCloseDate()
+	return _u
+}
+
+// SetBuyerRealtorID sets the "buyer_realtor_id" field.
+func (_u *ListingUpdate) SetBuyerRealtorID(v uuid.UUID) *ListingUpdate {
+	_u.mutation.SetBuyerRealtorID(v)
+	return _u
+}
+
+// SetNillableBuyerRealtorID sets the "buyer_realtor_id" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableBuyerRealtorID(v *uuid.UUID) *ListingUpdate {
+	if v != nil {
+		_u.SetBuyerRealtorID(*v)
 	}
-	if v, ok := lu.mutation.LotSize(); ok {
-		if err := listing.LotSizeValidator(v); err != nil {
-			return &ValidationError{Name: "lot_size", err: fmt.Errorf(`ent: validator failed for field "Listing.lot_size": %w`, err)}
-		}
+	return _u
+}
+
+// ClearBuyerRealtorID clears the value of the "buyer_realtor_id" field.
+func (_u *ListingUpdate) ClearBuyerRealtorID() *ListingUpdate {
+	_u.mutation.ClearBuyerRealtorID()
+	return _u
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (_u *ListingUpdate) SetPublishAt(v time.Time) *ListingUpdate {
+	_u.mutation.SetPublishAt(v)
+	return _u
+}
+
+// SetNillablePublishAt sets the "publish_at" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillablePublishAt(v *time.Time) *ListingUpdate {
+	if v != nil {
+		_u.SetPublishAt(*v)
 	}
-	if v, ok := lu.mutation.YearBuilt(); ok {
-		if err := listing.YearBuiltValidator(v); err != nil {
-			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Listing.year_built": %w`, err)}
-		}
+	return _u
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (_u *ListingUpdate) ClearPublishAt() *ListingUpdate {
+	_u.mutation.ClearPublishAt()
+	return _u
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (_u *ListingUpdate) SetUnpublishAt(v time.Time) *ListingUpdate {
+	_u.mutation.SetUnpublishAt(v)
+	return _u
+}
+
+// SetNillableUnpublishAt sets the "unpublish_at" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableUnpublishAt(v *time.Time) *ListingUpdate {
+	if v != nil {
+		_u.SetUnpublishAt(*v)
 	}
-	if lu.mutation.RealtorCleared() && len(lu.mutation.RealtorIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Listing.realtor"`)
+	return _u
+}
+
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (_u *ListingUpdate) ClearUnpublishAt() *ListingUpdate {
+	_u.mutation.ClearUnpublishAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *ListingUpdate) SetVersion(v int) *ListingUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ListingUpdate) SetNillableVersion(v *int) *ListingUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
 	}
-	return nil
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ListingUpdate) AddVersion(v int) *ListingUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetRealtor sets the "realtor" edge to the Realtor entity.
+func (_u *ListingUpdate) SetRealtor(v *Realtor) *ListingUpdate {
+	return _u.SetRealtorID(v.ID)
+}
+
+// SetProperty sets the "property" edge to the Property entity.
+func (_u *ListingUpdate) SetProperty(v *Property) *ListingUpdate {
+	return _u.SetPropertyID(v.ID)
 }
 
-func (lu *ListingUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := lu.check(); err != nil {
-		return n, err
+// SetNeighborhood sets the "neighborhood" edge to the Neighborhood entity.
+func (_u *ListingUpdate) SetNeighborhood(v *Neighborhood) *ListingUpdate {
+	return _u.SetNeighborhoodID(v.ID)
+}
+
+// SetBuyerRealtor sets the "buyer_realtor" edge to the Realtor entity.
+func (_u *ListingUpdate) SetBuyerRealtor(v *Realtor) *ListingUpdate {
+	return _u.SetBuyerRealtorID(v.ID)
+}
+
+// AddRenewalIDs adds the "renewals" edge to the ListingRenewal entity by IDs.
+func (_u *ListingUpdate) AddRenewalIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.AddRenewalIDs(ids...)
+	return _u
+}
+
+// AddRenewals adds the "renewals" edges to the ListingRenewal entity.
+func (_u *ListingUpdate) AddRenewals(v ...*ListingRenewal) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(listing.Table, listing.Columns, sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID))
-	if ps := lu.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return _u.AddRenewalIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the ListingDocument entity by IDs.
+func (_u *ListingUpdate) AddDocumentIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.AddDocumentIDs(ids...)
+	return _u
+}
+
+// AddDocuments adds the "documents" edges to the ListingDocument entity.
+func (_u *ListingUpdate) AddDocuments(v ...*ListingDocument) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := lu.mutation.UpdateTime(); ok {
-		_spec.SetField(listing.FieldUpdateTime, field.TypeTime, value)
-	}
-	if value, ok := lu.mutation.Title(); ok {
-		_spec.SetField(listing.FieldTitle, field.TypeString, value)
-	}
-	if value, ok := lu.mutation.Address(); ok {
-		_spec.SetField(listing.FieldAddress, field.TypeString, value)
-	}
-	if value, ok := lu.mutation.City(); ok {
-		_spec.SetField(listing.FieldCity, field.TypeString, value)
-	}
-	if value, ok := lu.mutation.State(); ok {
-		_spec.SetField(listing.FieldState, field.TypeString, value)
-	}
-	if value, ok := lu.mutation.ZipCode(); ok {
-		_spec.SetField(listing.FieldZipCode, field.TypeString, value)
-	}
-	if value, ok := lu.mutation.Description(); ok {
-		_spec.SetField(listing.FieldDescription, field.TypeString, value)
-	}
-	if lu.mutation.DescriptionCleared() {
-		_spec.ClearField(listing.FieldDescription, field.TypeString)
-	}
-	if value, ok := lu.mutation.Price(); ok {
-		_spec.SetField(listing.FieldPrice, field.TypeFloat64, value)
-	}
-	if value, ok := lu.mutation.AddedPrice(); ok {
-		_spec.AddField(listing.FieldPrice, field.TypeFloat64, value)
-	}
-	if value, ok := lu.mutation.Bedroom(); ok {
-		_spec.SetField(listing.FieldBedroom, field.TypeInt, value)
-	}
-	if value, ok := lu.mutation.AddedBedroom(); ok {
-		_spec.AddField(listing.FieldBedroom, field.TypeInt, value)
-	}
-	if value, ok := lu.mutation.Bathroom(); ok {
-		_spec.SetField(listing.FieldBathroom, field.TypeFloat64, value)
-	}
-	if value, ok := lu.mutation.AddedBathroom(); ok {
-		_spec.AddField(listing.FieldBathroom, field.TypeFloat64, value)
-	}
-	if value, ok := lu.mutation.Garage(); ok {
-		_spec.SetField(listing.FieldGarage, field.TypeInt, value)
-	}
-	if value, ok := lu.mutation.AddedGarage(); ok {
-		_spec.AddField(listing.FieldGarage, field.TypeInt, value)
-	}
-	if lu.mutation.GarageCleared() {
-		_spec.ClearField(listing.FieldGarage, field.TypeInt)
-	}
-	if value, ok := lu.mutation.Sqft(); ok {
-		_spec.SetField(listing.FieldSqft, field.TypeInt, value)
-	}
-	if value, ok := lu.mutation.AddedSqft(); ok {
-		_spec.AddField(listing.FieldSqft, field.TypeInt, value)
-	}
-	if value, ok := lu.mutation.TypeOfProperty(); ok {
-		_spec.SetField(listing.FieldTypeOfProperty, field.TypeEnum, value)
-	}
-	if value, ok := lu.mutation.Status(); ok {
-		_spec.SetField(listing.FieldStatus, field.TypeEnum, value)
-	}
-	if value, ok := lu.mutation.LotSize(); ok {
-		_spec.SetField(listing.FieldLotSize, field.TypeInt, value)
-	}
-	if value, ok := lu.mutation.AddedLotSize(); ok {
-		_spec.AddField(listing.FieldLotSize, field.TypeInt, value)
-	}
-	if lu.mutation.LotSizeCleared() {
-		_spec.ClearField(listing.FieldLotSize, field.TypeInt)
-	}
-	if value, ok := lu.mutation.Pool(); ok {
-		_spec.SetField(listing.FieldPool, field.TypeBool, value)
-	}
-	if lu.mutation.PoolCleared() {
-		_spec.ClearField(listing.FieldPool, field.TypeBool)
-	}
-	if value, ok := lu.mutation.YearBuilt(); ok {
-		_spec.SetField(listing.FieldYearBuilt, field.TypeInt, value)
-	}
-	if value, ok := lu.mutation.AddedYearBuilt(); ok {
-		_spec.AddField(listing.FieldYearBuilt, field.TypeInt, value)
-	}
-	if value, ok := lu.mutation.Media(); ok {
-		_spec.SetField(listing.FieldMedia, field.TypeJSON, value)
-	}
-	if value, ok := lu.mutation.AppendedMedia(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, listing.FieldMedia, value)
-		})
-	}
-	if lu.mutation.MediaCleared() {
-		_spec.ClearField(listing.FieldMedia, field.TypeJSON)
-	}
-	if lu.mutation.RealtorCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   listing.RealtorTable,
-			Columns: []string{listing.RealtorColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := lu.mutation.RealtorIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   listing.RealtorTable,
-			Columns: []string{listing.RealtorColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{listing.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
-	lu.mutation.done = true
-	return n, nil
-}
-
-// ListingUpdateOne is the builder for updating a single Listing entity.
-type ListingUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ListingMutation
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (luo *ListingUpdateOne) SetUpdateTime(t time.Time) *ListingUpdateOne {
-	luo.mutation.SetUpdateTime(t)
-	return luo
-}
-
-// SetTitle sets the "title" field.
-func (luo *ListingUpdateOne) SetTitle(s string) *ListingUpdateOne {
-	luo.mutation.SetTitle(s)
-	return luo
-}
-
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableTitle(s *string) *ListingUpdateOne {
-	if s != nil {
-		luo.SetTitle(*s)
-	}
-	return luo
-}
-
-// SetAddress sets the "address" field.
-func (luo *ListingUpdateOne) SetAddress(s string) *ListingUpdateOne {
-	luo.mutation.SetAddress(s)
-	return luo
-}
-
-// SetNillableAddress sets the "address" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableAddress(s *string) *ListingUpdateOne {
-	if s != nil {
-		luo.SetAddress(*s)
-	}
-	return luo
-}
-
-// SetCity sets the "city" field.
-func (luo *ListingUpdateOne) SetCity(s string) *ListingUpdateOne {
-	luo.mutation.SetCity(s)
-	return luo
-}
-
-// SetNillableCity sets the "city" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableCity(s *string) *ListingUpdateOne {
-	if s != nil {
-		luo.SetCity(*s)
-	}
-	return luo
+	return _u.AddDocumentIDs(ids...)
 }
 
-// SetState sets the "state" field.
-func (luo *ListingUpdateOne) SetState(s string) *ListingUpdateOne {
-	luo.mutation.SetState(s)
-	return luo
+// AddInquiryIDs adds the "inquiries" edge to the ListingInquiry entity by IDs.
+func (_u *ListingUpdate) AddInquiryIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.AddInquiryIDs(ids...)
+	return _u
 }
 
-// SetNillableState sets the "state" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableState(s *string) *ListingUpdateOne {
-	if s != nil {
-		luo.SetState(*s)
+// AddInquiries adds the "inquiries" edges to the ListingInquiry entity.
+func (_u *ListingUpdate) AddInquiries(v ...*ListingInquiry) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return luo
+	return _u.AddInquiryIDs(ids...)
 }
 
-// SetZipCode sets the "zip_code" field.
-func (luo *ListingUpdateOne) SetZipCode(s string) *ListingUpdateOne {
-	luo.mutation.SetZipCode(s)
-	return luo
+// AddReviewIDs adds the "reviews" edge to the ListingReview entity by IDs.
+func (_u *ListingUpdate) AddReviewIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.AddReviewIDs(ids...)
+	return _u
 }
 
-// SetNillableZipCode sets the "zip_code" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableZipCode(s *string) *ListingUpdateOne {
-	if s != nil {
-		luo.SetZipCode(*s)
+// AddReviews adds the "reviews" edges to the ListingReview entity.
+func (_u *ListingUpdate) AddReviews(v ...*ListingReview) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return luo
+	return _u.AddReviewIDs(ids...)
 }
 
-// SetDescription sets the "description" field.
-func (luo *ListingUpdateOne) SetDescription(s string) *ListingUpdateOne {
-	luo.mutation.SetDescription(s)
-	return luo
+// AddOfferIDs adds the "offers" edge to the Offer entity by IDs.
+func (_u *ListingUpdate) AddOfferIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.AddOfferIDs(ids...)
+	return _u
 }
 
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableDescription(s *string) *ListingUpdateOne {
-	if s != nil {
-		luo.SetDescription(*s)
+// AddOffers adds the "offers" edges to the Offer entity.
+func (_u *ListingUpdate) AddOffers(v ...*Offer) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return luo
+	return _u.AddOfferIDs(ids...)
 }
 
-// ClearDescription clears the value of the "description" field.
-func (luo *ListingUpdateOne) ClearDescription() *ListingUpdateOne {
-	luo.mutation.ClearDescription()
-	return luo
-}
-
-// SetPrice sets the "price" field.
-func (luo *ListingUpdateOne) SetPrice(d decimal.Decimal) *ListingUpdateOne {
-	luo.mutation.ResetPrice()
-	luo.mutation.SetPrice(d)
-	return luo
+// AddStatusEventIDs adds the "status_events" edge to the ListingStatusEvent entity by IDs.
+func (_u *ListingUpdate) AddStatusEventIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.AddStatusEventIDs(ids...)
+	return _u
 }
 
-// SetNillablePrice sets the "price" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillablePrice(d *decimal.Decimal) *ListingUpdateOne {
-	if d != nil {
-		luo.SetPrice(*d)
+// AddStatusEvents adds the "status_events" edges to the ListingStatusEvent entity.
+func (_u *ListingUpdate) AddStatusEvents(v ...*ListingStatusEvent) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return luo
-}
-
-// AddPrice adds d to the "price" field.
-func (luo *ListingUpdateOne) AddPrice(d decimal.Decimal) *ListingUpdateOne {
-	luo.mutation.AddPrice(d)
-	return luo
+	return _u.AddStatusEventIDs(ids...)
 }
 
-// SetBedroom sets the "bedroom" field.
-func (luo *ListingUpdateOne) SetBedroom(i int) *ListingUpdateOne {
-	luo.mutation.ResetBedroom()
-	luo.mutation.SetBedroom(i)
-	return luo
+// Mutation returns the ListingMutation object of the builder.
+func (_u *ListingUpdate) Mutation() *ListingMutation {
+	return _u.mutation
 }
 
-// SetNillableBedroom sets the "bedroom" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableBedroom(i *int) *ListingUpdateOne {
-	if i != nil {
-		luo.SetBedroom(*i)
-	}
-	return luo
+// ClearRealtor clears the "realtor" edge to the Realtor entity.
+func (_u *ListingUpdate) ClearRealtor() *ListingUpdate {
+	_u.mutation.ClearRealtor()
+	return _u
 }
 
-// AddBedroom adds i to the "bedroom" field.
-func (luo *ListingUpdateOne) AddBedroom(i int) *ListingUpdateOne {
-	luo.mutation.AddBedroom(i)
-	return luo
+// ClearProperty clears the "property" edge to the Property entity.
+func (_u *ListingUpdate) ClearProperty() *ListingUpdate {
+	_u.mutation.ClearProperty()
+	return _u
 }
 
-// SetBathroom sets the "bathroom" field.
-func (luo *ListingUpdateOne) SetBathroom(f float64) *ListingUpdateOne {
-	luo.mutation.ResetBathroom()
-	luo.mutation.SetBathroom(f)
-	return luo
+// ClearNeighborhood clears the "neighborhood" edge to the Neighborhood entity.
+func (_u *ListingUpdate) ClearNeighborhood() *ListingUpdate {
+	_u.mutation.ClearNeighborhood()
+	return _u
 }
 
-// SetNillableBathroom sets the "bathroom" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableBathroom(f *float64) *ListingUpdateOne {
-	if f != nil {
-		luo.SetBathroom(*f)
-	}
-	return luo
+// ClearBuyerRealtor clears the "buyer_realtor" edge to the Realtor entity.
+func (_u *ListingUpdate) ClearBuyerRealtor() *ListingUpdate {
+	_u.mutation.ClearBuyerRealtor()
+	return _u
 }
 
-// AddBathroom adds f to the "bathroom" field.
-func (luo *ListingUpdateOne) AddBathroom(f float64) *ListingUpdateOne {
-	luo.mutation.AddBathroom(f)
-	return luo
+// ClearRenewals clears all "renewals" edges to the ListingRenewal entity.
+func (_u *ListingUpdate) ClearRenewals() *ListingUpdate {
+	_u.mutation.ClearRenewals()
+	return _u
 }
 
-// SetGarage sets the "garage" field.
-func (luo *ListingUpdateOne) SetGarage(i int) *ListingUpdateOne {
-	luo.mutation.ResetGarage()
-	luo.mutation.SetGarage(i)
-	return luo
+// RemoveRenewalIDs removes the "renewals" edge to ListingRenewal entities by IDs.
+func (_u *ListingUpdate) RemoveRenewalIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.RemoveRenewalIDs(ids...)
+	return _u
 }
 
-// SetNillableGarage sets the "garage" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableGarage(i *int) *ListingUpdateOne {
-	if i != nil {
-		luo.SetGarage(*i)
+// RemoveRenewals removes "renewals" edges to ListingRenewal entities.
+func (_u *ListingUpdate) RemoveRenewals(v ...*ListingRenewal) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return luo
-}
-
-// AddGarage adds i to the "garage" field.
-func (luo *ListingUpdateOne) AddGarage(i int) *ListingUpdateOne {
-	luo.mutation.AddGarage(i)
-	return luo
+	return _u.RemoveRenewalIDs(ids...)
 }
 
-// ClearGarage clears the value of the "garage" field.
-func (luo *ListingUpdateOne) ClearGarage() *ListingUpdateOne {
-	luo.mutation.ClearGarage()
-	return luo
+// ClearDocuments clears all "documents" edges to the ListingDocument entity.
+func (_u *ListingUpdate) ClearDocuments() *ListingUpdate {
+	_u.mutation.ClearDocuments()
+	return _u
 }
 
-// SetSqft sets the "sqft" field.
-func (luo *ListingUpdateOne) SetSqft(i int) *ListingUpdateOne {
-	luo.mutation.ResetSqft()
-	luo.mutation.SetSqft(i)
-	return luo
+// RemoveDocumentIDs removes the "documents" edge to ListingDocument entities by IDs.
+func (_u *ListingUpdate) RemoveDocumentIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.RemoveDocumentIDs(ids...)
+	return _u
 }
 
-// SetNillableSqft sets the "sqft" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableSqft(i *int) *ListingUpdateOne {
-	if i != nil {
-		luo.SetSqft(*i)
+// RemoveDocuments removes "documents" edges to ListingDocument entities.
+func (_u *ListingUpdate) RemoveDocuments(v ...*ListingDocument) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return luo
+	return _u.RemoveDocumentIDs(ids...)
 }
 
-// AddSqft adds i to the "sqft" field.
-func (luo *ListingUpdateOne) AddSqft(i int) *ListingUpdateOne {
-	luo.mutation.AddSqft(i)
-	return luo
+// ClearInquiries clears all "inquiries" edges to the ListingInquiry entity.
+func (_u *ListingUpdate) ClearInquiries() *ListingUpdate {
+	_u.mutation.ClearInquiries()
+	return _u
 }
 
-// SetTypeOfProperty sets the "type_of_property" field.
-func (luo *ListingUpdateOne) SetTypeOfProperty(lop listing.TypeOfProperty) *ListingUpdateOne {
-	luo.mutation.SetTypeOfProperty(lop)
-	return luo
+// RemoveInquiryIDs removes the "inquiries" edge to ListingInquiry entities by IDs.
+func (_u *ListingUpdate) RemoveInquiryIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.RemoveInquiryIDs(ids...)
+	return _u
 }
 
-// SetNillableTypeOfProperty sets the "type_of_property" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableTypeOfProperty(lop *listing.TypeOfProperty) *ListingUpdateOne {
-	if lop != nil {
-		luo.SetTypeOfProperty(*lop)
+// RemoveInquiries removes "inquiries" edges to ListingInquiry entities.
+func (_u *ListingUpdate) RemoveInquiries(v ...*ListingInquiry) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return luo
+	return _u.RemoveInquiryIDs(ids...)
 }
 
-// SetStatus sets the "status" field.
-func (luo *ListingUpdateOne) SetStatus(l listing.Status) *ListingUpdateOne {
-	luo.mutation.SetStatus(l)
-	return luo
-}
-
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableStatus(l *listing.Status) *ListingUpdateOne {
-	if l != nil {
-		luo.SetStatus(*l)
-	}
-	return luo
+// ClearReviews clears all "reviews" edges to the ListingReview entity.
+func (_u *ListingUpdate) ClearReviews() *ListingUpdate {
+	_u.mutation.ClearReviews()
+	return _u
 }
 
-// SetLotSize sets the "lot_size" field.
-func (luo *ListingUpdateOne) SetLotSize(i int) *ListingUpdateOne {
-	luo.mutation.ResetLotSize()
-	luo.mutation.SetLotSize(i)
-	return luo
+// RemoveReviewIDs removes the "reviews" edge to ListingReview entities by IDs.
+func (_u *ListingUpdate) RemoveReviewIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.RemoveReviewIDs(ids...)
+	return _u
 }
 
-// SetNillableLotSize sets the "lot_size" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableLotSize(i *int) *ListingUpdateOne {
-	if i != nil {
-		luo.SetLotSize(*i)
+// RemoveReviews removes "reviews" edges to ListingReview entities.
+func (_u *ListingUpdate) RemoveReviews(v ...*ListingReview) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return luo
-}
-
-// AddLotSize adds i to the "lot_size" field.
-func (luo *ListingUpdateOne) AddLotSize(i int) *ListingUpdateOne {
-	luo.mutation.AddLotSize(i)
-	return luo
+	return _u.RemoveReviewIDs(ids...)
 }
 
-// ClearLotSize clears the value of the "lot_size" field.
-func (luo *ListingUpdateOne) ClearLotSize() *ListingUpdateOne {
-	luo.mutation.ClearLotSize()
-	return luo
+// ClearOffers clears all "offers" edges to the Offer entity.
+func (_u *ListingUpdate) ClearOffers() *ListingUpdate {
+	_u.mutation.ClearOffers()
+	return _u
 }
 
-// SetPool sets the "pool" field.
-func (luo *ListingUpdateOne) SetPool(b bool) *ListingUpdateOne {
-	luo.mutation.SetPool(b)
-	return luo
+// RemoveOfferIDs removes the "offers" edge to Offer entities by IDs.
+func (_u *ListingUpdate) RemoveOfferIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.RemoveOfferIDs(ids...)
+	return _u
 }
 
-// SetNillablePool sets the "pool" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillablePool(b *bool) *ListingUpdateOne {
-	if b != nil {
-		luo.SetPool(*b)
+// RemoveOffers removes "offers" edges to Offer entities.
+func (_u *ListingUpdate) RemoveOffers(v ...*Offer) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return luo
+	return _u.RemoveOfferIDs(ids...)
 }
 
-// ClearPool clears the value of the "pool" field.
-func (luo *ListingUpdateOne) ClearPool() *ListingUpdateOne {
-	luo.mutation.ClearPool()
-	return luo
+// ClearStatusEvents clears all "status_events" edges to the ListingStatusEvent entity.
+func (_u *ListingUpdate) ClearStatusEvents() *ListingUpdate {
+	_u.mutation.ClearStatusEvents()
+	return _u
 }
 
-// SetYearBuilt sets the "year_built" field.
-func (luo *ListingUpdateOne) SetYearBuilt(i int) *ListingUpdateOne {
-	luo.mutation.ResetYearBuilt()
-	luo.mutation.SetYearBuilt(i)
-	return luo
+// RemoveStatusEventIDs removes the "status_events" edge to ListingStatusEvent entities by IDs.
+func (_u *ListingUpdate) RemoveStatusEventIDs(ids ...uuid.UUID) *ListingUpdate {
+	_u.mutation.RemoveStatusEventIDs(ids...)
+	return _u
 }
 
-// SetNillableYearBuilt sets the "year_built" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableYearBuilt(i *int) *ListingUpdateOne {
-	if i != nil {
-		luo.SetYearBuilt(*i)
+// RemoveStatusEvents removes "status_events" edges to ListingStatusEvent entities.
+func (_u *ListingUpdate) RemoveStatusEvents(v ...*ListingStatusEvent) *ListingUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return luo
-}
-
-// AddYearBuilt adds i to the "year_built" field.
-func (luo *ListingUpdateOne) AddYearBuilt(i int) *ListingUpdateOne {
-	luo.mutation.AddYearBuilt(i)
-	return luo
-}
-
-// SetMedia sets the "media" field.
-func (luo *ListingUpdateOne) SetMedia(s []schema.Media) *ListingUpdateOne {
-	luo.mutation.SetMedia(s)
-	return luo
-}
-
-// AppendMedia appends s to the "media" field.
-func (luo *ListingUpdateOne) AppendMedia(s []schema.Media) *ListingUpdateOne {
-	luo.mutation.AppendMedia(s)
-	return luo
-}
-
-// ClearMedia clears the value of the "media" field.
-func (luo *ListingUpdateOne) ClearMedia() *ListingUpdateOne {
-	luo.mutation.ClearMedia()
-	return luo
-}
-
-// SetRealtorID sets the "realtor_id" field.
-func (luo *ListingUpdateOne) SetRealtorID(u uuid.UUID) *ListingUpdateOne {
-	luo.mutation.SetRealtorID(u)
-	return luo
+	return _u.RemoveStatusEventIDs(ids...)
 }
 
-// SetNillableRealtorID sets the "realtor_id" field if the given value is not nil.
-func (luo *ListingUpdateOne) SetNillableRealtorID(u *uuid.UUID) *ListingUpdateOne {
-	if u != nil {
-		luo.SetRealtorID(*u)
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ListingUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
 	}
-	return luo
-}
-
-// SetRealtor sets the "realtor" edge to the Realtor entity.
-func (luo *ListingUpdateOne) SetRealtor(r *Realtor) *ListingUpdateOne {
-	return luo.SetRealtorID(r.ID)
-}
-
-// Mutation returns the ListingMutation object of the builder.
-func (luo *ListingUpdateOne) Mutation() *ListingMutation {
-	return luo.mutation
-}
-
-// ClearRealtor clears the "realtor" edge to the Realtor entity.
-func (luo *ListingUpdateOne) ClearRealtor() *ListingUpdateOne {
-	luo.mutation.ClearRealtor()
-	return luo
-}
-
-// Where appends a list predicates to the ListingUpdate builder.
-func (luo *ListingUpdateOne) Where(ps ...predicate.Listing) *ListingUpdateOne {
-	luo.mutation.Where(ps...)
-	return luo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (luo *ListingUpdateOne) Select(field string, fields ...string) *ListingUpdateOne {
-	luo.fields = append([]string{field}, fields...)
-	return luo
-}
-
-// Save executes the query and returns the updated Listing entity.
-func (luo *ListingUpdateOne) Save(ctx context.Context) (*Listing, error) {
-	luo.defaults()
-	return withHooks(ctx, luo.sqlSave, luo.mutation, luo.hooks)
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (luo *ListingUpdateOne) SaveX(ctx context.Context) *Listing {
-	node, err := luo.Save(ctx)
+func (_u *ListingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
-	return node
+	return affected
 }
 
-// Exec executes the query on the entity.
-func (luo *ListingUpdateOne) Exec(ctx context.Context) error {
-	_, err := luo.Save(ctx)
+// Exec executes the query.
+func (_u *ListingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (luo *ListingUpdateOne) ExecX(ctx context.Context) {
-	if err := luo.Exec(ctx); err != nil {
+func (_u *ListingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (luo *ListingUpdateOne) defaults() {
-	if _, ok := luo.mutation.UpdateTime(); !ok {
+func (_u *ListingUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if listing.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listing.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := listing.UpdateDefaultUpdateTime()
-		luo.mutation.SetUpdateTime(v)
+		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (luo *ListingUpdateOne) check() error {
-	if v, ok := luo.mutation.Title(); ok {
+func (_u *ListingUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
 		if err := listing.TitleValidator(v); err != nil {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Listing.title": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.Address(); ok {
+	if v, ok := _u.mutation.Address(); ok {
 		if err := listing.AddressValidator(v); err != nil {
 			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Listing.address": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.City(); ok {
+	if v, ok := _u.mutation.AddressKey(); ok {
+		if err := listing.AddressKeyValidator(v); err != nil {
+			return &ValidationError{Name: "address_key", err: fmt.Errorf(`ent: validator failed for field "Listing.address_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.City(); ok {
 		if err := listing.CityValidator(v); err != nil {
 			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Listing.city": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.State(); ok {
+	if v, ok := _u.mutation.State(); ok {
 		if err := listing.StateValidator(v); err != nil {
 			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Listing.state": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.ZipCode(); ok {
+	if v, ok := _u.mutation.ZipCode(); ok {
 		if err := listing.ZipCodeValidator(v); err != nil {
 			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Listing.zip_code": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.Bedroom(); ok {
+	if v, ok := _u.mutation.Country(); ok {
+		if err := listing.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "Listing.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Latitude(); ok {
+		if err := listing.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "Listing.latitude": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Longitude(); ok {
+		if err := listing.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "Listing.longitude": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceLocale(); ok {
+		if err := listing.SourceLocaleValidator(v); err != nil {
+			return &ValidationError{Name: "source_locale", err: fmt.Errorf(`ent: validator failed for field "Listing.source_locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := listing.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Listing.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bedroom(); ok {
 		if err := listing.BedroomValidator(v); err != nil {
 			return &ValidationError{Name: "bedroom", err: fmt.Errorf(`ent: validator failed for field "Listing.bedroom": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.Bathroom(); ok {
+	if v, ok := _u.mutation.Bathroom(); ok {
 		if err := listing.BathroomValidator(v); err != nil {
 			return &ValidationError{Name: "bathroom", err: fmt.Errorf(`ent: validator failed for field "Listing.bathroom": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.Garage(); ok {
+	if v, ok := _u.mutation.Garage(); ok {
 		if err := listing.GarageValidator(v); err != nil {
 			return &ValidationError{Name: "garage", err: fmt.Errorf(`ent: validator failed for field "Listing.garage": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.Sqft(); ok {
+	if v, ok := _u.mutation.Sqft(); ok {
 		if err := listing.SqftValidator(v); err != nil {
 			return &ValidationError{Name: "sqft", err: fmt.Errorf(`ent: validator failed for field "Listing.sqft": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.TypeOfProperty(); ok {
+	if v, ok := _u.mutation.Sqm(); ok {
+		if err := listing.SqmValidator(v); err != nil {
+			return &ValidationError{Name: "sqm", err: fmt.Errorf(`ent: validator failed for field "Listing.sqm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AreaUnit(); ok {
+		if err := listing.AreaUnitValidator(v); err != nil {
+			return &ValidationError{Name: "area_unit", err: fmt.Errorf(`ent: validator failed for field "Listing.area_unit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TypeOfProperty(); ok {
 		if err := listing.TypeOfPropertyValidator(v); err != nil {
 			return &ValidationError{Name: "type_of_property", err: fmt.Errorf(`ent: validator failed for field "Listing.type_of_property": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.Status(); ok {
+	if v, ok := _u.mutation.Status(); ok {
 		if err := listing.StatusValidator(v); err != nil {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Listing.status": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.LotSize(); ok {
+	if v, ok := _u.mutation.LotSize(); ok {
 		if err := listing.LotSizeValidator(v); err != nil {
 			return &ValidationError{Name: "lot_size", err: fmt.Errorf(`ent: validator failed for field "Listing.lot_size": %w`, err)}
 		}
 	}
-	if v, ok := luo.mutation.YearBuilt(); ok {
+	if v, ok := _u.mutation.YearBuilt(); ok {
 		if err := listing.YearBuiltValidator(v); err != nil {
 			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Listing.year_built": %w`, err)}
 		}
 	}
-	if luo.mutation.RealtorCleared() && len(luo.mutation.RealtorIDs()) > 0 {
+	if v, ok := _u.mutation.HoaFrequency(); ok {
+		if err := listing.HoaFrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "hoa_frequency", err: fmt.Errorf(`ent: validator failed for field "Listing.hoa_frequency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HoaName(); ok {
+		if err := listing.HoaNameValidator(v); err != nil {
+			return &ValidationError{Name: "hoa_name", err: fmt.Errorf(`ent: validator failed for field "Listing.hoa_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MarketSeconds(); ok {
+		if err := listing.MarketSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "market_seconds", err: fmt.Errorf(`ent: validator failed for field "Listing.market_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := listing.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Listing.version": %w`, err)}
+		}
+	}
+	if _u.mutation.RealtorCleared() && len(_u.mutation.RealtorIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Listing.realtor"`)
 	}
 	return nil
 }
 
-func (luo *ListingUpdateOne) sqlSave(ctx context.Context) (_node *Listing, err error) {
-	if err := luo.check(); err != nil {
+func (_u *ListingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
 		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(listing.Table, listing.Columns, sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID))
-	id, ok := luo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Listing.id" for update`)}
-	}
-	_spec.Node.ID.Value = id
-	if fields := luo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, listing.FieldID)
-		for _, f := range fields {
-			if !listing.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != listing.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
-		}
-	}
-	if ps := luo.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := luo.mutation.UpdateTime(); ok {
+	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(listing.FieldUpdateTime, field.TypeTime, value)
 	}
-	if value, ok := luo.mutation.Title(); ok {
+	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(listing.FieldTitle, field.TypeString, value)
 	}
-	if value, ok := luo.mutation.Address(); ok {
+	if value, ok := _u.mutation.Address(); ok {
 		_spec.SetField(listing.FieldAddress, field.TypeString, value)
 	}
-	if value, ok := luo.mutation.City(); ok {
-		_spec.SetField(listing.FieldCity, field.TypeString, value)
+	if value, ok := _u.mutation.AddressKey(); ok {
+		_spec.SetField(listing.FieldAddressKey, field.TypeString, value)
 	}
-	if value, ok := luo.mutation.State(); ok {
+	if _u.mutation.AddressKeyCleared() {
+		_spec.ClearField(listing.FieldAddressKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(listing.FieldCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
 		_spec.SetField(listing.FieldState, field.TypeString, value)
 	}
-	if value, ok := luo.mutation.ZipCode(); ok {
+	if value, ok := _u.mutation.ZipCode(); ok {
 		_spec.SetField(listing.FieldZipCode, field.TypeString, value)
 	}
-	if value, ok := luo.mutation.Description(); ok {
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(listing.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(listing.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(listing.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(listing.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(listing.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(listing.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(listing.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(listing.FieldDescription, field.TypeString, value)
 	}
-	if luo.mutation.DescriptionCleared() {
+	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(listing.FieldDescription, field.TypeString)
 	}
-	if value, ok := luo.mutation.Price(); ok {
+	if value, ok := _u.mutation.SourceLocale(); ok {
+		_spec.SetField(listing.FieldSourceLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Translations(); ok {
+		_spec.SetField(listing.FieldTranslations, field.TypeJSON, value)
+	}
+	if _u.mutation.TranslationsCleared() {
+		_spec.ClearField(listing.FieldTranslations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Price(); ok {
 		_spec.SetField(listing.FieldPrice, field.TypeFloat64, value)
 	}
-	if value, ok := luo.mutation.AddedPrice(); ok {
+	if value, ok := _u.mutation.AddedPrice(); ok {
 		_spec.AddField(listing.FieldPrice, field.TypeFloat64, value)
 	}
-	if value, ok := luo.mutation.Bedroom(); ok {
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(listing.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bedroom(); ok {
 		_spec.SetField(listing.FieldBedroom, field.TypeInt, value)
 	}
-	if value, ok := luo.mutation.AddedBedroom(); ok {
+	if value, ok := _u.mutation.AddedBedroom(); ok {
 		_spec.AddField(listing.FieldBedroom, field.TypeInt, value)
 	}
-	if value, ok := luo.mutation.Bathroom(); ok {
+	if value, ok := _u.mutation.Bathroom(); ok {
 		_spec.SetField(listing.FieldBathroom, field.TypeFloat64, value)
 	}
-	if value, ok := luo.mutation.AddedBathroom(); ok {
+	if value, ok := _u.mutation.AddedBathroom(); ok {
 		_spec.AddField(listing.FieldBathroom, field.TypeFloat64, value)
 	}
-	if value, ok := luo.mutation.Garage(); ok {
+	if value, ok := _u.mutation.Garage(); ok {
 		_spec.SetField(listing.FieldGarage, field.TypeInt, value)
 	}
-	if value, ok := luo.mutation.AddedGarage(); ok {
+	if value, ok := _u.mutation.AddedGarage(); ok {
 		_spec.AddField(listing.FieldGarage, field.TypeInt, value)
 	}
-	if luo.mutation.GarageCleared() {
+	if _u.mutation.GarageCleared() {
 		_spec.ClearField(listing.FieldGarage, field.TypeInt)
 	}
-	if value, ok := luo.mutation.Sqft(); ok {
+	if value, ok := _u.mutation.Sqft(); ok {
 		_spec.SetField(listing.FieldSqft, field.TypeInt, value)
 	}
-	if value, ok := luo.mutation.AddedSqft(); ok {
+	if value, ok := _u.mutation.AddedSqft(); ok {
 		_spec.AddField(listing.FieldSqft, field.TypeInt, value)
 	}
-	if value, ok := luo.mutation.TypeOfProperty(); ok {
+	if value, ok := _u.mutation.Sqm(); ok {
+		_spec.SetField(listing.FieldSqm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSqm(); ok {
+		_spec.AddField(listing.FieldSqm, field.TypeInt, value)
+	}
+	if _u.mutation.SqmCleared() {
+		_spec.ClearField(listing.FieldSqm, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AreaUnit(); ok {
+		_spec.SetField(listing.FieldAreaUnit, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TypeOfProperty(); ok {
 		_spec.SetField(listing.FieldTypeOfProperty, field.TypeEnum, value)
 	}
-	if value, ok := luo.mutation.Status(); ok {
+	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(listing.FieldStatus, field.TypeEnum, value)
 	}
-	if value, ok := luo.mutation.LotSize(); ok {
+	if value, ok := _u.mutation.LotSize(); ok {
 		_spec.SetField(listing.FieldLotSize, field.TypeInt, value)
 	}
-	if value, ok := luo.mutation.AddedLotSize(); ok {
+	if value, ok := _u.mutation.AddedLotSize(); ok {
 		_spec.AddField(listing.FieldLotSize, field.TypeInt, value)
 	}
-	if luo.mutation.LotSizeCleared() {
+	if _u.mutation.LotSizeCleared() {
 		_spec.ClearField(listing.FieldLotSize, field.TypeInt)
 	}
-	if value, ok := luo.mutation.Pool(); ok {
+	if value, ok := _u.mutation.Pool(); ok {
 		_spec.SetField(listing.FieldPool, field.TypeBool, value)
 	}
-	if luo.mutation.PoolCleared() {
+	if _u.mutation.PoolCleared() {
 		_spec.ClearField(listing.FieldPool, field.TypeBool)
 	}
-	if value, ok := luo.mutation.YearBuilt(); ok {
+	if value, ok := _u.mutation.YearBuilt(); ok {
 		_spec.SetField(listing.FieldYearBuilt, field.TypeInt, value)
 	}
-	if value, ok := luo.mutation.AddedYearBuilt(); ok {
+	if value, ok := _u.mutation.AddedYearBuilt(); ok {
 		_spec.AddField(listing.FieldYearBuilt, field.TypeInt, value)
 	}
-	if value, ok := luo.mutation.Media(); ok {
+	if value, ok := _u.mutation.AnnualPropertyTax(); ok {
+		_spec.SetField(listing.FieldAnnualPropertyTax, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAnnualPropertyTax(); ok {
+		_spec.AddField(listing.FieldAnnualPropertyTax, field.TypeFloat64, value)
+	}
+	if _u.mutation.AnnualPropertyTaxCleared() {
+		_spec.ClearField(listing.FieldAnnualPropertyTax, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HoaDues(); ok {
+		_spec.SetField(listing.FieldHoaDues, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHoaDues(); ok {
+		_spec.AddField(listing.FieldHoaDues, field.TypeFloat64, value)
+	}
+	if _u.mutation.HoaDuesCleared() {
+		_spec.ClearField(listing.FieldHoaDues, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HoaFrequency(); ok {
+		_spec.SetField(listing.FieldHoaFrequency, field.TypeEnum, value)
+	}
+	if _u.mutation.HoaFrequencyCleared() {
+		_spec.ClearField(listing.FieldHoaFrequency, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.HoaName(); ok {
+		_spec.SetField(listing.FieldHoaName, field.TypeString, value)
+	}
+	if _u.mutation.HoaNameCleared() {
+		_spec.ClearField(listing.FieldHoaName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SpecialAssessments(); ok {
+		_spec.SetField(listing.FieldSpecialAssessments, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSpecialAssessments(); ok {
+		_spec.AddField(listing.FieldSpecialAssessments, field.TypeFloat64, value)
+	}
+	if _u.mutation.SpecialAssessmentsCleared() {
+		_spec.ClearField(listing.FieldSpecialAssessments, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MonthlyUtilities(); ok {
+		_spec.SetField(listing.FieldMonthlyUtilities, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyUtilities(); ok {
+		_spec.AddField(listing.FieldMonthlyUtilities, field.TypeFloat64, value)
+	}
+	if _u.mutation.MonthlyUtilitiesCleared() {
+		_spec.ClearField(listing.FieldMonthlyUtilities, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Media(); ok {
 		_spec.SetField(listing.FieldMedia, field.TypeJSON, value)
 	}
-	if value, ok := luo.mutation.AppendedMedia(); ok {
+	if value, ok := _u.mutation.AppendedMedia(); ok {
 		_spec.AddModifier(func(u *sql.UpdateBuilder) {
 			sqljson.Append(u, listing.FieldMedia, value)
 		})
 	}
-	if luo.mutation.MediaCleared() {
+	if _u.mutation.MediaCleared() {
 		_spec.ClearField(listing.FieldMedia, field.TypeJSON)
 	}
-	if luo.mutation.RealtorCleared() {
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(listing.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(listing.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiryReminderSentAt(); ok {
+		_spec.SetField(listing.FieldExpiryReminderSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiryReminderSentAtCleared() {
+		_spec.ClearField(listing.FieldExpiryReminderSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Expired(); ok {
+		_spec.SetField(listing.FieldExpired, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ListedAt(); ok {
+		_spec.SetField(listing.FieldListedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ListedAtCleared() {
+		_spec.ClearField(listing.FieldListedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OnMarketSince(); ok {
+		_spec.SetField(listing.FieldOnMarketSince, field.TypeTime, value)
+	}
+	if _u.mutation.OnMarketSinceCleared() {
+		_spec.ClearField(listing.FieldOnMarketSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MarketSeconds(); ok {
+		_spec.SetField(listing.FieldMarketSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMarketSeconds(); ok {
+		_spec.AddField(listing.FieldMarketSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SoldPrice(); ok {
+		_spec.SetField(listing.FieldSoldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSoldPrice(); ok {
+		_spec.AddField(listing.FieldSoldPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.SoldPriceCleared() {
+		_spec.ClearField(listing.FieldSoldPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CloseDate(); ok {
+		_spec.SetField(listing.FieldCloseDate, field.TypeTime, value)
+	}
+	if _u.mutation.CloseDateCleared() {
+		_spec.ClearField(listing.FieldCloseDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PublishAt(); ok {
+		_spec.SetField(listing.FieldPublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishAtCleared() {
+		_spec.ClearField(listing.FieldPublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnpublishAt(); ok {
+		_spec.SetField(listing.FieldUnpublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.UnpublishAtCleared() {
+		_spec.ClearField(listing.FieldUnpublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(listing.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(listing.FieldVersion, field.TypeInt, value)
+	}
+	if _u.mutation.RealtorCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -1259,7 +1701,7 @@ func (luo *ListingUpdateOne) sqlSave(ctx context.Context) (_node *Listing, err e
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := luo.mutation.RealtorIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.RealtorIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
@@ -1275,10 +1717,2450 @@ func (luo *ListingUpdateOne) sqlSave(ctx context.Context) (_node *Listing, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_node = &Listing{config: luo.config}
+	if _u.mutation.PropertyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.PropertyTable,
+			Columns: []string{listing.PropertyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(property.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PropertyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.PropertyTable,
+			Columns: []string{listing.PropertyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(property.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NeighborhoodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.NeighborhoodTable,
+			Columns: []string{listing.NeighborhoodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(neighborhood.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NeighborhoodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.NeighborhoodTable,
+			Columns: []string{listing.NeighborhoodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(neighborhood.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BuyerRealtorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.BuyerRealtorTable,
+			Columns: []string{listing.BuyerRealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BuyerRealtorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.BuyerRealtorTable,
+			Columns: []string{listing.BuyerRealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RenewalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.RenewalsTable,
+			Columns: []string{listing.RenewalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingrenewal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRenewalsIDs(); len(nodes) > 0 && !_u.mutation.RenewalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.RenewalsTable,
+			Columns: []string{listing.RenewalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingrenewal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RenewalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.RenewalsTable,
+			Columns: []string{listing.RenewalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingrenewal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.DocumentsTable,
+			Columns: []string{listing.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !_u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.DocumentsTable,
+			Columns: []string{listing.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.DocumentsTable,
+			Columns: []string{listing.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InquiriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.InquiriesTable,
+			Columns: []string{listing.InquiriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listinginquiry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInquiriesIDs(); len(nodes) > 0 && !_u.mutation.InquiriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.InquiriesTable,
+			Columns: []string{listing.InquiriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listinginquiry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InquiriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.InquiriesTable,
+			Columns: []string{listing.InquiriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listinginquiry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.ReviewsTable,
+			Columns: []string{listing.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingreview.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !_u.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.ReviewsTable,
+			Columns: []string{listing.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingreview.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.ReviewsTable,
+			Columns: []string{listing.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingreview.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OffersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.OffersTable,
+			Columns: []string{listing.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOffersIDs(); len(nodes) > 0 && !_u.mutation.OffersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.OffersTable,
+			Columns: []string{listing.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OffersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.OffersTable,
+			Columns: []string{listing.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.StatusEventsTable,
+			Columns: []string{listing.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatusEventsIDs(); len(nodes) > 0 && !_u.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.StatusEventsTable,
+			Columns: []string{listing.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.StatusEventsTable,
+			Columns: []string{listing.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listing.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ListingUpdateOne is the builder for updating a single Listing entity.
+type ListingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ListingMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ListingUpdateOne) SetUpdateTime(v time.Time) *ListingUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ListingUpdateOne) SetTitle(v string) *ListingUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableTitle(v *string) *ListingUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *ListingUpdateOne) SetAddress(v string) *ListingUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableAddress(v *string) *ListingUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetAddressKey sets the "address_key" field.
+func (_u *ListingUpdateOne) SetAddressKey(v string) *ListingUpdateOne {
+	_u.mutation.SetAddressKey(v)
+	return _u
+}
+
+// SetNillableAddressKey sets the "address_key" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableAddressKey(v *string) *ListingUpdateOne {
+	if v != nil {
+		_u.SetAddressKey(*v)
+	}
+	return _u
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (_u *ListingUpdateOne) ClearAddressKey() *ListingUpdateOne {
+	_u.mutation.ClearAddressKey()
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *ListingUpdateOne) SetCity(v string) *ListingUpdateOne {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableCity(v *string) *ListingUpdateOne {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *ListingUpdateOne) SetState(v string) *ListingUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableState(v *string) *ListingUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetZipCode sets the "zip_code" field.
+func (_u *ListingUpdateOne) SetZipCode(v string) *ListingUpdateOne {
+	_u.mutation.SetZipCode(v)
+	return _u
+}
+
+// SetNillableZipCode sets the "zip_code" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableZipCode(v *string) *ListingUpdateOne {
+	if v != nil {
+		_u.SetZipCode(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *ListingUpdateOne) SetCountry(v string) *ListingUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableCountry(v *string) *ListingUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *ListingUpdateOne) SetLatitude(v float64) *ListingUpdateOne {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableLatitude(v *float64) *ListingUpdateOne {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *ListingUpdateOne) AddLatitude(v float64) *ListingUpdateOne {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *ListingUpdateOne) ClearLatitude() *ListingUpdateOne {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *ListingUpdateOne) SetLongitude(v float64) *ListingUpdateOne {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableLongitude(v *float64) *ListingUpdateOne {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *ListingUpdateOne) AddLongitude(v float64) *ListingUpdateOne {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *ListingUpdateOne) ClearLongitude() *ListingUpdateOne {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetNeighborhoodID sets the "neighborhood_id" field.
+func (_u *ListingUpdateOne) SetNeighborhoodID(v uuid.UUID) *ListingUpdateOne {
+	_u.mutation.SetNeighborhoodID(v)
+	return _u
+}
+
+// SetNillableNeighborhoodID sets the "neighborhood_id" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableNeighborhoodID(v *uuid.UUID) *ListingUpdateOne {
+	if v != nil {
+		_u.SetNeighborhoodID(*v)
+	}
+	return _u
+}
+
+// ClearNeighborhoodID clears the value of the "neighborhood_id" field.
+func (_u *ListingUpdateOne) ClearNeighborhoodID() *ListingUpdateOne {
+	_u.mutation.ClearNeighborhoodID()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ListingUpdateOne) SetDescription(v string) *ListingUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableDescription(v *string) *ListingUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ListingUpdateOne) ClearDescription() *ListingUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetSourceLocale sets the "source_locale" field.
+func (_u *ListingUpdateOne) SetSourceLocale(v string) *ListingUpdateOne {
+	_u.mutation.SetSourceLocale(v)
+	return _u
+}
+
+// SetNillableSourceLocale sets the "source_locale" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableSourceLocale(v *string) *ListingUpdateOne {
+	if v != nil {
+		_u.SetSourceLocale(*v)
+	}
+	return _u
+}
+
+// SetTranslations sets the "translations" field.
+func (_u *ListingUpdateOne) SetTranslations(v map[string]schematype.Translation) *ListingUpdateOne {
+	_u.mutation.SetTranslations(v)
+	return _u
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (_u *ListingUpdateOne) ClearTranslations() *ListingUpdateOne {
+	_u.mutation.ClearTranslations()
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *ListingUpdateOne) SetPrice(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillablePrice(v *decimal.Decimal) *ListingUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *ListingUpdateOne) AddPrice(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *ListingUpdateOne) SetCurrency(v string) *ListingUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableCurrency(v *string) *ListingUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetBedroom sets the "bedroom" field.
+func (_u *ListingUpdateOne) SetBedroom(v int) *ListingUpdateOne {
+	_u.mutation.ResetBedroom()
+	_u.mutation.SetBedroom(v)
+	return _u
+}
+
+// SetNillableBedroom sets the "bedroom" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableBedroom(v *int) *ListingUpdateOne {
+	if v != nil {
+		_u.SetBedroom(*v)
+	}
+	return _u
+}
+
+// AddBedroom adds value to the "bedroom" field.
+func (_u *ListingUpdateOne) AddBedroom(v int) *ListingUpdateOne {
+	_u.mutation.AddBedroom(v)
+	return _u
+}
+
+// SetBathroom sets the "bathroom" field.
+func (_u *ListingUpdateOne) SetBathroom(v float64) *ListingUpdateOne {
+	_u.mutation.ResetBathroom()
+	_u.mutation.SetBathroom(v)
+	return _u
+}
+
+// SetNillableBathroom sets the "bathroom" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableBathroom(v *float64) *ListingUpdateOne {
+	if v != nil {
+		_u.SetBathroom(*v)
+	}
+	return _u
+}
+
+// AddBathroom adds value to the "bathroom" field.
+func (_u *ListingUpdateOne) AddBathroom(v float64) *ListingUpdateOne {
+	_u.mutation.AddBathroom(v)
+	return _u
+}
+
+// SetGarage sets the "garage" field.
+func (_u *ListingUpdateOne) SetGarage(v int) *ListingUpdateOne {
+	_u.mutation.ResetGarage()
+	_u.mutation.SetGarage(v)
+	return _u
+}
+
+// SetNillableGarage sets the "garage" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableGarage(v *int) *ListingUpdateOne {
+	if v != nil {
+		_u.SetGarage(*v)
+	}
+	return _u
+}
+
+// AddGarage adds value to the "garage" field.
+func (_u *ListingUpdateOne) AddGarage(v int) *ListingUpdateOne {
+	_u.mutation.AddGarage(v)
+	return _u
+}
+
+// ClearGarage clears the value of the "garage" field.
+func (_u *ListingUpdateOne) ClearGarage() *ListingUpdateOne {
+	_u.mutation.ClearGarage()
+	return _u
+}
+
+// SetSqft sets the "sqft" field.
+func (_u *ListingUpdateOne) SetSqft(v int) *ListingUpdateOne {
+	_u.mutation.ResetSqft()
+	_u.mutation.SetSqft(v)
+	return _u
+}
+
+// SetNillableSqft sets the "sqft" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableSqft(v *int) *ListingUpdateOne {
+	if v != nil {
+		_u.SetSqft(*v)
+	}
+	return _u
+}
+
+// AddSqft adds value to the "sqft" field.
+func (_u *ListingUpdateOne) AddSqft(v int) *ListingUpdateOne {
+	_u.mutation.AddSqft(v)
+	return _u
+}
+
+// SetSqm sets the "sqm" field.
+func (_u *ListingUpdateOne) SetSqm(v int) *ListingUpdateOne {
+	_u.mutation.ResetSqm()
+	_u.mutation.SetSqm(v)
+	return _u
+}
+
+// SetNillableSqm sets the "sqm" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableSqm(v *int) *ListingUpdateOne {
+	if v != nil {
+		_u.SetSqm(*v)
+	}
+	return _u
+}
+
+// AddSqm adds value to the "sqm" field.
+func (_u *ListingUpdateOne) AddSqm(v int) *ListingUpdateOne {
+	_u.mutation.AddSqm(v)
+	return _u
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (_u *ListingUpdateOne) ClearSqm() *ListingUpdateOne {
+	_u.mutation.ClearSqm()
+	return _u
+}
+
+// SetAreaUnit sets the "area_unit" field.
+func (_u *ListingUpdateOne) SetAreaUnit(v listing.AreaUnit) *ListingUpdateOne {
+	_u.mutation.SetAreaUnit(v)
+	return _u
+}
+
+// SetNillableAreaUnit sets the "area_unit" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableAreaUnit(v *listing.AreaUnit) *ListingUpdateOne {
+	if v != nil {
+		_u.SetAreaUnit(*v)
+	}
+	return _u
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (_u *ListingUpdateOne) SetTypeOfProperty(v listing.TypeOfProperty) *ListingUpdateOne {
+	_u.mutation.SetTypeOfProperty(v)
+	return _u
+}
+
+// SetNillableTypeOfProperty sets the "type_of_property" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableTypeOfProperty(v *listing.TypeOfProperty) *ListingUpdateOne {
+	if v != nil {
+		_u.SetTypeOfProperty(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ListingUpdateOne) SetStatus(v listing.Status) *ListingUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableStatus(v *listing.Status) *ListingUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLotSize sets the "lot_size" field.
+func (_u *ListingUpdateOne) SetLotSize(v int) *ListingUpdateOne {
+	_u.mutation.ResetLotSize()
+	_u.mutation.SetLotSize(v)
+	return _u
+}
+
+// SetNillableLotSize sets the "lot_size" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableLotSize(v *int) *ListingUpdateOne {
+	if v != nil {
+		_u.SetLotSize(*v)
+	}
+	return _u
+}
+
+// AddLotSize adds value to the "lot_size" field.
+func (_u *ListingUpdateOne) AddLotSize(v int) *ListingUpdateOne {
+	_u.mutation.AddLotSize(v)
+	return _u
+}
+
+// ClearLotSize clears the value of the "lot_size" field.
+func (_u *ListingUpdateOne) ClearLotSize() *ListingUpdateOne {
+	_u.mutation.ClearLotSize()
+	return _u
+}
+
+// SetPool sets the "pool" field.
+func (_u *ListingUpdateOne) SetPool(v bool) *ListingUpdateOne {
+	_u.mutation.SetPool(v)
+	return _u
+}
+
+// SetNillablePool sets the "pool" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillablePool(v *bool) *ListingUpdateOne {
+	if v != nil {
+		_u.SetPool(*v)
+	}
+	return _u
+}
+
+// ClearPool clears the value of the "pool" field.
+func (_u *ListingUpdateOne) ClearPool() *ListingUpdateOne {
+	_u.mutation.ClearPool()
+	return _u
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (_u *ListingUpdateOne) SetYearBuilt(v int) *ListingUpdateOne {
+	_u.mutation.ResetYearBuilt()
+	_u.mutation.SetYearBuilt(v)
+	return _u
+}
+
+// SetNillableYearBuilt sets the "year_built" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableYearBuilt(v *int) *ListingUpdateOne {
+	if v != nil {
+		_u.SetYearBuilt(*v)
+	}
+	return _u
+}
+
+// AddYearBuilt adds value to the "year_built" field.
+func (_u *ListingUpdateOne) AddYearBuilt(v int) *ListingUpdateOne {
+	_u.mutation.AddYearBuilt(v)
+	return _u
+}
+
+// SetAnnualPropertyTax sets the "annual_property_tax" field.
+func (_u *ListingUpdateOne) SetAnnualPropertyTax(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.ResetAnnualPropertyTax()
+	_u.mutation.SetAnnualPropertyTax(v)
+	return _u
+}
+
+// SetNillableAnnualPropertyTax sets the "annual_property_tax" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableAnnualPropertyTax(v *decimal.Decimal) *ListingUpdateOne {
+	if v != nil {
+		_u.SetAnnualPropertyTax(*v)
+	}
+	return _u
+}
+
+// AddAnnualPropertyTax adds value to the "annual_property_tax" field.
+func (_u *ListingUpdateOne) AddAnnualPropertyTax(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.AddAnnualPropertyTax(v)
+	return _u
+}
+
+// ClearAnnualPropertyTax clears the value of the "annual_property_tax" field.
+func (_u *ListingUpdateOne) ClearAnnualPropertyTax() *ListingUpdateOne {
+	_u.mutation.ClearAnnualPropertyTax()
+	return _u
+}
+
+// SetHoaDues sets the "hoa_dues" field.
+func (_u *ListingUpdateOne) SetHoaDues(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.ResetHoaDues()
+	_u.mutation.SetHoaDues(v)
+	return _u
+}
+
+// SetNillableHoaDues sets the "hoa_dues" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableHoaDues(v *decimal.Decimal) *ListingUpdateOne {
+	if v != nil {
+		_u.SetHoaDues(*v)
+	}
+	return _u
+}
+
+// AddHoaDues adds value to the "hoa_dues" field.
+func (_u *ListingUpdateOne) AddHoaDues(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.AddHoaDues(v)
+	return _u
+}
+
+// ClearHoaDues clears the value of the "hoa_dues" field.
+func (_u *ListingUpdateOne) ClearHoaDues() *ListingUpdateOne {
+	_u.mutation.ClearHoaDues()
+	return _u
+}
+
+// SetHoaFrequency sets the "hoa_frequency" field.
+func (_u *ListingUpdateOne) SetHoaFrequency(v listing.HoaFrequency) *ListingUpdateOne {
+	_u.mutation.SetHoaFrequency(v)
+	return _u
+}
+
+// SetNillableHoaFrequency sets the "hoa_frequency" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableHoaFrequency(v *listing.HoaFrequency) *ListingUpdateOne {
+	if v != nil {
+		_u.SetHoaFrequency(*v)
+	}
+	return _u
+}
+
+// ClearHoaFrequency clears the value of the "hoa_frequency" field.
+func (_u *ListingUpdateOne) ClearHoaFrequency() *ListingUpdateOne {
+	_u.mutation.ClearHoaFrequency()
+	return _u
+}
+
+// SetHoaName sets the "hoa_name" field.
+func (_u *ListingUpdateOne) SetHoaName(v string) *ListingUpdateOne {
+	_u.mutation.SetHoaName(v)
+	return _u
+}
+
+// SetNillableHoaName sets the "hoa_name" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableHoaName(v *string) *ListingUpdateOne {
+	if v != nil {
+		_u.SetHoaName(*v)
+	}
+	return _u
+}
+
+// ClearHoaName clears the value of the "hoa_name" field.
+func (_u *ListingUpdateOne) ClearHoaName() *ListingUpdateOne {
+	_u.mutation.ClearHoaName()
+	return _u
+}
+
+// SetSpecialAssessments sets the "special_assessments" field.
+func (_u *ListingUpdateOne) SetSpecialAssessments(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.ResetSpecialAssessments()
+	_u.mutation.SetSpecialAssessments(v)
+	return _u
+}
+
+// SetNillableSpecialAssessments sets the "special_assessments" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableSpecialAssessments(v *decimal.Decimal) *ListingUpdateOne {
+	if v != nil {
+		_u.SetSpecialAssessments(*v)
+	}
+	return _u
+}
+
+// AddSpecialAssessments adds value to the "special_assessments" field.
+func (_u *ListingUpdateOne) AddSpecialAssessments(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.AddSpecialAssessments(v)
+	return _u
+}
+
+// ClearSpecialAssessments clears the value of the "special_assessments" field.
+func (_u *ListingUpdateOne) ClearSpecialAssessments() *ListingUpdateOne {
+	_u.mutation.ClearSpecialAssessments()
+	return _u
+}
+
+// SetMonthlyUtilities sets the "monthly_utilities" field.
+func (_u *ListingUpdateOne) SetMonthlyUtilities(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.ResetMonthlyUtilities()
+	_u.mutation.SetMonthlyUtilities(v)
+	return _u
+}
+
+// SetNillableMonthlyUtilities sets the "monthly_utilities" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableMonthlyUtilities(v *decimal.Decimal) *ListingUpdateOne {
+	if v != nil {
+		_u.SetMonthlyUtilities(*v)
+	}
+	return _u
+}
+
+// AddMonthlyUtilities adds value to the "monthly_utilities" field.
+func (_u *ListingUpdateOne) AddMonthlyUtilities(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.AddMonthlyUtilities(v)
+	return _u
+}
+
+// ClearMonthlyUtilities clears the value of the "monthly_utilities" field.
+func (_u *ListingUpdateOne) ClearMonthlyUtilities() *ListingUpdateOne {
+	_u.mutation.ClearMonthlyUtilities()
+	return _u
+}
+
+// SetMedia sets the "media" field.
+func (_u *ListingUpdateOne) SetMedia(v []schematype.Media) *ListingUpdateOne {
+	_u.mutation.SetMedia(v)
+	return _u
+}
+
+// AppendMedia appends value to the "media" field.
+func (_u *ListingUpdateOne) AppendMedia(v []schematype.Media) *ListingUpdateOne {
+	_u.mutation.AppendMedia(v)
+	return _u
+}
+
+// ClearMedia clears the value of the "media" field.
+func (_u *ListingUpdateOne) ClearMedia() *ListingUpdateOne {
+	_u.mutation.ClearMedia()
+	return _u
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (_u *ListingUpdateOne) SetRealtorID(v uuid.UUID) *ListingUpdateOne {
+	_u.mutation.SetRealtorID(v)
+	return _u
+}
+
+// SetNillableRealtorID sets the "realtor_id" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableRealtorID(v *uuid.UUID) *ListingUpdateOne {
+	if v != nil {
+		_u.SetRealtorID(*v)
+	}
+	return _u
+}
+
+// SetPropertyID sets the "property_id" field.
+func (_u *ListingUpdateOne) SetPropertyID(v uuid.UUID) *ListingUpdateOne {
+	_u.mutation.SetPropertyID(v)
+	return _u
+}
+
+// SetNillablePropertyID sets the "property_id" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillablePropertyID(v *uuid.UUID) *ListingUpdateOne {
+	if v != nil {
+		_u.SetPropertyID(*v)
+	}
+	return _u
+}
+
+// ClearPropertyID clears the value of the "property_id" field.
+func (_u *ListingUpdateOne) ClearPropertyID() *ListingUpdateOne {
+	_u.mutation.ClearPropertyID()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ListingUpdateOne) SetExpiresAt(v time.Time) *ListingUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableExpiresAt(v *time.Time) *ListingUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ListingUpdateOne) ClearExpiresAt() *ListingUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetExpiryReminderSentAt sets the "expiry_reminder_sent_at" field.
+func (_u *ListingUpdateOne) SetExpiryReminderSentAt(v time.Time) *ListingUpdateOne {
+	_u.mutation.SetExpiryReminderSentAt(v)
+	return _u
+}
+
+// SetNillableExpiryReminderSentAt sets the "expiry_reminder_sent_at" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableExpiryReminderSentAt(v *time.Time) *ListingUpdateOne {
+	if v != nil {
+		_u.SetExpiryReminderSentAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiryReminderSentAt clears the value of the "expiry_reminder_sent_at" field.
+func (_u *ListingUpdateOne) ClearExpiryReminderSentAt() *ListingUpdateOne {
+	_u.mutation.ClearExpiryReminderSentAt()
+	return _u
+}
+
+// SetExpired sets the "expired" field.
+func (_u *ListingUpdateOne) SetExpired(v bool) *ListingUpdateOne {
+	_u.mutation.SetExpired(v)
+	return _u
+}
+
+// SetNillableExpired sets the "expired" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableExpired(v *bool) *ListingUpdateOne {
+	if v != nil {
+		_u.SetExpired(*v)
+	}
+	return _u
+}
+
+// SetListedAt sets the "listed_at" field.
+func (_u *ListingUpdateOne) SetListedAt(v time.Time) *ListingUpdateOne {
+	_u.mutation.SetListedAt(v)
+	return _u
+}
+
+// SetNillableListedAt sets the "listed_at" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableListedAt(v *time.Time) *ListingUpdateOne {
+	if v != nil {
+		_u.SetListedAt(*v)
+	}
+	return _u
+}
+
+// ClearListedAt clears the value of the "listed_at" field.
+func (_u *ListingUpdateOne) ClearListedAt() *ListingUpdateOne {
+	_u.mutation.ClearListedAt()
+	return _u
+}
+
+// SetOnMarketSince sets the "on_market_since" field.
+func (_u *ListingUpdateOne) SetOnMarketSince(v time.Time) *ListingUpdateOne {
+	_u.mutation.SetOnMarketSince(v)
+	return _u
+}
+
+// SetNillableOnMarketSince sets the "on_market_since" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableOnMarketSince(v *time.Time) *ListingUpdateOne {
+	if v != nil {
+		_u.SetOnMarketSince(*v)
+	}
+	return _u
+}
+
+// ClearOnMarketSince clears the value of the "on_market_since" field.
+func (_u *ListingUpdateOne) ClearOnMarketSince() *ListingUpdateOne {
+	_u.mutation.ClearOnMarketSince()
+	return _u
+}
+
+// SetMarketSeconds sets the "market_seconds" field.
+func (_u *ListingUpdateOne) SetMarketSeconds(v int64) *ListingUpdateOne {
+	_u.mutation.ResetMarketSeconds()
+	_u.mutation.SetMarketSeconds(v)
+	return _u
+}
+
+// SetNillableMarketSeconds sets the "market_seconds" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableMarketSeconds(v *int64) *ListingUpdateOne {
+	if v != nil {
+		_u.SetMarketSeconds(*v)
+	}
+	return _u
+}
+
+// AddMarketSeconds adds value to the "market_seconds" field.
+func (_u *ListingUpdateOne) AddMarketSeconds(v int64) *ListingUpdateOne {
+	_u.mutation.AddMarketSeconds(v)
+	return _u
+}
+
+// SetSoldPrice sets the "sold_price" field.
+func (_u *ListingUpdateOne) SetSoldPrice(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.ResetSoldPrice()
+	_u.mutation.SetSoldPrice(v)
+	return _u
+}
+
+// SetNillableSoldPrice sets the "sold_price" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableSoldPrice(v *decimal.Decimal) *ListingUpdateOne {
+	if v != nil {
+		_u.SetSoldPrice(*v)
+	}
+	return _u
+}
+
+// AddSoldPrice adds value to the "sold_price" field.
+func (_u *ListingUpdateOne) AddSoldPrice(v decimal.Decimal) *ListingUpdateOne {
+	_u.mutation.AddSoldPrice(v)
+	return _u
+}
+
+// ClearSoldPrice clears the value of the "sold_price" field.
+func (_u *ListingUpdateOne) ClearSoldPrice() *ListingUpdateOne {
+	_u.mutation.ClearSoldPrice()
+	return _u
+}
+
+// SetCloseDate sets the "close_date" field.
+func (_u *ListingUpdateOne) SetCloseDate(v time.Time) *ListingUpdateOne {
+	_u.mutation.SetCloseDate(v)
+	return _u
+}
+
+// SetNillableCloseDate sets the "close_date" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableCloseDate(v *time.Time) *ListingUpdateOne {
+	if v != nil {
+		_u.SetCloseDate(*v)
+	}
+	return _u
+}
+
+// ClearCloseDate clears the value of the "close_date" field.
+func (_u *ListingUpdateOne) ClearCloseDate() *ListingUpdateOne {
+	_u.mutation.ClearCloseDate()
+	return _u
+}
+
+// SetBuyerRealtorID sets the "buyer_realtor_id" field.
+func (_u *ListingUpdateOne) SetBuyerRealtorID(v uuid.UUID) *ListingUpdateOne {
+	_u.mutation.SetBuyerRealtorID(v)
+	return _u
+}
+
+// SetNillableBuyerRealtorID sets the "buyer_realtor_id" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableBuyerRealtorID(v *uuid.UUID) *ListingUpdateOne {
+	if v != nil {
+		_u.SetBuyerRealtorID(*v)
+	}
+	return _u
+}
+
+// ClearBuyerRealtorID clears the value of the "buyer_realtor_id" field.
+func (_u *ListingUpdateOne) ClearBuyerRealtorID() *ListingUpdateOne {
+	_u.mutation.ClearBuyerRealtorID()
+	return _u
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (_u *ListingUpdateOne) SetPublishAt(v time.Time) *ListingUpdateOne {
+	_u.mutation.SetPublishAt(v)
+	return _u
+}
+
+// SetNillablePublishAt sets the "publish_at" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillablePublishAt(v *time.Time) *ListingUpdateOne {
+	if v != nil {
+		_u.SetPublishAt(*v)
+	}
+	return _u
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (_u *ListingUpdateOne) ClearPublishAt() *ListingUpdateOne {
+	_u.mutation.ClearPublishAt()
+	return _u
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (_u *ListingUpdateOne) SetUnpublishAt(v time.Time) *ListingUpdateOne {
+	_u.mutation.SetUnpublishAt(v)
+	return _u
+}
+
+// SetNillableUnpublishAt sets the "unpublish_at" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableUnpublishAt(v *time.Time) *ListingUpdateOne {
+	if v != nil {
+		_u.SetUnpublishAt(*v)
+	}
+	return _u
+}
+
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (_u *ListingUpdateOne) ClearUnpublishAt() *ListingUpdateOne {
+	_u.mutation.ClearUnpublishAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *ListingUpdateOne) SetVersion(v int) *ListingUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ListingUpdateOne) SetNillableVersion(v *int) *ListingUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ListingUpdateOne) AddVersion(v int) *ListingUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetRealtor sets the "realtor" edge to the Realtor entity.
+func (_u *ListingUpdateOne) SetRealtor(v *Realtor) *ListingUpdateOne {
+	return _u.SetRealtorID(v.ID)
+}
+
+// SetProperty sets the "property" edge to the Property entity.
+func (_u *ListingUpdateOne) SetProperty(v *Property) *ListingUpdateOne {
+	return _u.SetPropertyID(v.ID)
+}
+
+// SetNeighborhood sets the "neighborhood" edge to the Neighborhood entity.
+func (_u *ListingUpdateOne) SetNeighborhood(v *Neighborhood) *ListingUpdateOne {
+	return _u.SetNeighborhoodID(v.ID)
+}
+
+// SetBuyerRealtor sets the "buyer_realtor" edge to the Realtor entity.
+func (_u *ListingUpdateOne) SetBuyerRealtor(v *Realtor) *ListingUpdateOne {
+	return _u.SetBuyerRealtorID(v.ID)
+}
+
+// AddRenewalIDs adds the "renewals" edge to the ListingRenewal entity by IDs.
+func (_u *ListingUpdateOne) AddRenewalIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.AddRenewalIDs(ids...)
+	return _u
+}
+
+// AddRenewals adds the "renewals" edges to the ListingRenewal entity.
+func (_u *ListingUpdateOne) AddRenewals(v ...*ListingRenewal) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRenewalIDs(ids...)
+}
+
+// AddDocumentIDs adds the "documents" edge to the ListingDocument entity by IDs.
+func (_u *ListingUpdateOne) AddDocumentIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.AddDocumentIDs(ids...)
+	return _u
+}
+
+// AddDocuments adds the "documents" edges to the ListingDocument entity.
+func (_u *ListingUpdateOne) AddDocuments(v ...*ListingDocument) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDocumentIDs(ids...)
+}
+
+// AddInquiryIDs adds the "inquiries" edge to the ListingInquiry entity by IDs.
+func (_u *ListingUpdateOne) AddInquiryIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.AddInquiryIDs(ids...)
+	return _u
+}
+
+// AddInquiries adds the "inquiries" edges to the ListingInquiry entity.
+func (_u *ListingUpdateOne) AddInquiries(v ...*ListingInquiry) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInquiryIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the ListingReview entity by IDs.
+func (_u *ListingUpdateOne) AddReviewIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.AddReviewIDs(ids...)
+	return _u
+}
+
+// AddReviews adds the "reviews" edges to the ListingReview entity.
+func (_u *ListingUpdateOne) AddReviews(v ...*ListingReview) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewIDs(ids...)
+}
+
+// AddOfferIDs adds the "offers" edge to the Offer entity by IDs.
+func (_u *ListingUpdateOne) AddOfferIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.AddOfferIDs(ids...)
+	return _u
+}
+
+// AddOffers adds the "offers" edges to the Offer entity.
+func (_u *ListingUpdateOne) AddOffers(v ...*Offer) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOfferIDs(ids...)
+}
+
+// AddStatusEventIDs adds the "status_events" edge to the ListingStatusEvent entity by IDs.
+func (_u *ListingUpdateOne) AddStatusEventIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.AddStatusEventIDs(ids...)
+	return _u
+}
+
+// AddStatusEvents adds the "status_events" edges to the ListingStatusEvent entity.
+func (_u *ListingUpdateOne) AddStatusEvents(v ...*ListingStatusEvent) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusEventIDs(ids...)
+}
+
+// Mutation returns the ListingMutation object of the builder.
+func (_u *ListingUpdateOne) Mutation() *ListingMutation {
+	return _u.mutation
+}
+
+// ClearRealtor clears the "realtor" edge to the Realtor entity.
+func (_u *ListingUpdateOne) ClearRealtor() *ListingUpdateOne {
+	_u.mutation.ClearRealtor()
+	return _u
+}
+
+// ClearProperty clears the "property" edge to the Property entity.
+func (_u *ListingUpdateOne) ClearProperty() *ListingUpdateOne {
+	_u.mutation.ClearProperty()
+	return _u
+}
+
+// ClearNeighborhood clears the "neighborhood" edge to the Neighborhood entity.
+func (_u *ListingUpdateOne) ClearNeighborhood() *ListingUpdateOne {
+	_u.mutation.ClearNeighborhood()
+	return _u
+}
+
+// ClearBuyerRealtor clears the "buyer_realtor" edge to the Realtor entity.
+func (_u *ListingUpdateOne) ClearBuyerRealtor() *ListingUpdateOne {
+	_u.mutation.ClearBuyerRealtor()
+	return _u
+}
+
+// ClearRenewals clears all "renewals" edges to the ListingRenewal entity.
+func (_u *ListingUpdateOne) ClearRenewals() *ListingUpdateOne {
+	_u.mutation.ClearRenewals()
+	return _u
+}
+
+// RemoveRenewalIDs removes the "renewals" edge to ListingRenewal entities by IDs.
+func (_u *ListingUpdateOne) RemoveRenewalIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.RemoveRenewalIDs(ids...)
+	return _u
+}
+
+// RemoveRenewals removes "renewals" edges to ListingRenewal entities.
+func (_u *ListingUpdateOne) RemoveRenewals(v ...*ListingRenewal) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRenewalIDs(ids...)
+}
+
+// ClearDocuments clears all "documents" edges to the ListingDocument entity.
+func (_u *ListingUpdateOne) ClearDocuments() *ListingUpdateOne {
+	_u.mutation.ClearDocuments()
+	return _u
+}
+
+// RemoveDocumentIDs removes the "documents" edge to ListingDocument entities by IDs.
+func (_u *ListingUpdateOne) RemoveDocumentIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.RemoveDocumentIDs(ids...)
+	return _u
+}
+
+// RemoveDocuments removes "documents" edges to ListingDocument entities.
+func (_u *ListingUpdateOne) RemoveDocuments(v ...*ListingDocument) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDocumentIDs(ids...)
+}
+
+// ClearInquiries clears all "inquiries" edges to the ListingInquiry entity.
+func (_u *ListingUpdateOne) ClearInquiries() *ListingUpdateOne {
+	_u.mutation.ClearInquiries()
+	return _u
+}
+
+// RemoveInquiryIDs removes the "inquiries" edge to ListingInquiry entities by IDs.
+func (_u *ListingUpdateOne) RemoveInquiryIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.RemoveInquiryIDs(ids...)
+	return _u
+}
+
+// RemoveInquiries removes "inquiries" edges to ListingInquiry entities.
+func (_u *ListingUpdateOne) RemoveInquiries(v ...*ListingInquiry) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInquiryIDs(ids...)
+}
+
+// ClearReviews clears all "reviews" edges to the ListingReview entity.
+func (_u *ListingUpdateOne) ClearReviews() *ListingUpdateOne {
+	_u.mutation.ClearReviews()
+	return _u
+}
+
+// RemoveReviewIDs removes the "reviews" edge to ListingReview entities by IDs.
+func (_u *ListingUpdateOne) RemoveReviewIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.RemoveReviewIDs(ids...)
+	return _u
+}
+
+// RemoveReviews removes "reviews" edges to ListingReview entities.
+func (_u *ListingUpdateOne) RemoveReviews(v ...*ListingReview) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewIDs(ids...)
+}
+
+// ClearOffers clears all "offers" edges to the Offer entity.
+func (_u *ListingUpdateOne) ClearOffers() *ListingUpdateOne {
+	_u.mutation.ClearOffers()
+	return _u
+}
+
+// RemoveOfferIDs removes the "offers" edge to Offer entities by IDs.
+func (_u *ListingUpdateOne) RemoveOfferIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.RemoveOfferIDs(ids...)
+	return _u
+}
+
+// RemoveOffers removes "offers" edges to Offer entities.
+func (_u *ListingUpdateOne) RemoveOffers(v ...*Offer) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOfferIDs(ids...)
+}
+
+// ClearStatusEvents clears all "status_events" edges to the ListingStatusEvent entity.
+func (_u *ListingUpdateOne) ClearStatusEvents() *ListingUpdateOne {
+	_u.mutation.ClearStatusEvents()
+	return _u
+}
+
+// RemoveStatusEventIDs removes the "status_events" edge to ListingStatusEvent entities by IDs.
+func (_u *ListingUpdateOne) RemoveStatusEventIDs(ids ...uuid.UUID) *ListingUpdateOne {
+	_u.mutation.RemoveStatusEventIDs(ids...)
+	return _u
+}
+
+// RemoveStatusEvents removes "status_events" edges to ListingStatusEvent entities.
+func (_u *ListingUpdateOne) RemoveStatusEvents(v ...*ListingStatusEvent) *ListingUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusEventIDs(ids...)
+}
+
+// Where appends a list predicates to the ListingUpdate builder.
+func (_u *ListingUpdateOne) Where(ps ...predicate.Listing) *ListingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ListingUpdateOne) Select(field string, fields ...string) *ListingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Listing entity.
+func (_u *ListingUpdateOne) Save(ctx context.Context) (*Listing, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListingUpdateOne) SaveX(ctx context.Context) *Listing {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ListingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ListingUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if listing.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listing.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := listing.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListingUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := listing.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Listing.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Address(); ok {
+		if err := listing.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Listing.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressKey(); ok {
+		if err := listing.AddressKeyValidator(v); err != nil {
+			return &ValidationError{Name: "address_key", err: fmt.Errorf(`ent: validator failed for field "Listing.address_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.City(); ok {
+		if err := listing.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Listing.city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := listing.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Listing.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ZipCode(); ok {
+		if err := listing.ZipCodeValidator(v); err != nil {
+			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Listing.zip_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := listing.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "Listing.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Latitude(); ok {
+		if err := listing.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "Listing.latitude": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Longitude(); ok {
+		if err := listing.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "Listing.longitude": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceLocale(); ok {
+		if err := listing.SourceLocaleValidator(v); err != nil {
+			return &ValidationError{Name: "source_locale", err: fmt.Errorf(`ent: validator failed for field "Listing.source_locale": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := listing.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Listing.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bedroom(); ok {
+		if err := listing.BedroomValidator(v); err != nil {
+			return &ValidationError{Name: "bedroom", err: fmt.Errorf(`ent: validator failed for field "Listing.bedroom": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bathroom(); ok {
+		if err := listing.BathroomValidator(v); err != nil {
+			return &ValidationError{Name: "bathroom", err: fmt.Errorf(`ent: validator failed for field "Listing.bathroom": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Garage(); ok {
+		if err := listing.GarageValidator(v); err != nil {
+			return &ValidationError{Name: "garage", err: fmt.Errorf(`ent: validator failed for field "Listing.garage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sqft(); ok {
+		if err := listing.SqftValidator(v); err != nil {
+			return &ValidationError{Name: "sqft", err: fmt.Errorf(`ent: validator failed for field "Listing.sqft": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sqm(); ok {
+		if err := listing.SqmValidator(v); err != nil {
+			return &ValidationError{Name: "sqm", err: fmt.Errorf(`ent: validator failed for field "Listing.sqm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AreaUnit(); ok {
+		if err := listing.AreaUnitValidator(v); err != nil {
+			return &ValidationError{Name: "area_unit", err: fmt.Errorf(`ent: validator failed for field "Listing.area_unit": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TypeOfProperty(); ok {
+		if err := listing.TypeOfPropertyValidator(v); err != nil {
+			return &ValidationError{Name: "type_of_property", err: fmt.Errorf(`ent: validator failed for field "Listing.type_of_property": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := listing.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Listing.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LotSize(); ok {
+		if err := listing.LotSizeValidator(v); err != nil {
+			return &ValidationError{Name: "lot_size", err: fmt.Errorf(`ent: validator failed for field "Listing.lot_size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.YearBuilt(); ok {
+		if err := listing.YearBuiltValidator(v); err != nil {
+			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Listing.year_built": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HoaFrequency(); ok {
+		if err := listing.HoaFrequencyValidator(v); err != nil {
+			return &ValidationError{Name: "hoa_frequency", err: fmt.Errorf(`ent: validator failed for field "Listing.hoa_frequency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HoaName(); ok {
+		if err := listing.HoaNameValidator(v); err != nil {
+			return &ValidationError{Name: "hoa_name", err: fmt.Errorf(`ent: validator failed for field "Listing.hoa_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MarketSeconds(); ok {
+		if err := listing.MarketSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "market_seconds", err: fmt.Errorf(`ent: validator failed for field "Listing.market_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := listing.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Listing.version": %w`, err)}
+		}
+	}
+	if _u.mutation.RealtorCleared() && len(_u.mutation.RealtorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Listing.realtor"`)
+	}
+	return nil
+}
+
+func (_u *ListingUpdateOne) sqlSave(ctx context.Context) (_node *Listing, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listing.Table, listing.Columns, sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Listing.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, listing.FieldID)
+		for _, f := range fields {
+			if !listing.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != listing.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(listing.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(listing.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(listing.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressKey(); ok {
+		_spec.SetField(listing.FieldAddressKey, field.TypeString, value)
+	}
+	if _u.mutation.AddressKeyCleared() {
+		_spec.ClearField(listing.FieldAddressKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(listing.FieldCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(listing.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ZipCode(); ok {
+		_spec.SetField(listing.FieldZipCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(listing.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(listing.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(listing.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(listing.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(listing.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(listing.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(listing.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(listing.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(listing.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.SourceLocale(); ok {
+		_spec.SetField(listing.FieldSourceLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Translations(); ok {
+		_spec.SetField(listing.FieldTranslations, field.TypeJSON, value)
+	}
+	if _u.mutation.TranslationsCleared() {
+		_spec.ClearField(listing.FieldTranslations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(listing.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(listing.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(listing.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bedroom(); ok {
+		_spec.SetField(listing.FieldBedroom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBedroom(); ok {
+		_spec.AddField(listing.FieldBedroom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Bathroom(); ok {
+		_spec.SetField(listing.FieldBathroom, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBathroom(); ok {
+		_spec.AddField(listing.FieldBathroom, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Garage(); ok {
+		_spec.SetField(listing.FieldGarage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGarage(); ok {
+		_spec.AddField(listing.FieldGarage, field.TypeInt, value)
+	}
+	if _u.mutation.GarageCleared() {
+		_spec.ClearField(listing.FieldGarage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Sqft(); ok {
+		_spec.SetField(listing.FieldSqft, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSqft(); ok {
+		_spec.AddField(listing.FieldSqft, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Sqm(); ok {
+		_spec.SetField(listing.FieldSqm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSqm(); ok {
+		_spec.AddField(listing.FieldSqm, field.TypeInt, value)
+	}
+	if _u.mutation.SqmCleared() {
+		_spec.ClearField(listing.FieldSqm, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AreaUnit(); ok {
+		_spec.SetField(listing.FieldAreaUnit, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TypeOfProperty(); ok {
+		_spec.SetField(listing.FieldTypeOfProperty, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(listing.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LotSize(); ok {
+		_spec.SetField(listing.FieldLotSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLotSize(); ok {
+		_spec.AddField(listing.FieldLotSize, field.TypeInt, value)
+	}
+	if _u.mutation.LotSizeCleared() {
+		_spec.ClearField(listing.FieldLotSize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Pool(); ok {
+		_spec.SetField(listing.FieldPool, field.TypeBool, value)
+	}
+	if _u.mutation.PoolCleared() {
+		_spec.ClearField(listing.FieldPool, field.TypeBool)
+	}
+	if value, ok := _u.mutation.YearBuilt(); ok {
+		_spec.SetField(listing.FieldYearBuilt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedYearBuilt(); ok {
+		_spec.AddField(listing.FieldYearBuilt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AnnualPropertyTax(); ok {
+		_spec.SetField(listing.FieldAnnualPropertyTax, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAnnualPropertyTax(); ok {
+		_spec.AddField(listing.FieldAnnualPropertyTax, field.TypeFloat64, value)
+	}
+	if _u.mutation.AnnualPropertyTaxCleared() {
+		_spec.ClearField(listing.FieldAnnualPropertyTax, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HoaDues(); ok {
+		_spec.SetField(listing.FieldHoaDues, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHoaDues(); ok {
+		_spec.AddField(listing.FieldHoaDues, field.TypeFloat64, value)
+	}
+	if _u.mutation.HoaDuesCleared() {
+		_spec.ClearField(listing.FieldHoaDues, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.HoaFrequency(); ok {
+		_spec.SetField(listing.FieldHoaFrequency, field.TypeEnum, value)
+	}
+	if _u.mutation.HoaFrequencyCleared() {
+		_spec.ClearField(listing.FieldHoaFrequency, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.HoaName(); ok {
+		_spec.SetField(listing.FieldHoaName, field.TypeString, value)
+	}
+	if _u.mutation.HoaNameCleared() {
+		_spec.ClearField(listing.FieldHoaName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SpecialAssessments(); ok {
+		_spec.SetField(listing.FieldSpecialAssessments, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSpecialAssessments(); ok {
+		_spec.AddField(listing.FieldSpecialAssessments, field.TypeFloat64, value)
+	}
+	if _u.mutation.SpecialAssessmentsCleared() {
+		_spec.ClearField(listing.FieldSpecialAssessments, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MonthlyUtilities(); ok {
+		_spec.SetField(listing.FieldMonthlyUtilities, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyUtilities(); ok {
+		_spec.AddField(listing.FieldMonthlyUtilities, field.TypeFloat64, value)
+	}
+	if _u.mutation.MonthlyUtilitiesCleared() {
+		_spec.ClearField(listing.FieldMonthlyUtilities, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Media(); ok {
+		_spec.SetField(listing.FieldMedia, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMedia(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, listing.FieldMedia, value)
+		})
+	}
+	if _u.mutation.MediaCleared() {
+		_spec.ClearField(listing.FieldMedia, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(listing.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(listing.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiryReminderSentAt(); ok {
+		_spec.SetField(listing.FieldExpiryReminderSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiryReminderSentAtCleared() {
+		_spec.ClearField(listing.FieldExpiryReminderSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Expired(); ok {
+		_spec.SetField(listing.FieldExpired, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ListedAt(); ok {
+		_spec.SetField(listing.FieldListedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ListedAtCleared() {
+		_spec.ClearField(listing.FieldListedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OnMarketSince(); ok {
+		_spec.SetField(listing.FieldOnMarketSince, field.TypeTime, value)
+	}
+	if _u.mutation.OnMarketSinceCleared() {
+		_spec.ClearField(listing.FieldOnMarketSince, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MarketSeconds(); ok {
+		_spec.SetField(listing.FieldMarketSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMarketSeconds(); ok {
+		_spec.AddField(listing.FieldMarketSeconds, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SoldPrice(); ok {
+		_spec.SetField(listing.FieldSoldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSoldPrice(); ok {
+		_spec.AddField(listing.FieldSoldPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.SoldPriceCleared() {
+		_spec.ClearField(listing.FieldSoldPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CloseDate(); ok {
+		_spec.SetField(listing.FieldCloseDate, field.TypeTime, value)
+	}
+	if _u.mutation.CloseDateCleared() {
+		_spec.ClearField(listing.FieldCloseDate, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PublishAt(); ok {
+		_spec.SetField(listing.FieldPublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishAtCleared() {
+		_spec.ClearField(listing.FieldPublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnpublishAt(); ok {
+		_spec.SetField(listing.FieldUnpublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.UnpublishAtCleared() {
+		_spec.ClearField(listing.FieldUnpublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(listing.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(listing.FieldVersion, field.TypeInt, value)
+	}
+	if _u.mutation.RealtorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.RealtorTable,
+			Columns: []string{listing.RealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RealtorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.RealtorTable,
+			Columns: []string{listing.RealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PropertyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.PropertyTable,
+			Columns: []string{listing.PropertyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(property.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PropertyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.PropertyTable,
+			Columns: []string{listing.PropertyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(property.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NeighborhoodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.NeighborhoodTable,
+			Columns: []string{listing.NeighborhoodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(neighborhood.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NeighborhoodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.NeighborhoodTable,
+			Columns: []string{listing.NeighborhoodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(neighborhood.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BuyerRealtorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.BuyerRealtorTable,
+			Columns: []string{listing.BuyerRealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BuyerRealtorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listing.BuyerRealtorTable,
+			Columns: []string{listing.BuyerRealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RenewalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.RenewalsTable,
+			Columns: []string{listing.RenewalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingrenewal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRenewalsIDs(); len(nodes) > 0 && !_u.mutation.RenewalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.RenewalsTable,
+			Columns: []string{listing.RenewalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingrenewal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RenewalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.RenewalsTable,
+			Columns: []string{listing.RenewalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingrenewal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.DocumentsTable,
+			Columns: []string{listing.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !_u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.DocumentsTable,
+			Columns: []string{listing.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.DocumentsTable,
+			Columns: []string{listing.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InquiriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.InquiriesTable,
+			Columns: []string{listing.InquiriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listinginquiry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInquiriesIDs(); len(nodes) > 0 && !_u.mutation.InquiriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.InquiriesTable,
+			Columns: []string{listing.InquiriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listinginquiry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InquiriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.InquiriesTable,
+			Columns: []string{listing.InquiriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listinginquiry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.ReviewsTable,
+			Columns: []string{listing.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingreview.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !_u.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.ReviewsTable,
+			Columns: []string{listing.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingreview.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.ReviewsTable,
+			Columns: []string{listing.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingreview.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OffersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.OffersTable,
+			Columns: []string{listing.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOffersIDs(); len(nodes) > 0 && !_u.mutation.OffersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.OffersTable,
+			Columns: []string{listing.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OffersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.OffersTable,
+			Columns: []string{listing.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.StatusEventsTable,
+			Columns: []string{listing.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatusEventsIDs(); len(nodes) > 0 && !_u.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.StatusEventsTable,
+			Columns: []string{listing.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listing.StatusEventsTable,
+			Columns: []string{listing.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listingstatusevent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Listing{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
-	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{listing.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -1286,6 +4168,6 @@ func (luo *ListingUpdateOne) sqlSave(ctx context.Context) (_node *Listing, err e
 		}
 		return nil, err
 	}
-	luo.mutation.done = true
+	_u.mutation.done = true
 	return _node, nil
 }
@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+)
+
+// ListingDocument is the model entity for the ListingDocument schema.
+type ListingDocument struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// ListingID holds the value of the "listing_id" field.
+	ListingID uuid.UUID `json:"listing_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind listingdocument.Kind `json:"kind,omitempty"`
+	// AccessLevel holds the value of the "access_level" field.
+	AccessLevel listingdocument.AccessLevel `json:"access_level,omitempty"`
+	// Filename holds the value of the "filename" field.
+	Filename string `json:"filename,omitempty"`
+	// SizeBytes holds the value of the "size_bytes" field.
+	SizeBytes int64 `json:"size_bytes,omitempty"`
+	// StorageID holds the value of the "storage_id" field.
+	StorageID string `json:"-"`
+	// StorageFormat holds the value of the "storage_format" field.
+	StorageFormat string `json:"-"`
+	// UploadedBy holds the value of the "uploaded_by" field.
+	UploadedBy string `json:"uploaded_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ListingDocumentQuery when eager-loading is set.
+	Edges        ListingDocumentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ListingDocumentEdges holds the relations/edges for other nodes in the graph.
+type ListingDocumentEdges struct {
+	// Listing holds the value of the listing edge.
+	Listing *Listing `json:"listing,omitempty"`
+	// Downloads holds the value of the downloads edge.
+	Downloads []*DocumentDownload `json:"downloads,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ListingOrErr returns the Listing value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingDocumentEdges) ListingOrErr() (*Listing, error) {
+	if e.Listing != nil {
+		return e.Listing, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: listing.Label}
+	}
+	return nil, &NotLoadedError{edge: "listing"}
+}
+
+// DownloadsOrErr returns the Downloads value or an error if the edge
+// was not loaded in eager-loading.
+func (e ListingDocumentEdges) DownloadsOrErr() ([]*DocumentDownload, error) {
+	if e.loadedTypes[1] {
+		return e.Downloads, nil
+	}
+	return nil, &NotLoadedError{edge: "downloads"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ListingDocument) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case listingdocument.FieldSizeBytes:
+			values[i] = new(sql.NullInt64)
+		case listingdocument.FieldTitle, listingdocument.FieldKind, listingdocument.FieldAccessLevel, listingdocument.FieldFilename, listingdocument.FieldStorageID, listingdocument.FieldStorageFormat, listingdocument.FieldUploadedBy:
+			values[i] = new(sql.NullString)
+		case listingdocument.FieldCreateTime, listingdocument.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case listingdocument.FieldID, listingdocument.FieldListingID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ListingDocument fields.
+func (_m *ListingDocument) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case listingdocument.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case listingdocument.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case listingdocument.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case listingdocument.FieldListingID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field listing_id", values[i])
+			} else if value != nil {
+				_m.ListingID = *value
+			}
+		case listingdocument.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case listingdocument.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = listingdocument.Kind(value.String)
+			}
+		case listingdocument.FieldAccessLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_level", values[i])
+			} else if value.Valid {
+				_m.AccessLevel = listingdocument.AccessLevel(value.String)
+			}
+		case listingdocument.FieldFilename:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename", values[i])
+			} else if value.Valid {
+				_m.Filename = value.String
+			}
+		case listingdocument.FieldSizeBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size_bytes", values[i])
+			} else if value.Valid {
+				_m.SizeBytes = value.Int64
+			}
+		case listingdocument.FieldStorageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_id", values[i])
+			} else if value.Valid {
+				_m.StorageID = value.String
+			}
+		case listingdocument.FieldStorageFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_format", values[i])
+			} else if value.Valid {
+				_m.StorageFormat = value.String
+			}
+		case listingdocument.FieldUploadedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uploaded_by", values[i])
+			} else if value.Valid {
+				_m.UploadedBy = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ListingDocument.
+// This includes values selected through modifiers, order, etc.
+func (_m *ListingDocument) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryListing queries the "listing" edge of the ListingDocument entity.
+func (_m *ListingDocument) QueryListing() *ListingQuery {
+	return NewListingDocumentClient(_m.config).QueryListing(_m)
+}
+
+// QueryDownloads queries the "downloads" edge of the ListingDocument entity.
+func (_m *ListingDocument) QueryDownloads() *DocumentDownloadQuery {
+	return NewListingDocumentClient(_m.config).QueryDownloads(_m)
+}
+
+// Update returns a builder for updating this ListingDocument.
+// Note that you need to call ListingDocument.Unwrap() before calling this method if this ListingDocument
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ListingDocument) Update() *ListingDocumentUpdateOne {
+	return NewListingDocumentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ListingDocument entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ListingDocument) Unwrap() *ListingDocument {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ListingDocument is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ListingDocument) String() string {
+	var builder strings.Builder
+	builder.WriteString("ListingDocument(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("listing_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ListingID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("access_level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccessLevel))
+	builder.WriteString(", ")
+	builder.WriteString("filename=")
+	builder.WriteString(_m.Filename)
+	builder.WriteString(", ")
+	builder.WriteString("size_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SizeBytes))
+	builder.WriteString(", ")
+	builder.WriteString("storage_id=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("storage_format=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("uploaded_by=")
+	builder.WriteString(_m.UploadedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ListingDocuments is a parsable slice of ListingDocument.
+type ListingDocuments []*ListingDocument
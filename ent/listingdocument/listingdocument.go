@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package listingdocument
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the listingdocument type in the database.
+	Label = "listing_document"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldListingID holds the string denoting the listing_id field in the database.
+	FieldListingID = "listing_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldAccessLevel holds the string denoting the access_level field in the database.
+	FieldAccessLevel = "access_level"
+	// FieldFilename holds the string denoting the filename field in the database.
+	FieldFilename = "filename"
+	// FieldSizeBytes holds the string denoting the size_bytes field in the database.
+	FieldSizeBytes = "size_bytes"
+	// FieldStorageID holds the string denoting the storage_id field in the database.
+	FieldStorageID = "storage_id"
+	// FieldStorageFormat holds the string denoting the storage_format field in the database.
+	FieldStorageFormat = "storage_format"
+	// FieldUploadedBy holds the string denoting the uploaded_by field in the database.
+	FieldUploadedBy = "uploaded_by"
+	// EdgeListing holds the string denoting the listing edge name in mutations.
+	EdgeListing = "listing"
+	// EdgeDownloads holds the string denoting the downloads edge name in mutations.
+	EdgeDownloads = "downloads"
+	// Table holds the table name of the listingdocument in the database.
+	Table = "listing_documents"
+	// ListingTable is the table that holds the listing relation/edge.
+	ListingTable = "listing_documents"
+	// ListingInverseTable is the table name for the Listing entity.
+	// It exists in this package in order to avoid circular dependency with the "listing" package.
+	ListingInverseTable = "listings"
+	// ListingColumn is the table column denoting the listing relation/edge.
+	ListingColumn = "listing_id"
+	// DownloadsTable is the table that holds the downloads relation/edge.
+	DownloadsTable = "document_downloads"
+	// DownloadsInverseTable is the table name for the DocumentDownload entity.
+	// It exists in this package in order to avoid circular dependency with the "documentdownload" package.
+	DownloadsInverseTable = "document_downloads"
+	// DownloadsColumn is the table column denoting the downloads relation/edge.
+	DownloadsColumn = "document_id"
+)
+
+// Columns holds all SQL columns for listingdocument fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldListingID,
+	FieldTitle,
+	FieldKind,
+	FieldAccessLevel,
+	FieldFilename,
+	FieldSizeBytes,
+	FieldStorageID,
+	FieldStorageFormat,
+	FieldUploadedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "ppgroup.ppgroup.com/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	FilenameValidator func(string) error
+	// SizeBytesValidator is a validator for the "size_bytes" field. It is called by the builders before save.
+	SizeBytesValidator func(int64) error
+	// StorageIDValidator is a validator for the "storage_id" field. It is called by the builders before save.
+	StorageIDValidator func(string) error
+	// StorageFormatValidator is a validator for the "storage_format" field. It is called by the builders before save.
+	StorageFormatValidator func(string) error
+	// UploadedByValidator is a validator for the "uploaded_by" field. It is called by the builders before save.
+	UploadedByValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// KindOther is the default value of the Kind enum.
+const DefaultKind = KindOther
+
+// Kind values.
+const (
+	KindDisclosure Kind = "disclosure"
+	KindInspection Kind = "inspection"
+	KindHoa        Kind = "hoa"
+	KindOther      Kind = "other"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindDisclosure, KindInspection, KindHoa, KindOther:
+		return nil
+	default:
+		return fmt.Errorf("listingdocument: invalid enum value for kind field: %q", k)
+	}
+}
+
+// AccessLevel defines the type for the "access_level" enum field.
+type AccessLevel string
+
+// AccessLevelStaff is the default value of the AccessLevel enum.
+const DefaultAccessLevel = AccessLevelStaff
+
+// AccessLevel values.
+const (
+	AccessLevelPublic           AccessLevel = "public"
+	AccessLevelSignedIn         AccessLevel = "signed_in"
+	AccessLevelApprovedInquirer AccessLevel = "approved_inquirer"
+	AccessLevelStaff            AccessLevel = "staff"
+)
+
+func (al AccessLevel) String() string {
+	return string(al)
+}
+
+// AccessLevelValidator is a validator for the "access_level" field enum values. It is called by the builders before save.
+func AccessLevelValidator(al AccessLevel) error {
+	switch al {
+	case AccessLevelPublic, AccessLevelSignedIn, AccessLevelApprovedInquirer, AccessLevelStaff:
+		return nil
+	default:
+		return fmt.Errorf("listingdocument: invalid enum value for access_level field: %q", al)
+	}
+}
+
+// OrderOption defines the ordering options for the ListingDocument queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByListingID orders the results by the listing_id field.
+func ByListingID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListingID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByAccessLevel orders the results by the access_level field.
+func ByAccessLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessLevel, opts...).ToFunc()
+}
+
+// ByFilename orders the results by the filename field.
+func ByFilename(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilename, opts...).ToFunc()
+}
+
+// BySizeBytes orders the results by the size_bytes field.
+func BySizeBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSizeBytes, opts...).ToFunc()
+}
+
+// ByStorageID orders the results by the storage_id field.
+func ByStorageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageID, opts...).ToFunc()
+}
+
+// ByStorageFormat orders the results by the storage_format field.
+func ByStorageFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageFormat, opts...).ToFunc()
+}
+
+// ByUploadedBy orders the results by the uploaded_by field.
+func ByUploadedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadedBy, opts...).ToFunc()
+}
+
+// ByListingField orders the results by listing field.
+func ByListingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListingStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDownloadsCount orders the results by downloads count.
+func ByDownloadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDownloadsStep(), opts...)
+	}
+}
+
+// ByDownloads orders the results by downloads terms.
+func ByDownloads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDownloadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newListingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ListingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ListingTable, ListingColumn),
+	)
+}
+func newDownloadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DownloadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DownloadsTable, DownloadsColumn),
+	)
+}
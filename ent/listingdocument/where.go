@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package listingdocument
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ListingID applies equality check predicate on the "listing_id" field. It's identical to ListingIDEQ.
+func ListingID(v uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldListingID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldTitle, v))
+}
+
+// Filename applies equality check predicate on the "filename" field. It's identical to FilenameEQ.
+func Filename(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldFilename, v))
+}
+
+// SizeBytes applies equality check predicate on the "size_bytes" field. It's identical to SizeBytesEQ.
+func SizeBytes(v int64) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldSizeBytes, v))
+}
+
+// StorageID applies equality check predicate on the "storage_id" field. It's identical to StorageIDEQ.
+func StorageID(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldStorageID, v))
+}
+
+// StorageFormat applies equality check predicate on the "storage_format" field. It's identical to StorageFormatEQ.
+func StorageFormat(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldStorageFormat, v))
+}
+
+// UploadedBy applies equality check predicate on the "uploaded_by" field. It's identical to UploadedByEQ.
+func UploadedBy(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldUploadedBy, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ListingIDEQ applies the EQ predicate on the "listing_id" field.
+func ListingIDEQ(v uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldListingID, v))
+}
+
+// ListingIDNEQ applies the NEQ predicate on the "listing_id" field.
+func ListingIDNEQ(v uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldListingID, v))
+}
+
+// ListingIDIn applies the In predicate on the "listing_id" field.
+func ListingIDIn(vs ...uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldListingID, vs...))
+}
+
+// ListingIDNotIn applies the NotIn predicate on the "listing_id" field.
+func ListingIDNotIn(vs ...uuid.UUID) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldListingID, vs...))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// AccessLevelEQ applies the EQ predicate on the "access_level" field.
+func AccessLevelEQ(v AccessLevel) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldAccessLevel, v))
+}
+
+// AccessLevelNEQ applies the NEQ predicate on the "access_level" field.
+func AccessLevelNEQ(v AccessLevel) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldAccessLevel, v))
+}
+
+// AccessLevelIn applies the In predicate on the "access_level" field.
+func AccessLevelIn(vs ...AccessLevel) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldAccessLevel, vs...))
+}
+
+// AccessLevelNotIn applies the NotIn predicate on the "access_level" field.
+func AccessLevelNotIn(vs ...AccessLevel) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldAccessLevel, vs...))
+}
+
+// FilenameEQ applies the EQ predicate on the "filename" field.
+func FilenameEQ(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldFilename, v))
+}
+
+// FilenameNEQ applies the NEQ predicate on the "filename" field.
+func FilenameNEQ(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldFilename, v))
+}
+
+// FilenameIn applies the In predicate on the "filename" field.
+func FilenameIn(vs ...string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldFilename, vs...))
+}
+
+// FilenameNotIn applies the NotIn predicate on the "filename" field.
+func FilenameNotIn(vs ...string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldFilename, vs...))
+}
+
+// FilenameGT applies the GT predicate on the "filename" field.
+func FilenameGT(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGT(FieldFilename, v))
+}
+
+// FilenameGTE applies the GTE predicate on the "filename" field.
+func FilenameGTE(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGTE(FieldFilename, v))
+}
+
+// FilenameLT applies the LT predicate on the "filename" field.
+func FilenameLT(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLT(FieldFilename, v))
+}
+
+// FilenameLTE applies the LTE predicate on the "filename" field.
+func FilenameLTE(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLTE(FieldFilename, v))
+}
+
+// FilenameContains applies the Contains predicate on the "filename" field.
+func FilenameContains(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldContains(FieldFilename, v))
+}
+
+// FilenameHasPrefix applies the HasPrefix predicate on the "filename" field.
+func FilenameHasPrefix(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldHasPrefix(FieldFilename, v))
+}
+
+// FilenameHasSuffix applies the HasSuffix predicate on the "filename" field.
+func FilenameHasSuffix(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldHasSuffix(FieldFilename, v))
+}
+
+// FilenameEqualFold applies the EqualFold predicate on the "filename" field.
+func FilenameEqualFold(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEqualFold(FieldFilename, v))
+}
+
+// FilenameContainsFold applies the ContainsFold predicate on the "filename" field.
+func FilenameContainsFold(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldContainsFold(FieldFilename, v))
+}
+
+// SizeBytesEQ applies the EQ predicate on the "size_bytes" field.
+func SizeBytesEQ(v int64) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldSizeBytes, v))
+}
+
+// SizeBytesNEQ applies the NEQ predicate on the "size_bytes" field.
+func SizeBytesNEQ(v int64) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldSizeBytes, v))
+}
+
+// SizeBytesIn applies the In predicate on the "size_bytes" field.
+func SizeBytesIn(vs ...int64) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldSizeBytes, vs...))
+}
+
+// SizeBytesNotIn applies the NotIn predicate on the "size_bytes" field.
+func SizeBytesNotIn(vs ...int64) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldSizeBytes, vs...))
+}
+
+// SizeBytesGT applies the GT predicate on the "size_bytes" field.
+func SizeBytesGT(v int64) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGT(FieldSizeBytes, v))
+}
+
+// SizeBytesGTE applies the GTE predicate on the "size_bytes" field.
+func SizeBytesGTE(v int64) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGTE(FieldSizeBytes, v))
+}
+
+// SizeBytesLT applies the LT predicate on the "size_bytes" field.
+func SizeBytesLT(v int64) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLT(FieldSizeBytes, v))
+}
+
+// SizeBytesLTE applies the LTE predicate on the "size_bytes" field.
+func SizeBytesLTE(v int64) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLTE(FieldSizeBytes, v))
+}
+
+// StorageIDEQ applies the EQ predicate on the "storage_id" field.
+func StorageIDEQ(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldStorageID, v))
+}
+
+// StorageIDNEQ applies the NEQ predicate on the "storage_id" field.
+func StorageIDNEQ(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldStorageID, v))
+}
+
+// StorageIDIn applies the In predicate on the "storage_id" field.
+func StorageIDIn(vs ...string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldStorageID, vs...))
+}
+
+// StorageIDNotIn applies the NotIn predicate on the "storage_id" field.
+func StorageIDNotIn(vs ...string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldStorageID, vs...))
+}
+
+// StorageIDGT applies the GT predicate on the "storage_id" field.
+func StorageIDGT(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGT(FieldStorageID, v))
+}
+
+// StorageIDGTE applies the GTE predicate on the "storage_id" field.
+func StorageIDGTE(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGTE(FieldStorageID, v))
+}
+
+// StorageIDLT applies the LT predicate on the "storage_id" field.
+func StorageIDLT(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLT(FieldStorageID, v))
+}
+
+// StorageIDLTE applies the LTE predicate on the "storage_id" field.
+func StorageIDLTE(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLTE(FieldStorageID, v))
+}
+
+// StorageIDContains applies the Contains predicate on the "storage_id" field.
+func StorageIDContains(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldContains(FieldStorageID, v))
+}
+
+// StorageIDHasPrefix applies the HasPrefix predicate on the "storage_id" field.
+func StorageIDHasPrefix(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldHasPrefix(FieldStorageID, v))
+}
+
+// StorageIDHasSuffix applies the HasSuffix predicate on the "storage_id" field.
+func StorageIDHasSuffix(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldHasSuffix(FieldStorageID, v))
+}
+
+// StorageIDEqualFold applies the EqualFold predicate on the "storage_id" field.
+func StorageIDEqualFold(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEqualFold(FieldStorageID, v))
+}
+
+// StorageIDContainsFold applies the ContainsFold predicate on the "storage_id" field.
+func StorageIDContainsFold(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldContainsFold(FieldStorageID, v))
+}
+
+// StorageFormatEQ applies the EQ predicate on the "storage_format" field.
+func StorageFormatEQ(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldStorageFormat, v))
+}
+
+// StorageFormatNEQ applies the NEQ predicate on the "storage_format" field.
+func StorageFormatNEQ(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldStorageFormat, v))
+}
+
+// StorageFormatIn applies the In predicate on the "storage_format" field.
+func StorageFormatIn(vs ...string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldStorageFormat, vs...))
+}
+
+// StorageFormatNotIn applies the NotIn predicate on the "storage_format" field.
+func StorageFormatNotIn(vs ...string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldStorageFormat, vs...))
+}
+
+// StorageFormatGT applies the GT predicate on the "storage_format" field.
+func StorageFormatGT(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGT(FieldStorageFormat, v))
+}
+
+// StorageFormatGTE applies the GTE predicate on the "storage_format" field.
+func StorageFormatGTE(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGTE(FieldStorageFormat, v))
+}
+
+// StorageFormatLT applies the LT predicate on the "storage_format" field.
+func StorageFormatLT(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLT(FieldStorageFormat, v))
+}
+
+// StorageFormatLTE applies the LTE predicate on the "storage_format" field.
+func StorageFormatLTE(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLTE(FieldStorageFormat, v))
+}
+
+// StorageFormatContains applies the Contains predicate on the "storage_format" field.
+func StorageFormatContains(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldContains(FieldStorageFormat, v))
+}
+
+// StorageFormatHasPrefix applies the HasPrefix predicate on the "storage_format" field.
+func StorageFormatHasPrefix(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldHasPrefix(FieldStorageFormat, v))
+}
+
+// StorageFormatHasSuffix applies the HasSuffix predicate on the "storage_format" field.
+func StorageFormatHasSuffix(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldHasSuffix(FieldStorageFormat, v))
+}
+
+// StorageFormatIsNil applies the IsNil predicate on the "storage_format" field.
+func StorageFormatIsNil() predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIsNull(FieldStorageFormat))
+}
+
+// StorageFormatNotNil applies the NotNil predicate on the "storage_format" field.
+func StorageFormatNotNil() predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotNull(FieldStorageFormat))
+}
+
+// StorageFormatEqualFold applies the EqualFold predicate on the "storage_format" field.
+func StorageFormatEqualFold(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEqualFold(FieldStorageFormat, v))
+}
+
+// StorageFormatContainsFold applies the ContainsFold predicate on the "storage_format" field.
+func StorageFormatContainsFold(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldContainsFold(FieldStorageFormat, v))
+}
+
+// UploadedByEQ applies the EQ predicate on the "uploaded_by" field.
+func UploadedByEQ(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEQ(FieldUploadedBy, v))
+}
+
+// UploadedByNEQ applies the NEQ predicate on the "uploaded_by" field.
+func UploadedByNEQ(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNEQ(FieldUploadedBy, v))
+}
+
+// UploadedByIn applies the In predicate on the "uploaded_by" field.
+func UploadedByIn(vs ...string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIn(FieldUploadedBy, vs...))
+}
+
+// UploadedByNotIn applies the NotIn predicate on the "uploaded_by" field.
+func UploadedByNotIn(vs ...string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotIn(FieldUploadedBy, vs...))
+}
+
+// UploadedByGT applies the GT predicate on the "uploaded_by" field.
+func UploadedByGT(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGT(FieldUploadedBy, v))
+}
+
+// UploadedByGTE applies the GTE predicate on the "uploaded_by" field.
+func UploadedByGTE(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldGTE(FieldUploadedBy, v))
+}
+
+// UploadedByLT applies the LT predicate on the "uploaded_by" field.
+func UploadedByLT(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLT(FieldUploadedBy, v))
+}
+
+// UploadedByLTE applies the LTE predicate on the "uploaded_by" field.
+func UploadedByLTE(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldLTE(FieldUploadedBy, v))
+}
+
+// UploadedByContains applies the Contains predicate on the "uploaded_by" field.
+func UploadedByContains(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldContains(FieldUploadedBy, v))
+}
+
+// UploadedByHasPrefix applies the HasPrefix predicate on the "uploaded_by" field.
+func UploadedByHasPrefix(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldHasPrefix(FieldUploadedBy, v))
+}
+
+// UploadedByHasSuffix applies the HasSuffix predicate on the "uploaded_by" field.
+func UploadedByHasSuffix(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldHasSuffix(FieldUploadedBy, v))
+}
+
+// UploadedByIsNil applies the IsNil predicate on the "uploaded_by" field.
+func UploadedByIsNil() predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldIsNull(FieldUploadedBy))
+}
+
+// UploadedByNotNil applies the NotNil predicate on the "uploaded_by" field.
+func UploadedByNotNil() predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldNotNull(FieldUploadedBy))
+}
+
+// UploadedByEqualFold applies the EqualFold predicate on the "uploaded_by" field.
+func UploadedByEqualFold(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldEqualFold(FieldUploadedBy, v))
+}
+
+// UploadedByContainsFold applies the ContainsFold predicate on the "uploaded_by" field.
+func UploadedByContainsFold(v string) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.FieldContainsFold(FieldUploadedBy, v))
+}
+
+// HasListing applies the HasEdge predicate on the "listing" edge.
+func HasListing() predicate.ListingDocument {
+	return predicate.ListingDocument(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ListingTable, ListingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListingWith applies the HasEdge predicate on the "listing" edge with a given conditions (other predicates).
+func HasListingWith(preds ...predicate.Listing) predicate.ListingDocument {
+	return predicate.ListingDocument(func(s *sql.Selector) {
+		step := newListingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDownloads applies the HasEdge predicate on the "downloads" edge.
+func HasDownloads() predicate.ListingDocument {
+	return predicate.ListingDocument(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DownloadsTable, DownloadsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDownloadsWith applies the HasEdge predicate on the "downloads" edge with a given conditions (other predicates).
+func HasDownloadsWith(preds ...predicate.DocumentDownload) predicate.ListingDocument {
+	return predicate.ListingDocument(func(s *sql.Selector) {
+		step := newDownloadsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ListingDocument) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ListingDocument) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ListingDocument) predicate.ListingDocument {
+	return predicate.ListingDocument(sql.NotPredicates(p))
+}
@@ -0,0 +1,1229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+)
+
+// ListingDocumentCreate is the builder for creating a ListingDocument entity.
+type ListingDocumentCreate struct {
+	config
+	mutation *ListingDocumentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ListingDocumentCreate) SetCreateTime(v time.Time) *ListingDocumentCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ListingDocumentCreate) SetNillableCreateTime(v *time.Time) *ListingDocumentCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ListingDocumentCreate) SetUpdateTime(v time.Time) *ListingDocumentCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ListingDocumentCreate) SetNillableUpdateTime(v *time.Time) *ListingDocumentCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetListingID sets the "listing_id" field.
+func (_c *ListingDocumentCreate) SetListingID(v uuid.UUID) *ListingDocumentCreate {
+	_c.mutation.SetListingID(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *ListingDocumentCreate) SetTitle(v string) *ListingDocumentCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ListingDocumentCreate) SetKind(v listingdocument.Kind) *ListingDocumentCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *ListingDocumentCreate) SetNillableKind(v *listingdocument.Kind) *ListingDocumentCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetAccessLevel sets the "access_level" field.
+func (_c *ListingDocumentCreate) SetAccessLevel(v listingdocument.AccessLevel) *ListingDocumentCreate {
+	_c.mutation.SetAccessLevel(v)
+	return _c
+}
+
+// SetNillableAccessLevel sets the "access_level" field if the given value is not nil.
+func (_c *ListingDocumentCreate) SetNillableAccessLevel(v *listingdocument.AccessLevel) *ListingDocumentCreate {
+	if v != nil {
+		_c.SetAccessLevel(*v)
+	}
+	return _c
+}
+
+// SetFilename sets the "filename" field.
+func (_c *ListingDocumentCreate) SetFilename(v string) *ListingDocumentCreate {
+	_c.mutation.SetFilename(v)
+	return _c
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_c *ListingDocumentCreate) SetSizeBytes(v int64) *ListingDocumentCreate {
+	_c.mutation.SetSizeBytes(v)
+	return _c
+}
+
+// SetStorageID sets the "storage_id" field.
+func (_c *ListingDocumentCreate) SetStorageID(v string) *ListingDocumentCreate {
+	_c.mutation.SetStorageID(v)
+	return _c
+}
+
+// SetStorageFormat sets the "storage_format" field.
+func (_c *ListingDocumentCreate) SetStorageFormat(v string) *ListingDocumentCreate {
+	_c.mutation.SetStorageFormat(v)
+	return _c
+}
+
+// SetNillableStorageFormat sets the "storage_format" field if the given value is not nil.
+func (_c *ListingDocumentCreate) SetNillableStorageFormat(v *string) *ListingDocumentCreate {
+	if v != nil {
+		_c.SetStorageFormat(*v)
+	}
+	return _c
+}
+
+// SetUploadedBy sets the "uploaded_by" field.
+func (_c *ListingDocumentCreate) SetUploadedBy(v string) *ListingDocumentCreate {
+	_c.mutation.SetUploadedBy(v)
+	return _c
+}
+
+// SetNillableUploadedBy sets the "uploaded_by" field if the given value is not nil.
+func (_c *ListingDocumentCreate) SetNillableUploadedBy(v *string) *ListingDocumentCreate {
+	if v != nil {
+		_c.SetUploadedBy(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ListingDocumentCreate) SetID(v uuid.UUID) *ListingDocumentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ListingDocumentCreate) SetNillableID(v *uuid.UUID) *ListingDocumentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_c *ListingDocumentCreate) SetListing(v *Listing) *ListingDocumentCreate {
+	return _c.SetListingID(v.ID)
+}
+
+// AddDownloadIDs adds the "downloads" edge to the DocumentDownload entity by IDs.
+func (_c *ListingDocumentCreate) AddDownloadIDs(ids ...uuid.UUID) *ListingDocumentCreate {
+	_c.mutation.AddDownloadIDs(ids...)
+	return _c
+}
+
+// AddDownloads adds the "downloads" edges to the DocumentDownload entity.
+func (_c *ListingDocumentCreate) AddDownloads(v ...*DocumentDownload) *ListingDocumentCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDownloadIDs(ids...)
+}
+
+// Mutation returns the ListingDocumentMutation object of the builder.
+func (_c *ListingDocumentCreate) Mutation() *ListingDocumentMutation {
+	return _c.mutation
+}
+
+// Save creates the ListingDocument in the database.
+func (_c *ListingDocumentCreate) Save(ctx context.Context) (*ListingDocument, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ListingDocumentCreate) SaveX(ctx context.Context) *ListingDocument {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListingDocumentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListingDocumentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ListingDocumentCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if listingdocument.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized listingdocument.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := listingdocument.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if listingdocument.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listingdocument.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := listingdocument.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := listingdocument.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.AccessLevel(); !ok {
+		v := listingdocument.DefaultAccessLevel
+		_c.mutation.SetAccessLevel(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if listingdocument.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized listingdocument.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := listingdocument.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ListingDocumentCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ListingDocument.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ListingDocument.update_time"`)}
+	}
+	if _, ok := _c.mutation.ListingID(); !ok {
+		return &ValidationError{Name: "listing_id", err: errors.New(`ent: missing required field "ListingDocument.listing_id"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "ListingDocument.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := listingdocument.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ListingDocument.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := listingdocument.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccessLevel(); !ok {
+		return &ValidationError{Name: "access_level", err: errors.New(`ent: missing required field "ListingDocument.access_level"`)}
+	}
+	if v, ok := _c.mutation.AccessLevel(); ok {
+		if err := listingdocument.AccessLevelValidator(v); err != nil {
+			return &ValidationError{Name: "access_level", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.access_level": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Filename(); !ok {
+		return &ValidationError{Name: "filename", err: errors.New(`ent: missing required field "ListingDocument.filename"`)}
+	}
+	if v, ok := _c.mutation.Filename(); ok {
+		if err := listingdocument.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.filename": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SizeBytes(); !ok {
+		return &ValidationError{Name: "size_bytes", err: errors.New(`ent: missing required field "ListingDocument.size_bytes"`)}
+	}
+	if v, ok := _c.mutation.SizeBytes(); ok {
+		if err := listingdocument.SizeBytesValidator(v); err != nil {
+			return &ValidationError{Name: "size_bytes", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.size_bytes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StorageID(); !ok {
+		return &ValidationError{Name: "storage_id", err: errors.New(`ent: missing required field "ListingDocument.storage_id"`)}
+	}
+	if v, ok := _c.mutation.StorageID(); ok {
+		if err := listingdocument.StorageIDValidator(v); err != nil {
+			return &ValidationError{Name: "storage_id", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.storage_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.StorageFormat(); ok {
+		if err := listingdocument.StorageFormatValidator(v); err != nil {
+			return &ValidationError{Name: "storage_format", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.storage_format": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.UploadedBy(); ok {
+		if err := listingdocument.UploadedByValidator(v); err != nil {
+			return &ValidationError{Name: "uploaded_by", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.uploaded_by": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ListingIDs()) == 0 {
+		return &ValidationError{Name: "listing", err: errors.New(`ent: missing required edge "ListingDocument.listing"`)}
+	}
+	return nil
+}
+
+func (_c *ListingDocumentCreate) sqlSave(ctx context.Context) (*ListingDocument, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ListingDocumentCreate) createSpec() (*ListingDocument, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ListingDocument{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(listingdocument.Table, sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(listingdocument.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(listingdocument.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(listingdocument.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(listingdocument.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.AccessLevel(); ok {
+		_spec.SetField(listingdocument.FieldAccessLevel, field.TypeEnum, value)
+		_node.AccessLevel = value
+	}
+	if value, ok := _c.mutation.Filename(); ok {
+		_spec.SetField(listingdocument.FieldFilename, field.TypeString, value)
+		_node.Filename = value
+	}
+	if value, ok := _c.mutation.SizeBytes(); ok {
+		_spec.SetField(listingdocument.FieldSizeBytes, field.TypeInt64, value)
+		_node.SizeBytes = value
+	}
+	if value, ok := _c.mutation.StorageID(); ok {
+		_spec.SetField(listingdocument.FieldStorageID, field.TypeString, value)
+		_node.StorageID = value
+	}
+	if value, ok := _c.mutation.StorageFormat(); ok {
+		_spec.SetField(listingdocument.FieldStorageFormat, field.TypeString, value)
+		_node.StorageFormat = value
+	}
+	if value, ok := _c.mutation.UploadedBy(); ok {
+		_spec.SetField(listingdocument.FieldUploadedBy, field.TypeString, value)
+		_node.UploadedBy = value
+	}
+	if nodes := _c.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingdocument.ListingTable,
+			Columns: []string{listingdocument.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListingID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DownloadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listingdocument.DownloadsTable,
+			Columns: []string{listingdocument.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ListingDocument.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingDocumentUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingDocumentCreate) OnConflict(opts ...sql.ConflictOption) *ListingDocumentUpsertOne {
+	_c.conflict = opts
+	return &ListingDocumentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ListingDocument.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingDocumentCreate) OnConflictColumns(columns ...string) *ListingDocumentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingDocumentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ListingDocumentUpsertOne is the builder for "upsert"-ing
+	//  one ListingDocument node.
+	ListingDocumentUpsertOne struct {
+		create *ListingDocumentCreate
+	}
+
+	// ListingDocumentUpsert is the "OnConflict" setter.
+	ListingDocumentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingDocumentUpsert) SetUpdateTime(v time.Time) *ListingDocumentUpsert {
+	u.Set(listingdocument.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingDocumentUpsert) UpdateUpdateTime() *ListingDocumentUpsert {
+	u.SetExcluded(listingdocument.FieldUpdateTime)
+	return u
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingDocumentUpsert) SetListingID(v uuid.UUID) *ListingDocumentUpsert {
+	u.Set(listingdocument.FieldListingID, v)
+	return u
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingDocumentUpsert) UpdateListingID() *ListingDocumentUpsert {
+	u.SetExcluded(listingdocument.FieldListingID)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *ListingDocumentUpsert) SetTitle(v string) *ListingDocumentUpsert {
+	u.Set(listingdocument.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ListingDocumentUpsert) UpdateTitle() *ListingDocumentUpsert {
+	u.SetExcluded(listingdocument.FieldTitle)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *ListingDocumentUpsert) SetKind(v listingdocument.Kind) *ListingDocumentUpsert {
+	u.Set(listingdocument.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ListingDocumentUpsert) UpdateKind() *ListingDocumentUpsert {
+	u.SetExcluded(listingdocument.FieldKind)
+	return u
+}
+
+// SetAccessLevel sets the "access_level" field.
+func (u *ListingDocumentUpsert) SetAccessLevel(v listingdocument.AccessLevel) *ListingDocumentUpsert {
+	u.Set(listingdocument.FieldAccessLevel, v)
+	return u
+}
+
+// UpdateAccessLevel sets the "access_level" field to the value that was provided on create.
+func (u *ListingDocumentUpsert) UpdateAccessLevel() *ListingDocumentUpsert {
+	u.SetExcluded(listingdocument.FieldAccessLevel)
+	return u
+}
+
+// SetFilename sets the "filename" field.
+func (u *ListingDocumentUpsert) SetFilename(v string) *ListingDocumentUpsert {
+	u.Set(listingdocument.FieldFilename, v)
+	return u
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *ListingDocumentUpsert) UpdateFilename() *ListingDocumentUpsert {
+	u.SetExcluded(listingdocument.FieldFilename)
+	return u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *ListingDocumentUpsert) SetSizeBytes(v int64) *ListingDocumentUpsert {
+	u.Set(listingdocument.FieldSizeBytes, v)
+	return u
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *ListingDocumentUpsert) UpdateSizeBytes() *ListingDocumentUpsert {
+	u.SetExcluded(listingdocument.FieldSizeBytes)
+	return u
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *ListingDocumentUpsert) AddSizeBytes(v int64) *ListingDocumentUpsert {
+	u.Add(listingdocument.FieldSizeBytes, v)
+	return u
+}
+
+// SetStorageID sets the "storage_id" field.
+func (u *ListingDocumentUpsert) SetStorageID(v string) *ListingDocumentUpsert {
+	u.Set(listingdocument.FieldStorageID, v)
+	return u
+}
+
+// UpdateStorageID sets the "storage_id" field to the value that was provided on create.
+func (u *ListingDocumentUpsert) UpdateStorageID() *ListingDocumentUpsert {
+	u.SetExcluded(listingdocument.FieldStorageID)
+	return u
+}
+
+// SetStorageFormat sets the "storage_format" field.
+func (u *ListingDocumentUpsert) SetStorageFormat(v string) *ListingDocumentUpsert {
+	u.Set(listingdocument.FieldStorageFormat, v)
+	return u
+}
+
+// UpdateStorageFormat sets the "storage_format" field to the value that was provided on create.
+func (u *ListingDocumentUpsert) UpdateStorageFormat() *ListingDocumentUpsert {
+	u.SetExcluded(listingdocument.FieldStorageFormat)
+	return u
+}
+
+// ClearStorageFormat clears the value of the "storage_format" field.
+func (u *ListingDocumentUpsert) ClearStorageFormat() *ListingDocumentUpsert {
+	u.SetNull(listingdocument.FieldStorageFormat)
+	return u
+}
+
+// SetUploadedBy sets the "uploaded_by" field.
+func (u *ListingDocumentUpsert) SetUploadedBy(v string) *ListingDocumentUpsert {
+	u.Set(listingdocument.FieldUploadedBy, v)
+	return u
+}
+
+// UpdateUploadedBy sets the "uploaded_by" field to the value that was provided on create.
+func (u *ListingDocumentUpsert) UpdateUploadedBy() *ListingDocumentUpsert {
+	u.SetExcluded(listingdocument.FieldUploadedBy)
+	return u
+}
+
+// ClearUploadedBy clears the value of the "uploaded_by" field.
+func (u *ListingDocumentUpsert) ClearUploadedBy() *ListingDocumentUpsert {
+	u.SetNull(listingdocument.FieldUploadedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ListingDocument.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listingdocument.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingDocumentUpsertOne) UpdateNewValues() *ListingDocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(listingdocument.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(listingdocument.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ListingDocument.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ListingDocumentUpsertOne) Ignore() *ListingDocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingDocumentUpsertOne) DoNothing() *ListingDocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingDocumentCreate.OnConflict
+// documentation for more info.
+func (u *ListingDocumentUpsertOne) Update(set func(*ListingDocumentUpsert)) *ListingDocumentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingDocumentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingDocumentUpsertOne) SetUpdateTime(v time.Time) *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingDocumentUpsertOne) UpdateUpdateTime() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingDocumentUpsertOne) SetListingID(v uuid.UUID) *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingDocumentUpsertOne) UpdateListingID() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ListingDocumentUpsertOne) SetTitle(v string) *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ListingDocumentUpsertOne) UpdateTitle() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ListingDocumentUpsertOne) SetKind(v listingdocument.Kind) *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ListingDocumentUpsertOne) UpdateKind() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetAccessLevel sets the "access_level" field.
+func (u *ListingDocumentUpsertOne) SetAccessLevel(v listingdocument.AccessLevel) *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetAccessLevel(v)
+	})
+}
+
+// UpdateAccessLevel sets the "access_level" field to the value that was provided on create.
+func (u *ListingDocumentUpsertOne) UpdateAccessLevel() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateAccessLevel()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *ListingDocumentUpsertOne) SetFilename(v string) *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *ListingDocumentUpsertOne) UpdateFilename() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *ListingDocumentUpsertOne) SetSizeBytes(v int64) *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *ListingDocumentUpsertOne) AddSizeBytes(v int64) *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *ListingDocumentUpsertOne) UpdateSizeBytes() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// SetStorageID sets the "storage_id" field.
+func (u *ListingDocumentUpsertOne) SetStorageID(v string) *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetStorageID(v)
+	})
+}
+
+// UpdateStorageID sets the "storage_id" field to the value that was provided on create.
+func (u *ListingDocumentUpsertOne) UpdateStorageID() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateStorageID()
+	})
+}
+
+// SetStorageFormat sets the "storage_format" field.
+func (u *ListingDocumentUpsertOne) SetStorageFormat(v string) *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetStorageFormat(v)
+	})
+}
+
+// UpdateStorageFormat sets the "storage_format" field to the value that was provided on create.
+func (u *ListingDocumentUpsertOne) UpdateStorageFormat() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateStorageFormat()
+	})
+}
+
+// ClearStorageFormat clears the value of the "storage_format" field.
+func (u *ListingDocumentUpsertOne) ClearStorageFormat() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.ClearStorageFormat()
+	})
+}
+
+// SetUploadedBy sets the "uploaded_by" field.
+func (u *ListingDocumentUpsertOne) SetUploadedBy(v string) *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetUploadedBy(v)
+	})
+}
+
+// UpdateUploadedBy sets the "uploaded_by" field to the value that was provided on create.
+func (u *ListingDocumentUpsertOne) UpdateUploadedBy() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateUploadedBy()
+	})
+}
+
+// ClearUploadedBy clears the value of the "uploaded_by" field.
+func (u *ListingDocumentUpsertOne) ClearUploadedBy() *ListingDocumentUpsertOne {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.ClearUploadedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingDocumentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingDocumentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingDocumentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ListingDocumentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ListingDocumentUpsertOne.ID is not supported by MySQL driver. Use ListingDocumentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ListingDocumentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ListingDocumentCreateBulk is the builder for creating many ListingDocument entities in bulk.
+type ListingDocumentCreateBulk struct {
+	config
+	err      error
+	builders []*ListingDocumentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ListingDocument entities in the database.
+func (_c *ListingDocumentCreateBulk) Save(ctx context.Context) ([]*ListingDocument, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ListingDocument, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ListingDocumentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ListingDocumentCreateBulk) SaveX(ctx context.Context) []*ListingDocument {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListingDocumentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListingDocumentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ListingDocument.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingDocumentUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingDocumentCreateBulk) OnConflict(opts ...sql.ConflictOption) *ListingDocumentUpsertBulk {
+	_c.conflict = opts
+	return &ListingDocumentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ListingDocument.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingDocumentCreateBulk) OnConflictColumns(columns ...string) *ListingDocumentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingDocumentUpsertBulk{
+		create: _c,
+	}
+}
+
+// ListingDocumentUpsertBulk is the builder for "upsert"-ing
+// a bulk of ListingDocument nodes.
+type ListingDocumentUpsertBulk struct {
+	create *ListingDocumentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ListingDocument.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listingdocument.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingDocumentUpsertBulk) UpdateNewValues() *ListingDocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(listingdocument.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(listingdocument.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ListingDocument.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ListingDocumentUpsertBulk) Ignore() *ListingDocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingDocumentUpsertBulk) DoNothing() *ListingDocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingDocumentCreateBulk.OnConflict
+// documentation for more info.
+func (u *ListingDocumentUpsertBulk) Update(set func(*ListingDocumentUpsert)) *ListingDocumentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingDocumentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingDocumentUpsertBulk) SetUpdateTime(v time.Time) *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingDocumentUpsertBulk) UpdateUpdateTime() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingDocumentUpsertBulk) SetListingID(v uuid.UUID) *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingDocumentUpsertBulk) UpdateListingID() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *ListingDocumentUpsertBulk) SetTitle(v string) *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *ListingDocumentUpsertBulk) UpdateTitle() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ListingDocumentUpsertBulk) SetKind(v listingdocument.Kind) *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ListingDocumentUpsertBulk) UpdateKind() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetAccessLevel sets the "access_level" field.
+func (u *ListingDocumentUpsertBulk) SetAccessLevel(v listingdocument.AccessLevel) *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetAccessLevel(v)
+	})
+}
+
+// UpdateAccessLevel sets the "access_level" field to the value that was provided on create.
+func (u *ListingDocumentUpsertBulk) UpdateAccessLevel() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateAccessLevel()
+	})
+}
+
+// SetFilename sets the "filename" field.
+func (u *ListingDocumentUpsertBulk) SetFilename(v string) *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetFilename(v)
+	})
+}
+
+// UpdateFilename sets the "filename" field to the value that was provided on create.
+func (u *ListingDocumentUpsertBulk) UpdateFilename() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateFilename()
+	})
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *ListingDocumentUpsertBulk) SetSizeBytes(v int64) *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *ListingDocumentUpsertBulk) AddSizeBytes(v int64) *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *ListingDocumentUpsertBulk) UpdateSizeBytes() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// SetStorageID sets the "storage_id" field.
+func (u *ListingDocumentUpsertBulk) SetStorageID(v string) *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetStorageID(v)
+	})
+}
+
+// UpdateStorageID sets the "storage_id" field to the value that was provided on create.
+func (u *ListingDocumentUpsertBulk) UpdateStorageID() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateStorageID()
+	})
+}
+
+// SetStorageFormat sets the "storage_format" field.
+func (u *ListingDocumentUpsertBulk) SetStorageFormat(v string) *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetStorageFormat(v)
+	})
+}
+
+// UpdateStorageFormat sets the "storage_format" field to the value that was provided on create.
+func (u *ListingDocumentUpsertBulk) UpdateStorageFormat() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateStorageFormat()
+	})
+}
+
+// ClearStorageFormat clears the value of the "storage_format" field.
+func (u *ListingDocumentUpsertBulk) ClearStorageFormat() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.ClearStorageFormat()
+	})
+}
+
+// SetUploadedBy sets the "uploaded_by" field.
+func (u *ListingDocumentUpsertBulk) SetUploadedBy(v string) *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.SetUploadedBy(v)
+	})
+}
+
+// UpdateUploadedBy sets the "uploaded_by" field to the value that was provided on create.
+func (u *ListingDocumentUpsertBulk) UpdateUploadedBy() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.UpdateUploadedBy()
+	})
+}
+
+// ClearUploadedBy clears the value of the "uploaded_by" field.
+func (u *ListingDocumentUpsertBulk) ClearUploadedBy() *ListingDocumentUpsertBulk {
+	return u.Update(func(s *ListingDocumentUpsert) {
+		s.ClearUploadedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingDocumentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ListingDocumentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingDocumentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingDocumentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
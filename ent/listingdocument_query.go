@@ -0,0 +1,726 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ListingDocumentQuery is the builder for querying ListingDocument entities.
+type ListingDocumentQuery struct {
+	config
+	ctx           *QueryContext
+	order         []listingdocument.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.ListingDocument
+	withListing   *ListingQuery
+	withDownloads *DocumentDownloadQuery
+	modifiers     []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ListingDocumentQuery builder.
+func (_q *ListingDocumentQuery) Where(ps ...predicate.ListingDocument) *ListingDocumentQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *ListingDocumentQuery) Limit(limit int) *ListingDocumentQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *ListingDocumentQuery) Offset(offset int) *ListingDocumentQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *ListingDocumentQuery) Unique(unique bool) *ListingDocumentQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *ListingDocumentQuery) Order(o ...listingdocument.OrderOption) *ListingDocumentQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryListing chains the current query on the "listing" edge.
+func (_q *ListingDocumentQuery) QueryListing() *ListingQuery {
+	query := (&ListingClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listingdocument.Table, listingdocument.FieldID, selector),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, listingdocument.ListingTable, listingdocument.ListingColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDownloads chains the current query on the "downloads" edge.
+func (_q *ListingDocumentQuery) QueryDownloads() *DocumentDownloadQuery {
+	query := (&DocumentDownloadClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(listingdocument.Table, listingdocument.FieldID, selector),
+			sqlgraph.To(documentdownload.Table, documentdownload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, listingdocument.DownloadsTable, listingdocument.DownloadsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ListingDocument entity from the query.
+// Returns a *NotFoundError when no ListingDocument was found.
+func (_q *ListingDocumentQuery) First(ctx context.Context) (*ListingDocument, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{listingdocument.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *ListingDocumentQuery) FirstX(ctx context.Context) *ListingDocument {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ListingDocument ID from the query.
+// Returns a *NotFoundError when no ListingDocument ID was found.
+func (_q *ListingDocumentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{listingdocument.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *ListingDocumentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ListingDocument entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ListingDocument entity is found.
+// Returns a *NotFoundError when no ListingDocument entities are found.
+func (_q *ListingDocumentQuery) Only(ctx context.Context) (*ListingDocument, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{listingdocument.Label}
+	default:
+		return nil, &NotSingularError{listingdocument.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *ListingDocumentQuery) OnlyX(ctx context.Context) *ListingDocument {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ListingDocument ID in the query.
+// Returns a *NotSingularError when more than one ListingDocument ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *ListingDocumentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{listingdocument.Label}
+	default:
+		err = &NotSingularError{listingdocument.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *ListingDocumentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ListingDocuments.
+func (_q *ListingDocumentQuery) All(ctx context.Context) ([]*ListingDocument, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ListingDocument, *ListingDocumentQuery]()
+	return withInterceptors[[]*ListingDocument](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *ListingDocumentQuery) AllX(ctx context.Context) []*ListingDocument {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ListingDocument IDs.
+func (_q *ListingDocumentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(listingdocument.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *ListingDocumentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *ListingDocumentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*ListingDocumentQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *ListingDocumentQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *ListingDocumentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *ListingDocumentQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ListingDocumentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *ListingDocumentQuery) Clone() *ListingDocumentQuery {
+	if _q == nil {
+		return nil
+	}
+	return &ListingDocumentQuery{
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]listingdocument.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.ListingDocument{}, _q.predicates...),
+		withListing:   _q.withListing.Clone(),
+		withDownloads: _q.withDownloads.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithListing tells the query-builder to eager-load the nodes that are connected to
+// the "listing" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingDocumentQuery) WithListing(opts ...func(*ListingQuery)) *ListingDocumentQuery {
+	query := (&ListingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withListing = query
+	return _q
+}
+
+// WithDownloads tells the query-builder to eager-load the nodes that are connected to
+// the "downloads" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ListingDocumentQuery) WithDownloads(opts ...func(*DocumentDownloadQuery)) *ListingDocumentQuery {
+	query := (&DocumentDownloadClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDownloads = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ListingDocument.Query().
+//		GroupBy(listingdocument.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *ListingDocumentQuery) GroupBy(field string, fields ...string) *ListingDocumentGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ListingDocumentGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = listingdocument.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ListingDocument.Query().
+//		Select(listingdocument.FieldCreateTime).
+//		Scan(ctx, &v)
+func (_q *ListingDocumentQuery) Select(fields ...string) *ListingDocumentSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ListingDocumentSelect{ListingDocumentQuery: _q}
+	sbuild.label = listingdocument.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ListingDocumentSelect configured with the given aggregations.
+func (_q *ListingDocumentQuery) Aggregate(fns ...AggregateFunc) *ListingDocumentSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *ListingDocumentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !listingdocument.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	if listingdocument.Policy == nil {
+		return errors.New("ent: uninitialized listingdocument.Policy (forgotten import ent/runtime?)")
+	}
+	if err := listingdocument.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (_q *ListingDocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ListingDocument, error) {
+	var (
+		nodes       = []*ListingDocument{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withListing != nil,
+			_q.withDownloads != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ListingDocument).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ListingDocument{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withListing; query != nil {
+		if err := _q.loadListing(ctx, query, nodes, nil,
+			func(n *ListingDocument, e *Listing) { n.Edges.Listing = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDownloads; query != nil {
+		if err := _q.loadDownloads(ctx, query, nodes,
+			func(n *ListingDocument) { n.Edges.Downloads = []*DocumentDownload{} },
+			func(n *ListingDocument, e *DocumentDownload) { n.Edges.Downloads = append(n.Edges.Downloads, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *ListingDocumentQuery) loadListing(ctx context.Context, query *ListingQuery, nodes []*ListingDocument, init func(*ListingDocument), assign func(*ListingDocument, *Listing)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ListingDocument)
+	for i := range nodes {
+		fk := nodes[i].ListingID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(listing.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "listing_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ListingDocumentQuery) loadDownloads(ctx context.Context, query *DocumentDownloadQuery, nodes []*ListingDocument, init func(*ListingDocument), assign func(*ListingDocument, *DocumentDownload)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ListingDocument)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(documentdownload.FieldDocumentID)
+	}
+	query.Where(predicate.DocumentDownload(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(listingdocument.DownloadsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DocumentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "document_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *ListingDocumentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *ListingDocumentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(listingdocument.Table, listingdocument.Columns, sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, listingdocument.FieldID)
+		for i := range fields {
+			if fields[i] != listingdocument.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withListing != nil {
+			_spec.Node.AddColumnOnce(listingdocument.FieldListingID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *ListingDocumentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(listingdocument.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = listingdocument.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *ListingDocumentQuery) ForUpdate(opts ...sql.LockOption) *ListingDocumentQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *ListingDocumentQuery) ForShare(opts ...sql.LockOption) *ListingDocumentQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// ListingDocumentGroupBy is the group-by builder for ListingDocument entities.
+type ListingDocumentGroupBy struct {
+	selector
+	build *ListingDocumentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *ListingDocumentGroupBy) Aggregate(fns ...AggregateFunc) *ListingDocumentGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *ListingDocumentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ListingDocumentQuery, *ListingDocumentGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *ListingDocumentGroupBy) sqlScan(ctx context.Context, root *ListingDocumentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ListingDocumentSelect is the builder for selecting fields of ListingDocument entities.
+type ListingDocumentSelect struct {
+	*ListingDocumentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *ListingDocumentSelect) Aggregate(fns ...AggregateFunc) *ListingDocumentSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *ListingDocumentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ListingDocumentQuery, *ListingDocumentSelect](ctx, _s.ListingDocumentQuery, _s, _s.inters, v)
+}
+
+func (_s *ListingDocumentSelect) sqlScan(ctx context.Context, root *ListingDocumentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,927 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ListingDocumentUpdate is the builder for updating ListingDocument entities.
+type ListingDocumentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ListingDocumentMutation
+}
+
+// Where appends a list predicates to the ListingDocumentUpdate builder.
+func (_u *ListingDocumentUpdate) Where(ps ...predicate.ListingDocument) *ListingDocumentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ListingDocumentUpdate) SetUpdateTime(v time.Time) *ListingDocumentUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *ListingDocumentUpdate) SetListingID(v uuid.UUID) *ListingDocumentUpdate {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *ListingDocumentUpdate) SetNillableListingID(v *uuid.UUID) *ListingDocumentUpdate {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ListingDocumentUpdate) SetTitle(v string) *ListingDocumentUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ListingDocumentUpdate) SetNillableTitle(v *string) *ListingDocumentUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ListingDocumentUpdate) SetKind(v listingdocument.Kind) *ListingDocumentUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ListingDocumentUpdate) SetNillableKind(v *listingdocument.Kind) *ListingDocumentUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAccessLevel sets the "access_level" field.
+func (_u *ListingDocumentUpdate) SetAccessLevel(v listingdocument.AccessLevel) *ListingDocumentUpdate {
+	_u.mutation.SetAccessLevel(v)
+	return _u
+}
+
+// SetNillableAccessLevel sets the "access_level" field if the given value is not nil.
+func (_u *ListingDocumentUpdate) SetNillableAccessLevel(v *listingdocument.AccessLevel) *ListingDocumentUpdate {
+	if v != nil {
+		_u.SetAccessLevel(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *ListingDocumentUpdate) SetFilename(v string) *ListingDocumentUpdate {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *ListingDocumentUpdate) SetNillableFilename(v *string) *ListingDocumentUpdate {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *ListingDocumentUpdate) SetSizeBytes(v int64) *ListingDocumentUpdate {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *ListingDocumentUpdate) SetNillableSizeBytes(v *int64) *ListingDocumentUpdate {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *ListingDocumentUpdate) AddSizeBytes(v int64) *ListingDocumentUpdate {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// SetStorageID sets the "storage_id" field.
+func (_u *ListingDocumentUpdate) SetStorageID(v string) *ListingDocumentUpdate {
+	_u.mutation.SetStorageID(v)
+	return _u
+}
+
+// SetNillableStorageID sets the "storage_id" field if the given value is not nil.
+func (_u *ListingDocumentUpdate) SetNillableStorageID(v *string) *ListingDocumentUpdate {
+	if v != nil {
+		_u.SetStorageID(*v)
+	}
+	return _u
+}
+
+// SetStorageFormat sets the "storage_format" field.
+func (_u *ListingDocumentUpdate) SetStorageFormat(v string) *ListingDocumentUpdate {
+	_u.mutation.SetStorageFormat(v)
+	return _u
+}
+
+// SetNillableStorageFormat sets the "storage_format" field if the given value is not nil.
+func (_u *ListingDocumentUpdate) SetNillableStorageFormat(v *string) *ListingDocumentUpdate {
+	if v != nil {
+		_u.SetStorageFormat(*v)
+	}
+	return _u
+}
+
+// ClearStorageFormat clears the value of the "storage_format" field.
+func (_u *ListingDocumentUpdate) ClearStorageFormat() *ListingDocumentUpdate {
+	_u.mutation.ClearStorageFormat()
+	return _u
+}
+
+// SetUploadedBy sets the "uploaded_by" field.
+func (_u *ListingDocumentUpdate) SetUploadedBy(v string) *ListingDocumentUpdate {
+	_u.mutation.SetUploadedBy(v)
+	return _u
+}
+
+// SetNillableUploadedBy sets the "uploaded_by" field if the given value is not nil.
+func (_u *ListingDocumentUpdate) SetNillableUploadedBy(v *string) *ListingDocumentUpdate {
+	if v != nil {
+		_u.SetUploadedBy(*v)
+	}
+	return _u
+}
+
+// ClearUploadedBy clears the value of the "uploaded_by" field.
+func (_u *ListingDocumentUpdate) ClearUploadedBy() *ListingDocumentUpdate {
+	_u.mutation.ClearUploadedBy()
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *ListingDocumentUpdate) SetListing(v *Listing) *ListingDocumentUpdate {
+	return _u.SetListingID(v.ID)
+}
+
+// AddDownloadIDs adds the "downloads" edge to the DocumentDownload entity by IDs.
+func (_u *ListingDocumentUpdate) AddDownloadIDs(ids ...uuid.UUID) *ListingDocumentUpdate {
+	_u.mutation.AddDownloadIDs(ids...)
+	return _u
+}
+
+// AddDownloads adds the "downloads" edges to the DocumentDownload entity.
+func (_u *ListingDocumentUpdate) AddDownloads(v ...*DocumentDownload) *ListingDocumentUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDownloadIDs(ids...)
+}
+
+// Mutation returns the ListingDocumentMutation object of the builder.
+func (_u *ListingDocumentUpdate) Mutation() *ListingDocumentMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *ListingDocumentUpdate) ClearListing() *ListingDocumentUpdate {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// ClearDownloads clears all "downloads" edges to the DocumentDownload entity.
+func (_u *ListingDocumentUpdate) ClearDownloads() *ListingDocumentUpdate {
+	_u.mutation.ClearDownloads()
+	return _u
+}
+
+// RemoveDownloadIDs removes the "downloads" edge to DocumentDownload entities by IDs.
+func (_u *ListingDocumentUpdate) RemoveDownloadIDs(ids ...uuid.UUID) *ListingDocumentUpdate {
+	_u.mutation.RemoveDownloadIDs(ids...)
+	return _u
+}
+
+// RemoveDownloads removes "downloads" edges to DocumentDownload entities.
+func (_u *ListingDocumentUpdate) RemoveDownloads(v ...*DocumentDownload) *ListingDocumentUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDownloadIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ListingDocumentUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListingDocumentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ListingDocumentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListingDocumentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ListingDocumentUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if listingdocument.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listingdocument.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := listingdocument.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListingDocumentUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := listingdocument.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := listingdocument.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccessLevel(); ok {
+		if err := listingdocument.AccessLevelValidator(v); err != nil {
+			return &ValidationError{Name: "access_level", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.access_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := listingdocument.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.filename": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SizeBytes(); ok {
+		if err := listingdocument.SizeBytesValidator(v); err != nil {
+			return &ValidationError{Name: "size_bytes", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.size_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageID(); ok {
+		if err := listingdocument.StorageIDValidator(v); err != nil {
+			return &ValidationError{Name: "storage_id", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.storage_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageFormat(); ok {
+		if err := listingdocument.StorageFormatValidator(v); err != nil {
+			return &ValidationError{Name: "storage_format", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.storage_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UploadedBy(); ok {
+		if err := listingdocument.UploadedByValidator(v); err != nil {
+			return &ValidationError{Name: "uploaded_by", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.uploaded_by": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingDocument.listing"`)
+	}
+	return nil
+}
+
+func (_u *ListingDocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listingdocument.Table, listingdocument.Columns, sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(listingdocument.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(listingdocument.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(listingdocument.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AccessLevel(); ok {
+		_spec.SetField(listingdocument.FieldAccessLevel, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(listingdocument.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(listingdocument.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(listingdocument.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StorageID(); ok {
+		_spec.SetField(listingdocument.FieldStorageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StorageFormat(); ok {
+		_spec.SetField(listingdocument.FieldStorageFormat, field.TypeString, value)
+	}
+	if _u.mutation.StorageFormatCleared() {
+		_spec.ClearField(listingdocument.FieldStorageFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.UploadedBy(); ok {
+		_spec.SetField(listingdocument.FieldUploadedBy, field.TypeString, value)
+	}
+	if _u.mutation.UploadedByCleared() {
+		_spec.ClearField(listingdocument.FieldUploadedBy, field.TypeString)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingdocument.ListingTable,
+			Columns: []string{listingdocument.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingdocument.ListingTable,
+			Columns: []string{listingdocument.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DownloadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listingdocument.DownloadsTable,
+			Columns: []string{listingdocument.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDownloadsIDs(); len(nodes) > 0 && !_u.mutation.DownloadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listingdocument.DownloadsTable,
+			Columns: []string{listingdocument.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DownloadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listingdocument.DownloadsTable,
+			Columns: []string{listingdocument.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listingdocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ListingDocumentUpdateOne is the builder for updating a single ListingDocument entity.
+type ListingDocumentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ListingDocumentMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ListingDocumentUpdateOne) SetUpdateTime(v time.Time) *ListingDocumentUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *ListingDocumentUpdateOne) SetListingID(v uuid.UUID) *ListingDocumentUpdateOne {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *ListingDocumentUpdateOne) SetNillableListingID(v *uuid.UUID) *ListingDocumentUpdateOne {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *ListingDocumentUpdateOne) SetTitle(v string) *ListingDocumentUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *ListingDocumentUpdateOne) SetNillableTitle(v *string) *ListingDocumentUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ListingDocumentUpdateOne) SetKind(v listingdocument.Kind) *ListingDocumentUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ListingDocumentUpdateOne) SetNillableKind(v *listingdocument.Kind) *ListingDocumentUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetAccessLevel sets the "access_level" field.
+func (_u *ListingDocumentUpdateOne) SetAccessLevel(v listingdocument.AccessLevel) *ListingDocumentUpdateOne {
+	_u.mutation.SetAccessLevel(v)
+	return _u
+}
+
+// SetNillableAccessLevel sets the "access_level" field if the given value is not nil.
+func (_u *ListingDocumentUpdateOne) SetNillableAccessLevel(v *listingdocument.AccessLevel) *ListingDocumentUpdateOne {
+	if v != nil {
+		_u.SetAccessLevel(*v)
+	}
+	return _u
+}
+
+// SetFilename sets the "filename" field.
+func (_u *ListingDocumentUpdateOne) SetFilename(v string) *ListingDocumentUpdateOne {
+	_u.mutation.SetFilename(v)
+	return _u
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (_u *ListingDocumentUpdateOne) SetNillableFilename(v *string) *ListingDocumentUpdateOne {
+	if v != nil {
+		_u.SetFilename(*v)
+	}
+	return _u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (_u *ListingDocumentUpdateOne) SetSizeBytes(v int64) *ListingDocumentUpdateOne {
+	_u.mutation.ResetSizeBytes()
+	_u.mutation.SetSizeBytes(v)
+	return _u
+}
+
+// SetNillableSizeBytes sets the "size_bytes" field if the given value is not nil.
+func (_u *ListingDocumentUpdateOne) SetNillableSizeBytes(v *int64) *ListingDocumentUpdateOne {
+	if v != nil {
+		_u.SetSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddSizeBytes adds value to the "size_bytes" field.
+func (_u *ListingDocumentUpdateOne) AddSizeBytes(v int64) *ListingDocumentUpdateOne {
+	_u.mutation.AddSizeBytes(v)
+	return _u
+}
+
+// SetStorageID sets the "storage_id" field.
+func (_u *ListingDocumentUpdateOne) SetStorageID(v string) *ListingDocumentUpdateOne {
+	_u.mutation.SetStorageID(v)
+	return _u
+}
+
+// SetNillableStorageID sets the "storage_id" field if the given value is not nil.
+func (_u *ListingDocumentUpdateOne) SetNillableStorageID(v *string) *ListingDocumentUpdateOne {
+	if v != nil {
+		_u.SetStorageID(*v)
+	}
+	return _u
+}
+
+// SetStorageFormat sets the "storage_format" field.
+func (_u *ListingDocumentUpdateOne) SetStorageFormat(v string) *ListingDocumentUpdateOne {
+	_u.mutation.SetStorageFormat(v)
+	return _u
+}
+
+// SetNillableStorageFormat sets the "storage_format" field if the given value is not nil.
+func (_u *ListingDocumentUpdateOne) SetNillableStorageFormat(v *string) *ListingDocumentUpdateOne {
+	if v != nil {
+		_u.SetStorageFormat(*v)
+	}
+	return _u
+}
+
+// ClearStorageFormat clears the value of the "storage_format" field.
+func (_u *ListingDocumentUpdateOne) ClearStorageFormat() *ListingDocumentUpdateOne {
+	_u.mutation.ClearStorageFormat()
+	return _u
+}
+
+// SetUploadedBy sets the "uploaded_by" field.
+func (_u *ListingDocumentUpdateOne) SetUploadedBy(v string) *ListingDocumentUpdateOne {
+	_u.mutation.SetUploadedBy(v)
+	return _u
+}
+
+// SetNillableUploadedBy sets the "uploaded_by" field if the given value is not nil.
+func (_u *ListingDocumentUpdateOne) SetNillableUploadedBy(v *string) *ListingDocumentUpdateOne {
+	if v != nil {
+		_u.SetUploadedBy(*v)
+	}
+	return _u
+}
+
+// ClearUploadedBy clears the value of the "uploaded_by" field.
+func (_u *ListingDocumentUpdateOne) ClearUploadedBy() *ListingDocumentUpdateOne {
+	_u.mutation.ClearUploadedBy()
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *ListingDocumentUpdateOne) SetListing(v *Listing) *ListingDocumentUpdateOne {
+	return _u.SetListingID(v.ID)
+}
+
+// AddDownloadIDs adds the "downloads" edge to the DocumentDownload entity by IDs.
+func (_u *ListingDocumentUpdateOne) AddDownloadIDs(ids ...uuid.UUID) *ListingDocumentUpdateOne {
+	_u.mutation.AddDownloadIDs(ids...)
+	return _u
+}
+
+// AddDownloads adds the "downloads" edges to the DocumentDownload entity.
+func (_u *ListingDocumentUpdateOne) AddDownloads(v ...*DocumentDownload) *ListingDocumentUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDownloadIDs(ids...)
+}
+
+// Mutation returns the ListingDocumentMutation object of the builder.
+func (_u *ListingDocumentUpdateOne) Mutation() *ListingDocumentMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *ListingDocumentUpdateOne) ClearListing() *ListingDocumentUpdateOne {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// ClearDownloads clears all "downloads" edges to the DocumentDownload entity.
+func (_u *ListingDocumentUpdateOne) ClearDownloads() *ListingDocumentUpdateOne {
+	_u.mutation.ClearDownloads()
+	return _u
+}
+
+// RemoveDownloadIDs removes the "downloads" edge to DocumentDownload entities by IDs.
+func (_u *ListingDocumentUpdateOne) RemoveDownloadIDs(ids ...uuid.UUID) *ListingDocumentUpdateOne {
+	_u.mutation.RemoveDownloadIDs(ids...)
+	return _u
+}
+
+// RemoveDownloads removes "downloads" edges to DocumentDownload entities.
+func (_u *ListingDocumentUpdateOne) RemoveDownloads(v ...*DocumentDownload) *ListingDocumentUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDownloadIDs(ids...)
+}
+
+// Where appends a list predicates to the ListingDocumentUpdate builder.
+func (_u *ListingDocumentUpdateOne) Where(ps ...predicate.ListingDocument) *ListingDocumentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ListingDocumentUpdateOne) Select(field string, fields ...string) *ListingDocumentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ListingDocument entity.
+func (_u *ListingDocumentUpdateOne) Save(ctx context.Context) (*ListingDocument, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListingDocumentUpdateOne) SaveX(ctx context.Context) *ListingDocument {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ListingDocumentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListingDocumentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ListingDocumentUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if listingdocument.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listingdocument.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := listingdocument.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListingDocumentUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := listingdocument.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := listingdocument.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccessLevel(); ok {
+		if err := listingdocument.AccessLevelValidator(v); err != nil {
+			return &ValidationError{Name: "access_level", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.access_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Filename(); ok {
+		if err := listingdocument.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.filename": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SizeBytes(); ok {
+		if err := listingdocument.SizeBytesValidator(v); err != nil {
+			return &ValidationError{Name: "size_bytes", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.size_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageID(); ok {
+		if err := listingdocument.StorageIDValidator(v); err != nil {
+			return &ValidationError{Name: "storage_id", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.storage_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageFormat(); ok {
+		if err := listingdocument.StorageFormatValidator(v); err != nil {
+			return &ValidationError{Name: "storage_format", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.storage_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UploadedBy(); ok {
+		if err := listingdocument.UploadedByValidator(v); err != nil {
+			return &ValidationError{Name: "uploaded_by", err: fmt.Errorf(`ent: validator failed for field "ListingDocument.uploaded_by": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingDocument.listing"`)
+	}
+	return nil
+}
+
+func (_u *ListingDocumentUpdateOne) sqlSave(ctx context.Context) (_node *ListingDocument, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listingdocument.Table, listingdocument.Columns, sqlgraph.NewFieldSpec(listingdocument.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ListingDocument.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, listingdocument.FieldID)
+		for _, f := range fields {
+			if !listingdocument.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != listingdocument.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(listingdocument.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(listingdocument.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(listingdocument.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AccessLevel(); ok {
+		_spec.SetField(listingdocument.FieldAccessLevel, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Filename(); ok {
+		_spec.SetField(listingdocument.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SizeBytes(); ok {
+		_spec.SetField(listingdocument.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSizeBytes(); ok {
+		_spec.AddField(listingdocument.FieldSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StorageID(); ok {
+		_spec.SetField(listingdocument.FieldStorageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StorageFormat(); ok {
+		_spec.SetField(listingdocument.FieldStorageFormat, field.TypeString, value)
+	}
+	if _u.mutation.StorageFormatCleared() {
+		_spec.ClearField(listingdocument.FieldStorageFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.UploadedBy(); ok {
+		_spec.SetField(listingdocument.FieldUploadedBy, field.TypeString, value)
+	}
+	if _u.mutation.UploadedByCleared() {
+		_spec.ClearField(listingdocument.FieldUploadedBy, field.TypeString)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingdocument.ListingTable,
+			Columns: []string{listingdocument.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingdocument.ListingTable,
+			Columns: []string{listingdocument.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DownloadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listingdocument.DownloadsTable,
+			Columns: []string{listingdocument.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDownloadsIDs(); len(nodes) > 0 && !_u.mutation.DownloadsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listingdocument.DownloadsTable,
+			Columns: []string{listingdocument.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DownloadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   listingdocument.DownloadsTable,
+			Columns: []string{listingdocument.DownloadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentdownload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ListingDocument{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listingdocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/user"
+)
+
+// ListingInquiry is the model entity for the ListingInquiry schema.
+type ListingInquiry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// ListingID holds the value of the "listing_id" field.
+	ListingID uuid.UUID `json:"listing_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// Status holds the value of the "status" field.
+	Status listinginquiry.Status `json:"status,omitempty"`
+	// DecidedAt holds the value of the "decided_at" field.
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ListingInquiryQuery when eager-loading is set.
+	Edges        ListingInquiryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ListingInquiryEdges holds the relations/edges for other nodes in the graph.
+type ListingInquiryEdges struct {
+	// Listing holds the value of the listing edge.
+	Listing *Listing `json:"listing,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ListingOrErr returns the Listing value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingInquiryEdges) ListingOrErr() (*Listing, error) {
+	if e.Listing != nil {
+		return e.Listing, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: listing.Label}
+	}
+	return nil, &NotLoadedError{edge: "listing"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingInquiryEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ListingInquiry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case listinginquiry.FieldMessage, listinginquiry.FieldStatus:
+			values[i] = new(sql.NullString)
+		case listinginquiry.FieldCreateTime, listinginquiry.FieldUpdateTime, listinginquiry.FieldDecidedAt:
+			values[i] = new(sql.NullTime)
+		case listinginquiry.FieldID, listinginquiry.FieldListingID, listinginquiry.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ListingInquiry fields.
+func (_m *ListingInquiry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case listinginquiry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case listinginquiry.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case listinginquiry.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case listinginquiry.FieldListingID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field listing_id", values[i])
+			} else if value != nil {
+				_m.ListingID = *value
+			}
+		case listinginquiry.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case listinginquiry.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case listinginquiry.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = listinginquiry.Status(value.String)
+			}
+		case listinginquiry.FieldDecidedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_at", values[i])
+			} else if value.Valid {
+				_m.DecidedAt = new(time.Time)
+				*_m.DecidedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ListingInquiry.
+// This includes values selected through modifiers, order, etc.
+func (_m *ListingInquiry) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryListing queries the "listing" edge of the ListingInquiry entity.
+func (_m *ListingInquiry) QueryListing() *ListingQuery {
+	return NewListingInquiryClient(_m.config).QueryListing(_m)
+}
+
+// QueryUser queries the "user" edge of the ListingInquiry entity.
+func (_m *ListingInquiry) QueryUser() *UserQuery {
+	return NewListingInquiryClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this ListingInquiry.
+// Note that you need to call ListingInquiry.Unwrap() before calling this method if this ListingInquiry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ListingInquiry) Update() *ListingInquiryUpdateOne {
+	return NewListingInquiryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ListingInquiry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ListingInquiry) Unwrap() *ListingInquiry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ListingInquiry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ListingInquiry) String() string {
+	var builder strings.Builder
+	builder.WriteString("ListingInquiry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("listing_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ListingID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.DecidedAt; v != nil {
+		builder.WriteString("decided_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ListingInquiries is a parsable slice of ListingInquiry.
+type ListingInquiries []*ListingInquiry
@@ -0,0 +1,945 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/user"
+)
+
+// ListingInquiryCreate is the builder for creating a ListingInquiry entity.
+type ListingInquiryCreate struct {
+	config
+	mutation *ListingInquiryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ListingInquiryCreate) SetCreateTime(v time.Time) *ListingInquiryCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ListingInquiryCreate) SetNillableCreateTime(v *time.Time) *ListingInquiryCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ListingInquiryCreate) SetUpdateTime(v time.Time) *ListingInquiryCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ListingInquiryCreate) SetNillableUpdateTime(v *time.Time) *ListingInquiryCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetListingID sets the "listing_id" field.
+func (_c *ListingInquiryCreate) SetListingID(v uuid.UUID) *ListingInquiryCreate {
+	_c.mutation.SetListingID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ListingInquiryCreate) SetUserID(v uuid.UUID) *ListingInquiryCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *ListingInquiryCreate) SetMessage(v string) *ListingInquiryCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *ListingInquiryCreate) SetNillableMessage(v *string) *ListingInquiryCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ListingInquiryCreate) SetStatus(v listinginquiry.Status) *ListingInquiryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ListingInquiryCreate) SetNillableStatus(v *listinginquiry.Status) *ListingInquiryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_c *ListingInquiryCreate) SetDecidedAt(v time.Time) *ListingInquiryCreate {
+	_c.mutation.SetDecidedAt(v)
+	return _c
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_c *ListingInquiryCreate) SetNillableDecidedAt(v *time.Time) *ListingInquiryCreate {
+	if v != nil {
+		_c.SetDecidedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ListingInquiryCreate) SetID(v uuid.UUID) *ListingInquiryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ListingInquiryCreate) SetNillableID(v *uuid.UUID) *ListingInquiryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_c *ListingInquiryCreate) SetListing(v *Listing) *ListingInquiryCreate {
+	return _c.SetListingID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ListingInquiryCreate) SetUser(v *User) *ListingInquiryCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ListingInquiryMutation object of the builder.
+func (_c *ListingInquiryCreate) Mutation() *ListingInquiryMutation {
+	return _c.mutation
+}
+
+// Save creates the ListingInquiry in the database.
+func (_c *ListingInquiryCreate) Save(ctx context.Context) (*ListingInquiry, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ListingInquiryCreate) SaveX(ctx context.Context) *ListingInquiry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListingInquiryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListingInquiryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ListingInquiryCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if listinginquiry.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized listinginquiry.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := listinginquiry.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if listinginquiry.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listinginquiry.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := listinginquiry.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := listinginquiry.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if listinginquiry.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized listinginquiry.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := listinginquiry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ListingInquiryCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ListingInquiry.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ListingInquiry.update_time"`)}
+	}
+	if _, ok := _c.mutation.ListingID(); !ok {
+		return &ValidationError{Name: "listing_id", err: errors.New(`ent: missing required field "ListingInquiry.listing_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ListingInquiry.user_id"`)}
+	}
+	if v, ok := _c.mutation.Message(); ok {
+		if err := listinginquiry.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ListingInquiry.message": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ListingInquiry.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := listinginquiry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ListingInquiry.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ListingIDs()) == 0 {
+		return &ValidationError{Name: "listing", err: errors.New(`ent: missing required edge "ListingInquiry.listing"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ListingInquiry.user"`)}
+	}
+	return nil
+}
+
+func (_c *ListingInquiryCreate) sqlSave(ctx context.Context) (*ListingInquiry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ListingInquiryCreate) createSpec() (*ListingInquiry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ListingInquiry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(listinginquiry.Table, sqlgraph.NewFieldSpec(listinginquiry.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(listinginquiry.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(listinginquiry.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(listinginquiry.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(listinginquiry.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.DecidedAt(); ok {
+		_spec.SetField(listinginquiry.FieldDecidedAt, field.TypeTime, value)
+		_node.DecidedAt = &value
+	}
+	if nodes := _c.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listinginquiry.ListingTable,
+			Columns: []string{listinginquiry.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListingID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listinginquiry.UserTable,
+			Columns: []string{listinginquiry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ListingInquiry.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingInquiryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingInquiryCreate) OnConflict(opts ...sql.ConflictOption) *ListingInquiryUpsertOne {
+	_c.conflict = opts
+	return &ListingInquiryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ListingInquiry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingInquiryCreate) OnConflictColumns(columns ...string) *ListingInquiryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingInquiryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ListingInquiryUpsertOne is the builder for "upsert"-ing
+	//  one ListingInquiry node.
+	ListingInquiryUpsertOne struct {
+		create *ListingInquiryCreate
+	}
+
+	// ListingInquiryUpsert is the "OnConflict" setter.
+	ListingInquiryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingInquiryUpsert) SetUpdateTime(v time.Time) *ListingInquiryUpsert {
+	u.Set(listinginquiry.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingInquiryUpsert) UpdateUpdateTime() *ListingInquiryUpsert {
+	u.SetExcluded(listinginquiry.FieldUpdateTime)
+	return u
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingInquiryUpsert) SetListingID(v uuid.UUID) *ListingInquiryUpsert {
+	u.Set(listinginquiry.FieldListingID, v)
+	return u
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingInquiryUpsert) UpdateListingID() *ListingInquiryUpsert {
+	u.SetExcluded(listinginquiry.FieldListingID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ListingInquiryUpsert) SetUserID(v uuid.UUID) *ListingInquiryUpsert {
+	u.Set(listinginquiry.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ListingInquiryUpsert) UpdateUserID() *ListingInquiryUpsert {
+	u.SetExcluded(listinginquiry.FieldUserID)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *ListingInquiryUpsert) SetMessage(v string) *ListingInquiryUpsert {
+	u.Set(listinginquiry.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ListingInquiryUpsert) UpdateMessage() *ListingInquiryUpsert {
+	u.SetExcluded(listinginquiry.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ListingInquiryUpsert) ClearMessage() *ListingInquiryUpsert {
+	u.SetNull(listinginquiry.FieldMessage)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingInquiryUpsert) SetStatus(v listinginquiry.Status) *ListingInquiryUpsert {
+	u.Set(listinginquiry.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingInquiryUpsert) UpdateStatus() *ListingInquiryUpsert {
+	u.SetExcluded(listinginquiry.FieldStatus)
+	return u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *ListingInquiryUpsert) SetDecidedAt(v time.Time) *ListingInquiryUpsert {
+	u.Set(listinginquiry.FieldDecidedAt, v)
+	return u
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *ListingInquiryUpsert) UpdateDecidedAt() *ListingInquiryUpsert {
+	u.SetExcluded(listinginquiry.FieldDecidedAt)
+	return u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *ListingInquiryUpsert) ClearDecidedAt() *ListingInquiryUpsert {
+	u.SetNull(listinginquiry.FieldDecidedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ListingInquiry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listinginquiry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingInquiryUpsertOne) UpdateNewValues() *ListingInquiryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(listinginquiry.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(listinginquiry.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ListingInquiry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ListingInquiryUpsertOne) Ignore() *ListingInquiryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingInquiryUpsertOne) DoNothing() *ListingInquiryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingInquiryCreate.OnConflict
+// documentation for more info.
+func (u *ListingInquiryUpsertOne) Update(set func(*ListingInquiryUpsert)) *ListingInquiryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingInquiryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingInquiryUpsertOne) SetUpdateTime(v time.Time) *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingInquiryUpsertOne) UpdateUpdateTime() *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingInquiryUpsertOne) SetListingID(v uuid.UUID) *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingInquiryUpsertOne) UpdateListingID() *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ListingInquiryUpsertOne) SetUserID(v uuid.UUID) *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ListingInquiryUpsertOne) UpdateUserID() *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ListingInquiryUpsertOne) SetMessage(v string) *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ListingInquiryUpsertOne) UpdateMessage() *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ListingInquiryUpsertOne) ClearMessage() *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingInquiryUpsertOne) SetStatus(v listinginquiry.Status) *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingInquiryUpsertOne) UpdateStatus() *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *ListingInquiryUpsertOne) SetDecidedAt(v time.Time) *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetDecidedAt(v)
+	})
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *ListingInquiryUpsertOne) UpdateDecidedAt() *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateDecidedAt()
+	})
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *ListingInquiryUpsertOne) ClearDecidedAt() *ListingInquiryUpsertOne {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.ClearDecidedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingInquiryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingInquiryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingInquiryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ListingInquiryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ListingInquiryUpsertOne.ID is not supported by MySQL driver. Use ListingInquiryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ListingInquiryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ListingInquiryCreateBulk is the builder for creating many ListingInquiry entities in bulk.
+type ListingInquiryCreateBulk struct {
+	config
+	err      error
+	builders []*ListingInquiryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ListingInquiry entities in the database.
+func (_c *ListingInquiryCreateBulk) Save(ctx context.Context) ([]*ListingInquiry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ListingInquiry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ListingInquiryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ListingInquiryCreateBulk) SaveX(ctx context.Context) []*ListingInquiry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListingInquiryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListingInquiryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ListingInquiry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingInquiryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingInquiryCreateBulk) OnConflict(opts ...sql.ConflictOption) *ListingInquiryUpsertBulk {
+	_c.conflict = opts
+	return &ListingInquiryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ListingInquiry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingInquiryCreateBulk) OnConflictColumns(columns ...string) *ListingInquiryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingInquiryUpsertBulk{
+		create: _c,
+	}
+}
+
+// ListingInquiryUpsertBulk is the builder for "upsert"-ing
+// a bulk of ListingInquiry nodes.
+type ListingInquiryUpsertBulk struct {
+	create *ListingInquiryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ListingInquiry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listinginquiry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingInquiryUpsertBulk) UpdateNewValues() *ListingInquiryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(listinginquiry.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(listinginquiry.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ListingInquiry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ListingInquiryUpsertBulk) Ignore() *ListingInquiryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingInquiryUpsertBulk) DoNothing() *ListingInquiryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingInquiryCreateBulk.OnConflict
+// documentation for more info.
+func (u *ListingInquiryUpsertBulk) Update(set func(*ListingInquiryUpsert)) *ListingInquiryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingInquiryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingInquiryUpsertBulk) SetUpdateTime(v time.Time) *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingInquiryUpsertBulk) UpdateUpdateTime() *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingInquiryUpsertBulk) SetListingID(v uuid.UUID) *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingInquiryUpsertBulk) UpdateListingID() *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ListingInquiryUpsertBulk) SetUserID(v uuid.UUID) *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ListingInquiryUpsertBulk) UpdateUserID() *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ListingInquiryUpsertBulk) SetMessage(v string) *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ListingInquiryUpsertBulk) UpdateMessage() *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ListingInquiryUpsertBulk) ClearMessage() *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingInquiryUpsertBulk) SetStatus(v listinginquiry.Status) *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingInquiryUpsertBulk) UpdateStatus() *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *ListingInquiryUpsertBulk) SetDecidedAt(v time.Time) *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.SetDecidedAt(v)
+	})
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *ListingInquiryUpsertBulk) UpdateDecidedAt() *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.UpdateDecidedAt()
+	})
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *ListingInquiryUpsertBulk) ClearDecidedAt() *ListingInquiryUpsertBulk {
+	return u.Update(func(s *ListingInquiryUpsert) {
+		s.ClearDecidedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingInquiryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ListingInquiryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingInquiryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingInquiryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/user"
+)
+
+// ListingInquiryUpdate is the builder for updating ListingInquiry entities.
+type ListingInquiryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ListingInquiryMutation
+}
+
+// Where appends a list predicates to the ListingInquiryUpdate builder.
+func (_u *ListingInquiryUpdate) Where(ps ...predicate.ListingInquiry) *ListingInquiryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ListingInquiryUpdate) SetUpdateTime(v time.Time) *ListingInquiryUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *ListingInquiryUpdate) SetListingID(v uuid.UUID) *ListingInquiryUpdate {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *ListingInquiryUpdate) SetNillableListingID(v *uuid.UUID) *ListingInquiryUpdate {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ListingInquiryUpdate) SetUserID(v uuid.UUID) *ListingInquiryUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ListingInquiryUpdate) SetNillableUserID(v *uuid.UUID) *ListingInquiryUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ListingInquiryUpdate) SetMessage(v string) *ListingInquiryUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ListingInquiryUpdate) SetNillableMessage(v *string) *ListingInquiryUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ListingInquiryUpdate) ClearMessage() *ListingInquiryUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ListingInquiryUpdate) SetStatus(v listinginquiry.Status) *ListingInquiryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ListingInquiryUpdate) SetNillableStatus(v *listinginquiry.Status) *ListingInquiryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_u *ListingInquiryUpdate) SetDecidedAt(v time.Time) *ListingInquiryUpdate {
+	_u.mutation.SetDecidedAt(v)
+	return _u
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_u *ListingInquiryUpdate) SetNillableDecidedAt(v *time.Time) *ListingInquiryUpdate {
+	if v != nil {
+		_u.SetDecidedAt(*v)
+	}
+	return _u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (_u *ListingInquiryUpdate) ClearDecidedAt() *ListingInquiryUpdate {
+	_u.mutation.ClearDecidedAt()
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *ListingInquiryUpdate) SetListing(v *Listing) *ListingInquiryUpdate {
+	return _u.SetListingID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ListingInquiryUpdate) SetUser(v *User) *ListingInquiryUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ListingInquiryMutation object of the builder.
+func (_u *ListingInquiryUpdate) Mutation() *ListingInquiryMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *ListingInquiryUpdate) ClearListing() *ListingInquiryUpdate {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ListingInquiryUpdate) ClearUser() *ListingInquiryUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ListingInquiryUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListingInquiryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ListingInquiryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListingInquiryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ListingInquiryUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if listinginquiry.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listinginquiry.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := listinginquiry.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListingInquiryUpdate) check() error {
+	if v, ok := _u.mutation.Message(); ok {
+		if err := listinginquiry.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ListingInquiry.message": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := listinginquiry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ListingInquiry.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingInquiry.listing"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingInquiry.user"`)
+	}
+	return nil
+}
+
+func (_u *ListingInquiryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listinginquiry.Table, listinginquiry.Columns, sqlgraph.NewFieldSpec(listinginquiry.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(listinginquiry.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(listinginquiry.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(listinginquiry.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(listinginquiry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DecidedAt(); ok {
+		_spec.SetField(listinginquiry.FieldDecidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecidedAtCleared() {
+		_spec.ClearField(listinginquiry.FieldDecidedAt, field.TypeTime)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listinginquiry.ListingTable,
+			Columns: []string{listinginquiry.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listinginquiry.ListingTable,
+			Columns: []string{listinginquiry.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listinginquiry.UserTable,
+			Columns: []string{listinginquiry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listinginquiry.UserTable,
+			Columns: []string{listinginquiry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listinginquiry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ListingInquiryUpdateOne is the builder for updating a single ListingInquiry entity.
+type ListingInquiryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ListingInquiryMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ListingInquiryUpdateOne) SetUpdateTime(v time.Time) *ListingInquiryUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *ListingInquiryUpdateOne) SetListingID(v uuid.UUID) *ListingInquiryUpdateOne {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *ListingInquiryUpdateOne) SetNillableListingID(v *uuid.UUID) *ListingInquiryUpdateOne {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ListingInquiryUpdateOne) SetUserID(v uuid.UUID) *ListingInquiryUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ListingInquiryUpdateOne) SetNillableUserID(v *uuid.UUID) *ListingInquiryUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ListingInquiryUpdateOne) SetMessage(v string) *ListingInquiryUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ListingInquiryUpdateOne) SetNillableMessage(v *string) *ListingInquiryUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ListingInquiryUpdateOne) ClearMessage() *ListingInquiryUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ListingInquiryUpdateOne) SetStatus(v listinginquiry.Status) *ListingInquiryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ListingInquiryUpdateOne) SetNillableStatus(v *listinginquiry.Status) *ListingInquiryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_u *ListingInquiryUpdateOne) SetDecidedAt(v time.Time) *ListingInquiryUpdateOne {
+	_u.mutation.SetDecidedAt(v)
+	return _u
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_u *ListingInquiryUpdateOne) SetNillableDecidedAt(v *time.Time) *ListingInquiryUpdateOne {
+	if v != nil {
+		_u.SetDecidedAt(*v)
+	}
+	return _u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (_u *ListingInquiryUpdateOne) ClearDecidedAt() *ListingInquiryUpdateOne {
+	_u.mutation.ClearDecidedAt()
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *ListingInquiryUpdateOne) SetListing(v *Listing) *ListingInquiryUpdateOne {
+	return _u.SetListingID(v.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *ListingInquiryUpdateOne) SetUser(v *User) *ListingInquiryUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the ListingInquiryMutation object of the builder.
+func (_u *ListingInquiryUpdateOne) Mutation() *ListingInquiryMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *ListingInquiryUpdateOne) ClearListing() *ListingInquiryUpdateOne {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *ListingInquiryUpdateOne) ClearUser() *ListingInquiryUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the ListingInquiryUpdate builder.
+func (_u *ListingInquiryUpdateOne) Where(ps ...predicate.ListingInquiry) *ListingInquiryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ListingInquiryUpdateOne) Select(field string, fields ...string) *ListingInquiryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ListingInquiry entity.
+func (_u *ListingInquiryUpdateOne) Save(ctx context.Context) (*ListingInquiry, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListingInquiryUpdateOne) SaveX(ctx context.Context) *ListingInquiry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ListingInquiryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListingInquiryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ListingInquiryUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if listinginquiry.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listinginquiry.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := listinginquiry.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListingInquiryUpdateOne) check() error {
+	if v, ok := _u.mutation.Message(); ok {
+		if err := listinginquiry.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ListingInquiry.message": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := listinginquiry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ListingInquiry.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingInquiry.listing"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingInquiry.user"`)
+	}
+	return nil
+}
+
+func (_u *ListingInquiryUpdateOne) sqlSave(ctx context.Context) (_node *ListingInquiry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listinginquiry.Table, listinginquiry.Columns, sqlgraph.NewFieldSpec(listinginquiry.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ListingInquiry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, listinginquiry.FieldID)
+		for _, f := range fields {
+			if !listinginquiry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != listinginquiry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(listinginquiry.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(listinginquiry.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(listinginquiry.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(listinginquiry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.DecidedAt(); ok {
+		_spec.SetField(listinginquiry.FieldDecidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecidedAtCleared() {
+		_spec.ClearField(listinginquiry.FieldDecidedAt, field.TypeTime)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listinginquiry.ListingTable,
+			Columns: []string{listinginquiry.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listinginquiry.ListingTable,
+			Columns: []string{listinginquiry.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listinginquiry.UserTable,
+			Columns: []string{listinginquiry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listinginquiry.UserTable,
+			Columns: []string{listinginquiry.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ListingInquiry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listinginquiry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingrenewal"
+)
+
+// ListingRenewal is the model entity for the ListingRenewal schema.
+type ListingRenewal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ListingID holds the value of the "listing_id" field.
+	ListingID uuid.UUID `json:"listing_id,omitempty"`
+	// PreviousExpiresAt holds the value of the "previous_expires_at" field.
+	PreviousExpiresAt *time.Time `json:"previous_expires_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// TermDays holds the value of the "term_days" field.
+	TermDays int `json:"term_days,omitempty"`
+	// RenewedAt holds the value of the "renewed_at" field.
+	RenewedAt time.Time `json:"renewed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ListingRenewalQuery when eager-loading is set.
+	Edges        ListingRenewalEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ListingRenewalEdges holds the relations/edges for other nodes in the graph.
+type ListingRenewalEdges struct {
+	// Listing holds the value of the listing edge.
+	Listing *Listing `json:"listing,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ListingOrErr returns the Listing value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingRenewalEdges) ListingOrErr() (*Listing, error) {
+	if e.Listing != nil {
+		return e.Listing, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: listing.Label}
+	}
+	return nil, &NotLoadedError{edge: "listing"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ListingRenewal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case listingrenewal.FieldTermDays:
+			values[i] = new(sql.NullInt64)
+		case listingrenewal.FieldPreviousExpiresAt, listingrenewal.FieldExpiresAt, listingrenewal.FieldRenewedAt:
+			values[i] = new(sql.NullTime)
+		case listingrenewal.FieldID, listingrenewal.FieldListingID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ListingRenewal fields.
+func (_m *ListingRenewal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case listingrenewal.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case listingrenewal.FieldListingID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field listing_id", values[i])
+			} else if value != nil {
+				_m.ListingID = *value
+			}
+		case listingrenewal.FieldPreviousExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_expires_at", values[i])
+			} else if value.Valid {
+				_m.PreviousExpiresAt = new(time.Time)
+				*_m.PreviousExpiresAt = value.Time
+			}
+		case listingrenewal.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case listingrenewal.FieldTermDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field term_days", values[i])
+			} else if value.Valid {
+				_m.TermDays = int(value.Int64)
+			}
+		case listingrenewal.FieldRenewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field renewed_at", values[i])
+			} else if value.Valid {
+				_m.RenewedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ListingRenewal.
+// This includes values selected through modifiers, order, etc.
+func (_m *ListingRenewal) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryListing queries the "listing" edge of the ListingRenewal entity.
+func (_m *ListingRenewal) QueryListing() *ListingQuery {
+	return NewListingRenewalClient(_m.config).QueryListing(_m)
+}
+
+// Update returns a builder for updating this ListingRenewal.
+// Note that you need to call ListingRenewal.Unwrap() before calling this method if this ListingRenewal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ListingRenewal) Update() *ListingRenewalUpdateOne {
+	return NewListingRenewalClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ListingRenewal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ListingRenewal) Unwrap() *ListingRenewal {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ListingRenewal is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ListingRenewal) String() string {
+	var builder strings.Builder
+	builder.WriteString("ListingRenewal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("listing_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ListingID))
+	builder.WriteString(", ")
+	if v := _m.PreviousExpiresAt; v != nil {
+		builder.WriteString("previous_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("term_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TermDays))
+	builder.WriteString(", ")
+	builder.WriteString("renewed_at=")
+	builder.WriteString(_m.RenewedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ListingRenewals is a parsable slice of ListingRenewal.
+type ListingRenewals []*ListingRenewal
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package listingrenewal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the listingrenewal type in the database.
+	Label = "listing_renewal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldListingID holds the string denoting the listing_id field in the database.
+	FieldListingID = "listing_id"
+	// FieldPreviousExpiresAt holds the string denoting the previous_expires_at field in the database.
+	FieldPreviousExpiresAt = "previous_expires_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldTermDays holds the string denoting the term_days field in the database.
+	FieldTermDays = "term_days"
+	// FieldRenewedAt holds the string denoting the renewed_at field in the database.
+	FieldRenewedAt = "renewed_at"
+	// EdgeListing holds the string denoting the listing edge name in mutations.
+	EdgeListing = "listing"
+	// Table holds the table name of the listingrenewal in the database.
+	Table = "listing_renewals"
+	// ListingTable is the table that holds the listing relation/edge.
+	ListingTable = "listing_renewals"
+	// ListingInverseTable is the table name for the Listing entity.
+	// It exists in this package in order to avoid circular dependency with the "listing" package.
+	ListingInverseTable = "listings"
+	// ListingColumn is the table column denoting the listing relation/edge.
+	ListingColumn = "listing_id"
+)
+
+// Columns holds all SQL columns for listingrenewal fields.
+var Columns = []string{
+	FieldID,
+	FieldListingID,
+	FieldPreviousExpiresAt,
+	FieldExpiresAt,
+	FieldTermDays,
+	FieldRenewedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TermDaysValidator is a validator for the "term_days" field. It is called by the builders before save.
+	TermDaysValidator func(int) error
+	// DefaultRenewedAt holds the default value on creation for the "renewed_at" field.
+	DefaultRenewedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ListingRenewal queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByListingID orders the results by the listing_id field.
+func ByListingID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListingID, opts...).ToFunc()
+}
+
+// ByPreviousExpiresAt orders the results by the previous_expires_at field.
+func ByPreviousExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousExpiresAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByTermDays orders the results by the term_days field.
+func ByTermDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTermDays, opts...).ToFunc()
+}
+
+// ByRenewedAt orders the results by the renewed_at field.
+func ByRenewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenewedAt, opts...).ToFunc()
+}
+
+// ByListingField orders the results by listing field.
+func ByListingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListingStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newListingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ListingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ListingTable, ListingColumn),
+	)
+}
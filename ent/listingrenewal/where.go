@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package listingrenewal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldLTE(FieldID, id))
+}
+
+// ListingID applies equality check predicate on the "listing_id" field. It's identical to ListingIDEQ.
+func ListingID(v uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldListingID, v))
+}
+
+// PreviousExpiresAt applies equality check predicate on the "previous_expires_at" field. It's identical to PreviousExpiresAtEQ.
+func PreviousExpiresAt(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldPreviousExpiresAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// TermDays applies equality check predicate on the "term_days" field. It's identical to TermDaysEQ.
+func TermDays(v int) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldTermDays, v))
+}
+
+// RenewedAt applies equality check predicate on the "renewed_at" field. It's identical to RenewedAtEQ.
+func RenewedAt(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldRenewedAt, v))
+}
+
+// ListingIDEQ applies the EQ predicate on the "listing_id" field.
+func ListingIDEQ(v uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldListingID, v))
+}
+
+// ListingIDNEQ applies the NEQ predicate on the "listing_id" field.
+func ListingIDNEQ(v uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNEQ(FieldListingID, v))
+}
+
+// ListingIDIn applies the In predicate on the "listing_id" field.
+func ListingIDIn(vs ...uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldIn(FieldListingID, vs...))
+}
+
+// ListingIDNotIn applies the NotIn predicate on the "listing_id" field.
+func ListingIDNotIn(vs ...uuid.UUID) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNotIn(FieldListingID, vs...))
+}
+
+// PreviousExpiresAtEQ applies the EQ predicate on the "previous_expires_at" field.
+func PreviousExpiresAtEQ(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtNEQ applies the NEQ predicate on the "previous_expires_at" field.
+func PreviousExpiresAtNEQ(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNEQ(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtIn applies the In predicate on the "previous_expires_at" field.
+func PreviousExpiresAtIn(vs ...time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldIn(FieldPreviousExpiresAt, vs...))
+}
+
+// PreviousExpiresAtNotIn applies the NotIn predicate on the "previous_expires_at" field.
+func PreviousExpiresAtNotIn(vs ...time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNotIn(FieldPreviousExpiresAt, vs...))
+}
+
+// PreviousExpiresAtGT applies the GT predicate on the "previous_expires_at" field.
+func PreviousExpiresAtGT(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldGT(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtGTE applies the GTE predicate on the "previous_expires_at" field.
+func PreviousExpiresAtGTE(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldGTE(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtLT applies the LT predicate on the "previous_expires_at" field.
+func PreviousExpiresAtLT(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldLT(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtLTE applies the LTE predicate on the "previous_expires_at" field.
+func PreviousExpiresAtLTE(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldLTE(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtIsNil applies the IsNil predicate on the "previous_expires_at" field.
+func PreviousExpiresAtIsNil() predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldIsNull(FieldPreviousExpiresAt))
+}
+
+// PreviousExpiresAtNotNil applies the NotNil predicate on the "previous_expires_at" field.
+func PreviousExpiresAtNotNil() predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNotNull(FieldPreviousExpiresAt))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// TermDaysEQ applies the EQ predicate on the "term_days" field.
+func TermDaysEQ(v int) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldTermDays, v))
+}
+
+// TermDaysNEQ applies the NEQ predicate on the "term_days" field.
+func TermDaysNEQ(v int) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNEQ(FieldTermDays, v))
+}
+
+// TermDaysIn applies the In predicate on the "term_days" field.
+func TermDaysIn(vs ...int) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldIn(FieldTermDays, vs...))
+}
+
+// TermDaysNotIn applies the NotIn predicate on the "term_days" field.
+func TermDaysNotIn(vs ...int) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNotIn(FieldTermDays, vs...))
+}
+
+// TermDaysGT applies the GT predicate on the "term_days" field.
+func TermDaysGT(v int) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldGT(FieldTermDays, v))
+}
+
+// TermDaysGTE applies the GTE predicate on the "term_days" field.
+func TermDaysGTE(v int) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldGTE(FieldTermDays, v))
+}
+
+// TermDaysLT applies the LT predicate on the "term_days" field.
+func TermDaysLT(v int) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldLT(FieldTermDays, v))
+}
+
+// TermDaysLTE applies the LTE predicate on the "term_days" field.
+func TermDaysLTE(v int) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldLTE(FieldTermDays, v))
+}
+
+// RenewedAtEQ applies the EQ predicate on the "renewed_at" field.
+func RenewedAtEQ(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldEQ(FieldRenewedAt, v))
+}
+
+// RenewedAtNEQ applies the NEQ predicate on the "renewed_at" field.
+func RenewedAtNEQ(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNEQ(FieldRenewedAt, v))
+}
+
+// RenewedAtIn applies the In predicate on the "renewed_at" field.
+func RenewedAtIn(vs ...time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldIn(FieldRenewedAt, vs...))
+}
+
+// RenewedAtNotIn applies the NotIn predicate on the "renewed_at" field.
+func RenewedAtNotIn(vs ...time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldNotIn(FieldRenewedAt, vs...))
+}
+
+// RenewedAtGT applies the GT predicate on the "renewed_at" field.
+func RenewedAtGT(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldGT(FieldRenewedAt, v))
+}
+
+// RenewedAtGTE applies the GTE predicate on the "renewed_at" field.
+func RenewedAtGTE(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldGTE(FieldRenewedAt, v))
+}
+
+// RenewedAtLT applies the LT predicate on the "renewed_at" field.
+func RenewedAtLT(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldLT(FieldRenewedAt, v))
+}
+
+// RenewedAtLTE applies the LTE predicate on the "renewed_at" field.
+func RenewedAtLTE(v time.Time) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.FieldLTE(FieldRenewedAt, v))
+}
+
+// HasListing applies the HasEdge predicate on the "listing" edge.
+func HasListing() predicate.ListingRenewal {
+	return predicate.ListingRenewal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ListingTable, ListingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListingWith applies the HasEdge predicate on the "listing" edge with a given conditions (other predicates).
+func HasListingWith(preds ...predicate.Listing) predicate.ListingRenewal {
+	return predicate.ListingRenewal(func(s *sql.Selector) {
+		step := newListingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ListingRenewal) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ListingRenewal) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ListingRenewal) predicate.ListingRenewal {
+	return predicate.ListingRenewal(sql.NotPredicates(p))
+}
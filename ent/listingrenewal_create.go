@@ -0,0 +1,771 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingrenewal"
+)
+
+// ListingRenewalCreate is the builder for creating a ListingRenewal entity.
+type ListingRenewalCreate struct {
+	config
+	mutation *ListingRenewalMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetListingID sets the "listing_id" field.
+func (_c *ListingRenewalCreate) SetListingID(v uuid.UUID) *ListingRenewalCreate {
+	_c.mutation.SetListingID(v)
+	return _c
+}
+
+// SetPreviousExpiresAt sets the "previous_expires_at" field.
+func (_c *ListingRenewalCreate) SetPreviousExpiresAt(v time.Time) *ListingRenewalCreate {
+	_c.mutation.SetPreviousExpiresAt(v)
+	return _c
+}
+
+// SetNillablePreviousExpiresAt sets the "previous_expires_at" field if the given value is not nil.
+func (_c *ListingRenewalCreate) SetNillablePreviousExpiresAt(v *time.Time) *ListingRenewalCreate {
+	if v != nil {
+		_c.SetPreviousExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ListingRenewalCreate) SetExpiresAt(v time.Time) *ListingRenewalCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetTermDays sets the "term_days" field.
+func (_c *ListingRenewalCreate) SetTermDays(v int) *ListingRenewalCreate {
+	_c.mutation.SetTermDays(v)
+	return _c
+}
+
+// SetRenewedAt sets the "renewed_at" field.
+func (_c *ListingRenewalCreate) SetRenewedAt(v time.Time) *ListingRenewalCreate {
+	_c.mutation.SetRenewedAt(v)
+	return _c
+}
+
+// SetNillableRenewedAt sets the "renewed_at" field if the given value is not nil.
+func (_c *ListingRenewalCreate) SetNillableRenewedAt(v *time.Time) *ListingRenewalCreate {
+	if v != nil {
+		_c.SetRenewedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ListingRenewalCreate) SetID(v uuid.UUID) *ListingRenewalCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ListingRenewalCreate) SetNillableID(v *uuid.UUID) *ListingRenewalCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_c *ListingRenewalCreate) SetListing(v *Listing) *ListingRenewalCreate {
+	return _c.SetListingID(v.ID)
+}
+
+// Mutation returns the ListingRenewalMutation object of the builder.
+func (_c *ListingRenewalCreate) Mutation() *ListingRenewalMutation {
+	return _c.mutation
+}
+
+// Save creates the ListingRenewal in the database.
+func (_c *ListingRenewalCreate) Save(ctx context.Context) (*ListingRenewal, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ListingRenewalCreate) SaveX(ctx context.Context) *ListingRenewal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListingRenewalCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListingRenewalCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ListingRenewalCreate) defaults() {
+	if _, ok := _c.mutation.RenewedAt(); !ok {
+		v := listingrenewal.DefaultRenewedAt()
+		_c.mutation.SetRenewedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := listingrenewal.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ListingRenewalCreate) check() error {
+	if _, ok := _c.mutation.ListingID(); !ok {
+		return &ValidationError{Name: "listing_id", err: errors.New(`ent: missing required field "ListingRenewal.listing_id"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ListingRenewal.expires_at"`)}
+	}
+	if _, ok := _c.mutation.TermDays(); !ok {
+		return &ValidationError{Name: "term_days", err: errors.New(`ent: missing required field "ListingRenewal.term_days"`)}
+	}
+	if v, ok := _c.mutation.TermDays(); ok {
+		if err := listingrenewal.TermDaysValidator(v); err != nil {
+			return &ValidationError{Name: "term_days", err: fmt.Errorf(`ent: validator failed for field "ListingRenewal.term_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RenewedAt(); !ok {
+		return &ValidationError{Name: "renewed_at", err: errors.New(`ent: missing required field "ListingRenewal.renewed_at"`)}
+	}
+	if len(_c.mutation.ListingIDs()) == 0 {
+		return &ValidationError{Name: "listing", err: errors.New(`ent: missing required edge "ListingRenewal.listing"`)}
+	}
+	return nil
+}
+
+func (_c *ListingRenewalCreate) sqlSave(ctx context.Context) (*ListingRenewal, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ListingRenewalCreate) createSpec() (*ListingRenewal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ListingRenewal{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(listingrenewal.Table, sqlgraph.NewFieldSpec(listingrenewal.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.PreviousExpiresAt(); ok {
+		_spec.SetField(listingrenewal.FieldPreviousExpiresAt, field.TypeTime, value)
+		_node.PreviousExpiresAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(listingrenewal.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.TermDays(); ok {
+		_spec.SetField(listingrenewal.FieldTermDays, field.TypeInt, value)
+		_node.TermDays = value
+	}
+	if value, ok := _c.mutation.RenewedAt(); ok {
+		_spec.SetField(listingrenewal.FieldRenewedAt, field.TypeTime, value)
+		_node.RenewedAt = value
+	}
+	if nodes := _c.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingrenewal.ListingTable,
+			Columns: []string{listingrenewal.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListingID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ListingRenewal.Create().
+//		SetListingID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingRenewalUpsert) {
+//			SetListingID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingRenewalCreate) OnConflict(opts ...sql.ConflictOption) *ListingRenewalUpsertOne {
+	_c.conflict = opts
+	return &ListingRenewalUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ListingRenewal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingRenewalCreate) OnConflictColumns(columns ...string) *ListingRenewalUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingRenewalUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ListingRenewalUpsertOne is the builder for "upsert"-ing
+	//  one ListingRenewal node.
+	ListingRenewalUpsertOne struct {
+		create *ListingRenewalCreate
+	}
+
+	// ListingRenewalUpsert is the "OnConflict" setter.
+	ListingRenewalUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingRenewalUpsert) SetListingID(v uuid.UUID) *ListingRenewalUpsert {
+	u.Set(listingrenewal.FieldListingID, v)
+	return u
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingRenewalUpsert) UpdateListingID() *ListingRenewalUpsert {
+	u.SetExcluded(listingrenewal.FieldListingID)
+	return u
+}
+
+// SetPreviousExpiresAt sets the "previous_expires_at" field.
+func (u *ListingRenewalUpsert) SetPreviousExpiresAt(v time.Time) *ListingRenewalUpsert {
+	u.Set(listingrenewal.FieldPreviousExpiresAt, v)
+	return u
+}
+
+// UpdatePreviousExpiresAt sets the "previous_expires_at" field to the value that was provided on create.
+func (u *ListingRenewalUpsert) UpdatePreviousExpiresAt() *ListingRenewalUpsert {
+	u.SetExcluded(listingrenewal.FieldPreviousExpiresAt)
+	return u
+}
+
+// ClearPreviousExpiresAt clears the value of the "previous_expires_at" field.
+func (u *ListingRenewalUpsert) ClearPreviousExpiresAt() *ListingRenewalUpsert {
+	u.SetNull(listingrenewal.FieldPreviousExpiresAt)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ListingRenewalUpsert) SetExpiresAt(v time.Time) *ListingRenewalUpsert {
+	u.Set(listingrenewal.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ListingRenewalUpsert) UpdateExpiresAt() *ListingRenewalUpsert {
+	u.SetExcluded(listingrenewal.FieldExpiresAt)
+	return u
+}
+
+// SetTermDays sets the "term_days" field.
+func (u *ListingRenewalUpsert) SetTermDays(v int) *ListingRenewalUpsert {
+	u.Set(listingrenewal.FieldTermDays, v)
+	return u
+}
+
+// UpdateTermDays sets the "term_days" field to the value that was provided on create.
+func (u *ListingRenewalUpsert) UpdateTermDays() *ListingRenewalUpsert {
+	u.SetExcluded(listingrenewal.FieldTermDays)
+	return u
+}
+
+// AddTermDays adds v to the "term_days" field.
+func (u *ListingRenewalUpsert) AddTermDays(v int) *ListingRenewalUpsert {
+	u.Add(listingrenewal.FieldTermDays, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ListingRenewal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listingrenewal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingRenewalUpsertOne) UpdateNewValues() *ListingRenewalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(listingrenewal.FieldID)
+		}
+		if _, exists := u.create.mutation.RenewedAt(); exists {
+			s.SetIgnore(listingrenewal.FieldRenewedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ListingRenewal.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ListingRenewalUpsertOne) Ignore() *ListingRenewalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingRenewalUpsertOne) DoNothing() *ListingRenewalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingRenewalCreate.OnConflict
+// documentation for more info.
+func (u *ListingRenewalUpsertOne) Update(set func(*ListingRenewalUpsert)) *ListingRenewalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingRenewalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingRenewalUpsertOne) SetListingID(v uuid.UUID) *ListingRenewalUpsertOne {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingRenewalUpsertOne) UpdateListingID() *ListingRenewalUpsertOne {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetPreviousExpiresAt sets the "previous_expires_at" field.
+func (u *ListingRenewalUpsertOne) SetPreviousExpiresAt(v time.Time) *ListingRenewalUpsertOne {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.SetPreviousExpiresAt(v)
+	})
+}
+
+// UpdatePreviousExpiresAt sets the "previous_expires_at" field to the value that was provided on create.
+func (u *ListingRenewalUpsertOne) UpdatePreviousExpiresAt() *ListingRenewalUpsertOne {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.UpdatePreviousExpiresAt()
+	})
+}
+
+// ClearPreviousExpiresAt clears the value of the "previous_expires_at" field.
+func (u *ListingRenewalUpsertOne) ClearPreviousExpiresAt() *ListingRenewalUpsertOne {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.ClearPreviousExpiresAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ListingRenewalUpsertOne) SetExpiresAt(v time.Time) *ListingRenewalUpsertOne {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ListingRenewalUpsertOne) UpdateExpiresAt() *ListingRenewalUpsertOne {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetTermDays sets the "term_days" field.
+func (u *ListingRenewalUpsertOne) SetTermDays(v int) *ListingRenewalUpsertOne {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.SetTermDays(v)
+	})
+}
+
+// AddTermDays adds v to the "term_days" field.
+func (u *ListingRenewalUpsertOne) AddTermDays(v int) *ListingRenewalUpsertOne {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.AddTermDays(v)
+	})
+}
+
+// UpdateTermDays sets the "term_days" field to the value that was provided on create.
+func (u *ListingRenewalUpsertOne) UpdateTermDays() *ListingRenewalUpsertOne {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.UpdateTermDays()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingRenewalUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingRenewalCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingRenewalUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ListingRenewalUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ListingRenewalUpsertOne.ID is not supported by MySQL driver. Use ListingRenewalUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ListingRenewalUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ListingRenewalCreateBulk is the builder for creating many ListingRenewal entities in bulk.
+type ListingRenewalCreateBulk struct {
+	config
+	err      error
+	builders []*ListingRenewalCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ListingRenewal entities in the database.
+func (_c *ListingRenewalCreateBulk) Save(ctx context.Context) ([]*ListingRenewal, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ListingRenewal, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ListingRenewalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ListingRenewalCreateBulk) SaveX(ctx context.Context) []*ListingRenewal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListingRenewalCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListingRenewalCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ListingRenewal.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingRenewalUpsert) {
+//			SetListingID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingRenewalCreateBulk) OnConflict(opts ...sql.ConflictOption) *ListingRenewalUpsertBulk {
+	_c.conflict = opts
+	return &ListingRenewalUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ListingRenewal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingRenewalCreateBulk) OnConflictColumns(columns ...string) *ListingRenewalUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingRenewalUpsertBulk{
+		create: _c,
+	}
+}
+
+// ListingRenewalUpsertBulk is the builder for "upsert"-ing
+// a bulk of ListingRenewal nodes.
+type ListingRenewalUpsertBulk struct {
+	create *ListingRenewalCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ListingRenewal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listingrenewal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingRenewalUpsertBulk) UpdateNewValues() *ListingRenewalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(listingrenewal.FieldID)
+			}
+			if _, exists := b.mutation.RenewedAt(); exists {
+				s.SetIgnore(listingrenewal.FieldRenewedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ListingRenewal.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ListingRenewalUpsertBulk) Ignore() *ListingRenewalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingRenewalUpsertBulk) DoNothing() *ListingRenewalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingRenewalCreateBulk.OnConflict
+// documentation for more info.
+func (u *ListingRenewalUpsertBulk) Update(set func(*ListingRenewalUpsert)) *ListingRenewalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingRenewalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingRenewalUpsertBulk) SetListingID(v uuid.UUID) *ListingRenewalUpsertBulk {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingRenewalUpsertBulk) UpdateListingID() *ListingRenewalUpsertBulk {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetPreviousExpiresAt sets the "previous_expires_at" field.
+func (u *ListingRenewalUpsertBulk) SetPreviousExpiresAt(v time.Time) *ListingRenewalUpsertBulk {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.SetPreviousExpiresAt(v)
+	})
+}
+
+// UpdatePreviousExpiresAt sets the "previous_expires_at" field to the value that was provided on create.
+func (u *ListingRenewalUpsertBulk) UpdatePreviousExpiresAt() *ListingRenewalUpsertBulk {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.UpdatePreviousExpiresAt()
+	})
+}
+
+// ClearPreviousExpiresAt clears the value of the "previous_expires_at" field.
+func (u *ListingRenewalUpsertBulk) ClearPreviousExpiresAt() *ListingRenewalUpsertBulk {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.ClearPreviousExpiresAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ListingRenewalUpsertBulk) SetExpiresAt(v time.Time) *ListingRenewalUpsertBulk {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ListingRenewalUpsertBulk) UpdateExpiresAt() *ListingRenewalUpsertBulk {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetTermDays sets the "term_days" field.
+func (u *ListingRenewalUpsertBulk) SetTermDays(v int) *ListingRenewalUpsertBulk {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.SetTermDays(v)
+	})
+}
+
+// AddTermDays adds v to the "term_days" field.
+func (u *ListingRenewalUpsertBulk) AddTermDays(v int) *ListingRenewalUpsertBulk {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.AddTermDays(v)
+	})
+}
+
+// UpdateTermDays sets the "term_days" field to the value that was provided on create.
+func (u *ListingRenewalUpsertBulk) UpdateTermDays() *ListingRenewalUpsertBulk {
+	return u.Update(func(s *ListingRenewalUpsert) {
+		s.UpdateTermDays()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingRenewalUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ListingRenewalCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingRenewalCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingRenewalUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
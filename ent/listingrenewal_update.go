@@ -0,0 +1,458 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingrenewal"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ListingRenewalUpdate is the builder for updating ListingRenewal entities.
+type ListingRenewalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ListingRenewalMutation
+}
+
+// Where appends a list predicates to the ListingRenewalUpdate builder.
+func (_u *ListingRenewalUpdate) Where(ps ...predicate.ListingRenewal) *ListingRenewalUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *ListingRenewalUpdate) SetListingID(v uuid.UUID) *ListingRenewalUpdate {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *ListingRenewalUpdate) SetNillableListingID(v *uuid.UUID) *ListingRenewalUpdate {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetPreviousExpiresAt sets the "previous_expires_at" field.
+func (_u *ListingRenewalUpdate) SetPreviousExpiresAt(v time.Time) *ListingRenewalUpdate {
+	_u.mutation.SetPreviousExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousExpiresAt sets the "previous_expires_at" field if the given value is not nil.
+func (_u *ListingRenewalUpdate) SetNillablePreviousExpiresAt(v *time.Time) *ListingRenewalUpdate {
+	if v != nil {
+		_u.SetPreviousExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearPreviousExpiresAt clears the value of the "previous_expires_at" field.
+func (_u *ListingRenewalUpdate) ClearPreviousExpiresAt() *ListingRenewalUpdate {
+	_u.mutation.ClearPreviousExpiresAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ListingRenewalUpdate) SetExpiresAt(v time.Time) *ListingRenewalUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ListingRenewalUpdate) SetNillableExpiresAt(v *time.Time) *ListingRenewalUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetTermDays sets the "term_days" field.
+func (_u *ListingRenewalUpdate) SetTermDays(v int) *ListingRenewalUpdate {
+	_u.mutation.ResetTermDays()
+	_u.mutation.SetTermDays(v)
+	return _u
+}
+
+// SetNillableTermDays sets the "term_days" field if the given value is not nil.
+func (_u *ListingRenewalUpdate) SetNillableTermDays(v *int) *ListingRenewalUpdate {
+	if v != nil {
+		_u.SetTermDays(*v)
+	}
+	return _u
+}
+
+// AddTermDays adds value to the "term_days" field.
+func (_u *ListingRenewalUpdate) AddTermDays(v int) *ListingRenewalUpdate {
+	_u.mutation.AddTermDays(v)
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *ListingRenewalUpdate) SetListing(v *Listing) *ListingRenewalUpdate {
+	return _u.SetListingID(v.ID)
+}
+
+// Mutation returns the ListingRenewalMutation object of the builder.
+func (_u *ListingRenewalUpdate) Mutation() *ListingRenewalMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *ListingRenewalUpdate) ClearListing() *ListingRenewalUpdate {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ListingRenewalUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListingRenewalUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ListingRenewalUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListingRenewalUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListingRenewalUpdate) check() error {
+	if v, ok := _u.mutation.TermDays(); ok {
+		if err := listingrenewal.TermDaysValidator(v); err != nil {
+			return &ValidationError{Name: "term_days", err: fmt.Errorf(`ent: validator failed for field "ListingRenewal.term_days": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingRenewal.listing"`)
+	}
+	return nil
+}
+
+func (_u *ListingRenewalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listingrenewal.Table, listingrenewal.Columns, sqlgraph.NewFieldSpec(listingrenewal.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PreviousExpiresAt(); ok {
+		_spec.SetField(listingrenewal.FieldPreviousExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousExpiresAtCleared() {
+		_spec.ClearField(listingrenewal.FieldPreviousExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(listingrenewal.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TermDays(); ok {
+		_spec.SetField(listingrenewal.FieldTermDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTermDays(); ok {
+		_spec.AddField(listingrenewal.FieldTermDays, field.TypeInt, value)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingrenewal.ListingTable,
+			Columns: []string{listingrenewal.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingrenewal.ListingTable,
+			Columns: []string{listingrenewal.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listingrenewal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ListingRenewalUpdateOne is the builder for updating a single ListingRenewal entity.
+type ListingRenewalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ListingRenewalMutation
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *ListingRenewalUpdateOne) SetListingID(v uuid.UUID) *ListingRenewalUpdateOne {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *ListingRenewalUpdateOne) SetNillableListingID(v *uuid.UUID) *ListingRenewalUpdateOne {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetPreviousExpiresAt sets the "previous_expires_at" field.
+func (_u *ListingRenewalUpdateOne) SetPreviousExpiresAt(v time.Time) *ListingRenewalUpdateOne {
+	_u.mutation.SetPreviousExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousExpiresAt sets the "previous_expires_at" field if the given value is not nil.
+func (_u *ListingRenewalUpdateOne) SetNillablePreviousExpiresAt(v *time.Time) *ListingRenewalUpdateOne {
+	if v != nil {
+		_u.SetPreviousExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearPreviousExpiresAt clears the value of the "previous_expires_at" field.
+func (_u *ListingRenewalUpdateOne) ClearPreviousExpiresAt() *ListingRenewalUpdateOne {
+	_u.mutation.ClearPreviousExpiresAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ListingRenewalUpdateOne) SetExpiresAt(v time.Time) *ListingRenewalUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ListingRenewalUpdateOne) SetNillableExpiresAt(v *time.Time) *ListingRenewalUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetTermDays sets the "term_days" field.
+func (_u *ListingRenewalUpdateOne) SetTermDays(v int) *ListingRenewalUpdateOne {
+	_u.mutation.ResetTermDays()
+	_u.mutation.SetTermDays(v)
+	return _u
+}
+
+// SetNillableTermDays sets the "term_days" field if the given value is not nil.
+func (_u *ListingRenewalUpdateOne) SetNillableTermDays(v *int) *ListingRenewalUpdateOne {
+	if v != nil {
+		_u.SetTermDays(*v)
+	}
+	return _u
+}
+
+// AddTermDays adds value to the "term_days" field.
+func (_u *ListingRenewalUpdateOne) AddTermDays(v int) *ListingRenewalUpdateOne {
+	_u.mutation.AddTermDays(v)
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *ListingRenewalUpdateOne) SetListing(v *Listing) *ListingRenewalUpdateOne {
+	return _u.SetListingID(v.ID)
+}
+
+// Mutation returns the ListingRenewalMutation object of the builder.
+func (_u *ListingRenewalUpdateOne) Mutation() *ListingRenewalMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *ListingRenewalUpdateOne) ClearListing() *ListingRenewalUpdateOne {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// Where appends a list predicates to the ListingRenewalUpdate builder.
+func (_u *ListingRenewalUpdateOne) Where(ps ...predicate.ListingRenewal) *ListingRenewalUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ListingRenewalUpdateOne) Select(field string, fields ...string) *ListingRenewalUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ListingRenewal entity.
+func (_u *ListingRenewalUpdateOne) Save(ctx context.Context) (*ListingRenewal, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListingRenewalUpdateOne) SaveX(ctx context.Context) *ListingRenewal {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ListingRenewalUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListingRenewalUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListingRenewalUpdateOne) check() error {
+	if v, ok := _u.mutation.TermDays(); ok {
+		if err := listingrenewal.TermDaysValidator(v); err != nil {
+			return &ValidationError{Name: "term_days", err: fmt.Errorf(`ent: validator failed for field "ListingRenewal.term_days": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingRenewal.listing"`)
+	}
+	return nil
+}
+
+func (_u *ListingRenewalUpdateOne) sqlSave(ctx context.Context) (_node *ListingRenewal, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listingrenewal.Table, listingrenewal.Columns, sqlgraph.NewFieldSpec(listingrenewal.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ListingRenewal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, listingrenewal.FieldID)
+		for _, f := range fields {
+			if !listingrenewal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != listingrenewal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PreviousExpiresAt(); ok {
+		_spec.SetField(listingrenewal.FieldPreviousExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousExpiresAtCleared() {
+		_spec.ClearField(listingrenewal.FieldPreviousExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(listingrenewal.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.TermDays(); ok {
+		_spec.SetField(listingrenewal.FieldTermDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTermDays(); ok {
+		_spec.AddField(listingrenewal.FieldTermDays, field.TypeInt, value)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingrenewal.ListingTable,
+			Columns: []string{listingrenewal.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingrenewal.ListingTable,
+			Columns: []string{listingrenewal.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ListingRenewal{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listingrenewal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// ListingReview is the model entity for the ListingReview schema.
+type ListingReview struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// ListingID holds the value of the "listing_id" field.
+	ListingID uuid.UUID `json:"listing_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind listingreview.Kind `json:"kind,omitempty"`
+	// Status holds the value of the "status" field.
+	Status listingreview.Status `json:"status,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes schematype.ListingChanges `json:"changes,omitempty"`
+	// SubmittedBy holds the value of the "submitted_by" field.
+	SubmittedBy *uuid.UUID `json:"submitted_by,omitempty"`
+	// DecidedBy holds the value of the "decided_by" field.
+	DecidedBy *uuid.UUID `json:"decided_by,omitempty"`
+	// DecidedAt holds the value of the "decided_at" field.
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+	// Reasons holds the value of the "reasons" field.
+	Reasons []string `json:"reasons,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ListingReviewQuery when eager-loading is set.
+	Edges        ListingReviewEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ListingReviewEdges holds the relations/edges for other nodes in the graph.
+type ListingReviewEdges struct {
+	// Listing holds the value of the listing edge.
+	Listing *Listing `json:"listing,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ListingOrErr returns the Listing value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingReviewEdges) ListingOrErr() (*Listing, error) {
+	if e.Listing != nil {
+		return e.Listing, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: listing.Label}
+	}
+	return nil, &NotLoadedError{edge: "listing"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ListingReview) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case listingreview.FieldSubmittedBy, listingreview.FieldDecidedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case listingreview.FieldChanges, listingreview.FieldReasons:
+			values[i] = new([]byte)
+		case listingreview.FieldKind, listingreview.FieldStatus, listingreview.FieldNote:
+			values[i] = new(sql.NullString)
+		case listingreview.FieldCreateTime, listingreview.FieldUpdateTime, listingreview.FieldDecidedAt:
+			values[i] = new(sql.NullTime)
+		case listingreview.FieldID, listingreview.FieldListingID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ListingReview fields.
+func (_m *ListingReview) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case listingreview.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case listingreview.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case listingreview.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case listingreview.FieldListingID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field listing_id", values[i])
+			} else if value != nil {
+				_m.ListingID = *value
+			}
+		case listingreview.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = listingreview.Kind(value.String)
+			}
+		case listingreview.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = listingreview.Status(value.String)
+			}
+		case listingreview.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case listingreview.FieldSubmittedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_by", values[i])
+			} else if value.Valid {
+				_m.SubmittedBy = new(uuid.UUID)
+				*_m.SubmittedBy = *value.S.(*uuid.UUID)
+			}
+		case listingreview.FieldDecidedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_by", values[i])
+			} else if value.Valid {
+				_m.DecidedBy = new(uuid.UUID)
+				*_m.DecidedBy = *value.S.(*uuid.UUID)
+			}
+		case listingreview.FieldDecidedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_at", values[i])
+			} else if value.Valid {
+				_m.DecidedAt = new(time.Time)
+				*_m.DecidedAt = value.Time
+			}
+		case listingreview.FieldReasons:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reasons", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Reasons); err != nil {
+					return fmt.Errorf("unmarshal field reasons: %w", err)
+				}
+			}
+		case listingreview.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ListingReview.
+// This includes values selected through modifiers, order, etc.
+func (_m *ListingReview) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryListing queries the "listing" edge of the ListingReview entity.
+func (_m *ListingReview) QueryListing() *ListingQuery {
+	return NewListingReviewClient(_m.config).QueryListing(_m)
+}
+
+// Update returns a builder for updating this ListingReview.
+// Note that you need to call ListingReview.Unwrap() before calling this method if this ListingReview
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ListingReview) Update() *ListingReviewUpdateOne {
+	return NewListingReviewClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ListingReview entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ListingReview) Unwrap() *ListingReview {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ListingReview is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ListingReview) String() string {
+	var builder strings.Builder
+	builder.WriteString("ListingReview(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("listing_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ListingID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Changes))
+	builder.WriteString(", ")
+	if v := _m.SubmittedBy; v != nil {
+		builder.WriteString("submitted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DecidedBy; v != nil {
+		builder.WriteString("decided_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DecidedAt; v != nil {
+		builder.WriteString("decided_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reasons=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Reasons))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ListingReviews is a parsable slice of ListingReview.
+type ListingReviews []*ListingReview
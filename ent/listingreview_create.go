@@ -0,0 +1,1233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// ListingReviewCreate is the builder for creating a ListingReview entity.
+type ListingReviewCreate struct {
+	config
+	mutation *ListingReviewMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ListingReviewCreate) SetCreateTime(v time.Time) *ListingReviewCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ListingReviewCreate) SetNillableCreateTime(v *time.Time) *ListingReviewCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ListingReviewCreate) SetUpdateTime(v time.Time) *ListingReviewCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ListingReviewCreate) SetNillableUpdateTime(v *time.Time) *ListingReviewCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetListingID sets the "listing_id" field.
+func (_c *ListingReviewCreate) SetListingID(v uuid.UUID) *ListingReviewCreate {
+	_c.mutation.SetListingID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ListingReviewCreate) SetKind(v listingreview.Kind) *ListingReviewCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ListingReviewCreate) SetStatus(v listingreview.Status) *ListingReviewCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ListingReviewCreate) SetNillableStatus(v *listingreview.Status) *ListingReviewCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *ListingReviewCreate) SetChanges(v schematype.ListingChanges) *ListingReviewCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_c *ListingReviewCreate) SetNillableChanges(v *schematype.ListingChanges) *ListingReviewCreate {
+	if v != nil {
+		_c.SetChanges(*v)
+	}
+	return _c
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_c *ListingReviewCreate) SetSubmittedBy(v uuid.UUID) *ListingReviewCreate {
+	_c.mutation.SetSubmittedBy(v)
+	return _c
+}
+
+// SetNillableSubmittedBy sets the "submitted_by" field if the given value is not nil.
+func (_c *ListingReviewCreate) SetNillableSubmittedBy(v *uuid.UUID) *ListingReviewCreate {
+	if v != nil {
+		_c.SetSubmittedBy(*v)
+	}
+	return _c
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (_c *ListingReviewCreate) SetDecidedBy(v uuid.UUID) *ListingReviewCreate {
+	_c.mutation.SetDecidedBy(v)
+	return _c
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (_c *ListingReviewCreate) SetNillableDecidedBy(v *uuid.UUID) *ListingReviewCreate {
+	if v != nil {
+		_c.SetDecidedBy(*v)
+	}
+	return _c
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_c *ListingReviewCreate) SetDecidedAt(v time.Time) *ListingReviewCreate {
+	_c.mutation.SetDecidedAt(v)
+	return _c
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_c *ListingReviewCreate) SetNillableDecidedAt(v *time.Time) *ListingReviewCreate {
+	if v != nil {
+		_c.SetDecidedAt(*v)
+	}
+	return _c
+}
+
+// SetReasons sets the "reasons" field.
+func (_c *ListingReviewCreate) SetReasons(v []string) *ListingReviewCreate {
+	_c.mutation.SetReasons(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *ListingReviewCreate) SetNote(v string) *ListingReviewCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *ListingReviewCreate) SetNillableNote(v *string) *ListingReviewCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ListingReviewCreate) SetID(v uuid.UUID) *ListingReviewCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ListingReviewCreate) SetNillableID(v *uuid.UUID) *ListingReviewCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_c *ListingReviewCreate) SetListing(v *Listing) *ListingReviewCreate {
+	return _c.SetListingID(v.ID)
+}
+
+// Mutation returns the ListingReviewMutation object of the builder.
+func (_c *ListingReviewCreate) Mutation() *ListingReviewMutation {
+	return _c.mutation
+}
+
+// Save creates the ListingReview in the database.
+func (_c *ListingReviewCreate) Save(ctx context.Context) (*ListingReview, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ListingReviewCreate) SaveX(ctx context.Context) *ListingReview {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListingReviewCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListingReviewCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ListingReviewCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if listingreview.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized listingreview.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := listingreview.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if listingreview.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listingreview.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := listingreview.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := listingreview.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if listingreview.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized listingreview.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := listingreview.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ListingReviewCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ListingReview.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ListingReview.update_time"`)}
+	}
+	if _, ok := _c.mutation.ListingID(); !ok {
+		return &ValidationError{Name: "listing_id", err: errors.New(`ent: missing required field "ListingReview.listing_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ListingReview.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := listingreview.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ListingReview.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ListingReview.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := listingreview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ListingReview.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := listingreview.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "ListingReview.note": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ListingIDs()) == 0 {
+		return &ValidationError{Name: "listing", err: errors.New(`ent: missing required edge "ListingReview.listing"`)}
+	}
+	return nil
+}
+
+func (_c *ListingReviewCreate) sqlSave(ctx context.Context) (*ListingReview, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ListingReviewCreate) createSpec() (*ListingReview, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ListingReview{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(listingreview.Table, sqlgraph.NewFieldSpec(listingreview.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(listingreview.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(listingreview.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(listingreview.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(listingreview.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(listingreview.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.SubmittedBy(); ok {
+		_spec.SetField(listingreview.FieldSubmittedBy, field.TypeUUID, value)
+		_node.SubmittedBy = &value
+	}
+	if value, ok := _c.mutation.DecidedBy(); ok {
+		_spec.SetField(listingreview.FieldDecidedBy, field.TypeUUID, value)
+		_node.DecidedBy = &value
+	}
+	if value, ok := _c.mutation.DecidedAt(); ok {
+		_spec.SetField(listingreview.FieldDecidedAt, field.TypeTime, value)
+		_node.DecidedAt = &value
+	}
+	if value, ok := _c.mutation.Reasons(); ok {
+		_spec.SetField(listingreview.FieldReasons, field.TypeJSON, value)
+		_node.Reasons = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(listingreview.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if nodes := _c.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingreview.ListingTable,
+			Columns: []string{listingreview.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListingID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ListingReview.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingReviewUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingReviewCreate) OnConflict(opts ...sql.ConflictOption) *ListingReviewUpsertOne {
+	_c.conflict = opts
+	return &ListingReviewUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ListingReview.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingReviewCreate) OnConflictColumns(columns ...string) *ListingReviewUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingReviewUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ListingReviewUpsertOne is the builder for "upsert"-ing
+	//  one ListingReview node.
+	ListingReviewUpsertOne struct {
+		create *ListingReviewCreate
+	}
+
+	// ListingReviewUpsert is the "OnConflict" setter.
+	ListingReviewUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingReviewUpsert) SetUpdateTime(v time.Time) *ListingReviewUpsert {
+	u.Set(listingreview.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingReviewUpsert) UpdateUpdateTime() *ListingReviewUpsert {
+	u.SetExcluded(listingreview.FieldUpdateTime)
+	return u
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingReviewUpsert) SetListingID(v uuid.UUID) *ListingReviewUpsert {
+	u.Set(listingreview.FieldListingID, v)
+	return u
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingReviewUpsert) UpdateListingID() *ListingReviewUpsert {
+	u.SetExcluded(listingreview.FieldListingID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *ListingReviewUpsert) SetKind(v listingreview.Kind) *ListingReviewUpsert {
+	u.Set(listingreview.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ListingReviewUpsert) UpdateKind() *ListingReviewUpsert {
+	u.SetExcluded(listingreview.FieldKind)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingReviewUpsert) SetStatus(v listingreview.Status) *ListingReviewUpsert {
+	u.Set(listingreview.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingReviewUpsert) UpdateStatus() *ListingReviewUpsert {
+	u.SetExcluded(listingreview.FieldStatus)
+	return u
+}
+
+// SetChanges sets the "changes" field.
+func (u *ListingReviewUpsert) SetChanges(v schematype.ListingChanges) *ListingReviewUpsert {
+	u.Set(listingreview.FieldChanges, v)
+	return u
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *ListingReviewUpsert) UpdateChanges() *ListingReviewUpsert {
+	u.SetExcluded(listingreview.FieldChanges)
+	return u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (u *ListingReviewUpsert) ClearChanges() *ListingReviewUpsert {
+	u.SetNull(listingreview.FieldChanges)
+	return u
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (u *ListingReviewUpsert) SetSubmittedBy(v uuid.UUID) *ListingReviewUpsert {
+	u.Set(listingreview.FieldSubmittedBy, v)
+	return u
+}
+
+// UpdateSubmittedBy sets the "submitted_by" field to the value that was provided on create.
+func (u *ListingReviewUpsert) UpdateSubmittedBy() *ListingReviewUpsert {
+	u.SetExcluded(listingreview.FieldSubmittedBy)
+	return u
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (u *ListingReviewUpsert) ClearSubmittedBy() *ListingReviewUpsert {
+	u.SetNull(listingreview.FieldSubmittedBy)
+	return u
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (u *ListingReviewUpsert) SetDecidedBy(v uuid.UUID) *ListingReviewUpsert {
+	u.Set(listingreview.FieldDecidedBy, v)
+	return u
+}
+
+// UpdateDecidedBy sets the "decided_by" field to the value that was provided on create.
+func (u *ListingReviewUpsert) UpdateDecidedBy() *ListingReviewUpsert {
+	u.SetExcluded(listingreview.FieldDecidedBy)
+	return u
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (u *ListingReviewUpsert) ClearDecidedBy() *ListingReviewUpsert {
+	u.SetNull(listingreview.FieldDecidedBy)
+	return u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *ListingReviewUpsert) SetDecidedAt(v time.Time) *ListingReviewUpsert {
+	u.Set(listingreview.FieldDecidedAt, v)
+	return u
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *ListingReviewUpsert) UpdateDecidedAt() *ListingReviewUpsert {
+	u.SetExcluded(listingreview.FieldDecidedAt)
+	return u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *ListingReviewUpsert) ClearDecidedAt() *ListingReviewUpsert {
+	u.SetNull(listingreview.FieldDecidedAt)
+	return u
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ListingReviewUpsert) SetReasons(v []string) *ListingReviewUpsert {
+	u.Set(listingreview.FieldReasons, v)
+	return u
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ListingReviewUpsert) UpdateReasons() *ListingReviewUpsert {
+	u.SetExcluded(listingreview.FieldReasons)
+	return u
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ListingReviewUpsert) ClearReasons() *ListingReviewUpsert {
+	u.SetNull(listingreview.FieldReasons)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *ListingReviewUpsert) SetNote(v string) *ListingReviewUpsert {
+	u.Set(listingreview.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *ListingReviewUpsert) UpdateNote() *ListingReviewUpsert {
+	u.SetExcluded(listingreview.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *ListingReviewUpsert) ClearNote() *ListingReviewUpsert {
+	u.SetNull(listingreview.FieldNote)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ListingReview.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listingreview.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingReviewUpsertOne) UpdateNewValues() *ListingReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(listingreview.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(listingreview.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ListingReview.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ListingReviewUpsertOne) Ignore() *ListingReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingReviewUpsertOne) DoNothing() *ListingReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingReviewCreate.OnConflict
+// documentation for more info.
+func (u *ListingReviewUpsertOne) Update(set func(*ListingReviewUpsert)) *ListingReviewUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingReviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingReviewUpsertOne) SetUpdateTime(v time.Time) *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingReviewUpsertOne) UpdateUpdateTime() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingReviewUpsertOne) SetListingID(v uuid.UUID) *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingReviewUpsertOne) UpdateListingID() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ListingReviewUpsertOne) SetKind(v listingreview.Kind) *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ListingReviewUpsertOne) UpdateKind() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingReviewUpsertOne) SetStatus(v listingreview.Status) *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingReviewUpsertOne) UpdateStatus() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetChanges sets the "changes" field.
+func (u *ListingReviewUpsertOne) SetChanges(v schematype.ListingChanges) *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetChanges(v)
+	})
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *ListingReviewUpsertOne) UpdateChanges() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateChanges()
+	})
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (u *ListingReviewUpsertOne) ClearChanges() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearChanges()
+	})
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (u *ListingReviewUpsertOne) SetSubmittedBy(v uuid.UUID) *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetSubmittedBy(v)
+	})
+}
+
+// UpdateSubmittedBy sets the "submitted_by" field to the value that was provided on create.
+func (u *ListingReviewUpsertOne) UpdateSubmittedBy() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateSubmittedBy()
+	})
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (u *ListingReviewUpsertOne) ClearSubmittedBy() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearSubmittedBy()
+	})
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (u *ListingReviewUpsertOne) SetDecidedBy(v uuid.UUID) *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetDecidedBy(v)
+	})
+}
+
+// UpdateDecidedBy sets the "decided_by" field to the value that was provided on create.
+func (u *ListingReviewUpsertOne) UpdateDecidedBy() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateDecidedBy()
+	})
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (u *ListingReviewUpsertOne) ClearDecidedBy() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearDecidedBy()
+	})
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *ListingReviewUpsertOne) SetDecidedAt(v time.Time) *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetDecidedAt(v)
+	})
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *ListingReviewUpsertOne) UpdateDecidedAt() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateDecidedAt()
+	})
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *ListingReviewUpsertOne) ClearDecidedAt() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearDecidedAt()
+	})
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ListingReviewUpsertOne) SetReasons(v []string) *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetReasons(v)
+	})
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ListingReviewUpsertOne) UpdateReasons() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateReasons()
+	})
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ListingReviewUpsertOne) ClearReasons() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearReasons()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *ListingReviewUpsertOne) SetNote(v string) *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *ListingReviewUpsertOne) UpdateNote() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *ListingReviewUpsertOne) ClearNote() *ListingReviewUpsertOne {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingReviewUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingReviewCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingReviewUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ListingReviewUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ListingReviewUpsertOne.ID is not supported by MySQL driver. Use ListingReviewUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ListingReviewUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ListingReviewCreateBulk is the builder for creating many ListingReview entities in bulk.
+type ListingReviewCreateBulk struct {
+	config
+	err      error
+	builders []*ListingReviewCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ListingReview entities in the database.
+func (_c *ListingReviewCreateBulk) Save(ctx context.Context) ([]*ListingReview, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ListingReview, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ListingReviewMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ListingReviewCreateBulk) SaveX(ctx context.Context) []*ListingReview {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListingReviewCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListingReviewCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ListingReview.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingReviewUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingReviewCreateBulk) OnConflict(opts ...sql.ConflictOption) *ListingReviewUpsertBulk {
+	_c.conflict = opts
+	return &ListingReviewUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ListingReview.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingReviewCreateBulk) OnConflictColumns(columns ...string) *ListingReviewUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingReviewUpsertBulk{
+		create: _c,
+	}
+}
+
+// ListingReviewUpsertBulk is the builder for "upsert"-ing
+// a bulk of ListingReview nodes.
+type ListingReviewUpsertBulk struct {
+	create *ListingReviewCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ListingReview.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listingreview.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingReviewUpsertBulk) UpdateNewValues() *ListingReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(listingreview.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(listingreview.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ListingReview.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ListingReviewUpsertBulk) Ignore() *ListingReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingReviewUpsertBulk) DoNothing() *ListingReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingReviewCreateBulk.OnConflict
+// documentation for more info.
+func (u *ListingReviewUpsertBulk) Update(set func(*ListingReviewUpsert)) *ListingReviewUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingReviewUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ListingReviewUpsertBulk) SetUpdateTime(v time.Time) *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ListingReviewUpsertBulk) UpdateUpdateTime() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingReviewUpsertBulk) SetListingID(v uuid.UUID) *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingReviewUpsertBulk) UpdateListingID() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ListingReviewUpsertBulk) SetKind(v listingreview.Kind) *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ListingReviewUpsertBulk) UpdateKind() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ListingReviewUpsertBulk) SetStatus(v listingreview.Status) *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ListingReviewUpsertBulk) UpdateStatus() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetChanges sets the "changes" field.
+func (u *ListingReviewUpsertBulk) SetChanges(v schematype.ListingChanges) *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetChanges(v)
+	})
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *ListingReviewUpsertBulk) UpdateChanges() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateChanges()
+	})
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (u *ListingReviewUpsertBulk) ClearChanges() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearChanges()
+	})
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (u *ListingReviewUpsertBulk) SetSubmittedBy(v uuid.UUID) *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetSubmittedBy(v)
+	})
+}
+
+// UpdateSubmittedBy sets the "submitted_by" field to the value that was provided on create.
+func (u *ListingReviewUpsertBulk) UpdateSubmittedBy() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateSubmittedBy()
+	})
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (u *ListingReviewUpsertBulk) ClearSubmittedBy() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearSubmittedBy()
+	})
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (u *ListingReviewUpsertBulk) SetDecidedBy(v uuid.UUID) *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetDecidedBy(v)
+	})
+}
+
+// UpdateDecidedBy sets the "decided_by" field to the value that was provided on create.
+func (u *ListingReviewUpsertBulk) UpdateDecidedBy() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateDecidedBy()
+	})
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (u *ListingReviewUpsertBulk) ClearDecidedBy() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearDecidedBy()
+	})
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (u *ListingReviewUpsertBulk) SetDecidedAt(v time.Time) *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetDecidedAt(v)
+	})
+}
+
+// UpdateDecidedAt sets the "decided_at" field to the value that was provided on create.
+func (u *ListingReviewUpsertBulk) UpdateDecidedAt() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateDecidedAt()
+	})
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (u *ListingReviewUpsertBulk) ClearDecidedAt() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearDecidedAt()
+	})
+}
+
+// SetReasons sets the "reasons" field.
+func (u *ListingReviewUpsertBulk) SetReasons(v []string) *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetReasons(v)
+	})
+}
+
+// UpdateReasons sets the "reasons" field to the value that was provided on create.
+func (u *ListingReviewUpsertBulk) UpdateReasons() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateReasons()
+	})
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (u *ListingReviewUpsertBulk) ClearReasons() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearReasons()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *ListingReviewUpsertBulk) SetNote(v string) *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *ListingReviewUpsertBulk) UpdateNote() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *ListingReviewUpsertBulk) ClearNote() *ListingReviewUpsertBulk {
+	return u.Update(func(s *ListingReviewUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingReviewUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ListingReviewCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingReviewCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingReviewUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
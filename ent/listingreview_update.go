@@ -0,0 +1,774 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// ListingReviewUpdate is the builder for updating ListingReview entities.
+type ListingReviewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ListingReviewMutation
+}
+
+// Where appends a list predicates to the ListingReviewUpdate builder.
+func (_u *ListingReviewUpdate) Where(ps ...predicate.ListingReview) *ListingReviewUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ListingReviewUpdate) SetUpdateTime(v time.Time) *ListingReviewUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *ListingReviewUpdate) SetListingID(v uuid.UUID) *ListingReviewUpdate {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *ListingReviewUpdate) SetNillableListingID(v *uuid.UUID) *ListingReviewUpdate {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ListingReviewUpdate) SetKind(v listingreview.Kind) *ListingReviewUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ListingReviewUpdate) SetNillableKind(v *listingreview.Kind) *ListingReviewUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ListingReviewUpdate) SetStatus(v listingreview.Status) *ListingReviewUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ListingReviewUpdate) SetNillableStatus(v *listingreview.Status) *ListingReviewUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *ListingReviewUpdate) SetChanges(v schematype.ListingChanges) *ListingReviewUpdate {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_u *ListingReviewUpdate) SetNillableChanges(v *schematype.ListingChanges) *ListingReviewUpdate {
+	if v != nil {
+		_u.SetChanges(*v)
+	}
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *ListingReviewUpdate) ClearChanges() *ListingReviewUpdate {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_u *ListingReviewUpdate) SetSubmittedBy(v uuid.UUID) *ListingReviewUpdate {
+	_u.mutation.SetSubmittedBy(v)
+	return _u
+}
+
+// SetNillableSubmittedBy sets the "submitted_by" field if the given value is not nil.
+func (_u *ListingReviewUpdate) SetNillableSubmittedBy(v *uuid.UUID) *ListingReviewUpdate {
+	if v != nil {
+		_u.SetSubmittedBy(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (_u *ListingReviewUpdate) ClearSubmittedBy() *ListingReviewUpdate {
+	_u.mutation.ClearSubmittedBy()
+	return _u
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (_u *ListingReviewUpdate) SetDecidedBy(v uuid.UUID) *ListingReviewUpdate {
+	_u.mutation.SetDecidedBy(v)
+	return _u
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (_u *ListingReviewUpdate) SetNillableDecidedBy(v *uuid.UUID) *ListingReviewUpdate {
+	if v != nil {
+		_u.SetDecidedBy(*v)
+	}
+	return _u
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (_u *ListingReviewUpdate) ClearDecidedBy() *ListingReviewUpdate {
+	_u.mutation.ClearDecidedBy()
+	return _u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_u *ListingReviewUpdate) SetDecidedAt(v time.Time) *ListingReviewUpdate {
+	_u.mutation.SetDecidedAt(v)
+	return _u
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_u *ListingReviewUpdate) SetNillableDecidedAt(v *time.Time) *ListingReviewUpdate {
+	if v != nil {
+		_u.SetDecidedAt(*v)
+	}
+	return _u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (_u *ListingReviewUpdate) ClearDecidedAt() *ListingReviewUpdate {
+	_u.mutation.ClearDecidedAt()
+	return _u
+}
+
+// SetReasons sets the "reasons" field.
+func (_u *ListingReviewUpdate) SetReasons(v []string) *ListingReviewUpdate {
+	_u.mutation.SetReasons(v)
+	return _u
+}
+
+// AppendReasons appends value to the "reasons" field.
+func (_u *ListingReviewUpdate) AppendReasons(v []string) *ListingReviewUpdate {
+	_u.mutation.AppendReasons(v)
+	return _u
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (_u *ListingReviewUpdate) ClearReasons() *ListingReviewUpdate {
+	_u.mutation.ClearReasons()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *ListingReviewUpdate) SetNote(v string) *ListingReviewUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *ListingReviewUpdate) SetNillableNote(v *string) *ListingReviewUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *ListingReviewUpdate) ClearNote() *ListingReviewUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *ListingReviewUpdate) SetListing(v *Listing) *ListingReviewUpdate {
+	return _u.SetListingID(v.ID)
+}
+
+// Mutation returns the ListingReviewMutation object of the builder.
+func (_u *ListingReviewUpdate) Mutation() *ListingReviewMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *ListingReviewUpdate) ClearListing() *ListingReviewUpdate {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ListingReviewUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListingReviewUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ListingReviewUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListingReviewUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ListingReviewUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if listingreview.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listingreview.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := listingreview.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListingReviewUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := listingreview.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ListingReview.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := listingreview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ListingReview.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := listingreview.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "ListingReview.note": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingReview.listing"`)
+	}
+	return nil
+}
+
+func (_u *ListingReviewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listingreview.Table, listingreview.Columns, sqlgraph.NewFieldSpec(listingreview.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(listingreview.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(listingreview.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(listingreview.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(listingreview.FieldChanges, field.TypeJSON, value)
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(listingreview.FieldChanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SubmittedBy(); ok {
+		_spec.SetField(listingreview.FieldSubmittedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.SubmittedByCleared() {
+		_spec.ClearField(listingreview.FieldSubmittedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DecidedBy(); ok {
+		_spec.SetField(listingreview.FieldDecidedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.DecidedByCleared() {
+		_spec.ClearField(listingreview.FieldDecidedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DecidedAt(); ok {
+		_spec.SetField(listingreview.FieldDecidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecidedAtCleared() {
+		_spec.ClearField(listingreview.FieldDecidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Reasons(); ok {
+		_spec.SetField(listingreview.FieldReasons, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReasons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, listingreview.FieldReasons, value)
+		})
+	}
+	if _u.mutation.ReasonsCleared() {
+		_spec.ClearField(listingreview.FieldReasons, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(listingreview.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(listingreview.FieldNote, field.TypeString)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingreview.ListingTable,
+			Columns: []string{listingreview.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingreview.ListingTable,
+			Columns: []string{listingreview.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listingreview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ListingReviewUpdateOne is the builder for updating a single ListingReview entity.
+type ListingReviewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ListingReviewMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ListingReviewUpdateOne) SetUpdateTime(v time.Time) *ListingReviewUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *ListingReviewUpdateOne) SetListingID(v uuid.UUID) *ListingReviewUpdateOne {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *ListingReviewUpdateOne) SetNillableListingID(v *uuid.UUID) *ListingReviewUpdateOne {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ListingReviewUpdateOne) SetKind(v listingreview.Kind) *ListingReviewUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ListingReviewUpdateOne) SetNillableKind(v *listingreview.Kind) *ListingReviewUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ListingReviewUpdateOne) SetStatus(v listingreview.Status) *ListingReviewUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ListingReviewUpdateOne) SetNillableStatus(v *listingreview.Status) *ListingReviewUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetChanges sets the "changes" field.
+func (_u *ListingReviewUpdateOne) SetChanges(v schematype.ListingChanges) *ListingReviewUpdateOne {
+	_u.mutation.SetChanges(v)
+	return _u
+}
+
+// SetNillableChanges sets the "changes" field if the given value is not nil.
+func (_u *ListingReviewUpdateOne) SetNillableChanges(v *schematype.ListingChanges) *ListingReviewUpdateOne {
+	if v != nil {
+		_u.SetChanges(*v)
+	}
+	return _u
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (_u *ListingReviewUpdateOne) ClearChanges() *ListingReviewUpdateOne {
+	_u.mutation.ClearChanges()
+	return _u
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_u *ListingReviewUpdateOne) SetSubmittedBy(v uuid.UUID) *ListingReviewUpdateOne {
+	_u.mutation.SetSubmittedBy(v)
+	return _u
+}
+
+// SetNillableSubmittedBy sets the "submitted_by" field if the given value is not nil.
+func (_u *ListingReviewUpdateOne) SetNillableSubmittedBy(v *uuid.UUID) *ListingReviewUpdateOne {
+	if v != nil {
+		_u.SetSubmittedBy(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (_u *ListingReviewUpdateOne) ClearSubmittedBy() *ListingReviewUpdateOne {
+	_u.mutation.ClearSubmittedBy()
+	return _u
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (_u *ListingReviewUpdateOne) SetDecidedBy(v uuid.UUID) *ListingReviewUpdateOne {
+	_u.mutation.SetDecidedBy(v)
+	return _u
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (_u *ListingReviewUpdateOne) SetNillableDecidedBy(v *uuid.UUID) *ListingReviewUpdateOne {
+	if v != nil {
+		_u.SetDecidedBy(*v)
+	}
+	return _u
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (_u *ListingReviewUpdateOne) ClearDecidedBy() *ListingReviewUpdateOne {
+	_u.mutation.ClearDecidedBy()
+	return _u
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (_u *ListingReviewUpdateOne) SetDecidedAt(v time.Time) *ListingReviewUpdateOne {
+	_u.mutation.SetDecidedAt(v)
+	return _u
+}
+
+// SetNillableDecidedAt sets the "decided_at" field if the given value is not nil.
+func (_u *ListingReviewUpdateOne) SetNillableDecidedAt(v *time.Time) *ListingReviewUpdateOne {
+	if v != nil {
+		_u.SetDecidedAt(*v)
+	}
+	return _u
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (_u *ListingReviewUpdateOne) ClearDecidedAt() *ListingReviewUpdateOne {
+	_u.mutation.ClearDecidedAt()
+	return _u
+}
+
+// SetReasons sets the "reasons" field.
+func (_u *ListingReviewUpdateOne) SetReasons(v []string) *ListingReviewUpdateOne {
+	_u.mutation.SetReasons(v)
+	return _u
+}
+
+// AppendReasons appends value to the "reasons" field.
+func (_u *ListingReviewUpdateOne) AppendReasons(v []string) *ListingReviewUpdateOne {
+	_u.mutation.AppendReasons(v)
+	return _u
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (_u *ListingReviewUpdateOne) ClearReasons() *ListingReviewUpdateOne {
+	_u.mutation.ClearReasons()
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *ListingReviewUpdateOne) SetNote(v string) *ListingReviewUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *ListingReviewUpdateOne) SetNillableNote(v *string) *ListingReviewUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *ListingReviewUpdateOne) ClearNote() *ListingReviewUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *ListingReviewUpdateOne) SetListing(v *Listing) *ListingReviewUpdateOne {
+	return _u.SetListingID(v.ID)
+}
+
+// Mutation returns the ListingReviewMutation object of the builder.
+func (_u *ListingReviewUpdateOne) Mutation() *ListingReviewMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *ListingReviewUpdateOne) ClearListing() *ListingReviewUpdateOne {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// Where appends a list predicates to the ListingReviewUpdate builder.
+func (_u *ListingReviewUpdateOne) Where(ps ...predicate.ListingReview) *ListingReviewUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ListingReviewUpdateOne) Select(field string, fields ...string) *ListingReviewUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ListingReview entity.
+func (_u *ListingReviewUpdateOne) Save(ctx context.Context) (*ListingReview, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListingReviewUpdateOne) SaveX(ctx context.Context) *ListingReview {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ListingReviewUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListingReviewUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ListingReviewUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if listingreview.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized listingreview.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := listingreview.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListingReviewUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := listingreview.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ListingReview.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := listingreview.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ListingReview.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := listingreview.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "ListingReview.note": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingReview.listing"`)
+	}
+	return nil
+}
+
+func (_u *ListingReviewUpdateOne) sqlSave(ctx context.Context) (_node *ListingReview, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listingreview.Table, listingreview.Columns, sqlgraph.NewFieldSpec(listingreview.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ListingReview.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, listingreview.FieldID)
+		for _, f := range fields {
+			if !listingreview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != listingreview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(listingreview.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(listingreview.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(listingreview.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Changes(); ok {
+		_spec.SetField(listingreview.FieldChanges, field.TypeJSON, value)
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(listingreview.FieldChanges, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.SubmittedBy(); ok {
+		_spec.SetField(listingreview.FieldSubmittedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.SubmittedByCleared() {
+		_spec.ClearField(listingreview.FieldSubmittedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DecidedBy(); ok {
+		_spec.SetField(listingreview.FieldDecidedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.DecidedByCleared() {
+		_spec.ClearField(listingreview.FieldDecidedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DecidedAt(); ok {
+		_spec.SetField(listingreview.FieldDecidedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DecidedAtCleared() {
+		_spec.ClearField(listingreview.FieldDecidedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Reasons(); ok {
+		_spec.SetField(listingreview.FieldReasons, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReasons(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, listingreview.FieldReasons, value)
+		})
+	}
+	if _u.mutation.ReasonsCleared() {
+		_spec.ClearField(listingreview.FieldReasons, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(listingreview.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(listingreview.FieldNote, field.TypeString)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingreview.ListingTable,
+			Columns: []string{listingreview.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingreview.ListingTable,
+			Columns: []string{listingreview.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ListingReview{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listingreview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
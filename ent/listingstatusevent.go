@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingstatusevent"
+)
+
+// ListingStatusEvent is the model entity for the ListingStatusEvent schema.
+type ListingStatusEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ListingID holds the value of the "listing_id" field.
+	ListingID uuid.UUID `json:"listing_id,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus string `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus string `json:"to_status,omitempty"`
+	// ChangedBy holds the value of the "changed_by" field.
+	ChangedBy *uuid.UUID `json:"changed_by,omitempty"`
+	// ChangedAt holds the value of the "changed_at" field.
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ListingStatusEventQuery when eager-loading is set.
+	Edges        ListingStatusEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ListingStatusEventEdges holds the relations/edges for other nodes in the graph.
+type ListingStatusEventEdges struct {
+	// Listing holds the value of the listing edge.
+	Listing *Listing `json:"listing,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ListingOrErr returns the Listing value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ListingStatusEventEdges) ListingOrErr() (*Listing, error) {
+	if e.Listing != nil {
+		return e.Listing, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: listing.Label}
+	}
+	return nil, &NotLoadedError{edge: "listing"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ListingStatusEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case listingstatusevent.FieldChangedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case listingstatusevent.FieldFromStatus, listingstatusevent.FieldToStatus:
+			values[i] = new(sql.NullString)
+		case listingstatusevent.FieldChangedAt:
+			values[i] = new(sql.NullTime)
+		case listingstatusevent.FieldID, listingstatusevent.FieldListingID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ListingStatusEvent fields.
+func (_m *ListingStatusEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case listingstatusevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case listingstatusevent.FieldListingID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field listing_id", values[i])
+			} else if value != nil {
+				_m.ListingID = *value
+			}
+		case listingstatusevent.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				_m.FromStatus = value.String
+			}
+		case listingstatusevent.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				_m.ToStatus = value.String
+			}
+		case listingstatusevent.FieldChangedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by", values[i])
+			} else if value.Valid {
+				_m.ChangedBy = new(uuid.UUID)
+				*_m.ChangedBy = *value.S.(*uuid.UUID)
+			}
+		case listingstatusevent.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				_m.ChangedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ListingStatusEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *ListingStatusEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryListing queries the "listing" edge of the ListingStatusEvent entity.
+func (_m *ListingStatusEvent) QueryListing() *ListingQuery {
+	return NewListingStatusEventClient(_m.config).QueryListing(_m)
+}
+
+// Update returns a builder for updating this ListingStatusEvent.
+// Note that you need to call ListingStatusEvent.Unwrap() before calling this method if this ListingStatusEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ListingStatusEvent) Update() *ListingStatusEventUpdateOne {
+	return NewListingStatusEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ListingStatusEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ListingStatusEvent) Unwrap() *ListingStatusEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ListingStatusEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ListingStatusEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ListingStatusEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("listing_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ListingID))
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(_m.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(_m.ToStatus)
+	builder.WriteString(", ")
+	if v := _m.ChangedBy; v != nil {
+		builder.WriteString("changed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(_m.ChangedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ListingStatusEvents is a parsable slice of ListingStatusEvent.
+type ListingStatusEvents []*ListingStatusEvent
@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package listingstatusevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the listingstatusevent type in the database.
+	Label = "listing_status_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldListingID holds the string denoting the listing_id field in the database.
+	FieldListingID = "listing_id"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldChangedBy holds the string denoting the changed_by field in the database.
+	FieldChangedBy = "changed_by"
+	// FieldChangedAt holds the string denoting the changed_at field in the database.
+	FieldChangedAt = "changed_at"
+	// EdgeListing holds the string denoting the listing edge name in mutations.
+	EdgeListing = "listing"
+	// Table holds the table name of the listingstatusevent in the database.
+	Table = "listing_status_events"
+	// ListingTable is the table that holds the listing relation/edge.
+	ListingTable = "listing_status_events"
+	// ListingInverseTable is the table name for the Listing entity.
+	// It exists in this package in order to avoid circular dependency with the "listing" package.
+	ListingInverseTable = "listings"
+	// ListingColumn is the table column denoting the listing relation/edge.
+	ListingColumn = "listing_id"
+)
+
+// Columns holds all SQL columns for listingstatusevent fields.
+var Columns = []string{
+	FieldID,
+	FieldListingID,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldChangedBy,
+	FieldChangedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// FromStatusValidator is a validator for the "from_status" field. It is called by the builders before save.
+	FromStatusValidator func(string) error
+	// ToStatusValidator is a validator for the "to_status" field. It is called by the builders before save.
+	ToStatusValidator func(string) error
+	// DefaultChangedAt holds the default value on creation for the "changed_at" field.
+	DefaultChangedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ListingStatusEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByListingID orders the results by the listing_id field.
+func ByListingID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListingID, opts...).ToFunc()
+}
+
+// ByFromStatus orders the results by the from_status field.
+func ByFromStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromStatus, opts...).ToFunc()
+}
+
+// ByToStatus orders the results by the to_status field.
+func ByToStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToStatus, opts...).ToFunc()
+}
+
+// ByChangedBy orders the results by the changed_by field.
+func ByChangedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedBy, opts...).ToFunc()
+}
+
+// ByChangedAt orders the results by the changed_at field.
+func ByChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedAt, opts...).ToFunc()
+}
+
+// ByListingField orders the results by listing field.
+func ByListingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListingStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newListingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ListingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ListingTable, ListingColumn),
+	)
+}
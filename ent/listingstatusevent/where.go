@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package listingstatusevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldLTE(FieldID, id))
+}
+
+// ListingID applies equality check predicate on the "listing_id" field. It's identical to ListingIDEQ.
+func ListingID(v uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldListingID, v))
+}
+
+// FromStatus applies equality check predicate on the "from_status" field. It's identical to FromStatusEQ.
+func FromStatus(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// ToStatus applies equality check predicate on the "to_status" field. It's identical to ToStatusEQ.
+func ToStatus(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ChangedBy applies equality check predicate on the "changed_by" field. It's identical to ChangedByEQ.
+func ChangedBy(v uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// ChangedAt applies equality check predicate on the "changed_at" field. It's identical to ChangedAtEQ.
+func ChangedAt(v time.Time) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ListingIDEQ applies the EQ predicate on the "listing_id" field.
+func ListingIDEQ(v uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldListingID, v))
+}
+
+// ListingIDNEQ applies the NEQ predicate on the "listing_id" field.
+func ListingIDNEQ(v uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNEQ(FieldListingID, v))
+}
+
+// ListingIDIn applies the In predicate on the "listing_id" field.
+func ListingIDIn(vs ...uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldIn(FieldListingID, vs...))
+}
+
+// ListingIDNotIn applies the NotIn predicate on the "listing_id" field.
+func ListingIDNotIn(vs ...uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNotIn(FieldListingID, vs...))
+}
+
+// FromStatusEQ applies the EQ predicate on the "from_status" field.
+func FromStatusEQ(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// FromStatusNEQ applies the NEQ predicate on the "from_status" field.
+func FromStatusNEQ(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNEQ(FieldFromStatus, v))
+}
+
+// FromStatusIn applies the In predicate on the "from_status" field.
+func FromStatusIn(vs ...string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldIn(FieldFromStatus, vs...))
+}
+
+// FromStatusNotIn applies the NotIn predicate on the "from_status" field.
+func FromStatusNotIn(vs ...string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNotIn(FieldFromStatus, vs...))
+}
+
+// FromStatusGT applies the GT predicate on the "from_status" field.
+func FromStatusGT(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldGT(FieldFromStatus, v))
+}
+
+// FromStatusGTE applies the GTE predicate on the "from_status" field.
+func FromStatusGTE(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldGTE(FieldFromStatus, v))
+}
+
+// FromStatusLT applies the LT predicate on the "from_status" field.
+func FromStatusLT(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldLT(FieldFromStatus, v))
+}
+
+// FromStatusLTE applies the LTE predicate on the "from_status" field.
+func FromStatusLTE(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldLTE(FieldFromStatus, v))
+}
+
+// FromStatusContains applies the Contains predicate on the "from_status" field.
+func FromStatusContains(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldContains(FieldFromStatus, v))
+}
+
+// FromStatusHasPrefix applies the HasPrefix predicate on the "from_status" field.
+func FromStatusHasPrefix(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldHasPrefix(FieldFromStatus, v))
+}
+
+// FromStatusHasSuffix applies the HasSuffix predicate on the "from_status" field.
+func FromStatusHasSuffix(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldHasSuffix(FieldFromStatus, v))
+}
+
+// FromStatusIsNil applies the IsNil predicate on the "from_status" field.
+func FromStatusIsNil() predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldIsNull(FieldFromStatus))
+}
+
+// FromStatusNotNil applies the NotNil predicate on the "from_status" field.
+func FromStatusNotNil() predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNotNull(FieldFromStatus))
+}
+
+// FromStatusEqualFold applies the EqualFold predicate on the "from_status" field.
+func FromStatusEqualFold(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEqualFold(FieldFromStatus, v))
+}
+
+// FromStatusContainsFold applies the ContainsFold predicate on the "from_status" field.
+func FromStatusContainsFold(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldContainsFold(FieldFromStatus, v))
+}
+
+// ToStatusEQ applies the EQ predicate on the "to_status" field.
+func ToStatusEQ(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ToStatusNEQ applies the NEQ predicate on the "to_status" field.
+func ToStatusNEQ(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNEQ(FieldToStatus, v))
+}
+
+// ToStatusIn applies the In predicate on the "to_status" field.
+func ToStatusIn(vs ...string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldIn(FieldToStatus, vs...))
+}
+
+// ToStatusNotIn applies the NotIn predicate on the "to_status" field.
+func ToStatusNotIn(vs ...string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNotIn(FieldToStatus, vs...))
+}
+
+// ToStatusGT applies the GT predicate on the "to_status" field.
+func ToStatusGT(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldGT(FieldToStatus, v))
+}
+
+// ToStatusGTE applies the GTE predicate on the "to_status" field.
+func ToStatusGTE(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldGTE(FieldToStatus, v))
+}
+
+// ToStatusLT applies the LT predicate on the "to_status" field.
+func ToStatusLT(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldLT(FieldToStatus, v))
+}
+
+// ToStatusLTE applies the LTE predicate on the "to_status" field.
+func ToStatusLTE(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldLTE(FieldToStatus, v))
+}
+
+// ToStatusContains applies the Contains predicate on the "to_status" field.
+func ToStatusContains(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldContains(FieldToStatus, v))
+}
+
+// ToStatusHasPrefix applies the HasPrefix predicate on the "to_status" field.
+func ToStatusHasPrefix(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldHasPrefix(FieldToStatus, v))
+}
+
+// ToStatusHasSuffix applies the HasSuffix predicate on the "to_status" field.
+func ToStatusHasSuffix(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldHasSuffix(FieldToStatus, v))
+}
+
+// ToStatusEqualFold applies the EqualFold predicate on the "to_status" field.
+func ToStatusEqualFold(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEqualFold(FieldToStatus, v))
+}
+
+// ToStatusContainsFold applies the ContainsFold predicate on the "to_status" field.
+func ToStatusContainsFold(v string) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldContainsFold(FieldToStatus, v))
+}
+
+// ChangedByEQ applies the EQ predicate on the "changed_by" field.
+func ChangedByEQ(v uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// ChangedByNEQ applies the NEQ predicate on the "changed_by" field.
+func ChangedByNEQ(v uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNEQ(FieldChangedBy, v))
+}
+
+// ChangedByIn applies the In predicate on the "changed_by" field.
+func ChangedByIn(vs ...uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldIn(FieldChangedBy, vs...))
+}
+
+// ChangedByNotIn applies the NotIn predicate on the "changed_by" field.
+func ChangedByNotIn(vs ...uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNotIn(FieldChangedBy, vs...))
+}
+
+// ChangedByGT applies the GT predicate on the "changed_by" field.
+func ChangedByGT(v uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldGT(FieldChangedBy, v))
+}
+
+// ChangedByGTE applies the GTE predicate on the "changed_by" field.
+func ChangedByGTE(v uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldGTE(FieldChangedBy, v))
+}
+
+// ChangedByLT applies the LT predicate on the "changed_by" field.
+func ChangedByLT(v uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldLT(FieldChangedBy, v))
+}
+
+// ChangedByLTE applies the LTE predicate on the "changed_by" field.
+func ChangedByLTE(v uuid.UUID) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldLTE(FieldChangedBy, v))
+}
+
+// ChangedByIsNil applies the IsNil predicate on the "changed_by" field.
+func ChangedByIsNil() predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldIsNull(FieldChangedBy))
+}
+
+// ChangedByNotNil applies the NotNil predicate on the "changed_by" field.
+func ChangedByNotNil() predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNotNull(FieldChangedBy))
+}
+
+// ChangedAtEQ applies the EQ predicate on the "changed_at" field.
+func ChangedAtEQ(v time.Time) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ChangedAtNEQ applies the NEQ predicate on the "changed_at" field.
+func ChangedAtNEQ(v time.Time) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNEQ(FieldChangedAt, v))
+}
+
+// ChangedAtIn applies the In predicate on the "changed_at" field.
+func ChangedAtIn(vs ...time.Time) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtNotIn applies the NotIn predicate on the "changed_at" field.
+func ChangedAtNotIn(vs ...time.Time) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldNotIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtGT applies the GT predicate on the "changed_at" field.
+func ChangedAtGT(v time.Time) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldGT(FieldChangedAt, v))
+}
+
+// ChangedAtGTE applies the GTE predicate on the "changed_at" field.
+func ChangedAtGTE(v time.Time) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldGTE(FieldChangedAt, v))
+}
+
+// ChangedAtLT applies the LT predicate on the "changed_at" field.
+func ChangedAtLT(v time.Time) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldLT(FieldChangedAt, v))
+}
+
+// ChangedAtLTE applies the LTE predicate on the "changed_at" field.
+func ChangedAtLTE(v time.Time) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.FieldLTE(FieldChangedAt, v))
+}
+
+// HasListing applies the HasEdge predicate on the "listing" edge.
+func HasListing() predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ListingTable, ListingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListingWith applies the HasEdge predicate on the "listing" edge with a given conditions (other predicates).
+func HasListingWith(preds ...predicate.Listing) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(func(s *sql.Selector) {
+		step := newListingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ListingStatusEvent) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ListingStatusEvent) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ListingStatusEvent) predicate.ListingStatusEvent {
+	return predicate.ListingStatusEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,781 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingstatusevent"
+)
+
+// ListingStatusEventCreate is the builder for creating a ListingStatusEvent entity.
+type ListingStatusEventCreate struct {
+	config
+	mutation *ListingStatusEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetListingID sets the "listing_id" field.
+func (_c *ListingStatusEventCreate) SetListingID(v uuid.UUID) *ListingStatusEventCreate {
+	_c.mutation.SetListingID(v)
+	return _c
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_c *ListingStatusEventCreate) SetFromStatus(v string) *ListingStatusEventCreate {
+	_c.mutation.SetFromStatus(v)
+	return _c
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_c *ListingStatusEventCreate) SetNillableFromStatus(v *string) *ListingStatusEventCreate {
+	if v != nil {
+		_c.SetFromStatus(*v)
+	}
+	return _c
+}
+
+// SetToStatus sets the "to_status" field.
+func (_c *ListingStatusEventCreate) SetToStatus(v string) *ListingStatusEventCreate {
+	_c.mutation.SetToStatus(v)
+	return _c
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_c *ListingStatusEventCreate) SetChangedBy(v uuid.UUID) *ListingStatusEventCreate {
+	_c.mutation.SetChangedBy(v)
+	return _c
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_c *ListingStatusEventCreate) SetNillableChangedBy(v *uuid.UUID) *ListingStatusEventCreate {
+	if v != nil {
+		_c.SetChangedBy(*v)
+	}
+	return _c
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (_c *ListingStatusEventCreate) SetChangedAt(v time.Time) *ListingStatusEventCreate {
+	_c.mutation.SetChangedAt(v)
+	return _c
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (_c *ListingStatusEventCreate) SetNillableChangedAt(v *time.Time) *ListingStatusEventCreate {
+	if v != nil {
+		_c.SetChangedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ListingStatusEventCreate) SetID(v uuid.UUID) *ListingStatusEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ListingStatusEventCreate) SetNillableID(v *uuid.UUID) *ListingStatusEventCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_c *ListingStatusEventCreate) SetListing(v *Listing) *ListingStatusEventCreate {
+	return _c.SetListingID(v.ID)
+}
+
+// Mutation returns the ListingStatusEventMutation object of the builder.
+func (_c *ListingStatusEventCreate) Mutation() *ListingStatusEventMutation {
+	return _c.mutation
+}
+
+// Save creates the ListingStatusEvent in the database.
+func (_c *ListingStatusEventCreate) Save(ctx context.Context) (*ListingStatusEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ListingStatusEventCreate) SaveX(ctx context.Context) *ListingStatusEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListingStatusEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListingStatusEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ListingStatusEventCreate) defaults() {
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		v := listingstatusevent.DefaultChangedAt()
+		_c.mutation.SetChangedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := listingstatusevent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ListingStatusEventCreate) check() error {
+	if _, ok := _c.mutation.ListingID(); !ok {
+		return &ValidationError{Name: "listing_id", err: errors.New(`ent: missing required field "ListingStatusEvent.listing_id"`)}
+	}
+	if v, ok := _c.mutation.FromStatus(); ok {
+		if err := listingstatusevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "ListingStatusEvent.from_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "ListingStatusEvent.to_status"`)}
+	}
+	if v, ok := _c.mutation.ToStatus(); ok {
+		if err := listingstatusevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "ListingStatusEvent.to_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "ListingStatusEvent.changed_at"`)}
+	}
+	if len(_c.mutation.ListingIDs()) == 0 {
+		return &ValidationError{Name: "listing", err: errors.New(`ent: missing required edge "ListingStatusEvent.listing"`)}
+	}
+	return nil
+}
+
+func (_c *ListingStatusEventCreate) sqlSave(ctx context.Context) (*ListingStatusEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ListingStatusEventCreate) createSpec() (*ListingStatusEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ListingStatusEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(listingstatusevent.Table, sqlgraph.NewFieldSpec(listingstatusevent.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.FromStatus(); ok {
+		_spec.SetField(listingstatusevent.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = value
+	}
+	if value, ok := _c.mutation.ToStatus(); ok {
+		_spec.SetField(listingstatusevent.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := _c.mutation.ChangedBy(); ok {
+		_spec.SetField(listingstatusevent.FieldChangedBy, field.TypeUUID, value)
+		_node.ChangedBy = &value
+	}
+	if value, ok := _c.mutation.ChangedAt(); ok {
+		_spec.SetField(listingstatusevent.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	if nodes := _c.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingstatusevent.ListingTable,
+			Columns: []string{listingstatusevent.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListingID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ListingStatusEvent.Create().
+//		SetListingID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingStatusEventUpsert) {
+//			SetListingID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingStatusEventCreate) OnConflict(opts ...sql.ConflictOption) *ListingStatusEventUpsertOne {
+	_c.conflict = opts
+	return &ListingStatusEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ListingStatusEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingStatusEventCreate) OnConflictColumns(columns ...string) *ListingStatusEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingStatusEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ListingStatusEventUpsertOne is the builder for "upsert"-ing
+	//  one ListingStatusEvent node.
+	ListingStatusEventUpsertOne struct {
+		create *ListingStatusEventCreate
+	}
+
+	// ListingStatusEventUpsert is the "OnConflict" setter.
+	ListingStatusEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingStatusEventUpsert) SetListingID(v uuid.UUID) *ListingStatusEventUpsert {
+	u.Set(listingstatusevent.FieldListingID, v)
+	return u
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingStatusEventUpsert) UpdateListingID() *ListingStatusEventUpsert {
+	u.SetExcluded(listingstatusevent.FieldListingID)
+	return u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (u *ListingStatusEventUpsert) SetFromStatus(v string) *ListingStatusEventUpsert {
+	u.Set(listingstatusevent.FieldFromStatus, v)
+	return u
+}
+
+// UpdateFromStatus sets the "from_status" field to the value that was provided on create.
+func (u *ListingStatusEventUpsert) UpdateFromStatus() *ListingStatusEventUpsert {
+	u.SetExcluded(listingstatusevent.FieldFromStatus)
+	return u
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (u *ListingStatusEventUpsert) ClearFromStatus() *ListingStatusEventUpsert {
+	u.SetNull(listingstatusevent.FieldFromStatus)
+	return u
+}
+
+// SetToStatus sets the "to_status" field.
+func (u *ListingStatusEventUpsert) SetToStatus(v string) *ListingStatusEventUpsert {
+	u.Set(listingstatusevent.FieldToStatus, v)
+	return u
+}
+
+// UpdateToStatus sets the "to_status" field to the value that was provided on create.
+func (u *ListingStatusEventUpsert) UpdateToStatus() *ListingStatusEventUpsert {
+	u.SetExcluded(listingstatusevent.FieldToStatus)
+	return u
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (u *ListingStatusEventUpsert) SetChangedBy(v uuid.UUID) *ListingStatusEventUpsert {
+	u.Set(listingstatusevent.FieldChangedBy, v)
+	return u
+}
+
+// UpdateChangedBy sets the "changed_by" field to the value that was provided on create.
+func (u *ListingStatusEventUpsert) UpdateChangedBy() *ListingStatusEventUpsert {
+	u.SetExcluded(listingstatusevent.FieldChangedBy)
+	return u
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (u *ListingStatusEventUpsert) ClearChangedBy() *ListingStatusEventUpsert {
+	u.SetNull(listingstatusevent.FieldChangedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ListingStatusEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listingstatusevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingStatusEventUpsertOne) UpdateNewValues() *ListingStatusEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(listingstatusevent.FieldID)
+		}
+		if _, exists := u.create.mutation.ChangedAt(); exists {
+			s.SetIgnore(listingstatusevent.FieldChangedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ListingStatusEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ListingStatusEventUpsertOne) Ignore() *ListingStatusEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingStatusEventUpsertOne) DoNothing() *ListingStatusEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingStatusEventCreate.OnConflict
+// documentation for more info.
+func (u *ListingStatusEventUpsertOne) Update(set func(*ListingStatusEventUpsert)) *ListingStatusEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingStatusEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingStatusEventUpsertOne) SetListingID(v uuid.UUID) *ListingStatusEventUpsertOne {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingStatusEventUpsertOne) UpdateListingID() *ListingStatusEventUpsertOne {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetFromStatus sets the "from_status" field.
+func (u *ListingStatusEventUpsertOne) SetFromStatus(v string) *ListingStatusEventUpsertOne {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.SetFromStatus(v)
+	})
+}
+
+// UpdateFromStatus sets the "from_status" field to the value that was provided on create.
+func (u *ListingStatusEventUpsertOne) UpdateFromStatus() *ListingStatusEventUpsertOne {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.UpdateFromStatus()
+	})
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (u *ListingStatusEventUpsertOne) ClearFromStatus() *ListingStatusEventUpsertOne {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.ClearFromStatus()
+	})
+}
+
+// SetToStatus sets the "to_status" field.
+func (u *ListingStatusEventUpsertOne) SetToStatus(v string) *ListingStatusEventUpsertOne {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.SetToStatus(v)
+	})
+}
+
+// UpdateToStatus sets the "to_status" field to the value that was provided on create.
+func (u *ListingStatusEventUpsertOne) UpdateToStatus() *ListingStatusEventUpsertOne {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.UpdateToStatus()
+	})
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (u *ListingStatusEventUpsertOne) SetChangedBy(v uuid.UUID) *ListingStatusEventUpsertOne {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.SetChangedBy(v)
+	})
+}
+
+// UpdateChangedBy sets the "changed_by" field to the value that was provided on create.
+func (u *ListingStatusEventUpsertOne) UpdateChangedBy() *ListingStatusEventUpsertOne {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.UpdateChangedBy()
+	})
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (u *ListingStatusEventUpsertOne) ClearChangedBy() *ListingStatusEventUpsertOne {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.ClearChangedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingStatusEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingStatusEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingStatusEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ListingStatusEventUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ListingStatusEventUpsertOne.ID is not supported by MySQL driver. Use ListingStatusEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ListingStatusEventUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ListingStatusEventCreateBulk is the builder for creating many ListingStatusEvent entities in bulk.
+type ListingStatusEventCreateBulk struct {
+	config
+	err      error
+	builders []*ListingStatusEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ListingStatusEvent entities in the database.
+func (_c *ListingStatusEventCreateBulk) Save(ctx context.Context) ([]*ListingStatusEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ListingStatusEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ListingStatusEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ListingStatusEventCreateBulk) SaveX(ctx context.Context) []*ListingStatusEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ListingStatusEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ListingStatusEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ListingStatusEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ListingStatusEventUpsert) {
+//			SetListingID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ListingStatusEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *ListingStatusEventUpsertBulk {
+	_c.conflict = opts
+	return &ListingStatusEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ListingStatusEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ListingStatusEventCreateBulk) OnConflictColumns(columns ...string) *ListingStatusEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ListingStatusEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// ListingStatusEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of ListingStatusEvent nodes.
+type ListingStatusEventUpsertBulk struct {
+	create *ListingStatusEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ListingStatusEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(listingstatusevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ListingStatusEventUpsertBulk) UpdateNewValues() *ListingStatusEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(listingstatusevent.FieldID)
+			}
+			if _, exists := b.mutation.ChangedAt(); exists {
+				s.SetIgnore(listingstatusevent.FieldChangedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ListingStatusEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ListingStatusEventUpsertBulk) Ignore() *ListingStatusEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ListingStatusEventUpsertBulk) DoNothing() *ListingStatusEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ListingStatusEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *ListingStatusEventUpsertBulk) Update(set func(*ListingStatusEventUpsert)) *ListingStatusEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ListingStatusEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *ListingStatusEventUpsertBulk) SetListingID(v uuid.UUID) *ListingStatusEventUpsertBulk {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *ListingStatusEventUpsertBulk) UpdateListingID() *ListingStatusEventUpsertBulk {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetFromStatus sets the "from_status" field.
+func (u *ListingStatusEventUpsertBulk) SetFromStatus(v string) *ListingStatusEventUpsertBulk {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.SetFromStatus(v)
+	})
+}
+
+// UpdateFromStatus sets the "from_status" field to the value that was provided on create.
+func (u *ListingStatusEventUpsertBulk) UpdateFromStatus() *ListingStatusEventUpsertBulk {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.UpdateFromStatus()
+	})
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (u *ListingStatusEventUpsertBulk) ClearFromStatus() *ListingStatusEventUpsertBulk {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.ClearFromStatus()
+	})
+}
+
+// SetToStatus sets the "to_status" field.
+func (u *ListingStatusEventUpsertBulk) SetToStatus(v string) *ListingStatusEventUpsertBulk {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.SetToStatus(v)
+	})
+}
+
+// UpdateToStatus sets the "to_status" field to the value that was provided on create.
+func (u *ListingStatusEventUpsertBulk) UpdateToStatus() *ListingStatusEventUpsertBulk {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.UpdateToStatus()
+	})
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (u *ListingStatusEventUpsertBulk) SetChangedBy(v uuid.UUID) *ListingStatusEventUpsertBulk {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.SetChangedBy(v)
+	})
+}
+
+// UpdateChangedBy sets the "changed_by" field to the value that was provided on create.
+func (u *ListingStatusEventUpsertBulk) UpdateChangedBy() *ListingStatusEventUpsertBulk {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.UpdateChangedBy()
+	})
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (u *ListingStatusEventUpsertBulk) ClearChangedBy() *ListingStatusEventUpsertBulk {
+	return u.Update(func(s *ListingStatusEventUpsert) {
+		s.ClearChangedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *ListingStatusEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ListingStatusEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ListingStatusEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ListingStatusEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
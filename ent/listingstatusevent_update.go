@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingstatusevent"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ListingStatusEventUpdate is the builder for updating ListingStatusEvent entities.
+type ListingStatusEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ListingStatusEventMutation
+}
+
+// Where appends a list predicates to the ListingStatusEventUpdate builder.
+func (_u *ListingStatusEventUpdate) Where(ps ...predicate.ListingStatusEvent) *ListingStatusEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *ListingStatusEventUpdate) SetListingID(v uuid.UUID) *ListingStatusEventUpdate {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *ListingStatusEventUpdate) SetNillableListingID(v *uuid.UUID) *ListingStatusEventUpdate {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_u *ListingStatusEventUpdate) SetFromStatus(v string) *ListingStatusEventUpdate {
+	_u.mutation.SetFromStatus(v)
+	return _u
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_u *ListingStatusEventUpdate) SetNillableFromStatus(v *string) *ListingStatusEventUpdate {
+	if v != nil {
+		_u.SetFromStatus(*v)
+	}
+	return _u
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (_u *ListingStatusEventUpdate) ClearFromStatus() *ListingStatusEventUpdate {
+	_u.mutation.ClearFromStatus()
+	return _u
+}
+
+// SetToStatus sets the "to_status" field.
+func (_u *ListingStatusEventUpdate) SetToStatus(v string) *ListingStatusEventUpdate {
+	_u.mutation.SetToStatus(v)
+	return _u
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (_u *ListingStatusEventUpdate) SetNillableToStatus(v *string) *ListingStatusEventUpdate {
+	if v != nil {
+		_u.SetToStatus(*v)
+	}
+	return _u
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_u *ListingStatusEventUpdate) SetChangedBy(v uuid.UUID) *ListingStatusEventUpdate {
+	_u.mutation.SetChangedBy(v)
+	return _u
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_u *ListingStatusEventUpdate) SetNillableChangedBy(v *uuid.UUID) *ListingStatusEventUpdate {
+	if v != nil {
+		_u.SetChangedBy(*v)
+	}
+	return _u
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (_u *ListingStatusEventUpdate) ClearChangedBy() *ListingStatusEventUpdate {
+	_u.mutation.ClearChangedBy()
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *ListingStatusEventUpdate) SetListing(v *Listing) *ListingStatusEventUpdate {
+	return _u.SetListingID(v.ID)
+}
+
+// Mutation returns the ListingStatusEventMutation object of the builder.
+func (_u *ListingStatusEventUpdate) Mutation() *ListingStatusEventMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *ListingStatusEventUpdate) ClearListing() *ListingStatusEventUpdate {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ListingStatusEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListingStatusEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ListingStatusEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListingStatusEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListingStatusEventUpdate) check() error {
+	if v, ok := _u.mutation.FromStatus(); ok {
+		if err := listingstatusevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "ListingStatusEvent.from_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToStatus(); ok {
+		if err := listingstatusevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "ListingStatusEvent.to_status": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingStatusEvent.listing"`)
+	}
+	return nil
+}
+
+func (_u *ListingStatusEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listingstatusevent.Table, listingstatusevent.Columns, sqlgraph.NewFieldSpec(listingstatusevent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromStatus(); ok {
+		_spec.SetField(listingstatusevent.FieldFromStatus, field.TypeString, value)
+	}
+	if _u.mutation.FromStatusCleared() {
+		_spec.ClearField(listingstatusevent.FieldFromStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.ToStatus(); ok {
+		_spec.SetField(listingstatusevent.FieldToStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChangedBy(); ok {
+		_spec.SetField(listingstatusevent.FieldChangedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ChangedByCleared() {
+		_spec.ClearField(listingstatusevent.FieldChangedBy, field.TypeUUID)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingstatusevent.ListingTable,
+			Columns: []string{listingstatusevent.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingstatusevent.ListingTable,
+			Columns: []string{listingstatusevent.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listingstatusevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ListingStatusEventUpdateOne is the builder for updating a single ListingStatusEvent entity.
+type ListingStatusEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ListingStatusEventMutation
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *ListingStatusEventUpdateOne) SetListingID(v uuid.UUID) *ListingStatusEventUpdateOne {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *ListingStatusEventUpdateOne) SetNillableListingID(v *uuid.UUID) *ListingStatusEventUpdateOne {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_u *ListingStatusEventUpdateOne) SetFromStatus(v string) *ListingStatusEventUpdateOne {
+	_u.mutation.SetFromStatus(v)
+	return _u
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_u *ListingStatusEventUpdateOne) SetNillableFromStatus(v *string) *ListingStatusEventUpdateOne {
+	if v != nil {
+		_u.SetFromStatus(*v)
+	}
+	return _u
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (_u *ListingStatusEventUpdateOne) ClearFromStatus() *ListingStatusEventUpdateOne {
+	_u.mutation.ClearFromStatus()
+	return _u
+}
+
+// SetToStatus sets the "to_status" field.
+func (_u *ListingStatusEventUpdateOne) SetToStatus(v string) *ListingStatusEventUpdateOne {
+	_u.mutation.SetToStatus(v)
+	return _u
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (_u *ListingStatusEventUpdateOne) SetNillableToStatus(v *string) *ListingStatusEventUpdateOne {
+	if v != nil {
+		_u.SetToStatus(*v)
+	}
+	return _u
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_u *ListingStatusEventUpdateOne) SetChangedBy(v uuid.UUID) *ListingStatusEventUpdateOne {
+	_u.mutation.SetChangedBy(v)
+	return _u
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (_u *ListingStatusEventUpdateOne) SetNillableChangedBy(v *uuid.UUID) *ListingStatusEventUpdateOne {
+	if v != nil {
+		_u.SetChangedBy(*v)
+	}
+	return _u
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (_u *ListingStatusEventUpdateOne) ClearChangedBy() *ListingStatusEventUpdateOne {
+	_u.mutation.ClearChangedBy()
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *ListingStatusEventUpdateOne) SetListing(v *Listing) *ListingStatusEventUpdateOne {
+	return _u.SetListingID(v.ID)
+}
+
+// Mutation returns the ListingStatusEventMutation object of the builder.
+func (_u *ListingStatusEventUpdateOne) Mutation() *ListingStatusEventMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *ListingStatusEventUpdateOne) ClearListing() *ListingStatusEventUpdateOne {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// Where appends a list predicates to the ListingStatusEventUpdate builder.
+func (_u *ListingStatusEventUpdateOne) Where(ps ...predicate.ListingStatusEvent) *ListingStatusEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ListingStatusEventUpdateOne) Select(field string, fields ...string) *ListingStatusEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ListingStatusEvent entity.
+func (_u *ListingStatusEventUpdateOne) Save(ctx context.Context) (*ListingStatusEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ListingStatusEventUpdateOne) SaveX(ctx context.Context) *ListingStatusEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ListingStatusEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ListingStatusEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ListingStatusEventUpdateOne) check() error {
+	if v, ok := _u.mutation.FromStatus(); ok {
+		if err := listingstatusevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "ListingStatusEvent.from_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToStatus(); ok {
+		if err := listingstatusevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "ListingStatusEvent.to_status": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ListingStatusEvent.listing"`)
+	}
+	return nil
+}
+
+func (_u *ListingStatusEventUpdateOne) sqlSave(ctx context.Context) (_node *ListingStatusEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(listingstatusevent.Table, listingstatusevent.Columns, sqlgraph.NewFieldSpec(listingstatusevent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ListingStatusEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, listingstatusevent.FieldID)
+		for _, f := range fields {
+			if !listingstatusevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != listingstatusevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromStatus(); ok {
+		_spec.SetField(listingstatusevent.FieldFromStatus, field.TypeString, value)
+	}
+	if _u.mutation.FromStatusCleared() {
+		_spec.ClearField(listingstatusevent.FieldFromStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.ToStatus(); ok {
+		_spec.SetField(listingstatusevent.FieldToStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChangedBy(); ok {
+		_spec.SetField(listingstatusevent.FieldChangedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ChangedByCleared() {
+		_spec.ClearField(listingstatusevent.FieldChangedBy, field.TypeUUID)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingstatusevent.ListingTable,
+			Columns: []string{listingstatusevent.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   listingstatusevent.ListingTable,
+			Columns: []string{listingstatusevent.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ListingStatusEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{listingstatusevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,29 +8,138 @@ import (
 )
 
 var (
+	// BrokeragesColumns holds the columns for the "brokerages" table.
+	BrokeragesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 150},
+		{Name: "license_number", Type: field.TypeString, Size: 50},
+		{Name: "address", Type: field.TypeString, Size: 255},
+		{Name: "city", Type: field.TypeString, Size: 100},
+		{Name: "state", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "zip_code", Type: field.TypeString, Size: 20},
+		{Name: "phone", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "website", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "logo_url", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "primary_color", Type: field.TypeString, Nullable: true, Size: 7},
+		{Name: "secondary_color", Type: field.TypeString, Nullable: true, Size: 7},
+	}
+	// BrokeragesTable holds the schema information for the "brokerages" table.
+	BrokeragesTable = &schema.Table{
+		Name:       "brokerages",
+		Columns:    BrokeragesColumns,
+		PrimaryKey: []*schema.Column{BrokeragesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "brokerage_name",
+				Unique:  true,
+				Columns: []*schema.Column{BrokeragesColumns[3]},
+			},
+			{
+				Name:    "brokerage_license_number",
+				Unique:  true,
+				Columns: []*schema.Column{BrokeragesColumns[4]},
+			},
+		},
+	}
+	// DocumentDownloadsColumns holds the columns for the "document_downloads" table.
+	DocumentDownloadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "downloaded_at", Type: field.TypeTime},
+		{Name: "document_id", Type: field.TypeUUID},
+	}
+	// DocumentDownloadsTable holds the schema information for the "document_downloads" table.
+	DocumentDownloadsTable = &schema.Table{
+		Name:       "document_downloads",
+		Columns:    DocumentDownloadsColumns,
+		PrimaryKey: []*schema.Column{DocumentDownloadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "document_downloads_listing_documents_downloads",
+				Columns:    []*schema.Column{DocumentDownloadsColumns[5]},
+				RefColumns: []*schema.Column{ListingDocumentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "documentdownload_document_id_downloaded_at",
+				Unique:  false,
+				Columns: []*schema.Column{DocumentDownloadsColumns[5], DocumentDownloadsColumns[4]},
+			},
+		},
+	}
+	// ExchangeRatesColumns holds the columns for the "exchange_rates" table.
+	ExchangeRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "currency", Type: field.TypeString, Unique: true, Size: 3},
+		{Name: "rate", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric"}},
+	}
+	// ExchangeRatesTable holds the schema information for the "exchange_rates" table.
+	ExchangeRatesTable = &schema.Table{
+		Name:       "exchange_rates",
+		Columns:    ExchangeRatesColumns,
+		PrimaryKey: []*schema.Column{ExchangeRatesColumns[0]},
+	}
 	// ListingsColumns holds the columns for the "listings" table.
 	ListingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "title", Type: field.TypeString, Size: 120},
-		{Name: "address", Type: field.TypeString, Unique: true, Size: 255},
+		{Name: "address", Type: field.TypeString, Size: 255},
+		{Name: "address_key", Type: field.TypeString, Nullable: true, Size: 300},
 		{Name: "city", Type: field.TypeString, Size: 255},
 		{Name: "state", Type: field.TypeString, Size: 3},
-		{Name: "zip_code", Type: field.TypeString, Size: 6},
+		{Name: "zip_code", Type: field.TypeString, Size: 10},
+		{Name: "country", Type: field.TypeString, Size: 2, Default: "US"},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "source_locale", Type: field.TypeString, Size: 35, Default: "en"},
+		{Name: "translations", Type: field.TypeJSON, Nullable: true},
 		{Name: "price", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric"}},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "USD"},
 		{Name: "bedroom", Type: field.TypeInt},
 		{Name: "bathroom", Type: field.TypeFloat64},
 		{Name: "garage", Type: field.TypeInt, Nullable: true},
 		{Name: "sqft", Type: field.TypeInt},
+		{Name: "sqm", Type: field.TypeInt, Nullable: true},
+		{Name: "area_unit", Type: field.TypeEnum, Enums: []string{"sqft", "sqm"}, Default: "sqft"},
 		{Name: "type_of_property", Type: field.TypeEnum, Enums: []string{"house", "apartment", "condo", "townhouse"}, Default: "house"},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"DRAFT", "PUBLISHED", "ARCHIVED"}, Default: "DRAFT"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"DRAFT", "IN_REVIEW", "SCHEDULED", "PUBLISHED", "PENDING", "SOLD", "ARCHIVED"}, Default: "DRAFT"},
 		{Name: "lot_size", Type: field.TypeInt, Nullable: true},
 		{Name: "pool", Type: field.TypeBool, Nullable: true},
 		{Name: "year_built", Type: field.TypeInt},
+		{Name: "annual_property_tax", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric"}},
+		{Name: "hoa_dues", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric"}},
+		{Name: "hoa_frequency", Type: field.TypeEnum, Nullable: true, Enums: []string{"MONTHLY", "QUARTERLY", "ANNUALLY"}},
+		{Name: "hoa_name", Type: field.TypeString, Nullable: true, Size: 120},
+		{Name: "special_assessments", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric"}},
+		{Name: "monthly_utilities", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric"}},
 		{Name: "media", Type: field.TypeJSON, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expiry_reminder_sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expired", Type: field.TypeBool, Default: false},
+		{Name: "listed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "on_market_since", Type: field.TypeTime, Nullable: true},
+		{Name: "market_seconds", Type: field.TypeInt64, Default: 0},
+		{Name: "sold_price", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"postgres": "numeric"}},
+		{Name: "close_date", Type: field.TypeTime, Nullable: true},
+		{Name: "publish_at", Type: field.TypeTime, Nullable: true},
+		{Name: "unpublish_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "neighborhood_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "property_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "realtor_id", Type: field.TypeUUID},
+		{Name: "buyer_realtor_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// ListingsTable holds the schema information for the "listings" table.
 	ListingsTable = &schema.Table{
@@ -38,12 +147,30 @@ var (
 		Columns:    ListingsColumns,
 		PrimaryKey: []*schema.Column{ListingsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "listings_neighborhoods_listings",
+				Columns:    []*schema.Column{ListingsColumns[46]},
+				RefColumns: []*schema.Column{NeighborhoodsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "listings_properties_listings",
+				Columns:    []*schema.Column{ListingsColumns[47]},
+				RefColumns: []*schema.Column{PropertiesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "listings_realtors_listings",
-				Columns:    []*schema.Column{ListingsColumns[20]},
+				Columns:    []*schema.Column{ListingsColumns[48]},
 				RefColumns: []*schema.Column{RealtorsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "listings_realtors_buyer_side_sales",
+				Columns:    []*schema.Column{ListingsColumns[49]},
+				RefColumns: []*schema.Column{RealtorsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
@@ -56,15 +183,444 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{ListingsColumns[4]},
 			},
+			{
+				Name:    "listing_address_key",
+				Unique:  false,
+				Columns: []*schema.Column{ListingsColumns[5]},
+			},
 			{
 				Name:    "listing_type_of_property",
 				Unique:  false,
-				Columns: []*schema.Column{ListingsColumns[14]},
+				Columns: []*schema.Column{ListingsColumns[23]},
+			},
+			{
+				Name:    "listing_country_state",
+				Unique:  false,
+				Columns: []*schema.Column{ListingsColumns[9], ListingsColumns[7]},
 			},
 			{
 				Name:    "listing_realtor_id",
 				Unique:  false,
-				Columns: []*schema.Column{ListingsColumns[20]},
+				Columns: []*schema.Column{ListingsColumns[48]},
+			},
+			{
+				Name:    "listing_property_id",
+				Unique:  false,
+				Columns: []*schema.Column{ListingsColumns[47]},
+			},
+			{
+				Name:    "listing_neighborhood_id",
+				Unique:  false,
+				Columns: []*schema.Column{ListingsColumns[46]},
+			},
+			{
+				Name:    "listing_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ListingsColumns[24], ListingsColumns[35]},
+			},
+			{
+				Name:    "listing_status_publish_at",
+				Unique:  false,
+				Columns: []*schema.Column{ListingsColumns[24], ListingsColumns[43]},
+			},
+			{
+				Name:    "listing_status_unpublish_at",
+				Unique:  false,
+				Columns: []*schema.Column{ListingsColumns[24], ListingsColumns[44]},
+			},
+			{
+				Name:    "listing_listed_at",
+				Unique:  false,
+				Columns: []*schema.Column{ListingsColumns[38]},
+			},
+			{
+				Name:    "listing_status_close_date",
+				Unique:  false,
+				Columns: []*schema.Column{ListingsColumns[24], ListingsColumns[42]},
+			},
+			{
+				Name:    "listing_buyer_realtor_id",
+				Unique:  false,
+				Columns: []*schema.Column{ListingsColumns[49]},
+			},
+		},
+	}
+	// ListingDocumentsColumns holds the columns for the "listing_documents" table.
+	ListingDocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "title", Type: field.TypeString, Size: 120},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"disclosure", "inspection", "hoa", "other"}, Default: "other"},
+		{Name: "access_level", Type: field.TypeEnum, Enums: []string{"public", "signed_in", "approved_inquirer", "staff"}, Default: "staff"},
+		{Name: "filename", Type: field.TypeString, Size: 255},
+		{Name: "size_bytes", Type: field.TypeInt64},
+		{Name: "storage_id", Type: field.TypeString, Size: 255},
+		{Name: "storage_format", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "uploaded_by", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "listing_id", Type: field.TypeUUID},
+	}
+	// ListingDocumentsTable holds the schema information for the "listing_documents" table.
+	ListingDocumentsTable = &schema.Table{
+		Name:       "listing_documents",
+		Columns:    ListingDocumentsColumns,
+		PrimaryKey: []*schema.Column{ListingDocumentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "listing_documents_listings_documents",
+				Columns:    []*schema.Column{ListingDocumentsColumns[11]},
+				RefColumns: []*schema.Column{ListingsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "listingdocument_listing_id",
+				Unique:  false,
+				Columns: []*schema.Column{ListingDocumentsColumns[11]},
+			},
+		},
+	}
+	// ListingInquiriesColumns holds the columns for the "listing_inquiries" table.
+	ListingInquiriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "APPROVED", "REJECTED"}, Default: "PENDING"},
+		{Name: "decided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "listing_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// ListingInquiriesTable holds the schema information for the "listing_inquiries" table.
+	ListingInquiriesTable = &schema.Table{
+		Name:       "listing_inquiries",
+		Columns:    ListingInquiriesColumns,
+		PrimaryKey: []*schema.Column{ListingInquiriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "listing_inquiries_listings_inquiries",
+				Columns:    []*schema.Column{ListingInquiriesColumns[6]},
+				RefColumns: []*schema.Column{ListingsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "listing_inquiries_users_inquiries",
+				Columns:    []*schema.Column{ListingInquiriesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "listinginquiry_listing_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{ListingInquiriesColumns[6], ListingInquiriesColumns[7]},
+			},
+		},
+	}
+	// ListingRenewalsColumns holds the columns for the "listing_renewals" table.
+	ListingRenewalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "previous_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "term_days", Type: field.TypeInt},
+		{Name: "renewed_at", Type: field.TypeTime},
+		{Name: "listing_id", Type: field.TypeUUID},
+	}
+	// ListingRenewalsTable holds the schema information for the "listing_renewals" table.
+	ListingRenewalsTable = &schema.Table{
+		Name:       "listing_renewals",
+		Columns:    ListingRenewalsColumns,
+		PrimaryKey: []*schema.Column{ListingRenewalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "listing_renewals_listings_renewals",
+				Columns:    []*schema.Column{ListingRenewalsColumns[5]},
+				RefColumns: []*schema.Column{ListingsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "listingrenewal_listing_id",
+				Unique:  false,
+				Columns: []*schema.Column{ListingRenewalsColumns[5]},
+			},
+		},
+	}
+	// ListingReviewsColumns holds the columns for the "listing_reviews" table.
+	ListingReviewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"NEW", "EDIT"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "APPROVED", "REJECTED", "WITHDRAWN"}, Default: "PENDING"},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "submitted_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "decided_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "decided_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reasons", Type: field.TypeJSON, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "listing_id", Type: field.TypeUUID},
+	}
+	// ListingReviewsTable holds the schema information for the "listing_reviews" table.
+	ListingReviewsTable = &schema.Table{
+		Name:       "listing_reviews",
+		Columns:    ListingReviewsColumns,
+		PrimaryKey: []*schema.Column{ListingReviewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "listing_reviews_listings_reviews",
+				Columns:    []*schema.Column{ListingReviewsColumns[11]},
+				RefColumns: []*schema.Column{ListingsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "listingreview_listing_id",
+				Unique:  false,
+				Columns: []*schema.Column{ListingReviewsColumns[11]},
+			},
+			{
+				Name:    "listingreview_status_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{ListingReviewsColumns[4], ListingReviewsColumns[1]},
+			},
+		},
+	}
+	// ListingStatusEventsColumns holds the columns for the "listing_status_events" table.
+	ListingStatusEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "from_status", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "to_status", Type: field.TypeString, Size: 20},
+		{Name: "changed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "listing_id", Type: field.TypeUUID},
+	}
+	// ListingStatusEventsTable holds the schema information for the "listing_status_events" table.
+	ListingStatusEventsTable = &schema.Table{
+		Name:       "listing_status_events",
+		Columns:    ListingStatusEventsColumns,
+		PrimaryKey: []*schema.Column{ListingStatusEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "listing_status_events_listings_status_events",
+				Columns:    []*schema.Column{ListingStatusEventsColumns[5]},
+				RefColumns: []*schema.Column{ListingsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "listingstatusevent_listing_id_changed_at",
+				Unique:  false,
+				Columns: []*schema.Column{ListingStatusEventsColumns[5], ListingStatusEventsColumns[4]},
+			},
+		},
+	}
+	// NeighborhoodsColumns holds the columns for the "neighborhoods" table.
+	NeighborhoodsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "city", Type: field.TypeString, Size: 255},
+		{Name: "state", Type: field.TypeString, Size: 3},
+		{Name: "country", Type: field.TypeString, Size: 2, Default: "US"},
+		{Name: "boundary", Type: field.TypeJSON},
+		{Name: "min_latitude", Type: field.TypeFloat64},
+		{Name: "max_latitude", Type: field.TypeFloat64},
+		{Name: "min_longitude", Type: field.TypeFloat64},
+		{Name: "max_longitude", Type: field.TypeFloat64},
+	}
+	// NeighborhoodsTable holds the schema information for the "neighborhoods" table.
+	NeighborhoodsTable = &schema.Table{
+		Name:       "neighborhoods",
+		Columns:    NeighborhoodsColumns,
+		PrimaryKey: []*schema.Column{NeighborhoodsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "neighborhood_name_city_state_country",
+				Unique:  true,
+				Columns: []*schema.Column{NeighborhoodsColumns[3], NeighborhoodsColumns[4], NeighborhoodsColumns[5], NeighborhoodsColumns[6]},
+			},
+			{
+				Name:    "neighborhood_city",
+				Unique:  false,
+				Columns: []*schema.Column{NeighborhoodsColumns[4]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "recipient_email", Type: field.TypeString, Size: 255},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "realtor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "kind", Type: field.TypeString, Size: 50},
+		{Name: "subject", Type: field.TypeString, Size: 255},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_recipient_email",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[3]},
+			},
+			{
+				Name:    "notification_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[4]},
+			},
+			{
+				Name:    "notification_realtor_id",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[5]},
+			},
+		},
+	}
+	// OffersColumns holds the columns for the "offers" table.
+	OffersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "side", Type: field.TypeEnum, Enums: []string{"BUYER", "SELLER"}},
+		{Name: "made_by", Type: field.TypeUUID},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "numeric"}},
+		{Name: "currency", Type: field.TypeString, Size: 3},
+		{Name: "contingencies", Type: field.TypeJSON, Nullable: true},
+		{Name: "closing_date", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2000},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "ACCEPTED", "REJECTED", "COUNTERED", "WITHDRAWN", "EXPIRED"}, Default: "PENDING"},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "listing_id", Type: field.TypeUUID},
+		{Name: "previous_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "buyer_id", Type: field.TypeUUID},
+	}
+	// OffersTable holds the schema information for the "offers" table.
+	OffersTable = &schema.Table{
+		Name:       "offers",
+		Columns:    OffersColumns,
+		PrimaryKey: []*schema.Column{OffersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "offers_listings_offers",
+				Columns:    []*schema.Column{OffersColumns[13]},
+				RefColumns: []*schema.Column{ListingsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "offers_offers_counters",
+				Columns:    []*schema.Column{OffersColumns[14]},
+				RefColumns: []*schema.Column{OffersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "offers_users_offers",
+				Columns:    []*schema.Column{OffersColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "offer_listing_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{OffersColumns[13], OffersColumns[11]},
+			},
+			{
+				Name:    "offer_buyer_id",
+				Unique:  false,
+				Columns: []*schema.Column{OffersColumns[15]},
+			},
+			{
+				Name:    "offer_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{OffersColumns[11], OffersColumns[9]},
+			},
+		},
+	}
+	// PointOfInterestsColumns holds the columns for the "point_of_interests" table.
+	PointOfInterestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "category", Type: field.TypeEnum, Enums: []string{"SCHOOL", "PARK", "TRANSIT"}},
+		{Name: "subtype", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "latitude", Type: field.TypeFloat64},
+		{Name: "longitude", Type: field.TypeFloat64},
+	}
+	// PointOfInterestsTable holds the schema information for the "point_of_interests" table.
+	PointOfInterestsTable = &schema.Table{
+		Name:       "point_of_interests",
+		Columns:    PointOfInterestsColumns,
+		PrimaryKey: []*schema.Column{PointOfInterestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pointofinterest_category_name_latitude_longitude",
+				Unique:  true,
+				Columns: []*schema.Column{PointOfInterestsColumns[4], PointOfInterestsColumns[3], PointOfInterestsColumns[6], PointOfInterestsColumns[7]},
+			},
+			{
+				Name:    "pointofinterest_latitude_longitude",
+				Unique:  false,
+				Columns: []*schema.Column{PointOfInterestsColumns[6], PointOfInterestsColumns[7]},
+			},
+		},
+	}
+	// PropertiesColumns holds the columns for the "properties" table.
+	PropertiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "address", Type: field.TypeString, Size: 255},
+		{Name: "address_key", Type: field.TypeString, Nullable: true, Size: 300},
+		{Name: "city", Type: field.TypeString, Size: 255},
+		{Name: "state", Type: field.TypeString, Size: 3},
+		{Name: "zip_code", Type: field.TypeString, Size: 10},
+		{Name: "country", Type: field.TypeString, Size: 2, Default: "US"},
+		{Name: "type_of_property", Type: field.TypeEnum, Enums: []string{"house", "apartment", "condo", "townhouse"}, Default: "house"},
+		{Name: "bedroom", Type: field.TypeInt},
+		{Name: "bathroom", Type: field.TypeFloat64},
+		{Name: "garage", Type: field.TypeInt, Nullable: true},
+		{Name: "sqft", Type: field.TypeInt},
+		{Name: "sqm", Type: field.TypeInt, Nullable: true},
+		{Name: "lot_size", Type: field.TypeInt, Nullable: true},
+		{Name: "pool", Type: field.TypeBool, Nullable: true},
+		{Name: "year_built", Type: field.TypeInt},
+	}
+	// PropertiesTable holds the schema information for the "properties" table.
+	PropertiesTable = &schema.Table{
+		Name:       "properties",
+		Columns:    PropertiesColumns,
+		PrimaryKey: []*schema.Column{PropertiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "property_address",
+				Unique:  false,
+				Columns: []*schema.Column{PropertiesColumns[3]},
+			},
+			{
+				Name:    "property_address_key_country",
+				Unique:  false,
+				Columns: []*schema.Column{PropertiesColumns[4], PropertiesColumns[8]},
+			},
+			{
+				Name:    "property_city",
+				Unique:  false,
+				Columns: []*schema.Column{PropertiesColumns[5]},
 			},
 		},
 	}
@@ -80,12 +636,38 @@ var (
 		{Name: "email", Type: field.TypeString, Unique: true, Size: 255},
 		{Name: "is_mvp", Type: field.TypeBool, Default: false},
 		{Name: "hire_date", Type: field.TypeTime},
+		{Name: "service_areas", Type: field.TypeJSON, Nullable: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "deactivated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "brokerage_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "team_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID, Unique: true, Nullable: true},
 	}
 	// RealtorsTable holds the schema information for the "realtors" table.
 	RealtorsTable = &schema.Table{
 		Name:       "realtors",
 		Columns:    RealtorsColumns,
 		PrimaryKey: []*schema.Column{RealtorsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "realtors_brokerages_realtors",
+				Columns:    []*schema.Column{RealtorsColumns[13]},
+				RefColumns: []*schema.Column{BrokeragesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "realtors_teams_members",
+				Columns:    []*schema.Column{RealtorsColumns[14]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "realtors_users_realtor",
+				Columns:    []*schema.Column{RealtorsColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "realtor_full_name",
@@ -102,6 +684,97 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{RealtorsColumns[6]},
 			},
+			{
+				Name:    "realtor_active",
+				Unique:  false,
+				Columns: []*schema.Column{RealtorsColumns[11]},
+			},
+			{
+				Name:    "realtor_brokerage_id",
+				Unique:  false,
+				Columns: []*schema.Column{RealtorsColumns[13]},
+			},
+			{
+				Name:    "realtor_team_id",
+				Unique:  false,
+				Columns: []*schema.Column{RealtorsColumns[14]},
+			},
+		},
+	}
+	// RealtorInvitesColumns holds the columns for the "realtor_invites" table.
+	RealtorInvitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "email", Type: field.TypeString, Size: 255},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Nullable: true, Size: 64},
+		{Name: "invited_by", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "ACCEPTED", "REVOKED"}, Default: "PENDING"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "accepted_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "realtor_id", Type: field.TypeUUID},
+	}
+	// RealtorInvitesTable holds the schema information for the "realtor_invites" table.
+	RealtorInvitesTable = &schema.Table{
+		Name:       "realtor_invites",
+		Columns:    RealtorInvitesColumns,
+		PrimaryKey: []*schema.Column{RealtorInvitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "realtor_invites_realtors_invites",
+				Columns:    []*schema.Column{RealtorInvitesColumns[10]},
+				RefColumns: []*schema.Column{RealtorsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "realtorinvite_realtor_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{RealtorInvitesColumns[10], RealtorInvitesColumns[6]},
+			},
+		},
+	}
+	// TeamsColumns holds the columns for the "teams" table.
+	TeamsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "brokerage_id", Type: field.TypeUUID},
+		{Name: "lead_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// TeamsTable holds the schema information for the "teams" table.
+	TeamsTable = &schema.Table{
+		Name:       "teams",
+		Columns:    TeamsColumns,
+		PrimaryKey: []*schema.Column{TeamsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "teams_brokerages_teams",
+				Columns:    []*schema.Column{TeamsColumns[4]},
+				RefColumns: []*schema.Column{BrokeragesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "teams_realtors_led_teams",
+				Columns:    []*schema.Column{TeamsColumns[5]},
+				RefColumns: []*schema.Column{RealtorsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "team_brokerage_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TeamsColumns[4], TeamsColumns[3]},
+			},
+			{
+				Name:    "team_lead_id",
+				Unique:  false,
+				Columns: []*schema.Column{TeamsColumns[5]},
+			},
 		},
 	}
 	// UsersColumns holds the columns for the "users" table.
@@ -145,12 +818,46 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BrokeragesTable,
+		DocumentDownloadsTable,
+		ExchangeRatesTable,
 		ListingsTable,
+		ListingDocumentsTable,
+		ListingInquiriesTable,
+		ListingRenewalsTable,
+		ListingReviewsTable,
+		ListingStatusEventsTable,
+		NeighborhoodsTable,
+		NotificationsTable,
+		OffersTable,
+		PointOfInterestsTable,
+		PropertiesTable,
 		RealtorsTable,
+		RealtorInvitesTable,
+		TeamsTable,
 		UsersTable,
 	}
 )
 
 func init() {
-	ListingsTable.ForeignKeys[0].RefTable = RealtorsTable
+	DocumentDownloadsTable.ForeignKeys[0].RefTable = ListingDocumentsTable
+	ListingsTable.ForeignKeys[0].RefTable = NeighborhoodsTable
+	ListingsTable.ForeignKeys[1].RefTable = PropertiesTable
+	ListingsTable.ForeignKeys[2].RefTable = RealtorsTable
+	ListingsTable.ForeignKeys[3].RefTable = RealtorsTable
+	ListingDocumentsTable.ForeignKeys[0].RefTable = ListingsTable
+	ListingInquiriesTable.ForeignKeys[0].RefTable = ListingsTable
+	ListingInquiriesTable.ForeignKeys[1].RefTable = UsersTable
+	ListingRenewalsTable.ForeignKeys[0].RefTable = ListingsTable
+	ListingReviewsTable.ForeignKeys[0].RefTable = ListingsTable
+	ListingStatusEventsTable.ForeignKeys[0].RefTable = ListingsTable
+	OffersTable.ForeignKeys[0].RefTable = ListingsTable
+	OffersTable.ForeignKeys[1].RefTable = OffersTable
+	OffersTable.ForeignKeys[2].RefTable = UsersTable
+	RealtorsTable.ForeignKeys[0].RefTable = BrokeragesTable
+	RealtorsTable.ForeignKeys[1].RefTable = TeamsTable
+	RealtorsTable.ForeignKeys[2].RefTable = UsersTable
+	RealtorInvitesTable.ForeignKeys[0].RefTable = RealtorsTable
+	TeamsTable.ForeignKeys[0].RefTable = BrokeragesTable
+	TeamsTable.ForeignKeys[1].RefTable = RealtorsTable
 }
@@ -13,10 +13,25 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent/brokerage"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/exchangerate"
 	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/listingrenewal"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/listingstatusevent"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/notification"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/pointofinterest"
 	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/property"
 	"ppgroup.ppgroup.com/ent/realtor"
-	"ppgroup.ppgroup.com/ent/schema"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+	"ppgroup.ppgroup.com/ent/schematype"
+	"ppgroup.ppgroup.com/ent/team"
 	"ppgroup.ppgroup.com/ent/user"
 )
 
@@ -29,63 +44,69 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeListing = "Listing"
-	TypeRealtor = "Realtor"
-	TypeUser    = "User"
+	TypeBrokerage          = "Brokerage"
+	TypeDocumentDownload   = "DocumentDownload"
+	TypeExchangeRate       = "ExchangeRate"
+	TypeListing            = "Listing"
+	TypeListingDocument    = "ListingDocument"
+	TypeListingInquiry     = "ListingInquiry"
+	TypeListingRenewal     = "ListingRenewal"
+	TypeListingReview      = "ListingReview"
+	TypeListingStatusEvent = "ListingStatusEvent"
+	TypeNeighborhood       = "Neighborhood"
+	TypeNotification       = "Notification"
+	TypeOffer              = "Offer"
+	TypePointOfInterest    = "PointOfInterest"
+	TypeProperty           = "Property"
+	TypeRealtor            = "Realtor"
+	TypeRealtorInvite      = "RealtorInvite"
+	TypeTeam               = "Team"
+	TypeUser               = "User"
 )
 
-// ListingMutation represents an operation that mutates the Listing nodes in the graph.
-type ListingMutation struct {
+// BrokerageMutation represents an operation that mutates the Brokerage nodes in the graph.
+type BrokerageMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	create_time      *time.Time
-	update_time      *time.Time
-	title            *string
-	address          *string
-	city             *string
-	state            *string
-	zip_code         *string
-	description      *string
-	price            *decimal.Decimal
-	addprice         *decimal.Decimal
-	bedroom          *int
-	addbedroom       *int
-	bathroom         *float64
-	addbathroom      *float64
-	garage           *int
-	addgarage        *int
-	sqft             *int
-	addsqft          *int
-	type_of_property *listing.TypeOfProperty
-	status           *listing.Status
-	lot_size         *int
-	addlot_size      *int
-	pool             *bool
-	year_built       *int
-	addyear_built    *int
-	media            *[]schema.Media
-	appendmedia      []schema.Media
-	clearedFields    map[string]struct{}
-	realtor          *uuid.UUID
-	clearedrealtor   bool
-	done             bool
-	oldValue         func(context.Context) (*Listing, error)
-	predicates       []predicate.Listing
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	create_time     *time.Time
+	update_time     *time.Time
+	name            *string
+	license_number  *string
+	address         *string
+	city            *string
+	state           *string
+	zip_code        *string
+	phone           *string
+	email           *string
+	website         *string
+	logo_url        *string
+	primary_color   *string
+	secondary_color *string
+	clearedFields   map[string]struct{}
+	teams           map[uuid.UUID]struct{}
+	removedteams    map[uuid.UUID]struct{}
+	clearedteams    bool
+	realtors        map[uuid.UUID]struct{}
+	removedrealtors map[uuid.UUID]struct{}
+	clearedrealtors bool
+	done            bool
+	oldValue        func(context.Context) (*Brokerage, error)
+	predicates      []predicate.Brokerage
 }
 
-var _ ent.Mutation = (*ListingMutation)(nil)
+var _ ent.Mutation = (*BrokerageMutation)(nil)
 
-// listingOption allows management of the mutation configuration using functional options.
-type listingOption func(*ListingMutation)
+// brokerageOption allows management of the mutation configuration using functional options.
+type brokerageOption func(*BrokerageMutation)
 
-// newListingMutation creates new mutation for the Listing entity.
-func newListingMutation(c config, op Op, opts ...listingOption) *ListingMutation {
-	m := &ListingMutation{
+// newBrokerageMutation creates new mutation for the Brokerage entity.
+func newBrokerageMutation(c config, op Op, opts ...brokerageOption) *BrokerageMutation {
+	m := &BrokerageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeListing,
+		typ:           TypeBrokerage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -94,20 +115,20 @@ func newListingMutation(c config, op Op, opts ...listingOption) *ListingMutation
 	return m
 }
 
-// withListingID sets the ID field of the mutation.
-func withListingID(id uuid.UUID) listingOption {
-	return func(m *ListingMutation) {
+// withBrokerageID sets the ID field of the mutation.
+func withBrokerageID(id uuid.UUID) brokerageOption {
+	return func(m *BrokerageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Listing
+			value *Brokerage
 		)
-		m.oldValue = func(ctx context.Context) (*Listing, error) {
+		m.oldValue = func(ctx context.Context) (*Brokerage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Listing.Get(ctx, id)
+					value, err = m.Client().Brokerage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -116,10 +137,10 @@ func withListingID(id uuid.UUID) listingOption {
 	}
 }
 
-// withListing sets the old Listing of the mutation.
-func withListing(node *Listing) listingOption {
-	return func(m *ListingMutation) {
-		m.oldValue = func(context.Context) (*Listing, error) {
+// withBrokerage sets the old Brokerage of the mutation.
+func withBrokerage(node *Brokerage) brokerageOption {
+	return func(m *BrokerageMutation) {
+		m.oldValue = func(context.Context) (*Brokerage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -128,7 +149,7 @@ func withListing(node *Listing) listingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ListingMutation) Client() *Client {
+func (m BrokerageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -136,7 +157,7 @@ func (m ListingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ListingMutation) Tx() (*Tx, error) {
+func (m BrokerageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -146,14 +167,14 @@ func (m ListingMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Listing entities.
-func (m *ListingMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Brokerage entities.
+func (m *BrokerageMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ListingMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BrokerageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -164,7 +185,7 @@ func (m *ListingMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ListingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BrokerageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -173,19 +194,19 @@ func (m *ListingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Listing.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Brokerage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ListingMutation) SetCreateTime(t time.Time) {
+func (m *BrokerageMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ListingMutation) CreateTime() (r time.Time, exists bool) {
+func (m *BrokerageMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -193,10 +214,10 @@ func (m *ListingMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *BrokerageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -211,17 +232,17 @@ func (m *ListingMutation) OldCreateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ListingMutation) ResetCreateTime() {
+func (m *BrokerageMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *ListingMutation) SetUpdateTime(t time.Time) {
+func (m *BrokerageMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ListingMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *BrokerageMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -229,10 +250,10 @@ func (m *ListingMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *BrokerageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -247,53 +268,89 @@ func (m *ListingMutation) OldUpdateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ListingMutation) ResetUpdateTime() {
+func (m *BrokerageMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *ListingMutation) SetTitle(s string) {
-	m.title = &s
+// SetName sets the "name" field.
+func (m *BrokerageMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *ListingMutation) Title() (r string, exists bool) {
-	v := m.title
+// Name returns the value of the "name" field in the mutation.
+func (m *BrokerageMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *BrokerageMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Name, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *ListingMutation) ResetTitle() {
-	m.title = nil
+// ResetName resets all changes to the "name" field.
+func (m *BrokerageMutation) ResetName() {
+	m.name = nil
+}
+
+// SetLicenseNumber sets the "license_number" field.
+func (m *BrokerageMutation) SetLicenseNumber(s string) {
+	m.license_number = &s
+}
+
+// LicenseNumber returns the value of the "license_number" field in the mutation.
+func (m *BrokerageMutation) LicenseNumber() (r string, exists bool) {
+	v := m.license_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLicenseNumber returns the old "license_number" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerageMutation) OldLicenseNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLicenseNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLicenseNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLicenseNumber: %w", err)
+	}
+	return oldValue.LicenseNumber, nil
+}
+
+// ResetLicenseNumber resets all changes to the "license_number" field.
+func (m *BrokerageMutation) ResetLicenseNumber() {
+	m.license_number = nil
 }
 
 // SetAddress sets the "address" field.
-func (m *ListingMutation) SetAddress(s string) {
+func (m *BrokerageMutation) SetAddress(s string) {
 	m.address = &s
 }
 
 // Address returns the value of the "address" field in the mutation.
-func (m *ListingMutation) Address() (r string, exists bool) {
+func (m *BrokerageMutation) Address() (r string, exists bool) {
 	v := m.address
 	if v == nil {
 		return
@@ -301,10 +358,10 @@ func (m *ListingMutation) Address() (r string, exists bool) {
 	return *v, true
 }
 
-// OldAddress returns the old "address" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldAddress returns the old "address" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldAddress(ctx context.Context) (v string, err error) {
+func (m *BrokerageMutation) OldAddress(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
 	}
@@ -319,17 +376,17 @@ func (m *ListingMutation) OldAddress(ctx context.Context) (v string, err error)
 }
 
 // ResetAddress resets all changes to the "address" field.
-func (m *ListingMutation) ResetAddress() {
+func (m *BrokerageMutation) ResetAddress() {
 	m.address = nil
 }
 
 // SetCity sets the "city" field.
-func (m *ListingMutation) SetCity(s string) {
+func (m *BrokerageMutation) SetCity(s string) {
 	m.city = &s
 }
 
 // City returns the value of the "city" field in the mutation.
-func (m *ListingMutation) City() (r string, exists bool) {
+func (m *BrokerageMutation) City() (r string, exists bool) {
 	v := m.city
 	if v == nil {
 		return
@@ -337,10 +394,10 @@ func (m *ListingMutation) City() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCity returns the old "city" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldCity returns the old "city" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldCity(ctx context.Context) (v string, err error) {
+func (m *BrokerageMutation) OldCity(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCity is only allowed on UpdateOne operations")
 	}
@@ -355,17 +412,17 @@ func (m *ListingMutation) OldCity(ctx context.Context) (v string, err error) {
 }
 
 // ResetCity resets all changes to the "city" field.
-func (m *ListingMutation) ResetCity() {
+func (m *BrokerageMutation) ResetCity() {
 	m.city = nil
 }
 
 // SetState sets the "state" field.
-func (m *ListingMutation) SetState(s string) {
+func (m *BrokerageMutation) SetState(s string) {
 	m.state = &s
 }
 
 // State returns the value of the "state" field in the mutation.
-func (m *ListingMutation) State() (r string, exists bool) {
+func (m *BrokerageMutation) State() (r string, exists bool) {
 	v := m.state
 	if v == nil {
 		return
@@ -373,10 +430,10 @@ func (m *ListingMutation) State() (r string, exists bool) {
 	return *v, true
 }
 
-// OldState returns the old "state" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldState returns the old "state" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldState(ctx context.Context) (v string, err error) {
+func (m *BrokerageMutation) OldState(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldState is only allowed on UpdateOne operations")
 	}
@@ -390,18 +447,31 @@ func (m *ListingMutation) OldState(ctx context.Context) (v string, err error) {
 	return oldValue.State, nil
 }
 
+// ClearState clears the value of the "state" field.
+func (m *BrokerageMutation) ClearState() {
+	m.state = nil
+	m.clearedFields[brokerage.FieldState] = struct{}{}
+}
+
+// StateCleared returns if the "state" field was cleared in this mutation.
+func (m *BrokerageMutation) StateCleared() bool {
+	_, ok := m.clearedFields[brokerage.FieldState]
+	return ok
+}
+
 // ResetState resets all changes to the "state" field.
-func (m *ListingMutation) ResetState() {
+func (m *BrokerageMutation) ResetState() {
 	m.state = nil
+	delete(m.clearedFields, brokerage.FieldState)
 }
 
 // SetZipCode sets the "zip_code" field.
-func (m *ListingMutation) SetZipCode(s string) {
+func (m *BrokerageMutation) SetZipCode(s string) {
 	m.zip_code = &s
 }
 
 // ZipCode returns the value of the "zip_code" field in the mutation.
-func (m *ListingMutation) ZipCode() (r string, exists bool) {
+func (m *BrokerageMutation) ZipCode() (r string, exists bool) {
 	v := m.zip_code
 	if v == nil {
 		return
@@ -409,10 +479,10 @@ func (m *ListingMutation) ZipCode() (r string, exists bool) {
 	return *v, true
 }
 
-// OldZipCode returns the old "zip_code" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldZipCode returns the old "zip_code" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldZipCode(ctx context.Context) (v string, err error) {
+func (m *BrokerageMutation) OldZipCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldZipCode is only allowed on UpdateOne operations")
 	}
@@ -427,716 +497,19125 @@ func (m *ListingMutation) OldZipCode(ctx context.Context) (v string, err error)
 }
 
 // ResetZipCode resets all changes to the "zip_code" field.
-func (m *ListingMutation) ResetZipCode() {
+func (m *BrokerageMutation) ResetZipCode() {
 	m.zip_code = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ListingMutation) SetDescription(s string) {
-	m.description = &s
+// SetPhone sets the "phone" field.
+func (m *BrokerageMutation) SetPhone(s string) {
+	m.phone = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ListingMutation) Description() (r string, exists bool) {
-	v := m.description
+// Phone returns the value of the "phone" field in the mutation.
+func (m *BrokerageMutation) Phone() (r string, exists bool) {
+	v := m.phone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldPhone returns the old "phone" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *BrokerageMutation) OldPhone(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldPhone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Phone, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *ListingMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[listing.FieldDescription] = struct{}{}
+// ClearPhone clears the value of the "phone" field.
+func (m *BrokerageMutation) ClearPhone() {
+	m.phone = nil
+	m.clearedFields[brokerage.FieldPhone] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ListingMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[listing.FieldDescription]
+// PhoneCleared returns if the "phone" field was cleared in this mutation.
+func (m *BrokerageMutation) PhoneCleared() bool {
+	_, ok := m.clearedFields[brokerage.FieldPhone]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ListingMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, listing.FieldDescription)
+// ResetPhone resets all changes to the "phone" field.
+func (m *BrokerageMutation) ResetPhone() {
+	m.phone = nil
+	delete(m.clearedFields, brokerage.FieldPhone)
 }
 
-// SetPrice sets the "price" field.
-func (m *ListingMutation) SetPrice(d decimal.Decimal) {
-	m.price = &d
-	m.addprice = nil
+// SetEmail sets the "email" field.
+func (m *BrokerageMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Price returns the value of the "price" field in the mutation.
-func (m *ListingMutation) Price() (r decimal.Decimal, exists bool) {
-	v := m.price
+// Email returns the value of the "email" field in the mutation.
+func (m *BrokerageMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrice returns the old "price" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldPrice(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *BrokerageMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrice requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Price, nil
+	return oldValue.Email, nil
 }
 
-// AddPrice adds d to the "price" field.
-func (m *ListingMutation) AddPrice(d decimal.Decimal) {
-	if m.addprice != nil {
-		*m.addprice = m.addprice.Add(d)
-	} else {
-		m.addprice = &d
-	}
+// ClearEmail clears the value of the "email" field.
+func (m *BrokerageMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[brokerage.FieldEmail] = struct{}{}
 }
 
-// AddedPrice returns the value that was added to the "price" field in this mutation.
-func (m *ListingMutation) AddedPrice() (r decimal.Decimal, exists bool) {
-	v := m.addprice
-	if v == nil {
-		return
-	}
-	return *v, true
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *BrokerageMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[brokerage.FieldEmail]
+	return ok
 }
 
-// ResetPrice resets all changes to the "price" field.
-func (m *ListingMutation) ResetPrice() {
-	m.price = nil
-	m.addprice = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *BrokerageMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, brokerage.FieldEmail)
 }
 
-// SetBedroom sets the "bedroom" field.
-func (m *ListingMutation) SetBedroom(i int) {
-	m.bedroom = &i
-	m.addbedroom = nil
+// SetWebsite sets the "website" field.
+func (m *BrokerageMutation) SetWebsite(s string) {
+	m.website = &s
 }
 
-// Bedroom returns the value of the "bedroom" field in the mutation.
-func (m *ListingMutation) Bedroom() (r int, exists bool) {
-	v := m.bedroom
+// Website returns the value of the "website" field in the mutation.
+func (m *BrokerageMutation) Website() (r string, exists bool) {
+	v := m.website
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBedroom returns the old "bedroom" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldWebsite returns the old "website" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldBedroom(ctx context.Context) (v int, err error) {
+func (m *BrokerageMutation) OldWebsite(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBedroom is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebsite is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBedroom requires an ID field in the mutation")
+		return v, errors.New("OldWebsite requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBedroom: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebsite: %w", err)
 	}
-	return oldValue.Bedroom, nil
+	return oldValue.Website, nil
 }
 
-// AddBedroom adds i to the "bedroom" field.
-func (m *ListingMutation) AddBedroom(i int) {
-	if m.addbedroom != nil {
-		*m.addbedroom += i
-	} else {
-		m.addbedroom = &i
-	}
+// ClearWebsite clears the value of the "website" field.
+func (m *BrokerageMutation) ClearWebsite() {
+	m.website = nil
+	m.clearedFields[brokerage.FieldWebsite] = struct{}{}
 }
 
-// AddedBedroom returns the value that was added to the "bedroom" field in this mutation.
-func (m *ListingMutation) AddedBedroom() (r int, exists bool) {
-	v := m.addbedroom
-	if v == nil {
-		return
-	}
-	return *v, true
+// WebsiteCleared returns if the "website" field was cleared in this mutation.
+func (m *BrokerageMutation) WebsiteCleared() bool {
+	_, ok := m.clearedFields[brokerage.FieldWebsite]
+	return ok
 }
 
-// ResetBedroom resets all changes to the "bedroom" field.
-func (m *ListingMutation) ResetBedroom() {
-	m.bedroom = nil
-	m.addbedroom = nil
+// ResetWebsite resets all changes to the "website" field.
+func (m *BrokerageMutation) ResetWebsite() {
+	m.website = nil
+	delete(m.clearedFields, brokerage.FieldWebsite)
 }
 
-// SetBathroom sets the "bathroom" field.
-func (m *ListingMutation) SetBathroom(f float64) {
-	m.bathroom = &f
-	m.addbathroom = nil
+// SetLogoURL sets the "logo_url" field.
+func (m *BrokerageMutation) SetLogoURL(s string) {
+	m.logo_url = &s
 }
 
-// Bathroom returns the value of the "bathroom" field in the mutation.
-func (m *ListingMutation) Bathroom() (r float64, exists bool) {
-	v := m.bathroom
+// LogoURL returns the value of the "logo_url" field in the mutation.
+func (m *BrokerageMutation) LogoURL() (r string, exists bool) {
+	v := m.logo_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBathroom returns the old "bathroom" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldLogoURL returns the old "logo_url" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldBathroom(ctx context.Context) (v float64, err error) {
+func (m *BrokerageMutation) OldLogoURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBathroom is only allowed on UpdateOne operations")
+		return v, errors.New("OldLogoURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBathroom requires an ID field in the mutation")
+		return v, errors.New("OldLogoURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBathroom: %w", err)
+		return v, fmt.Errorf("querying old value for OldLogoURL: %w", err)
 	}
-	return oldValue.Bathroom, nil
+	return oldValue.LogoURL, nil
 }
 
-// AddBathroom adds f to the "bathroom" field.
-func (m *ListingMutation) AddBathroom(f float64) {
-	if m.addbathroom != nil {
-		*m.addbathroom += f
-	} else {
-		m.addbathroom = &f
-	}
+// ClearLogoURL clears the value of the "logo_url" field.
+func (m *BrokerageMutation) ClearLogoURL() {
+	m.logo_url = nil
+	m.clearedFields[brokerage.FieldLogoURL] = struct{}{}
 }
 
-// AddedBathroom returns the value that was added to the "bathroom" field in this mutation.
-func (m *ListingMutation) AddedBathroom() (r float64, exists bool) {
-	v := m.addbathroom
-	if v == nil {
-		return
-	}
-	return *v, true
+// LogoURLCleared returns if the "logo_url" field was cleared in this mutation.
+func (m *BrokerageMutation) LogoURLCleared() bool {
+	_, ok := m.clearedFields[brokerage.FieldLogoURL]
+	return ok
 }
 
-// ResetBathroom resets all changes to the "bathroom" field.
-func (m *ListingMutation) ResetBathroom() {
-	m.bathroom = nil
-	m.addbathroom = nil
+// ResetLogoURL resets all changes to the "logo_url" field.
+func (m *BrokerageMutation) ResetLogoURL() {
+	m.logo_url = nil
+	delete(m.clearedFields, brokerage.FieldLogoURL)
 }
 
-// SetGarage sets the "garage" field.
-func (m *ListingMutation) SetGarage(i int) {
-	m.garage = &i
-	m.addgarage = nil
+// SetPrimaryColor sets the "primary_color" field.
+func (m *BrokerageMutation) SetPrimaryColor(s string) {
+	m.primary_color = &s
 }
 
-// Garage returns the value of the "garage" field in the mutation.
-func (m *ListingMutation) Garage() (r int, exists bool) {
-	v := m.garage
+// PrimaryColor returns the value of the "primary_color" field in the mutation.
+func (m *BrokerageMutation) PrimaryColor() (r string, exists bool) {
+	v := m.primary_color
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGarage returns the old "garage" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldPrimaryColor returns the old "primary_color" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldGarage(ctx context.Context) (v int, err error) {
+func (m *BrokerageMutation) OldPrimaryColor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGarage is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrimaryColor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGarage requires an ID field in the mutation")
+		return v, errors.New("OldPrimaryColor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGarage: %w", err)
-	}
-	return oldValue.Garage, nil
-}
-
-// AddGarage adds i to the "garage" field.
-func (m *ListingMutation) AddGarage(i int) {
-	if m.addgarage != nil {
-		*m.addgarage += i
-	} else {
-		m.addgarage = &i
-	}
-}
-
-// AddedGarage returns the value that was added to the "garage" field in this mutation.
-func (m *ListingMutation) AddedGarage() (r int, exists bool) {
-	v := m.addgarage
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldPrimaryColor: %w", err)
 	}
-	return *v, true
+	return oldValue.PrimaryColor, nil
 }
 
-// ClearGarage clears the value of the "garage" field.
-func (m *ListingMutation) ClearGarage() {
-	m.garage = nil
-	m.addgarage = nil
-	m.clearedFields[listing.FieldGarage] = struct{}{}
+// ClearPrimaryColor clears the value of the "primary_color" field.
+func (m *BrokerageMutation) ClearPrimaryColor() {
+	m.primary_color = nil
+	m.clearedFields[brokerage.FieldPrimaryColor] = struct{}{}
 }
 
-// GarageCleared returns if the "garage" field was cleared in this mutation.
-func (m *ListingMutation) GarageCleared() bool {
-	_, ok := m.clearedFields[listing.FieldGarage]
+// PrimaryColorCleared returns if the "primary_color" field was cleared in this mutation.
+func (m *BrokerageMutation) PrimaryColorCleared() bool {
+	_, ok := m.clearedFields[brokerage.FieldPrimaryColor]
 	return ok
 }
 
-// ResetGarage resets all changes to the "garage" field.
-func (m *ListingMutation) ResetGarage() {
-	m.garage = nil
-	m.addgarage = nil
-	delete(m.clearedFields, listing.FieldGarage)
+// ResetPrimaryColor resets all changes to the "primary_color" field.
+func (m *BrokerageMutation) ResetPrimaryColor() {
+	m.primary_color = nil
+	delete(m.clearedFields, brokerage.FieldPrimaryColor)
 }
 
-// SetSqft sets the "sqft" field.
-func (m *ListingMutation) SetSqft(i int) {
-	m.sqft = &i
-	m.addsqft = nil
+// SetSecondaryColor sets the "secondary_color" field.
+func (m *BrokerageMutation) SetSecondaryColor(s string) {
+	m.secondary_color = &s
 }
 
-// Sqft returns the value of the "sqft" field in the mutation.
-func (m *ListingMutation) Sqft() (r int, exists bool) {
-	v := m.sqft
+// SecondaryColor returns the value of the "secondary_color" field in the mutation.
+func (m *BrokerageMutation) SecondaryColor() (r string, exists bool) {
+	v := m.secondary_color
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSqft returns the old "sqft" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldSecondaryColor returns the old "secondary_color" field's value of the Brokerage entity.
+// If the Brokerage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldSqft(ctx context.Context) (v int, err error) {
+func (m *BrokerageMutation) OldSecondaryColor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSqft is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecondaryColor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSqft requires an ID field in the mutation")
+		return v, errors.New("OldSecondaryColor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSqft: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecondaryColor: %w", err)
 	}
-	return oldValue.Sqft, nil
+	return oldValue.SecondaryColor, nil
 }
 
-// AddSqft adds i to the "sqft" field.
-func (m *ListingMutation) AddSqft(i int) {
-	if m.addsqft != nil {
-		*m.addsqft += i
-	} else {
-		m.addsqft = &i
-	}
+// ClearSecondaryColor clears the value of the "secondary_color" field.
+func (m *BrokerageMutation) ClearSecondaryColor() {
+	m.secondary_color = nil
+	m.clearedFields[brokerage.FieldSecondaryColor] = struct{}{}
 }
 
-// AddedSqft returns the value that was added to the "sqft" field in this mutation.
-func (m *ListingMutation) AddedSqft() (r int, exists bool) {
-	v := m.addsqft
-	if v == nil {
-		return
-	}
-	return *v, true
+// SecondaryColorCleared returns if the "secondary_color" field was cleared in this mutation.
+func (m *BrokerageMutation) SecondaryColorCleared() bool {
+	_, ok := m.clearedFields[brokerage.FieldSecondaryColor]
+	return ok
 }
 
-// ResetSqft resets all changes to the "sqft" field.
-func (m *ListingMutation) ResetSqft() {
-	m.sqft = nil
-	m.addsqft = nil
+// ResetSecondaryColor resets all changes to the "secondary_color" field.
+func (m *BrokerageMutation) ResetSecondaryColor() {
+	m.secondary_color = nil
+	delete(m.clearedFields, brokerage.FieldSecondaryColor)
 }
 
-// SetTypeOfProperty sets the "type_of_property" field.
-func (m *ListingMutation) SetTypeOfProperty(lop listing.TypeOfProperty) {
-	m.type_of_property = &lop
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *BrokerageMutation) AddTeamIDs(ids ...uuid.UUID) {
+	if m.teams == nil {
+		m.teams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
+	}
 }
 
-// TypeOfProperty returns the value of the "type_of_property" field in the mutation.
-func (m *ListingMutation) TypeOfProperty() (r listing.TypeOfProperty, exists bool) {
-	v := m.type_of_property
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *BrokerageMutation) ClearTeams() {
+	m.clearedteams = true
 }
 
-// OldTypeOfProperty returns the old "type_of_property" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldTypeOfProperty(ctx context.Context) (v listing.TypeOfProperty, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTypeOfProperty is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTypeOfProperty requires an ID field in the mutation")
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *BrokerageMutation) TeamsCleared() bool {
+	return m.clearedteams
+}
+
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *BrokerageMutation) RemoveTeamIDs(ids ...uuid.UUID) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTypeOfProperty: %w", err)
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
 	}
-	return oldValue.TypeOfProperty, nil
 }
 
-// ResetTypeOfProperty resets all changes to the "type_of_property" field.
-func (m *ListingMutation) ResetTypeOfProperty() {
-	m.type_of_property = nil
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *BrokerageMutation) RemovedTeamsIDs() (ids []uuid.UUID) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetStatus sets the "status" field.
-func (m *ListingMutation) SetStatus(l listing.Status) {
-	m.status = &l
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *BrokerageMutation) TeamsIDs() (ids []uuid.UUID) {
+	for id := range m.teams {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *ListingMutation) Status() (r listing.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetTeams resets all changes to the "teams" edge.
+func (m *BrokerageMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
 }
 
-// OldStatus returns the old "status" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldStatus(ctx context.Context) (v listing.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+// AddRealtorIDs adds the "realtors" edge to the Realtor entity by ids.
+func (m *BrokerageMutation) AddRealtorIDs(ids ...uuid.UUID) {
+	if m.realtors == nil {
+		m.realtors = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	for i := range ids {
+		m.realtors[ids[i]] = struct{}{}
 	}
-	return oldValue.Status, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *ListingMutation) ResetStatus() {
-	m.status = nil
+// ClearRealtors clears the "realtors" edge to the Realtor entity.
+func (m *BrokerageMutation) ClearRealtors() {
+	m.clearedrealtors = true
 }
 
-// SetLotSize sets the "lot_size" field.
-func (m *ListingMutation) SetLotSize(i int) {
-	m.lot_size = &i
-	m.addlot_size = nil
+// RealtorsCleared reports if the "realtors" edge to the Realtor entity was cleared.
+func (m *BrokerageMutation) RealtorsCleared() bool {
+	return m.clearedrealtors
 }
 
-// LotSize returns the value of the "lot_size" field in the mutation.
-func (m *ListingMutation) LotSize() (r int, exists bool) {
-	v := m.lot_size
-	if v == nil {
-		return
+// RemoveRealtorIDs removes the "realtors" edge to the Realtor entity by IDs.
+func (m *BrokerageMutation) RemoveRealtorIDs(ids ...uuid.UUID) {
+	if m.removedrealtors == nil {
+		m.removedrealtors = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.realtors, ids[i])
+		m.removedrealtors[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldLotSize returns the old "lot_size" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldLotSize(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLotSize is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLotSize requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLotSize: %w", err)
+// RemovedRealtors returns the removed IDs of the "realtors" edge to the Realtor entity.
+func (m *BrokerageMutation) RemovedRealtorsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrealtors {
+		ids = append(ids, id)
 	}
-	return oldValue.LotSize, nil
+	return
 }
 
-// AddLotSize adds i to the "lot_size" field.
-func (m *ListingMutation) AddLotSize(i int) {
-	if m.addlot_size != nil {
-		*m.addlot_size += i
-	} else {
-		m.addlot_size = &i
+// RealtorsIDs returns the "realtors" edge IDs in the mutation.
+func (m *BrokerageMutation) RealtorsIDs() (ids []uuid.UUID) {
+	for id := range m.realtors {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedLotSize returns the value that was added to the "lot_size" field in this mutation.
-func (m *ListingMutation) AddedLotSize() (r int, exists bool) {
-	v := m.addlot_size
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetRealtors resets all changes to the "realtors" edge.
+func (m *BrokerageMutation) ResetRealtors() {
+	m.realtors = nil
+	m.clearedrealtors = false
+	m.removedrealtors = nil
 }
 
-// ClearLotSize clears the value of the "lot_size" field.
-func (m *ListingMutation) ClearLotSize() {
-	m.lot_size = nil
-	m.addlot_size = nil
-	m.clearedFields[listing.FieldLotSize] = struct{}{}
+// Where appends a list predicates to the BrokerageMutation builder.
+func (m *BrokerageMutation) Where(ps ...predicate.Brokerage) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// LotSizeCleared returns if the "lot_size" field was cleared in this mutation.
-func (m *ListingMutation) LotSizeCleared() bool {
-	_, ok := m.clearedFields[listing.FieldLotSize]
-	return ok
+// WhereP appends storage-level predicates to the BrokerageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BrokerageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Brokerage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// ResetLotSize resets all changes to the "lot_size" field.
-func (m *ListingMutation) ResetLotSize() {
-	m.lot_size = nil
-	m.addlot_size = nil
-	delete(m.clearedFields, listing.FieldLotSize)
+// Op returns the operation name.
+func (m *BrokerageMutation) Op() Op {
+	return m.op
 }
 
-// SetPool sets the "pool" field.
-func (m *ListingMutation) SetPool(b bool) {
-	m.pool = &b
+// SetOp allows setting the mutation operation.
+func (m *BrokerageMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Pool returns the value of the "pool" field in the mutation.
-func (m *ListingMutation) Pool() (r bool, exists bool) {
-	v := m.pool
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Brokerage).
+func (m *BrokerageMutation) Type() string {
+	return m.typ
 }
 
-// OldPool returns the old "pool" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldPool(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPool is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BrokerageMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_time != nil {
+		fields = append(fields, brokerage.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, brokerage.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, brokerage.FieldName)
+	}
+	if m.license_number != nil {
+		fields = append(fields, brokerage.FieldLicenseNumber)
+	}
+	if m.address != nil {
+		fields = append(fields, brokerage.FieldAddress)
+	}
+	if m.city != nil {
+		fields = append(fields, brokerage.FieldCity)
+	}
+	if m.state != nil {
+		fields = append(fields, brokerage.FieldState)
+	}
+	if m.zip_code != nil {
+		fields = append(fields, brokerage.FieldZipCode)
+	}
+	if m.phone != nil {
+		fields = append(fields, brokerage.FieldPhone)
+	}
+	if m.email != nil {
+		fields = append(fields, brokerage.FieldEmail)
+	}
+	if m.website != nil {
+		fields = append(fields, brokerage.FieldWebsite)
+	}
+	if m.logo_url != nil {
+		fields = append(fields, brokerage.FieldLogoURL)
+	}
+	if m.primary_color != nil {
+		fields = append(fields, brokerage.FieldPrimaryColor)
+	}
+	if m.secondary_color != nil {
+		fields = append(fields, brokerage.FieldSecondaryColor)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BrokerageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case brokerage.FieldCreateTime:
+		return m.CreateTime()
+	case brokerage.FieldUpdateTime:
+		return m.UpdateTime()
+	case brokerage.FieldName:
+		return m.Name()
+	case brokerage.FieldLicenseNumber:
+		return m.LicenseNumber()
+	case brokerage.FieldAddress:
+		return m.Address()
+	case brokerage.FieldCity:
+		return m.City()
+	case brokerage.FieldState:
+		return m.State()
+	case brokerage.FieldZipCode:
+		return m.ZipCode()
+	case brokerage.FieldPhone:
+		return m.Phone()
+	case brokerage.FieldEmail:
+		return m.Email()
+	case brokerage.FieldWebsite:
+		return m.Website()
+	case brokerage.FieldLogoURL:
+		return m.LogoURL()
+	case brokerage.FieldPrimaryColor:
+		return m.PrimaryColor()
+	case brokerage.FieldSecondaryColor:
+		return m.SecondaryColor()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BrokerageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case brokerage.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case brokerage.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case brokerage.FieldName:
+		return m.OldName(ctx)
+	case brokerage.FieldLicenseNumber:
+		return m.OldLicenseNumber(ctx)
+	case brokerage.FieldAddress:
+		return m.OldAddress(ctx)
+	case brokerage.FieldCity:
+		return m.OldCity(ctx)
+	case brokerage.FieldState:
+		return m.OldState(ctx)
+	case brokerage.FieldZipCode:
+		return m.OldZipCode(ctx)
+	case brokerage.FieldPhone:
+		return m.OldPhone(ctx)
+	case brokerage.FieldEmail:
+		return m.OldEmail(ctx)
+	case brokerage.FieldWebsite:
+		return m.OldWebsite(ctx)
+	case brokerage.FieldLogoURL:
+		return m.OldLogoURL(ctx)
+	case brokerage.FieldPrimaryColor:
+		return m.OldPrimaryColor(ctx)
+	case brokerage.FieldSecondaryColor:
+		return m.OldSecondaryColor(ctx)
+	}
+	return nil, fmt.Errorf("unknown Brokerage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BrokerageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case brokerage.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case brokerage.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case brokerage.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case brokerage.FieldLicenseNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLicenseNumber(v)
+		return nil
+	case brokerage.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case brokerage.FieldCity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCity(v)
+		return nil
+	case brokerage.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case brokerage.FieldZipCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetZipCode(v)
+		return nil
+	case brokerage.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case brokerage.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case brokerage.FieldWebsite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebsite(v)
+		return nil
+	case brokerage.FieldLogoURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoURL(v)
+		return nil
+	case brokerage.FieldPrimaryColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrimaryColor(v)
+		return nil
+	case brokerage.FieldSecondaryColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecondaryColor(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Brokerage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BrokerageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BrokerageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BrokerageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Brokerage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BrokerageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(brokerage.FieldState) {
+		fields = append(fields, brokerage.FieldState)
+	}
+	if m.FieldCleared(brokerage.FieldPhone) {
+		fields = append(fields, brokerage.FieldPhone)
+	}
+	if m.FieldCleared(brokerage.FieldEmail) {
+		fields = append(fields, brokerage.FieldEmail)
+	}
+	if m.FieldCleared(brokerage.FieldWebsite) {
+		fields = append(fields, brokerage.FieldWebsite)
+	}
+	if m.FieldCleared(brokerage.FieldLogoURL) {
+		fields = append(fields, brokerage.FieldLogoURL)
+	}
+	if m.FieldCleared(brokerage.FieldPrimaryColor) {
+		fields = append(fields, brokerage.FieldPrimaryColor)
+	}
+	if m.FieldCleared(brokerage.FieldSecondaryColor) {
+		fields = append(fields, brokerage.FieldSecondaryColor)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BrokerageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BrokerageMutation) ClearField(name string) error {
+	switch name {
+	case brokerage.FieldState:
+		m.ClearState()
+		return nil
+	case brokerage.FieldPhone:
+		m.ClearPhone()
+		return nil
+	case brokerage.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case brokerage.FieldWebsite:
+		m.ClearWebsite()
+		return nil
+	case brokerage.FieldLogoURL:
+		m.ClearLogoURL()
+		return nil
+	case brokerage.FieldPrimaryColor:
+		m.ClearPrimaryColor()
+		return nil
+	case brokerage.FieldSecondaryColor:
+		m.ClearSecondaryColor()
+		return nil
+	}
+	return fmt.Errorf("unknown Brokerage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BrokerageMutation) ResetField(name string) error {
+	switch name {
+	case brokerage.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case brokerage.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case brokerage.FieldName:
+		m.ResetName()
+		return nil
+	case brokerage.FieldLicenseNumber:
+		m.ResetLicenseNumber()
+		return nil
+	case brokerage.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case brokerage.FieldCity:
+		m.ResetCity()
+		return nil
+	case brokerage.FieldState:
+		m.ResetState()
+		return nil
+	case brokerage.FieldZipCode:
+		m.ResetZipCode()
+		return nil
+	case brokerage.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case brokerage.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case brokerage.FieldWebsite:
+		m.ResetWebsite()
+		return nil
+	case brokerage.FieldLogoURL:
+		m.ResetLogoURL()
+		return nil
+	case brokerage.FieldPrimaryColor:
+		m.ResetPrimaryColor()
+		return nil
+	case brokerage.FieldSecondaryColor:
+		m.ResetSecondaryColor()
+		return nil
+	}
+	return fmt.Errorf("unknown Brokerage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BrokerageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.teams != nil {
+		edges = append(edges, brokerage.EdgeTeams)
+	}
+	if m.realtors != nil {
+		edges = append(edges, brokerage.EdgeRealtors)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BrokerageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case brokerage.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.teams))
+		for id := range m.teams {
+			ids = append(ids, id)
+		}
+		return ids
+	case brokerage.EdgeRealtors:
+		ids := make([]ent.Value, 0, len(m.realtors))
+		for id := range m.realtors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BrokerageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedteams != nil {
+		edges = append(edges, brokerage.EdgeTeams)
+	}
+	if m.removedrealtors != nil {
+		edges = append(edges, brokerage.EdgeRealtors)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BrokerageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case brokerage.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.removedteams))
+		for id := range m.removedteams {
+			ids = append(ids, id)
+		}
+		return ids
+	case brokerage.EdgeRealtors:
+		ids := make([]ent.Value, 0, len(m.removedrealtors))
+		for id := range m.removedrealtors {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BrokerageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedteams {
+		edges = append(edges, brokerage.EdgeTeams)
+	}
+	if m.clearedrealtors {
+		edges = append(edges, brokerage.EdgeRealtors)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BrokerageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case brokerage.EdgeTeams:
+		return m.clearedteams
+	case brokerage.EdgeRealtors:
+		return m.clearedrealtors
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BrokerageMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Brokerage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BrokerageMutation) ResetEdge(name string) error {
+	switch name {
+	case brokerage.EdgeTeams:
+		m.ResetTeams()
+		return nil
+	case brokerage.EdgeRealtors:
+		m.ResetRealtors()
+		return nil
+	}
+	return fmt.Errorf("unknown Brokerage edge %s", name)
+}
+
+// DocumentDownloadMutation represents an operation that mutates the DocumentDownload nodes in the graph.
+type DocumentDownloadMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	user_email      *string
+	ip_address      *string
+	user_agent      *string
+	downloaded_at   *time.Time
+	clearedFields   map[string]struct{}
+	document        *uuid.UUID
+	cleareddocument bool
+	done            bool
+	oldValue        func(context.Context) (*DocumentDownload, error)
+	predicates      []predicate.DocumentDownload
+}
+
+var _ ent.Mutation = (*DocumentDownloadMutation)(nil)
+
+// documentdownloadOption allows management of the mutation configuration using functional options.
+type documentdownloadOption func(*DocumentDownloadMutation)
+
+// newDocumentDownloadMutation creates new mutation for the DocumentDownload entity.
+func newDocumentDownloadMutation(c config, op Op, opts ...documentdownloadOption) *DocumentDownloadMutation {
+	m := &DocumentDownloadMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDocumentDownload,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDocumentDownloadID sets the ID field of the mutation.
+func withDocumentDownloadID(id uuid.UUID) documentdownloadOption {
+	return func(m *DocumentDownloadMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DocumentDownload
+		)
+		m.oldValue = func(ctx context.Context) (*DocumentDownload, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DocumentDownload.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDocumentDownload sets the old DocumentDownload of the mutation.
+func withDocumentDownload(node *DocumentDownload) documentdownloadOption {
+	return func(m *DocumentDownloadMutation) {
+		m.oldValue = func(context.Context) (*DocumentDownload, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DocumentDownloadMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DocumentDownloadMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DocumentDownload entities.
+func (m *DocumentDownloadMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DocumentDownloadMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DocumentDownloadMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DocumentDownload.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDocumentID sets the "document_id" field.
+func (m *DocumentDownloadMutation) SetDocumentID(u uuid.UUID) {
+	m.document = &u
+}
+
+// DocumentID returns the value of the "document_id" field in the mutation.
+func (m *DocumentDownloadMutation) DocumentID() (r uuid.UUID, exists bool) {
+	v := m.document
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentID returns the old "document_id" field's value of the DocumentDownload entity.
+// If the DocumentDownload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentDownloadMutation) OldDocumentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentID: %w", err)
+	}
+	return oldValue.DocumentID, nil
+}
+
+// ResetDocumentID resets all changes to the "document_id" field.
+func (m *DocumentDownloadMutation) ResetDocumentID() {
+	m.document = nil
+}
+
+// SetUserEmail sets the "user_email" field.
+func (m *DocumentDownloadMutation) SetUserEmail(s string) {
+	m.user_email = &s
+}
+
+// UserEmail returns the value of the "user_email" field in the mutation.
+func (m *DocumentDownloadMutation) UserEmail() (r string, exists bool) {
+	v := m.user_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserEmail returns the old "user_email" field's value of the DocumentDownload entity.
+// If the DocumentDownload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentDownloadMutation) OldUserEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserEmail: %w", err)
+	}
+	return oldValue.UserEmail, nil
+}
+
+// ClearUserEmail clears the value of the "user_email" field.
+func (m *DocumentDownloadMutation) ClearUserEmail() {
+	m.user_email = nil
+	m.clearedFields[documentdownload.FieldUserEmail] = struct{}{}
+}
+
+// UserEmailCleared returns if the "user_email" field was cleared in this mutation.
+func (m *DocumentDownloadMutation) UserEmailCleared() bool {
+	_, ok := m.clearedFields[documentdownload.FieldUserEmail]
+	return ok
+}
+
+// ResetUserEmail resets all changes to the "user_email" field.
+func (m *DocumentDownloadMutation) ResetUserEmail() {
+	m.user_email = nil
+	delete(m.clearedFields, documentdownload.FieldUserEmail)
+}
+
+// SetIPAddress sets the "ip_address" field.
+func (m *DocumentDownloadMutation) SetIPAddress(s string) {
+	m.ip_address = &s
+}
+
+// IPAddress returns the value of the "ip_address" field in the mutation.
+func (m *DocumentDownloadMutation) IPAddress() (r string, exists bool) {
+	v := m.ip_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIPAddress returns the old "ip_address" field's value of the DocumentDownload entity.
+// If the DocumentDownload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentDownloadMutation) OldIPAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIPAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIPAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIPAddress: %w", err)
+	}
+	return oldValue.IPAddress, nil
+}
+
+// ClearIPAddress clears the value of the "ip_address" field.
+func (m *DocumentDownloadMutation) ClearIPAddress() {
+	m.ip_address = nil
+	m.clearedFields[documentdownload.FieldIPAddress] = struct{}{}
+}
+
+// IPAddressCleared returns if the "ip_address" field was cleared in this mutation.
+func (m *DocumentDownloadMutation) IPAddressCleared() bool {
+	_, ok := m.clearedFields[documentdownload.FieldIPAddress]
+	return ok
+}
+
+// ResetIPAddress resets all changes to the "ip_address" field.
+func (m *DocumentDownloadMutation) ResetIPAddress() {
+	m.ip_address = nil
+	delete(m.clearedFields, documentdownload.FieldIPAddress)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *DocumentDownloadMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *DocumentDownloadMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the DocumentDownload entity.
+// If the DocumentDownload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentDownloadMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *DocumentDownloadMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[documentdownload.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *DocumentDownloadMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[documentdownload.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *DocumentDownloadMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, documentdownload.FieldUserAgent)
+}
+
+// SetDownloadedAt sets the "downloaded_at" field.
+func (m *DocumentDownloadMutation) SetDownloadedAt(t time.Time) {
+	m.downloaded_at = &t
+}
+
+// DownloadedAt returns the value of the "downloaded_at" field in the mutation.
+func (m *DocumentDownloadMutation) DownloadedAt() (r time.Time, exists bool) {
+	v := m.downloaded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloadedAt returns the old "downloaded_at" field's value of the DocumentDownload entity.
+// If the DocumentDownload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentDownloadMutation) OldDownloadedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloadedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloadedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloadedAt: %w", err)
+	}
+	return oldValue.DownloadedAt, nil
+}
+
+// ResetDownloadedAt resets all changes to the "downloaded_at" field.
+func (m *DocumentDownloadMutation) ResetDownloadedAt() {
+	m.downloaded_at = nil
+}
+
+// ClearDocument clears the "document" edge to the ListingDocument entity.
+func (m *DocumentDownloadMutation) ClearDocument() {
+	m.cleareddocument = true
+	m.clearedFields[documentdownload.FieldDocumentID] = struct{}{}
+}
+
+// DocumentCleared reports if the "document" edge to the ListingDocument entity was cleared.
+func (m *DocumentDownloadMutation) DocumentCleared() bool {
+	return m.cleareddocument
+}
+
+// DocumentIDs returns the "document" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DocumentID instead. It exists only for internal usage by the builders.
+func (m *DocumentDownloadMutation) DocumentIDs() (ids []uuid.UUID) {
+	if id := m.document; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDocument resets all changes to the "document" edge.
+func (m *DocumentDownloadMutation) ResetDocument() {
+	m.document = nil
+	m.cleareddocument = false
+}
+
+// Where appends a list predicates to the DocumentDownloadMutation builder.
+func (m *DocumentDownloadMutation) Where(ps ...predicate.DocumentDownload) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DocumentDownloadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DocumentDownloadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DocumentDownload, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DocumentDownloadMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DocumentDownloadMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DocumentDownload).
+func (m *DocumentDownloadMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DocumentDownloadMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.document != nil {
+		fields = append(fields, documentdownload.FieldDocumentID)
+	}
+	if m.user_email != nil {
+		fields = append(fields, documentdownload.FieldUserEmail)
+	}
+	if m.ip_address != nil {
+		fields = append(fields, documentdownload.FieldIPAddress)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, documentdownload.FieldUserAgent)
+	}
+	if m.downloaded_at != nil {
+		fields = append(fields, documentdownload.FieldDownloadedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DocumentDownloadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case documentdownload.FieldDocumentID:
+		return m.DocumentID()
+	case documentdownload.FieldUserEmail:
+		return m.UserEmail()
+	case documentdownload.FieldIPAddress:
+		return m.IPAddress()
+	case documentdownload.FieldUserAgent:
+		return m.UserAgent()
+	case documentdownload.FieldDownloadedAt:
+		return m.DownloadedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DocumentDownloadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case documentdownload.FieldDocumentID:
+		return m.OldDocumentID(ctx)
+	case documentdownload.FieldUserEmail:
+		return m.OldUserEmail(ctx)
+	case documentdownload.FieldIPAddress:
+		return m.OldIPAddress(ctx)
+	case documentdownload.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case documentdownload.FieldDownloadedAt:
+		return m.OldDownloadedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DocumentDownload field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentDownloadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case documentdownload.FieldDocumentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentID(v)
+		return nil
+	case documentdownload.FieldUserEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserEmail(v)
+		return nil
+	case documentdownload.FieldIPAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIPAddress(v)
+		return nil
+	case documentdownload.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case documentdownload.FieldDownloadedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloadedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentDownload field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DocumentDownloadMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DocumentDownloadMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentDownloadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DocumentDownload numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DocumentDownloadMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(documentdownload.FieldUserEmail) {
+		fields = append(fields, documentdownload.FieldUserEmail)
+	}
+	if m.FieldCleared(documentdownload.FieldIPAddress) {
+		fields = append(fields, documentdownload.FieldIPAddress)
+	}
+	if m.FieldCleared(documentdownload.FieldUserAgent) {
+		fields = append(fields, documentdownload.FieldUserAgent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DocumentDownloadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DocumentDownloadMutation) ClearField(name string) error {
+	switch name {
+	case documentdownload.FieldUserEmail:
+		m.ClearUserEmail()
+		return nil
+	case documentdownload.FieldIPAddress:
+		m.ClearIPAddress()
+		return nil
+	case documentdownload.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentDownload nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DocumentDownloadMutation) ResetField(name string) error {
+	switch name {
+	case documentdownload.FieldDocumentID:
+		m.ResetDocumentID()
+		return nil
+	case documentdownload.FieldUserEmail:
+		m.ResetUserEmail()
+		return nil
+	case documentdownload.FieldIPAddress:
+		m.ResetIPAddress()
+		return nil
+	case documentdownload.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case documentdownload.FieldDownloadedAt:
+		m.ResetDownloadedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentDownload field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DocumentDownloadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.document != nil {
+		edges = append(edges, documentdownload.EdgeDocument)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DocumentDownloadMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case documentdownload.EdgeDocument:
+		if id := m.document; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DocumentDownloadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DocumentDownloadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DocumentDownloadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddocument {
+		edges = append(edges, documentdownload.EdgeDocument)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DocumentDownloadMutation) EdgeCleared(name string) bool {
+	switch name {
+	case documentdownload.EdgeDocument:
+		return m.cleareddocument
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DocumentDownloadMutation) ClearEdge(name string) error {
+	switch name {
+	case documentdownload.EdgeDocument:
+		m.ClearDocument()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentDownload unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DocumentDownloadMutation) ResetEdge(name string) error {
+	switch name {
+	case documentdownload.EdgeDocument:
+		m.ResetDocument()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentDownload edge %s", name)
+}
+
+// ExchangeRateMutation represents an operation that mutates the ExchangeRate nodes in the graph.
+type ExchangeRateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	currency      *string
+	rate          *decimal.Decimal
+	addrate       *decimal.Decimal
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExchangeRate, error)
+	predicates    []predicate.ExchangeRate
+}
+
+var _ ent.Mutation = (*ExchangeRateMutation)(nil)
+
+// exchangerateOption allows management of the mutation configuration using functional options.
+type exchangerateOption func(*ExchangeRateMutation)
+
+// newExchangeRateMutation creates new mutation for the ExchangeRate entity.
+func newExchangeRateMutation(c config, op Op, opts ...exchangerateOption) *ExchangeRateMutation {
+	m := &ExchangeRateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExchangeRate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExchangeRateID sets the ID field of the mutation.
+func withExchangeRateID(id uuid.UUID) exchangerateOption {
+	return func(m *ExchangeRateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExchangeRate
+		)
+		m.oldValue = func(ctx context.Context) (*ExchangeRate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExchangeRate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExchangeRate sets the old ExchangeRate of the mutation.
+func withExchangeRate(node *ExchangeRate) exchangerateOption {
+	return func(m *ExchangeRateMutation) {
+		m.oldValue = func(context.Context) (*ExchangeRate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExchangeRateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExchangeRateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExchangeRate entities.
+func (m *ExchangeRateMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExchangeRateMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExchangeRateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExchangeRate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ExchangeRateMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ExchangeRateMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ExchangeRateMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ExchangeRateMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ExchangeRateMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ExchangeRateMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *ExchangeRateMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *ExchangeRateMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *ExchangeRateMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetRate sets the "rate" field.
+func (m *ExchangeRateMutation) SetRate(d decimal.Decimal) {
+	m.rate = &d
+	m.addrate = nil
+}
+
+// Rate returns the value of the "rate" field in the mutation.
+func (m *ExchangeRateMutation) Rate() (r decimal.Decimal, exists bool) {
+	v := m.rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRate returns the old "rate" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExchangeRateMutation) OldRate(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
+	}
+	return oldValue.Rate, nil
+}
+
+// AddRate adds d to the "rate" field.
+func (m *ExchangeRateMutation) AddRate(d decimal.Decimal) {
+	if m.addrate != nil {
+		*m.addrate = m.addrate.Add(d)
+	} else {
+		m.addrate = &d
+	}
+}
+
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *ExchangeRateMutation) AddedRate() (r decimal.Decimal, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRate resets all changes to the "rate" field.
+func (m *ExchangeRateMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
+}
+
+// Where appends a list predicates to the ExchangeRateMutation builder.
+func (m *ExchangeRateMutation) Where(ps ...predicate.ExchangeRate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExchangeRateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExchangeRateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExchangeRate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExchangeRateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExchangeRateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExchangeRate).
+func (m *ExchangeRateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExchangeRateMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.create_time != nil {
+		fields = append(fields, exchangerate.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, exchangerate.FieldUpdateTime)
+	}
+	if m.currency != nil {
+		fields = append(fields, exchangerate.FieldCurrency)
+	}
+	if m.rate != nil {
+		fields = append(fields, exchangerate.FieldRate)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExchangeRateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exchangerate.FieldCreateTime:
+		return m.CreateTime()
+	case exchangerate.FieldUpdateTime:
+		return m.UpdateTime()
+	case exchangerate.FieldCurrency:
+		return m.Currency()
+	case exchangerate.FieldRate:
+		return m.Rate()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExchangeRateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exchangerate.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case exchangerate.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case exchangerate.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case exchangerate.FieldRate:
+		return m.OldRate(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExchangeRate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExchangeRateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exchangerate.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case exchangerate.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case exchangerate.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case exchangerate.FieldRate:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExchangeRate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExchangeRateMutation) AddedFields() []string {
+	var fields []string
+	if m.addrate != nil {
+		fields = append(fields, exchangerate.FieldRate)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExchangeRateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case exchangerate.FieldRate:
+		return m.AddedRate()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExchangeRateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case exchangerate.FieldRate:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExchangeRate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExchangeRateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExchangeRateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExchangeRateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExchangeRate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExchangeRateMutation) ResetField(name string) error {
+	switch name {
+	case exchangerate.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case exchangerate.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case exchangerate.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case exchangerate.FieldRate:
+		m.ResetRate()
+		return nil
+	}
+	return fmt.Errorf("unknown ExchangeRate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExchangeRateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExchangeRateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExchangeRateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExchangeRateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExchangeRateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExchangeRateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExchangeRateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExchangeRate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExchangeRateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExchangeRate edge %s", name)
+}
+
+// ListingMutation represents an operation that mutates the Listing nodes in the graph.
+type ListingMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	create_time             *time.Time
+	update_time             *time.Time
+	title                   *string
+	address                 *string
+	address_key             *string
+	city                    *string
+	state                   *string
+	zip_code                *string
+	country                 *string
+	latitude                *float64
+	addlatitude             *float64
+	longitude               *float64
+	addlongitude            *float64
+	description             *string
+	source_locale           *string
+	translations            *map[string]schematype.Translation
+	price                   *decimal.Decimal
+	addprice                *decimal.Decimal
+	currency                *string
+	bedroom                 *int
+	addbedroom              *int
+	bathroom                *float64
+	addbathroom             *float64
+	garage                  *int
+	addgarage               *int
+	sqft                    *int
+	addsqft                 *int
+	sqm                     *int
+	addsqm                  *int
+	area_unit               *listing.AreaUnit
+	type_of_property        *listing.TypeOfProperty
+	status                  *listing.Status
+	lot_size                *int
+	addlot_size             *int
+	pool                    *bool
+	year_built              *int
+	addyear_built           *int
+	annual_property_tax     *decimal.Decimal
+	addannual_property_tax  *decimal.Decimal
+	hoa_dues                *decimal.Decimal
+	addhoa_dues             *decimal.Decimal
+	hoa_frequency           *listing.HoaFrequency
+	hoa_name                *string
+	special_assessments     *decimal.Decimal
+	addspecial_assessments  *decimal.Decimal
+	monthly_utilities       *decimal.Decimal
+	addmonthly_utilities    *decimal.Decimal
+	media                   *[]schematype.Media
+	appendmedia             []schematype.Media
+	expires_at              *time.Time
+	expiry_reminder_sent_at *time.Time
+	expired                 *bool
+	listed_at               *time.Time
+	on_market_since         *time.Time
+	market_seconds          *int64
+	addmarket_seconds       *int64
+	sold_price              *decimal.Decimal
+	addsold_price           *decimal.Decimal
+	close_date              *time.Time
+	publish_at              *time.Time
+	unpublish_at            *time.Time
+	version                 *int
+	addversion              *int
+	clearedFields           map[string]struct{}
+	realtor                 *uuid.UUID
+	clearedrealtor          bool
+	property                *uuid.UUID
+	clearedproperty         bool
+	neighborhood            *uuid.UUID
+	clearedneighborhood     bool
+	buyer_realtor           *uuid.UUID
+	clearedbuyer_realtor    bool
+	renewals                map[uuid.UUID]struct{}
+	removedrenewals         map[uuid.UUID]struct{}
+	clearedrenewals         bool
+	documents               map[uuid.UUID]struct{}
+	removeddocuments        map[uuid.UUID]struct{}
+	cleareddocuments        bool
+	inquiries               map[uuid.UUID]struct{}
+	removedinquiries        map[uuid.UUID]struct{}
+	clearedinquiries        bool
+	reviews                 map[uuid.UUID]struct{}
+	removedreviews          map[uuid.UUID]struct{}
+	clearedreviews          bool
+	offers                  map[uuid.UUID]struct{}
+	removedoffers           map[uuid.UUID]struct{}
+	clearedoffers           bool
+	status_events           map[uuid.UUID]struct{}
+	removedstatus_events    map[uuid.UUID]struct{}
+	clearedstatus_events    bool
+	done                    bool
+	oldValue                func(context.Context) (*Listing, error)
+	predicates              []predicate.Listing
+}
+
+var _ ent.Mutation = (*ListingMutation)(nil)
+
+// listingOption allows management of the mutation configuration using functional options.
+type listingOption func(*ListingMutation)
+
+// newListingMutation creates new mutation for the Listing entity.
+func newListingMutation(c config, op Op, opts ...listingOption) *ListingMutation {
+	m := &ListingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeListing,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withListingID sets the ID field of the mutation.
+func withListingID(id uuid.UUID) listingOption {
+	return func(m *ListingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Listing
+		)
+		m.oldValue = func(ctx context.Context) (*Listing, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Listing.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withListing sets the old Listing of the mutation.
+func withListing(node *Listing) listingOption {
+	return func(m *ListingMutation) {
+		m.oldValue = func(context.Context) (*Listing, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ListingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ListingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Listing entities.
+func (m *ListingMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ListingMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ListingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Listing.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ListingMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ListingMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ListingMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ListingMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ListingMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ListingMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *ListingMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ListingMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ListingMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetAddress sets the "address" field.
+func (m *ListingMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *ListingMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *ListingMutation) ResetAddress() {
+	m.address = nil
+}
+
+// SetAddressKey sets the "address_key" field.
+func (m *ListingMutation) SetAddressKey(s string) {
+	m.address_key = &s
+}
+
+// AddressKey returns the value of the "address_key" field in the mutation.
+func (m *ListingMutation) AddressKey() (r string, exists bool) {
+	v := m.address_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressKey returns the old "address_key" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldAddressKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressKey: %w", err)
+	}
+	return oldValue.AddressKey, nil
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (m *ListingMutation) ClearAddressKey() {
+	m.address_key = nil
+	m.clearedFields[listing.FieldAddressKey] = struct{}{}
+}
+
+// AddressKeyCleared returns if the "address_key" field was cleared in this mutation.
+func (m *ListingMutation) AddressKeyCleared() bool {
+	_, ok := m.clearedFields[listing.FieldAddressKey]
+	return ok
+}
+
+// ResetAddressKey resets all changes to the "address_key" field.
+func (m *ListingMutation) ResetAddressKey() {
+	m.address_key = nil
+	delete(m.clearedFields, listing.FieldAddressKey)
+}
+
+// SetCity sets the "city" field.
+func (m *ListingMutation) SetCity(s string) {
+	m.city = &s
+}
+
+// City returns the value of the "city" field in the mutation.
+func (m *ListingMutation) City() (r string, exists bool) {
+	v := m.city
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCity returns the old "city" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldCity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCity: %w", err)
+	}
+	return oldValue.City, nil
+}
+
+// ResetCity resets all changes to the "city" field.
+func (m *ListingMutation) ResetCity() {
+	m.city = nil
+}
+
+// SetState sets the "state" field.
+func (m *ListingMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *ListingMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *ListingMutation) ResetState() {
+	m.state = nil
+}
+
+// SetZipCode sets the "zip_code" field.
+func (m *ListingMutation) SetZipCode(s string) {
+	m.zip_code = &s
+}
+
+// ZipCode returns the value of the "zip_code" field in the mutation.
+func (m *ListingMutation) ZipCode() (r string, exists bool) {
+	v := m.zip_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldZipCode returns the old "zip_code" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldZipCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldZipCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldZipCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldZipCode: %w", err)
+	}
+	return oldValue.ZipCode, nil
+}
+
+// ResetZipCode resets all changes to the "zip_code" field.
+func (m *ListingMutation) ResetZipCode() {
+	m.zip_code = nil
+}
+
+// SetCountry sets the "country" field.
+func (m *ListingMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *ListingMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountry returns the old "country" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
+}
+
+// ResetCountry resets all changes to the "country" field.
+func (m *ListingMutation) ResetCountry() {
+	m.country = nil
+}
+
+// SetLatitude sets the "latitude" field.
+func (m *ListingMutation) SetLatitude(f float64) {
+	m.latitude = &f
+	m.addlatitude = nil
+}
+
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *ListingMutation) Latitude() (r float64, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatitude returns the old "latitude" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldLatitude(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	}
+	return oldValue.Latitude, nil
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (m *ListingMutation) AddLatitude(f float64) {
+	if m.addlatitude != nil {
+		*m.addlatitude += f
+	} else {
+		m.addlatitude = &f
+	}
+}
+
+// AddedLatitude returns the value that was added to the "latitude" field in this mutation.
+func (m *ListingMutation) AddedLatitude() (r float64, exists bool) {
+	v := m.addlatitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (m *ListingMutation) ClearLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	m.clearedFields[listing.FieldLatitude] = struct{}{}
+}
+
+// LatitudeCleared returns if the "latitude" field was cleared in this mutation.
+func (m *ListingMutation) LatitudeCleared() bool {
+	_, ok := m.clearedFields[listing.FieldLatitude]
+	return ok
+}
+
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *ListingMutation) ResetLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	delete(m.clearedFields, listing.FieldLatitude)
+}
+
+// SetLongitude sets the "longitude" field.
+func (m *ListingMutation) SetLongitude(f float64) {
+	m.longitude = &f
+	m.addlongitude = nil
+}
+
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *ListingMutation) Longitude() (r float64, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongitude returns the old "longitude" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldLongitude(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+	}
+	return oldValue.Longitude, nil
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (m *ListingMutation) AddLongitude(f float64) {
+	if m.addlongitude != nil {
+		*m.addlongitude += f
+	} else {
+		m.addlongitude = &f
+	}
+}
+
+// AddedLongitude returns the value that was added to the "longitude" field in this mutation.
+func (m *ListingMutation) AddedLongitude() (r float64, exists bool) {
+	v := m.addlongitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (m *ListingMutation) ClearLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	m.clearedFields[listing.FieldLongitude] = struct{}{}
+}
+
+// LongitudeCleared returns if the "longitude" field was cleared in this mutation.
+func (m *ListingMutation) LongitudeCleared() bool {
+	_, ok := m.clearedFields[listing.FieldLongitude]
+	return ok
+}
+
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *ListingMutation) ResetLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	delete(m.clearedFields, listing.FieldLongitude)
+}
+
+// SetNeighborhoodID sets the "neighborhood_id" field.
+func (m *ListingMutation) SetNeighborhoodID(u uuid.UUID) {
+	m.neighborhood = &u
+}
+
+// NeighborhoodID returns the value of the "neighborhood_id" field in the mutation.
+func (m *ListingMutation) NeighborhoodID() (r uuid.UUID, exists bool) {
+	v := m.neighborhood
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNeighborhoodID returns the old "neighborhood_id" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldNeighborhoodID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNeighborhoodID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNeighborhoodID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNeighborhoodID: %w", err)
+	}
+	return oldValue.NeighborhoodID, nil
+}
+
+// ClearNeighborhoodID clears the value of the "neighborhood_id" field.
+func (m *ListingMutation) ClearNeighborhoodID() {
+	m.neighborhood = nil
+	m.clearedFields[listing.FieldNeighborhoodID] = struct{}{}
+}
+
+// NeighborhoodIDCleared returns if the "neighborhood_id" field was cleared in this mutation.
+func (m *ListingMutation) NeighborhoodIDCleared() bool {
+	_, ok := m.clearedFields[listing.FieldNeighborhoodID]
+	return ok
+}
+
+// ResetNeighborhoodID resets all changes to the "neighborhood_id" field.
+func (m *ListingMutation) ResetNeighborhoodID() {
+	m.neighborhood = nil
+	delete(m.clearedFields, listing.FieldNeighborhoodID)
+}
+
+// SetDescription sets the "description" field.
+func (m *ListingMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ListingMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ListingMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[listing.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ListingMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[listing.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ListingMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, listing.FieldDescription)
+}
+
+// SetSourceLocale sets the "source_locale" field.
+func (m *ListingMutation) SetSourceLocale(s string) {
+	m.source_locale = &s
+}
+
+// SourceLocale returns the value of the "source_locale" field in the mutation.
+func (m *ListingMutation) SourceLocale() (r string, exists bool) {
+	v := m.source_locale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceLocale returns the old "source_locale" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldSourceLocale(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceLocale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceLocale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceLocale: %w", err)
+	}
+	return oldValue.SourceLocale, nil
+}
+
+// ResetSourceLocale resets all changes to the "source_locale" field.
+func (m *ListingMutation) ResetSourceLocale() {
+	m.source_locale = nil
+}
+
+// SetTranslations sets the "translations" field.
+func (m *ListingMutation) SetTranslations(value map[string]schematype.Translation) {
+	m.translations = &value
+}
+
+// Translations returns the value of the "translations" field in the mutation.
+func (m *ListingMutation) Translations() (r map[string]schematype.Translation, exists bool) {
+	v := m.translations
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTranslations returns the old "translations" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldTranslations(ctx context.Context) (v map[string]schematype.Translation, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTranslations is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTranslations requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTranslations: %w", err)
+	}
+	return oldValue.Translations, nil
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (m *ListingMutation) ClearTranslations() {
+	m.translations = nil
+	m.clearedFields[listing.FieldTranslations] = struct{}{}
+}
+
+// TranslationsCleared returns if the "translations" field was cleared in this mutation.
+func (m *ListingMutation) TranslationsCleared() bool {
+	_, ok := m.clearedFields[listing.FieldTranslations]
+	return ok
+}
+
+// ResetTranslations resets all changes to the "translations" field.
+func (m *ListingMutation) ResetTranslations() {
+	m.translations = nil
+	delete(m.clearedFields, listing.FieldTranslations)
+}
+
+// SetPrice sets the "price" field.
+func (m *ListingMutation) SetPrice(d decimal.Decimal) {
+	m.price = &d
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *ListingMutation) Price() (r decimal.Decimal, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldPrice(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds d to the "price" field.
+func (m *ListingMutation) AddPrice(d decimal.Decimal) {
+	if m.addprice != nil {
+		*m.addprice = m.addprice.Add(d)
+	} else {
+		m.addprice = &d
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *ListingMutation) AddedPrice() (r decimal.Decimal, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *ListingMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *ListingMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *ListingMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *ListingMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetBedroom sets the "bedroom" field.
+func (m *ListingMutation) SetBedroom(i int) {
+	m.bedroom = &i
+	m.addbedroom = nil
+}
+
+// Bedroom returns the value of the "bedroom" field in the mutation.
+func (m *ListingMutation) Bedroom() (r int, exists bool) {
+	v := m.bedroom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBedroom returns the old "bedroom" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldBedroom(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBedroom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBedroom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBedroom: %w", err)
+	}
+	return oldValue.Bedroom, nil
+}
+
+// AddBedroom adds i to the "bedroom" field.
+func (m *ListingMutation) AddBedroom(i int) {
+	if m.addbedroom != nil {
+		*m.addbedroom += i
+	} else {
+		m.addbedroom = &i
+	}
+}
+
+// AddedBedroom returns the value that was added to the "bedroom" field in this mutation.
+func (m *ListingMutation) AddedBedroom() (r int, exists bool) {
+	v := m.addbedroom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBedroom resets all changes to the "bedroom" field.
+func (m *ListingMutation) ResetBedroom() {
+	m.bedroom = nil
+	m.addbedroom = nil
+}
+
+// SetBathroom sets the "bathroom" field.
+func (m *ListingMutation) SetBathroom(f float64) {
+	m.bathroom = &f
+	m.addbathroom = nil
+}
+
+// Bathroom returns the value of the "bathroom" field in the mutation.
+func (m *ListingMutation) Bathroom() (r float64, exists bool) {
+	v := m.bathroom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBathroom returns the old "bathroom" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldBathroom(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBathroom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBathroom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBathroom: %w", err)
+	}
+	return oldValue.Bathroom, nil
+}
+
+// AddBathroom adds f to the "bathroom" field.
+func (m *ListingMutation) AddBathroom(f float64) {
+	if m.addbathroom != nil {
+		*m.addbathroom += f
+	} else {
+		m.addbathroom = &f
+	}
+}
+
+// AddedBathroom returns the value that was added to the "bathroom" field in this mutation.
+func (m *ListingMutation) AddedBathroom() (r float64, exists bool) {
+	v := m.addbathroom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBathroom resets all changes to the "bathroom" field.
+func (m *ListingMutation) ResetBathroom() {
+	m.bathroom = nil
+	m.addbathroom = nil
+}
+
+// SetGarage sets the "garage" field.
+func (m *ListingMutation) SetGarage(i int) {
+	m.garage = &i
+	m.addgarage = nil
+}
+
+// Garage returns the value of the "garage" field in the mutation.
+func (m *ListingMutation) Garage() (r int, exists bool) {
+	v := m.garage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGarage returns the old "garage" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldGarage(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGarage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGarage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGarage: %w", err)
+	}
+	return oldValue.Garage, nil
+}
+
+// AddGarage adds i to the "garage" field.
+func (m *ListingMutation) AddGarage(i int) {
+	if m.addgarage != nil {
+		*m.addgarage += i
+	} else {
+		m.addgarage = &i
+	}
+}
+
+// AddedGarage returns the value that was added to the "garage" field in this mutation.
+func (m *ListingMutation) AddedGarage() (r int, exists bool) {
+	v := m.addgarage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearGarage clears the value of the "garage" field.
+func (m *ListingMutation) ClearGarage() {
+	m.garage = nil
+	m.addgarage = nil
+	m.clearedFields[listing.FieldGarage] = struct{}{}
+}
+
+// GarageCleared returns if the "garage" field was cleared in this mutation.
+func (m *ListingMutation) GarageCleared() bool {
+	_, ok := m.clearedFields[listing.FieldGarage]
+	return ok
+}
+
+// ResetGarage resets all changes to the "garage" field.
+func (m *ListingMutation) ResetGarage() {
+	m.garage = nil
+	m.addgarage = nil
+	delete(m.clearedFields, listing.FieldGarage)
+}
+
+// SetSqft sets the "sqft" field.
+func (m *ListingMutation) SetSqft(i int) {
+	m.sqft = &i
+	m.addsqft = nil
+}
+
+// Sqft returns the value of the "sqft" field in the mutation.
+func (m *ListingMutation) Sqft() (r int, exists bool) {
+	v := m.sqft
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSqft returns the old "sqft" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldSqft(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSqft is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSqft requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSqft: %w", err)
+	}
+	return oldValue.Sqft, nil
+}
+
+// AddSqft adds i to the "sqft" field.
+func (m *ListingMutation) AddSqft(i int) {
+	if m.addsqft != nil {
+		*m.addsqft += i
+	} else {
+		m.addsqft = &i
+	}
+}
+
+// AddedSqft returns the value that was added to the "sqft" field in this mutation.
+func (m *ListingMutation) AddedSqft() (r int, exists bool) {
+	v := m.addsqft
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSqft resets all changes to the "sqft" field.
+func (m *ListingMutation) ResetSqft() {
+	m.sqft = nil
+	m.addsqft = nil
+}
+
+// SetSqm sets the "sqm" field.
+func (m *ListingMutation) SetSqm(i int) {
+	m.sqm = &i
+	m.addsqm = nil
+}
+
+// Sqm returns the value of the "sqm" field in the mutation.
+func (m *ListingMutation) Sqm() (r int, exists bool) {
+	v := m.sqm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSqm returns the old "sqm" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldSqm(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSqm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSqm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSqm: %w", err)
+	}
+	return oldValue.Sqm, nil
+}
+
+// AddSqm adds i to the "sqm" field.
+func (m *ListingMutation) AddSqm(i int) {
+	if m.addsqm != nil {
+		*m.addsqm += i
+	} else {
+		m.addsqm = &i
+	}
+}
+
+// AddedSqm returns the value that was added to the "sqm" field in this mutation.
+func (m *ListingMutation) AddedSqm() (r int, exists bool) {
+	v := m.addsqm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (m *ListingMutation) ClearSqm() {
+	m.sqm = nil
+	m.addsqm = nil
+	m.clearedFields[listing.FieldSqm] = struct{}{}
+}
+
+// SqmCleared returns if the "sqm" field was cleared in this mutation.
+func (m *ListingMutation) SqmCleared() bool {
+	_, ok := m.clearedFields[listing.FieldSqm]
+	return ok
+}
+
+// ResetSqm resets all changes to the "sqm" field.
+func (m *ListingMutation) ResetSqm() {
+	m.sqm = nil
+	m.addsqm = nil
+	delete(m.clearedFields, listing.FieldSqm)
+}
+
+// SetAreaUnit sets the "area_unit" field.
+func (m *ListingMutation) SetAreaUnit(lu listing.AreaUnit) {
+	m.area_unit = &lu
+}
+
+// AreaUnit returns the value of the "area_unit" field in the mutation.
+func (m *ListingMutation) AreaUnit() (r listing.AreaUnit, exists bool) {
+	v := m.area_unit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAreaUnit returns the old "area_unit" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldAreaUnit(ctx context.Context) (v listing.AreaUnit, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAreaUnit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAreaUnit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAreaUnit: %w", err)
+	}
+	return oldValue.AreaUnit, nil
+}
+
+// ResetAreaUnit resets all changes to the "area_unit" field.
+func (m *ListingMutation) ResetAreaUnit() {
+	m.area_unit = nil
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (m *ListingMutation) SetTypeOfProperty(lop listing.TypeOfProperty) {
+	m.type_of_property = &lop
+}
+
+// TypeOfProperty returns the value of the "type_of_property" field in the mutation.
+func (m *ListingMutation) TypeOfProperty() (r listing.TypeOfProperty, exists bool) {
+	v := m.type_of_property
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypeOfProperty returns the old "type_of_property" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldTypeOfProperty(ctx context.Context) (v listing.TypeOfProperty, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypeOfProperty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypeOfProperty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypeOfProperty: %w", err)
+	}
+	return oldValue.TypeOfProperty, nil
+}
+
+// ResetTypeOfProperty resets all changes to the "type_of_property" field.
+func (m *ListingMutation) ResetTypeOfProperty() {
+	m.type_of_property = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ListingMutation) SetStatus(l listing.Status) {
+	m.status = &l
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ListingMutation) Status() (r listing.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldStatus(ctx context.Context) (v listing.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ListingMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetLotSize sets the "lot_size" field.
+func (m *ListingMutation) SetLotSize(i int) {
+	m.lot_size = &i
+	m.addlot_size = nil
+}
+
+// LotSize returns the value of the "lot_size" field in the mutation.
+func (m *ListingMutation) LotSize() (r int, exists bool) {
+	v := m.lot_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLotSize returns the old "lot_size" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldLotSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLotSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLotSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLotSize: %w", err)
+	}
+	return oldValue.LotSize, nil
+}
+
+// AddLotSize adds i to the "lot_size" field.
+func (m *ListingMutation) AddLotSize(i int) {
+	if m.addlot_size != nil {
+		*m.addlot_size += i
+	} else {
+		m.addlot_size = &i
+	}
+}
+
+// AddedLotSize returns the value that was added to the "lot_size" field in this mutation.
+func (m *ListingMutation) AddedLotSize() (r int, exists bool) {
+	v := m.addlot_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLotSize clears the value of the "lot_size" field.
+func (m *ListingMutation) ClearLotSize() {
+	m.lot_size = nil
+	m.addlot_size = nil
+	m.clearedFields[listing.FieldLotSize] = struct{}{}
+}
+
+// LotSizeCleared returns if the "lot_size" field was cleared in this mutation.
+func (m *ListingMutation) LotSizeCleared() bool {
+	_, ok := m.clearedFields[listing.FieldLotSize]
+	return ok
+}
+
+// ResetLotSize resets all changes to the "lot_size" field.
+func (m *ListingMutation) ResetLotSize() {
+	m.lot_size = nil
+	m.addlot_size = nil
+	delete(m.clearedFields, listing.FieldLotSize)
+}
+
+// SetPool sets the "pool" field.
+func (m *ListingMutation) SetPool(b bool) {
+	m.pool = &b
+}
+
+// Pool returns the value of the "pool" field in the mutation.
+func (m *ListingMutation) Pool() (r bool, exists bool) {
+	v := m.pool
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPool returns the old "pool" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldPool(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPool is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPool requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPool: %w", err)
+	}
+	return oldValue.Pool, nil
+}
+
+// ClearPool clears the value of the "pool" field.
+func (m *ListingMutation) ClearPool() {
+	m.pool = nil
+	m.clearedFields[listing.FieldPool] = struct{}{}
+}
+
+// PoolCleared returns if the "pool" field was cleared in this mutation.
+func (m *ListingMutation) PoolCleared() bool {
+	_, ok := m.clearedFields[listing.FieldPool]
+	return ok
+}
+
+// ResetPool resets all changes to the "pool" field.
+func (m *ListingMutation) ResetPool() {
+	m.pool = nil
+	delete(m.clearedFields, listing.FieldPool)
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (m *ListingMutation) SetYearBuilt(i int) {
+	m.year_built = &i
+	m.addyear_built = nil
+}
+
+// YearBuilt returns the value of the "year_built" field in the mutation.
+func (m *ListingMutation) YearBuilt() (r int, exists bool) {
+	v := m.year_built
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldYearBuilt returns the old "year_built" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldYearBuilt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldYearBuilt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldYearBuilt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldYearBuilt: %w", err)
+	}
+	return oldValue.YearBuilt, nil
+}
+
+// AddYearBuilt adds i to the "year_built" field.
+func (m *ListingMutation) AddYearBuilt(i int) {
+	if m.addyear_built != nil {
+		*m.addyear_built += i
+	} else {
+		m.addyear_built = &i
+	}
+}
+
+// AddedYearBuilt returns the value that was added to the "year_built" field in this mutation.
+func (m *ListingMutation) AddedYearBuilt() (r int, exists bool) {
+	v := m.addyear_built
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetYearBuilt resets all changes to the "year_built" field.
+func (m *ListingMutation) ResetYearBuilt() {
+	m.year_built = nil
+	m.addyear_built = nil
+}
+
+// SetAnnualPropertyTax sets the "annual_property_tax" field.
+func (m *ListingMutation) SetAnnualPropertyTax(d decimal.Decimal) {
+	m.annual_property_tax = &d
+	m.addannual_property_tax = nil
+}
+
+// AnnualPropertyTax returns the value of the "annual_property_tax" field in the mutation.
+func (m *ListingMutation) AnnualPropertyTax() (r decimal.Decimal, exists bool) {
+	v := m.annual_property_tax
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnnualPropertyTax returns the old "annual_property_tax" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldAnnualPropertyTax(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnnualPropertyTax is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnnualPropertyTax requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnnualPropertyTax: %w", err)
+	}
+	return oldValue.AnnualPropertyTax, nil
+}
+
+// AddAnnualPropertyTax adds d to the "annual_property_tax" field.
+func (m *ListingMutation) AddAnnualPropertyTax(d decimal.Decimal) {
+	if m.addannual_property_tax != nil {
+		*m.addannual_property_tax = m.addannual_property_tax.Add(d)
+	} else {
+		m.addannual_property_tax = &d
+	}
+}
+
+// AddedAnnualPropertyTax returns the value that was added to the "annual_property_tax" field in this mutation.
+func (m *ListingMutation) AddedAnnualPropertyTax() (r decimal.Decimal, exists bool) {
+	v := m.addannual_property_tax
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAnnualPropertyTax clears the value of the "annual_property_tax" field.
+func (m *ListingMutation) ClearAnnualPropertyTax() {
+	m.annual_property_tax = nil
+	m.addannual_property_tax = nil
+	m.clearedFields[listing.FieldAnnualPropertyTax] = struct{}{}
+}
+
+// AnnualPropertyTaxCleared returns if the "annual_property_tax" field was cleared in this mutation.
+func (m *ListingMutation) AnnualPropertyTaxCleared() bool {
+	_, ok := m.clearedFields[listing.FieldAnnualPropertyTax]
+	return ok
+}
+
+// ResetAnnualPropertyTax resets all changes to the "annual_property_tax" field.
+func (m *ListingMutation) ResetAnnualPropertyTax() {
+	m.annual_property_tax = nil
+	m.addannual_property_tax = nil
+	delete(m.clearedFields, listing.FieldAnnualPropertyTax)
+}
+
+// SetHoaDues sets the "hoa_dues" field.
+func (m *ListingMutation) SetHoaDues(d decimal.Decimal) {
+	m.hoa_dues = &d
+	m.addhoa_dues = nil
+}
+
+// HoaDues returns the value of the "hoa_dues" field in the mutation.
+func (m *ListingMutation) HoaDues() (r decimal.Decimal, exists bool) {
+	v := m.hoa_dues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHoaDues returns the old "hoa_dues" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldHoaDues(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHoaDues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHoaDues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHoaDues: %w", err)
+	}
+	return oldValue.HoaDues, nil
+}
+
+// AddHoaDues adds d to the "hoa_dues" field.
+func (m *ListingMutation) AddHoaDues(d decimal.Decimal) {
+	if m.addhoa_dues != nil {
+		*m.addhoa_dues = m.addhoa_dues.Add(d)
+	} else {
+		m.addhoa_dues = &d
+	}
+}
+
+// AddedHoaDues returns the value that was added to the "hoa_dues" field in this mutation.
+func (m *ListingMutation) AddedHoaDues() (r decimal.Decimal, exists bool) {
+	v := m.addhoa_dues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHoaDues clears the value of the "hoa_dues" field.
+func (m *ListingMutation) ClearHoaDues() {
+	m.hoa_dues = nil
+	m.addhoa_dues = nil
+	m.clearedFields[listing.FieldHoaDues] = struct{}{}
+}
+
+// HoaDuesCleared returns if the "hoa_dues" field was cleared in this mutation.
+func (m *ListingMutation) HoaDuesCleared() bool {
+	_, ok := m.clearedFields[listing.FieldHoaDues]
+	return ok
+}
+
+// ResetHoaDues resets all changes to the "hoa_dues" field.
+func (m *ListingMutation) ResetHoaDues() {
+	m.hoa_dues = nil
+	m.addhoa_dues = nil
+	delete(m.clearedFields, listing.FieldHoaDues)
+}
+
+// SetHoaFrequency sets the "hoa_frequency" field.
+func (m *ListingMutation) SetHoaFrequency(lf listing.HoaFrequency) {
+	m.hoa_frequency = &lf
+}
+
+// HoaFrequency returns the value of the "hoa_frequency" field in the mutation.
+func (m *ListingMutation) HoaFrequency() (r listing.HoaFrequency, exists bool) {
+	v := m.hoa_frequency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHoaFrequency returns the old "hoa_frequency" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldHoaFrequency(ctx context.Context) (v *listing.HoaFrequency, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHoaFrequency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHoaFrequency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHoaFrequency: %w", err)
+	}
+	return oldValue.HoaFrequency, nil
+}
+
+// ClearHoaFrequency clears the value of the "hoa_frequency" field.
+func (m *ListingMutation) ClearHoaFrequency() {
+	m.hoa_frequency = nil
+	m.clearedFields[listing.FieldHoaFrequency] = struct{}{}
+}
+
+// HoaFrequencyCleared returns if the "hoa_frequency" field was cleared in this mutation.
+func (m *ListingMutation) HoaFrequencyCleared() bool {
+	_, ok := m.clearedFields[listing.FieldHoaFrequency]
+	return ok
+}
+
+// ResetHoaFrequency resets all changes to the "hoa_frequency" field.
+func (m *ListingMutation) ResetHoaFrequency() {
+	m.hoa_frequency = nil
+	delete(m.clearedFields, listing.FieldHoaFrequency)
+}
+
+// SetHoaName sets the "hoa_name" field.
+func (m *ListingMutation) SetHoaName(s string) {
+	m.hoa_name = &s
+}
+
+// HoaName returns the value of the "hoa_name" field in the mutation.
+func (m *ListingMutation) HoaName() (r string, exists bool) {
+	v := m.hoa_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHoaName returns the old "hoa_name" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldHoaName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHoaName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHoaName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHoaName: %w", err)
+	}
+	return oldValue.HoaName, nil
+}
+
+// ClearHoaName clears the value of the "hoa_name" field.
+func (m *ListingMutation) ClearHoaName() {
+	m.hoa_name = nil
+	m.clearedFields[listing.FieldHoaName] = struct{}{}
+}
+
+// HoaNameCleared returns if the "hoa_name" field was cleared in this mutation.
+func (m *ListingMutation) HoaNameCleared() bool {
+	_, ok := m.clearedFields[listing.FieldHoaName]
+	return ok
+}
+
+// ResetHoaName resets all changes to the "hoa_name" field.
+func (m *ListingMutation) ResetHoaName() {
+	m.hoa_name = nil
+	delete(m.clearedFields, listing.FieldHoaName)
+}
+
+// SetSpecialAssessments sets the "special_assessments" field.
+func (m *ListingMutation) SetSpecialAssessments(d decimal.Decimal) {
+	m.special_assessments = &d
+	m.addspecial_assessments = nil
+}
+
+// SpecialAssessments returns the value of the "special_assessments" field in the mutation.
+func (m *ListingMutation) SpecialAssessments() (r decimal.Decimal, exists bool) {
+	v := m.special_assessments
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpecialAssessments returns the old "special_assessments" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldSpecialAssessments(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpecialAssessments is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpecialAssessments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpecialAssessments: %w", err)
+	}
+	return oldValue.SpecialAssessments, nil
+}
+
+// AddSpecialAssessments adds d to the "special_assessments" field.
+func (m *ListingMutation) AddSpecialAssessments(d decimal.Decimal) {
+	if m.addspecial_assessments != nil {
+		*m.addspecial_assessments = m.addspecial_assessments.Add(d)
+	} else {
+		m.addspecial_assessments = &d
+	}
+}
+
+// AddedSpecialAssessments returns the value that was added to the "special_assessments" field in this mutation.
+func (m *ListingMutation) AddedSpecialAssessments() (r decimal.Decimal, exists bool) {
+	v := m.addspecial_assessments
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSpecialAssessments clears the value of the "special_assessments" field.
+func (m *ListingMutation) ClearSpecialAssessments() {
+	m.special_assessments = nil
+	m.addspecial_assessments = nil
+	m.clearedFields[listing.FieldSpecialAssessments] = struct{}{}
+}
+
+// SpecialAssessmentsCleared returns if the "special_assessments" field was cleared in this mutation.
+func (m *ListingMutation) SpecialAssessmentsCleared() bool {
+	_, ok := m.clearedFields[listing.FieldSpecialAssessments]
+	return ok
+}
+
+// ResetSpecialAssessments resets all changes to the "special_assessments" field.
+func (m *ListingMutation) ResetSpecialAssessments() {
+	m.special_assessments = nil
+	m.addspecial_assessments = nil
+	delete(m.clearedFields, listing.FieldSpecialAssessments)
+}
+
+// SetMonthlyUtilities sets the "monthly_utilities" field.
+func (m *ListingMutation) SetMonthlyUtilities(d decimal.Decimal) {
+	m.monthly_utilities = &d
+	m.addmonthly_utilities = nil
+}
+
+// MonthlyUtilities returns the value of the "monthly_utilities" field in the mutation.
+func (m *ListingMutation) MonthlyUtilities() (r decimal.Decimal, exists bool) {
+	v := m.monthly_utilities
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonthlyUtilities returns the old "monthly_utilities" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldMonthlyUtilities(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyUtilities is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyUtilities requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyUtilities: %w", err)
+	}
+	return oldValue.MonthlyUtilities, nil
+}
+
+// AddMonthlyUtilities adds d to the "monthly_utilities" field.
+func (m *ListingMutation) AddMonthlyUtilities(d decimal.Decimal) {
+	if m.addmonthly_utilities != nil {
+		*m.addmonthly_utilities = m.addmonthly_utilities.Add(d)
+	} else {
+		m.addmonthly_utilities = &d
+	}
+}
+
+// AddedMonthlyUtilities returns the value that was added to the "monthly_utilities" field in this mutation.
+func (m *ListingMutation) AddedMonthlyUtilities() (r decimal.Decimal, exists bool) {
+	v := m.addmonthly_utilities
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMonthlyUtilities clears the value of the "monthly_utilities" field.
+func (m *ListingMutation) ClearMonthlyUtilities() {
+	m.monthly_utilities = nil
+	m.addmonthly_utilities = nil
+	m.clearedFields[listing.FieldMonthlyUtilities] = struct{}{}
+}
+
+// MonthlyUtilitiesCleared returns if the "monthly_utilities" field was cleared in this mutation.
+func (m *ListingMutation) MonthlyUtilitiesCleared() bool {
+	_, ok := m.clearedFields[listing.FieldMonthlyUtilities]
+	return ok
+}
+
+// ResetMonthlyUtilities resets all changes to the "monthly_utilities" field.
+func (m *ListingMutation) ResetMonthlyUtilities() {
+	m.monthly_utilities = nil
+	m.addmonthly_utilities = nil
+	delete(m.clearedFields, listing.FieldMonthlyUtilities)
+}
+
+// SetMedia sets the "media" field.
+func (m *ListingMutation) SetMedia(s []schematype.Media) {
+	m.media = &s
+	m.appendmedia = nil
+}
+
+// Media returns the value of the "media" field in the mutation.
+func (m *ListingMutation) Media() (r []schematype.Media, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMedia returns the old "media" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldMedia(ctx context.Context) (v []schematype.Media, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMedia is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMedia requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMedia: %w", err)
+	}
+	return oldValue.Media, nil
+}
+
+// AppendMedia adds s to the "media" field.
+func (m *ListingMutation) AppendMedia(s []schematype.Media) {
+	m.appendmedia = append(m.appendmedia, s...)
+}
+
+// AppendedMedia returns the list of values that were appended to the "media" field in this mutation.
+func (m *ListingMutation) AppendedMedia() ([]schematype.Media, bool) {
+	if len(m.appendmedia) == 0 {
+		return nil, false
+	}
+	return m.appendmedia, true
+}
+
+// ClearMedia clears the value of the "media" field.
+func (m *ListingMutation) ClearMedia() {
+	m.media = nil
+	m.appendmedia = nil
+	m.clearedFields[listing.FieldMedia] = struct{}{}
+}
+
+// MediaCleared returns if the "media" field was cleared in this mutation.
+func (m *ListingMutation) MediaCleared() bool {
+	_, ok := m.clearedFields[listing.FieldMedia]
+	return ok
+}
+
+// ResetMedia resets all changes to the "media" field.
+func (m *ListingMutation) ResetMedia() {
+	m.media = nil
+	m.appendmedia = nil
+	delete(m.clearedFields, listing.FieldMedia)
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (m *ListingMutation) SetRealtorID(u uuid.UUID) {
+	m.realtor = &u
+}
+
+// RealtorID returns the value of the "realtor_id" field in the mutation.
+func (m *ListingMutation) RealtorID() (r uuid.UUID, exists bool) {
+	v := m.realtor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRealtorID returns the old "realtor_id" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldRealtorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRealtorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRealtorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRealtorID: %w", err)
+	}
+	return oldValue.RealtorID, nil
+}
+
+// ResetRealtorID resets all changes to the "realtor_id" field.
+func (m *ListingMutation) ResetRealtorID() {
+	m.realtor = nil
+}
+
+// SetPropertyID sets the "property_id" field.
+func (m *ListingMutation) SetPropertyID(u uuid.UUID) {
+	m.property = &u
+}
+
+// PropertyID returns the value of the "property_id" field in the mutation.
+func (m *ListingMutation) PropertyID() (r uuid.UUID, exists bool) {
+	v := m.property
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPropertyID returns the old "property_id" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldPropertyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPropertyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPropertyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPropertyID: %w", err)
+	}
+	return oldValue.PropertyID, nil
+}
+
+// ClearPropertyID clears the value of the "property_id" field.
+func (m *ListingMutation) ClearPropertyID() {
+	m.property = nil
+	m.clearedFields[listing.FieldPropertyID] = struct{}{}
+}
+
+// PropertyIDCleared returns if the "property_id" field was cleared in this mutation.
+func (m *ListingMutation) PropertyIDCleared() bool {
+	_, ok := m.clearedFields[listing.FieldPropertyID]
+	return ok
+}
+
+// ResetPropertyID resets all changes to the "property_id" field.
+func (m *ListingMutation) ResetPropertyID() {
+	m.property = nil
+	delete(m.clearedFields, listing.FieldPropertyID)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ListingMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ListingMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ListingMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[listing.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ListingMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[listing.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ListingMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, listing.FieldExpiresAt)
+}
+
+// SetExpiryReminderSentAt sets the "expiry_reminder_sent_at" field.
+func (m *ListingMutation) SetExpiryReminderSentAt(t time.Time) {
+	m.expiry_reminder_sent_at = &t
+}
+
+// ExpiryReminderSentAt returns the value of the "expiry_reminder_sent_at" field in the mutation.
+func (m *ListingMutation) ExpiryReminderSentAt() (r time.Time, exists bool) {
+	v := m.expiry_reminder_sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryReminderSentAt returns the old "expiry_reminder_sent_at" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldExpiryReminderSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryReminderSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryReminderSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryReminderSentAt: %w", err)
+	}
+	return oldValue.ExpiryReminderSentAt, nil
+}
+
+// ClearExpiryReminderSentAt clears the value of the "expiry_reminder_sent_at" field.
+func (m *ListingMutation) ClearExpiryReminderSentAt() {
+	m.expiry_reminder_sent_at = nil
+	m.clearedFields[listing.FieldExpiryReminderSentAt] = struct{}{}
+}
+
+// ExpiryReminderSentAtCleared returns if the "expiry_reminder_sent_at" field was cleared in this mutation.
+func (m *ListingMutation) ExpiryReminderSentAtCleared() bool {
+	_, ok := m.clearedFields[listing.FieldExpiryReminderSentAt]
+	return ok
+}
+
+// ResetExpiryReminderSentAt resets all changes to the "expiry_reminder_sent_at" field.
+func (m *ListingMutation) ResetExpiryReminderSentAt() {
+	m.expiry_reminder_sent_at = nil
+	delete(m.clearedFields, listing.FieldExpiryReminderSentAt)
+}
+
+// SetExpired sets the "expired" field.
+func (m *ListingMutation) SetExpired(b bool) {
+	m.expired = &b
+}
+
+// Expired returns the value of the "expired" field in the mutation.
+func (m *ListingMutation) Expired() (r bool, exists bool) {
+	v := m.expired
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpired returns the old "expired" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldExpired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpired: %w", err)
+	}
+	return oldValue.Expired, nil
+}
+
+// ResetExpired resets all changes to the "expired" field.
+func (m *ListingMutation) ResetExpired() {
+	m.expired = nil
+}
+
+// SetListedAt sets the "listed_at" field.
+func (m *ListingMutation) SetListedAt(t time.Time) {
+	m.listed_at = &t
+}
+
+// ListedAt returns the value of the "listed_at" field in the mutation.
+func (m *ListingMutation) ListedAt() (r time.Time, exists bool) {
+	v := m.listed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldListedAt returns the old "listed_at" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldListedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListedAt: %w", err)
+	}
+	return oldValue.ListedAt, nil
+}
+
+// ClearListedAt clears the value of the "listed_at" field.
+func (m *ListingMutation) ClearListedAt() {
+	m.listed_at = nil
+	m.clearedFields[listing.FieldListedAt] = struct{}{}
+}
+
+// ListedAtCleared returns if the "listed_at" field was cleared in this mutation.
+func (m *ListingMutation) ListedAtCleared() bool {
+	_, ok := m.clearedFields[listing.FieldListedAt]
+	return ok
+}
+
+// ResetListedAt resets all changes to the "listed_at" field.
+func (m *ListingMutation) ResetListedAt() {
+	m.listed_at = nil
+	delete(m.clearedFields, listing.FieldListedAt)
+}
+
+// SetOnMarketSince sets the "on_market_since" field.
+func (m *ListingMutation) SetOnMarketSince(t time.Time) {
+	m.on_market_since = &t
+}
+
+// OnMarketSince returns the value of the "on_market_since" field in the mutation.
+func (m *ListingMutation) OnMarketSince() (r time.Time, exists bool) {
+	v := m.on_market_since
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOnMarketSince returns the old "on_market_since" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldOnMarketSince(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOnMarketSince is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOnMarketSince requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOnMarketSince: %w", err)
+	}
+	return oldValue.OnMarketSince, nil
+}
+
+// ClearOnMarketSince clears the value of the "on_market_since" field.
+func (m *ListingMutation) ClearOnMarketSince() {
+	m.on_market_since = nil
+	m.clearedFields[listing.FieldOnMarketSince] = struct{}{}
+}
+
+// OnMarketSinceCleared returns if the "on_market_since" field was cleared in this mutation.
+func (m *ListingMutation) OnMarketSinceCleared() bool {
+	_, ok := m.clearedFields[listing.FieldOnMarketSince]
+	return ok
+}
+
+// ResetOnMarketSince resets all changes to the "on_market_since" field.
+func (m *ListingMutation) ResetOnMarketSince() {
+	m.on_market_since = nil
+	delete(m.clearedFields, listing.FieldOnMarketSince)
+}
+
+// SetMarketSeconds sets the "market_seconds" field.
+func (m *ListingMutation) SetMarketSeconds(i int64) {
+	m.market_seconds = &i
+	m.addmarket_seconds = nil
+}
+
+// MarketSeconds returns the value of the "market_seconds" field in the mutation.
+func (m *ListingMutation) MarketSeconds() (r int64, exists bool) {
+	v := m.market_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMarketSeconds returns the old "market_seconds" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldMarketSeconds(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMarketSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMarketSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMarketSeconds: %w", err)
+	}
+	return oldValue.MarketSeconds, nil
+}
+
+// AddMarketSeconds adds i to the "market_seconds" field.
+func (m *ListingMutation) AddMarketSeconds(i int64) {
+	if m.addmarket_seconds != nil {
+		*m.addmarket_seconds += i
+	} else {
+		m.addmarket_seconds = &i
+	}
+}
+
+// AddedMarketSeconds returns the value that was added to the "market_seconds" field in this mutation.
+func (m *ListingMutation) AddedMarketSeconds() (r int64, exists bool) {
+	v := m.addmarket_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMarketSeconds resets all changes to the "market_seconds" field.
+func (m *ListingMutation) ResetMarketSeconds() {
+	m.market_seconds = nil
+	m.addmarket_seconds = nil
+}
+
+// SetSoldPrice sets the "sold_price" field.
+func (m *ListingMutation) SetSoldPrice(d decimal.Decimal) {
+	m.sold_price = &d
+	m.addsold_price = nil
+}
+
+// SoldPrice returns the value of the "sold_price" field in the mutation.
+func (m *ListingMutation) SoldPrice() (r decimal.Decimal, exists bool) {
+	v := m.sold_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoldPrice returns the old "sold_price" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldSoldPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoldPrice: %w", err)
+	}
+	return oldValue.SoldPrice, nil
+}
+
+// AddSoldPrice adds d to the "sold_price" field.
+func (m *ListingMutation) AddSoldPrice(d decimal.Decimal) {
+	if m.addsold_price != nil {
+		*m.addsold_price = m.addsold_price.Add(d)
+	} else {
+		m.addsold_price = &d
+	}
+}
+
+// AddedSoldPrice returns the value that was added to the "sold_price" field in this mutation.
+func (m *ListingMutation) AddedSoldPrice() (r decimal.Decimal, exists bool) {
+	v := m.addsold_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSoldPrice clears the value of the "sold_price" field.
+func (m *ListingMutation) ClearSoldPrice() {
+	m.sold_price = nil
+	m.addsold_price = nil
+	m.clearedFields[listing.FieldSoldPrice] = struct{}{}
+}
+
+// SoldPriceCleared returns if the "sold_price" field was cleared in this mutation.
+func (m *ListingMutation) SoldPriceCleared() bool {
+	_, ok := m.clearedFields[listing.FieldSoldPrice]
+	return ok
+}
+
+// ResetSoldPrice resets all changes to the "sold_price" field.
+func (m *ListingMutation) ResetSoldPrice() {
+	m.sold_price = nil
+	m.addsold_price = nil
+	delete(m.clearedFields, listing.FieldSoldPrice)
+}
+
+// SetCloseDate sets the "close_date" field.
+func (m *ListingMutation) SetCloseDate(t time.Time) {
+	m.close_date = &t
+}
+
+// CloseDate returns the value of the "close_date" field in the mutation.
+func (m *ListingMutation) CloseDate() (r time.Time, exists bool) {
+	v := m.close_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCloseDate returns the old "close_date" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldCloseDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCloseDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCloseDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCloseDate: %w", err)
+	}
+	return oldValue.CloseDate, nil
+}
+
+// ClearCloseDate clears the value of the "close_date" field.
+func (m *ListingMutation) ClearCloseDate() {
+	m.close_date = nil
+	m.clearedFields[listing.FieldCloseDate] = struct{}{}
+}
+
+// CloseDateCleared returns if the "close_date" field was cleared in this mutation.
+func (m *ListingMutation) CloseDateCleared() bool {
+	_, ok := m.clearedFields[listing.FieldCloseDate]
+	return ok
+}
+
+// ResetCloseDate resets all changes to the "close_date" field.
+func (m *ListingMutation) ResetCloseDate() {
+	m.close_date = nil
+	delete(m.clearedFields, listing.FieldCloseDate)
+}
+
+// SetBuyerRealtorID sets the "buyer_realtor_id" field.
+func (m *ListingMutation) SetBuyerRealtorID(u uuid.UUID) {
+	m.buyer_realtor = &u
+}
+
+// BuyerRealtorID returns the value of the "buyer_realtor_id" field in the mutation.
+func (m *ListingMutation) BuyerRealtorID() (r uuid.UUID, exists bool) {
+	v := m.buyer_realtor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyerRealtorID returns the old "buyer_realtor_id" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldBuyerRealtorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyerRealtorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyerRealtorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyerRealtorID: %w", err)
+	}
+	return oldValue.BuyerRealtorID, nil
+}
+
+// ClearBuyerRealtorID clears the value of the "buyer_realtor_id" field.
+func (m *ListingMutation) ClearBuyerRealtorID() {
+	m.buyer_realtor = nil
+	m.clearedFields[listing.FieldBuyerRealtorID] = struct{}{}
+}
+
+// BuyerRealtorIDCleared returns if the "buyer_realtor_id" field was cleared in this mutation.
+func (m *ListingMutation) BuyerRealtorIDCleared() bool {
+	_, ok := m.clearedFields[listing.FieldBuyerRealtorID]
+	return ok
+}
+
+// ResetBuyerRealtorID resets all changes to the "buyer_realtor_id" field.
+func (m *ListingMutation) ResetBuyerRealtorID() {
+	m.buyer_realtor = nil
+	delete(m.clearedFields, listing.FieldBuyerRealtorID)
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (m *ListingMutation) SetPublishAt(t time.Time) {
+	m.publish_at = &t
+}
+
+// PublishAt returns the value of the "publish_at" field in the mutation.
+func (m *ListingMutation) PublishAt() (r time.Time, exists bool) {
+	v := m.publish_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishAt returns the old "publish_at" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldPublishAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishAt: %w", err)
+	}
+	return oldValue.PublishAt, nil
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (m *ListingMutation) ClearPublishAt() {
+	m.publish_at = nil
+	m.clearedFields[listing.FieldPublishAt] = struct{}{}
+}
+
+// PublishAtCleared returns if the "publish_at" field was cleared in this mutation.
+func (m *ListingMutation) PublishAtCleared() bool {
+	_, ok := m.clearedFields[listing.FieldPublishAt]
+	return ok
+}
+
+// ResetPublishAt resets all changes to the "publish_at" field.
+func (m *ListingMutation) ResetPublishAt() {
+	m.publish_at = nil
+	delete(m.clearedFields, listing.FieldPublishAt)
+}
+
+// SetUnpublishAt sets the "unpublish_at" field.
+func (m *ListingMutation) SetUnpublishAt(t time.Time) {
+	m.unpublish_at = &t
+}
+
+// UnpublishAt returns the value of the "unpublish_at" field in the mutation.
+func (m *ListingMutation) UnpublishAt() (r time.Time, exists bool) {
+	v := m.unpublish_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnpublishAt returns the old "unpublish_at" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldUnpublishAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnpublishAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnpublishAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnpublishAt: %w", err)
+	}
+	return oldValue.UnpublishAt, nil
+}
+
+// ClearUnpublishAt clears the value of the "unpublish_at" field.
+func (m *ListingMutation) ClearUnpublishAt() {
+	m.unpublish_at = nil
+	m.clearedFields[listing.FieldUnpublishAt] = struct{}{}
+}
+
+// UnpublishAtCleared returns if the "unpublish_at" field was cleared in this mutation.
+func (m *ListingMutation) UnpublishAtCleared() bool {
+	_, ok := m.clearedFields[listing.FieldUnpublishAt]
+	return ok
+}
+
+// ResetUnpublishAt resets all changes to the "unpublish_at" field.
+func (m *ListingMutation) ResetUnpublishAt() {
+	m.unpublish_at = nil
+	delete(m.clearedFields, listing.FieldUnpublishAt)
+}
+
+// SetVersion sets the "version" field.
+func (m *ListingMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ListingMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Listing entity.
+// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *ListingMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ListingMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ListingMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// ClearRealtor clears the "realtor" edge to the Realtor entity.
+func (m *ListingMutation) ClearRealtor() {
+	m.clearedrealtor = true
+	m.clearedFields[listing.FieldRealtorID] = struct{}{}
+}
+
+// RealtorCleared reports if the "realtor" edge to the Realtor entity was cleared.
+func (m *ListingMutation) RealtorCleared() bool {
+	return m.clearedrealtor
+}
+
+// RealtorIDs returns the "realtor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RealtorID instead. It exists only for internal usage by the builders.
+func (m *ListingMutation) RealtorIDs() (ids []uuid.UUID) {
+	if id := m.realtor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRealtor resets all changes to the "realtor" edge.
+func (m *ListingMutation) ResetRealtor() {
+	m.realtor = nil
+	m.clearedrealtor = false
+}
+
+// ClearProperty clears the "property" edge to the Property entity.
+func (m *ListingMutation) ClearProperty() {
+	m.clearedproperty = true
+	m.clearedFields[listing.FieldPropertyID] = struct{}{}
+}
+
+// PropertyCleared reports if the "property" edge to the Property entity was cleared.
+func (m *ListingMutation) PropertyCleared() bool {
+	return m.PropertyIDCleared() || m.clearedproperty
+}
+
+// PropertyIDs returns the "property" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PropertyID instead. It exists only for internal usage by the builders.
+func (m *ListingMutation) PropertyIDs() (ids []uuid.UUID) {
+	if id := m.property; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProperty resets all changes to the "property" edge.
+func (m *ListingMutation) ResetProperty() {
+	m.property = nil
+	m.clearedproperty = false
+}
+
+// ClearNeighborhood clears the "neighborhood" edge to the Neighborhood entity.
+func (m *ListingMutation) ClearNeighborhood() {
+	m.clearedneighborhood = true
+	m.clearedFields[listing.FieldNeighborhoodID] = struct{}{}
+}
+
+// NeighborhoodCleared reports if the "neighborhood" edge to the Neighborhood entity was cleared.
+func (m *ListingMutation) NeighborhoodCleared() bool {
+	return m.NeighborhoodIDCleared() || m.clearedneighborhood
+}
+
+// NeighborhoodIDs returns the "neighborhood" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NeighborhoodID instead. It exists only for internal usage by the builders.
+func (m *ListingMutation) NeighborhoodIDs() (ids []uuid.UUID) {
+	if id := m.neighborhood; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNeighborhood resets all changes to the "neighborhood" edge.
+func (m *ListingMutation) ResetNeighborhood() {
+	m.neighborhood = nil
+	m.clearedneighborhood = false
+}
+
+// ClearBuyerRealtor clears the "buyer_realtor" edge to the Realtor entity.
+func (m *ListingMutation) ClearBuyerRealtor() {
+	m.clearedbuyer_realtor = true
+	m.clearedFields[listing.FieldBuyerRealtorID] = struct{}{}
+}
+
+// BuyerRealtorCleared reports if the "buyer_realtor" edge to the Realtor entity was cleared.
+func (m *ListingMutation) BuyerRealtorCleared() bool {
+	return m.BuyerRealtorIDCleared() || m.clearedbuyer_realtor
+}
+
+// BuyerRealtorIDs returns the "buyer_realtor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BuyerRealtorID instead. It exists only for internal usage by the builders.
+func (m *ListingMutation) BuyerRealtorIDs() (ids []uuid.UUID) {
+	if id := m.buyer_realtor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBuyerRealtor resets all changes to the "buyer_realtor" edge.
+func (m *ListingMutation) ResetBuyerRealtor() {
+	m.buyer_realtor = nil
+	m.clearedbuyer_realtor = false
+}
+
+// AddRenewalIDs adds the "renewals" edge to the ListingRenewal entity by ids.
+func (m *ListingMutation) AddRenewalIDs(ids ...uuid.UUID) {
+	if m.renewals == nil {
+		m.renewals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.renewals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRenewals clears the "renewals" edge to the ListingRenewal entity.
+func (m *ListingMutation) ClearRenewals() {
+	m.clearedrenewals = true
+}
+
+// RenewalsCleared reports if the "renewals" edge to the ListingRenewal entity was cleared.
+func (m *ListingMutation) RenewalsCleared() bool {
+	return m.clearedrenewals
+}
+
+// RemoveRenewalIDs removes the "renewals" edge to the ListingRenewal entity by IDs.
+func (m *ListingMutation) RemoveRenewalIDs(ids ...uuid.UUID) {
+	if m.removedrenewals == nil {
+		m.removedrenewals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.renewals, ids[i])
+		m.removedrenewals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRenewals returns the removed IDs of the "renewals" edge to the ListingRenewal entity.
+func (m *ListingMutation) RemovedRenewalsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrenewals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RenewalsIDs returns the "renewals" edge IDs in the mutation.
+func (m *ListingMutation) RenewalsIDs() (ids []uuid.UUID) {
+	for id := range m.renewals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRenewals resets all changes to the "renewals" edge.
+func (m *ListingMutation) ResetRenewals() {
+	m.renewals = nil
+	m.clearedrenewals = false
+	m.removedrenewals = nil
+}
+
+// AddDocumentIDs adds the "documents" edge to the ListingDocument entity by ids.
+func (m *ListingMutation) AddDocumentIDs(ids ...uuid.UUID) {
+	if m.documents == nil {
+		m.documents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.documents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDocuments clears the "documents" edge to the ListingDocument entity.
+func (m *ListingMutation) ClearDocuments() {
+	m.cleareddocuments = true
+}
+
+// DocumentsCleared reports if the "documents" edge to the ListingDocument entity was cleared.
+func (m *ListingMutation) DocumentsCleared() bool {
+	return m.cleareddocuments
+}
+
+// RemoveDocumentIDs removes the "documents" edge to the ListingDocument entity by IDs.
+func (m *ListingMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
+	if m.removeddocuments == nil {
+		m.removeddocuments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.documents, ids[i])
+		m.removeddocuments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDocuments returns the removed IDs of the "documents" edge to the ListingDocument entity.
+func (m *ListingMutation) RemovedDocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddocuments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DocumentsIDs returns the "documents" edge IDs in the mutation.
+func (m *ListingMutation) DocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.documents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDocuments resets all changes to the "documents" edge.
+func (m *ListingMutation) ResetDocuments() {
+	m.documents = nil
+	m.cleareddocuments = false
+	m.removeddocuments = nil
+}
+
+// AddInquiryIDs adds the "inquiries" edge to the ListingInquiry entity by ids.
+func (m *ListingMutation) AddInquiryIDs(ids ...uuid.UUID) {
+	if m.inquiries == nil {
+		m.inquiries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.inquiries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInquiries clears the "inquiries" edge to the ListingInquiry entity.
+func (m *ListingMutation) ClearInquiries() {
+	m.clearedinquiries = true
+}
+
+// InquiriesCleared reports if the "inquiries" edge to the ListingInquiry entity was cleared.
+func (m *ListingMutation) InquiriesCleared() bool {
+	return m.clearedinquiries
+}
+
+// RemoveInquiryIDs removes the "inquiries" edge to the ListingInquiry entity by IDs.
+func (m *ListingMutation) RemoveInquiryIDs(ids ...uuid.UUID) {
+	if m.removedinquiries == nil {
+		m.removedinquiries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.inquiries, ids[i])
+		m.removedinquiries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInquiries returns the removed IDs of the "inquiries" edge to the ListingInquiry entity.
+func (m *ListingMutation) RemovedInquiriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedinquiries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InquiriesIDs returns the "inquiries" edge IDs in the mutation.
+func (m *ListingMutation) InquiriesIDs() (ids []uuid.UUID) {
+	for id := range m.inquiries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInquiries resets all changes to the "inquiries" edge.
+func (m *ListingMutation) ResetInquiries() {
+	m.inquiries = nil
+	m.clearedinquiries = false
+	m.removedinquiries = nil
+}
+
+// AddReviewIDs adds the "reviews" edge to the ListingReview entity by ids.
+func (m *ListingMutation) AddReviewIDs(ids ...uuid.UUID) {
+	if m.reviews == nil {
+		m.reviews = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reviews[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReviews clears the "reviews" edge to the ListingReview entity.
+func (m *ListingMutation) ClearReviews() {
+	m.clearedreviews = true
+}
+
+// ReviewsCleared reports if the "reviews" edge to the ListingReview entity was cleared.
+func (m *ListingMutation) ReviewsCleared() bool {
+	return m.clearedreviews
+}
+
+// RemoveReviewIDs removes the "reviews" edge to the ListingReview entity by IDs.
+func (m *ListingMutation) RemoveReviewIDs(ids ...uuid.UUID) {
+	if m.removedreviews == nil {
+		m.removedreviews = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reviews, ids[i])
+		m.removedreviews[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReviews returns the removed IDs of the "reviews" edge to the ListingReview entity.
+func (m *ListingMutation) RemovedReviewsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReviewsIDs returns the "reviews" edge IDs in the mutation.
+func (m *ListingMutation) ReviewsIDs() (ids []uuid.UUID) {
+	for id := range m.reviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReviews resets all changes to the "reviews" edge.
+func (m *ListingMutation) ResetReviews() {
+	m.reviews = nil
+	m.clearedreviews = false
+	m.removedreviews = nil
+}
+
+// AddOfferIDs adds the "offers" edge to the Offer entity by ids.
+func (m *ListingMutation) AddOfferIDs(ids ...uuid.UUID) {
+	if m.offers == nil {
+		m.offers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.offers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOffers clears the "offers" edge to the Offer entity.
+func (m *ListingMutation) ClearOffers() {
+	m.clearedoffers = true
+}
+
+// OffersCleared reports if the "offers" edge to the Offer entity was cleared.
+func (m *ListingMutation) OffersCleared() bool {
+	return m.clearedoffers
+}
+
+// RemoveOfferIDs removes the "offers" edge to the Offer entity by IDs.
+func (m *ListingMutation) RemoveOfferIDs(ids ...uuid.UUID) {
+	if m.removedoffers == nil {
+		m.removedoffers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.offers, ids[i])
+		m.removedoffers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOffers returns the removed IDs of the "offers" edge to the Offer entity.
+func (m *ListingMutation) RemovedOffersIDs() (ids []uuid.UUID) {
+	for id := range m.removedoffers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OffersIDs returns the "offers" edge IDs in the mutation.
+func (m *ListingMutation) OffersIDs() (ids []uuid.UUID) {
+	for id := range m.offers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOffers resets all changes to the "offers" edge.
+func (m *ListingMutation) ResetOffers() {
+	m.offers = nil
+	m.clearedoffers = false
+	m.removedoffers = nil
+}
+
+// AddStatusEventIDs adds the "status_events" edge to the ListingStatusEvent entity by ids.
+func (m *ListingMutation) AddStatusEventIDs(ids ...uuid.UUID) {
+	if m.status_events == nil {
+		m.status_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.status_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStatusEvents clears the "status_events" edge to the ListingStatusEvent entity.
+func (m *ListingMutation) ClearStatusEvents() {
+	m.clearedstatus_events = true
+}
+
+// StatusEventsCleared reports if the "status_events" edge to the ListingStatusEvent entity was cleared.
+func (m *ListingMutation) StatusEventsCleared() bool {
+	return m.clearedstatus_events
+}
+
+// RemoveStatusEventIDs removes the "status_events" edge to the ListingStatusEvent entity by IDs.
+func (m *ListingMutation) RemoveStatusEventIDs(ids ...uuid.UUID) {
+	if m.removedstatus_events == nil {
+		m.removedstatus_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.status_events, ids[i])
+		m.removedstatus_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStatusEvents returns the removed IDs of the "status_events" edge to the ListingStatusEvent entity.
+func (m *ListingMutation) RemovedStatusEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedstatus_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StatusEventsIDs returns the "status_events" edge IDs in the mutation.
+func (m *ListingMutation) StatusEventsIDs() (ids []uuid.UUID) {
+	for id := range m.status_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStatusEvents resets all changes to the "status_events" edge.
+func (m *ListingMutation) ResetStatusEvents() {
+	m.status_events = nil
+	m.clearedstatus_events = false
+	m.removedstatus_events = nil
+}
+
+// Where appends a list predicates to the ListingMutation builder.
+func (m *ListingMutation) Where(ps ...predicate.Listing) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ListingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ListingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Listing, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ListingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ListingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Listing).
+func (m *ListingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ListingMutation) Fields() []string {
+	fields := make([]string, 0, 49)
+	if m.create_time != nil {
+		fields = append(fields, listing.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, listing.FieldUpdateTime)
+	}
+	if m.title != nil {
+		fields = append(fields, listing.FieldTitle)
+	}
+	if m.address != nil {
+		fields = append(fields, listing.FieldAddress)
+	}
+	if m.address_key != nil {
+		fields = append(fields, listing.FieldAddressKey)
+	}
+	if m.city != nil {
+		fields = append(fields, listing.FieldCity)
+	}
+	if m.state != nil {
+		fields = append(fields, listing.FieldState)
+	}
+	if m.zip_code != nil {
+		fields = append(fields, listing.FieldZipCode)
+	}
+	if m.country != nil {
+		fields = append(fields, listing.FieldCountry)
+	}
+	if m.latitude != nil {
+		fields = append(fields, listing.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, listing.FieldLongitude)
+	}
+	if m.neighborhood != nil {
+		fields = append(fields, listing.FieldNeighborhoodID)
+	}
+	if m.description != nil {
+		fields = append(fields, listing.FieldDescription)
+	}
+	if m.source_locale != nil {
+		fields = append(fields, listing.FieldSourceLocale)
+	}
+	if m.translations != nil {
+		fields = append(fields, listing.FieldTranslations)
+	}
+	if m.price != nil {
+		fields = append(fields, listing.FieldPrice)
+	}
+	if m.currency != nil {
+		fields = append(fields, listing.FieldCurrency)
+	}
+	if m.bedroom != nil {
+		fields = append(fields, listing.FieldBedroom)
+	}
+	if m.bathroom != nil {
+		fields = append(fields, listing.FieldBathroom)
+	}
+	if m.garage != nil {
+		fields = append(fields, listing.FieldGarage)
+	}
+	if m.sqft != nil {
+		fields = append(fields, listing.FieldSqft)
+	}
+	if m.sqm != nil {
+		fields = append(fields, listing.FieldSqm)
+	}
+	if m.area_unit != nil {
+		fields = append(fields, listing.FieldAreaUnit)
+	}
+	if m.type_of_property != nil {
+		fields = append(fields, listing.FieldTypeOfProperty)
+	}
+	if m.status != nil {
+		fields = append(fields, listing.FieldStatus)
+	}
+	if m.lot_size != nil {
+		fields = append(fields, listing.FieldLotSize)
+	}
+	if m.pool != nil {
+		fields = append(fields, listing.FieldPool)
+	}
+	if m.year_built != nil {
+		fields = append(fields, listing.FieldYearBuilt)
+	}
+	if m.annual_property_tax != nil {
+		fields = append(fields, listing.FieldAnnualPropertyTax)
+	}
+	if m.hoa_dues != nil {
+		fields = append(fields, listing.FieldHoaDues)
+	}
+	if m.hoa_frequency != nil {
+		fields = append(fields, listing.FieldHoaFrequency)
+	}
+	if m.hoa_name != nil {
+		fields = append(fields, listing.FieldHoaName)
+	}
+	if m.special_assessments != nil {
+		fields = append(fields, listing.FieldSpecialAssessments)
+	}
+	if m.monthly_utilities != nil {
+		fields = append(fields, listing.FieldMonthlyUtilities)
+	}
+	if m.media != nil {
+		fields = append(fields, listing.FieldMedia)
+	}
+	if m.realtor != nil {
+		fields = append(fields, listing.FieldRealtorID)
+	}
+	if m.property != nil {
+		fields = append(fields, listing.FieldPropertyID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, listing.FieldExpiresAt)
+	}
+	if m.expiry_reminder_sent_at != nil {
+		fields = append(fields, listing.FieldExpiryReminderSentAt)
+	}
+	if m.expired != nil {
+		fields = append(fields, listing.FieldExpired)
+	}
+	if m.listed_at != nil {
+		fields = append(fields, listing.FieldListedAt)
+	}
+	if m.on_market_since != nil {
+		fields = append(fields, listing.FieldOnMarketSince)
+	}
+	if m.market_seconds != nil {
+		fields = append(fields, listing.FieldMarketSeconds)
+	}
+	if m.sold_price != nil {
+		fields = append(fields, listing.FieldSoldPrice)
+	}
+	if m.close_date != nil {
+		fields = append(fields, listing.FieldCloseDate)
+	}
+	if m.buyer_realtor != nil {
+		fields = append(fields, listing.FieldBuyerRealtorID)
+	}
+	if m.publish_at != nil {
+		fields = append(fields, listing.FieldPublishAt)
+	}
+	if m.unpublish_at != nil {
+		fields = append(fields, listing.FieldUnpublishAt)
+	}
+	if m.version != nil {
+		fields = append(fields, listing.FieldVersion)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ListingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case listing.FieldCreateTime:
+		return m.CreateTime()
+	case listing.FieldUpdateTime:
+		return m.UpdateTime()
+	case listing.FieldTitle:
+		return m.Title()
+	case listing.FieldAddress:
+		return m.Address()
+	case listing.FieldAddressKey:
+		return m.AddressKey()
+	case listing.FieldCity:
+		return m.City()
+	case listing.FieldState:
+		return m.State()
+	case listing.FieldZipCode:
+		return m.ZipCode()
+	case listing.FieldCountry:
+		return m.Country()
+	case listing.FieldLatitude:
+		return m.Latitude()
+	case listing.FieldLongitude:
+		return m.Longitude()
+	case listing.FieldNeighborhoodID:
+		return m.NeighborhoodID()
+	case listing.FieldDescription:
+		return m.Description()
+	case listing.FieldSourceLocale:
+		return m.SourceLocale()
+	case listing.FieldTranslations:
+		return m.Translations()
+	case listing.FieldPrice:
+		return m.Price()
+	case listing.FieldCurrency:
+		return m.Currency()
+	case listing.FieldBedroom:
+		return m.Bedroom()
+	case listing.FieldBathroom:
+		return m.Bathroom()
+	case listing.FieldGarage:
+		return m.Garage()
+	case listing.FieldSqft:
+		return m.Sqft()
+	case listing.FieldSqm:
+		return m.Sqm()
+	case listing.FieldAreaUnit:
+		return m.AreaUnit()
+	case listing.FieldTypeOfProperty:
+		return m.TypeOfProperty()
+	case listing.FieldStatus:
+		return m.Status()
+	case listing.FieldLotSize:
+		return m.LotSize()
+	case listing.FieldPool:
+		return m.Pool()
+	case listing.FieldYearBuilt:
+		return m.YearBuilt()
+	case listing.FieldAnnualPropertyTax:
+		return m.AnnualPropertyTax()
+	case listing.FieldHoaDues:
+		return m.HoaDues()
+	case listing.FieldHoaFrequency:
+		return m.HoaFrequency()
+	case listing.FieldHoaName:
+		return m.HoaName()
+	case listing.FieldSpecialAssessments:
+		return m.SpecialAssessments()
+	case listing.FieldMonthlyUtilities:
+		return m.MonthlyUtilities()
+	case listing.FieldMedia:
+		return m.Media()
+	case listing.FieldRealtorID:
+		return m.RealtorID()
+	case listing.FieldPropertyID:
+		return m.PropertyID()
+	case listing.FieldExpiresAt:
+		return m.ExpiresAt()
+	case listing.FieldExpiryReminderSentAt:
+		return m.ExpiryReminderSentAt()
+	case listing.FieldExpired:
+		return m.Expired()
+	case listing.FieldListedAt:
+		return m.ListedAt()
+	case listing.FieldOnMarketSince:
+		return m.OnMarketSince()
+	case listing.FieldMarketSeconds:
+		return m.MarketSeconds()
+	case listing.FieldSoldPrice:
+		return m.SoldPrice()
+	case listing.FieldCloseDate:
+		return m.CloseDate()
+	case listing.FieldBuyerRealtorID:
+		return m.BuyerRealtorID()
+	case listing.FieldPublishAt:
+		return m.PublishAt()
+	case listing.FieldUnpublishAt:
+		return m.UnpublishAt()
+	case listing.FieldVersion:
+		return m.Version()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ListingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case listing.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case listing.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case listing.FieldTitle:
+		return m.OldTitle(ctx)
+	case listing.FieldAddress:
+		return m.OldAddress(ctx)
+	case listing.FieldAddressKey:
+		return m.OldAddressKey(ctx)
+	case listing.FieldCity:
+		return m.OldCity(ctx)
+	case listing.FieldState:
+		return m.OldState(ctx)
+	case listing.FieldZipCode:
+		return m.OldZipCode(ctx)
+	case listing.FieldCountry:
+		return m.OldCountry(ctx)
+	case listing.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case listing.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case listing.FieldNeighborhoodID:
+		return m.OldNeighborhoodID(ctx)
+	case listing.FieldDescription:
+		return m.OldDescription(ctx)
+	case listing.FieldSourceLocale:
+		return m.OldSourceLocale(ctx)
+	case listing.FieldTranslations:
+		return m.OldTranslations(ctx)
+	case listing.FieldPrice:
+		return m.OldPrice(ctx)
+	case listing.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case listing.FieldBedroom:
+		return m.OldBedroom(ctx)
+	case listing.FieldBathroom:
+		return m.OldBathroom(ctx)
+	case listing.FieldGarage:
+		return m.OldGarage(ctx)
+	case listing.FieldSqft:
+		return m.OldSqft(ctx)
+	case listing.FieldSqm:
+		return m.OldSqm(ctx)
+	case listing.FieldAreaUnit:
+		return m.OldAreaUnit(ctx)
+	case listing.FieldTypeOfProperty:
+		return m.OldTypeOfProperty(ctx)
+	case listing.FieldStatus:
+		return m.OldStatus(ctx)
+	case listing.FieldLotSize:
+		return m.OldLotSize(ctx)
+	case listing.FieldPool:
+		return m.OldPool(ctx)
+	case listing.FieldYearBuilt:
+		return m.OldYearBuilt(ctx)
+	case listing.FieldAnnualPropertyTax:
+		return m.OldAnnualPropertyTax(ctx)
+	case listing.FieldHoaDues:
+		return m.OldHoaDues(ctx)
+	case listing.FieldHoaFrequency:
+		return m.OldHoaFrequency(ctx)
+	case listing.FieldHoaName:
+		return m.OldHoaName(ctx)
+	case listing.FieldSpecialAssessments:
+		return m.OldSpecialAssessments(ctx)
+	case listing.FieldMonthlyUtilities:
+		return m.OldMonthlyUtilities(ctx)
+	case listing.FieldMedia:
+		return m.OldMedia(ctx)
+	case listing.FieldRealtorID:
+		return m.OldRealtorID(ctx)
+	case listing.FieldPropertyID:
+		return m.OldPropertyID(ctx)
+	case listing.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case listing.FieldExpiryReminderSentAt:
+		return m.OldExpiryReminderSentAt(ctx)
+	case listing.FieldExpired:
+		return m.OldExpired(ctx)
+	case listing.FieldListedAt:
+		return m.OldListedAt(ctx)
+	case listing.FieldOnMarketSince:
+		return m.OldOnMarketSince(ctx)
+	case listing.FieldMarketSeconds:
+		return m.OldMarketSeconds(ctx)
+	case listing.FieldSoldPrice:
+		return m.OldSoldPrice(ctx)
+	case listing.FieldCloseDate:
+		return m.OldCloseDate(ctx)
+	case listing.FieldBuyerRealtorID:
+		return m.OldBuyerRealtorID(ctx)
+	case listing.FieldPublishAt:
+		return m.OldPublishAt(ctx)
+	case listing.FieldUnpublishAt:
+		return m.OldUnpublishAt(ctx)
+	case listing.FieldVersion:
+		return m.OldVersion(ctx)
+	}
+	return nil, fmt.Errorf("unknown Listing field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case listing.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case listing.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case listing.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case listing.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case listing.FieldAddressKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressKey(v)
+		return nil
+	case listing.FieldCity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCity(v)
+		return nil
+	case listing.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case listing.FieldZipCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetZipCode(v)
+		return nil
+	case listing.FieldCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountry(v)
+		return nil
+	case listing.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case listing.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	case listing.FieldNeighborhoodID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNeighborhoodID(v)
+		return nil
+	case listing.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case listing.FieldSourceLocale:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceLocale(v)
+		return nil
+	case listing.FieldTranslations:
+		v, ok := value.(map[string]schematype.Translation)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTranslations(v)
+		return nil
+	case listing.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case listing.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case listing.FieldBedroom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBedroom(v)
+		return nil
+	case listing.FieldBathroom:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBathroom(v)
+		return nil
+	case listing.FieldGarage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGarage(v)
+		return nil
+	case listing.FieldSqft:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSqft(v)
+		return nil
+	case listing.FieldSqm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSqm(v)
+		return nil
+	case listing.FieldAreaUnit:
+		v, ok := value.(listing.AreaUnit)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAreaUnit(v)
+		return nil
+	case listing.FieldTypeOfProperty:
+		v, ok := value.(listing.TypeOfProperty)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypeOfProperty(v)
+		return nil
+	case listing.FieldStatus:
+		v, ok := value.(listing.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case listing.FieldLotSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLotSize(v)
+		return nil
+	case listing.FieldPool:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPool(v)
+		return nil
+	case listing.FieldYearBuilt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetYearBuilt(v)
+		return nil
+	case listing.FieldAnnualPropertyTax:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnnualPropertyTax(v)
+		return nil
+	case listing.FieldHoaDues:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHoaDues(v)
+		return nil
+	case listing.FieldHoaFrequency:
+		v, ok := value.(listing.HoaFrequency)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHoaFrequency(v)
+		return nil
+	case listing.FieldHoaName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHoaName(v)
+		return nil
+	case listing.FieldSpecialAssessments:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpecialAssessments(v)
+		return nil
+	case listing.FieldMonthlyUtilities:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyUtilities(v)
+		return nil
+	case listing.FieldMedia:
+		v, ok := value.([]schematype.Media)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMedia(v)
+		return nil
+	case listing.FieldRealtorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRealtorID(v)
+		return nil
+	case listing.FieldPropertyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPropertyID(v)
+		return nil
+	case listing.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case listing.FieldExpiryReminderSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryReminderSentAt(v)
+		return nil
+	case listing.FieldExpired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpired(v)
+		return nil
+	case listing.FieldListedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListedAt(v)
+		return nil
+	case listing.FieldOnMarketSince:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOnMarketSince(v)
+		return nil
+	case listing.FieldMarketSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMarketSeconds(v)
+		return nil
+	case listing.FieldSoldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoldPrice(v)
+		return nil
+	case listing.FieldCloseDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloseDate(v)
+		return nil
+	case listing.FieldBuyerRealtorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyerRealtorID(v)
+		return nil
+	case listing.FieldPublishAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishAt(v)
+		return nil
+	case listing.FieldUnpublishAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnpublishAt(v)
+		return nil
+	case listing.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Listing field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ListingMutation) AddedFields() []string {
+	var fields []string
+	if m.addlatitude != nil {
+		fields = append(fields, listing.FieldLatitude)
+	}
+	if m.addlongitude != nil {
+		fields = append(fields, listing.FieldLongitude)
+	}
+	if m.addprice != nil {
+		fields = append(fields, listing.FieldPrice)
+	}
+	if m.addbedroom != nil {
+		fields = append(fields, listing.FieldBedroom)
+	}
+	if m.addbathroom != nil {
+		fields = append(fields, listing.FieldBathroom)
+	}
+	if m.addgarage != nil {
+		fields = append(fields, listing.FieldGarage)
+	}
+	if m.addsqft != nil {
+		fields = append(fields, listing.FieldSqft)
+	}
+	if m.addsqm != nil {
+		fields = append(fields, listing.FieldSqm)
+	}
+	if m.addlot_size != nil {
+		fields = append(fields, listing.FieldLotSize)
+	}
+	if m.addyear_built != nil {
+		fields = append(fields, listing.FieldYearBuilt)
+	}
+	if m.addannual_property_tax != nil {
+		fields = append(fields, listing.FieldAnnualPropertyTax)
+	}
+	if m.addhoa_dues != nil {
+		fields = append(fields, listing.FieldHoaDues)
+	}
+	if m.addspecial_assessments != nil {
+		fields = append(fields, listing.FieldSpecialAssessments)
+	}
+	if m.addmonthly_utilities != nil {
+		fields = append(fields, listing.FieldMonthlyUtilities)
+	}
+	if m.addmarket_seconds != nil {
+		fields = append(fields, listing.FieldMarketSeconds)
+	}
+	if m.addsold_price != nil {
+		fields = append(fields, listing.FieldSoldPrice)
+	}
+	if m.addversion != nil {
+		fields = append(fields, listing.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ListingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case listing.FieldLatitude:
+		return m.AddedLatitude()
+	case listing.FieldLongitude:
+		return m.AddedLongitude()
+	case listing.FieldPrice:
+		return m.AddedPrice()
+	case listing.FieldBedroom:
+		return m.AddedBedroom()
+	case listing.FieldBathroom:
+		return m.AddedBathroom()
+	case listing.FieldGarage:
+		return m.AddedGarage()
+	case listing.FieldSqft:
+		return m.AddedSqft()
+	case listing.FieldSqm:
+		return m.AddedSqm()
+	case listing.FieldLotSize:
+		return m.AddedLotSize()
+	case listing.FieldYearBuilt:
+		return m.AddedYearBuilt()
+	case listing.FieldAnnualPropertyTax:
+		return m.AddedAnnualPropertyTax()
+	case listing.FieldHoaDues:
+		return m.AddedHoaDues()
+	case listing.FieldSpecialAssessments:
+		return m.AddedSpecialAssessments()
+	case listing.FieldMonthlyUtilities:
+		return m.AddedMonthlyUtilities()
+	case listing.FieldMarketSeconds:
+		return m.AddedMarketSeconds()
+	case listing.FieldSoldPrice:
+		return m.AddedSoldPrice()
+	case listing.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case listing.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatitude(v)
+		return nil
+	case listing.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongitude(v)
+		return nil
+	case listing.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	case listing.FieldBedroom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBedroom(v)
+		return nil
+	case listing.FieldBathroom:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBathroom(v)
+		return nil
+	case listing.FieldGarage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGarage(v)
+		return nil
+	case listing.FieldSqft:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSqft(v)
+		return nil
+	case listing.FieldSqm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSqm(v)
+		return nil
+	case listing.FieldLotSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLotSize(v)
+		return nil
+	case listing.FieldYearBuilt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYearBuilt(v)
+		return nil
+	case listing.FieldAnnualPropertyTax:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnnualPropertyTax(v)
+		return nil
+	case listing.FieldHoaDues:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHoaDues(v)
+		return nil
+	case listing.FieldSpecialAssessments:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpecialAssessments(v)
+		return nil
+	case listing.FieldMonthlyUtilities:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyUtilities(v)
+		return nil
+	case listing.FieldMarketSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMarketSeconds(v)
+		return nil
+	case listing.FieldSoldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSoldPrice(v)
+		return nil
+	case listing.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Listing numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ListingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(listing.FieldAddressKey) {
+		fields = append(fields, listing.FieldAddressKey)
+	}
+	if m.FieldCleared(listing.FieldLatitude) {
+		fields = append(fields, listing.FieldLatitude)
+	}
+	if m.FieldCleared(listing.FieldLongitude) {
+		fields = append(fields, listing.FieldLongitude)
+	}
+	if m.FieldCleared(listing.FieldNeighborhoodID) {
+		fields = append(fields, listing.FieldNeighborhoodID)
+	}
+	if m.FieldCleared(listing.FieldDescription) {
+		fields = append(fields, listing.FieldDescription)
+	}
+	if m.FieldCleared(listing.FieldTranslations) {
+		fields = append(fields, listing.FieldTranslations)
+	}
+	if m.FieldCleared(listing.FieldGarage) {
+		fields = append(fields, listing.FieldGarage)
+	}
+	if m.FieldCleared(listing.FieldSqm) {
+		fields = append(fields, listing.FieldSqm)
+	}
+	if m.FieldCleared(listing.FieldLotSize) {
+		fields = append(fields, listing.FieldLotSize)
+	}
+	if m.FieldCleared(listing.FieldPool) {
+		fields = append(fields, listing.FieldPool)
+	}
+	if m.FieldCleared(listing.FieldAnnualPropertyTax) {
+		fields = append(fields, listing.FieldAnnualPropertyTax)
+	}
+	if m.FieldCleared(listing.FieldHoaDues) {
+		fields = append(fields, listing.FieldHoaDues)
+	}
+	if m.FieldCleared(listing.FieldHoaFrequency) {
+		fields = append(fields, listing.FieldHoaFrequency)
+	}
+	if m.FieldCleared(listing.FieldHoaName) {
+		fields = append(fields, listing.FieldHoaName)
+	}
+	if m.FieldCleared(listing.FieldSpecialAssessments) {
+		fields = append(fields, listing.FieldSpecialAssessments)
+	}
+	if m.FieldCleared(listing.FieldMonthlyUtilities) {
+		fields = append(fields, listing.FieldMonthlyUtilities)
+	}
+	if m.FieldCleared(listing.FieldMedia) {
+		fields = append(fields, listing.FieldMedia)
+	}
+	if m.FieldCleared(listing.FieldPropertyID) {
+		fields = append(fields, listing.FieldPropertyID)
+	}
+	if m.FieldCleared(listing.FieldExpiresAt) {
+		fields = append(fields, listing.FieldExpiresAt)
+	}
+	if m.FieldCleared(listing.FieldExpiryReminderSentAt) {
+		fields = append(fields, listing.FieldExpiryReminderSentAt)
+	}
+	if m.FieldCleared(listing.FieldListedAt) {
+		fields = append(fields, listing.FieldListedAt)
+	}
+	if m.FieldCleared(listing.FieldOnMarketSince) {
+		fields = append(fields, listing.FieldOnMarketSince)
+	}
+	if m.FieldCleared(listing.FieldSoldPrice) {
+		fields = append(fields, listing.FieldSoldPrice)
+	}
+	if m.FieldCleared(listing.FieldCloseDate) {
+		fields = append(fields, listing.FieldCloseDate)
+	}
+	if m.FieldCleared(listing.FieldBuyerRealtorID) {
+		fields = append(fields, listing.FieldBuyerRealtorID)
+	}
+	if m.FieldCleared(listing.FieldPublishAt) {
+		fields = append(fields, listing.FieldPublishAt)
+	}
+	if m.FieldCleared(listing.FieldUnpublishAt) {
+		fields = append(fields, listing.FieldUnpublishAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ListingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ListingMutation) ClearField(name string) error {
+	switch name {
+	case listing.FieldAddressKey:
+		m.ClearAddressKey()
+		return nil
+	case listing.FieldLatitude:
+		m.ClearLatitude()
+		return nil
+	case listing.FieldLongitude:
+		m.ClearLongitude()
+		return nil
+	case listing.FieldNeighborhoodID:
+		m.ClearNeighborhoodID()
+		return nil
+	case listing.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case listing.FieldTranslations:
+		m.ClearTranslations()
+		return nil
+	case listing.FieldGarage:
+		m.ClearGarage()
+		return nil
+	case listing.FieldSqm:
+		m.ClearSqm()
+		return nil
+	case listing.FieldLotSize:
+		m.ClearLotSize()
+		return nil
+	case listing.FieldPool:
+		m.ClearPool()
+		return nil
+	case listing.FieldAnnualPropertyTax:
+		m.ClearAnnualPropertyTax()
+		return nil
+	case listing.FieldHoaDues:
+		m.ClearHoaDues()
+		return nil
+	case listing.FieldHoaFrequency:
+		m.ClearHoaFrequency()
+		return nil
+	case listing.FieldHoaName:
+		m.ClearHoaName()
+		return nil
+	case listing.FieldSpecialAssessments:
+		m.ClearSpecialAssessments()
+		return nil
+	case listing.FieldMonthlyUtilities:
+		m.ClearMonthlyUtilities()
+		return nil
+	case listing.FieldMedia:
+		m.ClearMedia()
+		return nil
+	case listing.FieldPropertyID:
+		m.ClearPropertyID()
+		return nil
+	case listing.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case listing.FieldExpiryReminderSentAt:
+		m.ClearExpiryReminderSentAt()
+		return nil
+	case listing.FieldListedAt:
+		m.ClearListedAt()
+		return nil
+	case listing.FieldOnMarketSince:
+		m.ClearOnMarketSince()
+		return nil
+	case listing.FieldSoldPrice:
+		m.ClearSoldPrice()
+		return nil
+	case listing.FieldCloseDate:
+		m.ClearCloseDate()
+		return nil
+	case listing.FieldBuyerRealtorID:
+		m.ClearBuyerRealtorID()
+		return nil
+	case listing.FieldPublishAt:
+		m.ClearPublishAt()
+		return nil
+	case listing.FieldUnpublishAt:
+		m.ClearUnpublishAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ListingMutation) ResetField(name string) error {
+	switch name {
+	case listing.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case listing.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case listing.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case listing.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case listing.FieldAddressKey:
+		m.ResetAddressKey()
+		return nil
+	case listing.FieldCity:
+		m.ResetCity()
+		return nil
+	case listing.FieldState:
+		m.ResetState()
+		return nil
+	case listing.FieldZipCode:
+		m.ResetZipCode()
+		return nil
+	case listing.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case listing.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case listing.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	case listing.FieldNeighborhoodID:
+		m.ResetNeighborhoodID()
+		return nil
+	case listing.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case listing.FieldSourceLocale:
+		m.ResetSourceLocale()
+		return nil
+	case listing.FieldTranslations:
+		m.ResetTranslations()
+		return nil
+	case listing.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case listing.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case listing.FieldBedroom:
+		m.ResetBedroom()
+		return nil
+	case listing.FieldBathroom:
+		m.ResetBathroom()
+		return nil
+	case listing.FieldGarage:
+		m.ResetGarage()
+		return nil
+	case listing.FieldSqft:
+		m.ResetSqft()
+		return nil
+	case listing.FieldSqm:
+		m.ResetSqm()
+		return nil
+	case listing.FieldAreaUnit:
+		m.ResetAreaUnit()
+		return nil
+	case listing.FieldTypeOfProperty:
+		m.ResetTypeOfProperty()
+		return nil
+	case listing.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case listing.FieldLotSize:
+		m.ResetLotSize()
+		return nil
+	case listing.FieldPool:
+		m.ResetPool()
+		return nil
+	case listing.FieldYearBuilt:
+		m.ResetYearBuilt()
+		return nil
+	case listing.FieldAnnualPropertyTax:
+		m.ResetAnnualPropertyTax()
+		return nil
+	case listing.FieldHoaDues:
+		m.ResetHoaDues()
+		return nil
+	case listing.FieldHoaFrequency:
+		m.ResetHoaFrequency()
+		return nil
+	case listing.FieldHoaName:
+		m.ResetHoaName()
+		return nil
+	case listing.FieldSpecialAssessments:
+		m.ResetSpecialAssessments()
+		return nil
+	case listing.FieldMonthlyUtilities:
+		m.ResetMonthlyUtilities()
+		return nil
+	case listing.FieldMedia:
+		m.ResetMedia()
+		return nil
+	case listing.FieldRealtorID:
+		m.ResetRealtorID()
+		return nil
+	case listing.FieldPropertyID:
+		m.ResetPropertyID()
+		return nil
+	case listing.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case listing.FieldExpiryReminderSentAt:
+		m.ResetExpiryReminderSentAt()
+		return nil
+	case listing.FieldExpired:
+		m.ResetExpired()
+		return nil
+	case listing.FieldListedAt:
+		m.ResetListedAt()
+		return nil
+	case listing.FieldOnMarketSince:
+		m.ResetOnMarketSince()
+		return nil
+	case listing.FieldMarketSeconds:
+		m.ResetMarketSeconds()
+		return nil
+	case listing.FieldSoldPrice:
+		m.ResetSoldPrice()
+		return nil
+	case listing.FieldCloseDate:
+		m.ResetCloseDate()
+		return nil
+	case listing.FieldBuyerRealtorID:
+		m.ResetBuyerRealtorID()
+		return nil
+	case listing.FieldPublishAt:
+		m.ResetPublishAt()
+		return nil
+	case listing.FieldUnpublishAt:
+		m.ResetUnpublishAt()
+		return nil
+	case listing.FieldVersion:
+		m.ResetVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ListingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.realtor != nil {
+		edges = append(edges, listing.EdgeRealtor)
+	}
+	if m.property != nil {
+		edges = append(edges, listing.EdgeProperty)
+	}
+	if m.neighborhood != nil {
+		edges = append(edges, listing.EdgeNeighborhood)
+	}
+	if m.buyer_realtor != nil {
+		edges = append(edges, listing.EdgeBuyerRealtor)
+	}
+	if m.renewals != nil {
+		edges = append(edges, listing.EdgeRenewals)
+	}
+	if m.documents != nil {
+		edges = append(edges, listing.EdgeDocuments)
+	}
+	if m.inquiries != nil {
+		edges = append(edges, listing.EdgeInquiries)
+	}
+	if m.reviews != nil {
+		edges = append(edges, listing.EdgeReviews)
+	}
+	if m.offers != nil {
+		edges = append(edges, listing.EdgeOffers)
+	}
+	if m.status_events != nil {
+		edges = append(edges, listing.EdgeStatusEvents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ListingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case listing.EdgeRealtor:
+		if id := m.realtor; id != nil {
+			return []ent.Value{*id}
+		}
+	case listing.EdgeProperty:
+		if id := m.property; id != nil {
+			return []ent.Value{*id}
+		}
+	case listing.EdgeNeighborhood:
+		if id := m.neighborhood; id != nil {
+			return []ent.Value{*id}
+		}
+	case listing.EdgeBuyerRealtor:
+		if id := m.buyer_realtor; id != nil {
+			return []ent.Value{*id}
+		}
+	case listing.EdgeRenewals:
+		ids := make([]ent.Value, 0, len(m.renewals))
+		for id := range m.renewals {
+			ids = append(ids, id)
+		}
+		return ids
+	case listing.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.documents))
+		for id := range m.documents {
+			ids = append(ids, id)
+		}
+		return ids
+	case listing.EdgeInquiries:
+		ids := make([]ent.Value, 0, len(m.inquiries))
+		for id := range m.inquiries {
+			ids = append(ids, id)
+		}
+		return ids
+	case listing.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.reviews))
+		for id := range m.reviews {
+			ids = append(ids, id)
+		}
+		return ids
+	case listing.EdgeOffers:
+		ids := make([]ent.Value, 0, len(m.offers))
+		for id := range m.offers {
+			ids = append(ids, id)
+		}
+		return ids
+	case listing.EdgeStatusEvents:
+		ids := make([]ent.Value, 0, len(m.status_events))
+		for id := range m.status_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ListingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removedrenewals != nil {
+		edges = append(edges, listing.EdgeRenewals)
+	}
+	if m.removeddocuments != nil {
+		edges = append(edges, listing.EdgeDocuments)
+	}
+	if m.removedinquiries != nil {
+		edges = append(edges, listing.EdgeInquiries)
+	}
+	if m.removedreviews != nil {
+		edges = append(edges, listing.EdgeReviews)
+	}
+	if m.removedoffers != nil {
+		edges = append(edges, listing.EdgeOffers)
+	}
+	if m.removedstatus_events != nil {
+		edges = append(edges, listing.EdgeStatusEvents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ListingMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case listing.EdgeRenewals:
+		ids := make([]ent.Value, 0, len(m.removedrenewals))
+		for id := range m.removedrenewals {
+			ids = append(ids, id)
+		}
+		return ids
+	case listing.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.removeddocuments))
+		for id := range m.removeddocuments {
+			ids = append(ids, id)
+		}
+		return ids
+	case listing.EdgeInquiries:
+		ids := make([]ent.Value, 0, len(m.removedinquiries))
+		for id := range m.removedinquiries {
+			ids = append(ids, id)
+		}
+		return ids
+	case listing.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.removedreviews))
+		for id := range m.removedreviews {
+			ids = append(ids, id)
+		}
+		return ids
+	case listing.EdgeOffers:
+		ids := make([]ent.Value, 0, len(m.removedoffers))
+		for id := range m.removedoffers {
+			ids = append(ids, id)
+		}
+		return ids
+	case listing.EdgeStatusEvents:
+		ids := make([]ent.Value, 0, len(m.removedstatus_events))
+		for id := range m.removedstatus_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ListingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.clearedrealtor {
+		edges = append(edges, listing.EdgeRealtor)
+	}
+	if m.clearedproperty {
+		edges = append(edges, listing.EdgeProperty)
+	}
+	if m.clearedneighborhood {
+		edges = append(edges, listing.EdgeNeighborhood)
+	}
+	if m.clearedbuyer_realtor {
+		edges = append(edges, listing.EdgeBuyerRealtor)
+	}
+	if m.clearedrenewals {
+		edges = append(edges, listing.EdgeRenewals)
+	}
+	if m.cleareddocuments {
+		edges = append(edges, listing.EdgeDocuments)
+	}
+	if m.clearedinquiries {
+		edges = append(edges, listing.EdgeInquiries)
+	}
+	if m.clearedreviews {
+		edges = append(edges, listing.EdgeReviews)
+	}
+	if m.clearedoffers {
+		edges = append(edges, listing.EdgeOffers)
+	}
+	if m.clearedstatus_events {
+		edges = append(edges, listing.EdgeStatusEvents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ListingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case listing.EdgeRealtor:
+		return m.clearedrealtor
+	case listing.EdgeProperty:
+		return m.clearedproperty
+	case listing.EdgeNeighborhood:
+		return m.clearedneighborhood
+	case listing.EdgeBuyerRealtor:
+		return m.clearedbuyer_realtor
+	case listing.EdgeRenewals:
+		return m.clearedrenewals
+	case listing.EdgeDocuments:
+		return m.cleareddocuments
+	case listing.EdgeInquiries:
+		return m.clearedinquiries
+	case listing.EdgeReviews:
+		return m.clearedreviews
+	case listing.EdgeOffers:
+		return m.clearedoffers
+	case listing.EdgeStatusEvents:
+		return m.clearedstatus_events
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ListingMutation) ClearEdge(name string) error {
+	switch name {
+	case listing.EdgeRealtor:
+		m.ClearRealtor()
+		return nil
+	case listing.EdgeProperty:
+		m.ClearProperty()
+		return nil
+	case listing.EdgeNeighborhood:
+		m.ClearNeighborhood()
+		return nil
+	case listing.EdgeBuyerRealtor:
+		m.ClearBuyerRealtor()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ListingMutation) ResetEdge(name string) error {
+	switch name {
+	case listing.EdgeRealtor:
+		m.ResetRealtor()
+		return nil
+	case listing.EdgeProperty:
+		m.ResetProperty()
+		return nil
+	case listing.EdgeNeighborhood:
+		m.ResetNeighborhood()
+		return nil
+	case listing.EdgeBuyerRealtor:
+		m.ResetBuyerRealtor()
+		return nil
+	case listing.EdgeRenewals:
+		m.ResetRenewals()
+		return nil
+	case listing.EdgeDocuments:
+		m.ResetDocuments()
+		return nil
+	case listing.EdgeInquiries:
+		m.ResetInquiries()
+		return nil
+	case listing.EdgeReviews:
+		m.ResetReviews()
+		return nil
+	case listing.EdgeOffers:
+		m.ResetOffers()
+		return nil
+	case listing.EdgeStatusEvents:
+		m.ResetStatusEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown Listing edge %s", name)
+}
+
+// ListingDocumentMutation represents an operation that mutates the ListingDocument nodes in the graph.
+type ListingDocumentMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	create_time      *time.Time
+	update_time      *time.Time
+	title            *string
+	kind             *listingdocument.Kind
+	access_level     *listingdocument.AccessLevel
+	filename         *string
+	size_bytes       *int64
+	addsize_bytes    *int64
+	storage_id       *string
+	storage_format   *string
+	uploaded_by      *string
+	clearedFields    map[string]struct{}
+	listing          *uuid.UUID
+	clearedlisting   bool
+	downloads        map[uuid.UUID]struct{}
+	removeddownloads map[uuid.UUID]struct{}
+	cleareddownloads bool
+	done             bool
+	oldValue         func(context.Context) (*ListingDocument, error)
+	predicates       []predicate.ListingDocument
+}
+
+var _ ent.Mutation = (*ListingDocumentMutation)(nil)
+
+// listingdocumentOption allows management of the mutation configuration using functional options.
+type listingdocumentOption func(*ListingDocumentMutation)
+
+// newListingDocumentMutation creates new mutation for the ListingDocument entity.
+func newListingDocumentMutation(c config, op Op, opts ...listingdocumentOption) *ListingDocumentMutation {
+	m := &ListingDocumentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeListingDocument,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withListingDocumentID sets the ID field of the mutation.
+func withListingDocumentID(id uuid.UUID) listingdocumentOption {
+	return func(m *ListingDocumentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ListingDocument
+		)
+		m.oldValue = func(ctx context.Context) (*ListingDocument, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ListingDocument.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withListingDocument sets the old ListingDocument of the mutation.
+func withListingDocument(node *ListingDocument) listingdocumentOption {
+	return func(m *ListingDocumentMutation) {
+		m.oldValue = func(context.Context) (*ListingDocument, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ListingDocumentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ListingDocumentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ListingDocument entities.
+func (m *ListingDocumentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ListingDocumentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ListingDocumentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ListingDocument.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ListingDocumentMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ListingDocumentMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ListingDocument entity.
+// If the ListingDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingDocumentMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ListingDocumentMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ListingDocumentMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ListingDocumentMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ListingDocument entity.
+// If the ListingDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingDocumentMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ListingDocumentMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetListingID sets the "listing_id" field.
+func (m *ListingDocumentMutation) SetListingID(u uuid.UUID) {
+	m.listing = &u
+}
+
+// ListingID returns the value of the "listing_id" field in the mutation.
+func (m *ListingDocumentMutation) ListingID() (r uuid.UUID, exists bool) {
+	v := m.listing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldListingID returns the old "listing_id" field's value of the ListingDocument entity.
+// If the ListingDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingDocumentMutation) OldListingID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListingID: %w", err)
+	}
+	return oldValue.ListingID, nil
+}
+
+// ResetListingID resets all changes to the "listing_id" field.
+func (m *ListingDocumentMutation) ResetListingID() {
+	m.listing = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *ListingDocumentMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *ListingDocumentMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the ListingDocument entity.
+// If the ListingDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingDocumentMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *ListingDocumentMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *ListingDocumentMutation) SetKind(l listingdocument.Kind) {
+	m.kind = &l
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ListingDocumentMutation) Kind() (r listingdocument.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the ListingDocument entity.
+// If the ListingDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingDocumentMutation) OldKind(ctx context.Context) (v listingdocument.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ListingDocumentMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetAccessLevel sets the "access_level" field.
+func (m *ListingDocumentMutation) SetAccessLevel(ll listingdocument.AccessLevel) {
+	m.access_level = &ll
+}
+
+// AccessLevel returns the value of the "access_level" field in the mutation.
+func (m *ListingDocumentMutation) AccessLevel() (r listingdocument.AccessLevel, exists bool) {
+	v := m.access_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessLevel returns the old "access_level" field's value of the ListingDocument entity.
+// If the ListingDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingDocumentMutation) OldAccessLevel(ctx context.Context) (v listingdocument.AccessLevel, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessLevel: %w", err)
+	}
+	return oldValue.AccessLevel, nil
+}
+
+// ResetAccessLevel resets all changes to the "access_level" field.
+func (m *ListingDocumentMutation) ResetAccessLevel() {
+	m.access_level = nil
+}
+
+// SetFilename sets the "filename" field.
+func (m *ListingDocumentMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *ListingDocumentMutation) Filename() (r string, exists bool) {
+	v := m.filename
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilename returns the old "filename" field's value of the ListingDocument entity.
+// If the ListingDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingDocumentMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *ListingDocumentMutation) ResetFilename() {
+	m.filename = nil
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (m *ListingDocumentMutation) SetSizeBytes(i int64) {
+	m.size_bytes = &i
+	m.addsize_bytes = nil
+}
+
+// SizeBytes returns the value of the "size_bytes" field in the mutation.
+func (m *ListingDocumentMutation) SizeBytes() (r int64, exists bool) {
+	v := m.size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSizeBytes returns the old "size_bytes" field's value of the ListingDocument entity.
+// If the ListingDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingDocumentMutation) OldSizeBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSizeBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSizeBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSizeBytes: %w", err)
+	}
+	return oldValue.SizeBytes, nil
+}
+
+// AddSizeBytes adds i to the "size_bytes" field.
+func (m *ListingDocumentMutation) AddSizeBytes(i int64) {
+	if m.addsize_bytes != nil {
+		*m.addsize_bytes += i
+	} else {
+		m.addsize_bytes = &i
+	}
+}
+
+// AddedSizeBytes returns the value that was added to the "size_bytes" field in this mutation.
+func (m *ListingDocumentMutation) AddedSizeBytes() (r int64, exists bool) {
+	v := m.addsize_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSizeBytes resets all changes to the "size_bytes" field.
+func (m *ListingDocumentMutation) ResetSizeBytes() {
+	m.size_bytes = nil
+	m.addsize_bytes = nil
+}
+
+// SetStorageID sets the "storage_id" field.
+func (m *ListingDocumentMutation) SetStorageID(s string) {
+	m.storage_id = &s
+}
+
+// StorageID returns the value of the "storage_id" field in the mutation.
+func (m *ListingDocumentMutation) StorageID() (r string, exists bool) {
+	v := m.storage_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageID returns the old "storage_id" field's value of the ListingDocument entity.
+// If the ListingDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingDocumentMutation) OldStorageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageID: %w", err)
+	}
+	return oldValue.StorageID, nil
+}
+
+// ResetStorageID resets all changes to the "storage_id" field.
+func (m *ListingDocumentMutation) ResetStorageID() {
+	m.storage_id = nil
+}
+
+// SetStorageFormat sets the "storage_format" field.
+func (m *ListingDocumentMutation) SetStorageFormat(s string) {
+	m.storage_format = &s
+}
+
+// StorageFormat returns the value of the "storage_format" field in the mutation.
+func (m *ListingDocumentMutation) StorageFormat() (r string, exists bool) {
+	v := m.storage_format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageFormat returns the old "storage_format" field's value of the ListingDocument entity.
+// If the ListingDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingDocumentMutation) OldStorageFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageFormat: %w", err)
+	}
+	return oldValue.StorageFormat, nil
+}
+
+// ClearStorageFormat clears the value of the "storage_format" field.
+func (m *ListingDocumentMutation) ClearStorageFormat() {
+	m.storage_format = nil
+	m.clearedFields[listingdocument.FieldStorageFormat] = struct{}{}
+}
+
+// StorageFormatCleared returns if the "storage_format" field was cleared in this mutation.
+func (m *ListingDocumentMutation) StorageFormatCleared() bool {
+	_, ok := m.clearedFields[listingdocument.FieldStorageFormat]
+	return ok
+}
+
+// ResetStorageFormat resets all changes to the "storage_format" field.
+func (m *ListingDocumentMutation) ResetStorageFormat() {
+	m.storage_format = nil
+	delete(m.clearedFields, listingdocument.FieldStorageFormat)
+}
+
+// SetUploadedBy sets the "uploaded_by" field.
+func (m *ListingDocumentMutation) SetUploadedBy(s string) {
+	m.uploaded_by = &s
+}
+
+// UploadedBy returns the value of the "uploaded_by" field in the mutation.
+func (m *ListingDocumentMutation) UploadedBy() (r string, exists bool) {
+	v := m.uploaded_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadedBy returns the old "uploaded_by" field's value of the ListingDocument entity.
+// If the ListingDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingDocumentMutation) OldUploadedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadedBy: %w", err)
+	}
+	return oldValue.UploadedBy, nil
+}
+
+// ClearUploadedBy clears the value of the "uploaded_by" field.
+func (m *ListingDocumentMutation) ClearUploadedBy() {
+	m.uploaded_by = nil
+	m.clearedFields[listingdocument.FieldUploadedBy] = struct{}{}
+}
+
+// UploadedByCleared returns if the "uploaded_by" field was cleared in this mutation.
+func (m *ListingDocumentMutation) UploadedByCleared() bool {
+	_, ok := m.clearedFields[listingdocument.FieldUploadedBy]
+	return ok
+}
+
+// ResetUploadedBy resets all changes to the "uploaded_by" field.
+func (m *ListingDocumentMutation) ResetUploadedBy() {
+	m.uploaded_by = nil
+	delete(m.clearedFields, listingdocument.FieldUploadedBy)
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (m *ListingDocumentMutation) ClearListing() {
+	m.clearedlisting = true
+	m.clearedFields[listingdocument.FieldListingID] = struct{}{}
+}
+
+// ListingCleared reports if the "listing" edge to the Listing entity was cleared.
+func (m *ListingDocumentMutation) ListingCleared() bool {
+	return m.clearedlisting
+}
+
+// ListingIDs returns the "listing" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ListingID instead. It exists only for internal usage by the builders.
+func (m *ListingDocumentMutation) ListingIDs() (ids []uuid.UUID) {
+	if id := m.listing; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetListing resets all changes to the "listing" edge.
+func (m *ListingDocumentMutation) ResetListing() {
+	m.listing = nil
+	m.clearedlisting = false
+}
+
+// AddDownloadIDs adds the "downloads" edge to the DocumentDownload entity by ids.
+func (m *ListingDocumentMutation) AddDownloadIDs(ids ...uuid.UUID) {
+	if m.downloads == nil {
+		m.downloads = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.downloads[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDownloads clears the "downloads" edge to the DocumentDownload entity.
+func (m *ListingDocumentMutation) ClearDownloads() {
+	m.cleareddownloads = true
+}
+
+// DownloadsCleared reports if the "downloads" edge to the DocumentDownload entity was cleared.
+func (m *ListingDocumentMutation) DownloadsCleared() bool {
+	return m.cleareddownloads
+}
+
+// RemoveDownloadIDs removes the "downloads" edge to the DocumentDownload entity by IDs.
+func (m *ListingDocumentMutation) RemoveDownloadIDs(ids ...uuid.UUID) {
+	if m.removeddownloads == nil {
+		m.removeddownloads = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.downloads, ids[i])
+		m.removeddownloads[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDownloads returns the removed IDs of the "downloads" edge to the DocumentDownload entity.
+func (m *ListingDocumentMutation) RemovedDownloadsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddownloads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DownloadsIDs returns the "downloads" edge IDs in the mutation.
+func (m *ListingDocumentMutation) DownloadsIDs() (ids []uuid.UUID) {
+	for id := range m.downloads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDownloads resets all changes to the "downloads" edge.
+func (m *ListingDocumentMutation) ResetDownloads() {
+	m.downloads = nil
+	m.cleareddownloads = false
+	m.removeddownloads = nil
+}
+
+// Where appends a list predicates to the ListingDocumentMutation builder.
+func (m *ListingDocumentMutation) Where(ps ...predicate.ListingDocument) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ListingDocumentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ListingDocumentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ListingDocument, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ListingDocumentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ListingDocumentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ListingDocument).
+func (m *ListingDocumentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ListingDocumentMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, listingdocument.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, listingdocument.FieldUpdateTime)
+	}
+	if m.listing != nil {
+		fields = append(fields, listingdocument.FieldListingID)
+	}
+	if m.title != nil {
+		fields = append(fields, listingdocument.FieldTitle)
+	}
+	if m.kind != nil {
+		fields = append(fields, listingdocument.FieldKind)
+	}
+	if m.access_level != nil {
+		fields = append(fields, listingdocument.FieldAccessLevel)
+	}
+	if m.filename != nil {
+		fields = append(fields, listingdocument.FieldFilename)
+	}
+	if m.size_bytes != nil {
+		fields = append(fields, listingdocument.FieldSizeBytes)
+	}
+	if m.storage_id != nil {
+		fields = append(fields, listingdocument.FieldStorageID)
+	}
+	if m.storage_format != nil {
+		fields = append(fields, listingdocument.FieldStorageFormat)
+	}
+	if m.uploaded_by != nil {
+		fields = append(fields, listingdocument.FieldUploadedBy)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ListingDocumentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case listingdocument.FieldCreateTime:
+		return m.CreateTime()
+	case listingdocument.FieldUpdateTime:
+		return m.UpdateTime()
+	case listingdocument.FieldListingID:
+		return m.ListingID()
+	case listingdocument.FieldTitle:
+		return m.Title()
+	case listingdocument.FieldKind:
+		return m.Kind()
+	case listingdocument.FieldAccessLevel:
+		return m.AccessLevel()
+	case listingdocument.FieldFilename:
+		return m.Filename()
+	case listingdocument.FieldSizeBytes:
+		return m.SizeBytes()
+	case listingdocument.FieldStorageID:
+		return m.StorageID()
+	case listingdocument.FieldStorageFormat:
+		return m.StorageFormat()
+	case listingdocument.FieldUploadedBy:
+		return m.UploadedBy()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ListingDocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case listingdocument.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case listingdocument.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case listingdocument.FieldListingID:
+		return m.OldListingID(ctx)
+	case listingdocument.FieldTitle:
+		return m.OldTitle(ctx)
+	case listingdocument.FieldKind:
+		return m.OldKind(ctx)
+	case listingdocument.FieldAccessLevel:
+		return m.OldAccessLevel(ctx)
+	case listingdocument.FieldFilename:
+		return m.OldFilename(ctx)
+	case listingdocument.FieldSizeBytes:
+		return m.OldSizeBytes(ctx)
+	case listingdocument.FieldStorageID:
+		return m.OldStorageID(ctx)
+	case listingdocument.FieldStorageFormat:
+		return m.OldStorageFormat(ctx)
+	case listingdocument.FieldUploadedBy:
+		return m.OldUploadedBy(ctx)
+	}
+	return nil, fmt.Errorf("unknown ListingDocument field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingDocumentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case listingdocument.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case listingdocument.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case listingdocument.FieldListingID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListingID(v)
+		return nil
+	case listingdocument.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case listingdocument.FieldKind:
+		v, ok := value.(listingdocument.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case listingdocument.FieldAccessLevel:
+		v, ok := value.(listingdocument.AccessLevel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessLevel(v)
+		return nil
+	case listingdocument.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case listingdocument.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSizeBytes(v)
+		return nil
+	case listingdocument.FieldStorageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageID(v)
+		return nil
+	case listingdocument.FieldStorageFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageFormat(v)
+		return nil
+	case listingdocument.FieldUploadedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ListingDocument field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ListingDocumentMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize_bytes != nil {
+		fields = append(fields, listingdocument.FieldSizeBytes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ListingDocumentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case listingdocument.FieldSizeBytes:
+		return m.AddedSizeBytes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingDocumentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case listingdocument.FieldSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSizeBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ListingDocument numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ListingDocumentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(listingdocument.FieldStorageFormat) {
+		fields = append(fields, listingdocument.FieldStorageFormat)
+	}
+	if m.FieldCleared(listingdocument.FieldUploadedBy) {
+		fields = append(fields, listingdocument.FieldUploadedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ListingDocumentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ListingDocumentMutation) ClearField(name string) error {
+	switch name {
+	case listingdocument.FieldStorageFormat:
+		m.ClearStorageFormat()
+		return nil
+	case listingdocument.FieldUploadedBy:
+		m.ClearUploadedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingDocument nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ListingDocumentMutation) ResetField(name string) error {
+	switch name {
+	case listingdocument.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case listingdocument.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case listingdocument.FieldListingID:
+		m.ResetListingID()
+		return nil
+	case listingdocument.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case listingdocument.FieldKind:
+		m.ResetKind()
+		return nil
+	case listingdocument.FieldAccessLevel:
+		m.ResetAccessLevel()
+		return nil
+	case listingdocument.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case listingdocument.FieldSizeBytes:
+		m.ResetSizeBytes()
+		return nil
+	case listingdocument.FieldStorageID:
+		m.ResetStorageID()
+		return nil
+	case listingdocument.FieldStorageFormat:
+		m.ResetStorageFormat()
+		return nil
+	case listingdocument.FieldUploadedBy:
+		m.ResetUploadedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingDocument field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ListingDocumentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.listing != nil {
+		edges = append(edges, listingdocument.EdgeListing)
+	}
+	if m.downloads != nil {
+		edges = append(edges, listingdocument.EdgeDownloads)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ListingDocumentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case listingdocument.EdgeListing:
+		if id := m.listing; id != nil {
+			return []ent.Value{*id}
+		}
+	case listingdocument.EdgeDownloads:
+		ids := make([]ent.Value, 0, len(m.downloads))
+		for id := range m.downloads {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ListingDocumentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddownloads != nil {
+		edges = append(edges, listingdocument.EdgeDownloads)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ListingDocumentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case listingdocument.EdgeDownloads:
+		ids := make([]ent.Value, 0, len(m.removeddownloads))
+		for id := range m.removeddownloads {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ListingDocumentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedlisting {
+		edges = append(edges, listingdocument.EdgeListing)
+	}
+	if m.cleareddownloads {
+		edges = append(edges, listingdocument.EdgeDownloads)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ListingDocumentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case listingdocument.EdgeListing:
+		return m.clearedlisting
+	case listingdocument.EdgeDownloads:
+		return m.cleareddownloads
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ListingDocumentMutation) ClearEdge(name string) error {
+	switch name {
+	case listingdocument.EdgeListing:
+		m.ClearListing()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingDocument unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ListingDocumentMutation) ResetEdge(name string) error {
+	switch name {
+	case listingdocument.EdgeListing:
+		m.ResetListing()
+		return nil
+	case listingdocument.EdgeDownloads:
+		m.ResetDownloads()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingDocument edge %s", name)
+}
+
+// ListingInquiryMutation represents an operation that mutates the ListingInquiry nodes in the graph.
+type ListingInquiryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	message        *string
+	status         *listinginquiry.Status
+	decided_at     *time.Time
+	clearedFields  map[string]struct{}
+	listing        *uuid.UUID
+	clearedlisting bool
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*ListingInquiry, error)
+	predicates     []predicate.ListingInquiry
+}
+
+var _ ent.Mutation = (*ListingInquiryMutation)(nil)
+
+// listinginquiryOption allows management of the mutation configuration using functional options.
+type listinginquiryOption func(*ListingInquiryMutation)
+
+// newListingInquiryMutation creates new mutation for the ListingInquiry entity.
+func newListingInquiryMutation(c config, op Op, opts ...listinginquiryOption) *ListingInquiryMutation {
+	m := &ListingInquiryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeListingInquiry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withListingInquiryID sets the ID field of the mutation.
+func withListingInquiryID(id uuid.UUID) listinginquiryOption {
+	return func(m *ListingInquiryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ListingInquiry
+		)
+		m.oldValue = func(ctx context.Context) (*ListingInquiry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ListingInquiry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withListingInquiry sets the old ListingInquiry of the mutation.
+func withListingInquiry(node *ListingInquiry) listinginquiryOption {
+	return func(m *ListingInquiryMutation) {
+		m.oldValue = func(context.Context) (*ListingInquiry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ListingInquiryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ListingInquiryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ListingInquiry entities.
+func (m *ListingInquiryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ListingInquiryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ListingInquiryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ListingInquiry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ListingInquiryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ListingInquiryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ListingInquiry entity.
+// If the ListingInquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingInquiryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ListingInquiryMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ListingInquiryMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ListingInquiryMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ListingInquiry entity.
+// If the ListingInquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingInquiryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ListingInquiryMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetListingID sets the "listing_id" field.
+func (m *ListingInquiryMutation) SetListingID(u uuid.UUID) {
+	m.listing = &u
+}
+
+// ListingID returns the value of the "listing_id" field in the mutation.
+func (m *ListingInquiryMutation) ListingID() (r uuid.UUID, exists bool) {
+	v := m.listing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldListingID returns the old "listing_id" field's value of the ListingInquiry entity.
+// If the ListingInquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingInquiryMutation) OldListingID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListingID: %w", err)
+	}
+	return oldValue.ListingID, nil
+}
+
+// ResetListingID resets all changes to the "listing_id" field.
+func (m *ListingInquiryMutation) ResetListingID() {
+	m.listing = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ListingInquiryMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ListingInquiryMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ListingInquiry entity.
+// If the ListingInquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingInquiryMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ListingInquiryMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *ListingInquiryMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *ListingInquiryMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the ListingInquiry entity.
+// If the ListingInquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingInquiryMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *ListingInquiryMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[listinginquiry.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *ListingInquiryMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[listinginquiry.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *ListingInquiryMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, listinginquiry.FieldMessage)
+}
+
+// SetStatus sets the "status" field.
+func (m *ListingInquiryMutation) SetStatus(l listinginquiry.Status) {
+	m.status = &l
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ListingInquiryMutation) Status() (r listinginquiry.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ListingInquiry entity.
+// If the ListingInquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingInquiryMutation) OldStatus(ctx context.Context) (v listinginquiry.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ListingInquiryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (m *ListingInquiryMutation) SetDecidedAt(t time.Time) {
+	m.decided_at = &t
+}
+
+// DecidedAt returns the value of the "decided_at" field in the mutation.
+func (m *ListingInquiryMutation) DecidedAt() (r time.Time, exists bool) {
+	v := m.decided_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecidedAt returns the old "decided_at" field's value of the ListingInquiry entity.
+// If the ListingInquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingInquiryMutation) OldDecidedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedAt: %w", err)
+	}
+	return oldValue.DecidedAt, nil
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (m *ListingInquiryMutation) ClearDecidedAt() {
+	m.decided_at = nil
+	m.clearedFields[listinginquiry.FieldDecidedAt] = struct{}{}
+}
+
+// DecidedAtCleared returns if the "decided_at" field was cleared in this mutation.
+func (m *ListingInquiryMutation) DecidedAtCleared() bool {
+	_, ok := m.clearedFields[listinginquiry.FieldDecidedAt]
+	return ok
+}
+
+// ResetDecidedAt resets all changes to the "decided_at" field.
+func (m *ListingInquiryMutation) ResetDecidedAt() {
+	m.decided_at = nil
+	delete(m.clearedFields, listinginquiry.FieldDecidedAt)
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (m *ListingInquiryMutation) ClearListing() {
+	m.clearedlisting = true
+	m.clearedFields[listinginquiry.FieldListingID] = struct{}{}
+}
+
+// ListingCleared reports if the "listing" edge to the Listing entity was cleared.
+func (m *ListingInquiryMutation) ListingCleared() bool {
+	return m.clearedlisting
+}
+
+// ListingIDs returns the "listing" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ListingID instead. It exists only for internal usage by the builders.
+func (m *ListingInquiryMutation) ListingIDs() (ids []uuid.UUID) {
+	if id := m.listing; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetListing resets all changes to the "listing" edge.
+func (m *ListingInquiryMutation) ResetListing() {
+	m.listing = nil
+	m.clearedlisting = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ListingInquiryMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[listinginquiry.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ListingInquiryMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ListingInquiryMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ListingInquiryMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the ListingInquiryMutation builder.
+func (m *ListingInquiryMutation) Where(ps ...predicate.ListingInquiry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ListingInquiryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ListingInquiryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ListingInquiry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ListingInquiryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ListingInquiryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ListingInquiry).
+func (m *ListingInquiryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ListingInquiryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, listinginquiry.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, listinginquiry.FieldUpdateTime)
+	}
+	if m.listing != nil {
+		fields = append(fields, listinginquiry.FieldListingID)
+	}
+	if m.user != nil {
+		fields = append(fields, listinginquiry.FieldUserID)
+	}
+	if m.message != nil {
+		fields = append(fields, listinginquiry.FieldMessage)
+	}
+	if m.status != nil {
+		fields = append(fields, listinginquiry.FieldStatus)
+	}
+	if m.decided_at != nil {
+		fields = append(fields, listinginquiry.FieldDecidedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ListingInquiryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case listinginquiry.FieldCreateTime:
+		return m.CreateTime()
+	case listinginquiry.FieldUpdateTime:
+		return m.UpdateTime()
+	case listinginquiry.FieldListingID:
+		return m.ListingID()
+	case listinginquiry.FieldUserID:
+		return m.UserID()
+	case listinginquiry.FieldMessage:
+		return m.Message()
+	case listinginquiry.FieldStatus:
+		return m.Status()
+	case listinginquiry.FieldDecidedAt:
+		return m.DecidedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ListingInquiryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case listinginquiry.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case listinginquiry.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case listinginquiry.FieldListingID:
+		return m.OldListingID(ctx)
+	case listinginquiry.FieldUserID:
+		return m.OldUserID(ctx)
+	case listinginquiry.FieldMessage:
+		return m.OldMessage(ctx)
+	case listinginquiry.FieldStatus:
+		return m.OldStatus(ctx)
+	case listinginquiry.FieldDecidedAt:
+		return m.OldDecidedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ListingInquiry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingInquiryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case listinginquiry.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case listinginquiry.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case listinginquiry.FieldListingID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListingID(v)
+		return nil
+	case listinginquiry.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case listinginquiry.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case listinginquiry.FieldStatus:
+		v, ok := value.(listinginquiry.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case listinginquiry.FieldDecidedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecidedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ListingInquiry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ListingInquiryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ListingInquiryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingInquiryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ListingInquiry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ListingInquiryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(listinginquiry.FieldMessage) {
+		fields = append(fields, listinginquiry.FieldMessage)
+	}
+	if m.FieldCleared(listinginquiry.FieldDecidedAt) {
+		fields = append(fields, listinginquiry.FieldDecidedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ListingInquiryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ListingInquiryMutation) ClearField(name string) error {
+	switch name {
+	case listinginquiry.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case listinginquiry.FieldDecidedAt:
+		m.ClearDecidedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingInquiry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ListingInquiryMutation) ResetField(name string) error {
+	switch name {
+	case listinginquiry.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case listinginquiry.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case listinginquiry.FieldListingID:
+		m.ResetListingID()
+		return nil
+	case listinginquiry.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case listinginquiry.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case listinginquiry.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case listinginquiry.FieldDecidedAt:
+		m.ResetDecidedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingInquiry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ListingInquiryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.listing != nil {
+		edges = append(edges, listinginquiry.EdgeListing)
+	}
+	if m.user != nil {
+		edges = append(edges, listinginquiry.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ListingInquiryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case listinginquiry.EdgeListing:
+		if id := m.listing; id != nil {
+			return []ent.Value{*id}
+		}
+	case listinginquiry.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ListingInquiryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ListingInquiryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ListingInquiryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedlisting {
+		edges = append(edges, listinginquiry.EdgeListing)
+	}
+	if m.cleareduser {
+		edges = append(edges, listinginquiry.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ListingInquiryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case listinginquiry.EdgeListing:
+		return m.clearedlisting
+	case listinginquiry.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ListingInquiryMutation) ClearEdge(name string) error {
+	switch name {
+	case listinginquiry.EdgeListing:
+		m.ClearListing()
+		return nil
+	case listinginquiry.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingInquiry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ListingInquiryMutation) ResetEdge(name string) error {
+	switch name {
+	case listinginquiry.EdgeListing:
+		m.ResetListing()
+		return nil
+	case listinginquiry.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingInquiry edge %s", name)
+}
+
+// ListingRenewalMutation represents an operation that mutates the ListingRenewal nodes in the graph.
+type ListingRenewalMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	previous_expires_at *time.Time
+	expires_at          *time.Time
+	term_days           *int
+	addterm_days        *int
+	renewed_at          *time.Time
+	clearedFields       map[string]struct{}
+	listing             *uuid.UUID
+	clearedlisting      bool
+	done                bool
+	oldValue            func(context.Context) (*ListingRenewal, error)
+	predicates          []predicate.ListingRenewal
+}
+
+var _ ent.Mutation = (*ListingRenewalMutation)(nil)
+
+// listingrenewalOption allows management of the mutation configuration using functional options.
+type listingrenewalOption func(*ListingRenewalMutation)
+
+// newListingRenewalMutation creates new mutation for the ListingRenewal entity.
+func newListingRenewalMutation(c config, op Op, opts ...listingrenewalOption) *ListingRenewalMutation {
+	m := &ListingRenewalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeListingRenewal,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withListingRenewalID sets the ID field of the mutation.
+func withListingRenewalID(id uuid.UUID) listingrenewalOption {
+	return func(m *ListingRenewalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ListingRenewal
+		)
+		m.oldValue = func(ctx context.Context) (*ListingRenewal, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ListingRenewal.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withListingRenewal sets the old ListingRenewal of the mutation.
+func withListingRenewal(node *ListingRenewal) listingrenewalOption {
+	return func(m *ListingRenewalMutation) {
+		m.oldValue = func(context.Context) (*ListingRenewal, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ListingRenewalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ListingRenewalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ListingRenewal entities.
+func (m *ListingRenewalMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ListingRenewalMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ListingRenewalMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ListingRenewal.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetListingID sets the "listing_id" field.
+func (m *ListingRenewalMutation) SetListingID(u uuid.UUID) {
+	m.listing = &u
+}
+
+// ListingID returns the value of the "listing_id" field in the mutation.
+func (m *ListingRenewalMutation) ListingID() (r uuid.UUID, exists bool) {
+	v := m.listing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldListingID returns the old "listing_id" field's value of the ListingRenewal entity.
+// If the ListingRenewal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingRenewalMutation) OldListingID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListingID: %w", err)
+	}
+	return oldValue.ListingID, nil
+}
+
+// ResetListingID resets all changes to the "listing_id" field.
+func (m *ListingRenewalMutation) ResetListingID() {
+	m.listing = nil
+}
+
+// SetPreviousExpiresAt sets the "previous_expires_at" field.
+func (m *ListingRenewalMutation) SetPreviousExpiresAt(t time.Time) {
+	m.previous_expires_at = &t
+}
+
+// PreviousExpiresAt returns the value of the "previous_expires_at" field in the mutation.
+func (m *ListingRenewalMutation) PreviousExpiresAt() (r time.Time, exists bool) {
+	v := m.previous_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousExpiresAt returns the old "previous_expires_at" field's value of the ListingRenewal entity.
+// If the ListingRenewal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingRenewalMutation) OldPreviousExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousExpiresAt: %w", err)
+	}
+	return oldValue.PreviousExpiresAt, nil
+}
+
+// ClearPreviousExpiresAt clears the value of the "previous_expires_at" field.
+func (m *ListingRenewalMutation) ClearPreviousExpiresAt() {
+	m.previous_expires_at = nil
+	m.clearedFields[listingrenewal.FieldPreviousExpiresAt] = struct{}{}
+}
+
+// PreviousExpiresAtCleared returns if the "previous_expires_at" field was cleared in this mutation.
+func (m *ListingRenewalMutation) PreviousExpiresAtCleared() bool {
+	_, ok := m.clearedFields[listingrenewal.FieldPreviousExpiresAt]
+	return ok
+}
+
+// ResetPreviousExpiresAt resets all changes to the "previous_expires_at" field.
+func (m *ListingRenewalMutation) ResetPreviousExpiresAt() {
+	m.previous_expires_at = nil
+	delete(m.clearedFields, listingrenewal.FieldPreviousExpiresAt)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ListingRenewalMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ListingRenewalMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ListingRenewal entity.
+// If the ListingRenewal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingRenewalMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ListingRenewalMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetTermDays sets the "term_days" field.
+func (m *ListingRenewalMutation) SetTermDays(i int) {
+	m.term_days = &i
+	m.addterm_days = nil
+}
+
+// TermDays returns the value of the "term_days" field in the mutation.
+func (m *ListingRenewalMutation) TermDays() (r int, exists bool) {
+	v := m.term_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTermDays returns the old "term_days" field's value of the ListingRenewal entity.
+// If the ListingRenewal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingRenewalMutation) OldTermDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTermDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTermDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTermDays: %w", err)
+	}
+	return oldValue.TermDays, nil
+}
+
+// AddTermDays adds i to the "term_days" field.
+func (m *ListingRenewalMutation) AddTermDays(i int) {
+	if m.addterm_days != nil {
+		*m.addterm_days += i
+	} else {
+		m.addterm_days = &i
+	}
+}
+
+// AddedTermDays returns the value that was added to the "term_days" field in this mutation.
+func (m *ListingRenewalMutation) AddedTermDays() (r int, exists bool) {
+	v := m.addterm_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTermDays resets all changes to the "term_days" field.
+func (m *ListingRenewalMutation) ResetTermDays() {
+	m.term_days = nil
+	m.addterm_days = nil
+}
+
+// SetRenewedAt sets the "renewed_at" field.
+func (m *ListingRenewalMutation) SetRenewedAt(t time.Time) {
+	m.renewed_at = &t
+}
+
+// RenewedAt returns the value of the "renewed_at" field in the mutation.
+func (m *ListingRenewalMutation) RenewedAt() (r time.Time, exists bool) {
+	v := m.renewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRenewedAt returns the old "renewed_at" field's value of the ListingRenewal entity.
+// If the ListingRenewal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingRenewalMutation) OldRenewedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRenewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRenewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRenewedAt: %w", err)
+	}
+	return oldValue.RenewedAt, nil
+}
+
+// ResetRenewedAt resets all changes to the "renewed_at" field.
+func (m *ListingRenewalMutation) ResetRenewedAt() {
+	m.renewed_at = nil
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (m *ListingRenewalMutation) ClearListing() {
+	m.clearedlisting = true
+	m.clearedFields[listingrenewal.FieldListingID] = struct{}{}
+}
+
+// ListingCleared reports if the "listing" edge to the Listing entity was cleared.
+func (m *ListingRenewalMutation) ListingCleared() bool {
+	return m.clearedlisting
+}
+
+// ListingIDs returns the "listing" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ListingID instead. It exists only for internal usage by the builders.
+func (m *ListingRenewalMutation) ListingIDs() (ids []uuid.UUID) {
+	if id := m.listing; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetListing resets all changes to the "listing" edge.
+func (m *ListingRenewalMutation) ResetListing() {
+	m.listing = nil
+	m.clearedlisting = false
+}
+
+// Where appends a list predicates to the ListingRenewalMutation builder.
+func (m *ListingRenewalMutation) Where(ps ...predicate.ListingRenewal) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ListingRenewalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ListingRenewalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ListingRenewal, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ListingRenewalMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ListingRenewalMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ListingRenewal).
+func (m *ListingRenewalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ListingRenewalMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.listing != nil {
+		fields = append(fields, listingrenewal.FieldListingID)
+	}
+	if m.previous_expires_at != nil {
+		fields = append(fields, listingrenewal.FieldPreviousExpiresAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, listingrenewal.FieldExpiresAt)
+	}
+	if m.term_days != nil {
+		fields = append(fields, listingrenewal.FieldTermDays)
+	}
+	if m.renewed_at != nil {
+		fields = append(fields, listingrenewal.FieldRenewedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ListingRenewalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case listingrenewal.FieldListingID:
+		return m.ListingID()
+	case listingrenewal.FieldPreviousExpiresAt:
+		return m.PreviousExpiresAt()
+	case listingrenewal.FieldExpiresAt:
+		return m.ExpiresAt()
+	case listingrenewal.FieldTermDays:
+		return m.TermDays()
+	case listingrenewal.FieldRenewedAt:
+		return m.RenewedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ListingRenewalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case listingrenewal.FieldListingID:
+		return m.OldListingID(ctx)
+	case listingrenewal.FieldPreviousExpiresAt:
+		return m.OldPreviousExpiresAt(ctx)
+	case listingrenewal.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case listingrenewal.FieldTermDays:
+		return m.OldTermDays(ctx)
+	case listingrenewal.FieldRenewedAt:
+		return m.OldRenewedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ListingRenewal field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingRenewalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case listingrenewal.FieldListingID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListingID(v)
+		return nil
+	case listingrenewal.FieldPreviousExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousExpiresAt(v)
+		return nil
+	case listingrenewal.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case listingrenewal.FieldTermDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTermDays(v)
+		return nil
+	case listingrenewal.FieldRenewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRenewedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ListingRenewal field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ListingRenewalMutation) AddedFields() []string {
+	var fields []string
+	if m.addterm_days != nil {
+		fields = append(fields, listingrenewal.FieldTermDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ListingRenewalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case listingrenewal.FieldTermDays:
+		return m.AddedTermDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingRenewalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case listingrenewal.FieldTermDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTermDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ListingRenewal numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ListingRenewalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(listingrenewal.FieldPreviousExpiresAt) {
+		fields = append(fields, listingrenewal.FieldPreviousExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ListingRenewalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ListingRenewalMutation) ClearField(name string) error {
+	switch name {
+	case listingrenewal.FieldPreviousExpiresAt:
+		m.ClearPreviousExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingRenewal nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ListingRenewalMutation) ResetField(name string) error {
+	switch name {
+	case listingrenewal.FieldListingID:
+		m.ResetListingID()
+		return nil
+	case listingrenewal.FieldPreviousExpiresAt:
+		m.ResetPreviousExpiresAt()
+		return nil
+	case listingrenewal.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case listingrenewal.FieldTermDays:
+		m.ResetTermDays()
+		return nil
+	case listingrenewal.FieldRenewedAt:
+		m.ResetRenewedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingRenewal field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ListingRenewalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.listing != nil {
+		edges = append(edges, listingrenewal.EdgeListing)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ListingRenewalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case listingrenewal.EdgeListing:
+		if id := m.listing; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ListingRenewalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ListingRenewalMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ListingRenewalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlisting {
+		edges = append(edges, listingrenewal.EdgeListing)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ListingRenewalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case listingrenewal.EdgeListing:
+		return m.clearedlisting
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ListingRenewalMutation) ClearEdge(name string) error {
+	switch name {
+	case listingrenewal.EdgeListing:
+		m.ClearListing()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingRenewal unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ListingRenewalMutation) ResetEdge(name string) error {
+	switch name {
+	case listingrenewal.EdgeListing:
+		m.ResetListing()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingRenewal edge %s", name)
+}
+
+// ListingReviewMutation represents an operation that mutates the ListingReview nodes in the graph.
+type ListingReviewMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	kind           *listingreview.Kind
+	status         *listingreview.Status
+	changes        *schematype.ListingChanges
+	submitted_by   *uuid.UUID
+	decided_by     *uuid.UUID
+	decided_at     *time.Time
+	reasons        *[]string
+	appendreasons  []string
+	note           *string
+	clearedFields  map[string]struct{}
+	listing        *uuid.UUID
+	clearedlisting bool
+	done           bool
+	oldValue       func(context.Context) (*ListingReview, error)
+	predicates     []predicate.ListingReview
+}
+
+var _ ent.Mutation = (*ListingReviewMutation)(nil)
+
+// listingreviewOption allows management of the mutation configuration using functional options.
+type listingreviewOption func(*ListingReviewMutation)
+
+// newListingReviewMutation creates new mutation for the ListingReview entity.
+func newListingReviewMutation(c config, op Op, opts ...listingreviewOption) *ListingReviewMutation {
+	m := &ListingReviewMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeListingReview,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withListingReviewID sets the ID field of the mutation.
+func withListingReviewID(id uuid.UUID) listingreviewOption {
+	return func(m *ListingReviewMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ListingReview
+		)
+		m.oldValue = func(ctx context.Context) (*ListingReview, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ListingReview.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withListingReview sets the old ListingReview of the mutation.
+func withListingReview(node *ListingReview) listingreviewOption {
+	return func(m *ListingReviewMutation) {
+		m.oldValue = func(context.Context) (*ListingReview, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ListingReviewMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ListingReviewMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ListingReview entities.
+func (m *ListingReviewMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ListingReviewMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ListingReviewMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ListingReview.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ListingReviewMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ListingReviewMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ListingReview entity.
+// If the ListingReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingReviewMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ListingReviewMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ListingReviewMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ListingReviewMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ListingReview entity.
+// If the ListingReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingReviewMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ListingReviewMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetListingID sets the "listing_id" field.
+func (m *ListingReviewMutation) SetListingID(u uuid.UUID) {
+	m.listing = &u
+}
+
+// ListingID returns the value of the "listing_id" field in the mutation.
+func (m *ListingReviewMutation) ListingID() (r uuid.UUID, exists bool) {
+	v := m.listing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldListingID returns the old "listing_id" field's value of the ListingReview entity.
+// If the ListingReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingReviewMutation) OldListingID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListingID: %w", err)
+	}
+	return oldValue.ListingID, nil
+}
+
+// ResetListingID resets all changes to the "listing_id" field.
+func (m *ListingReviewMutation) ResetListingID() {
+	m.listing = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *ListingReviewMutation) SetKind(l listingreview.Kind) {
+	m.kind = &l
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ListingReviewMutation) Kind() (r listingreview.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the ListingReview entity.
+// If the ListingReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingReviewMutation) OldKind(ctx context.Context) (v listingreview.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ListingReviewMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ListingReviewMutation) SetStatus(l listingreview.Status) {
+	m.status = &l
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ListingReviewMutation) Status() (r listingreview.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ListingReview entity.
+// If the ListingReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingReviewMutation) OldStatus(ctx context.Context) (v listingreview.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ListingReviewMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *ListingReviewMutation) SetChanges(sc schematype.ListingChanges) {
+	m.changes = &sc
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *ListingReviewMutation) Changes() (r schematype.ListingChanges, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the ListingReview entity.
+// If the ListingReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingReviewMutation) OldChanges(ctx context.Context) (v schematype.ListingChanges, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (m *ListingReviewMutation) ClearChanges() {
+	m.changes = nil
+	m.clearedFields[listingreview.FieldChanges] = struct{}{}
+}
+
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *ListingReviewMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[listingreview.FieldChanges]
+	return ok
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *ListingReviewMutation) ResetChanges() {
+	m.changes = nil
+	delete(m.clearedFields, listingreview.FieldChanges)
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (m *ListingReviewMutation) SetSubmittedBy(u uuid.UUID) {
+	m.submitted_by = &u
+}
+
+// SubmittedBy returns the value of the "submitted_by" field in the mutation.
+func (m *ListingReviewMutation) SubmittedBy() (r uuid.UUID, exists bool) {
+	v := m.submitted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedBy returns the old "submitted_by" field's value of the ListingReview entity.
+// If the ListingReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingReviewMutation) OldSubmittedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedBy: %w", err)
+	}
+	return oldValue.SubmittedBy, nil
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (m *ListingReviewMutation) ClearSubmittedBy() {
+	m.submitted_by = nil
+	m.clearedFields[listingreview.FieldSubmittedBy] = struct{}{}
+}
+
+// SubmittedByCleared returns if the "submitted_by" field was cleared in this mutation.
+func (m *ListingReviewMutation) SubmittedByCleared() bool {
+	_, ok := m.clearedFields[listingreview.FieldSubmittedBy]
+	return ok
+}
+
+// ResetSubmittedBy resets all changes to the "submitted_by" field.
+func (m *ListingReviewMutation) ResetSubmittedBy() {
+	m.submitted_by = nil
+	delete(m.clearedFields, listingreview.FieldSubmittedBy)
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (m *ListingReviewMutation) SetDecidedBy(u uuid.UUID) {
+	m.decided_by = &u
+}
+
+// DecidedBy returns the value of the "decided_by" field in the mutation.
+func (m *ListingReviewMutation) DecidedBy() (r uuid.UUID, exists bool) {
+	v := m.decided_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecidedBy returns the old "decided_by" field's value of the ListingReview entity.
+// If the ListingReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingReviewMutation) OldDecidedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedBy: %w", err)
+	}
+	return oldValue.DecidedBy, nil
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (m *ListingReviewMutation) ClearDecidedBy() {
+	m.decided_by = nil
+	m.clearedFields[listingreview.FieldDecidedBy] = struct{}{}
+}
+
+// DecidedByCleared returns if the "decided_by" field was cleared in this mutation.
+func (m *ListingReviewMutation) DecidedByCleared() bool {
+	_, ok := m.clearedFields[listingreview.FieldDecidedBy]
+	return ok
+}
+
+// ResetDecidedBy resets all changes to the "decided_by" field.
+func (m *ListingReviewMutation) ResetDecidedBy() {
+	m.decided_by = nil
+	delete(m.clearedFields, listingreview.FieldDecidedBy)
+}
+
+// SetDecidedAt sets the "decided_at" field.
+func (m *ListingReviewMutation) SetDecidedAt(t time.Time) {
+	m.decided_at = &t
+}
+
+// DecidedAt returns the value of the "decided_at" field in the mutation.
+func (m *ListingReviewMutation) DecidedAt() (r time.Time, exists bool) {
+	v := m.decided_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecidedAt returns the old "decided_at" field's value of the ListingReview entity.
+// If the ListingReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingReviewMutation) OldDecidedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedAt: %w", err)
+	}
+	return oldValue.DecidedAt, nil
+}
+
+// ClearDecidedAt clears the value of the "decided_at" field.
+func (m *ListingReviewMutation) ClearDecidedAt() {
+	m.decided_at = nil
+	m.clearedFields[listingreview.FieldDecidedAt] = struct{}{}
+}
+
+// DecidedAtCleared returns if the "decided_at" field was cleared in this mutation.
+func (m *ListingReviewMutation) DecidedAtCleared() bool {
+	_, ok := m.clearedFields[listingreview.FieldDecidedAt]
+	return ok
+}
+
+// ResetDecidedAt resets all changes to the "decided_at" field.
+func (m *ListingReviewMutation) ResetDecidedAt() {
+	m.decided_at = nil
+	delete(m.clearedFields, listingreview.FieldDecidedAt)
+}
+
+// SetReasons sets the "reasons" field.
+func (m *ListingReviewMutation) SetReasons(s []string) {
+	m.reasons = &s
+	m.appendreasons = nil
+}
+
+// Reasons returns the value of the "reasons" field in the mutation.
+func (m *ListingReviewMutation) Reasons() (r []string, exists bool) {
+	v := m.reasons
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReasons returns the old "reasons" field's value of the ListingReview entity.
+// If the ListingReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingReviewMutation) OldReasons(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReasons is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReasons requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReasons: %w", err)
+	}
+	return oldValue.Reasons, nil
+}
+
+// AppendReasons adds s to the "reasons" field.
+func (m *ListingReviewMutation) AppendReasons(s []string) {
+	m.appendreasons = append(m.appendreasons, s...)
+}
+
+// AppendedReasons returns the list of values that were appended to the "reasons" field in this mutation.
+func (m *ListingReviewMutation) AppendedReasons() ([]string, bool) {
+	if len(m.appendreasons) == 0 {
+		return nil, false
+	}
+	return m.appendreasons, true
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (m *ListingReviewMutation) ClearReasons() {
+	m.reasons = nil
+	m.appendreasons = nil
+	m.clearedFields[listingreview.FieldReasons] = struct{}{}
+}
+
+// ReasonsCleared returns if the "reasons" field was cleared in this mutation.
+func (m *ListingReviewMutation) ReasonsCleared() bool {
+	_, ok := m.clearedFields[listingreview.FieldReasons]
+	return ok
+}
+
+// ResetReasons resets all changes to the "reasons" field.
+func (m *ListingReviewMutation) ResetReasons() {
+	m.reasons = nil
+	m.appendreasons = nil
+	delete(m.clearedFields, listingreview.FieldReasons)
+}
+
+// SetNote sets the "note" field.
+func (m *ListingReviewMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *ListingReviewMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the ListingReview entity.
+// If the ListingReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingReviewMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *ListingReviewMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[listingreview.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *ListingReviewMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[listingreview.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *ListingReviewMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, listingreview.FieldNote)
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (m *ListingReviewMutation) ClearListing() {
+	m.clearedlisting = true
+	m.clearedFields[listingreview.FieldListingID] = struct{}{}
+}
+
+// ListingCleared reports if the "listing" edge to the Listing entity was cleared.
+func (m *ListingReviewMutation) ListingCleared() bool {
+	return m.clearedlisting
+}
+
+// ListingIDs returns the "listing" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ListingID instead. It exists only for internal usage by the builders.
+func (m *ListingReviewMutation) ListingIDs() (ids []uuid.UUID) {
+	if id := m.listing; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetListing resets all changes to the "listing" edge.
+func (m *ListingReviewMutation) ResetListing() {
+	m.listing = nil
+	m.clearedlisting = false
+}
+
+// Where appends a list predicates to the ListingReviewMutation builder.
+func (m *ListingReviewMutation) Where(ps ...predicate.ListingReview) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ListingReviewMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ListingReviewMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ListingReview, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ListingReviewMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ListingReviewMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ListingReview).
+func (m *ListingReviewMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ListingReviewMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, listingreview.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, listingreview.FieldUpdateTime)
+	}
+	if m.listing != nil {
+		fields = append(fields, listingreview.FieldListingID)
+	}
+	if m.kind != nil {
+		fields = append(fields, listingreview.FieldKind)
+	}
+	if m.status != nil {
+		fields = append(fields, listingreview.FieldStatus)
+	}
+	if m.changes != nil {
+		fields = append(fields, listingreview.FieldChanges)
+	}
+	if m.submitted_by != nil {
+		fields = append(fields, listingreview.FieldSubmittedBy)
+	}
+	if m.decided_by != nil {
+		fields = append(fields, listingreview.FieldDecidedBy)
+	}
+	if m.decided_at != nil {
+		fields = append(fields, listingreview.FieldDecidedAt)
+	}
+	if m.reasons != nil {
+		fields = append(fields, listingreview.FieldReasons)
+	}
+	if m.note != nil {
+		fields = append(fields, listingreview.FieldNote)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ListingReviewMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case listingreview.FieldCreateTime:
+		return m.CreateTime()
+	case listingreview.FieldUpdateTime:
+		return m.UpdateTime()
+	case listingreview.FieldListingID:
+		return m.ListingID()
+	case listingreview.FieldKind:
+		return m.Kind()
+	case listingreview.FieldStatus:
+		return m.Status()
+	case listingreview.FieldChanges:
+		return m.Changes()
+	case listingreview.FieldSubmittedBy:
+		return m.SubmittedBy()
+	case listingreview.FieldDecidedBy:
+		return m.DecidedBy()
+	case listingreview.FieldDecidedAt:
+		return m.DecidedAt()
+	case listingreview.FieldReasons:
+		return m.Reasons()
+	case listingreview.FieldNote:
+		return m.Note()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ListingReviewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case listingreview.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case listingreview.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case listingreview.FieldListingID:
+		return m.OldListingID(ctx)
+	case listingreview.FieldKind:
+		return m.OldKind(ctx)
+	case listingreview.FieldStatus:
+		return m.OldStatus(ctx)
+	case listingreview.FieldChanges:
+		return m.OldChanges(ctx)
+	case listingreview.FieldSubmittedBy:
+		return m.OldSubmittedBy(ctx)
+	case listingreview.FieldDecidedBy:
+		return m.OldDecidedBy(ctx)
+	case listingreview.FieldDecidedAt:
+		return m.OldDecidedAt(ctx)
+	case listingreview.FieldReasons:
+		return m.OldReasons(ctx)
+	case listingreview.FieldNote:
+		return m.OldNote(ctx)
+	}
+	return nil, fmt.Errorf("unknown ListingReview field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingReviewMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case listingreview.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case listingreview.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case listingreview.FieldListingID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListingID(v)
+		return nil
+	case listingreview.FieldKind:
+		v, ok := value.(listingreview.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case listingreview.FieldStatus:
+		v, ok := value.(listingreview.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case listingreview.FieldChanges:
+		v, ok := value.(schematype.ListingChanges)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case listingreview.FieldSubmittedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedBy(v)
+		return nil
+	case listingreview.FieldDecidedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecidedBy(v)
+		return nil
+	case listingreview.FieldDecidedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecidedAt(v)
+		return nil
+	case listingreview.FieldReasons:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReasons(v)
+		return nil
+	case listingreview.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ListingReview field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ListingReviewMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ListingReviewMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingReviewMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ListingReview numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ListingReviewMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(listingreview.FieldChanges) {
+		fields = append(fields, listingreview.FieldChanges)
+	}
+	if m.FieldCleared(listingreview.FieldSubmittedBy) {
+		fields = append(fields, listingreview.FieldSubmittedBy)
+	}
+	if m.FieldCleared(listingreview.FieldDecidedBy) {
+		fields = append(fields, listingreview.FieldDecidedBy)
+	}
+	if m.FieldCleared(listingreview.FieldDecidedAt) {
+		fields = append(fields, listingreview.FieldDecidedAt)
+	}
+	if m.FieldCleared(listingreview.FieldReasons) {
+		fields = append(fields, listingreview.FieldReasons)
+	}
+	if m.FieldCleared(listingreview.FieldNote) {
+		fields = append(fields, listingreview.FieldNote)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ListingReviewMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ListingReviewMutation) ClearField(name string) error {
+	switch name {
+	case listingreview.FieldChanges:
+		m.ClearChanges()
+		return nil
+	case listingreview.FieldSubmittedBy:
+		m.ClearSubmittedBy()
+		return nil
+	case listingreview.FieldDecidedBy:
+		m.ClearDecidedBy()
+		return nil
+	case listingreview.FieldDecidedAt:
+		m.ClearDecidedAt()
+		return nil
+	case listingreview.FieldReasons:
+		m.ClearReasons()
+		return nil
+	case listingreview.FieldNote:
+		m.ClearNote()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingReview nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ListingReviewMutation) ResetField(name string) error {
+	switch name {
+	case listingreview.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case listingreview.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case listingreview.FieldListingID:
+		m.ResetListingID()
+		return nil
+	case listingreview.FieldKind:
+		m.ResetKind()
+		return nil
+	case listingreview.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case listingreview.FieldChanges:
+		m.ResetChanges()
+		return nil
+	case listingreview.FieldSubmittedBy:
+		m.ResetSubmittedBy()
+		return nil
+	case listingreview.FieldDecidedBy:
+		m.ResetDecidedBy()
+		return nil
+	case listingreview.FieldDecidedAt:
+		m.ResetDecidedAt()
+		return nil
+	case listingreview.FieldReasons:
+		m.ResetReasons()
+		return nil
+	case listingreview.FieldNote:
+		m.ResetNote()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingReview field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ListingReviewMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.listing != nil {
+		edges = append(edges, listingreview.EdgeListing)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ListingReviewMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case listingreview.EdgeListing:
+		if id := m.listing; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ListingReviewMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ListingReviewMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ListingReviewMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlisting {
+		edges = append(edges, listingreview.EdgeListing)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ListingReviewMutation) EdgeCleared(name string) bool {
+	switch name {
+	case listingreview.EdgeListing:
+		return m.clearedlisting
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ListingReviewMutation) ClearEdge(name string) error {
+	switch name {
+	case listingreview.EdgeListing:
+		m.ClearListing()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingReview unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ListingReviewMutation) ResetEdge(name string) error {
+	switch name {
+	case listingreview.EdgeListing:
+		m.ResetListing()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingReview edge %s", name)
+}
+
+// ListingStatusEventMutation represents an operation that mutates the ListingStatusEvent nodes in the graph.
+type ListingStatusEventMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	from_status    *string
+	to_status      *string
+	changed_by     *uuid.UUID
+	changed_at     *time.Time
+	clearedFields  map[string]struct{}
+	listing        *uuid.UUID
+	clearedlisting bool
+	done           bool
+	oldValue       func(context.Context) (*ListingStatusEvent, error)
+	predicates     []predicate.ListingStatusEvent
+}
+
+var _ ent.Mutation = (*ListingStatusEventMutation)(nil)
+
+// listingstatuseventOption allows management of the mutation configuration using functional options.
+type listingstatuseventOption func(*ListingStatusEventMutation)
+
+// newListingStatusEventMutation creates new mutation for the ListingStatusEvent entity.
+func newListingStatusEventMutation(c config, op Op, opts ...listingstatuseventOption) *ListingStatusEventMutation {
+	m := &ListingStatusEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeListingStatusEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withListingStatusEventID sets the ID field of the mutation.
+func withListingStatusEventID(id uuid.UUID) listingstatuseventOption {
+	return func(m *ListingStatusEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ListingStatusEvent
+		)
+		m.oldValue = func(ctx context.Context) (*ListingStatusEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ListingStatusEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withListingStatusEvent sets the old ListingStatusEvent of the mutation.
+func withListingStatusEvent(node *ListingStatusEvent) listingstatuseventOption {
+	return func(m *ListingStatusEventMutation) {
+		m.oldValue = func(context.Context) (*ListingStatusEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ListingStatusEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ListingStatusEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ListingStatusEvent entities.
+func (m *ListingStatusEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ListingStatusEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ListingStatusEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ListingStatusEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetListingID sets the "listing_id" field.
+func (m *ListingStatusEventMutation) SetListingID(u uuid.UUID) {
+	m.listing = &u
+}
+
+// ListingID returns the value of the "listing_id" field in the mutation.
+func (m *ListingStatusEventMutation) ListingID() (r uuid.UUID, exists bool) {
+	v := m.listing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldListingID returns the old "listing_id" field's value of the ListingStatusEvent entity.
+// If the ListingStatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingStatusEventMutation) OldListingID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListingID: %w", err)
+	}
+	return oldValue.ListingID, nil
+}
+
+// ResetListingID resets all changes to the "listing_id" field.
+func (m *ListingStatusEventMutation) ResetListingID() {
+	m.listing = nil
+}
+
+// SetFromStatus sets the "from_status" field.
+func (m *ListingStatusEventMutation) SetFromStatus(s string) {
+	m.from_status = &s
+}
+
+// FromStatus returns the value of the "from_status" field in the mutation.
+func (m *ListingStatusEventMutation) FromStatus() (r string, exists bool) {
+	v := m.from_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromStatus returns the old "from_status" field's value of the ListingStatusEvent entity.
+// If the ListingStatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingStatusEventMutation) OldFromStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromStatus: %w", err)
+	}
+	return oldValue.FromStatus, nil
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (m *ListingStatusEventMutation) ClearFromStatus() {
+	m.from_status = nil
+	m.clearedFields[listingstatusevent.FieldFromStatus] = struct{}{}
+}
+
+// FromStatusCleared returns if the "from_status" field was cleared in this mutation.
+func (m *ListingStatusEventMutation) FromStatusCleared() bool {
+	_, ok := m.clearedFields[listingstatusevent.FieldFromStatus]
+	return ok
+}
+
+// ResetFromStatus resets all changes to the "from_status" field.
+func (m *ListingStatusEventMutation) ResetFromStatus() {
+	m.from_status = nil
+	delete(m.clearedFields, listingstatusevent.FieldFromStatus)
+}
+
+// SetToStatus sets the "to_status" field.
+func (m *ListingStatusEventMutation) SetToStatus(s string) {
+	m.to_status = &s
+}
+
+// ToStatus returns the value of the "to_status" field in the mutation.
+func (m *ListingStatusEventMutation) ToStatus() (r string, exists bool) {
+	v := m.to_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToStatus returns the old "to_status" field's value of the ListingStatusEvent entity.
+// If the ListingStatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingStatusEventMutation) OldToStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToStatus: %w", err)
+	}
+	return oldValue.ToStatus, nil
+}
+
+// ResetToStatus resets all changes to the "to_status" field.
+func (m *ListingStatusEventMutation) ResetToStatus() {
+	m.to_status = nil
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (m *ListingStatusEventMutation) SetChangedBy(u uuid.UUID) {
+	m.changed_by = &u
+}
+
+// ChangedBy returns the value of the "changed_by" field in the mutation.
+func (m *ListingStatusEventMutation) ChangedBy() (r uuid.UUID, exists bool) {
+	v := m.changed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedBy returns the old "changed_by" field's value of the ListingStatusEvent entity.
+// If the ListingStatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingStatusEventMutation) OldChangedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedBy: %w", err)
+	}
+	return oldValue.ChangedBy, nil
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (m *ListingStatusEventMutation) ClearChangedBy() {
+	m.changed_by = nil
+	m.clearedFields[listingstatusevent.FieldChangedBy] = struct{}{}
+}
+
+// ChangedByCleared returns if the "changed_by" field was cleared in this mutation.
+func (m *ListingStatusEventMutation) ChangedByCleared() bool {
+	_, ok := m.clearedFields[listingstatusevent.FieldChangedBy]
+	return ok
+}
+
+// ResetChangedBy resets all changes to the "changed_by" field.
+func (m *ListingStatusEventMutation) ResetChangedBy() {
+	m.changed_by = nil
+	delete(m.clearedFields, listingstatusevent.FieldChangedBy)
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (m *ListingStatusEventMutation) SetChangedAt(t time.Time) {
+	m.changed_at = &t
+}
+
+// ChangedAt returns the value of the "changed_at" field in the mutation.
+func (m *ListingStatusEventMutation) ChangedAt() (r time.Time, exists bool) {
+	v := m.changed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedAt returns the old "changed_at" field's value of the ListingStatusEvent entity.
+// If the ListingStatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ListingStatusEventMutation) OldChangedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedAt: %w", err)
+	}
+	return oldValue.ChangedAt, nil
+}
+
+// ResetChangedAt resets all changes to the "changed_at" field.
+func (m *ListingStatusEventMutation) ResetChangedAt() {
+	m.changed_at = nil
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (m *ListingStatusEventMutation) ClearListing() {
+	m.clearedlisting = true
+	m.clearedFields[listingstatusevent.FieldListingID] = struct{}{}
+}
+
+// ListingCleared reports if the "listing" edge to the Listing entity was cleared.
+func (m *ListingStatusEventMutation) ListingCleared() bool {
+	return m.clearedlisting
+}
+
+// ListingIDs returns the "listing" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ListingID instead. It exists only for internal usage by the builders.
+func (m *ListingStatusEventMutation) ListingIDs() (ids []uuid.UUID) {
+	if id := m.listing; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetListing resets all changes to the "listing" edge.
+func (m *ListingStatusEventMutation) ResetListing() {
+	m.listing = nil
+	m.clearedlisting = false
+}
+
+// Where appends a list predicates to the ListingStatusEventMutation builder.
+func (m *ListingStatusEventMutation) Where(ps ...predicate.ListingStatusEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ListingStatusEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ListingStatusEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ListingStatusEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ListingStatusEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ListingStatusEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ListingStatusEvent).
+func (m *ListingStatusEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ListingStatusEventMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.listing != nil {
+		fields = append(fields, listingstatusevent.FieldListingID)
+	}
+	if m.from_status != nil {
+		fields = append(fields, listingstatusevent.FieldFromStatus)
+	}
+	if m.to_status != nil {
+		fields = append(fields, listingstatusevent.FieldToStatus)
+	}
+	if m.changed_by != nil {
+		fields = append(fields, listingstatusevent.FieldChangedBy)
+	}
+	if m.changed_at != nil {
+		fields = append(fields, listingstatusevent.FieldChangedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ListingStatusEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case listingstatusevent.FieldListingID:
+		return m.ListingID()
+	case listingstatusevent.FieldFromStatus:
+		return m.FromStatus()
+	case listingstatusevent.FieldToStatus:
+		return m.ToStatus()
+	case listingstatusevent.FieldChangedBy:
+		return m.ChangedBy()
+	case listingstatusevent.FieldChangedAt:
+		return m.ChangedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ListingStatusEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case listingstatusevent.FieldListingID:
+		return m.OldListingID(ctx)
+	case listingstatusevent.FieldFromStatus:
+		return m.OldFromStatus(ctx)
+	case listingstatusevent.FieldToStatus:
+		return m.OldToStatus(ctx)
+	case listingstatusevent.FieldChangedBy:
+		return m.OldChangedBy(ctx)
+	case listingstatusevent.FieldChangedAt:
+		return m.OldChangedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ListingStatusEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingStatusEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case listingstatusevent.FieldListingID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListingID(v)
+		return nil
+	case listingstatusevent.FieldFromStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromStatus(v)
+		return nil
+	case listingstatusevent.FieldToStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToStatus(v)
+		return nil
+	case listingstatusevent.FieldChangedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedBy(v)
+		return nil
+	case listingstatusevent.FieldChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ListingStatusEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ListingStatusEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ListingStatusEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ListingStatusEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ListingStatusEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ListingStatusEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(listingstatusevent.FieldFromStatus) {
+		fields = append(fields, listingstatusevent.FieldFromStatus)
+	}
+	if m.FieldCleared(listingstatusevent.FieldChangedBy) {
+		fields = append(fields, listingstatusevent.FieldChangedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ListingStatusEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ListingStatusEventMutation) ClearField(name string) error {
+	switch name {
+	case listingstatusevent.FieldFromStatus:
+		m.ClearFromStatus()
+		return nil
+	case listingstatusevent.FieldChangedBy:
+		m.ClearChangedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingStatusEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ListingStatusEventMutation) ResetField(name string) error {
+	switch name {
+	case listingstatusevent.FieldListingID:
+		m.ResetListingID()
+		return nil
+	case listingstatusevent.FieldFromStatus:
+		m.ResetFromStatus()
+		return nil
+	case listingstatusevent.FieldToStatus:
+		m.ResetToStatus()
+		return nil
+	case listingstatusevent.FieldChangedBy:
+		m.ResetChangedBy()
+		return nil
+	case listingstatusevent.FieldChangedAt:
+		m.ResetChangedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingStatusEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ListingStatusEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.listing != nil {
+		edges = append(edges, listingstatusevent.EdgeListing)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ListingStatusEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case listingstatusevent.EdgeListing:
+		if id := m.listing; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ListingStatusEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ListingStatusEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ListingStatusEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlisting {
+		edges = append(edges, listingstatusevent.EdgeListing)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ListingStatusEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case listingstatusevent.EdgeListing:
+		return m.clearedlisting
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ListingStatusEventMutation) ClearEdge(name string) error {
+	switch name {
+	case listingstatusevent.EdgeListing:
+		m.ClearListing()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingStatusEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ListingStatusEventMutation) ResetEdge(name string) error {
+	switch name {
+	case listingstatusevent.EdgeListing:
+		m.ResetListing()
+		return nil
+	}
+	return fmt.Errorf("unknown ListingStatusEvent edge %s", name)
+}
+
+// NeighborhoodMutation represents an operation that mutates the Neighborhood nodes in the graph.
+type NeighborhoodMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	create_time      *time.Time
+	update_time      *time.Time
+	name             *string
+	city             *string
+	state            *string
+	country          *string
+	boundary         *schematype.MultiPolygon
+	appendboundary   schematype.MultiPolygon
+	min_latitude     *float64
+	addmin_latitude  *float64
+	max_latitude     *float64
+	addmax_latitude  *float64
+	min_longitude    *float64
+	addmin_longitude *float64
+	max_longitude    *float64
+	addmax_longitude *float64
+	clearedFields    map[string]struct{}
+	listings         map[uuid.UUID]struct{}
+	removedlistings  map[uuid.UUID]struct{}
+	clearedlistings  bool
+	done             bool
+	oldValue         func(context.Context) (*Neighborhood, error)
+	predicates       []predicate.Neighborhood
+}
+
+var _ ent.Mutation = (*NeighborhoodMutation)(nil)
+
+// neighborhoodOption allows management of the mutation configuration using functional options.
+type neighborhoodOption func(*NeighborhoodMutation)
+
+// newNeighborhoodMutation creates new mutation for the Neighborhood entity.
+func newNeighborhoodMutation(c config, op Op, opts ...neighborhoodOption) *NeighborhoodMutation {
+	m := &NeighborhoodMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNeighborhood,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNeighborhoodID sets the ID field of the mutation.
+func withNeighborhoodID(id uuid.UUID) neighborhoodOption {
+	return func(m *NeighborhoodMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Neighborhood
+		)
+		m.oldValue = func(ctx context.Context) (*Neighborhood, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Neighborhood.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNeighborhood sets the old Neighborhood of the mutation.
+func withNeighborhood(node *Neighborhood) neighborhoodOption {
+	return func(m *NeighborhoodMutation) {
+		m.oldValue = func(context.Context) (*Neighborhood, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NeighborhoodMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NeighborhoodMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Neighborhood entities.
+func (m *NeighborhoodMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NeighborhoodMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NeighborhoodMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Neighborhood.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *NeighborhoodMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *NeighborhoodMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Neighborhood entity.
+// If the Neighborhood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NeighborhoodMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *NeighborhoodMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *NeighborhoodMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *NeighborhoodMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Neighborhood entity.
+// If the Neighborhood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NeighborhoodMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *NeighborhoodMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *NeighborhoodMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NeighborhoodMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Neighborhood entity.
+// If the Neighborhood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NeighborhoodMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *NeighborhoodMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCity sets the "city" field.
+func (m *NeighborhoodMutation) SetCity(s string) {
+	m.city = &s
+}
+
+// City returns the value of the "city" field in the mutation.
+func (m *NeighborhoodMutation) City() (r string, exists bool) {
+	v := m.city
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCity returns the old "city" field's value of the Neighborhood entity.
+// If the Neighborhood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NeighborhoodMutation) OldCity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCity: %w", err)
+	}
+	return oldValue.City, nil
+}
+
+// ResetCity resets all changes to the "city" field.
+func (m *NeighborhoodMutation) ResetCity() {
+	m.city = nil
+}
+
+// SetState sets the "state" field.
+func (m *NeighborhoodMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *NeighborhoodMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Neighborhood entity.
+// If the Neighborhood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NeighborhoodMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *NeighborhoodMutation) ResetState() {
+	m.state = nil
+}
+
+// SetCountry sets the "country" field.
+func (m *NeighborhoodMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *NeighborhoodMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountry returns the old "country" field's value of the Neighborhood entity.
+// If the Neighborhood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NeighborhoodMutation) OldCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
+}
+
+// ResetCountry resets all changes to the "country" field.
+func (m *NeighborhoodMutation) ResetCountry() {
+	m.country = nil
+}
+
+// SetBoundary sets the "boundary" field.
+func (m *NeighborhoodMutation) SetBoundary(sp schematype.MultiPolygon) {
+	m.boundary = &sp
+	m.appendboundary = nil
+}
+
+// Boundary returns the value of the "boundary" field in the mutation.
+func (m *NeighborhoodMutation) Boundary() (r schematype.MultiPolygon, exists bool) {
+	v := m.boundary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoundary returns the old "boundary" field's value of the Neighborhood entity.
+// If the Neighborhood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NeighborhoodMutation) OldBoundary(ctx context.Context) (v schematype.MultiPolygon, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoundary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoundary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoundary: %w", err)
+	}
+	return oldValue.Boundary, nil
+}
+
+// AppendBoundary adds sp to the "boundary" field.
+func (m *NeighborhoodMutation) AppendBoundary(sp schematype.MultiPolygon) {
+	m.appendboundary = append(m.appendboundary, sp...)
+}
+
+// AppendedBoundary returns the list of values that were appended to the "boundary" field in this mutation.
+func (m *NeighborhoodMutation) AppendedBoundary() (schematype.MultiPolygon, bool) {
+	if len(m.appendboundary) == 0 {
+		return nil, false
+	}
+	return m.appendboundary, true
+}
+
+// ResetBoundary resets all changes to the "boundary" field.
+func (m *NeighborhoodMutation) ResetBoundary() {
+	m.boundary = nil
+	m.appendboundary = nil
+}
+
+// SetMinLatitude sets the "min_latitude" field.
+func (m *NeighborhoodMutation) SetMinLatitude(f float64) {
+	m.min_latitude = &f
+	m.addmin_latitude = nil
+}
+
+// MinLatitude returns the value of the "min_latitude" field in the mutation.
+func (m *NeighborhoodMutation) MinLatitude() (r float64, exists bool) {
+	v := m.min_latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinLatitude returns the old "min_latitude" field's value of the Neighborhood entity.
+// If the Neighborhood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NeighborhoodMutation) OldMinLatitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinLatitude: %w", err)
+	}
+	return oldValue.MinLatitude, nil
+}
+
+// AddMinLatitude adds f to the "min_latitude" field.
+func (m *NeighborhoodMutation) AddMinLatitude(f float64) {
+	if m.addmin_latitude != nil {
+		*m.addmin_latitude += f
+	} else {
+		m.addmin_latitude = &f
+	}
+}
+
+// AddedMinLatitude returns the value that was added to the "min_latitude" field in this mutation.
+func (m *NeighborhoodMutation) AddedMinLatitude() (r float64, exists bool) {
+	v := m.addmin_latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinLatitude resets all changes to the "min_latitude" field.
+func (m *NeighborhoodMutation) ResetMinLatitude() {
+	m.min_latitude = nil
+	m.addmin_latitude = nil
+}
+
+// SetMaxLatitude sets the "max_latitude" field.
+func (m *NeighborhoodMutation) SetMaxLatitude(f float64) {
+	m.max_latitude = &f
+	m.addmax_latitude = nil
+}
+
+// MaxLatitude returns the value of the "max_latitude" field in the mutation.
+func (m *NeighborhoodMutation) MaxLatitude() (r float64, exists bool) {
+	v := m.max_latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxLatitude returns the old "max_latitude" field's value of the Neighborhood entity.
+// If the Neighborhood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NeighborhoodMutation) OldMaxLatitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxLatitude: %w", err)
+	}
+	return oldValue.MaxLatitude, nil
+}
+
+// AddMaxLatitude adds f to the "max_latitude" field.
+func (m *NeighborhoodMutation) AddMaxLatitude(f float64) {
+	if m.addmax_latitude != nil {
+		*m.addmax_latitude += f
+	} else {
+		m.addmax_latitude = &f
+	}
+}
+
+// AddedMaxLatitude returns the value that was added to the "max_latitude" field in this mutation.
+func (m *NeighborhoodMutation) AddedMaxLatitude() (r float64, exists bool) {
+	v := m.addmax_latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxLatitude resets all changes to the "max_latitude" field.
+func (m *NeighborhoodMutation) ResetMaxLatitude() {
+	m.max_latitude = nil
+	m.addmax_latitude = nil
+}
+
+// SetMinLongitude sets the "min_longitude" field.
+func (m *NeighborhoodMutation) SetMinLongitude(f float64) {
+	m.min_longitude = &f
+	m.addmin_longitude = nil
+}
+
+// MinLongitude returns the value of the "min_longitude" field in the mutation.
+func (m *NeighborhoodMutation) MinLongitude() (r float64, exists bool) {
+	v := m.min_longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinLongitude returns the old "min_longitude" field's value of the Neighborhood entity.
+// If the Neighborhood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NeighborhoodMutation) OldMinLongitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinLongitude: %w", err)
+	}
+	return oldValue.MinLongitude, nil
+}
+
+// AddMinLongitude adds f to the "min_longitude" field.
+func (m *NeighborhoodMutation) AddMinLongitude(f float64) {
+	if m.addmin_longitude != nil {
+		*m.addmin_longitude += f
+	} else {
+		m.addmin_longitude = &f
+	}
+}
+
+// AddedMinLongitude returns the value that was added to the "min_longitude" field in this mutation.
+func (m *NeighborhoodMutation) AddedMinLongitude() (r float64, exists bool) {
+	v := m.addmin_longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinLongitude resets all changes to the "min_longitude" field.
+func (m *NeighborhoodMutation) ResetMinLongitude() {
+	m.min_longitude = nil
+	m.addmin_longitude = nil
+}
+
+// SetMaxLongitude sets the "max_longitude" field.
+func (m *NeighborhoodMutation) SetMaxLongitude(f float64) {
+	m.max_longitude = &f
+	m.addmax_longitude = nil
+}
+
+// MaxLongitude returns the value of the "max_longitude" field in the mutation.
+func (m *NeighborhoodMutation) MaxLongitude() (r float64, exists bool) {
+	v := m.max_longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxLongitude returns the old "max_longitude" field's value of the Neighborhood entity.
+// If the Neighborhood object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NeighborhoodMutation) OldMaxLongitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxLongitude: %w", err)
+	}
+	return oldValue.MaxLongitude, nil
+}
+
+// AddMaxLongitude adds f to the "max_longitude" field.
+func (m *NeighborhoodMutation) AddMaxLongitude(f float64) {
+	if m.addmax_longitude != nil {
+		*m.addmax_longitude += f
+	} else {
+		m.addmax_longitude = &f
+	}
+}
+
+// AddedMaxLongitude returns the value that was added to the "max_longitude" field in this mutation.
+func (m *NeighborhoodMutation) AddedMaxLongitude() (r float64, exists bool) {
+	v := m.addmax_longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxLongitude resets all changes to the "max_longitude" field.
+func (m *NeighborhoodMutation) ResetMaxLongitude() {
+	m.max_longitude = nil
+	m.addmax_longitude = nil
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by ids.
+func (m *NeighborhoodMutation) AddListingIDs(ids ...uuid.UUID) {
+	if m.listings == nil {
+		m.listings = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.listings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearListings clears the "listings" edge to the Listing entity.
+func (m *NeighborhoodMutation) ClearListings() {
+	m.clearedlistings = true
+}
+
+// ListingsCleared reports if the "listings" edge to the Listing entity was cleared.
+func (m *NeighborhoodMutation) ListingsCleared() bool {
+	return m.clearedlistings
+}
+
+// RemoveListingIDs removes the "listings" edge to the Listing entity by IDs.
+func (m *NeighborhoodMutation) RemoveListingIDs(ids ...uuid.UUID) {
+	if m.removedlistings == nil {
+		m.removedlistings = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.listings, ids[i])
+		m.removedlistings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedListings returns the removed IDs of the "listings" edge to the Listing entity.
+func (m *NeighborhoodMutation) RemovedListingsIDs() (ids []uuid.UUID) {
+	for id := range m.removedlistings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ListingsIDs returns the "listings" edge IDs in the mutation.
+func (m *NeighborhoodMutation) ListingsIDs() (ids []uuid.UUID) {
+	for id := range m.listings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetListings resets all changes to the "listings" edge.
+func (m *NeighborhoodMutation) ResetListings() {
+	m.listings = nil
+	m.clearedlistings = false
+	m.removedlistings = nil
+}
+
+// Where appends a list predicates to the NeighborhoodMutation builder.
+func (m *NeighborhoodMutation) Where(ps ...predicate.Neighborhood) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NeighborhoodMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NeighborhoodMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Neighborhood, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NeighborhoodMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NeighborhoodMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Neighborhood).
+func (m *NeighborhoodMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NeighborhoodMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, neighborhood.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, neighborhood.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, neighborhood.FieldName)
+	}
+	if m.city != nil {
+		fields = append(fields, neighborhood.FieldCity)
+	}
+	if m.state != nil {
+		fields = append(fields, neighborhood.FieldState)
+	}
+	if m.country != nil {
+		fields = append(fields, neighborhood.FieldCountry)
+	}
+	if m.boundary != nil {
+		fields = append(fields, neighborhood.FieldBoundary)
+	}
+	if m.min_latitude != nil {
+		fields = append(fields, neighborhood.FieldMinLatitude)
+	}
+	if m.max_latitude != nil {
+		fields = append(fields, neighborhood.FieldMaxLatitude)
+	}
+	if m.min_longitude != nil {
+		fields = append(fields, neighborhood.FieldMinLongitude)
+	}
+	if m.max_longitude != nil {
+		fields = append(fields, neighborhood.FieldMaxLongitude)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NeighborhoodMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case neighborhood.FieldCreateTime:
+		return m.CreateTime()
+	case neighborhood.FieldUpdateTime:
+		return m.UpdateTime()
+	case neighborhood.FieldName:
+		return m.Name()
+	case neighborhood.FieldCity:
+		return m.City()
+	case neighborhood.FieldState:
+		return m.State()
+	case neighborhood.FieldCountry:
+		return m.Country()
+	case neighborhood.FieldBoundary:
+		return m.Boundary()
+	case neighborhood.FieldMinLatitude:
+		return m.MinLatitude()
+	case neighborhood.FieldMaxLatitude:
+		return m.MaxLatitude()
+	case neighborhood.FieldMinLongitude:
+		return m.MinLongitude()
+	case neighborhood.FieldMaxLongitude:
+		return m.MaxLongitude()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NeighborhoodMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case neighborhood.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case neighborhood.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case neighborhood.FieldName:
+		return m.OldName(ctx)
+	case neighborhood.FieldCity:
+		return m.OldCity(ctx)
+	case neighborhood.FieldState:
+		return m.OldState(ctx)
+	case neighborhood.FieldCountry:
+		return m.OldCountry(ctx)
+	case neighborhood.FieldBoundary:
+		return m.OldBoundary(ctx)
+	case neighborhood.FieldMinLatitude:
+		return m.OldMinLatitude(ctx)
+	case neighborhood.FieldMaxLatitude:
+		return m.OldMaxLatitude(ctx)
+	case neighborhood.FieldMinLongitude:
+		return m.OldMinLongitude(ctx)
+	case neighborhood.FieldMaxLongitude:
+		return m.OldMaxLongitude(ctx)
+	}
+	return nil, fmt.Errorf("unknown Neighborhood field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NeighborhoodMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case neighborhood.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case neighborhood.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case neighborhood.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case neighborhood.FieldCity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCity(v)
+		return nil
+	case neighborhood.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case neighborhood.FieldCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountry(v)
+		return nil
+	case neighborhood.FieldBoundary:
+		v, ok := value.(schematype.MultiPolygon)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoundary(v)
+		return nil
+	case neighborhood.FieldMinLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinLatitude(v)
+		return nil
+	case neighborhood.FieldMaxLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxLatitude(v)
+		return nil
+	case neighborhood.FieldMinLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinLongitude(v)
+		return nil
+	case neighborhood.FieldMaxLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxLongitude(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Neighborhood field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NeighborhoodMutation) AddedFields() []string {
+	var fields []string
+	if m.addmin_latitude != nil {
+		fields = append(fields, neighborhood.FieldMinLatitude)
+	}
+	if m.addmax_latitude != nil {
+		fields = append(fields, neighborhood.FieldMaxLatitude)
+	}
+	if m.addmin_longitude != nil {
+		fields = append(fields, neighborhood.FieldMinLongitude)
+	}
+	if m.addmax_longitude != nil {
+		fields = append(fields, neighborhood.FieldMaxLongitude)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NeighborhoodMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case neighborhood.FieldMinLatitude:
+		return m.AddedMinLatitude()
+	case neighborhood.FieldMaxLatitude:
+		return m.AddedMaxLatitude()
+	case neighborhood.FieldMinLongitude:
+		return m.AddedMinLongitude()
+	case neighborhood.FieldMaxLongitude:
+		return m.AddedMaxLongitude()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NeighborhoodMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case neighborhood.FieldMinLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinLatitude(v)
+		return nil
+	case neighborhood.FieldMaxLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxLatitude(v)
+		return nil
+	case neighborhood.FieldMinLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinLongitude(v)
+		return nil
+	case neighborhood.FieldMaxLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxLongitude(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Neighborhood numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NeighborhoodMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NeighborhoodMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NeighborhoodMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Neighborhood nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NeighborhoodMutation) ResetField(name string) error {
+	switch name {
+	case neighborhood.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case neighborhood.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case neighborhood.FieldName:
+		m.ResetName()
+		return nil
+	case neighborhood.FieldCity:
+		m.ResetCity()
+		return nil
+	case neighborhood.FieldState:
+		m.ResetState()
+		return nil
+	case neighborhood.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case neighborhood.FieldBoundary:
+		m.ResetBoundary()
+		return nil
+	case neighborhood.FieldMinLatitude:
+		m.ResetMinLatitude()
+		return nil
+	case neighborhood.FieldMaxLatitude:
+		m.ResetMaxLatitude()
+		return nil
+	case neighborhood.FieldMinLongitude:
+		m.ResetMinLongitude()
+		return nil
+	case neighborhood.FieldMaxLongitude:
+		m.ResetMaxLongitude()
+		return nil
+	}
+	return fmt.Errorf("unknown Neighborhood field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NeighborhoodMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.listings != nil {
+		edges = append(edges, neighborhood.EdgeListings)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NeighborhoodMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case neighborhood.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.listings))
+		for id := range m.listings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NeighborhoodMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedlistings != nil {
+		edges = append(edges, neighborhood.EdgeListings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NeighborhoodMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case neighborhood.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.removedlistings))
+		for id := range m.removedlistings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NeighborhoodMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlistings {
+		edges = append(edges, neighborhood.EdgeListings)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NeighborhoodMutation) EdgeCleared(name string) bool {
+	switch name {
+	case neighborhood.EdgeListings:
+		return m.clearedlistings
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NeighborhoodMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Neighborhood unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NeighborhoodMutation) ResetEdge(name string) error {
+	switch name {
+	case neighborhood.EdgeListings:
+		m.ResetListings()
+		return nil
+	}
+	return fmt.Errorf("unknown Neighborhood edge %s", name)
+}
+
+// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
+type NotificationMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	create_time     *time.Time
+	update_time     *time.Time
+	recipient_email *string
+	user_id         *uuid.UUID
+	realtor_id      *uuid.UUID
+	kind            *string
+	subject         *string
+	body            *string
+	read_at         *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Notification, error)
+	predicates      []predicate.Notification
+}
+
+var _ ent.Mutation = (*NotificationMutation)(nil)
+
+// notificationOption allows management of the mutation configuration using functional options.
+type notificationOption func(*NotificationMutation)
+
+// newNotificationMutation creates new mutation for the Notification entity.
+func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
+	m := &NotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationID sets the ID field of the mutation.
+func withNotificationID(id uuid.UUID) notificationOption {
+	return func(m *NotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notification
+		)
+		m.oldValue = func(ctx context.Context) (*Notification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotification sets the old Notification of the mutation.
+func withNotification(node *Notification) notificationOption {
+	return func(m *NotificationMutation) {
+		m.oldValue = func(context.Context) (*Notification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Notification entities.
+func (m *NotificationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *NotificationMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *NotificationMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *NotificationMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *NotificationMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *NotificationMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *NotificationMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (m *NotificationMutation) SetRecipientEmail(s string) {
+	m.recipient_email = &s
+}
+
+// RecipientEmail returns the value of the "recipient_email" field in the mutation.
+func (m *NotificationMutation) RecipientEmail() (r string, exists bool) {
+	v := m.recipient_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecipientEmail returns the old "recipient_email" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldRecipientEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecipientEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecipientEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecipientEmail: %w", err)
+	}
+	return oldValue.RecipientEmail, nil
+}
+
+// ResetRecipientEmail resets all changes to the "recipient_email" field.
+func (m *NotificationMutation) ResetRecipientEmail() {
+	m.recipient_email = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *NotificationMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *NotificationMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *NotificationMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[notification.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *NotificationMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[notification.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *NotificationMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, notification.FieldUserID)
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (m *NotificationMutation) SetRealtorID(u uuid.UUID) {
+	m.realtor_id = &u
+}
+
+// RealtorID returns the value of the "realtor_id" field in the mutation.
+func (m *NotificationMutation) RealtorID() (r uuid.UUID, exists bool) {
+	v := m.realtor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRealtorID returns the old "realtor_id" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldRealtorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRealtorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRealtorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRealtorID: %w", err)
+	}
+	return oldValue.RealtorID, nil
+}
+
+// ClearRealtorID clears the value of the "realtor_id" field.
+func (m *NotificationMutation) ClearRealtorID() {
+	m.realtor_id = nil
+	m.clearedFields[notification.FieldRealtorID] = struct{}{}
+}
+
+// RealtorIDCleared returns if the "realtor_id" field was cleared in this mutation.
+func (m *NotificationMutation) RealtorIDCleared() bool {
+	_, ok := m.clearedFields[notification.FieldRealtorID]
+	return ok
+}
+
+// ResetRealtorID resets all changes to the "realtor_id" field.
+func (m *NotificationMutation) ResetRealtorID() {
+	m.realtor_id = nil
+	delete(m.clearedFields, notification.FieldRealtorID)
+}
+
+// SetKind sets the "kind" field.
+func (m *NotificationMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *NotificationMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *NotificationMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *NotificationMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *NotificationMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *NotificationMutation) ResetSubject() {
+	m.subject = nil
+}
+
+// SetBody sets the "body" field.
+func (m *NotificationMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *NotificationMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ClearBody clears the value of the "body" field.
+func (m *NotificationMutation) ClearBody() {
+	m.body = nil
+	m.clearedFields[notification.FieldBody] = struct{}{}
+}
+
+// BodyCleared returns if the "body" field was cleared in this mutation.
+func (m *NotificationMutation) BodyCleared() bool {
+	_, ok := m.clearedFields[notification.FieldBody]
+	return ok
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *NotificationMutation) ResetBody() {
+	m.body = nil
+	delete(m.clearedFields, notification.FieldBody)
+}
+
+// SetReadAt sets the "read_at" field.
+func (m *NotificationMutation) SetReadAt(t time.Time) {
+	m.read_at = &t
+}
+
+// ReadAt returns the value of the "read_at" field in the mutation.
+func (m *NotificationMutation) ReadAt() (r time.Time, exists bool) {
+	v := m.read_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadAt returns the old "read_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldReadAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadAt: %w", err)
+	}
+	return oldValue.ReadAt, nil
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (m *NotificationMutation) ClearReadAt() {
+	m.read_at = nil
+	m.clearedFields[notification.FieldReadAt] = struct{}{}
+}
+
+// ReadAtCleared returns if the "read_at" field was cleared in this mutation.
+func (m *NotificationMutation) ReadAtCleared() bool {
+	_, ok := m.clearedFields[notification.FieldReadAt]
+	return ok
+}
+
+// ResetReadAt resets all changes to the "read_at" field.
+func (m *NotificationMutation) ResetReadAt() {
+	m.read_at = nil
+	delete(m.clearedFields, notification.FieldReadAt)
+}
+
+// Where appends a list predicates to the NotificationMutation builder.
+func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Notification).
+func (m *NotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, notification.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, notification.FieldUpdateTime)
+	}
+	if m.recipient_email != nil {
+		fields = append(fields, notification.FieldRecipientEmail)
+	}
+	if m.user_id != nil {
+		fields = append(fields, notification.FieldUserID)
+	}
+	if m.realtor_id != nil {
+		fields = append(fields, notification.FieldRealtorID)
+	}
+	if m.kind != nil {
+		fields = append(fields, notification.FieldKind)
+	}
+	if m.subject != nil {
+		fields = append(fields, notification.FieldSubject)
+	}
+	if m.body != nil {
+		fields = append(fields, notification.FieldBody)
+	}
+	if m.read_at != nil {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldCreateTime:
+		return m.CreateTime()
+	case notification.FieldUpdateTime:
+		return m.UpdateTime()
+	case notification.FieldRecipientEmail:
+		return m.RecipientEmail()
+	case notification.FieldUserID:
+		return m.UserID()
+	case notification.FieldRealtorID:
+		return m.RealtorID()
+	case notification.FieldKind:
+		return m.Kind()
+	case notification.FieldSubject:
+		return m.Subject()
+	case notification.FieldBody:
+		return m.Body()
+	case notification.FieldReadAt:
+		return m.ReadAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notification.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case notification.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case notification.FieldRecipientEmail:
+		return m.OldRecipientEmail(ctx)
+	case notification.FieldUserID:
+		return m.OldUserID(ctx)
+	case notification.FieldRealtorID:
+		return m.OldRealtorID(ctx)
+	case notification.FieldKind:
+		return m.OldKind(ctx)
+	case notification.FieldSubject:
+		return m.OldSubject(ctx)
+	case notification.FieldBody:
+		return m.OldBody(ctx)
+	case notification.FieldReadAt:
+		return m.OldReadAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case notification.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case notification.FieldRecipientEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecipientEmail(v)
+		return nil
+	case notification.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case notification.FieldRealtorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRealtorID(v)
+		return nil
+	case notification.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case notification.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case notification.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case notification.FieldReadAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Notification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notification.FieldUserID) {
+		fields = append(fields, notification.FieldUserID)
+	}
+	if m.FieldCleared(notification.FieldRealtorID) {
+		fields = append(fields, notification.FieldRealtorID)
+	}
+	if m.FieldCleared(notification.FieldBody) {
+		fields = append(fields, notification.FieldBody)
+	}
+	if m.FieldCleared(notification.FieldReadAt) {
+		fields = append(fields, notification.FieldReadAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationMutation) ClearField(name string) error {
+	switch name {
+	case notification.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case notification.FieldRealtorID:
+		m.ClearRealtorID()
+		return nil
+	case notification.FieldBody:
+		m.ClearBody()
+		return nil
+	case notification.FieldReadAt:
+		m.ClearReadAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationMutation) ResetField(name string) error {
+	switch name {
+	case notification.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case notification.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case notification.FieldRecipientEmail:
+		m.ResetRecipientEmail()
+		return nil
+	case notification.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case notification.FieldRealtorID:
+		m.ResetRealtorID()
+		return nil
+	case notification.FieldKind:
+		m.ResetKind()
+		return nil
+	case notification.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case notification.FieldBody:
+		m.ResetBody()
+		return nil
+	case notification.FieldReadAt:
+		m.ResetReadAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Notification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Notification edge %s", name)
+}
+
+// OfferMutation represents an operation that mutates the Offer nodes in the graph.
+type OfferMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	create_time         *time.Time
+	update_time         *time.Time
+	side                *offer.Side
+	made_by             *uuid.UUID
+	amount              *decimal.Decimal
+	addamount           *decimal.Decimal
+	currency            *string
+	contingencies       *[]string
+	appendcontingencies []string
+	closing_date        *time.Time
+	expires_at          *time.Time
+	message             *string
+	status              *offer.Status
+	responded_at        *time.Time
+	clearedFields       map[string]struct{}
+	listing             *uuid.UUID
+	clearedlisting      bool
+	buyer               *uuid.UUID
+	clearedbuyer        bool
+	previous            *uuid.UUID
+	clearedprevious     bool
+	counters            map[uuid.UUID]struct{}
+	removedcounters     map[uuid.UUID]struct{}
+	clearedcounters     bool
+	done                bool
+	oldValue            func(context.Context) (*Offer, error)
+	predicates          []predicate.Offer
+}
+
+var _ ent.Mutation = (*OfferMutation)(nil)
+
+// offerOption allows management of the mutation configuration using functional options.
+type offerOption func(*OfferMutation)
+
+// newOfferMutation creates new mutation for the Offer entity.
+func newOfferMutation(c config, op Op, opts ...offerOption) *OfferMutation {
+	m := &OfferMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOffer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOfferID sets the ID field of the mutation.
+func withOfferID(id uuid.UUID) offerOption {
+	return func(m *OfferMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Offer
+		)
+		m.oldValue = func(ctx context.Context) (*Offer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Offer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOffer sets the old Offer of the mutation.
+func withOffer(node *Offer) offerOption {
+	return func(m *OfferMutation) {
+		m.oldValue = func(context.Context) (*Offer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OfferMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OfferMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Offer entities.
+func (m *OfferMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OfferMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OfferMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Offer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *OfferMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *OfferMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *OfferMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *OfferMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *OfferMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *OfferMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetListingID sets the "listing_id" field.
+func (m *OfferMutation) SetListingID(u uuid.UUID) {
+	m.listing = &u
+}
+
+// ListingID returns the value of the "listing_id" field in the mutation.
+func (m *OfferMutation) ListingID() (r uuid.UUID, exists bool) {
+	v := m.listing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldListingID returns the old "listing_id" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldListingID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListingID: %w", err)
+	}
+	return oldValue.ListingID, nil
+}
+
+// ResetListingID resets all changes to the "listing_id" field.
+func (m *OfferMutation) ResetListingID() {
+	m.listing = nil
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (m *OfferMutation) SetBuyerID(u uuid.UUID) {
+	m.buyer = &u
+}
+
+// BuyerID returns the value of the "buyer_id" field in the mutation.
+func (m *OfferMutation) BuyerID() (r uuid.UUID, exists bool) {
+	v := m.buyer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyerID returns the old "buyer_id" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldBuyerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyerID: %w", err)
+	}
+	return oldValue.BuyerID, nil
+}
+
+// ResetBuyerID resets all changes to the "buyer_id" field.
+func (m *OfferMutation) ResetBuyerID() {
+	m.buyer = nil
+}
+
+// SetPreviousID sets the "previous_id" field.
+func (m *OfferMutation) SetPreviousID(u uuid.UUID) {
+	m.previous = &u
+}
+
+// PreviousID returns the value of the "previous_id" field in the mutation.
+func (m *OfferMutation) PreviousID() (r uuid.UUID, exists bool) {
+	v := m.previous
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousID returns the old "previous_id" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldPreviousID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousID: %w", err)
+	}
+	return oldValue.PreviousID, nil
+}
+
+// ClearPreviousID clears the value of the "previous_id" field.
+func (m *OfferMutation) ClearPreviousID() {
+	m.previous = nil
+	m.clearedFields[offer.FieldPreviousID] = struct{}{}
+}
+
+// PreviousIDCleared returns if the "previous_id" field was cleared in this mutation.
+func (m *OfferMutation) PreviousIDCleared() bool {
+	_, ok := m.clearedFields[offer.FieldPreviousID]
+	return ok
+}
+
+// ResetPreviousID resets all changes to the "previous_id" field.
+func (m *OfferMutation) ResetPreviousID() {
+	m.previous = nil
+	delete(m.clearedFields, offer.FieldPreviousID)
+}
+
+// SetSide sets the "side" field.
+func (m *OfferMutation) SetSide(o offer.Side) {
+	m.side = &o
+}
+
+// Side returns the value of the "side" field in the mutation.
+func (m *OfferMutation) Side() (r offer.Side, exists bool) {
+	v := m.side
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSide returns the old "side" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldSide(ctx context.Context) (v offer.Side, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSide is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSide requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSide: %w", err)
+	}
+	return oldValue.Side, nil
+}
+
+// ResetSide resets all changes to the "side" field.
+func (m *OfferMutation) ResetSide() {
+	m.side = nil
+}
+
+// SetMadeBy sets the "made_by" field.
+func (m *OfferMutation) SetMadeBy(u uuid.UUID) {
+	m.made_by = &u
+}
+
+// MadeBy returns the value of the "made_by" field in the mutation.
+func (m *OfferMutation) MadeBy() (r uuid.UUID, exists bool) {
+	v := m.made_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMadeBy returns the old "made_by" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldMadeBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMadeBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMadeBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMadeBy: %w", err)
+	}
+	return oldValue.MadeBy, nil
+}
+
+// ResetMadeBy resets all changes to the "made_by" field.
+func (m *OfferMutation) ResetMadeBy() {
+	m.made_by = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *OfferMutation) SetAmount(d decimal.Decimal) {
+	m.amount = &d
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *OfferMutation) Amount() (r decimal.Decimal, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldAmount(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds d to the "amount" field.
+func (m *OfferMutation) AddAmount(d decimal.Decimal) {
+	if m.addamount != nil {
+		*m.addamount = m.addamount.Add(d)
+	} else {
+		m.addamount = &d
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *OfferMutation) AddedAmount() (r decimal.Decimal, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *OfferMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *OfferMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *OfferMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *OfferMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetContingencies sets the "contingencies" field.
+func (m *OfferMutation) SetContingencies(s []string) {
+	m.contingencies = &s
+	m.appendcontingencies = nil
+}
+
+// Contingencies returns the value of the "contingencies" field in the mutation.
+func (m *OfferMutation) Contingencies() (r []string, exists bool) {
+	v := m.contingencies
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContingencies returns the old "contingencies" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldContingencies(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContingencies is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContingencies requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContingencies: %w", err)
+	}
+	return oldValue.Contingencies, nil
+}
+
+// AppendContingencies adds s to the "contingencies" field.
+func (m *OfferMutation) AppendContingencies(s []string) {
+	m.appendcontingencies = append(m.appendcontingencies, s...)
+}
+
+// AppendedContingencies returns the list of values that were appended to the "contingencies" field in this mutation.
+func (m *OfferMutation) AppendedContingencies() ([]string, bool) {
+	if len(m.appendcontingencies) == 0 {
+		return nil, false
+	}
+	return m.appendcontingencies, true
+}
+
+// ClearContingencies clears the value of the "contingencies" field.
+func (m *OfferMutation) ClearContingencies() {
+	m.contingencies = nil
+	m.appendcontingencies = nil
+	m.clearedFields[offer.FieldContingencies] = struct{}{}
+}
+
+// ContingenciesCleared returns if the "contingencies" field was cleared in this mutation.
+func (m *OfferMutation) ContingenciesCleared() bool {
+	_, ok := m.clearedFields[offer.FieldContingencies]
+	return ok
+}
+
+// ResetContingencies resets all changes to the "contingencies" field.
+func (m *OfferMutation) ResetContingencies() {
+	m.contingencies = nil
+	m.appendcontingencies = nil
+	delete(m.clearedFields, offer.FieldContingencies)
+}
+
+// SetClosingDate sets the "closing_date" field.
+func (m *OfferMutation) SetClosingDate(t time.Time) {
+	m.closing_date = &t
+}
+
+// ClosingDate returns the value of the "closing_date" field in the mutation.
+func (m *OfferMutation) ClosingDate() (r time.Time, exists bool) {
+	v := m.closing_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosingDate returns the old "closing_date" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldClosingDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosingDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosingDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosingDate: %w", err)
+	}
+	return oldValue.ClosingDate, nil
+}
+
+// ResetClosingDate resets all changes to the "closing_date" field.
+func (m *OfferMutation) ResetClosingDate() {
+	m.closing_date = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *OfferMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *OfferMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *OfferMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *OfferMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *OfferMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *OfferMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[offer.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *OfferMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[offer.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *OfferMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, offer.FieldMessage)
+}
+
+// SetStatus sets the "status" field.
+func (m *OfferMutation) SetStatus(o offer.Status) {
+	m.status = &o
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OfferMutation) Status() (r offer.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldStatus(ctx context.Context) (v offer.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OfferMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (m *OfferMutation) SetRespondedAt(t time.Time) {
+	m.responded_at = &t
+}
+
+// RespondedAt returns the value of the "responded_at" field in the mutation.
+func (m *OfferMutation) RespondedAt() (r time.Time, exists bool) {
+	v := m.responded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRespondedAt returns the old "responded_at" field's value of the Offer entity.
+// If the Offer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OfferMutation) OldRespondedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRespondedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRespondedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRespondedAt: %w", err)
+	}
+	return oldValue.RespondedAt, nil
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (m *OfferMutation) ClearRespondedAt() {
+	m.responded_at = nil
+	m.clearedFields[offer.FieldRespondedAt] = struct{}{}
+}
+
+// RespondedAtCleared returns if the "responded_at" field was cleared in this mutation.
+func (m *OfferMutation) RespondedAtCleared() bool {
+	_, ok := m.clearedFields[offer.FieldRespondedAt]
+	return ok
+}
+
+// ResetRespondedAt resets all changes to the "responded_at" field.
+func (m *OfferMutation) ResetRespondedAt() {
+	m.responded_at = nil
+	delete(m.clearedFields, offer.FieldRespondedAt)
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (m *OfferMutation) ClearListing() {
+	m.clearedlisting = true
+	m.clearedFields[offer.FieldListingID] = struct{}{}
+}
+
+// ListingCleared reports if the "listing" edge to the Listing entity was cleared.
+func (m *OfferMutation) ListingCleared() bool {
+	return m.clearedlisting
+}
+
+// ListingIDs returns the "listing" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ListingID instead. It exists only for internal usage by the builders.
+func (m *OfferMutation) ListingIDs() (ids []uuid.UUID) {
+	if id := m.listing; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetListing resets all changes to the "listing" edge.
+func (m *OfferMutation) ResetListing() {
+	m.listing = nil
+	m.clearedlisting = false
+}
+
+// ClearBuyer clears the "buyer" edge to the User entity.
+func (m *OfferMutation) ClearBuyer() {
+	m.clearedbuyer = true
+	m.clearedFields[offer.FieldBuyerID] = struct{}{}
+}
+
+// BuyerCleared reports if the "buyer" edge to the User entity was cleared.
+func (m *OfferMutation) BuyerCleared() bool {
+	return m.clearedbuyer
+}
+
+// BuyerIDs returns the "buyer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BuyerID instead. It exists only for internal usage by the builders.
+func (m *OfferMutation) BuyerIDs() (ids []uuid.UUID) {
+	if id := m.buyer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBuyer resets all changes to the "buyer" edge.
+func (m *OfferMutation) ResetBuyer() {
+	m.buyer = nil
+	m.clearedbuyer = false
+}
+
+// ClearPrevious clears the "previous" edge to the Offer entity.
+func (m *OfferMutation) ClearPrevious() {
+	m.clearedprevious = true
+	m.clearedFields[offer.FieldPreviousID] = struct{}{}
+}
+
+// PreviousCleared reports if the "previous" edge to the Offer entity was cleared.
+func (m *OfferMutation) PreviousCleared() bool {
+	return m.PreviousIDCleared() || m.clearedprevious
+}
+
+// PreviousIDs returns the "previous" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PreviousID instead. It exists only for internal usage by the builders.
+func (m *OfferMutation) PreviousIDs() (ids []uuid.UUID) {
+	if id := m.previous; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPrevious resets all changes to the "previous" edge.
+func (m *OfferMutation) ResetPrevious() {
+	m.previous = nil
+	m.clearedprevious = false
+}
+
+// AddCounterIDs adds the "counters" edge to the Offer entity by ids.
+func (m *OfferMutation) AddCounterIDs(ids ...uuid.UUID) {
+	if m.counters == nil {
+		m.counters = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.counters[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCounters clears the "counters" edge to the Offer entity.
+func (m *OfferMutation) ClearCounters() {
+	m.clearedcounters = true
+}
+
+// CountersCleared reports if the "counters" edge to the Offer entity was cleared.
+func (m *OfferMutation) CountersCleared() bool {
+	return m.clearedcounters
+}
+
+// RemoveCounterIDs removes the "counters" edge to the Offer entity by IDs.
+func (m *OfferMutation) RemoveCounterIDs(ids ...uuid.UUID) {
+	if m.removedcounters == nil {
+		m.removedcounters = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.counters, ids[i])
+		m.removedcounters[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCounters returns the removed IDs of the "counters" edge to the Offer entity.
+func (m *OfferMutation) RemovedCountersIDs() (ids []uuid.UUID) {
+	for id := range m.removedcounters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CountersIDs returns the "counters" edge IDs in the mutation.
+func (m *OfferMutation) CountersIDs() (ids []uuid.UUID) {
+	for id := range m.counters {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCounters resets all changes to the "counters" edge.
+func (m *OfferMutation) ResetCounters() {
+	m.counters = nil
+	m.clearedcounters = false
+	m.removedcounters = nil
+}
+
+// Where appends a list predicates to the OfferMutation builder.
+func (m *OfferMutation) Where(ps ...predicate.Offer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OfferMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OfferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Offer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OfferMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OfferMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Offer).
+func (m *OfferMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OfferMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.create_time != nil {
+		fields = append(fields, offer.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, offer.FieldUpdateTime)
+	}
+	if m.listing != nil {
+		fields = append(fields, offer.FieldListingID)
+	}
+	if m.buyer != nil {
+		fields = append(fields, offer.FieldBuyerID)
+	}
+	if m.previous != nil {
+		fields = append(fields, offer.FieldPreviousID)
+	}
+	if m.side != nil {
+		fields = append(fields, offer.FieldSide)
+	}
+	if m.made_by != nil {
+		fields = append(fields, offer.FieldMadeBy)
+	}
+	if m.amount != nil {
+		fields = append(fields, offer.FieldAmount)
+	}
+	if m.currency != nil {
+		fields = append(fields, offer.FieldCurrency)
+	}
+	if m.contingencies != nil {
+		fields = append(fields, offer.FieldContingencies)
+	}
+	if m.closing_date != nil {
+		fields = append(fields, offer.FieldClosingDate)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, offer.FieldExpiresAt)
+	}
+	if m.message != nil {
+		fields = append(fields, offer.FieldMessage)
+	}
+	if m.status != nil {
+		fields = append(fields, offer.FieldStatus)
+	}
+	if m.responded_at != nil {
+		fields = append(fields, offer.FieldRespondedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OfferMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case offer.FieldCreateTime:
+		return m.CreateTime()
+	case offer.FieldUpdateTime:
+		return m.UpdateTime()
+	case offer.FieldListingID:
+		return m.ListingID()
+	case offer.FieldBuyerID:
+		return m.BuyerID()
+	case offer.FieldPreviousID:
+		return m.PreviousID()
+	case offer.FieldSide:
+		return m.Side()
+	case offer.FieldMadeBy:
+		return m.MadeBy()
+	case offer.FieldAmount:
+		return m.Amount()
+	case offer.FieldCurrency:
+		return m.Currency()
+	case offer.FieldContingencies:
+		return m.Contingencies()
+	case offer.FieldClosingDate:
+		return m.ClosingDate()
+	case offer.FieldExpiresAt:
+		return m.ExpiresAt()
+	case offer.FieldMessage:
+		return m.Message()
+	case offer.FieldStatus:
+		return m.Status()
+	case offer.FieldRespondedAt:
+		return m.RespondedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OfferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case offer.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case offer.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case offer.FieldListingID:
+		return m.OldListingID(ctx)
+	case offer.FieldBuyerID:
+		return m.OldBuyerID(ctx)
+	case offer.FieldPreviousID:
+		return m.OldPreviousID(ctx)
+	case offer.FieldSide:
+		return m.OldSide(ctx)
+	case offer.FieldMadeBy:
+		return m.OldMadeBy(ctx)
+	case offer.FieldAmount:
+		return m.OldAmount(ctx)
+	case offer.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case offer.FieldContingencies:
+		return m.OldContingencies(ctx)
+	case offer.FieldClosingDate:
+		return m.OldClosingDate(ctx)
+	case offer.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case offer.FieldMessage:
+		return m.OldMessage(ctx)
+	case offer.FieldStatus:
+		return m.OldStatus(ctx)
+	case offer.FieldRespondedAt:
+		return m.OldRespondedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Offer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OfferMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case offer.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case offer.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case offer.FieldListingID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListingID(v)
+		return nil
+	case offer.FieldBuyerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyerID(v)
+		return nil
+	case offer.FieldPreviousID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousID(v)
+		return nil
+	case offer.FieldSide:
+		v, ok := value.(offer.Side)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSide(v)
+		return nil
+	case offer.FieldMadeBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMadeBy(v)
+		return nil
+	case offer.FieldAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case offer.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case offer.FieldContingencies:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContingencies(v)
+		return nil
+	case offer.FieldClosingDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosingDate(v)
+		return nil
+	case offer.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case offer.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case offer.FieldStatus:
+		v, ok := value.(offer.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case offer.FieldRespondedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRespondedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Offer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OfferMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, offer.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OfferMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case offer.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OfferMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case offer.FieldAmount:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Offer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OfferMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(offer.FieldPreviousID) {
+		fields = append(fields, offer.FieldPreviousID)
+	}
+	if m.FieldCleared(offer.FieldContingencies) {
+		fields = append(fields, offer.FieldContingencies)
+	}
+	if m.FieldCleared(offer.FieldMessage) {
+		fields = append(fields, offer.FieldMessage)
+	}
+	if m.FieldCleared(offer.FieldRespondedAt) {
+		fields = append(fields, offer.FieldRespondedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OfferMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OfferMutation) ClearField(name string) error {
+	switch name {
+	case offer.FieldPreviousID:
+		m.ClearPreviousID()
+		return nil
+	case offer.FieldContingencies:
+		m.ClearContingencies()
+		return nil
+	case offer.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case offer.FieldRespondedAt:
+		m.ClearRespondedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Offer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OfferMutation) ResetField(name string) error {
+	switch name {
+	case offer.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case offer.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case offer.FieldListingID:
+		m.ResetListingID()
+		return nil
+	case offer.FieldBuyerID:
+		m.ResetBuyerID()
+		return nil
+	case offer.FieldPreviousID:
+		m.ResetPreviousID()
+		return nil
+	case offer.FieldSide:
+		m.ResetSide()
+		return nil
+	case offer.FieldMadeBy:
+		m.ResetMadeBy()
+		return nil
+	case offer.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case offer.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case offer.FieldContingencies:
+		m.ResetContingencies()
+		return nil
+	case offer.FieldClosingDate:
+		m.ResetClosingDate()
+		return nil
+	case offer.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case offer.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case offer.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case offer.FieldRespondedAt:
+		m.ResetRespondedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Offer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OfferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.listing != nil {
+		edges = append(edges, offer.EdgeListing)
+	}
+	if m.buyer != nil {
+		edges = append(edges, offer.EdgeBuyer)
+	}
+	if m.previous != nil {
+		edges = append(edges, offer.EdgePrevious)
+	}
+	if m.counters != nil {
+		edges = append(edges, offer.EdgeCounters)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OfferMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case offer.EdgeListing:
+		if id := m.listing; id != nil {
+			return []ent.Value{*id}
+		}
+	case offer.EdgeBuyer:
+		if id := m.buyer; id != nil {
+			return []ent.Value{*id}
+		}
+	case offer.EdgePrevious:
+		if id := m.previous; id != nil {
+			return []ent.Value{*id}
+		}
+	case offer.EdgeCounters:
+		ids := make([]ent.Value, 0, len(m.counters))
+		for id := range m.counters {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OfferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedcounters != nil {
+		edges = append(edges, offer.EdgeCounters)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OfferMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case offer.EdgeCounters:
+		ids := make([]ent.Value, 0, len(m.removedcounters))
+		for id := range m.removedcounters {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OfferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedlisting {
+		edges = append(edges, offer.EdgeListing)
+	}
+	if m.clearedbuyer {
+		edges = append(edges, offer.EdgeBuyer)
+	}
+	if m.clearedprevious {
+		edges = append(edges, offer.EdgePrevious)
+	}
+	if m.clearedcounters {
+		edges = append(edges, offer.EdgeCounters)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OfferMutation) EdgeCleared(name string) bool {
+	switch name {
+	case offer.EdgeListing:
+		return m.clearedlisting
+	case offer.EdgeBuyer:
+		return m.clearedbuyer
+	case offer.EdgePrevious:
+		return m.clearedprevious
+	case offer.EdgeCounters:
+		return m.clearedcounters
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OfferMutation) ClearEdge(name string) error {
+	switch name {
+	case offer.EdgeListing:
+		m.ClearListing()
+		return nil
+	case offer.EdgeBuyer:
+		m.ClearBuyer()
+		return nil
+	case offer.EdgePrevious:
+		m.ClearPrevious()
+		return nil
+	}
+	return fmt.Errorf("unknown Offer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OfferMutation) ResetEdge(name string) error {
+	switch name {
+	case offer.EdgeListing:
+		m.ResetListing()
+		return nil
+	case offer.EdgeBuyer:
+		m.ResetBuyer()
+		return nil
+	case offer.EdgePrevious:
+		m.ResetPrevious()
+		return nil
+	case offer.EdgeCounters:
+		m.ResetCounters()
+		return nil
+	}
+	return fmt.Errorf("unknown Offer edge %s", name)
+}
+
+// PointOfInterestMutation represents an operation that mutates the PointOfInterest nodes in the graph.
+type PointOfInterestMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	name          *string
+	category      *pointofinterest.Category
+	subtype       *string
+	latitude      *float64
+	addlatitude   *float64
+	longitude     *float64
+	addlongitude  *float64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PointOfInterest, error)
+	predicates    []predicate.PointOfInterest
+}
+
+var _ ent.Mutation = (*PointOfInterestMutation)(nil)
+
+// pointofinterestOption allows management of the mutation configuration using functional options.
+type pointofinterestOption func(*PointOfInterestMutation)
+
+// newPointOfInterestMutation creates new mutation for the PointOfInterest entity.
+func newPointOfInterestMutation(c config, op Op, opts ...pointofinterestOption) *PointOfInterestMutation {
+	m := &PointOfInterestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePointOfInterest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPointOfInterestID sets the ID field of the mutation.
+func withPointOfInterestID(id uuid.UUID) pointofinterestOption {
+	return func(m *PointOfInterestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PointOfInterest
+		)
+		m.oldValue = func(ctx context.Context) (*PointOfInterest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PointOfInterest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPointOfInterest sets the old PointOfInterest of the mutation.
+func withPointOfInterest(node *PointOfInterest) pointofinterestOption {
+	return func(m *PointOfInterestMutation) {
+		m.oldValue = func(context.Context) (*PointOfInterest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PointOfInterestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PointOfInterestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PointOfInterest entities.
+func (m *PointOfInterestMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PointOfInterestMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PointOfInterestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PointOfInterest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *PointOfInterestMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *PointOfInterestMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the PointOfInterest entity.
+// If the PointOfInterest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfInterestMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *PointOfInterestMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *PointOfInterestMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *PointOfInterestMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the PointOfInterest entity.
+// If the PointOfInterest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfInterestMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *PointOfInterestMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *PointOfInterestMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PointOfInterestMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the PointOfInterest entity.
+// If the PointOfInterest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfInterestMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PointOfInterestMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *PointOfInterestMutation) SetCategory(po pointofinterest.Category) {
+	m.category = &po
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *PointOfInterestMutation) Category() (r pointofinterest.Category, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the PointOfInterest entity.
+// If the PointOfInterest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfInterestMutation) OldCategory(ctx context.Context) (v pointofinterest.Category, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *PointOfInterestMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetSubtype sets the "subtype" field.
+func (m *PointOfInterestMutation) SetSubtype(s string) {
+	m.subtype = &s
+}
+
+// Subtype returns the value of the "subtype" field in the mutation.
+func (m *PointOfInterestMutation) Subtype() (r string, exists bool) {
+	v := m.subtype
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtype returns the old "subtype" field's value of the PointOfInterest entity.
+// If the PointOfInterest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfInterestMutation) OldSubtype(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtype is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtype requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtype: %w", err)
+	}
+	return oldValue.Subtype, nil
+}
+
+// ClearSubtype clears the value of the "subtype" field.
+func (m *PointOfInterestMutation) ClearSubtype() {
+	m.subtype = nil
+	m.clearedFields[pointofinterest.FieldSubtype] = struct{}{}
+}
+
+// SubtypeCleared returns if the "subtype" field was cleared in this mutation.
+func (m *PointOfInterestMutation) SubtypeCleared() bool {
+	_, ok := m.clearedFields[pointofinterest.FieldSubtype]
+	return ok
+}
+
+// ResetSubtype resets all changes to the "subtype" field.
+func (m *PointOfInterestMutation) ResetSubtype() {
+	m.subtype = nil
+	delete(m.clearedFields, pointofinterest.FieldSubtype)
+}
+
+// SetLatitude sets the "latitude" field.
+func (m *PointOfInterestMutation) SetLatitude(f float64) {
+	m.latitude = &f
+	m.addlatitude = nil
+}
+
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *PointOfInterestMutation) Latitude() (r float64, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatitude returns the old "latitude" field's value of the PointOfInterest entity.
+// If the PointOfInterest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfInterestMutation) OldLatitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	}
+	return oldValue.Latitude, nil
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (m *PointOfInterestMutation) AddLatitude(f float64) {
+	if m.addlatitude != nil {
+		*m.addlatitude += f
+	} else {
+		m.addlatitude = &f
+	}
+}
+
+// AddedLatitude returns the value that was added to the "latitude" field in this mutation.
+func (m *PointOfInterestMutation) AddedLatitude() (r float64, exists bool) {
+	v := m.addlatitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *PointOfInterestMutation) ResetLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+}
+
+// SetLongitude sets the "longitude" field.
+func (m *PointOfInterestMutation) SetLongitude(f float64) {
+	m.longitude = &f
+	m.addlongitude = nil
+}
+
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *PointOfInterestMutation) Longitude() (r float64, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongitude returns the old "longitude" field's value of the PointOfInterest entity.
+// If the PointOfInterest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PointOfInterestMutation) OldLongitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+	}
+	return oldValue.Longitude, nil
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (m *PointOfInterestMutation) AddLongitude(f float64) {
+	if m.addlongitude != nil {
+		*m.addlongitude += f
+	} else {
+		m.addlongitude = &f
+	}
+}
+
+// AddedLongitude returns the value that was added to the "longitude" field in this mutation.
+func (m *PointOfInterestMutation) AddedLongitude() (r float64, exists bool) {
+	v := m.addlongitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *PointOfInterestMutation) ResetLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+}
+
+// Where appends a list predicates to the PointOfInterestMutation builder.
+func (m *PointOfInterestMutation) Where(ps ...predicate.PointOfInterest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PointOfInterestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PointOfInterestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PointOfInterest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PointOfInterestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PointOfInterestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PointOfInterest).
+func (m *PointOfInterestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PointOfInterestMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, pointofinterest.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, pointofinterest.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, pointofinterest.FieldName)
+	}
+	if m.category != nil {
+		fields = append(fields, pointofinterest.FieldCategory)
+	}
+	if m.subtype != nil {
+		fields = append(fields, pointofinterest.FieldSubtype)
+	}
+	if m.latitude != nil {
+		fields = append(fields, pointofinterest.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, pointofinterest.FieldLongitude)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PointOfInterestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pointofinterest.FieldCreateTime:
+		return m.CreateTime()
+	case pointofinterest.FieldUpdateTime:
+		return m.UpdateTime()
+	case pointofinterest.FieldName:
+		return m.Name()
+	case pointofinterest.FieldCategory:
+		return m.Category()
+	case pointofinterest.FieldSubtype:
+		return m.Subtype()
+	case pointofinterest.FieldLatitude:
+		return m.Latitude()
+	case pointofinterest.FieldLongitude:
+		return m.Longitude()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PointOfInterestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pointofinterest.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case pointofinterest.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case pointofinterest.FieldName:
+		return m.OldName(ctx)
+	case pointofinterest.FieldCategory:
+		return m.OldCategory(ctx)
+	case pointofinterest.FieldSubtype:
+		return m.OldSubtype(ctx)
+	case pointofinterest.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case pointofinterest.FieldLongitude:
+		return m.OldLongitude(ctx)
+	}
+	return nil, fmt.Errorf("unknown PointOfInterest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PointOfInterestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pointofinterest.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case pointofinterest.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case pointofinterest.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case pointofinterest.FieldCategory:
+		v, ok := value.(pointofinterest.Category)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case pointofinterest.FieldSubtype:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtype(v)
+		return nil
+	case pointofinterest.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case pointofinterest.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PointOfInterest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PointOfInterestMutation) AddedFields() []string {
+	var fields []string
+	if m.addlatitude != nil {
+		fields = append(fields, pointofinterest.FieldLatitude)
+	}
+	if m.addlongitude != nil {
+		fields = append(fields, pointofinterest.FieldLongitude)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PointOfInterestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pointofinterest.FieldLatitude:
+		return m.AddedLatitude()
+	case pointofinterest.FieldLongitude:
+		return m.AddedLongitude()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PointOfInterestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pointofinterest.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatitude(v)
+		return nil
+	case pointofinterest.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongitude(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PointOfInterest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PointOfInterestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pointofinterest.FieldSubtype) {
+		fields = append(fields, pointofinterest.FieldSubtype)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PointOfInterestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PointOfInterestMutation) ClearField(name string) error {
+	switch name {
+	case pointofinterest.FieldSubtype:
+		m.ClearSubtype()
+		return nil
+	}
+	return fmt.Errorf("unknown PointOfInterest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PointOfInterestMutation) ResetField(name string) error {
+	switch name {
+	case pointofinterest.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case pointofinterest.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case pointofinterest.FieldName:
+		m.ResetName()
+		return nil
+	case pointofinterest.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case pointofinterest.FieldSubtype:
+		m.ResetSubtype()
+		return nil
+	case pointofinterest.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case pointofinterest.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	}
+	return fmt.Errorf("unknown PointOfInterest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PointOfInterestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PointOfInterestMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PointOfInterestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PointOfInterestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PointOfInterestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PointOfInterestMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PointOfInterestMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PointOfInterest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PointOfInterestMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PointOfInterest edge %s", name)
+}
+
+// PropertyMutation represents an operation that mutates the Property nodes in the graph.
+type PropertyMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	create_time      *time.Time
+	update_time      *time.Time
+	address          *string
+	address_key      *string
+	city             *string
+	state            *string
+	zip_code         *string
+	country          *string
+	type_of_property *property.TypeOfProperty
+	bedroom          *int
+	addbedroom       *int
+	bathroom         *float64
+	addbathroom      *float64
+	garage           *int
+	addgarage        *int
+	sqft             *int
+	addsqft          *int
+	sqm              *int
+	addsqm           *int
+	lot_size         *int
+	addlot_size      *int
+	pool             *bool
+	year_built       *int
+	addyear_built    *int
+	clearedFields    map[string]struct{}
+	listings         map[uuid.UUID]struct{}
+	removedlistings  map[uuid.UUID]struct{}
+	clearedlistings  bool
+	done             bool
+	oldValue         func(context.Context) (*Property, error)
+	predicates       []predicate.Property
+}
+
+var _ ent.Mutation = (*PropertyMutation)(nil)
+
+// propertyOption allows management of the mutation configuration using functional options.
+type propertyOption func(*PropertyMutation)
+
+// newPropertyMutation creates new mutation for the Property entity.
+func newPropertyMutation(c config, op Op, opts ...propertyOption) *PropertyMutation {
+	m := &PropertyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProperty,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPropertyID sets the ID field of the mutation.
+func withPropertyID(id uuid.UUID) propertyOption {
+	return func(m *PropertyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Property
+		)
+		m.oldValue = func(ctx context.Context) (*Property, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Property.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProperty sets the old Property of the mutation.
+func withProperty(node *Property) propertyOption {
+	return func(m *PropertyMutation) {
+		m.oldValue = func(context.Context) (*Property, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PropertyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PropertyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Property entities.
+func (m *PropertyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PropertyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PropertyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Property.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *PropertyMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *PropertyMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *PropertyMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *PropertyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *PropertyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *PropertyMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetAddress sets the "address" field.
+func (m *PropertyMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *PropertyMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *PropertyMutation) ResetAddress() {
+	m.address = nil
+}
+
+// SetAddressKey sets the "address_key" field.
+func (m *PropertyMutation) SetAddressKey(s string) {
+	m.address_key = &s
+}
+
+// AddressKey returns the value of the "address_key" field in the mutation.
+func (m *PropertyMutation) AddressKey() (r string, exists bool) {
+	v := m.address_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressKey returns the old "address_key" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldAddressKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressKey: %w", err)
+	}
+	return oldValue.AddressKey, nil
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (m *PropertyMutation) ClearAddressKey() {
+	m.address_key = nil
+	m.clearedFields[property.FieldAddressKey] = struct{}{}
+}
+
+// AddressKeyCleared returns if the "address_key" field was cleared in this mutation.
+func (m *PropertyMutation) AddressKeyCleared() bool {
+	_, ok := m.clearedFields[property.FieldAddressKey]
+	return ok
+}
+
+// ResetAddressKey resets all changes to the "address_key" field.
+func (m *PropertyMutation) ResetAddressKey() {
+	m.address_key = nil
+	delete(m.clearedFields, property.FieldAddressKey)
+}
+
+// SetCity sets the "city" field.
+func (m *PropertyMutation) SetCity(s string) {
+	m.city = &s
+}
+
+// City returns the value of the "city" field in the mutation.
+func (m *PropertyMutation) City() (r string, exists bool) {
+	v := m.city
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCity returns the old "city" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldCity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCity: %w", err)
+	}
+	return oldValue.City, nil
+}
+
+// ResetCity resets all changes to the "city" field.
+func (m *PropertyMutation) ResetCity() {
+	m.city = nil
+}
+
+// SetState sets the "state" field.
+func (m *PropertyMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *PropertyMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *PropertyMutation) ResetState() {
+	m.state = nil
+}
+
+// SetZipCode sets the "zip_code" field.
+func (m *PropertyMutation) SetZipCode(s string) {
+	m.zip_code = &s
+}
+
+// ZipCode returns the value of the "zip_code" field in the mutation.
+func (m *PropertyMutation) ZipCode() (r string, exists bool) {
+	v := m.zip_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldZipCode returns the old "zip_code" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldZipCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldZipCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldZipCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldZipCode: %w", err)
+	}
+	return oldValue.ZipCode, nil
+}
+
+// ResetZipCode resets all changes to the "zip_code" field.
+func (m *PropertyMutation) ResetZipCode() {
+	m.zip_code = nil
+}
+
+// SetCountry sets the "country" field.
+func (m *PropertyMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *PropertyMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountry returns the old "country" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
+}
+
+// ResetCountry resets all changes to the "country" field.
+func (m *PropertyMutation) ResetCountry() {
+	m.country = nil
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (m *PropertyMutation) SetTypeOfProperty(pop property.TypeOfProperty) {
+	m.type_of_property = &pop
+}
+
+// TypeOfProperty returns the value of the "type_of_property" field in the mutation.
+func (m *PropertyMutation) TypeOfProperty() (r property.TypeOfProperty, exists bool) {
+	v := m.type_of_property
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypeOfProperty returns the old "type_of_property" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldTypeOfProperty(ctx context.Context) (v property.TypeOfProperty, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypeOfProperty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypeOfProperty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypeOfProperty: %w", err)
+	}
+	return oldValue.TypeOfProperty, nil
+}
+
+// ResetTypeOfProperty resets all changes to the "type_of_property" field.
+func (m *PropertyMutation) ResetTypeOfProperty() {
+	m.type_of_property = nil
+}
+
+// SetBedroom sets the "bedroom" field.
+func (m *PropertyMutation) SetBedroom(i int) {
+	m.bedroom = &i
+	m.addbedroom = nil
+}
+
+// Bedroom returns the value of the "bedroom" field in the mutation.
+func (m *PropertyMutation) Bedroom() (r int, exists bool) {
+	v := m.bedroom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBedroom returns the old "bedroom" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldBedroom(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBedroom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBedroom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBedroom: %w", err)
+	}
+	return oldValue.Bedroom, nil
+}
+
+// AddBedroom adds i to the "bedroom" field.
+func (m *PropertyMutation) AddBedroom(i int) {
+	if m.addbedroom != nil {
+		*m.addbedroom += i
+	} else {
+		m.addbedroom = &i
+	}
+}
+
+// AddedBedroom returns the value that was added to the "bedroom" field in this mutation.
+func (m *PropertyMutation) AddedBedroom() (r int, exists bool) {
+	v := m.addbedroom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBedroom resets all changes to the "bedroom" field.
+func (m *PropertyMutation) ResetBedroom() {
+	m.bedroom = nil
+	m.addbedroom = nil
+}
+
+// SetBathroom sets the "bathroom" field.
+func (m *PropertyMutation) SetBathroom(f float64) {
+	m.bathroom = &f
+	m.addbathroom = nil
+}
+
+// Bathroom returns the value of the "bathroom" field in the mutation.
+func (m *PropertyMutation) Bathroom() (r float64, exists bool) {
+	v := m.bathroom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBathroom returns the old "bathroom" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldBathroom(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBathroom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBathroom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBathroom: %w", err)
+	}
+	return oldValue.Bathroom, nil
+}
+
+// AddBathroom adds f to the "bathroom" field.
+func (m *PropertyMutation) AddBathroom(f float64) {
+	if m.addbathroom != nil {
+		*m.addbathroom += f
+	} else {
+		m.addbathroom = &f
+	}
+}
+
+// AddedBathroom returns the value that was added to the "bathroom" field in this mutation.
+func (m *PropertyMutation) AddedBathroom() (r float64, exists bool) {
+	v := m.addbathroom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBathroom resets all changes to the "bathroom" field.
+func (m *PropertyMutation) ResetBathroom() {
+	m.bathroom = nil
+	m.addbathroom = nil
+}
+
+// SetGarage sets the "garage" field.
+func (m *PropertyMutation) SetGarage(i int) {
+	m.garage = &i
+	m.addgarage = nil
+}
+
+// Garage returns the value of the "garage" field in the mutation.
+func (m *PropertyMutation) Garage() (r int, exists bool) {
+	v := m.garage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGarage returns the old "garage" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldGarage(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGarage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGarage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGarage: %w", err)
+	}
+	return oldValue.Garage, nil
+}
+
+// AddGarage adds i to the "garage" field.
+func (m *PropertyMutation) AddGarage(i int) {
+	if m.addgarage != nil {
+		*m.addgarage += i
+	} else {
+		m.addgarage = &i
+	}
+}
+
+// AddedGarage returns the value that was added to the "garage" field in this mutation.
+func (m *PropertyMutation) AddedGarage() (r int, exists bool) {
+	v := m.addgarage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearGarage clears the value of the "garage" field.
+func (m *PropertyMutation) ClearGarage() {
+	m.garage = nil
+	m.addgarage = nil
+	m.clearedFields[property.FieldGarage] = struct{}{}
+}
+
+// GarageCleared returns if the "garage" field was cleared in this mutation.
+func (m *PropertyMutation) GarageCleared() bool {
+	_, ok := m.clearedFields[property.FieldGarage]
+	return ok
+}
+
+// ResetGarage resets all changes to the "garage" field.
+func (m *PropertyMutation) ResetGarage() {
+	m.garage = nil
+	m.addgarage = nil
+	delete(m.clearedFields, property.FieldGarage)
+}
+
+// SetSqft sets the "sqft" field.
+func (m *PropertyMutation) SetSqft(i int) {
+	m.sqft = &i
+	m.addsqft = nil
+}
+
+// Sqft returns the value of the "sqft" field in the mutation.
+func (m *PropertyMutation) Sqft() (r int, exists bool) {
+	v := m.sqft
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSqft returns the old "sqft" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldSqft(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSqft is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSqft requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSqft: %w", err)
+	}
+	return oldValue.Sqft, nil
+}
+
+// AddSqft adds i to the "sqft" field.
+func (m *PropertyMutation) AddSqft(i int) {
+	if m.addsqft != nil {
+		*m.addsqft += i
+	} else {
+		m.addsqft = &i
+	}
+}
+
+// AddedSqft returns the value that was added to the "sqft" field in this mutation.
+func (m *PropertyMutation) AddedSqft() (r int, exists bool) {
+	v := m.addsqft
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSqft resets all changes to the "sqft" field.
+func (m *PropertyMutation) ResetSqft() {
+	m.sqft = nil
+	m.addsqft = nil
+}
+
+// SetSqm sets the "sqm" field.
+func (m *PropertyMutation) SetSqm(i int) {
+	m.sqm = &i
+	m.addsqm = nil
+}
+
+// Sqm returns the value of the "sqm" field in the mutation.
+func (m *PropertyMutation) Sqm() (r int, exists bool) {
+	v := m.sqm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSqm returns the old "sqm" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldSqm(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSqm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSqm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSqm: %w", err)
+	}
+	return oldValue.Sqm, nil
+}
+
+// AddSqm adds i to the "sqm" field.
+func (m *PropertyMutation) AddSqm(i int) {
+	if m.addsqm != nil {
+		*m.addsqm += i
+	} else {
+		m.addsqm = &i
+	}
+}
+
+// AddedSqm returns the value that was added to the "sqm" field in this mutation.
+func (m *PropertyMutation) AddedSqm() (r int, exists bool) {
+	v := m.addsqm
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (m *PropertyMutation) ClearSqm() {
+	m.sqm = nil
+	m.addsqm = nil
+	m.clearedFields[property.FieldSqm] = struct{}{}
+}
+
+// SqmCleared returns if the "sqm" field was cleared in this mutation.
+func (m *PropertyMutation) SqmCleared() bool {
+	_, ok := m.clearedFields[property.FieldSqm]
+	return ok
+}
+
+// ResetSqm resets all changes to the "sqm" field.
+func (m *PropertyMutation) ResetSqm() {
+	m.sqm = nil
+	m.addsqm = nil
+	delete(m.clearedFields, property.FieldSqm)
+}
+
+// SetLotSize sets the "lot_size" field.
+func (m *PropertyMutation) SetLotSize(i int) {
+	m.lot_size = &i
+	m.addlot_size = nil
+}
+
+// LotSize returns the value of the "lot_size" field in the mutation.
+func (m *PropertyMutation) LotSize() (r int, exists bool) {
+	v := m.lot_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLotSize returns the old "lot_size" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldLotSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLotSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLotSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLotSize: %w", err)
+	}
+	return oldValue.LotSize, nil
+}
+
+// AddLotSize adds i to the "lot_size" field.
+func (m *PropertyMutation) AddLotSize(i int) {
+	if m.addlot_size != nil {
+		*m.addlot_size += i
+	} else {
+		m.addlot_size = &i
+	}
+}
+
+// AddedLotSize returns the value that was added to the "lot_size" field in this mutation.
+func (m *PropertyMutation) AddedLotSize() (r int, exists bool) {
+	v := m.addlot_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLotSize clears the value of the "lot_size" field.
+func (m *PropertyMutation) ClearLotSize() {
+	m.lot_size = nil
+	m.addlot_size = nil
+	m.clearedFields[property.FieldLotSize] = struct{}{}
+}
+
+// LotSizeCleared returns if the "lot_size" field was cleared in this mutation.
+func (m *PropertyMutation) LotSizeCleared() bool {
+	_, ok := m.clearedFields[property.FieldLotSize]
+	return ok
+}
+
+// ResetLotSize resets all changes to the "lot_size" field.
+func (m *PropertyMutation) ResetLotSize() {
+	m.lot_size = nil
+	m.addlot_size = nil
+	delete(m.clearedFields, property.FieldLotSize)
+}
+
+// SetPool sets the "pool" field.
+func (m *PropertyMutation) SetPool(b bool) {
+	m.pool = &b
+}
+
+// Pool returns the value of the "pool" field in the mutation.
+func (m *PropertyMutation) Pool() (r bool, exists bool) {
+	v := m.pool
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPool returns the old "pool" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldPool(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPool is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPool requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPool: %w", err)
+	}
+	return oldValue.Pool, nil
+}
+
+// ClearPool clears the value of the "pool" field.
+func (m *PropertyMutation) ClearPool() {
+	m.pool = nil
+	m.clearedFields[property.FieldPool] = struct{}{}
+}
+
+// PoolCleared returns if the "pool" field was cleared in this mutation.
+func (m *PropertyMutation) PoolCleared() bool {
+	_, ok := m.clearedFields[property.FieldPool]
+	return ok
+}
+
+// ResetPool resets all changes to the "pool" field.
+func (m *PropertyMutation) ResetPool() {
+	m.pool = nil
+	delete(m.clearedFields, property.FieldPool)
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (m *PropertyMutation) SetYearBuilt(i int) {
+	m.year_built = &i
+	m.addyear_built = nil
+}
+
+// YearBuilt returns the value of the "year_built" field in the mutation.
+func (m *PropertyMutation) YearBuilt() (r int, exists bool) {
+	v := m.year_built
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldYearBuilt returns the old "year_built" field's value of the Property entity.
+// If the Property object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PropertyMutation) OldYearBuilt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldYearBuilt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldYearBuilt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldYearBuilt: %w", err)
+	}
+	return oldValue.YearBuilt, nil
+}
+
+// AddYearBuilt adds i to the "year_built" field.
+func (m *PropertyMutation) AddYearBuilt(i int) {
+	if m.addyear_built != nil {
+		*m.addyear_built += i
+	} else {
+		m.addyear_built = &i
+	}
+}
+
+// AddedYearBuilt returns the value that was added to the "year_built" field in this mutation.
+func (m *PropertyMutation) AddedYearBuilt() (r int, exists bool) {
+	v := m.addyear_built
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetYearBuilt resets all changes to the "year_built" field.
+func (m *PropertyMutation) ResetYearBuilt() {
+	m.year_built = nil
+	m.addyear_built = nil
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by ids.
+func (m *PropertyMutation) AddListingIDs(ids ...uuid.UUID) {
+	if m.listings == nil {
+		m.listings = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.listings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearListings clears the "listings" edge to the Listing entity.
+func (m *PropertyMutation) ClearListings() {
+	m.clearedlistings = true
+}
+
+// ListingsCleared reports if the "listings" edge to the Listing entity was cleared.
+func (m *PropertyMutation) ListingsCleared() bool {
+	return m.clearedlistings
+}
+
+// RemoveListingIDs removes the "listings" edge to the Listing entity by IDs.
+func (m *PropertyMutation) RemoveListingIDs(ids ...uuid.UUID) {
+	if m.removedlistings == nil {
+		m.removedlistings = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.listings, ids[i])
+		m.removedlistings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedListings returns the removed IDs of the "listings" edge to the Listing entity.
+func (m *PropertyMutation) RemovedListingsIDs() (ids []uuid.UUID) {
+	for id := range m.removedlistings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ListingsIDs returns the "listings" edge IDs in the mutation.
+func (m *PropertyMutation) ListingsIDs() (ids []uuid.UUID) {
+	for id := range m.listings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetListings resets all changes to the "listings" edge.
+func (m *PropertyMutation) ResetListings() {
+	m.listings = nil
+	m.clearedlistings = false
+	m.removedlistings = nil
+}
+
+// Where appends a list predicates to the PropertyMutation builder.
+func (m *PropertyMutation) Where(ps ...predicate.Property) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PropertyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PropertyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Property, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PropertyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PropertyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Property).
+func (m *PropertyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PropertyMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.create_time != nil {
+		fields = append(fields, property.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, property.FieldUpdateTime)
+	}
+	if m.address != nil {
+		fields = append(fields, property.FieldAddress)
+	}
+	if m.address_key != nil {
+		fields = append(fields, property.FieldAddressKey)
+	}
+	if m.city != nil {
+		fields = append(fields, property.FieldCity)
+	}
+	if m.state != nil {
+		fields = append(fields, property.FieldState)
+	}
+	if m.zip_code != nil {
+		fields = append(fields, property.FieldZipCode)
+	}
+	if m.country != nil {
+		fields = append(fields, property.FieldCountry)
+	}
+	if m.type_of_property != nil {
+		fields = append(fields, property.FieldTypeOfProperty)
+	}
+	if m.bedroom != nil {
+		fields = append(fields, property.FieldBedroom)
+	}
+	if m.bathroom != nil {
+		fields = append(fields, property.FieldBathroom)
+	}
+	if m.garage != nil {
+		fields = append(fields, property.FieldGarage)
+	}
+	if m.sqft != nil {
+		fields = append(fields, property.FieldSqft)
+	}
+	if m.sqm != nil {
+		fields = append(fields, property.FieldSqm)
+	}
+	if m.lot_size != nil {
+		fields = append(fields, property.FieldLotSize)
+	}
+	if m.pool != nil {
+		fields = append(fields, property.FieldPool)
+	}
+	if m.year_built != nil {
+		fields = append(fields, property.FieldYearBuilt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PropertyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case property.FieldCreateTime:
+		return m.CreateTime()
+	case property.FieldUpdateTime:
+		return m.UpdateTime()
+	case property.FieldAddress:
+		return m.Address()
+	case property.FieldAddressKey:
+		return m.AddressKey()
+	case property.FieldCity:
+		return m.City()
+	case property.FieldState:
+		return m.State()
+	case property.FieldZipCode:
+		return m.ZipCode()
+	case property.FieldCountry:
+		return m.Country()
+	case property.FieldTypeOfProperty:
+		return m.TypeOfProperty()
+	case property.FieldBedroom:
+		return m.Bedroom()
+	case property.FieldBathroom:
+		return m.Bathroom()
+	case property.FieldGarage:
+		return m.Garage()
+	case property.FieldSqft:
+		return m.Sqft()
+	case property.FieldSqm:
+		return m.Sqm()
+	case property.FieldLotSize:
+		return m.LotSize()
+	case property.FieldPool:
+		return m.Pool()
+	case property.FieldYearBuilt:
+		return m.YearBuilt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PropertyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case property.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case property.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case property.FieldAddress:
+		return m.OldAddress(ctx)
+	case property.FieldAddressKey:
+		return m.OldAddressKey(ctx)
+	case property.FieldCity:
+		return m.OldCity(ctx)
+	case property.FieldState:
+		return m.OldState(ctx)
+	case property.FieldZipCode:
+		return m.OldZipCode(ctx)
+	case property.FieldCountry:
+		return m.OldCountry(ctx)
+	case property.FieldTypeOfProperty:
+		return m.OldTypeOfProperty(ctx)
+	case property.FieldBedroom:
+		return m.OldBedroom(ctx)
+	case property.FieldBathroom:
+		return m.OldBathroom(ctx)
+	case property.FieldGarage:
+		return m.OldGarage(ctx)
+	case property.FieldSqft:
+		return m.OldSqft(ctx)
+	case property.FieldSqm:
+		return m.OldSqm(ctx)
+	case property.FieldLotSize:
+		return m.OldLotSize(ctx)
+	case property.FieldPool:
+		return m.OldPool(ctx)
+	case property.FieldYearBuilt:
+		return m.OldYearBuilt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Property field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PropertyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case property.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case property.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case property.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case property.FieldAddressKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressKey(v)
+		return nil
+	case property.FieldCity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCity(v)
+		return nil
+	case property.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case property.FieldZipCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetZipCode(v)
+		return nil
+	case property.FieldCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountry(v)
+		return nil
+	case property.FieldTypeOfProperty:
+		v, ok := value.(property.TypeOfProperty)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypeOfProperty(v)
+		return nil
+	case property.FieldBedroom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBedroom(v)
+		return nil
+	case property.FieldBathroom:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBathroom(v)
+		return nil
+	case property.FieldGarage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGarage(v)
+		return nil
+	case property.FieldSqft:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSqft(v)
+		return nil
+	case property.FieldSqm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSqm(v)
+		return nil
+	case property.FieldLotSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLotSize(v)
+		return nil
+	case property.FieldPool:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPool(v)
+		return nil
+	case property.FieldYearBuilt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetYearBuilt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Property field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PropertyMutation) AddedFields() []string {
+	var fields []string
+	if m.addbedroom != nil {
+		fields = append(fields, property.FieldBedroom)
+	}
+	if m.addbathroom != nil {
+		fields = append(fields, property.FieldBathroom)
+	}
+	if m.addgarage != nil {
+		fields = append(fields, property.FieldGarage)
+	}
+	if m.addsqft != nil {
+		fields = append(fields, property.FieldSqft)
+	}
+	if m.addsqm != nil {
+		fields = append(fields, property.FieldSqm)
+	}
+	if m.addlot_size != nil {
+		fields = append(fields, property.FieldLotSize)
+	}
+	if m.addyear_built != nil {
+		fields = append(fields, property.FieldYearBuilt)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PropertyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case property.FieldBedroom:
+		return m.AddedBedroom()
+	case property.FieldBathroom:
+		return m.AddedBathroom()
+	case property.FieldGarage:
+		return m.AddedGarage()
+	case property.FieldSqft:
+		return m.AddedSqft()
+	case property.FieldSqm:
+		return m.AddedSqm()
+	case property.FieldLotSize:
+		return m.AddedLotSize()
+	case property.FieldYearBuilt:
+		return m.AddedYearBuilt()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PropertyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case property.FieldBedroom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBedroom(v)
+		return nil
+	case property.FieldBathroom:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBathroom(v)
+		return nil
+	case property.FieldGarage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGarage(v)
+		return nil
+	case property.FieldSqft:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSqft(v)
+		return nil
+	case property.FieldSqm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSqm(v)
+		return nil
+	case property.FieldLotSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLotSize(v)
+		return nil
+	case property.FieldYearBuilt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYearBuilt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Property numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PropertyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(property.FieldAddressKey) {
+		fields = append(fields, property.FieldAddressKey)
+	}
+	if m.FieldCleared(property.FieldGarage) {
+		fields = append(fields, property.FieldGarage)
+	}
+	if m.FieldCleared(property.FieldSqm) {
+		fields = append(fields, property.FieldSqm)
+	}
+	if m.FieldCleared(property.FieldLotSize) {
+		fields = append(fields, property.FieldLotSize)
+	}
+	if m.FieldCleared(property.FieldPool) {
+		fields = append(fields, property.FieldPool)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PropertyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PropertyMutation) ClearField(name string) error {
+	switch name {
+	case property.FieldAddressKey:
+		m.ClearAddressKey()
+		return nil
+	case property.FieldGarage:
+		m.ClearGarage()
+		return nil
+	case property.FieldSqm:
+		m.ClearSqm()
+		return nil
+	case property.FieldLotSize:
+		m.ClearLotSize()
+		return nil
+	case property.FieldPool:
+		m.ClearPool()
+		return nil
+	}
+	return fmt.Errorf("unknown Property nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PropertyMutation) ResetField(name string) error {
+	switch name {
+	case property.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case property.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case property.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case property.FieldAddressKey:
+		m.ResetAddressKey()
+		return nil
+	case property.FieldCity:
+		m.ResetCity()
+		return nil
+	case property.FieldState:
+		m.ResetState()
+		return nil
+	case property.FieldZipCode:
+		m.ResetZipCode()
+		return nil
+	case property.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case property.FieldTypeOfProperty:
+		m.ResetTypeOfProperty()
+		return nil
+	case property.FieldBedroom:
+		m.ResetBedroom()
+		return nil
+	case property.FieldBathroom:
+		m.ResetBathroom()
+		return nil
+	case property.FieldGarage:
+		m.ResetGarage()
+		return nil
+	case property.FieldSqft:
+		m.ResetSqft()
+		return nil
+	case property.FieldSqm:
+		m.ResetSqm()
+		return nil
+	case property.FieldLotSize:
+		m.ResetLotSize()
+		return nil
+	case property.FieldPool:
+		m.ResetPool()
+		return nil
+	case property.FieldYearBuilt:
+		m.ResetYearBuilt()
+		return nil
+	}
+	return fmt.Errorf("unknown Property field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PropertyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.listings != nil {
+		edges = append(edges, property.EdgeListings)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PropertyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case property.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.listings))
+		for id := range m.listings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PropertyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedlistings != nil {
+		edges = append(edges, property.EdgeListings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PropertyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case property.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.removedlistings))
+		for id := range m.removedlistings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PropertyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlistings {
+		edges = append(edges, property.EdgeListings)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PropertyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case property.EdgeListings:
+		return m.clearedlistings
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PropertyMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Property unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PropertyMutation) ResetEdge(name string) error {
+	switch name {
+	case property.EdgeListings:
+		m.ResetListings()
+		return nil
+	}
+	return fmt.Errorf("unknown Property edge %s", name)
+}
+
+// RealtorMutation represents an operation that mutates the Realtor nodes in the graph.
+type RealtorMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	create_time             *time.Time
+	update_time             *time.Time
+	full_name               *string
+	photo                   **schematype.Photo
+	description             *string
+	phone                   *string
+	email                   *string
+	is_mvp                  *bool
+	hire_date               *time.Time
+	service_areas           *[]string
+	appendservice_areas     []string
+	active                  *bool
+	deactivated_at          *time.Time
+	clearedFields           map[string]struct{}
+	listings                map[uuid.UUID]struct{}
+	removedlistings         map[uuid.UUID]struct{}
+	clearedlistings         bool
+	buyer_side_sales        map[uuid.UUID]struct{}
+	removedbuyer_side_sales map[uuid.UUID]struct{}
+	clearedbuyer_side_sales bool
+	user                    *uuid.UUID
+	cleareduser             bool
+	invites                 map[uuid.UUID]struct{}
+	removedinvites          map[uuid.UUID]struct{}
+	clearedinvites          bool
+	brokerage               *uuid.UUID
+	clearedbrokerage        bool
+	team                    *uuid.UUID
+	clearedteam             bool
+	led_teams               map[uuid.UUID]struct{}
+	removedled_teams        map[uuid.UUID]struct{}
+	clearedled_teams        bool
+	done                    bool
+	oldValue                func(context.Context) (*Realtor, error)
+	predicates              []predicate.Realtor
+}
+
+var _ ent.Mutation = (*RealtorMutation)(nil)
+
+// realtorOption allows management of the mutation configuration using functional options.
+type realtorOption func(*RealtorMutation)
+
+// newRealtorMutation creates new mutation for the Realtor entity.
+func newRealtorMutation(c config, op Op, opts ...realtorOption) *RealtorMutation {
+	m := &RealtorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRealtor,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRealtorID sets the ID field of the mutation.
+func withRealtorID(id uuid.UUID) realtorOption {
+	return func(m *RealtorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Realtor
+		)
+		m.oldValue = func(ctx context.Context) (*Realtor, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Realtor.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRealtor sets the old Realtor of the mutation.
+func withRealtor(node *Realtor) realtorOption {
+	return func(m *RealtorMutation) {
+		m.oldValue = func(context.Context) (*Realtor, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RealtorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RealtorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Realtor entities.
+func (m *RealtorMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RealtorMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RealtorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Realtor.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *RealtorMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *RealtorMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *RealtorMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *RealtorMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *RealtorMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *RealtorMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetFullName sets the "full_name" field.
+func (m *RealtorMutation) SetFullName(s string) {
+	m.full_name = &s
+}
+
+// FullName returns the value of the "full_name" field in the mutation.
+func (m *RealtorMutation) FullName() (r string, exists bool) {
+	v := m.full_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFullName returns the old "full_name" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldFullName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFullName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFullName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFullName: %w", err)
+	}
+	return oldValue.FullName, nil
+}
+
+// ResetFullName resets all changes to the "full_name" field.
+func (m *RealtorMutation) ResetFullName() {
+	m.full_name = nil
+}
+
+// SetPhoto sets the "photo" field.
+func (m *RealtorMutation) SetPhoto(s *schematype.Photo) {
+	m.photo = &s
+}
+
+// Photo returns the value of the "photo" field in the mutation.
+func (m *RealtorMutation) Photo() (r *schematype.Photo, exists bool) {
+	v := m.photo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhoto returns the old "photo" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldPhoto(ctx context.Context) (v *schematype.Photo, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhoto is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhoto requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhoto: %w", err)
+	}
+	return oldValue.Photo, nil
+}
+
+// ClearPhoto clears the value of the "photo" field.
+func (m *RealtorMutation) ClearPhoto() {
+	m.photo = nil
+	m.clearedFields[realtor.FieldPhoto] = struct{}{}
+}
+
+// PhotoCleared returns if the "photo" field was cleared in this mutation.
+func (m *RealtorMutation) PhotoCleared() bool {
+	_, ok := m.clearedFields[realtor.FieldPhoto]
+	return ok
+}
+
+// ResetPhoto resets all changes to the "photo" field.
+func (m *RealtorMutation) ResetPhoto() {
+	m.photo = nil
+	delete(m.clearedFields, realtor.FieldPhoto)
+}
+
+// SetDescription sets the "description" field.
+func (m *RealtorMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RealtorMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RealtorMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[realtor.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RealtorMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[realtor.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RealtorMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, realtor.FieldDescription)
+}
+
+// SetPhone sets the "phone" field.
+func (m *RealtorMutation) SetPhone(s string) {
+	m.phone = &s
+}
+
+// Phone returns the value of the "phone" field in the mutation.
+func (m *RealtorMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhone returns the old "phone" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
+}
+
+// ResetPhone resets all changes to the "phone" field.
+func (m *RealtorMutation) ResetPhone() {
+	m.phone = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *RealtorMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *RealtorMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *RealtorMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetIsMvp sets the "is_mvp" field.
+func (m *RealtorMutation) SetIsMvp(b bool) {
+	m.is_mvp = &b
+}
+
+// IsMvp returns the value of the "is_mvp" field in the mutation.
+func (m *RealtorMutation) IsMvp() (r bool, exists bool) {
+	v := m.is_mvp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsMvp returns the old "is_mvp" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldIsMvp(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsMvp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsMvp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsMvp: %w", err)
+	}
+	return oldValue.IsMvp, nil
+}
+
+// ResetIsMvp resets all changes to the "is_mvp" field.
+func (m *RealtorMutation) ResetIsMvp() {
+	m.is_mvp = nil
+}
+
+// SetHireDate sets the "hire_date" field.
+func (m *RealtorMutation) SetHireDate(t time.Time) {
+	m.hire_date = &t
+}
+
+// HireDate returns the value of the "hire_date" field in the mutation.
+func (m *RealtorMutation) HireDate() (r time.Time, exists bool) {
+	v := m.hire_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHireDate returns the old "hire_date" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldHireDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHireDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHireDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHireDate: %w", err)
+	}
+	return oldValue.HireDate, nil
+}
+
+// ResetHireDate resets all changes to the "hire_date" field.
+func (m *RealtorMutation) ResetHireDate() {
+	m.hire_date = nil
+}
+
+// SetServiceAreas sets the "service_areas" field.
+func (m *RealtorMutation) SetServiceAreas(s []string) {
+	m.service_areas = &s
+	m.appendservice_areas = nil
+}
+
+// ServiceAreas returns the value of the "service_areas" field in the mutation.
+func (m *RealtorMutation) ServiceAreas() (r []string, exists bool) {
+	v := m.service_areas
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServiceAreas returns the old "service_areas" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldServiceAreas(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServiceAreas is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServiceAreas requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServiceAreas: %w", err)
+	}
+	return oldValue.ServiceAreas, nil
+}
+
+// AppendServiceAreas adds s to the "service_areas" field.
+func (m *RealtorMutation) AppendServiceAreas(s []string) {
+	m.appendservice_areas = append(m.appendservice_areas, s...)
+}
+
+// AppendedServiceAreas returns the list of values that were appended to the "service_areas" field in this mutation.
+func (m *RealtorMutation) AppendedServiceAreas() ([]string, bool) {
+	if len(m.appendservice_areas) == 0 {
+		return nil, false
+	}
+	return m.appendservice_areas, true
+}
+
+// ClearServiceAreas clears the value of the "service_areas" field.
+func (m *RealtorMutation) ClearServiceAreas() {
+	m.service_areas = nil
+	m.appendservice_areas = nil
+	m.clearedFields[realtor.FieldServiceAreas] = struct{}{}
+}
+
+// ServiceAreasCleared returns if the "service_areas" field was cleared in this mutation.
+func (m *RealtorMutation) ServiceAreasCleared() bool {
+	_, ok := m.clearedFields[realtor.FieldServiceAreas]
+	return ok
+}
+
+// ResetServiceAreas resets all changes to the "service_areas" field.
+func (m *RealtorMutation) ResetServiceAreas() {
+	m.service_areas = nil
+	m.appendservice_areas = nil
+	delete(m.clearedFields, realtor.FieldServiceAreas)
+}
+
+// SetActive sets the "active" field.
+func (m *RealtorMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *RealtorMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *RealtorMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (m *RealtorMutation) SetDeactivatedAt(t time.Time) {
+	m.deactivated_at = &t
+}
+
+// DeactivatedAt returns the value of the "deactivated_at" field in the mutation.
+func (m *RealtorMutation) DeactivatedAt() (r time.Time, exists bool) {
+	v := m.deactivated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeactivatedAt returns the old "deactivated_at" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldDeactivatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeactivatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeactivatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeactivatedAt: %w", err)
+	}
+	return oldValue.DeactivatedAt, nil
+}
+
+// ClearDeactivatedAt clears the value of the "deactivated_at" field.
+func (m *RealtorMutation) ClearDeactivatedAt() {
+	m.deactivated_at = nil
+	m.clearedFields[realtor.FieldDeactivatedAt] = struct{}{}
+}
+
+// DeactivatedAtCleared returns if the "deactivated_at" field was cleared in this mutation.
+func (m *RealtorMutation) DeactivatedAtCleared() bool {
+	_, ok := m.clearedFields[realtor.FieldDeactivatedAt]
+	return ok
+}
+
+// ResetDeactivatedAt resets all changes to the "deactivated_at" field.
+func (m *RealtorMutation) ResetDeactivatedAt() {
+	m.deactivated_at = nil
+	delete(m.clearedFields, realtor.FieldDeactivatedAt)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RealtorMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RealtorMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *RealtorMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[realtor.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *RealtorMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[realtor.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RealtorMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, realtor.FieldUserID)
+}
+
+// SetBrokerageID sets the "brokerage_id" field.
+func (m *RealtorMutation) SetBrokerageID(u uuid.UUID) {
+	m.brokerage = &u
+}
+
+// BrokerageID returns the value of the "brokerage_id" field in the mutation.
+func (m *RealtorMutation) BrokerageID() (r uuid.UUID, exists bool) {
+	v := m.brokerage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBrokerageID returns the old "brokerage_id" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldBrokerageID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBrokerageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBrokerageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBrokerageID: %w", err)
+	}
+	return oldValue.BrokerageID, nil
+}
+
+// ClearBrokerageID clears the value of the "brokerage_id" field.
+func (m *RealtorMutation) ClearBrokerageID() {
+	m.brokerage = nil
+	m.clearedFields[realtor.FieldBrokerageID] = struct{}{}
+}
+
+// BrokerageIDCleared returns if the "brokerage_id" field was cleared in this mutation.
+func (m *RealtorMutation) BrokerageIDCleared() bool {
+	_, ok := m.clearedFields[realtor.FieldBrokerageID]
+	return ok
+}
+
+// ResetBrokerageID resets all changes to the "brokerage_id" field.
+func (m *RealtorMutation) ResetBrokerageID() {
+	m.brokerage = nil
+	delete(m.clearedFields, realtor.FieldBrokerageID)
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *RealtorMutation) SetTeamID(u uuid.UUID) {
+	m.team = &u
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *RealtorMutation) TeamID() (r uuid.UUID, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the Realtor entity.
+// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorMutation) OldTeamID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (m *RealtorMutation) ClearTeamID() {
+	m.team = nil
+	m.clearedFields[realtor.FieldTeamID] = struct{}{}
+}
+
+// TeamIDCleared returns if the "team_id" field was cleared in this mutation.
+func (m *RealtorMutation) TeamIDCleared() bool {
+	_, ok := m.clearedFields[realtor.FieldTeamID]
+	return ok
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *RealtorMutation) ResetTeamID() {
+	m.team = nil
+	delete(m.clearedFields, realtor.FieldTeamID)
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by ids.
+func (m *RealtorMutation) AddListingIDs(ids ...uuid.UUID) {
+	if m.listings == nil {
+		m.listings = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.listings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearListings clears the "listings" edge to the Listing entity.
+func (m *RealtorMutation) ClearListings() {
+	m.clearedlistings = true
+}
+
+// ListingsCleared reports if the "listings" edge to the Listing entity was cleared.
+func (m *RealtorMutation) ListingsCleared() bool {
+	return m.clearedlistings
+}
+
+// RemoveListingIDs removes the "listings" edge to the Listing entity by IDs.
+func (m *RealtorMutation) RemoveListingIDs(ids ...uuid.UUID) {
+	if m.removedlistings == nil {
+		m.removedlistings = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.listings, ids[i])
+		m.removedlistings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedListings returns the removed IDs of the "listings" edge to the Listing entity.
+func (m *RealtorMutation) RemovedListingsIDs() (ids []uuid.UUID) {
+	for id := range m.removedlistings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ListingsIDs returns the "listings" edge IDs in the mutation.
+func (m *RealtorMutation) ListingsIDs() (ids []uuid.UUID) {
+	for id := range m.listings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetListings resets all changes to the "listings" edge.
+func (m *RealtorMutation) ResetListings() {
+	m.listings = nil
+	m.clearedlistings = false
+	m.removedlistings = nil
+}
+
+// AddBuyerSideSaleIDs adds the "buyer_side_sales" edge to the Listing entity by ids.
+func (m *RealtorMutation) AddBuyerSideSaleIDs(ids ...uuid.UUID) {
+	if m.buyer_side_sales == nil {
+		m.buyer_side_sales = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.buyer_side_sales[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBuyerSideSales clears the "buyer_side_sales" edge to the Listing entity.
+func (m *RealtorMutation) ClearBuyerSideSales() {
+	m.clearedbuyer_side_sales = true
+}
+
+// BuyerSideSalesCleared reports if the "buyer_side_sales" edge to the Listing entity was cleared.
+func (m *RealtorMutation) BuyerSideSalesCleared() bool {
+	return m.clearedbuyer_side_sales
+}
+
+// RemoveBuyerSideSaleIDs removes the "buyer_side_sales" edge to the Listing entity by IDs.
+func (m *RealtorMutation) RemoveBuyerSideSaleIDs(ids ...uuid.UUID) {
+	if m.removedbuyer_side_sales == nil {
+		m.removedbuyer_side_sales = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.buyer_side_sales, ids[i])
+		m.removedbuyer_side_sales[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBuyerSideSales returns the removed IDs of the "buyer_side_sales" edge to the Listing entity.
+func (m *RealtorMutation) RemovedBuyerSideSalesIDs() (ids []uuid.UUID) {
+	for id := range m.removedbuyer_side_sales {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BuyerSideSalesIDs returns the "buyer_side_sales" edge IDs in the mutation.
+func (m *RealtorMutation) BuyerSideSalesIDs() (ids []uuid.UUID) {
+	for id := range m.buyer_side_sales {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBuyerSideSales resets all changes to the "buyer_side_sales" edge.
+func (m *RealtorMutation) ResetBuyerSideSales() {
+	m.buyer_side_sales = nil
+	m.clearedbuyer_side_sales = false
+	m.removedbuyer_side_sales = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RealtorMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[realtor.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RealtorMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RealtorMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RealtorMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddInviteIDs adds the "invites" edge to the RealtorInvite entity by ids.
+func (m *RealtorMutation) AddInviteIDs(ids ...uuid.UUID) {
+	if m.invites == nil {
+		m.invites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.invites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvites clears the "invites" edge to the RealtorInvite entity.
+func (m *RealtorMutation) ClearInvites() {
+	m.clearedinvites = true
+}
+
+// InvitesCleared reports if the "invites" edge to the RealtorInvite entity was cleared.
+func (m *RealtorMutation) InvitesCleared() bool {
+	return m.clearedinvites
+}
+
+// RemoveInviteIDs removes the "invites" edge to the RealtorInvite entity by IDs.
+func (m *RealtorMutation) RemoveInviteIDs(ids ...uuid.UUID) {
+	if m.removedinvites == nil {
+		m.removedinvites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.invites, ids[i])
+		m.removedinvites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvites returns the removed IDs of the "invites" edge to the RealtorInvite entity.
+func (m *RealtorMutation) RemovedInvitesIDs() (ids []uuid.UUID) {
+	for id := range m.removedinvites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvitesIDs returns the "invites" edge IDs in the mutation.
+func (m *RealtorMutation) InvitesIDs() (ids []uuid.UUID) {
+	for id := range m.invites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvites resets all changes to the "invites" edge.
+func (m *RealtorMutation) ResetInvites() {
+	m.invites = nil
+	m.clearedinvites = false
+	m.removedinvites = nil
+}
+
+// ClearBrokerage clears the "brokerage" edge to the Brokerage entity.
+func (m *RealtorMutation) ClearBrokerage() {
+	m.clearedbrokerage = true
+	m.clearedFields[realtor.FieldBrokerageID] = struct{}{}
+}
+
+// BrokerageCleared reports if the "brokerage" edge to the Brokerage entity was cleared.
+func (m *RealtorMutation) BrokerageCleared() bool {
+	return m.BrokerageIDCleared() || m.clearedbrokerage
+}
+
+// BrokerageIDs returns the "brokerage" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BrokerageID instead. It exists only for internal usage by the builders.
+func (m *RealtorMutation) BrokerageIDs() (ids []uuid.UUID) {
+	if id := m.brokerage; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBrokerage resets all changes to the "brokerage" edge.
+func (m *RealtorMutation) ResetBrokerage() {
+	m.brokerage = nil
+	m.clearedbrokerage = false
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *RealtorMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[realtor.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *RealtorMutation) TeamCleared() bool {
+	return m.TeamIDCleared() || m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *RealtorMutation) TeamIDs() (ids []uuid.UUID) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *RealtorMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// AddLedTeamIDs adds the "led_teams" edge to the Team entity by ids.
+func (m *RealtorMutation) AddLedTeamIDs(ids ...uuid.UUID) {
+	if m.led_teams == nil {
+		m.led_teams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.led_teams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLedTeams clears the "led_teams" edge to the Team entity.
+func (m *RealtorMutation) ClearLedTeams() {
+	m.clearedled_teams = true
+}
+
+// LedTeamsCleared reports if the "led_teams" edge to the Team entity was cleared.
+func (m *RealtorMutation) LedTeamsCleared() bool {
+	return m.clearedled_teams
+}
+
+// RemoveLedTeamIDs removes the "led_teams" edge to the Team entity by IDs.
+func (m *RealtorMutation) RemoveLedTeamIDs(ids ...uuid.UUID) {
+	if m.removedled_teams == nil {
+		m.removedled_teams = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.led_teams, ids[i])
+		m.removedled_teams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLedTeams returns the removed IDs of the "led_teams" edge to the Team entity.
+func (m *RealtorMutation) RemovedLedTeamsIDs() (ids []uuid.UUID) {
+	for id := range m.removedled_teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LedTeamsIDs returns the "led_teams" edge IDs in the mutation.
+func (m *RealtorMutation) LedTeamsIDs() (ids []uuid.UUID) {
+	for id := range m.led_teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLedTeams resets all changes to the "led_teams" edge.
+func (m *RealtorMutation) ResetLedTeams() {
+	m.led_teams = nil
+	m.clearedled_teams = false
+	m.removedled_teams = nil
+}
+
+// Where appends a list predicates to the RealtorMutation builder.
+func (m *RealtorMutation) Where(ps ...predicate.Realtor) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RealtorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RealtorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Realtor, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RealtorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RealtorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Realtor).
+func (m *RealtorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RealtorMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.create_time != nil {
+		fields = append(fields, realtor.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, realtor.FieldUpdateTime)
+	}
+	if m.full_name != nil {
+		fields = append(fields, realtor.FieldFullName)
+	}
+	if m.photo != nil {
+		fields = append(fields, realtor.FieldPhoto)
+	}
+	if m.description != nil {
+		fields = append(fields, realtor.FieldDescription)
+	}
+	if m.phone != nil {
+		fields = append(fields, realtor.FieldPhone)
+	}
+	if m.email != nil {
+		fields = append(fields, realtor.FieldEmail)
+	}
+	if m.is_mvp != nil {
+		fields = append(fields, realtor.FieldIsMvp)
+	}
+	if m.hire_date != nil {
+		fields = append(fields, realtor.FieldHireDate)
+	}
+	if m.service_areas != nil {
+		fields = append(fields, realtor.FieldServiceAreas)
+	}
+	if m.active != nil {
+		fields = append(fields, realtor.FieldActive)
+	}
+	if m.deactivated_at != nil {
+		fields = append(fields, realtor.FieldDeactivatedAt)
+	}
+	if m.user != nil {
+		fields = append(fields, realtor.FieldUserID)
+	}
+	if m.brokerage != nil {
+		fields = append(fields, realtor.FieldBrokerageID)
+	}
+	if m.team != nil {
+		fields = append(fields, realtor.FieldTeamID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RealtorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case realtor.FieldCreateTime:
+		return m.CreateTime()
+	case realtor.FieldUpdateTime:
+		return m.UpdateTime()
+	case realtor.FieldFullName:
+		return m.FullName()
+	case realtor.FieldPhoto:
+		return m.Photo()
+	case realtor.FieldDescription:
+		return m.Description()
+	case realtor.FieldPhone:
+		return m.Phone()
+	case realtor.FieldEmail:
+		return m.Email()
+	case realtor.FieldIsMvp:
+		return m.IsMvp()
+	case realtor.FieldHireDate:
+		return m.HireDate()
+	case realtor.FieldServiceAreas:
+		return m.ServiceAreas()
+	case realtor.FieldActive:
+		return m.Active()
+	case realtor.FieldDeactivatedAt:
+		return m.DeactivatedAt()
+	case realtor.FieldUserID:
+		return m.UserID()
+	case realtor.FieldBrokerageID:
+		return m.BrokerageID()
+	case realtor.FieldTeamID:
+		return m.TeamID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RealtorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case realtor.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case realtor.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case realtor.FieldFullName:
+		return m.OldFullName(ctx)
+	case realtor.FieldPhoto:
+		return m.OldPhoto(ctx)
+	case realtor.FieldDescription:
+		return m.OldDescription(ctx)
+	case realtor.FieldPhone:
+		return m.OldPhone(ctx)
+	case realtor.FieldEmail:
+		return m.OldEmail(ctx)
+	case realtor.FieldIsMvp:
+		return m.OldIsMvp(ctx)
+	case realtor.FieldHireDate:
+		return m.OldHireDate(ctx)
+	case realtor.FieldServiceAreas:
+		return m.OldServiceAreas(ctx)
+	case realtor.FieldActive:
+		return m.OldActive(ctx)
+	case realtor.FieldDeactivatedAt:
+		return m.OldDeactivatedAt(ctx)
+	case realtor.FieldUserID:
+		return m.OldUserID(ctx)
+	case realtor.FieldBrokerageID:
+		return m.OldBrokerageID(ctx)
+	case realtor.FieldTeamID:
+		return m.OldTeamID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Realtor field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RealtorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case realtor.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case realtor.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case realtor.FieldFullName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFullName(v)
+		return nil
+	case realtor.FieldPhoto:
+		v, ok := value.(*schematype.Photo)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhoto(v)
+		return nil
+	case realtor.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case realtor.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case realtor.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case realtor.FieldIsMvp:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsMvp(v)
+		return nil
+	case realtor.FieldHireDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHireDate(v)
+		return nil
+	case realtor.FieldServiceAreas:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServiceAreas(v)
+		return nil
+	case realtor.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case realtor.FieldDeactivatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeactivatedAt(v)
+		return nil
+	case realtor.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case realtor.FieldBrokerageID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBrokerageID(v)
+		return nil
+	case realtor.FieldTeamID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Realtor field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RealtorMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RealtorMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RealtorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Realtor numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RealtorMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(realtor.FieldPhoto) {
+		fields = append(fields, realtor.FieldPhoto)
+	}
+	if m.FieldCleared(realtor.FieldDescription) {
+		fields = append(fields, realtor.FieldDescription)
+	}
+	if m.FieldCleared(realtor.FieldServiceAreas) {
+		fields = append(fields, realtor.FieldServiceAreas)
+	}
+	if m.FieldCleared(realtor.FieldDeactivatedAt) {
+		fields = append(fields, realtor.FieldDeactivatedAt)
+	}
+	if m.FieldCleared(realtor.FieldUserID) {
+		fields = append(fields, realtor.FieldUserID)
+	}
+	if m.FieldCleared(realtor.FieldBrokerageID) {
+		fields = append(fields, realtor.FieldBrokerageID)
+	}
+	if m.FieldCleared(realtor.FieldTeamID) {
+		fields = append(fields, realtor.FieldTeamID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RealtorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RealtorMutation) ClearField(name string) error {
+	switch name {
+	case realtor.FieldPhoto:
+		m.ClearPhoto()
+		return nil
+	case realtor.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case realtor.FieldServiceAreas:
+		m.ClearServiceAreas()
+		return nil
+	case realtor.FieldDeactivatedAt:
+		m.ClearDeactivatedAt()
+		return nil
+	case realtor.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case realtor.FieldBrokerageID:
+		m.ClearBrokerageID()
+		return nil
+	case realtor.FieldTeamID:
+		m.ClearTeamID()
+		return nil
+	}
+	return fmt.Errorf("unknown Realtor nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RealtorMutation) ResetField(name string) error {
+	switch name {
+	case realtor.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case realtor.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case realtor.FieldFullName:
+		m.ResetFullName()
+		return nil
+	case realtor.FieldPhoto:
+		m.ResetPhoto()
+		return nil
+	case realtor.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case realtor.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case realtor.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case realtor.FieldIsMvp:
+		m.ResetIsMvp()
+		return nil
+	case realtor.FieldHireDate:
+		m.ResetHireDate()
+		return nil
+	case realtor.FieldServiceAreas:
+		m.ResetServiceAreas()
+		return nil
+	case realtor.FieldActive:
+		m.ResetActive()
+		return nil
+	case realtor.FieldDeactivatedAt:
+		m.ResetDeactivatedAt()
+		return nil
+	case realtor.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case realtor.FieldBrokerageID:
+		m.ResetBrokerageID()
+		return nil
+	case realtor.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	}
+	return fmt.Errorf("unknown Realtor field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RealtorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.listings != nil {
+		edges = append(edges, realtor.EdgeListings)
+	}
+	if m.buyer_side_sales != nil {
+		edges = append(edges, realtor.EdgeBuyerSideSales)
+	}
+	if m.user != nil {
+		edges = append(edges, realtor.EdgeUser)
+	}
+	if m.invites != nil {
+		edges = append(edges, realtor.EdgeInvites)
+	}
+	if m.brokerage != nil {
+		edges = append(edges, realtor.EdgeBrokerage)
+	}
+	if m.team != nil {
+		edges = append(edges, realtor.EdgeTeam)
+	}
+	if m.led_teams != nil {
+		edges = append(edges, realtor.EdgeLedTeams)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RealtorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case realtor.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.listings))
+		for id := range m.listings {
+			ids = append(ids, id)
+		}
+		return ids
+	case realtor.EdgeBuyerSideSales:
+		ids := make([]ent.Value, 0, len(m.buyer_side_sales))
+		for id := range m.buyer_side_sales {
+			ids = append(ids, id)
+		}
+		return ids
+	case realtor.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case realtor.EdgeInvites:
+		ids := make([]ent.Value, 0, len(m.invites))
+		for id := range m.invites {
+			ids = append(ids, id)
+		}
+		return ids
+	case realtor.EdgeBrokerage:
+		if id := m.brokerage; id != nil {
+			return []ent.Value{*id}
+		}
+	case realtor.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case realtor.EdgeLedTeams:
+		ids := make([]ent.Value, 0, len(m.led_teams))
+		for id := range m.led_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RealtorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedlistings != nil {
+		edges = append(edges, realtor.EdgeListings)
+	}
+	if m.removedbuyer_side_sales != nil {
+		edges = append(edges, realtor.EdgeBuyerSideSales)
+	}
+	if m.removedinvites != nil {
+		edges = append(edges, realtor.EdgeInvites)
+	}
+	if m.removedled_teams != nil {
+		edges = append(edges, realtor.EdgeLedTeams)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RealtorMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case realtor.EdgeListings:
+		ids := make([]ent.Value, 0, len(m.removedlistings))
+		for id := range m.removedlistings {
+			ids = append(ids, id)
+		}
+		return ids
+	case realtor.EdgeBuyerSideSales:
+		ids := make([]ent.Value, 0, len(m.removedbuyer_side_sales))
+		for id := range m.removedbuyer_side_sales {
+			ids = append(ids, id)
+		}
+		return ids
+	case realtor.EdgeInvites:
+		ids := make([]ent.Value, 0, len(m.removedinvites))
+		for id := range m.removedinvites {
+			ids = append(ids, id)
+		}
+		return ids
+	case realtor.EdgeLedTeams:
+		ids := make([]ent.Value, 0, len(m.removedled_teams))
+		for id := range m.removedled_teams {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RealtorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedlistings {
+		edges = append(edges, realtor.EdgeListings)
+	}
+	if m.clearedbuyer_side_sales {
+		edges = append(edges, realtor.EdgeBuyerSideSales)
+	}
+	if m.cleareduser {
+		edges = append(edges, realtor.EdgeUser)
+	}
+	if m.clearedinvites {
+		edges = append(edges, realtor.EdgeInvites)
+	}
+	if m.clearedbrokerage {
+		edges = append(edges, realtor.EdgeBrokerage)
+	}
+	if m.clearedteam {
+		edges = append(edges, realtor.EdgeTeam)
+	}
+	if m.clearedled_teams {
+		edges = append(edges, realtor.EdgeLedTeams)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RealtorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case realtor.EdgeListings:
+		return m.clearedlistings
+	case realtor.EdgeBuyerSideSales:
+		return m.clearedbuyer_side_sales
+	case realtor.EdgeUser:
+		return m.cleareduser
+	case realtor.EdgeInvites:
+		return m.clearedinvites
+	case realtor.EdgeBrokerage:
+		return m.clearedbrokerage
+	case realtor.EdgeTeam:
+		return m.clearedteam
+	case realtor.EdgeLedTeams:
+		return m.clearedled_teams
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RealtorMutation) ClearEdge(name string) error {
+	switch name {
+	case realtor.EdgeUser:
+		m.ClearUser()
+		return nil
+	case realtor.EdgeBrokerage:
+		m.ClearBrokerage()
+		return nil
+	case realtor.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	}
+	return fmt.Errorf("unknown Realtor unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RealtorMutation) ResetEdge(name string) error {
+	switch name {
+	case realtor.EdgeListings:
+		m.ResetListings()
+		return nil
+	case realtor.EdgeBuyerSideSales:
+		m.ResetBuyerSideSales()
+		return nil
+	case realtor.EdgeUser:
+		m.ResetUser()
+		return nil
+	case realtor.EdgeInvites:
+		m.ResetInvites()
+		return nil
+	case realtor.EdgeBrokerage:
+		m.ResetBrokerage()
+		return nil
+	case realtor.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case realtor.EdgeLedTeams:
+		m.ResetLedTeams()
+		return nil
+	}
+	return fmt.Errorf("unknown Realtor edge %s", name)
+}
+
+// RealtorInviteMutation represents an operation that mutates the RealtorInvite nodes in the graph.
+type RealtorInviteMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	email          *string
+	token_hash     *string
+	invited_by     *uuid.UUID
+	status         *realtorinvite.Status
+	expires_at     *time.Time
+	accepted_by    *uuid.UUID
+	accepted_at    *time.Time
+	clearedFields  map[string]struct{}
+	realtor        *uuid.UUID
+	clearedrealtor bool
+	done           bool
+	oldValue       func(context.Context) (*RealtorInvite, error)
+	predicates     []predicate.RealtorInvite
+}
+
+var _ ent.Mutation = (*RealtorInviteMutation)(nil)
+
+// realtorinviteOption allows management of the mutation configuration using functional options.
+type realtorinviteOption func(*RealtorInviteMutation)
+
+// newRealtorInviteMutation creates new mutation for the RealtorInvite entity.
+func newRealtorInviteMutation(c config, op Op, opts ...realtorinviteOption) *RealtorInviteMutation {
+	m := &RealtorInviteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRealtorInvite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRealtorInviteID sets the ID field of the mutation.
+func withRealtorInviteID(id uuid.UUID) realtorinviteOption {
+	return func(m *RealtorInviteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RealtorInvite
+		)
+		m.oldValue = func(ctx context.Context) (*RealtorInvite, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RealtorInvite.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRealtorInvite sets the old RealtorInvite of the mutation.
+func withRealtorInvite(node *RealtorInvite) realtorinviteOption {
+	return func(m *RealtorInviteMutation) {
+		m.oldValue = func(context.Context) (*RealtorInvite, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RealtorInviteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RealtorInviteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RealtorInvite entities.
+func (m *RealtorInviteMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RealtorInviteMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RealtorInviteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RealtorInvite.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *RealtorInviteMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *RealtorInviteMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the RealtorInvite entity.
+// If the RealtorInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorInviteMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPool requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPool: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.Pool, nil
+	return oldValue.CreateTime, nil
 }
 
-// ClearPool clears the value of the "pool" field.
-func (m *ListingMutation) ClearPool() {
-	m.pool = nil
-	m.clearedFields[listing.FieldPool] = struct{}{}
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *RealtorInviteMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// PoolCleared returns if the "pool" field was cleared in this mutation.
-func (m *ListingMutation) PoolCleared() bool {
-	_, ok := m.clearedFields[listing.FieldPool]
-	return ok
+// SetUpdateTime sets the "update_time" field.
+func (m *RealtorInviteMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// ResetPool resets all changes to the "pool" field.
-func (m *ListingMutation) ResetPool() {
-	m.pool = nil
-	delete(m.clearedFields, listing.FieldPool)
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *RealtorInviteMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetYearBuilt sets the "year_built" field.
-func (m *ListingMutation) SetYearBuilt(i int) {
-	m.year_built = &i
-	m.addyear_built = nil
+// OldUpdateTime returns the old "update_time" field's value of the RealtorInvite entity.
+// If the RealtorInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorInviteMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *RealtorInviteMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (m *RealtorInviteMutation) SetRealtorID(u uuid.UUID) {
+	m.realtor = &u
+}
+
+// RealtorID returns the value of the "realtor_id" field in the mutation.
+func (m *RealtorInviteMutation) RealtorID() (r uuid.UUID, exists bool) {
+	v := m.realtor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRealtorID returns the old "realtor_id" field's value of the RealtorInvite entity.
+// If the RealtorInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorInviteMutation) OldRealtorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRealtorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRealtorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRealtorID: %w", err)
+	}
+	return oldValue.RealtorID, nil
+}
+
+// ResetRealtorID resets all changes to the "realtor_id" field.
+func (m *RealtorInviteMutation) ResetRealtorID() {
+	m.realtor = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *RealtorInviteMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *RealtorInviteMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the RealtorInvite entity.
+// If the RealtorInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorInviteMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *RealtorInviteMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *RealtorInviteMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *RealtorInviteMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the RealtorInvite entity.
+// If the RealtorInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorInviteMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ClearTokenHash clears the value of the "token_hash" field.
+func (m *RealtorInviteMutation) ClearTokenHash() {
+	m.token_hash = nil
+	m.clearedFields[realtorinvite.FieldTokenHash] = struct{}{}
+}
+
+// TokenHashCleared returns if the "token_hash" field was cleared in this mutation.
+func (m *RealtorInviteMutation) TokenHashCleared() bool {
+	_, ok := m.clearedFields[realtorinvite.FieldTokenHash]
+	return ok
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *RealtorInviteMutation) ResetTokenHash() {
+	m.token_hash = nil
+	delete(m.clearedFields, realtorinvite.FieldTokenHash)
 }
 
-// YearBuilt returns the value of the "year_built" field in the mutation.
-func (m *ListingMutation) YearBuilt() (r int, exists bool) {
-	v := m.year_built
+// SetInvitedBy sets the "invited_by" field.
+func (m *RealtorInviteMutation) SetInvitedBy(u uuid.UUID) {
+	m.invited_by = &u
+}
+
+// InvitedBy returns the value of the "invited_by" field in the mutation.
+func (m *RealtorInviteMutation) InvitedBy() (r uuid.UUID, exists bool) {
+	v := m.invited_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldYearBuilt returns the old "year_built" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldInvitedBy returns the old "invited_by" field's value of the RealtorInvite entity.
+// If the RealtorInvite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldYearBuilt(ctx context.Context) (v int, err error) {
+func (m *RealtorInviteMutation) OldInvitedBy(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldYearBuilt is only allowed on UpdateOne operations")
+		return v, errors.New("OldInvitedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldYearBuilt requires an ID field in the mutation")
+		return v, errors.New("OldInvitedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldYearBuilt: %w", err)
+		return v, fmt.Errorf("querying old value for OldInvitedBy: %w", err)
 	}
-	return oldValue.YearBuilt, nil
+	return oldValue.InvitedBy, nil
 }
 
-// AddYearBuilt adds i to the "year_built" field.
-func (m *ListingMutation) AddYearBuilt(i int) {
-	if m.addyear_built != nil {
-		*m.addyear_built += i
-	} else {
-		m.addyear_built = &i
-	}
+// ResetInvitedBy resets all changes to the "invited_by" field.
+func (m *RealtorInviteMutation) ResetInvitedBy() {
+	m.invited_by = nil
 }
 
-// AddedYearBuilt returns the value that was added to the "year_built" field in this mutation.
-func (m *ListingMutation) AddedYearBuilt() (r int, exists bool) {
-	v := m.addyear_built
+// SetStatus sets the "status" field.
+func (m *RealtorInviteMutation) SetStatus(r realtorinvite.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RealtorInviteMutation) Status() (r realtorinvite.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetYearBuilt resets all changes to the "year_built" field.
-func (m *ListingMutation) ResetYearBuilt() {
-	m.year_built = nil
-	m.addyear_built = nil
+// OldStatus returns the old "status" field's value of the RealtorInvite entity.
+// If the RealtorInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorInviteMutation) OldStatus(ctx context.Context) (v realtorinvite.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// SetMedia sets the "media" field.
-func (m *ListingMutation) SetMedia(s []schema.Media) {
-	m.media = &s
-	m.appendmedia = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *RealtorInviteMutation) ResetStatus() {
+	m.status = nil
 }
 
-// Media returns the value of the "media" field in the mutation.
-func (m *ListingMutation) Media() (r []schema.Media, exists bool) {
-	v := m.media
+// SetExpiresAt sets the "expires_at" field.
+func (m *RealtorInviteMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RealtorInviteMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMedia returns the old "media" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the RealtorInvite entity.
+// If the RealtorInvite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldMedia(ctx context.Context) (v []schema.Media, err error) {
+func (m *RealtorInviteMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMedia is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMedia requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMedia: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Media, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// AppendMedia adds s to the "media" field.
-func (m *ListingMutation) AppendMedia(s []schema.Media) {
-	m.appendmedia = append(m.appendmedia, s...)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RealtorInviteMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// AppendedMedia returns the list of values that were appended to the "media" field in this mutation.
-func (m *ListingMutation) AppendedMedia() ([]schema.Media, bool) {
-	if len(m.appendmedia) == 0 {
-		return nil, false
+// SetAcceptedBy sets the "accepted_by" field.
+func (m *RealtorInviteMutation) SetAcceptedBy(u uuid.UUID) {
+	m.accepted_by = &u
+}
+
+// AcceptedBy returns the value of the "accepted_by" field in the mutation.
+func (m *RealtorInviteMutation) AcceptedBy() (r uuid.UUID, exists bool) {
+	v := m.accepted_by
+	if v == nil {
+		return
 	}
-	return m.appendmedia, true
+	return *v, true
 }
 
-// ClearMedia clears the value of the "media" field.
-func (m *ListingMutation) ClearMedia() {
-	m.media = nil
-	m.appendmedia = nil
-	m.clearedFields[listing.FieldMedia] = struct{}{}
+// OldAcceptedBy returns the old "accepted_by" field's value of the RealtorInvite entity.
+// If the RealtorInvite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RealtorInviteMutation) OldAcceptedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcceptedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcceptedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcceptedBy: %w", err)
+	}
+	return oldValue.AcceptedBy, nil
 }
 
-// MediaCleared returns if the "media" field was cleared in this mutation.
-func (m *ListingMutation) MediaCleared() bool {
-	_, ok := m.clearedFields[listing.FieldMedia]
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (m *RealtorInviteMutation) ClearAcceptedBy() {
+	m.accepted_by = nil
+	m.clearedFields[realtorinvite.FieldAcceptedBy] = struct{}{}
+}
+
+// AcceptedByCleared returns if the "accepted_by" field was cleared in this mutation.
+func (m *RealtorInviteMutation) AcceptedByCleared() bool {
+	_, ok := m.clearedFields[realtorinvite.FieldAcceptedBy]
 	return ok
 }
 
-// ResetMedia resets all changes to the "media" field.
-func (m *ListingMutation) ResetMedia() {
-	m.media = nil
-	m.appendmedia = nil
-	delete(m.clearedFields, listing.FieldMedia)
+// ResetAcceptedBy resets all changes to the "accepted_by" field.
+func (m *RealtorInviteMutation) ResetAcceptedBy() {
+	m.accepted_by = nil
+	delete(m.clearedFields, realtorinvite.FieldAcceptedBy)
 }
 
-// SetRealtorID sets the "realtor_id" field.
-func (m *ListingMutation) SetRealtorID(u uuid.UUID) {
-	m.realtor = &u
+// SetAcceptedAt sets the "accepted_at" field.
+func (m *RealtorInviteMutation) SetAcceptedAt(t time.Time) {
+	m.accepted_at = &t
 }
 
-// RealtorID returns the value of the "realtor_id" field in the mutation.
-func (m *ListingMutation) RealtorID() (r uuid.UUID, exists bool) {
-	v := m.realtor
+// AcceptedAt returns the value of the "accepted_at" field in the mutation.
+func (m *RealtorInviteMutation) AcceptedAt() (r time.Time, exists bool) {
+	v := m.accepted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRealtorID returns the old "realtor_id" field's value of the Listing entity.
-// If the Listing object wasn't provided to the builder, the object is fetched from the database.
+// OldAcceptedAt returns the old "accepted_at" field's value of the RealtorInvite entity.
+// If the RealtorInvite object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ListingMutation) OldRealtorID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *RealtorInviteMutation) OldAcceptedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRealtorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAcceptedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRealtorID requires an ID field in the mutation")
+		return v, errors.New("OldAcceptedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRealtorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAcceptedAt: %w", err)
 	}
-	return oldValue.RealtorID, nil
+	return oldValue.AcceptedAt, nil
 }
 
-// ResetRealtorID resets all changes to the "realtor_id" field.
-func (m *ListingMutation) ResetRealtorID() {
-	m.realtor = nil
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (m *RealtorInviteMutation) ClearAcceptedAt() {
+	m.accepted_at = nil
+	m.clearedFields[realtorinvite.FieldAcceptedAt] = struct{}{}
+}
+
+// AcceptedAtCleared returns if the "accepted_at" field was cleared in this mutation.
+func (m *RealtorInviteMutation) AcceptedAtCleared() bool {
+	_, ok := m.clearedFields[realtorinvite.FieldAcceptedAt]
+	return ok
+}
+
+// ResetAcceptedAt resets all changes to the "accepted_at" field.
+func (m *RealtorInviteMutation) ResetAcceptedAt() {
+	m.accepted_at = nil
+	delete(m.clearedFields, realtorinvite.FieldAcceptedAt)
 }
 
 // ClearRealtor clears the "realtor" edge to the Realtor entity.
-func (m *ListingMutation) ClearRealtor() {
+func (m *RealtorInviteMutation) ClearRealtor() {
 	m.clearedrealtor = true
-	m.clearedFields[listing.FieldRealtorID] = struct{}{}
+	m.clearedFields[realtorinvite.FieldRealtorID] = struct{}{}
 }
 
 // RealtorCleared reports if the "realtor" edge to the Realtor entity was cleared.
-func (m *ListingMutation) RealtorCleared() bool {
+func (m *RealtorInviteMutation) RealtorCleared() bool {
 	return m.clearedrealtor
 }
 
 // RealtorIDs returns the "realtor" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // RealtorID instead. It exists only for internal usage by the builders.
-func (m *ListingMutation) RealtorIDs() (ids []uuid.UUID) {
+func (m *RealtorInviteMutation) RealtorIDs() (ids []uuid.UUID) {
 	if id := m.realtor; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1144,20 +19623,20 @@ func (m *ListingMutation) RealtorIDs() (ids []uuid.UUID) {
 }
 
 // ResetRealtor resets all changes to the "realtor" edge.
-func (m *ListingMutation) ResetRealtor() {
+func (m *RealtorInviteMutation) ResetRealtor() {
 	m.realtor = nil
 	m.clearedrealtor = false
 }
 
-// Where appends a list predicates to the ListingMutation builder.
-func (m *ListingMutation) Where(ps ...predicate.Listing) {
+// Where appends a list predicates to the RealtorInviteMutation builder.
+func (m *RealtorInviteMutation) Where(ps ...predicate.RealtorInvite) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ListingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RealtorInviteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ListingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Listing, len(ps))
+func (m *RealtorInviteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RealtorInvite, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1165,84 +19644,54 @@ func (m *ListingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ListingMutation) Op() Op {
+func (m *RealtorInviteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ListingMutation) SetOp(op Op) {
+func (m *RealtorInviteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Listing).
-func (m *ListingMutation) Type() string {
+// Type returns the node type of this mutation (RealtorInvite).
+func (m *RealtorInviteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ListingMutation) Fields() []string {
-	fields := make([]string, 0, 20)
+func (m *RealtorInviteMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.create_time != nil {
-		fields = append(fields, listing.FieldCreateTime)
+		fields = append(fields, realtorinvite.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, listing.FieldUpdateTime)
-	}
-	if m.title != nil {
-		fields = append(fields, listing.FieldTitle)
-	}
-	if m.address != nil {
-		fields = append(fields, listing.FieldAddress)
-	}
-	if m.city != nil {
-		fields = append(fields, listing.FieldCity)
-	}
-	if m.state != nil {
-		fields = append(fields, listing.FieldState)
-	}
-	if m.zip_code != nil {
-		fields = append(fields, listing.FieldZipCode)
-	}
-	if m.description != nil {
-		fields = append(fields, listing.FieldDescription)
-	}
-	if m.price != nil {
-		fields = append(fields, listing.FieldPrice)
-	}
-	if m.bedroom != nil {
-		fields = append(fields, listing.FieldBedroom)
+		fields = append(fields, realtorinvite.FieldUpdateTime)
 	}
-	if m.bathroom != nil {
-		fields = append(fields, listing.FieldBathroom)
+	if m.realtor != nil {
+		fields = append(fields, realtorinvite.FieldRealtorID)
 	}
-	if m.garage != nil {
-		fields = append(fields, listing.FieldGarage)
+	if m.email != nil {
+		fields = append(fields, realtorinvite.FieldEmail)
 	}
-	if m.sqft != nil {
-		fields = append(fields, listing.FieldSqft)
+	if m.token_hash != nil {
+		fields = append(fields, realtorinvite.FieldTokenHash)
 	}
-	if m.type_of_property != nil {
-		fields = append(fields, listing.FieldTypeOfProperty)
+	if m.invited_by != nil {
+		fields = append(fields, realtorinvite.FieldInvitedBy)
 	}
 	if m.status != nil {
-		fields = append(fields, listing.FieldStatus)
-	}
-	if m.lot_size != nil {
-		fields = append(fields, listing.FieldLotSize)
+		fields = append(fields, realtorinvite.FieldStatus)
 	}
-	if m.pool != nil {
-		fields = append(fields, listing.FieldPool)
-	}
-	if m.year_built != nil {
-		fields = append(fields, listing.FieldYearBuilt)
+	if m.expires_at != nil {
+		fields = append(fields, realtorinvite.FieldExpiresAt)
 	}
-	if m.media != nil {
-		fields = append(fields, listing.FieldMedia)
+	if m.accepted_by != nil {
+		fields = append(fields, realtorinvite.FieldAcceptedBy)
 	}
-	if m.realtor != nil {
-		fields = append(fields, listing.FieldRealtorID)
+	if m.accepted_at != nil {
+		fields = append(fields, realtorinvite.FieldAcceptedAt)
 	}
 	return fields
 }
@@ -1250,48 +19699,28 @@ func (m *ListingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ListingMutation) Field(name string) (ent.Value, bool) {
+func (m *RealtorInviteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case listing.FieldCreateTime:
+	case realtorinvite.FieldCreateTime:
 		return m.CreateTime()
-	case listing.FieldUpdateTime:
+	case realtorinvite.FieldUpdateTime:
 		return m.UpdateTime()
-	case listing.FieldTitle:
-		return m.Title()
-	case listing.FieldAddress:
-		return m.Address()
-	case listing.FieldCity:
-		return m.City()
-	case listing.FieldState:
-		return m.State()
-	case listing.FieldZipCode:
-		return m.ZipCode()
-	case listing.FieldDescription:
-		return m.Description()
-	case listing.FieldPrice:
-		return m.Price()
-	case listing.FieldBedroom:
-		return m.Bedroom()
-	case listing.FieldBathroom:
-		return m.Bathroom()
-	case listing.FieldGarage:
-		return m.Garage()
-	case listing.FieldSqft:
-		return m.Sqft()
-	case listing.FieldTypeOfProperty:
-		return m.TypeOfProperty()
-	case listing.FieldStatus:
-		return m.Status()
-	case listing.FieldLotSize:
-		return m.LotSize()
-	case listing.FieldPool:
-		return m.Pool()
-	case listing.FieldYearBuilt:
-		return m.YearBuilt()
-	case listing.FieldMedia:
-		return m.Media()
-	case listing.FieldRealtorID:
+	case realtorinvite.FieldRealtorID:
 		return m.RealtorID()
+	case realtorinvite.FieldEmail:
+		return m.Email()
+	case realtorinvite.FieldTokenHash:
+		return m.TokenHash()
+	case realtorinvite.FieldInvitedBy:
+		return m.InvitedBy()
+	case realtorinvite.FieldStatus:
+		return m.Status()
+	case realtorinvite.FieldExpiresAt:
+		return m.ExpiresAt()
+	case realtorinvite.FieldAcceptedBy:
+		return m.AcceptedBy()
+	case realtorinvite.FieldAcceptedAt:
+		return m.AcceptedAt()
 	}
 	return nil, false
 }
@@ -1299,444 +19728,225 @@ func (m *ListingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ListingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RealtorInviteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case listing.FieldCreateTime:
+	case realtorinvite.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case listing.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case listing.FieldTitle:
-		return m.OldTitle(ctx)
-	case listing.FieldAddress:
-		return m.OldAddress(ctx)
-	case listing.FieldCity:
-		return m.OldCity(ctx)
-	case listing.FieldState:
-		return m.OldState(ctx)
-	case listing.FieldZipCode:
-		return m.OldZipCode(ctx)
-	case listing.FieldDescription:
-		return m.OldDescription(ctx)
-	case listing.FieldPrice:
-		return m.OldPrice(ctx)
-	case listing.FieldBedroom:
-		return m.OldBedroom(ctx)
-	case listing.FieldBathroom:
-		return m.OldBathroom(ctx)
-	case listing.FieldGarage:
-		return m.OldGarage(ctx)
-	case listing.FieldSqft:
-		return m.OldSqft(ctx)
-	case listing.FieldTypeOfProperty:
-		return m.OldTypeOfProperty(ctx)
-	case listing.FieldStatus:
-		return m.OldStatus(ctx)
-	case listing.FieldLotSize:
-		return m.OldLotSize(ctx)
-	case listing.FieldPool:
-		return m.OldPool(ctx)
-	case listing.FieldYearBuilt:
-		return m.OldYearBuilt(ctx)
-	case listing.FieldMedia:
-		return m.OldMedia(ctx)
-	case listing.FieldRealtorID:
+	case realtorinvite.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case realtorinvite.FieldRealtorID:
 		return m.OldRealtorID(ctx)
+	case realtorinvite.FieldEmail:
+		return m.OldEmail(ctx)
+	case realtorinvite.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case realtorinvite.FieldInvitedBy:
+		return m.OldInvitedBy(ctx)
+	case realtorinvite.FieldStatus:
+		return m.OldStatus(ctx)
+	case realtorinvite.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case realtorinvite.FieldAcceptedBy:
+		return m.OldAcceptedBy(ctx)
+	case realtorinvite.FieldAcceptedAt:
+		return m.OldAcceptedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Listing field %s", name)
+	return nil, fmt.Errorf("unknown RealtorInvite field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ListingMutation) SetField(name string, value ent.Value) error {
+func (m *RealtorInviteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case listing.FieldCreateTime:
+	case realtorinvite.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case listing.FieldUpdateTime:
+	case realtorinvite.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case listing.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case listing.FieldAddress:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAddress(v)
-		return nil
-	case listing.FieldCity:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCity(v)
-		return nil
-	case listing.FieldState:
-		v, ok := value.(string)
+	case realtorinvite.FieldRealtorID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetState(v)
+		m.SetRealtorID(v)
 		return nil
-	case listing.FieldZipCode:
+	case realtorinvite.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetZipCode(v)
+		m.SetEmail(v)
 		return nil
-	case listing.FieldDescription:
+	case realtorinvite.FieldTokenHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
-		return nil
-	case listing.FieldPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrice(v)
-		return nil
-	case listing.FieldBedroom:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBedroom(v)
-		return nil
-	case listing.FieldBathroom:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBathroom(v)
-		return nil
-	case listing.FieldGarage:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGarage(v)
-		return nil
-	case listing.FieldSqft:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSqft(v)
+		m.SetTokenHash(v)
 		return nil
-	case listing.FieldTypeOfProperty:
-		v, ok := value.(listing.TypeOfProperty)
+	case realtorinvite.FieldInvitedBy:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTypeOfProperty(v)
+		m.SetInvitedBy(v)
 		return nil
-	case listing.FieldStatus:
-		v, ok := value.(listing.Status)
+	case realtorinvite.FieldStatus:
+		v, ok := value.(realtorinvite.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case listing.FieldLotSize:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLotSize(v)
-		return nil
-	case listing.FieldPool:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPool(v)
-		return nil
-	case listing.FieldYearBuilt:
-		v, ok := value.(int)
+	case realtorinvite.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetYearBuilt(v)
+		m.SetExpiresAt(v)
 		return nil
-	case listing.FieldMedia:
-		v, ok := value.([]schema.Media)
+	case realtorinvite.FieldAcceptedBy:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMedia(v)
+		m.SetAcceptedBy(v)
 		return nil
-	case listing.FieldRealtorID:
-		v, ok := value.(uuid.UUID)
+	case realtorinvite.FieldAcceptedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRealtorID(v)
+		m.SetAcceptedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Listing field %s", name)
+	return fmt.Errorf("unknown RealtorInvite field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ListingMutation) AddedFields() []string {
-	var fields []string
-	if m.addprice != nil {
-		fields = append(fields, listing.FieldPrice)
-	}
-	if m.addbedroom != nil {
-		fields = append(fields, listing.FieldBedroom)
-	}
-	if m.addbathroom != nil {
-		fields = append(fields, listing.FieldBathroom)
-	}
-	if m.addgarage != nil {
-		fields = append(fields, listing.FieldGarage)
-	}
-	if m.addsqft != nil {
-		fields = append(fields, listing.FieldSqft)
-	}
-	if m.addlot_size != nil {
-		fields = append(fields, listing.FieldLotSize)
-	}
-	if m.addyear_built != nil {
-		fields = append(fields, listing.FieldYearBuilt)
-	}
-	return fields
+func (m *RealtorInviteMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ListingMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case listing.FieldPrice:
-		return m.AddedPrice()
-	case listing.FieldBedroom:
-		return m.AddedBedroom()
-	case listing.FieldBathroom:
-		return m.AddedBathroom()
-	case listing.FieldGarage:
-		return m.AddedGarage()
-	case listing.FieldSqft:
-		return m.AddedSqft()
-	case listing.FieldLotSize:
-		return m.AddedLotSize()
-	case listing.FieldYearBuilt:
-		return m.AddedYearBuilt()
-	}
+func (m *RealtorInviteMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ListingMutation) AddField(name string, value ent.Value) error {
+func (m *RealtorInviteMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case listing.FieldPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPrice(v)
-		return nil
-	case listing.FieldBedroom:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBedroom(v)
-		return nil
-	case listing.FieldBathroom:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddBathroom(v)
-		return nil
-	case listing.FieldGarage:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddGarage(v)
-		return nil
-	case listing.FieldSqft:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSqft(v)
-		return nil
-	case listing.FieldLotSize:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLotSize(v)
-		return nil
-	case listing.FieldYearBuilt:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddYearBuilt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Listing numeric field %s", name)
+	return fmt.Errorf("unknown RealtorInvite numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ListingMutation) ClearedFields() []string {
+func (m *RealtorInviteMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(listing.FieldDescription) {
-		fields = append(fields, listing.FieldDescription)
-	}
-	if m.FieldCleared(listing.FieldGarage) {
-		fields = append(fields, listing.FieldGarage)
+	if m.FieldCleared(realtorinvite.FieldTokenHash) {
+		fields = append(fields, realtorinvite.FieldTokenHash)
 	}
-	if m.FieldCleared(listing.FieldLotSize) {
-		fields = append(fields, listing.FieldLotSize)
-	}
-	if m.FieldCleared(listing.FieldPool) {
-		fields = append(fields, listing.FieldPool)
+	if m.FieldCleared(realtorinvite.FieldAcceptedBy) {
+		fields = append(fields, realtorinvite.FieldAcceptedBy)
 	}
-	if m.FieldCleared(listing.FieldMedia) {
-		fields = append(fields, listing.FieldMedia)
+	if m.FieldCleared(realtorinvite.FieldAcceptedAt) {
+		fields = append(fields, realtorinvite.FieldAcceptedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ListingMutation) FieldCleared(name string) bool {
+func (m *RealtorInviteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ListingMutation) ClearField(name string) error {
-	switch name {
-	case listing.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case listing.FieldGarage:
-		m.ClearGarage()
-		return nil
-	case listing.FieldLotSize:
-		m.ClearLotSize()
-		return nil
-	case listing.FieldPool:
-		m.ClearPool()
-		return nil
-	case listing.FieldMedia:
-		m.ClearMedia()
-		return nil
-	}
-	return fmt.Errorf("unknown Listing nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ListingMutation) ResetField(name string) error {
-	switch name {
-	case listing.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case listing.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case listing.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case listing.FieldAddress:
-		m.ResetAddress()
-		return nil
-	case listing.FieldCity:
-		m.ResetCity()
-		return nil
-	case listing.FieldState:
-		m.ResetState()
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RealtorInviteMutation) ClearField(name string) error {
+	switch name {
+	case realtorinvite.FieldTokenHash:
+		m.ClearTokenHash()
 		return nil
-	case listing.FieldZipCode:
-		m.ResetZipCode()
+	case realtorinvite.FieldAcceptedBy:
+		m.ClearAcceptedBy()
 		return nil
-	case listing.FieldDescription:
-		m.ResetDescription()
+	case realtorinvite.FieldAcceptedAt:
+		m.ClearAcceptedAt()
 		return nil
-	case listing.FieldPrice:
-		m.ResetPrice()
+	}
+	return fmt.Errorf("unknown RealtorInvite nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RealtorInviteMutation) ResetField(name string) error {
+	switch name {
+	case realtorinvite.FieldCreateTime:
+		m.ResetCreateTime()
 		return nil
-	case listing.FieldBedroom:
-		m.ResetBedroom()
+	case realtorinvite.FieldUpdateTime:
+		m.ResetUpdateTime()
 		return nil
-	case listing.FieldBathroom:
-		m.ResetBathroom()
+	case realtorinvite.FieldRealtorID:
+		m.ResetRealtorID()
 		return nil
-	case listing.FieldGarage:
-		m.ResetGarage()
+	case realtorinvite.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case listing.FieldSqft:
-		m.ResetSqft()
+	case realtorinvite.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case listing.FieldTypeOfProperty:
-		m.ResetTypeOfProperty()
+	case realtorinvite.FieldInvitedBy:
+		m.ResetInvitedBy()
 		return nil
-	case listing.FieldStatus:
+	case realtorinvite.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case listing.FieldLotSize:
-		m.ResetLotSize()
-		return nil
-	case listing.FieldPool:
-		m.ResetPool()
-		return nil
-	case listing.FieldYearBuilt:
-		m.ResetYearBuilt()
+	case realtorinvite.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case listing.FieldMedia:
-		m.ResetMedia()
+	case realtorinvite.FieldAcceptedBy:
+		m.ResetAcceptedBy()
 		return nil
-	case listing.FieldRealtorID:
-		m.ResetRealtorID()
+	case realtorinvite.FieldAcceptedAt:
+		m.ResetAcceptedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Listing field %s", name)
+	return fmt.Errorf("unknown RealtorInvite field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ListingMutation) AddedEdges() []string {
+func (m *RealtorInviteMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.realtor != nil {
-		edges = append(edges, listing.EdgeRealtor)
+		edges = append(edges, realtorinvite.EdgeRealtor)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ListingMutation) AddedIDs(name string) []ent.Value {
+func (m *RealtorInviteMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case listing.EdgeRealtor:
+	case realtorinvite.EdgeRealtor:
 		if id := m.realtor; id != nil {
 			return []ent.Value{*id}
 		}
@@ -1745,31 +19955,31 @@ func (m *ListingMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ListingMutation) RemovedEdges() []string {
+func (m *RealtorInviteMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ListingMutation) RemovedIDs(name string) []ent.Value {
+func (m *RealtorInviteMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ListingMutation) ClearedEdges() []string {
+func (m *RealtorInviteMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedrealtor {
-		edges = append(edges, listing.EdgeRealtor)
+		edges = append(edges, realtorinvite.EdgeRealtor)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ListingMutation) EdgeCleared(name string) bool {
+func (m *RealtorInviteMutation) EdgeCleared(name string) bool {
 	switch name {
-	case listing.EdgeRealtor:
+	case realtorinvite.EdgeRealtor:
 		return m.clearedrealtor
 	}
 	return false
@@ -1777,61 +19987,59 @@ func (m *ListingMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ListingMutation) ClearEdge(name string) error {
+func (m *RealtorInviteMutation) ClearEdge(name string) error {
 	switch name {
-	case listing.EdgeRealtor:
+	case realtorinvite.EdgeRealtor:
 		m.ClearRealtor()
 		return nil
 	}
-	return fmt.Errorf("unknown Listing unique edge %s", name)
+	return fmt.Errorf("unknown RealtorInvite unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ListingMutation) ResetEdge(name string) error {
+func (m *RealtorInviteMutation) ResetEdge(name string) error {
 	switch name {
-	case listing.EdgeRealtor:
+	case realtorinvite.EdgeRealtor:
 		m.ResetRealtor()
 		return nil
 	}
-	return fmt.Errorf("unknown Listing edge %s", name)
+	return fmt.Errorf("unknown RealtorInvite edge %s", name)
 }
 
-// RealtorMutation represents an operation that mutates the Realtor nodes in the graph.
-type RealtorMutation struct {
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	create_time     *time.Time
-	update_time     *time.Time
-	full_name       *string
-	photo           *map[string]interface{}
-	description     *string
-	phone           *string
-	email           *string
-	is_mvp          *bool
-	hire_date       *time.Time
-	clearedFields   map[string]struct{}
-	listings        map[uuid.UUID]struct{}
-	removedlistings map[uuid.UUID]struct{}
-	clearedlistings bool
-	done            bool
-	oldValue        func(context.Context) (*Realtor, error)
-	predicates      []predicate.Realtor
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	create_time      *time.Time
+	update_time      *time.Time
+	name             *string
+	clearedFields    map[string]struct{}
+	brokerage        *uuid.UUID
+	clearedbrokerage bool
+	lead             *uuid.UUID
+	clearedlead      bool
+	members          map[uuid.UUID]struct{}
+	removedmembers   map[uuid.UUID]struct{}
+	clearedmembers   bool
+	done             bool
+	oldValue         func(context.Context) (*Team, error)
+	predicates       []predicate.Team
 }
 
-var _ ent.Mutation = (*RealtorMutation)(nil)
+var _ ent.Mutation = (*TeamMutation)(nil)
 
-// realtorOption allows management of the mutation configuration using functional options.
-type realtorOption func(*RealtorMutation)
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
 
-// newRealtorMutation creates new mutation for the Realtor entity.
-func newRealtorMutation(c config, op Op, opts ...realtorOption) *RealtorMutation {
-	m := &RealtorMutation{
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRealtor,
+		typ:           TypeTeam,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1840,20 +20048,20 @@ func newRealtorMutation(c config, op Op, opts ...realtorOption) *RealtorMutation
 	return m
 }
 
-// withRealtorID sets the ID field of the mutation.
-func withRealtorID(id uuid.UUID) realtorOption {
-	return func(m *RealtorMutation) {
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id uuid.UUID) teamOption {
+	return func(m *TeamMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Realtor
+			value *Team
 		)
-		m.oldValue = func(ctx context.Context) (*Realtor, error) {
+		m.oldValue = func(ctx context.Context) (*Team, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Realtor.Get(ctx, id)
+					value, err = m.Client().Team.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1862,10 +20070,10 @@ func withRealtorID(id uuid.UUID) realtorOption {
 	}
 }
 
-// withRealtor sets the old Realtor of the mutation.
-func withRealtor(node *Realtor) realtorOption {
-	return func(m *RealtorMutation) {
-		m.oldValue = func(context.Context) (*Realtor, error) {
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1874,7 +20082,7 @@ func withRealtor(node *Realtor) realtorOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RealtorMutation) Client() *Client {
+func (m TeamMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1882,7 +20090,7 @@ func (m RealtorMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RealtorMutation) Tx() (*Tx, error) {
+func (m TeamMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1892,14 +20100,14 @@ func (m RealtorMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Realtor entities.
-func (m *RealtorMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Team entities.
+func (m *TeamMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RealtorMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TeamMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1910,7 +20118,7 @@ func (m *RealtorMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RealtorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TeamMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1919,19 +20127,19 @@ func (m *RealtorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Realtor.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *RealtorMutation) SetCreateTime(t time.Time) {
+func (m *TeamMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *RealtorMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TeamMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -1939,405 +20147,302 @@ func (m *RealtorMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Realtor entity.
-// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RealtorMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
-	}
-	return oldValue.CreateTime, nil
-}
-
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *RealtorMutation) ResetCreateTime() {
-	m.create_time = nil
-}
-
-// SetUpdateTime sets the "update_time" field.
-func (m *RealtorMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
-}
-
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *RealtorMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdateTime returns the old "update_time" field's value of the Realtor entity.
-// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RealtorMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
-	}
-	return oldValue.UpdateTime, nil
-}
-
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *RealtorMutation) ResetUpdateTime() {
-	m.update_time = nil
-}
-
-// SetFullName sets the "full_name" field.
-func (m *RealtorMutation) SetFullName(s string) {
-	m.full_name = &s
-}
-
-// FullName returns the value of the "full_name" field in the mutation.
-func (m *RealtorMutation) FullName() (r string, exists bool) {
-	v := m.full_name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldFullName returns the old "full_name" field's value of the Realtor entity.
-// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RealtorMutation) OldFullName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFullName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFullName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFullName: %w", err)
-	}
-	return oldValue.FullName, nil
-}
-
-// ResetFullName resets all changes to the "full_name" field.
-func (m *RealtorMutation) ResetFullName() {
-	m.full_name = nil
-}
-
-// SetPhoto sets the "photo" field.
-func (m *RealtorMutation) SetPhoto(value map[string]interface{}) {
-	m.photo = &value
-}
-
-// Photo returns the value of the "photo" field in the mutation.
-func (m *RealtorMutation) Photo() (r map[string]interface{}, exists bool) {
-	v := m.photo
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPhoto returns the old "photo" field's value of the Realtor entity.
-// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RealtorMutation) OldPhoto(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhoto is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhoto requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhoto: %w", err)
-	}
-	return oldValue.Photo, nil
-}
-
-// ClearPhoto clears the value of the "photo" field.
-func (m *RealtorMutation) ClearPhoto() {
-	m.photo = nil
-	m.clearedFields[realtor.FieldPhoto] = struct{}{}
-}
-
-// PhotoCleared returns if the "photo" field was cleared in this mutation.
-func (m *RealtorMutation) PhotoCleared() bool {
-	_, ok := m.clearedFields[realtor.FieldPhoto]
-	return ok
-}
-
-// ResetPhoto resets all changes to the "photo" field.
-func (m *RealtorMutation) ResetPhoto() {
-	m.photo = nil
-	delete(m.clearedFields, realtor.FieldPhoto)
-}
-
-// SetDescription sets the "description" field.
-func (m *RealtorMutation) SetDescription(s string) {
-	m.description = &s
-}
-
-// Description returns the value of the "description" field in the mutation.
-func (m *RealtorMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDescription returns the old "description" field's value of the Realtor entity.
-// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RealtorMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *TeamMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *RealtorMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[realtor.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *RealtorMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[realtor.FieldDescription]
-	return ok
+	return oldValue.CreateTime, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *RealtorMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, realtor.FieldDescription)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TeamMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetPhone sets the "phone" field.
-func (m *RealtorMutation) SetPhone(s string) {
-	m.phone = &s
+// SetUpdateTime sets the "update_time" field.
+func (m *TeamMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// Phone returns the value of the "phone" field in the mutation.
-func (m *RealtorMutation) Phone() (r string, exists bool) {
-	v := m.phone
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TeamMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPhone returns the old "phone" field's value of the Realtor entity.
-// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RealtorMutation) OldPhone(ctx context.Context) (v string, err error) {
+func (m *TeamMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhone requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.Phone, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ResetPhone resets all changes to the "phone" field.
-func (m *RealtorMutation) ResetPhone() {
-	m.phone = nil
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TeamMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *RealtorMutation) SetEmail(s string) {
-	m.email = &s
+// SetBrokerageID sets the "brokerage_id" field.
+func (m *TeamMutation) SetBrokerageID(u uuid.UUID) {
+	m.brokerage = &u
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *RealtorMutation) Email() (r string, exists bool) {
-	v := m.email
+// BrokerageID returns the value of the "brokerage_id" field in the mutation.
+func (m *TeamMutation) BrokerageID() (r uuid.UUID, exists bool) {
+	v := m.brokerage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the Realtor entity.
-// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// OldBrokerageID returns the old "brokerage_id" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RealtorMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *TeamMutation) OldBrokerageID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldBrokerageID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldBrokerageID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldBrokerageID: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.BrokerageID, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *RealtorMutation) ResetEmail() {
-	m.email = nil
+// ResetBrokerageID resets all changes to the "brokerage_id" field.
+func (m *TeamMutation) ResetBrokerageID() {
+	m.brokerage = nil
 }
 
-// SetIsMvp sets the "is_mvp" field.
-func (m *RealtorMutation) SetIsMvp(b bool) {
-	m.is_mvp = &b
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
 }
 
-// IsMvp returns the value of the "is_mvp" field in the mutation.
-func (m *RealtorMutation) IsMvp() (r bool, exists bool) {
-	v := m.is_mvp
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsMvp returns the old "is_mvp" field's value of the Realtor entity.
-// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RealtorMutation) OldIsMvp(ctx context.Context) (v bool, err error) {
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsMvp is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsMvp requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsMvp: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.IsMvp, nil
+	return oldValue.Name, nil
 }
 
-// ResetIsMvp resets all changes to the "is_mvp" field.
-func (m *RealtorMutation) ResetIsMvp() {
-	m.is_mvp = nil
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
 }
 
-// SetHireDate sets the "hire_date" field.
-func (m *RealtorMutation) SetHireDate(t time.Time) {
-	m.hire_date = &t
+// SetLeadID sets the "lead_id" field.
+func (m *TeamMutation) SetLeadID(u uuid.UUID) {
+	m.lead = &u
 }
 
-// HireDate returns the value of the "hire_date" field in the mutation.
-func (m *RealtorMutation) HireDate() (r time.Time, exists bool) {
-	v := m.hire_date
+// LeadID returns the value of the "lead_id" field in the mutation.
+func (m *TeamMutation) LeadID() (r uuid.UUID, exists bool) {
+	v := m.lead
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHireDate returns the old "hire_date" field's value of the Realtor entity.
-// If the Realtor object wasn't provided to the builder, the object is fetched from the database.
+// OldLeadID returns the old "lead_id" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RealtorMutation) OldHireDate(ctx context.Context) (v time.Time, err error) {
+func (m *TeamMutation) OldLeadID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHireDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldLeadID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHireDate requires an ID field in the mutation")
+		return v, errors.New("OldLeadID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHireDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldLeadID: %w", err)
 	}
-	return oldValue.HireDate, nil
+	return oldValue.LeadID, nil
 }
 
-// ResetHireDate resets all changes to the "hire_date" field.
-func (m *RealtorMutation) ResetHireDate() {
-	m.hire_date = nil
+// ClearLeadID clears the value of the "lead_id" field.
+func (m *TeamMutation) ClearLeadID() {
+	m.lead = nil
+	m.clearedFields[team.FieldLeadID] = struct{}{}
 }
 
-// AddListingIDs adds the "listings" edge to the Listing entity by ids.
-func (m *RealtorMutation) AddListingIDs(ids ...uuid.UUID) {
-	if m.listings == nil {
-		m.listings = make(map[uuid.UUID]struct{})
+// LeadIDCleared returns if the "lead_id" field was cleared in this mutation.
+func (m *TeamMutation) LeadIDCleared() bool {
+	_, ok := m.clearedFields[team.FieldLeadID]
+	return ok
+}
+
+// ResetLeadID resets all changes to the "lead_id" field.
+func (m *TeamMutation) ResetLeadID() {
+	m.lead = nil
+	delete(m.clearedFields, team.FieldLeadID)
+}
+
+// ClearBrokerage clears the "brokerage" edge to the Brokerage entity.
+func (m *TeamMutation) ClearBrokerage() {
+	m.clearedbrokerage = true
+	m.clearedFields[team.FieldBrokerageID] = struct{}{}
+}
+
+// BrokerageCleared reports if the "brokerage" edge to the Brokerage entity was cleared.
+func (m *TeamMutation) BrokerageCleared() bool {
+	return m.clearedbrokerage
+}
+
+// BrokerageIDs returns the "brokerage" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BrokerageID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) BrokerageIDs() (ids []uuid.UUID) {
+	if id := m.brokerage; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBrokerage resets all changes to the "brokerage" edge.
+func (m *TeamMutation) ResetBrokerage() {
+	m.brokerage = nil
+	m.clearedbrokerage = false
+}
+
+// ClearLead clears the "lead" edge to the Realtor entity.
+func (m *TeamMutation) ClearLead() {
+	m.clearedlead = true
+	m.clearedFields[team.FieldLeadID] = struct{}{}
+}
+
+// LeadCleared reports if the "lead" edge to the Realtor entity was cleared.
+func (m *TeamMutation) LeadCleared() bool {
+	return m.LeadIDCleared() || m.clearedlead
+}
+
+// LeadIDs returns the "lead" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LeadID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) LeadIDs() (ids []uuid.UUID) {
+	if id := m.lead; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLead resets all changes to the "lead" edge.
+func (m *TeamMutation) ResetLead() {
+	m.lead = nil
+	m.clearedlead = false
+}
+
+// AddMemberIDs adds the "members" edge to the Realtor entity by ids.
+func (m *TeamMutation) AddMemberIDs(ids ...uuid.UUID) {
+	if m.members == nil {
+		m.members = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.listings[ids[i]] = struct{}{}
+		m.members[ids[i]] = struct{}{}
 	}
 }
 
-// ClearListings clears the "listings" edge to the Listing entity.
-func (m *RealtorMutation) ClearListings() {
-	m.clearedlistings = true
+// ClearMembers clears the "members" edge to the Realtor entity.
+func (m *TeamMutation) ClearMembers() {
+	m.clearedmembers = true
 }
 
-// ListingsCleared reports if the "listings" edge to the Listing entity was cleared.
-func (m *RealtorMutation) ListingsCleared() bool {
-	return m.clearedlistings
+// MembersCleared reports if the "members" edge to the Realtor entity was cleared.
+func (m *TeamMutation) MembersCleared() bool {
+	return m.clearedmembers
 }
 
-// RemoveListingIDs removes the "listings" edge to the Listing entity by IDs.
-func (m *RealtorMutation) RemoveListingIDs(ids ...uuid.UUID) {
-	if m.removedlistings == nil {
-		m.removedlistings = make(map[uuid.UUID]struct{})
+// RemoveMemberIDs removes the "members" edge to the Realtor entity by IDs.
+func (m *TeamMutation) RemoveMemberIDs(ids ...uuid.UUID) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.listings, ids[i])
-		m.removedlistings[ids[i]] = struct{}{}
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedListings returns the removed IDs of the "listings" edge to the Listing entity.
-func (m *RealtorMutation) RemovedListingsIDs() (ids []uuid.UUID) {
-	for id := range m.removedlistings {
+// RemovedMembers returns the removed IDs of the "members" edge to the Realtor entity.
+func (m *TeamMutation) RemovedMembersIDs() (ids []uuid.UUID) {
+	for id := range m.removedmembers {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ListingsIDs returns the "listings" edge IDs in the mutation.
-func (m *RealtorMutation) ListingsIDs() (ids []uuid.UUID) {
-	for id := range m.listings {
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *TeamMutation) MembersIDs() (ids []uuid.UUID) {
+	for id := range m.members {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetListings resets all changes to the "listings" edge.
-func (m *RealtorMutation) ResetListings() {
-	m.listings = nil
-	m.clearedlistings = false
-	m.removedlistings = nil
+// ResetMembers resets all changes to the "members" edge.
+func (m *TeamMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
 }
 
-// Where appends a list predicates to the RealtorMutation builder.
-func (m *RealtorMutation) Where(ps ...predicate.Realtor) {
+// Where appends a list predicates to the TeamMutation builder.
+func (m *TeamMutation) Where(ps ...predicate.Team) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RealtorMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RealtorMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Realtor, len(ps))
+func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Team, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2345,51 +20450,39 @@ func (m *RealtorMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RealtorMutation) Op() Op {
+func (m *TeamMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RealtorMutation) SetOp(op Op) {
+func (m *TeamMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Realtor).
-func (m *RealtorMutation) Type() string {
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RealtorMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *TeamMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.create_time != nil {
-		fields = append(fields, realtor.FieldCreateTime)
+		fields = append(fields, team.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, realtor.FieldUpdateTime)
-	}
-	if m.full_name != nil {
-		fields = append(fields, realtor.FieldFullName)
+		fields = append(fields, team.FieldUpdateTime)
 	}
-	if m.photo != nil {
-		fields = append(fields, realtor.FieldPhoto)
-	}
-	if m.description != nil {
-		fields = append(fields, realtor.FieldDescription)
-	}
-	if m.phone != nil {
-		fields = append(fields, realtor.FieldPhone)
+	if m.brokerage != nil {
+		fields = append(fields, team.FieldBrokerageID)
 	}
-	if m.email != nil {
-		fields = append(fields, realtor.FieldEmail)
-	}
-	if m.is_mvp != nil {
-		fields = append(fields, realtor.FieldIsMvp)
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
 	}
-	if m.hire_date != nil {
-		fields = append(fields, realtor.FieldHireDate)
+	if m.lead != nil {
+		fields = append(fields, team.FieldLeadID)
 	}
 	return fields
 }
@@ -2397,26 +20490,18 @@ func (m *RealtorMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RealtorMutation) Field(name string) (ent.Value, bool) {
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case realtor.FieldCreateTime:
+	case team.FieldCreateTime:
 		return m.CreateTime()
-	case realtor.FieldUpdateTime:
+	case team.FieldUpdateTime:
 		return m.UpdateTime()
-	case realtor.FieldFullName:
-		return m.FullName()
-	case realtor.FieldPhoto:
-		return m.Photo()
-	case realtor.FieldDescription:
-		return m.Description()
-	case realtor.FieldPhone:
-		return m.Phone()
-	case realtor.FieldEmail:
-		return m.Email()
-	case realtor.FieldIsMvp:
-		return m.IsMvp()
-	case realtor.FieldHireDate:
-		return m.HireDate()
+	case team.FieldBrokerageID:
+		return m.BrokerageID()
+	case team.FieldName:
+		return m.Name()
+	case team.FieldLeadID:
+		return m.LeadID()
 	}
 	return nil, false
 }
@@ -2424,209 +20509,169 @@ func (m *RealtorMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RealtorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case realtor.FieldCreateTime:
+	case team.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case realtor.FieldUpdateTime:
+	case team.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case realtor.FieldFullName:
-		return m.OldFullName(ctx)
-	case realtor.FieldPhoto:
-		return m.OldPhoto(ctx)
-	case realtor.FieldDescription:
-		return m.OldDescription(ctx)
-	case realtor.FieldPhone:
-		return m.OldPhone(ctx)
-	case realtor.FieldEmail:
-		return m.OldEmail(ctx)
-	case realtor.FieldIsMvp:
-		return m.OldIsMvp(ctx)
-	case realtor.FieldHireDate:
-		return m.OldHireDate(ctx)
-	}
-	return nil, fmt.Errorf("unknown Realtor field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RealtorMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case realtor.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case realtor.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case realtor.FieldFullName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFullName(v)
-		return nil
-	case realtor.FieldPhoto:
-		v, ok := value.(map[string]interface{})
+	case team.FieldBrokerageID:
+		return m.OldBrokerageID(ctx)
+	case team.FieldName:
+		return m.OldName(ctx)
+	case team.FieldLeadID:
+		return m.OldLeadID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Team field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldCreateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPhoto(v)
+		m.SetCreateTime(v)
 		return nil
-	case realtor.FieldDescription:
-		v, ok := value.(string)
+	case team.FieldUpdateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetUpdateTime(v)
 		return nil
-	case realtor.FieldPhone:
-		v, ok := value.(string)
+	case team.FieldBrokerageID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPhone(v)
+		m.SetBrokerageID(v)
 		return nil
-	case realtor.FieldEmail:
+	case team.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
-		return nil
-	case realtor.FieldIsMvp:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsMvp(v)
+		m.SetName(v)
 		return nil
-	case realtor.FieldHireDate:
-		v, ok := value.(time.Time)
+	case team.FieldLeadID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHireDate(v)
+		m.SetLeadID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Realtor field %s", name)
+	return fmt.Errorf("unknown Team field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RealtorMutation) AddedFields() []string {
+func (m *TeamMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RealtorMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RealtorMutation) AddField(name string, value ent.Value) error {
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Realtor numeric field %s", name)
+	return fmt.Errorf("unknown Team numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RealtorMutation) ClearedFields() []string {
+func (m *TeamMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(realtor.FieldPhoto) {
-		fields = append(fields, realtor.FieldPhoto)
-	}
-	if m.FieldCleared(realtor.FieldDescription) {
-		fields = append(fields, realtor.FieldDescription)
+	if m.FieldCleared(team.FieldLeadID) {
+		fields = append(fields, team.FieldLeadID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RealtorMutation) FieldCleared(name string) bool {
+func (m *TeamMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RealtorMutation) ClearField(name string) error {
+func (m *TeamMutation) ClearField(name string) error {
 	switch name {
-	case realtor.FieldPhoto:
-		m.ClearPhoto()
-		return nil
-	case realtor.FieldDescription:
-		m.ClearDescription()
+	case team.FieldLeadID:
+		m.ClearLeadID()
 		return nil
 	}
-	return fmt.Errorf("unknown Realtor nullable field %s", name)
+	return fmt.Errorf("unknown Team nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RealtorMutation) ResetField(name string) error {
+func (m *TeamMutation) ResetField(name string) error {
 	switch name {
-	case realtor.FieldCreateTime:
+	case team.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case realtor.FieldUpdateTime:
+	case team.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case realtor.FieldFullName:
-		m.ResetFullName()
-		return nil
-	case realtor.FieldPhoto:
-		m.ResetPhoto()
-		return nil
-	case realtor.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case realtor.FieldPhone:
-		m.ResetPhone()
-		return nil
-	case realtor.FieldEmail:
-		m.ResetEmail()
+	case team.FieldBrokerageID:
+		m.ResetBrokerageID()
 		return nil
-	case realtor.FieldIsMvp:
-		m.ResetIsMvp()
+	case team.FieldName:
+		m.ResetName()
 		return nil
-	case realtor.FieldHireDate:
-		m.ResetHireDate()
+	case team.FieldLeadID:
+		m.ResetLeadID()
 		return nil
 	}
-	return fmt.Errorf("unknown Realtor field %s", name)
+	return fmt.Errorf("unknown Team field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RealtorMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.listings != nil {
-		edges = append(edges, realtor.EdgeListings)
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.brokerage != nil {
+		edges = append(edges, team.EdgeBrokerage)
+	}
+	if m.lead != nil {
+		edges = append(edges, team.EdgeLead)
+	}
+	if m.members != nil {
+		edges = append(edges, team.EdgeMembers)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RealtorMutation) AddedIDs(name string) []ent.Value {
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case realtor.EdgeListings:
-		ids := make([]ent.Value, 0, len(m.listings))
-		for id := range m.listings {
+	case team.EdgeBrokerage:
+		if id := m.brokerage; id != nil {
+			return []ent.Value{*id}
+		}
+	case team.EdgeLead:
+		if id := m.lead; id != nil {
+			return []ent.Value{*id}
+		}
+	case team.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2635,21 +20680,21 @@ func (m *RealtorMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RealtorMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedlistings != nil {
-		edges = append(edges, realtor.EdgeListings)
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedmembers != nil {
+		edges = append(edges, team.EdgeMembers)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RealtorMutation) RemovedIDs(name string) []ent.Value {
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case realtor.EdgeListings:
-		ids := make([]ent.Value, 0, len(m.removedlistings))
-		for id := range m.removedlistings {
+	case team.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2658,65 +20703,95 @@ func (m *RealtorMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RealtorMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedlistings {
-		edges = append(edges, realtor.EdgeListings)
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedbrokerage {
+		edges = append(edges, team.EdgeBrokerage)
+	}
+	if m.clearedlead {
+		edges = append(edges, team.EdgeLead)
+	}
+	if m.clearedmembers {
+		edges = append(edges, team.EdgeMembers)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RealtorMutation) EdgeCleared(name string) bool {
+func (m *TeamMutation) EdgeCleared(name string) bool {
 	switch name {
-	case realtor.EdgeListings:
-		return m.clearedlistings
+	case team.EdgeBrokerage:
+		return m.clearedbrokerage
+	case team.EdgeLead:
+		return m.clearedlead
+	case team.EdgeMembers:
+		return m.clearedmembers
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RealtorMutation) ClearEdge(name string) error {
+func (m *TeamMutation) ClearEdge(name string) error {
 	switch name {
+	case team.EdgeBrokerage:
+		m.ClearBrokerage()
+		return nil
+	case team.EdgeLead:
+		m.ClearLead()
+		return nil
 	}
-	return fmt.Errorf("unknown Realtor unique edge %s", name)
+	return fmt.Errorf("unknown Team unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RealtorMutation) ResetEdge(name string) error {
+func (m *TeamMutation) ResetEdge(name string) error {
 	switch name {
-	case realtor.EdgeListings:
-		m.ResetListings()
+	case team.EdgeBrokerage:
+		m.ResetBrokerage()
+		return nil
+	case team.EdgeLead:
+		m.ResetLead()
+		return nil
+	case team.EdgeMembers:
+		m.ResetMembers()
 		return nil
 	}
-	return fmt.Errorf("unknown Realtor edge %s", name)
+	return fmt.Errorf("unknown Team edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
 type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	create_time   *time.Time
-	update_time   *time.Time
-	avatar        *string
-	email         *string
-	username      *string
-	full_name     *string
-	start_date    *time.Time
-	is_staff      *bool
-	is_active     *bool
-	password      *string
-	provider      *string
-	provider_id   *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	create_time      *time.Time
+	update_time      *time.Time
+	avatar           *string
+	email            *string
+	username         *string
+	full_name        *string
+	start_date       *time.Time
+	is_staff         *bool
+	is_active        *bool
+	password         *string
+	provider         *string
+	provider_id      *string
+	clearedFields    map[string]struct{}
+	inquiries        map[uuid.UUID]struct{}
+	removedinquiries map[uuid.UUID]struct{}
+	clearedinquiries bool
+	offers           map[uuid.UUID]struct{}
+	removedoffers    map[uuid.UUID]struct{}
+	clearedoffers    bool
+	realtor          *uuid.UUID
+	clearedrealtor   bool
+	done             bool
+	oldValue         func(context.Context) (*User, error)
+	predicates       []predicate.User
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -3281,6 +21356,153 @@ func (m *UserMutation) ResetProviderID() {
 	delete(m.clearedFields, user.FieldProviderID)
 }
 
+// AddInquiryIDs adds the "inquiries" edge to the ListingInquiry entity by ids.
+func (m *UserMutation) AddInquiryIDs(ids ...uuid.UUID) {
+	if m.inquiries == nil {
+		m.inquiries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.inquiries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInquiries clears the "inquiries" edge to the ListingInquiry entity.
+func (m *UserMutation) ClearInquiries() {
+	m.clearedinquiries = true
+}
+
+// InquiriesCleared reports if the "inquiries" edge to the ListingInquiry entity was cleared.
+func (m *UserMutation) InquiriesCleared() bool {
+	return m.clearedinquiries
+}
+
+// RemoveInquiryIDs removes the "inquiries" edge to the ListingInquiry entity by IDs.
+func (m *UserMutation) RemoveInquiryIDs(ids ...uuid.UUID) {
+	if m.removedinquiries == nil {
+		m.removedinquiries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.inquiries, ids[i])
+		m.removedinquiries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInquiries returns the removed IDs of the "inquiries" edge to the ListingInquiry entity.
+func (m *UserMutation) RemovedInquiriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedinquiries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InquiriesIDs returns the "inquiries" edge IDs in the mutation.
+func (m *UserMutation) InquiriesIDs() (ids []uuid.UUID) {
+	for id := range m.inquiries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInquiries resets all changes to the "inquiries" edge.
+func (m *UserMutation) ResetInquiries() {
+	m.inquiries = nil
+	m.clearedinquiries = false
+	m.removedinquiries = nil
+}
+
+// AddOfferIDs adds the "offers" edge to the Offer entity by ids.
+func (m *UserMutation) AddOfferIDs(ids ...uuid.UUID) {
+	if m.offers == nil {
+		m.offers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.offers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOffers clears the "offers" edge to the Offer entity.
+func (m *UserMutation) ClearOffers() {
+	m.clearedoffers = true
+}
+
+// OffersCleared reports if the "offers" edge to the Offer entity was cleared.
+func (m *UserMutation) OffersCleared() bool {
+	return m.clearedoffers
+}
+
+// RemoveOfferIDs removes the "offers" edge to the Offer entity by IDs.
+func (m *UserMutation) RemoveOfferIDs(ids ...uuid.UUID) {
+	if m.removedoffers == nil {
+		m.removedoffers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.offers, ids[i])
+		m.removedoffers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOffers returns the removed IDs of the "offers" edge to the Offer entity.
+func (m *UserMutation) RemovedOffersIDs() (ids []uuid.UUID) {
+	for id := range m.removedoffers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OffersIDs returns the "offers" edge IDs in the mutation.
+func (m *UserMutation) OffersIDs() (ids []uuid.UUID) {
+	for id := range m.offers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOffers resets all changes to the "offers" edge.
+func (m *UserMutation) ResetOffers() {
+	m.offers = nil
+	m.clearedoffers = false
+	m.removedoffers = nil
+}
+
+// SetRealtorID sets the "realtor" edge to the Realtor entity by id.
+func (m *UserMutation) SetRealtorID(id uuid.UUID) {
+	m.realtor = &id
+}
+
+// ClearRealtor clears the "realtor" edge to the Realtor entity.
+func (m *UserMutation) ClearRealtor() {
+	m.clearedrealtor = true
+}
+
+// RealtorCleared reports if the "realtor" edge to the Realtor entity was cleared.
+func (m *UserMutation) RealtorCleared() bool {
+	return m.clearedrealtor
+}
+
+// RealtorID returns the "realtor" edge ID in the mutation.
+func (m *UserMutation) RealtorID() (id uuid.UUID, exists bool) {
+	if m.realtor != nil {
+		return *m.realtor, true
+	}
+	return
+}
+
+// RealtorIDs returns the "realtor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RealtorID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) RealtorIDs() (ids []uuid.UUID) {
+	if id := m.realtor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRealtor resets all changes to the "realtor" edge.
+func (m *UserMutation) ResetRealtor() {
+	m.realtor = nil
+	m.clearedrealtor = false
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -3616,48 +21838,128 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 3)
+	if m.inquiries != nil {
+		edges = append(edges, user.EdgeInquiries)
+	}
+	if m.offers != nil {
+		edges = append(edges, user.EdgeOffers)
+	}
+	if m.realtor != nil {
+		edges = append(edges, user.EdgeRealtor)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeInquiries:
+		ids := make([]ent.Value, 0, len(m.inquiries))
+		for id := range m.inquiries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeOffers:
+		ids := make([]ent.Value, 0, len(m.offers))
+		for id := range m.offers {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRealtor:
+		if id := m.realtor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 3)
+	if m.removedinquiries != nil {
+		edges = append(edges, user.EdgeInquiries)
+	}
+	if m.removedoffers != nil {
+		edges = append(edges, user.EdgeOffers)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeInquiries:
+		ids := make([]ent.Value, 0, len(m.removedinquiries))
+		for id := range m.removedinquiries {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeOffers:
+		ids := make([]ent.Value, 0, len(m.removedoffers))
+		for id := range m.removedoffers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 3)
+	if m.clearedinquiries {
+		edges = append(edges, user.EdgeInquiries)
+	}
+	if m.clearedoffers {
+		edges = append(edges, user.EdgeOffers)
+	}
+	if m.clearedrealtor {
+		edges = append(edges, user.EdgeRealtor)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeInquiries:
+		return m.clearedinquiries
+	case user.EdgeOffers:
+		return m.clearedoffers
+	case user.EdgeRealtor:
+		return m.clearedrealtor
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	case user.EdgeRealtor:
+		m.ClearRealtor()
+		return nil
+	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeInquiries:
+		m.ResetInquiries()
+		return nil
+	case user.EdgeOffers:
+		m.ResetOffers()
+		return nil
+	case user.EdgeRealtor:
+		m.ResetRealtor()
+		return nil
+	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// Neighborhood is the model entity for the Neighborhood schema.
+type Neighborhood struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// City holds the value of the "city" field.
+	City string `json:"city,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// Country holds the value of the "country" field.
+	Country string `json:"country,omitempty"`
+	// Boundary holds the value of the "boundary" field.
+	Boundary schematype.MultiPolygon `json:"boundary,omitempty"`
+	// MinLatitude holds the value of the "min_latitude" field.
+	MinLatitude float64 `json:"min_latitude,omitempty"`
+	// MaxLatitude holds the value of the "max_latitude" field.
+	MaxLatitude float64 `json:"max_latitude,omitempty"`
+	// MinLongitude holds the value of the "min_longitude" field.
+	MinLongitude float64 `json:"min_longitude,omitempty"`
+	// MaxLongitude holds the value of the "max_longitude" field.
+	MaxLongitude float64 `json:"max_longitude,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NeighborhoodQuery when eager-loading is set.
+	Edges        NeighborhoodEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NeighborhoodEdges holds the relations/edges for other nodes in the graph.
+type NeighborhoodEdges struct {
+	// Listings holds the value of the listings edge.
+	Listings []*Listing `json:"listings,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ListingsOrErr returns the Listings value or an error if the edge
+// was not loaded in eager-loading.
+func (e NeighborhoodEdges) ListingsOrErr() ([]*Listing, error) {
+	if e.loadedTypes[0] {
+		return e.Listings, nil
+	}
+	return nil, &NotLoadedError{edge: "listings"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Neighborhood) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case neighborhood.FieldBoundary:
+			values[i] = new([]byte)
+		case neighborhood.FieldMinLatitude, neighborhood.FieldMaxLatitude, neighborhood.FieldMinLongitude, neighborhood.FieldMaxLongitude:
+			values[i] = new(sql.NullFloat64)
+		case neighborhood.FieldName, neighborhood.FieldCity, neighborhood.FieldState, neighborhood.FieldCountry:
+			values[i] = new(sql.NullString)
+		case neighborhood.FieldCreateTime, neighborhood.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case neighborhood.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Neighborhood fields.
+func (_m *Neighborhood) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case neighborhood.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case neighborhood.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case neighborhood.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case neighborhood.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case neighborhood.FieldCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field city", values[i])
+			} else if value.Valid {
+				_m.City = value.String
+			}
+		case neighborhood.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case neighborhood.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				_m.Country = value.String
+			}
+		case neighborhood.FieldBoundary:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field boundary", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Boundary); err != nil {
+					return fmt.Errorf("unmarshal field boundary: %w", err)
+				}
+			}
+		case neighborhood.FieldMinLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_latitude", values[i])
+			} else if value.Valid {
+				_m.MinLatitude = value.Float64
+			}
+		case neighborhood.FieldMaxLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_latitude", values[i])
+			} else if value.Valid {
+				_m.MaxLatitude = value.Float64
+			}
+		case neighborhood.FieldMinLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_longitude", values[i])
+			} else if value.Valid {
+				_m.MinLongitude = value.Float64
+			}
+		case neighborhood.FieldMaxLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_longitude", values[i])
+			} else if value.Valid {
+				_m.MaxLongitude = value.Float64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Neighborhood.
+// This includes values selected through modifiers, order, etc.
+func (_m *Neighborhood) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryListings queries the "listings" edge of the Neighborhood entity.
+func (_m *Neighborhood) QueryListings() *ListingQuery {
+	return NewNeighborhoodClient(_m.config).QueryListings(_m)
+}
+
+// Update returns a builder for updating this Neighborhood.
+// Note that you need to call Neighborhood.Unwrap() before calling this method if this Neighborhood
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Neighborhood) Update() *NeighborhoodUpdateOne {
+	return NewNeighborhoodClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Neighborhood entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Neighborhood) Unwrap() *Neighborhood {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Neighborhood is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Neighborhood) String() string {
+	var builder strings.Builder
+	builder.WriteString("Neighborhood(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("city=")
+	builder.WriteString(_m.City)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("country=")
+	builder.WriteString(_m.Country)
+	builder.WriteString(", ")
+	builder.WriteString("boundary=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Boundary))
+	builder.WriteString(", ")
+	builder.WriteString("min_latitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinLatitude))
+	builder.WriteString(", ")
+	builder.WriteString("max_latitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxLatitude))
+	builder.WriteString(", ")
+	builder.WriteString("min_longitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinLongitude))
+	builder.WriteString(", ")
+	builder.WriteString("max_longitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxLongitude))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Neighborhoods is a parsable slice of Neighborhood.
+type Neighborhoods []*Neighborhood
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package neighborhood
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the neighborhood type in the database.
+	Label = "neighborhood"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCity holds the string denoting the city field in the database.
+	FieldCity = "city"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldCountry holds the string denoting the country field in the database.
+	FieldCountry = "country"
+	// FieldBoundary holds the string denoting the boundary field in the database.
+	FieldBoundary = "boundary"
+	// FieldMinLatitude holds the string denoting the min_latitude field in the database.
+	FieldMinLatitude = "min_latitude"
+	// FieldMaxLatitude holds the string denoting the max_latitude field in the database.
+	FieldMaxLatitude = "max_latitude"
+	// FieldMinLongitude holds the string denoting the min_longitude field in the database.
+	FieldMinLongitude = "min_longitude"
+	// FieldMaxLongitude holds the string denoting the max_longitude field in the database.
+	FieldMaxLongitude = "max_longitude"
+	// EdgeListings holds the string denoting the listings edge name in mutations.
+	EdgeListings = "listings"
+	// Table holds the table name of the neighborhood in the database.
+	Table = "neighborhoods"
+	// ListingsTable is the table that holds the listings relation/edge.
+	ListingsTable = "listings"
+	// ListingsInverseTable is the table name for the Listing entity.
+	// It exists in this package in order to avoid circular dependency with the "listing" package.
+	ListingsInverseTable = "listings"
+	// ListingsColumn is the table column denoting the listings relation/edge.
+	ListingsColumn = "neighborhood_id"
+)
+
+// Columns holds all SQL columns for neighborhood fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldName,
+	FieldCity,
+	FieldState,
+	FieldCountry,
+	FieldBoundary,
+	FieldMinLatitude,
+	FieldMaxLatitude,
+	FieldMinLongitude,
+	FieldMaxLongitude,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "ppgroup.ppgroup.com/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// CityValidator is a validator for the "city" field. It is called by the builders before save.
+	CityValidator func(string) error
+	// StateValidator is a validator for the "state" field. It is called by the builders before save.
+	StateValidator func(string) error
+	// DefaultCountry holds the default value on creation for the "country" field.
+	DefaultCountry string
+	// CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	CountryValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Neighborhood queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCity orders the results by the city field.
+func ByCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCity, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByCountry orders the results by the country field.
+func ByCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountry, opts...).ToFunc()
+}
+
+// ByMinLatitude orders the results by the min_latitude field.
+func ByMinLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinLatitude, opts...).ToFunc()
+}
+
+// ByMaxLatitude orders the results by the max_latitude field.
+func ByMaxLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxLatitude, opts...).ToFunc()
+}
+
+// ByMinLongitude orders the results by the min_longitude field.
+func ByMinLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinLongitude, opts...).ToFunc()
+}
+
+// ByMaxLongitude orders the results by the max_longitude field.
+func ByMaxLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxLongitude, opts...).ToFunc()
+}
+
+// ByListingsCount orders the results by listings count.
+func ByListingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newListingsStep(), opts...)
+	}
+}
+
+// ByListings orders the results by listings terms.
+func ByListings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newListingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ListingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ListingsTable, ListingsColumn),
+	)
+}
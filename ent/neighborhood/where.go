@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package neighborhood
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldName, v))
+}
+
+// City applies equality check predicate on the "city" field. It's identical to CityEQ.
+func City(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldCity, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldState, v))
+}
+
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldCountry, v))
+}
+
+// MinLatitude applies equality check predicate on the "min_latitude" field. It's identical to MinLatitudeEQ.
+func MinLatitude(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldMinLatitude, v))
+}
+
+// MaxLatitude applies equality check predicate on the "max_latitude" field. It's identical to MaxLatitudeEQ.
+func MaxLatitude(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldMaxLatitude, v))
+}
+
+// MinLongitude applies equality check predicate on the "min_longitude" field. It's identical to MinLongitudeEQ.
+func MinLongitude(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldMinLongitude, v))
+}
+
+// MaxLongitude applies equality check predicate on the "max_longitude" field. It's identical to MaxLongitudeEQ.
+func MaxLongitude(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldMaxLongitude, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldContainsFold(FieldName, v))
+}
+
+// CityEQ applies the EQ predicate on the "city" field.
+func CityEQ(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldCity, v))
+}
+
+// CityNEQ applies the NEQ predicate on the "city" field.
+func CityNEQ(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNEQ(FieldCity, v))
+}
+
+// CityIn applies the In predicate on the "city" field.
+func CityIn(vs ...string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldIn(FieldCity, vs...))
+}
+
+// CityNotIn applies the NotIn predicate on the "city" field.
+func CityNotIn(vs ...string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNotIn(FieldCity, vs...))
+}
+
+// CityGT applies the GT predicate on the "city" field.
+func CityGT(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGT(FieldCity, v))
+}
+
+// CityGTE applies the GTE predicate on the "city" field.
+func CityGTE(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGTE(FieldCity, v))
+}
+
+// CityLT applies the LT predicate on the "city" field.
+func CityLT(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLT(FieldCity, v))
+}
+
+// CityLTE applies the LTE predicate on the "city" field.
+func CityLTE(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLTE(FieldCity, v))
+}
+
+// CityContains applies the Contains predicate on the "city" field.
+func CityContains(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldContains(FieldCity, v))
+}
+
+// CityHasPrefix applies the HasPrefix predicate on the "city" field.
+func CityHasPrefix(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldHasPrefix(FieldCity, v))
+}
+
+// CityHasSuffix applies the HasSuffix predicate on the "city" field.
+func CityHasSuffix(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldHasSuffix(FieldCity, v))
+}
+
+// CityEqualFold applies the EqualFold predicate on the "city" field.
+func CityEqualFold(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEqualFold(FieldCity, v))
+}
+
+// CityContainsFold applies the ContainsFold predicate on the "city" field.
+func CityContainsFold(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldContainsFold(FieldCity, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldContainsFold(FieldState, v))
+}
+
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldCountry, v))
+}
+
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNEQ(FieldCountry, v))
+}
+
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldIn(FieldCountry, vs...))
+}
+
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNotIn(FieldCountry, vs...))
+}
+
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGT(FieldCountry, v))
+}
+
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGTE(FieldCountry, v))
+}
+
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLT(FieldCountry, v))
+}
+
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLTE(FieldCountry, v))
+}
+
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldContains(FieldCountry, v))
+}
+
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldHasPrefix(FieldCountry, v))
+}
+
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldHasSuffix(FieldCountry, v))
+}
+
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEqualFold(FieldCountry, v))
+}
+
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldContainsFold(FieldCountry, v))
+}
+
+// MinLatitudeEQ applies the EQ predicate on the "min_latitude" field.
+func MinLatitudeEQ(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldMinLatitude, v))
+}
+
+// MinLatitudeNEQ applies the NEQ predicate on the "min_latitude" field.
+func MinLatitudeNEQ(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNEQ(FieldMinLatitude, v))
+}
+
+// MinLatitudeIn applies the In predicate on the "min_latitude" field.
+func MinLatitudeIn(vs ...float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldIn(FieldMinLatitude, vs...))
+}
+
+// MinLatitudeNotIn applies the NotIn predicate on the "min_latitude" field.
+func MinLatitudeNotIn(vs ...float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNotIn(FieldMinLatitude, vs...))
+}
+
+// MinLatitudeGT applies the GT predicate on the "min_latitude" field.
+func MinLatitudeGT(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGT(FieldMinLatitude, v))
+}
+
+// MinLatitudeGTE applies the GTE predicate on the "min_latitude" field.
+func MinLatitudeGTE(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGTE(FieldMinLatitude, v))
+}
+
+// MinLatitudeLT applies the LT predicate on the "min_latitude" field.
+func MinLatitudeLT(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLT(FieldMinLatitude, v))
+}
+
+// MinLatitudeLTE applies the LTE predicate on the "min_latitude" field.
+func MinLatitudeLTE(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLTE(FieldMinLatitude, v))
+}
+
+// MaxLatitudeEQ applies the EQ predicate on the "max_latitude" field.
+func MaxLatitudeEQ(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldMaxLatitude, v))
+}
+
+// MaxLatitudeNEQ applies the NEQ predicate on the "max_latitude" field.
+func MaxLatitudeNEQ(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNEQ(FieldMaxLatitude, v))
+}
+
+// MaxLatitudeIn applies the In predicate on the "max_latitude" field.
+func MaxLatitudeIn(vs ...float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldIn(FieldMaxLatitude, vs...))
+}
+
+// MaxLatitudeNotIn applies the NotIn predicate on the "max_latitude" field.
+func MaxLatitudeNotIn(vs ...float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNotIn(FieldMaxLatitude, vs...))
+}
+
+// MaxLatitudeGT applies the GT predicate on the "max_latitude" field.
+func MaxLatitudeGT(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGT(FieldMaxLatitude, v))
+}
+
+// MaxLatitudeGTE applies the GTE predicate on the "max_latitude" field.
+func MaxLatitudeGTE(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGTE(FieldMaxLatitude, v))
+}
+
+// MaxLatitudeLT applies the LT predicate on the "max_latitude" field.
+func MaxLatitudeLT(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLT(FieldMaxLatitude, v))
+}
+
+// MaxLatitudeLTE applies the LTE predicate on the "max_latitude" field.
+func MaxLatitudeLTE(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLTE(FieldMaxLatitude, v))
+}
+
+// MinLongitudeEQ applies the EQ predicate on the "min_longitude" field.
+func MinLongitudeEQ(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldMinLongitude, v))
+}
+
+// MinLongitudeNEQ applies the NEQ predicate on the "min_longitude" field.
+func MinLongitudeNEQ(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNEQ(FieldMinLongitude, v))
+}
+
+// MinLongitudeIn applies the In predicate on the "min_longitude" field.
+func MinLongitudeIn(vs ...float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldIn(FieldMinLongitude, vs...))
+}
+
+// MinLongitudeNotIn applies the NotIn predicate on the "min_longitude" field.
+func MinLongitudeNotIn(vs ...float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNotIn(FieldMinLongitude, vs...))
+}
+
+// MinLongitudeGT applies the GT predicate on the "min_longitude" field.
+func MinLongitudeGT(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGT(FieldMinLongitude, v))
+}
+
+// MinLongitudeGTE applies the GTE predicate on the "min_longitude" field.
+func MinLongitudeGTE(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGTE(FieldMinLongitude, v))
+}
+
+// MinLongitudeLT applies the LT predicate on the "min_longitude" field.
+func MinLongitudeLT(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLT(FieldMinLongitude, v))
+}
+
+// MinLongitudeLTE applies the LTE predicate on the "min_longitude" field.
+func MinLongitudeLTE(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLTE(FieldMinLongitude, v))
+}
+
+// MaxLongitudeEQ applies the EQ predicate on the "max_longitude" field.
+func MaxLongitudeEQ(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldEQ(FieldMaxLongitude, v))
+}
+
+// MaxLongitudeNEQ applies the NEQ predicate on the "max_longitude" field.
+func MaxLongitudeNEQ(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNEQ(FieldMaxLongitude, v))
+}
+
+// MaxLongitudeIn applies the In predicate on the "max_longitude" field.
+func MaxLongitudeIn(vs ...float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldIn(FieldMaxLongitude, vs...))
+}
+
+// MaxLongitudeNotIn applies the NotIn predicate on the "max_longitude" field.
+func MaxLongitudeNotIn(vs ...float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldNotIn(FieldMaxLongitude, vs...))
+}
+
+// MaxLongitudeGT applies the GT predicate on the "max_longitude" field.
+func MaxLongitudeGT(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGT(FieldMaxLongitude, v))
+}
+
+// MaxLongitudeGTE applies the GTE predicate on the "max_longitude" field.
+func MaxLongitudeGTE(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldGTE(FieldMaxLongitude, v))
+}
+
+// MaxLongitudeLT applies the LT predicate on the "max_longitude" field.
+func MaxLongitudeLT(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLT(FieldMaxLongitude, v))
+}
+
+// MaxLongitudeLTE applies the LTE predicate on the "max_longitude" field.
+func MaxLongitudeLTE(v float64) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.FieldLTE(FieldMaxLongitude, v))
+}
+
+// HasListings applies the HasEdge predicate on the "listings" edge.
+func HasListings() predicate.Neighborhood {
+	return predicate.Neighborhood(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ListingsTable, ListingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListingsWith applies the HasEdge predicate on the "listings" edge with a given conditions (other predicates).
+func HasListingsWith(preds ...predicate.Listing) predicate.Neighborhood {
+	return predicate.Neighborhood(func(s *sql.Selector) {
+		step := newListingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Neighborhood) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Neighborhood) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Neighborhood) predicate.Neighborhood {
+	return predicate.Neighborhood(sql.NotPredicates(p))
+}
@@ -0,0 +1,1186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// NeighborhoodCreate is the builder for creating a Neighborhood entity.
+type NeighborhoodCreate struct {
+	config
+	mutation *NeighborhoodMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *NeighborhoodCreate) SetCreateTime(v time.Time) *NeighborhoodCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *NeighborhoodCreate) SetNillableCreateTime(v *time.Time) *NeighborhoodCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *NeighborhoodCreate) SetUpdateTime(v time.Time) *NeighborhoodCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *NeighborhoodCreate) SetNillableUpdateTime(v *time.Time) *NeighborhoodCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *NeighborhoodCreate) SetName(v string) *NeighborhoodCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetCity sets the "city" field.
+func (_c *NeighborhoodCreate) SetCity(v string) *NeighborhoodCreate {
+	_c.mutation.SetCity(v)
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *NeighborhoodCreate) SetState(v string) *NeighborhoodCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetCountry sets the "country" field.
+func (_c *NeighborhoodCreate) SetCountry(v string) *NeighborhoodCreate {
+	_c.mutation.SetCountry(v)
+	return _c
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_c *NeighborhoodCreate) SetNillableCountry(v *string) *NeighborhoodCreate {
+	if v != nil {
+		_c.SetCountry(*v)
+	}
+	return _c
+}
+
+// SetBoundary sets the "boundary" field.
+func (_c *NeighborhoodCreate) SetBoundary(v schematype.MultiPolygon) *NeighborhoodCreate {
+	_c.mutation.SetBoundary(v)
+	return _c
+}
+
+// SetMinLatitude sets the "min_latitude" field.
+func (_c *NeighborhoodCreate) SetMinLatitude(v float64) *NeighborhoodCreate {
+	_c.mutation.SetMinLatitude(v)
+	return _c
+}
+
+// SetMaxLatitude sets the "max_latitude" field.
+func (_c *NeighborhoodCreate) SetMaxLatitude(v float64) *NeighborhoodCreate {
+	_c.mutation.SetMaxLatitude(v)
+	return _c
+}
+
+// SetMinLongitude sets the "min_longitude" field.
+func (_c *NeighborhoodCreate) SetMinLongitude(v float64) *NeighborhoodCreate {
+	_c.mutation.SetMinLongitude(v)
+	return _c
+}
+
+// SetMaxLongitude sets the "max_longitude" field.
+func (_c *NeighborhoodCreate) SetMaxLongitude(v float64) *NeighborhoodCreate {
+	_c.mutation.SetMaxLongitude(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NeighborhoodCreate) SetID(v uuid.UUID) *NeighborhoodCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NeighborhoodCreate) SetNillableID(v *uuid.UUID) *NeighborhoodCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_c *NeighborhoodCreate) AddListingIDs(ids ...uuid.UUID) *NeighborhoodCreate {
+	_c.mutation.AddListingIDs(ids...)
+	return _c
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_c *NeighborhoodCreate) AddListings(v ...*Listing) *NeighborhoodCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddListingIDs(ids...)
+}
+
+// Mutation returns the NeighborhoodMutation object of the builder.
+func (_c *NeighborhoodCreate) Mutation() *NeighborhoodMutation {
+	return _c.mutation
+}
+
+// Save creates the Neighborhood in the database.
+func (_c *NeighborhoodCreate) Save(ctx context.Context) (*Neighborhood, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NeighborhoodCreate) SaveX(ctx context.Context) *Neighborhood {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NeighborhoodCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NeighborhoodCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NeighborhoodCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if neighborhood.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized neighborhood.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := neighborhood.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if neighborhood.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized neighborhood.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := neighborhood.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Country(); !ok {
+		v := neighborhood.DefaultCountry
+		_c.mutation.SetCountry(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if neighborhood.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized neighborhood.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := neighborhood.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NeighborhoodCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Neighborhood.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Neighborhood.update_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Neighborhood.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := neighborhood.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.City(); !ok {
+		return &ValidationError{Name: "city", err: errors.New(`ent: missing required field "Neighborhood.city"`)}
+	}
+	if v, ok := _c.mutation.City(); ok {
+		if err := neighborhood.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.city": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Neighborhood.state"`)}
+	}
+	if v, ok := _c.mutation.State(); ok {
+		if err := neighborhood.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.state": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Country(); !ok {
+		return &ValidationError{Name: "country", err: errors.New(`ent: missing required field "Neighborhood.country"`)}
+	}
+	if v, ok := _c.mutation.Country(); ok {
+		if err := neighborhood.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.country": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Boundary(); !ok {
+		return &ValidationError{Name: "boundary", err: errors.New(`ent: missing required field "Neighborhood.boundary"`)}
+	}
+	if _, ok := _c.mutation.MinLatitude(); !ok {
+		return &ValidationError{Name: "min_latitude", err: errors.New(`ent: missing required field "Neighborhood.min_latitude"`)}
+	}
+	if _, ok := _c.mutation.MaxLatitude(); !ok {
+		return &ValidationError{Name: "max_latitude", err: errors.New(`ent: missing required field "Neighborhood.max_latitude"`)}
+	}
+	if _, ok := _c.mutation.MinLongitude(); !ok {
+		return &ValidationError{Name: "min_longitude", err: errors.New(`ent: missing required field "Neighborhood.min_longitude"`)}
+	}
+	if _, ok := _c.mutation.MaxLongitude(); !ok {
+		return &ValidationError{Name: "max_longitude", err: errors.New(`ent: missing required field "Neighborhood.max_longitude"`)}
+	}
+	return nil
+}
+
+func (_c *NeighborhoodCreate) sqlSave(ctx context.Context) (*Neighborhood, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NeighborhoodCreate) createSpec() (*Neighborhood, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Neighborhood{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(neighborhood.Table, sqlgraph.NewFieldSpec(neighborhood.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(neighborhood.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(neighborhood.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(neighborhood.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.City(); ok {
+		_spec.SetField(neighborhood.FieldCity, field.TypeString, value)
+		_node.City = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(neighborhood.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.Country(); ok {
+		_spec.SetField(neighborhood.FieldCountry, field.TypeString, value)
+		_node.Country = value
+	}
+	if value, ok := _c.mutation.Boundary(); ok {
+		_spec.SetField(neighborhood.FieldBoundary, field.TypeJSON, value)
+		_node.Boundary = value
+	}
+	if value, ok := _c.mutation.MinLatitude(); ok {
+		_spec.SetField(neighborhood.FieldMinLatitude, field.TypeFloat64, value)
+		_node.MinLatitude = value
+	}
+	if value, ok := _c.mutation.MaxLatitude(); ok {
+		_spec.SetField(neighborhood.FieldMaxLatitude, field.TypeFloat64, value)
+		_node.MaxLatitude = value
+	}
+	if value, ok := _c.mutation.MinLongitude(); ok {
+		_spec.SetField(neighborhood.FieldMinLongitude, field.TypeFloat64, value)
+		_node.MinLongitude = value
+	}
+	if value, ok := _c.mutation.MaxLongitude(); ok {
+		_spec.SetField(neighborhood.FieldMaxLongitude, field.TypeFloat64, value)
+		_node.MaxLongitude = value
+	}
+	if nodes := _c.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   neighborhood.ListingsTable,
+			Columns: []string{neighborhood.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Neighborhood.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NeighborhoodUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NeighborhoodCreate) OnConflict(opts ...sql.ConflictOption) *NeighborhoodUpsertOne {
+	_c.conflict = opts
+	return &NeighborhoodUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Neighborhood.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NeighborhoodCreate) OnConflictColumns(columns ...string) *NeighborhoodUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NeighborhoodUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NeighborhoodUpsertOne is the builder for "upsert"-ing
+	//  one Neighborhood node.
+	NeighborhoodUpsertOne struct {
+		create *NeighborhoodCreate
+	}
+
+	// NeighborhoodUpsert is the "OnConflict" setter.
+	NeighborhoodUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NeighborhoodUpsert) SetUpdateTime(v time.Time) *NeighborhoodUpsert {
+	u.Set(neighborhood.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NeighborhoodUpsert) UpdateUpdateTime() *NeighborhoodUpsert {
+	u.SetExcluded(neighborhood.FieldUpdateTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NeighborhoodUpsert) SetName(v string) *NeighborhoodUpsert {
+	u.Set(neighborhood.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NeighborhoodUpsert) UpdateName() *NeighborhoodUpsert {
+	u.SetExcluded(neighborhood.FieldName)
+	return u
+}
+
+// SetCity sets the "city" field.
+func (u *NeighborhoodUpsert) SetCity(v string) *NeighborhoodUpsert {
+	u.Set(neighborhood.FieldCity, v)
+	return u
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *NeighborhoodUpsert) UpdateCity() *NeighborhoodUpsert {
+	u.SetExcluded(neighborhood.FieldCity)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *NeighborhoodUpsert) SetState(v string) *NeighborhoodUpsert {
+	u.Set(neighborhood.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *NeighborhoodUpsert) UpdateState() *NeighborhoodUpsert {
+	u.SetExcluded(neighborhood.FieldState)
+	return u
+}
+
+// SetCountry sets the "country" field.
+func (u *NeighborhoodUpsert) SetCountry(v string) *NeighborhoodUpsert {
+	u.Set(neighborhood.FieldCountry, v)
+	return u
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *NeighborhoodUpsert) UpdateCountry() *NeighborhoodUpsert {
+	u.SetExcluded(neighborhood.FieldCountry)
+	return u
+}
+
+// SetBoundary sets the "boundary" field.
+func (u *NeighborhoodUpsert) SetBoundary(v schematype.MultiPolygon) *NeighborhoodUpsert {
+	u.Set(neighborhood.FieldBoundary, v)
+	return u
+}
+
+// UpdateBoundary sets the "boundary" field to the value that was provided on create.
+func (u *NeighborhoodUpsert) UpdateBoundary() *NeighborhoodUpsert {
+	u.SetExcluded(neighborhood.FieldBoundary)
+	return u
+}
+
+// SetMinLatitude sets the "min_latitude" field.
+func (u *NeighborhoodUpsert) SetMinLatitude(v float64) *NeighborhoodUpsert {
+	u.Set(neighborhood.FieldMinLatitude, v)
+	return u
+}
+
+// UpdateMinLatitude sets the "min_latitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsert) UpdateMinLatitude() *NeighborhoodUpsert {
+	u.SetExcluded(neighborhood.FieldMinLatitude)
+	return u
+}
+
+// AddMinLatitude adds v to the "min_latitude" field.
+func (u *NeighborhoodUpsert) AddMinLatitude(v float64) *NeighborhoodUpsert {
+	u.Add(neighborhood.FieldMinLatitude, v)
+	return u
+}
+
+// SetMaxLatitude sets the "max_latitude" field.
+func (u *NeighborhoodUpsert) SetMaxLatitude(v float64) *NeighborhoodUpsert {
+	u.Set(neighborhood.FieldMaxLatitude, v)
+	return u
+}
+
+// UpdateMaxLatitude sets the "max_latitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsert) UpdateMaxLatitude() *NeighborhoodUpsert {
+	u.SetExcluded(neighborhood.FieldMaxLatitude)
+	return u
+}
+
+// AddMaxLatitude adds v to the "max_latitude" field.
+func (u *NeighborhoodUpsert) AddMaxLatitude(v float64) *NeighborhoodUpsert {
+	u.Add(neighborhood.FieldMaxLatitude, v)
+	return u
+}
+
+// SetMinLongitude sets the "min_longitude" field.
+func (u *NeighborhoodUpsert) SetMinLongitude(v float64) *NeighborhoodUpsert {
+	u.Set(neighborhood.FieldMinLongitude, v)
+	return u
+}
+
+// UpdateMinLongitude sets the "min_longitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsert) UpdateMinLongitude() *NeighborhoodUpsert {
+	u.SetExcluded(neighborhood.FieldMinLongitude)
+	return u
+}
+
+// AddMinLongitude adds v to the "min_longitude" field.
+func (u *NeighborhoodUpsert) AddMinLongitude(v float64) *NeighborhoodUpsert {
+	u.Add(neighborhood.FieldMinLongitude, v)
+	return u
+}
+
+// SetMaxLongitude sets the "max_longitude" field.
+func (u *NeighborhoodUpsert) SetMaxLongitude(v float64) *NeighborhoodUpsert {
+	u.Set(neighborhood.FieldMaxLongitude, v)
+	return u
+}
+
+// UpdateMaxLongitude sets the "max_longitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsert) UpdateMaxLongitude() *NeighborhoodUpsert {
+	u.SetExcluded(neighborhood.FieldMaxLongitude)
+	return u
+}
+
+// AddMaxLongitude adds v to the "max_longitude" field.
+func (u *NeighborhoodUpsert) AddMaxLongitude(v float64) *NeighborhoodUpsert {
+	u.Add(neighborhood.FieldMaxLongitude, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Neighborhood.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(neighborhood.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NeighborhoodUpsertOne) UpdateNewValues() *NeighborhoodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(neighborhood.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(neighborhood.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Neighborhood.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NeighborhoodUpsertOne) Ignore() *NeighborhoodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NeighborhoodUpsertOne) DoNothing() *NeighborhoodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NeighborhoodCreate.OnConflict
+// documentation for more info.
+func (u *NeighborhoodUpsertOne) Update(set func(*NeighborhoodUpsert)) *NeighborhoodUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NeighborhoodUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NeighborhoodUpsertOne) SetUpdateTime(v time.Time) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NeighborhoodUpsertOne) UpdateUpdateTime() *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *NeighborhoodUpsertOne) SetName(v string) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NeighborhoodUpsertOne) UpdateName() *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *NeighborhoodUpsertOne) SetCity(v string) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *NeighborhoodUpsertOne) UpdateCity() *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *NeighborhoodUpsertOne) SetState(v string) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *NeighborhoodUpsertOne) UpdateState() *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetCountry sets the "country" field.
+func (u *NeighborhoodUpsertOne) SetCountry(v string) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *NeighborhoodUpsertOne) UpdateCountry() *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// SetBoundary sets the "boundary" field.
+func (u *NeighborhoodUpsertOne) SetBoundary(v schematype.MultiPolygon) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetBoundary(v)
+	})
+}
+
+// UpdateBoundary sets the "boundary" field to the value that was provided on create.
+func (u *NeighborhoodUpsertOne) UpdateBoundary() *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateBoundary()
+	})
+}
+
+// SetMinLatitude sets the "min_latitude" field.
+func (u *NeighborhoodUpsertOne) SetMinLatitude(v float64) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetMinLatitude(v)
+	})
+}
+
+// AddMinLatitude adds v to the "min_latitude" field.
+func (u *NeighborhoodUpsertOne) AddMinLatitude(v float64) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.AddMinLatitude(v)
+	})
+}
+
+// UpdateMinLatitude sets the "min_latitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsertOne) UpdateMinLatitude() *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateMinLatitude()
+	})
+}
+
+// SetMaxLatitude sets the "max_latitude" field.
+func (u *NeighborhoodUpsertOne) SetMaxLatitude(v float64) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetMaxLatitude(v)
+	})
+}
+
+// AddMaxLatitude adds v to the "max_latitude" field.
+func (u *NeighborhoodUpsertOne) AddMaxLatitude(v float64) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.AddMaxLatitude(v)
+	})
+}
+
+// UpdateMaxLatitude sets the "max_latitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsertOne) UpdateMaxLatitude() *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateMaxLatitude()
+	})
+}
+
+// SetMinLongitude sets the "min_longitude" field.
+func (u *NeighborhoodUpsertOne) SetMinLongitude(v float64) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetMinLongitude(v)
+	})
+}
+
+// AddMinLongitude adds v to the "min_longitude" field.
+func (u *NeighborhoodUpsertOne) AddMinLongitude(v float64) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.AddMinLongitude(v)
+	})
+}
+
+// UpdateMinLongitude sets the "min_longitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsertOne) UpdateMinLongitude() *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateMinLongitude()
+	})
+}
+
+// SetMaxLongitude sets the "max_longitude" field.
+func (u *NeighborhoodUpsertOne) SetMaxLongitude(v float64) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetMaxLongitude(v)
+	})
+}
+
+// AddMaxLongitude adds v to the "max_longitude" field.
+func (u *NeighborhoodUpsertOne) AddMaxLongitude(v float64) *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.AddMaxLongitude(v)
+	})
+}
+
+// UpdateMaxLongitude sets the "max_longitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsertOne) UpdateMaxLongitude() *NeighborhoodUpsertOne {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateMaxLongitude()
+	})
+}
+
+// Exec executes the query.
+func (u *NeighborhoodUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NeighborhoodCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NeighborhoodUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NeighborhoodUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NeighborhoodUpsertOne.ID is not supported by MySQL driver. Use NeighborhoodUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NeighborhoodUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NeighborhoodCreateBulk is the builder for creating many Neighborhood entities in bulk.
+type NeighborhoodCreateBulk struct {
+	config
+	err      error
+	builders []*NeighborhoodCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Neighborhood entities in the database.
+func (_c *NeighborhoodCreateBulk) Save(ctx context.Context) ([]*Neighborhood, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Neighborhood, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NeighborhoodMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NeighborhoodCreateBulk) SaveX(ctx context.Context) []*Neighborhood {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NeighborhoodCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NeighborhoodCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Neighborhood.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NeighborhoodUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NeighborhoodCreateBulk) OnConflict(opts ...sql.ConflictOption) *NeighborhoodUpsertBulk {
+	_c.conflict = opts
+	return &NeighborhoodUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Neighborhood.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NeighborhoodCreateBulk) OnConflictColumns(columns ...string) *NeighborhoodUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NeighborhoodUpsertBulk{
+		create: _c,
+	}
+}
+
+// NeighborhoodUpsertBulk is the builder for "upsert"-ing
+// a bulk of Neighborhood nodes.
+type NeighborhoodUpsertBulk struct {
+	create *NeighborhoodCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Neighborhood.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(neighborhood.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NeighborhoodUpsertBulk) UpdateNewValues() *NeighborhoodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(neighborhood.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(neighborhood.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Neighborhood.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NeighborhoodUpsertBulk) Ignore() *NeighborhoodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NeighborhoodUpsertBulk) DoNothing() *NeighborhoodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NeighborhoodCreateBulk.OnConflict
+// documentation for more info.
+func (u *NeighborhoodUpsertBulk) Update(set func(*NeighborhoodUpsert)) *NeighborhoodUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NeighborhoodUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NeighborhoodUpsertBulk) SetUpdateTime(v time.Time) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NeighborhoodUpsertBulk) UpdateUpdateTime() *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *NeighborhoodUpsertBulk) SetName(v string) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NeighborhoodUpsertBulk) UpdateName() *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *NeighborhoodUpsertBulk) SetCity(v string) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *NeighborhoodUpsertBulk) UpdateCity() *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *NeighborhoodUpsertBulk) SetState(v string) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *NeighborhoodUpsertBulk) UpdateState() *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetCountry sets the "country" field.
+func (u *NeighborhoodUpsertBulk) SetCountry(v string) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *NeighborhoodUpsertBulk) UpdateCountry() *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// SetBoundary sets the "boundary" field.
+func (u *NeighborhoodUpsertBulk) SetBoundary(v schematype.MultiPolygon) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetBoundary(v)
+	})
+}
+
+// UpdateBoundary sets the "boundary" field to the value that was provided on create.
+func (u *NeighborhoodUpsertBulk) UpdateBoundary() *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateBoundary()
+	})
+}
+
+// SetMinLatitude sets the "min_latitude" field.
+func (u *NeighborhoodUpsertBulk) SetMinLatitude(v float64) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetMinLatitude(v)
+	})
+}
+
+// AddMinLatitude adds v to the "min_latitude" field.
+func (u *NeighborhoodUpsertBulk) AddMinLatitude(v float64) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.AddMinLatitude(v)
+	})
+}
+
+// UpdateMinLatitude sets the "min_latitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsertBulk) UpdateMinLatitude() *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateMinLatitude()
+	})
+}
+
+// SetMaxLatitude sets the "max_latitude" field.
+func (u *NeighborhoodUpsertBulk) SetMaxLatitude(v float64) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetMaxLatitude(v)
+	})
+}
+
+// AddMaxLatitude adds v to the "max_latitude" field.
+func (u *NeighborhoodUpsertBulk) AddMaxLatitude(v float64) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.AddMaxLatitude(v)
+	})
+}
+
+// UpdateMaxLatitude sets the "max_latitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsertBulk) UpdateMaxLatitude() *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateMaxLatitude()
+	})
+}
+
+// SetMinLongitude sets the "min_longitude" field.
+func (u *NeighborhoodUpsertBulk) SetMinLongitude(v float64) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetMinLongitude(v)
+	})
+}
+
+// AddMinLongitude adds v to the "min_longitude" field.
+func (u *NeighborhoodUpsertBulk) AddMinLongitude(v float64) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.AddMinLongitude(v)
+	})
+}
+
+// UpdateMinLongitude sets the "min_longitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsertBulk) UpdateMinLongitude() *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateMinLongitude()
+	})
+}
+
+// SetMaxLongitude sets the "max_longitude" field.
+func (u *NeighborhoodUpsertBulk) SetMaxLongitude(v float64) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.SetMaxLongitude(v)
+	})
+}
+
+// AddMaxLongitude adds v to the "max_longitude" field.
+func (u *NeighborhoodUpsertBulk) AddMaxLongitude(v float64) *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.AddMaxLongitude(v)
+	})
+}
+
+// UpdateMaxLongitude sets the "max_longitude" field to the value that was provided on create.
+func (u *NeighborhoodUpsertBulk) UpdateMaxLongitude() *NeighborhoodUpsertBulk {
+	return u.Update(func(s *NeighborhoodUpsert) {
+		s.UpdateMaxLongitude()
+	})
+}
+
+// Exec executes the query.
+func (u *NeighborhoodUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NeighborhoodCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NeighborhoodCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NeighborhoodUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,838 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// NeighborhoodUpdate is the builder for updating Neighborhood entities.
+type NeighborhoodUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NeighborhoodMutation
+}
+
+// Where appends a list predicates to the NeighborhoodUpdate builder.
+func (_u *NeighborhoodUpdate) Where(ps ...predicate.Neighborhood) *NeighborhoodUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *NeighborhoodUpdate) SetUpdateTime(v time.Time) *NeighborhoodUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *NeighborhoodUpdate) SetName(v string) *NeighborhoodUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *NeighborhoodUpdate) SetNillableName(v *string) *NeighborhoodUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *NeighborhoodUpdate) SetCity(v string) *NeighborhoodUpdate {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *NeighborhoodUpdate) SetNillableCity(v *string) *NeighborhoodUpdate {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *NeighborhoodUpdate) SetState(v string) *NeighborhoodUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *NeighborhoodUpdate) SetNillableState(v *string) *NeighborhoodUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *NeighborhoodUpdate) SetCountry(v string) *NeighborhoodUpdate {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *NeighborhoodUpdate) SetNillableCountry(v *string) *NeighborhoodUpdate {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// SetBoundary sets the "boundary" field.
+func (_u *NeighborhoodUpdate) SetBoundary(v schematype.MultiPolygon) *NeighborhoodUpdate {
+	_u.mutation.SetBoundary(v)
+	return _u
+}
+
+// AppendBoundary appends value to the "boundary" field.
+func (_u *NeighborhoodUpdate) AppendBoundary(v schematype.MultiPolygon) *NeighborhoodUpdate {
+	_u.mutation.AppendBoundary(v)
+	return _u
+}
+
+// SetMinLatitude sets the "min_latitude" field.
+func (_u *NeighborhoodUpdate) SetMinLatitude(v float64) *NeighborhoodUpdate {
+	_u.mutation.ResetMinLatitude()
+	_u.mutation.SetMinLatitude(v)
+	return _u
+}
+
+// SetNillableMinLatitude sets the "min_latitude" field if the given value is not nil.
+func (_u *NeighborhoodUpdate) SetNillableMinLatitude(v *float64) *NeighborhoodUpdate {
+	if v != nil {
+		_u.SetMinLatitude(*v)
+	}
+	return _u
+}
+
+// AddMinLatitude adds value to the "min_latitude" field.
+func (_u *NeighborhoodUpdate) AddMinLatitude(v float64) *NeighborhoodUpdate {
+	_u.mutation.AddMinLatitude(v)
+	return _u
+}
+
+// SetMaxLatitude sets the "max_latitude" field.
+func (_u *NeighborhoodUpdate) SetMaxLatitude(v float64) *NeighborhoodUpdate {
+	_u.mutation.ResetMaxLatitude()
+	_u.mutation.SetMaxLatitude(v)
+	return _u
+}
+
+// SetNillableMaxLatitude sets the "max_latitude" field if the given value is not nil.
+func (_u *NeighborhoodUpdate) SetNillableMaxLatitude(v *float64) *NeighborhoodUpdate {
+	if v != nil {
+		_u.SetMaxLatitude(*v)
+	}
+	return _u
+}
+
+// AddMaxLatitude adds value to the "max_latitude" field.
+func (_u *NeighborhoodUpdate) AddMaxLatitude(v float64) *NeighborhoodUpdate {
+	_u.mutation.AddMaxLatitude(v)
+	return _u
+}
+
+// SetMinLongitude sets the "min_longitude" field.
+func (_u *NeighborhoodUpdate) SetMinLongitude(v float64) *NeighborhoodUpdate {
+	_u.mutation.ResetMinLongitude()
+	_u.mutation.SetMinLongitude(v)
+	return _u
+}
+
+// SetNillableMinLongitude sets the "min_longitude" field if the given value is not nil.
+func (_u *NeighborhoodUpdate) SetNillableMinLongitude(v *float64) *NeighborhoodUpdate {
+	if v != nil {
+		_u.SetMinLongitude(*v)
+	}
+	return _u
+}
+
+// AddMinLongitude adds value to the "min_longitude" field.
+func (_u *NeighborhoodUpdate) AddMinLongitude(v float64) *NeighborhoodUpdate {
+	_u.mutation.AddMinLongitude(v)
+	return _u
+}
+
+// SetMaxLongitude sets the "max_longitude" field.
+func (_u *NeighborhoodUpdate) SetMaxLongitude(v float64) *NeighborhoodUpdate {
+	_u.mutation.ResetMaxLongitude()
+	_u.mutation.SetMaxLongitude(v)
+	return _u
+}
+
+// SetNillableMaxLongitude sets the "max_longitude" field if the given value is not nil.
+func (_u *NeighborhoodUpdate) SetNillableMaxLongitude(v *float64) *NeighborhoodUpdate {
+	if v != nil {
+		_u.SetMaxLongitude(*v)
+	}
+	return _u
+}
+
+// AddMaxLongitude adds value to the "max_longitude" field.
+func (_u *NeighborhoodUpdate) AddMaxLongitude(v float64) *NeighborhoodUpdate {
+	_u.mutation.AddMaxLongitude(v)
+	return _u
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_u *NeighborhoodUpdate) AddListingIDs(ids ...uuid.UUID) *NeighborhoodUpdate {
+	_u.mutation.AddListingIDs(ids...)
+	return _u
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_u *NeighborhoodUpdate) AddListings(v ...*Listing) *NeighborhoodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListingIDs(ids...)
+}
+
+// Mutation returns the NeighborhoodMutation object of the builder.
+func (_u *NeighborhoodUpdate) Mutation() *NeighborhoodMutation {
+	return _u.mutation
+}
+
+// ClearListings clears all "listings" edges to the Listing entity.
+func (_u *NeighborhoodUpdate) ClearListings() *NeighborhoodUpdate {
+	_u.mutation.ClearListings()
+	return _u
+}
+
+// RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
+func (_u *NeighborhoodUpdate) RemoveListingIDs(ids ...uuid.UUID) *NeighborhoodUpdate {
+	_u.mutation.RemoveListingIDs(ids...)
+	return _u
+}
+
+// RemoveListings removes "listings" edges to Listing entities.
+func (_u *NeighborhoodUpdate) RemoveListings(v ...*Listing) *NeighborhoodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListingIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NeighborhoodUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NeighborhoodUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NeighborhoodUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NeighborhoodUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NeighborhoodUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if neighborhood.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized neighborhood.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := neighborhood.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NeighborhoodUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := neighborhood.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.City(); ok {
+		if err := neighborhood.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := neighborhood.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := neighborhood.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.country": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NeighborhoodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(neighborhood.Table, neighborhood.Columns, sqlgraph.NewFieldSpec(neighborhood.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(neighborhood.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(neighborhood.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(neighborhood.FieldCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(neighborhood.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(neighborhood.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Boundary(); ok {
+		_spec.SetField(neighborhood.FieldBoundary, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBoundary(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, neighborhood.FieldBoundary, value)
+		})
+	}
+	if value, ok := _u.mutation.MinLatitude(); ok {
+		_spec.SetField(neighborhood.FieldMinLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinLatitude(); ok {
+		_spec.AddField(neighborhood.FieldMinLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxLatitude(); ok {
+		_spec.SetField(neighborhood.FieldMaxLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLatitude(); ok {
+		_spec.AddField(neighborhood.FieldMaxLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MinLongitude(); ok {
+		_spec.SetField(neighborhood.FieldMinLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinLongitude(); ok {
+		_spec.AddField(neighborhood.FieldMinLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxLongitude(); ok {
+		_spec.SetField(neighborhood.FieldMaxLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLongitude(); ok {
+		_spec.AddField(neighborhood.FieldMaxLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   neighborhood.ListingsTable,
+			Columns: []string{neighborhood.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedListingsIDs(); len(nodes) > 0 && !_u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   neighborhood.ListingsTable,
+			Columns: []string{neighborhood.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   neighborhood.ListingsTable,
+			Columns: []string{neighborhood.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{neighborhood.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NeighborhoodUpdateOne is the builder for updating a single Neighborhood entity.
+type NeighborhoodUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NeighborhoodMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *NeighborhoodUpdateOne) SetUpdateTime(v time.Time) *NeighborhoodUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *NeighborhoodUpdateOne) SetName(v string) *NeighborhoodUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *NeighborhoodUpdateOne) SetNillableName(v *string) *NeighborhoodUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *NeighborhoodUpdateOne) SetCity(v string) *NeighborhoodUpdateOne {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *NeighborhoodUpdateOne) SetNillableCity(v *string) *NeighborhoodUpdateOne {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *NeighborhoodUpdateOne) SetState(v string) *NeighborhoodUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *NeighborhoodUpdateOne) SetNillableState(v *string) *NeighborhoodUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *NeighborhoodUpdateOne) SetCountry(v string) *NeighborhoodUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *NeighborhoodUpdateOne) SetNillableCountry(v *string) *NeighborhoodUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// SetBoundary sets the "boundary" field.
+func (_u *NeighborhoodUpdateOne) SetBoundary(v schematype.MultiPolygon) *NeighborhoodUpdateOne {
+	_u.mutation.SetBoundary(v)
+	return _u
+}
+
+// AppendBoundary appends value to the "boundary" field.
+func (_u *NeighborhoodUpdateOne) AppendBoundary(v schematype.MultiPolygon) *NeighborhoodUpdateOne {
+	_u.mutation.AppendBoundary(v)
+	return _u
+}
+
+// SetMinLatitude sets the "min_latitude" field.
+func (_u *NeighborhoodUpdateOne) SetMinLatitude(v float64) *NeighborhoodUpdateOne {
+	_u.mutation.ResetMinLatitude()
+	_u.mutation.SetMinLatitude(v)
+	return _u
+}
+
+// SetNillableMinLatitude sets the "min_latitude" field if the given value is not nil.
+func (_u *NeighborhoodUpdateOne) SetNillableMinLatitude(v *float64) *NeighborhoodUpdateOne {
+	if v != nil {
+		_u.SetMinLatitude(*v)
+	}
+	return _u
+}
+
+// AddMinLatitude adds value to the "min_latitude" field.
+func (_u *NeighborhoodUpdateOne) AddMinLatitude(v float64) *NeighborhoodUpdateOne {
+	_u.mutation.AddMinLatitude(v)
+	return _u
+}
+
+// SetMaxLatitude sets the "max_latitude" field.
+func (_u *NeighborhoodUpdateOne) SetMaxLatitude(v float64) *NeighborhoodUpdateOne {
+	_u.mutation.ResetMaxLatitude()
+	_u.mutation.SetMaxLatitude(v)
+	return _u
+}
+
+// SetNillableMaxLatitude sets the "max_latitude" field if the given value is not nil.
+func (_u *NeighborhoodUpdateOne) SetNillableMaxLatitude(v *float64) *NeighborhoodUpdateOne {
+	if v != nil {
+		_u.SetMaxLatitude(*v)
+	}
+	return _u
+}
+
+// AddMaxLatitude adds value to the "max_latitude" field.
+func (_u *NeighborhoodUpdateOne) AddMaxLatitude(v float64) *NeighborhoodUpdateOne {
+	_u.mutation.AddMaxLatitude(v)
+	return _u
+}
+
+// SetMinLongitude sets the "min_longitude" field.
+func (_u *NeighborhoodUpdateOne) SetMinLongitude(v float64) *NeighborhoodUpdateOne {
+	_u.mutation.ResetMinLongitude()
+	_u.mutation.SetMinLongitude(v)
+	return _u
+}
+
+// SetNillableMinLongitude sets the "min_longitude" field if the given value is not nil.
+func (_u *NeighborhoodUpdateOne) SetNillableMinLongitude(v *float64) *NeighborhoodUpdateOne {
+	if v != nil {
+		_u.SetMinLongitude(*v)
+	}
+	return _u
+}
+
+// AddMinLongitude adds value to the "min_longitude" field.
+func (_u *NeighborhoodUpdateOne) AddMinLongitude(v float64) *NeighborhoodUpdateOne {
+	_u.mutation.AddMinLongitude(v)
+	return _u
+}
+
+// SetMaxLongitude sets the "max_longitude" field.
+func (_u *NeighborhoodUpdateOne) SetMaxLongitude(v float64) *NeighborhoodUpdateOne {
+	_u.mutation.ResetMaxLongitude()
+	_u.mutation.SetMaxLongitude(v)
+	return _u
+}
+
+// SetNillableMaxLongitude sets the "max_longitude" field if the given value is not nil.
+func (_u *NeighborhoodUpdateOne) SetNillableMaxLongitude(v *float64) *NeighborhoodUpdateOne {
+	if v != nil {
+		_u.SetMaxLongitude(*v)
+	}
+	return _u
+}
+
+// AddMaxLongitude adds value to the "max_longitude" field.
+func (_u *NeighborhoodUpdateOne) AddMaxLongitude(v float64) *NeighborhoodUpdateOne {
+	_u.mutation.AddMaxLongitude(v)
+	return _u
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_u *NeighborhoodUpdateOne) AddListingIDs(ids ...uuid.UUID) *NeighborhoodUpdateOne {
+	_u.mutation.AddListingIDs(ids...)
+	return _u
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_u *NeighborhoodUpdateOne) AddListings(v ...*Listing) *NeighborhoodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListingIDs(ids...)
+}
+
+// Mutation returns the NeighborhoodMutation object of the builder.
+func (_u *NeighborhoodUpdateOne) Mutation() *NeighborhoodMutation {
+	return _u.mutation
+}
+
+// ClearListings clears all "listings" edges to the Listing entity.
+func (_u *NeighborhoodUpdateOne) ClearListings() *NeighborhoodUpdateOne {
+	_u.mutation.ClearListings()
+	return _u
+}
+
+// RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
+func (_u *NeighborhoodUpdateOne) RemoveListingIDs(ids ...uuid.UUID) *NeighborhoodUpdateOne {
+	_u.mutation.RemoveListingIDs(ids...)
+	return _u
+}
+
+// RemoveListings removes "listings" edges to Listing entities.
+func (_u *NeighborhoodUpdateOne) RemoveListings(v ...*Listing) *NeighborhoodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListingIDs(ids...)
+}
+
+// Where appends a list predicates to the NeighborhoodUpdate builder.
+func (_u *NeighborhoodUpdateOne) Where(ps ...predicate.Neighborhood) *NeighborhoodUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NeighborhoodUpdateOne) Select(field string, fields ...string) *NeighborhoodUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Neighborhood entity.
+func (_u *NeighborhoodUpdateOne) Save(ctx context.Context) (*Neighborhood, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NeighborhoodUpdateOne) SaveX(ctx context.Context) *Neighborhood {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NeighborhoodUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NeighborhoodUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NeighborhoodUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if neighborhood.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized neighborhood.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := neighborhood.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NeighborhoodUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := neighborhood.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.City(); ok {
+		if err := neighborhood.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := neighborhood.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := neighborhood.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "Neighborhood.country": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NeighborhoodUpdateOne) sqlSave(ctx context.Context) (_node *Neighborhood, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(neighborhood.Table, neighborhood.Columns, sqlgraph.NewFieldSpec(neighborhood.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Neighborhood.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, neighborhood.FieldID)
+		for _, f := range fields {
+			if !neighborhood.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != neighborhood.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(neighborhood.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(neighborhood.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(neighborhood.FieldCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(neighborhood.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(neighborhood.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Boundary(); ok {
+		_spec.SetField(neighborhood.FieldBoundary, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBoundary(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, neighborhood.FieldBoundary, value)
+		})
+	}
+	if value, ok := _u.mutation.MinLatitude(); ok {
+		_spec.SetField(neighborhood.FieldMinLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinLatitude(); ok {
+		_spec.AddField(neighborhood.FieldMinLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxLatitude(); ok {
+		_spec.SetField(neighborhood.FieldMaxLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLatitude(); ok {
+		_spec.AddField(neighborhood.FieldMaxLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MinLongitude(); ok {
+		_spec.SetField(neighborhood.FieldMinLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinLongitude(); ok {
+		_spec.AddField(neighborhood.FieldMinLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxLongitude(); ok {
+		_spec.SetField(neighborhood.FieldMaxLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLongitude(); ok {
+		_spec.AddField(neighborhood.FieldMaxLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   neighborhood.ListingsTable,
+			Columns: []string{neighborhood.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedListingsIDs(); len(nodes) > 0 && !_u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   neighborhood.ListingsTable,
+			Columns: []string{neighborhood.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   neighborhood.ListingsTable,
+			Columns: []string{neighborhood.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Neighborhood{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{neighborhood.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
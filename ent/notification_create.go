@@ -0,0 +1,1050 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/notification"
+)
+
+// NotificationCreate is the builder for creating a Notification entity.
+type NotificationCreate struct {
+	config
+	mutation *NotificationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *NotificationCreate) SetCreateTime(v time.Time) *NotificationCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *NotificationCreate) SetNillableCreateTime(v *time.Time) *NotificationCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *NotificationCreate) SetUpdateTime(v time.Time) *NotificationCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *NotificationCreate) SetNillableUpdateTime(v *time.Time) *NotificationCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (_c *NotificationCreate) SetRecipientEmail(v string) *NotificationCreate {
+	_c.mutation.SetRecipientEmail(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *NotificationCreate) SetUserID(v uuid.UUID) *NotificationCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *NotificationCreate) SetNillableUserID(v *uuid.UUID) *NotificationCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (_c *NotificationCreate) SetRealtorID(v uuid.UUID) *NotificationCreate {
+	_c.mutation.SetRealtorID(v)
+	return _c
+}
+
+// SetNillableRealtorID sets the "realtor_id" field if the given value is not nil.
+func (_c *NotificationCreate) SetNillableRealtorID(v *uuid.UUID) *NotificationCreate {
+	if v != nil {
+		_c.SetRealtorID(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *NotificationCreate) SetKind(v string) *NotificationCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *NotificationCreate) SetSubject(v string) *NotificationCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *NotificationCreate) SetBody(v string) *NotificationCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_c *NotificationCreate) SetNillableBody(v *string) *NotificationCreate {
+	if v != nil {
+		_c.SetBody(*v)
+	}
+	return _c
+}
+
+// SetReadAt sets the "read_at" field.
+func (_c *NotificationCreate) SetReadAt(v time.Time) *NotificationCreate {
+	_c.mutation.SetReadAt(v)
+	return _c
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_c *NotificationCreate) SetNillableReadAt(v *time.Time) *NotificationCreate {
+	if v != nil {
+		_c.SetReadAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *NotificationCreate) SetID(v uuid.UUID) *NotificationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *NotificationCreate) SetNillableID(v *uuid.UUID) *NotificationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (_c *NotificationCreate) Mutation() *NotificationMutation {
+	return _c.mutation
+}
+
+// Save creates the Notification in the database.
+func (_c *NotificationCreate) Save(ctx context.Context) (*Notification, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *NotificationCreate) SaveX(ctx context.Context) *Notification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *NotificationCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if notification.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized notification.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := notification.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if notification.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized notification.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := notification.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if notification.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized notification.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := notification.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *NotificationCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Notification.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Notification.update_time"`)}
+	}
+	if _, ok := _c.mutation.RecipientEmail(); !ok {
+		return &ValidationError{Name: "recipient_email", err: errors.New(`ent: missing required field "Notification.recipient_email"`)}
+	}
+	if v, ok := _c.mutation.RecipientEmail(); ok {
+		if err := notification.RecipientEmailValidator(v); err != nil {
+			return &ValidationError{Name: "recipient_email", err: fmt.Errorf(`ent: validator failed for field "Notification.recipient_email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Notification.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := notification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Notification.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "Notification.subject"`)}
+	}
+	if v, ok := _c.mutation.Subject(); ok {
+		if err := notification.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "Notification.subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *NotificationCreate) sqlSave(ctx context.Context) (*Notification, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Notification{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(notification.Table, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(notification.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(notification.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.RecipientEmail(); ok {
+		_spec.SetField(notification.FieldRecipientEmail, field.TypeString, value)
+		_node.RecipientEmail = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(notification.FieldUserID, field.TypeUUID, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.RealtorID(); ok {
+		_spec.SetField(notification.FieldRealtorID, field.TypeUUID, value)
+		_node.RealtorID = &value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(notification.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(notification.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(notification.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Notification.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationCreate) OnConflict(opts ...sql.ConflictOption) *NotificationUpsertOne {
+	_c.conflict = opts
+	return &NotificationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationCreate) OnConflictColumns(columns ...string) *NotificationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// NotificationUpsertOne is the builder for "upsert"-ing
+	//  one Notification node.
+	NotificationUpsertOne struct {
+		create *NotificationCreate
+	}
+
+	// NotificationUpsert is the "OnConflict" setter.
+	NotificationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NotificationUpsert) SetUpdateTime(v time.Time) *NotificationUpsert {
+	u.Set(notification.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateUpdateTime() *NotificationUpsert {
+	u.SetExcluded(notification.FieldUpdateTime)
+	return u
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (u *NotificationUpsert) SetRecipientEmail(v string) *NotificationUpsert {
+	u.Set(notification.FieldRecipientEmail, v)
+	return u
+}
+
+// UpdateRecipientEmail sets the "recipient_email" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateRecipientEmail() *NotificationUpsert {
+	u.SetExcluded(notification.FieldRecipientEmail)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *NotificationUpsert) SetUserID(v uuid.UUID) *NotificationUpsert {
+	u.Set(notification.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateUserID() *NotificationUpsert {
+	u.SetExcluded(notification.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *NotificationUpsert) ClearUserID() *NotificationUpsert {
+	u.SetNull(notification.FieldUserID)
+	return u
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (u *NotificationUpsert) SetRealtorID(v uuid.UUID) *NotificationUpsert {
+	u.Set(notification.FieldRealtorID, v)
+	return u
+}
+
+// UpdateRealtorID sets the "realtor_id" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateRealtorID() *NotificationUpsert {
+	u.SetExcluded(notification.FieldRealtorID)
+	return u
+}
+
+// ClearRealtorID clears the value of the "realtor_id" field.
+func (u *NotificationUpsert) ClearRealtorID() *NotificationUpsert {
+	u.SetNull(notification.FieldRealtorID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *NotificationUpsert) SetKind(v string) *NotificationUpsert {
+	u.Set(notification.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateKind() *NotificationUpsert {
+	u.SetExcluded(notification.FieldKind)
+	return u
+}
+
+// SetSubject sets the "subject" field.
+func (u *NotificationUpsert) SetSubject(v string) *NotificationUpsert {
+	u.Set(notification.FieldSubject, v)
+	return u
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateSubject() *NotificationUpsert {
+	u.SetExcluded(notification.FieldSubject)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationUpsert) SetBody(v string) *NotificationUpsert {
+	u.Set(notification.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateBody() *NotificationUpsert {
+	u.SetExcluded(notification.FieldBody)
+	return u
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *NotificationUpsert) ClearBody() *NotificationUpsert {
+	u.SetNull(notification.FieldBody)
+	return u
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsert) SetReadAt(v time.Time) *NotificationUpsert {
+	u.Set(notification.FieldReadAt, v)
+	return u
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateReadAt() *NotificationUpsert {
+	u.SetExcluded(notification.FieldReadAt)
+	return u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsert) ClearReadAt() *NotificationUpsert {
+	u.SetNull(notification.FieldReadAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notification.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationUpsertOne) UpdateNewValues() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notification.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(notification.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationUpsertOne) Ignore() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationUpsertOne) DoNothing() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationCreate.OnConflict
+// documentation for more info.
+func (u *NotificationUpsertOne) Update(set func(*NotificationUpsert)) *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NotificationUpsertOne) SetUpdateTime(v time.Time) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateUpdateTime() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (u *NotificationUpsertOne) SetRecipientEmail(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetRecipientEmail(v)
+	})
+}
+
+// UpdateRecipientEmail sets the "recipient_email" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateRecipientEmail() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateRecipientEmail()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *NotificationUpsertOne) SetUserID(v uuid.UUID) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateUserID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *NotificationUpsertOne) ClearUserID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (u *NotificationUpsertOne) SetRealtorID(v uuid.UUID) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetRealtorID(v)
+	})
+}
+
+// UpdateRealtorID sets the "realtor_id" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateRealtorID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateRealtorID()
+	})
+}
+
+// ClearRealtorID clears the value of the "realtor_id" field.
+func (u *NotificationUpsertOne) ClearRealtorID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearRealtorID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *NotificationUpsertOne) SetKind(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateKind() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *NotificationUpsertOne) SetSubject(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateSubject() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationUpsertOne) SetBody(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateBody() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *NotificationUpsertOne) ClearBody() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearBody()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsertOne) SetReadAt(v time.Time) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateReadAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsertOne) ClearReadAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NotificationUpsertOne.ID is not supported by MySQL driver. Use NotificationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationCreateBulk is the builder for creating many Notification entities in bulk.
+type NotificationCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Notification entities in the database.
+func (_c *NotificationCreateBulk) Save(ctx context.Context) ([]*Notification, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Notification, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *NotificationCreateBulk) SaveX(ctx context.Context) []*Notification {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *NotificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *NotificationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Notification.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *NotificationCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationUpsertBulk {
+	_c.conflict = opts
+	return &NotificationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *NotificationCreateBulk) OnConflictColumns(columns ...string) *NotificationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &NotificationUpsertBulk{
+		create: _c,
+	}
+}
+
+// NotificationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Notification nodes.
+type NotificationUpsertBulk struct {
+	create *NotificationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notification.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationUpsertBulk) UpdateNewValues() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notification.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(notification.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationUpsertBulk) Ignore() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationUpsertBulk) DoNothing() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationUpsertBulk) Update(set func(*NotificationUpsert)) *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *NotificationUpsertBulk) SetUpdateTime(v time.Time) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateUpdateTime() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (u *NotificationUpsertBulk) SetRecipientEmail(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetRecipientEmail(v)
+	})
+}
+
+// UpdateRecipientEmail sets the "recipient_email" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateRecipientEmail() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateRecipientEmail()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *NotificationUpsertBulk) SetUserID(v uuid.UUID) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateUserID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *NotificationUpsertBulk) ClearUserID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (u *NotificationUpsertBulk) SetRealtorID(v uuid.UUID) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetRealtorID(v)
+	})
+}
+
+// UpdateRealtorID sets the "realtor_id" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateRealtorID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateRealtorID()
+	})
+}
+
+// ClearRealtorID clears the value of the "realtor_id" field.
+func (u *NotificationUpsertBulk) ClearRealtorID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearRealtorID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *NotificationUpsertBulk) SetKind(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateKind() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetSubject sets the "subject" field.
+func (u *NotificationUpsertBulk) SetSubject(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetSubject(v)
+	})
+}
+
+// UpdateSubject sets the "subject" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateSubject() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateSubject()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationUpsertBulk) SetBody(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateBody() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *NotificationUpsertBulk) ClearBody() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearBody()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsertBulk) SetReadAt(v time.Time) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateReadAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsertBulk) ClearReadAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,581 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/notification"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// NotificationUpdate is the builder for updating Notification entities.
+type NotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (_u *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *NotificationUpdate) SetUpdateTime(v time.Time) *NotificationUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (_u *NotificationUpdate) SetRecipientEmail(v string) *NotificationUpdate {
+	_u.mutation.SetRecipientEmail(v)
+	return _u
+}
+
+// SetNillableRecipientEmail sets the "recipient_email" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableRecipientEmail(v *string) *NotificationUpdate {
+	if v != nil {
+		_u.SetRecipientEmail(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *NotificationUpdate) SetUserID(v uuid.UUID) *NotificationUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableUserID(v *uuid.UUID) *NotificationUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *NotificationUpdate) ClearUserID() *NotificationUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (_u *NotificationUpdate) SetRealtorID(v uuid.UUID) *NotificationUpdate {
+	_u.mutation.SetRealtorID(v)
+	return _u
+}
+
+// SetNillableRealtorID sets the "realtor_id" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableRealtorID(v *uuid.UUID) *NotificationUpdate {
+	if v != nil {
+		_u.SetRealtorID(*v)
+	}
+	return _u
+}
+
+// ClearRealtorID clears the value of the "realtor_id" field.
+func (_u *NotificationUpdate) ClearRealtorID() *NotificationUpdate {
+	_u.mutation.ClearRealtorID()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *NotificationUpdate) SetKind(v string) *NotificationUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableKind(v *string) *NotificationUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *NotificationUpdate) SetSubject(v string) *NotificationUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableSubject(v *string) *NotificationUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *NotificationUpdate) SetBody(v string) *NotificationUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableBody(v *string) *NotificationUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// ClearBody clears the value of the "body" field.
+func (_u *NotificationUpdate) ClearBody() *NotificationUpdate {
+	_u.mutation.ClearBody()
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *NotificationUpdate) SetReadAt(v time.Time) *NotificationUpdate {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *NotificationUpdate) SetNillableReadAt(v *time.Time) *NotificationUpdate {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *NotificationUpdate) ClearReadAt() *NotificationUpdate {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (_u *NotificationUpdate) Mutation() *NotificationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *NotificationUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *NotificationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if notification.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized notification.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := notification.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationUpdate) check() error {
+	if v, ok := _u.mutation.RecipientEmail(); ok {
+		if err := notification.RecipientEmailValidator(v); err != nil {
+			return &ValidationError{Name: "recipient_email", err: fmt.Errorf(`ent: validator failed for field "Notification.recipient_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := notification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Notification.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := notification.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "Notification.subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NotificationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(notification.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RecipientEmail(); ok {
+		_spec.SetField(notification.FieldRecipientEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(notification.FieldUserID, field.TypeUUID, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(notification.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.RealtorID(); ok {
+		_spec.SetField(notification.FieldRealtorID, field.TypeUUID, value)
+	}
+	if _u.mutation.RealtorIDCleared() {
+		_spec.ClearField(notification.FieldRealtorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(notification.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(notification.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(notification.FieldBody, field.TypeString, value)
+	}
+	if _u.mutation.BodyCleared() {
+		_spec.ClearField(notification.FieldBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *NotificationUpdateOne) SetUpdateTime(v time.Time) *NotificationUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (_u *NotificationUpdateOne) SetRecipientEmail(v string) *NotificationUpdateOne {
+	_u.mutation.SetRecipientEmail(v)
+	return _u
+}
+
+// SetNillableRecipientEmail sets the "recipient_email" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableRecipientEmail(v *string) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetRecipientEmail(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *NotificationUpdateOne) SetUserID(v uuid.UUID) *NotificationUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableUserID(v *uuid.UUID) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *NotificationUpdateOne) ClearUserID() *NotificationUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (_u *NotificationUpdateOne) SetRealtorID(v uuid.UUID) *NotificationUpdateOne {
+	_u.mutation.SetRealtorID(v)
+	return _u
+}
+
+// SetNillableRealtorID sets the "realtor_id" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableRealtorID(v *uuid.UUID) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetRealtorID(*v)
+	}
+	return _u
+}
+
+// ClearRealtorID clears the value of the "realtor_id" field.
+func (_u *NotificationUpdateOne) ClearRealtorID() *NotificationUpdateOne {
+	_u.mutation.ClearRealtorID()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *NotificationUpdateOne) SetKind(v string) *NotificationUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableKind(v *string) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *NotificationUpdateOne) SetSubject(v string) *NotificationUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableSubject(v *string) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *NotificationUpdateOne) SetBody(v string) *NotificationUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableBody(v *string) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// ClearBody clears the value of the "body" field.
+func (_u *NotificationUpdateOne) ClearBody() *NotificationUpdateOne {
+	_u.mutation.ClearBody()
+	return _u
+}
+
+// SetReadAt sets the "read_at" field.
+func (_u *NotificationUpdateOne) SetReadAt(v time.Time) *NotificationUpdateOne {
+	_u.mutation.SetReadAt(v)
+	return _u
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (_u *NotificationUpdateOne) SetNillableReadAt(v *time.Time) *NotificationUpdateOne {
+	if v != nil {
+		_u.SetReadAt(*v)
+	}
+	return _u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (_u *NotificationUpdateOne) ClearReadAt() *NotificationUpdateOne {
+	_u.mutation.ClearReadAt()
+	return _u
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (_u *NotificationUpdateOne) Mutation() *NotificationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (_u *NotificationUpdateOne) Where(ps ...predicate.Notification) *NotificationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *NotificationUpdateOne) Select(field string, fields ...string) *NotificationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Notification entity.
+func (_u *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *NotificationUpdateOne) SaveX(ctx context.Context) *Notification {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *NotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *NotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *NotificationUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if notification.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized notification.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := notification.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *NotificationUpdateOne) check() error {
+	if v, ok := _u.mutation.RecipientEmail(); ok {
+		if err := notification.RecipientEmailValidator(v); err != nil {
+			return &ValidationError{Name: "recipient_email", err: fmt.Errorf(`ent: validator failed for field "Notification.recipient_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := notification.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Notification.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := notification.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "Notification.subject": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for _, f := range fields {
+			if !notification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(notification.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RecipientEmail(); ok {
+		_spec.SetField(notification.FieldRecipientEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(notification.FieldUserID, field.TypeUUID, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(notification.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.RealtorID(); ok {
+		_spec.SetField(notification.FieldRealtorID, field.TypeUUID, value)
+	}
+	if _u.mutation.RealtorIDCleared() {
+		_spec.ClearField(notification.FieldRealtorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(notification.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(notification.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(notification.FieldBody, field.TypeString, value)
+	}
+	if _u.mutation.BodyCleared() {
+		_spec.ClearField(notification.FieldBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	_node = &Notification{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/user"
+)
+
+// Offer is the model entity for the Offer schema.
+type Offer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// ListingID holds the value of the "listing_id" field.
+	ListingID uuid.UUID `json:"listing_id,omitempty"`
+	// BuyerID holds the value of the "buyer_id" field.
+	BuyerID uuid.UUID `json:"buyer_id,omitempty"`
+	// PreviousID holds the value of the "previous_id" field.
+	PreviousID *uuid.UUID `json:"previous_id,omitempty"`
+	// Side holds the value of the "side" field.
+	Side offer.Side `json:"side,omitempty"`
+	// MadeBy holds the value of the "made_by" field.
+	MadeBy uuid.UUID `json:"made_by,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount decimal.Decimal `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Contingencies holds the value of the "contingencies" field.
+	Contingencies []string `json:"contingencies,omitempty"`
+	// ClosingDate holds the value of the "closing_date" field.
+	ClosingDate time.Time `json:"closing_date,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// Status holds the value of the "status" field.
+	Status offer.Status `json:"status,omitempty"`
+	// RespondedAt holds the value of the "responded_at" field.
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OfferQuery when eager-loading is set.
+	Edges        OfferEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OfferEdges holds the relations/edges for other nodes in the graph.
+type OfferEdges struct {
+	// Listing holds the value of the listing edge.
+	Listing *Listing `json:"listing,omitempty"`
+	// Buyer holds the value of the buyer edge.
+	Buyer *User `json:"buyer,omitempty"`
+	// Previous holds the value of the previous edge.
+	Previous *Offer `json:"previous,omitempty"`
+	// Counters holds the value of the counters edge.
+	Counters []*Offer `json:"counters,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// ListingOrErr returns the Listing value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OfferEdges) ListingOrErr() (*Listing, error) {
+	if e.Listing != nil {
+		return e.Listing, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: listing.Label}
+	}
+	return nil, &NotLoadedError{edge: "listing"}
+}
+
+// BuyerOrErr returns the Buyer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OfferEdges) BuyerOrErr() (*User, error) {
+	if e.Buyer != nil {
+		return e.Buyer, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "buyer"}
+}
+
+// PreviousOrErr returns the Previous value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OfferEdges) PreviousOrErr() (*Offer, error) {
+	if e.Previous != nil {
+		return e.Previous, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: offer.Label}
+	}
+	return nil, &NotLoadedError{edge: "previous"}
+}
+
+// CountersOrErr returns the Counters value or an error if the edge
+// was not loaded in eager-loading.
+func (e OfferEdges) CountersOrErr() ([]*Offer, error) {
+	if e.loadedTypes[3] {
+		return e.Counters, nil
+	}
+	return nil, &NotLoadedError{edge: "counters"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Offer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case offer.FieldPreviousID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case offer.FieldContingencies:
+			values[i] = new([]byte)
+		case offer.FieldAmount:
+			values[i] = new(decimal.Decimal)
+		case offer.FieldSide, offer.FieldCurrency, offer.FieldMessage, offer.FieldStatus:
+			values[i] = new(sql.NullString)
+		case offer.FieldCreateTime, offer.FieldUpdateTime, offer.FieldClosingDate, offer.FieldExpiresAt, offer.FieldRespondedAt:
+			values[i] = new(sql.NullTime)
+		case offer.FieldID, offer.FieldListingID, offer.FieldBuyerID, offer.FieldMadeBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Offer fields.
+func (_m *Offer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case offer.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case offer.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case offer.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case offer.FieldListingID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field listing_id", values[i])
+			} else if value != nil {
+				_m.ListingID = *value
+			}
+		case offer.FieldBuyerID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_id", values[i])
+			} else if value != nil {
+				_m.BuyerID = *value
+			}
+		case offer.FieldPreviousID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_id", values[i])
+			} else if value.Valid {
+				_m.PreviousID = new(uuid.UUID)
+				*_m.PreviousID = *value.S.(*uuid.UUID)
+			}
+		case offer.FieldSide:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field side", values[i])
+			} else if value.Valid {
+				_m.Side = offer.Side(value.String)
+			}
+		case offer.FieldMadeBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field made_by", values[i])
+			} else if value != nil {
+				_m.MadeBy = *value
+			}
+		case offer.FieldAmount:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				_m.Amount = *value
+			}
+		case offer.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case offer.FieldContingencies:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field contingencies", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Contingencies); err != nil {
+					return fmt.Errorf("unmarshal field contingencies: %w", err)
+				}
+			}
+		case offer.FieldClosingDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closing_date", values[i])
+			} else if value.Valid {
+				_m.ClosingDate = value.Time
+			}
+		case offer.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case offer.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case offer.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = offer.Status(value.String)
+			}
+		case offer.FieldRespondedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field responded_at", values[i])
+			} else if value.Valid {
+				_m.RespondedAt = new(time.Time)
+				*_m.RespondedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Offer.
+// This includes values selected through modifiers, order, etc.
+func (_m *Offer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryListing queries the "listing" edge of the Offer entity.
+func (_m *Offer) QueryListing() *ListingQuery {
+	return NewOfferClient(_m.config).QueryListing(_m)
+}
+
+// QueryBuyer queries the "buyer" edge of the Offer entity.
+func (_m *Offer) QueryBuyer() *UserQuery {
+	return NewOfferClient(_m.config).QueryBuyer(_m)
+}
+
+// QueryPrevious queries the "previous" edge of the Offer entity.
+func (_m *Offer) QueryPrevious() *OfferQuery {
+	return NewOfferClient(_m.config).QueryPrevious(_m)
+}
+
+// QueryCounters queries the "counters" edge of the Offer entity.
+func (_m *Offer) QueryCounters() *OfferQuery {
+	return NewOfferClient(_m.config).QueryCounters(_m)
+}
+
+// Update returns a builder for updating this Offer.
+// Note that you need to call Offer.Unwrap() before calling this method if this Offer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Offer) Update() *OfferUpdateOne {
+	return NewOfferClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Offer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Offer) Unwrap() *Offer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Offer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Offer) String() string {
+	var builder strings.Builder
+	builder.WriteString("Offer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("listing_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ListingID))
+	builder.WriteString(", ")
+	builder.WriteString("buyer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BuyerID))
+	builder.WriteString(", ")
+	if v := _m.PreviousID; v != nil {
+		builder.WriteString("previous_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("side=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Side))
+	builder.WriteString(", ")
+	builder.WriteString("made_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MadeBy))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("contingencies=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Contingencies))
+	builder.WriteString(", ")
+	builder.WriteString("closing_date=")
+	builder.WriteString(_m.ClosingDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.RespondedAt; v != nil {
+		builder.WriteString("responded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Offers is a parsable slice of Offer.
+type Offers []*Offer
@@ -0,0 +1,1491 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/user"
+)
+
+// OfferCreate is the builder for creating a Offer entity.
+type OfferCreate struct {
+	config
+	mutation *OfferMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *OfferCreate) SetCreateTime(v time.Time) *OfferCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *OfferCreate) SetNillableCreateTime(v *time.Time) *OfferCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *OfferCreate) SetUpdateTime(v time.Time) *OfferCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *OfferCreate) SetNillableUpdateTime(v *time.Time) *OfferCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetListingID sets the "listing_id" field.
+func (_c *OfferCreate) SetListingID(v uuid.UUID) *OfferCreate {
+	_c.mutation.SetListingID(v)
+	return _c
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (_c *OfferCreate) SetBuyerID(v uuid.UUID) *OfferCreate {
+	_c.mutation.SetBuyerID(v)
+	return _c
+}
+
+// SetPreviousID sets the "previous_id" field.
+func (_c *OfferCreate) SetPreviousID(v uuid.UUID) *OfferCreate {
+	_c.mutation.SetPreviousID(v)
+	return _c
+}
+
+// SetNillablePreviousID sets the "previous_id" field if the given value is not nil.
+func (_c *OfferCreate) SetNillablePreviousID(v *uuid.UUID) *OfferCreate {
+	if v != nil {
+		_c.SetPreviousID(*v)
+	}
+	return _c
+}
+
+// SetSide sets the "side" field.
+func (_c *OfferCreate) SetSide(v offer.Side) *OfferCreate {
+	_c.mutation.SetSide(v)
+	return _c
+}
+
+// SetMadeBy sets the "made_by" field.
+func (_c *OfferCreate) SetMadeBy(v uuid.UUID) *OfferCreate {
+	_c.mutation.SetMadeBy(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *OfferCreate) SetAmount(v decimal.Decimal) *OfferCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *OfferCreate) SetCurrency(v string) *OfferCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetContingencies sets the "contingencies" field.
+func (_c *OfferCreate) SetContingencies(v []string) *OfferCreate {
+	_c.mutation.SetContingencies(v)
+	return _c
+}
+
+// SetClosingDate sets the "closing_date" field.
+func (_c *OfferCreate) SetClosingDate(v time.Time) *OfferCreate {
+	_c.mutation.SetClosingDate(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *OfferCreate) SetExpiresAt(v time.Time) *OfferCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *OfferCreate) SetMessage(v string) *OfferCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *OfferCreate) SetNillableMessage(v *string) *OfferCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OfferCreate) SetStatus(v offer.Status) *OfferCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OfferCreate) SetNillableStatus(v *offer.Status) *OfferCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_c *OfferCreate) SetRespondedAt(v time.Time) *OfferCreate {
+	_c.mutation.SetRespondedAt(v)
+	return _c
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_c *OfferCreate) SetNillableRespondedAt(v *time.Time) *OfferCreate {
+	if v != nil {
+		_c.SetRespondedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OfferCreate) SetID(v uuid.UUID) *OfferCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OfferCreate) SetNillableID(v *uuid.UUID) *OfferCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_c *OfferCreate) SetListing(v *Listing) *OfferCreate {
+	return _c.SetListingID(v.ID)
+}
+
+// SetBuyer sets the "buyer" edge to the User entity.
+func (_c *OfferCreate) SetBuyer(v *User) *OfferCreate {
+	return _c.SetBuyerID(v.ID)
+}
+
+// SetPrevious sets the "previous" edge to the Offer entity.
+func (_c *OfferCreate) SetPrevious(v *Offer) *OfferCreate {
+	return _c.SetPreviousID(v.ID)
+}
+
+// AddCounterIDs adds the "counters" edge to the Offer entity by IDs.
+func (_c *OfferCreate) AddCounterIDs(ids ...uuid.UUID) *OfferCreate {
+	_c.mutation.AddCounterIDs(ids...)
+	return _c
+}
+
+// AddCounters adds the "counters" edges to the Offer entity.
+func (_c *OfferCreate) AddCounters(v ...*Offer) *OfferCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCounterIDs(ids...)
+}
+
+// Mutation returns the OfferMutation object of the builder.
+func (_c *OfferCreate) Mutation() *OfferMutation {
+	return _c.mutation
+}
+
+// Save creates the Offer in the database.
+func (_c *OfferCreate) Save(ctx context.Context) (*Offer, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OfferCreate) SaveX(ctx context.Context) *Offer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OfferCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OfferCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OfferCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if offer.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized offer.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := offer.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if offer.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized offer.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := offer.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := offer.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if offer.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized offer.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := offer.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OfferCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Offer.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Offer.update_time"`)}
+	}
+	if _, ok := _c.mutation.ListingID(); !ok {
+		return &ValidationError{Name: "listing_id", err: errors.New(`ent: missing required field "Offer.listing_id"`)}
+	}
+	if _, ok := _c.mutation.BuyerID(); !ok {
+		return &ValidationError{Name: "buyer_id", err: errors.New(`ent: missing required field "Offer.buyer_id"`)}
+	}
+	if _, ok := _c.mutation.Side(); !ok {
+		return &ValidationError{Name: "side", err: errors.New(`ent: missing required field "Offer.side"`)}
+	}
+	if v, ok := _c.mutation.Side(); ok {
+		if err := offer.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Offer.side": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MadeBy(); !ok {
+		return &ValidationError{Name: "made_by", err: errors.New(`ent: missing required field "Offer.made_by"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Offer.amount"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Offer.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := offer.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Offer.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClosingDate(); !ok {
+		return &ValidationError{Name: "closing_date", err: errors.New(`ent: missing required field "Offer.closing_date"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "Offer.expires_at"`)}
+	}
+	if v, ok := _c.mutation.Message(); ok {
+		if err := offer.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "Offer.message": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Offer.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := offer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Offer.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ListingIDs()) == 0 {
+		return &ValidationError{Name: "listing", err: errors.New(`ent: missing required edge "Offer.listing"`)}
+	}
+	if len(_c.mutation.BuyerIDs()) == 0 {
+		return &ValidationError{Name: "buyer", err: errors.New(`ent: missing required edge "Offer.buyer"`)}
+	}
+	return nil
+}
+
+func (_c *OfferCreate) sqlSave(ctx context.Context) (*Offer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OfferCreate) createSpec() (*Offer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Offer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(offer.Table, sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(offer.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(offer.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Side(); ok {
+		_spec.SetField(offer.FieldSide, field.TypeEnum, value)
+		_node.Side = value
+	}
+	if value, ok := _c.mutation.MadeBy(); ok {
+		_spec.SetField(offer.FieldMadeBy, field.TypeUUID, value)
+		_node.MadeBy = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(offer.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(offer.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Contingencies(); ok {
+		_spec.SetField(offer.FieldContingencies, field.TypeJSON, value)
+		_node.Contingencies = value
+	}
+	if value, ok := _c.mutation.ClosingDate(); ok {
+		_spec.SetField(offer.FieldClosingDate, field.TypeTime, value)
+		_node.ClosingDate = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(offer.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(offer.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(offer.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RespondedAt(); ok {
+		_spec.SetField(offer.FieldRespondedAt, field.TypeTime, value)
+		_node.RespondedAt = &value
+	}
+	if nodes := _c.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.ListingTable,
+			Columns: []string{offer.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ListingID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BuyerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.BuyerTable,
+			Columns: []string{offer.BuyerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BuyerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PreviousIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.PreviousTable,
+			Columns: []string{offer.PreviousColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PreviousID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CountersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   offer.CountersTable,
+			Columns: []string{offer.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Offer.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OfferUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OfferCreate) OnConflict(opts ...sql.ConflictOption) *OfferUpsertOne {
+	_c.conflict = opts
+	return &OfferUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Offer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OfferCreate) OnConflictColumns(columns ...string) *OfferUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OfferUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// OfferUpsertOne is the builder for "upsert"-ing
+	//  one Offer node.
+	OfferUpsertOne struct {
+		create *OfferCreate
+	}
+
+	// OfferUpsert is the "OnConflict" setter.
+	OfferUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OfferUpsert) SetUpdateTime(v time.Time) *OfferUpsert {
+	u.Set(offer.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateUpdateTime() *OfferUpsert {
+	u.SetExcluded(offer.FieldUpdateTime)
+	return u
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *OfferUpsert) SetListingID(v uuid.UUID) *OfferUpsert {
+	u.Set(offer.FieldListingID, v)
+	return u
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateListingID() *OfferUpsert {
+	u.SetExcluded(offer.FieldListingID)
+	return u
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (u *OfferUpsert) SetBuyerID(v uuid.UUID) *OfferUpsert {
+	u.Set(offer.FieldBuyerID, v)
+	return u
+}
+
+// UpdateBuyerID sets the "buyer_id" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateBuyerID() *OfferUpsert {
+	u.SetExcluded(offer.FieldBuyerID)
+	return u
+}
+
+// SetPreviousID sets the "previous_id" field.
+func (u *OfferUpsert) SetPreviousID(v uuid.UUID) *OfferUpsert {
+	u.Set(offer.FieldPreviousID, v)
+	return u
+}
+
+// UpdatePreviousID sets the "previous_id" field to the value that was provided on create.
+func (u *OfferUpsert) UpdatePreviousID() *OfferUpsert {
+	u.SetExcluded(offer.FieldPreviousID)
+	return u
+}
+
+// ClearPreviousID clears the value of the "previous_id" field.
+func (u *OfferUpsert) ClearPreviousID() *OfferUpsert {
+	u.SetNull(offer.FieldPreviousID)
+	return u
+}
+
+// SetSide sets the "side" field.
+func (u *OfferUpsert) SetSide(v offer.Side) *OfferUpsert {
+	u.Set(offer.FieldSide, v)
+	return u
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateSide() *OfferUpsert {
+	u.SetExcluded(offer.FieldSide)
+	return u
+}
+
+// SetMadeBy sets the "made_by" field.
+func (u *OfferUpsert) SetMadeBy(v uuid.UUID) *OfferUpsert {
+	u.Set(offer.FieldMadeBy, v)
+	return u
+}
+
+// UpdateMadeBy sets the "made_by" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateMadeBy() *OfferUpsert {
+	u.SetExcluded(offer.FieldMadeBy)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *OfferUpsert) SetAmount(v decimal.Decimal) *OfferUpsert {
+	u.Set(offer.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateAmount() *OfferUpsert {
+	u.SetExcluded(offer.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *OfferUpsert) AddAmount(v decimal.Decimal) *OfferUpsert {
+	u.Add(offer.FieldAmount, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *OfferUpsert) SetCurrency(v string) *OfferUpsert {
+	u.Set(offer.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateCurrency() *OfferUpsert {
+	u.SetExcluded(offer.FieldCurrency)
+	return u
+}
+
+// SetContingencies sets the "contingencies" field.
+func (u *OfferUpsert) SetContingencies(v []string) *OfferUpsert {
+	u.Set(offer.FieldContingencies, v)
+	return u
+}
+
+// UpdateContingencies sets the "contingencies" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateContingencies() *OfferUpsert {
+	u.SetExcluded(offer.FieldContingencies)
+	return u
+}
+
+// ClearContingencies clears the value of the "contingencies" field.
+func (u *OfferUpsert) ClearContingencies() *OfferUpsert {
+	u.SetNull(offer.FieldContingencies)
+	return u
+}
+
+// SetClosingDate sets the "closing_date" field.
+func (u *OfferUpsert) SetClosingDate(v time.Time) *OfferUpsert {
+	u.Set(offer.FieldClosingDate, v)
+	return u
+}
+
+// UpdateClosingDate sets the "closing_date" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateClosingDate() *OfferUpsert {
+	u.SetExcluded(offer.FieldClosingDate)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *OfferUpsert) SetExpiresAt(v time.Time) *OfferUpsert {
+	u.Set(offer.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateExpiresAt() *OfferUpsert {
+	u.SetExcluded(offer.FieldExpiresAt)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *OfferUpsert) SetMessage(v string) *OfferUpsert {
+	u.Set(offer.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateMessage() *OfferUpsert {
+	u.SetExcluded(offer.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *OfferUpsert) ClearMessage() *OfferUpsert {
+	u.SetNull(offer.FieldMessage)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *OfferUpsert) SetStatus(v offer.Status) *OfferUpsert {
+	u.Set(offer.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateStatus() *OfferUpsert {
+	u.SetExcluded(offer.FieldStatus)
+	return u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (u *OfferUpsert) SetRespondedAt(v time.Time) *OfferUpsert {
+	u.Set(offer.FieldRespondedAt, v)
+	return u
+}
+
+// UpdateRespondedAt sets the "responded_at" field to the value that was provided on create.
+func (u *OfferUpsert) UpdateRespondedAt() *OfferUpsert {
+	u.SetExcluded(offer.FieldRespondedAt)
+	return u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (u *OfferUpsert) ClearRespondedAt() *OfferUpsert {
+	u.SetNull(offer.FieldRespondedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Offer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(offer.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OfferUpsertOne) UpdateNewValues() *OfferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(offer.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(offer.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Offer.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OfferUpsertOne) Ignore() *OfferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OfferUpsertOne) DoNothing() *OfferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OfferCreate.OnConflict
+// documentation for more info.
+func (u *OfferUpsertOne) Update(set func(*OfferUpsert)) *OfferUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OfferUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OfferUpsertOne) SetUpdateTime(v time.Time) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateUpdateTime() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *OfferUpsertOne) SetListingID(v uuid.UUID) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateListingID() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (u *OfferUpsertOne) SetBuyerID(v uuid.UUID) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetBuyerID(v)
+	})
+}
+
+// UpdateBuyerID sets the "buyer_id" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateBuyerID() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateBuyerID()
+	})
+}
+
+// SetPreviousID sets the "previous_id" field.
+func (u *OfferUpsertOne) SetPreviousID(v uuid.UUID) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetPreviousID(v)
+	})
+}
+
+// UpdatePreviousID sets the "previous_id" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdatePreviousID() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdatePreviousID()
+	})
+}
+
+// ClearPreviousID clears the value of the "previous_id" field.
+func (u *OfferUpsertOne) ClearPreviousID() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.ClearPreviousID()
+	})
+}
+
+// SetSide sets the "side" field.
+func (u *OfferUpsertOne) SetSide(v offer.Side) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetSide(v)
+	})
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateSide() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateSide()
+	})
+}
+
+// SetMadeBy sets the "made_by" field.
+func (u *OfferUpsertOne) SetMadeBy(v uuid.UUID) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetMadeBy(v)
+	})
+}
+
+// UpdateMadeBy sets the "made_by" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateMadeBy() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateMadeBy()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *OfferUpsertOne) SetAmount(v decimal.Decimal) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *OfferUpsertOne) AddAmount(v decimal.Decimal) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateAmount() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *OfferUpsertOne) SetCurrency(v string) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateCurrency() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetContingencies sets the "contingencies" field.
+func (u *OfferUpsertOne) SetContingencies(v []string) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetContingencies(v)
+	})
+}
+
+// UpdateContingencies sets the "contingencies" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateContingencies() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateContingencies()
+	})
+}
+
+// ClearContingencies clears the value of the "contingencies" field.
+func (u *OfferUpsertOne) ClearContingencies() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.ClearContingencies()
+	})
+}
+
+// SetClosingDate sets the "closing_date" field.
+func (u *OfferUpsertOne) SetClosingDate(v time.Time) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetClosingDate(v)
+	})
+}
+
+// UpdateClosingDate sets the "closing_date" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateClosingDate() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateClosingDate()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *OfferUpsertOne) SetExpiresAt(v time.Time) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateExpiresAt() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *OfferUpsertOne) SetMessage(v string) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateMessage() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *OfferUpsertOne) ClearMessage() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *OfferUpsertOne) SetStatus(v offer.Status) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateStatus() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (u *OfferUpsertOne) SetRespondedAt(v time.Time) *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetRespondedAt(v)
+	})
+}
+
+// UpdateRespondedAt sets the "responded_at" field to the value that was provided on create.
+func (u *OfferUpsertOne) UpdateRespondedAt() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateRespondedAt()
+	})
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (u *OfferUpsertOne) ClearRespondedAt() *OfferUpsertOne {
+	return u.Update(func(s *OfferUpsert) {
+		s.ClearRespondedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OfferUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OfferCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OfferUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OfferUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OfferUpsertOne.ID is not supported by MySQL driver. Use OfferUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OfferUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OfferCreateBulk is the builder for creating many Offer entities in bulk.
+type OfferCreateBulk struct {
+	config
+	err      error
+	builders []*OfferCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Offer entities in the database.
+func (_c *OfferCreateBulk) Save(ctx context.Context) ([]*Offer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Offer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OfferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OfferCreateBulk) SaveX(ctx context.Context) []*Offer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OfferCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OfferCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Offer.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OfferUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OfferCreateBulk) OnConflict(opts ...sql.ConflictOption) *OfferUpsertBulk {
+	_c.conflict = opts
+	return &OfferUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Offer.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OfferCreateBulk) OnConflictColumns(columns ...string) *OfferUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OfferUpsertBulk{
+		create: _c,
+	}
+}
+
+// OfferUpsertBulk is the builder for "upsert"-ing
+// a bulk of Offer nodes.
+type OfferUpsertBulk struct {
+	create *OfferCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Offer.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(offer.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OfferUpsertBulk) UpdateNewValues() *OfferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(offer.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(offer.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Offer.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OfferUpsertBulk) Ignore() *OfferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OfferUpsertBulk) DoNothing() *OfferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OfferCreateBulk.OnConflict
+// documentation for more info.
+func (u *OfferUpsertBulk) Update(set func(*OfferUpsert)) *OfferUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OfferUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OfferUpsertBulk) SetUpdateTime(v time.Time) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateUpdateTime() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetListingID sets the "listing_id" field.
+func (u *OfferUpsertBulk) SetListingID(v uuid.UUID) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetListingID(v)
+	})
+}
+
+// UpdateListingID sets the "listing_id" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateListingID() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateListingID()
+	})
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (u *OfferUpsertBulk) SetBuyerID(v uuid.UUID) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetBuyerID(v)
+	})
+}
+
+// UpdateBuyerID sets the "buyer_id" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateBuyerID() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateBuyerID()
+	})
+}
+
+// SetPreviousID sets the "previous_id" field.
+func (u *OfferUpsertBulk) SetPreviousID(v uuid.UUID) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetPreviousID(v)
+	})
+}
+
+// UpdatePreviousID sets the "previous_id" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdatePreviousID() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdatePreviousID()
+	})
+}
+
+// ClearPreviousID clears the value of the "previous_id" field.
+func (u *OfferUpsertBulk) ClearPreviousID() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.ClearPreviousID()
+	})
+}
+
+// SetSide sets the "side" field.
+func (u *OfferUpsertBulk) SetSide(v offer.Side) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetSide(v)
+	})
+}
+
+// UpdateSide sets the "side" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateSide() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateSide()
+	})
+}
+
+// SetMadeBy sets the "made_by" field.
+func (u *OfferUpsertBulk) SetMadeBy(v uuid.UUID) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetMadeBy(v)
+	})
+}
+
+// UpdateMadeBy sets the "made_by" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateMadeBy() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateMadeBy()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *OfferUpsertBulk) SetAmount(v decimal.Decimal) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *OfferUpsertBulk) AddAmount(v decimal.Decimal) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateAmount() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *OfferUpsertBulk) SetCurrency(v string) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateCurrency() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetContingencies sets the "contingencies" field.
+func (u *OfferUpsertBulk) SetContingencies(v []string) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetContingencies(v)
+	})
+}
+
+// UpdateContingencies sets the "contingencies" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateContingencies() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateContingencies()
+	})
+}
+
+// ClearContingencies clears the value of the "contingencies" field.
+func (u *OfferUpsertBulk) ClearContingencies() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.ClearContingencies()
+	})
+}
+
+// SetClosingDate sets the "closing_date" field.
+func (u *OfferUpsertBulk) SetClosingDate(v time.Time) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetClosingDate(v)
+	})
+}
+
+// UpdateClosingDate sets the "closing_date" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateClosingDate() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateClosingDate()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *OfferUpsertBulk) SetExpiresAt(v time.Time) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateExpiresAt() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *OfferUpsertBulk) SetMessage(v string) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateMessage() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *OfferUpsertBulk) ClearMessage() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *OfferUpsertBulk) SetStatus(v offer.Status) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateStatus() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (u *OfferUpsertBulk) SetRespondedAt(v time.Time) *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.SetRespondedAt(v)
+	})
+}
+
+// UpdateRespondedAt sets the "responded_at" field to the value that was provided on create.
+func (u *OfferUpsertBulk) UpdateRespondedAt() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.UpdateRespondedAt()
+	})
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (u *OfferUpsertBulk) ClearRespondedAt() *OfferUpsertBulk {
+	return u.Update(func(s *OfferUpsert) {
+		s.ClearRespondedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *OfferUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OfferCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OfferCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OfferUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
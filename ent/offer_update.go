@@ -0,0 +1,1215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/user"
+)
+
+// OfferUpdate is the builder for updating Offer entities.
+type OfferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OfferMutation
+}
+
+// Where appends a list predicates to the OfferUpdate builder.
+func (_u *OfferUpdate) Where(ps ...predicate.Offer) *OfferUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *OfferUpdate) SetUpdateTime(v time.Time) *OfferUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *OfferUpdate) SetListingID(v uuid.UUID) *OfferUpdate {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillableListingID(v *uuid.UUID) *OfferUpdate {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (_u *OfferUpdate) SetBuyerID(v uuid.UUID) *OfferUpdate {
+	_u.mutation.SetBuyerID(v)
+	return _u
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillableBuyerID(v *uuid.UUID) *OfferUpdate {
+	if v != nil {
+		_u.SetBuyerID(*v)
+	}
+	return _u
+}
+
+// SetPreviousID sets the "previous_id" field.
+func (_u *OfferUpdate) SetPreviousID(v uuid.UUID) *OfferUpdate {
+	_u.mutation.SetPreviousID(v)
+	return _u
+}
+
+// SetNillablePreviousID sets the "previous_id" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillablePreviousID(v *uuid.UUID) *OfferUpdate {
+	if v != nil {
+		_u.SetPreviousID(*v)
+	}
+	return _u
+}
+
+// ClearPreviousID clears the value of the "previous_id" field.
+func (_u *OfferUpdate) ClearPreviousID() *OfferUpdate {
+	_u.mutation.ClearPreviousID()
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *OfferUpdate) SetSide(v offer.Side) *OfferUpdate {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillableSide(v *offer.Side) *OfferUpdate {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetMadeBy sets the "made_by" field.
+func (_u *OfferUpdate) SetMadeBy(v uuid.UUID) *OfferUpdate {
+	_u.mutation.SetMadeBy(v)
+	return _u
+}
+
+// SetNillableMadeBy sets the "made_by" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillableMadeBy(v *uuid.UUID) *OfferUpdate {
+	if v != nil {
+		_u.SetMadeBy(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *OfferUpdate) SetAmount(v decimal.Decimal) *OfferUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillableAmount(v *decimal.Decimal) *OfferUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *OfferUpdate) AddAmount(v decimal.Decimal) *OfferUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *OfferUpdate) SetCurrency(v string) *OfferUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillableCurrency(v *string) *OfferUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetContingencies sets the "contingencies" field.
+func (_u *OfferUpdate) SetContingencies(v []string) *OfferUpdate {
+	_u.mutation.SetContingencies(v)
+	return _u
+}
+
+// AppendContingencies appends value to the "contingencies" field.
+func (_u *OfferUpdate) AppendContingencies(v []string) *OfferUpdate {
+	_u.mutation.AppendContingencies(v)
+	return _u
+}
+
+// ClearContingencies clears the value of the "contingencies" field.
+func (_u *OfferUpdate) ClearContingencies() *OfferUpdate {
+	_u.mutation.ClearContingencies()
+	return _u
+}
+
+// SetClosingDate sets the "closing_date" field.
+func (_u *OfferUpdate) SetClosingDate(v time.Time) *OfferUpdate {
+	_u.mutation.SetClosingDate(v)
+	return _u
+}
+
+// SetNillableClosingDate sets the "closing_date" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillableClosingDate(v *time.Time) *OfferUpdate {
+	if v != nil {
+		_u.SetClosingDate(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OfferUpdate) SetExpiresAt(v time.Time) *OfferUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillableExpiresAt(v *time.Time) *OfferUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *OfferUpdate) SetMessage(v string) *OfferUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillableMessage(v *string) *OfferUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *OfferUpdate) ClearMessage() *OfferUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OfferUpdate) SetStatus(v offer.Status) *OfferUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillableStatus(v *offer.Status) *OfferUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *OfferUpdate) SetRespondedAt(v time.Time) *OfferUpdate {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *OfferUpdate) SetNillableRespondedAt(v *time.Time) *OfferUpdate {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *OfferUpdate) ClearRespondedAt() *OfferUpdate {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *OfferUpdate) SetListing(v *Listing) *OfferUpdate {
+	return _u.SetListingID(v.ID)
+}
+
+// SetBuyer sets the "buyer" edge to the User entity.
+func (_u *OfferUpdate) SetBuyer(v *User) *OfferUpdate {
+	return _u.SetBuyerID(v.ID)
+}
+
+// SetPrevious sets the "previous" edge to the Offer entity.
+func (_u *OfferUpdate) SetPrevious(v *Offer) *OfferUpdate {
+	return _u.SetPreviousID(v.ID)
+}
+
+// AddCounterIDs adds the "counters" edge to the Offer entity by IDs.
+func (_u *OfferUpdate) AddCounterIDs(ids ...uuid.UUID) *OfferUpdate {
+	_u.mutation.AddCounterIDs(ids...)
+	return _u
+}
+
+// AddCounters adds the "counters" edges to the Offer entity.
+func (_u *OfferUpdate) AddCounters(v ...*Offer) *OfferUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCounterIDs(ids...)
+}
+
+// Mutation returns the OfferMutation object of the builder.
+func (_u *OfferUpdate) Mutation() *OfferMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *OfferUpdate) ClearListing() *OfferUpdate {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// ClearBuyer clears the "buyer" edge to the User entity.
+func (_u *OfferUpdate) ClearBuyer() *OfferUpdate {
+	_u.mutation.ClearBuyer()
+	return _u
+}
+
+// ClearPrevious clears the "previous" edge to the Offer entity.
+func (_u *OfferUpdate) ClearPrevious() *OfferUpdate {
+	_u.mutation.ClearPrevious()
+	return _u
+}
+
+// ClearCounters clears all "counters" edges to the Offer entity.
+func (_u *OfferUpdate) ClearCounters() *OfferUpdate {
+	_u.mutation.ClearCounters()
+	return _u
+}
+
+// RemoveCounterIDs removes the "counters" edge to Offer entities by IDs.
+func (_u *OfferUpdate) RemoveCounterIDs(ids ...uuid.UUID) *OfferUpdate {
+	_u.mutation.RemoveCounterIDs(ids...)
+	return _u
+}
+
+// RemoveCounters removes "counters" edges to Offer entities.
+func (_u *OfferUpdate) RemoveCounters(v ...*Offer) *OfferUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCounterIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OfferUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OfferUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OfferUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OfferUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OfferUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if offer.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized offer.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := offer.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OfferUpdate) check() error {
+	if v, ok := _u.mutation.Side(); ok {
+		if err := offer.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Offer.side": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := offer.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Offer.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := offer.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "Offer.message": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := offer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Offer.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Offer.listing"`)
+	}
+	if _u.mutation.BuyerCleared() && len(_u.mutation.BuyerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Offer.buyer"`)
+	}
+	return nil
+}
+
+func (_u *OfferUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(offer.Table, offer.Columns, sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(offer.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(offer.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MadeBy(); ok {
+		_spec.SetField(offer.FieldMadeBy, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(offer.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(offer.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(offer.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Contingencies(); ok {
+		_spec.SetField(offer.FieldContingencies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContingencies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, offer.FieldContingencies, value)
+		})
+	}
+	if _u.mutation.ContingenciesCleared() {
+		_spec.ClearField(offer.FieldContingencies, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClosingDate(); ok {
+		_spec.SetField(offer.FieldClosingDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(offer.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(offer.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(offer.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(offer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(offer.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(offer.FieldRespondedAt, field.TypeTime)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.ListingTable,
+			Columns: []string{offer.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.ListingTable,
+			Columns: []string{offer.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BuyerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.BuyerTable,
+			Columns: []string{offer.BuyerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BuyerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.BuyerTable,
+			Columns: []string{offer.BuyerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PreviousCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.PreviousTable,
+			Columns: []string{offer.PreviousColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PreviousIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.PreviousTable,
+			Columns: []string{offer.PreviousColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CountersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   offer.CountersTable,
+			Columns: []string{offer.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCountersIDs(); len(nodes) > 0 && !_u.mutation.CountersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   offer.CountersTable,
+			Columns: []string{offer.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CountersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   offer.CountersTable,
+			Columns: []string{offer.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{offer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OfferUpdateOne is the builder for updating a single Offer entity.
+type OfferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OfferMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *OfferUpdateOne) SetUpdateTime(v time.Time) *OfferUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetListingID sets the "listing_id" field.
+func (_u *OfferUpdateOne) SetListingID(v uuid.UUID) *OfferUpdateOne {
+	_u.mutation.SetListingID(v)
+	return _u
+}
+
+// SetNillableListingID sets the "listing_id" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillableListingID(v *uuid.UUID) *OfferUpdateOne {
+	if v != nil {
+		_u.SetListingID(*v)
+	}
+	return _u
+}
+
+// SetBuyerID sets the "buyer_id" field.
+func (_u *OfferUpdateOne) SetBuyerID(v uuid.UUID) *OfferUpdateOne {
+	_u.mutation.SetBuyerID(v)
+	return _u
+}
+
+// SetNillableBuyerID sets the "buyer_id" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillableBuyerID(v *uuid.UUID) *OfferUpdateOne {
+	if v != nil {
+		_u.SetBuyerID(*v)
+	}
+	return _u
+}
+
+// SetPreviousID sets the "previous_id" field.
+func (_u *OfferUpdateOne) SetPreviousID(v uuid.UUID) *OfferUpdateOne {
+	_u.mutation.SetPreviousID(v)
+	return _u
+}
+
+// SetNillablePreviousID sets the "previous_id" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillablePreviousID(v *uuid.UUID) *OfferUpdateOne {
+	if v != nil {
+		_u.SetPreviousID(*v)
+	}
+	return _u
+}
+
+// ClearPreviousID clears the value of the "previous_id" field.
+func (_u *OfferUpdateOne) ClearPreviousID() *OfferUpdateOne {
+	_u.mutation.ClearPreviousID()
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *OfferUpdateOne) SetSide(v offer.Side) *OfferUpdateOne {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillableSide(v *offer.Side) *OfferUpdateOne {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetMadeBy sets the "made_by" field.
+func (_u *OfferUpdateOne) SetMadeBy(v uuid.UUID) *OfferUpdateOne {
+	_u.mutation.SetMadeBy(v)
+	return _u
+}
+
+// SetNillableMadeBy sets the "made_by" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillableMadeBy(v *uuid.UUID) *OfferUpdateOne {
+	if v != nil {
+		_u.SetMadeBy(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *OfferUpdateOne) SetAmount(v decimal.Decimal) *OfferUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillableAmount(v *decimal.Decimal) *OfferUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *OfferUpdateOne) AddAmount(v decimal.Decimal) *OfferUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *OfferUpdateOne) SetCurrency(v string) *OfferUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillableCurrency(v *string) *OfferUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetContingencies sets the "contingencies" field.
+func (_u *OfferUpdateOne) SetContingencies(v []string) *OfferUpdateOne {
+	_u.mutation.SetContingencies(v)
+	return _u
+}
+
+// AppendContingencies appends value to the "contingencies" field.
+func (_u *OfferUpdateOne) AppendContingencies(v []string) *OfferUpdateOne {
+	_u.mutation.AppendContingencies(v)
+	return _u
+}
+
+// ClearContingencies clears the value of the "contingencies" field.
+func (_u *OfferUpdateOne) ClearContingencies() *OfferUpdateOne {
+	_u.mutation.ClearContingencies()
+	return _u
+}
+
+// SetClosingDate sets the "closing_date" field.
+func (_u *OfferUpdateOne) SetClosingDate(v time.Time) *OfferUpdateOne {
+	_u.mutation.SetClosingDate(v)
+	return _u
+}
+
+// SetNillableClosingDate sets the "closing_date" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillableClosingDate(v *time.Time) *OfferUpdateOne {
+	if v != nil {
+		_u.SetClosingDate(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OfferUpdateOne) SetExpiresAt(v time.Time) *OfferUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillableExpiresAt(v *time.Time) *OfferUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *OfferUpdateOne) SetMessage(v string) *OfferUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillableMessage(v *string) *OfferUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *OfferUpdateOne) ClearMessage() *OfferUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OfferUpdateOne) SetStatus(v offer.Status) *OfferUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillableStatus(v *offer.Status) *OfferUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *OfferUpdateOne) SetRespondedAt(v time.Time) *OfferUpdateOne {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *OfferUpdateOne) SetNillableRespondedAt(v *time.Time) *OfferUpdateOne {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *OfferUpdateOne) ClearRespondedAt() *OfferUpdateOne {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetListing sets the "listing" edge to the Listing entity.
+func (_u *OfferUpdateOne) SetListing(v *Listing) *OfferUpdateOne {
+	return _u.SetListingID(v.ID)
+}
+
+// SetBuyer sets the "buyer" edge to the User entity.
+func (_u *OfferUpdateOne) SetBuyer(v *User) *OfferUpdateOne {
+	return _u.SetBuyerID(v.ID)
+}
+
+// SetPrevious sets the "previous" edge to the Offer entity.
+func (_u *OfferUpdateOne) SetPrevious(v *Offer) *OfferUpdateOne {
+	return _u.SetPreviousID(v.ID)
+}
+
+// AddCounterIDs adds the "counters" edge to the Offer entity by IDs.
+func (_u *OfferUpdateOne) AddCounterIDs(ids ...uuid.UUID) *OfferUpdateOne {
+	_u.mutation.AddCounterIDs(ids...)
+	return _u
+}
+
+// AddCounters adds the "counters" edges to the Offer entity.
+func (_u *OfferUpdateOne) AddCounters(v ...*Offer) *OfferUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCounterIDs(ids...)
+}
+
+// Mutation returns the OfferMutation object of the builder.
+func (_u *OfferUpdateOne) Mutation() *OfferMutation {
+	return _u.mutation
+}
+
+// ClearListing clears the "listing" edge to the Listing entity.
+func (_u *OfferUpdateOne) ClearListing() *OfferUpdateOne {
+	_u.mutation.ClearListing()
+	return _u
+}
+
+// ClearBuyer clears the "buyer" edge to the User entity.
+func (_u *OfferUpdateOne) ClearBuyer() *OfferUpdateOne {
+	_u.mutation.ClearBuyer()
+	return _u
+}
+
+// ClearPrevious clears the "previous" edge to the Offer entity.
+func (_u *OfferUpdateOne) ClearPrevious() *OfferUpdateOne {
+	_u.mutation.ClearPrevious()
+	return _u
+}
+
+// ClearCounters clears all "counters" edges to the Offer entity.
+func (_u *OfferUpdateOne) ClearCounters() *OfferUpdateOne {
+	_u.mutation.ClearCounters()
+	return _u
+}
+
+// RemoveCounterIDs removes the "counters" edge to Offer entities by IDs.
+func (_u *OfferUpdateOne) RemoveCounterIDs(ids ...uuid.UUID) *OfferUpdateOne {
+	_u.mutation.RemoveCounterIDs(ids...)
+	return _u
+}
+
+// RemoveCounters removes "counters" edges to Offer entities.
+func (_u *OfferUpdateOne) RemoveCounters(v ...*Offer) *OfferUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCounterIDs(ids...)
+}
+
+// Where appends a list predicates to the OfferUpdate builder.
+func (_u *OfferUpdateOne) Where(ps ...predicate.Offer) *OfferUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OfferUpdateOne) Select(field string, fields ...string) *OfferUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Offer entity.
+func (_u *OfferUpdateOne) Save(ctx context.Context) (*Offer, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OfferUpdateOne) SaveX(ctx context.Context) *Offer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OfferUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OfferUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OfferUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if offer.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized offer.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := offer.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OfferUpdateOne) check() error {
+	if v, ok := _u.mutation.Side(); ok {
+		if err := offer.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Offer.side": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := offer.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Offer.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := offer.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "Offer.message": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := offer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Offer.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ListingCleared() && len(_u.mutation.ListingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Offer.listing"`)
+	}
+	if _u.mutation.BuyerCleared() && len(_u.mutation.BuyerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Offer.buyer"`)
+	}
+	return nil
+}
+
+func (_u *OfferUpdateOne) sqlSave(ctx context.Context) (_node *Offer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(offer.Table, offer.Columns, sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Offer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, offer.FieldID)
+		for _, f := range fields {
+			if !offer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != offer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(offer.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(offer.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MadeBy(); ok {
+		_spec.SetField(offer.FieldMadeBy, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(offer.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(offer.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(offer.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Contingencies(); ok {
+		_spec.SetField(offer.FieldContingencies, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedContingencies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, offer.FieldContingencies, value)
+		})
+	}
+	if _u.mutation.ContingenciesCleared() {
+		_spec.ClearField(offer.FieldContingencies, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ClosingDate(); ok {
+		_spec.SetField(offer.FieldClosingDate, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(offer.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(offer.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(offer.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(offer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(offer.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(offer.FieldRespondedAt, field.TypeTime)
+	}
+	if _u.mutation.ListingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.ListingTable,
+			Columns: []string{offer.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.ListingTable,
+			Columns: []string{offer.ListingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BuyerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.BuyerTable,
+			Columns: []string{offer.BuyerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BuyerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.BuyerTable,
+			Columns: []string{offer.BuyerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PreviousCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.PreviousTable,
+			Columns: []string{offer.PreviousColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PreviousIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   offer.PreviousTable,
+			Columns: []string{offer.PreviousColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CountersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   offer.CountersTable,
+			Columns: []string{offer.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCountersIDs(); len(nodes) > 0 && !_u.mutation.CountersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   offer.CountersTable,
+			Columns: []string{offer.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CountersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   offer.CountersTable,
+			Columns: []string{offer.CountersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Offer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{offer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
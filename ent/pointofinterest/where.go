@@ -0,0 +1,421 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pointofinterest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldName, v))
+}
+
+// Subtype applies equality check predicate on the "subtype" field. It's identical to SubtypeEQ.
+func Subtype(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldSubtype, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldLongitude, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldContainsFold(FieldName, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v Category) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v Category) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...Category) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...Category) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// SubtypeEQ applies the EQ predicate on the "subtype" field.
+func SubtypeEQ(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldSubtype, v))
+}
+
+// SubtypeNEQ applies the NEQ predicate on the "subtype" field.
+func SubtypeNEQ(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNEQ(FieldSubtype, v))
+}
+
+// SubtypeIn applies the In predicate on the "subtype" field.
+func SubtypeIn(vs ...string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldIn(FieldSubtype, vs...))
+}
+
+// SubtypeNotIn applies the NotIn predicate on the "subtype" field.
+func SubtypeNotIn(vs ...string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNotIn(FieldSubtype, vs...))
+}
+
+// SubtypeGT applies the GT predicate on the "subtype" field.
+func SubtypeGT(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGT(FieldSubtype, v))
+}
+
+// SubtypeGTE applies the GTE predicate on the "subtype" field.
+func SubtypeGTE(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGTE(FieldSubtype, v))
+}
+
+// SubtypeLT applies the LT predicate on the "subtype" field.
+func SubtypeLT(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLT(FieldSubtype, v))
+}
+
+// SubtypeLTE applies the LTE predicate on the "subtype" field.
+func SubtypeLTE(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLTE(FieldSubtype, v))
+}
+
+// SubtypeContains applies the Contains predicate on the "subtype" field.
+func SubtypeContains(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldContains(FieldSubtype, v))
+}
+
+// SubtypeHasPrefix applies the HasPrefix predicate on the "subtype" field.
+func SubtypeHasPrefix(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldHasPrefix(FieldSubtype, v))
+}
+
+// SubtypeHasSuffix applies the HasSuffix predicate on the "subtype" field.
+func SubtypeHasSuffix(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldHasSuffix(FieldSubtype, v))
+}
+
+// SubtypeIsNil applies the IsNil predicate on the "subtype" field.
+func SubtypeIsNil() predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldIsNull(FieldSubtype))
+}
+
+// SubtypeNotNil applies the NotNil predicate on the "subtype" field.
+func SubtypeNotNil() predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNotNull(FieldSubtype))
+}
+
+// SubtypeEqualFold applies the EqualFold predicate on the "subtype" field.
+func SubtypeEqualFold(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEqualFold(FieldSubtype, v))
+}
+
+// SubtypeContainsFold applies the ContainsFold predicate on the "subtype" field.
+func SubtypeContainsFold(v string) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldContainsFold(FieldSubtype, v))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.FieldLTE(FieldLongitude, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PointOfInterest) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PointOfInterest) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PointOfInterest) predicate.PointOfInterest {
+	return predicate.PointOfInterest(sql.NotPredicates(p))
+}
@@ -0,0 +1,919 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/pointofinterest"
+)
+
+// PointOfInterestCreate is the builder for creating a PointOfInterest entity.
+type PointOfInterestCreate struct {
+	config
+	mutation *PointOfInterestMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *PointOfInterestCreate) SetCreateTime(v time.Time) *PointOfInterestCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *PointOfInterestCreate) SetNillableCreateTime(v *time.Time) *PointOfInterestCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *PointOfInterestCreate) SetUpdateTime(v time.Time) *PointOfInterestCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *PointOfInterestCreate) SetNillableUpdateTime(v *time.Time) *PointOfInterestCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PointOfInterestCreate) SetName(v string) *PointOfInterestCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *PointOfInterestCreate) SetCategory(v pointofinterest.Category) *PointOfInterestCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetSubtype sets the "subtype" field.
+func (_c *PointOfInterestCreate) SetSubtype(v string) *PointOfInterestCreate {
+	_c.mutation.SetSubtype(v)
+	return _c
+}
+
+// SetNillableSubtype sets the "subtype" field if the given value is not nil.
+func (_c *PointOfInterestCreate) SetNillableSubtype(v *string) *PointOfInterestCreate {
+	if v != nil {
+		_c.SetSubtype(*v)
+	}
+	return _c
+}
+
+// SetLatitude sets the "latitude" field.
+func (_c *PointOfInterestCreate) SetLatitude(v float64) *PointOfInterestCreate {
+	_c.mutation.SetLatitude(v)
+	return _c
+}
+
+// SetLongitude sets the "longitude" field.
+func (_c *PointOfInterestCreate) SetLongitude(v float64) *PointOfInterestCreate {
+	_c.mutation.SetLongitude(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PointOfInterestCreate) SetID(v uuid.UUID) *PointOfInterestCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PointOfInterestCreate) SetNillableID(v *uuid.UUID) *PointOfInterestCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PointOfInterestMutation object of the builder.
+func (_c *PointOfInterestCreate) Mutation() *PointOfInterestMutation {
+	return _c.mutation
+}
+
+// Save creates the PointOfInterest in the database.
+func (_c *PointOfInterestCreate) Save(ctx context.Context) (*PointOfInterest, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PointOfInterestCreate) SaveX(ctx context.Context) *PointOfInterest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PointOfInterestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PointOfInterestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PointOfInterestCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if pointofinterest.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized pointofinterest.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := pointofinterest.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if pointofinterest.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized pointofinterest.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := pointofinterest.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if pointofinterest.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized pointofinterest.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := pointofinterest.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PointOfInterestCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "PointOfInterest.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "PointOfInterest.update_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PointOfInterest.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := pointofinterest.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "PointOfInterest.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := pointofinterest.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.category": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Subtype(); ok {
+		if err := pointofinterest.SubtypeValidator(v); err != nil {
+			return &ValidationError{Name: "subtype", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.subtype": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Latitude(); !ok {
+		return &ValidationError{Name: "latitude", err: errors.New(`ent: missing required field "PointOfInterest.latitude"`)}
+	}
+	if v, ok := _c.mutation.Latitude(); ok {
+		if err := pointofinterest.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.latitude": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Longitude(); !ok {
+		return &ValidationError{Name: "longitude", err: errors.New(`ent: missing required field "PointOfInterest.longitude"`)}
+	}
+	if v, ok := _c.mutation.Longitude(); ok {
+		if err := pointofinterest.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.longitude": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PointOfInterestCreate) sqlSave(ctx context.Context) (*PointOfInterest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PointOfInterestCreate) createSpec() (*PointOfInterest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PointOfInterest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(pointofinterest.Table, sqlgraph.NewFieldSpec(pointofinterest.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(pointofinterest.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(pointofinterest.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(pointofinterest.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(pointofinterest.FieldCategory, field.TypeEnum, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Subtype(); ok {
+		_spec.SetField(pointofinterest.FieldSubtype, field.TypeString, value)
+		_node.Subtype = value
+	}
+	if value, ok := _c.mutation.Latitude(); ok {
+		_spec.SetField(pointofinterest.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = value
+	}
+	if value, ok := _c.mutation.Longitude(); ok {
+		_spec.SetField(pointofinterest.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PointOfInterest.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PointOfInterestUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PointOfInterestCreate) OnConflict(opts ...sql.ConflictOption) *PointOfInterestUpsertOne {
+	_c.conflict = opts
+	return &PointOfInterestUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PointOfInterest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PointOfInterestCreate) OnConflictColumns(columns ...string) *PointOfInterestUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PointOfInterestUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PointOfInterestUpsertOne is the builder for "upsert"-ing
+	//  one PointOfInterest node.
+	PointOfInterestUpsertOne struct {
+		create *PointOfInterestCreate
+	}
+
+	// PointOfInterestUpsert is the "OnConflict" setter.
+	PointOfInterestUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PointOfInterestUpsert) SetUpdateTime(v time.Time) *PointOfInterestUpsert {
+	u.Set(pointofinterest.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PointOfInterestUpsert) UpdateUpdateTime() *PointOfInterestUpsert {
+	u.SetExcluded(pointofinterest.FieldUpdateTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PointOfInterestUpsert) SetName(v string) *PointOfInterestUpsert {
+	u.Set(pointofinterest.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PointOfInterestUpsert) UpdateName() *PointOfInterestUpsert {
+	u.SetExcluded(pointofinterest.FieldName)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *PointOfInterestUpsert) SetCategory(v pointofinterest.Category) *PointOfInterestUpsert {
+	u.Set(pointofinterest.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *PointOfInterestUpsert) UpdateCategory() *PointOfInterestUpsert {
+	u.SetExcluded(pointofinterest.FieldCategory)
+	return u
+}
+
+// SetSubtype sets the "subtype" field.
+func (u *PointOfInterestUpsert) SetSubtype(v string) *PointOfInterestUpsert {
+	u.Set(pointofinterest.FieldSubtype, v)
+	return u
+}
+
+// UpdateSubtype sets the "subtype" field to the value that was provided on create.
+func (u *PointOfInterestUpsert) UpdateSubtype() *PointOfInterestUpsert {
+	u.SetExcluded(pointofinterest.FieldSubtype)
+	return u
+}
+
+// ClearSubtype clears the value of the "subtype" field.
+func (u *PointOfInterestUpsert) ClearSubtype() *PointOfInterestUpsert {
+	u.SetNull(pointofinterest.FieldSubtype)
+	return u
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *PointOfInterestUpsert) SetLatitude(v float64) *PointOfInterestUpsert {
+	u.Set(pointofinterest.FieldLatitude, v)
+	return u
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *PointOfInterestUpsert) UpdateLatitude() *PointOfInterestUpsert {
+	u.SetExcluded(pointofinterest.FieldLatitude)
+	return u
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *PointOfInterestUpsert) AddLatitude(v float64) *PointOfInterestUpsert {
+	u.Add(pointofinterest.FieldLatitude, v)
+	return u
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *PointOfInterestUpsert) SetLongitude(v float64) *PointOfInterestUpsert {
+	u.Set(pointofinterest.FieldLongitude, v)
+	return u
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *PointOfInterestUpsert) UpdateLongitude() *PointOfInterestUpsert {
+	u.SetExcluded(pointofinterest.FieldLongitude)
+	return u
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *PointOfInterestUpsert) AddLongitude(v float64) *PointOfInterestUpsert {
+	u.Add(pointofinterest.FieldLongitude, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PointOfInterest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pointofinterest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PointOfInterestUpsertOne) UpdateNewValues() *PointOfInterestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pointofinterest.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(pointofinterest.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PointOfInterest.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PointOfInterestUpsertOne) Ignore() *PointOfInterestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PointOfInterestUpsertOne) DoNothing() *PointOfInterestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PointOfInterestCreate.OnConflict
+// documentation for more info.
+func (u *PointOfInterestUpsertOne) Update(set func(*PointOfInterestUpsert)) *PointOfInterestUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PointOfInterestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PointOfInterestUpsertOne) SetUpdateTime(v time.Time) *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PointOfInterestUpsertOne) UpdateUpdateTime() *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PointOfInterestUpsertOne) SetName(v string) *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PointOfInterestUpsertOne) UpdateName() *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *PointOfInterestUpsertOne) SetCategory(v pointofinterest.Category) *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *PointOfInterestUpsertOne) UpdateCategory() *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetSubtype sets the "subtype" field.
+func (u *PointOfInterestUpsertOne) SetSubtype(v string) *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetSubtype(v)
+	})
+}
+
+// UpdateSubtype sets the "subtype" field to the value that was provided on create.
+func (u *PointOfInterestUpsertOne) UpdateSubtype() *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateSubtype()
+	})
+}
+
+// ClearSubtype clears the value of the "subtype" field.
+func (u *PointOfInterestUpsertOne) ClearSubtype() *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.ClearSubtype()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *PointOfInterestUpsertOne) SetLatitude(v float64) *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *PointOfInterestUpsertOne) AddLatitude(v float64) *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.AddLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *PointOfInterestUpsertOne) UpdateLatitude() *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *PointOfInterestUpsertOne) SetLongitude(v float64) *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *PointOfInterestUpsertOne) AddLongitude(v float64) *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.AddLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *PointOfInterestUpsertOne) UpdateLongitude() *PointOfInterestUpsertOne {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// Exec executes the query.
+func (u *PointOfInterestUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PointOfInterestCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PointOfInterestUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PointOfInterestUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PointOfInterestUpsertOne.ID is not supported by MySQL driver. Use PointOfInterestUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PointOfInterestUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PointOfInterestCreateBulk is the builder for creating many PointOfInterest entities in bulk.
+type PointOfInterestCreateBulk struct {
+	config
+	err      error
+	builders []*PointOfInterestCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PointOfInterest entities in the database.
+func (_c *PointOfInterestCreateBulk) Save(ctx context.Context) ([]*PointOfInterest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PointOfInterest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PointOfInterestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PointOfInterestCreateBulk) SaveX(ctx context.Context) []*PointOfInterest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PointOfInterestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PointOfInterestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PointOfInterest.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PointOfInterestUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PointOfInterestCreateBulk) OnConflict(opts ...sql.ConflictOption) *PointOfInterestUpsertBulk {
+	_c.conflict = opts
+	return &PointOfInterestUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PointOfInterest.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PointOfInterestCreateBulk) OnConflictColumns(columns ...string) *PointOfInterestUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PointOfInterestUpsertBulk{
+		create: _c,
+	}
+}
+
+// PointOfInterestUpsertBulk is the builder for "upsert"-ing
+// a bulk of PointOfInterest nodes.
+type PointOfInterestUpsertBulk struct {
+	create *PointOfInterestCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PointOfInterest.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pointofinterest.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PointOfInterestUpsertBulk) UpdateNewValues() *PointOfInterestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pointofinterest.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(pointofinterest.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PointOfInterest.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PointOfInterestUpsertBulk) Ignore() *PointOfInterestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PointOfInterestUpsertBulk) DoNothing() *PointOfInterestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PointOfInterestCreateBulk.OnConflict
+// documentation for more info.
+func (u *PointOfInterestUpsertBulk) Update(set func(*PointOfInterestUpsert)) *PointOfInterestUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PointOfInterestUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PointOfInterestUpsertBulk) SetUpdateTime(v time.Time) *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PointOfInterestUpsertBulk) UpdateUpdateTime() *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PointOfInterestUpsertBulk) SetName(v string) *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PointOfInterestUpsertBulk) UpdateName() *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *PointOfInterestUpsertBulk) SetCategory(v pointofinterest.Category) *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *PointOfInterestUpsertBulk) UpdateCategory() *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetSubtype sets the "subtype" field.
+func (u *PointOfInterestUpsertBulk) SetSubtype(v string) *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetSubtype(v)
+	})
+}
+
+// UpdateSubtype sets the "subtype" field to the value that was provided on create.
+func (u *PointOfInterestUpsertBulk) UpdateSubtype() *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateSubtype()
+	})
+}
+
+// ClearSubtype clears the value of the "subtype" field.
+func (u *PointOfInterestUpsertBulk) ClearSubtype() *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.ClearSubtype()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *PointOfInterestUpsertBulk) SetLatitude(v float64) *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *PointOfInterestUpsertBulk) AddLatitude(v float64) *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.AddLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *PointOfInterestUpsertBulk) UpdateLatitude() *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *PointOfInterestUpsertBulk) SetLongitude(v float64) *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *PointOfInterestUpsertBulk) AddLongitude(v float64) *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.AddLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *PointOfInterestUpsertBulk) UpdateLongitude() *PointOfInterestUpsertBulk {
+	return u.Update(func(s *PointOfInterestUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
+// Exec executes the query.
+func (u *PointOfInterestUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PointOfInterestCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PointOfInterestCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PointOfInterestUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
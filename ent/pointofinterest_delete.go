@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"ppgroup.ppgroup.com/ent/pointofinterest"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// PointOfInterestDelete is the builder for deleting a PointOfInterest entity.
+type PointOfInterestDelete struct {
+	config
+	hooks    []Hook
+	mutation *PointOfInterestMutation
+}
+
+// Where appends a list predicates to the PointOfInterestDelete builder.
+func (_d *PointOfInterestDelete) Where(ps ...predicate.PointOfInterest) *PointOfInterestDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PointOfInterestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PointOfInterestDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PointOfInterestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pointofinterest.Table, sqlgraph.NewFieldSpec(pointofinterest.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PointOfInterestDeleteOne is the builder for deleting a single PointOfInterest entity.
+type PointOfInterestDeleteOne struct {
+	_d *PointOfInterestDelete
+}
+
+// Where appends a list predicates to the PointOfInterestDelete builder.
+func (_d *PointOfInterestDeleteOne) Where(ps ...predicate.PointOfInterest) *PointOfInterestDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PointOfInterestDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pointofinterest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PointOfInterestDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
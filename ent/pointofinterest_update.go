@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"ppgroup.ppgroup.com/ent/pointofinterest"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// PointOfInterestUpdate is the builder for updating PointOfInterest entities.
+type PointOfInterestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PointOfInterestMutation
+}
+
+// Where appends a list predicates to the PointOfInterestUpdate builder.
+func (_u *PointOfInterestUpdate) Where(ps ...predicate.PointOfInterest) *PointOfInterestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *PointOfInterestUpdate) SetUpdateTime(v time.Time) *PointOfInterestUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PointOfInterestUpdate) SetName(v string) *PointOfInterestUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PointOfInterestUpdate) SetNillableName(v *string) *PointOfInterestUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *PointOfInterestUpdate) SetCategory(v pointofinterest.Category) *PointOfInterestUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *PointOfInterestUpdate) SetNillableCategory(v *pointofinterest.Category) *PointOfInterestUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetSubtype sets the "subtype" field.
+func (_u *PointOfInterestUpdate) SetSubtype(v string) *PointOfInterestUpdate {
+	_u.mutation.SetSubtype(v)
+	return _u
+}
+
+// SetNillableSubtype sets the "subtype" field if the given value is not nil.
+func (_u *PointOfInterestUpdate) SetNillableSubtype(v *string) *PointOfInterestUpdate {
+	if v != nil {
+		_u.SetSubtype(*v)
+	}
+	return _u
+}
+
+// ClearSubtype clears the value of the "subtype" field.
+func (_u *PointOfInterestUpdate) ClearSubtype() *PointOfInterestUpdate {
+	_u.mutation.ClearSubtype()
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *PointOfInterestUpdate) SetLatitude(v float64) *PointOfInterestUpdate {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *PointOfInterestUpdate) SetNillableLatitude(v *float64) *PointOfInterestUpdate {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *PointOfInterestUpdate) AddLatitude(v float64) *PointOfInterestUpdate {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *PointOfInterestUpdate) SetLongitude(v float64) *PointOfInterestUpdate {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *PointOfInterestUpdate) SetNillableLongitude(v *float64) *PointOfInterestUpdate {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *PointOfInterestUpdate) AddLongitude(v float64) *PointOfInterestUpdate {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// Mutation returns the PointOfInterestMutation object of the builder.
+func (_u *PointOfInterestUpdate) Mutation() *PointOfInterestMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PointOfInterestUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PointOfInterestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PointOfInterestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PointOfInterestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PointOfInterestUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if pointofinterest.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized pointofinterest.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := pointofinterest.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PointOfInterestUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := pointofinterest.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := pointofinterest.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subtype(); ok {
+		if err := pointofinterest.SubtypeValidator(v); err != nil {
+			return &ValidationError{Name: "subtype", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.subtype": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Latitude(); ok {
+		if err := pointofinterest.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.latitude": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Longitude(); ok {
+		if err := pointofinterest.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.longitude": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PointOfInterestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pointofinterest.Table, pointofinterest.Columns, sqlgraph.NewFieldSpec(pointofinterest.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(pointofinterest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(pointofinterest.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(pointofinterest.FieldCategory, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Subtype(); ok {
+		_spec.SetField(pointofinterest.FieldSubtype, field.TypeString, value)
+	}
+	if _u.mutation.SubtypeCleared() {
+		_spec.ClearField(pointofinterest.FieldSubtype, field.TypeString)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(pointofinterest.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(pointofinterest.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(pointofinterest.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(pointofinterest.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pointofinterest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PointOfInterestUpdateOne is the builder for updating a single PointOfInterest entity.
+type PointOfInterestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PointOfInterestMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *PointOfInterestUpdateOne) SetUpdateTime(v time.Time) *PointOfInterestUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PointOfInterestUpdateOne) SetName(v string) *PointOfInterestUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PointOfInterestUpdateOne) SetNillableName(v *string) *PointOfInterestUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *PointOfInterestUpdateOne) SetCategory(v pointofinterest.Category) *PointOfInterestUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *PointOfInterestUpdateOne) SetNillableCategory(v *pointofinterest.Category) *PointOfInterestUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetSubtype sets the "subtype" field.
+func (_u *PointOfInterestUpdateOne) SetSubtype(v string) *PointOfInterestUpdateOne {
+	_u.mutation.SetSubtype(v)
+	return _u
+}
+
+// SetNillableSubtype sets the "subtype" field if the given value is not nil.
+func (_u *PointOfInterestUpdateOne) SetNillableSubtype(v *string) *PointOfInterestUpdateOne {
+	if v != nil {
+		_u.SetSubtype(*v)
+	}
+	return _u
+}
+
+// ClearSubtype clears the value of the "subtype" field.
+func (_u *PointOfInterestUpdateOne) ClearSubtype() *PointOfInterestUpdateOne {
+	_u.mutation.ClearSubtype()
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *PointOfInterestUpdateOne) SetLatitude(v float64) *PointOfInterestUpdateOne {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *PointOfInterestUpdateOne) SetNillableLatitude(v *float64) *PointOfInterestUpdateOne {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *PointOfInterestUpdateOne) AddLatitude(v float64) *PointOfInterestUpdateOne {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *PointOfInterestUpdateOne) SetLongitude(v float64) *PointOfInterestUpdateOne {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *PointOfInterestUpdateOne) SetNillableLongitude(v *float64) *PointOfInterestUpdateOne {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *PointOfInterestUpdateOne) AddLongitude(v float64) *PointOfInterestUpdateOne {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// Mutation returns the PointOfInterestMutation object of the builder.
+func (_u *PointOfInterestUpdateOne) Mutation() *PointOfInterestMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PointOfInterestUpdate builder.
+func (_u *PointOfInterestUpdateOne) Where(ps ...predicate.PointOfInterest) *PointOfInterestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PointOfInterestUpdateOne) Select(field string, fields ...string) *PointOfInterestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PointOfInterest entity.
+func (_u *PointOfInterestUpdateOne) Save(ctx context.Context) (*PointOfInterest, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PointOfInterestUpdateOne) SaveX(ctx context.Context) *PointOfInterest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PointOfInterestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PointOfInterestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PointOfInterestUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if pointofinterest.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized pointofinterest.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := pointofinterest.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PointOfInterestUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := pointofinterest.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := pointofinterest.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subtype(); ok {
+		if err := pointofinterest.SubtypeValidator(v); err != nil {
+			return &ValidationError{Name: "subtype", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.subtype": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Latitude(); ok {
+		if err := pointofinterest.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.latitude": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Longitude(); ok {
+		if err := pointofinterest.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`ent: validator failed for field "PointOfInterest.longitude": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PointOfInterestUpdateOne) sqlSave(ctx context.Context) (_node *PointOfInterest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pointofinterest.Table, pointofinterest.Columns, sqlgraph.NewFieldSpec(pointofinterest.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PointOfInterest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pointofinterest.FieldID)
+		for _, f := range fields {
+			if !pointofinterest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pointofinterest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(pointofinterest.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(pointofinterest.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(pointofinterest.FieldCategory, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Subtype(); ok {
+		_spec.SetField(pointofinterest.FieldSubtype, field.TypeString, value)
+	}
+	if _u.mutation.SubtypeCleared() {
+		_spec.ClearField(pointofinterest.FieldSubtype, field.TypeString)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(pointofinterest.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(pointofinterest.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(pointofinterest.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(pointofinterest.FieldLongitude, field.TypeFloat64, value)
+	}
+	_node = &PointOfInterest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pointofinterest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
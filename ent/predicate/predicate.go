@@ -6,11 +6,56 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Brokerage is the predicate function for brokerage builders.
+type Brokerage func(*sql.Selector)
+
+// DocumentDownload is the predicate function for documentdownload builders.
+type DocumentDownload func(*sql.Selector)
+
+// ExchangeRate is the predicate function for exchangerate builders.
+type ExchangeRate func(*sql.Selector)
+
 // Listing is the predicate function for listing builders.
 type Listing func(*sql.Selector)
 
+// ListingDocument is the predicate function for listingdocument builders.
+type ListingDocument func(*sql.Selector)
+
+// ListingInquiry is the predicate function for listinginquiry builders.
+type ListingInquiry func(*sql.Selector)
+
+// ListingRenewal is the predicate function for listingrenewal builders.
+type ListingRenewal func(*sql.Selector)
+
+// ListingReview is the predicate function for listingreview builders.
+type ListingReview func(*sql.Selector)
+
+// ListingStatusEvent is the predicate function for listingstatusevent builders.
+type ListingStatusEvent func(*sql.Selector)
+
+// Neighborhood is the predicate function for neighborhood builders.
+type Neighborhood func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// Offer is the predicate function for offer builders.
+type Offer func(*sql.Selector)
+
+// PointOfInterest is the predicate function for pointofinterest builders.
+type PointOfInterest func(*sql.Selector)
+
+// Property is the predicate function for property builders.
+type Property func(*sql.Selector)
+
 // Realtor is the predicate function for realtor builders.
 type Realtor func(*sql.Selector)
 
+// RealtorInvite is the predicate function for realtorinvite builders.
+type RealtorInvite func(*sql.Selector)
+
+// Team is the predicate function for team builders.
+type Team func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -0,0 +1,543 @@
+// Code generated by ent, DO NOT EDIT.
+
+package privacy
+
+import (
+	"context"
+
+	"ppgroup.ppgroup.com/ent"
+
+	"entgo.io/ent/privacy"
+)
+
+var (
+	// Allow may be returned by rules to indicate that the policy
+	// evaluation should terminate with allow decision.
+	Allow = privacy.Allow
+
+	// Deny may be returned by rules to indicate that the policy
+	// evaluation should terminate with deny decision.
+	Deny = privacy.Deny
+
+	// Skip may be returned by rules to indicate that the policy
+	// evaluation should continue to the next rule.
+	Skip = privacy.Skip
+)
+
+// Allowf returns a formatted wrapped Allow decision.
+func Allowf(format string, a ...any) error {
+	return privacy.Allowf(format, a...)
+}
+
+// Denyf returns a formatted wrapped Deny decision.
+func Denyf(format string, a ...any) error {
+	return privacy.Denyf(format, a...)
+}
+
+// Skipf returns a formatted wrapped Skip decision.
+func Skipf(format string, a ...any) error {
+	return privacy.Skipf(format, a...)
+}
+
+// DecisionContext creates a new context from the given parent context with
+// a policy decision attach to it.
+func DecisionContext(parent context.Context, decision error) context.Context {
+	return privacy.DecisionContext(parent, decision)
+}
+
+// DecisionFromContext retrieves the policy decision from the context.
+func DecisionFromContext(ctx context.Context) (error, bool) {
+	return privacy.DecisionFromContext(ctx)
+}
+
+type (
+	// Policy groups query and mutation policies.
+	Policy = privacy.Policy
+
+	// QueryRule defines the interface deciding whether a
+	// query is allowed and optionally modify it.
+	QueryRule = privacy.QueryRule
+	// QueryPolicy combines multiple query rules into a single policy.
+	QueryPolicy = privacy.QueryPolicy
+
+	// MutationRule defines the interface which decides whether a
+	// mutation is allowed and optionally modifies it.
+	MutationRule = privacy.MutationRule
+	// MutationPolicy combines multiple mutation rules into a single policy.
+	MutationPolicy = privacy.MutationPolicy
+	// MutationRuleFunc type is an adapter which allows the use of
+	// ordinary functions as mutation rules.
+	MutationRuleFunc = privacy.MutationRuleFunc
+
+	// QueryMutationRule is an interface which groups query and mutation rules.
+	QueryMutationRule = privacy.QueryMutationRule
+)
+
+// QueryRuleFunc type is an adapter to allow the use of
+// ordinary functions as query rules.
+type QueryRuleFunc func(context.Context, ent.Query) error
+
+// Eval returns f(ctx, q).
+func (f QueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	return f(ctx, q)
+}
+
+// AlwaysAllowRule returns a rule that returns an allow decision.
+func AlwaysAllowRule() QueryMutationRule {
+	return privacy.AlwaysAllowRule()
+}
+
+// AlwaysDenyRule returns a rule that returns a deny decision.
+func AlwaysDenyRule() QueryMutationRule {
+	return privacy.AlwaysDenyRule()
+}
+
+// ContextQueryMutationRule creates a query/mutation rule from a context eval func.
+func ContextQueryMutationRule(eval func(context.Context) error) QueryMutationRule {
+	return privacy.ContextQueryMutationRule(eval)
+}
+
+// OnMutationOperation evaluates the given rule only on a given mutation operation.
+func OnMutationOperation(rule MutationRule, op ent.Op) MutationRule {
+	return privacy.OnMutationOperation(rule, op)
+}
+
+// DenyMutationOperationRule returns a rule denying specified mutation operation.
+func DenyMutationOperationRule(op ent.Op) MutationRule {
+	rule := MutationRuleFunc(func(_ context.Context, m ent.Mutation) error {
+		return Denyf("ent/privacy: operation %s is not allowed", m.Op())
+	})
+	return OnMutationOperation(rule, op)
+}
+
+// The BrokerageQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type BrokerageQueryRuleFunc func(context.Context, *ent.BrokerageQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f BrokerageQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BrokerageQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.BrokerageQuery", q)
+}
+
+// The BrokerageMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type BrokerageMutationRuleFunc func(context.Context, *ent.BrokerageMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f BrokerageMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.BrokerageMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.BrokerageMutation", m)
+}
+
+// The DocumentDownloadQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DocumentDownloadQueryRuleFunc func(context.Context, *ent.DocumentDownloadQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DocumentDownloadQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DocumentDownloadQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DocumentDownloadQuery", q)
+}
+
+// The DocumentDownloadMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DocumentDownloadMutationRuleFunc func(context.Context, *ent.DocumentDownloadMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DocumentDownloadMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DocumentDownloadMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DocumentDownloadMutation", m)
+}
+
+// The ExchangeRateQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ExchangeRateQueryRuleFunc func(context.Context, *ent.ExchangeRateQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ExchangeRateQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ExchangeRateQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ExchangeRateQuery", q)
+}
+
+// The ExchangeRateMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ExchangeRateMutationRuleFunc func(context.Context, *ent.ExchangeRateMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ExchangeRateMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ExchangeRateMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ExchangeRateMutation", m)
+}
+
+// The ListingQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ListingQueryRuleFunc func(context.Context, *ent.ListingQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ListingQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ListingQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ListingQuery", q)
+}
+
+// The ListingMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ListingMutationRuleFunc func(context.Context, *ent.ListingMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ListingMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ListingMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ListingMutation", m)
+}
+
+// The ListingDocumentQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ListingDocumentQueryRuleFunc func(context.Context, *ent.ListingDocumentQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ListingDocumentQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ListingDocumentQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ListingDocumentQuery", q)
+}
+
+// The ListingDocumentMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ListingDocumentMutationRuleFunc func(context.Context, *ent.ListingDocumentMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ListingDocumentMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ListingDocumentMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ListingDocumentMutation", m)
+}
+
+// The ListingInquiryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ListingInquiryQueryRuleFunc func(context.Context, *ent.ListingInquiryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ListingInquiryQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ListingInquiryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ListingInquiryQuery", q)
+}
+
+// The ListingInquiryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ListingInquiryMutationRuleFunc func(context.Context, *ent.ListingInquiryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ListingInquiryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ListingInquiryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ListingInquiryMutation", m)
+}
+
+// The ListingRenewalQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ListingRenewalQueryRuleFunc func(context.Context, *ent.ListingRenewalQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ListingRenewalQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ListingRenewalQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ListingRenewalQuery", q)
+}
+
+// The ListingRenewalMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ListingRenewalMutationRuleFunc func(context.Context, *ent.ListingRenewalMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ListingRenewalMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ListingRenewalMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ListingRenewalMutation", m)
+}
+
+// The ListingReviewQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ListingReviewQueryRuleFunc func(context.Context, *ent.ListingReviewQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ListingReviewQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ListingReviewQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ListingReviewQuery", q)
+}
+
+// The ListingReviewMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ListingReviewMutationRuleFunc func(context.Context, *ent.ListingReviewMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ListingReviewMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ListingReviewMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ListingReviewMutation", m)
+}
+
+// The ListingStatusEventQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ListingStatusEventQueryRuleFunc func(context.Context, *ent.ListingStatusEventQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ListingStatusEventQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ListingStatusEventQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ListingStatusEventQuery", q)
+}
+
+// The ListingStatusEventMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ListingStatusEventMutationRuleFunc func(context.Context, *ent.ListingStatusEventMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ListingStatusEventMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ListingStatusEventMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ListingStatusEventMutation", m)
+}
+
+// The NeighborhoodQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type NeighborhoodQueryRuleFunc func(context.Context, *ent.NeighborhoodQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f NeighborhoodQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.NeighborhoodQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.NeighborhoodQuery", q)
+}
+
+// The NeighborhoodMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type NeighborhoodMutationRuleFunc func(context.Context, *ent.NeighborhoodMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f NeighborhoodMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.NeighborhoodMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.NeighborhoodMutation", m)
+}
+
+// The NotificationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type NotificationQueryRuleFunc func(context.Context, *ent.NotificationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f NotificationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.NotificationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.NotificationQuery", q)
+}
+
+// The NotificationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type NotificationMutationRuleFunc func(context.Context, *ent.NotificationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f NotificationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.NotificationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.NotificationMutation", m)
+}
+
+// The OfferQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OfferQueryRuleFunc func(context.Context, *ent.OfferQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OfferQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OfferQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OfferQuery", q)
+}
+
+// The OfferMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OfferMutationRuleFunc func(context.Context, *ent.OfferMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OfferMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OfferMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OfferMutation", m)
+}
+
+// The PointOfInterestQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PointOfInterestQueryRuleFunc func(context.Context, *ent.PointOfInterestQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PointOfInterestQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PointOfInterestQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PointOfInterestQuery", q)
+}
+
+// The PointOfInterestMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PointOfInterestMutationRuleFunc func(context.Context, *ent.PointOfInterestMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PointOfInterestMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PointOfInterestMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PointOfInterestMutation", m)
+}
+
+// The PropertyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PropertyQueryRuleFunc func(context.Context, *ent.PropertyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PropertyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PropertyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PropertyQuery", q)
+}
+
+// The PropertyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PropertyMutationRuleFunc func(context.Context, *ent.PropertyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PropertyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PropertyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PropertyMutation", m)
+}
+
+// The RealtorQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RealtorQueryRuleFunc func(context.Context, *ent.RealtorQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RealtorQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RealtorQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RealtorQuery", q)
+}
+
+// The RealtorMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RealtorMutationRuleFunc func(context.Context, *ent.RealtorMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RealtorMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RealtorMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RealtorMutation", m)
+}
+
+// The RealtorInviteQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RealtorInviteQueryRuleFunc func(context.Context, *ent.RealtorInviteQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RealtorInviteQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RealtorInviteQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RealtorInviteQuery", q)
+}
+
+// The RealtorInviteMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RealtorInviteMutationRuleFunc func(context.Context, *ent.RealtorInviteMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RealtorInviteMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RealtorInviteMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RealtorInviteMutation", m)
+}
+
+// The TeamQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TeamQueryRuleFunc func(context.Context, *ent.TeamQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TeamQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TeamQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TeamQuery", q)
+}
+
+// The TeamMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TeamMutationRuleFunc func(context.Context, *ent.TeamMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TeamMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TeamMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TeamMutation", m)
+}
+
+// The UserQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserQueryRuleFunc func(context.Context, *ent.UserQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserQuery", q)
+}
+
+// The UserMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserMutationRuleFunc func(context.Context, *ent.UserMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserMutation", m)
+}
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/property"
+)
+
+// Property is the model entity for the Property schema.
+type Property struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// AddressKey holds the value of the "address_key" field.
+	AddressKey string `json:"address_key,omitempty"`
+	// City holds the value of the "city" field.
+	City string `json:"city,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// ZipCode holds the value of the "zip_code" field.
+	ZipCode string `json:"zip_code,omitempty"`
+	// Country holds the value of the "country" field.
+	Country string `json:"country,omitempty"`
+	// TypeOfProperty holds the value of the "type_of_property" field.
+	TypeOfProperty property.TypeOfProperty `json:"type_of_property,omitempty"`
+	// Bedroom holds the value of the "bedroom" field.
+	Bedroom int `json:"bedroom,omitempty"`
+	// Bathroom holds the value of the "bathroom" field.
+	Bathroom float64 `json:"bathroom,omitempty"`
+	// Garage holds the value of the "garage" field.
+	Garage int `json:"garage,omitempty"`
+	// Sqft holds the value of the "sqft" field.
+	Sqft int `json:"sqft,omitempty"`
+	// Sqm holds the value of the "sqm" field.
+	Sqm int `json:"sqm,omitempty"`
+	// LotSize holds the value of the "lot_size" field.
+	LotSize int `json:"lot_size,omitempty"`
+	// Pool holds the value of the "pool" field.
+	Pool bool `json:"pool,omitempty"`
+	// YearBuilt holds the value of the "year_built" field.
+	YearBuilt int `json:"year_built,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PropertyQuery when eager-loading is set.
+	Edges        PropertyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PropertyEdges holds the relations/edges for other nodes in the graph.
+type PropertyEdges struct {
+	// Listings holds the value of the listings edge.
+	Listings []*Listing `json:"listings,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ListingsOrErr returns the Listings value or an error if the edge
+// was not loaded in eager-loading.
+func (e PropertyEdges) ListingsOrErr() ([]*Listing, error) {
+	if e.loadedTypes[0] {
+		return e.Listings, nil
+	}
+	return nil, &NotLoadedError{edge: "listings"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Property) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case property.FieldPool:
+			values[i] = new(sql.NullBool)
+		case property.FieldBathroom:
+			values[i] = new(sql.NullFloat64)
+		case property.FieldBedroom, property.FieldGarage, property.FieldSqft, property.FieldSqm, property.FieldLotSize, property.FieldYearBuilt:
+			values[i] = new(sql.NullInt64)
+		case property.FieldAddress, property.FieldAddressKey, property.FieldCity, property.FieldState, property.FieldZipCode, property.FieldCountry, property.FieldTypeOfProperty:
+			values[i] = new(sql.NullString)
+		case property.FieldCreateTime, property.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case property.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Property fields.
+func (_m *Property) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case property.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case property.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case property.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case property.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = value.String
+			}
+		case property.FieldAddressKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_key", values[i])
+			} else if value.Valid {
+				_m.AddressKey = value.String
+			}
+		case property.FieldCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field city", values[i])
+			} else if value.Valid {
+				_m.City = value.String
+			}
+		case property.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case property.FieldZipCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field zip_code", values[i])
+			} else if value.Valid {
+				_m.ZipCode = value.String
+			}
+		case property.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				_m.Country = value.String
+			}
+		case property.FieldTypeOfProperty:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type_of_property", values[i])
+			} else if value.Valid {
+				_m.TypeOfProperty = property.TypeOfProperty(value.String)
+			}
+		case property.FieldBedroom:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bedroom", values[i])
+			} else if value.Valid {
+				_m.Bedroom = int(value.Int64)
+			}
+		case property.FieldBathroom:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field bathroom", values[i])
+			} else if value.Valid {
+				_m.Bathroom = value.Float64
+			}
+		case property.FieldGarage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field garage", values[i])
+			} else if value.Valid {
+				_m.Garage = int(value.Int64)
+			}
+		case property.FieldSqft:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sqft", values[i])
+			} else if value.Valid {
+				_m.Sqft = int(value.Int64)
+			}
+		case property.FieldSqm:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sqm", values[i])
+			} else if value.Valid {
+				_m.Sqm = int(value.Int64)
+			}
+		case property.FieldLotSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lot_size", values[i])
+			} else if value.Valid {
+				_m.LotSize = int(value.Int64)
+			}
+		case property.FieldPool:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pool", values[i])
+			} else if value.Valid {
+				_m.Pool = value.Bool
+			}
+		case property.FieldYearBuilt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field year_built", values[i])
+			} else if value.Valid {
+				_m.YearBuilt = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Property.
+// This includes values selected through modifiers, order, etc.
+func (_m *Property) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryListings queries the "listings" edge of the Property entity.
+func (_m *Property) QueryListings() *ListingQuery {
+	return NewPropertyClient(_m.config).QueryListings(_m)
+}
+
+// Update returns a builder for updating this Property.
+// Note that you need to call Property.Unwrap() before calling this method if this Property
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Property) Update() *PropertyUpdateOne {
+	return NewPropertyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Property entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Property) Unwrap() *Property {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Property is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Property) String() string {
+	var builder strings.Builder
+	builder.WriteString("Property(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(_m.Address)
+	builder.WriteString(", ")
+	builder.WriteString("address_key=")
+	builder.WriteString(_m.AddressKey)
+	builder.WriteString(", ")
+	builder.WriteString("city=")
+	builder.WriteString(_m.City)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("zip_code=")
+	builder.WriteString(_m.ZipCode)
+	builder.WriteString(", ")
+	builder.WriteString("country=")
+	builder.WriteString(_m.Country)
+	builder.WriteString(", ")
+	builder.WriteString("type_of_property=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TypeOfProperty))
+	builder.WriteString(", ")
+	builder.WriteString("bedroom=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Bedroom))
+	builder.WriteString(", ")
+	builder.WriteString("bathroom=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Bathroom))
+	builder.WriteString(", ")
+	builder.WriteString("garage=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Garage))
+	builder.WriteString(", ")
+	builder.WriteString("sqft=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sqft))
+	builder.WriteString(", ")
+	builder.WriteString("sqm=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sqm))
+	builder.WriteString(", ")
+	builder.WriteString("lot_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LotSize))
+	builder.WriteString(", ")
+	builder.WriteString("pool=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pool))
+	builder.WriteString(", ")
+	builder.WriteString("year_built=")
+	builder.WriteString(fmt.Sprintf("%v", _m.YearBuilt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Properties is a parsable slice of Property.
+type Properties []*Property
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package property
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the property type in the database.
+	Label = "property"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldAddressKey holds the string denoting the address_key field in the database.
+	FieldAddressKey = "address_key"
+	// FieldCity holds the string denoting the city field in the database.
+	FieldCity = "city"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldZipCode holds the string denoting the zip_code field in the database.
+	FieldZipCode = "zip_code"
+	// FieldCountry holds the string denoting the country field in the database.
+	FieldCountry = "country"
+	// FieldTypeOfProperty holds the string denoting the type_of_property field in the database.
+	FieldTypeOfProperty = "type_of_property"
+	// FieldBedroom holds the string denoting the bedroom field in the database.
+	FieldBedroom = "bedroom"
+	// FieldBathroom holds the string denoting the bathroom field in the database.
+	FieldBathroom = "bathroom"
+	// FieldGarage holds the string denoting the garage field in the database.
+	FieldGarage = "garage"
+	// FieldSqft holds the string denoting the sqft field in the database.
+	FieldSqft = "sqft"
+	// FieldSqm holds the string denoting the sqm field in the database.
+	FieldSqm = "sqm"
+	// FieldLotSize holds the string denoting the lot_size field in the database.
+	FieldLotSize = "lot_size"
+	// FieldPool holds the string denoting the pool field in the database.
+	FieldPool = "pool"
+	// FieldYearBuilt holds the string denoting the year_built field in the database.
+	FieldYearBuilt = "year_built"
+	// EdgeListings holds the string denoting the listings edge name in mutations.
+	EdgeListings = "listings"
+	// Table holds the table name of the property in the database.
+	Table = "properties"
+	// ListingsTable is the table that holds the listings relation/edge.
+	ListingsTable = "listings"
+	// ListingsInverseTable is the table name for the Listing entity.
+	// It exists in this package in order to avoid circular dependency with the "listing" package.
+	ListingsInverseTable = "listings"
+	// ListingsColumn is the table column denoting the listings relation/edge.
+	ListingsColumn = "property_id"
+)
+
+// Columns holds all SQL columns for property fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldAddress,
+	FieldAddressKey,
+	FieldCity,
+	FieldState,
+	FieldZipCode,
+	FieldCountry,
+	FieldTypeOfProperty,
+	FieldBedroom,
+	FieldBathroom,
+	FieldGarage,
+	FieldSqft,
+	FieldSqm,
+	FieldLotSize,
+	FieldPool,
+	FieldYearBuilt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "ppgroup.ppgroup.com/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	AddressValidator func(string) error
+	// AddressKeyValidator is a validator for the "address_key" field. It is called by the builders before save.
+	AddressKeyValidator func(string) error
+	// CityValidator is a validator for the "city" field. It is called by the builders before save.
+	CityValidator func(string) error
+	// StateValidator is a validator for the "state" field. It is called by the builders before save.
+	StateValidator func(string) error
+	// ZipCodeValidator is a validator for the "zip_code" field. It is called by the builders before save.
+	ZipCodeValidator func(string) error
+	// DefaultCountry holds the default value on creation for the "country" field.
+	DefaultCountry string
+	// CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	CountryValidator func(string) error
+	// BedroomValidator is a validator for the "bedroom" field. It is called by the builders before save.
+	BedroomValidator func(int) error
+	// BathroomValidator is a validator for the "bathroom" field. It is called by the builders before save.
+	BathroomValidator func(float64) error
+	// GarageValidator is a validator for the "garage" field. It is called by the builders before save.
+	GarageValidator func(int) error
+	// SqftValidator is a validator for the "sqft" field. It is called by the builders before save.
+	SqftValidator func(int) error
+	// SqmValidator is a validator for the "sqm" field. It is called by the builders before save.
+	SqmValidator func(int) error
+	// LotSizeValidator is a validator for the "lot_size" field. It is called by the builders before save.
+	LotSizeValidator func(int) error
+	// YearBuiltValidator is a validator for the "year_built" field. It is called by the builders before save.
+	YearBuiltValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// TypeOfProperty defines the type for the "type_of_property" enum field.
+type TypeOfProperty string
+
+// TypeOfPropertyHouse is the default value of the TypeOfProperty enum.
+const DefaultTypeOfProperty = TypeOfPropertyHouse
+
+// TypeOfProperty values.
+const (
+	TypeOfPropertyHouse     TypeOfProperty = "house"
+	TypeOfPropertyApartment TypeOfProperty = "apartment"
+	TypeOfPropertyCondo     TypeOfProperty = "condo"
+	TypeOfPropertyTownhouse TypeOfProperty = "townhouse"
+)
+
+func (top TypeOfProperty) String() string {
+	return string(top)
+}
+
+// TypeOfPropertyValidator is a validator for the "type_of_property" field enum values. It is called by the builders before save.
+func TypeOfPropertyValidator(top TypeOfProperty) error {
+	switch top {
+	case TypeOfPropertyHouse, TypeOfPropertyApartment, TypeOfPropertyCondo, TypeOfPropertyTownhouse:
+		return nil
+	default:
+		return fmt.Errorf("property: invalid enum value for type_of_property field: %q", top)
+	}
+}
+
+// OrderOption defines the ordering options for the Property queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByAddress orders the results by the address field.
+func ByAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddress, opts...).ToFunc()
+}
+
+// ByAddressKey orders the results by the address_key field.
+func ByAddressKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressKey, opts...).ToFunc()
+}
+
+// ByCity orders the results by the city field.
+func ByCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCity, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByZipCode orders the results by the zip_code field.
+func ByZipCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldZipCode, opts...).ToFunc()
+}
+
+// ByCountry orders the results by the country field.
+func ByCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountry, opts...).ToFunc()
+}
+
+// ByTypeOfProperty orders the results by the type_of_property field.
+func ByTypeOfProperty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTypeOfProperty, opts...).ToFunc()
+}
+
+// ByBedroom orders the results by the bedroom field.
+func ByBedroom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBedroom, opts...).ToFunc()
+}
+
+// ByBathroom orders the results by the bathroom field.
+func ByBathroom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBathroom, opts...).ToFunc()
+}
+
+// ByGarage orders the results by the garage field.
+func ByGarage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGarage, opts...).ToFunc()
+}
+
+// BySqft orders the results by the sqft field.
+func BySqft(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSqft, opts...).ToFunc()
+}
+
+// BySqm orders the results by the sqm field.
+func BySqm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSqm, opts...).ToFunc()
+}
+
+// ByLotSize orders the results by the lot_size field.
+func ByLotSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLotSize, opts...).ToFunc()
+}
+
+// ByPool orders the results by the pool field.
+func ByPool(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPool, opts...).ToFunc()
+}
+
+// ByYearBuilt orders the results by the year_built field.
+func ByYearBuilt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldYearBuilt, opts...).ToFunc()
+}
+
+// ByListingsCount orders the results by listings count.
+func ByListingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newListingsStep(), opts...)
+	}
+}
+
+// ByListings orders the results by listings terms.
+func ByListings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newListingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newListingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ListingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ListingsTable, ListingsColumn),
+	)
+}
@@ -0,0 +1,1005 @@
+// Code generated by ent, DO NOT EDIT.
+
+package property
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressKey applies equality check predicate on the "address_key" field. It's identical to AddressKeyEQ.
+func AddressKey(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldAddressKey, v))
+}
+
+// City applies equality check predicate on the "city" field. It's identical to CityEQ.
+func City(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldCity, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldState, v))
+}
+
+// ZipCode applies equality check predicate on the "zip_code" field. It's identical to ZipCodeEQ.
+func ZipCode(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldZipCode, v))
+}
+
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldCountry, v))
+}
+
+// Bedroom applies equality check predicate on the "bedroom" field. It's identical to BedroomEQ.
+func Bedroom(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldBedroom, v))
+}
+
+// Bathroom applies equality check predicate on the "bathroom" field. It's identical to BathroomEQ.
+func Bathroom(v float64) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldBathroom, v))
+}
+
+// Garage applies equality check predicate on the "garage" field. It's identical to GarageEQ.
+func Garage(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldGarage, v))
+}
+
+// Sqft applies equality check predicate on the "sqft" field. It's identical to SqftEQ.
+func Sqft(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldSqft, v))
+}
+
+// Sqm applies equality check predicate on the "sqm" field. It's identical to SqmEQ.
+func Sqm(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldSqm, v))
+}
+
+// LotSize applies equality check predicate on the "lot_size" field. It's identical to LotSizeEQ.
+func LotSize(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldLotSize, v))
+}
+
+// Pool applies equality check predicate on the "pool" field. It's identical to PoolEQ.
+func Pool(v bool) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldPool, v))
+}
+
+// YearBuilt applies equality check predicate on the "year_built" field. It's identical to YearBuiltEQ.
+func YearBuilt(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldYearBuilt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.Property {
+	return predicate.Property(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// AddressKeyEQ applies the EQ predicate on the "address_key" field.
+func AddressKeyEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldAddressKey, v))
+}
+
+// AddressKeyNEQ applies the NEQ predicate on the "address_key" field.
+func AddressKeyNEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldAddressKey, v))
+}
+
+// AddressKeyIn applies the In predicate on the "address_key" field.
+func AddressKeyIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldAddressKey, vs...))
+}
+
+// AddressKeyNotIn applies the NotIn predicate on the "address_key" field.
+func AddressKeyNotIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldAddressKey, vs...))
+}
+
+// AddressKeyGT applies the GT predicate on the "address_key" field.
+func AddressKeyGT(v string) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldAddressKey, v))
+}
+
+// AddressKeyGTE applies the GTE predicate on the "address_key" field.
+func AddressKeyGTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldAddressKey, v))
+}
+
+// AddressKeyLT applies the LT predicate on the "address_key" field.
+func AddressKeyLT(v string) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldAddressKey, v))
+}
+
+// AddressKeyLTE applies the LTE predicate on the "address_key" field.
+func AddressKeyLTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldAddressKey, v))
+}
+
+// AddressKeyContains applies the Contains predicate on the "address_key" field.
+func AddressKeyContains(v string) predicate.Property {
+	return predicate.Property(sql.FieldContains(FieldAddressKey, v))
+}
+
+// AddressKeyHasPrefix applies the HasPrefix predicate on the "address_key" field.
+func AddressKeyHasPrefix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasPrefix(FieldAddressKey, v))
+}
+
+// AddressKeyHasSuffix applies the HasSuffix predicate on the "address_key" field.
+func AddressKeyHasSuffix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasSuffix(FieldAddressKey, v))
+}
+
+// AddressKeyIsNil applies the IsNil predicate on the "address_key" field.
+func AddressKeyIsNil() predicate.Property {
+	return predicate.Property(sql.FieldIsNull(FieldAddressKey))
+}
+
+// AddressKeyNotNil applies the NotNil predicate on the "address_key" field.
+func AddressKeyNotNil() predicate.Property {
+	return predicate.Property(sql.FieldNotNull(FieldAddressKey))
+}
+
+// AddressKeyEqualFold applies the EqualFold predicate on the "address_key" field.
+func AddressKeyEqualFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldEqualFold(FieldAddressKey, v))
+}
+
+// AddressKeyContainsFold applies the ContainsFold predicate on the "address_key" field.
+func AddressKeyContainsFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldContainsFold(FieldAddressKey, v))
+}
+
+// CityEQ applies the EQ predicate on the "city" field.
+func CityEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldCity, v))
+}
+
+// CityNEQ applies the NEQ predicate on the "city" field.
+func CityNEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldCity, v))
+}
+
+// CityIn applies the In predicate on the "city" field.
+func CityIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldCity, vs...))
+}
+
+// CityNotIn applies the NotIn predicate on the "city" field.
+func CityNotIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldCity, vs...))
+}
+
+// CityGT applies the GT predicate on the "city" field.
+func CityGT(v string) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldCity, v))
+}
+
+// CityGTE applies the GTE predicate on the "city" field.
+func CityGTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldCity, v))
+}
+
+// CityLT applies the LT predicate on the "city" field.
+func CityLT(v string) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldCity, v))
+}
+
+// CityLTE applies the LTE predicate on the "city" field.
+func CityLTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldCity, v))
+}
+
+// CityContains applies the Contains predicate on the "city" field.
+func CityContains(v string) predicate.Property {
+	return predicate.Property(sql.FieldContains(FieldCity, v))
+}
+
+// CityHasPrefix applies the HasPrefix predicate on the "city" field.
+func CityHasPrefix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasPrefix(FieldCity, v))
+}
+
+// CityHasSuffix applies the HasSuffix predicate on the "city" field.
+func CityHasSuffix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasSuffix(FieldCity, v))
+}
+
+// CityEqualFold applies the EqualFold predicate on the "city" field.
+func CityEqualFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldEqualFold(FieldCity, v))
+}
+
+// CityContainsFold applies the ContainsFold predicate on the "city" field.
+func CityContainsFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldContainsFold(FieldCity, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.Property {
+	return predicate.Property(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldContainsFold(FieldState, v))
+}
+
+// ZipCodeEQ applies the EQ predicate on the "zip_code" field.
+func ZipCodeEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldZipCode, v))
+}
+
+// ZipCodeNEQ applies the NEQ predicate on the "zip_code" field.
+func ZipCodeNEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldZipCode, v))
+}
+
+// ZipCodeIn applies the In predicate on the "zip_code" field.
+func ZipCodeIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldZipCode, vs...))
+}
+
+// ZipCodeNotIn applies the NotIn predicate on the "zip_code" field.
+func ZipCodeNotIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldZipCode, vs...))
+}
+
+// ZipCodeGT applies the GT predicate on the "zip_code" field.
+func ZipCodeGT(v string) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldZipCode, v))
+}
+
+// ZipCodeGTE applies the GTE predicate on the "zip_code" field.
+func ZipCodeGTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldZipCode, v))
+}
+
+// ZipCodeLT applies the LT predicate on the "zip_code" field.
+func ZipCodeLT(v string) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldZipCode, v))
+}
+
+// ZipCodeLTE applies the LTE predicate on the "zip_code" field.
+func ZipCodeLTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldZipCode, v))
+}
+
+// ZipCodeContains applies the Contains predicate on the "zip_code" field.
+func ZipCodeContains(v string) predicate.Property {
+	return predicate.Property(sql.FieldContains(FieldZipCode, v))
+}
+
+// ZipCodeHasPrefix applies the HasPrefix predicate on the "zip_code" field.
+func ZipCodeHasPrefix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasPrefix(FieldZipCode, v))
+}
+
+// ZipCodeHasSuffix applies the HasSuffix predicate on the "zip_code" field.
+func ZipCodeHasSuffix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasSuffix(FieldZipCode, v))
+}
+
+// ZipCodeEqualFold applies the EqualFold predicate on the "zip_code" field.
+func ZipCodeEqualFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldEqualFold(FieldZipCode, v))
+}
+
+// ZipCodeContainsFold applies the ContainsFold predicate on the "zip_code" field.
+func ZipCodeContainsFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldContainsFold(FieldZipCode, v))
+}
+
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldCountry, v))
+}
+
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldCountry, v))
+}
+
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldCountry, vs...))
+}
+
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldCountry, vs...))
+}
+
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldCountry, v))
+}
+
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldCountry, v))
+}
+
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldCountry, v))
+}
+
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldCountry, v))
+}
+
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.Property {
+	return predicate.Property(sql.FieldContains(FieldCountry, v))
+}
+
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasPrefix(FieldCountry, v))
+}
+
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.Property {
+	return predicate.Property(sql.FieldHasSuffix(FieldCountry, v))
+}
+
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldEqualFold(FieldCountry, v))
+}
+
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.Property {
+	return predicate.Property(sql.FieldContainsFold(FieldCountry, v))
+}
+
+// TypeOfPropertyEQ applies the EQ predicate on the "type_of_property" field.
+func TypeOfPropertyEQ(v TypeOfProperty) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldTypeOfProperty, v))
+}
+
+// TypeOfPropertyNEQ applies the NEQ predicate on the "type_of_property" field.
+func TypeOfPropertyNEQ(v TypeOfProperty) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldTypeOfProperty, v))
+}
+
+// TypeOfPropertyIn applies the In predicate on the "type_of_property" field.
+func TypeOfPropertyIn(vs ...TypeOfProperty) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldTypeOfProperty, vs...))
+}
+
+// TypeOfPropertyNotIn applies the NotIn predicate on the "type_of_property" field.
+func TypeOfPropertyNotIn(vs ...TypeOfProperty) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldTypeOfProperty, vs...))
+}
+
+// BedroomEQ applies the EQ predicate on the "bedroom" field.
+func BedroomEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldBedroom, v))
+}
+
+// BedroomNEQ applies the NEQ predicate on the "bedroom" field.
+func BedroomNEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldBedroom, v))
+}
+
+// BedroomIn applies the In predicate on the "bedroom" field.
+func BedroomIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldBedroom, vs...))
+}
+
+// BedroomNotIn applies the NotIn predicate on the "bedroom" field.
+func BedroomNotIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldBedroom, vs...))
+}
+
+// BedroomGT applies the GT predicate on the "bedroom" field.
+func BedroomGT(v int) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldBedroom, v))
+}
+
+// BedroomGTE applies the GTE predicate on the "bedroom" field.
+func BedroomGTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldBedroom, v))
+}
+
+// BedroomLT applies the LT predicate on the "bedroom" field.
+func BedroomLT(v int) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldBedroom, v))
+}
+
+// BedroomLTE applies the LTE predicate on the "bedroom" field.
+func BedroomLTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldBedroom, v))
+}
+
+// BathroomEQ applies the EQ predicate on the "bathroom" field.
+func BathroomEQ(v float64) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldBathroom, v))
+}
+
+// BathroomNEQ applies the NEQ predicate on the "bathroom" field.
+func BathroomNEQ(v float64) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldBathroom, v))
+}
+
+// BathroomIn applies the In predicate on the "bathroom" field.
+func BathroomIn(vs ...float64) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldBathroom, vs...))
+}
+
+// BathroomNotIn applies the NotIn predicate on the "bathroom" field.
+func BathroomNotIn(vs ...float64) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldBathroom, vs...))
+}
+
+// BathroomGT applies the GT predicate on the "bathroom" field.
+func BathroomGT(v float64) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldBathroom, v))
+}
+
+// BathroomGTE applies the GTE predicate on the "bathroom" field.
+func BathroomGTE(v float64) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldBathroom, v))
+}
+
+// BathroomLT applies the LT predicate on the "bathroom" field.
+func BathroomLT(v float64) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldBathroom, v))
+}
+
+// BathroomLTE applies the LTE predicate on the "bathroom" field.
+func BathroomLTE(v float64) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldBathroom, v))
+}
+
+// GarageEQ applies the EQ predicate on the "garage" field.
+func GarageEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldGarage, v))
+}
+
+// GarageNEQ applies the NEQ predicate on the "garage" field.
+func GarageNEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldGarage, v))
+}
+
+// GarageIn applies the In predicate on the "garage" field.
+func GarageIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldGarage, vs...))
+}
+
+// GarageNotIn applies the NotIn predicate on the "garage" field.
+func GarageNotIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldGarage, vs...))
+}
+
+// GarageGT applies the GT predicate on the "garage" field.
+func GarageGT(v int) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldGarage, v))
+}
+
+// GarageGTE applies the GTE predicate on the "garage" field.
+func GarageGTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldGarage, v))
+}
+
+// GarageLT applies the LT predicate on the "garage" field.
+func GarageLT(v int) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldGarage, v))
+}
+
+// GarageLTE applies the LTE predicate on the "garage" field.
+func GarageLTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldGarage, v))
+}
+
+// GarageIsNil applies the IsNil predicate on the "garage" field.
+func GarageIsNil() predicate.Property {
+	return predicate.Property(sql.FieldIsNull(FieldGarage))
+}
+
+// GarageNotNil applies the NotNil predicate on the "garage" field.
+func GarageNotNil() predicate.Property {
+	return predicate.Property(sql.FieldNotNull(FieldGarage))
+}
+
+// SqftEQ applies the EQ predicate on the "sqft" field.
+func SqftEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldSqft, v))
+}
+
+// SqftNEQ applies the NEQ predicate on the "sqft" field.
+func SqftNEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldSqft, v))
+}
+
+// SqftIn applies the In predicate on the "sqft" field.
+func SqftIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldSqft, vs...))
+}
+
+// SqftNotIn applies the NotIn predicate on the "sqft" field.
+func SqftNotIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldSqft, vs...))
+}
+
+// SqftGT applies the GT predicate on the "sqft" field.
+func SqftGT(v int) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldSqft, v))
+}
+
+// SqftGTE applies the GTE predicate on the "sqft" field.
+func SqftGTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldSqft, v))
+}
+
+// SqftLT applies the LT predicate on the "sqft" field.
+func SqftLT(v int) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldSqft, v))
+}
+
+// SqftLTE applies the LTE predicate on the "sqft" field.
+func SqftLTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldSqft, v))
+}
+
+// SqmEQ applies the EQ predicate on the "sqm" field.
+func SqmEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldSqm, v))
+}
+
+// SqmNEQ applies the NEQ predicate on the "sqm" field.
+func SqmNEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldSqm, v))
+}
+
+// SqmIn applies the In predicate on the "sqm" field.
+func SqmIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldSqm, vs...))
+}
+
+// SqmNotIn applies the NotIn predicate on the "sqm" field.
+func SqmNotIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldSqm, vs...))
+}
+
+// SqmGT applies the GT predicate on the "sqm" field.
+func SqmGT(v int) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldSqm, v))
+}
+
+// SqmGTE applies the GTE predicate on the "sqm" field.
+func SqmGTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldSqm, v))
+}
+
+// SqmLT applies the LT predicate on the "sqm" field.
+func SqmLT(v int) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldSqm, v))
+}
+
+// SqmLTE applies the LTE predicate on the "sqm" field.
+func SqmLTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldSqm, v))
+}
+
+// SqmIsNil applies the IsNil predicate on the "sqm" field.
+func SqmIsNil() predicate.Property {
+	return predicate.Property(sql.FieldIsNull(FieldSqm))
+}
+
+// SqmNotNil applies the NotNil predicate on the "sqm" field.
+func SqmNotNil() predicate.Property {
+	return predicate.Property(sql.FieldNotNull(FieldSqm))
+}
+
+// LotSizeEQ applies the EQ predicate on the "lot_size" field.
+func LotSizeEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldLotSize, v))
+}
+
+// LotSizeNEQ applies the NEQ predicate on the "lot_size" field.
+func LotSizeNEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldLotSize, v))
+}
+
+// LotSizeIn applies the In predicate on the "lot_size" field.
+func LotSizeIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldLotSize, vs...))
+}
+
+// LotSizeNotIn applies the NotIn predicate on the "lot_size" field.
+func LotSizeNotIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldLotSize, vs...))
+}
+
+// LotSizeGT applies the GT predicate on the "lot_size" field.
+func LotSizeGT(v int) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldLotSize, v))
+}
+
+// LotSizeGTE applies the GTE predicate on the "lot_size" field.
+func LotSizeGTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldLotSize, v))
+}
+
+// LotSizeLT applies the LT predicate on the "lot_size" field.
+func LotSizeLT(v int) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldLotSize, v))
+}
+
+// LotSizeLTE applies the LTE predicate on the "lot_size" field.
+func LotSizeLTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldLotSize, v))
+}
+
+// LotSizeIsNil applies the IsNil predicate on the "lot_size" field.
+func LotSizeIsNil() predicate.Property {
+	return predicate.Property(sql.FieldIsNull(FieldLotSize))
+}
+
+// LotSizeNotNil applies the NotNil predicate on the "lot_size" field.
+func LotSizeNotNil() predicate.Property {
+	return predicate.Property(sql.FieldNotNull(FieldLotSize))
+}
+
+// PoolEQ applies the EQ predicate on the "pool" field.
+func PoolEQ(v bool) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldPool, v))
+}
+
+// PoolNEQ applies the NEQ predicate on the "pool" field.
+func PoolNEQ(v bool) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldPool, v))
+}
+
+// PoolIsNil applies the IsNil predicate on the "pool" field.
+func PoolIsNil() predicate.Property {
+	return predicate.Property(sql.FieldIsNull(FieldPool))
+}
+
+// PoolNotNil applies the NotNil predicate on the "pool" field.
+func PoolNotNil() predicate.Property {
+	return predicate.Property(sql.FieldNotNull(FieldPool))
+}
+
+// YearBuiltEQ applies the EQ predicate on the "year_built" field.
+func YearBuiltEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldEQ(FieldYearBuilt, v))
+}
+
+// YearBuiltNEQ applies the NEQ predicate on the "year_built" field.
+func YearBuiltNEQ(v int) predicate.Property {
+	return predicate.Property(sql.FieldNEQ(FieldYearBuilt, v))
+}
+
+// YearBuiltIn applies the In predicate on the "year_built" field.
+func YearBuiltIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldIn(FieldYearBuilt, vs...))
+}
+
+// YearBuiltNotIn applies the NotIn predicate on the "year_built" field.
+func YearBuiltNotIn(vs ...int) predicate.Property {
+	return predicate.Property(sql.FieldNotIn(FieldYearBuilt, vs...))
+}
+
+// YearBuiltGT applies the GT predicate on the "year_built" field.
+func YearBuiltGT(v int) predicate.Property {
+	return predicate.Property(sql.FieldGT(FieldYearBuilt, v))
+}
+
+// YearBuiltGTE applies the GTE predicate on the "year_built" field.
+func YearBuiltGTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldGTE(FieldYearBuilt, v))
+}
+
+// YearBuiltLT applies the LT predicate on the "year_built" field.
+func YearBuiltLT(v int) predicate.Property {
+	return predicate.Property(sql.FieldLT(FieldYearBuilt, v))
+}
+
+// YearBuiltLTE applies the LTE predicate on the "year_built" field.
+func YearBuiltLTE(v int) predicate.Property {
+	return predicate.Property(sql.FieldLTE(FieldYearBuilt, v))
+}
+
+// HasListings applies the HasEdge predicate on the "listings" edge.
+func HasListings() predicate.Property {
+	return predicate.Property(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ListingsTable, ListingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasListingsWith applies the HasEdge predicate on the "listings" edge with a given conditions (other predicates).
+func HasListingsWith(preds ...predicate.Listing) predicate.Property {
+	return predicate.Property(func(s *sql.Selector) {
+		step := newListingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Property) predicate.Property {
+	return predicate.Property(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Property) predicate.Property {
+	return predicate.Property(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Property) predicate.Property {
+	return predicate.Property(sql.NotPredicates(p))
+}
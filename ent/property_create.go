@@ -0,0 +1,1750 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/property"
+)
+
+// PropertyCreate is the builder for creating a Property entity.
+type PropertyCreate struct {
+	config
+	mutation *PropertyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *PropertyCreate) SetCreateTime(v time.Time) *PropertyCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *PropertyCreate) SetNillableCreateTime(v *time.Time) *PropertyCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *PropertyCreate) SetUpdateTime(v time.Time) *PropertyCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *PropertyCreate) SetNillableUpdateTime(v *time.Time) *PropertyCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetAddress sets the "address" field.
+func (_c *PropertyCreate) SetAddress(v string) *PropertyCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetAddressKey sets the "address_key" field.
+func (_c *PropertyCreate) SetAddressKey(v string) *PropertyCreate {
+	_c.mutation.SetAddressKey(v)
+	return _c
+}
+
+// SetNillableAddressKey sets the "address_key" field if the given value is not nil.
+func (_c *PropertyCreate) SetNillableAddressKey(v *string) *PropertyCreate {
+	if v != nil {
+		_c.SetAddressKey(*v)
+	}
+	return _c
+}
+
+// SetCity sets the "city" field.
+func (_c *PropertyCreate) SetCity(v string) *PropertyCreate {
+	_c.mutation.SetCity(v)
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *PropertyCreate) SetState(v string) *PropertyCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetZipCode sets the "zip_code" field.
+func (_c *PropertyCreate) SetZipCode(v string) *PropertyCreate {
+	_c.mutation.SetZipCode(v)
+	return _c
+}
+
+// SetCountry sets the "country" field.
+func (_c *PropertyCreate) SetCountry(v string) *PropertyCreate {
+	_c.mutation.SetCountry(v)
+	return _c
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_c *PropertyCreate) SetNillableCountry(v *string) *PropertyCreate {
+	if v != nil {
+		_c.SetCountry(*v)
+	}
+	return _c
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (_c *PropertyCreate) SetTypeOfProperty(v property.TypeOfProperty) *PropertyCreate {
+	_c.mutation.SetTypeOfProperty(v)
+	return _c
+}
+
+// SetNillableTypeOfProperty sets the "type_of_property" field if the given value is not nil.
+func (_c *PropertyCreate) SetNillableTypeOfProperty(v *property.TypeOfProperty) *PropertyCreate {
+	if v != nil {
+		_c.SetTypeOfProperty(*v)
+	}
+	return _c
+}
+
+// SetBedroom sets the "bedroom" field.
+func (_c *PropertyCreate) SetBedroom(v int) *PropertyCreate {
+	_c.mutation.SetBedroom(v)
+	return _c
+}
+
+// SetBathroom sets the "bathroom" field.
+func (_c *PropertyCreate) SetBathroom(v float64) *PropertyCreate {
+	_c.mutation.SetBathroom(v)
+	return _c
+}
+
+// SetGarage sets the "garage" field.
+func (_c *PropertyCreate) SetGarage(v int) *PropertyCreate {
+	_c.mutation.SetGarage(v)
+	return _c
+}
+
+// SetNillableGarage sets the "garage" field if the given value is not nil.
+func (_c *PropertyCreate) SetNillableGarage(v *int) *PropertyCreate {
+	if v != nil {
+		_c.SetGarage(*v)
+	}
+	return _c
+}
+
+// SetSqft sets the "sqft" field.
+func (_c *PropertyCreate) SetSqft(v int) *PropertyCreate {
+	_c.mutation.SetSqft(v)
+	return _c
+}
+
+// SetSqm sets the "sqm" field.
+func (_c *PropertyCreate) SetSqm(v int) *PropertyCreate {
+	_c.mutation.SetSqm(v)
+	return _c
+}
+
+// SetNillableSqm sets the "sqm" field if the given value is not nil.
+func (_c *PropertyCreate) SetNillableSqm(v *int) *PropertyCreate {
+	if v != nil {
+		_c.SetSqm(*v)
+	}
+	return _c
+}
+
+// SetLotSize sets the "lot_size" field.
+func (_c *PropertyCreate) SetLotSize(v int) *PropertyCreate {
+	_c.mutation.SetLotSize(v)
+	return _c
+}
+
+// SetNillableLotSize sets the "lot_size" field if the given value is not nil.
+func (_c *PropertyCreate) SetNillableLotSize(v *int) *PropertyCreate {
+	if v != nil {
+		_c.SetLotSize(*v)
+	}
+	return _c
+}
+
+// SetPool sets the "pool" field.
+func (_c *PropertyCreate) SetPool(v bool) *PropertyCreate {
+	_c.mutation.SetPool(v)
+	return _c
+}
+
+// SetNillablePool sets the "pool" field if the given value is not nil.
+func (_c *PropertyCreate) SetNillablePool(v *bool) *PropertyCreate {
+	if v != nil {
+		_c.SetPool(*v)
+	}
+	return _c
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (_c *PropertyCreate) SetYearBuilt(v int) *PropertyCreate {
+	_c.mutation.SetYearBuilt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PropertyCreate) SetID(v uuid.UUID) *PropertyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PropertyCreate) SetNillableID(v *uuid.UUID) *PropertyCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_c *PropertyCreate) AddListingIDs(ids ...uuid.UUID) *PropertyCreate {
+	_c.mutation.AddListingIDs(ids...)
+	return _c
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_c *PropertyCreate) AddListings(v ...*Listing) *PropertyCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddListingIDs(ids...)
+}
+
+// Mutation returns the PropertyMutation object of the builder.
+func (_c *PropertyCreate) Mutation() *PropertyMutation {
+	return _c.mutation
+}
+
+// Save creates the Property in the database.
+func (_c *PropertyCreate) Save(ctx context.Context) (*Property, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PropertyCreate) SaveX(ctx context.Context) *Property {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PropertyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PropertyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PropertyCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if property.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized property.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := property.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if property.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized property.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := property.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Country(); !ok {
+		v := property.DefaultCountry
+		_c.mutation.SetCountry(v)
+	}
+	if _, ok := _c.mutation.TypeOfProperty(); !ok {
+		v := property.DefaultTypeOfProperty
+		_c.mutation.SetTypeOfProperty(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if property.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized property.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := property.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PropertyCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Property.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Property.update_time"`)}
+	}
+	if _, ok := _c.mutation.Address(); !ok {
+		return &ValidationError{Name: "address", err: errors.New(`ent: missing required field "Property.address"`)}
+	}
+	if v, ok := _c.mutation.Address(); ok {
+		if err := property.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Property.address": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AddressKey(); ok {
+		if err := property.AddressKeyValidator(v); err != nil {
+			return &ValidationError{Name: "address_key", err: fmt.Errorf(`ent: validator failed for field "Property.address_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.City(); !ok {
+		return &ValidationError{Name: "city", err: errors.New(`ent: missing required field "Property.city"`)}
+	}
+	if v, ok := _c.mutation.City(); ok {
+		if err := property.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Property.city": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Property.state"`)}
+	}
+	if v, ok := _c.mutation.State(); ok {
+		if err := property.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Property.state": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ZipCode(); !ok {
+		return &ValidationError{Name: "zip_code", err: errors.New(`ent: missing required field "Property.zip_code"`)}
+	}
+	if v, ok := _c.mutation.ZipCode(); ok {
+		if err := property.ZipCodeValidator(v); err != nil {
+			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Property.zip_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Country(); !ok {
+		return &ValidationError{Name: "country", err: errors.New(`ent: missing required field "Property.country"`)}
+	}
+	if v, ok := _c.mutation.Country(); ok {
+		if err := property.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "Property.country": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TypeOfProperty(); !ok {
+		return &ValidationError{Name: "type_of_property", err: errors.New(`ent: missing required field "Property.type_of_property"`)}
+	}
+	if v, ok := _c.mutation.TypeOfProperty(); ok {
+		if err := property.TypeOfPropertyValidator(v); err != nil {
+			return &ValidationError{Name: "type_of_property", err: fmt.Errorf(`ent: validator failed for field "Property.type_of_property": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Bedroom(); !ok {
+		return &ValidationError{Name: "bedroom", err: errors.New(`ent: missing required field "Property.bedroom"`)}
+	}
+	if v, ok := _c.mutation.Bedroom(); ok {
+		if err := property.BedroomValidator(v); err != nil {
+			return &ValidationError{Name: "bedroom", err: fmt.Errorf(`ent: validator failed for field "Property.bedroom": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Bathroom(); !ok {
+		return &ValidationError{Name: "bathroom", err: errors.New(`ent: missing required field "Property.bathroom"`)}
+	}
+	if v, ok := _c.mutation.Bathroom(); ok {
+		if err := property.BathroomValidator(v); err != nil {
+			return &ValidationError{Name: "bathroom", err: fmt.Errorf(`ent: validator failed for field "Property.bathroom": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Garage(); ok {
+		if err := property.GarageValidator(v); err != nil {
+			return &ValidationError{Name: "garage", err: fmt.Errorf(`ent: validator failed for field "Property.garage": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Sqft(); !ok {
+		return &ValidationError{Name: "sqft", err: errors.New(`ent: missing required field "Property.sqft"`)}
+	}
+	if v, ok := _c.mutation.Sqft(); ok {
+		if err := property.SqftValidator(v); err != nil {
+			return &ValidationError{Name: "sqft", err: fmt.Errorf(`ent: validator failed for field "Property.sqft": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Sqm(); ok {
+		if err := property.SqmValidator(v); err != nil {
+			return &ValidationError{Name: "sqm", err: fmt.Errorf(`ent: validator failed for field "Property.sqm": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LotSize(); ok {
+		if err := property.LotSizeValidator(v); err != nil {
+			return &ValidationError{Name: "lot_size", err: fmt.Errorf(`ent: validator failed for field "Property.lot_size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.YearBuilt(); !ok {
+		return &ValidationError{Name: "year_built", err: errors.New(`ent: missing required field "Property.year_built"`)}
+	}
+	if v, ok := _c.mutation.YearBuilt(); ok {
+		if err := property.YearBuiltValidator(v); err != nil {
+			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Property.year_built": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PropertyCreate) sqlSave(ctx context.Context) (*Property, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PropertyCreate) createSpec() (*Property, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Property{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(property.Table, sqlgraph.NewFieldSpec(property.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(property.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(property.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(property.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := _c.mutation.AddressKey(); ok {
+		_spec.SetField(property.FieldAddressKey, field.TypeString, value)
+		_node.AddressKey = value
+	}
+	if value, ok := _c.mutation.City(); ok {
+		_spec.SetField(property.FieldCity, field.TypeString, value)
+		_node.City = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(property.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.ZipCode(); ok {
+		_spec.SetField(property.FieldZipCode, field.TypeString, value)
+		_node.ZipCode = value
+	}
+	if value, ok := _c.mutation.Country(); ok {
+		_spec.SetField(property.FieldCountry, field.TypeString, value)
+		_node.Country = value
+	}
+	if value, ok := _c.mutation.TypeOfProperty(); ok {
+		_spec.SetField(property.FieldTypeOfProperty, field.TypeEnum, value)
+		_node.TypeOfProperty = value
+	}
+	if value, ok := _c.mutation.Bedroom(); ok {
+		_spec.SetField(property.FieldBedroom, field.TypeInt, value)
+		_node.Bedroom = value
+	}
+	if value, ok := _c.mutation.Bathroom(); ok {
+		_spec.SetField(property.FieldBathroom, field.TypeFloat64, value)
+		_node.Bathroom = value
+	}
+	if value, ok := _c.mutation.Garage(); ok {
+		_spec.SetField(property.FieldGarage, field.TypeInt, value)
+		_node.Garage = value
+	}
+	if value, ok := _c.mutation.Sqft(); ok {
+		_spec.SetField(property.FieldSqft, field.TypeInt, value)
+		_node.Sqft = value
+	}
+	if value, ok := _c.mutation.Sqm(); ok {
+		_spec.SetField(property.FieldSqm, field.TypeInt, value)
+		_node.Sqm = value
+	}
+	if value, ok := _c.mutation.LotSize(); ok {
+		_spec.SetField(property.FieldLotSize, field.TypeInt, value)
+		_node.LotSize = value
+	}
+	if value, ok := _c.mutation.Pool(); ok {
+		_spec.SetField(property.FieldPool, field.TypeBool, value)
+		_node.Pool = value
+	}
+	if value, ok := _c.mutation.YearBuilt(); ok {
+		_spec.SetField(property.FieldYearBuilt, field.TypeInt, value)
+		_node.YearBuilt = value
+	}
+	if nodes := _c.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   property.ListingsTable,
+			Columns: []string{property.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Property.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PropertyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PropertyCreate) OnConflict(opts ...sql.ConflictOption) *PropertyUpsertOne {
+	_c.conflict = opts
+	return &PropertyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Property.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PropertyCreate) OnConflictColumns(columns ...string) *PropertyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PropertyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PropertyUpsertOne is the builder for "upsert"-ing
+	//  one Property node.
+	PropertyUpsertOne struct {
+		create *PropertyCreate
+	}
+
+	// PropertyUpsert is the "OnConflict" setter.
+	PropertyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PropertyUpsert) SetUpdateTime(v time.Time) *PropertyUpsert {
+	u.Set(property.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateUpdateTime() *PropertyUpsert {
+	u.SetExcluded(property.FieldUpdateTime)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *PropertyUpsert) SetAddress(v string) *PropertyUpsert {
+	u.Set(property.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateAddress() *PropertyUpsert {
+	u.SetExcluded(property.FieldAddress)
+	return u
+}
+
+// SetAddressKey sets the "address_key" field.
+func (u *PropertyUpsert) SetAddressKey(v string) *PropertyUpsert {
+	u.Set(property.FieldAddressKey, v)
+	return u
+}
+
+// UpdateAddressKey sets the "address_key" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateAddressKey() *PropertyUpsert {
+	u.SetExcluded(property.FieldAddressKey)
+	return u
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (u *PropertyUpsert) ClearAddressKey() *PropertyUpsert {
+	u.SetNull(property.FieldAddressKey)
+	return u
+}
+
+// SetCity sets the "city" field.
+func (u *PropertyUpsert) SetCity(v string) *PropertyUpsert {
+	u.Set(property.FieldCity, v)
+	return u
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateCity() *PropertyUpsert {
+	u.SetExcluded(property.FieldCity)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *PropertyUpsert) SetState(v string) *PropertyUpsert {
+	u.Set(property.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateState() *PropertyUpsert {
+	u.SetExcluded(property.FieldState)
+	return u
+}
+
+// SetZipCode sets the "zip_code" field.
+func (u *PropertyUpsert) SetZipCode(v string) *PropertyUpsert {
+	u.Set(property.FieldZipCode, v)
+	return u
+}
+
+// UpdateZipCode sets the "zip_code" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateZipCode() *PropertyUpsert {
+	u.SetExcluded(property.FieldZipCode)
+	return u
+}
+
+// SetCountry sets the "country" field.
+func (u *PropertyUpsert) SetCountry(v string) *PropertyUpsert {
+	u.Set(property.FieldCountry, v)
+	return u
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateCountry() *PropertyUpsert {
+	u.SetExcluded(property.FieldCountry)
+	return u
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (u *PropertyUpsert) SetTypeOfProperty(v property.TypeOfProperty) *PropertyUpsert {
+	u.Set(property.FieldTypeOfProperty, v)
+	return u
+}
+
+// UpdateTypeOfProperty sets the "type_of_property" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateTypeOfProperty() *PropertyUpsert {
+	u.SetExcluded(property.FieldTypeOfProperty)
+	return u
+}
+
+// SetBedroom sets the "bedroom" field.
+func (u *PropertyUpsert) SetBedroom(v int) *PropertyUpsert {
+	u.Set(property.FieldBedroom, v)
+	return u
+}
+
+// UpdateBedroom sets the "bedroom" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateBedroom() *PropertyUpsert {
+	u.SetExcluded(property.FieldBedroom)
+	return u
+}
+
+// AddBedroom adds v to the "bedroom" field.
+func (u *PropertyUpsert) AddBedroom(v int) *PropertyUpsert {
+	u.Add(property.FieldBedroom, v)
+	return u
+}
+
+// SetBathroom sets the "bathroom" field.
+func (u *PropertyUpsert) SetBathroom(v float64) *PropertyUpsert {
+	u.Set(property.FieldBathroom, v)
+	return u
+}
+
+// UpdateBathroom sets the "bathroom" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateBathroom() *PropertyUpsert {
+	u.SetExcluded(property.FieldBathroom)
+	return u
+}
+
+// AddBathroom adds v to the "bathroom" field.
+func (u *PropertyUpsert) AddBathroom(v float64) *PropertyUpsert {
+	u.Add(property.FieldBathroom, v)
+	return u
+}
+
+// SetGarage sets the "garage" field.
+func (u *PropertyUpsert) SetGarage(v int) *PropertyUpsert {
+	u.Set(property.FieldGarage, v)
+	return u
+}
+
+// UpdateGarage sets the "garage" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateGarage() *PropertyUpsert {
+	u.SetExcluded(property.FieldGarage)
+	return u
+}
+
+// AddGarage adds v to the "garage" field.
+func (u *PropertyUpsert) AddGarage(v int) *PropertyUpsert {
+	u.Add(property.FieldGarage, v)
+	return u
+}
+
+// ClearGarage clears the value of the "garage" field.
+func (u *PropertyUpsert) ClearGarage() *PropertyUpsert {
+	u.SetNull(property.FieldGarage)
+	return u
+}
+
+// SetSqft sets the "sqft" field.
+func (u *PropertyUpsert) SetSqft(v int) *PropertyUpsert {
+	u.Set(property.FieldSqft, v)
+	return u
+}
+
+// UpdateSqft sets the "sqft" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateSqft() *PropertyUpsert {
+	u.SetExcluded(property.FieldSqft)
+	return u
+}
+
+// AddSqft adds v to the "sqft" field.
+func (u *PropertyUpsert) AddSqft(v int) *PropertyUpsert {
+	u.Add(property.FieldSqft, v)
+	return u
+}
+
+// SetSqm sets the "sqm" field.
+func (u *PropertyUpsert) SetSqm(v int) *PropertyUpsert {
+	u.Set(property.FieldSqm, v)
+	return u
+}
+
+// UpdateSqm sets the "sqm" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateSqm() *PropertyUpsert {
+	u.SetExcluded(property.FieldSqm)
+	return u
+}
+
+// AddSqm adds v to the "sqm" field.
+func (u *PropertyUpsert) AddSqm(v int) *PropertyUpsert {
+	u.Add(property.FieldSqm, v)
+	return u
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (u *PropertyUpsert) ClearSqm() *PropertyUpsert {
+	u.SetNull(property.FieldSqm)
+	return u
+}
+
+// SetLotSize sets the "lot_size" field.
+func (u *PropertyUpsert) SetLotSize(v int) *PropertyUpsert {
+	u.Set(property.FieldLotSize, v)
+	return u
+}
+
+// UpdateLotSize sets the "lot_size" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateLotSize() *PropertyUpsert {
+	u.SetExcluded(property.FieldLotSize)
+	return u
+}
+
+// AddLotSize adds v to the "lot_size" field.
+func (u *PropertyUpsert) AddLotSize(v int) *PropertyUpsert {
+	u.Add(property.FieldLotSize, v)
+	return u
+}
+
+// ClearLotSize clears the value of the "lot_size" field.
+func (u *PropertyUpsert) ClearLotSize() *PropertyUpsert {
+	u.SetNull(property.FieldLotSize)
+	return u
+}
+
+// SetPool sets the "pool" field.
+func (u *PropertyUpsert) SetPool(v bool) *PropertyUpsert {
+	u.Set(property.FieldPool, v)
+	return u
+}
+
+// UpdatePool sets the "pool" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdatePool() *PropertyUpsert {
+	u.SetExcluded(property.FieldPool)
+	return u
+}
+
+// ClearPool clears the value of the "pool" field.
+func (u *PropertyUpsert) ClearPool() *PropertyUpsert {
+	u.SetNull(property.FieldPool)
+	return u
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (u *PropertyUpsert) SetYearBuilt(v int) *PropertyUpsert {
+	u.Set(property.FieldYearBuilt, v)
+	return u
+}
+
+// UpdateYearBuilt sets the "year_built" field to the value that was provided on create.
+func (u *PropertyUpsert) UpdateYearBuilt() *PropertyUpsert {
+	u.SetExcluded(property.FieldYearBuilt)
+	return u
+}
+
+// AddYearBuilt adds v to the "year_built" field.
+func (u *PropertyUpsert) AddYearBuilt(v int) *PropertyUpsert {
+	u.Add(property.FieldYearBuilt, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Property.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(property.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PropertyUpsertOne) UpdateNewValues() *PropertyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(property.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(property.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Property.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PropertyUpsertOne) Ignore() *PropertyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PropertyUpsertOne) DoNothing() *PropertyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PropertyCreate.OnConflict
+// documentation for more info.
+func (u *PropertyUpsertOne) Update(set func(*PropertyUpsert)) *PropertyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PropertyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PropertyUpsertOne) SetUpdateTime(v time.Time) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateUpdateTime() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *PropertyUpsertOne) SetAddress(v string) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateAddress() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetAddressKey sets the "address_key" field.
+func (u *PropertyUpsertOne) SetAddressKey(v string) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetAddressKey(v)
+	})
+}
+
+// UpdateAddressKey sets the "address_key" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateAddressKey() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateAddressKey()
+	})
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (u *PropertyUpsertOne) ClearAddressKey() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.ClearAddressKey()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *PropertyUpsertOne) SetCity(v string) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateCity() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *PropertyUpsertOne) SetState(v string) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateState() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetZipCode sets the "zip_code" field.
+func (u *PropertyUpsertOne) SetZipCode(v string) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetZipCode(v)
+	})
+}
+
+// UpdateZipCode sets the "zip_code" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateZipCode() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateZipCode()
+	})
+}
+
+// SetCountry sets the "country" field.
+func (u *PropertyUpsertOne) SetCountry(v string) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateCountry() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (u *PropertyUpsertOne) SetTypeOfProperty(v property.TypeOfProperty) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetTypeOfProperty(v)
+	})
+}
+
+// UpdateTypeOfProperty sets the "type_of_property" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateTypeOfProperty() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateTypeOfProperty()
+	})
+}
+
+// SetBedroom sets the "bedroom" field.
+func (u *PropertyUpsertOne) SetBedroom(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetBedroom(v)
+	})
+}
+
+// AddBedroom adds v to the "bedroom" field.
+func (u *PropertyUpsertOne) AddBedroom(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddBedroom(v)
+	})
+}
+
+// UpdateBedroom sets the "bedroom" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateBedroom() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateBedroom()
+	})
+}
+
+// SetBathroom sets the "bathroom" field.
+func (u *PropertyUpsertOne) SetBathroom(v float64) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetBathroom(v)
+	})
+}
+
+// AddBathroom adds v to the "bathroom" field.
+func (u *PropertyUpsertOne) AddBathroom(v float64) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddBathroom(v)
+	})
+}
+
+// UpdateBathroom sets the "bathroom" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateBathroom() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateBathroom()
+	})
+}
+
+// SetGarage sets the "garage" field.
+func (u *PropertyUpsertOne) SetGarage(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetGarage(v)
+	})
+}
+
+// AddGarage adds v to the "garage" field.
+func (u *PropertyUpsertOne) AddGarage(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddGarage(v)
+	})
+}
+
+// UpdateGarage sets the "garage" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateGarage() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateGarage()
+	})
+}
+
+// ClearGarage clears the value of the "garage" field.
+func (u *PropertyUpsertOne) ClearGarage() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.ClearGarage()
+	})
+}
+
+// SetSqft sets the "sqft" field.
+func (u *PropertyUpsertOne) SetSqft(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetSqft(v)
+	})
+}
+
+// AddSqft adds v to the "sqft" field.
+func (u *PropertyUpsertOne) AddSqft(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddSqft(v)
+	})
+}
+
+// UpdateSqft sets the "sqft" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateSqft() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateSqft()
+	})
+}
+
+// SetSqm sets the "sqm" field.
+func (u *PropertyUpsertOne) SetSqm(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetSqm(v)
+	})
+}
+
+// AddSqm adds v to the "sqm" field.
+func (u *PropertyUpsertOne) AddSqm(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddSqm(v)
+	})
+}
+
+// UpdateSqm sets the "sqm" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateSqm() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateSqm()
+	})
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (u *PropertyUpsertOne) ClearSqm() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.ClearSqm()
+	})
+}
+
+// SetLotSize sets the "lot_size" field.
+func (u *PropertyUpsertOne) SetLotSize(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetLotSize(v)
+	})
+}
+
+// AddLotSize adds v to the "lot_size" field.
+func (u *PropertyUpsertOne) AddLotSize(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddLotSize(v)
+	})
+}
+
+// UpdateLotSize sets the "lot_size" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateLotSize() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateLotSize()
+	})
+}
+
+// ClearLotSize clears the value of the "lot_size" field.
+func (u *PropertyUpsertOne) ClearLotSize() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.ClearLotSize()
+	})
+}
+
+// SetPool sets the "pool" field.
+func (u *PropertyUpsertOne) SetPool(v bool) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetPool(v)
+	})
+}
+
+// UpdatePool sets the "pool" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdatePool() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdatePool()
+	})
+}
+
+// ClearPool clears the value of the "pool" field.
+func (u *PropertyUpsertOne) ClearPool() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.ClearPool()
+	})
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (u *PropertyUpsertOne) SetYearBuilt(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetYearBuilt(v)
+	})
+}
+
+// AddYearBuilt adds v to the "year_built" field.
+func (u *PropertyUpsertOne) AddYearBuilt(v int) *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddYearBuilt(v)
+	})
+}
+
+// UpdateYearBuilt sets the "year_built" field to the value that was provided on create.
+func (u *PropertyUpsertOne) UpdateYearBuilt() *PropertyUpsertOne {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateYearBuilt()
+	})
+}
+
+// Exec executes the query.
+func (u *PropertyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PropertyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PropertyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PropertyUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PropertyUpsertOne.ID is not supported by MySQL driver. Use PropertyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PropertyUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PropertyCreateBulk is the builder for creating many Property entities in bulk.
+type PropertyCreateBulk struct {
+	config
+	err      error
+	builders []*PropertyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Property entities in the database.
+func (_c *PropertyCreateBulk) Save(ctx context.Context) ([]*Property, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Property, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PropertyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PropertyCreateBulk) SaveX(ctx context.Context) []*Property {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PropertyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PropertyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Property.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PropertyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PropertyCreateBulk) OnConflict(opts ...sql.ConflictOption) *PropertyUpsertBulk {
+	_c.conflict = opts
+	return &PropertyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Property.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PropertyCreateBulk) OnConflictColumns(columns ...string) *PropertyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PropertyUpsertBulk{
+		create: _c,
+	}
+}
+
+// PropertyUpsertBulk is the builder for "upsert"-ing
+// a bulk of Property nodes.
+type PropertyUpsertBulk struct {
+	create *PropertyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Property.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(property.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PropertyUpsertBulk) UpdateNewValues() *PropertyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(property.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(property.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Property.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PropertyUpsertBulk) Ignore() *PropertyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PropertyUpsertBulk) DoNothing() *PropertyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PropertyCreateBulk.OnConflict
+// documentation for more info.
+func (u *PropertyUpsertBulk) Update(set func(*PropertyUpsert)) *PropertyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PropertyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *PropertyUpsertBulk) SetUpdateTime(v time.Time) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateUpdateTime() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *PropertyUpsertBulk) SetAddress(v string) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateAddress() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// SetAddressKey sets the "address_key" field.
+func (u *PropertyUpsertBulk) SetAddressKey(v string) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetAddressKey(v)
+	})
+}
+
+// UpdateAddressKey sets the "address_key" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateAddressKey() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateAddressKey()
+	})
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (u *PropertyUpsertBulk) ClearAddressKey() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.ClearAddressKey()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *PropertyUpsertBulk) SetCity(v string) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateCity() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *PropertyUpsertBulk) SetState(v string) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateState() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateState()
+	})
+}
+
+// SetZipCode sets the "zip_code" field.
+func (u *PropertyUpsertBulk) SetZipCode(v string) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetZipCode(v)
+	})
+}
+
+// UpdateZipCode sets the "zip_code" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateZipCode() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateZipCode()
+	})
+}
+
+// SetCountry sets the "country" field.
+func (u *PropertyUpsertBulk) SetCountry(v string) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetCountry(v)
+	})
+}
+
+// UpdateCountry sets the "country" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateCountry() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateCountry()
+	})
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (u *PropertyUpsertBulk) SetTypeOfProperty(v property.TypeOfProperty) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetTypeOfProperty(v)
+	})
+}
+
+// UpdateTypeOfProperty sets the "type_of_property" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateTypeOfProperty() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateTypeOfProperty()
+	})
+}
+
+// SetBedroom sets the "bedroom" field.
+func (u *PropertyUpsertBulk) SetBedroom(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetBedroom(v)
+	})
+}
+
+// AddBedroom adds v to the "bedroom" field.
+func (u *PropertyUpsertBulk) AddBedroom(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddBedroom(v)
+	})
+}
+
+// UpdateBedroom sets the "bedroom" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateBedroom() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateBedroom()
+	})
+}
+
+// SetBathroom sets the "bathroom" field.
+func (u *PropertyUpsertBulk) SetBathroom(v float64) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetBathroom(v)
+	})
+}
+
+// AddBathroom adds v to the "bathroom" field.
+func (u *PropertyUpsertBulk) AddBathroom(v float64) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddBathroom(v)
+	})
+}
+
+// UpdateBathroom sets the "bathroom" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateBathroom() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateBathroom()
+	})
+}
+
+// SetGarage sets the "garage" field.
+func (u *PropertyUpsertBulk) SetGarage(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetGarage(v)
+	})
+}
+
+// AddGarage adds v to the "garage" field.
+func (u *PropertyUpsertBulk) AddGarage(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddGarage(v)
+	})
+}
+
+// UpdateGarage sets the "garage" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateGarage() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateGarage()
+	})
+}
+
+// ClearGarage clears the value of the "garage" field.
+func (u *PropertyUpsertBulk) ClearGarage() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.ClearGarage()
+	})
+}
+
+// SetSqft sets the "sqft" field.
+func (u *PropertyUpsertBulk) SetSqft(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetSqft(v)
+	})
+}
+
+// AddSqft adds v to the "sqft" field.
+func (u *PropertyUpsertBulk) AddSqft(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddSqft(v)
+	})
+}
+
+// UpdateSqft sets the "sqft" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateSqft() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateSqft()
+	})
+}
+
+// SetSqm sets the "sqm" field.
+func (u *PropertyUpsertBulk) SetSqm(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetSqm(v)
+	})
+}
+
+// AddSqm adds v to the "sqm" field.
+func (u *PropertyUpsertBulk) AddSqm(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddSqm(v)
+	})
+}
+
+// UpdateSqm sets the "sqm" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateSqm() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateSqm()
+	})
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (u *PropertyUpsertBulk) ClearSqm() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.ClearSqm()
+	})
+}
+
+// SetLotSize sets the "lot_size" field.
+func (u *PropertyUpsertBulk) SetLotSize(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetLotSize(v)
+	})
+}
+
+// AddLotSize adds v to the "lot_size" field.
+func (u *PropertyUpsertBulk) AddLotSize(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddLotSize(v)
+	})
+}
+
+// UpdateLotSize sets the "lot_size" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateLotSize() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateLotSize()
+	})
+}
+
+// ClearLotSize clears the value of the "lot_size" field.
+func (u *PropertyUpsertBulk) ClearLotSize() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.ClearLotSize()
+	})
+}
+
+// SetPool sets the "pool" field.
+func (u *PropertyUpsertBulk) SetPool(v bool) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetPool(v)
+	})
+}
+
+// UpdatePool sets the "pool" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdatePool() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdatePool()
+	})
+}
+
+// ClearPool clears the value of the "pool" field.
+func (u *PropertyUpsertBulk) ClearPool() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.ClearPool()
+	})
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (u *PropertyUpsertBulk) SetYearBuilt(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.SetYearBuilt(v)
+	})
+}
+
+// AddYearBuilt adds v to the "year_built" field.
+func (u *PropertyUpsertBulk) AddYearBuilt(v int) *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.AddYearBuilt(v)
+	})
+}
+
+// UpdateYearBuilt sets the "year_built" field to the value that was provided on create.
+func (u *PropertyUpsertBulk) UpdateYearBuilt() *PropertyUpsertBulk {
+	return u.Update(func(s *PropertyUpsert) {
+		s.UpdateYearBuilt()
+	})
+}
+
+// Exec executes the query.
+func (u *PropertyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PropertyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PropertyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PropertyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/property"
+)
+
+// PropertyUpdate is the builder for updating Property entities.
+type PropertyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PropertyMutation
+}
+
+// Where appends a list predicates to the PropertyUpdate builder.
+func (_u *PropertyUpdate) Where(ps ...predicate.Property) *PropertyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *PropertyUpdate) SetUpdateTime(v time.Time) *PropertyUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *PropertyUpdate) SetAddress(v string) *PropertyUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableAddress(v *string) *PropertyUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetAddressKey sets the "address_key" field.
+func (_u *PropertyUpdate) SetAddressKey(v string) *PropertyUpdate {
+	_u.mutation.SetAddressKey(v)
+	return _u
+}
+
+// SetNillableAddressKey sets the "address_key" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableAddressKey(v *string) *PropertyUpdate {
+	if v != nil {
+		_u.SetAddressKey(*v)
+	}
+	return _u
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (_u *PropertyUpdate) ClearAddressKey() *PropertyUpdate {
+	_u.mutation.ClearAddressKey()
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *PropertyUpdate) SetCity(v string) *PropertyUpdate {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableCity(v *string) *PropertyUpdate {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *PropertyUpdate) SetState(v string) *PropertyUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableState(v *string) *PropertyUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetZipCode sets the "zip_code" field.
+func (_u *PropertyUpdate) SetZipCode(v string) *PropertyUpdate {
+	_u.mutation.SetZipCode(v)
+	return _u
+}
+
+// SetNillableZipCode sets the "zip_code" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableZipCode(v *string) *PropertyUpdate {
+	if v != nil {
+		_u.SetZipCode(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *PropertyUpdate) SetCountry(v string) *PropertyUpdate {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableCountry(v *string) *PropertyUpdate {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (_u *PropertyUpdate) SetTypeOfProperty(v property.TypeOfProperty) *PropertyUpdate {
+	_u.mutation.SetTypeOfProperty(v)
+	return _u
+}
+
+// SetNillableTypeOfProperty sets the "type_of_property" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableTypeOfProperty(v *property.TypeOfProperty) *PropertyUpdate {
+	if v != nil {
+		_u.SetTypeOfProperty(*v)
+	}
+	return _u
+}
+
+// SetBedroom sets the "bedroom" field.
+func (_u *PropertyUpdate) SetBedroom(v int) *PropertyUpdate {
+	_u.mutation.ResetBedroom()
+	_u.mutation.SetBedroom(v)
+	return _u
+}
+
+// SetNillableBedroom sets the "bedroom" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableBedroom(v *int) *PropertyUpdate {
+	if v != nil {
+		_u.SetBedroom(*v)
+	}
+	return _u
+}
+
+// AddBedroom adds value to the "bedroom" field.
+func (_u *PropertyUpdate) AddBedroom(v int) *PropertyUpdate {
+	_u.mutation.AddBedroom(v)
+	return _u
+}
+
+// SetBathroom sets the "bathroom" field.
+func (_u *PropertyUpdate) SetBathroom(v float64) *PropertyUpdate {
+	_u.mutation.ResetBathroom()
+	_u.mutation.SetBathroom(v)
+	return _u
+}
+
+// SetNillableBathroom sets the "bathroom" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableBathroom(v *float64) *PropertyUpdate {
+	if v != nil {
+		_u.SetBathroom(*v)
+	}
+	return _u
+}
+
+// AddBathroom adds value to the "bathroom" field.
+func (_u *PropertyUpdate) AddBathroom(v float64) *PropertyUpdate {
+	_u.mutation.AddBathroom(v)
+	return _u
+}
+
+// SetGarage sets the "garage" field.
+func (_u *PropertyUpdate) SetGarage(v int) *PropertyUpdate {
+	_u.mutation.ResetGarage()
+	_u.mutation.SetGarage(v)
+	return _u
+}
+
+// SetNillableGarage sets the "garage" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableGarage(v *int) *PropertyUpdate {
+	if v != nil {
+		_u.SetGarage(*v)
+	}
+	return _u
+}
+
+// AddGarage adds value to the "garage" field.
+func (_u *PropertyUpdate) AddGarage(v int) *PropertyUpdate {
+	_u.mutation.AddGarage(v)
+	return _u
+}
+
+// ClearGarage clears the value of the "garage" field.
+func (_u *PropertyUpdate) ClearGarage() *PropertyUpdate {
+	_u.mutation.ClearGarage()
+	return _u
+}
+
+// SetSqft sets the "sqft" field.
+func (_u *PropertyUpdate) SetSqft(v int) *PropertyUpdate {
+	_u.mutation.ResetSqft()
+	_u.mutation.SetSqft(v)
+	return _u
+}
+
+// SetNillableSqft sets the "sqft" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableSqft(v *int) *PropertyUpdate {
+	if v != nil {
+		_u.SetSqft(*v)
+	}
+	return _u
+}
+
+// AddSqft adds value to the "sqft" field.
+func (_u *PropertyUpdate) AddSqft(v int) *PropertyUpdate {
+	_u.mutation.AddSqft(v)
+	return _u
+}
+
+// SetSqm sets the "sqm" field.
+func (_u *PropertyUpdate) SetSqm(v int) *PropertyUpdate {
+	_u.mutation.ResetSqm()
+	_u.mutation.SetSqm(v)
+	return _u
+}
+
+// SetNillableSqm sets the "sqm" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableSqm(v *int) *PropertyUpdate {
+	if v != nil {
+		_u.SetSqm(*v)
+	}
+	return _u
+}
+
+// AddSqm adds value to the "sqm" field.
+func (_u *PropertyUpdate) AddSqm(v int) *PropertyUpdate {
+	_u.mutation.AddSqm(v)
+	return _u
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (_u *PropertyUpdate) ClearSqm() *PropertyUpdate {
+	_u.mutation.ClearSqm()
+	return _u
+}
+
+// SetLotSize sets the "lot_size" field.
+func (_u *PropertyUpdate) SetLotSize(v int) *PropertyUpdate {
+	_u.mutation.ResetLotSize()
+	_u.mutation.SetLotSize(v)
+	return _u
+}
+
+// SetNillableLotSize sets the "lot_size" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableLotSize(v *int) *PropertyUpdate {
+	if v != nil {
+		_u.SetLotSize(*v)
+	}
+	return _u
+}
+
+// AddLotSize adds value to the "lot_size" field.
+func (_u *PropertyUpdate) AddLotSize(v int) *PropertyUpdate {
+	_u.mutation.AddLotSize(v)
+	return _u
+}
+
+// ClearLotSize clears the value of the "lot_size" field.
+func (_u *PropertyUpdate) ClearLotSize() *PropertyUpdate {
+	_u.mutation.ClearLotSize()
+	return _u
+}
+
+// SetPool sets the "pool" field.
+func (_u *PropertyUpdate) SetPool(v bool) *PropertyUpdate {
+	_u.mutation.SetPool(v)
+	return _u
+}
+
+// SetNillablePool sets the "pool" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillablePool(v *bool) *PropertyUpdate {
+	if v != nil {
+		_u.SetPool(*v)
+	}
+	return _u
+}
+
+// ClearPool clears the value of the "pool" field.
+func (_u *PropertyUpdate) ClearPool() *PropertyUpdate {
+	_u.mutation.ClearPool()
+	return _u
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (_u *PropertyUpdate) SetYearBuilt(v int) *PropertyUpdate {
+	_u.mutation.ResetYearBuilt()
+	_u.mutation.SetYearBuilt(v)
+	return _u
+}
+
+// SetNillableYearBuilt sets the "year_built" field if the given value is not nil.
+func (_u *PropertyUpdate) SetNillableYearBuilt(v *int) *PropertyUpdate {
+	if v != nil {
+		_u.SetYearBuilt(*v)
+	}
+	return _u
+}
+
+// AddYearBuilt adds value to the "year_built" field.
+func (_u *PropertyUpdate) AddYearBuilt(v int) *PropertyUpdate {
+	_u.mutation.AddYearBuilt(v)
+	return _u
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_u *PropertyUpdate) AddListingIDs(ids ...uuid.UUID) *PropertyUpdate {
+	_u.mutation.AddListingIDs(ids...)
+	return _u
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_u *PropertyUpdate) AddListings(v ...*Listing) *PropertyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListingIDs(ids...)
+}
+
+// Mutation returns the PropertyMutation object of the builder.
+func (_u *PropertyUpdate) Mutation() *PropertyMutation {
+	return _u.mutation
+}
+
+// ClearListings clears all "listings" edges to the Listing entity.
+func (_u *PropertyUpdate) ClearListings() *PropertyUpdate {
+	_u.mutation.ClearListings()
+	return _u
+}
+
+// RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
+func (_u *PropertyUpdate) RemoveListingIDs(ids ...uuid.UUID) *PropertyUpdate {
+	_u.mutation.RemoveListingIDs(ids...)
+	return _u
+}
+
+// RemoveListings removes "listings" edges to Listing entities.
+func (_u *PropertyUpdate) RemoveListings(v ...*Listing) *PropertyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListingIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PropertyUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PropertyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PropertyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PropertyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PropertyUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if property.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized property.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := property.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PropertyUpdate) check() error {
+	if v, ok := _u.mutation.Address(); ok {
+		if err := property.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Property.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressKey(); ok {
+		if err := property.AddressKeyValidator(v); err != nil {
+			return &ValidationError{Name: "address_key", err: fmt.Errorf(`ent: validator failed for field "Property.address_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.City(); ok {
+		if err := property.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Property.city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := property.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Property.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ZipCode(); ok {
+		if err := property.ZipCodeValidator(v); err != nil {
+			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Property.zip_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := property.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "Property.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TypeOfProperty(); ok {
+		if err := property.TypeOfPropertyValidator(v); err != nil {
+			return &ValidationError{Name: "type_of_property", err: fmt.Errorf(`ent: validator failed for field "Property.type_of_property": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bedroom(); ok {
+		if err := property.BedroomValidator(v); err != nil {
+			return &ValidationError{Name: "bedroom", err: fmt.Errorf(`ent: validator failed for field "Property.bedroom": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bathroom(); ok {
+		if err := property.BathroomValidator(v); err != nil {
+			return &ValidationError{Name: "bathroom", err: fmt.Errorf(`ent: validator failed for field "Property.bathroom": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Garage(); ok {
+		if err := property.GarageValidator(v); err != nil {
+			return &ValidationError{Name: "garage", err: fmt.Errorf(`ent: validator failed for field "Property.garage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sqft(); ok {
+		if err := property.SqftValidator(v); err != nil {
+			return &ValidationError{Name: "sqft", err: fmt.Errorf(`ent: validator failed for field "Property.sqft": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sqm(); ok {
+		if err := property.SqmValidator(v); err != nil {
+			return &ValidationError{Name: "sqm", err: fmt.Errorf(`ent: validator failed for field "Property.sqm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LotSize(); ok {
+		if err := property.LotSizeValidator(v); err != nil {
+			return &ValidationError{Name: "lot_size", err: fmt.Errorf(`ent: validator failed for field "Property.lot_size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.YearBuilt(); ok {
+		if err := property.YearBuiltValidator(v); err != nil {
+			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Property.year_built": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PropertyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(property.Table, property.Columns, sqlgraph.NewFieldSpec(property.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(property.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(property.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressKey(); ok {
+		_spec.SetField(property.FieldAddressKey, field.TypeString, value)
+	}
+	if _u.mutation.AddressKeyCleared() {
+		_spec.ClearField(property.FieldAddressKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(property.FieldCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(property.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ZipCode(); ok {
+		_spec.SetField(property.FieldZipCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(property.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TypeOfProperty(); ok {
+		_spec.SetField(property.FieldTypeOfProperty, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Bedroom(); ok {
+		_spec.SetField(property.FieldBedroom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBedroom(); ok {
+		_spec.AddField(property.FieldBedroom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Bathroom(); ok {
+		_spec.SetField(property.FieldBathroom, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBathroom(); ok {
+		_spec.AddField(property.FieldBathroom, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Garage(); ok {
+		_spec.SetField(property.FieldGarage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGarage(); ok {
+		_spec.AddField(property.FieldGarage, field.TypeInt, value)
+	}
+	if _u.mutation.GarageCleared() {
+		_spec.ClearField(property.FieldGarage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Sqft(); ok {
+		_spec.SetField(property.FieldSqft, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSqft(); ok {
+		_spec.AddField(property.FieldSqft, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Sqm(); ok {
+		_spec.SetField(property.FieldSqm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSqm(); ok {
+		_spec.AddField(property.FieldSqm, field.TypeInt, value)
+	}
+	if _u.mutation.SqmCleared() {
+		_spec.ClearField(property.FieldSqm, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LotSize(); ok {
+		_spec.SetField(property.FieldLotSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLotSize(); ok {
+		_spec.AddField(property.FieldLotSize, field.TypeInt, value)
+	}
+	if _u.mutation.LotSizeCleared() {
+		_spec.ClearField(property.FieldLotSize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Pool(); ok {
+		_spec.SetField(property.FieldPool, field.TypeBool, value)
+	}
+	if _u.mutation.PoolCleared() {
+		_spec.ClearField(property.FieldPool, field.TypeBool)
+	}
+	if value, ok := _u.mutation.YearBuilt(); ok {
+		_spec.SetField(property.FieldYearBuilt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedYearBuilt(); ok {
+		_spec.AddField(property.FieldYearBuilt, field.TypeInt, value)
+	}
+	if _u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   property.ListingsTable,
+			Columns: []string{property.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedListingsIDs(); len(nodes) > 0 && !_u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   property.ListingsTable,
+			Columns: []string{property.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   property.ListingsTable,
+			Columns: []string{property.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{property.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PropertyUpdateOne is the builder for updating a single Property entity.
+type PropertyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PropertyMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *PropertyUpdateOne) SetUpdateTime(v time.Time) *PropertyUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *PropertyUpdateOne) SetAddress(v string) *PropertyUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableAddress(v *string) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// SetAddressKey sets the "address_key" field.
+func (_u *PropertyUpdateOne) SetAddressKey(v string) *PropertyUpdateOne {
+	_u.mutation.SetAddressKey(v)
+	return _u
+}
+
+// SetNillableAddressKey sets the "address_key" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableAddressKey(v *string) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetAddressKey(*v)
+	}
+	return _u
+}
+
+// ClearAddressKey clears the value of the "address_key" field.
+func (_u *PropertyUpdateOne) ClearAddressKey() *PropertyUpdateOne {
+	_u.mutation.ClearAddressKey()
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *PropertyUpdateOne) SetCity(v string) *PropertyUpdateOne {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableCity(v *string) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *PropertyUpdateOne) SetState(v string) *PropertyUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableState(v *string) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetZipCode sets the "zip_code" field.
+func (_u *PropertyUpdateOne) SetZipCode(v string) *PropertyUpdateOne {
+	_u.mutation.SetZipCode(v)
+	return _u
+}
+
+// SetNillableZipCode sets the "zip_code" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableZipCode(v *string) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetZipCode(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *PropertyUpdateOne) SetCountry(v string) *PropertyUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableCountry(v *string) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// SetTypeOfProperty sets the "type_of_property" field.
+func (_u *PropertyUpdateOne) SetTypeOfProperty(v property.TypeOfProperty) *PropertyUpdateOne {
+	_u.mutation.SetTypeOfProperty(v)
+	return _u
+}
+
+// SetNillableTypeOfProperty sets the "type_of_property" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableTypeOfProperty(v *property.TypeOfProperty) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetTypeOfProperty(*v)
+	}
+	return _u
+}
+
+// SetBedroom sets the "bedroom" field.
+func (_u *PropertyUpdateOne) SetBedroom(v int) *PropertyUpdateOne {
+	_u.mutation.ResetBedroom()
+	_u.mutation.SetBedroom(v)
+	return _u
+}
+
+// SetNillableBedroom sets the "bedroom" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableBedroom(v *int) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetBedroom(*v)
+	}
+	return _u
+}
+
+// AddBedroom adds value to the "bedroom" field.
+func (_u *PropertyUpdateOne) AddBedroom(v int) *PropertyUpdateOne {
+	_u.mutation.AddBedroom(v)
+	return _u
+}
+
+// SetBathroom sets the "bathroom" field.
+func (_u *PropertyUpdateOne) SetBathroom(v float64) *PropertyUpdateOne {
+	_u.mutation.ResetBathroom()
+	_u.mutation.SetBathroom(v)
+	return _u
+}
+
+// SetNillableBathroom sets the "bathroom" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableBathroom(v *float64) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetBathroom(*v)
+	}
+	return _u
+}
+
+// AddBathroom adds value to the "bathroom" field.
+func (_u *PropertyUpdateOne) AddBathroom(v float64) *PropertyUpdateOne {
+	_u.mutation.AddBathroom(v)
+	return _u
+}
+
+// SetGarage sets the "garage" field.
+func (_u *PropertyUpdateOne) SetGarage(v int) *PropertyUpdateOne {
+	_u.mutation.ResetGarage()
+	_u.mutation.SetGarage(v)
+	return _u
+}
+
+// SetNillableGarage sets the "garage" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableGarage(v *int) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetGarage(*v)
+	}
+	return _u
+}
+
+// AddGarage adds value to the "garage" field.
+func (_u *PropertyUpdateOne) AddGarage(v int) *PropertyUpdateOne {
+	_u.mutation.AddGarage(v)
+	return _u
+}
+
+// ClearGarage clears the value of the "garage" field.
+func (_u *PropertyUpdateOne) ClearGarage() *PropertyUpdateOne {
+	_u.mutation.ClearGarage()
+	return _u
+}
+
+// SetSqft sets the "sqft" field.
+func (_u *PropertyUpdateOne) SetSqft(v int) *PropertyUpdateOne {
+	_u.mutation.ResetSqft()
+	_u.mutation.SetSqft(v)
+	return _u
+}
+
+// SetNillableSqft sets the "sqft" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableSqft(v *int) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetSqft(*v)
+	}
+	return _u
+}
+
+// AddSqft adds value to the "sqft" field.
+func (_u *PropertyUpdateOne) AddSqft(v int) *PropertyUpdateOne {
+	_u.mutation.AddSqft(v)
+	return _u
+}
+
+// SetSqm sets the "sqm" field.
+func (_u *PropertyUpdateOne) SetSqm(v int) *PropertyUpdateOne {
+	_u.mutation.ResetSqm()
+	_u.mutation.SetSqm(v)
+	return _u
+}
+
+// SetNillableSqm sets the "sqm" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableSqm(v *int) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetSqm(*v)
+	}
+	return _u
+}
+
+// AddSqm adds value to the "sqm" field.
+func (_u *PropertyUpdateOne) AddSqm(v int) *PropertyUpdateOne {
+	_u.mutation.AddSqm(v)
+	return _u
+}
+
+// ClearSqm clears the value of the "sqm" field.
+func (_u *PropertyUpdateOne) ClearSqm() *PropertyUpdateOne {
+	_u.mutation.ClearSqm()
+	return _u
+}
+
+// SetLotSize sets the "lot_size" field.
+func (_u *PropertyUpdateOne) SetLotSize(v int) *PropertyUpdateOne {
+	_u.mutation.ResetLotSize()
+	_u.mutation.SetLotSize(v)
+	return _u
+}
+
+// SetNillableLotSize sets the "lot_size" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableLotSize(v *int) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetLotSize(*v)
+	}
+	return _u
+}
+
+// AddLotSize adds value to the "lot_size" field.
+func (_u *PropertyUpdateOne) AddLotSize(v int) *PropertyUpdateOne {
+	_u.mutation.AddLotSize(v)
+	return _u
+}
+
+// ClearLotSize clears the value of the "lot_size" field.
+func (_u *PropertyUpdateOne) ClearLotSize() *PropertyUpdateOne {
+	_u.mutation.ClearLotSize()
+	return _u
+}
+
+// SetPool sets the "pool" field.
+func (_u *PropertyUpdateOne) SetPool(v bool) *PropertyUpdateOne {
+	_u.mutation.SetPool(v)
+	return _u
+}
+
+// SetNillablePool sets the "pool" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillablePool(v *bool) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetPool(*v)
+	}
+	return _u
+}
+
+// ClearPool clears the value of the "pool" field.
+func (_u *PropertyUpdateOne) ClearPool() *PropertyUpdateOne {
+	_u.mutation.ClearPool()
+	return _u
+}
+
+// SetYearBuilt sets the "year_built" field.
+func (_u *PropertyUpdateOne) SetYearBuilt(v int) *PropertyUpdateOne {
+	_u.mutation.ResetYearBuilt()
+	_u.mutation.SetYearBuilt(v)
+	return _u
+}
+
+// SetNillableYearBuilt sets the "year_built" field if the given value is not nil.
+func (_u *PropertyUpdateOne) SetNillableYearBuilt(v *int) *PropertyUpdateOne {
+	if v != nil {
+		_u.SetYearBuilt(*v)
+	}
+	return _u
+}
+
+// AddYearBuilt adds value to the "year_built" field.
+func (_u *PropertyUpdateOne) AddYearBuilt(v int) *PropertyUpdateOne {
+	_u.mutation.AddYearBuilt(v)
+	return _u
+}
+
+// AddListingIDs adds the "listings" edge to the Listing entity by IDs.
+func (_u *PropertyUpdateOne) AddListingIDs(ids ...uuid.UUID) *PropertyUpdateOne {
+	_u.mutation.AddListingIDs(ids...)
+	return _u
+}
+
+// AddListings adds the "listings" edges to the Listing entity.
+func (_u *PropertyUpdateOne) AddListings(v ...*Listing) *PropertyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListingIDs(ids...)
+}
+
+// Mutation returns the PropertyMutation object of the builder.
+func (_u *PropertyUpdateOne) Mutation() *PropertyMutation {
+	return _u.mutation
+}
+
+// ClearListings clears all "listings" edges to the Listing entity.
+func (_u *PropertyUpdateOne) ClearListings() *PropertyUpdateOne {
+	_u.mutation.ClearListings()
+	return _u
+}
+
+// RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
+func (_u *PropertyUpdateOne) RemoveListingIDs(ids ...uuid.UUID) *PropertyUpdateOne {
+	_u.mutation.RemoveListingIDs(ids...)
+	return _u
+}
+
+// RemoveListings removes "listings" edges to Listing entities.
+func (_u *PropertyUpdateOne) RemoveListings(v ...*Listing) *PropertyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListingIDs(ids...)
+}
+
+// Where appends a list predicates to the PropertyUpdate builder.
+func (_u *PropertyUpdateOne) Where(ps ...predicate.Property) *PropertyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PropertyUpdateOne) Select(field string, fields ...string) *PropertyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Property entity.
+func (_u *PropertyUpdateOne) Save(ctx context.Context) (*Property, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PropertyUpdateOne) SaveX(ctx context.Context) *Property {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PropertyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PropertyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PropertyUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if property.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized property.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := property.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PropertyUpdateOne) check() error {
+	if v, ok := _u.mutation.Address(); ok {
+		if err := property.AddressValidator(v); err != nil {
+			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Property.address": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressKey(); ok {
+		if err := property.AddressKeyValidator(v); err != nil {
+			return &ValidationError{Name: "address_key", err: fmt.Errorf(`ent: validator failed for field "Property.address_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.City(); ok {
+		if err := property.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`ent: validator failed for field "Property.city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := property.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Property.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ZipCode(); ok {
+		if err := property.ZipCodeValidator(v); err != nil {
+			return &ValidationError{Name: "zip_code", err: fmt.Errorf(`ent: validator failed for field "Property.zip_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := property.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`ent: validator failed for field "Property.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TypeOfProperty(); ok {
+		if err := property.TypeOfPropertyValidator(v); err != nil {
+			return &ValidationError{Name: "type_of_property", err: fmt.Errorf(`ent: validator failed for field "Property.type_of_property": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bedroom(); ok {
+		if err := property.BedroomValidator(v); err != nil {
+			return &ValidationError{Name: "bedroom", err: fmt.Errorf(`ent: validator failed for field "Property.bedroom": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bathroom(); ok {
+		if err := property.BathroomValidator(v); err != nil {
+			return &ValidationError{Name: "bathroom", err: fmt.Errorf(`ent: validator failed for field "Property.bathroom": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Garage(); ok {
+		if err := property.GarageValidator(v); err != nil {
+			return &ValidationError{Name: "garage", err: fmt.Errorf(`ent: validator failed for field "Property.garage": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sqft(); ok {
+		if err := property.SqftValidator(v); err != nil {
+			return &ValidationError{Name: "sqft", err: fmt.Errorf(`ent: validator failed for field "Property.sqft": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Sqm(); ok {
+		if err := property.SqmValidator(v); err != nil {
+			return &ValidationError{Name: "sqm", err: fmt.Errorf(`ent: validator failed for field "Property.sqm": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LotSize(); ok {
+		if err := property.LotSizeValidator(v); err != nil {
+			return &ValidationError{Name: "lot_size", err: fmt.Errorf(`ent: validator failed for field "Property.lot_size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.YearBuilt(); ok {
+		if err := property.YearBuiltValidator(v); err != nil {
+			return &ValidationError{Name: "year_built", err: fmt.Errorf(`ent: validator failed for field "Property.year_built": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PropertyUpdateOne) sqlSave(ctx context.Context) (_node *Property, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(property.Table, property.Columns, sqlgraph.NewFieldSpec(property.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Property.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, property.FieldID)
+		for _, f := range fields {
+			if !property.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != property.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(property.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(property.FieldAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressKey(); ok {
+		_spec.SetField(property.FieldAddressKey, field.TypeString, value)
+	}
+	if _u.mutation.AddressKeyCleared() {
+		_spec.ClearField(property.FieldAddressKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(property.FieldCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(property.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ZipCode(); ok {
+		_spec.SetField(property.FieldZipCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(property.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TypeOfProperty(); ok {
+		_spec.SetField(property.FieldTypeOfProperty, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Bedroom(); ok {
+		_spec.SetField(property.FieldBedroom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBedroom(); ok {
+		_spec.AddField(property.FieldBedroom, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Bathroom(); ok {
+		_spec.SetField(property.FieldBathroom, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBathroom(); ok {
+		_spec.AddField(property.FieldBathroom, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Garage(); ok {
+		_spec.SetField(property.FieldGarage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGarage(); ok {
+		_spec.AddField(property.FieldGarage, field.TypeInt, value)
+	}
+	if _u.mutation.GarageCleared() {
+		_spec.ClearField(property.FieldGarage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Sqft(); ok {
+		_spec.SetField(property.FieldSqft, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSqft(); ok {
+		_spec.AddField(property.FieldSqft, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Sqm(); ok {
+		_spec.SetField(property.FieldSqm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSqm(); ok {
+		_spec.AddField(property.FieldSqm, field.TypeInt, value)
+	}
+	if _u.mutation.SqmCleared() {
+		_spec.ClearField(property.FieldSqm, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LotSize(); ok {
+		_spec.SetField(property.FieldLotSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLotSize(); ok {
+		_spec.AddField(property.FieldLotSize, field.TypeInt, value)
+	}
+	if _u.mutation.LotSizeCleared() {
+		_spec.ClearField(property.FieldLotSize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Pool(); ok {
+		_spec.SetField(property.FieldPool, field.TypeBool, value)
+	}
+	if _u.mutation.PoolCleared() {
+		_spec.ClearField(property.FieldPool, field.TypeBool)
+	}
+	if value, ok := _u.mutation.YearBuilt(); ok {
+		_spec.SetField(property.FieldYearBuilt, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedYearBuilt(); ok {
+		_spec.AddField(property.FieldYearBuilt, field.TypeInt, value)
+	}
+	if _u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   property.ListingsTable,
+			Columns: []string{property.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedListingsIDs(); len(nodes) > 0 && !_u.mutation.ListingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   property.ListingsTable,
+			Columns: []string{property.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ListingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   property.ListingsTable,
+			Columns: []string{property.ListingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Property{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{property.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
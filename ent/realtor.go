@@ -11,7 +11,11 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/brokerage"
 	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/schematype"
+	"ppgroup.ppgroup.com/ent/team"
+	"ppgroup.ppgroup.com/ent/user"
 )
 
 // Realtor is the model entity for the Realtor schema.
@@ -26,7 +30,7 @@ type Realtor struct {
 	// FullName holds the value of the "full_name" field.
 	FullName string `json:"full_name" validate:"required,min=5,max=100"`
 	// Photo holds the value of the "photo" field.
-	Photo map[string]interface{} `json:"photo,omitempty" validate:"omitempty, json"`
+	Photo *schematype.Photo `json:"photo,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty" validate:"max=500"`
 	// Phone holds the value of the "phone" field.
@@ -37,6 +41,18 @@ type Realtor struct {
 	IsMvp bool `json:"is_mvp"`
 	// HireDate holds the value of the "hire_date" field.
 	HireDate time.Time `json:"hire_date"`
+	// ServiceAreas holds the value of the "service_areas" field.
+	ServiceAreas []string `json:"service_areas,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active"`
+	// DeactivatedAt holds the value of the "deactivated_at" field.
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uuid.UUID `json:"-"`
+	// BrokerageID holds the value of the "brokerage_id" field.
+	BrokerageID *uuid.UUID `json:"brokerage_id,omitempty"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID *uuid.UUID `json:"team_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RealtorQuery when eager-loading is set.
 	Edges        RealtorEdges `json:"edges"`
@@ -47,9 +63,21 @@ type Realtor struct {
 type RealtorEdges struct {
 	// Listings holds the value of the listings edge.
 	Listings []*Listing `json:"listings,omitempty"`
+	// BuyerSideSales holds the value of the buyer_side_sales edge.
+	BuyerSideSales []*Listing `json:"buyer_side_sales,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Invites holds the value of the invites edge.
+	Invites []*RealtorInvite `json:"invites,omitempty"`
+	// Brokerage holds the value of the brokerage edge.
+	Brokerage *Brokerage `json:"brokerage,omitempty"`
+	// Team holds the value of the team edge.
+	Team *Team `json:"team,omitempty"`
+	// LedTeams holds the value of the led_teams edge.
+	LedTeams []*Team `json:"led_teams,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [7]bool
 }
 
 // ListingsOrErr returns the Listings value or an error if the edge
@@ -61,18 +89,80 @@ func (e RealtorEdges) ListingsOrErr() ([]*Listing, error) {
 	return nil, &NotLoadedError{edge: "listings"}
 }
 
+// BuyerSideSalesOrErr returns the BuyerSideSales value or an error if the edge
+// was not loaded in eager-loading.
+func (e RealtorEdges) BuyerSideSalesOrErr() ([]*Listing, error) {
+	if e.loadedTypes[1] {
+		return e.BuyerSideSales, nil
+	}
+	return nil, &NotLoadedError{edge: "buyer_side_sales"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RealtorEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// InvitesOrErr returns the Invites value or an error if the edge
+// was not loaded in eager-loading.
+func (e RealtorEdges) InvitesOrErr() ([]*RealtorInvite, error) {
+	if e.loadedTypes[3] {
+		return e.Invites, nil
+	}
+	return nil, &NotLoadedError{edge: "invites"}
+}
+
+// BrokerageOrErr returns the Brokerage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RealtorEdges) BrokerageOrErr() (*Brokerage, error) {
+	if e.Brokerage != nil {
+		return e.Brokerage, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: brokerage.Label}
+	}
+	return nil, &NotLoadedError{edge: "brokerage"}
+}
+
+// TeamOrErr returns the Team value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RealtorEdges) TeamOrErr() (*Team, error) {
+	if e.Team != nil {
+		return e.Team, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: team.Label}
+	}
+	return nil, &NotLoadedError{edge: "team"}
+}
+
+// LedTeamsOrErr returns the LedTeams value or an error if the edge
+// was not loaded in eager-loading.
+func (e RealtorEdges) LedTeamsOrErr() ([]*Team, error) {
+	if e.loadedTypes[6] {
+		return e.LedTeams, nil
+	}
+	return nil, &NotLoadedError{edge: "led_teams"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Realtor) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case realtor.FieldPhoto:
+		case realtor.FieldUserID, realtor.FieldBrokerageID, realtor.FieldTeamID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case realtor.FieldPhoto, realtor.FieldServiceAreas:
 			values[i] = new([]byte)
-		case realtor.FieldIsMvp:
+		case realtor.FieldIsMvp, realtor.FieldActive:
 			values[i] = new(sql.NullBool)
 		case realtor.FieldFullName, realtor.FieldDescription, realtor.FieldPhone, realtor.FieldEmail:
 			values[i] = new(sql.NullString)
-		case realtor.FieldCreateTime, realtor.FieldUpdateTime, realtor.FieldHireDate:
+		case realtor.FieldCreateTime, realtor.FieldUpdateTime, realtor.FieldHireDate, realtor.FieldDeactivatedAt:
 			values[i] = new(sql.NullTime)
 		case realtor.FieldID:
 			values[i] = new(uuid.UUID)
@@ -85,7 +175,7 @@ func (*Realtor) scanValues(columns []string) ([]any, error) {
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
 // to the Realtor fields.
-func (r *Realtor) assignValues(columns []string, values []any) error {
+func (_m *Realtor) assignValues(columns []string, values []any) error {
 	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
@@ -95,31 +185,31 @@ func (r *Realtor) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field id", values[i])
 			} else if value != nil {
-				r.ID = *value
+				_m.ID = *value
 			}
 		case realtor.FieldCreateTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field create_time", values[i])
 			} else if value.Valid {
-				r.CreateTime = value.Time
+				_m.CreateTime = value.Time
 			}
 		case realtor.FieldUpdateTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field update_time", values[i])
 			} else if value.Valid {
-				r.UpdateTime = value.Time
+				_m.UpdateTime = value.Time
 			}
 		case realtor.FieldFullName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field full_name", values[i])
 			} else if value.Valid {
-				r.FullName = value.String
+				_m.FullName = value.String
 			}
 		case realtor.FieldPhoto:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field photo", values[i])
 			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &r.Photo); err != nil {
+				if err := json.Unmarshal(*value, &_m.Photo); err != nil {
 					return fmt.Errorf("unmarshal field photo: %w", err)
 				}
 			}
@@ -127,34 +217,76 @@ func (r *Realtor) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
 			} else if value.Valid {
-				r.Description = value.String
+				_m.Description = value.String
 			}
 		case realtor.FieldPhone:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field phone", values[i])
 			} else if value.Valid {
-				r.Phone = value.String
+				_m.Phone = value.String
 			}
 		case realtor.FieldEmail:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field email", values[i])
 			} else if value.Valid {
-				r.Email = value.String
+				_m.Email = value.String
 			}
 		case realtor.FieldIsMvp:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_mvp", values[i])
 			} else if value.Valid {
-				r.IsMvp = value.Bool
+				_m.IsMvp = value.Bool
 			}
 		case realtor.FieldHireDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field hire_date", values[i])
 			} else if value.Valid {
-				r.HireDate = value.Time
+				_m.HireDate = value.Time
+			}
+		case realtor.FieldServiceAreas:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field service_areas", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ServiceAreas); err != nil {
+					return fmt.Errorf("unmarshal field service_areas: %w", err)
+				}
+			}
+		case realtor.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				_m.Active = value.Bool
+			}
+		case realtor.FieldDeactivatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deactivated_at", values[i])
+			} else if value.Valid {
+				_m.DeactivatedAt = new(time.Time)
+				*_m.DeactivatedAt = value.Time
+			}
+		case realtor.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(uuid.UUID)
+				*_m.UserID = *value.S.(*uuid.UUID)
+			}
+		case realtor.FieldBrokerageID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field brokerage_id", values[i])
+			} else if value.Valid {
+				_m.BrokerageID = new(uuid.UUID)
+				*_m.BrokerageID = *value.S.(*uuid.UUID)
+			}
+		case realtor.FieldTeamID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				_m.TeamID = new(uuid.UUID)
+				*_m.TeamID = *value.S.(*uuid.UUID)
 			}
 		default:
-			r.selectValues.Set(columns[i], values[i])
+			_m.selectValues.Set(columns[i], values[i])
 		}
 	}
 	return nil
@@ -162,64 +294,120 @@ func (r *Realtor) assignValues(columns []string, values []any) error {
 
 // Value returns the ent.Value that was dynamically selected and assigned to the Realtor.
 // This includes values selected through modifiers, order, etc.
-func (r *Realtor) Value(name string) (ent.Value, error) {
-	return r.selectValues.Get(name)
+func (_m *Realtor) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
 }
 
 // QueryListings queries the "listings" edge of the Realtor entity.
-func (r *Realtor) QueryListings() *ListingQuery {
-	return NewRealtorClient(r.config).QueryListings(r)
+func (_m *Realtor) QueryListings() *ListingQuery {
+	return NewRealtorClient(_m.config).QueryListings(_m)
+}
+
+// QueryBuyerSideSales queries the "buyer_side_sales" edge of the Realtor entity.
+func (_m *Realtor) QueryBuyerSideSales() *ListingQuery {
+	return NewRealtorClient(_m.config).QueryBuyerSideSales(_m)
+}
+
+// QueryUser queries the "user" edge of the Realtor entity.
+func (_m *Realtor) QueryUser() *UserQuery {
+	return NewRealtorClient(_m.config).QueryUser(_m)
+}
+
+// QueryInvites queries the "invites" edge of the Realtor entity.
+func (_m *Realtor) QueryInvites() *RealtorInviteQuery {
+	return NewRealtorClient(_m.config).QueryInvites(_m)
+}
+
+// QueryBrokerage queries the "brokerage" edge of the Realtor entity.
+func (_m *Realtor) QueryBrokerage() *BrokerageQuery {
+	return NewRealtorClient(_m.config).QueryBrokerage(_m)
+}
+
+// QueryTeam queries the "team" edge of the Realtor entity.
+func (_m *Realtor) QueryTeam() *TeamQuery {
+	return NewRealtorClient(_m.config).QueryTeam(_m)
+}
+
+// QueryLedTeams queries the "led_teams" edge of the Realtor entity.
+func (_m *Realtor) QueryLedTeams() *TeamQuery {
+	return NewRealtorClient(_m.config).QueryLedTeams(_m)
 }
 
 // Update returns a builder for updating this Realtor.
 // Note that you need to call Realtor.Unwrap() before calling this method if this Realtor
 // was returned from a transaction, and the transaction was committed or rolled back.
-func (r *Realtor) Update() *RealtorUpdateOne {
-	return NewRealtorClient(r.config).UpdateOne(r)
+func (_m *Realtor) Update() *RealtorUpdateOne {
+	return NewRealtorClient(_m.config).UpdateOne(_m)
 }
 
 // Unwrap unwraps the Realtor entity that was returned from a transaction after it was closed,
 // so that all future queries will be executed through the driver which created the transaction.
-func (r *Realtor) Unwrap() *Realtor {
-	_tx, ok := r.config.driver.(*txDriver)
+func (_m *Realtor) Unwrap() *Realtor {
+	_tx, ok := _m.config.driver.(*txDriver)
 	if !ok {
 		panic("ent: Realtor is not a transactional entity")
 	}
-	r.config.driver = _tx.drv
-	return r
+	_m.config.driver = _tx.drv
+	return _m
 }
 
 // String implements the fmt.Stringer.
-func (r *Realtor) String() string {
+func (_m *Realtor) String() string {
 	var builder strings.Builder
 	builder.WriteString("Realtor(")
-	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("create_time=")
-	builder.WriteString(r.CreateTime.Format(time.ANSIC))
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("update_time=")
-	builder.WriteString(r.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("full_name=")
-	builder.WriteString(r.FullName)
+	builder.WriteString(_m.FullName)
 	builder.WriteString(", ")
 	builder.WriteString("photo=")
-	builder.WriteString(fmt.Sprintf("%v", r.Photo))
+	builder.WriteString(fmt.Sprintf("%v", _m.Photo))
 	builder.WriteString(", ")
 	builder.WriteString("description=")
-	builder.WriteString(r.Description)
+	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
 	builder.WriteString("phone=")
-	builder.WriteString(r.Phone)
+	builder.WriteString(_m.Phone)
 	builder.WriteString(", ")
 	builder.WriteString("email=")
-	builder.WriteString(r.Email)
+	builder.WriteString(_m.Email)
 	builder.WriteString(", ")
 	builder.WriteString("is_mvp=")
-	builder.WriteString(fmt.Sprintf("%v", r.IsMvp))
+	builder.WriteString(fmt.Sprintf("%v", _m.IsMvp))
 	builder.WriteString(", ")
 	builder.WriteString("hire_date=")
-	builder.WriteString(r.HireDate.Format(time.ANSIC))
+	builder.WriteString(_m.HireDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("service_areas=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ServiceAreas))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Active))
+	builder.WriteString(", ")
+	if v := _m.DeactivatedAt; v != nil {
+		builder.WriteString("deactivated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BrokerageID; v != nil {
+		builder.WriteString("brokerage_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.TeamID; v != nil {
+		builder.WriteString("team_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
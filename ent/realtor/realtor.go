@@ -5,6 +5,7 @@ package realtor
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -33,8 +34,32 @@ const (
 	FieldIsMvp = "is_mvp"
 	// FieldHireDate holds the string denoting the hire_date field in the database.
 	FieldHireDate = "hire_date"
+	// FieldServiceAreas holds the string denoting the service_areas field in the database.
+	FieldServiceAreas = "service_areas"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
+	// FieldDeactivatedAt holds the string denoting the deactivated_at field in the database.
+	FieldDeactivatedAt = "deactivated_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldBrokerageID holds the string denoting the brokerage_id field in the database.
+	FieldBrokerageID = "brokerage_id"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
 	// EdgeListings holds the string denoting the listings edge name in mutations.
 	EdgeListings = "listings"
+	// EdgeBuyerSideSales holds the string denoting the buyer_side_sales edge name in mutations.
+	EdgeBuyerSideSales = "buyer_side_sales"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeInvites holds the string denoting the invites edge name in mutations.
+	EdgeInvites = "invites"
+	// EdgeBrokerage holds the string denoting the brokerage edge name in mutations.
+	EdgeBrokerage = "brokerage"
+	// EdgeTeam holds the string denoting the team edge name in mutations.
+	EdgeTeam = "team"
+	// EdgeLedTeams holds the string denoting the led_teams edge name in mutations.
+	EdgeLedTeams = "led_teams"
 	// Table holds the table name of the realtor in the database.
 	Table = "realtors"
 	// ListingsTable is the table that holds the listings relation/edge.
@@ -44,6 +69,48 @@ const (
 	ListingsInverseTable = "listings"
 	// ListingsColumn is the table column denoting the listings relation/edge.
 	ListingsColumn = "realtor_id"
+	// BuyerSideSalesTable is the table that holds the buyer_side_sales relation/edge.
+	BuyerSideSalesTable = "listings"
+	// BuyerSideSalesInverseTable is the table name for the Listing entity.
+	// It exists in this package in order to avoid circular dependency with the "listing" package.
+	BuyerSideSalesInverseTable = "listings"
+	// BuyerSideSalesColumn is the table column denoting the buyer_side_sales relation/edge.
+	BuyerSideSalesColumn = "buyer_realtor_id"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "realtors"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// InvitesTable is the table that holds the invites relation/edge.
+	InvitesTable = "realtor_invites"
+	// InvitesInverseTable is the table name for the RealtorInvite entity.
+	// It exists in this package in order to avoid circular dependency with the "realtorinvite" package.
+	InvitesInverseTable = "realtor_invites"
+	// InvitesColumn is the table column denoting the invites relation/edge.
+	InvitesColumn = "realtor_id"
+	// BrokerageTable is the table that holds the brokerage relation/edge.
+	BrokerageTable = "realtors"
+	// BrokerageInverseTable is the table name for the Brokerage entity.
+	// It exists in this package in order to avoid circular dependency with the "brokerage" package.
+	BrokerageInverseTable = "brokerages"
+	// BrokerageColumn is the table column denoting the brokerage relation/edge.
+	BrokerageColumn = "brokerage_id"
+	// TeamTable is the table that holds the team relation/edge.
+	TeamTable = "realtors"
+	// TeamInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	TeamInverseTable = "teams"
+	// TeamColumn is the table column denoting the team relation/edge.
+	TeamColumn = "team_id"
+	// LedTeamsTable is the table that holds the led_teams relation/edge.
+	LedTeamsTable = "teams"
+	// LedTeamsInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	LedTeamsInverseTable = "teams"
+	// LedTeamsColumn is the table column denoting the led_teams relation/edge.
+	LedTeamsColumn = "lead_id"
 )
 
 // Columns holds all SQL columns for realtor fields.
@@ -58,6 +125,12 @@ var Columns = []string{
 	FieldEmail,
 	FieldIsMvp,
 	FieldHireDate,
+	FieldServiceAreas,
+	FieldActive,
+	FieldDeactivatedAt,
+	FieldUserID,
+	FieldBrokerageID,
+	FieldTeamID,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -70,7 +143,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "ppgroup.ppgroup.com/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -89,6 +169,8 @@ var (
 	DefaultIsMvp bool
 	// DefaultHireDate holds the default value on creation for the "hire_date" field.
 	DefaultHireDate func() time.Time
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -141,6 +223,31 @@ func ByHireDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHireDate, opts...).ToFunc()
 }
 
+// ByActive orders the results by the active field.
+func ByActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActive, opts...).ToFunc()
+}
+
+// ByDeactivatedAt orders the results by the deactivated_at field.
+func ByDeactivatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeactivatedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByBrokerageID orders the results by the brokerage_id field.
+func ByBrokerageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBrokerageID, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
 // ByListingsCount orders the results by listings count.
 func ByListingsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -154,6 +261,69 @@ func ByListings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newListingsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByBuyerSideSalesCount orders the results by buyer_side_sales count.
+func ByBuyerSideSalesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBuyerSideSalesStep(), opts...)
+	}
+}
+
+// ByBuyerSideSales orders the results by buyer_side_sales terms.
+func ByBuyerSideSales(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBuyerSideSalesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInvitesCount orders the results by invites count.
+func ByInvitesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitesStep(), opts...)
+	}
+}
+
+// ByInvites orders the results by invites terms.
+func ByInvites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBrokerageField orders the results by brokerage field.
+func ByBrokerageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBrokerageStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTeamField orders the results by team field.
+func ByTeamField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLedTeamsCount orders the results by led_teams count.
+func ByLedTeamsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLedTeamsStep(), opts...)
+	}
+}
+
+// ByLedTeams orders the results by led_teams terms.
+func ByLedTeams(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLedTeamsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newListingsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -161,3 +331,45 @@ func newListingsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ListingsTable, ListingsColumn),
 	)
 }
+func newBuyerSideSalesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BuyerSideSalesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BuyerSideSalesTable, BuyerSideSalesColumn),
+	)
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+	)
+}
+func newInvitesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+	)
+}
+func newBrokerageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BrokerageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BrokerageTable, BrokerageColumn),
+	)
+}
+func newTeamStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+	)
+}
+func newLedTeamsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LedTeamsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LedTeamsTable, LedTeamsColumn),
+	)
+}
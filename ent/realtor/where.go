@@ -96,6 +96,31 @@ func HireDate(v time.Time) predicate.Realtor {
 	return predicate.Realtor(sql.FieldEQ(FieldHireDate, v))
 }
 
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.Realtor {
+	return predicate.Realtor(sql.FieldEQ(FieldActive, v))
+}
+
+// DeactivatedAt applies equality check predicate on the "deactivated_at" field. It's identical to DeactivatedAtEQ.
+func DeactivatedAt(v time.Time) predicate.Realtor {
+	return predicate.Realtor(sql.FieldEQ(FieldDeactivatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldEQ(FieldUserID, v))
+}
+
+// BrokerageID applies equality check predicate on the "brokerage_id" field. It's identical to BrokerageIDEQ.
+func BrokerageID(v uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldEQ(FieldBrokerageID, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldEQ(FieldTeamID, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Realtor {
 	return predicate.Realtor(sql.FieldEQ(FieldCreateTime, v))
@@ -506,6 +531,166 @@ func HireDateLTE(v time.Time) predicate.Realtor {
 	return predicate.Realtor(sql.FieldLTE(FieldHireDate, v))
 }
 
+// ServiceAreasIsNil applies the IsNil predicate on the "service_areas" field.
+func ServiceAreasIsNil() predicate.Realtor {
+	return predicate.Realtor(sql.FieldIsNull(FieldServiceAreas))
+}
+
+// ServiceAreasNotNil applies the NotNil predicate on the "service_areas" field.
+func ServiceAreasNotNil() predicate.Realtor {
+	return predicate.Realtor(sql.FieldNotNull(FieldServiceAreas))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.Realtor {
+	return predicate.Realtor(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.Realtor {
+	return predicate.Realtor(sql.FieldNEQ(FieldActive, v))
+}
+
+// DeactivatedAtEQ applies the EQ predicate on the "deactivated_at" field.
+func DeactivatedAtEQ(v time.Time) predicate.Realtor {
+	return predicate.Realtor(sql.FieldEQ(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtNEQ applies the NEQ predicate on the "deactivated_at" field.
+func DeactivatedAtNEQ(v time.Time) predicate.Realtor {
+	return predicate.Realtor(sql.FieldNEQ(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtIn applies the In predicate on the "deactivated_at" field.
+func DeactivatedAtIn(vs ...time.Time) predicate.Realtor {
+	return predicate.Realtor(sql.FieldIn(FieldDeactivatedAt, vs...))
+}
+
+// DeactivatedAtNotIn applies the NotIn predicate on the "deactivated_at" field.
+func DeactivatedAtNotIn(vs ...time.Time) predicate.Realtor {
+	return predicate.Realtor(sql.FieldNotIn(FieldDeactivatedAt, vs...))
+}
+
+// DeactivatedAtGT applies the GT predicate on the "deactivated_at" field.
+func DeactivatedAtGT(v time.Time) predicate.Realtor {
+	return predicate.Realtor(sql.FieldGT(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtGTE applies the GTE predicate on the "deactivated_at" field.
+func DeactivatedAtGTE(v time.Time) predicate.Realtor {
+	return predicate.Realtor(sql.FieldGTE(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtLT applies the LT predicate on the "deactivated_at" field.
+func DeactivatedAtLT(v time.Time) predicate.Realtor {
+	return predicate.Realtor(sql.FieldLT(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtLTE applies the LTE predicate on the "deactivated_at" field.
+func DeactivatedAtLTE(v time.Time) predicate.Realtor {
+	return predicate.Realtor(sql.FieldLTE(FieldDeactivatedAt, v))
+}
+
+// DeactivatedAtIsNil applies the IsNil predicate on the "deactivated_at" field.
+func DeactivatedAtIsNil() predicate.Realtor {
+	return predicate.Realtor(sql.FieldIsNull(FieldDeactivatedAt))
+}
+
+// DeactivatedAtNotNil applies the NotNil predicate on the "deactivated_at" field.
+func DeactivatedAtNotNil() predicate.Realtor {
+	return predicate.Realtor(sql.FieldNotNull(FieldDeactivatedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Realtor {
+	return predicate.Realtor(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Realtor {
+	return predicate.Realtor(sql.FieldNotNull(FieldUserID))
+}
+
+// BrokerageIDEQ applies the EQ predicate on the "brokerage_id" field.
+func BrokerageIDEQ(v uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldEQ(FieldBrokerageID, v))
+}
+
+// BrokerageIDNEQ applies the NEQ predicate on the "brokerage_id" field.
+func BrokerageIDNEQ(v uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldNEQ(FieldBrokerageID, v))
+}
+
+// BrokerageIDIn applies the In predicate on the "brokerage_id" field.
+func BrokerageIDIn(vs ...uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldIn(FieldBrokerageID, vs...))
+}
+
+// BrokerageIDNotIn applies the NotIn predicate on the "brokerage_id" field.
+func BrokerageIDNotIn(vs ...uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldNotIn(FieldBrokerageID, vs...))
+}
+
+// BrokerageIDIsNil applies the IsNil predicate on the "brokerage_id" field.
+func BrokerageIDIsNil() predicate.Realtor {
+	return predicate.Realtor(sql.FieldIsNull(FieldBrokerageID))
+}
+
+// BrokerageIDNotNil applies the NotNil predicate on the "brokerage_id" field.
+func BrokerageIDNotNil() predicate.Realtor {
+	return predicate.Realtor(sql.FieldNotNull(FieldBrokerageID))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...uuid.UUID) predicate.Realtor {
+	return predicate.Realtor(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDIsNil applies the IsNil predicate on the "team_id" field.
+func TeamIDIsNil() predicate.Realtor {
+	return predicate.Realtor(sql.FieldIsNull(FieldTeamID))
+}
+
+// TeamIDNotNil applies the NotNil predicate on the "team_id" field.
+func TeamIDNotNil() predicate.Realtor {
+	return predicate.Realtor(sql.FieldNotNull(FieldTeamID))
+}
+
 // HasListings applies the HasEdge predicate on the "listings" edge.
 func HasListings() predicate.Realtor {
 	return predicate.Realtor(func(s *sql.Selector) {
@@ -529,6 +714,144 @@ func HasListingsWith(preds ...predicate.Listing) predicate.Realtor {
 	})
 }
 
+// HasBuyerSideSales applies the HasEdge predicate on the "buyer_side_sales" edge.
+func HasBuyerSideSales() predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BuyerSideSalesTable, BuyerSideSalesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBuyerSideSalesWith applies the HasEdge predicate on the "buyer_side_sales" edge with a given conditions (other predicates).
+func HasBuyerSideSalesWith(preds ...predicate.Listing) predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := newBuyerSideSalesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvites applies the HasEdge predicate on the "invites" edge.
+func HasInvites() predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitesTable, InvitesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitesWith applies the HasEdge predicate on the "invites" edge with a given conditions (other predicates).
+func HasInvitesWith(preds ...predicate.RealtorInvite) predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := newInvitesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBrokerage applies the HasEdge predicate on the "brokerage" edge.
+func HasBrokerage() predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BrokerageTable, BrokerageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBrokerageWith applies the HasEdge predicate on the "brokerage" edge with a given conditions (other predicates).
+func HasBrokerageWith(preds ...predicate.Brokerage) predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := newBrokerageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTeam applies the HasEdge predicate on the "team" edge.
+func HasTeam() predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TeamTable, TeamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamWith applies the HasEdge predicate on the "team" edge with a given conditions (other predicates).
+func HasTeamWith(preds ...predicate.Team) predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := newTeamStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLedTeams applies the HasEdge predicate on the "led_teams" edge.
+func HasLedTeams() predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LedTeamsTable, LedTeamsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLedTeamsWith applies the HasEdge predicate on the "led_teams" edge with a given conditions (other predicates).
+func HasLedTeamsWith(preds ...predicate.Team) predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		step := newLedTeamsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Realtor) predicate.Realtor {
 	return predicate.Realtor(sql.AndPredicates(predicates...))
@@ -8,11 +8,18 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/brokerage"
 	"ppgroup.ppgroup.com/ent/listing"
 	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+	"ppgroup.ppgroup.com/ent/schematype"
+	"ppgroup.ppgroup.com/ent/team"
+	"ppgroup.ppgroup.com/ent/user"
 )
 
 // RealtorCreate is the builder for creating a Realtor entity.
@@ -20,145 +27,284 @@ type RealtorCreate struct {
 	config
 	mutation *RealtorMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreateTime sets the "create_time" field.
-func (rc *RealtorCreate) SetCreateTime(t time.Time) *RealtorCreate {
-	rc.mutation.SetCreateTime(t)
-	return rc
+func (_c *RealtorCreate) SetCreateTime(v time.Time) *RealtorCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
 }
 
 // SetNillableCreateTime sets the "create_time" field if the given value is not nil.
-func (rc *RealtorCreate) SetNillableCreateTime(t *time.Time) *RealtorCreate {
-	if t != nil {
-		rc.SetCreateTime(*t)
+func (_c *RealtorCreate) SetNillableCreateTime(v *time.Time) *RealtorCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
 	}
-	return rc
+	return _c
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (rc *RealtorCreate) SetUpdateTime(t time.Time) *RealtorCreate {
-	rc.mutation.SetUpdateTime(t)
-	return rc
+func (_c *RealtorCreate) SetUpdateTime(v time.Time) *RealtorCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
 }
 
 // SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
-func (rc *RealtorCreate) SetNillableUpdateTime(t *time.Time) *RealtorCreate {
-	if t != nil {
-		rc.SetUpdateTime(*t)
+func (_c *RealtorCreate) SetNillableUpdateTime(v *time.Time) *RealtorCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
 	}
-	return rc
+	return _c
 }
 
 // SetFullName sets the "full_name" field.
-func (rc *RealtorCreate) SetFullName(s string) *RealtorCreate {
-	rc.mutation.SetFullName(s)
-	return rc
+func (_c *RealtorCreate) SetFullName(v string) *RealtorCreate {
+	_c.mutation.SetFullName(v)
+	return _c
 }
 
 // SetPhoto sets the "photo" field.
-func (rc *RealtorCreate) SetPhoto(m map[string]interface{}) *RealtorCreate {
-	rc.mutation.SetPhoto(m)
-	return rc
+func (_c *RealtorCreate) SetPhoto(v *schematype.Photo) *RealtorCreate {
+	_c.mutation.SetPhoto(v)
+	return _c
 }
 
 // SetDescription sets the "description" field.
-func (rc *RealtorCreate) SetDescription(s string) *RealtorCreate {
-	rc.mutation.SetDescription(s)
-	return rc
+func (_c *RealtorCreate) SetDescription(v string) *RealtorCreate {
+	_c.mutation.SetDescription(v)
+	return _c
 }
 
 // SetNillableDescription sets the "description" field if the given value is not nil.
-func (rc *RealtorCreate) SetNillableDescription(s *string) *RealtorCreate {
-	if s != nil {
-		rc.SetDescription(*s)
+func (_c *RealtorCreate) SetNillableDescription(v *string) *RealtorCreate {
+	if v != nil {
+		_c.SetDescription(*v)
 	}
-	return rc
+	return _c
 }
 
 // SetPhone sets the "phone" field.
-func (rc *RealtorCreate) SetPhone(s string) *RealtorCreate {
-	rc.mutation.SetPhone(s)
-	return rc
+func (_c *RealtorCreate) SetPhone(v string) *RealtorCreate {
+	_c.mutation.SetPhone(v)
+	return _c
 }
 
 // SetEmail sets the "email" field.
-func (rc *RealtorCreate) SetEmail(s string) *RealtorCreate {
-	rc.mutation.SetEmail(s)
-	return rc
+func (_c *RealtorCreate) SetEmail(v string) *RealtorCreate {
+	_c.mutation.SetEmail(v)
+	return _c
 }
 
 // SetIsMvp sets the "is_mvp" field.
-func (rc *RealtorCreate) SetIsMvp(b bool) *RealtorCreate {
-	rc.mutation.SetIsMvp(b)
-	return rc
+func (_c *RealtorCreate) SetIsMvp(v bool) *RealtorCreate {
+	_c.mutation.SetIsMvp(v)
+	return _c
 }
 
 // SetNillableIsMvp sets the "is_mvp" field if the given value is not nil.
-func (rc *RealtorCreate) SetNillableIsMvp(b *bool) *RealtorCreate {
-	if b != nil {
-		rc.SetIsMvp(*b)
+func (_c *RealtorCreate) SetNillableIsMvp(v *bool) *RealtorCreate {
+	if v != nil {
+		_c.SetIsMvp(*v)
 	}
-	return rc
+	return _c
 }
 
 // SetHireDate sets the "hire_date" field.
-func (rc *RealtorCreate) SetHireDate(t time.Time) *RealtorCreate {
-	rc.mutation.SetHireDate(t)
-	return rc
+func (_c *RealtorCreate) SetHireDate(v time.Time) *RealtorCreate {
+	_c.mutation.SetHireDate(v)
+	return _c
 }
 
 // SetNillableHireDate sets the "hire_date" field if the given value is not nil.
-func (rc *RealtorCreate) SetNillableHireDate(t *time.Time) *RealtorCreate {
-	if t != nil {
-		rc.SetHireDate(*t)
+func (_c *RealtorCreate) SetNillableHireDate(v *time.Time) *RealtorCreate {
+	if v != nil {
+		_c.SetHireDate(*v)
 	}
-	return rc
+	return _c
+}
+
+// SetServiceAreas sets the "service_areas" field.
+func (_c *RealtorCreate) SetServiceAreas(v []string) *RealtorCreate {
+	_c.mutation.SetServiceAreas(v)
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *RealtorCreate) SetActive(v bool) *RealtorCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *RealtorCreate) SetNillableActive(v *bool) *RealtorCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (_c *RealtorCreate) SetDeactivatedAt(v time.Time) *RealtorCreate {
+	_c.mutation.SetDeactivatedAt(v)
+	return _c
+}
+
+// SetNillableDeactivatedAt sets the "deactivated_at" field if the given value is not nil.
+func (_c *RealtorCreate) SetNillableDeactivatedAt(v *time.Time) *RealtorCreate {
+	if v != nil {
+		_c.SetDeactivatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *RealtorCreate) SetUserID(v uuid.UUID) *RealtorCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *RealtorCreate) SetNillableUserID(v *uuid.UUID) *RealtorCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetBrokerageID sets the "brokerage_id" field.
+func (_c *RealtorCreate) SetBrokerageID(v uuid.UUID) *RealtorCreate {
+	_c.mutation.SetBrokerageID(v)
+	return _c
+}
+
+// SetNillableBrokerageID sets the "brokerage_id" field if the given value is not nil.
+func (_c *RealtorCreate) SetNillableBrokerageID(v *uuid.UUID) *RealtorCreate {
+	if v != nil {
+		_c.SetBrokerageID(*v)
+	}
+	return _c
+}
+
+// SetTeamID sets the "team_id" field.
+func (_c *RealtorCreate) SetTeamID(v uuid.UUID) *RealtorCreate {
+	_c.mutation.SetTeamID(v)
+	return _c
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_c *RealtorCreate) SetNillableTeamID(v *uuid.UUID) *RealtorCreate {
+	if v != nil {
+		_c.SetTeamID(*v)
+	}
+	return _c
 }
 
 // SetID sets the "id" field.
-func (rc *RealtorCreate) SetID(u uuid.UUID) *RealtorCreate {
-	rc.mutation.SetID(u)
-	return rc
+func (_c *RealtorCreate) SetID(v uuid.UUID) *RealtorCreate {
+	_c.mutation.SetID(v)
+	return _c
 }
 
 // SetNillableID sets the "id" field if the given value is not nil.
-func (rc *RealtorCreate) SetNillableID(u *uuid.UUID) *RealtorCreate {
-	if u != nil {
-		rc.SetID(*u)
+func (_c *RealtorCreate) SetNillableID(v *uuid.UUID) *RealtorCreate {
+	if v != nil {
+		_c.SetID(*v)
 	}
-	return rc
+	return _c
 }
 
 // AddListingIDs adds the "listings" edge to the Listing entity by IDs.
-func (rc *RealtorCreate) AddListingIDs(ids ...uuid.UUID) *RealtorCreate {
-	rc.mutation.AddListingIDs(ids...)
-	return rc
+func (_c *RealtorCreate) AddListingIDs(ids ...uuid.UUID) *RealtorCreate {
+	_c.mutation.AddListingIDs(ids...)
+	return _c
 }
 
 // AddListings adds the "listings" edges to the Listing entity.
-func (rc *RealtorCreate) AddListings(l ...*Listing) *RealtorCreate {
-	ids := make([]uuid.UUID, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+func (_c *RealtorCreate) AddListings(v ...*Listing) *RealtorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return rc.AddListingIDs(ids...)
+	return _c.AddListingIDs(ids...)
+}
+
+// AddBuyerSideSaleIDs adds the "buyer_side_sales" edge to the Listing entity by IDs.
+func (_c *RealtorCreate) AddBuyerSideSaleIDs(ids ...uuid.UUID) *RealtorCreate {
+	_c.mutation.AddBuyerSideSaleIDs(ids...)
+	return _c
+}
+
+// AddBuyerSideSales adds the "buyer_side_sales" edges to the Listing entity.
+func (_c *RealtorCreate) AddBuyerSideSales(v ...*Listing) *RealtorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBuyerSideSaleIDs(ids...)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *RealtorCreate) SetUser(v *User) *RealtorCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// AddInviteIDs adds the "invites" edge to the RealtorInvite entity by IDs.
+func (_c *RealtorCreate) AddInviteIDs(ids ...uuid.UUID) *RealtorCreate {
+	_c.mutation.AddInviteIDs(ids...)
+	return _c
+}
+
+// AddInvites adds the "invites" edges to the RealtorInvite entity.
+func (_c *RealtorCreate) AddInvites(v ...*RealtorInvite) *RealtorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInviteIDs(ids...)
+}
+
+// SetBrokerage sets the "brokerage" edge to the Brokerage entity.
+func (_c *RealtorCreate) SetBrokerage(v *Brokerage) *RealtorCreate {
+	return _c.SetBrokerageID(v.ID)
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (_c *RealtorCreate) SetTeam(v *Team) *RealtorCreate {
+	return _c.SetTeamID(v.ID)
+}
+
+// AddLedTeamIDs adds the "led_teams" edge to the Team entity by IDs.
+func (_c *RealtorCreate) AddLedTeamIDs(ids ...uuid.UUID) *RealtorCreate {
+	_c.mutation.AddLedTeamIDs(ids...)
+	return _c
+}
+
+// AddLedTeams adds the "led_teams" edges to the Team entity.
+func (_c *RealtorCreate) AddLedTeams(v ...*Team) *RealtorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLedTeamIDs(ids...)
 }
 
 // Mutation returns the RealtorMutation object of the builder.
-func (rc *RealtorCreate) Mutation() *RealtorMutation {
-	return rc.mutation
+func (_c *RealtorCreate) Mutation() *RealtorMutation {
+	return _c.mutation
 }
 
 // Save creates the Realtor in the database.
-func (rc *RealtorCreate) Save(ctx context.Context) (*Realtor, error) {
-	rc.defaults()
-	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+func (_c *RealtorCreate) Save(ctx context.Context) (*Realtor, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (rc *RealtorCreate) SaveX(ctx context.Context) *Realtor {
-	v, err := rc.Save(ctx)
+func (_c *RealtorCreate) SaveX(ctx context.Context) *Realtor {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -166,94 +312,114 @@ func (rc *RealtorCreate) SaveX(ctx context.Context) *Realtor {
 }
 
 // Exec executes the query.
-func (rc *RealtorCreate) Exec(ctx context.Context) error {
-	_, err := rc.Save(ctx)
+func (_c *RealtorCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (rc *RealtorCreate) ExecX(ctx context.Context) {
-	if err := rc.Exec(ctx); err != nil {
+func (_c *RealtorCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (rc *RealtorCreate) defaults() {
-	if _, ok := rc.mutation.CreateTime(); !ok {
+func (_c *RealtorCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if realtor.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized realtor.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := realtor.DefaultCreateTime()
-		rc.mutation.SetCreateTime(v)
+		_c.mutation.SetCreateTime(v)
 	}
-	if _, ok := rc.mutation.UpdateTime(); !ok {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if realtor.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized realtor.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := realtor.DefaultUpdateTime()
-		rc.mutation.SetUpdateTime(v)
+		_c.mutation.SetUpdateTime(v)
 	}
-	if _, ok := rc.mutation.IsMvp(); !ok {
+	if _, ok := _c.mutation.IsMvp(); !ok {
 		v := realtor.DefaultIsMvp
-		rc.mutation.SetIsMvp(v)
+		_c.mutation.SetIsMvp(v)
 	}
-	if _, ok := rc.mutation.HireDate(); !ok {
+	if _, ok := _c.mutation.HireDate(); !ok {
+		if realtor.DefaultHireDate == nil {
+			return fmt.Errorf("ent: uninitialized realtor.DefaultHireDate (forgotten import ent/runtime?)")
+		}
 		v := realtor.DefaultHireDate()
-		rc.mutation.SetHireDate(v)
+		_c.mutation.SetHireDate(v)
 	}
-	if _, ok := rc.mutation.ID(); !ok {
+	if _, ok := _c.mutation.Active(); !ok {
+		v := realtor.DefaultActive
+		_c.mutation.SetActive(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if realtor.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized realtor.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := realtor.DefaultID()
-		rc.mutation.SetID(v)
+		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (rc *RealtorCreate) check() error {
-	if _, ok := rc.mutation.CreateTime(); !ok {
+func (_c *RealtorCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
 		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Realtor.create_time"`)}
 	}
-	if _, ok := rc.mutation.UpdateTime(); !ok {
+	if _, ok := _c.mutation.UpdateTime(); !ok {
 		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Realtor.update_time"`)}
 	}
-	if _, ok := rc.mutation.FullName(); !ok {
+	if _, ok := _c.mutation.FullName(); !ok {
 		return &ValidationError{Name: "full_name", err: errors.New(`ent: missing required field "Realtor.full_name"`)}
 	}
-	if v, ok := rc.mutation.FullName(); ok {
+	if v, ok := _c.mutation.FullName(); ok {
 		if err := realtor.FullNameValidator(v); err != nil {
 			return &ValidationError{Name: "full_name", err: fmt.Errorf(`ent: validator failed for field "Realtor.full_name": %w`, err)}
 		}
 	}
-	if v, ok := rc.mutation.Description(); ok {
+	if v, ok := _c.mutation.Description(); ok {
 		if err := realtor.DescriptionValidator(v); err != nil {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Realtor.description": %w`, err)}
 		}
 	}
-	if _, ok := rc.mutation.Phone(); !ok {
+	if _, ok := _c.mutation.Phone(); !ok {
 		return &ValidationError{Name: "phone", err: errors.New(`ent: missing required field "Realtor.phone"`)}
 	}
-	if v, ok := rc.mutation.Phone(); ok {
+	if v, ok := _c.mutation.Phone(); ok {
 		if err := realtor.PhoneValidator(v); err != nil {
 			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Realtor.phone": %w`, err)}
 		}
 	}
-	if _, ok := rc.mutation.Email(); !ok {
+	if _, ok := _c.mutation.Email(); !ok {
 		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "Realtor.email"`)}
 	}
-	if v, ok := rc.mutation.Email(); ok {
+	if v, ok := _c.mutation.Email(); ok {
 		if err := realtor.EmailValidator(v); err != nil {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Realtor.email": %w`, err)}
 		}
 	}
-	if _, ok := rc.mutation.IsMvp(); !ok {
+	if _, ok := _c.mutation.IsMvp(); !ok {
 		return &ValidationError{Name: "is_mvp", err: errors.New(`ent: missing required field "Realtor.is_mvp"`)}
 	}
-	if _, ok := rc.mutation.HireDate(); !ok {
+	if _, ok := _c.mutation.HireDate(); !ok {
 		return &ValidationError{Name: "hire_date", err: errors.New(`ent: missing required field "Realtor.hire_date"`)}
 	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Realtor.active"`)}
+	}
 	return nil
 }
 
-func (rc *RealtorCreate) sqlSave(ctx context.Context) (*Realtor, error) {
-	if err := rc.check(); err != nil {
+func (_c *RealtorCreate) sqlSave(ctx context.Context) (*Realtor, error) {
+	if err := _c.check(); err != nil {
 		return nil, err
 	}
-	_node, _spec := rc.createSpec()
-	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -266,57 +432,70 @@ func (rc *RealtorCreate) sqlSave(ctx context.Context) (*Realtor, error) {
 			return nil, err
 		}
 	}
-	rc.mutation.id = &_node.ID
-	rc.mutation.done = true
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
 	return _node, nil
 }
 
-func (rc *RealtorCreate) createSpec() (*Realtor, *sqlgraph.CreateSpec) {
+func (_c *RealtorCreate) createSpec() (*Realtor, *sqlgraph.CreateSpec) {
 	var (
-		_node = &Realtor{config: rc.config}
+		_node = &Realtor{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(realtor.Table, sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID))
 	)
-	if id, ok := rc.mutation.ID(); ok {
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
-	if value, ok := rc.mutation.CreateTime(); ok {
+	if value, ok := _c.mutation.CreateTime(); ok {
 		_spec.SetField(realtor.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
 	}
-	if value, ok := rc.mutation.UpdateTime(); ok {
+	if value, ok := _c.mutation.UpdateTime(); ok {
 		_spec.SetField(realtor.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
-	if value, ok := rc.mutation.FullName(); ok {
+	if value, ok := _c.mutation.FullName(); ok {
 		_spec.SetField(realtor.FieldFullName, field.TypeString, value)
 		_node.FullName = value
 	}
-	if value, ok := rc.mutation.Photo(); ok {
+	if value, ok := _c.mutation.Photo(); ok {
 		_spec.SetField(realtor.FieldPhoto, field.TypeJSON, value)
 		_node.Photo = value
 	}
-	if value, ok := rc.mutation.Description(); ok {
+	if value, ok := _c.mutation.Description(); ok {
 		_spec.SetField(realtor.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
-	if value, ok := rc.mutation.Phone(); ok {
+	if value, ok := _c.mutation.Phone(); ok {
 		_spec.SetField(realtor.FieldPhone, field.TypeString, value)
 		_node.Phone = value
 	}
-	if value, ok := rc.mutation.Email(); ok {
+	if value, ok := _c.mutation.Email(); ok {
 		_spec.SetField(realtor.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
-	if value, ok := rc.mutation.IsMvp(); ok {
+	if value, ok := _c.mutation.IsMvp(); ok {
 		_spec.SetField(realtor.FieldIsMvp, field.TypeBool, value)
 		_node.IsMvp = value
 	}
-	if value, ok := rc.mutation.HireDate(); ok {
+	if value, ok := _c.mutation.HireDate(); ok {
 		_spec.SetField(realtor.FieldHireDate, field.TypeTime, value)
 		_node.HireDate = value
 	}
-	if nodes := rc.mutation.ListingsIDs(); len(nodes) > 0 {
+	if value, ok := _c.mutation.ServiceAreas(); ok {
+		_spec.SetField(realtor.FieldServiceAreas, field.TypeJSON, value)
+		_node.ServiceAreas = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(realtor.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := _c.mutation.DeactivatedAt(); ok {
+		_spec.SetField(realtor.FieldDeactivatedAt, field.TypeTime, value)
+		_node.DeactivatedAt = &value
+	}
+	if nodes := _c.mutation.ListingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -332,27 +511,697 @@ func (rc *RealtorCreate) createSpec() (*Realtor, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BuyerSideSalesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.BuyerSideSalesTable,
+			Columns: []string{realtor.BuyerSideSalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   realtor.UserTable,
+			Columns: []string{realtor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.InvitesTable,
+			Columns: []string{realtor.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtorinvite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BrokerageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtor.BrokerageTable,
+			Columns: []string{realtor.BrokerageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(brokerage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BrokerageID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtor.TeamTable,
+			Columns: []string{realtor.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LedTeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.LedTeamsTable,
+			Columns: []string{realtor.LedTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Realtor.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RealtorUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RealtorCreate) OnConflict(opts ...sql.ConflictOption) *RealtorUpsertOne {
+	_c.conflict = opts
+	return &RealtorUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Realtor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RealtorCreate) OnConflictColumns(columns ...string) *RealtorUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RealtorUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RealtorUpsertOne is the builder for "upsert"-ing
+	//  one Realtor node.
+	RealtorUpsertOne struct {
+		create *RealtorCreate
+	}
+
+	// RealtorUpsert is the "OnConflict" setter.
+	RealtorUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *RealtorUpsert) SetUpdateTime(v time.Time) *RealtorUpsert {
+	u.Set(realtor.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdateUpdateTime() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldUpdateTime)
+	return u
+}
+
+// SetFullName sets the "full_name" field.
+func (u *RealtorUpsert) SetFullName(v string) *RealtorUpsert {
+	u.Set(realtor.FieldFullName, v)
+	return u
+}
+
+// UpdateFullName sets the "full_name" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdateFullName() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldFullName)
+	return u
+}
+
+// SetPhoto sets the "photo" field.
+func (u *RealtorUpsert) SetPhoto(v *schematype.Photo) *RealtorUpsert {
+	u.Set(realtor.FieldPhoto, v)
+	return u
+}
+
+// UpdatePhoto sets the "photo" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdatePhoto() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldPhoto)
+	return u
+}
+
+// ClearPhoto clears the value of the "photo" field.
+func (u *RealtorUpsert) ClearPhoto() *RealtorUpsert {
+	u.SetNull(realtor.FieldPhoto)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *RealtorUpsert) SetDescription(v string) *RealtorUpsert {
+	u.Set(realtor.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdateDescription() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RealtorUpsert) ClearDescription() *RealtorUpsert {
+	u.SetNull(realtor.FieldDescription)
+	return u
+}
+
+// SetPhone sets the "phone" field.
+func (u *RealtorUpsert) SetPhone(v string) *RealtorUpsert {
+	u.Set(realtor.FieldPhone, v)
+	return u
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdatePhone() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldPhone)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *RealtorUpsert) SetEmail(v string) *RealtorUpsert {
+	u.Set(realtor.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdateEmail() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldEmail)
+	return u
+}
+
+// SetIsMvp sets the "is_mvp" field.
+func (u *RealtorUpsert) SetIsMvp(v bool) *RealtorUpsert {
+	u.Set(realtor.FieldIsMvp, v)
+	return u
+}
+
+// UpdateIsMvp sets the "is_mvp" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdateIsMvp() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldIsMvp)
+	return u
+}
+
+// SetServiceAreas sets the "service_areas" field.
+func (u *RealtorUpsert) SetServiceAreas(v []string) *RealtorUpsert {
+	u.Set(realtor.FieldServiceAreas, v)
+	return u
+}
+
+// UpdateServiceAreas sets the "service_areas" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdateServiceAreas() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldServiceAreas)
+	return u
+}
+
+// ClearServiceAreas clears the value of the "service_areas" field.
+func (u *RealtorUpsert) ClearServiceAreas() *RealtorUpsert {
+	u.SetNull(realtor.FieldServiceAreas)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *RealtorUpsert) SetActive(v bool) *RealtorUpsert {
+	u.Set(realtor.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdateActive() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldActive)
+	return u
+}
+
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (u *RealtorUpsert) SetDeactivatedAt(v time.Time) *RealtorUpsert {
+	u.Set(realtor.FieldDeactivatedAt, v)
+	return u
+}
+
+// UpdateDeactivatedAt sets the "deactivated_at" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdateDeactivatedAt() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldDeactivatedAt)
+	return u
+}
+
+// ClearDeactivatedAt clears the value of the "deactivated_at" field.
+func (u *RealtorUpsert) ClearDeactivatedAt() *RealtorUpsert {
+	u.SetNull(realtor.FieldDeactivatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RealtorUpsert) SetUserID(v uuid.UUID) *RealtorUpsert {
+	u.Set(realtor.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdateUserID() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *RealtorUpsert) ClearUserID() *RealtorUpsert {
+	u.SetNull(realtor.FieldUserID)
+	return u
+}
+
+// SetBrokerageID sets the "brokerage_id" field.
+func (u *RealtorUpsert) SetBrokerageID(v uuid.UUID) *RealtorUpsert {
+	u.Set(realtor.FieldBrokerageID, v)
+	return u
+}
+
+// UpdateBrokerageID sets the "brokerage_id" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdateBrokerageID() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldBrokerageID)
+	return u
+}
+
+// ClearBrokerageID clears the value of the "brokerage_id" field.
+func (u *RealtorUpsert) ClearBrokerageID() *RealtorUpsert {
+	u.SetNull(realtor.FieldBrokerageID)
+	return u
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *RealtorUpsert) SetTeamID(v uuid.UUID) *RealtorUpsert {
+	u.Set(realtor.FieldTeamID, v)
+	return u
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *RealtorUpsert) UpdateTeamID() *RealtorUpsert {
+	u.SetExcluded(realtor.FieldTeamID)
+	return u
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (u *RealtorUpsert) ClearTeamID() *RealtorUpsert {
+	u.SetNull(realtor.FieldTeamID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Realtor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(realtor.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RealtorUpsertOne) UpdateNewValues() *RealtorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(realtor.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(realtor.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.HireDate(); exists {
+			s.SetIgnore(realtor.FieldHireDate)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Realtor.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RealtorUpsertOne) Ignore() *RealtorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RealtorUpsertOne) DoNothing() *RealtorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RealtorCreate.OnConflict
+// documentation for more info.
+func (u *RealtorUpsertOne) Update(set func(*RealtorUpsert)) *RealtorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RealtorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *RealtorUpsertOne) SetUpdateTime(v time.Time) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdateUpdateTime() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetFullName sets the "full_name" field.
+func (u *RealtorUpsertOne) SetFullName(v string) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetFullName(v)
+	})
+}
+
+// UpdateFullName sets the "full_name" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdateFullName() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateFullName()
+	})
+}
+
+// SetPhoto sets the "photo" field.
+func (u *RealtorUpsertOne) SetPhoto(v *schematype.Photo) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetPhoto(v)
+	})
+}
+
+// UpdatePhoto sets the "photo" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdatePhoto() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdatePhoto()
+	})
+}
+
+// ClearPhoto clears the value of the "photo" field.
+func (u *RealtorUpsertOne) ClearPhoto() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearPhoto()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RealtorUpsertOne) SetDescription(v string) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdateDescription() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RealtorUpsertOne) ClearDescription() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *RealtorUpsertOne) SetPhone(v string) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdatePhone() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *RealtorUpsertOne) SetEmail(v string) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdateEmail() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetIsMvp sets the "is_mvp" field.
+func (u *RealtorUpsertOne) SetIsMvp(v bool) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetIsMvp(v)
+	})
+}
+
+// UpdateIsMvp sets the "is_mvp" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdateIsMvp() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateIsMvp()
+	})
+}
+
+// SetServiceAreas sets the "service_areas" field.
+func (u *RealtorUpsertOne) SetServiceAreas(v []string) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetServiceAreas(v)
+	})
+}
+
+// UpdateServiceAreas sets the "service_areas" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdateServiceAreas() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateServiceAreas()
+	})
+}
+
+// ClearServiceAreas clears the value of the "service_areas" field.
+func (u *RealtorUpsertOne) ClearServiceAreas() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearServiceAreas()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *RealtorUpsertOne) SetActive(v bool) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdateActive() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (u *RealtorUpsertOne) SetDeactivatedAt(v time.Time) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetDeactivatedAt(v)
+	})
+}
+
+// UpdateDeactivatedAt sets the "deactivated_at" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdateDeactivatedAt() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateDeactivatedAt()
+	})
+}
+
+// ClearDeactivatedAt clears the value of the "deactivated_at" field.
+func (u *RealtorUpsertOne) ClearDeactivatedAt() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearDeactivatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RealtorUpsertOne) SetUserID(v uuid.UUID) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdateUserID() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *RealtorUpsertOne) ClearUserID() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetBrokerageID sets the "brokerage_id" field.
+func (u *RealtorUpsertOne) SetBrokerageID(v uuid.UUID) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetBrokerageID(v)
+	})
+}
+
+// UpdateBrokerageID sets the "brokerage_id" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdateBrokerageID() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateBrokerageID()
+	})
+}
+
+// ClearBrokerageID clears the value of the "brokerage_id" field.
+func (u *RealtorUpsertOne) ClearBrokerageID() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearBrokerageID()
+	})
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *RealtorUpsertOne) SetTeamID(v uuid.UUID) *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *RealtorUpsertOne) UpdateTeamID() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (u *RealtorUpsertOne) ClearTeamID() *RealtorUpsertOne {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearTeamID()
+	})
+}
+
+// Exec executes the query.
+func (u *RealtorUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RealtorCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RealtorUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RealtorUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RealtorUpsertOne.ID is not supported by MySQL driver. Use RealtorUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RealtorUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // RealtorCreateBulk is the builder for creating many Realtor entities in bulk.
 type RealtorCreateBulk struct {
 	config
 	err      error
 	builders []*RealtorCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Realtor entities in the database.
-func (rcb *RealtorCreateBulk) Save(ctx context.Context) ([]*Realtor, error) {
-	if rcb.err != nil {
-		return nil, rcb.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
-	nodes := make([]*Realtor, len(rcb.builders))
-	mutators := make([]Mutator, len(rcb.builders))
-	for i := range rcb.builders {
+func (_c *RealtorCreateBulk) Save(ctx context.Context) ([]*Realtor, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Realtor, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
 		func(i int, root context.Context) {
-			builder := rcb.builders[i]
+			builder := _c.builders[i]
 			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*RealtorMutation)
@@ -366,11 +1215,12 @@ func (rcb *RealtorCreateBulk) Save(ctx context.Context) ([]*Realtor, error) {
 				var err error
 				nodes[i], specs[i] = builder.createSpec()
 				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
 							err = &ConstraintError{msg: err.Error(), wrap: err}
 						}
@@ -390,7 +1240,7 @@ func (rcb *RealtorCreateBulk) Save(ctx context.Context) ([]*Realtor, error) {
 		}(i, ctx)
 	}
 	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
 			return nil, err
 		}
 	}
@@ -398,8 +1248,8 @@ func (rcb *RealtorCreateBulk) Save(ctx context.Context) ([]*Realtor, error) {
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (rcb *RealtorCreateBulk) SaveX(ctx context.Context) []*Realtor {
-	v, err := rcb.Save(ctx)
+func (_c *RealtorCreateBulk) SaveX(ctx context.Context) []*Realtor {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -407,14 +1257,365 @@ func (rcb *RealtorCreateBulk) SaveX(ctx context.Context) []*Realtor {
 }
 
 // Exec executes the query.
-func (rcb *RealtorCreateBulk) Exec(ctx context.Context) error {
-	_, err := rcb.Save(ctx)
+func (_c *RealtorCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (rcb *RealtorCreateBulk) ExecX(ctx context.Context) {
-	if err := rcb.Exec(ctx); err != nil {
+func (_c *RealtorCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Realtor.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RealtorUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RealtorCreateBulk) OnConflict(opts ...sql.ConflictOption) *RealtorUpsertBulk {
+	_c.conflict = opts
+	return &RealtorUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Realtor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RealtorCreateBulk) OnConflictColumns(columns ...string) *RealtorUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RealtorUpsertBulk{
+		create: _c,
+	}
+}
+
+// RealtorUpsertBulk is the builder for "upsert"-ing
+// a bulk of Realtor nodes.
+type RealtorUpsertBulk struct {
+	create *RealtorCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Realtor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(realtor.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RealtorUpsertBulk) UpdateNewValues() *RealtorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(realtor.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(realtor.FieldCreateTime)
+			}
+			if _, exists := b.mutation.HireDate(); exists {
+				s.SetIgnore(realtor.FieldHireDate)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Realtor.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RealtorUpsertBulk) Ignore() *RealtorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RealtorUpsertBulk) DoNothing() *RealtorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RealtorCreateBulk.OnConflict
+// documentation for more info.
+func (u *RealtorUpsertBulk) Update(set func(*RealtorUpsert)) *RealtorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RealtorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *RealtorUpsertBulk) SetUpdateTime(v time.Time) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdateUpdateTime() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetFullName sets the "full_name" field.
+func (u *RealtorUpsertBulk) SetFullName(v string) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetFullName(v)
+	})
+}
+
+// UpdateFullName sets the "full_name" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdateFullName() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateFullName()
+	})
+}
+
+// SetPhoto sets the "photo" field.
+func (u *RealtorUpsertBulk) SetPhoto(v *schematype.Photo) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetPhoto(v)
+	})
+}
+
+// UpdatePhoto sets the "photo" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdatePhoto() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdatePhoto()
+	})
+}
+
+// ClearPhoto clears the value of the "photo" field.
+func (u *RealtorUpsertBulk) ClearPhoto() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearPhoto()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RealtorUpsertBulk) SetDescription(v string) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdateDescription() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RealtorUpsertBulk) ClearDescription() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetPhone sets the "phone" field.
+func (u *RealtorUpsertBulk) SetPhone(v string) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetPhone(v)
+	})
+}
+
+// UpdatePhone sets the "phone" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdatePhone() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdatePhone()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *RealtorUpsertBulk) SetEmail(v string) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdateEmail() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetIsMvp sets the "is_mvp" field.
+func (u *RealtorUpsertBulk) SetIsMvp(v bool) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetIsMvp(v)
+	})
+}
+
+// UpdateIsMvp sets the "is_mvp" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdateIsMvp() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateIsMvp()
+	})
+}
+
+// SetServiceAreas sets the "service_areas" field.
+func (u *RealtorUpsertBulk) SetServiceAreas(v []string) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetServiceAreas(v)
+	})
+}
+
+// UpdateServiceAreas sets the "service_areas" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdateServiceAreas() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateServiceAreas()
+	})
+}
+
+// ClearServiceAreas clears the value of the "service_areas" field.
+func (u *RealtorUpsertBulk) ClearServiceAreas() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearServiceAreas()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *RealtorUpsertBulk) SetActive(v bool) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdateActive() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (u *RealtorUpsertBulk) SetDeactivatedAt(v time.Time) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetDeactivatedAt(v)
+	})
+}
+
+// UpdateDeactivatedAt sets the "deactivated_at" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdateDeactivatedAt() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateDeactivatedAt()
+	})
+}
+
+// ClearDeactivatedAt clears the value of the "deactivated_at" field.
+func (u *RealtorUpsertBulk) ClearDeactivatedAt() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearDeactivatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RealtorUpsertBulk) SetUserID(v uuid.UUID) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdateUserID() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *RealtorUpsertBulk) ClearUserID() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetBrokerageID sets the "brokerage_id" field.
+func (u *RealtorUpsertBulk) SetBrokerageID(v uuid.UUID) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetBrokerageID(v)
+	})
+}
+
+// UpdateBrokerageID sets the "brokerage_id" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdateBrokerageID() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateBrokerageID()
+	})
+}
+
+// ClearBrokerageID clears the value of the "brokerage_id" field.
+func (u *RealtorUpsertBulk) ClearBrokerageID() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearBrokerageID()
+	})
+}
+
+// SetTeamID sets the "team_id" field.
+func (u *RealtorUpsertBulk) SetTeamID(v uuid.UUID) *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.SetTeamID(v)
+	})
+}
+
+// UpdateTeamID sets the "team_id" field to the value that was provided on create.
+func (u *RealtorUpsertBulk) UpdateTeamID() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.UpdateTeamID()
+	})
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (u *RealtorUpsertBulk) ClearTeamID() *RealtorUpsertBulk {
+	return u.Update(func(s *RealtorUpsert) {
+		s.ClearTeamID()
+	})
+}
+
+// Exec executes the query.
+func (u *RealtorUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RealtorCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RealtorCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RealtorUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -20,56 +20,56 @@ type RealtorDelete struct {
 }
 
 // Where appends a list predicates to the RealtorDelete builder.
-func (rd *RealtorDelete) Where(ps ...predicate.Realtor) *RealtorDelete {
-	rd.mutation.Where(ps...)
-	return rd
+func (_d *RealtorDelete) Where(ps ...predicate.Realtor) *RealtorDelete {
+	_d.mutation.Where(ps...)
+	return _d
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (rd *RealtorDelete) Exec(ctx context.Context) (int, error) {
-	return withHooks(ctx, rd.sqlExec, rd.mutation, rd.hooks)
+func (_d *RealtorDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (rd *RealtorDelete) ExecX(ctx context.Context) int {
-	n, err := rd.Exec(ctx)
+func (_d *RealtorDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
 	if err != nil {
 		panic(err)
 	}
 	return n
 }
 
-func (rd *RealtorDelete) sqlExec(ctx context.Context) (int, error) {
+func (_d *RealtorDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(realtor.Table, sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID))
-	if ps := rd.mutation.predicates; len(ps) > 0 {
+	if ps := _d.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	affected, err := sqlgraph.DeleteNodes(ctx, rd.driver, _spec)
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
 		err = &ConstraintError{msg: err.Error(), wrap: err}
 	}
-	rd.mutation.done = true
+	_d.mutation.done = true
 	return affected, err
 }
 
 // RealtorDeleteOne is the builder for deleting a single Realtor entity.
 type RealtorDeleteOne struct {
-	rd *RealtorDelete
+	_d *RealtorDelete
 }
 
 // Where appends a list predicates to the RealtorDelete builder.
-func (rdo *RealtorDeleteOne) Where(ps ...predicate.Realtor) *RealtorDeleteOne {
-	rdo.rd.mutation.Where(ps...)
-	return rdo
+func (_d *RealtorDeleteOne) Where(ps ...predicate.Realtor) *RealtorDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
 }
 
 // Exec executes the deletion query.
-func (rdo *RealtorDeleteOne) Exec(ctx context.Context) error {
-	n, err := rdo.rd.Exec(ctx)
+func (_d *RealtorDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
 	switch {
 	case err != nil:
 		return err
@@ -81,8 +81,8 @@ func (rdo *RealtorDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (rdo *RealtorDeleteOne) ExecX(ctx context.Context) {
-	if err := rdo.Exec(ctx); err != nil {
+func (_d *RealtorDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
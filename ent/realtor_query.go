@@ -5,71 +5,84 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/brokerage"
 	"ppgroup.ppgroup.com/ent/listing"
 	"ppgroup.ppgroup.com/ent/predicate"
 	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+	"ppgroup.ppgroup.com/ent/team"
+	"ppgroup.ppgroup.com/ent/user"
 )
 
 // RealtorQuery is the builder for querying Realtor entities.
 type RealtorQuery struct {
 	config
-	ctx          *QueryContext
-	order        []realtor.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Realtor
-	withListings *ListingQuery
+	ctx                *QueryContext
+	order              []realtor.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Realtor
+	withListings       *ListingQuery
+	withBuyerSideSales *ListingQuery
+	withUser           *UserQuery
+	withInvites        *RealtorInviteQuery
+	withBrokerage      *BrokerageQuery
+	withTeam           *TeamQuery
+	withLedTeams       *TeamQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
 }
 
 // Where adds a new predicate for the RealtorQuery builder.
-func (rq *RealtorQuery) Where(ps ...predicate.Realtor) *RealtorQuery {
-	rq.predicates = append(rq.predicates, ps...)
-	return rq
+func (_q *RealtorQuery) Where(ps ...predicate.Realtor) *RealtorQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
 }
 
 // Limit the number of records to be returned by this query.
-func (rq *RealtorQuery) Limit(limit int) *RealtorQuery {
-	rq.ctx.Limit = &limit
-	return rq
+func (_q *RealtorQuery) Limit(limit int) *RealtorQuery {
+	_q.ctx.Limit = &limit
+	return _q
 }
 
 // Offset to start from.
-func (rq *RealtorQuery) Offset(offset int) *RealtorQuery {
-	rq.ctx.Offset = &offset
-	return rq
+func (_q *RealtorQuery) Offset(offset int) *RealtorQuery {
+	_q.ctx.Offset = &offset
+	return _q
 }
 
 // Unique configures the query builder to filter duplicate records on query.
 // By default, unique is set to true, and can be disabled using this method.
-func (rq *RealtorQuery) Unique(unique bool) *RealtorQuery {
-	rq.ctx.Unique = &unique
-	return rq
+func (_q *RealtorQuery) Unique(unique bool) *RealtorQuery {
+	_q.ctx.Unique = &unique
+	return _q
 }
 
 // Order specifies how the records should be ordered.
-func (rq *RealtorQuery) Order(o ...realtor.OrderOption) *RealtorQuery {
-	rq.order = append(rq.order, o...)
-	return rq
+func (_q *RealtorQuery) Order(o ...realtor.OrderOption) *RealtorQuery {
+	_q.order = append(_q.order, o...)
+	return _q
 }
 
 // QueryListings chains the current query on the "listings" edge.
-func (rq *RealtorQuery) QueryListings() *ListingQuery {
-	query := (&ListingClient{config: rq.config}).Query()
+func (_q *RealtorQuery) QueryListings() *ListingQuery {
+	query := (&ListingClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
-		if err := rq.prepareQuery(ctx); err != nil {
+		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		selector := rq.sqlQuery(ctx)
+		selector := _q.sqlQuery(ctx)
 		if err := selector.Err(); err != nil {
 			return nil, err
 		}
@@ -78,7 +91,139 @@ func (rq *RealtorQuery) QueryListings() *ListingQuery {
 			sqlgraph.To(listing.Table, listing.FieldID),
 			sqlgraph.Edge(sqlgraph.O2M, false, realtor.ListingsTable, realtor.ListingsColumn),
 		)
-		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBuyerSideSales chains the current query on the "buyer_side_sales" edge.
+func (_q *RealtorQuery) QueryBuyerSideSales() *ListingQuery {
+	query := (&ListingClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, selector),
+			sqlgraph.To(listing.Table, listing.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, realtor.BuyerSideSalesTable, realtor.BuyerSideSalesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (_q *RealtorQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, realtor.UserTable, realtor.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvites chains the current query on the "invites" edge.
+func (_q *RealtorQuery) QueryInvites() *RealtorInviteQuery {
+	query := (&RealtorInviteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, selector),
+			sqlgraph.To(realtorinvite.Table, realtorinvite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, realtor.InvitesTable, realtor.InvitesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBrokerage chains the current query on the "brokerage" edge.
+func (_q *RealtorQuery) QueryBrokerage() *BrokerageQuery {
+	query := (&BrokerageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, selector),
+			sqlgraph.To(brokerage.Table, brokerage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, realtor.BrokerageTable, realtor.BrokerageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTeam chains the current query on the "team" edge.
+func (_q *RealtorQuery) QueryTeam() *TeamQuery {
+	query := (&TeamClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, realtor.TeamTable, realtor.TeamColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLedTeams chains the current query on the "led_teams" edge.
+func (_q *RealtorQuery) QueryLedTeams() *TeamQuery {
+	query := (&TeamClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(realtor.Table, realtor.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, realtor.LedTeamsTable, realtor.LedTeamsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
 	}
 	return query
@@ -86,8 +231,8 @@ func (rq *RealtorQuery) QueryListings() *ListingQuery {
 
 // First returns the first Realtor entity from the query.
 // Returns a *NotFoundError when no Realtor was found.
-func (rq *RealtorQuery) First(ctx context.Context) (*Realtor, error) {
-	nodes, err := rq.Limit(1).All(setContextOp(ctx, rq.ctx, ent.OpQueryFirst))
+func (_q *RealtorQuery) First(ctx context.Context) (*Realtor, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -98,8 +243,8 @@ func (rq *RealtorQuery) First(ctx context.Context) (*Realtor, error) {
 }
 
 // FirstX is like First, but panics if an error occurs.
-func (rq *RealtorQuery) FirstX(ctx context.Context) *Realtor {
-	node, err := rq.First(ctx)
+func (_q *RealtorQuery) FirstX(ctx context.Context) *Realtor {
+	node, err := _q.First(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
 	}
@@ -108,9 +253,9 @@ func (rq *RealtorQuery) FirstX(ctx context.Context) *Realtor {
 
 // FirstID returns the first Realtor ID from the query.
 // Returns a *NotFoundError when no Realtor ID was found.
-func (rq *RealtorQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+func (_q *RealtorQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = rq.Limit(1).IDs(setContextOp(ctx, rq.ctx, ent.OpQueryFirstID)); err != nil {
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -121,8 +266,8 @@ func (rq *RealtorQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
 }
 
 // FirstIDX is like FirstID, but panics if an error occurs.
-func (rq *RealtorQuery) FirstIDX(ctx context.Context) uuid.UUID {
-	id, err := rq.FirstID(ctx)
+func (_q *RealtorQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
 	}
@@ -132,8 +277,8 @@ func (rq *RealtorQuery) FirstIDX(ctx context.Context) uuid.UUID {
 // Only returns a single Realtor entity found by the query, ensuring it only returns one.
 // Returns a *NotSingularError when more than one Realtor entity is found.
 // Returns a *NotFoundError when no Realtor entities are found.
-func (rq *RealtorQuery) Only(ctx context.Context) (*Realtor, error) {
-	nodes, err := rq.Limit(2).All(setContextOp(ctx, rq.ctx, ent.OpQueryOnly))
+func (_q *RealtorQuery) Only(ctx context.Context) (*Realtor, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -148,8 +293,8 @@ func (rq *RealtorQuery) Only(ctx context.Context) (*Realtor, error) {
 }
 
 // OnlyX is like Only, but panics if an error occurs.
-func (rq *RealtorQuery) OnlyX(ctx context.Context) *Realtor {
-	node, err := rq.Only(ctx)
+func (_q *RealtorQuery) OnlyX(ctx context.Context) *Realtor {
+	node, err := _q.Only(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -159,9 +304,9 @@ func (rq *RealtorQuery) OnlyX(ctx context.Context) *Realtor {
 // OnlyID is like Only, but returns the only Realtor ID in the query.
 // Returns a *NotSingularError when more than one Realtor ID is found.
 // Returns a *NotFoundError when no entities are found.
-func (rq *RealtorQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+func (_q *RealtorQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 	var ids []uuid.UUID
-	if ids, err = rq.Limit(2).IDs(setContextOp(ctx, rq.ctx, ent.OpQueryOnlyID)); err != nil {
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -176,8 +321,8 @@ func (rq *RealtorQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
 }
 
 // OnlyIDX is like OnlyID, but panics if an error occurs.
-func (rq *RealtorQuery) OnlyIDX(ctx context.Context) uuid.UUID {
-	id, err := rq.OnlyID(ctx)
+func (_q *RealtorQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -185,18 +330,18 @@ func (rq *RealtorQuery) OnlyIDX(ctx context.Context) uuid.UUID {
 }
 
 // All executes the query and returns a list of Realtors.
-func (rq *RealtorQuery) All(ctx context.Context) ([]*Realtor, error) {
-	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryAll)
-	if err := rq.prepareQuery(ctx); err != nil {
+func (_q *RealtorQuery) All(ctx context.Context) ([]*Realtor, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
 	qr := querierAll[[]*Realtor, *RealtorQuery]()
-	return withInterceptors[[]*Realtor](ctx, rq, qr, rq.inters)
+	return withInterceptors[[]*Realtor](ctx, _q, qr, _q.inters)
 }
 
 // AllX is like All, but panics if an error occurs.
-func (rq *RealtorQuery) AllX(ctx context.Context) []*Realtor {
-	nodes, err := rq.All(ctx)
+func (_q *RealtorQuery) AllX(ctx context.Context) []*Realtor {
+	nodes, err := _q.All(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -204,20 +349,20 @@ func (rq *RealtorQuery) AllX(ctx context.Context) []*Realtor {
 }
 
 // IDs executes the query and returns a list of Realtor IDs.
-func (rq *RealtorQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
-	if rq.ctx.Unique == nil && rq.path != nil {
-		rq.Unique(true)
+func (_q *RealtorQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
 	}
-	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryIDs)
-	if err = rq.Select(realtor.FieldID).Scan(ctx, &ids); err != nil {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(realtor.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
 	return ids, nil
 }
 
 // IDsX is like IDs, but panics if an error occurs.
-func (rq *RealtorQuery) IDsX(ctx context.Context) []uuid.UUID {
-	ids, err := rq.IDs(ctx)
+func (_q *RealtorQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -225,17 +370,17 @@ func (rq *RealtorQuery) IDsX(ctx context.Context) []uuid.UUID {
 }
 
 // Count returns the count of the given query.
-func (rq *RealtorQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryCount)
-	if err := rq.prepareQuery(ctx); err != nil {
+func (_q *RealtorQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return withInterceptors[int](ctx, rq, querierCount[*RealtorQuery](), rq.inters)
+	return withInterceptors[int](ctx, _q, querierCount[*RealtorQuery](), _q.inters)
 }
 
 // CountX is like Count, but panics if an error occurs.
-func (rq *RealtorQuery) CountX(ctx context.Context) int {
-	count, err := rq.Count(ctx)
+func (_q *RealtorQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -243,9 +388,9 @@ func (rq *RealtorQuery) CountX(ctx context.Context) int {
 }
 
 // Exist returns true if the query has elements in the graph.
-func (rq *RealtorQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, rq.ctx, ent.OpQueryExist)
-	switch _, err := rq.FirstID(ctx); {
+func (_q *RealtorQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
 	case err != nil:
@@ -256,8 +401,8 @@ func (rq *RealtorQuery) Exist(ctx context.Context) (bool, error) {
 }
 
 // ExistX is like Exist, but panics if an error occurs.
-func (rq *RealtorQuery) ExistX(ctx context.Context) bool {
-	exist, err := rq.Exist(ctx)
+func (_q *RealtorQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -266,32 +411,104 @@ func (rq *RealtorQuery) ExistX(ctx context.Context) bool {
 
 // Clone returns a duplicate of the RealtorQuery builder, including all associated steps. It can be
 // used to prepare common query builders and use them differently after the clone is made.
-func (rq *RealtorQuery) Clone() *RealtorQuery {
-	if rq == nil {
+func (_q *RealtorQuery) Clone() *RealtorQuery {
+	if _q == nil {
 		return nil
 	}
 	return &RealtorQuery{
-		config:       rq.config,
-		ctx:          rq.ctx.Clone(),
-		order:        append([]realtor.OrderOption{}, rq.order...),
-		inters:       append([]Interceptor{}, rq.inters...),
-		predicates:   append([]predicate.Realtor{}, rq.predicates...),
-		withListings: rq.withListings.Clone(),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]realtor.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.Realtor{}, _q.predicates...),
+		withListings:       _q.withListings.Clone(),
+		withBuyerSideSales: _q.withBuyerSideSales.Clone(),
+		withUser:           _q.withUser.Clone(),
+		withInvites:        _q.withInvites.Clone(),
+		withBrokerage:      _q.withBrokerage.Clone(),
+		withTeam:           _q.withTeam.Clone(),
+		withLedTeams:       _q.withLedTeams.Clone(),
 		// clone intermediate query.
-		sql:  rq.sql.Clone(),
-		path: rq.path,
+		sql:  _q.sql.Clone(),
+		path: _q.path,
 	}
 }
 
 // WithListings tells the query-builder to eager-load the nodes that are connected to
 // the "listings" edge. The optional arguments are used to configure the query builder of the edge.
-func (rq *RealtorQuery) WithListings(opts ...func(*ListingQuery)) *RealtorQuery {
-	query := (&ListingClient{config: rq.config}).Query()
+func (_q *RealtorQuery) WithListings(opts ...func(*ListingQuery)) *RealtorQuery {
+	query := (&ListingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withListings = query
+	return _q
+}
+
+// WithBuyerSideSales tells the query-builder to eager-load the nodes that are connected to
+// the "buyer_side_sales" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RealtorQuery) WithBuyerSideSales(opts ...func(*ListingQuery)) *RealtorQuery {
+	query := (&ListingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBuyerSideSales = query
+	return _q
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RealtorQuery) WithUser(opts ...func(*UserQuery)) *RealtorQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withUser = query
+	return _q
+}
+
+// WithInvites tells the query-builder to eager-load the nodes that are connected to
+// the "invites" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RealtorQuery) WithInvites(opts ...func(*RealtorInviteQuery)) *RealtorQuery {
+	query := (&RealtorInviteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInvites = query
+	return _q
+}
+
+// WithBrokerage tells the query-builder to eager-load the nodes that are connected to
+// the "brokerage" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RealtorQuery) WithBrokerage(opts ...func(*BrokerageQuery)) *RealtorQuery {
+	query := (&BrokerageClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	rq.withListings = query
-	return rq
+	_q.withBrokerage = query
+	return _q
+}
+
+// WithTeam tells the query-builder to eager-load the nodes that are connected to
+// the "team" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RealtorQuery) WithTeam(opts ...func(*TeamQuery)) *RealtorQuery {
+	query := (&TeamClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTeam = query
+	return _q
+}
+
+// WithLedTeams tells the query-builder to eager-load the nodes that are connected to
+// the "led_teams" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RealtorQuery) WithLedTeams(opts ...func(*TeamQuery)) *RealtorQuery {
+	query := (&TeamClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLedTeams = query
+	return _q
 }
 
 // GroupBy is used to group vertices by one or more fields/columns.
@@ -308,10 +525,10 @@ func (rq *RealtorQuery) WithListings(opts ...func(*ListingQuery)) *RealtorQuery
 //		GroupBy(realtor.FieldCreateTime).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-func (rq *RealtorQuery) GroupBy(field string, fields ...string) *RealtorGroupBy {
-	rq.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &RealtorGroupBy{build: rq}
-	grbuild.flds = &rq.ctx.Fields
+func (_q *RealtorQuery) GroupBy(field string, fields ...string) *RealtorGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RealtorGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = realtor.Label
 	grbuild.scan = grbuild.Scan
 	return grbuild
@@ -329,82 +546,136 @@ func (rq *RealtorQuery) GroupBy(field string, fields ...string) *RealtorGroupBy
 //	client.Realtor.Query().
 //		Select(realtor.FieldCreateTime).
 //		Scan(ctx, &v)
-func (rq *RealtorQuery) Select(fields ...string) *RealtorSelect {
-	rq.ctx.Fields = append(rq.ctx.Fields, fields...)
-	sbuild := &RealtorSelect{RealtorQuery: rq}
+func (_q *RealtorQuery) Select(fields ...string) *RealtorSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &RealtorSelect{RealtorQuery: _q}
 	sbuild.label = realtor.Label
-	sbuild.flds, sbuild.scan = &rq.ctx.Fields, sbuild.Scan
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
 	return sbuild
 }
 
 // Aggregate returns a RealtorSelect configured with the given aggregations.
-func (rq *RealtorQuery) Aggregate(fns ...AggregateFunc) *RealtorSelect {
-	return rq.Select().Aggregate(fns...)
+func (_q *RealtorQuery) Aggregate(fns ...AggregateFunc) *RealtorSelect {
+	return _q.Select().Aggregate(fns...)
 }
 
-func (rq *RealtorQuery) prepareQuery(ctx context.Context) error {
-	for _, inter := range rq.inters {
+func (_q *RealtorQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
 		if inter == nil {
 			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
 		}
 		if trv, ok := inter.(Traverser); ok {
-			if err := trv.Traverse(ctx, rq); err != nil {
+			if err := trv.Traverse(ctx, _q); err != nil {
 				return err
 			}
 		}
 	}
-	for _, f := range rq.ctx.Fields {
+	for _, f := range _q.ctx.Fields {
 		if !realtor.ValidColumn(f) {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
-	if rq.path != nil {
-		prev, err := rq.path(ctx)
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
 		if err != nil {
 			return err
 		}
-		rq.sql = prev
+		_q.sql = prev
+	}
+	if realtor.Policy == nil {
+		return errors.New("ent: uninitialized realtor.Policy (forgotten import ent/runtime?)")
+	}
+	if err := realtor.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
 	}
 	return nil
 }
 
-func (rq *RealtorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Realtor, error) {
+func (_q *RealtorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Realtor, error) {
 	var (
 		nodes       = []*Realtor{}
-		_spec       = rq.querySpec()
-		loadedTypes = [1]bool{
-			rq.withListings != nil,
+		_spec       = _q.querySpec()
+		loadedTypes = [7]bool{
+			_q.withListings != nil,
+			_q.withBuyerSideSales != nil,
+			_q.withUser != nil,
+			_q.withInvites != nil,
+			_q.withBrokerage != nil,
+			_q.withTeam != nil,
+			_q.withLedTeams != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Realtor).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []any) error {
-		node := &Realtor{config: rq.config}
+		node := &Realtor{config: _q.config}
 		nodes = append(nodes, node)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, rq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
-	if query := rq.withListings; query != nil {
-		if err := rq.loadListings(ctx, query, nodes,
+	if query := _q.withListings; query != nil {
+		if err := _q.loadListings(ctx, query, nodes,
 			func(n *Realtor) { n.Edges.Listings = []*Listing{} },
 			func(n *Realtor, e *Listing) { n.Edges.Listings = append(n.Edges.Listings, e) }); err != nil {
 			return nil, err
 		}
 	}
+	if query := _q.withBuyerSideSales; query != nil {
+		if err := _q.loadBuyerSideSales(ctx, query, nodes,
+			func(n *Realtor) { n.Edges.BuyerSideSales = []*Listing{} },
+			func(n *Realtor, e *Listing) { n.Edges.BuyerSideSales = append(n.Edges.BuyerSideSales, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withUser; query != nil {
+		if err := _q.loadUser(ctx, query, nodes, nil,
+			func(n *Realtor, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInvites; query != nil {
+		if err := _q.loadInvites(ctx, query, nodes,
+			func(n *Realtor) { n.Edges.Invites = []*RealtorInvite{} },
+			func(n *Realtor, e *RealtorInvite) { n.Edges.Invites = append(n.Edges.Invites, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBrokerage; query != nil {
+		if err := _q.loadBrokerage(ctx, query, nodes, nil,
+			func(n *Realtor, e *Brokerage) { n.Edges.Brokerage = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTeam; query != nil {
+		if err := _q.loadTeam(ctx, query, nodes, nil,
+			func(n *Realtor, e *Team) { n.Edges.Team = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLedTeams; query != nil {
+		if err := _q.loadLedTeams(ctx, query, nodes,
+			func(n *Realtor) { n.Edges.LedTeams = []*Team{} },
+			func(n *Realtor, e *Team) { n.Edges.LedTeams = append(n.Edges.LedTeams, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
-func (rq *RealtorQuery) loadListings(ctx context.Context, query *ListingQuery, nodes []*Realtor, init func(*Realtor), assign func(*Realtor, *Listing)) error {
+func (_q *RealtorQuery) loadListings(ctx context.Context, query *ListingQuery, nodes []*Realtor, init func(*Realtor), assign func(*Realtor, *Listing)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Realtor)
 	for i := range nodes {
@@ -434,25 +705,220 @@ func (rq *RealtorQuery) loadListings(ctx context.Context, query *ListingQuery, n
 	}
 	return nil
 }
+func (_q *RealtorQuery) loadBuyerSideSales(ctx context.Context, query *ListingQuery, nodes []*Realtor, init func(*Realtor), assign func(*Realtor, *Listing)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Realtor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(listing.FieldBuyerRealtorID)
+	}
+	query.Where(predicate.Listing(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(realtor.BuyerSideSalesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BuyerRealtorID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "buyer_realtor_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "buyer_realtor_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RealtorQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Realtor, init func(*Realtor), assign func(*Realtor, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Realtor)
+	for i := range nodes {
+		if nodes[i].UserID == nil {
+			continue
+		}
+		fk := *nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RealtorQuery) loadInvites(ctx context.Context, query *RealtorInviteQuery, nodes []*Realtor, init func(*Realtor), assign func(*Realtor, *RealtorInvite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Realtor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(realtorinvite.FieldRealtorID)
+	}
+	query.Where(predicate.RealtorInvite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(realtor.InvitesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RealtorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "realtor_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *RealtorQuery) loadBrokerage(ctx context.Context, query *BrokerageQuery, nodes []*Realtor, init func(*Realtor), assign func(*Realtor, *Brokerage)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Realtor)
+	for i := range nodes {
+		if nodes[i].BrokerageID == nil {
+			continue
+		}
+		fk := *nodes[i].BrokerageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(brokerage.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "brokerage_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RealtorQuery) loadTeam(ctx context.Context, query *TeamQuery, nodes []*Realtor, init func(*Realtor), assign func(*Realtor, *Team)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Realtor)
+	for i := range nodes {
+		if nodes[i].TeamID == nil {
+			continue
+		}
+		fk := *nodes[i].TeamID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(team.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "team_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RealtorQuery) loadLedTeams(ctx context.Context, query *TeamQuery, nodes []*Realtor, init func(*Realtor), assign func(*Realtor, *Team)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Realtor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(team.FieldLeadID)
+	}
+	query.Where(predicate.Team(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(realtor.LedTeamsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LeadID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "lead_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "lead_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
-func (rq *RealtorQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := rq.querySpec()
-	_spec.Node.Columns = rq.ctx.Fields
-	if len(rq.ctx.Fields) > 0 {
-		_spec.Unique = rq.ctx.Unique != nil && *rq.ctx.Unique
+func (_q *RealtorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
 	}
-	return sqlgraph.CountNodes(ctx, rq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
 }
 
-func (rq *RealtorQuery) querySpec() *sqlgraph.QuerySpec {
+func (_q *RealtorQuery) querySpec() *sqlgraph.QuerySpec {
 	_spec := sqlgraph.NewQuerySpec(realtor.Table, realtor.Columns, sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID))
-	_spec.From = rq.sql
-	if unique := rq.ctx.Unique; unique != nil {
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
-	} else if rq.path != nil {
+	} else if _q.path != nil {
 		_spec.Unique = true
 	}
-	if fields := rq.ctx.Fields; len(fields) > 0 {
+	if fields := _q.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, realtor.FieldID)
 		for i := range fields {
@@ -460,21 +926,30 @@ func (rq *RealtorQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withUser != nil {
+			_spec.Node.AddColumnOnce(realtor.FieldUserID)
+		}
+		if _q.withBrokerage != nil {
+			_spec.Node.AddColumnOnce(realtor.FieldBrokerageID)
+		}
+		if _q.withTeam != nil {
+			_spec.Node.AddColumnOnce(realtor.FieldTeamID)
+		}
 	}
-	if ps := rq.predicates; len(ps) > 0 {
+	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if limit := rq.ctx.Limit; limit != nil {
+	if limit := _q.ctx.Limit; limit != nil {
 		_spec.Limit = *limit
 	}
-	if offset := rq.ctx.Offset; offset != nil {
+	if offset := _q.ctx.Offset; offset != nil {
 		_spec.Offset = *offset
 	}
-	if ps := rq.order; len(ps) > 0 {
+	if ps := _q.order; len(ps) > 0 {
 		_spec.Order = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
@@ -484,38 +959,67 @@ func (rq *RealtorQuery) querySpec() *sqlgraph.QuerySpec {
 	return _spec
 }
 
-func (rq *RealtorQuery) sqlQuery(ctx context.Context) *sql.Selector {
-	builder := sql.Dialect(rq.driver.Dialect())
+func (_q *RealtorQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
 	t1 := builder.Table(realtor.Table)
-	columns := rq.ctx.Fields
+	columns := _q.ctx.Fields
 	if len(columns) == 0 {
 		columns = realtor.Columns
 	}
 	selector := builder.Select(t1.Columns(columns...)...).From(t1)
-	if rq.sql != nil {
-		selector = rq.sql
+	if _q.sql != nil {
+		selector = _q.sql
 		selector.Select(selector.Columns(columns...)...)
 	}
-	if rq.ctx.Unique != nil && *rq.ctx.Unique {
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
-	for _, p := range rq.predicates {
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
 		p(selector)
 	}
-	for _, p := range rq.order {
+	for _, p := range _q.order {
 		p(selector)
 	}
-	if offset := rq.ctx.Offset; offset != nil {
+	if offset := _q.ctx.Offset; offset != nil {
 		// limit is mandatory for offset clause. We start
 		// with default value, and override it below if needed.
 		selector.Offset(*offset).Limit(math.MaxInt32)
 	}
-	if limit := rq.ctx.Limit; limit != nil {
+	if limit := _q.ctx.Limit; limit != nil {
 		selector.Limit(*limit)
 	}
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *RealtorQuery) ForUpdate(opts ...sql.LockOption) *RealtorQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *RealtorQuery) ForShare(opts ...sql.LockOption) *RealtorQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // RealtorGroupBy is the group-by builder for Realtor entities.
 type RealtorGroupBy struct {
 	selector
@@ -523,41 +1027,41 @@ type RealtorGroupBy struct {
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
-func (rgb *RealtorGroupBy) Aggregate(fns ...AggregateFunc) *RealtorGroupBy {
-	rgb.fns = append(rgb.fns, fns...)
-	return rgb
+func (_g *RealtorGroupBy) Aggregate(fns ...AggregateFunc) *RealtorGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
 }
 
 // Scan applies the selector query and scans the result into the given value.
-func (rgb *RealtorGroupBy) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, rgb.build.ctx, ent.OpQueryGroupBy)
-	if err := rgb.build.prepareQuery(ctx); err != nil {
+func (_g *RealtorGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
 		return err
 	}
-	return scanWithInterceptors[*RealtorQuery, *RealtorGroupBy](ctx, rgb.build, rgb, rgb.build.inters, v)
+	return scanWithInterceptors[*RealtorQuery, *RealtorGroupBy](ctx, _g.build, _g, _g.build.inters, v)
 }
 
-func (rgb *RealtorGroupBy) sqlScan(ctx context.Context, root *RealtorQuery, v any) error {
+func (_g *RealtorGroupBy) sqlScan(ctx context.Context, root *RealtorQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
-	aggregation := make([]string, 0, len(rgb.fns))
-	for _, fn := range rgb.fns {
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
 		aggregation = append(aggregation, fn(selector))
 	}
 	if len(selector.SelectedColumns()) == 0 {
-		columns := make([]string, 0, len(*rgb.flds)+len(rgb.fns))
-		for _, f := range *rgb.flds {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
 			columns = append(columns, selector.C(f))
 		}
 		columns = append(columns, aggregation...)
 		selector.Select(columns...)
 	}
-	selector.GroupBy(selector.Columns(*rgb.flds...)...)
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
 	if err := selector.Err(); err != nil {
 		return err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := rgb.build.driver.Query(ctx, query, args, rows); err != nil {
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -571,27 +1075,27 @@ type RealtorSelect struct {
 }
 
 // Aggregate adds the given aggregation functions to the selector query.
-func (rs *RealtorSelect) Aggregate(fns ...AggregateFunc) *RealtorSelect {
-	rs.fns = append(rs.fns, fns...)
-	return rs
+func (_s *RealtorSelect) Aggregate(fns ...AggregateFunc) *RealtorSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
 }
 
 // Scan applies the selector query and scans the result into the given value.
-func (rs *RealtorSelect) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, rs.ctx, ent.OpQuerySelect)
-	if err := rs.prepareQuery(ctx); err != nil {
+func (_s *RealtorSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
 		return err
 	}
-	return scanWithInterceptors[*RealtorQuery, *RealtorSelect](ctx, rs.RealtorQuery, rs, rs.inters, v)
+	return scanWithInterceptors[*RealtorQuery, *RealtorSelect](ctx, _s.RealtorQuery, _s, _s.inters, v)
 }
 
-func (rs *RealtorSelect) sqlScan(ctx context.Context, root *RealtorQuery, v any) error {
+func (_s *RealtorSelect) sqlScan(ctx context.Context, root *RealtorQuery, v any) error {
 	selector := root.sqlQuery(ctx)
-	aggregation := make([]string, 0, len(rs.fns))
-	for _, fn := range rs.fns {
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
 		aggregation = append(aggregation, fn(selector))
 	}
-	switch n := len(*rs.selector.flds); {
+	switch n := len(*_s.selector.flds); {
 	case n == 0 && len(aggregation) > 0:
 		selector.Select(aggregation...)
 	case n != 0 && len(aggregation) > 0:
@@ -599,7 +1103,7 @@ func (rs *RealtorSelect) sqlScan(ctx context.Context, root *RealtorQuery, v any)
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := rs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
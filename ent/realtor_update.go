@@ -10,11 +10,17 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/brokerage"
 	"ppgroup.ppgroup.com/ent/listing"
 	"ppgroup.ppgroup.com/ent/predicate"
 	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+	"ppgroup.ppgroup.com/ent/schematype"
+	"ppgroup.ppgroup.com/ent/team"
+	"ppgroup.ppgroup.com/ent/user"
 )
 
 // RealtorUpdate is the builder for updating Realtor entities.
@@ -25,155 +31,410 @@ type RealtorUpdate struct {
 }
 
 // Where appends a list predicates to the RealtorUpdate builder.
-func (ru *RealtorUpdate) Where(ps ...predicate.Realtor) *RealtorUpdate {
-	ru.mutation.Where(ps...)
-	return ru
+func (_u *RealtorUpdate) Where(ps ...predicate.Realtor) *RealtorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (ru *RealtorUpdate) SetUpdateTime(t time.Time) *RealtorUpdate {
-	ru.mutation.SetUpdateTime(t)
-	return ru
+func (_u *RealtorUpdate) SetUpdateTime(v time.Time) *RealtorUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
 }
 
 // SetFullName sets the "full_name" field.
-func (ru *RealtorUpdate) SetFullName(s string) *RealtorUpdate {
-	ru.mutation.SetFullName(s)
-	return ru
+func (_u *RealtorUpdate) SetFullName(v string) *RealtorUpdate {
+	_u.mutation.SetFullName(v)
+	return _u
 }
 
 // SetNillableFullName sets the "full_name" field if the given value is not nil.
-func (ru *RealtorUpdate) SetNillableFullName(s *string) *RealtorUpdate {
-	if s != nil {
-		ru.SetFullName(*s)
+func (_u *RealtorUpdate) SetNillableFullName(v *string) *RealtorUpdate {
+	if v != nil {
+		_u.SetFullName(*v)
 	}
-	return ru
+	return _u
 }
 
 // SetPhoto sets the "photo" field.
-func (ru *RealtorUpdate) SetPhoto(m map[string]interface{}) *RealtorUpdate {
-	ru.mutation.SetPhoto(m)
-	return ru
+func (_u *RealtorUpdate) SetPhoto(v *schematype.Photo) *RealtorUpdate {
+	_u.mutation.SetPhoto(v)
+	return _u
 }
 
 // ClearPhoto clears the value of the "photo" field.
-func (ru *RealtorUpdate) ClearPhoto() *RealtorUpdate {
-	ru.mutation.ClearPhoto()
-	return ru
+func (_u *RealtorUpdate) ClearPhoto() *RealtorUpdate {
+	_u.mutation.ClearPhoto()
+	return _u
 }
 
 // SetDescription sets the "description" field.
-func (ru *RealtorUpdate) SetDescription(s string) *RealtorUpdate {
-	ru.mutation.SetDescription(s)
-	return ru
+func (_u *RealtorUpdate) SetDescription(v string) *RealtorUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
 }
 
 // SetNillableDescription sets the "description" field if the given value is not nil.
-func (ru *RealtorUpdate) SetNillableDescription(s *string) *RealtorUpdate {
-	if s != nil {
-		ru.SetDescription(*s)
+func (_u *RealtorUpdate) SetNillableDescription(v *string) *RealtorUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
 	}
-	return ru
+	return _u
 }
 
 // ClearDescription clears the value of the "description" field.
-func (ru *RealtorUpdate) ClearDescription() *RealtorUpdate {
-	ru.mutation.ClearDescription()
-	return ru
+func (_u *RealtorUpdate) ClearDescription() *RealtorUpdate {
+	_u.mutation.ClearDescription()
+	return _u
 }
 
 // SetPhone sets the "phone" field.
-func (ru *RealtorUpdate) SetPhone(s string) *RealtorUpdate {
-	ru.mutation.SetPhone(s)
-	return ru
+func (_u *RealtorUpdate) SetPhone(v string) *RealtorUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
 }
 
 // SetNillablePhone sets the "phone" field if the given value is not nil.
-func (ru *RealtorUpdate) SetNillablePhone(s *string) *RealtorUpdate {
-	if s != nil {
-		ru.SetPhone(*s)
+func (_u *RealtorUpdate) SetNillablePhone(v *string) *RealtorUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
 	}
-	return ru
+	return _u
 }
 
 // SetEmail sets the "email" field.
-func (ru *RealtorUpdate) SetEmail(s string) *RealtorUpdate {
-	ru.mutation.SetEmail(s)
-	return ru
+func (_u *RealtorUpdate) SetEmail(v string) *RealtorUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
 }
 
 // SetNillableEmail sets the "email" field if the given value is not nil.
-func (ru *RealtorUpdate) SetNillableEmail(s *string) *RealtorUpdate {
-	if s != nil {
-		ru.SetEmail(*s)
+func (_u *RealtorUpdate) SetNillableEmail(v *string) *RealtorUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
 	}
-	return ru
+	return _u
 }
 
 // SetIsMvp sets the "is_mvp" field.
-func (ru *RealtorUpdate) SetIsMvp(b bool) *RealtorUpdate {
-	ru.mutation.SetIsMvp(b)
-	return ru
+func (_u *RealtorUpdate) SetIsMvp(v bool) *RealtorUpdate {
+	_u.mutation.SetIsMvp(v)
+	return _u
 }
 
 // SetNillableIsMvp sets the "is_mvp" field if the given value is not nil.
-func (ru *RealtorUpdate) SetNillableIsMvp(b *bool) *RealtorUpdate {
-	if b != nil {
-		ru.SetIsMvp(*b)
+func (_u *RealtorUpdate) SetNillableIsMvp(v *bool) *RealtorUpdate {
+	if v != nil {
+		_u.SetIsMvp(*v)
 	}
-	return ru
+	return _u
+}
+
+// SetServiceAreas sets the "service_areas" field.
+func (_u *RealtorUpdate) SetServiceAreas(v []string) *RealtorUpdate {
+	_u.mutation.SetServiceAreas(v)
+	return _u
+}
+
+// AppendServiceAreas appends value to the "service_areas" field.
+func (_u *RealtorUpdate) AppendServiceAreas(v []string) *RealtorUpdate {
+	_u.mutation.AppendServiceAreas(v)
+	return _u
+}
+
+// ClearServiceAreas clears the value of the "service_areas" field.
+func (_u *RealtorUpdate) ClearServiceAreas() *RealtorUpdate {
+	_u.mutation.ClearServiceAreas()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *RealtorUpdate) SetActive(v bool) *RealtorUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *RealtorUpdate) SetNillableActive(v *bool) *RealtorUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (_u *RealtorUpdate) SetDeactivatedAt(v time.Time) *RealtorUpdate {
+	_u.mutation.SetDeactivatedAt(v)
+	return _u
+}
+
+// SetNillableDeactivatedAt sets the "deactivated_at" field if the given value is not nil.
+func (_u *RealtorUpdate) SetNillableDeactivatedAt(v *time.Time) *RealtorUpdate {
+	if v != nil {
+		_u.SetDeactivatedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeactivatedAt clears the value of the "deactivated_at" field.
+func (_u *RealtorUpdate) ClearDeactivatedAt() *RealtorUpdate {
+	_u.mutation.ClearDeactivatedAt()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RealtorUpdate) SetUserID(v uuid.UUID) *RealtorUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RealtorUpdate) SetNillableUserID(v *uuid.UUID) *RealtorUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *RealtorUpdate) ClearUserID() *RealtorUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetBrokerageID sets the "brokerage_id" field.
+func (_u *RealtorUpdate) SetBrokerageID(v uuid.UUID) *RealtorUpdate {
+	_u.mutation.SetBrokerageID(v)
+	return _u
+}
+
+// SetNillableBrokerageID sets the "brokerage_id" field if the given value is not nil.
+func (_u *RealtorUpdate) SetNillableBrokerageID(v *uuid.UUID) *RealtorUpdate {
+	if v != nil {
+		_u.SetBrokerageID(*v)
+	}
+	return _u
+}
+
+// ClearBrokerageID clears the value of the "brokerage_id" field.
+func (_u *RealtorUpdate) ClearBrokerageID() *RealtorUpdate {
+	_u.mutation.ClearBrokerageID()
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *RealtorUpdate) SetTeamID(v uuid.UUID) *RealtorUpdate {
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *RealtorUpdate) SetNillableTeamID(v *uuid.UUID) *RealtorUpdate {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (_u *RealtorUpdate) ClearTeamID() *RealtorUpdate {
+	_u.mutation.ClearTeamID()
+	return _u
 }
 
 // AddListingIDs adds the "listings" edge to the Listing entity by IDs.
-func (ru *RealtorUpdate) AddListingIDs(ids ...uuid.UUID) *RealtorUpdate {
-	ru.mutation.AddListingIDs(ids...)
-	return ru
+func (_u *RealtorUpdate) AddListingIDs(ids ...uuid.UUID) *RealtorUpdate {
+	_u.mutation.AddListingIDs(ids...)
+	return _u
 }
 
 // AddListings adds the "listings" edges to the Listing entity.
-func (ru *RealtorUpdate) AddListings(l ...*Listing) *RealtorUpdate {
-	ids := make([]uuid.UUID, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+func (_u *RealtorUpdate) AddListings(v ...*Listing) *RealtorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddListingIDs(ids...)
+}
+
+// AddBuyerSideSaleIDs adds the "buyer_side_sales" edge to the Listing entity by IDs.
+func (_u *RealtorUpdate) AddBuyerSideSaleIDs(ids ...uuid.UUID) *RealtorUpdate {
+	_u.mutation.AddBuyerSideSaleIDs(ids...)
+	return _u
+}
+
+// AddBuyerSideSales adds the "buyer_side_sales" edges to the Listing entity.
+func (_u *RealtorUpdate) AddBuyerSideSales(v ...*Listing) *RealtorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return ru.AddListingIDs(ids...)
+	return _u.AddBuyerSideSaleIDs(ids...)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *RealtorUpdate) SetUser(v *User) *RealtorUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// AddInviteIDs adds the "invites" edge to the RealtorInvite entity by IDs.
+func (_u *RealtorUpdate) AddInviteIDs(ids ...uuid.UUID) *RealtorUpdate {
+	_u.mutation.AddInviteIDs(ids...)
+	return _u
+}
+
+// AddInvites adds the "invites" edges to the RealtorInvite entity.
+func (_u *RealtorUpdate) AddInvites(v ...*RealtorInvite) *RealtorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInviteIDs(ids...)
+}
+
+// SetBrokerage sets the "brokerage" edge to the Brokerage entity.
+func (_u *RealtorUpdate) SetBrokerage(v *Brokerage) *RealtorUpdate {
+	return _u.SetBrokerageID(v.ID)
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (_u *RealtorUpdate) SetTeam(v *Team) *RealtorUpdate {
+	return _u.SetTeamID(v.ID)
+}
+
+// AddLedTeamIDs adds the "led_teams" edge to the Team entity by IDs.
+func (_u *RealtorUpdate) AddLedTeamIDs(ids ...uuid.UUID) *RealtorUpdate {
+	_u.mutation.AddLedTeamIDs(ids...)
+	return _u
+}
+
+// AddLedTeams adds the "led_teams" edges to the Team entity.
+func (_u *RealtorUpdate) AddLedTeams(v ...*Team) *RealtorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLedTeamIDs(ids...)
 }
 
 // Mutation returns the RealtorMutation object of the builder.
-func (ru *RealtorUpdate) Mutation() *RealtorMutation {
-	return ru.mutation
+func (_u *RealtorUpdate) Mutation() *RealtorMutation {
+	return _u.mutation
 }
 
 // ClearListings clears all "listings" edges to the Listing entity.
-func (ru *RealtorUpdate) ClearListings() *RealtorUpdate {
-	ru.mutation.ClearListings()
-	return ru
+func (_u *RealtorUpdate) ClearListings() *RealtorUpdate {
+	_u.mutation.ClearListings()
+	return _u
 }
 
 // RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
-func (ru *RealtorUpdate) RemoveListingIDs(ids ...uuid.UUID) *RealtorUpdate {
-	ru.mutation.RemoveListingIDs(ids...)
-	return ru
+func (_u *RealtorUpdate) RemoveListingIDs(ids ...uuid.UUID) *RealtorUpdate {
+	_u.mutation.RemoveListingIDs(ids...)
+	return _u
 }
 
 // RemoveListings removes "listings" edges to Listing entities.
-func (ru *RealtorUpdate) RemoveListings(l ...*Listing) *RealtorUpdate {
-	ids := make([]uuid.UUID, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+func (_u *RealtorUpdate) RemoveListings(v ...*Listing) *RealtorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListingIDs(ids...)
+}
+
+// ClearBuyerSideSales clears all "buyer_side_sales" edges to the Listing entity.
+func (_u *RealtorUpdate) ClearBuyerSideSales() *RealtorUpdate {
+	_u.mutation.ClearBuyerSideSales()
+	return _u
+}
+
+// RemoveBuyerSideSaleIDs removes the "buyer_side_sales" edge to Listing entities by IDs.
+func (_u *RealtorUpdate) RemoveBuyerSideSaleIDs(ids ...uuid.UUID) *RealtorUpdate {
+	_u.mutation.RemoveBuyerSideSaleIDs(ids...)
+	return _u
+}
+
+// RemoveBuyerSideSales removes "buyer_side_sales" edges to Listing entities.
+func (_u *RealtorUpdate) RemoveBuyerSideSales(v ...*Listing) *RealtorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBuyerSideSaleIDs(ids...)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *RealtorUpdate) ClearUser() *RealtorUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearInvites clears all "invites" edges to the RealtorInvite entity.
+func (_u *RealtorUpdate) ClearInvites() *RealtorUpdate {
+	_u.mutation.ClearInvites()
+	return _u
+}
+
+// RemoveInviteIDs removes the "invites" edge to RealtorInvite entities by IDs.
+func (_u *RealtorUpdate) RemoveInviteIDs(ids ...uuid.UUID) *RealtorUpdate {
+	_u.mutation.RemoveInviteIDs(ids...)
+	return _u
+}
+
+// RemoveInvites removes "invites" edges to RealtorInvite entities.
+func (_u *RealtorUpdate) RemoveInvites(v ...*RealtorInvite) *RealtorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInviteIDs(ids...)
+}
+
+// ClearBrokerage clears the "brokerage" edge to the Brokerage entity.
+func (_u *RealtorUpdate) ClearBrokerage() *RealtorUpdate {
+	_u.mutation.ClearBrokerage()
+	return _u
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (_u *RealtorUpdate) ClearTeam() *RealtorUpdate {
+	_u.mutation.ClearTeam()
+	return _u
+}
+
+// ClearLedTeams clears all "led_teams" edges to the Team entity.
+func (_u *RealtorUpdate) ClearLedTeams() *RealtorUpdate {
+	_u.mutation.ClearLedTeams()
+	return _u
+}
+
+// RemoveLedTeamIDs removes the "led_teams" edge to Team entities by IDs.
+func (_u *RealtorUpdate) RemoveLedTeamIDs(ids ...uuid.UUID) *RealtorUpdate {
+	_u.mutation.RemoveLedTeamIDs(ids...)
+	return _u
+}
+
+// RemoveLedTeams removes "led_teams" edges to Team entities.
+func (_u *RealtorUpdate) RemoveLedTeams(v ...*Team) *RealtorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return ru.RemoveListingIDs(ids...)
+	return _u.RemoveLedTeamIDs(ids...)
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
-func (ru *RealtorUpdate) Save(ctx context.Context) (int, error) {
-	ru.defaults()
-	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+func (_u *RealtorUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (ru *RealtorUpdate) SaveX(ctx context.Context) int {
-	affected, err := ru.Save(ctx)
+func (_u *RealtorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -181,44 +442,48 @@ func (ru *RealtorUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (ru *RealtorUpdate) Exec(ctx context.Context) error {
-	_, err := ru.Save(ctx)
+func (_u *RealtorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ru *RealtorUpdate) ExecX(ctx context.Context) {
-	if err := ru.Exec(ctx); err != nil {
+func (_u *RealtorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (ru *RealtorUpdate) defaults() {
-	if _, ok := ru.mutation.UpdateTime(); !ok {
+func (_u *RealtorUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if realtor.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized realtor.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := realtor.UpdateDefaultUpdateTime()
-		ru.mutation.SetUpdateTime(v)
+		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (ru *RealtorUpdate) check() error {
-	if v, ok := ru.mutation.FullName(); ok {
+func (_u *RealtorUpdate) check() error {
+	if v, ok := _u.mutation.FullName(); ok {
 		if err := realtor.FullNameValidator(v); err != nil {
 			return &ValidationError{Name: "full_name", err: fmt.Errorf(`ent: validator failed for field "Realtor.full_name": %w`, err)}
 		}
 	}
-	if v, ok := ru.mutation.Description(); ok {
+	if v, ok := _u.mutation.Description(); ok {
 		if err := realtor.DescriptionValidator(v); err != nil {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Realtor.description": %w`, err)}
 		}
 	}
-	if v, ok := ru.mutation.Phone(); ok {
+	if v, ok := _u.mutation.Phone(); ok {
 		if err := realtor.PhoneValidator(v); err != nil {
 			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Realtor.phone": %w`, err)}
 		}
 	}
-	if v, ok := ru.mutation.Email(); ok {
+	if v, ok := _u.mutation.Email(); ok {
 		if err := realtor.EmailValidator(v); err != nil {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Realtor.email": %w`, err)}
 		}
@@ -226,46 +491,66 @@ func (ru *RealtorUpdate) check() error {
 	return nil
 }
 
-func (ru *RealtorUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := ru.check(); err != nil {
-		return n, err
+func (_u *RealtorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(realtor.Table, realtor.Columns, sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID))
-	if ps := ru.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := ru.mutation.UpdateTime(); ok {
+	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(realtor.FieldUpdateTime, field.TypeTime, value)
 	}
-	if value, ok := ru.mutation.FullName(); ok {
+	if value, ok := _u.mutation.FullName(); ok {
 		_spec.SetField(realtor.FieldFullName, field.TypeString, value)
 	}
-	if value, ok := ru.mutation.Photo(); ok {
+	if value, ok := _u.mutation.Photo(); ok {
 		_spec.SetField(realtor.FieldPhoto, field.TypeJSON, value)
 	}
-	if ru.mutation.PhotoCleared() {
+	if _u.mutation.PhotoCleared() {
 		_spec.ClearField(realtor.FieldPhoto, field.TypeJSON)
 	}
-	if value, ok := ru.mutation.Description(); ok {
+	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(realtor.FieldDescription, field.TypeString, value)
 	}
-	if ru.mutation.DescriptionCleared() {
+	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(realtor.FieldDescription, field.TypeString)
 	}
-	if value, ok := ru.mutation.Phone(); ok {
+	if value, ok := _u.mutation.Phone(); ok {
 		_spec.SetField(realtor.FieldPhone, field.TypeString, value)
 	}
-	if value, ok := ru.mutation.Email(); ok {
+	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(realtor.FieldEmail, field.TypeString, value)
 	}
-	if value, ok := ru.mutation.IsMvp(); ok {
+	if value, ok := _u.mutation.IsMvp(); ok {
 		_spec.SetField(realtor.FieldIsMvp, field.TypeBool, value)
 	}
-	if ru.mutation.ListingsCleared() {
+	if value, ok := _u.mutation.ServiceAreas(); ok {
+		_spec.SetField(realtor.FieldServiceAreas, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedServiceAreas(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, realtor.FieldServiceAreas, value)
+		})
+	}
+	if _u.mutation.ServiceAreasCleared() {
+		_spec.ClearField(realtor.FieldServiceAreas, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(realtor.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeactivatedAt(); ok {
+		_spec.SetField(realtor.FieldDeactivatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeactivatedAtCleared() {
+		_spec.ClearField(realtor.FieldDeactivatedAt, field.TypeTime)
+	}
+	if _u.mutation.ListingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -278,7 +563,7 @@ func (ru *RealtorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ru.mutation.RemovedListingsIDs(); len(nodes) > 0 && !ru.mutation.ListingsCleared() {
+	if nodes := _u.mutation.RemovedListingsIDs(); len(nodes) > 0 && !_u.mutation.ListingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -294,7 +579,7 @@ func (ru *RealtorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ru.mutation.ListingsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ListingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -310,7 +595,229 @@ func (ru *RealtorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+	if _u.mutation.BuyerSideSalesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.BuyerSideSalesTable,
+			Columns: []string{realtor.BuyerSideSalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBuyerSideSalesIDs(); len(nodes) > 0 && !_u.mutation.BuyerSideSalesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.BuyerSideSalesTable,
+			Columns: []string{realtor.BuyerSideSalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BuyerSideSalesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.BuyerSideSalesTable,
+			Columns: []string{realtor.BuyerSideSalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   realtor.UserTable,
+			Columns: []string{realtor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   realtor.UserTable,
+			Columns: []string{realtor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.InvitesTable,
+			Columns: []string{realtor.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtorinvite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !_u.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.InvitesTable,
+			Columns: []string{realtor.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtorinvite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.InvitesTable,
+			Columns: []string{realtor.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtorinvite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BrokerageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtor.BrokerageTable,
+			Columns: []string{realtor.BrokerageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(brokerage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BrokerageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtor.BrokerageTable,
+			Columns: []string{realtor.BrokerageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(brokerage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtor.TeamTable,
+			Columns: []string{realtor.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtor.TeamTable,
+			Columns: []string{realtor.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LedTeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.LedTeamsTable,
+			Columns: []string{realtor.LedTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLedTeamsIDs(); len(nodes) > 0 && !_u.mutation.LedTeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.LedTeamsTable,
+			Columns: []string{realtor.LedTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedTeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.LedTeamsTable,
+			Columns: []string{realtor.LedTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{realtor.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -318,8 +825,8 @@ func (ru *RealtorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		return 0, err
 	}
-	ru.mutation.done = true
-	return n, nil
+	_u.mutation.done = true
+	return _node, nil
 }
 
 // RealtorUpdateOne is the builder for updating a single Realtor entity.
@@ -331,162 +838,417 @@ type RealtorUpdateOne struct {
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (ruo *RealtorUpdateOne) SetUpdateTime(t time.Time) *RealtorUpdateOne {
-	ruo.mutation.SetUpdateTime(t)
-	return ruo
+func (_u *RealtorUpdateOne) SetUpdateTime(v time.Time) *RealtorUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
 }
 
 // SetFullName sets the "full_name" field.
-func (ruo *RealtorUpdateOne) SetFullName(s string) *RealtorUpdateOne {
-	ruo.mutation.SetFullName(s)
-	return ruo
+func (_u *RealtorUpdateOne) SetFullName(v string) *RealtorUpdateOne {
+	_u.mutation.SetFullName(v)
+	return _u
 }
 
 // SetNillableFullName sets the "full_name" field if the given value is not nil.
-func (ruo *RealtorUpdateOne) SetNillableFullName(s *string) *RealtorUpdateOne {
-	if s != nil {
-		ruo.SetFullName(*s)
+func (_u *RealtorUpdateOne) SetNillableFullName(v *string) *RealtorUpdateOne {
+	if v != nil {
+		_u.SetFullName(*v)
 	}
-	return ruo
+	return _u
 }
 
 // SetPhoto sets the "photo" field.
-func (ruo *RealtorUpdateOne) SetPhoto(m map[string]interface{}) *RealtorUpdateOne {
-	ruo.mutation.SetPhoto(m)
-	return ruo
+func (_u *RealtorUpdateOne) SetPhoto(v *schematype.Photo) *RealtorUpdateOne {
+	_u.mutation.SetPhoto(v)
+	return _u
 }
 
 // ClearPhoto clears the value of the "photo" field.
-func (ruo *RealtorUpdateOne) ClearPhoto() *RealtorUpdateOne {
-	ruo.mutation.ClearPhoto()
-	return ruo
+func (_u *RealtorUpdateOne) ClearPhoto() *RealtorUpdateOne {
+	_u.mutation.ClearPhoto()
+	return _u
 }
 
 // SetDescription sets the "description" field.
-func (ruo *RealtorUpdateOne) SetDescription(s string) *RealtorUpdateOne {
-	ruo.mutation.SetDescription(s)
-	return ruo
+func (_u *RealtorUpdateOne) SetDescription(v string) *RealtorUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
 }
 
 // SetNillableDescription sets the "description" field if the given value is not nil.
-func (ruo *RealtorUpdateOne) SetNillableDescription(s *string) *RealtorUpdateOne {
-	if s != nil {
-		ruo.SetDescription(*s)
+func (_u *RealtorUpdateOne) SetNillableDescription(v *string) *RealtorUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
 	}
-	return ruo
+	return _u
 }
 
 // ClearDescription clears the value of the "description" field.
-func (ruo *RealtorUpdateOne) ClearDescription() *RealtorUpdateOne {
-	ruo.mutation.ClearDescription()
-	return ruo
+func (_u *RealtorUpdateOne) ClearDescription() *RealtorUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
 }
 
 // SetPhone sets the "phone" field.
-func (ruo *RealtorUpdateOne) SetPhone(s string) *RealtorUpdateOne {
-	ruo.mutation.SetPhone(s)
-	return ruo
+func (_u *RealtorUpdateOne) SetPhone(v string) *RealtorUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
 }
 
 // SetNillablePhone sets the "phone" field if the given value is not nil.
-func (ruo *RealtorUpdateOne) SetNillablePhone(s *string) *RealtorUpdateOne {
-	if s != nil {
-		ruo.SetPhone(*s)
+func (_u *RealtorUpdateOne) SetNillablePhone(v *string) *RealtorUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
 	}
-	return ruo
+	return _u
 }
 
 // SetEmail sets the "email" field.
-func (ruo *RealtorUpdateOne) SetEmail(s string) *RealtorUpdateOne {
-	ruo.mutation.SetEmail(s)
-	return ruo
+func (_u *RealtorUpdateOne) SetEmail(v string) *RealtorUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
 }
 
 // SetNillableEmail sets the "email" field if the given value is not nil.
-func (ruo *RealtorUpdateOne) SetNillableEmail(s *string) *RealtorUpdateOne {
-	if s != nil {
-		ruo.SetEmail(*s)
+func (_u *RealtorUpdateOne) SetNillableEmail(v *string) *RealtorUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
 	}
-	return ruo
+	return _u
 }
 
 // SetIsMvp sets the "is_mvp" field.
-func (ruo *RealtorUpdateOne) SetIsMvp(b bool) *RealtorUpdateOne {
-	ruo.mutation.SetIsMvp(b)
-	return ruo
+func (_u *RealtorUpdateOne) SetIsMvp(v bool) *RealtorUpdateOne {
+	_u.mutation.SetIsMvp(v)
+	return _u
 }
 
 // SetNillableIsMvp sets the "is_mvp" field if the given value is not nil.
-func (ruo *RealtorUpdateOne) SetNillableIsMvp(b *bool) *RealtorUpdateOne {
-	if b != nil {
-		ruo.SetIsMvp(*b)
+func (_u *RealtorUpdateOne) SetNillableIsMvp(v *bool) *RealtorUpdateOne {
+	if v != nil {
+		_u.SetIsMvp(*v)
+	}
+	return _u
+}
+
+// SetServiceAreas sets the "service_areas" field.
+func (_u *RealtorUpdateOne) SetServiceAreas(v []string) *RealtorUpdateOne {
+	_u.mutation.SetServiceAreas(v)
+	return _u
+}
+
+// AppendServiceAreas appends value to the "service_areas" field.
+func (_u *RealtorUpdateOne) AppendServiceAreas(v []string) *RealtorUpdateOne {
+	_u.mutation.AppendServiceAreas(v)
+	return _u
+}
+
+// ClearServiceAreas clears the value of the "service_areas" field.
+func (_u *RealtorUpdateOne) ClearServiceAreas() *RealtorUpdateOne {
+	_u.mutation.ClearServiceAreas()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *RealtorUpdateOne) SetActive(v bool) *RealtorUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *RealtorUpdateOne) SetNillableActive(v *bool) *RealtorUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetDeactivatedAt sets the "deactivated_at" field.
+func (_u *RealtorUpdateOne) SetDeactivatedAt(v time.Time) *RealtorUpdateOne {
+	_u.mutation.SetDeactivatedAt(v)
+	return _u
+}
+
+// SetNillableDeactivatedAt sets the "deactivated_at" field if the given value is not nil.
+func (_u *RealtorUpdateOne) SetNillableDeactivatedAt(v *time.Time) *RealtorUpdateOne {
+	if v != nil {
+		_u.SetDeactivatedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeactivatedAt clears the value of the "deactivated_at" field.
+func (_u *RealtorUpdateOne) ClearDeactivatedAt() *RealtorUpdateOne {
+	_u.mutation.ClearDeactivatedAt()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RealtorUpdateOne) SetUserID(v uuid.UUID) *RealtorUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RealtorUpdateOne) SetNillableUserID(v *uuid.UUID) *RealtorUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
 	}
-	return ruo
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *RealtorUpdateOne) ClearUserID() *RealtorUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetBrokerageID sets the "brokerage_id" field.
+func (_u *RealtorUpdateOne) SetBrokerageID(v uuid.UUID) *RealtorUpdateOne {
+	_u.mutation.SetBrokerageID(v)
+	return _u
+}
+
+// SetNillableBrokerageID sets the "brokerage_id" field if the given value is not nil.
+func (_u *RealtorUpdateOne) SetNillableBrokerageID(v *uuid.UUID) *RealtorUpdateOne {
+	if v != nil {
+		_u.SetBrokerageID(*v)
+	}
+	return _u
+}
+
+// ClearBrokerageID clears the value of the "brokerage_id" field.
+func (_u *RealtorUpdateOne) ClearBrokerageID() *RealtorUpdateOne {
+	_u.mutation.ClearBrokerageID()
+	return _u
+}
+
+// SetTeamID sets the "team_id" field.
+func (_u *RealtorUpdateOne) SetTeamID(v uuid.UUID) *RealtorUpdateOne {
+	_u.mutation.SetTeamID(v)
+	return _u
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (_u *RealtorUpdateOne) SetNillableTeamID(v *uuid.UUID) *RealtorUpdateOne {
+	if v != nil {
+		_u.SetTeamID(*v)
+	}
+	return _u
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (_u *RealtorUpdateOne) ClearTeamID() *RealtorUpdateOne {
+	_u.mutation.ClearTeamID()
+	return _u
 }
 
 // AddListingIDs adds the "listings" edge to the Listing entity by IDs.
-func (ruo *RealtorUpdateOne) AddListingIDs(ids ...uuid.UUID) *RealtorUpdateOne {
-	ruo.mutation.AddListingIDs(ids...)
-	return ruo
+func (_u *RealtorUpdateOne) AddListingIDs(ids ...uuid.UUID) *RealtorUpdateOne {
+	_u.mutation.AddListingIDs(ids...)
+	return _u
 }
 
 // AddListings adds the "listings" edges to the Listing entity.
-func (ruo *RealtorUpdateOne) AddListings(l ...*Listing) *RealtorUpdateOne {
-	ids := make([]uuid.UUID, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+func (_u *RealtorUpdateOne) AddListings(v ...*Listing) *RealtorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return ruo.AddListingIDs(ids...)
+	return _u.AddListingIDs(ids...)
+}
+
+// AddBuyerSideSaleIDs adds the "buyer_side_sales" edge to the Listing entity by IDs.
+func (_u *RealtorUpdateOne) AddBuyerSideSaleIDs(ids ...uuid.UUID) *RealtorUpdateOne {
+	_u.mutation.AddBuyerSideSaleIDs(ids...)
+	return _u
+}
+
+// AddBuyerSideSales adds the "buyer_side_sales" edges to the Listing entity.
+func (_u *RealtorUpdateOne) AddBuyerSideSales(v ...*Listing) *RealtorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBuyerSideSaleIDs(ids...)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *RealtorUpdateOne) SetUser(v *User) *RealtorUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// AddInviteIDs adds the "invites" edge to the RealtorInvite entity by IDs.
+func (_u *RealtorUpdateOne) AddInviteIDs(ids ...uuid.UUID) *RealtorUpdateOne {
+	_u.mutation.AddInviteIDs(ids...)
+	return _u
+}
+
+// AddInvites adds the "invites" edges to the RealtorInvite entity.
+func (_u *RealtorUpdateOne) AddInvites(v ...*RealtorInvite) *RealtorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInviteIDs(ids...)
+}
+
+// SetBrokerage sets the "brokerage" edge to the Brokerage entity.
+func (_u *RealtorUpdateOne) SetBrokerage(v *Brokerage) *RealtorUpdateOne {
+	return _u.SetBrokerageID(v.ID)
+}
+
+// SetTeam sets the "team" edge to the Team entity.
+func (_u *RealtorUpdateOne) SetTeam(v *Team) *RealtorUpdateOne {
+	return _u.SetTeamID(v.ID)
+}
+
+// AddLedTeamIDs adds the "led_teams" edge to the Team entity by IDs.
+func (_u *RealtorUpdateOne) AddLedTeamIDs(ids ...uuid.UUID) *RealtorUpdateOne {
+	_u.mutation.AddLedTeamIDs(ids...)
+	return _u
+}
+
+// AddLedTeams adds the "led_teams" edges to the Team entity.
+func (_u *RealtorUpdateOne) AddLedTeams(v ...*Team) *RealtorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLedTeamIDs(ids...)
 }
 
 // Mutation returns the RealtorMutation object of the builder.
-func (ruo *RealtorUpdateOne) Mutation() *RealtorMutation {
-	return ruo.mutation
+func (_u *RealtorUpdateOne) Mutation() *RealtorMutation {
+	return _u.mutation
 }
 
 // ClearListings clears all "listings" edges to the Listing entity.
-func (ruo *RealtorUpdateOne) ClearListings() *RealtorUpdateOne {
-	ruo.mutation.ClearListings()
-	return ruo
+func (_u *RealtorUpdateOne) ClearListings() *RealtorUpdateOne {
+	_u.mutation.ClearListings()
+	return _u
 }
 
 // RemoveListingIDs removes the "listings" edge to Listing entities by IDs.
-func (ruo *RealtorUpdateOne) RemoveListingIDs(ids ...uuid.UUID) *RealtorUpdateOne {
-	ruo.mutation.RemoveListingIDs(ids...)
-	return ruo
+func (_u *RealtorUpdateOne) RemoveListingIDs(ids ...uuid.UUID) *RealtorUpdateOne {
+	_u.mutation.RemoveListingIDs(ids...)
+	return _u
 }
 
 // RemoveListings removes "listings" edges to Listing entities.
-func (ruo *RealtorUpdateOne) RemoveListings(l ...*Listing) *RealtorUpdateOne {
-	ids := make([]uuid.UUID, len(l))
-	for i := range l {
-		ids[i] = l[i].ID
+func (_u *RealtorUpdateOne) RemoveListings(v ...*Listing) *RealtorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveListingIDs(ids...)
+}
+
+// ClearBuyerSideSales clears all "buyer_side_sales" edges to the Listing entity.
+func (_u *RealtorUpdateOne) ClearBuyerSideSales() *RealtorUpdateOne {
+	_u.mutation.ClearBuyerSideSales()
+	return _u
+}
+
+// RemoveBuyerSideSaleIDs removes the "buyer_side_sales" edge to Listing entities by IDs.
+func (_u *RealtorUpdateOne) RemoveBuyerSideSaleIDs(ids ...uuid.UUID) *RealtorUpdateOne {
+	_u.mutation.RemoveBuyerSideSaleIDs(ids...)
+	return _u
+}
+
+// RemoveBuyerSideSales removes "buyer_side_sales" edges to Listing entities.
+func (_u *RealtorUpdateOne) RemoveBuyerSideSales(v ...*Listing) *RealtorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBuyerSideSaleIDs(ids...)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *RealtorUpdateOne) ClearUser() *RealtorUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearInvites clears all "invites" edges to the RealtorInvite entity.
+func (_u *RealtorUpdateOne) ClearInvites() *RealtorUpdateOne {
+	_u.mutation.ClearInvites()
+	return _u
+}
+
+// RemoveInviteIDs removes the "invites" edge to RealtorInvite entities by IDs.
+func (_u *RealtorUpdateOne) RemoveInviteIDs(ids ...uuid.UUID) *RealtorUpdateOne {
+	_u.mutation.RemoveInviteIDs(ids...)
+	return _u
+}
+
+// RemoveInvites removes "invites" edges to RealtorInvite entities.
+func (_u *RealtorUpdateOne) RemoveInvites(v ...*RealtorInvite) *RealtorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInviteIDs(ids...)
+}
+
+// ClearBrokerage clears the "brokerage" edge to the Brokerage entity.
+func (_u *RealtorUpdateOne) ClearBrokerage() *RealtorUpdateOne {
+	_u.mutation.ClearBrokerage()
+	return _u
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (_u *RealtorUpdateOne) ClearTeam() *RealtorUpdateOne {
+	_u.mutation.ClearTeam()
+	return _u
+}
+
+// ClearLedTeams clears all "led_teams" edges to the Team entity.
+func (_u *RealtorUpdateOne) ClearLedTeams() *RealtorUpdateOne {
+	_u.mutation.ClearLedTeams()
+	return _u
+}
+
+// RemoveLedTeamIDs removes the "led_teams" edge to Team entities by IDs.
+func (_u *RealtorUpdateOne) RemoveLedTeamIDs(ids ...uuid.UUID) *RealtorUpdateOne {
+	_u.mutation.RemoveLedTeamIDs(ids...)
+	return _u
+}
+
+// RemoveLedTeams removes "led_teams" edges to Team entities.
+func (_u *RealtorUpdateOne) RemoveLedTeams(v ...*Team) *RealtorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return ruo.RemoveListingIDs(ids...)
+	return _u.RemoveLedTeamIDs(ids...)
 }
 
 // Where appends a list predicates to the RealtorUpdate builder.
-func (ruo *RealtorUpdateOne) Where(ps ...predicate.Realtor) *RealtorUpdateOne {
-	ruo.mutation.Where(ps...)
-	return ruo
+func (_u *RealtorUpdateOne) Where(ps ...predicate.Realtor) *RealtorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
-func (ruo *RealtorUpdateOne) Select(field string, fields ...string) *RealtorUpdateOne {
-	ruo.fields = append([]string{field}, fields...)
-	return ruo
+func (_u *RealtorUpdateOne) Select(field string, fields ...string) *RealtorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
 }
 
 // Save executes the query and returns the updated Realtor entity.
-func (ruo *RealtorUpdateOne) Save(ctx context.Context) (*Realtor, error) {
-	ruo.defaults()
-	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+func (_u *RealtorUpdateOne) Save(ctx context.Context) (*Realtor, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (ruo *RealtorUpdateOne) SaveX(ctx context.Context) *Realtor {
-	node, err := ruo.Save(ctx)
+func (_u *RealtorUpdateOne) SaveX(ctx context.Context) *Realtor {
+	node, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -494,44 +1256,48 @@ func (ruo *RealtorUpdateOne) SaveX(ctx context.Context) *Realtor {
 }
 
 // Exec executes the query on the entity.
-func (ruo *RealtorUpdateOne) Exec(ctx context.Context) error {
-	_, err := ruo.Save(ctx)
+func (_u *RealtorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (ruo *RealtorUpdateOne) ExecX(ctx context.Context) {
-	if err := ruo.Exec(ctx); err != nil {
+func (_u *RealtorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (ruo *RealtorUpdateOne) defaults() {
-	if _, ok := ruo.mutation.UpdateTime(); !ok {
+func (_u *RealtorUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if realtor.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized realtor.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := realtor.UpdateDefaultUpdateTime()
-		ruo.mutation.SetUpdateTime(v)
+		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (ruo *RealtorUpdateOne) check() error {
-	if v, ok := ruo.mutation.FullName(); ok {
+func (_u *RealtorUpdateOne) check() error {
+	if v, ok := _u.mutation.FullName(); ok {
 		if err := realtor.FullNameValidator(v); err != nil {
 			return &ValidationError{Name: "full_name", err: fmt.Errorf(`ent: validator failed for field "Realtor.full_name": %w`, err)}
 		}
 	}
-	if v, ok := ruo.mutation.Description(); ok {
+	if v, ok := _u.mutation.Description(); ok {
 		if err := realtor.DescriptionValidator(v); err != nil {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Realtor.description": %w`, err)}
 		}
 	}
-	if v, ok := ruo.mutation.Phone(); ok {
+	if v, ok := _u.mutation.Phone(); ok {
 		if err := realtor.PhoneValidator(v); err != nil {
 			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "Realtor.phone": %w`, err)}
 		}
 	}
-	if v, ok := ruo.mutation.Email(); ok {
+	if v, ok := _u.mutation.Email(); ok {
 		if err := realtor.EmailValidator(v); err != nil {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Realtor.email": %w`, err)}
 		}
@@ -539,17 +1305,17 @@ func (ruo *RealtorUpdateOne) check() error {
 	return nil
 }
 
-func (ruo *RealtorUpdateOne) sqlSave(ctx context.Context) (_node *Realtor, err error) {
-	if err := ruo.check(); err != nil {
+func (_u *RealtorUpdateOne) sqlSave(ctx context.Context) (_node *Realtor, err error) {
+	if err := _u.check(); err != nil {
 		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(realtor.Table, realtor.Columns, sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID))
-	id, ok := ruo.mutation.ID()
+	id, ok := _u.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Realtor.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
-	if fields := ruo.fields; len(fields) > 0 {
+	if fields := _u.fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, realtor.FieldID)
 		for _, f := range fields {
@@ -561,41 +1327,61 @@ func (ruo *RealtorUpdateOne) sqlSave(ctx context.Context) (_node *Realtor, err e
 			}
 		}
 	}
-	if ps := ruo.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := ruo.mutation.UpdateTime(); ok {
+	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(realtor.FieldUpdateTime, field.TypeTime, value)
 	}
-	if value, ok := ruo.mutation.FullName(); ok {
+	if value, ok := _u.mutation.FullName(); ok {
 		_spec.SetField(realtor.FieldFullName, field.TypeString, value)
 	}
-	if value, ok := ruo.mutation.Photo(); ok {
+	if value, ok := _u.mutation.Photo(); ok {
 		_spec.SetField(realtor.FieldPhoto, field.TypeJSON, value)
 	}
-	if ruo.mutation.PhotoCleared() {
+	if _u.mutation.PhotoCleared() {
 		_spec.ClearField(realtor.FieldPhoto, field.TypeJSON)
 	}
-	if value, ok := ruo.mutation.Description(); ok {
+	if value, ok := _u.mutation.Description(); ok {
 		_spec.SetField(realtor.FieldDescription, field.TypeString, value)
 	}
-	if ruo.mutation.DescriptionCleared() {
+	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(realtor.FieldDescription, field.TypeString)
 	}
-	if value, ok := ruo.mutation.Phone(); ok {
+	if value, ok := _u.mutation.Phone(); ok {
 		_spec.SetField(realtor.FieldPhone, field.TypeString, value)
 	}
-	if value, ok := ruo.mutation.Email(); ok {
+	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(realtor.FieldEmail, field.TypeString, value)
 	}
-	if value, ok := ruo.mutation.IsMvp(); ok {
+	if value, ok := _u.mutation.IsMvp(); ok {
 		_spec.SetField(realtor.FieldIsMvp, field.TypeBool, value)
 	}
-	if ruo.mutation.ListingsCleared() {
+	if value, ok := _u.mutation.ServiceAreas(); ok {
+		_spec.SetField(realtor.FieldServiceAreas, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedServiceAreas(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, realtor.FieldServiceAreas, value)
+		})
+	}
+	if _u.mutation.ServiceAreasCleared() {
+		_spec.ClearField(realtor.FieldServiceAreas, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(realtor.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeactivatedAt(); ok {
+		_spec.SetField(realtor.FieldDeactivatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeactivatedAtCleared() {
+		_spec.ClearField(realtor.FieldDeactivatedAt, field.TypeTime)
+	}
+	if _u.mutation.ListingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -608,7 +1394,7 @@ func (ruo *RealtorUpdateOne) sqlSave(ctx context.Context) (_node *Realtor, err e
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ruo.mutation.RemovedListingsIDs(); len(nodes) > 0 && !ruo.mutation.ListingsCleared() {
+	if nodes := _u.mutation.RemovedListingsIDs(); len(nodes) > 0 && !_u.mutation.ListingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -624,7 +1410,7 @@ func (ruo *RealtorUpdateOne) sqlSave(ctx context.Context) (_node *Realtor, err e
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := ruo.mutation.ListingsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ListingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
@@ -640,10 +1426,232 @@ func (ruo *RealtorUpdateOne) sqlSave(ctx context.Context) (_node *Realtor, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_node = &Realtor{config: ruo.config}
+	if _u.mutation.BuyerSideSalesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.BuyerSideSalesTable,
+			Columns: []string{realtor.BuyerSideSalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBuyerSideSalesIDs(); len(nodes) > 0 && !_u.mutation.BuyerSideSalesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.BuyerSideSalesTable,
+			Columns: []string{realtor.BuyerSideSalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BuyerSideSalesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.BuyerSideSalesTable,
+			Columns: []string{realtor.BuyerSideSalesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(listing.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   realtor.UserTable,
+			Columns: []string{realtor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   realtor.UserTable,
+			Columns: []string{realtor.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.InvitesTable,
+			Columns: []string{realtor.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtorinvite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInvitesIDs(); len(nodes) > 0 && !_u.mutation.InvitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.InvitesTable,
+			Columns: []string{realtor.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtorinvite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InvitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.InvitesTable,
+			Columns: []string{realtor.InvitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtorinvite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BrokerageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtor.BrokerageTable,
+			Columns: []string{realtor.BrokerageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(brokerage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BrokerageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtor.BrokerageTable,
+			Columns: []string{realtor.BrokerageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(brokerage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtor.TeamTable,
+			Columns: []string{realtor.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtor.TeamTable,
+			Columns: []string{realtor.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LedTeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.LedTeamsTable,
+			Columns: []string{realtor.LedTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLedTeamsIDs(); len(nodes) > 0 && !_u.mutation.LedTeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.LedTeamsTable,
+			Columns: []string{realtor.LedTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LedTeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   realtor.LedTeamsTable,
+			Columns: []string{realtor.LedTeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Realtor{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
-	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{realtor.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -651,6 +1659,6 @@ func (ruo *RealtorUpdateOne) sqlSave(ctx context.Context) (_node *Realtor, err e
 		}
 		return nil, err
 	}
-	ruo.mutation.done = true
+	_u.mutation.done = true
 	return _node, nil
 }
@@ -0,0 +1,242 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+)
+
+// RealtorInvite is the model entity for the RealtorInvite schema.
+type RealtorInvite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// RealtorID holds the value of the "realtor_id" field.
+	RealtorID uuid.UUID `json:"realtor_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// InvitedBy holds the value of the "invited_by" field.
+	InvitedBy uuid.UUID `json:"invited_by,omitempty"`
+	// Status holds the value of the "status" field.
+	Status realtorinvite.Status `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// AcceptedBy holds the value of the "accepted_by" field.
+	AcceptedBy *uuid.UUID `json:"accepted_by,omitempty"`
+	// AcceptedAt holds the value of the "accepted_at" field.
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RealtorInviteQuery when eager-loading is set.
+	Edges        RealtorInviteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RealtorInviteEdges holds the relations/edges for other nodes in the graph.
+type RealtorInviteEdges struct {
+	// Realtor holds the value of the realtor edge.
+	Realtor *Realtor `json:"realtor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RealtorOrErr returns the Realtor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RealtorInviteEdges) RealtorOrErr() (*Realtor, error) {
+	if e.Realtor != nil {
+		return e.Realtor, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: realtor.Label}
+	}
+	return nil, &NotLoadedError{edge: "realtor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RealtorInvite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case realtorinvite.FieldAcceptedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case realtorinvite.FieldEmail, realtorinvite.FieldTokenHash, realtorinvite.FieldStatus:
+			values[i] = new(sql.NullString)
+		case realtorinvite.FieldCreateTime, realtorinvite.FieldUpdateTime, realtorinvite.FieldExpiresAt, realtorinvite.FieldAcceptedAt:
+			values[i] = new(sql.NullTime)
+		case realtorinvite.FieldID, realtorinvite.FieldRealtorID, realtorinvite.FieldInvitedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RealtorInvite fields.
+func (_m *RealtorInvite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case realtorinvite.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case realtorinvite.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case realtorinvite.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case realtorinvite.FieldRealtorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field realtor_id", values[i])
+			} else if value != nil {
+				_m.RealtorID = *value
+			}
+		case realtorinvite.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				_m.Email = value.String
+			}
+		case realtorinvite.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case realtorinvite.FieldInvitedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field invited_by", values[i])
+			} else if value != nil {
+				_m.InvitedBy = *value
+			}
+		case realtorinvite.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = realtorinvite.Status(value.String)
+			}
+		case realtorinvite.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case realtorinvite.FieldAcceptedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_by", values[i])
+			} else if value.Valid {
+				_m.AcceptedBy = new(uuid.UUID)
+				*_m.AcceptedBy = *value.S.(*uuid.UUID)
+			}
+		case realtorinvite.FieldAcceptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted_at", values[i])
+			} else if value.Valid {
+				_m.AcceptedAt = new(time.Time)
+				*_m.AcceptedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RealtorInvite.
+// This includes values selected through modifiers, order, etc.
+func (_m *RealtorInvite) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRealtor queries the "realtor" edge of the RealtorInvite entity.
+func (_m *RealtorInvite) QueryRealtor() *RealtorQuery {
+	return NewRealtorInviteClient(_m.config).QueryRealtor(_m)
+}
+
+// Update returns a builder for updating this RealtorInvite.
+// Note that you need to call RealtorInvite.Unwrap() before calling this method if this RealtorInvite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RealtorInvite) Update() *RealtorInviteUpdateOne {
+	return NewRealtorInviteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RealtorInvite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RealtorInvite) Unwrap() *RealtorInvite {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RealtorInvite is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RealtorInvite) String() string {
+	var builder strings.Builder
+	builder.WriteString("RealtorInvite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("realtor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RealtorID))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(_m.Email)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("invited_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvitedBy))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.AcceptedBy; v != nil {
+		builder.WriteString("accepted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AcceptedAt; v != nil {
+		builder.WriteString("accepted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RealtorInvites is a parsable slice of RealtorInvite.
+type RealtorInvites []*RealtorInvite
@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package realtorinvite
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// RealtorID applies equality check predicate on the "realtor_id" field. It's identical to RealtorIDEQ.
+func RealtorID(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldRealtorID, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldEmail, v))
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// InvitedBy applies equality check predicate on the "invited_by" field. It's identical to InvitedByEQ.
+func InvitedBy(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldInvitedBy, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// AcceptedBy applies equality check predicate on the "accepted_by" field. It's identical to AcceptedByEQ.
+func AcceptedBy(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldAcceptedBy, v))
+}
+
+// AcceptedAt applies equality check predicate on the "accepted_at" field. It's identical to AcceptedAtEQ.
+func AcceptedAt(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// RealtorIDEQ applies the EQ predicate on the "realtor_id" field.
+func RealtorIDEQ(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldRealtorID, v))
+}
+
+// RealtorIDNEQ applies the NEQ predicate on the "realtor_id" field.
+func RealtorIDNEQ(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNEQ(FieldRealtorID, v))
+}
+
+// RealtorIDIn applies the In predicate on the "realtor_id" field.
+func RealtorIDIn(vs ...uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIn(FieldRealtorID, vs...))
+}
+
+// RealtorIDNotIn applies the NotIn predicate on the "realtor_id" field.
+func RealtorIDNotIn(vs ...uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotIn(FieldRealtorID, vs...))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNEQ(FieldTokenHash, v))
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIn(FieldTokenHash, vs...))
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotIn(FieldTokenHash, vs...))
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGT(FieldTokenHash, v))
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGTE(FieldTokenHash, v))
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLT(FieldTokenHash, v))
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLTE(FieldTokenHash, v))
+}
+
+// TokenHashContains applies the Contains predicate on the "token_hash" field.
+func TokenHashContains(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldContains(FieldTokenHash, v))
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "token_hash" field.
+func TokenHashHasPrefix(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldHasPrefix(FieldTokenHash, v))
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "token_hash" field.
+func TokenHashHasSuffix(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldHasSuffix(FieldTokenHash, v))
+}
+
+// TokenHashIsNil applies the IsNil predicate on the "token_hash" field.
+func TokenHashIsNil() predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIsNull(FieldTokenHash))
+}
+
+// TokenHashNotNil applies the NotNil predicate on the "token_hash" field.
+func TokenHashNotNil() predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotNull(FieldTokenHash))
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "token_hash" field.
+func TokenHashEqualFold(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEqualFold(FieldTokenHash, v))
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "token_hash" field.
+func TokenHashContainsFold(v string) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldContainsFold(FieldTokenHash, v))
+}
+
+// InvitedByEQ applies the EQ predicate on the "invited_by" field.
+func InvitedByEQ(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldInvitedBy, v))
+}
+
+// InvitedByNEQ applies the NEQ predicate on the "invited_by" field.
+func InvitedByNEQ(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNEQ(FieldInvitedBy, v))
+}
+
+// InvitedByIn applies the In predicate on the "invited_by" field.
+func InvitedByIn(vs ...uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIn(FieldInvitedBy, vs...))
+}
+
+// InvitedByNotIn applies the NotIn predicate on the "invited_by" field.
+func InvitedByNotIn(vs ...uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotIn(FieldInvitedBy, vs...))
+}
+
+// InvitedByGT applies the GT predicate on the "invited_by" field.
+func InvitedByGT(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGT(FieldInvitedBy, v))
+}
+
+// InvitedByGTE applies the GTE predicate on the "invited_by" field.
+func InvitedByGTE(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGTE(FieldInvitedBy, v))
+}
+
+// InvitedByLT applies the LT predicate on the "invited_by" field.
+func InvitedByLT(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLT(FieldInvitedBy, v))
+}
+
+// InvitedByLTE applies the LTE predicate on the "invited_by" field.
+func InvitedByLTE(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLTE(FieldInvitedBy, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// AcceptedByEQ applies the EQ predicate on the "accepted_by" field.
+func AcceptedByEQ(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldAcceptedBy, v))
+}
+
+// AcceptedByNEQ applies the NEQ predicate on the "accepted_by" field.
+func AcceptedByNEQ(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNEQ(FieldAcceptedBy, v))
+}
+
+// AcceptedByIn applies the In predicate on the "accepted_by" field.
+func AcceptedByIn(vs ...uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIn(FieldAcceptedBy, vs...))
+}
+
+// AcceptedByNotIn applies the NotIn predicate on the "accepted_by" field.
+func AcceptedByNotIn(vs ...uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotIn(FieldAcceptedBy, vs...))
+}
+
+// AcceptedByGT applies the GT predicate on the "accepted_by" field.
+func AcceptedByGT(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGT(FieldAcceptedBy, v))
+}
+
+// AcceptedByGTE applies the GTE predicate on the "accepted_by" field.
+func AcceptedByGTE(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGTE(FieldAcceptedBy, v))
+}
+
+// AcceptedByLT applies the LT predicate on the "accepted_by" field.
+func AcceptedByLT(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLT(FieldAcceptedBy, v))
+}
+
+// AcceptedByLTE applies the LTE predicate on the "accepted_by" field.
+func AcceptedByLTE(v uuid.UUID) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLTE(FieldAcceptedBy, v))
+}
+
+// AcceptedByIsNil applies the IsNil predicate on the "accepted_by" field.
+func AcceptedByIsNil() predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIsNull(FieldAcceptedBy))
+}
+
+// AcceptedByNotNil applies the NotNil predicate on the "accepted_by" field.
+func AcceptedByNotNil() predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotNull(FieldAcceptedBy))
+}
+
+// AcceptedAtEQ applies the EQ predicate on the "accepted_at" field.
+func AcceptedAtEQ(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtNEQ applies the NEQ predicate on the "accepted_at" field.
+func AcceptedAtNEQ(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNEQ(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIn applies the In predicate on the "accepted_at" field.
+func AcceptedAtIn(vs ...time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtNotIn applies the NotIn predicate on the "accepted_at" field.
+func AcceptedAtNotIn(vs ...time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotIn(FieldAcceptedAt, vs...))
+}
+
+// AcceptedAtGT applies the GT predicate on the "accepted_at" field.
+func AcceptedAtGT(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtGTE applies the GTE predicate on the "accepted_at" field.
+func AcceptedAtGTE(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldGTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLT applies the LT predicate on the "accepted_at" field.
+func AcceptedAtLT(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLT(FieldAcceptedAt, v))
+}
+
+// AcceptedAtLTE applies the LTE predicate on the "accepted_at" field.
+func AcceptedAtLTE(v time.Time) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldLTE(FieldAcceptedAt, v))
+}
+
+// AcceptedAtIsNil applies the IsNil predicate on the "accepted_at" field.
+func AcceptedAtIsNil() predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldIsNull(FieldAcceptedAt))
+}
+
+// AcceptedAtNotNil applies the NotNil predicate on the "accepted_at" field.
+func AcceptedAtNotNil() predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.FieldNotNull(FieldAcceptedAt))
+}
+
+// HasRealtor applies the HasEdge predicate on the "realtor" edge.
+func HasRealtor() predicate.RealtorInvite {
+	return predicate.RealtorInvite(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RealtorTable, RealtorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRealtorWith applies the HasEdge predicate on the "realtor" edge with a given conditions (other predicates).
+func HasRealtorWith(preds ...predicate.Realtor) predicate.RealtorInvite {
+	return predicate.RealtorInvite(func(s *sql.Selector) {
+		step := newRealtorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RealtorInvite) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RealtorInvite) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RealtorInvite) predicate.RealtorInvite {
+	return predicate.RealtorInvite(sql.NotPredicates(p))
+}
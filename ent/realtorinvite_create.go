@@ -0,0 +1,1078 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+)
+
+// RealtorInviteCreate is the builder for creating a RealtorInvite entity.
+type RealtorInviteCreate struct {
+	config
+	mutation *RealtorInviteMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *RealtorInviteCreate) SetCreateTime(v time.Time) *RealtorInviteCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *RealtorInviteCreate) SetNillableCreateTime(v *time.Time) *RealtorInviteCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *RealtorInviteCreate) SetUpdateTime(v time.Time) *RealtorInviteCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *RealtorInviteCreate) SetNillableUpdateTime(v *time.Time) *RealtorInviteCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (_c *RealtorInviteCreate) SetRealtorID(v uuid.UUID) *RealtorInviteCreate {
+	_c.mutation.SetRealtorID(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *RealtorInviteCreate) SetEmail(v string) *RealtorInviteCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *RealtorInviteCreate) SetTokenHash(v string) *RealtorInviteCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_c *RealtorInviteCreate) SetNillableTokenHash(v *string) *RealtorInviteCreate {
+	if v != nil {
+		_c.SetTokenHash(*v)
+	}
+	return _c
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (_c *RealtorInviteCreate) SetInvitedBy(v uuid.UUID) *RealtorInviteCreate {
+	_c.mutation.SetInvitedBy(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *RealtorInviteCreate) SetStatus(v realtorinvite.Status) *RealtorInviteCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *RealtorInviteCreate) SetNillableStatus(v *realtorinvite.Status) *RealtorInviteCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *RealtorInviteCreate) SetExpiresAt(v time.Time) *RealtorInviteCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (_c *RealtorInviteCreate) SetAcceptedBy(v uuid.UUID) *RealtorInviteCreate {
+	_c.mutation.SetAcceptedBy(v)
+	return _c
+}
+
+// SetNillableAcceptedBy sets the "accepted_by" field if the given value is not nil.
+func (_c *RealtorInviteCreate) SetNillableAcceptedBy(v *uuid.UUID) *RealtorInviteCreate {
+	if v != nil {
+		_c.SetAcceptedBy(*v)
+	}
+	return _c
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (_c *RealtorInviteCreate) SetAcceptedAt(v time.Time) *RealtorInviteCreate {
+	_c.mutation.SetAcceptedAt(v)
+	return _c
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (_c *RealtorInviteCreate) SetNillableAcceptedAt(v *time.Time) *RealtorInviteCreate {
+	if v != nil {
+		_c.SetAcceptedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RealtorInviteCreate) SetID(v uuid.UUID) *RealtorInviteCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RealtorInviteCreate) SetNillableID(v *uuid.UUID) *RealtorInviteCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRealtor sets the "realtor" edge to the Realtor entity.
+func (_c *RealtorInviteCreate) SetRealtor(v *Realtor) *RealtorInviteCreate {
+	return _c.SetRealtorID(v.ID)
+}
+
+// Mutation returns the RealtorInviteMutation object of the builder.
+func (_c *RealtorInviteCreate) Mutation() *RealtorInviteMutation {
+	return _c.mutation
+}
+
+// Save creates the RealtorInvite in the database.
+func (_c *RealtorInviteCreate) Save(ctx context.Context) (*RealtorInvite, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RealtorInviteCreate) SaveX(ctx context.Context) *RealtorInvite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RealtorInviteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RealtorInviteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RealtorInviteCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if realtorinvite.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized realtorinvite.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := realtorinvite.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if realtorinvite.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized realtorinvite.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := realtorinvite.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := realtorinvite.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if realtorinvite.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized realtorinvite.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := realtorinvite.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RealtorInviteCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "RealtorInvite.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "RealtorInvite.update_time"`)}
+	}
+	if _, ok := _c.mutation.RealtorID(); !ok {
+		return &ValidationError{Name: "realtor_id", err: errors.New(`ent: missing required field "RealtorInvite.realtor_id"`)}
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "RealtorInvite.email"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := realtorinvite.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "RealtorInvite.email": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := realtorinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "RealtorInvite.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InvitedBy(); !ok {
+		return &ValidationError{Name: "invited_by", err: errors.New(`ent: missing required field "RealtorInvite.invited_by"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RealtorInvite.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := realtorinvite.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RealtorInvite.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "RealtorInvite.expires_at"`)}
+	}
+	if len(_c.mutation.RealtorIDs()) == 0 {
+		return &ValidationError{Name: "realtor", err: errors.New(`ent: missing required edge "RealtorInvite.realtor"`)}
+	}
+	return nil
+}
+
+func (_c *RealtorInviteCreate) sqlSave(ctx context.Context) (*RealtorInvite, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RealtorInviteCreate) createSpec() (*RealtorInvite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RealtorInvite{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(realtorinvite.Table, sqlgraph.NewFieldSpec(realtorinvite.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(realtorinvite.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(realtorinvite.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(realtorinvite.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(realtorinvite.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.InvitedBy(); ok {
+		_spec.SetField(realtorinvite.FieldInvitedBy, field.TypeUUID, value)
+		_node.InvitedBy = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(realtorinvite.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(realtorinvite.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.AcceptedBy(); ok {
+		_spec.SetField(realtorinvite.FieldAcceptedBy, field.TypeUUID, value)
+		_node.AcceptedBy = &value
+	}
+	if value, ok := _c.mutation.AcceptedAt(); ok {
+		_spec.SetField(realtorinvite.FieldAcceptedAt, field.TypeTime, value)
+		_node.AcceptedAt = &value
+	}
+	if nodes := _c.mutation.RealtorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtorinvite.RealtorTable,
+			Columns: []string{realtorinvite.RealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RealtorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RealtorInvite.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RealtorInviteUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RealtorInviteCreate) OnConflict(opts ...sql.ConflictOption) *RealtorInviteUpsertOne {
+	_c.conflict = opts
+	return &RealtorInviteUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RealtorInvite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RealtorInviteCreate) OnConflictColumns(columns ...string) *RealtorInviteUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RealtorInviteUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RealtorInviteUpsertOne is the builder for "upsert"-ing
+	//  one RealtorInvite node.
+	RealtorInviteUpsertOne struct {
+		create *RealtorInviteCreate
+	}
+
+	// RealtorInviteUpsert is the "OnConflict" setter.
+	RealtorInviteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *RealtorInviteUpsert) SetUpdateTime(v time.Time) *RealtorInviteUpsert {
+	u.Set(realtorinvite.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *RealtorInviteUpsert) UpdateUpdateTime() *RealtorInviteUpsert {
+	u.SetExcluded(realtorinvite.FieldUpdateTime)
+	return u
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (u *RealtorInviteUpsert) SetRealtorID(v uuid.UUID) *RealtorInviteUpsert {
+	u.Set(realtorinvite.FieldRealtorID, v)
+	return u
+}
+
+// UpdateRealtorID sets the "realtor_id" field to the value that was provided on create.
+func (u *RealtorInviteUpsert) UpdateRealtorID() *RealtorInviteUpsert {
+	u.SetExcluded(realtorinvite.FieldRealtorID)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *RealtorInviteUpsert) SetEmail(v string) *RealtorInviteUpsert {
+	u.Set(realtorinvite.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *RealtorInviteUpsert) UpdateEmail() *RealtorInviteUpsert {
+	u.SetExcluded(realtorinvite.FieldEmail)
+	return u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *RealtorInviteUpsert) SetTokenHash(v string) *RealtorInviteUpsert {
+	u.Set(realtorinvite.FieldTokenHash, v)
+	return u
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *RealtorInviteUpsert) UpdateTokenHash() *RealtorInviteUpsert {
+	u.SetExcluded(realtorinvite.FieldTokenHash)
+	return u
+}
+
+// ClearTokenHash clears the value of the "token_hash" field.
+func (u *RealtorInviteUpsert) ClearTokenHash() *RealtorInviteUpsert {
+	u.SetNull(realtorinvite.FieldTokenHash)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *RealtorInviteUpsert) SetStatus(v realtorinvite.Status) *RealtorInviteUpsert {
+	u.Set(realtorinvite.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *RealtorInviteUpsert) UpdateStatus() *RealtorInviteUpsert {
+	u.SetExcluded(realtorinvite.FieldStatus)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *RealtorInviteUpsert) SetExpiresAt(v time.Time) *RealtorInviteUpsert {
+	u.Set(realtorinvite.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *RealtorInviteUpsert) UpdateExpiresAt() *RealtorInviteUpsert {
+	u.SetExcluded(realtorinvite.FieldExpiresAt)
+	return u
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (u *RealtorInviteUpsert) SetAcceptedBy(v uuid.UUID) *RealtorInviteUpsert {
+	u.Set(realtorinvite.FieldAcceptedBy, v)
+	return u
+}
+
+// UpdateAcceptedBy sets the "accepted_by" field to the value that was provided on create.
+func (u *RealtorInviteUpsert) UpdateAcceptedBy() *RealtorInviteUpsert {
+	u.SetExcluded(realtorinvite.FieldAcceptedBy)
+	return u
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (u *RealtorInviteUpsert) ClearAcceptedBy() *RealtorInviteUpsert {
+	u.SetNull(realtorinvite.FieldAcceptedBy)
+	return u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *RealtorInviteUpsert) SetAcceptedAt(v time.Time) *RealtorInviteUpsert {
+	u.Set(realtorinvite.FieldAcceptedAt, v)
+	return u
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *RealtorInviteUpsert) UpdateAcceptedAt() *RealtorInviteUpsert {
+	u.SetExcluded(realtorinvite.FieldAcceptedAt)
+	return u
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *RealtorInviteUpsert) ClearAcceptedAt() *RealtorInviteUpsert {
+	u.SetNull(realtorinvite.FieldAcceptedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RealtorInvite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(realtorinvite.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RealtorInviteUpsertOne) UpdateNewValues() *RealtorInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(realtorinvite.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(realtorinvite.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.InvitedBy(); exists {
+			s.SetIgnore(realtorinvite.FieldInvitedBy)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RealtorInvite.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RealtorInviteUpsertOne) Ignore() *RealtorInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RealtorInviteUpsertOne) DoNothing() *RealtorInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RealtorInviteCreate.OnConflict
+// documentation for more info.
+func (u *RealtorInviteUpsertOne) Update(set func(*RealtorInviteUpsert)) *RealtorInviteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RealtorInviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *RealtorInviteUpsertOne) SetUpdateTime(v time.Time) *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *RealtorInviteUpsertOne) UpdateUpdateTime() *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (u *RealtorInviteUpsertOne) SetRealtorID(v uuid.UUID) *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetRealtorID(v)
+	})
+}
+
+// UpdateRealtorID sets the "realtor_id" field to the value that was provided on create.
+func (u *RealtorInviteUpsertOne) UpdateRealtorID() *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateRealtorID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *RealtorInviteUpsertOne) SetEmail(v string) *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *RealtorInviteUpsertOne) UpdateEmail() *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *RealtorInviteUpsertOne) SetTokenHash(v string) *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *RealtorInviteUpsertOne) UpdateTokenHash() *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// ClearTokenHash clears the value of the "token_hash" field.
+func (u *RealtorInviteUpsertOne) ClearTokenHash() *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.ClearTokenHash()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *RealtorInviteUpsertOne) SetStatus(v realtorinvite.Status) *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *RealtorInviteUpsertOne) UpdateStatus() *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *RealtorInviteUpsertOne) SetExpiresAt(v time.Time) *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *RealtorInviteUpsertOne) UpdateExpiresAt() *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (u *RealtorInviteUpsertOne) SetAcceptedBy(v uuid.UUID) *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetAcceptedBy(v)
+	})
+}
+
+// UpdateAcceptedBy sets the "accepted_by" field to the value that was provided on create.
+func (u *RealtorInviteUpsertOne) UpdateAcceptedBy() *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateAcceptedBy()
+	})
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (u *RealtorInviteUpsertOne) ClearAcceptedBy() *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.ClearAcceptedBy()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *RealtorInviteUpsertOne) SetAcceptedAt(v time.Time) *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *RealtorInviteUpsertOne) UpdateAcceptedAt() *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *RealtorInviteUpsertOne) ClearAcceptedAt() *RealtorInviteUpsertOne {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RealtorInviteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RealtorInviteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RealtorInviteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RealtorInviteUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RealtorInviteUpsertOne.ID is not supported by MySQL driver. Use RealtorInviteUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RealtorInviteUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RealtorInviteCreateBulk is the builder for creating many RealtorInvite entities in bulk.
+type RealtorInviteCreateBulk struct {
+	config
+	err      error
+	builders []*RealtorInviteCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RealtorInvite entities in the database.
+func (_c *RealtorInviteCreateBulk) Save(ctx context.Context) ([]*RealtorInvite, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RealtorInvite, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RealtorInviteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RealtorInviteCreateBulk) SaveX(ctx context.Context) []*RealtorInvite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RealtorInviteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RealtorInviteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RealtorInvite.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RealtorInviteUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RealtorInviteCreateBulk) OnConflict(opts ...sql.ConflictOption) *RealtorInviteUpsertBulk {
+	_c.conflict = opts
+	return &RealtorInviteUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RealtorInvite.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RealtorInviteCreateBulk) OnConflictColumns(columns ...string) *RealtorInviteUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RealtorInviteUpsertBulk{
+		create: _c,
+	}
+}
+
+// RealtorInviteUpsertBulk is the builder for "upsert"-ing
+// a bulk of RealtorInvite nodes.
+type RealtorInviteUpsertBulk struct {
+	create *RealtorInviteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RealtorInvite.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(realtorinvite.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RealtorInviteUpsertBulk) UpdateNewValues() *RealtorInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(realtorinvite.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(realtorinvite.FieldCreateTime)
+			}
+			if _, exists := b.mutation.InvitedBy(); exists {
+				s.SetIgnore(realtorinvite.FieldInvitedBy)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RealtorInvite.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RealtorInviteUpsertBulk) Ignore() *RealtorInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RealtorInviteUpsertBulk) DoNothing() *RealtorInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RealtorInviteCreateBulk.OnConflict
+// documentation for more info.
+func (u *RealtorInviteUpsertBulk) Update(set func(*RealtorInviteUpsert)) *RealtorInviteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RealtorInviteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *RealtorInviteUpsertBulk) SetUpdateTime(v time.Time) *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *RealtorInviteUpsertBulk) UpdateUpdateTime() *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (u *RealtorInviteUpsertBulk) SetRealtorID(v uuid.UUID) *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetRealtorID(v)
+	})
+}
+
+// UpdateRealtorID sets the "realtor_id" field to the value that was provided on create.
+func (u *RealtorInviteUpsertBulk) UpdateRealtorID() *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateRealtorID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *RealtorInviteUpsertBulk) SetEmail(v string) *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *RealtorInviteUpsertBulk) UpdateEmail() *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (u *RealtorInviteUpsertBulk) SetTokenHash(v string) *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetTokenHash(v)
+	})
+}
+
+// UpdateTokenHash sets the "token_hash" field to the value that was provided on create.
+func (u *RealtorInviteUpsertBulk) UpdateTokenHash() *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateTokenHash()
+	})
+}
+
+// ClearTokenHash clears the value of the "token_hash" field.
+func (u *RealtorInviteUpsertBulk) ClearTokenHash() *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.ClearTokenHash()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *RealtorInviteUpsertBulk) SetStatus(v realtorinvite.Status) *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *RealtorInviteUpsertBulk) UpdateStatus() *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *RealtorInviteUpsertBulk) SetExpiresAt(v time.Time) *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *RealtorInviteUpsertBulk) UpdateExpiresAt() *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (u *RealtorInviteUpsertBulk) SetAcceptedBy(v uuid.UUID) *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetAcceptedBy(v)
+	})
+}
+
+// UpdateAcceptedBy sets the "accepted_by" field to the value that was provided on create.
+func (u *RealtorInviteUpsertBulk) UpdateAcceptedBy() *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateAcceptedBy()
+	})
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (u *RealtorInviteUpsertBulk) ClearAcceptedBy() *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.ClearAcceptedBy()
+	})
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (u *RealtorInviteUpsertBulk) SetAcceptedAt(v time.Time) *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.SetAcceptedAt(v)
+	})
+}
+
+// UpdateAcceptedAt sets the "accepted_at" field to the value that was provided on create.
+func (u *RealtorInviteUpsertBulk) UpdateAcceptedAt() *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.UpdateAcceptedAt()
+	})
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (u *RealtorInviteUpsertBulk) ClearAcceptedAt() *RealtorInviteUpsertBulk {
+	return u.Update(func(s *RealtorInviteUpsert) {
+		s.ClearAcceptedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RealtorInviteUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RealtorInviteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RealtorInviteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RealtorInviteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+)
+
+// RealtorInviteUpdate is the builder for updating RealtorInvite entities.
+type RealtorInviteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RealtorInviteMutation
+}
+
+// Where appends a list predicates to the RealtorInviteUpdate builder.
+func (_u *RealtorInviteUpdate) Where(ps ...predicate.RealtorInvite) *RealtorInviteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *RealtorInviteUpdate) SetUpdateTime(v time.Time) *RealtorInviteUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (_u *RealtorInviteUpdate) SetRealtorID(v uuid.UUID) *RealtorInviteUpdate {
+	_u.mutation.SetRealtorID(v)
+	return _u
+}
+
+// SetNillableRealtorID sets the "realtor_id" field if the given value is not nil.
+func (_u *RealtorInviteUpdate) SetNillableRealtorID(v *uuid.UUID) *RealtorInviteUpdate {
+	if v != nil {
+		_u.SetRealtorID(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *RealtorInviteUpdate) SetEmail(v string) *RealtorInviteUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *RealtorInviteUpdate) SetNillableEmail(v *string) *RealtorInviteUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *RealtorInviteUpdate) SetTokenHash(v string) *RealtorInviteUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *RealtorInviteUpdate) SetNillableTokenHash(v *string) *RealtorInviteUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearTokenHash clears the value of the "token_hash" field.
+func (_u *RealtorInviteUpdate) ClearTokenHash() *RealtorInviteUpdate {
+	_u.mutation.ClearTokenHash()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RealtorInviteUpdate) SetStatus(v realtorinvite.Status) *RealtorInviteUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RealtorInviteUpdate) SetNillableStatus(v *realtorinvite.Status) *RealtorInviteUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *RealtorInviteUpdate) SetExpiresAt(v time.Time) *RealtorInviteUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *RealtorInviteUpdate) SetNillableExpiresAt(v *time.Time) *RealtorInviteUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (_u *RealtorInviteUpdate) SetAcceptedBy(v uuid.UUID) *RealtorInviteUpdate {
+	_u.mutation.SetAcceptedBy(v)
+	return _u
+}
+
+// SetNillableAcceptedBy sets the "accepted_by" field if the given value is not nil.
+func (_u *RealtorInviteUpdate) SetNillableAcceptedBy(v *uuid.UUID) *RealtorInviteUpdate {
+	if v != nil {
+		_u.SetAcceptedBy(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (_u *RealtorInviteUpdate) ClearAcceptedBy() *RealtorInviteUpdate {
+	_u.mutation.ClearAcceptedBy()
+	return _u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (_u *RealtorInviteUpdate) SetAcceptedAt(v time.Time) *RealtorInviteUpdate {
+	_u.mutation.SetAcceptedAt(v)
+	return _u
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (_u *RealtorInviteUpdate) SetNillableAcceptedAt(v *time.Time) *RealtorInviteUpdate {
+	if v != nil {
+		_u.SetAcceptedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (_u *RealtorInviteUpdate) ClearAcceptedAt() *RealtorInviteUpdate {
+	_u.mutation.ClearAcceptedAt()
+	return _u
+}
+
+// SetRealtor sets the "realtor" edge to the Realtor entity.
+func (_u *RealtorInviteUpdate) SetRealtor(v *Realtor) *RealtorInviteUpdate {
+	return _u.SetRealtorID(v.ID)
+}
+
+// Mutation returns the RealtorInviteMutation object of the builder.
+func (_u *RealtorInviteUpdate) Mutation() *RealtorInviteMutation {
+	return _u.mutation
+}
+
+// ClearRealtor clears the "realtor" edge to the Realtor entity.
+func (_u *RealtorInviteUpdate) ClearRealtor() *RealtorInviteUpdate {
+	_u.mutation.ClearRealtor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RealtorInviteUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RealtorInviteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RealtorInviteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RealtorInviteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RealtorInviteUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if realtorinvite.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized realtorinvite.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := realtorinvite.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RealtorInviteUpdate) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := realtorinvite.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "RealtorInvite.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := realtorinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "RealtorInvite.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := realtorinvite.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RealtorInvite.status": %w`, err)}
+		}
+	}
+	if _u.mutation.RealtorCleared() && len(_u.mutation.RealtorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RealtorInvite.realtor"`)
+	}
+	return nil
+}
+
+func (_u *RealtorInviteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(realtorinvite.Table, realtorinvite.Columns, sqlgraph.NewFieldSpec(realtorinvite.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(realtorinvite.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(realtorinvite.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(realtorinvite.FieldTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.TokenHashCleared() {
+		_spec.ClearField(realtorinvite.FieldTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(realtorinvite.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(realtorinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AcceptedBy(); ok {
+		_spec.SetField(realtorinvite.FieldAcceptedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.AcceptedByCleared() {
+		_spec.ClearField(realtorinvite.FieldAcceptedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AcceptedAt(); ok {
+		_spec.SetField(realtorinvite.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcceptedAtCleared() {
+		_spec.ClearField(realtorinvite.FieldAcceptedAt, field.TypeTime)
+	}
+	if _u.mutation.RealtorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtorinvite.RealtorTable,
+			Columns: []string{realtorinvite.RealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RealtorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtorinvite.RealtorTable,
+			Columns: []string{realtorinvite.RealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{realtorinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RealtorInviteUpdateOne is the builder for updating a single RealtorInvite entity.
+type RealtorInviteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RealtorInviteMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *RealtorInviteUpdateOne) SetUpdateTime(v time.Time) *RealtorInviteUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetRealtorID sets the "realtor_id" field.
+func (_u *RealtorInviteUpdateOne) SetRealtorID(v uuid.UUID) *RealtorInviteUpdateOne {
+	_u.mutation.SetRealtorID(v)
+	return _u
+}
+
+// SetNillableRealtorID sets the "realtor_id" field if the given value is not nil.
+func (_u *RealtorInviteUpdateOne) SetNillableRealtorID(v *uuid.UUID) *RealtorInviteUpdateOne {
+	if v != nil {
+		_u.SetRealtorID(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *RealtorInviteUpdateOne) SetEmail(v string) *RealtorInviteUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *RealtorInviteUpdateOne) SetNillableEmail(v *string) *RealtorInviteUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *RealtorInviteUpdateOne) SetTokenHash(v string) *RealtorInviteUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *RealtorInviteUpdateOne) SetNillableTokenHash(v *string) *RealtorInviteUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearTokenHash clears the value of the "token_hash" field.
+func (_u *RealtorInviteUpdateOne) ClearTokenHash() *RealtorInviteUpdateOne {
+	_u.mutation.ClearTokenHash()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RealtorInviteUpdateOne) SetStatus(v realtorinvite.Status) *RealtorInviteUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RealtorInviteUpdateOne) SetNillableStatus(v *realtorinvite.Status) *RealtorInviteUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *RealtorInviteUpdateOne) SetExpiresAt(v time.Time) *RealtorInviteUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *RealtorInviteUpdateOne) SetNillableExpiresAt(v *time.Time) *RealtorInviteUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetAcceptedBy sets the "accepted_by" field.
+func (_u *RealtorInviteUpdateOne) SetAcceptedBy(v uuid.UUID) *RealtorInviteUpdateOne {
+	_u.mutation.SetAcceptedBy(v)
+	return _u
+}
+
+// SetNillableAcceptedBy sets the "accepted_by" field if the given value is not nil.
+func (_u *RealtorInviteUpdateOne) SetNillableAcceptedBy(v *uuid.UUID) *RealtorInviteUpdateOne {
+	if v != nil {
+		_u.SetAcceptedBy(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedBy clears the value of the "accepted_by" field.
+func (_u *RealtorInviteUpdateOne) ClearAcceptedBy() *RealtorInviteUpdateOne {
+	_u.mutation.ClearAcceptedBy()
+	return _u
+}
+
+// SetAcceptedAt sets the "accepted_at" field.
+func (_u *RealtorInviteUpdateOne) SetAcceptedAt(v time.Time) *RealtorInviteUpdateOne {
+	_u.mutation.SetAcceptedAt(v)
+	return _u
+}
+
+// SetNillableAcceptedAt sets the "accepted_at" field if the given value is not nil.
+func (_u *RealtorInviteUpdateOne) SetNillableAcceptedAt(v *time.Time) *RealtorInviteUpdateOne {
+	if v != nil {
+		_u.SetAcceptedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcceptedAt clears the value of the "accepted_at" field.
+func (_u *RealtorInviteUpdateOne) ClearAcceptedAt() *RealtorInviteUpdateOne {
+	_u.mutation.ClearAcceptedAt()
+	return _u
+}
+
+// SetRealtor sets the "realtor" edge to the Realtor entity.
+func (_u *RealtorInviteUpdateOne) SetRealtor(v *Realtor) *RealtorInviteUpdateOne {
+	return _u.SetRealtorID(v.ID)
+}
+
+// Mutation returns the RealtorInviteMutation object of the builder.
+func (_u *RealtorInviteUpdateOne) Mutation() *RealtorInviteMutation {
+	return _u.mutation
+}
+
+// ClearRealtor clears the "realtor" edge to the Realtor entity.
+func (_u *RealtorInviteUpdateOne) ClearRealtor() *RealtorInviteUpdateOne {
+	_u.mutation.ClearRealtor()
+	return _u
+}
+
+// Where appends a list predicates to the RealtorInviteUpdate builder.
+func (_u *RealtorInviteUpdateOne) Where(ps ...predicate.RealtorInvite) *RealtorInviteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RealtorInviteUpdateOne) Select(field string, fields ...string) *RealtorInviteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RealtorInvite entity.
+func (_u *RealtorInviteUpdateOne) Save(ctx context.Context) (*RealtorInvite, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RealtorInviteUpdateOne) SaveX(ctx context.Context) *RealtorInvite {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RealtorInviteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RealtorInviteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RealtorInviteUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if realtorinvite.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized realtorinvite.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := realtorinvite.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RealtorInviteUpdateOne) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := realtorinvite.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "RealtorInvite.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := realtorinvite.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "RealtorInvite.token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := realtorinvite.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RealtorInvite.status": %w`, err)}
+		}
+	}
+	if _u.mutation.RealtorCleared() && len(_u.mutation.RealtorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RealtorInvite.realtor"`)
+	}
+	return nil
+}
+
+func (_u *RealtorInviteUpdateOne) sqlSave(ctx context.Context) (_node *RealtorInvite, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(realtorinvite.Table, realtorinvite.Columns, sqlgraph.NewFieldSpec(realtorinvite.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RealtorInvite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, realtorinvite.FieldID)
+		for _, f := range fields {
+			if !realtorinvite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != realtorinvite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(realtorinvite.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(realtorinvite.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(realtorinvite.FieldTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.TokenHashCleared() {
+		_spec.ClearField(realtorinvite.FieldTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(realtorinvite.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(realtorinvite.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.AcceptedBy(); ok {
+		_spec.SetField(realtorinvite.FieldAcceptedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.AcceptedByCleared() {
+		_spec.ClearField(realtorinvite.FieldAcceptedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AcceptedAt(); ok {
+		_spec.SetField(realtorinvite.FieldAcceptedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcceptedAtCleared() {
+		_spec.ClearField(realtorinvite.FieldAcceptedAt, field.TypeTime)
+	}
+	if _u.mutation.RealtorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtorinvite.RealtorTable,
+			Columns: []string{realtorinvite.RealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RealtorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   realtorinvite.RealtorTable,
+			Columns: []string{realtorinvite.RealtorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(realtor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RealtorInvite{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{realtorinvite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
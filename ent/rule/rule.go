@@ -0,0 +1,304 @@
+// Package rule holds the privacy rules used by the ent schema policies.
+package rule
+
+import (
+	"context"
+
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/internal/viewer"
+)
+
+// DenyIfNoViewer denies mutations made without a signed-in user in the context.
+// System tasks bypass the policies with privacy.DecisionContext instead.
+func DenyIfNoViewer() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, _ ent.Mutation) error {
+		if viewer.FromContext(ctx) == nil {
+			return privacy.Denyf("sign in to make changes")
+		}
+		return privacy.Skip
+	})
+}
+
+// AllowIfStaff allows staff users to make any mutation.
+func AllowIfStaff() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, _ ent.Mutation) error {
+		if v := viewer.FromContext(ctx); v != nil && v.IsStaff {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// ManagedListings matches the listings the viewer manages as a realtor: their own and,
+// for team leads, those of their team members. The viewer must have a realtor profile.
+func ManagedListings(v *viewer.Viewer) predicate.Listing {
+	if len(v.LedTeamIDs) == 0 {
+		return listing.RealtorIDEQ(*v.RealtorID)
+	}
+	return listing.Or(
+		listing.RealtorIDEQ(*v.RealtorID),
+		listing.HasRealtorWith(realtor.TeamIDIn(v.LedTeamIDs...)),
+	)
+}
+
+// AllowIfListingRealtor allows the realtor linked to the viewer's account to create and
+// change their own listings, and team leads those of their team. Listings can only be
+// handed to a realtor the viewer manages this way.
+func AllowIfListingRealtor() privacy.MutationRule {
+	return privacy.ListingMutationRuleFunc(func(ctx context.Context, m *ent.ListingMutation) error {
+		v := viewer.FromContext(ctx)
+		if v == nil || v.RealtorID == nil {
+			return privacy.Skip
+		}
+
+		// A realtor being assigned must be the viewer's own or one of their team
+		if realtorID, ok := m.RealtorID(); ok && !v.IsRealtor(realtorID) {
+			if len(v.LedTeamIDs) == 0 {
+				return privacy.Skip
+			}
+			member, err := m.Client().Realtor.Query().
+				Where(realtor.ID(realtorID), realtor.TeamIDIn(v.LedTeamIDs...)).
+				Exist(ctx)
+			if err != nil {
+				return privacy.Denyf("checking listing realtor: %v", err)
+			}
+			if !member {
+				return privacy.Skip
+			}
+		}
+		if m.Op().Is(ent.OpCreate) {
+			if _, ok := m.RealtorID(); ok {
+				return privacy.Allow
+			}
+			return privacy.Skip
+		}
+
+		// Every listing the mutation touches must be managed by the viewer
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return privacy.Denyf("loading listings: %v", err)
+		}
+		owned, err := m.Client().Listing.Query().
+			Where(listing.IDIn(ids...), ManagedListings(v)).
+			Count(ctx)
+		if err != nil {
+			return privacy.Denyf("checking listing realtor: %v", err)
+		}
+		if owned != len(ids) {
+			return privacy.Skip
+		}
+		return privacy.Allow
+	})
+}
+
+// AllowIfRealtorSelf allows the viewer to update the realtor profile linked to their
+// account. Only staff can change the linked account, the brokerage and team, the MVP
+// badge and whether the realtor is active.
+func AllowIfRealtorSelf() privacy.MutationRule {
+	return privacy.RealtorMutationRuleFunc(func(ctx context.Context, m *ent.RealtorMutation) error {
+		v := viewer.FromContext(ctx)
+		if v == nil || !m.Op().Is(ent.OpUpdateOne) {
+			return privacy.Skip
+		}
+		if _, ok := m.UserID(); ok || m.UserIDCleared() {
+			return privacy.Skip
+		}
+		if _, ok := m.BrokerageID(); ok || m.BrokerageIDCleared() {
+			return privacy.Skip
+		}
+		if _, ok := m.TeamID(); ok || m.TeamIDCleared() {
+			return privacy.Skip
+		}
+		if _, ok := m.IsMvp(); ok {
+			return privacy.Skip
+		}
+		if _, ok := m.Active(); ok {
+			return privacy.Skip
+		}
+
+		if id, ok := m.ID(); !ok || !v.IsRealtor(id) {
+			return privacy.Skip
+		}
+		return privacy.Allow
+	})
+}
+
+// AllowIfReviewListingRealtor allows the realtor of a listing and their team lead to
+// submit it for review, update the pending changes and withdraw the review. Deciding
+// reviews is left to staff.
+func AllowIfReviewListingRealtor() privacy.MutationRule {
+	return privacy.ListingReviewMutationRuleFunc(func(ctx context.Context, m *ent.ListingReviewMutation) error {
+		v := viewer.FromContext(ctx)
+		if v == nil || v.RealtorID == nil {
+			return privacy.Skip
+		}
+		if status, ok := m.Status(); ok && status != listingreview.StatusPENDING && status != listingreview.StatusWITHDRAWN {
+			return privacy.Skip
+		}
+		if _, ok := m.Reasons(); ok {
+			return privacy.Skip
+		}
+		if _, ok := m.DecidedBy(); ok {
+			return privacy.Skip
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			listingID, ok := m.ListingID()
+			if !ok {
+				return privacy.Skip
+			}
+			own, err := m.Client().Listing.Query().
+				Where(listing.ID(listingID), ManagedListings(v)).
+				Exist(ctx)
+			if err != nil {
+				return privacy.Denyf("checking listing realtor: %v", err)
+			}
+			if !own {
+				return privacy.Skip
+			}
+			return privacy.Allow
+		}
+
+		// Every review the mutation touches must be of one of the viewer's listings
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return privacy.Denyf("loading reviews: %v", err)
+		}
+		owned, err := m.Client().ListingReview.Query().
+			Where(
+				listingreview.IDIn(ids...),
+				listingreview.HasListingWith(ManagedListings(v)),
+			).
+			Count(ctx)
+		if err != nil {
+			return privacy.Denyf("checking listing realtor: %v", err)
+		}
+		if owned != len(ids) {
+			return privacy.Skip
+		}
+		return privacy.Allow
+	})
+}
+
+// AllowIfOfferParty allows buyers to make offers for themselves and the realtor of a
+// listing or their team lead to counter offers on it, and lets both sides change the
+// offers they take part in. Which side may make which change is checked where offers
+// are answered.
+func AllowIfOfferParty() privacy.MutationRule {
+	return privacy.OfferMutationRuleFunc(func(ctx context.Context, m *ent.OfferMutation) error {
+		v := viewer.FromContext(ctx)
+		if v == nil {
+			return privacy.Skip
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			side, _ := m.Side()
+			buyerID, _ := m.BuyerID()
+			if side == offer.SideBUYER && buyerID == v.UserID {
+				return privacy.Allow
+			}
+			listingID, ok := m.ListingID()
+			if side != offer.SideSELLER || !ok || v.RealtorID == nil {
+				return privacy.Skip
+			}
+			own, err := m.Client().Listing.Query().
+				Where(listing.ID(listingID), ManagedListings(v)).
+				Exist(ctx)
+			if err != nil {
+				return privacy.Denyf("checking listing realtor: %v", err)
+			}
+			if !own {
+				return privacy.Skip
+			}
+			return privacy.Allow
+		}
+
+		// Every offer the mutation touches must be the viewer's or on a listing they manage
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return privacy.Denyf("loading offers: %v", err)
+		}
+		party := offer.BuyerIDEQ(v.UserID)
+		if v.RealtorID != nil {
+			party = offer.Or(party, offer.HasListingWith(ManagedListings(v)))
+		}
+		involved, err := m.Client().Offer.Query().
+			Where(offer.IDIn(ids...), party).
+			Count(ctx)
+		if err != nil {
+			return privacy.Denyf("checking offer parties: %v", err)
+		}
+		if involved != len(ids) {
+			return privacy.Skip
+		}
+		return privacy.Allow
+	})
+}
+
+// AllowIfInquirer allows users to inquire about listings for themselves.
+func AllowIfInquirer() privacy.MutationRule {
+	return privacy.ListingInquiryMutationRuleFunc(func(ctx context.Context, m *ent.ListingInquiryMutation) error {
+		v := viewer.FromContext(ctx)
+		if v == nil || !m.Op().Is(ent.OpCreate) {
+			return privacy.Skip
+		}
+		if userID, ok := m.UserID(); !ok || userID != v.UserID {
+			return privacy.Skip
+		}
+		return privacy.Allow
+	})
+}
+
+// AllowIfDocumentListingRealtor allows the realtor of a listing and their team lead to
+// add documents to it and remove them.
+func AllowIfDocumentListingRealtor() privacy.MutationRule {
+	return privacy.ListingDocumentMutationRuleFunc(func(ctx context.Context, m *ent.ListingDocumentMutation) error {
+		v := viewer.FromContext(ctx)
+		if v == nil || v.RealtorID == nil {
+			return privacy.Skip
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			listingID, ok := m.ListingID()
+			if !ok {
+				return privacy.Skip
+			}
+			own, err := m.Client().Listing.Query().
+				Where(listing.ID(listingID), ManagedListings(v)).
+				Exist(ctx)
+			if err != nil {
+				return privacy.Denyf("checking listing realtor: %v", err)
+			}
+			if !own {
+				return privacy.Skip
+			}
+			return privacy.Allow
+		}
+
+		// Every document the mutation touches must be of one of the viewer's listings
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return privacy.Denyf("loading documents: %v", err)
+		}
+		owned, err := m.Client().ListingDocument.Query().
+			Where(
+				listingdocument.IDIn(ids...),
+				listingdocument.HasListingWith(ManagedListings(v)),
+			).
+			Count(ctx)
+		if err != nil {
+			return privacy.Denyf("checking listing realtor: %v", err)
+		}
+		if owned != len(ids) {
+			return privacy.Skip
+		}
+		return privacy.Allow
+	})
+}
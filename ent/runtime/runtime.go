@@ -2,9 +2,1510 @@
 
 package runtime
 
-// The schema-stitching logic is generated in ppgroup.ppgroup.com/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/brokerage"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/exchangerate"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/listingrenewal"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/listingstatusevent"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/notification"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/pointofinterest"
+	"ppgroup.ppgroup.com/ent/property"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+	"ppgroup.ppgroup.com/ent/schema"
+	"ppgroup.ppgroup.com/ent/team"
+	"ppgroup.ppgroup.com/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	brokerageMixin := schema.Brokerage{}.Mixin()
+	brokerage.Policy = privacy.NewPolicies(schema.Brokerage{})
+	brokerage.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := brokerage.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	brokerageMixinFields0 := brokerageMixin[0].Fields()
+	_ = brokerageMixinFields0
+	brokerageFields := schema.Brokerage{}.Fields()
+	_ = brokerageFields
+	// brokerageDescCreateTime is the schema descriptor for create_time field.
+	brokerageDescCreateTime := brokerageMixinFields0[0].Descriptor()
+	// brokerage.DefaultCreateTime holds the default value on creation for the create_time field.
+	brokerage.DefaultCreateTime = brokerageDescCreateTime.Default.(func() time.Time)
+	// brokerageDescUpdateTime is the schema descriptor for update_time field.
+	brokerageDescUpdateTime := brokerageMixinFields0[1].Descriptor()
+	// brokerage.DefaultUpdateTime holds the default value on creation for the update_time field.
+	brokerage.DefaultUpdateTime = brokerageDescUpdateTime.Default.(func() time.Time)
+	// brokerage.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	brokerage.UpdateDefaultUpdateTime = brokerageDescUpdateTime.UpdateDefault.(func() time.Time)
+	// brokerageDescName is the schema descriptor for name field.
+	brokerageDescName := brokerageFields[1].Descriptor()
+	// brokerage.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	brokerage.NameValidator = func() func(string) error {
+		validators := brokerageDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// brokerageDescLicenseNumber is the schema descriptor for license_number field.
+	brokerageDescLicenseNumber := brokerageFields[2].Descriptor()
+	// brokerage.LicenseNumberValidator is a validator for the "license_number" field. It is called by the builders before save.
+	brokerage.LicenseNumberValidator = func() func(string) error {
+		validators := brokerageDescLicenseNumber.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(license_number string) error {
+			for _, fn := range fns {
+				if err := fn(license_number); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// brokerageDescAddress is the schema descriptor for address field.
+	brokerageDescAddress := brokerageFields[3].Descriptor()
+	// brokerage.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	brokerage.AddressValidator = func() func(string) error {
+		validators := brokerageDescAddress.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(address string) error {
+			for _, fn := range fns {
+				if err := fn(address); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// brokerageDescCity is the schema descriptor for city field.
+	brokerageDescCity := brokerageFields[4].Descriptor()
+	// brokerage.CityValidator is a validator for the "city" field. It is called by the builders before save.
+	brokerage.CityValidator = func() func(string) error {
+		validators := brokerageDescCity.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(city string) error {
+			for _, fn := range fns {
+				if err := fn(city); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// brokerageDescState is the schema descriptor for state field.
+	brokerageDescState := brokerageFields[5].Descriptor()
+	// brokerage.StateValidator is a validator for the "state" field. It is called by the builders before save.
+	brokerage.StateValidator = brokerageDescState.Validators[0].(func(string) error)
+	// brokerageDescZipCode is the schema descriptor for zip_code field.
+	brokerageDescZipCode := brokerageFields[6].Descriptor()
+	// brokerage.ZipCodeValidator is a validator for the "zip_code" field. It is called by the builders before save.
+	brokerage.ZipCodeValidator = func() func(string) error {
+		validators := brokerageDescZipCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(zip_code string) error {
+			for _, fn := range fns {
+				if err := fn(zip_code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// brokerageDescPhone is the schema descriptor for phone field.
+	brokerageDescPhone := brokerageFields[7].Descriptor()
+	// brokerage.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	brokerage.PhoneValidator = func() func(string) error {
+		validators := brokerageDescPhone.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(phone string) error {
+			for _, fn := range fns {
+				if err := fn(phone); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// brokerageDescEmail is the schema descriptor for email field.
+	brokerageDescEmail := brokerageFields[8].Descriptor()
+	// brokerage.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	brokerage.EmailValidator = brokerageDescEmail.Validators[0].(func(string) error)
+	// brokerageDescWebsite is the schema descriptor for website field.
+	brokerageDescWebsite := brokerageFields[9].Descriptor()
+	// brokerage.WebsiteValidator is a validator for the "website" field. It is called by the builders before save.
+	brokerage.WebsiteValidator = brokerageDescWebsite.Validators[0].(func(string) error)
+	// brokerageDescLogoURL is the schema descriptor for logo_url field.
+	brokerageDescLogoURL := brokerageFields[10].Descriptor()
+	// brokerage.LogoURLValidator is a validator for the "logo_url" field. It is called by the builders before save.
+	brokerage.LogoURLValidator = brokerageDescLogoURL.Validators[0].(func(string) error)
+	// brokerageDescPrimaryColor is the schema descriptor for primary_color field.
+	brokerageDescPrimaryColor := brokerageFields[11].Descriptor()
+	// brokerage.PrimaryColorValidator is a validator for the "primary_color" field. It is called by the builders before save.
+	brokerage.PrimaryColorValidator = func() func(string) error {
+		validators := brokerageDescPrimaryColor.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(primary_color string) error {
+			for _, fn := range fns {
+				if err := fn(primary_color); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// brokerageDescSecondaryColor is the schema descriptor for secondary_color field.
+	brokerageDescSecondaryColor := brokerageFields[12].Descriptor()
+	// brokerage.SecondaryColorValidator is a validator for the "secondary_color" field. It is called by the builders before save.
+	brokerage.SecondaryColorValidator = func() func(string) error {
+		validators := brokerageDescSecondaryColor.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(secondary_color string) error {
+			for _, fn := range fns {
+				if err := fn(secondary_color); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// brokerageDescID is the schema descriptor for id field.
+	brokerageDescID := brokerageFields[0].Descriptor()
+	// brokerage.DefaultID holds the default value on creation for the id field.
+	brokerage.DefaultID = brokerageDescID.Default.(func() uuid.UUID)
+	documentdownloadFields := schema.DocumentDownload{}.Fields()
+	_ = documentdownloadFields
+	// documentdownloadDescUserEmail is the schema descriptor for user_email field.
+	documentdownloadDescUserEmail := documentdownloadFields[2].Descriptor()
+	// documentdownload.UserEmailValidator is a validator for the "user_email" field. It is called by the builders before save.
+	documentdownload.UserEmailValidator = documentdownloadDescUserEmail.Validators[0].(func(string) error)
+	// documentdownloadDescIPAddress is the schema descriptor for ip_address field.
+	documentdownloadDescIPAddress := documentdownloadFields[3].Descriptor()
+	// documentdownload.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	documentdownload.IPAddressValidator = documentdownloadDescIPAddress.Validators[0].(func(string) error)
+	// documentdownloadDescUserAgent is the schema descriptor for user_agent field.
+	documentdownloadDescUserAgent := documentdownloadFields[4].Descriptor()
+	// documentdownload.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	documentdownload.UserAgentValidator = documentdownloadDescUserAgent.Validators[0].(func(string) error)
+	// documentdownloadDescDownloadedAt is the schema descriptor for downloaded_at field.
+	documentdownloadDescDownloadedAt := documentdownloadFields[5].Descriptor()
+	// documentdownload.DefaultDownloadedAt holds the default value on creation for the downloaded_at field.
+	documentdownload.DefaultDownloadedAt = documentdownloadDescDownloadedAt.Default.(func() time.Time)
+	// documentdownloadDescID is the schema descriptor for id field.
+	documentdownloadDescID := documentdownloadFields[0].Descriptor()
+	// documentdownload.DefaultID holds the default value on creation for the id field.
+	documentdownload.DefaultID = documentdownloadDescID.Default.(func() uuid.UUID)
+	exchangerateMixin := schema.ExchangeRate{}.Mixin()
+	exchangerate.Policy = privacy.NewPolicies(schema.ExchangeRate{})
+	exchangerate.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := exchangerate.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	exchangerateMixinFields0 := exchangerateMixin[0].Fields()
+	_ = exchangerateMixinFields0
+	exchangerateFields := schema.ExchangeRate{}.Fields()
+	_ = exchangerateFields
+	// exchangerateDescCreateTime is the schema descriptor for create_time field.
+	exchangerateDescCreateTime := exchangerateMixinFields0[0].Descriptor()
+	// exchangerate.DefaultCreateTime holds the default value on creation for the create_time field.
+	exchangerate.DefaultCreateTime = exchangerateDescCreateTime.Default.(func() time.Time)
+	// exchangerateDescUpdateTime is the schema descriptor for update_time field.
+	exchangerateDescUpdateTime := exchangerateMixinFields0[1].Descriptor()
+	// exchangerate.DefaultUpdateTime holds the default value on creation for the update_time field.
+	exchangerate.DefaultUpdateTime = exchangerateDescUpdateTime.Default.(func() time.Time)
+	// exchangerate.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	exchangerate.UpdateDefaultUpdateTime = exchangerateDescUpdateTime.UpdateDefault.(func() time.Time)
+	// exchangerateDescCurrency is the schema descriptor for currency field.
+	exchangerateDescCurrency := exchangerateFields[1].Descriptor()
+	// exchangerate.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	exchangerate.CurrencyValidator = func() func(string) error {
+		validators := exchangerateDescCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(currency string) error {
+			for _, fn := range fns {
+				if err := fn(currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// exchangerateDescID is the schema descriptor for id field.
+	exchangerateDescID := exchangerateFields[0].Descriptor()
+	// exchangerate.DefaultID holds the default value on creation for the id field.
+	exchangerate.DefaultID = exchangerateDescID.Default.(func() uuid.UUID)
+	listingMixin := schema.Listing{}.Mixin()
+	listing.Policy = privacy.NewPolicies(schema.Listing{})
+	listing.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := listing.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	listingHooks := schema.Listing{}.Hooks()
+
+	listing.Hooks[1] = listingHooks[0]
+	listingMixinFields0 := listingMixin[0].Fields()
+	_ = listingMixinFields0
+	listingFields := schema.Listing{}.Fields()
+	_ = listingFields
+	// listingDescCreateTime is the schema descriptor for create_time field.
+	listingDescCreateTime := listingMixinFields0[0].Descriptor()
+	// listing.DefaultCreateTime holds the default value on creation for the create_time field.
+	listing.DefaultCreateTime = listingDescCreateTime.Default.(func() time.Time)
+	// listingDescUpdateTime is the schema descriptor for update_time field.
+	listingDescUpdateTime := listingMixinFields0[1].Descriptor()
+	// listing.DefaultUpdateTime holds the default value on creation for the update_time field.
+	listing.DefaultUpdateTime = listingDescUpdateTime.Default.(func() time.Time)
+	// listing.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	listing.UpdateDefaultUpdateTime = listingDescUpdateTime.UpdateDefault.(func() time.Time)
+	// listingDescTitle is the schema descriptor for title field.
+	listingDescTitle := listingFields[1].Descriptor()
+	// listing.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	listing.TitleValidator = func() func(string) error {
+		validators := listingDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingDescAddress is the schema descriptor for address field.
+	listingDescAddress := listingFields[2].Descriptor()
+	// listing.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	listing.AddressValidator = func() func(string) error {
+		validators := listingDescAddress.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(address string) error {
+			for _, fn := range fns {
+				if err := fn(address); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingDescAddressKey is the schema descriptor for address_key field.
+	listingDescAddressKey := listingFields[3].Descriptor()
+	// listing.AddressKeyValidator is a validator for the "address_key" field. It is called by the builders before save.
+	listing.AddressKeyValidator = listingDescAddressKey.Validators[0].(func(string) error)
+	// listingDescCity is the schema descriptor for city field.
+	listingDescCity := listingFields[4].Descriptor()
+	// listing.CityValidator is a validator for the "city" field. It is called by the builders before save.
+	listing.CityValidator = func() func(string) error {
+		validators := listingDescCity.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(city string) error {
+			for _, fn := range fns {
+				if err := fn(city); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingDescState is the schema descriptor for state field.
+	listingDescState := listingFields[5].Descriptor()
+	// listing.StateValidator is a validator for the "state" field. It is called by the builders before save.
+	listing.StateValidator = func() func(string) error {
+		validators := listingDescState.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(state string) error {
+			for _, fn := range fns {
+				if err := fn(state); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingDescZipCode is the schema descriptor for zip_code field.
+	listingDescZipCode := listingFields[6].Descriptor()
+	// listing.ZipCodeValidator is a validator for the "zip_code" field. It is called by the builders before save.
+	listing.ZipCodeValidator = func() func(string) error {
+		validators := listingDescZipCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(zip_code string) error {
+			for _, fn := range fns {
+				if err := fn(zip_code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingDescCountry is the schema descriptor for country field.
+	listingDescCountry := listingFields[7].Descriptor()
+	// listing.DefaultCountry holds the default value on creation for the country field.
+	listing.DefaultCountry = listingDescCountry.Default.(string)
+	// listing.CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	listing.CountryValidator = func() func(string) error {
+		validators := listingDescCountry.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(country string) error {
+			for _, fn := range fns {
+				if err := fn(country); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingDescLatitude is the schema descriptor for latitude field.
+	listingDescLatitude := listingFields[8].Descriptor()
+	// listing.LatitudeValidator is a validator for the "latitude" field. It is called by the builders before save.
+	listing.LatitudeValidator = listingDescLatitude.Validators[0].(func(float64) error)
+	// listingDescLongitude is the schema descriptor for longitude field.
+	listingDescLongitude := listingFields[9].Descriptor()
+	// listing.LongitudeValidator is a validator for the "longitude" field. It is called by the builders before save.
+	listing.LongitudeValidator = listingDescLongitude.Validators[0].(func(float64) error)
+	// listingDescSourceLocale is the schema descriptor for source_locale field.
+	listingDescSourceLocale := listingFields[12].Descriptor()
+	// listing.DefaultSourceLocale holds the default value on creation for the source_locale field.
+	listing.DefaultSourceLocale = listingDescSourceLocale.Default.(string)
+	// listing.SourceLocaleValidator is a validator for the "source_locale" field. It is called by the builders before save.
+	listing.SourceLocaleValidator = listingDescSourceLocale.Validators[0].(func(string) error)
+	// listingDescCurrency is the schema descriptor for currency field.
+	listingDescCurrency := listingFields[15].Descriptor()
+	// listing.DefaultCurrency holds the default value on creation for the currency field.
+	listing.DefaultCurrency = listingDescCurrency.Default.(string)
+	// listing.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	listing.CurrencyValidator = func() func(string) error {
+		validators := listingDescCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(currency string) error {
+			for _, fn := range fns {
+				if err := fn(currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingDescBedroom is the schema descriptor for bedroom field.
+	listingDescBedroom := listingFields[16].Descriptor()
+	// listing.BedroomValidator is a validator for the "bedroom" field. It is called by the builders before save.
+	listing.BedroomValidator = listingDescBedroom.Validators[0].(func(int) error)
+	// listingDescBathroom is the schema descriptor for bathroom field.
+	listingDescBathroom := listingFields[17].Descriptor()
+	// listing.BathroomValidator is a validator for the "bathroom" field. It is called by the builders before save.
+	listing.BathroomValidator = listingDescBathroom.Validators[0].(func(float64) error)
+	// listingDescGarage is the schema descriptor for garage field.
+	listingDescGarage := listingFields[18].Descriptor()
+	// listing.GarageValidator is a validator for the "garage" field. It is called by the builders before save.
+	listing.GarageValidator = listingDescGarage.Validators[0].(func(int) error)
+	// listingDescSqft is the schema descriptor for sqft field.
+	listingDescSqft := listingFields[19].Descriptor()
+	// listing.SqftValidator is a validator for the "sqft" field. It is called by the builders before save.
+	listing.SqftValidator = listingDescSqft.Validators[0].(func(int) error)
+	// listingDescSqm is the schema descriptor for sqm field.
+	listingDescSqm := listingFields[20].Descriptor()
+	// listing.SqmValidator is a validator for the "sqm" field. It is called by the builders before save.
+	listing.SqmValidator = listingDescSqm.Validators[0].(func(int) error)
+	// listingDescLotSize is the schema descriptor for lot_size field.
+	listingDescLotSize := listingFields[24].Descriptor()
+	// listing.LotSizeValidator is a validator for the "lot_size" field. It is called by the builders before save.
+	listing.LotSizeValidator = listingDescLotSize.Validators[0].(func(int) error)
+	// listingDescYearBuilt is the schema descriptor for year_built field.
+	listingDescYearBuilt := listingFields[26].Descriptor()
+	// listing.YearBuiltValidator is a validator for the "year_built" field. It is called by the builders before save.
+	listing.YearBuiltValidator = func() func(int) error {
+		validators := listingDescYearBuilt.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(year_built int) error {
+			for _, fn := range fns {
+				if err := fn(year_built); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingDescHoaName is the schema descriptor for hoa_name field.
+	listingDescHoaName := listingFields[30].Descriptor()
+	// listing.HoaNameValidator is a validator for the "hoa_name" field. It is called by the builders before save.
+	listing.HoaNameValidator = listingDescHoaName.Validators[0].(func(string) error)
+	// listingDescExpired is the schema descriptor for expired field.
+	listingDescExpired := listingFields[38].Descriptor()
+	// listing.DefaultExpired holds the default value on creation for the expired field.
+	listing.DefaultExpired = listingDescExpired.Default.(bool)
+	// listingDescMarketSeconds is the schema descriptor for market_seconds field.
+	listingDescMarketSeconds := listingFields[41].Descriptor()
+	// listing.DefaultMarketSeconds holds the default value on creation for the market_seconds field.
+	listing.DefaultMarketSeconds = listingDescMarketSeconds.Default.(int64)
+	// listing.MarketSecondsValidator is a validator for the "market_seconds" field. It is called by the builders before save.
+	listing.MarketSecondsValidator = listingDescMarketSeconds.Validators[0].(func(int64) error)
+	// listingDescVersion is the schema descriptor for version field.
+	listingDescVersion := listingFields[47].Descriptor()
+	// listing.DefaultVersion holds the default value on creation for the version field.
+	listing.DefaultVersion = listingDescVersion.Default.(int)
+	// listing.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	listing.VersionValidator = listingDescVersion.Validators[0].(func(int) error)
+	// listingDescID is the schema descriptor for id field.
+	listingDescID := listingFields[0].Descriptor()
+	// listing.DefaultID holds the default value on creation for the id field.
+	listing.DefaultID = listingDescID.Default.(func() uuid.UUID)
+	listingdocumentMixin := schema.ListingDocument{}.Mixin()
+	listingdocument.Policy = privacy.NewPolicies(schema.ListingDocument{})
+	listingdocument.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := listingdocument.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	listingdocumentMixinFields0 := listingdocumentMixin[0].Fields()
+	_ = listingdocumentMixinFields0
+	listingdocumentFields := schema.ListingDocument{}.Fields()
+	_ = listingdocumentFields
+	// listingdocumentDescCreateTime is the schema descriptor for create_time field.
+	listingdocumentDescCreateTime := listingdocumentMixinFields0[0].Descriptor()
+	// listingdocument.DefaultCreateTime holds the default value on creation for the create_time field.
+	listingdocument.DefaultCreateTime = listingdocumentDescCreateTime.Default.(func() time.Time)
+	// listingdocumentDescUpdateTime is the schema descriptor for update_time field.
+	listingdocumentDescUpdateTime := listingdocumentMixinFields0[1].Descriptor()
+	// listingdocument.DefaultUpdateTime holds the default value on creation for the update_time field.
+	listingdocument.DefaultUpdateTime = listingdocumentDescUpdateTime.Default.(func() time.Time)
+	// listingdocument.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	listingdocument.UpdateDefaultUpdateTime = listingdocumentDescUpdateTime.UpdateDefault.(func() time.Time)
+	// listingdocumentDescTitle is the schema descriptor for title field.
+	listingdocumentDescTitle := listingdocumentFields[2].Descriptor()
+	// listingdocument.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	listingdocument.TitleValidator = func() func(string) error {
+		validators := listingdocumentDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingdocumentDescFilename is the schema descriptor for filename field.
+	listingdocumentDescFilename := listingdocumentFields[5].Descriptor()
+	// listingdocument.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	listingdocument.FilenameValidator = func() func(string) error {
+		validators := listingdocumentDescFilename.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(filename string) error {
+			for _, fn := range fns {
+				if err := fn(filename); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingdocumentDescSizeBytes is the schema descriptor for size_bytes field.
+	listingdocumentDescSizeBytes := listingdocumentFields[6].Descriptor()
+	// listingdocument.SizeBytesValidator is a validator for the "size_bytes" field. It is called by the builders before save.
+	listingdocument.SizeBytesValidator = listingdocumentDescSizeBytes.Validators[0].(func(int64) error)
+	// listingdocumentDescStorageID is the schema descriptor for storage_id field.
+	listingdocumentDescStorageID := listingdocumentFields[7].Descriptor()
+	// listingdocument.StorageIDValidator is a validator for the "storage_id" field. It is called by the builders before save.
+	listingdocument.StorageIDValidator = func() func(string) error {
+		validators := listingdocumentDescStorageID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(storage_id string) error {
+			for _, fn := range fns {
+				if err := fn(storage_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingdocumentDescStorageFormat is the schema descriptor for storage_format field.
+	listingdocumentDescStorageFormat := listingdocumentFields[8].Descriptor()
+	// listingdocument.StorageFormatValidator is a validator for the "storage_format" field. It is called by the builders before save.
+	listingdocument.StorageFormatValidator = listingdocumentDescStorageFormat.Validators[0].(func(string) error)
+	// listingdocumentDescUploadedBy is the schema descriptor for uploaded_by field.
+	listingdocumentDescUploadedBy := listingdocumentFields[9].Descriptor()
+	// listingdocument.UploadedByValidator is a validator for the "uploaded_by" field. It is called by the builders before save.
+	listingdocument.UploadedByValidator = listingdocumentDescUploadedBy.Validators[0].(func(string) error)
+	// listingdocumentDescID is the schema descriptor for id field.
+	listingdocumentDescID := listingdocumentFields[0].Descriptor()
+	// listingdocument.DefaultID holds the default value on creation for the id field.
+	listingdocument.DefaultID = listingdocumentDescID.Default.(func() uuid.UUID)
+	listinginquiryMixin := schema.ListingInquiry{}.Mixin()
+	listinginquiry.Policy = privacy.NewPolicies(schema.ListingInquiry{})
+	listinginquiry.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := listinginquiry.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	listinginquiryMixinFields0 := listinginquiryMixin[0].Fields()
+	_ = listinginquiryMixinFields0
+	listinginquiryFields := schema.ListingInquiry{}.Fields()
+	_ = listinginquiryFields
+	// listinginquiryDescCreateTime is the schema descriptor for create_time field.
+	listinginquiryDescCreateTime := listinginquiryMixinFields0[0].Descriptor()
+	// listinginquiry.DefaultCreateTime holds the default value on creation for the create_time field.
+	listinginquiry.DefaultCreateTime = listinginquiryDescCreateTime.Default.(func() time.Time)
+	// listinginquiryDescUpdateTime is the schema descriptor for update_time field.
+	listinginquiryDescUpdateTime := listinginquiryMixinFields0[1].Descriptor()
+	// listinginquiry.DefaultUpdateTime holds the default value on creation for the update_time field.
+	listinginquiry.DefaultUpdateTime = listinginquiryDescUpdateTime.Default.(func() time.Time)
+	// listinginquiry.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	listinginquiry.UpdateDefaultUpdateTime = listinginquiryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// listinginquiryDescMessage is the schema descriptor for message field.
+	listinginquiryDescMessage := listinginquiryFields[3].Descriptor()
+	// listinginquiry.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	listinginquiry.MessageValidator = listinginquiryDescMessage.Validators[0].(func(string) error)
+	// listinginquiryDescID is the schema descriptor for id field.
+	listinginquiryDescID := listinginquiryFields[0].Descriptor()
+	// listinginquiry.DefaultID holds the default value on creation for the id field.
+	listinginquiry.DefaultID = listinginquiryDescID.Default.(func() uuid.UUID)
+	listingrenewalFields := schema.ListingRenewal{}.Fields()
+	_ = listingrenewalFields
+	// listingrenewalDescTermDays is the schema descriptor for term_days field.
+	listingrenewalDescTermDays := listingrenewalFields[4].Descriptor()
+	// listingrenewal.TermDaysValidator is a validator for the "term_days" field. It is called by the builders before save.
+	listingrenewal.TermDaysValidator = listingrenewalDescTermDays.Validators[0].(func(int) error)
+	// listingrenewalDescRenewedAt is the schema descriptor for renewed_at field.
+	listingrenewalDescRenewedAt := listingrenewalFields[5].Descriptor()
+	// listingrenewal.DefaultRenewedAt holds the default value on creation for the renewed_at field.
+	listingrenewal.DefaultRenewedAt = listingrenewalDescRenewedAt.Default.(func() time.Time)
+	// listingrenewalDescID is the schema descriptor for id field.
+	listingrenewalDescID := listingrenewalFields[0].Descriptor()
+	// listingrenewal.DefaultID holds the default value on creation for the id field.
+	listingrenewal.DefaultID = listingrenewalDescID.Default.(func() uuid.UUID)
+	listingreviewMixin := schema.ListingReview{}.Mixin()
+	listingreview.Policy = privacy.NewPolicies(schema.ListingReview{})
+	listingreview.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := listingreview.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	listingreviewMixinFields0 := listingreviewMixin[0].Fields()
+	_ = listingreviewMixinFields0
+	listingreviewFields := schema.ListingReview{}.Fields()
+	_ = listingreviewFields
+	// listingreviewDescCreateTime is the schema descriptor for create_time field.
+	listingreviewDescCreateTime := listingreviewMixinFields0[0].Descriptor()
+	// listingreview.DefaultCreateTime holds the default value on creation for the create_time field.
+	listingreview.DefaultCreateTime = listingreviewDescCreateTime.Default.(func() time.Time)
+	// listingreviewDescUpdateTime is the schema descriptor for update_time field.
+	listingreviewDescUpdateTime := listingreviewMixinFields0[1].Descriptor()
+	// listingreview.DefaultUpdateTime holds the default value on creation for the update_time field.
+	listingreview.DefaultUpdateTime = listingreviewDescUpdateTime.Default.(func() time.Time)
+	// listingreview.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	listingreview.UpdateDefaultUpdateTime = listingreviewDescUpdateTime.UpdateDefault.(func() time.Time)
+	// listingreviewDescNote is the schema descriptor for note field.
+	listingreviewDescNote := listingreviewFields[9].Descriptor()
+	// listingreview.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	listingreview.NoteValidator = listingreviewDescNote.Validators[0].(func(string) error)
+	// listingreviewDescID is the schema descriptor for id field.
+	listingreviewDescID := listingreviewFields[0].Descriptor()
+	// listingreview.DefaultID holds the default value on creation for the id field.
+	listingreview.DefaultID = listingreviewDescID.Default.(func() uuid.UUID)
+	listingstatuseventFields := schema.ListingStatusEvent{}.Fields()
+	_ = listingstatuseventFields
+	// listingstatuseventDescFromStatus is the schema descriptor for from_status field.
+	listingstatuseventDescFromStatus := listingstatuseventFields[2].Descriptor()
+	// listingstatusevent.FromStatusValidator is a validator for the "from_status" field. It is called by the builders before save.
+	listingstatusevent.FromStatusValidator = listingstatuseventDescFromStatus.Validators[0].(func(string) error)
+	// listingstatuseventDescToStatus is the schema descriptor for to_status field.
+	listingstatuseventDescToStatus := listingstatuseventFields[3].Descriptor()
+	// listingstatusevent.ToStatusValidator is a validator for the "to_status" field. It is called by the builders before save.
+	listingstatusevent.ToStatusValidator = func() func(string) error {
+		validators := listingstatuseventDescToStatus.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(to_status string) error {
+			for _, fn := range fns {
+				if err := fn(to_status); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// listingstatuseventDescChangedAt is the schema descriptor for changed_at field.
+	listingstatuseventDescChangedAt := listingstatuseventFields[5].Descriptor()
+	// listingstatusevent.DefaultChangedAt holds the default value on creation for the changed_at field.
+	listingstatusevent.DefaultChangedAt = listingstatuseventDescChangedAt.Default.(func() time.Time)
+	// listingstatuseventDescID is the schema descriptor for id field.
+	listingstatuseventDescID := listingstatuseventFields[0].Descriptor()
+	// listingstatusevent.DefaultID holds the default value on creation for the id field.
+	listingstatusevent.DefaultID = listingstatuseventDescID.Default.(func() uuid.UUID)
+	neighborhoodMixin := schema.Neighborhood{}.Mixin()
+	neighborhood.Policy = privacy.NewPolicies(schema.Neighborhood{})
+	neighborhood.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := neighborhood.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	neighborhoodMixinFields0 := neighborhoodMixin[0].Fields()
+	_ = neighborhoodMixinFields0
+	neighborhoodFields := schema.Neighborhood{}.Fields()
+	_ = neighborhoodFields
+	// neighborhoodDescCreateTime is the schema descriptor for create_time field.
+	neighborhoodDescCreateTime := neighborhoodMixinFields0[0].Descriptor()
+	// neighborhood.DefaultCreateTime holds the default value on creation for the create_time field.
+	neighborhood.DefaultCreateTime = neighborhoodDescCreateTime.Default.(func() time.Time)
+	// neighborhoodDescUpdateTime is the schema descriptor for update_time field.
+	neighborhoodDescUpdateTime := neighborhoodMixinFields0[1].Descriptor()
+	// neighborhood.DefaultUpdateTime holds the default value on creation for the update_time field.
+	neighborhood.DefaultUpdateTime = neighborhoodDescUpdateTime.Default.(func() time.Time)
+	// neighborhood.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	neighborhood.UpdateDefaultUpdateTime = neighborhoodDescUpdateTime.UpdateDefault.(func() time.Time)
+	// neighborhoodDescName is the schema descriptor for name field.
+	neighborhoodDescName := neighborhoodFields[1].Descriptor()
+	// neighborhood.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	neighborhood.NameValidator = func() func(string) error {
+		validators := neighborhoodDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// neighborhoodDescCity is the schema descriptor for city field.
+	neighborhoodDescCity := neighborhoodFields[2].Descriptor()
+	// neighborhood.CityValidator is a validator for the "city" field. It is called by the builders before save.
+	neighborhood.CityValidator = func() func(string) error {
+		validators := neighborhoodDescCity.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(city string) error {
+			for _, fn := range fns {
+				if err := fn(city); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// neighborhoodDescState is the schema descriptor for state field.
+	neighborhoodDescState := neighborhoodFields[3].Descriptor()
+	// neighborhood.StateValidator is a validator for the "state" field. It is called by the builders before save.
+	neighborhood.StateValidator = func() func(string) error {
+		validators := neighborhoodDescState.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(state string) error {
+			for _, fn := range fns {
+				if err := fn(state); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// neighborhoodDescCountry is the schema descriptor for country field.
+	neighborhoodDescCountry := neighborhoodFields[4].Descriptor()
+	// neighborhood.DefaultCountry holds the default value on creation for the country field.
+	neighborhood.DefaultCountry = neighborhoodDescCountry.Default.(string)
+	// neighborhood.CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	neighborhood.CountryValidator = func() func(string) error {
+		validators := neighborhoodDescCountry.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(country string) error {
+			for _, fn := range fns {
+				if err := fn(country); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// neighborhoodDescID is the schema descriptor for id field.
+	neighborhoodDescID := neighborhoodFields[0].Descriptor()
+	// neighborhood.DefaultID holds the default value on creation for the id field.
+	neighborhood.DefaultID = neighborhoodDescID.Default.(func() uuid.UUID)
+	notificationMixin := schema.Notification{}.Mixin()
+	notification.Policy = privacy.NewPolicies(schema.Notification{})
+	notification.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := notification.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	notificationMixinFields0 := notificationMixin[0].Fields()
+	_ = notificationMixinFields0
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreateTime is the schema descriptor for create_time field.
+	notificationDescCreateTime := notificationMixinFields0[0].Descriptor()
+	// notification.DefaultCreateTime holds the default value on creation for the create_time field.
+	notification.DefaultCreateTime = notificationDescCreateTime.Default.(func() time.Time)
+	// notificationDescUpdateTime is the schema descriptor for update_time field.
+	notificationDescUpdateTime := notificationMixinFields0[1].Descriptor()
+	// notification.DefaultUpdateTime holds the default value on creation for the update_time field.
+	notification.DefaultUpdateTime = notificationDescUpdateTime.Default.(func() time.Time)
+	// notification.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	notification.UpdateDefaultUpdateTime = notificationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// notificationDescRecipientEmail is the schema descriptor for recipient_email field.
+	notificationDescRecipientEmail := notificationFields[1].Descriptor()
+	// notification.RecipientEmailValidator is a validator for the "recipient_email" field. It is called by the builders before save.
+	notification.RecipientEmailValidator = func() func(string) error {
+		validators := notificationDescRecipientEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(recipient_email string) error {
+			for _, fn := range fns {
+				if err := fn(recipient_email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationDescKind is the schema descriptor for kind field.
+	notificationDescKind := notificationFields[4].Descriptor()
+	// notification.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	notification.KindValidator = func() func(string) error {
+		validators := notificationDescKind.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(kind string) error {
+			for _, fn := range fns {
+				if err := fn(kind); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationDescSubject is the schema descriptor for subject field.
+	notificationDescSubject := notificationFields[5].Descriptor()
+	// notification.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	notification.SubjectValidator = func() func(string) error {
+		validators := notificationDescSubject.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(subject string) error {
+			for _, fn := range fns {
+				if err := fn(subject); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// notificationDescID is the schema descriptor for id field.
+	notificationDescID := notificationFields[0].Descriptor()
+	// notification.DefaultID holds the default value on creation for the id field.
+	notification.DefaultID = notificationDescID.Default.(func() uuid.UUID)
+	offerMixin := schema.Offer{}.Mixin()
+	offer.Policy = privacy.NewPolicies(schema.Offer{})
+	offer.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := offer.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	offerMixinFields0 := offerMixin[0].Fields()
+	_ = offerMixinFields0
+	offerFields := schema.Offer{}.Fields()
+	_ = offerFields
+	// offerDescCreateTime is the schema descriptor for create_time field.
+	offerDescCreateTime := offerMixinFields0[0].Descriptor()
+	// offer.DefaultCreateTime holds the default value on creation for the create_time field.
+	offer.DefaultCreateTime = offerDescCreateTime.Default.(func() time.Time)
+	// offerDescUpdateTime is the schema descriptor for update_time field.
+	offerDescUpdateTime := offerMixinFields0[1].Descriptor()
+	// offer.DefaultUpdateTime holds the default value on creation for the update_time field.
+	offer.DefaultUpdateTime = offerDescUpdateTime.Default.(func() time.Time)
+	// offer.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	offer.UpdateDefaultUpdateTime = offerDescUpdateTime.UpdateDefault.(func() time.Time)
+	// offerDescCurrency is the schema descriptor for currency field.
+	offerDescCurrency := offerFields[7].Descriptor()
+	// offer.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	offer.CurrencyValidator = func() func(string) error {
+		validators := offerDescCurrency.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(currency string) error {
+			for _, fn := range fns {
+				if err := fn(currency); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// offerDescMessage is the schema descriptor for message field.
+	offerDescMessage := offerFields[11].Descriptor()
+	// offer.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	offer.MessageValidator = offerDescMessage.Validators[0].(func(string) error)
+	// offerDescID is the schema descriptor for id field.
+	offerDescID := offerFields[0].Descriptor()
+	// offer.DefaultID holds the default value on creation for the id field.
+	offer.DefaultID = offerDescID.Default.(func() uuid.UUID)
+	pointofinterestMixin := schema.PointOfInterest{}.Mixin()
+	pointofinterest.Policy = privacy.NewPolicies(schema.PointOfInterest{})
+	pointofinterest.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := pointofinterest.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	pointofinterestMixinFields0 := pointofinterestMixin[0].Fields()
+	_ = pointofinterestMixinFields0
+	pointofinterestFields := schema.PointOfInterest{}.Fields()
+	_ = pointofinterestFields
+	// pointofinterestDescCreateTime is the schema descriptor for create_time field.
+	pointofinterestDescCreateTime := pointofinterestMixinFields0[0].Descriptor()
+	// pointofinterest.DefaultCreateTime holds the default value on creation for the create_time field.
+	pointofinterest.DefaultCreateTime = pointofinterestDescCreateTime.Default.(func() time.Time)
+	// pointofinterestDescUpdateTime is the schema descriptor for update_time field.
+	pointofinterestDescUpdateTime := pointofinterestMixinFields0[1].Descriptor()
+	// pointofinterest.DefaultUpdateTime holds the default value on creation for the update_time field.
+	pointofinterest.DefaultUpdateTime = pointofinterestDescUpdateTime.Default.(func() time.Time)
+	// pointofinterest.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	pointofinterest.UpdateDefaultUpdateTime = pointofinterestDescUpdateTime.UpdateDefault.(func() time.Time)
+	// pointofinterestDescName is the schema descriptor for name field.
+	pointofinterestDescName := pointofinterestFields[1].Descriptor()
+	// pointofinterest.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	pointofinterest.NameValidator = func() func(string) error {
+		validators := pointofinterestDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// pointofinterestDescSubtype is the schema descriptor for subtype field.
+	pointofinterestDescSubtype := pointofinterestFields[3].Descriptor()
+	// pointofinterest.SubtypeValidator is a validator for the "subtype" field. It is called by the builders before save.
+	pointofinterest.SubtypeValidator = pointofinterestDescSubtype.Validators[0].(func(string) error)
+	// pointofinterestDescLatitude is the schema descriptor for latitude field.
+	pointofinterestDescLatitude := pointofinterestFields[4].Descriptor()
+	// pointofinterest.LatitudeValidator is a validator for the "latitude" field. It is called by the builders before save.
+	pointofinterest.LatitudeValidator = pointofinterestDescLatitude.Validators[0].(func(float64) error)
+	// pointofinterestDescLongitude is the schema descriptor for longitude field.
+	pointofinterestDescLongitude := pointofinterestFields[5].Descriptor()
+	// pointofinterest.LongitudeValidator is a validator for the "longitude" field. It is called by the builders before save.
+	pointofinterest.LongitudeValidator = pointofinterestDescLongitude.Validators[0].(func(float64) error)
+	// pointofinterestDescID is the schema descriptor for id field.
+	pointofinterestDescID := pointofinterestFields[0].Descriptor()
+	// pointofinterest.DefaultID holds the default value on creation for the id field.
+	pointofinterest.DefaultID = pointofinterestDescID.Default.(func() uuid.UUID)
+	propertyMixin := schema.Property{}.Mixin()
+	property.Policy = privacy.NewPolicies(schema.Property{})
+	property.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := property.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	propertyMixinFields0 := propertyMixin[0].Fields()
+	_ = propertyMixinFields0
+	propertyFields := schema.Property{}.Fields()
+	_ = propertyFields
+	// propertyDescCreateTime is the schema descriptor for create_time field.
+	propertyDescCreateTime := propertyMixinFields0[0].Descriptor()
+	// property.DefaultCreateTime holds the default value on creation for the create_time field.
+	property.DefaultCreateTime = propertyDescCreateTime.Default.(func() time.Time)
+	// propertyDescUpdateTime is the schema descriptor for update_time field.
+	propertyDescUpdateTime := propertyMixinFields0[1].Descriptor()
+	// property.DefaultUpdateTime holds the default value on creation for the update_time field.
+	property.DefaultUpdateTime = propertyDescUpdateTime.Default.(func() time.Time)
+	// property.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	property.UpdateDefaultUpdateTime = propertyDescUpdateTime.UpdateDefault.(func() time.Time)
+	// propertyDescAddress is the schema descriptor for address field.
+	propertyDescAddress := propertyFields[1].Descriptor()
+	// property.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	property.AddressValidator = func() func(string) error {
+		validators := propertyDescAddress.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(address string) error {
+			for _, fn := range fns {
+				if err := fn(address); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// propertyDescAddressKey is the schema descriptor for address_key field.
+	propertyDescAddressKey := propertyFields[2].Descriptor()
+	// property.AddressKeyValidator is a validator for the "address_key" field. It is called by the builders before save.
+	property.AddressKeyValidator = propertyDescAddressKey.Validators[0].(func(string) error)
+	// propertyDescCity is the schema descriptor for city field.
+	propertyDescCity := propertyFields[3].Descriptor()
+	// property.CityValidator is a validator for the "city" field. It is called by the builders before save.
+	property.CityValidator = func() func(string) error {
+		validators := propertyDescCity.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(city string) error {
+			for _, fn := range fns {
+				if err := fn(city); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// propertyDescState is the schema descriptor for state field.
+	propertyDescState := propertyFields[4].Descriptor()
+	// property.StateValidator is a validator for the "state" field. It is called by the builders before save.
+	property.StateValidator = func() func(string) error {
+		validators := propertyDescState.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(state string) error {
+			for _, fn := range fns {
+				if err := fn(state); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// propertyDescZipCode is the schema descriptor for zip_code field.
+	propertyDescZipCode := propertyFields[5].Descriptor()
+	// property.ZipCodeValidator is a validator for the "zip_code" field. It is called by the builders before save.
+	property.ZipCodeValidator = func() func(string) error {
+		validators := propertyDescZipCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(zip_code string) error {
+			for _, fn := range fns {
+				if err := fn(zip_code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// propertyDescCountry is the schema descriptor for country field.
+	propertyDescCountry := propertyFields[6].Descriptor()
+	// property.DefaultCountry holds the default value on creation for the country field.
+	property.DefaultCountry = propertyDescCountry.Default.(string)
+	// property.CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	property.CountryValidator = func() func(string) error {
+		validators := propertyDescCountry.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(country string) error {
+			for _, fn := range fns {
+				if err := fn(country); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// propertyDescBedroom is the schema descriptor for bedroom field.
+	propertyDescBedroom := propertyFields[8].Descriptor()
+	// property.BedroomValidator is a validator for the "bedroom" field. It is called by the builders before save.
+	property.BedroomValidator = propertyDescBedroom.Validators[0].(func(int) error)
+	// propertyDescBathroom is the schema descriptor for bathroom field.
+	propertyDescBathroom := propertyFields[9].Descriptor()
+	// property.BathroomValidator is a validator for the "bathroom" field. It is called by the builders before save.
+	property.BathroomValidator = propertyDescBathroom.Validators[0].(func(float64) error)
+	// propertyDescGarage is the schema descriptor for garage field.
+	propertyDescGarage := propertyFields[10].Descriptor()
+	// property.GarageValidator is a validator for the "garage" field. It is called by the builders before save.
+	property.GarageValidator = propertyDescGarage.Validators[0].(func(int) error)
+	// propertyDescSqft is the schema descriptor for sqft field.
+	propertyDescSqft := propertyFields[11].Descriptor()
+	// property.SqftValidator is a validator for the "sqft" field. It is called by the builders before save.
+	property.SqftValidator = propertyDescSqft.Validators[0].(func(int) error)
+	// propertyDescSqm is the schema descriptor for sqm field.
+	propertyDescSqm := propertyFields[12].Descriptor()
+	// property.SqmValidator is a validator for the "sqm" field. It is called by the builders before save.
+	property.SqmValidator = propertyDescSqm.Validators[0].(func(int) error)
+	// propertyDescLotSize is the schema descriptor for lot_size field.
+	propertyDescLotSize := propertyFields[13].Descriptor()
+	// property.LotSizeValidator is a validator for the "lot_size" field. It is called by the builders before save.
+	property.LotSizeValidator = propertyDescLotSize.Validators[0].(func(int) error)
+	// propertyDescYearBuilt is the schema descriptor for year_built field.
+	propertyDescYearBuilt := propertyFields[15].Descriptor()
+	// property.YearBuiltValidator is a validator for the "year_built" field. It is called by the builders before save.
+	property.YearBuiltValidator = func() func(int) error {
+		validators := propertyDescYearBuilt.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(year_built int) error {
+			for _, fn := range fns {
+				if err := fn(year_built); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// propertyDescID is the schema descriptor for id field.
+	propertyDescID := propertyFields[0].Descriptor()
+	// property.DefaultID holds the default value on creation for the id field.
+	property.DefaultID = propertyDescID.Default.(func() uuid.UUID)
+	realtorMixin := schema.Realtor{}.Mixin()
+	realtor.Policy = privacy.NewPolicies(schema.Realtor{})
+	realtor.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := realtor.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	realtorMixinFields0 := realtorMixin[0].Fields()
+	_ = realtorMixinFields0
+	realtorFields := schema.Realtor{}.Fields()
+	_ = realtorFields
+	// realtorDescCreateTime is the schema descriptor for create_time field.
+	realtorDescCreateTime := realtorMixinFields0[0].Descriptor()
+	// realtor.DefaultCreateTime holds the default value on creation for the create_time field.
+	realtor.DefaultCreateTime = realtorDescCreateTime.Default.(func() time.Time)
+	// realtorDescUpdateTime is the schema descriptor for update_time field.
+	realtorDescUpdateTime := realtorMixinFields0[1].Descriptor()
+	// realtor.DefaultUpdateTime holds the default value on creation for the update_time field.
+	realtor.DefaultUpdateTime = realtorDescUpdateTime.Default.(func() time.Time)
+	// realtor.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	realtor.UpdateDefaultUpdateTime = realtorDescUpdateTime.UpdateDefault.(func() time.Time)
+	// realtorDescFullName is the schema descriptor for full_name field.
+	realtorDescFullName := realtorFields[1].Descriptor()
+	// realtor.FullNameValidator is a validator for the "full_name" field. It is called by the builders before save.
+	realtor.FullNameValidator = func() func(string) error {
+		validators := realtorDescFullName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(full_name string) error {
+			for _, fn := range fns {
+				if err := fn(full_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// realtorDescDescription is the schema descriptor for description field.
+	realtorDescDescription := realtorFields[3].Descriptor()
+	// realtor.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	realtor.DescriptionValidator = realtorDescDescription.Validators[0].(func(string) error)
+	// realtorDescPhone is the schema descriptor for phone field.
+	realtorDescPhone := realtorFields[4].Descriptor()
+	// realtor.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	realtor.PhoneValidator = func() func(string) error {
+		validators := realtorDescPhone.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(phone string) error {
+			for _, fn := range fns {
+				if err := fn(phone); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// realtorDescEmail is the schema descriptor for email field.
+	realtorDescEmail := realtorFields[5].Descriptor()
+	// realtor.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	realtor.EmailValidator = func() func(string) error {
+		validators := realtorDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// realtorDescIsMvp is the schema descriptor for is_mvp field.
+	realtorDescIsMvp := realtorFields[6].Descriptor()
+	// realtor.DefaultIsMvp holds the default value on creation for the is_mvp field.
+	realtor.DefaultIsMvp = realtorDescIsMvp.Default.(bool)
+	// realtorDescHireDate is the schema descriptor for hire_date field.
+	realtorDescHireDate := realtorFields[7].Descriptor()
+	// realtor.DefaultHireDate holds the default value on creation for the hire_date field.
+	realtor.DefaultHireDate = realtorDescHireDate.Default.(func() time.Time)
+	// realtorDescActive is the schema descriptor for active field.
+	realtorDescActive := realtorFields[9].Descriptor()
+	// realtor.DefaultActive holds the default value on creation for the active field.
+	realtor.DefaultActive = realtorDescActive.Default.(bool)
+	// realtorDescID is the schema descriptor for id field.
+	realtorDescID := realtorFields[0].Descriptor()
+	// realtor.DefaultID holds the default value on creation for the id field.
+	realtor.DefaultID = realtorDescID.Default.(func() uuid.UUID)
+	realtorinviteMixin := schema.RealtorInvite{}.Mixin()
+	realtorinvite.Policy = privacy.NewPolicies(schema.RealtorInvite{})
+	realtorinvite.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := realtorinvite.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	realtorinviteMixinFields0 := realtorinviteMixin[0].Fields()
+	_ = realtorinviteMixinFields0
+	realtorinviteFields := schema.RealtorInvite{}.Fields()
+	_ = realtorinviteFields
+	// realtorinviteDescCreateTime is the schema descriptor for create_time field.
+	realtorinviteDescCreateTime := realtorinviteMixinFields0[0].Descriptor()
+	// realtorinvite.DefaultCreateTime holds the default value on creation for the create_time field.
+	realtorinvite.DefaultCreateTime = realtorinviteDescCreateTime.Default.(func() time.Time)
+	// realtorinviteDescUpdateTime is the schema descriptor for update_time field.
+	realtorinviteDescUpdateTime := realtorinviteMixinFields0[1].Descriptor()
+	// realtorinvite.DefaultUpdateTime holds the default value on creation for the update_time field.
+	realtorinvite.DefaultUpdateTime = realtorinviteDescUpdateTime.Default.(func() time.Time)
+	// realtorinvite.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	realtorinvite.UpdateDefaultUpdateTime = realtorinviteDescUpdateTime.UpdateDefault.(func() time.Time)
+	// realtorinviteDescEmail is the schema descriptor for email field.
+	realtorinviteDescEmail := realtorinviteFields[2].Descriptor()
+	// realtorinvite.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	realtorinvite.EmailValidator = func() func(string) error {
+		validators := realtorinviteDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// realtorinviteDescTokenHash is the schema descriptor for token_hash field.
+	realtorinviteDescTokenHash := realtorinviteFields[3].Descriptor()
+	// realtorinvite.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	realtorinvite.TokenHashValidator = realtorinviteDescTokenHash.Validators[0].(func(string) error)
+	// realtorinviteDescID is the schema descriptor for id field.
+	realtorinviteDescID := realtorinviteFields[0].Descriptor()
+	// realtorinvite.DefaultID holds the default value on creation for the id field.
+	realtorinvite.DefaultID = realtorinviteDescID.Default.(func() uuid.UUID)
+	teamMixin := schema.Team{}.Mixin()
+	team.Policy = privacy.NewPolicies(schema.Team{})
+	team.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := team.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	teamMixinFields0 := teamMixin[0].Fields()
+	_ = teamMixinFields0
+	teamFields := schema.Team{}.Fields()
+	_ = teamFields
+	// teamDescCreateTime is the schema descriptor for create_time field.
+	teamDescCreateTime := teamMixinFields0[0].Descriptor()
+	// team.DefaultCreateTime holds the default value on creation for the create_time field.
+	team.DefaultCreateTime = teamDescCreateTime.Default.(func() time.Time)
+	// teamDescUpdateTime is the schema descriptor for update_time field.
+	teamDescUpdateTime := teamMixinFields0[1].Descriptor()
+	// team.DefaultUpdateTime holds the default value on creation for the update_time field.
+	team.DefaultUpdateTime = teamDescUpdateTime.Default.(func() time.Time)
+	// team.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	team.UpdateDefaultUpdateTime = teamDescUpdateTime.UpdateDefault.(func() time.Time)
+	// teamDescName is the schema descriptor for name field.
+	teamDescName := teamFields[2].Descriptor()
+	// team.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	team.NameValidator = func() func(string) error {
+		validators := teamDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// teamDescID is the schema descriptor for id field.
+	teamDescID := teamFields[0].Descriptor()
+	// team.DefaultID holds the default value on creation for the id field.
+	team.DefaultID = teamDescID.Default.(func() uuid.UUID)
+	userMixin := schema.User{}.Mixin()
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescCreateTime is the schema descriptor for create_time field.
+	userDescCreateTime := userMixinFields0[0].Descriptor()
+	// user.DefaultCreateTime holds the default value on creation for the create_time field.
+	user.DefaultCreateTime = userDescCreateTime.Default.(func() time.Time)
+	// userDescUpdateTime is the schema descriptor for update_time field.
+	userDescUpdateTime := userMixinFields0[1].Descriptor()
+	// user.DefaultUpdateTime holds the default value on creation for the update_time field.
+	user.DefaultUpdateTime = userDescUpdateTime.Default.(func() time.Time)
+	// user.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	user.UpdateDefaultUpdateTime = userDescUpdateTime.UpdateDefault.(func() time.Time)
+	// userDescAvatar is the schema descriptor for avatar field.
+	userDescAvatar := userFields[1].Descriptor()
+	// user.AvatarValidator is a validator for the "avatar" field. It is called by the builders before save.
+	user.AvatarValidator = userDescAvatar.Validators[0].(func(string) error)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[2].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = func() func(string) error {
+		validators := userDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[3].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = func() func(string) error {
+		validators := userDescUsername.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(username string) error {
+			for _, fn := range fns {
+				if err := fn(username); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userDescFullName is the schema descriptor for full_name field.
+	userDescFullName := userFields[4].Descriptor()
+	// user.FullNameValidator is a validator for the "full_name" field. It is called by the builders before save.
+	user.FullNameValidator = func() func(string) error {
+		validators := userDescFullName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(full_name string) error {
+			for _, fn := range fns {
+				if err := fn(full_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userDescStartDate is the schema descriptor for start_date field.
+	userDescStartDate := userFields[5].Descriptor()
+	// user.DefaultStartDate holds the default value on creation for the start_date field.
+	user.DefaultStartDate = userDescStartDate.Default.(func() time.Time)
+	// userDescIsStaff is the schema descriptor for is_staff field.
+	userDescIsStaff := userFields[6].Descriptor()
+	// user.DefaultIsStaff holds the default value on creation for the is_staff field.
+	user.DefaultIsStaff = userDescIsStaff.Default.(bool)
+	// userDescIsActive is the schema descriptor for is_active field.
+	userDescIsActive := userFields[7].Descriptor()
+	// user.DefaultIsActive holds the default value on creation for the is_active field.
+	user.DefaultIsActive = userDescIsActive.Default.(bool)
+	// userDescPassword is the schema descriptor for password field.
+	userDescPassword := userFields[8].Descriptor()
+	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	user.PasswordValidator = func() func(string) error {
+		validators := userDescPassword.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(password string) error {
+			for _, fn := range fns {
+				if err := fn(password); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userDescProvider is the schema descriptor for provider field.
+	userDescProvider := userFields[9].Descriptor()
+	// user.DefaultProvider holds the default value on creation for the provider field.
+	user.DefaultProvider = userDescProvider.Default.(string)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+}
 
 const (
-	Version = "v0.14.2"                                         // Version of ent codegen.
-	Sum     = "h1:ywld/j2Rx4EmnIKs8eZ29cbFA1zpB+DA9TLL5l3rlq0=" // Sum of ent codegen.
+	Version = "v0.14.5"                                         // Version of ent codegen.
+	Sum     = "h1:Rj2WOYJtCkWyFo6a+5wB3EfBRP0rnx1fMk6gGA0UUe4=" // Sum of ent codegen.
 )
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"regexp"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+)
+
+// Brokerage holds the schema definition for the Brokerage entity.
+// A brokerage is one of the offices realtors work from; it is organized in teams.
+type Brokerage struct {
+	ent.Schema
+}
+
+func (Brokerage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the Brokerage.
+func (Brokerage) Fields() []ent.Field {
+	hexColor := regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").MaxLen(150).NotEmpty().StructTag(`json:"name"`),
+		field.String("license_number").MaxLen(50).NotEmpty().StructTag(`json:"license_number"`),
+
+		// Office address
+		field.String("address").MaxLen(255).NotEmpty().StructTag(`json:"address"`),
+		field.String("city").MaxLen(100).NotEmpty().StructTag(`json:"city"`),
+		field.String("state").MaxLen(100).Optional().StructTag(`json:"state,omitempty"`),
+		field.String("zip_code").MaxLen(20).NotEmpty().StructTag(`json:"zip_code"`),
+		field.String("phone").MaxLen(20).Optional().Match(regexp.MustCompile(`^\+[1-9]\d{1,14}$`)).StructTag(`json:"phone,omitempty"`),
+		field.String("email").MaxLen(255).Optional().StructTag(`json:"email,omitempty"`),
+
+		// Branding shown on the brokerage's listings and realtor profiles
+		field.String("website").MaxLen(255).Optional().StructTag(`json:"website,omitempty"`),
+		field.String("logo_url").MaxLen(500).Optional().StructTag(`json:"logo_url,omitempty"`),
+		field.String("primary_color").MaxLen(7).Optional().Match(hexColor).StructTag(`json:"primary_color,omitempty"`),
+		field.String("secondary_color").MaxLen(7).Optional().Match(hexColor).StructTag(`json:"secondary_color,omitempty"`),
+	}
+}
+
+// Edges of the Brokerage.
+func (Brokerage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("teams", Team.Type),
+		edge.To("realtors", Realtor.Type),
+	}
+}
+
+// Indexes of the Brokerage.
+func (Brokerage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Unique(),
+		index.Fields("license_number").Unique(),
+	}
+}
+
+// Policy of the Brokerage. Anyone can read brokerages; only staff manage them.
+func (Brokerage) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
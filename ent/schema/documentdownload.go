@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DocumentDownload holds the schema definition for the DocumentDownload entity.
+// Every download of a listing document is recorded for auditing.
+type DocumentDownload struct {
+	ent.Schema
+}
+
+// Fields of the DocumentDownload.
+func (DocumentDownload) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("document_id", uuid.UUID{}),
+		field.String("user_email").MaxLen(255).Optional(),
+		field.String("ip_address").MaxLen(64).Optional(),
+		field.String("user_agent").MaxLen(512).Optional(),
+		field.Time("downloaded_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the DocumentDownload.
+func (DocumentDownload) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("document", ListingDocument.Type).Ref("downloads").Unique().Field("document_id").Required(),
+	}
+}
+
+// Indexes of the DocumentDownload.
+func (DocumentDownload) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("document_id", "downloaded_at"),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"regexp"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+)
+
+// ExchangeRate holds the schema definition for the ExchangeRate entity.
+// rate is how many units of the base currency one unit of currency buys;
+// the base currency itself always has a rate of 1.
+type ExchangeRate struct {
+	ent.Schema
+}
+
+func (ExchangeRate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the ExchangeRate.
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("currency").MaxLen(3).Unique().Match(regexp.MustCompile(`^[A-Z]{3}$`)),
+		field.Float("rate").GoType(decimal.Decimal{}).SchemaType(map[string]string{dialect.Postgres: "numeric"}),
+	}
+}
+
+// Policy of the ExchangeRate. Anyone can read the rates; only staff change them.
+func (ExchangeRate) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -1,27 +1,27 @@
 package schema
 
 import (
+	"context"
+	"errors"
 	"regexp"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	gen "ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/hook"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+	"ppgroup.ppgroup.com/ent/schematype"
 )
 
-// Media defines the structure of a media item.
-type Media struct {
-	URL       string `json:"url"`
-	Type      string `json:"type"`
-	Caption   string `json:"caption,omitempty"`
-	IsPrimary bool   `json:"is_primary,omitempty"`
-}
-
 // Listing holds the schema definition for the Listing entity.
 type Listing struct {
 	ent.Schema
@@ -38,23 +38,67 @@ func (Listing) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.String("title").MaxLen(120).MinLen(10).NotEmpty(),
-		field.String("address").MaxLen(255).Unique().NotEmpty(),
+		field.String("address").MaxLen(255).NotEmpty(),
+		field.String("address_key").MaxLen(300).Optional(),
 		field.String("city").MaxLen(255).NotEmpty(),
-		field.String("state").MaxLen(3).NotEmpty().Match(regexp.MustCompile(`^[A-Z]{2}$`)),
-		field.String("zip_code").MaxLen(6).NotEmpty().Match(regexp.MustCompile(`^\d{5}$`)),
+		// state and zip_code are validated per country, see services.ValidateLocation
+		field.String("state").MaxLen(3).NotEmpty(),
+		field.String("zip_code").MaxLen(10).NotEmpty(),
+		field.String("country").MaxLen(2).Default("US").Match(regexp.MustCompile(`^[A-Z]{2}$`)),
+		field.Float("latitude").Optional().Nillable().Range(-90, 90),
+		field.Float("longitude").Optional().Nillable().Range(-180, 180),
+		field.UUID("neighborhood_id", uuid.UUID{}).Optional(),
 		field.Text("description").Optional(),
+		// title and description are written in source_locale; translations are keyed by locale
+		field.String("source_locale").MaxLen(35).Default("en"),
+		field.JSON("translations", map[string]schematype.Translation{}).Optional(),
+		// price must be positive, see Hooks
 		field.Float("price").GoType(decimal.Decimal{}).SchemaType(map[string]string{dialect.Postgres: "numeric"}),
+		field.String("currency").MaxLen(3).Default("USD").Match(regexp.MustCompile(`^[A-Z]{3}$`)),
 		field.Int("bedroom").Positive(),
 		field.Float("bathroom").Positive(),
 		field.Int("garage").Optional().Positive(),
 		field.Int("sqft").Positive(),
+		field.Int("sqm").Optional().Positive(),
+		field.Enum("area_unit").Values("sqft", "sqm").Default("sqft"),
 		field.Enum("type_of_property").Values("house", "apartment", "condo", "townhouse").Default("house"),
-		field.Enum("status").Values("DRAFT", "PUBLISHED", "ARCHIVED").Default("DRAFT"),
+		// PENDING is under contract: an offer was accepted and the sale has not closed.
+		// SOLD is a closed sale, see repositories.ListingSaleHook
+		field.Enum("status").Values("DRAFT", "IN_REVIEW", "SCHEDULED", "PUBLISHED", "PENDING", "SOLD", "ARCHIVED").Default("DRAFT"),
 		field.Int("lot_size").Optional().Positive(),
 		field.Bool("pool").Optional(),
 		field.Int("year_built").Positive().Range(1800, time.Now().Year()),
-		field.JSON("media", []Media{}).Optional(),
+		// Carrying costs are in the listing's currency, see repositories.validateCarryingCosts.
+		// hoa_dues are paid every hoa_frequency; special_assessments are the yearly total.
+		field.Float("annual_property_tax").GoType(decimal.Decimal{}).SchemaType(map[string]string{dialect.Postgres: "numeric"}).Optional().Nillable(),
+		field.Float("hoa_dues").GoType(decimal.Decimal{}).SchemaType(map[string]string{dialect.Postgres: "numeric"}).Optional().Nillable(),
+		field.Enum("hoa_frequency").Values("MONTHLY", "QUARTERLY", "ANNUALLY").Optional().Nillable(),
+		field.String("hoa_name").MaxLen(120).Optional(),
+		field.Float("special_assessments").GoType(decimal.Decimal{}).SchemaType(map[string]string{dialect.Postgres: "numeric"}).Optional().Nillable(),
+		field.Float("monthly_utilities").GoType(decimal.Decimal{}).SchemaType(map[string]string{dialect.Postgres: "numeric"}).Optional().Nillable(),
+		field.JSON("media", []schematype.Media{}).Optional(),
 		field.UUID("realtor_id", uuid.UUID{}),
+		field.UUID("property_id", uuid.UUID{}).Optional(),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("expiry_reminder_sent_at").Optional().Nillable(),
+		// expired is set while the listing is ARCHIVED because its term ended, see
+		// repositories.ArchiveExpiredListingsRepo; any other status change clears it
+		field.Bool("expired").Default(false),
+		// listed_at is when the listing first went on the market. Time on the market is
+		// market_seconds of earlier periods plus the time since on_market_since, if it is on it now.
+		field.Time("listed_at").Optional().Nillable(),
+		field.Time("on_market_since").Optional().Nillable(),
+		field.Int64("market_seconds").Default(0).NonNegative(),
+		// sold_price (in the listing's currency), close_date and the buyer's realtor are set
+		// once the sale closes and only while the listing is SOLD
+		field.Float("sold_price").GoType(decimal.Decimal{}).SchemaType(map[string]string{dialect.Postgres: "numeric"}).Optional().Nillable(),
+		field.Time("close_date").Optional().Nillable(),
+		field.UUID("buyer_realtor_id", uuid.UUID{}).Optional().Nillable(),
+		// publish_at and unpublish_at are applied by the listing scheduler, see repositories.ListingScheduleHook
+		field.Time("publish_at").Optional().Nillable(),
+		field.Time("unpublish_at").Optional().Nillable(),
+		// version is bumped on every update, see repositories.ListingVersionHook
+		field.Int("version").Default(1).Positive(),
 	}
 }
 
@@ -62,6 +106,16 @@ func (Listing) Fields() []ent.Field {
 func (Listing) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("realtor", Realtor.Type).Ref("listings").Unique().Field("realtor_id").Required(),
+		edge.From("property", Property.Type).Ref("listings").Unique().Field("property_id"),
+		edge.From("neighborhood", Neighborhood.Type).Ref("listings").Unique().Field("neighborhood_id"),
+		edge.From("buyer_realtor", Realtor.Type).Ref("buyer_side_sales").Unique().Field("buyer_realtor_id"),
+		edge.To("renewals", ListingRenewal.Type),
+		edge.To("documents", ListingDocument.Type),
+		edge.To("inquiries", ListingInquiry.Type),
+		edge.To("reviews", ListingReview.Type),
+		edge.To("offers", Offer.Type),
+		// The status history goes with the listing
+		edge.To("status_events", ListingStatusEvent.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
@@ -70,7 +124,45 @@ func (Listing) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("title"),
 		index.Fields("address"),
+		index.Fields("address_key"),
 		index.Fields("type_of_property"),
+		index.Fields("country", "state"),
 		index.Fields("realtor_id"),
+		index.Fields("property_id"),
+		index.Fields("neighborhood_id"),
+		index.Fields("status", "expires_at"),
+		index.Fields("status", "publish_at"),
+		index.Fields("status", "unpublish_at"),
+		index.Fields("listed_at"),
+		index.Fields("status", "close_date"),
+		index.Fields("buyer_realtor_id"),
+	}
+}
+
+// Hooks of the Listing. Prices must be positive; ent validators do not apply to decimal
+// fields, so a hook checks them.
+func (Listing) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(func(next ent.Mutator) ent.Mutator {
+			return hook.ListingFunc(func(ctx context.Context, m *gen.ListingMutation) (ent.Value, error) {
+				if price, ok := m.Price(); ok && !price.IsPositive() {
+					return nil, errors.New("price must be positive")
+				}
+				return next.Mutate(ctx, m)
+			})
+		}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
+// Policy of the Listing. Anyone can read listings; only staff and the listing's realtor
+// can create, change or delete them.
+func (Listing) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			rule.AllowIfListingRealtor(),
+			privacy.AlwaysDenyRule(),
+		},
 	}
 }
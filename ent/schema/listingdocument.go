@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+)
+
+// ListingDocument holds the schema definition for the ListingDocument entity.
+// Documents such as disclosures are stored privately and only handed out through
+// expiring download links to callers allowed by their access level.
+type ListingDocument struct {
+	ent.Schema
+}
+
+func (ListingDocument) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the ListingDocument.
+func (ListingDocument) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("listing_id", uuid.UUID{}),
+		field.String("title").MaxLen(120).NotEmpty(),
+		field.Enum("kind").Values("disclosure", "inspection", "hoa", "other").Default("other"),
+		field.Enum("access_level").Values("public", "signed_in", "approved_inquirer", "staff").Default("staff"),
+		field.String("filename").MaxLen(255).NotEmpty(),
+		field.Int64("size_bytes").NonNegative(),
+		field.String("storage_id").MaxLen(255).NotEmpty().Sensitive(),
+		field.String("storage_format").MaxLen(20).Optional().Sensitive(),
+		field.String("uploaded_by").MaxLen(255).Optional(),
+	}
+}
+
+// Edges of the ListingDocument.
+func (ListingDocument) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("listing", Listing.Type).Ref("documents").Unique().Field("listing_id").Required(),
+		edge.To("downloads", DocumentDownload.Type),
+	}
+}
+
+// Indexes of the ListingDocument.
+func (ListingDocument) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("listing_id"),
+	}
+}
+
+// Policy of the ListingDocument. Staff, the listing's realtor and their team lead upload
+// and remove documents.
+func (ListingDocument) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			rule.AllowIfDocumentListingRealtor(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
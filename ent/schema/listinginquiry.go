@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+)
+
+// ListingInquiry holds the schema definition for the ListingInquiry entity.
+// A signed-in user inquires about a listing; once staff approve the inquiry the
+// user can access the listing's documents meant for approved inquirers.
+type ListingInquiry struct {
+	ent.Schema
+}
+
+func (ListingInquiry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the ListingInquiry.
+func (ListingInquiry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("listing_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Text("message").Optional().MaxLen(2000),
+		field.Enum("status").Values("PENDING", "APPROVED", "REJECTED").Default("PENDING"),
+		field.Time("decided_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the ListingInquiry.
+func (ListingInquiry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("listing", Listing.Type).Ref("inquiries").Unique().Field("listing_id").Required(),
+		edge.From("user", User.Type).Ref("inquiries").Unique().Field("user_id").Required(),
+	}
+}
+
+// Indexes of the ListingInquiry.
+func (ListingInquiry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("listing_id", "user_id").Unique(),
+	}
+}
+
+// Policy of the ListingInquiry. Users make their own inquiries; only staff decide them.
+func (ListingInquiry) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			rule.AllowIfInquirer(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ListingRenewal holds the schema definition for the ListingRenewal entity.
+// Each row records one extension of a listing's term.
+type ListingRenewal struct {
+	ent.Schema
+}
+
+// Fields of the ListingRenewal.
+func (ListingRenewal) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("listing_id", uuid.UUID{}),
+		field.Time("previous_expires_at").Optional().Nillable(),
+		field.Time("expires_at"),
+		field.Int("term_days").Positive(),
+		field.Time("renewed_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the ListingRenewal.
+func (ListingRenewal) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("listing", Listing.Type).Ref("renewals").Unique().Field("listing_id").Required(),
+	}
+}
+
+// Indexes of the ListingRenewal.
+func (ListingRenewal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("listing_id"),
+	}
+}
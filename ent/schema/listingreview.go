@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// ListingReview holds the schema definition for the ListingReview entity.
+// A NEW review decides whether a submitted listing goes live; an EDIT review holds
+// changes to a published listing's price, description or media until staff approve them.
+// The review's create_time starts its SLA timer.
+type ListingReview struct {
+	ent.Schema
+}
+
+func (ListingReview) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the ListingReview.
+func (ListingReview) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("listing_id", uuid.UUID{}),
+		field.Enum("kind").Values("NEW", "EDIT"),
+		field.Enum("status").Values("PENDING", "APPROVED", "REJECTED", "WITHDRAWN").Default("PENDING"),
+		field.JSON("changes", schematype.ListingChanges{}).Optional(),
+		field.UUID("submitted_by", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("decided_by", uuid.UUID{}).Optional().Nillable(),
+		field.Time("decided_at").Optional().Nillable(),
+		field.JSON("reasons", []string{}).Optional(),
+		field.Text("note").Optional().MaxLen(2000),
+	}
+}
+
+// Edges of the ListingReview.
+func (ListingReview) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("listing", Listing.Type).Ref("reviews").Unique().Field("listing_id").Required(),
+	}
+}
+
+// Indexes of the ListingReview.
+func (ListingReview) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("listing_id"),
+		index.Fields("status", "create_time"),
+	}
+}
+
+// Policy of the ListingReview. Realtors submit and withdraw reviews of their own
+// listings; only staff can decide them.
+func (ListingReview) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			rule.AllowIfReviewListingRealtor(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
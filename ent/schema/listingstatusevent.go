@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ListingStatusEvent holds the schema definition for the ListingStatusEvent entity.
+// Each row records one status transition of a listing; from_status is empty for the
+// status a listing was created with.
+type ListingStatusEvent struct {
+	ent.Schema
+}
+
+// Fields of the ListingStatusEvent.
+func (ListingStatusEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("listing_id", uuid.UUID{}),
+		field.String("from_status").MaxLen(20).Optional(),
+		field.String("to_status").MaxLen(20).NotEmpty(),
+		// changed_by is the user who made the change; empty for system jobs
+		field.UUID("changed_by", uuid.UUID{}).Optional().Nillable(),
+		field.Time("changed_at").Immutable().Default(time.Now),
+	}
+}
+
+// Edges of the ListingStatusEvent.
+func (ListingStatusEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("listing", Listing.Type).Ref("status_events").Unique().Field("listing_id").Required(),
+	}
+}
+
+// Indexes of the ListingStatusEvent.
+func (ListingStatusEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("listing_id", "changed_at"),
+	}
+}
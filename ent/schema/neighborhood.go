@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"regexp"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// Neighborhood holds the schema definition for the Neighborhood entity.
+// Neighborhoods are imported from GeoJSON; listings are linked to the neighborhood
+// whose boundary contains their coordinates. The bounding box narrows that lookup.
+type Neighborhood struct {
+	ent.Schema
+}
+
+func (Neighborhood) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the Neighborhood.
+func (Neighborhood) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").MaxLen(255).NotEmpty(),
+		field.String("city").MaxLen(255).NotEmpty(),
+		field.String("state").MaxLen(3).NotEmpty(),
+		field.String("country").MaxLen(2).Default("US").Match(regexp.MustCompile(`^[A-Z]{2}$`)),
+		field.JSON("boundary", schematype.MultiPolygon{}),
+		field.Float("min_latitude"),
+		field.Float("max_latitude"),
+		field.Float("min_longitude"),
+		field.Float("max_longitude"),
+	}
+}
+
+// Edges of the Neighborhood.
+func (Neighborhood) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("listings", Listing.Type),
+	}
+}
+
+// Indexes of the Neighborhood.
+func (Neighborhood) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "city", "state", "country").Unique(),
+		index.Fields("city"),
+	}
+}
+
+// Policy of the Neighborhood. Only staff import neighborhoods.
+func (Neighborhood) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
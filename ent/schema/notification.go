@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+)
+
+// Notification holds the schema definition for the Notification entity.
+// Notifications are delivered to an email and listed for the user account or realtor
+// profile they are for, never for whoever signs in with that email.
+type Notification struct {
+	ent.Schema
+}
+
+func (Notification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("recipient_email").MaxLen(255).NotEmpty(),
+		// user_id is the account the notification is for, if it is for a user
+		field.UUID("user_id", uuid.UUID{}).Optional().Nillable(),
+		// realtor_id is the realtor profile the notification is for, if it is for a realtor;
+		// it is listed for the account linked to that profile
+		field.UUID("realtor_id", uuid.UUID{}).Optional().Nillable(),
+		field.String("kind").MaxLen(50).NotEmpty(),
+		field.String("subject").MaxLen(255).NotEmpty(),
+		field.Text("body").Optional(),
+		field.Time("read_at").Optional().Nillable(),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("recipient_email"),
+		index.Fields("user_id"),
+		index.Fields("realtor_id"),
+	}
+}
+
+// Policy of the Notification. Notifications are stored as part of changes that were
+// already authorized; otherwise only staff change them.
+func (Notification) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"regexp"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+)
+
+// Offer holds the schema definition for the Offer entity.
+// A buyer makes an offer on a published listing; whoever receives an offer can accept,
+// reject or counter it. A counter is a new offer from the other side chained to the
+// previous one, so a negotiation is the chain of offers from the buyer's first offer.
+type Offer struct {
+	ent.Schema
+}
+
+func (Offer) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the Offer.
+func (Offer) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("listing_id", uuid.UUID{}),
+		field.UUID("buyer_id", uuid.UUID{}),
+		field.UUID("previous_id", uuid.UUID{}).Optional().Nillable(),
+		// side is who made the offer: the buyer, or the seller's realtor countering
+		field.Enum("side").Values("BUYER", "SELLER"),
+		field.UUID("made_by", uuid.UUID{}),
+		field.Float("amount").GoType(decimal.Decimal{}).SchemaType(map[string]string{dialect.Postgres: "numeric"}),
+		field.String("currency").MaxLen(3).Match(regexp.MustCompile(`^[A-Z]{3}$`)),
+		field.JSON("contingencies", []string{}).Optional(),
+		field.Time("closing_date"),
+		field.Time("expires_at"),
+		field.Text("message").Optional().MaxLen(2000),
+		field.Enum("status").Values("PENDING", "ACCEPTED", "REJECTED", "COUNTERED", "WITHDRAWN", "EXPIRED").Default("PENDING"),
+		field.Time("responded_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Offer.
+func (Offer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("listing", Listing.Type).Ref("offers").Unique().Field("listing_id").Required(),
+		edge.From("buyer", User.Type).Ref("offers").Unique().Field("buyer_id").Required(),
+		edge.To("counters", Offer.Type).From("previous").Unique().Field("previous_id"),
+	}
+}
+
+// Indexes of the Offer.
+func (Offer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("listing_id", "status"),
+		index.Fields("buyer_id"),
+		index.Fields("status", "expires_at"),
+	}
+}
+
+// Policy of the Offer. Buyers make and answer their own offers and realtors answer the
+// offers on their listings.
+func (Offer) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			rule.AllowIfOfferParty(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
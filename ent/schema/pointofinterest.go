@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+)
+
+// PointOfInterest holds the schema definition for the PointOfInterest entity.
+// Schools, parks and transit stops are imported from GeoJSON or CSV and shown with
+// their distance on the listings near them.
+type PointOfInterest struct {
+	ent.Schema
+}
+
+func (PointOfInterest) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the PointOfInterest.
+func (PointOfInterest) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").MaxLen(255).NotEmpty(),
+		field.Enum("category").Values("SCHOOL", "PARK", "TRANSIT"),
+		// subtype refines the category, e.g. "elementary" or "subway"
+		field.String("subtype").MaxLen(100).Optional(),
+		field.Float("latitude").Range(-90, 90),
+		field.Float("longitude").Range(-180, 180),
+	}
+}
+
+// Indexes of the PointOfInterest.
+func (PointOfInterest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("category", "name", "latitude", "longitude").Unique(),
+		index.Fields("latitude", "longitude"),
+	}
+}
+
+// Policy of the PointOfInterest. Only staff import points of interest.
+func (PointOfInterest) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"regexp"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+)
+
+// Property holds the schema definition for the Property entity.
+// A property is the physical home behind one or more listings over time.
+type Property struct {
+	ent.Schema
+}
+
+func (Property) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the Property.
+func (Property) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("address").MaxLen(255).NotEmpty(),
+		// address_key is the normalized street, unit and ZIP code; a property is identified
+		// by it together with the city and country, see repositories.upsertPropertyForListing
+		field.String("address_key").MaxLen(300).Optional(),
+		field.String("city").MaxLen(255).NotEmpty(),
+		field.String("state").MaxLen(3).NotEmpty(),
+		field.String("zip_code").MaxLen(10).NotEmpty(),
+		field.String("country").MaxLen(2).Default("US").Match(regexp.MustCompile(`^[A-Z]{2}$`)),
+		field.Enum("type_of_property").Values("house", "apartment", "condo", "townhouse").Default("house"),
+		field.Int("bedroom").Positive(),
+		field.Float("bathroom").Positive(),
+		field.Int("garage").Optional().Positive(),
+		field.Int("sqft").Positive(),
+		field.Int("sqm").Optional().Positive(),
+		field.Int("lot_size").Optional().Positive(),
+		field.Bool("pool").Optional(),
+		field.Int("year_built").Positive().Range(1800, time.Now().Year()),
+	}
+}
+
+// Edges of the Property.
+func (Property) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("listings", Listing.Type),
+	}
+}
+
+// Indexes of the Property.
+func (Property) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("address"),
+		index.Fields("address_key", "country"),
+		index.Fields("city"),
+	}
+}
+
+// Policy of the Property. Properties follow the listings at their address, which are
+// checked by the listing policy in the same transaction; otherwise only staff change them.
+func (Property) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
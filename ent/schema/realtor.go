@@ -10,6 +10,9 @@ import (
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+	"ppgroup.ppgroup.com/ent/schematype"
 )
 
 // Realtor holds the schema definition for the Realtor entity.
@@ -30,7 +33,8 @@ func (Realtor) Fields() []ent.Field {
 
 		field.String("full_name").MaxLen(100).NotEmpty().StructTag(`json:"full_name" validate:"required,min=5,max=100"`),
 
-		field.JSON("photo", map[string]interface{}{}).Optional().StructTag(`json:"photo,omitempty" validate:"omitempty, json"`),
+		// photo is only set by uploading one through the photo endpoint
+		field.JSON("photo", &schematype.Photo{}).Optional().StructTag(`json:"photo,omitempty"`),
 
 		field.Text("description").Optional().MaxLen(500).StructTag(`json:"description,omitempty" validate:"max=500"`),
 
@@ -41,6 +45,20 @@ func (Realtor) Fields() []ent.Field {
 		field.Bool("is_mvp").Default(false).StructTag(`json:"is_mvp"`),
 
 		field.Time("hire_date").Immutable().Default(time.Now).StructTag(`json:"hire_date"`),
+
+		// service_areas are the cities and ZIP codes the realtor works in, searched case-insensitively
+		field.JSON("service_areas", []string{}).Optional().StructTag(`json:"service_areas,omitempty"`),
+
+		// Deactivated realtors keep their history but take no new listings
+		field.Bool("active").Default(true).StructTag(`json:"active"`),
+		field.Time("deactivated_at").Optional().Nillable().StructTag(`json:"deactivated_at,omitempty"`),
+
+		// user_id is the account that signs in as the realtor, linked by accepting an invite
+		field.UUID("user_id", uuid.UUID{}).Optional().Nillable().Unique().StructTag(`json:"-"`),
+
+		// The office and team the realtor works in; the team is one of the brokerage's
+		field.UUID("brokerage_id", uuid.UUID{}).Optional().Nillable().StructTag(`json:"brokerage_id,omitempty"`),
+		field.UUID("team_id", uuid.UUID{}).Optional().Nillable().StructTag(`json:"team_id,omitempty"`),
 	}
 }
 
@@ -48,6 +66,13 @@ func (Realtor) Fields() []ent.Field {
 func (Realtor) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("listings", Listing.Type),
+		// Sold listings where the realtor represented the buyer
+		edge.To("buyer_side_sales", Listing.Type),
+		edge.From("user", User.Type).Ref("realtor").Unique().Field("user_id"),
+		edge.To("invites", RealtorInvite.Type),
+		edge.From("brokerage", Brokerage.Type).Ref("realtors").Unique().Field("brokerage_id"),
+		edge.From("team", Team.Type).Ref("members").Unique().Field("team_id"),
+		edge.To("led_teams", Team.Type),
 	}
 }
 
@@ -57,5 +82,21 @@ func (Realtor) Indexes() []ent.Index {
 		index.Fields("full_name"),
 		index.Fields("email").Unique(),
 		index.Fields("phone").Unique(),
+		index.Fields("active"),
+		index.Fields("brokerage_id"),
+		index.Fields("team_id"),
+	}
+}
+
+// Policy of the Realtor. Anyone can read realtors; staff manage them and realtors can
+// update their own profile.
+func (Realtor) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			rule.AllowIfRealtorSelf(),
+			privacy.AlwaysDenyRule(),
+		},
 	}
 }
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+)
+
+// RealtorInvite holds the schema definition for the RealtorInvite entity.
+// Staff invite someone to take over a realtor profile and hand them a secret token
+// out-of-band; the signed-in user who presents the token accepts the invite and their
+// account is linked to the profile.
+type RealtorInvite struct {
+	ent.Schema
+}
+
+func (RealtorInvite) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the RealtorInvite.
+func (RealtorInvite) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("realtor_id", uuid.UUID{}),
+		// email is where staff sent the invite token; it does not decide who may accept
+		field.String("email").MaxLen(255).NotEmpty(),
+		// token_hash is the SHA-256 of the invite token, which is only shown once to staff
+		field.String("token_hash").MaxLen(64).Optional().Unique().Sensitive(),
+		field.UUID("invited_by", uuid.UUID{}).Immutable(),
+		field.Enum("status").Values("PENDING", "ACCEPTED", "REVOKED").Default("PENDING"),
+		field.Time("expires_at"),
+		field.UUID("accepted_by", uuid.UUID{}).Optional().Nillable(),
+		field.Time("accepted_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the RealtorInvite.
+func (RealtorInvite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("realtor", Realtor.Type).Ref("invites").Unique().Field("realtor_id").Required(),
+	}
+}
+
+// Indexes of the RealtorInvite.
+func (RealtorInvite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("realtor_id", "status"),
+	}
+}
+
+// Policy of the RealtorInvite. Only staff send and revoke invites; accepting one is
+// checked against the invite token where it happens.
+func (RealtorInvite) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
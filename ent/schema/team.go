@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/rule"
+)
+
+// Team holds the schema definition for the Team entity.
+// A team groups realtors of one brokerage; its lead sees and manages the listings of
+// every member.
+type Team struct {
+	ent.Schema
+}
+
+func (Team) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+// Fields of the Team.
+func (Team) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("brokerage_id", uuid.UUID{}).Immutable().StructTag(`json:"brokerage_id"`),
+		field.String("name").MaxLen(100).NotEmpty().StructTag(`json:"name"`),
+		// lead_id is a member of the team
+		field.UUID("lead_id", uuid.UUID{}).Optional().Nillable().StructTag(`json:"lead_id,omitempty"`),
+	}
+}
+
+// Edges of the Team.
+func (Team) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("brokerage", Brokerage.Type).Ref("teams").Unique().Field("brokerage_id").Required().Immutable(),
+		edge.From("lead", Realtor.Type).Ref("led_teams").Unique().Field("lead_id"),
+		edge.To("members", Realtor.Type),
+	}
+}
+
+// Indexes of the Team.
+func (Team) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("brokerage_id", "name").Unique(),
+		index.Fields("lead_id"),
+	}
+}
+
+// Policy of the Team. Anyone can read teams; only staff manage them.
+func (Team) Policy() ent.Policy {
+	return privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			rule.DenyIfNoViewer(),
+			rule.AllowIfStaff(),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
@@ -52,7 +53,12 @@ func (User) Fields() []ent.Field {
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return []ent.Edge{}
+	return []ent.Edge{
+		edge.To("inquiries", ListingInquiry.Type),
+		edge.To("offers", Offer.Type),
+		// The realtor profile the user manages, if any
+		edge.To("realtor", Realtor.Type).Unique(),
+	}
 }
 
 // Indexes of the User.
@@ -0,0 +1,6 @@
+package schematype
+
+// MultiPolygon holds the coordinates of a GeoJSON MultiPolygon: polygons made of rings
+// of [longitude, latitude] positions. The first ring of a polygon is its outer boundary,
+// the others are holes.
+type MultiPolygon [][][][2]float64
@@ -0,0 +1,29 @@
+// Package schematype holds the custom Go types stored in ent schema fields. They live
+// outside the schema package so the generated code can use them without an import cycle.
+package schematype
+
+// MediaType is the kind of a media item.
+type MediaType string
+
+// Media types supported on listings.
+const (
+	MediaTypeImage       MediaType = "image"
+	MediaTypeVideo       MediaType = "video"
+	MediaTypeVirtualTour MediaType = "virtual_tour"
+	MediaTypeFloorPlan   MediaType = "floor_plan"
+)
+
+// Media defines the structure of a media item.
+// PosterURL is the still frame shown before a video plays. StorageID and PosterStorageID
+// identify the files uploaded for the item; media added by URL have none, and only files
+// with a storage ID are removed from storage with the item.
+type Media struct {
+	ID              string    `json:"id,omitempty"`
+	URL             string    `json:"url"`
+	Type            MediaType `json:"type"`
+	Caption         string    `json:"caption,omitempty"`
+	IsPrimary       bool      `json:"is_primary,omitempty"`
+	PosterURL       string    `json:"poster_url,omitempty"`
+	StorageID       string    `json:"storage_id,omitempty"`
+	PosterStorageID string    `json:"poster_storage_id,omitempty"`
+}
@@ -0,0 +1,10 @@
+package schematype
+
+// Photo is a square profile photo. URL is the uploaded photo cropped to a square; the
+// other URLs are renditions of it at fixed sizes.
+type Photo struct {
+	URL       string `json:"url"`
+	Thumbnail string `json:"thumbnail"`
+	Medium    string `json:"medium"`
+	Large     string `json:"large"`
+}
@@ -0,0 +1,19 @@
+package schematype
+
+import "github.com/shopspring/decimal"
+
+// ListingChanges holds the edits to a published listing that wait for staff review.
+// A nil field is left unchanged. An empty Description clears the description, an empty,
+// non-nil Media removes every media item and an empty, non-nil Translations removes
+// every translation.
+type ListingChanges struct {
+	Price        *decimal.Decimal       `json:"price,omitempty"`
+	Description  *string                `json:"description,omitempty"`
+	Media        []Media                `json:"media"`
+	Translations map[string]Translation `json:"translations"`
+}
+
+// IsEmpty reports whether the changes hold no edits.
+func (c ListingChanges) IsEmpty() bool {
+	return c.Price == nil && c.Description == nil && c.Media == nil && c.Translations == nil
+}
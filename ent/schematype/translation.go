@@ -0,0 +1,7 @@
+package schematype
+
+// Translation holds a listing's title and description in one locale.
+type Translation struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent/brokerage"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/team"
+)
+
+// Team is the model entity for the Team schema.
+type Team struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// BrokerageID holds the value of the "brokerage_id" field.
+	BrokerageID uuid.UUID `json:"brokerage_id"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name"`
+	// LeadID holds the value of the "lead_id" field.
+	LeadID *uuid.UUID `json:"lead_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TeamQuery when eager-loading is set.
+	Edges        TeamEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TeamEdges holds the relations/edges for other nodes in the graph.
+type TeamEdges struct {
+	// Brokerage holds the value of the brokerage edge.
+	Brokerage *Brokerage `json:"brokerage,omitempty"`
+	// Lead holds the value of the lead edge.
+	Lead *Realtor `json:"lead,omitempty"`
+	// Members holds the value of the members edge.
+	Members []*Realtor `json:"members,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// BrokerageOrErr returns the Brokerage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamEdges) BrokerageOrErr() (*Brokerage, error) {
+	if e.Brokerage != nil {
+		return e.Brokerage, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: brokerage.Label}
+	}
+	return nil, &NotLoadedError{edge: "brokerage"}
+}
+
+// LeadOrErr returns the Lead value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TeamEdges) LeadOrErr() (*Realtor, error) {
+	if e.Lead != nil {
+		return e.Lead, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: realtor.Label}
+	}
+	return nil, &NotLoadedError{edge: "lead"}
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e TeamEdges) MembersOrErr() ([]*Realtor, error) {
+	if e.loadedTypes[2] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Team) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case team.FieldLeadID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case team.FieldName:
+			values[i] = new(sql.NullString)
+		case team.FieldCreateTime, team.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case team.FieldID, team.FieldBrokerageID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Team fields.
+func (_m *Team) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case team.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case team.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case team.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case team.FieldBrokerageID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field brokerage_id", values[i])
+			} else if value != nil {
+				_m.BrokerageID = *value
+			}
+		case team.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case team.FieldLeadID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_id", values[i])
+			} else if value.Valid {
+				_m.LeadID = new(uuid.UUID)
+				*_m.LeadID = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Team.
+// This includes values selected through modifiers, order, etc.
+func (_m *Team) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBrokerage queries the "brokerage" edge of the Team entity.
+func (_m *Team) QueryBrokerage() *BrokerageQuery {
+	return NewTeamClient(_m.config).QueryBrokerage(_m)
+}
+
+// QueryLead queries the "lead" edge of the Team entity.
+func (_m *Team) QueryLead() *RealtorQuery {
+	return NewTeamClient(_m.config).QueryLead(_m)
+}
+
+// QueryMembers queries the "members" edge of the Team entity.
+func (_m *Team) QueryMembers() *RealtorQuery {
+	return NewTeamClient(_m.config).QueryMembers(_m)
+}
+
+// Update returns a builder for updating this Team.
+// Note that you need to call Team.Unwrap() before calling this method if this Team
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Team) Update() *TeamUpdateOne {
+	return NewTeamClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Team entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Team) Unwrap() *Team {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Team is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Team) String() string {
+	var builder strings.Builder
+	builder.WriteString("Team(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("brokerage_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BrokerageID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.LeadID; v != nil {
+		builder.WriteString("lead_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Teams is a parsable slice of Team.
+type Teams []*Team
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package team
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the team type in the database.
+	Label = "team"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldBrokerageID holds the string denoting the brokerage_id field in the database.
+	FieldBrokerageID = "brokerage_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldLeadID holds the string denoting the lead_id field in the database.
+	FieldLeadID = "lead_id"
+	// EdgeBrokerage holds the string denoting the brokerage edge name in mutations.
+	EdgeBrokerage = "brokerage"
+	// EdgeLead holds the string denoting the lead edge name in mutations.
+	EdgeLead = "lead"
+	// EdgeMembers holds the string denoting the members edge name in mutations.
+	EdgeMembers = "members"
+	// Table holds the table name of the team in the database.
+	Table = "teams"
+	// BrokerageTable is the table that holds the brokerage relation/edge.
+	BrokerageTable = "teams"
+	// BrokerageInverseTable is the table name for the Brokerage entity.
+	// It exists in this package in order to avoid circular dependency with the "brokerage" package.
+	BrokerageInverseTable = "brokerages"
+	// BrokerageColumn is the table column denoting the brokerage relation/edge.
+	BrokerageColumn = "brokerage_id"
+	// LeadTable is the table that holds the lead relation/edge.
+	LeadTable = "teams"
+	// LeadInverseTable is the table name for the Realtor entity.
+	// It exists in this package in order to avoid circular dependency with the "realtor" package.
+	LeadInverseTable = "realtors"
+	// LeadColumn is the table column denoting the lead relation/edge.
+	LeadColumn = "lead_id"
+	// MembersTable is the table that holds the members relation/edge.
+	MembersTable = "realtors"
+	// MembersInverseTable is the table name for the Realtor entity.
+	// It exists in this package in order to avoid circular dependency with the "realtor" package.
+	MembersInverseTable = "realtors"
+	// MembersColumn is the table column denoting the members relation/edge.
+	MembersColumn = "team_id"
+)
+
+// Columns holds all SQL columns for team fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldBrokerageID,
+	FieldName,
+	FieldLeadID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "ppgroup.ppgroup.com/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Team queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByBrokerageID orders the results by the brokerage_id field.
+func ByBrokerageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBrokerageID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByLeadID orders the results by the lead_id field.
+func ByLeadID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeadID, opts...).ToFunc()
+}
+
+// ByBrokerageField orders the results by brokerage field.
+func ByBrokerageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBrokerageStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLeadField orders the results by lead field.
+func ByLeadField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLeadStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMembersCount orders the results by members count.
+func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembersStep(), opts...)
+	}
+}
+
+// ByMembers orders the results by members terms.
+func ByMembers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newBrokerageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BrokerageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BrokerageTable, BrokerageColumn),
+	)
+}
+func newLeadStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LeadInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LeadTable, LeadColumn),
+	)
+}
+func newMembersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MembersTable, MembersColumn),
+	)
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,10 +14,40 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Brokerage is the client for interacting with the Brokerage builders.
+	Brokerage *BrokerageClient
+	// DocumentDownload is the client for interacting with the DocumentDownload builders.
+	DocumentDownload *DocumentDownloadClient
+	// ExchangeRate is the client for interacting with the ExchangeRate builders.
+	ExchangeRate *ExchangeRateClient
 	// Listing is the client for interacting with the Listing builders.
 	Listing *ListingClient
+	// ListingDocument is the client for interacting with the ListingDocument builders.
+	ListingDocument *ListingDocumentClient
+	// ListingInquiry is the client for interacting with the ListingInquiry builders.
+	ListingInquiry *ListingInquiryClient
+	// ListingRenewal is the client for interacting with the ListingRenewal builders.
+	ListingRenewal *ListingRenewalClient
+	// ListingReview is the client for interacting with the ListingReview builders.
+	ListingReview *ListingReviewClient
+	// ListingStatusEvent is the client for interacting with the ListingStatusEvent builders.
+	ListingStatusEvent *ListingStatusEventClient
+	// Neighborhood is the client for interacting with the Neighborhood builders.
+	Neighborhood *NeighborhoodClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// Offer is the client for interacting with the Offer builders.
+	Offer *OfferClient
+	// PointOfInterest is the client for interacting with the PointOfInterest builders.
+	PointOfInterest *PointOfInterestClient
+	// Property is the client for interacting with the Property builders.
+	Property *PropertyClient
 	// Realtor is the client for interacting with the Realtor builders.
 	Realtor *RealtorClient
+	// RealtorInvite is the client for interacting with the RealtorInvite builders.
+	RealtorInvite *RealtorInviteClient
+	// Team is the client for interacting with the Team builders.
+	Team *TeamClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -149,8 +181,23 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Brokerage = NewBrokerageClient(tx.config)
+	tx.DocumentDownload = NewDocumentDownloadClient(tx.config)
+	tx.ExchangeRate = NewExchangeRateClient(tx.config)
 	tx.Listing = NewListingClient(tx.config)
+	tx.ListingDocument = NewListingDocumentClient(tx.config)
+	tx.ListingInquiry = NewListingInquiryClient(tx.config)
+	tx.ListingRenewal = NewListingRenewalClient(tx.config)
+	tx.ListingReview = NewListingReviewClient(tx.config)
+	tx.ListingStatusEvent = NewListingStatusEventClient(tx.config)
+	tx.Neighborhood = NewNeighborhoodClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.Offer = NewOfferClient(tx.config)
+	tx.PointOfInterest = NewPointOfInterestClient(tx.config)
+	tx.Property = NewPropertyClient(tx.config)
 	tx.Realtor = NewRealtorClient(tx.config)
+	tx.RealtorInvite = NewRealtorInviteClient(tx.config)
+	tx.Team = NewTeamClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -161,7 +208,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Listing.QueryXXX(), the query will be executed
+// applies a query, for example: Brokerage.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -214,3 +261,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -38,8 +39,35 @@ const (
 	FieldProvider = "provider"
 	// FieldProviderID holds the string denoting the provider_id field in the database.
 	FieldProviderID = "provider_id"
+	// EdgeInquiries holds the string denoting the inquiries edge name in mutations.
+	EdgeInquiries = "inquiries"
+	// EdgeOffers holds the string denoting the offers edge name in mutations.
+	EdgeOffers = "offers"
+	// EdgeRealtor holds the string denoting the realtor edge name in mutations.
+	EdgeRealtor = "realtor"
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// InquiriesTable is the table that holds the inquiries relation/edge.
+	InquiriesTable = "listing_inquiries"
+	// InquiriesInverseTable is the table name for the ListingInquiry entity.
+	// It exists in this package in order to avoid circular dependency with the "listinginquiry" package.
+	InquiriesInverseTable = "listing_inquiries"
+	// InquiriesColumn is the table column denoting the inquiries relation/edge.
+	InquiriesColumn = "user_id"
+	// OffersTable is the table that holds the offers relation/edge.
+	OffersTable = "offers"
+	// OffersInverseTable is the table name for the Offer entity.
+	// It exists in this package in order to avoid circular dependency with the "offer" package.
+	OffersInverseTable = "offers"
+	// OffersColumn is the table column denoting the offers relation/edge.
+	OffersColumn = "buyer_id"
+	// RealtorTable is the table that holds the realtor relation/edge.
+	RealtorTable = "realtors"
+	// RealtorInverseTable is the table name for the Realtor entity.
+	// It exists in this package in order to avoid circular dependency with the "realtor" package.
+	RealtorInverseTable = "realtors"
+	// RealtorColumn is the table column denoting the realtor relation/edge.
+	RealtorColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -165,3 +193,59 @@ func ByProvider(opts ...sql.OrderTermOption) OrderOption {
 func ByProviderID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProviderID, opts...).ToFunc()
 }
+
+// ByInquiriesCount orders the results by inquiries count.
+func ByInquiriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInquiriesStep(), opts...)
+	}
+}
+
+// ByInquiries orders the results by inquiries terms.
+func ByInquiries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInquiriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOffersCount orders the results by offers count.
+func ByOffersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOffersStep(), opts...)
+	}
+}
+
+// ByOffers orders the results by offers terms.
+func ByOffers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOffersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRealtorField orders the results by realtor field.
+func ByRealtorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRealtorStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newInquiriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InquiriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InquiriesTable, InquiriesColumn),
+	)
+}
+func newOffersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OffersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OffersTable, OffersColumn),
+	)
+}
+func newRealtorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RealtorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, RealtorTable, RealtorColumn),
+	)
+}
@@ -0,0 +1,426 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/repositories"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// patchableBrokerageFields are the brokerage members a merge patch may set. Members mapped
+// to true are optional and can be cleared with null; the others are required.
+var patchableBrokerageFields = map[string]bool{
+	"name": false, "license_number": false, "address": false, "city": false, "state": true,
+	"zip_code": false, "phone": true, "email": true, "website": true, "logo_url": true,
+	"primary_color": true, "secondary_color": true,
+}
+
+// patchableTeamFields are the team members a merge patch may set.
+var patchableTeamFields = map[string]bool{"name": false, "lead_id": true}
+
+// GetBrokerages handles listing all brokerages.
+// @Summary List brokerages
+// @Tags brokerages
+// @Produce json
+// @Success 200 {object} gin.H{"status": "OK", "data": []ent.Brokerage}
+// @Router /api/v1/brokerages [get]
+func GetBrokerages(c *gin.Context) {
+	entClient := c.MustGet("entClient").(*ent.Client)
+	brokerages, err := repositories.GetBrokeragesRepo(c.Request.Context(), entClient)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get brokerages", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": brokerages})
+}
+
+// GetBrokerage handles retrieving a brokerage with its teams.
+// @Summary Get a brokerage
+// @Tags brokerages
+// @Produce json
+// @Param id path string true "Brokerage UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Brokerage}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/brokerages/{id} [get]
+func GetBrokerage(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid brokerage ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	brokerage, err := repositories.GetBrokerageRepo(c.Request.Context(), entClient, id)
+	if err != nil {
+		c.JSON(brokerageErrorStatus(err), gin.H{"error": "Failed to get brokerage", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": brokerage})
+}
+
+// CreateBrokerage handles the creation of a brokerage. Staff only.
+// @Summary Create a brokerage
+// @Description Creates an office with its license number, address and branding.
+// @Tags brokerages
+// @Accept json
+// @Produce json
+// @Param input body ent.Brokerage true "Brokerage"
+// @Success 201 {object} gin.H{"status": "OK", "data": ent.Brokerage}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/brokerages [post]
+func CreateBrokerage(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	var input ent.Brokerage
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	created, err := repositories.CreateBrokerageRepo(c.Request.Context(), entClient, &input)
+	if err != nil {
+		c.JSON(brokerageErrorStatus(err), gin.H{"error": "Failed to create brokerage", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "OK", "message": "Brokerage created!", "data": created})
+}
+
+// UpdateBrokerage handles partial updates of a brokerage with a JSON Merge Patch (RFC 7396).
+// Staff only.
+// @Summary Update a brokerage
+// @Description Absent members are kept; null clears state, phone, email, website and the branding.
+// @Tags brokerages
+// @Accept application/merge-patch+json
+// @Accept json
+// @Produce json
+// @Param id path string true "Brokerage UUID"
+// @Param input body object true "Merge patch of brokerage fields"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Brokerage}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/brokerages/{id} [patch]
+func UpdateBrokerage(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid brokerage ID"})
+		return
+	}
+	patch, ok := readMergePatch(c, patchableBrokerageFields)
+	if !ok {
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	current, err := repositories.GetBrokerageRepo(c.Request.Context(), entClient, id)
+	if err != nil {
+		c.JSON(brokerageErrorStatus(err), gin.H{"error": "Failed to update brokerage", "message": err.Error()})
+		return
+	}
+	var merged ent.Brokerage
+	if !applyMergePatch(c, current, patch, &merged) {
+		return
+	}
+	merged.ID = id
+
+	updated, err := repositories.UpdateBrokerageRepo(c.Request.Context(), entClient, &merged)
+	if err != nil {
+		c.JSON(brokerageErrorStatus(err), gin.H{"error": "Failed to update brokerage", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Brokerage updated!", "data": updated})
+}
+
+// DeleteBrokerage handles deleting a brokerage without teams or realtors. Staff only.
+// @Summary Delete a brokerage
+// @Tags brokerages
+// @Produce json
+// @Param id path string true "Brokerage UUID"
+// @Success 200 {object} gin.H{"status": "OK", "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/brokerages/{id} [delete]
+func DeleteBrokerage(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid brokerage ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	if err := repositories.DeleteBrokerageRepo(c.Request.Context(), entClient, id); err != nil {
+		c.JSON(brokerageErrorStatus(err), gin.H{"error": "Failed to delete brokerage", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Brokerage deleted!"})
+}
+
+// TeamInput names a new team
+type TeamInput struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// CreateTeam handles the creation of a team in a brokerage. Staff only.
+// @Summary Create a team
+// @Description Realtors join the team by having it set as their team_id; its lead is then one of them.
+// @Tags brokerages
+// @Accept json
+// @Produce json
+// @Param id path string true "Brokerage UUID"
+// @Param input body TeamInput true "Team"
+// @Success 201 {object} gin.H{"status": "OK", "data": ent.Team}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/brokerages/{id}/teams [post]
+func CreateTeam(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid brokerage ID"})
+		return
+	}
+
+	var input TeamInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	created, err := repositories.CreateTeamRepo(c.Request.Context(), entClient, id, input.Name)
+	if err != nil {
+		c.JSON(brokerageErrorStatus(err), gin.H{"error": "Failed to create team", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "OK", "message": "Team created!", "data": created})
+}
+
+// GetTeam handles retrieving a team with its members.
+// @Summary Get a team
+// @Tags brokerages
+// @Produce json
+// @Param id path string true "Team UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Team}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/teams/{id} [get]
+func GetTeam(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid team ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	team, err := repositories.GetTeamRepo(c.Request.Context(), entClient, id)
+	if err != nil {
+		c.JSON(brokerageErrorStatus(err), gin.H{"error": "Failed to get team", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": team})
+}
+
+// UpdateTeam handles renaming a team and choosing its lead with a JSON Merge Patch
+// (RFC 7396). Staff only.
+// @Summary Update a team
+// @Description The lead must be a member of the team; null removes the lead.
+// @Tags brokerages
+// @Accept application/merge-patch+json
+// @Accept json
+// @Produce json
+// @Param id path string true "Team UUID"
+// @Param input body object true "Merge patch of name and lead_id"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Team}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/teams/{id} [patch]
+func UpdateTeam(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid team ID"})
+		return
+	}
+	patch, ok := readMergePatch(c, patchableTeamFields)
+	if !ok {
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	current, err := repositories.GetTeamRepo(c.Request.Context(), entClient, id)
+	if err != nil {
+		c.JSON(brokerageErrorStatus(err), gin.H{"error": "Failed to update team", "message": err.Error()})
+		return
+	}
+	var merged ent.Team
+	if !applyMergePatch(c, current, patch, &merged) {
+		return
+	}
+	merged.ID = id
+
+	updated, err := repositories.UpdateTeamRepo(c.Request.Context(), entClient, &merged)
+	if err != nil {
+		c.JSON(brokerageErrorStatus(err), gin.H{"error": "Failed to update team", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Team updated!", "data": updated})
+}
+
+// DeleteTeam handles deleting a team. Its members stay with the brokerage. Staff only.
+// @Summary Delete a team
+// @Tags brokerages
+// @Produce json
+// @Param id path string true "Team UUID"
+// @Success 200 {object} gin.H{"status": "OK", "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/teams/{id} [delete]
+func DeleteTeam(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid team ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	if err := repositories.DeleteTeamRepo(c.Request.Context(), entClient, id); err != nil {
+		c.JSON(brokerageErrorStatus(err), gin.H{"error": "Failed to delete team", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Team deleted!"})
+}
+
+// GetTeamListings handles listing the listings of a team's members in every status.
+// Only staff and the team lead can see them.
+// @Summary List a team's listings
+// @Tags brokerages
+// @Produce json
+// @Param id path string true "Team UUID"
+// @Param status query string false "Only listings in this status"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} gin.H{"status": "OK", "data": []repositories.Listing, "meta": repositories.RealtorSearchMeta}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/teams/{id}/listings [get]
+func GetTeamListings(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid team ID"})
+		return
+	}
+
+	var params repositories.RealtorListingsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	listings, meta, err := repositories.GetTeamListingsRepo(c.Request.Context(), entClient, id, params)
+	if err != nil {
+		c.JSON(brokerageErrorStatus(err), gin.H{"error": "Failed to get team listings", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": listings, "meta": meta})
+}
+
+// readMergePatch reads a JSON Merge Patch from the request body and checks that it only
+// sets patchable members and does not clear required ones, responding with 400 otherwise.
+func readMergePatch(c *gin.Context, patchable map[string]bool) (map[string]any, bool) {
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return nil, false
+	}
+	patch, err := services.ParseMergePatch(body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return nil, false
+	}
+	for field, value := range patch {
+		optional, ok := patchable[field]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "Field cannot be updated: " + field})
+			return nil, false
+		}
+		if value == nil && !optional {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "Required field cannot be cleared: " + field})
+			return nil, false
+		}
+	}
+	return patch, true
+}
+
+// applyMergePatch applies patch to the JSON of current and decodes the result into merged.
+func applyMergePatch(c *gin.Context, current any, patch map[string]any, merged any) bool {
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to apply changes", "message": err.Error()})
+		return false
+	}
+	mergedJSON, err := services.ApplyMergePatch(currentJSON, patch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to apply changes", "message": err.Error()})
+		return false
+	}
+	if err := json.Unmarshal(mergedJSON, merged); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return false
+	}
+	return true
+}
+
+// brokerageErrorStatus maps an error from the brokerage and team repositories to a status code.
+func brokerageErrorStatus(err error) int {
+	switch {
+	case isForbidden(err), errors.Is(err, repositories.ErrNotTeamLead):
+		return http.StatusForbidden
+	case errors.Is(err, repositories.ErrBrokerageNotFound), errors.Is(err, repositories.ErrTeamNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, repositories.ErrBrokerageExists), errors.Is(err, repositories.ErrTeamExists),
+		errors.Is(err, repositories.ErrBrokerageInUse):
+		return http.StatusConflict
+	case errors.Is(err, repositories.ErrInvalidTeam), ent.IsValidationError(err):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
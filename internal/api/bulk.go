@@ -0,0 +1,103 @@
+package api
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/internal/repositories"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// PriceAdjustmentInput holds a bulk price change. Absolute values are added to the price
+// in the listing's currency; percentages scale it, e.g. -5 lowers prices by 5%.
+type PriceAdjustmentInput struct {
+	Mode  string          `json:"mode" binding:"required,oneof=absolute percent"`
+	Value decimal.Decimal `json:"value" binding:"required"`
+}
+
+// BulkListingInput holds a bulk operation on listings selected by IDs or by a filter
+type BulkListingInput struct {
+	IDs       []uuid.UUID                     `json:"ids"`
+	Filter    *repositories.BulkListingFilter `json:"filter"`
+	Action    string                          `json:"action" binding:"required,oneof=set_status reassign adjust_price delete"`
+	Status    listing.Status                  `json:"status"`
+	RealtorID uuid.UUID                       `json:"realtor_id"`
+	Price     *PriceAdjustmentInput           `json:"price"`
+	DryRun    bool                            `json:"dry_run"`
+}
+
+// BulkUpdateListings handles status changes, realtor reassignment, price adjustments and
+// deletion of many listings at once. The operation runs in one transaction: it is applied
+// only if every listing succeeds, and a dry run reports the outcome without applying it.
+// Staff only.
+// @Summary Change many listings at once
+// @Tags listings
+// @Accept json
+// @Produce json
+// @Param input body BulkListingInput true "Bulk operation"
+// @Success 200 {object} gin.H{"status": "OK", "data": repositories.BulkListingReport}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "data": repositories.BulkListingReport}
+// @Router /api/v1/properties/bulk [post]
+func BulkUpdateListings(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	var input BulkListingInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+	if len(input.IDs) > 0 && input.Filter != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "Provide either ids or filter, not both"})
+		return
+	}
+
+	op := repositories.BulkListingOperation{Action: input.Action}
+	switch input.Action {
+	case repositories.BulkSetStatus:
+		if !slices.Contains(repositories.BulkStatuses, input.Status) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "status must be one of DRAFT, PUBLISHED, ARCHIVED"})
+			return
+		}
+		op.Status = input.Status
+	case repositories.BulkReassign:
+		if input.RealtorID == uuid.Nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "realtor_id is required"})
+			return
+		}
+		op.RealtorID = input.RealtorID
+	case repositories.BulkAdjustPrice:
+		if input.Price == nil || input.Price.Value.IsZero() {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "price with a non-zero value is required"})
+			return
+		}
+		op.PriceMode = input.Price.Mode
+		op.PriceValue = input.Price.Value
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	report, err := repositories.BulkUpdateListingsRepo(c.Request.Context(), entClient, input.IDs, input.Filter, op, input.DryRun)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to run bulk operation", "message": err.Error()})
+		return
+	}
+
+	if !input.DryRun && !report.Applied {
+		c.JSON(http.StatusConflict, gin.H{"error": "Bulk operation rolled back because some listings failed", "data": report})
+		return
+	}
+
+	if report.Applied {
+		imageService := c.MustGet("imageService").(*services.ImageService)
+		deleteDocumentFiles(c.Request.Context(), imageService, report.DocumentStorageIDs)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": report})
+}
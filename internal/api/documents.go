@@ -0,0 +1,349 @@
+package api
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/internal/config"
+	"ppgroup.ppgroup.com/internal/repositories"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// storageLinkTTL is how long the storage URL behind a verified download link stays valid.
+// It only has to survive the redirect.
+const storageLinkTTL = time.Minute
+
+// UploadListingDocument handles attaching a private document to a listing. Staff, the
+// listing's realtor and their team lead can upload documents.
+// @Summary Upload a listing document
+// @Tags documents
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param file formData file true "Document (pdf, doc, docx, png, jpg, jpeg; 25 MB)"
+// @Param title formData string true "Document title"
+// @Param kind formData string false "Document kind" Enums(disclosure, inspection, hoa, other) default(other)
+// @Param access_level formData string false "Who may download the document" Enums(public, signed_in, approved_inquirer, staff) default(staff)
+// @Success 201 {object} gin.H{"status": "OK", "data": ent.ListingDocument}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 401 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/documents [post]
+func UploadListingDocument(c *gin.Context) {
+	user, err := sessionUser(c)
+	if err != nil || user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized", "message": "Please sign in"})
+		return
+	}
+
+	listingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No document provided", "message": err.Error()})
+		return
+	}
+	if err := services.ValidateDocumentFile(fileHeader.Filename, fileHeader.Size); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document", "message": err.Error()})
+		return
+	}
+
+	doc := &ent.ListingDocument{
+		ListingID:   listingID,
+		Title:       c.PostForm("title"),
+		Kind:        listingdocument.Kind(c.DefaultPostForm("kind", listingdocument.DefaultKind.String())),
+		AccessLevel: listingdocument.AccessLevel(c.DefaultPostForm("access_level", listingdocument.DefaultAccessLevel.String())),
+		Filename:    fileHeader.Filename,
+		SizeBytes:   fileHeader.Size,
+		UploadedBy:  user.Email,
+	}
+	if doc.Title == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing required fields", "message": "title is required"})
+		return
+	}
+	if err := listingdocument.KindValidator(doc.Kind); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid kind", "message": err.Error()})
+		return
+	}
+	if err := listingdocument.AccessLevelValidator(doc.AccessLevel); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid access_level", "message": err.Error()})
+		return
+	}
+
+	// Check who may attach documents before anything is uploaded
+	entClient := c.MustGet("entClient").(*ent.Client)
+	if err := repositories.RequireDocumentManagerRepo(c.Request.Context(), entClient, listingID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Failed to upload document", "message": err.Error()})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open file: " + fileHeader.Filename})
+		return
+	}
+	defer file.Close()
+
+	imageService := c.MustGet("imageService").(*services.ImageService)
+	doc.StorageID, doc.StorageFormat, err = imageService.UploadDocument(c.Request.Context(), file, fileHeader.Filename)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upload document", "message": err.Error()})
+		return
+	}
+
+	created, err := repositories.CreateListingDocumentRepo(c.Request.Context(), entClient, doc)
+	if err != nil {
+		if err := imageService.DeleteDocument(c.Request.Context(), doc.StorageID); err != nil {
+			log.Printf("Failed to remove uploaded document %s: %v", doc.StorageID, err)
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to create document", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "OK", "data": created})
+}
+
+// GetListingDocuments handles listing the documents of a listing that the caller may download.
+// @Summary List listing documents
+// @Tags documents
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": []ent.ListingDocument}
+// @Router /api/v1/properties/{id}/documents [get]
+func GetListingDocuments(c *gin.Context) {
+	listingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	user, err := sessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	docs, err := repositories.GetListingDocumentsRepo(entClient, listingID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get documents", "message": err.Error()})
+		return
+	}
+
+	visible := make([]*ent.ListingDocument, 0, len(docs))
+	for _, doc := range docs {
+		ok, err := repositories.CanAccessDocumentRepo(entClient, doc, user)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check document access", "message": err.Error()})
+			return
+		}
+		if ok {
+			visible = append(visible, doc)
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": visible})
+}
+
+// CreateDocumentLink handles issuing an expiring download link for a document.
+// @Summary Get a document download link
+// @Description Returns a link that downloads the document until it expires. Every download is audited.
+// @Tags documents
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param documentId path string true "Document UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": gin.H{"url": string, "expires_at": string}}
+// @Failure 400 {object} gin.H{"error": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/documents/{documentId}/link [post]
+func CreateDocumentLink(c *gin.Context) {
+	listingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+	documentID, err := uuid.Parse(c.Param("documentId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document ID"})
+		return
+	}
+
+	user, err := sessionUser(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	doc, err := repositories.GetListingDocumentRepo(entClient, documentID)
+	if err != nil || doc.ListingID != listingID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Document not found"})
+		return
+	}
+
+	ok, err := repositories.CanAccessDocumentRepo(entClient, doc, user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check document access", "message": err.Error()})
+		return
+	}
+	if !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden", "message": "You do not have access to this document"})
+		return
+	}
+
+	var email string
+	if user != nil {
+		email = user.Email
+	}
+
+	cfg := c.MustGet("config").(*config.Config)
+	expiresAt := time.Now().Add(time.Duration(cfg.DocumentLinkTTLMinutes) * time.Minute)
+	token := services.NewDocumentLinkSigner(cfg.SessionKey).Sign(doc.ID, email, expiresAt)
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "OK",
+		"data": gin.H{
+			"url":        "/api/v1/documents/download?token=" + url.QueryEscape(token),
+			"expires_at": expiresAt,
+		},
+	})
+}
+
+// DownloadDocument handles a download link: it verifies the token, checks that its holder
+// still has access, records the download and redirects to a short-lived storage URL.
+// @Summary Download a document
+// @Tags documents
+// @Param token query string true "Download token"
+// @Success 302
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/documents/download [get]
+func DownloadDocument(c *gin.Context) {
+	cfg := c.MustGet("config").(*config.Config)
+	documentID, email, err := services.NewDocumentLinkSigner(cfg.SessionKey).Verify(c.Query("token"))
+	if err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	doc, err := repositories.GetListingDocumentRepo(entClient, documentID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Document not found"})
+		return
+	}
+
+	// Access may have been revoked since the link was issued
+	var user *ent.User
+	if email != "" {
+		user, err = repositories.GetUserRepo(entClient, email)
+		if err != nil {
+			user = nil
+		}
+	}
+	ok, err := repositories.CanAccessDocumentRepo(entClient, doc, user)
+	if err != nil || !ok {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden", "message": "You no longer have access to this document"})
+		return
+	}
+
+	imageService := c.MustGet("imageService").(*services.ImageService)
+	storageURL, err := imageService.DocumentDownloadURL(doc.StorageID, doc.StorageFormat, storageLinkTTL)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to prepare download", "message": err.Error()})
+		return
+	}
+
+	if err := repositories.RecordDocumentDownloadRepo(entClient, doc.ID, email, c.ClientIP(), c.Request.UserAgent()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to record download", "message": err.Error()})
+		return
+	}
+
+	c.Redirect(http.StatusFound, storageURL)
+}
+
+// GetDocumentDownloads handles reading the download audit log of a document. Staff only.
+// @Summary Get a document's download log
+// @Tags documents
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param documentId path string true "Document UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": []ent.DocumentDownload}
+// @Router /api/v1/properties/{id}/documents/{documentId}/downloads [get]
+func GetDocumentDownloads(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	documentID, err := uuid.Parse(c.Param("documentId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	downloads, err := repositories.GetDocumentDownloadsRepo(entClient, documentID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get downloads", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": downloads})
+}
+
+// DeleteListingDocument handles removing a document from a listing and from storage. Staff,
+// the listing's realtor and their team lead can delete documents.
+// @Summary Delete a listing document
+// @Tags documents
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param documentId path string true "Document UUID"
+// @Success 200 {object} gin.H{"status": "OK", "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/documents/{documentId} [delete]
+func DeleteListingDocument(c *gin.Context) {
+	listingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+	documentID, err := uuid.Parse(c.Param("documentId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	doc, err := repositories.DeleteListingDocumentRepo(c.Request.Context(), entClient, listingID, documentID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Failed to delete document", "message": err.Error()})
+		return
+	}
+
+	imageService := c.MustGet("imageService").(*services.ImageService)
+	if err := imageService.DeleteDocument(c.Request.Context(), doc.StorageID); err != nil {
+		log.Printf("Failed to delete document file %s: %v", doc.StorageID, err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Document deleted"})
+}
+
+// deleteDocumentFiles removes the stored files of deleted documents. The documents are
+// already gone, so failures are only logged.
+func deleteDocumentFiles(ctx context.Context, imageService *services.ImageService, storageIDs []string) {
+	for _, storageID := range storageIDs {
+		if err := imageService.DeleteDocument(ctx, storageID); err != nil {
+			log.Printf("Failed to delete document file %s: %v", storageID, err)
+		}
+	}
+}
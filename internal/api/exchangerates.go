@@ -0,0 +1,99 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/config"
+	"ppgroup.ppgroup.com/internal/repositories"
+)
+
+// GetExchangeRates handles listing the exchange-rate table used to convert listing prices.
+// @Summary List exchange rates
+// @Tags exchange-rates
+// @Produce json
+// @Success 200 {object} gin.H{"status": "OK", "base_currency": string, "data": []ent.ExchangeRate}
+// @Router /api/v1/exchange-rates [get]
+func GetExchangeRates(c *gin.Context) {
+	entClient := c.MustGet("entClient").(*ent.Client)
+	rates, err := repositories.GetExchangeRatesRepo(entClient)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get exchange rates", "message": err.Error()})
+		return
+	}
+
+	cfg := c.MustGet("config").(*config.Config)
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "base_currency": cfg.BaseCurrency, "data": rates})
+}
+
+// SetExchangeRateInput holds the rate of a currency against the base currency
+type SetExchangeRateInput struct {
+	Rate decimal.Decimal `json:"rate" binding:"required"`
+}
+
+// SetExchangeRate handles creating or updating the rate of a currency. Staff only.
+// @Summary Set an exchange rate
+// @Description The rate is how many units of the base currency one unit of the currency buys
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param currency path string true "ISO currency code"
+// @Param input body SetExchangeRateInput true "Rate"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.ExchangeRate}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/exchange-rates/{currency} [put]
+func SetExchangeRate(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	var input SetExchangeRateInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	cfg := c.MustGet("config").(*config.Config)
+	rate, err := repositories.SetExchangeRateRepo(c.Request.Context(), entClient, cfg.BaseCurrency, c.Param("currency"), input.Rate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to set exchange rate", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": rate})
+}
+
+// DeleteExchangeRate handles removing a currency that no listing uses. Staff only.
+// @Summary Delete an exchange rate
+// @Tags exchange-rates
+// @Produce json
+// @Param currency path string true "ISO currency code"
+// @Success 200 {object} gin.H{"status": "OK", "message": string}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/exchange-rates/{currency} [delete]
+func DeleteExchangeRate(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	cfg := c.MustGet("config").(*config.Config)
+	if err := repositories.DeleteExchangeRateRepo(c.Request.Context(), entClient, cfg.BaseCurrency, c.Param("currency")); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, repositories.ErrUnknownCurrency) {
+			status = http.StatusNotFound
+		} else if errors.Is(err, repositories.ErrCurrencyInUse) {
+			status = http.StatusConflict
+		}
+		c.JSON(status, gin.H{"error": "Failed to delete exchange rate", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Exchange rate deleted"})
+}
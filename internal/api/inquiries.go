@@ -0,0 +1,132 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/repositories"
+)
+
+// CreateInquiryInput holds the message of an inquiry
+type CreateInquiryInput struct {
+	Message string `json:"message" binding:"max=2000"`
+}
+
+// CreateListingInquiry handles a signed-in user's inquiry about a published listing.
+// @Summary Inquire about a listing
+// @Tags inquiries
+// @Accept json
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param input body CreateInquiryInput false "Inquiry message"
+// @Success 201 {object} gin.H{"status": "OK", "data": ent.ListingInquiry}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/inquiries [post]
+func CreateListingInquiry(c *gin.Context) {
+	listingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	var input CreateInquiryInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+			return
+		}
+	}
+
+	user, err := sessionUser(c)
+	if err != nil || user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized", "message": "Please sign in"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	inquiry, err := repositories.CreateInquiryRepo(c.Request.Context(), entClient, listingID, user.ID, input.Message)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to create inquiry", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "OK", "data": inquiry})
+}
+
+// GetListingInquiries handles listing the inquiries about a listing. Staff only.
+// @Summary List listing inquiries
+// @Tags inquiries
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": []ent.ListingInquiry}
+// @Router /api/v1/properties/{id}/inquiries [get]
+func GetListingInquiries(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	listingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	inquiries, err := repositories.GetListingInquiriesRepo(entClient, listingID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get inquiries", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": inquiries})
+}
+
+// DecideInquiryInput holds the staff decision on an inquiry
+type DecideInquiryInput struct {
+	Status string `json:"status" binding:"required,oneof=APPROVED REJECTED"`
+}
+
+// DecideListingInquiry handles approving or rejecting an inquiry. Approved inquirers can
+// download the listing's documents meant for them. Staff only.
+// @Summary Approve or reject an inquiry
+// @Tags inquiries
+// @Accept json
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param inquiryId path string true "Inquiry UUID"
+// @Param input body DecideInquiryInput true "Decision"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.ListingInquiry}
+// @Router /api/v1/properties/{id}/inquiries/{inquiryId} [put]
+func DecideListingInquiry(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	listingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+	inquiryID, err := uuid.Parse(c.Param("inquiryId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid inquiry ID"})
+		return
+	}
+
+	var input DecideInquiryInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	inquiry, err := repositories.DecideInquiryRepo(c.Request.Context(), entClient, listingID, inquiryID, input.Status == "APPROVED")
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Failed to update inquiry", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": inquiry})
+}
@@ -1,16 +1,22 @@
 package api
 
 import (
+	"encoding/json"
+	"errors"
+	"maps"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"ppgroup.ppgroup.com/ent"
 	"ppgroup.ppgroup.com/ent/listing"
-	"ppgroup.ppgroup.com/ent/schema"
+	"ppgroup.ppgroup.com/ent/schematype"
+	"ppgroup.ppgroup.com/internal/config"
 	"ppgroup.ppgroup.com/internal/repositories"
 	"ppgroup.ppgroup.com/internal/services"
 )
@@ -24,24 +30,42 @@ type ListingQueryParams = repositories.ListingQueryParams
 // @Accept multipart/form-data
 // @Produce json
 // @Param title formData string true "Listing title (10-120 chars)"
-// @Param address formData string true "Property address (unique)"
+// @Param address formData string true "Property address (unique among active listings after normalization)"
 // @Param city formData string true "City"
-// @Param state formData string true "State (2 letters, e.g., CA)"
-// @Param zip_code formData string true "ZIP code (5 digits)"
+// @Param state formData string true "State, province or region code (e.g., CA, ON, JAL)"
+// @Param zip_code formData string true "Postal code in the country's format"
+// @Param country formData string false "ISO country code: US (default), CA or MX"
+// @Param latitude formData number false "Latitude; links the listing to its neighborhood (requires longitude)"
+// @Param longitude formData number false "Longitude (requires latitude)"
 // @Param description formData string false "Property description"
+// @Param source_locale formData string false "BCP 47 locale of the title and description (default en)"
 // @Param price formData number true "Property price"
+// @Param currency formData string false "ISO currency code of the price (default USD)"
 // @Param bedroom formData int true "Number of bedrooms"
 // @Param bathroom formData number true "Number of bathrooms"
 // @Param garage formData int false "Number of garage spaces"
-// @Param sqft formData int true "Square footage"
+// @Param sqft formData int false "Square footage (required unless sqm is given)"
+// @Param sqm formData int false "Area in square meters (required unless sqft is given)"
+// @Param area_unit formData string false "Unit the area is displayed in" Enums(sqft, sqm)
 // @Param type_of_property formData string true "Type of property" Enums(house, apartment, condo, townhouse)
 // @Param lot_size formData int false "Lot size"
 // @Param pool formData bool false "Has pool"
 // @Param year_built formData int true "Year built"
+// @Param annual_property_tax formData number false "Annual property tax"
+// @Param hoa_dues formData number false "HOA dues per hoa_frequency (requires hoa_frequency)"
+// @Param hoa_frequency formData string false "How often HOA dues are paid" Enums(MONTHLY, QUARTERLY, ANNUALLY)
+// @Param hoa_name formData string false "Name of the homeowners association"
+// @Param special_assessments formData number false "Special assessments per year"
+// @Param monthly_utilities formData number false "Average utility costs per month"
 // @Param realtor_id formData string true "Realtor UUID"
+// @Param publish_at formData string false "RFC 3339 time to publish the listing at once it is approved"
+// @Param unpublish_at formData string false "RFC 3339 time to take the listing down"
 // @Param images formData file false "Property images (multiple files allowed, formats: jpg, jpeg, png, gif, webp)"
+// @Param captions formData string false "Image captions, one per image in the same order"
 // @Success 201 {object} gin.H{"status": "OK", "message": "Listing created!", "data": object}
 // @Failure 400 {object} gin.H{"error": "Invalid input", "message": string}
+// @Failure 403 {object} gin.H{"error": "Failed to create listing", "message": string}
+// @Failure 409 {object} gin.H{"error": "Failed to create listing", "message": string}
 // @Failure 500 {object} gin.H{"error": "Failed to create listing", "message": string}
 // @Router /api/v1/properties/add [post]
 func CreateListing(c *gin.Context) {
@@ -64,15 +88,19 @@ func CreateListing(c *gin.Context) {
 	bathroomStr := c.PostForm("bathroom")
 	garageStr := c.PostForm("garage")
 	sqftStr := c.PostForm("sqft")
+	sqmStr := c.PostForm("sqm")
 	typeOfPropertyStr := c.PostForm("type_of_property")
 	lotSizeStr := c.PostForm("lot_size")
 	poolStr := c.PostForm("pool")
 	yearBuiltStr := c.PostForm("year_built")
 	realtorIDStr := c.PostForm("realtor_id")
+	country := c.DefaultPostForm("country", "US")
+	currency := c.DefaultPostForm("currency", "USD")
+	areaUnitStr := c.DefaultPostForm("area_unit", "sqft")
 
 	// Validate required fields
 	if title == "" || address == "" || city == "" || state == "" || zipCode == "" ||
-		priceStr == "" || bedroomStr == "" || bathroomStr == "" || sqftStr == "" ||
+		priceStr == "" || bedroomStr == "" || bathroomStr == "" || (sqftStr == "" && sqmStr == "") ||
 		typeOfPropertyStr == "" || yearBuiltStr == "" || realtorIDStr == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing required fields"})
 		return
@@ -84,6 +112,10 @@ func CreateListing(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid price format"})
 		return
 	}
+	if !price.IsPositive() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Price must be positive"})
+		return
+	}
 
 	bedroom, err := strconv.Atoi(bedroomStr)
 	if err != nil {
@@ -97,9 +129,26 @@ func CreateListing(c *gin.Context) {
 		return
 	}
 
-	sqft, err := strconv.Atoi(sqftStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sqft format"})
+	// The area can be given in square feet or square meters
+	var sqft, sqm int
+	if sqftStr != "" {
+		sqft, err = strconv.Atoi(sqftStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sqft format"})
+			return
+		}
+	}
+	if sqmStr != "" {
+		sqm, err = strconv.Atoi(sqmStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sqm format"})
+			return
+		}
+	}
+
+	areaUnit := listing.AreaUnit(strings.ToLower(areaUnitStr))
+	if err := listing.AreaUnitValidator(areaUnit); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid area_unit. Must be one of: sqft, sqm"})
 		return
 	}
 
@@ -143,6 +192,46 @@ func CreateListing(c *gin.Context) {
 		}
 	}
 
+	// Optional publishing schedule
+	publishAt, err := parseFormTime(c, "publish_at")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid publish_at format", "message": err.Error()})
+		return
+	}
+	unpublishAt, err := parseFormTime(c, "unpublish_at")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid unpublish_at format", "message": err.Error()})
+		return
+	}
+
+	// Optional coordinates, used to find the neighborhood and nearby places
+	latitude, err := parseFormFloat(c, "latitude")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid latitude format", "message": err.Error()})
+		return
+	}
+	longitude, err := parseFormFloat(c, "longitude")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid longitude format", "message": err.Error()})
+		return
+	}
+
+	// Optional carrying costs, in the listing's currency
+	carryingCosts := make(map[string]*decimal.Decimal)
+	for _, key := range []string{"annual_property_tax", "hoa_dues", "special_assessments", "monthly_utilities"} {
+		amount, err := parseFormDecimal(c, key)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid " + key + " format", "message": err.Error()})
+			return
+		}
+		carryingCosts[key] = amount
+	}
+	var hoaFrequency *listing.HoaFrequency
+	if value := c.PostForm("hoa_frequency"); value != "" {
+		frequency := listing.HoaFrequency(strings.ToUpper(value))
+		hoaFrequency = &frequency
+	}
+
 	// Validate and convert type_of_property
 	var typeOfProperty listing.TypeOfProperty
 	switch strings.ToLower(typeOfPropertyStr) {
@@ -159,10 +248,6 @@ func CreateListing(c *gin.Context) {
 		return
 	}
 
-	// Handle image uploads
-	var mediaItems []schema.Media
-	imageService := c.MustGet("imageService").(*services.ImageService)
-
 	// Get uploaded files
 	files := c.Request.MultipartForm.File["images"]
 
@@ -171,47 +256,14 @@ func CreateListing(c *gin.Context) {
 		files = c.Request.MultipartForm.File["image"]
 	}
 
-	// Only proceed with image processing if we have actual files
-	if len(files) > 0 {
-		for i, fileHeader := range files {
-			// Validate file type
-			if !isValidImageType(fileHeader.Filename) {
-				c.JSON(http.StatusBadRequest, gin.H{
-					"error": "Invalid file type: " + fileHeader.Filename,
-				})
-				return
-			}
-
-			// Open file
-			file, err := fileHeader.Open()
-			if err != nil {
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open file: " + fileHeader.Filename})
-				return
-			}
-			defer file.Close()
-
-			// Upload to Cloudinary
-			url, err := imageService.UploadImage(c.Request.Context(), file, fileHeader.Filename)
-			if err != nil {
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upload image to Cloudinary: " + err.Error()})
-				return
-			}
-
-			// Verify URL is not empty
-			if url == "" {
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Image upload returned empty URL"})
-				return
-			}
-
-			// Create media item
-			mediaItem := schema.Media{
-				URL:       url,
-				Type:      "image",
-				Caption:   "",     // You can add caption support later
-				IsPrimary: i == 0, // First image is primary
-			}
-			mediaItems = append(mediaItems, mediaItem)
-		}
+	// Upload images with their captions; the first image becomes primary
+	mediaItems, status, err := uploadListingMedia(c, schematype.MediaTypeImage, files, c.Request.MultipartForm.Value["captions"], nil)
+	if err != nil {
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	if len(mediaItems) > 0 {
+		mediaItems[0].IsPrimary = true
 	}
 
 	// Create listing entity
@@ -221,12 +273,18 @@ func CreateListing(c *gin.Context) {
 		City:           city,
 		State:          state,
 		ZipCode:        zipCode,
+		Country:        country,
+		Latitude:       latitude,
+		Longitude:      longitude,
 		Description:    description,
 		Price:          price,
+		Currency:       currency,
 		Bedroom:        bedroom,
 		Bathroom:       bathroom,
 		Garage:         garage,
 		Sqft:           sqft,
+		Sqm:            sqm,
+		AreaUnit:       areaUnit,
 		TypeOfProperty: typeOfProperty,
 		LotSize:        lotSize,
 		Pool:           pool,
@@ -234,13 +292,23 @@ func CreateListing(c *gin.Context) {
 		Media:          mediaItems,
 		RealtorID:      realtorID,
 		Status:         listing.StatusDRAFT, // Default status
+		PublishAt:      publishAt,
+		UnpublishAt:    unpublishAt,
+		SourceLocale:   c.PostForm("source_locale"),
+
+		AnnualPropertyTax:  carryingCosts["annual_property_tax"],
+		HoaDues:            carryingCosts["hoa_dues"],
+		HoaFrequency:       hoaFrequency,
+		HoaName:            c.PostForm("hoa_name"),
+		SpecialAssessments: carryingCosts["special_assessments"],
+		MonthlyUtilities:   carryingCosts["monthly_utilities"],
 	}
 
 	// Save to database
 	entClient := c.MustGet("entClient").(*ent.Client)
-	err = repositories.CreateListingRepo(entClient, listing)
+	warnings, err := repositories.CreateListingRepo(c.Request.Context(), entClient, listing)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create listing", "message": err.Error()})
+		c.JSON(listingWriteErrorStatus(err), gin.H{"error": "Failed to create listing", "message": err.Error()})
 		return
 	}
 
@@ -252,18 +320,27 @@ func CreateListing(c *gin.Context) {
 			"address":         listing.Address,
 			"uploaded_images": len(mediaItems),
 		},
+		"warnings": warnings,
 	})
 }
 
 // CreateListingJSON handles the creation of a new listing with JSON input (for pre-existing image URLs).
 // @Summary Create a new listing with JSON
-// @Description Create a new listing using JSON format with existing image URLs
+// @Description Create a new listing using JSON format with existing image URLs.
+// @Description A listing realtors create as PUBLISHED is submitted for staff review instead (status IN_REVIEW).
+// @Description source_locale names the locale of title and description (default en); translations maps
+// @Description other locales to their {"title", "description"}.
+// @Description Carrying costs are optional amounts in the listing's currency: annual_property_tax, hoa_dues
+// @Description with hoa_frequency (MONTHLY, QUARTERLY or ANNUALLY), hoa_name, special_assessments per year
+// @Description and monthly_utilities.
 // @Tags listings
 // @Accept json
 // @Produce json
 // @Param input body ent.Listing true "Listing input data"
 // @Success 201 {object} gin.H{"status": "OK", "message": "Listing created!", "data": object}
 // @Failure 400 {object} gin.H{"error": "Invalid input", "message": string}
+// @Failure 403 {object} gin.H{"error": "Failed to create listing", "message": string}
+// @Failure 409 {object} gin.H{"error": "Failed to create listing", "message": string}
 // @Failure 500 {object} gin.H{"error": "Failed to create listing", "message": string}
 // @Router /properties/add-json [post]
 func CreateListingJSON(c *gin.Context) {
@@ -275,12 +352,29 @@ func CreateListingJSON(c *gin.Context) {
 
 	// Validate required fields
 	if input.Title == "" || input.Address == "" || input.City == "" || input.State == "" ||
-		input.ZipCode == "" || input.Price.IsZero() || input.Bedroom == 0 ||
-		input.Bathroom == 0 || input.Sqft == 0 || input.YearBuilt == 0 {
+		input.ZipCode == "" || !input.Price.IsPositive() || input.Bedroom == 0 ||
+		input.Bathroom == 0 || (input.Sqft == 0 && input.Sqm == 0) || input.YearBuilt == 0 {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing required fields"})
 		return
 	}
 
+	// Validate media items
+	for i := range input.Media {
+		if input.Media[i].Type == "" {
+			input.Media[i].Type = schematype.MediaTypeImage
+		}
+		if err := services.ValidateMediaItem(input.Media[i]); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid media", "message": err.Error()})
+			return
+		}
+		if input.Media[i].ID == "" {
+			input.Media[i].ID = uuid.NewString()
+		}
+		// Media given by URL may point at files of other listings, so they are never
+		// removed from storage
+		input.Media[i].StorageID, input.Media[i].PosterStorageID = "", ""
+	}
+
 	// Set default status if not provided
 	if input.Status == "" {
 		input.Status = listing.StatusDRAFT
@@ -288,9 +382,9 @@ func CreateListingJSON(c *gin.Context) {
 
 	// Create listing
 	entClient := c.MustGet("entClient").(*ent.Client)
-	err := repositories.CreateListingRepo(entClient, input)
+	warnings, err := repositories.CreateListingRepo(c.Request.Context(), entClient, input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create listing", "message": err.Error()})
+		c.JSON(listingWriteErrorStatus(err), gin.H{"error": "Failed to create listing", "message": err.Error()})
 		return
 	}
 
@@ -302,20 +396,65 @@ func CreateListingJSON(c *gin.Context) {
 			"address":     input.Address,
 			"media_count": len(input.Media),
 		},
+		"warnings": warnings,
 	})
 }
 
-// isValidImageType checks if the file has a valid image extension
-func isValidImageType(filename string) bool {
-	validTypes := []string{".jpg", ".jpeg", ".png", ".gif", ".webp"}
-	filename = strings.ToLower(filename)
+// parseFormTime parses an optional RFC 3339 form value, returning nil when it is empty.
+func parseFormTime(c *gin.Context, key string) (*time.Time, error) {
+	value := c.PostForm(key)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// parseFormFloat parses an optional number form value, returning nil when it is empty.
+func parseFormFloat(c *gin.Context, key string) (*float64, error) {
+	value := c.PostForm(key)
+	if value == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
 
-	for _, ext := range validTypes {
-		if strings.HasSuffix(filename, ext) {
-			return true
-		}
+func parseFormDecimal(c *gin.Context, key string) (*decimal.Decimal, error) {
+	value := c.PostForm(key)
+	if value == "" {
+		return nil, nil
+	}
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// listingWriteErrorStatus maps an error from creating or updating a listing to a status code.
+func listingWriteErrorStatus(err error) int {
+	switch {
+	case isForbidden(err):
+		return http.StatusForbidden
+	case errors.Is(err, repositories.ErrDuplicateListing):
+		return http.StatusConflict
+	case errors.Is(err, services.ErrInvalidLocation), errors.Is(err, repositories.ErrUnknownCurrency),
+		errors.Is(err, services.ErrInvalidTranslation),
+		errors.Is(err, repositories.ErrListingNotSubmittable), errors.Is(err, repositories.ErrInvalidSchedule),
+		errors.Is(err, repositories.ErrInvalidSale), errors.Is(err, repositories.ErrInvalidCarryingCost),
+		errors.Is(err, repositories.ErrInvalidPrice),
+		errors.Is(err, repositories.ErrRealtorNotFound), errors.Is(err, repositories.ErrRealtorInactive):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
 	}
-	return false
 }
 
 // GetListings handles the retrieval of paginated property listings.
@@ -326,6 +465,19 @@ func isValidImageType(filename string) bool {
 // @Produce json
 // @Param page query int false "Page number (default: 1, min: 1)"
 // @Param limit query int false "Number of items per page (default: 10, min: 1)"
+// @Param has_virtual_tour query bool false "Only listings with a virtual tour"
+// @Param has_video query bool false "Only listings with a video"
+// @Param has_floor_plan query bool false "Only listings with a floor plan"
+// @Param country query string false "Only listings in this ISO country code"
+// @Param neighborhood_id query string false "Only listings in this neighborhood (UUID)"
+// @Param brokerage_id query string false "Only listings of realtors in this brokerage (UUID)"
+// @Param team_id query string false "Only listings of realtors in this team (UUID)"
+// @Param new_within_days query int false "Only listings first on the market within this many days"
+// @Param min_days_on_market query int false "Only listings on the market at least this many days"
+// @Param max_days_on_market query int false "Only listings on the market at most this many days"
+// @Param max_hoa_fee query number false "Only listings whose HOA dues come to at most this much a month; listings without dues match"
+// @Param currency query string false "Currency of min_price, max_hoa_fee and price sorting; adds converted_prices to the response"
+// @Param lang query string false "Preferred locale of titles and descriptions; overrides Accept-Language"
 //
 //	@Success 200 {object} gin.H{
 //	    "status": string,
@@ -354,16 +506,23 @@ func GetListings(c *gin.Context) {
 	// Get listings from repo
 	listings, meta, err := repositories.GetListingsRepo(entClient, params)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		status := http.StatusInternalServerError
+		if errors.Is(err, repositories.ErrUnknownCurrency) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{
 			"error":   "Failed to retrive listings",
 			"message": err.Error(),
 		})
 		return
 	}
 
+	localizeListings(c, listings...)
+	c.Header("Vary", "Accept-Language")
+
 	response := gin.H{
 		"status": "OK",
-		"data":   listings,
+		"data":   repositories.WithComputedFields(listings...),
 		"pagination": gin.H{
 			"total":       meta.Total,
 			"has_next":    meta.HasNext,
@@ -371,6 +530,20 @@ func GetListings(c *gin.Context) {
 			"page_size":   params.PageSize,
 		},
 	}
+
+	// Show every price in the requested currency as well
+	if params.Currency != "" {
+		converted, err := repositories.ConvertListingPricesRepo(entClient, listings, params.Currency)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to convert prices",
+				"message": err.Error(),
+			})
+			return
+		}
+		response["currency"] = strings.ToUpper(params.Currency)
+		response["converted_prices"] = converted
+	}
 	c.JSON(http.StatusOK, response)
 }
 
@@ -383,7 +556,8 @@ func GetListings(c *gin.Context) {
 //     a 400 Bad Request status and an error message.
 //  2. Retrieves the ent.Client instance from the context.
 //  3. Calls the repositories.DeleteListing function to delete the listing with the specified ID.
-//     If an error occurs during deletion, it responds with a 500 Internal Server Error status and the error message.
+//     If the viewer may not delete the listing, it responds with a 403 Forbidden status; any other
+//     error during deletion responds with a 500 Internal Server Error status and the error message.
 //  4. If the deletion is successful, it responds with a 200 OK status and a success message.
 func DeleteListing(c *gin.Context) {
 	ID := c.Query("ID")
@@ -395,46 +569,390 @@ func DeleteListing(c *gin.Context) {
 	}
 
 	entClient := c.MustGet("entClient").(*ent.Client)
-	err := repositories.DeleteListing(entClient, ID)
+	storageIDs, err := repositories.DeleteListing(c.Request.Context(), entClient, ID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		status := http.StatusInternalServerError
+		if isForbidden(err) {
+			status = http.StatusForbidden
+		}
+		c.JSON(status, gin.H{
 			"error":   "Failed to delete listing",
 			"message": err.Error(),
 		})
 		return
 	}
 
+	imageService := c.MustGet("imageService").(*services.ImageService)
+	deleteDocumentFiles(c.Request.Context(), imageService, storageIDs)
+
 	c.JSON(http.StatusOK, gin.H{
 		"status":  "OK",
 		"message": "Successfully deleted listing",
 	})
 }
 
-// UpdateListing handles the updating of an existing listing.
+// GetListing handles the retrieval of a single listing. The listing's version is returned
+// as an ETag, which clients send back in If-Match when updating it.
+// @Summary Get a listing
+// @Description A listing with coordinates comes with its neighborhood and the schools, parks and
+// @Description transit nearby, nearest first with their distance in meters.
+// @Tags listings
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param lang query string false "Preferred locale; overrides Accept-Language"
+// @Param Accept-Language header string false "Preferred locales; the title and description fall back to the source locale"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} gin.H{"status": "OK", "data": repositories.Listing, "nearby": repositories.NearbyPlaces}
+// @Success 304 "Not modified"
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id} [get]
+func GetListing(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	l, err := repositories.GetListingRepo(entClient, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Failed to get listing", "message": err.Error()})
+		return
+	}
+
+	c.Header("ETag", listingETag(l))
+	c.Header("Vary", "Accept-Language")
+	if version, ok := parseETag(c.GetHeader("If-None-Match")); ok && version == l.Version {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Header("Content-Language", localizeListings(c, l))
+
+	response := gin.H{"status": "OK", "data": repositories.WithComputedFields(l)[0]}
+	if l.Latitude != nil && l.Longitude != nil {
+		cfg := c.MustGet("config").(*config.Config)
+		nearby, err := repositories.GetNearbyPlacesRepo(c.Request.Context(), entClient, *l.Latitude, *l.Longitude,
+			float64(cfg.NearbyRadiusMeters), cfg.NearbyLimit)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get nearby places", "message": err.Error()})
+			return
+		}
+		response["nearby"] = nearby
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// patchableListingFields are the listing members a merge patch may set. Members mapped
+// to true are optional and can be cleared with null; the others are required.
+var patchableListingFields = map[string]bool{
+	"title": false, "address": false, "city": false, "state": false, "zip_code": false,
+	"country": false, "description": true, "price": false, "currency": false,
+	"bedroom": false, "bathroom": false, "garage": true, "sqft": false, "sqm": false,
+	"area_unit": false, "type_of_property": false, "status": false, "lot_size": true,
+	"pool": true, "year_built": false, "realtor_id": false,
+	"publish_at": true, "unpublish_at": true, "source_locale": false, "translations": true,
+	"latitude": true, "longitude": true,
+	"annual_property_tax": true, "hoa_dues": true, "hoa_frequency": true, "hoa_name": true,
+	"special_assessments": true, "monthly_utilities": true,
+}
+
+// UpdateListing handles partial updates of a listing with a JSON Merge Patch (RFC 7396).
+// Members left out of the patch stay untouched and null clears optional members.
 // @Summary Update an existing listing
-// @Description Update an existing listing with the provided input data
+// @Description Applies a JSON Merge Patch to the listing. Absent members are kept, null clears
+// @Description description, garage, lot_size, pool, latitude, longitude, publish_at, unpublish_at, translations and the
+// @Description carrying costs (annual_property_tax, hoa_dues with hoa_frequency, hoa_name, special_assessments and
+// @Description monthly_utilities); a null
+// @Description member of translations removes that locale. Media are managed through the media endpoints.
+// @Description If-Match must carry the ETag of the version being edited; a stale ETag fails with 412
+// @Description and the current listing. Realtors cannot publish directly: setting status to PUBLISHED
+// @Description submits the listing for review. New prices, descriptions and translations of a published
+// @Description listing wait in its pending edit review, which is returned as review. A listing published before
+// @Description its publish_at becomes SCHEDULED; the scheduler publishes it at publish_at and archives
+// @Description it at unpublish_at.
 // @Tags listings
+// @Accept application/merge-patch+json
 // @Accept json
 // @Produce json
-// @Param input body ent.Listing true "Listing update data"
-// @Success 200 {object} gin.H{"status": "OK", "message": "Listing updated!"}
-// @Failure 400 {object} gin.H{"error": "Invalid input", "message": "Please provide required fields"}
+// @Param id path string true "Listing UUID"
+// @Param If-Match header string true "ETag of the listing version being updated"
+// @Param input body object true "Merge patch of listing fields"
+// @Success 200 {object} gin.H{"status": "OK", "message": "Listing updated!", "data": ent.Listing, "warnings": []repositories.DuplicateMatch, "review": ent.ListingReview}
+// @Failure 400 {object} gin.H{"error": "Invalid input", "message": string}
+// @Failure 403 {object} gin.H{"error": "Failed to update listing", "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 412 {object} gin.H{"error": string, "message": string, "data": ent.Listing}
+// @Failure 428 {object} gin.H{"error": string, "message": string}
 // @Failure 500 {object} gin.H{"error": "Failed to update listing", "message": "Error message"}
-// @Router /listings [put]
+// @Router /api/v1/properties/{id} [patch]
 func UpdateListing(c *gin.Context) {
-	var input *ent.Listing
-	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "Please provide required fields: " + err.Error()})
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	version, ok := parseETag(c.GetHeader("If-Match"))
+	if !ok {
+		c.JSON(http.StatusPreconditionRequired, gin.H{"error": "Missing If-Match header", "message": "Send the ETag of the listing you are updating"})
+		return
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
 		return
 	}
+	patch, err := services.ParseMergePatch(body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+	for field, value := range patch {
+		optional, ok := patchableListingFields[field]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "Field cannot be updated: " + field})
+			return
+		}
+		if value == nil && !optional {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "Required field cannot be cleared: " + field})
+			return
+		}
+	}
 
 	entClient := c.MustGet("entClient").(*ent.Client)
+	current, err := repositories.GetListingRepo(entClient, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Failed to update listing", "message": err.Error()})
+		return
+	}
+	if current.Version != version {
+		respondListingConflict(c, entClient, id, repositories.ErrListingVersionConflict)
+		return
+	}
 
-	err := repositories.UpdateListingRepo(entClient, input)
+	// Apply the patch to the stored listing; media and edges are never part of the update
+	current.Edges = ent.ListingEdges{}
+	currentJSON, err := json.Marshal(current)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update listing", "message": err.Error()})
 		return
 	}
+	mergedJSON, err := services.ApplyMergePatch(currentJSON, patch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update listing", "message": err.Error()})
+		return
+	}
+	var merged ent.Listing
+	if err := json.Unmarshal(mergedJSON, &merged); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+	merged.ID = id
+	merged.Version = version
+	merged.Media = nil
+
+	warnings, review, err := repositories.UpdateListingRepo(c.Request.Context(), entClient, &merged)
+	if err != nil {
+		if errors.Is(err, repositories.ErrListingVersionConflict) {
+			respondListingConflict(c, entClient, id, err)
+			return
+		}
+		c.JSON(listingWriteErrorStatus(err), gin.H{"error": "Failed to update listing", "message": err.Error()})
+		return
+	}
+
+	updated, err := repositories.GetListingRepo(entClient, id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get updated listing", "message": err.Error()})
+		return
+	}
+
+	response := gin.H{"status": "OK", "message": "Listing updated!", "data": updated, "warnings": warnings}
+	if review != nil {
+		response["message"] = "Listing updated! Some changes wait for staff review."
+		response["review"] = review
+	}
+	c.Header("ETag", listingETag(updated))
+	c.JSON(http.StatusOK, response)
+}
+
+// localizeListings replaces the title and description of each listing with its translation
+// that best matches the lang parameter or the Accept-Language header, keeping the source
+// text when no translation fits. It returns the locale chosen for the last listing.
+func localizeListings(c *gin.Context, listings ...*ent.Listing) string {
+	preferred := services.PreferredLocales(c.Query("lang"), c.GetHeader("Accept-Language"))
+
+	var locale string
+	for _, l := range listings {
+		locale = services.BestLocale(preferred, l.SourceLocale, slices.Sorted(maps.Keys(l.Translations)))
+		if t, ok := l.Translations[locale]; ok {
+			l.Title = t.Title
+			if t.Description != "" {
+				l.Description = t.Description
+			}
+		}
+	}
+	return locale
+}
+
+// listingETag returns the entity tag of a listing's current version.
+func listingETag(l *ent.Listing) string {
+	return `"` + strconv.Itoa(l.Version) + `"`
+}
+
+// parseETag returns the listing version named by an ETag, accepting weak tags as well.
+func parseETag(tag string) (int, bool) {
+	tag = strings.Trim(strings.TrimPrefix(strings.TrimSpace(tag), "W/"), `"`)
+	version, err := strconv.Atoi(tag)
+	return version, err == nil && version > 0
+}
+
+// respondListingConflict answers a stale update with 412 and the listing's current state,
+// so the client can merge its changes and retry with the new ETag.
+func respondListingConflict(c *gin.Context, entClient *ent.Client, id uuid.UUID, err error) {
+	current, getErr := repositories.GetListingRepo(entClient, id)
+	if getErr != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update listing", "message": getErr.Error()})
+		return
+	}
+	c.Header("ETag", listingETag(current))
+	c.JSON(http.StatusPreconditionFailed, gin.H{"error": "Listing has changed", "message": err.Error(), "data": current})
+}
+
+// CompareListings handles the side-by-side comparison of published listings.
+// @Summary Compare listings
+// @Description Returns up to repositories.MaxComparedListings published listings in aligned rows with derived metrics and best/worst flags
+// @Tags listings
+// @Produce json
+// @Param ids query string true "Comma separated listing UUIDs"
+// @Success 200 {object} gin.H{"status": "OK", "data": repositories.ListingComparison}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 500 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/compare [get]
+func CompareListings(c *gin.Context) {
+	var ids []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, raw := range strings.Split(c.Query("ids"), ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID", "message": raw})
+			return
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) < 2 || len(ids) > repositories.MaxComparedListings {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ids query parameter",
+			"message": "Provide between 2 and " + strconv.Itoa(repositories.MaxComparedListings) + " distinct listing IDs",
+		})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	comparison, err := repositories.CompareListingsRepo(entClient, ids)
+	if err != nil {
+		if errors.Is(err, repositories.ErrListingNotComparable) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listings", "message": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to compare listings", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "OK",
+		"data":   comparison,
+	})
+}
+
+// RenewListingInput represents the optional body of a renewal request
+type RenewListingInput struct {
+	TermDays int `json:"term_days" binding:"omitempty,min=1,max=365"`
+}
+
+// RenewListing handles extending the term of a published or expired listing.
+// @Summary Renew a listing
+// @Description Extends the listing's expiry by term_days (defaults to the configured listing term) and records the renewal.
+// @Description A listing archived because it expired is published again. Realtors renewing a listing archived
+// @Description otherwise submit it for review, which is returned as review.
+// @Tags listings
+// @Accept json
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param input body RenewListingInput false "Renewal term"
+// @Success 200 {object} gin.H{"status": "OK", "message": "Listing renewed!", "data": ent.Listing, "review": ent.ListingReview}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/renew [post]
+func RenewListing(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	var input RenewListingInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+			return
+		}
+	}
+	if input.TermDays == 0 {
+		input.TermDays = c.MustGet("config").(*config.Config).ListingTermDays
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	renewed, review, err := repositories.RenewListingRepo(c.Request.Context(), entClient, id, input.TermDays)
+	if err != nil {
+		status := http.StatusBadRequest
+		if isForbidden(err) {
+			status = http.StatusForbidden
+		}
+		c.JSON(status, gin.H{"error": "Failed to renew listing", "message": err.Error()})
+		return
+	}
+
+	if review != nil {
+		c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Listing renewed and submitted for review.", "data": renewed, "review": review})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Listing renewed!", "data": renewed})
+}
+
+// GetListingStatusHistory handles listing the status transitions of a listing. Only staff
+// and the listing's realtor can see them.
+// @Summary Get the status history of a listing
+// @Tags listings
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": []ent.ListingStatusEvent}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/status-history [get]
+func GetListingStatusHistory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	events, err := repositories.GetListingStatusHistoryRepo(c.Request.Context(), entClient, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Failed to get status history", "message": err.Error()})
+		return
+	}
 
-	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Listing updated!"})
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": events})
 }
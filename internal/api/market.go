@@ -0,0 +1,54 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/config"
+	"ppgroup.ppgroup.com/internal/repositories"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// GetMarketStats handles the monthly market statistics of a city or ZIP code.
+// @Summary Get market statistics
+// @Description Median and average list price, price per sqft, active inventory, new listings and median
+// @Description days on market per month for a city and state or a ZIP code, optionally of one type of
+// @Description property. Statistics are recomputed every MARKET_STATS_REFRESH_MINUTES; meta.refreshed_at
+// @Description tells when.
+// @Tags market
+// @Produce json
+// @Param country query string false "ISO country code (default US)"
+// @Param state query string false "State, province or region code (with city)"
+// @Param city query string false "City (with state)"
+// @Param zip_code query string false "Postal code (instead of city and state)"
+// @Param type_of_property query string false "Type of property" Enums(house, apartment, condo, townhouse)
+// @Param from query string false "First month, YYYY-MM (default 11 months before to)"
+// @Param to query string false "Last month, YYYY-MM (default this month)"
+// @Param currency query string false "Currency of the prices (default the base currency)"
+// @Success 200 {object} gin.H{"status": "OK", "data": []repositories.MarketStats, "meta": repositories.MarketStatsMeta}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/market/stats [get]
+func GetMarketStats(c *gin.Context) {
+	var params repositories.MarketStatsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	cfg := c.MustGet("config").(*config.Config)
+	stats, meta, err := repositories.GetMarketStatsRepo(entClient, cfg.BaseCurrency, params)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, repositories.ErrInvalidMarketQuery) || errors.Is(err, repositories.ErrUnknownCurrency) ||
+			errors.Is(err, services.ErrInvalidLocation) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{"error": "Failed to get market statistics", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": stats, "meta": meta})
+}
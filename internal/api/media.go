@@ -0,0 +1,410 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/schematype"
+	"ppgroup.ppgroup.com/internal/repositories"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// uploadListingMedia uploads files of one media type to storage and returns them as media
+// items. captions, and for videos posters, are matched to files by position; videos without
+// an uploaded poster get a frame of the video as poster. On failure it returns the HTTP status
+// to respond with and removes any files that were already uploaded.
+func uploadListingMedia(c *gin.Context, mediaType schematype.MediaType, files []*multipart.FileHeader, captions []string, posters []*multipart.FileHeader) ([]schematype.Media, int, error) {
+	imageService := c.MustGet("imageService").(*services.ImageService)
+
+	var mediaItems []schematype.Media
+	for i, fileHeader := range files {
+		url, storageID, status, err := uploadMediaFile(c, imageService, mediaType, fileHeader)
+		if err != nil {
+			discardUploadedMedia(c.Request.Context(), imageService, mediaItems)
+			return nil, status, err
+		}
+
+		var caption string
+		if i < len(captions) {
+			caption = captions[i]
+		}
+		item := repositories.NewMediaItem(url, mediaType, caption)
+		item.StorageID = storageID
+
+		if mediaType == schematype.MediaTypeVideo {
+			item.PosterURL = services.VideoPosterURL(url)
+			if i < len(posters) {
+				posterURL, posterStorageID, status, err := uploadMediaFile(c, imageService, schematype.MediaTypeImage, posters[i])
+				if err != nil {
+					discardUploadedMedia(c.Request.Context(), imageService, append(mediaItems, item))
+					return nil, status, err
+				}
+				item.PosterURL = posterURL
+				item.PosterStorageID = posterStorageID
+			}
+		}
+
+		mediaItems = append(mediaItems, item)
+	}
+
+	return mediaItems, http.StatusOK, nil
+}
+
+// uploadMediaFile validates and uploads a single file, and returns its URL and storage ID.
+func uploadMediaFile(c *gin.Context, imageService *services.ImageService, mediaType schematype.MediaType, fileHeader *multipart.FileHeader) (string, string, int, error) {
+	// Validate file type and size
+	if err := services.ValidateMediaFile(mediaType, fileHeader.Filename, fileHeader.Size); err != nil {
+		return "", "", http.StatusBadRequest, err
+	}
+
+	// Open file
+	file, err := fileHeader.Open()
+	if err != nil {
+		return "", "", http.StatusInternalServerError, errors.New("Failed to open file: " + fileHeader.Filename)
+	}
+	defer file.Close()
+
+	// Upload to Cloudinary
+	url, storageID, err := imageService.UploadMedia(c.Request.Context(), file, fileHeader.Filename, mediaType)
+	if err != nil {
+		return "", "", http.StatusInternalServerError, errors.New("Failed to upload file to Cloudinary: " + err.Error())
+	}
+
+	// Verify URL is not empty
+	if url == "" {
+		return "", "", http.StatusInternalServerError, errors.New("Upload returned empty URL")
+	}
+
+	return url, storageID, http.StatusOK, nil
+}
+
+// mediaStorageIDs returns the files uploaded for a media item. Media added by URL, such as
+// virtual tours, and derived video posters have no files of their own.
+func mediaStorageIDs(item schematype.Media) []string {
+	var ids []string
+	for _, id := range []string{item.StorageID, item.PosterStorageID} {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// discardUploadedMedia removes the uploaded files of media items that are no longer used.
+func discardUploadedMedia(ctx context.Context, imageService *services.ImageService, items []schematype.Media) {
+	for _, item := range items {
+		for _, id := range mediaStorageIDs(item) {
+			if err := imageService.DeleteMedia(ctx, id); err != nil {
+				log.Printf("Failed to remove uploaded file %s: %v", id, err)
+			}
+		}
+	}
+}
+
+// respondMediaChange responds with the listing's media after a change. A change that waits
+// for staff review is answered with 202 and the pending review instead of status.
+func respondMediaChange(c *gin.Context, status int, media []schematype.Media, review *ent.ListingReview) {
+	if review != nil {
+		c.JSON(http.StatusAccepted, gin.H{"status": "OK", "message": "Media changes submitted for review", "data": media, "review": review})
+		return
+	}
+	c.JSON(status, gin.H{"status": "OK", "data": media})
+}
+
+// respondMediaError maps media repository errors to HTTP responses.
+func respondMediaError(c *gin.Context, action string, err error) {
+	status := http.StatusBadRequest
+	switch {
+	case isForbidden(err):
+		status = http.StatusForbidden
+	case errors.Is(err, repositories.ErrMediaNotFound):
+		status = http.StatusNotFound
+	}
+	c.JSON(status, gin.H{"error": "Failed to " + action, "message": err.Error()})
+}
+
+// GetListingMedia handles the retrieval of a listing's media.
+// @Summary List listing media
+// @Tags media
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": []schematype.Media}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/media [get]
+func GetListingMedia(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	media, err := repositories.GetListingMediaRepo(entClient, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Failed to get media", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": media})
+}
+
+// AddListingMedia handles appending uploaded files to a listing's media.
+// @Summary Upload listing media
+// @Description On a published listing, changes by realtors wait for staff review and are answered with 202.
+// @Tags media
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param type formData string false "Media type of all files" Enums(image, video, floor_plan) default(image)
+// @Param files formData file true "Files: images (jpg, jpeg, png, gif, webp; 10 MB), videos (mp4, webm; 200 MB) or floor plans (pdf, svg, png; 20 MB)"
+// @Param posters formData file false "Video poster images, one per video in the same order"
+// @Param captions formData string false "Captions, one per file in the same order"
+// @Success 201 {object} gin.H{"status": "OK", "data": []schematype.Media}
+// @Success 202 {object} gin.H{"status": "OK", "message": string, "data": []schematype.Media, "review": ent.ListingReview}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/media [post]
+func AddListingMedia(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	if err := c.Request.ParseMultipartForm(32 << 20); err != nil { // 32 MB max
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to parse multipart form", "message": err.Error()})
+		return
+	}
+
+	mediaType, err := services.ParseMediaType(c.PostForm("type"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid media type", "message": err.Error()})
+		return
+	}
+	if mediaType == schematype.MediaTypeVirtualTour {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Virtual tours are added by URL", "message": "Use POST /properties/:id/media/virtual-tours"})
+		return
+	}
+
+	form := c.Request.MultipartForm
+	files := form.File["files"]
+	// Fall back to the "images" key used by listing creation
+	if len(files) == 0 {
+		files = form.File["images"]
+	}
+	if len(files) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "No files provided"})
+		return
+	}
+
+	items, status, err := uploadListingMedia(c, mediaType, files, form.Value["captions"], form.File["posters"])
+	if err != nil {
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	media, review, err := repositories.AppendListingMediaRepo(c.Request.Context(), entClient, id, items)
+	if err != nil {
+		discardUploadedMedia(c.Request.Context(), c.MustGet("imageService").(*services.ImageService), items)
+		respondMediaError(c, "add media", err)
+		return
+	}
+
+	respondMediaChange(c, http.StatusCreated, media, review)
+}
+
+// AddVirtualTourInput holds the embed URL of a virtual tour
+type AddVirtualTourInput struct {
+	URL     string `json:"url" binding:"required,url"`
+	Caption string `json:"caption" binding:"max=255"`
+}
+
+// AddListingVirtualTour handles attaching a 3D or virtual tour embed URL to a listing.
+// @Summary Add a virtual tour
+// @Description Attaches an https embed URL from an allowed tour provider (e.g. Matterport, Kuula)
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param input body AddVirtualTourInput true "Virtual tour"
+// @Success 201 {object} gin.H{"status": "OK", "data": []schematype.Media}
+// @Success 202 {object} gin.H{"status": "OK", "message": string, "data": []schematype.Media, "review": ent.ListingReview}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/media/virtual-tours [post]
+func AddListingVirtualTour(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	var input AddVirtualTourInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+	if err := services.ValidateVirtualTourURL(input.URL); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid virtual tour", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	item := repositories.NewMediaItem(input.URL, schematype.MediaTypeVirtualTour, input.Caption)
+	media, review, err := repositories.AppendListingMediaRepo(c.Request.Context(), entClient, id, []schematype.Media{item})
+	if err != nil {
+		respondMediaError(c, "add virtual tour", err)
+		return
+	}
+
+	respondMediaChange(c, http.StatusCreated, media, review)
+}
+
+// DeleteListingMedia handles removing one media item from a listing and from storage.
+// @Summary Delete a listing media item
+// @Tags media
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param mediaId path string true "Media item ID"
+// @Success 200 {object} gin.H{"status": "OK", "data": []schematype.Media}
+// @Success 202 {object} gin.H{"status": "OK", "message": string, "data": []schematype.Media, "review": ent.ListingReview}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/media/{mediaId} [delete]
+func DeleteListingMedia(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	removed, media, review, err := repositories.DeleteListingMediaRepo(c.Request.Context(), entClient, id, c.Param("mediaId"))
+	if err != nil {
+		respondMediaError(c, "delete media", err)
+		return
+	}
+
+	// The listing no longer references the files, so a failed storage delete only leaves an orphan
+	if removed != nil {
+		discardUploadedMedia(c.Request.Context(), c.MustGet("imageService").(*services.ImageService), []schematype.Media{*removed})
+	}
+
+	respondMediaChange(c, http.StatusOK, media, review)
+}
+
+// ReorderListingMediaInput lists every media item ID in the desired order
+type ReorderListingMediaInput struct {
+	Order []string `json:"order" binding:"required"`
+}
+
+// ReorderListingMedia handles rearranging a listing's media.
+// @Summary Reorder listing media
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param input body ReorderListingMediaInput true "Media IDs in the new order"
+// @Success 200 {object} gin.H{"status": "OK", "data": []schematype.Media}
+// @Success 202 {object} gin.H{"status": "OK", "message": string, "data": []schematype.Media, "review": ent.ListingReview}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/media/order [put]
+func ReorderListingMedia(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	var input ReorderListingMediaInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	media, review, err := repositories.ReorderListingMediaRepo(c.Request.Context(), entClient, id, input.Order)
+	if err != nil {
+		respondMediaError(c, "reorder media", err)
+		return
+	}
+
+	respondMediaChange(c, http.StatusOK, media, review)
+}
+
+// SetPrimaryListingMedia handles choosing the primary image of a listing.
+// @Summary Set the primary listing image
+// @Tags media
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param mediaId path string true "Media item ID"
+// @Success 200 {object} gin.H{"status": "OK", "data": []schematype.Media}
+// @Success 202 {object} gin.H{"status": "OK", "message": string, "data": []schematype.Media, "review": ent.ListingReview}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/media/{mediaId}/primary [put]
+func SetPrimaryListingMedia(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	media, review, err := repositories.SetPrimaryListingMediaRepo(c.Request.Context(), entClient, id, c.Param("mediaId"))
+	if err != nil {
+		respondMediaError(c, "set primary media", err)
+		return
+	}
+
+	respondMediaChange(c, http.StatusOK, media, review)
+}
+
+// UpdateListingMediaInput holds the editable fields of a media item
+type UpdateListingMediaInput struct {
+	Caption string `json:"caption" binding:"max=255"`
+}
+
+// UpdateListingMedia handles editing the caption of a media item.
+// @Summary Edit a listing media caption
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param mediaId path string true "Media item ID"
+// @Param input body UpdateListingMediaInput true "New caption"
+// @Success 200 {object} gin.H{"status": "OK", "data": []schematype.Media}
+// @Success 202 {object} gin.H{"status": "OK", "message": string, "data": []schematype.Media, "review": ent.ListingReview}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/media/{mediaId} [patch]
+func UpdateListingMedia(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	var input UpdateListingMediaInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	media, review, err := repositories.UpdateListingMediaCaptionRepo(c.Request.Context(), entClient, id, c.Param("mediaId"), input.Caption)
+	if err != nil {
+		respondMediaError(c, "update media", err)
+		return
+	}
+
+	respondMediaChange(c, http.StatusOK, media, review)
+}
@@ -0,0 +1,221 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/config"
+	"ppgroup.ppgroup.com/internal/repositories"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// SubmitListingForReview handles putting a draft or archived listing in the moderation queue.
+// @Summary Submit a listing for review
+// @Description Moves the listing to IN_REVIEW; staff approval publishes it
+// @Tags moderation
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Success 201 {object} gin.H{"status": "OK", "message": string, "data": ent.ListingReview}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/submit [post]
+func SubmitListingForReview(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	review, err := repositories.SubmitListingForReviewRepo(c.Request.Context(), entClient, id)
+	if err != nil {
+		status := http.StatusBadRequest
+		if isForbidden(err) {
+			status = http.StatusForbidden
+		}
+		c.JSON(status, gin.H{"error": "Failed to submit listing", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "OK", "message": "Listing submitted for review!", "data": review})
+}
+
+// GetListingReviews handles listing the reviews of a listing, including the reasons of
+// rejected ones. Only staff and the listing's realtor can see them.
+// @Summary List listing reviews
+// @Tags moderation
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": []ent.ListingReview}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/reviews [get]
+func GetListingReviews(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	reviews, err := repositories.GetListingReviewsRepo(c.Request.Context(), entClient, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Failed to get reviews", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": reviews})
+}
+
+// GetReviewQueue handles the moderation queue. Staff only.
+// @Summary Get the moderation queue
+// @Description Lists reviews oldest first with their SLA due time. Without a status only pending reviews are listed.
+// @Tags moderation
+// @Produce json
+// @Param status query string false "Review status" Enums(PENDING, APPROVED, REJECTED, WITHDRAWN)
+// @Param kind query string false "Review kind" Enums(NEW, EDIT)
+// @Param realtor_id query string false "Realtor UUID"
+// @Param brokerage_id query string false "Only listings of realtors in this brokerage (UUID)"
+// @Param city query string false "Listing city"
+// @Param country query string false "Listing country code"
+// @Param overdue query bool false "Only pending reviews past their SLA"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} gin.H{"status": "OK", "data": []repositories.ReviewQueueItem, "meta": repositories.ReviewQueueMeta}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/moderation/reviews [get]
+func GetReviewQueue(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	var params repositories.ReviewQueueParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	sla := time.Duration(c.MustGet("config").(*config.Config).ModerationSLAHours) * time.Hour
+	items, meta, err := repositories.GetReviewQueueRepo(c.Request.Context(), entClient, params, sla)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get review queue", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": items, "meta": meta})
+}
+
+// ApproveReviewInput holds an optional note to the realtor
+type ApproveReviewInput struct {
+	Note string `json:"note" binding:"max=2000"`
+}
+
+// ApproveListingReview handles approving a review. Approving a new listing publishes it,
+// approving an edit applies the changes to the published listing. Staff only.
+// @Summary Approve a review
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Param reviewId path string true "Review UUID"
+// @Param input body ApproveReviewInput false "Note to the realtor"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.ListingReview}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/moderation/reviews/{reviewId}/approve [post]
+func ApproveListingReview(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	reviewID, err := uuid.Parse(c.Param("reviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review ID"})
+		return
+	}
+
+	var input ApproveReviewInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+			return
+		}
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	review, removed, err := repositories.ApproveListingReviewRepo(c.Request.Context(), entClient, reviewID, input.Note)
+	if err != nil {
+		respondReviewError(c, "approve review", err)
+		return
+	}
+
+	// Media the approved changes dropped are no longer referenced by the listing
+	discardUploadedMedia(c.Request.Context(), c.MustGet("imageService").(*services.ImageService), removed)
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": review})
+}
+
+// RejectReviewInput holds the reasons shown to the realtor
+type RejectReviewInput struct {
+	Reasons []string `json:"reasons" binding:"required,min=1,dive,required,max=500"`
+	Note    string   `json:"note" binding:"max=2000"`
+}
+
+// RejectListingReview handles rejecting a review with reasons the realtor sees. A rejected
+// new listing goes back to DRAFT; a rejected edit leaves the published listing unchanged.
+// Staff only.
+// @Summary Reject a review
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Param reviewId path string true "Review UUID"
+// @Param input body RejectReviewInput true "Reasons and note"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.ListingReview}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/moderation/reviews/{reviewId}/reject [post]
+func RejectListingReview(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	reviewID, err := uuid.Parse(c.Param("reviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid review ID"})
+		return
+	}
+
+	var input RejectReviewInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	review, discarded, err := repositories.RejectListingReviewRepo(c.Request.Context(), entClient, reviewID, input.Reasons, input.Note)
+	if err != nil {
+		respondReviewError(c, "reject review", err)
+		return
+	}
+
+	// Media uploaded only for the rejected changes will never be used
+	discardUploadedMedia(c.Request.Context(), c.MustGet("imageService").(*services.ImageService), discarded)
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": review})
+}
+
+// respondReviewError maps errors from deciding a review to HTTP responses.
+func respondReviewError(c *gin.Context, action string, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, repositories.ErrReviewNotPending):
+		status = http.StatusConflict
+	case errors.Is(err, repositories.ErrReviewNotFound):
+		status = http.StatusNotFound
+	}
+	c.JSON(status, gin.H{"error": "Failed to " + action, "message": err.Error()})
+}
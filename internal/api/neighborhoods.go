@@ -0,0 +1,139 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/repositories"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// GetNeighborhoods handles listing the neighborhoods listings can be searched by.
+// @Summary List neighborhoods
+// @Tags neighborhoods
+// @Produce json
+// @Param city query string false "Only neighborhoods in this city"
+// @Success 200 {object} gin.H{"status": "OK", "data": []ent.Neighborhood}
+// @Failure 500 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/neighborhoods [get]
+func GetNeighborhoods(c *gin.Context) {
+	entClient := c.MustGet("entClient").(*ent.Client)
+	neighborhoods, err := repositories.GetNeighborhoodsRepo(c.Request.Context(), entClient, c.Query("city"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get neighborhoods", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": neighborhoods})
+}
+
+// ImportNeighborhoods handles loading neighborhoods from a GeoJSON dataset. Listings with
+// coordinates are relinked to the neighborhood now containing them. Staff only.
+// @Summary Import neighborhoods
+// @Description The file is a GeoJSON FeatureCollection of Polygon or MultiPolygon features with
+// @Description name, city, state and optionally country properties. Neighborhoods with the same
+// @Description name, city, state and country are replaced.
+// @Tags neighborhoods
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "GeoJSON dataset"
+// @Success 200 {object} gin.H{"status": "OK", "data": repositories.ImportSummary}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/neighborhoods/import [post]
+func ImportNeighborhoods(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	var features []services.NeighborhoodFeature
+	err := readDataset(c, func(name string, r io.Reader) (err error) {
+		features, err = services.ParseNeighborhoodsGeoJSON(r)
+		return err
+	})
+	if err != nil {
+		respondImportError(c, err)
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	summary, err := repositories.ImportNeighborhoodsRepo(c.Request.Context(), entClient, features)
+	if err != nil {
+		respondImportError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": summary})
+}
+
+// ImportPointsOfInterest handles loading schools, parks and transit stops from a GeoJSON
+// or CSV dataset. Staff only.
+// @Summary Import points of interest
+// @Description A .csv file needs a header row with name, category, latitude, longitude and
+// @Description optionally subtype columns; any other file is read as a GeoJSON FeatureCollection
+// @Description of Point features with name, category and subtype properties. Categories are
+// @Description school, park and transit. Points with the same category, name and coordinates are updated.
+// @Tags neighborhoods
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "GeoJSON or CSV dataset"
+// @Success 200 {object} gin.H{"status": "OK", "data": repositories.ImportSummary}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/points-of-interest/import [post]
+func ImportPointsOfInterest(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	var features []services.PointFeature
+	err := readDataset(c, func(name string, r io.Reader) (err error) {
+		if strings.EqualFold(filepath.Ext(name), ".csv") {
+			features, err = services.ParsePointsOfInterestCSV(r)
+		} else {
+			features, err = services.ParsePointsOfInterestGeoJSON(r)
+		}
+		return err
+	})
+	if err != nil {
+		respondImportError(c, err)
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	summary, err := repositories.ImportPointsOfInterestRepo(c.Request.Context(), entClient, features)
+	if err != nil {
+		respondImportError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": summary})
+}
+
+// readDataset opens the uploaded dataset file and hands it to parse.
+func readDataset(c *gin.Context, parse func(name string, r io.Reader) error) error {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return fmt.Errorf("%w: %v", services.ErrInvalidDataset, err)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return parse(fileHeader.Filename, file)
+}
+
+// respondImportError maps errors from importing a dataset to HTTP responses.
+func respondImportError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, services.ErrInvalidDataset) {
+		status = http.StatusBadRequest
+	}
+	c.JSON(status, gin.H{"error": "Failed to import dataset", "message": err.Error()})
+}
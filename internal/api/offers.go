@@ -0,0 +1,189 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/repositories"
+)
+
+// SubmitOffer handles a signed-in buyer's offer on a published listing. The buyer and the
+// listing's realtor are notified.
+// @Summary Make an offer on a listing
+// @Description The amount is in the listing's currency. The closing date must be after the offer
+// @Description expires. A buyer can have one pending offer per listing.
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param input body repositories.OfferTerms true "Offer terms"
+// @Success 201 {object} gin.H{"status": "OK", "data": ent.Offer}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/offers [post]
+func SubmitOffer(c *gin.Context) {
+	listingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	var input repositories.OfferTerms
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	created, err := repositories.SubmitOfferRepo(c.Request.Context(), entClient, listingID, input)
+	if err != nil {
+		respondOfferError(c, "Failed to make offer", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "OK", "data": created})
+}
+
+// GetListingOffers handles listing the offers on a listing, oldest first. Staff and the
+// listing's realtor see every offer; buyers see their own negotiation.
+// @Summary List the offers on a listing
+// @Tags offers
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": []ent.Offer}
+// @Router /api/v1/properties/{id}/offers [get]
+func GetListingOffers(c *gin.Context) {
+	listingID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	offers, err := repositories.GetListingOffersRepo(c.Request.Context(), entClient, listingID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Failed to get offers", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": offers})
+}
+
+// GetMyOffers handles listing the signed-in user's offers with their listings, newest first.
+// @Summary List my offers
+// @Tags offers
+// @Produce json
+// @Success 200 {object} gin.H{"status": "OK", "data": []ent.Offer}
+// @Router /api/v1/users/me/offers [get]
+func GetMyOffers(c *gin.Context) {
+	entClient := c.MustGet("entClient").(*ent.Client)
+	offers, err := repositories.GetMyOffersRepo(c.Request.Context(), entClient)
+	if err != nil {
+		respondOfferError(c, "Failed to get offers", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": offers})
+}
+
+// AcceptOffer handles accepting an offer. The listing goes under contract (PENDING) and
+// its other pending offers are declined.
+// @Summary Accept an offer
+// @Tags offers
+// @Produce json
+// @Param offerId path string true "Offer UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Offer}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/offers/{offerId}/accept [post]
+func AcceptOffer(c *gin.Context) {
+	respondToOffer(c, repositories.OfferAccept)
+}
+
+// RejectOffer handles rejecting an offer.
+// @Summary Reject an offer
+// @Tags offers
+// @Produce json
+// @Param offerId path string true "Offer UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Offer}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/offers/{offerId}/reject [post]
+func RejectOffer(c *gin.Context) {
+	respondToOffer(c, repositories.OfferReject)
+}
+
+// CounterOffer handles answering an offer with a counteroffer from the other side.
+// @Summary Counter an offer
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param offerId path string true "Offer UUID"
+// @Param input body repositories.OfferTerms true "Counteroffer terms"
+// @Success 201 {object} gin.H{"status": "OK", "data": ent.Offer, "countered": ent.Offer}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/offers/{offerId}/counter [post]
+func CounterOffer(c *gin.Context) {
+	respondToOffer(c, repositories.OfferCounter)
+}
+
+// WithdrawOffer handles withdrawing an offer the signed-in side made.
+// @Summary Withdraw an offer
+// @Tags offers
+// @Produce json
+// @Param offerId path string true "Offer UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Offer}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/offers/{offerId}/withdraw [post]
+func WithdrawOffer(c *gin.Context) {
+	respondToOffer(c, repositories.OfferWithdraw)
+}
+
+func respondToOffer(c *gin.Context, action string) {
+	offerID, err := uuid.Parse(c.Param("offerId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid offer ID"})
+		return
+	}
+
+	var counter *repositories.OfferTerms
+	if action == repositories.OfferCounter {
+		counter = &repositories.OfferTerms{}
+		if err := c.ShouldBindJSON(counter); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+			return
+		}
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	answered, countered, err := repositories.RespondToOfferRepo(c.Request.Context(), entClient, offerID, action, counter)
+	if err != nil {
+		respondOfferError(c, "Failed to "+action+" offer", err)
+		return
+	}
+
+	if countered != nil {
+		c.JSON(http.StatusCreated, gin.H{"status": "OK", "data": countered, "countered": answered})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": answered})
+}
+
+// respondOfferError maps offer workflow errors to response statuses.
+func respondOfferError(c *gin.Context, message string, err error) {
+	status := http.StatusBadRequest
+	switch {
+	case errors.Is(err, repositories.ErrOfferNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, repositories.ErrOfferNotPending), errors.Is(err, repositories.ErrListingNotOpenForOffers):
+		status = http.StatusConflict
+	case errors.Is(err, repositories.ErrNotOfferRecipient), isForbidden(err):
+		status = http.StatusForbidden
+	}
+	c.JSON(status, gin.H{"error": message, "message": err.Error()})
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/config"
+	"ppgroup.ppgroup.com/internal/repositories"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// GetPropertyHistory handles the retrieval of every listing ever made for an address.
+// @Summary Get the listing history of a property
+// @Description Returns the property at the given address with all of its listings, oldest first.
+// @Description Addresses are matched within their ZIP code; give the city and country when the
+// @Description same address exists in several places.
+// @Tags properties
+// @Produce json
+// @Param address query string true "Property address"
+// @Param zip_code query string true "ZIP or postal code"
+// @Param city query string false "City"
+// @Param country query string false "ISO 3166-1 alpha-2 country code"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Property}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/history [get]
+func GetPropertyHistory(c *gin.Context) {
+	var params repositories.PropertyHistoryParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	prop, err := repositories.GetPropertyHistoryRepo(entClient, params)
+	if err != nil {
+		status := http.StatusNotFound
+		if errors.Is(err, repositories.ErrAmbiguousProperty) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{
+			"error":   "Failed to get property history",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "OK",
+		"data":   prop,
+	})
+}
+
+// GetDuplicateListings handles the report of active listings suspected to be duplicates.
+// Staff only.
+// @Summary Report suspected duplicate listings
+// @Description Groups active listings whose normalized addresses match or nearly match within a ZIP code,
+// @Description city and country
+// @Tags properties
+// @Produce json
+// @Success 200 {object} gin.H{"status": "OK", "data": []repositories.DuplicateCluster}
+// @Failure 500 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/duplicates [get]
+func GetDuplicateListings(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	clusters, err := repositories.GetDuplicateListingClustersRepo(entClient)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to get duplicate listings",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "OK",
+		"data":   clusters,
+	})
+}
+
+// GetMissingTranslations handles the report of active listings that are not translated
+// into the enabled locales. Staff only.
+// @Summary Report listings missing translations
+// @Description Lists active listings lacking a translation, or the description of one, for the enabled locales (LISTING_LOCALES) or the given locale
+// @Tags properties
+// @Produce json
+// @Param locale query string false "Only report this locale"
+// @Success 200 {object} gin.H{"status": "OK", "data": []repositories.MissingTranslations, "meta": gin.H{"locales": []string, "counts": map[string]int}}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 500 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/translations/missing [get]
+func GetMissingTranslations(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	locales := c.MustGet("config").(*config.Config).ListingLocales
+	if locale := c.Query("locale"); locale != "" {
+		locales = []string{locale}
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	report, counts, err := repositories.GetMissingTranslationsRepo(entClient, locales)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, services.ErrInvalidTranslation) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{
+			"error":   "Failed to get missing translations",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status": "OK",
+		"data":   report,
+		"meta":   gin.H{"locales": locales, "counts": counts},
+	})
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/repositories"
+)
+
+// RealtorInviteInput is the email invited to take over a realtor profile
+type RealtorInviteInput struct {
+	Email string `json:"email"`
+}
+
+// AcceptRealtorInviteInput is the token of the invite being accepted
+type AcceptRealtorInviteInput struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// InviteRealtor handles inviting an email to take over a realtor profile. Staff only.
+// @Summary Invite an account to a realtor profile
+// @Description Returns the invite token once; send it to the invited email. The user who presents the
+// @Description token can accept the invite within 7 days to manage the profile and its listings. Without
+// @Description an email the realtor's own is recorded. Sending an invite revokes the realtor's earlier
+// @Description pending ones.
+// @Tags realtors
+// @Accept json
+// @Produce json
+// @Param id path string true "Realtor UUID"
+// @Param input body RealtorInviteInput false "Invited email"
+// @Success 201 {object} gin.H{"status": "OK", "data": ent.RealtorInvite, "token": string}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/realtors/{id}/invites [post]
+func InviteRealtor(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid realtor ID"})
+		return
+	}
+
+	var input RealtorInviteInput
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+			return
+		}
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	invite, token, err := repositories.InviteRealtorRepo(c.Request.Context(), entClient, id, input.Email)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to invite realtor", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"status": "OK", "message": "Invite created!", "data": invite, "token": token})
+}
+
+// GetRealtorInvites handles listing the invites sent for a realtor profile. Staff only.
+// @Summary List realtor invites
+// @Tags realtors
+// @Produce json
+// @Param id path string true "Realtor UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": []ent.RealtorInvite}
+// @Failure 403 {object} gin.H{"error": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/realtors/{id}/invites [get]
+func GetRealtorInvites(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid realtor ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	invites, err := repositories.GetRealtorInvitesRepo(c.Request.Context(), entClient, id)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to get realtor invites", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": invites})
+}
+
+// AcceptRealtorInvite handles accepting a realtor invite, which links the user's account
+// to the realtor profile.
+// @Summary Accept a realtor invite
+// @Description The token must belong to an open invite, and the account must not manage another realtor
+// @Description profile yet.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param input body AcceptRealtorInviteInput true "Invite token"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Realtor}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/users/me/realtor-invites/accept [post]
+func AcceptRealtorInvite(c *gin.Context) {
+	var input AcceptRealtorInviteInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	linked, err := repositories.AcceptRealtorInviteRepo(c.Request.Context(), entClient, input.Token)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to accept realtor invite", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "You now manage this realtor profile!", "data": linked})
+}
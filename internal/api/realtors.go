@@ -1,11 +1,18 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/schematype"
 	"ppgroup.ppgroup.com/internal/repositories"
+	"ppgroup.ppgroup.com/internal/services"
 )
 
 // CreateRealtor handles the creation of a new realtor.
@@ -14,9 +21,11 @@ import (
 // @Tags realtors
 // @Accept json
 // @Produce json
-// @Param input body repositories.CreateRealtorInput true "Realtor input data"
+// @Param input body ent.Realtor true "Realtor input data"
 // @Success 201 {object} gin.H{"status": "OK", "data": "Realtor created!"}
 // @Failure 400 {object} gin.H{"error": "Invalid input", "message": "Please provide required fields"}
+// @Failure 403 {object} gin.H{"error": "Failed to create realtor", "message": "Error message"}
+// @Failure 409 {object} gin.H{"error": "Failed to create realtor", "message": "Error message"}
 // @Failure 500 {object} gin.H{"error": "Failed to create realtor", "message": "Error message"}
 // @Router /realtors [post]
 func CreateRealtor(c *gin.Context) {
@@ -30,57 +39,482 @@ func CreateRealtor(c *gin.Context) {
 	}
 	entClient := c.MustGet("entClient").(*ent.Client)
 
-	err := repositories.CreateRealtorRepo(entClient, input)
+	err := repositories.CreateRealtorRepo(c.Request.Context(), entClient, input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create realtor", "message": err.Error()})
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to create realtor", "message": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusCreated, gin.H{"status": "OK", "data": "Realtor created!"})
 }
 
-// GetRealtor handles the request to retrieve a realtor by email.
-// @Summary Get a realtor by email
-// @Description Get details of a realtor using their email address
+// GetRealtor handles the request to retrieve a realtor by ID.
+// @Summary Get a realtor
 // @Tags realtors
-// @Accept json
 // @Produce json
-// @Param email path string true "Realtor Email"
-// @Success 200 {object} gin.H{"data": models.Realtor}
-// @Failure 500 {object} gin.H{"error": string, "message": string}
-// @Router /realtors/{email} [get]
+// @Param id path string true "Realtor UUID"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Realtor}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/realtors/{id} [get]
 func GetRealtor(c *gin.Context) {
-	email := c.Param("email")
-	entClient := c.MustGet("entClient").(*ent.Client)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid realtor ID"})
+		return
+	}
 
-	realtor, err := repositories.GetRealtorRepo(entClient, email)
+	entClient := c.MustGet("entClient").(*ent.Client)
+	realtor, err := repositories.GetRealtorRepo(c.Request.Context(), entClient, id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to get realtor",
-			"message": err.Error(),
-		})
+		status := http.StatusInternalServerError
+		if errors.Is(err, repositories.ErrRealtorNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{"error": "Failed to get realtor", "message": err.Error()})
+		return
 	}
-	c.JSON(http.StatusOK, gin.H{"data": realtor})
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": realtor})
 }
 
-// GetRealtors handles HTTP requests to retrieve all realtors.
-// It extracts the ent.Client from the Gin context, queries the repository
-// for all realtors, and returns the result as JSON.
-// On success, it returns HTTP 200 with the realtors data.
-// On failure, it returns HTTP 500 with an error message.
+// GetRealtors handles searching realtors by name, email or area, one page at a time.
+// @Summary Search realtors
+// @Description Lists active realtors by name. An area matches realtors who list it among their service
+// @Description areas or have active listings in that city or ZIP code. Staff can include deactivated realtors.
+// @Tags realtors
+// @Produce json
+// @Param name query string false "Part of the realtor's name"
+// @Param email query string false "Realtor email"
+// @Param area query string false "City or ZIP code"
+// @Param brokerage_id query string false "Only realtors in this brokerage (UUID)"
+// @Param team_id query string false "Only realtors in this team (UUID)"
+// @Param include_inactive query bool false "Also list deactivated realtors (staff only)"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} gin.H{"status": "OK", "data": []ent.Realtor, "meta": repositories.RealtorSearchMeta}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/realtors [get]
 func GetRealtors(c *gin.Context) {
+	var params repositories.RealtorSearchParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "message": err.Error()})
+		return
+	}
+
 	entClient := c.MustGet("entClient").(*ent.Client)
+	realtors, meta, err := repositories.SearchRealtorsRepo(c.Request.Context(), entClient, params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get realtors", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": realtors, "meta": meta})
+}
+
+// patchableRealtorFields are the realtor members a merge patch may set. Members mapped
+// to true are optional and can be cleared with null; the others are required.
+var patchableRealtorFields = map[string]bool{
+	"full_name": false, "phone": false, "email": false, "description": true,
+	"is_mvp": false, "service_areas": true, "brokerage_id": true, "team_id": true,
+}
+
+// UpdateRealtor handles partial updates of a realtor with a JSON Merge Patch (RFC 7396).
+// @Summary Update a realtor
+// @Description Applies a JSON Merge Patch to the realtor. Absent members are kept, null clears description,
+// @Description service_areas, brokerage_id and team_id. A realtor given a team joins its brokerage; one moved
+// @Description to another brokerage leaves their team. Realtors can edit their own profile except is_mvp,
+// @Description brokerage_id and team_id, which only staff can change.
+// @Tags realtors
+// @Accept application/merge-patch+json
+// @Accept json
+// @Produce json
+// @Param id path string true "Realtor UUID"
+// @Param input body object true "Merge patch of realtor fields"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Realtor}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/realtors/{id} [patch]
+func UpdateRealtor(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid realtor ID"})
+		return
+	}
+
+	updateRealtor(c, id)
+}
 
-	realtors, err := repositories.GetRealtorsRepo(entClient)
+// updateRealtor applies the JSON Merge Patch in the request body to the realtor.
+func updateRealtor(c *gin.Context, id uuid.UUID) {
+	body, err := c.GetRawData()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to get realtors",
-			"message": err.Error(),
-		})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+	patch, err := services.ParseMergePatch(body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+	for field, value := range patch {
+		optional, ok := patchableRealtorFields[field]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "Field cannot be updated: " + field})
+			return
+		}
+		if value == nil && !optional {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "Required field cannot be cleared: " + field})
+			return
+		}
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	current, err := repositories.GetRealtorRepo(c.Request.Context(), entClient, id)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to update realtor", "message": err.Error()})
+		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"status": "OK",
-		"data":   realtors,
-	})
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update realtor", "message": err.Error()})
+		return
+	}
+	mergedJSON, err := services.ApplyMergePatch(currentJSON, patch)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update realtor", "message": err.Error()})
+		return
+	}
+	var merged ent.Realtor
+	if err := json.Unmarshal(mergedJSON, &merged); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+	merged.ID = id
+
+	// A realtor moved to a team follows it to its brokerage, and one moved to another
+	// brokerage leaves their team unless a new one is given
+	_, teamPatched := patch["team_id"]
+	_, brokeragePatched := patch["brokerage_id"]
+	if teamPatched && !brokeragePatched {
+		merged.BrokerageID = nil
+		if merged.TeamID == nil {
+			merged.BrokerageID = current.BrokerageID
+		}
+	}
+	if brokeragePatched && !teamPatched && !sameBrokerage(merged.BrokerageID, current.BrokerageID) {
+		merged.TeamID = nil
+	}
+
+	updated, err := repositories.UpdateRealtorRepo(c.Request.Context(), entClient, &merged)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to update realtor", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Realtor updated!", "data": updated})
+}
+
+// UploadRealtorPhoto handles replacing a realtor's photo. The upload is cropped to a square
+// and stored with thumbnail, medium and large renditions; the previous photo is deleted.
+// @Summary Upload a realtor photo
+// @Description Realtors can change their own photo; staff can change any.
+// @Tags realtors
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "Realtor UUID"
+// @Param photo formData file true "Photo (JPEG, PNG or WebP)"
+// @Success 200 {object} gin.H{"status": "OK", "data": schematype.Photo}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/realtors/{id}/photo [put]
+func UploadRealtorPhoto(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid realtor ID"})
+		return
+	}
+
+	fileHeader, err := c.FormFile("photo")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": "No photo provided"})
+		return
+	}
+	if err := services.ValidateMediaFile(schematype.MediaTypeImage, fileHeader.Filename, fileHeader.Size); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+
+	// Do not upload photos for realtors that do not exist or the viewer may not change
+	entClient := c.MustGet("entClient").(*ent.Client)
+	if err := repositories.RequireRealtorEditorRepo(c.Request.Context(), entClient, id); err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to upload photo", "message": err.Error()})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upload photo", "message": "Failed to open file: " + fileHeader.Filename})
+		return
+	}
+	defer file.Close()
+
+	imageService := c.MustGet("imageService").(*services.ImageService)
+	photo, err := imageService.UploadProfilePhoto(c.Request.Context(), file, fileHeader.Filename)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to upload photo", "message": err.Error()})
+		return
+	}
+
+	_, previous, err := repositories.SetRealtorPhotoRepo(c.Request.Context(), entClient, id, photo)
+	if err != nil {
+		discardRealtorPhoto(c.Request.Context(), imageService, photo)
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to upload photo", "message": err.Error()})
+		return
+	}
+	discardRealtorPhoto(c.Request.Context(), imageService, previous)
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Photo uploaded!", "data": photo})
+}
+
+// DeleteRealtorPhoto handles removing a realtor's photo and deleting it from storage.
+// @Summary Delete a realtor photo
+// @Tags realtors
+// @Produce json
+// @Param id path string true "Realtor UUID"
+// @Success 200 {object} gin.H{"status": "OK", "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/realtors/{id}/photo [delete]
+func DeleteRealtorPhoto(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid realtor ID"})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	_, previous, err := repositories.SetRealtorPhotoRepo(c.Request.Context(), entClient, id, nil)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to delete photo", "message": err.Error()})
+		return
+	}
+	discardRealtorPhoto(c.Request.Context(), c.MustGet("imageService").(*services.ImageService), previous)
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Photo deleted!"})
+}
+
+// discardRealtorPhoto removes a photo from storage. Its renditions are derived from the
+// uploaded file and go with it.
+func discardRealtorPhoto(ctx context.Context, imageService *services.ImageService, photo *schematype.Photo) {
+	if photo == nil || photo.URL == "" {
+		return
+	}
+	if err := imageService.DeleteImage(ctx, photo.URL); err != nil {
+		log.Printf("Failed to remove realtor photo %s: %v", photo.URL, err)
+	}
+}
+
+// GetMyRealtor handles retrieving the realtor profile linked to the user's account.
+// @Summary Get my realtor profile
+// @Tags users
+// @Produce json
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Realtor}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/users/me/realtor [get]
+func GetMyRealtor(c *gin.Context) {
+	entClient := c.MustGet("entClient").(*ent.Client)
+	realtor, err := repositories.GetMyRealtorRepo(c.Request.Context(), entClient)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to get realtor profile", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": realtor})
+}
+
+// UpdateMyRealtor handles partial updates of the realtor profile linked to the user's
+// account with a JSON Merge Patch (RFC 7396).
+// @Summary Update my realtor profile
+// @Description Same as updating the realtor by ID; is_mvp can only be changed by staff.
+// @Tags users
+// @Accept application/merge-patch+json
+// @Accept json
+// @Produce json
+// @Param input body object true "Merge patch of realtor fields"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Realtor}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 403 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/users/me/realtor [patch]
+func UpdateMyRealtor(c *gin.Context) {
+	entClient := c.MustGet("entClient").(*ent.Client)
+	realtor, err := repositories.GetMyRealtorRepo(c.Request.Context(), entClient)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to update realtor", "message": err.Error()})
+		return
+	}
+
+	updateRealtor(c, realtor.ID)
+}
+
+// GetMyRealtorListings handles listing the listings of the realtor linked to the user's
+// account, whatever their status.
+// @Summary List my realtor listings
+// @Tags users
+// @Produce json
+// @Param status query string false "Only listings in this status"
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Page size (default 20, max 100)"
+// @Success 200 {object} gin.H{"status": "OK", "data": []repositories.Listing, "meta": repositories.RealtorSearchMeta}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/users/me/realtor/listings [get]
+func GetMyRealtorListings(c *gin.Context) {
+	var params repositories.RealtorListingsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	listings, meta, err := repositories.GetMyRealtorListingsRepo(c.Request.Context(), entClient, params)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to get realtor listings", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": listings, "meta": meta})
+}
+
+// sameBrokerage reports whether two optional brokerage IDs are both unset or equal.
+func sameBrokerage(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// ReassignListingsInput names the realtor who takes over another realtor's listings
+type ReassignListingsInput struct {
+	ReassignTo *uuid.UUID `json:"reassign_to"`
+}
+
+// DeactivateRealtor handles deactivating a realtor. Their active listings move to the
+// realtor named in reassign_to. Staff only.
+// @Summary Deactivate a realtor
+// @Description Deactivated realtors are hidden from the search and cannot take new listings. Their draft,
+// @Description in-review, scheduled, published and pending listings move to reassign_to, which is required
+// @Description when there are any. Sold and archived listings stay with them.
+// @Tags realtors
+// @Accept json
+// @Produce json
+// @Param id path string true "Realtor UUID"
+// @Param input body ReassignListingsInput false "Realtor taking over the listings"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Realtor, "meta": repositories.ReassignmentSummary}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/realtors/{id}/deactivate [post]
+func DeactivateRealtor(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	id, input, ok := bindReassignment(c)
+	if !ok {
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	deactivated, summary, err := repositories.DeactivateRealtorRepo(c.Request.Context(), entClient, id, input.ReassignTo)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to deactivate realtor", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Realtor deactivated!", "data": deactivated, "meta": summary})
+}
+
+// DeleteRealtor handles deleting a realtor. All their listings move to the realtor named
+// in reassign_to. Staff only.
+// @Summary Delete a realtor
+// @Description Every listing of the realtor, whatever its status, moves to reassign_to, which is required
+// @Description when there are any. Sales where they represented the buyer no longer name a buyer's realtor.
+// @Tags realtors
+// @Accept json
+// @Produce json
+// @Param id path string true "Realtor UUID"
+// @Param input body ReassignListingsInput false "Realtor taking over the listings"
+// @Success 200 {object} gin.H{"status": "OK", "meta": repositories.ReassignmentSummary}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Failure 409 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/realtors/{id} [delete]
+func DeleteRealtor(c *gin.Context) {
+	if requireStaff(c) == nil {
+		return
+	}
+
+	id, input, ok := bindReassignment(c)
+	if !ok {
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	current, err := repositories.GetRealtorRepo(c.Request.Context(), entClient, id)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to delete realtor", "message": err.Error()})
+		return
+	}
+	summary, err := repositories.DeleteRealtorRepo(c.Request.Context(), entClient, id, input.ReassignTo)
+	if err != nil {
+		c.JSON(realtorWriteErrorStatus(err), gin.H{"error": "Failed to delete realtor", "message": err.Error()})
+		return
+	}
+	discardRealtorPhoto(c.Request.Context(), c.MustGet("imageService").(*services.ImageService), current.Photo)
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Realtor deleted!", "meta": summary})
+}
+
+// bindReassignment reads the realtor ID from the path and the optional reassignment from
+// the body, responding with 400 when either is invalid.
+func bindReassignment(c *gin.Context) (uuid.UUID, ReassignListingsInput, bool) {
+	var input ReassignListingsInput
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid realtor ID"})
+		return id, input, false
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+			return id, input, false
+		}
+	}
+	return id, input, true
+}
+
+// realtorWriteErrorStatus maps an error from changing a realtor to a status code.
+func realtorWriteErrorStatus(err error) int {
+	switch {
+	case isForbidden(err):
+		return http.StatusForbidden
+	case errors.Is(err, repositories.ErrRealtorNotFound), errors.Is(err, repositories.ErrNotRealtor),
+		errors.Is(err, repositories.ErrRealtorInviteNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, repositories.ErrRealtorExists), errors.Is(err, repositories.ErrReassignmentRequired),
+		errors.Is(err, repositories.ErrRealtorLinked), errors.Is(err, repositories.ErrAccountLinked),
+		errors.Is(err, repositories.ErrRealtorInviteClosed):
+		return http.StatusConflict
+	case errors.Is(err, repositories.ErrInvalidRealtor), errors.Is(err, repositories.ErrRealtorInactive),
+		errors.Is(err, repositories.ErrInvalidRealtorInvite), ent.IsValidationError(err):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
 }
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/config"
+	"ppgroup.ppgroup.com/internal/repositories"
+)
+
+// defaultCompsLimit is how many comparable sales are returned unless limit is given.
+const defaultCompsLimit = 10
+
+// MarkListingSold handles closing the sale of a published listing or one under contract.
+// Only staff and the listing's realtor can record it.
+// @Summary Mark a listing as sold
+// @Description Records the sold price in the listing's currency, the close date and optionally the
+// @Description buyer's realtor. Without a sold price the amount of the accepted offer is used.
+// @Tags listings
+// @Accept json
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param input body repositories.SaleInput true "Closing data"
+// @Success 200 {object} gin.H{"status": "OK", "data": ent.Listing}
+// @Failure 400 {object} gin.H{"error": string, "message": string}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/sold [post]
+func MarkListingSold(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	var input repositories.SaleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input", "message": err.Error()})
+		return
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	sold, err := repositories.MarkListingSoldRepo(c.Request.Context(), entClient, id, input)
+	if err != nil {
+		status := http.StatusNotFound
+		switch {
+		case errors.Is(err, repositories.ErrInvalidSale):
+			status = http.StatusBadRequest
+		case isForbidden(err):
+			status = http.StatusForbidden
+		}
+		c.JSON(status, gin.H{"error": "Failed to mark listing as sold", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "message": "Listing sold!", "data": sold})
+}
+
+// GetListingComps handles the comparable sales of a listing.
+// @Summary Get comparable sales
+// @Description Listings of the same type with at most one bedroom more or fewer and a living area within
+// @Description 20% of the listing's, sold within radius_meters of it (or in its ZIP code when it has no
+// @Description coordinates) in the last months months. Prices are converted to the listing's currency;
+// @Description meta summarizes the sold price per sqft of every match.
+// @Tags listings
+// @Produce json
+// @Param id path string true "Listing UUID"
+// @Param radius_meters query int false "Search radius in meters (default COMPS_RADIUS_METERS)"
+// @Param months query int false "Months of sales (default COMPS_MONTHS)"
+// @Param limit query int false "Number of sales returned (default 10, max 50)"
+// @Success 200 {object} gin.H{"status": "OK", "data": []repositories.Comp, "meta": repositories.CompsStats}
+// @Failure 404 {object} gin.H{"error": string, "message": string}
+// @Router /api/v1/properties/{id}/comps [get]
+func GetListingComps(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid listing ID"})
+		return
+	}
+
+	var params repositories.CompsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters", "message": err.Error()})
+		return
+	}
+	cfg := c.MustGet("config").(*config.Config)
+	if params.RadiusMeters == 0 {
+		params.RadiusMeters = cfg.CompsRadiusMeters
+	}
+	if params.Months == 0 {
+		params.Months = cfg.CompsMonths
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultCompsLimit
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	comps, stats, err := repositories.GetCompsRepo(c.Request.Context(), entClient, id, params)
+	if err != nil {
+		status := http.StatusNotFound
+		if errors.Is(err, repositories.ErrUnknownCurrency) {
+			status = http.StatusInternalServerError
+		}
+		c.JSON(status, gin.H{"error": "Failed to get comparable sales", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": comps, "meta": stats})
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/internal/repositories"
+)
+
+// sessionUser returns the signed-in user, or nil when the request has no session.
+// It works on public routes as well as behind AuthMiddleware.
+func sessionUser(c *gin.Context) (*ent.User, error) {
+	email, ok := sessions.Default(c).Get("userEmail").(string)
+	if !ok || email == "" {
+		return nil, nil
+	}
+
+	entClient := c.MustGet("entClient").(*ent.Client)
+	return repositories.GetUserRepo(entClient, email)
+}
+
+// requireStaff returns the signed-in staff user. Otherwise it responds with
+// 401 or 403 and returns nil.
+func requireStaff(c *gin.Context) *ent.User {
+	user, err := sessionUser(c)
+	if err != nil || user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized", "message": "Please sign in"})
+		return nil
+	}
+	if !user.IsStaff || !user.IsActive {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden", "message": "Staff access required"})
+		return nil
+	}
+	return user
+}
+
+// isForbidden reports whether err comes from a privacy policy refusing the change,
+// such as a realtor editing another realtor's listing.
+func isForbidden(err error) bool {
+	return errors.Is(err, privacy.Deny)
+}
@@ -70,3 +70,17 @@ func Dashboard(c *gin.Context) {
 	user.Password = ""
 	c.JSON(http.StatusOK, gin.H{"data": user})
 }
+
+// GetMyNotifications returns the notifications for the signed-in user and the realtor profile
+// linked to their account, newest first.
+func GetMyNotifications(c *gin.Context) {
+	entClient := c.MustGet("entClient").(*ent.Client)
+
+	notifications, err := repositories.GetNotificationsRepo(c.Request.Context(), entClient)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get notifications", "message": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"status": "OK", "data": notifications})
+}
@@ -1,6 +1,10 @@
 package config
 
-import "os"
+import (
+	"os"
+	"strconv"
+	"strings"
+)
 
 type Config struct {
 	DBHost              string
@@ -20,6 +24,35 @@ type Config struct {
 	CloudinaryAPIKey    string
 	CloudinaryAPISecret string
 	// SessionSecret     string
+
+	// Listing lifecycle settings, all optional
+	ListingTermDays           int
+	ExpiryReminderDays        int
+	ExpirationIntervalMinutes int
+	ScheduleIntervalSeconds   int
+
+	// Lifetime of listing document download links
+	DocumentLinkTTLMinutes int
+
+	// Currency every exchange rate is expressed in
+	BaseCurrency string
+
+	// Hours staff have to decide a listing review before it is overdue
+	ModerationSLAHours int
+
+	// Locales listings are expected to be translated into
+	ListingLocales []string
+
+	// Schools, parks and transit shown with a listing: how far away and how many of each
+	NearbyRadiusMeters int
+	NearbyLimit        int
+
+	// How often the market statistics are recomputed
+	MarketStatsRefreshMinutes int
+
+	// Default search area and window of comparable sales
+	CompsRadiusMeters int
+	CompsMonths       int
 }
 
 func LoadConfig() *Config {
@@ -41,6 +74,27 @@ func LoadConfig() *Config {
 		CloudinaryAPIKey:    getEnv("CLOUDINARY_API_KEY"),
 		CloudinaryAPISecret: getEnv("CLOUDINARY_API_SECRET"),
 		// SessionSecret:     getEnv("SESSION_SECRET"),
+
+		ListingTermDays:           getEnvInt("LISTING_TERM_DAYS", 90),
+		ExpiryReminderDays:        getEnvInt("LISTING_EXPIRY_REMINDER_DAYS", 7),
+		ExpirationIntervalMinutes: getEnvInt("LISTING_EXPIRATION_INTERVAL_MINUTES", 60),
+		ScheduleIntervalSeconds:   getEnvInt("LISTING_SCHEDULE_INTERVAL_SECONDS", 30),
+
+		DocumentLinkTTLMinutes: getEnvInt("DOCUMENT_LINK_TTL_MINUTES", 15),
+
+		BaseCurrency: getEnvDefault("BASE_CURRENCY", "USD"),
+
+		ModerationSLAHours: getEnvInt("MODERATION_SLA_HOURS", 24),
+
+		ListingLocales: getEnvList("LISTING_LOCALES", "en,es,zh"),
+
+		NearbyRadiusMeters: getEnvInt("NEARBY_RADIUS_METERS", 2000),
+		NearbyLimit:        getEnvInt("NEARBY_LIMIT", 5),
+
+		MarketStatsRefreshMinutes: getEnvInt("MARKET_STATS_REFRESH_MINUTES", 15),
+
+		CompsRadiusMeters: getEnvInt("COMPS_RADIUS_METERS", 1600),
+		CompsMonths:       getEnvInt("COMPS_MONTHS", 6),
 	}
 }
 
@@ -51,3 +105,36 @@ func getEnv(key string) string {
 	}
 	return value
 }
+
+// getEnvDefault reads an optional variable, falling back when it is unset or empty.
+func getEnvDefault(key, fallback string) string {
+	value, exists := os.LookupEnv(key)
+	if !exists || value == "" {
+		return fallback
+	}
+	return value
+}
+
+// getEnvInt reads an optional positive integer, falling back when the variable is unset.
+func getEnvInt(key string, fallback int) int {
+	value, exists := os.LookupEnv(key)
+	if !exists || value == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		panic("Environment variable " + key + " must be a positive integer")
+	}
+	return n
+}
+
+// getEnvList reads an optional comma-separated list, falling back when the variable is unset or empty.
+func getEnvList(key, fallback string) []string {
+	var list []string
+	for _, item := range strings.Split(getEnvDefault(key, fallback), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
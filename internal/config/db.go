@@ -17,6 +17,8 @@ import (
 	"github.com/jackc/pgx/v5/stdlib"
 
 	"ppgroup.ppgroup.com/ent"
+	// Registers the schema defaults, validators and privacy policies
+	_ "ppgroup.ppgroup.com/ent/runtime"
 )
 
 type Database struct {
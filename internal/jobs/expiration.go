@@ -0,0 +1,62 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/config"
+	"ppgroup.ppgroup.com/internal/repositories"
+)
+
+// StartListingExpiration runs the listing expiration job in the background every
+// configured interval until ctx is cancelled. Each run dates undated published
+// listings, reminds realtors about listings that expire soon, archives the
+// listings whose term has ended and expires unanswered offers.
+func StartListingExpiration(ctx context.Context, entClient *ent.Client, cfg *config.Config) {
+	interval := time.Duration(cfg.ExpirationIntervalMinutes) * time.Minute
+	term := time.Duration(cfg.ListingTermDays) * 24 * time.Hour
+	reminderWindow := time.Duration(cfg.ExpiryReminderDays) * 24 * time.Hour
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			runListingExpiration(entClient, term, reminderWindow)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func runListingExpiration(entClient *ent.Client, term, reminderWindow time.Duration) {
+	if n, err := repositories.AssignMissingExpiryRepo(entClient, term); err != nil {
+		log.Printf("Listing expiration: %v", err)
+	} else if n > 0 {
+		log.Printf("Listing expiration: dated %d published listings", n)
+	}
+
+	if n, err := repositories.SendExpiryRemindersRepo(entClient, reminderWindow); err != nil {
+		log.Printf("Listing expiration: %v", err)
+	} else if n > 0 {
+		log.Printf("Listing expiration: sent %d expiry reminders", n)
+	}
+
+	if n, err := repositories.ArchiveExpiredListingsRepo(entClient); err != nil {
+		log.Printf("Listing expiration: %v", err)
+	} else if n > 0 {
+		log.Printf("Listing expiration: archived %d expired listings", n)
+	}
+
+	if n, err := repositories.ExpireOffersRepo(entClient); err != nil {
+		log.Printf("Listing expiration: %v", err)
+	} else if n > 0 {
+		log.Printf("Listing expiration: expired %d offers", n)
+	}
+}
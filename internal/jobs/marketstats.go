@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/config"
+	"ppgroup.ppgroup.com/internal/repositories"
+)
+
+// StartMarketStatsRefresh recomputes the market statistics view in the background every
+// configured interval until ctx is cancelled. The view is filled when it is created at
+// start-up, so the first refresh waits one interval.
+func StartMarketStatsRefresh(ctx context.Context, entClient *ent.Client, cfg *config.Config) {
+	interval := time.Duration(cfg.MarketStatsRefreshMinutes) * time.Minute
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if err := repositories.RefreshMarketStatsRepo(entClient); err != nil {
+				log.Printf("Market statistics: %v", err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,49 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/config"
+	"ppgroup.ppgroup.com/internal/repositories"
+)
+
+// StartListingSchedule runs the listing scheduler in the background every configured
+// interval until ctx is cancelled. Each run publishes the scheduled listings whose
+// publish_at has passed and unpublishes the listings whose unpublish_at has passed.
+// Every replica runs the scheduler; the repositories claim listings with SKIP LOCKED
+// so each transition is applied once.
+func StartListingSchedule(ctx context.Context, entClient *ent.Client, cfg *config.Config) {
+	interval := time.Duration(cfg.ScheduleIntervalSeconds) * time.Second
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			runListingSchedule(entClient)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func runListingSchedule(entClient *ent.Client) {
+	if n, err := repositories.PublishScheduledListingsRepo(entClient); err != nil {
+		log.Printf("Listing schedule: %v", err)
+	} else if n > 0 {
+		log.Printf("Listing schedule: published %d listings", n)
+	}
+
+	if n, err := repositories.UnpublishScheduledListingsRepo(entClient); err != nil {
+		log.Printf("Listing schedule: %v", err)
+	} else if n > 0 {
+		log.Printf("Listing schedule: unpublished %d listings", n)
+	}
+}
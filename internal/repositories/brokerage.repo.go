@@ -0,0 +1,351 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/brokerage"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/team"
+	"ppgroup.ppgroup.com/internal/viewer"
+)
+
+var (
+	// ErrBrokerageNotFound is returned for brokerage IDs that do not exist.
+	ErrBrokerageNotFound = errors.New("brokerage not found")
+	// ErrBrokerageExists is returned when another brokerage has the name or license number.
+	ErrBrokerageExists = errors.New("brokerage with the given name or license number already exists")
+	// ErrBrokerageInUse is returned when a brokerage that still has teams or realtors is deleted.
+	ErrBrokerageInUse = errors.New("brokerage still has teams or realtors")
+	// ErrTeamNotFound is returned for team IDs that do not exist.
+	ErrTeamNotFound = errors.New("team not found")
+	// ErrTeamExists is returned when the brokerage already has a team with the name.
+	ErrTeamExists = errors.New("brokerage already has a team with the given name")
+	// ErrInvalidTeam is returned for a team lead who is not a member of the team.
+	ErrInvalidTeam = errors.New("invalid team")
+	// ErrNotTeamLead is returned when someone other than staff or the team lead asks for
+	// the team's listings.
+	ErrNotTeamLead = errors.New("only staff and the team lead can see the team's listings")
+)
+
+// CreateBrokerageRepo creates a brokerage. Staff only.
+func CreateBrokerageRepo(ctx context.Context, entClient *ent.Client, data *ent.Brokerage) (*ent.Brokerage, error) {
+	creator := entClient.Brokerage.Create().
+		SetName(strings.TrimSpace(data.Name)).
+		SetLicenseNumber(strings.TrimSpace(data.LicenseNumber)).
+		SetAddress(data.Address).
+		SetCity(data.City).
+		SetZipCode(data.ZipCode)
+
+	// Optional fields are left unset when empty
+	optional := []struct {
+		value string
+		set   func(string) *ent.BrokerageCreate
+	}{
+		{data.State, creator.SetState},
+		{data.Phone, creator.SetPhone},
+		{data.Email, creator.SetEmail},
+		{data.Website, creator.SetWebsite},
+		{data.LogoURL, creator.SetLogoURL},
+		{data.PrimaryColor, creator.SetPrimaryColor},
+		{data.SecondaryColor, creator.SetSecondaryColor},
+	}
+	for _, f := range optional {
+		if f.value != "" {
+			f.set(f.value)
+		}
+	}
+
+	created, err := creator.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrBrokerageExists
+		}
+		return nil, fmt.Errorf("failed to create brokerage: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetBrokeragesRepo retrieves all brokerages by name.
+func GetBrokeragesRepo(ctx context.Context, entClient *ent.Client) ([]*ent.Brokerage, error) {
+	brokerages, err := entClient.Brokerage.Query().
+		Order(ent.Asc(brokerage.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get brokerages: %w", err)
+	}
+
+	return brokerages, nil
+}
+
+// GetBrokerageRepo retrieves a brokerage with its teams.
+func GetBrokerageRepo(ctx context.Context, entClient *ent.Client, id uuid.UUID) (*ent.Brokerage, error) {
+	b, err := entClient.Brokerage.Query().
+		Where(brokerage.ID(id)).
+		WithTeams(func(q *ent.TeamQuery) { q.Order(ent.Asc(team.FieldName)) }).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBrokerageNotFound
+		}
+		return nil, fmt.Errorf("failed to get brokerage: %w", err)
+	}
+
+	return b, nil
+}
+
+// UpdateBrokerageRepo updates the fields of a brokerage that differ from the stored ones.
+// Staff only.
+func UpdateBrokerageRepo(ctx context.Context, entClient *ent.Client, data *ent.Brokerage) (*ent.Brokerage, error) {
+	current, err := entClient.Brokerage.Get(ctx, data.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBrokerageNotFound
+		}
+		return nil, fmt.Errorf("failed to get brokerage: %w", err)
+	}
+
+	updater := entClient.Brokerage.UpdateOneID(data.ID)
+	if name := strings.TrimSpace(data.Name); name != current.Name {
+		updater = updater.SetName(name)
+	}
+	if license := strings.TrimSpace(data.LicenseNumber); license != current.LicenseNumber {
+		updater = updater.SetLicenseNumber(license)
+	}
+	if data.Address != current.Address {
+		updater = updater.SetAddress(data.Address)
+	}
+	if data.City != current.City {
+		updater = updater.SetCity(data.City)
+	}
+	if data.ZipCode != current.ZipCode {
+		updater = updater.SetZipCode(data.ZipCode)
+	}
+
+	// Optional fields are cleared when emptied
+	optional := []struct {
+		value, current string
+		set            func(string) *ent.BrokerageUpdateOne
+		clear          func() *ent.BrokerageUpdateOne
+	}{
+		{data.State, current.State, updater.SetState, updater.ClearState},
+		{data.Phone, current.Phone, updater.SetPhone, updater.ClearPhone},
+		{data.Email, current.Email, updater.SetEmail, updater.ClearEmail},
+		{data.Website, current.Website, updater.SetWebsite, updater.ClearWebsite},
+		{data.LogoURL, current.LogoURL, updater.SetLogoURL, updater.ClearLogoURL},
+		{data.PrimaryColor, current.PrimaryColor, updater.SetPrimaryColor, updater.ClearPrimaryColor},
+		{data.SecondaryColor, current.SecondaryColor, updater.SetSecondaryColor, updater.ClearSecondaryColor},
+	}
+	for _, f := range optional {
+		switch {
+		case f.value == f.current:
+		case f.value == "":
+			f.clear()
+		default:
+			f.set(f.value)
+		}
+	}
+
+	updated, err := updater.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrBrokerageExists
+		}
+		return nil, fmt.Errorf("failed to update brokerage: %w", err)
+	}
+
+	return updated, nil
+}
+
+// DeleteBrokerageRepo deletes a brokerage without teams or realtors. Staff only.
+func DeleteBrokerageRepo(ctx context.Context, entClient *ent.Client, id uuid.UUID) error {
+	inUse, err := entClient.Brokerage.Query().
+		Where(brokerage.ID(id), brokerage.Or(brokerage.HasTeams(), brokerage.HasRealtors())).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return ErrBrokerageInUse
+	}
+
+	if err := entClient.Brokerage.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrBrokerageNotFound
+		}
+		return fmt.Errorf("failed to delete brokerage: %w", err)
+	}
+
+	return nil
+}
+
+// CreateTeamRepo creates a team in a brokerage. Its lead is chosen once it has members.
+// Staff only.
+func CreateTeamRepo(ctx context.Context, entClient *ent.Client, brokerageID uuid.UUID, name string) (*ent.Team, error) {
+	exists, err := entClient.Brokerage.Query().Where(brokerage.ID(brokerageID)).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrBrokerageNotFound
+	}
+
+	created, err := entClient.Team.Create().
+		SetBrokerageID(brokerageID).
+		SetName(strings.TrimSpace(name)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrTeamExists
+		}
+		return nil, fmt.Errorf("failed to create team: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetTeamRepo retrieves a team with its members.
+func GetTeamRepo(ctx context.Context, entClient *ent.Client, id uuid.UUID) (*ent.Team, error) {
+	t, err := entClient.Team.Query().
+		Where(team.ID(id)).
+		WithMembers(func(q *ent.RealtorQuery) { q.Order(ent.Asc(realtor.FieldFullName)) }).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	return t, nil
+}
+
+// UpdateTeamRepo renames a team and sets or clears its lead, who must be a member.
+// Staff only.
+func UpdateTeamRepo(ctx context.Context, entClient *ent.Client, data *ent.Team) (*ent.Team, error) {
+	current, err := entClient.Team.Get(ctx, data.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+
+	updater := entClient.Team.UpdateOneID(data.ID)
+	if name := strings.TrimSpace(data.Name); name != current.Name {
+		updater = updater.SetName(name)
+	}
+	if !sameValue(data.LeadID, current.LeadID) {
+		if data.LeadID == nil {
+			updater = updater.ClearLeadID()
+		} else {
+			member, err := entClient.Realtor.Query().
+				Where(realtor.ID(*data.LeadID), realtor.TeamIDEQ(data.ID)).
+				Exist(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if !member {
+				return nil, fmt.Errorf("%w: the team lead must be a member of the team", ErrInvalidTeam)
+			}
+			updater = updater.SetLeadID(*data.LeadID)
+		}
+	}
+
+	updated, err := updater.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrTeamExists
+		}
+		return nil, fmt.Errorf("failed to update team: %w", err)
+	}
+
+	return updated, nil
+}
+
+// DeleteTeamRepo deletes a team. Its members stay with the brokerage without a team.
+// Staff only.
+func DeleteTeamRepo(ctx context.Context, entClient *ent.Client, id uuid.UUID) error {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Realtor.Update().
+		Where(realtor.TeamIDEQ(id)).
+		ClearTeamID().
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to remove team members: %w", err)
+	}
+
+	if err := tx.Team.DeleteOneID(id).Exec(ctx); err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return ErrTeamNotFound
+		}
+		return fmt.Errorf("failed to delete team: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
+
+// GetTeamListingsRepo lists the listings of a team's members in every status, most
+// recently updated first. Only staff and the team lead can see them.
+func GetTeamListingsRepo(ctx context.Context, entClient *ent.Client, teamID uuid.UUID, params RealtorListingsParams) ([]Listing, RealtorSearchMeta, error) {
+	exists, err := entClient.Team.Query().Where(team.ID(teamID)).Exist(ctx)
+	if err != nil {
+		return nil, RealtorSearchMeta{}, err
+	}
+	if !exists {
+		return nil, RealtorSearchMeta{}, ErrTeamNotFound
+	}
+	if v := viewer.FromContext(ctx); v == nil || (!v.IsStaff && !v.LeadsTeam(teamID)) {
+		return nil, RealtorSearchMeta{}, ErrNotTeamLead
+	}
+
+	query := entClient.Listing.Query().Where(listing.HasRealtorWith(realtor.TeamIDEQ(teamID)))
+	return pageRealtorListings(ctx, query, params)
+}
+
+// resolveRealtorBrokerage checks the brokerage and team a realtor is placed in and
+// returns the brokerage, which defaults to the team's.
+func resolveRealtorBrokerage(ctx context.Context, entClient *ent.Client, brokerageID, teamID *uuid.UUID) (*uuid.UUID, error) {
+	if teamID != nil {
+		t, err := entClient.Team.Get(ctx, *teamID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidRealtor, ErrTeamNotFound)
+			}
+			return nil, err
+		}
+		if brokerageID != nil && *brokerageID != t.BrokerageID {
+			return nil, fmt.Errorf("%w: the team belongs to another brokerage", ErrInvalidRealtor)
+		}
+		return &t.BrokerageID, nil
+	}
+
+	if brokerageID != nil {
+		exists, err := entClient.Brokerage.Query().Where(brokerage.ID(*brokerageID)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRealtor, ErrBrokerageNotFound)
+		}
+	}
+	return brokerageID, nil
+}
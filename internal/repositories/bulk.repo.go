@@ -0,0 +1,285 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/realtor"
+)
+
+// MaxBulkListings caps how many listings a single bulk operation may touch.
+const MaxBulkListings = 500
+
+// Bulk listing actions.
+const (
+	BulkSetStatus   = "set_status"
+	BulkReassign    = "reassign"
+	BulkAdjustPrice = "adjust_price"
+	BulkDelete      = "delete"
+)
+
+// BulkStatuses are the statuses a bulk operation can set. Scheduling, pending and sold
+// listings need dates, offers or sale details per listing, and review is entered by
+// submitting a listing.
+var BulkStatuses = []listing.Status{listing.StatusDRAFT, listing.StatusPUBLISHED, listing.StatusARCHIVED}
+
+// Price adjustment modes. Absolute adjustments add an amount in the listing's currency,
+// percentage adjustments scale the price.
+const (
+	PriceAdjustAbsolute = "absolute"
+	PriceAdjustPercent  = "percent"
+)
+
+// ErrBulkNoTarget is returned when a bulk operation names neither IDs nor filter criteria.
+var ErrBulkNoTarget = errors.New("provide listing IDs or at least one filter criterion")
+
+// BulkListingFilter selects the listings of a bulk operation by their attributes.
+type BulkListingFilter struct {
+	Status        listing.Status `json:"status"`
+	RealtorID     uuid.UUID      `json:"realtor_id"`
+	BrokerageID   uuid.UUID      `json:"brokerage_id"`
+	TeamID        uuid.UUID      `json:"team_id"`
+	City          string         `json:"city"`
+	Country       string         `json:"country"`
+	UpdatedBefore *time.Time     `json:"updated_before"`
+}
+
+// BulkListingOperation describes the change applied to every selected listing.
+type BulkListingOperation struct {
+	Action     string
+	Status     listing.Status
+	RealtorID  uuid.UUID
+	PriceMode  string
+	PriceValue decimal.Decimal
+}
+
+// BulkListingResult reports what happened, or would happen, to one listing.
+type BulkListingResult struct {
+	ID     uuid.UUID      `json:"id"`
+	OK     bool           `json:"ok"`
+	Error  string         `json:"error,omitempty"`
+	Before map[string]any `json:"before,omitempty"`
+	After  map[string]any `json:"after,omitempty"`
+}
+
+// BulkListingReport summarizes a bulk operation. Applied is false for dry runs and for
+// runs in which any listing failed, since the whole operation is rolled back then.
+type BulkListingReport struct {
+	DryRun    bool                `json:"dry_run"`
+	Applied   bool                `json:"applied"`
+	Matched   int                 `json:"matched"`
+	Succeeded int                 `json:"succeeded"`
+	Failed    int                 `json:"failed"`
+	Results   []BulkListingResult `json:"results"`
+	// DocumentStorageIDs are the stored files of the documents of deleted listings, which
+	// the caller removes once the operation is applied
+	DocumentStorageIDs []string `json:"-"`
+}
+
+// predicates turns the filter into listing predicates, failing when it has no criteria.
+func (f BulkListingFilter) predicates() ([]predicate.Listing, error) {
+	var ps []predicate.Listing
+	if f.Status != "" {
+		ps = append(ps, listing.StatusEQ(f.Status))
+	}
+	if f.RealtorID != uuid.Nil {
+		ps = append(ps, listing.RealtorIDEQ(f.RealtorID))
+	}
+	if f.BrokerageID != uuid.Nil {
+		ps = append(ps, listing.HasRealtorWith(realtor.BrokerageIDEQ(f.BrokerageID)))
+	}
+	if f.TeamID != uuid.Nil {
+		ps = append(ps, listing.HasRealtorWith(realtor.TeamIDEQ(f.TeamID)))
+	}
+	if f.City != "" {
+		ps = append(ps, listing.CityEqualFold(f.City))
+	}
+	if f.Country != "" {
+		ps = append(ps, listing.CountryEQ(strings.ToUpper(f.Country)))
+	}
+	if f.UpdatedBefore != nil {
+		ps = append(ps, listing.UpdateTimeLT(*f.UpdatedBefore))
+	}
+	if len(ps) == 0 {
+		return nil, ErrBulkNoTarget
+	}
+	return ps, nil
+}
+
+// BulkUpdateListingsRepo applies one operation to the listings given by ids, or matched by
+// filter when ids is empty, in a single transaction. Every listing is checked and reported
+// on individually; if any of them fails, or dryRun is set, the transaction is rolled back
+// so that either all listings change or none do.
+func BulkUpdateListingsRepo(ctx context.Context, entClient *ent.Client, ids []uuid.UUID, filter *BulkListingFilter, op BulkListingOperation, dryRun bool) (*BulkListingReport, error) {
+	var where []predicate.Listing
+	switch {
+	case len(ids) > 0:
+		// Each listing is changed once even if it is named twice
+		seen := make(map[uuid.UUID]bool, len(ids))
+		unique := ids[:0:0]
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				unique = append(unique, id)
+			}
+		}
+		ids = unique
+		if len(ids) > MaxBulkListings {
+			return nil, fmt.Errorf("at most %d listings can be changed at once", MaxBulkListings)
+		}
+		where = []predicate.Listing{listing.IDIn(ids...)}
+	case filter != nil:
+		ps, err := filter.predicates()
+		if err != nil {
+			return nil, err
+		}
+		where = ps
+	default:
+		return nil, ErrBulkNoTarget
+	}
+
+	if op.Action == BulkReassign {
+		if err := requireActiveRealtor(ctx, entClient, op.RealtorID); err != nil {
+			return nil, fmt.Errorf("cannot reassign listings: %w", err)
+		}
+	}
+
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lock the selected listings so they cannot change while the report is built
+	found, err := tx.Listing.Query().
+		Where(where...).
+		Order(ent.Asc(listing.FieldCreateTime)).
+		Limit(MaxBulkListings + 1).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to load listings: %w", err)
+	}
+	if len(found) > MaxBulkListings {
+		tx.Rollback()
+		return nil, fmt.Errorf("the filter matches more than %d listings", MaxBulkListings)
+	}
+
+	// Report the requested IDs in order, including the ones that do not exist
+	byID := make(map[uuid.UUID]*ent.Listing, len(found))
+	for _, l := range found {
+		byID[l.ID] = l
+	}
+	if len(ids) == 0 {
+		for _, l := range found {
+			ids = append(ids, l.ID)
+		}
+	}
+
+	report := &BulkListingReport{DryRun: dryRun, Matched: len(found)}
+	aborted := false
+	for _, id := range ids {
+		result := BulkListingResult{ID: id}
+		l, ok := byID[id]
+		switch {
+		case aborted:
+			result.Error = "not attempted: an earlier listing failed"
+		case !ok:
+			result.Error = "listing not found"
+		default:
+			if err := applyBulkOperation(ctx, tx, l, op, report, &result); err != nil {
+				result.Error = err.Error()
+				// A failed statement aborts the transaction, so stop here
+				var validation *bulkValidationError
+				aborted = !errors.As(err, &validation)
+			} else {
+				result.OK = true
+			}
+		}
+
+		if result.OK {
+			report.Succeeded++
+		} else {
+			report.Failed++
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	if dryRun || report.Failed > 0 {
+		if err := tx.Rollback(); err != nil {
+			return nil, fmt.Errorf("failed to roll back bulk operation: %w", err)
+		}
+		return report, nil
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+	report.Applied = true
+
+	return report, nil
+}
+
+// bulkValidationError is a failed check on one listing, raised before anything was written.
+type bulkValidationError struct {
+	msg string
+}
+
+func (e *bulkValidationError) Error() string { return e.msg }
+
+// applyBulkOperation applies op to one listing inside the bulk transaction and records the
+// changed fields on result.
+func applyBulkOperation(ctx context.Context, tx *ent.Tx, l *ent.Listing, op BulkListingOperation, report *BulkListingReport, result *BulkListingResult) error {
+	switch op.Action {
+	case BulkSetStatus:
+		result.Before = map[string]any{"status": l.Status}
+		result.After = map[string]any{"status": op.Status}
+		if l.Status == op.Status {
+			return nil
+		}
+		return tx.Listing.UpdateOneID(l.ID).SetStatus(op.Status).Exec(ctx)
+
+	case BulkReassign:
+		result.Before = map[string]any{"realtor_id": l.RealtorID}
+		result.After = map[string]any{"realtor_id": op.RealtorID}
+		if l.RealtorID == op.RealtorID {
+			return nil
+		}
+		return tx.Listing.UpdateOneID(l.ID).SetRealtorID(op.RealtorID).Exec(ctx)
+
+	case BulkAdjustPrice:
+		price := l.Price.Add(op.PriceValue)
+		if op.PriceMode == PriceAdjustPercent {
+			price = l.Price.Mul(decimal.NewFromInt(100).Add(op.PriceValue)).Div(decimal.NewFromInt(100))
+		}
+		price = price.Round(2)
+		result.Before = map[string]any{"price": l.Price}
+		result.After = map[string]any{"price": price}
+		if !price.IsPositive() {
+			return &bulkValidationError{msg: "adjusted price must be positive"}
+		}
+		return tx.Listing.UpdateOneID(l.ID).SetPrice(price).Exec(ctx)
+
+	case BulkDelete:
+		result.Before = map[string]any{"title": l.Title, "status": l.Status}
+		storageIDs, err := deleteListingTx(ctx, tx, l.ID)
+		if err != nil {
+			return err
+		}
+		report.DocumentStorageIDs = append(report.DocumentStorageIDs, storageIDs...)
+		return nil
+
+	default:
+		return &bulkValidationError{msg: "unknown action: " + op.Action}
+	}
+}
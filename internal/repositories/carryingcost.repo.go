@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ErrInvalidCarryingCost is returned for negative or malformed carrying costs.
+var ErrInvalidCarryingCost = errors.New("invalid carrying cost")
+
+// hoaFrequencyMonths is the number of months each HOA payment covers.
+var hoaFrequencyMonths = map[listing.HoaFrequency]int64{
+	listing.HoaFrequencyMONTHLY:   1,
+	listing.HoaFrequencyQUARTERLY: 3,
+	listing.HoaFrequencyANNUALLY:  12,
+}
+
+// validateCarryingCosts checks that the carrying costs of a listing are non-negative
+// amounts with at most two decimal places, and that HOA dues come with their frequency.
+func validateCarryingCosts(data *ent.Listing) error {
+	amounts := []struct {
+		name  string
+		value *decimal.Decimal
+	}{
+		{"annual_property_tax", data.AnnualPropertyTax},
+		{"hoa_dues", data.HoaDues},
+		{"special_assessments", data.SpecialAssessments},
+		{"monthly_utilities", data.MonthlyUtilities},
+	}
+	for _, a := range amounts {
+		switch {
+		case a.value == nil:
+		case a.value.IsNegative():
+			return fmt.Errorf("%w: %s must not be negative", ErrInvalidCarryingCost, a.name)
+		case !a.value.Equal(a.value.Round(2)):
+			return fmt.Errorf("%w: %s must have at most two decimal places", ErrInvalidCarryingCost, a.name)
+		}
+	}
+
+	if (data.HoaDues == nil) != (data.HoaFrequency == nil) {
+		return fmt.Errorf("%w: hoa_dues and hoa_frequency must be given together", ErrInvalidCarryingCost)
+	}
+	if data.HoaFrequency != nil {
+		if err := listing.HoaFrequencyValidator(*data.HoaFrequency); err != nil {
+			return fmt.Errorf("%w: hoa_frequency must be one of MONTHLY, QUARTERLY, ANNUALLY", ErrInvalidCarryingCost)
+		}
+	}
+	return nil
+}
+
+// monthlyCarryingCost estimates what owning a listing costs per month on top of its
+// price: property tax, HOA dues, special assessments and utilities. It is nil when none
+// of them are known.
+func monthlyCarryingCost(l *ent.Listing) *decimal.Decimal {
+	if l.AnnualPropertyTax == nil && l.HoaDues == nil && l.SpecialAssessments == nil && l.MonthlyUtilities == nil {
+		return nil
+	}
+
+	months := decimal.NewFromInt(12)
+	total := decimal.Zero
+	if l.AnnualPropertyTax != nil {
+		total = total.Add(l.AnnualPropertyTax.Div(months))
+	}
+	if l.HoaDues != nil && l.HoaFrequency != nil {
+		total = total.Add(l.HoaDues.Div(decimal.NewFromInt(hoaFrequencyMonths[*l.HoaFrequency])))
+	}
+	if l.SpecialAssessments != nil {
+		total = total.Add(l.SpecialAssessments.Div(months))
+	}
+	if l.MonthlyUtilities != nil {
+		total = total.Add(*l.MonthlyUtilities)
+	}
+	total = total.Round(2)
+	return &total
+}
+
+// maxMonthlyHOADuesPredicate keeps the listings whose HOA dues come to at most max a
+// month, counting listings without dues as paying none. With a non-nil rate, max is in
+// the currency of that rate and dues are compared in the base currency.
+func maxMonthlyHOADuesPredicate(max decimal.Decimal, rate *decimal.Decimal) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			monthly := func(b *sql.Builder) {
+				b.WriteString("COALESCE(").
+					Ident(s.C(listing.FieldHoaDues)).
+					WriteString(" / CASE ").
+					Ident(s.C(listing.FieldHoaFrequency)).
+					WriteString(" WHEN 'QUARTERLY' THEN 3 WHEN 'ANNUALLY' THEN 12 ELSE 1 END, 0)")
+			}
+			if rate == nil {
+				monthly(b)
+				b.WriteString(" <= ").Arg(max)
+				return
+			}
+			writeAmountInBase(b, s, monthly)
+			b.WriteString(" <= ").Arg(max.Mul(*rate))
+		}))
+	})
+}
+
+// sameDecimal reports whether two optional amounts are both unset or equal.
+func sameDecimal(a, b *decimal.Decimal) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// sameValue reports whether two optional values are both unset or equal.
+func sameValue[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
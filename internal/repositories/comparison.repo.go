@@ -0,0 +1,200 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+)
+
+// MaxComparedListings is the maximum number of listings that can be compared at once.
+const MaxComparedListings = 4
+
+// ErrListingNotComparable is returned when a requested listing does not exist or is not published.
+var ErrListingNotComparable = errors.New("listing not found or not published")
+
+// ComparisonRow holds one attribute of the compared listings. Values are aligned with
+// ListingComparison.Listings; Best and Worst name the listings that rank first and last
+// on the attribute and are omitted when the attribute does not differ.
+type ComparisonRow struct {
+	Attribute string      `json:"attribute"`
+	Values    []any       `json:"values"`
+	Best      []uuid.UUID `json:"best,omitempty"`
+	Worst     []uuid.UUID `json:"worst,omitempty"`
+}
+
+// ListingComparison is a side-by-side view of several listings.
+// Prices in the rows are in Currency, the currency of the first listing.
+type ListingComparison struct {
+	Listings []*ent.Listing  `json:"listings"`
+	Currency string          `json:"currency"`
+	Rows     []ComparisonRow `json:"rows"`
+}
+
+// comparedAttribute describes how to extract and rank one row of the comparison.
+// value returns false when the listing has no value for the attribute.
+type comparedAttribute struct {
+	name         string
+	higherBetter bool
+	value        func(l *ent.Listing) (float64, bool)
+}
+
+var comparedAttributes = []comparedAttribute{
+	{"price", false, func(l *ent.Listing) (float64, bool) { return l.Price.InexactFloat64(), true }},
+	{"price_per_sqft", false, func(l *ent.Listing) (float64, bool) {
+		return l.Price.InexactFloat64() / float64(l.Sqft), l.Sqft > 0
+	}},
+	{"sqft", true, func(l *ent.Listing) (float64, bool) { return float64(l.Sqft), true }},
+	{"bedroom", true, func(l *ent.Listing) (float64, bool) { return float64(l.Bedroom), true }},
+	{"bathroom", true, func(l *ent.Listing) (float64, bool) { return l.Bathroom, true }},
+	{"garage", true, func(l *ent.Listing) (float64, bool) { return float64(l.Garage), true }},
+	{"lot_size", true, func(l *ent.Listing) (float64, bool) { return float64(l.LotSize), l.LotSize > 0 }},
+	{"lot_to_sqft_ratio", true, func(l *ent.Listing) (float64, bool) {
+		return float64(l.LotSize) / float64(l.Sqft), l.LotSize > 0 && l.Sqft > 0
+	}},
+	{"estimated_monthly_cost", false, func(l *ent.Listing) (float64, bool) {
+		cost := monthlyCarryingCost(l)
+		if cost == nil {
+			return 0, false
+		}
+		return cost.InexactFloat64(), true
+	}},
+	{"year_built", true, func(l *ent.Listing) (float64, bool) { return float64(l.YearBuilt), true }},
+	{"age", false, func(l *ent.Listing) (float64, bool) {
+		return float64(time.Now().Year() - l.YearBuilt), true
+	}},
+}
+
+// CompareListingsRepo loads the given published listings and lines them up attribute by
+// attribute, including derived metrics such as price per sqft, estimated monthly carrying
+// cost, age and lot-to-sqft ratio.
+// Listings are returned in the order of ids. If any ID is unknown or not published, it
+// returns an error wrapping ErrListingNotComparable that names the offending IDs.
+func CompareListingsRepo(entClient *ent.Client, ids []uuid.UUID) (*ListingComparison, error) {
+	ctx := context.Background()
+
+	found, err := entClient.Listing.Query().
+		Where(listing.IDIn(ids...), listing.StatusEQ(listing.StatusPUBLISHED)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get listings: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]*ent.Listing, len(found))
+	for _, l := range found {
+		byID[l.ID] = l
+	}
+
+	listings := make([]*ent.Listing, 0, len(ids))
+	var missing []string
+	for _, id := range ids {
+		l, ok := byID[id]
+		if !ok {
+			missing = append(missing, id.String())
+			continue
+		}
+		listings = append(listings, l)
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrListingNotComparable, strings.Join(missing, ", "))
+	}
+
+	// Rank prices in one currency; the listings themselves keep their own
+	currency := listings[0].Currency
+	currencies := make([]string, 0, len(listings))
+	for _, l := range listings {
+		currencies = append(currencies, l.Currency)
+	}
+	rates, err := exchangeRates(ctx, entClient, currencies...)
+	if err != nil {
+		return nil, err
+	}
+	ranked := make([]*ent.Listing, len(listings))
+	for i, l := range listings {
+		priced := *l
+		// Carrying costs are in the listing's currency too
+		rate := rates[l.Currency].Div(rates[currency])
+		priced.Price = l.Price.Mul(rate).Round(2)
+		for _, amount := range []**decimal.Decimal{&priced.AnnualPropertyTax, &priced.HoaDues, &priced.SpecialAssessments, &priced.MonthlyUtilities} {
+			if *amount != nil {
+				scaled := (*amount).Mul(rate)
+				*amount = &scaled
+			}
+		}
+		ranked[i] = &priced
+	}
+
+	comparison := &ListingComparison{Listings: listings, Currency: currency}
+	for _, attr := range comparedAttributes {
+		comparison.Rows = append(comparison.Rows, compareAttribute(ranked, attr))
+	}
+	comparison.Rows = append(comparison.Rows, ComparisonRow{
+		Attribute: "pool",
+		Values:    poolValues(listings),
+	})
+
+	return comparison, nil
+}
+
+// compareAttribute builds one comparison row and flags the best and worst listings.
+// Listings without a value are shown as null and are not ranked.
+func compareAttribute(listings []*ent.Listing, attr comparedAttribute) ComparisonRow {
+	row := ComparisonRow{Attribute: attr.name, Values: make([]any, len(listings))}
+
+	var best, worst float64
+	ranked := 0
+	for i, l := range listings {
+		v, ok := attr.value(l)
+		if !ok {
+			continue
+		}
+		row.Values[i] = v
+		if ranked == 0 || better(v, best, attr.higherBetter) {
+			best = v
+		}
+		if ranked == 0 || better(worst, v, attr.higherBetter) {
+			worst = v
+		}
+		ranked++
+	}
+
+	// Nothing to flag if fewer than two listings have a value or they are all equal
+	if ranked < 2 || best == worst {
+		return row
+	}
+
+	for i, l := range listings {
+		v, ok := row.Values[i].(float64)
+		if !ok {
+			continue
+		}
+		if v == best {
+			row.Best = append(row.Best, l.ID)
+		}
+		if v == worst {
+			row.Worst = append(row.Worst, l.ID)
+		}
+	}
+	return row
+}
+
+func better(a, b float64, higherBetter bool) bool {
+	if higherBetter {
+		return a > b
+	}
+	return a < b
+}
+
+func poolValues(listings []*ent.Listing) []any {
+	values := make([]any, len(listings))
+	for i, l := range listings {
+		values[i] = l.Pool
+	}
+	return values
+}
@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+)
+
+func TestCompareAttribute(t *testing.T) {
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New(), uuid.New()}
+	listings := make([]*ent.Listing, len(ids))
+	for i, id := range ids {
+		listings[i] = &ent.Listing{ID: id}
+	}
+	attr := func(higherBetter bool, values ...any) comparedAttribute {
+		return comparedAttribute{name: "attr", higherBetter: higherBetter, value: func(l *ent.Listing) (float64, bool) {
+			v, ok := values[slices.Index(ids, l.ID)].(float64)
+			return v, ok
+		}}
+	}
+
+	tests := []struct {
+		name      string
+		attr      comparedAttribute
+		wantBest  []uuid.UUID
+		wantWorst []uuid.UUID
+	}{
+		{
+			name:      "lower is better",
+			attr:      attr(false, 300.0, 100.0, 200.0, 300.0),
+			wantBest:  []uuid.UUID{ids[1]},
+			wantWorst: []uuid.UUID{ids[0], ids[3]},
+		},
+		{
+			name:      "higher is better",
+			attr:      attr(true, 3.0, 1.0, 2.0, 3.0),
+			wantBest:  []uuid.UUID{ids[0], ids[3]},
+			wantWorst: []uuid.UUID{ids[1]},
+		},
+		{
+			name:      "missing values are not ranked",
+			attr:      attr(true, nil, 1.0, nil, 2.0),
+			wantBest:  []uuid.UUID{ids[3]},
+			wantWorst: []uuid.UUID{ids[1]},
+		},
+		{
+			name: "all equal",
+			attr: attr(true, 2.0, 2.0, 2.0, 2.0),
+		},
+		{
+			name: "single value",
+			attr: attr(true, nil, nil, 5.0, nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := compareAttribute(listings, tt.attr)
+			if !slices.Equal(row.Best, tt.wantBest) {
+				t.Errorf("Best = %v, want %v", row.Best, tt.wantBest)
+			}
+			if !slices.Equal(row.Worst, tt.wantWorst) {
+				t.Errorf("Worst = %v, want %v", row.Worst, tt.wantWorst)
+			}
+			for i, v := range row.Values {
+				if want, ok := tt.attr.value(listings[i]); ok != (v != nil) || (ok && v != want) {
+					t.Errorf("Values[%d] = %v, want %v", i, v, want)
+				}
+			}
+		})
+	}
+}
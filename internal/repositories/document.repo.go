@@ -0,0 +1,202 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/rule"
+	"ppgroup.ppgroup.com/internal/viewer"
+)
+
+// RequireDocumentManagerRepo fails unless the viewer may add and remove the documents of
+// a listing, which staff, the listing's realtor and the lead of that realtor's team can
+// do. It lets callers refuse an upload before storing the file.
+func RequireDocumentManagerRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID) error {
+	return requireListingManager(ctx, entClient, listingID)
+}
+
+// CreateListingDocumentRepo stores the metadata of an uploaded listing document.
+func CreateListingDocumentRepo(ctx context.Context, entClient *ent.Client, data *ent.ListingDocument) (*ent.ListingDocument, error) {
+	if err := requireListingManager(ctx, entClient, data.ListingID); err != nil {
+		return nil, err
+	}
+
+	doc, err := entClient.ListingDocument.Create().
+		SetListingID(data.ListingID).
+		SetTitle(data.Title).
+		SetKind(data.Kind).
+		SetAccessLevel(data.AccessLevel).
+		SetFilename(data.Filename).
+		SetSizeBytes(data.SizeBytes).
+		SetStorageID(data.StorageID).
+		SetStorageFormat(data.StorageFormat).
+		SetUploadedBy(data.UploadedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create document: %w", err)
+	}
+
+	return doc, nil
+}
+
+// GetListingDocumentsRepo retrieves the documents of a listing, newest first.
+func GetListingDocumentsRepo(entClient *ent.Client, listingID uuid.UUID) ([]*ent.ListingDocument, error) {
+	ctx := context.Background()
+
+	docs, err := entClient.ListingDocument.Query().
+		Where(listingdocument.ListingIDEQ(listingID)).
+		Order(ent.Desc(listingdocument.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+
+	return docs, nil
+}
+
+// GetListingDocumentRepo retrieves one document by ID.
+func GetListingDocumentRepo(entClient *ent.Client, documentID uuid.UUID) (*ent.ListingDocument, error) {
+	ctx := context.Background()
+
+	doc, err := entClient.ListingDocument.Get(ctx, documentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("document not found")
+		}
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	return doc, nil
+}
+
+// CanAccessDocumentRepo reports whether a caller may download a document. user is nil for
+// anonymous callers. Staff and the realtors who manage the document's listing can access
+// every document; approved inquirers are users whose inquiry about the listing was approved.
+func CanAccessDocumentRepo(entClient *ent.Client, doc *ent.ListingDocument, user *ent.User) (bool, error) {
+	ctx := context.Background()
+
+	if doc.AccessLevel == listingdocument.AccessLevelPublic {
+		return true, nil
+	}
+	if user == nil || !user.IsActive {
+		return false, nil
+	}
+	if user.IsStaff {
+		return true, nil
+	}
+
+	realtorID, ledTeamIDs, err := GetUserRealtorRepo(ctx, entClient, user.ID)
+	if err != nil {
+		return false, err
+	}
+	if realtorID != nil {
+		v := &viewer.Viewer{UserID: user.ID, RealtorID: realtorID, LedTeamIDs: ledTeamIDs}
+		managed, err := entClient.Listing.Query().
+			Where(listing.ID(doc.ListingID), rule.ManagedListings(v)).
+			Exist(ctx)
+		if err != nil || managed {
+			return managed, err
+		}
+	}
+
+	switch doc.AccessLevel {
+	case listingdocument.AccessLevelSignedIn:
+		return true, nil
+	case listingdocument.AccessLevelApprovedInquirer:
+		return entClient.ListingInquiry.Query().
+			Where(
+				listinginquiry.ListingIDEQ(doc.ListingID),
+				listinginquiry.UserIDEQ(user.ID),
+				listinginquiry.StatusEQ(listinginquiry.StatusAPPROVED),
+			).
+			Exist(ctx)
+	default:
+		return false, nil
+	}
+}
+
+// RecordDocumentDownloadRepo appends an entry to a document's download audit log.
+func RecordDocumentDownloadRepo(entClient *ent.Client, documentID uuid.UUID, email, ipAddress, userAgent string) error {
+	ctx := context.Background()
+
+	if len(userAgent) > 512 {
+		userAgent = userAgent[:512]
+	}
+
+	_, err := entClient.DocumentDownload.Create().
+		SetDocumentID(documentID).
+		SetUserEmail(email).
+		SetIPAddress(ipAddress).
+		SetUserAgent(userAgent).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record document download: %w", err)
+	}
+
+	return nil
+}
+
+// GetDocumentDownloadsRepo retrieves the download audit log of a document, newest first.
+func GetDocumentDownloadsRepo(entClient *ent.Client, documentID uuid.UUID) ([]*ent.DocumentDownload, error) {
+	ctx := context.Background()
+
+	downloads, err := entClient.DocumentDownload.Query().
+		Where(documentdownload.DocumentIDEQ(documentID)).
+		Order(ent.Desc(documentdownload.FieldDownloadedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document downloads: %w", err)
+	}
+
+	return downloads, nil
+}
+
+// DeleteListingDocumentRepo deletes a document of a listing together with its download log
+// and returns it so the caller can remove the stored file.
+func DeleteListingDocumentRepo(ctx context.Context, entClient *ent.Client, listingID, documentID uuid.UUID) (*ent.ListingDocument, error) {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := tx.ListingDocument.Get(ctx, documentID)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, errors.New("document not found")
+		}
+		return nil, err
+	}
+	if doc.ListingID != listingID {
+		tx.Rollback()
+		return nil, errors.New("document not found")
+	}
+	if err := requireListingManager(ctx, tx.Client(), listingID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if _, err := tx.DocumentDownload.Delete().Where(documentdownload.DocumentIDEQ(documentID)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete document downloads: %w", err)
+	}
+	if err := tx.ListingDocument.DeleteOneID(documentID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete document: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	return doc, nil
+}
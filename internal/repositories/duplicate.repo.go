@@ -0,0 +1,303 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/property"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// Reasons a listing is suspected to duplicate another one.
+const (
+	// DuplicateExact means both listings normalize to the same street, unit and ZIP code.
+	DuplicateExact = "exact"
+	// DuplicateSameBuilding means the streets match but the units differ or one has no unit.
+	DuplicateSameBuilding = "same_building"
+	// DuplicateSimilar means the house numbers match and the streets are nearly identical.
+	DuplicateSimilar = "similar"
+)
+
+// duplicateSimilarityThreshold is the street similarity from which two addresses with the
+// same house number are reported as possible duplicates.
+const duplicateSimilarityThreshold = 0.8
+
+// duplicateStrength ranks the duplicate reasons from weakest to strongest.
+var duplicateStrength = map[string]int{DuplicateSimilar: 1, DuplicateSameBuilding: 2, DuplicateExact: 3}
+
+// ErrDuplicateListing is returned when an active listing already exists for the same address.
+var ErrDuplicateListing = errors.New("an active listing for this address already exists")
+
+// DuplicateMatch is an active listing that looks like a duplicate of another address.
+type DuplicateMatch struct {
+	ListingID uuid.UUID      `json:"listing_id"`
+	Title     string         `json:"title"`
+	Address   string         `json:"address"`
+	ZipCode   string         `json:"zip_code"`
+	Status    listing.Status `json:"status"`
+	Reason    string         `json:"reason"`
+}
+
+// DuplicateCluster is a group of active listings that all appear to describe the same place.
+type DuplicateCluster struct {
+	ZipCode  string           `json:"zip_code"`
+	City     string           `json:"city"`
+	Country  string           `json:"country"`
+	Listings []DuplicateMatch `json:"listings"`
+}
+
+// addressKeyMutation is implemented by the mutations of entities that store an address key.
+type addressKeyMutation interface {
+	ent.Mutation
+	Address() (string, bool)
+	ZipCode() (string, bool)
+	OldAddress(context.Context) (string, error)
+	OldZipCode(context.Context) (string, error)
+	SetAddressKey(string)
+}
+
+// AddressKeyHook keeps the normalized address key of listings and properties in sync with
+// their address and ZIP code.
+func AddressKeyHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			am, ok := m.(addressKeyMutation)
+			if !ok || !m.Op().Is(ent.OpCreate|ent.OpUpdateOne) {
+				return next.Mutate(ctx, m)
+			}
+
+			address, addressSet := am.Address()
+			zipCode, zipSet := am.ZipCode()
+			if !addressSet && !zipSet {
+				return next.Mutate(ctx, m)
+			}
+
+			if m.Op().Is(ent.OpUpdateOne) {
+				var err error
+				if !addressSet {
+					if address, err = am.OldAddress(ctx); err != nil {
+						return nil, err
+					}
+				}
+				if !zipSet {
+					if zipCode, err = am.OldZipCode(ctx); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			am.SetAddressKey(services.NormalizeAddress(address, zipCode).Key())
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// classifyDuplicate returns why two addresses look like duplicates, or "" if they do not.
+func classifyDuplicate(a, b services.NormalizedAddress) string {
+	switch {
+	case a.Zip != b.Zip:
+		return ""
+	case a.Key() == b.Key():
+		return DuplicateExact
+	case a.Street == b.Street:
+		return DuplicateSameBuilding
+	case a.HouseNumber() == b.HouseNumber() && services.AddressSimilarity(a.Street, b.Street) >= duplicateSimilarityThreshold:
+		return DuplicateSimilar
+	default:
+		return ""
+	}
+}
+
+// findDuplicateListings returns the active listings in the same ZIP code, city and country
+// whose address looks like a duplicate of the given listing's. Postal codes repeat across
+// countries, so listings elsewhere are never matched. excludeID skips the listing being
+// updated.
+func findDuplicateListings(ctx context.Context, entClient *ent.Client, data *ent.Listing, excludeID uuid.UUID) ([]DuplicateMatch, error) {
+	candidates, err := entClient.Listing.Query().
+		Where(
+			listing.ZipCodeEQ(data.ZipCode),
+			listing.CityEqualFold(data.City),
+			listing.CountryEQ(data.Country),
+			listing.StatusIn(activeListingStatuses...),
+			listing.IDNEQ(excludeID),
+		).
+		Select(listing.FieldID, listing.FieldTitle, listing.FieldAddress, listing.FieldZipCode, listing.FieldStatus).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up duplicate listings: %w", err)
+	}
+
+	target := services.NormalizeAddress(data.Address, data.ZipCode)
+	matches := []DuplicateMatch{}
+	for _, l := range candidates {
+		if reason := classifyDuplicate(target, services.NormalizeAddress(l.Address, l.ZipCode)); reason != "" {
+			matches = append(matches, DuplicateMatch{
+				ListingID: l.ID,
+				Title:     l.Title,
+				Address:   l.Address,
+				ZipCode:   l.ZipCode,
+				Status:    l.Status,
+				Reason:    reason,
+			})
+		}
+	}
+
+	return matches, nil
+}
+
+// checkDuplicateListings fails with ErrDuplicateListing when an exact duplicate exists and
+// otherwise returns the near matches so the caller can warn about them.
+func checkDuplicateListings(ctx context.Context, entClient *ent.Client, data *ent.Listing, excludeID uuid.UUID) ([]DuplicateMatch, error) {
+	matches, err := findDuplicateListings(ctx, entClient, data, excludeID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		if m.Reason == DuplicateExact {
+			return nil, fmt.Errorf("%w: %s (%s)", ErrDuplicateListing, m.Address, m.ListingID)
+		}
+	}
+	return matches, nil
+}
+
+// GetDuplicateListingClustersRepo groups the active listings that appear to describe the
+// same place. Listings are compared within their ZIP code, city and country, and a listing
+// joins a cluster when it looks like a duplicate of any listing already in it.
+func GetDuplicateListingClustersRepo(entClient *ent.Client) ([]DuplicateCluster, error) {
+	ctx := context.Background()
+
+	listings, err := entClient.Listing.Query().
+		Where(listing.StatusIn(activeListingStatuses...)).
+		Select(listing.FieldID, listing.FieldTitle, listing.FieldAddress, listing.FieldZipCode,
+			listing.FieldCity, listing.FieldCountry, listing.FieldStatus).
+		Order(ent.Asc(listing.FieldZipCode), ent.Asc(listing.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get listings: %w", err)
+	}
+
+	// Postal codes repeat across countries, so listings are grouped by place
+	type place struct{ zipCode, city, country string }
+	byPlace := map[place][]*ent.Listing{}
+	for _, l := range listings {
+		p := place{l.ZipCode, strings.ToLower(l.City), l.Country}
+		byPlace[p] = append(byPlace[p], l)
+	}
+
+	clusters := []DuplicateCluster{}
+	for _, group := range byPlace {
+		normalized := make([]services.NormalizedAddress, len(group))
+		for i, l := range group {
+			normalized[i] = services.NormalizeAddress(l.Address, l.ZipCode)
+		}
+
+		// Union-find over the pairs that look like duplicates
+		parent := make([]int, len(group))
+		for i := range parent {
+			parent[i] = i
+		}
+		var find func(int) int
+		find = func(i int) int {
+			if parent[i] != i {
+				parent[i] = find(parent[i])
+			}
+			return parent[i]
+		}
+		// reasons keeps the strongest reason each listing was matched with
+		reasons := make([]string, len(group))
+		for i := range group {
+			for j := i + 1; j < len(group); j++ {
+				reason := classifyDuplicate(normalized[i], normalized[j])
+				if reason == "" {
+					continue
+				}
+				parent[find(j)] = find(i)
+				for _, k := range []int{i, j} {
+					if duplicateStrength[reason] > duplicateStrength[reasons[k]] {
+						reasons[k] = reason
+					}
+				}
+			}
+		}
+
+		members := map[int][]DuplicateMatch{}
+		for i, l := range group {
+			if reasons[i] == "" {
+				continue
+			}
+			root := find(i)
+			members[root] = append(members[root], DuplicateMatch{
+				ListingID: l.ID,
+				Title:     l.Title,
+				Address:   l.Address,
+				ZipCode:   l.ZipCode,
+				Status:    l.Status,
+				Reason:    reasons[i],
+			})
+		}
+		first := group[0]
+		for _, m := range members {
+			clusters = append(clusters, DuplicateCluster{
+				ZipCode:  first.ZipCode,
+				City:     first.City,
+				Country:  first.Country,
+				Listings: m,
+			})
+		}
+	}
+
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Country != clusters[j].Country {
+			return clusters[i].Country < clusters[j].Country
+		}
+		if clusters[i].ZipCode != clusters[j].ZipCode {
+			return clusters[i].ZipCode < clusters[j].ZipCode
+		}
+		if clusters[i].City != clusters[j].City {
+			return clusters[i].City < clusters[j].City
+		}
+		return clusters[i].Listings[0].Address < clusters[j].Listings[0].Address
+	})
+
+	return clusters, nil
+}
+
+// BackfillAddressKeysRepo stores the normalized address key of listings and properties
+// created before address normalization existed. It is safe to run on every start-up.
+func BackfillAddressKeysRepo(entClient *ent.Client) error {
+	ctx := systemContext()
+
+	listings, err := entClient.Listing.Query().
+		Where(listing.Or(listing.AddressKeyIsNil(), listing.AddressKeyEQ(""))).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load listings without address key: %w", err)
+	}
+	for _, l := range listings {
+		key := services.NormalizeAddress(l.Address, l.ZipCode).Key()
+		if err := entClient.Listing.UpdateOneID(l.ID).SetAddressKey(key).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to set address key of listing %s: %w", l.ID, err)
+		}
+	}
+
+	properties, err := entClient.Property.Query().
+		Where(property.Or(property.AddressKeyIsNil(), property.AddressKeyEQ(""))).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load properties without address key: %w", err)
+	}
+	for _, p := range properties {
+		key := services.NormalizeAddress(p.Address, p.ZipCode).Key()
+		if err := entClient.Property.UpdateOneID(p.ID).SetAddressKey(key).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to set address key of property %s: %w", p.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/exchangerate"
+	"ppgroup.ppgroup.com/ent/listing"
+)
+
+var (
+	// ErrUnknownCurrency is returned for currencies missing from the exchange-rate table.
+	ErrUnknownCurrency = errors.New("unknown currency")
+	// ErrCurrencyInUse is returned when removing the rate of a currency listings are priced in.
+	ErrCurrencyInUse = errors.New("currency in use")
+)
+
+// EnsureBaseCurrencyRepo makes sure the base currency is in the exchange-rate table with
+// a rate of 1, so prices in every listed currency can be converted through it.
+func EnsureBaseCurrencyRepo(entClient *ent.Client, base string) error {
+	ctx := systemContext()
+
+	err := entClient.ExchangeRate.Create().
+		SetCurrency(strings.ToUpper(base)).
+		SetRate(decimal.NewFromInt(1)).
+		OnConflictColumns(exchangerate.FieldCurrency).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to store base currency: %w", err)
+	}
+
+	return nil
+}
+
+// GetExchangeRatesRepo retrieves the exchange-rate table ordered by currency.
+func GetExchangeRatesRepo(entClient *ent.Client) ([]*ent.ExchangeRate, error) {
+	ctx := context.Background()
+
+	rates, err := entClient.ExchangeRate.Query().
+		Order(ent.Asc(exchangerate.FieldCurrency)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rates: %w", err)
+	}
+
+	return rates, nil
+}
+
+// SetExchangeRateRepo creates or updates the rate of a currency against the base currency.
+// The base currency's own rate cannot be changed.
+func SetExchangeRateRepo(ctx context.Context, entClient *ent.Client, base, currency string, rate decimal.Decimal) (*ent.ExchangeRate, error) {
+	currency = strings.ToUpper(currency)
+	if currency == strings.ToUpper(base) {
+		return nil, fmt.Errorf("the rate of the base currency %s is always 1", currency)
+	}
+	if !rate.IsPositive() {
+		return nil, errors.New("rate must be positive")
+	}
+
+	id, err := entClient.ExchangeRate.Create().
+		SetCurrency(currency).
+		SetRate(rate).
+		OnConflictColumns(exchangerate.FieldCurrency).
+		UpdateNewValues().
+		ID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set exchange rate: %w", err)
+	}
+
+	return entClient.ExchangeRate.Get(ctx, id)
+}
+
+// DeleteExchangeRateRepo removes a currency from the exchange-rate table. Currencies still
+// used by listings and the base currency cannot be removed, since every listing's price
+// must stay convertible.
+func DeleteExchangeRateRepo(ctx context.Context, entClient *ent.Client, base, currency string) error {
+	currency = strings.ToUpper(currency)
+	if currency == strings.ToUpper(base) {
+		return errors.New("the base currency cannot be removed")
+	}
+
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Lock the rate so no listing takes up the currency before it is gone, see lockExchangeRate
+	exists, err := tx.ExchangeRate.Query().
+		Where(exchangerate.CurrencyEQ(currency)).
+		ForUpdate().
+		Exist(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if !exists {
+		tx.Rollback()
+		return fmt.Errorf("%w: %s", ErrUnknownCurrency, currency)
+	}
+
+	inUse, err := tx.Listing.Query().Where(listing.CurrencyEQ(currency)).Exist(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if inUse {
+		tx.Rollback()
+		return fmt.Errorf("%w: %s is used by listings", ErrCurrencyInUse, currency)
+	}
+
+	if _, err := tx.ExchangeRate.Delete().Where(exchangerate.CurrencyEQ(currency)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete exchange rate: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
+
+// lockExchangeRate checks that a listing can be priced in currency and keeps its rate
+// from being deleted until the client's transaction ends, so every listing's price stays
+// convertible and no listing drops out of converted price filters or market statistics.
+func lockExchangeRate(ctx context.Context, client *ent.Client, currency string) error {
+	exists, err := client.ExchangeRate.Query().
+		Where(exchangerate.CurrencyEQ(currency)).
+		ForShare().
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get exchange rate: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrUnknownCurrency, currency)
+	}
+	return nil
+}
+
+// exchangeRates loads the rates of the given currencies keyed by currency code.
+func exchangeRates(ctx context.Context, entClient *ent.Client, currencies ...string) (map[string]decimal.Decimal, error) {
+	rates, err := entClient.ExchangeRate.Query().
+		Where(exchangerate.CurrencyIn(currencies...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange rates: %w", err)
+	}
+
+	byCurrency := make(map[string]decimal.Decimal, len(rates))
+	for _, r := range rates {
+		byCurrency[r.Currency] = r.Rate
+	}
+	for _, c := range currencies {
+		if _, ok := byCurrency[c]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCurrency, c)
+		}
+	}
+
+	return byCurrency, nil
+}
+
+// ConvertListingPricesRepo converts the prices of listings into the given currency, keyed
+// by listing ID.
+func ConvertListingPricesRepo(entClient *ent.Client, listings []*ent.Listing, currency string) (map[uuid.UUID]decimal.Decimal, error) {
+	ctx := context.Background()
+
+	currency = strings.ToUpper(currency)
+	currencies := []string{currency}
+	for _, l := range listings {
+		currencies = append(currencies, l.Currency)
+	}
+
+	rates, err := exchangeRates(ctx, entClient, currencies...)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make(map[uuid.UUID]decimal.Decimal, len(listings))
+	for _, l := range listings {
+		converted[l.ID] = l.Price.Mul(rates[l.Currency]).Div(rates[currency]).Round(2)
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,236 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/hook"
+	"ppgroup.ppgroup.com/ent/listing"
+)
+
+// ListingExpiryHook gives every listing that gets published an expiry date term from now,
+// unless the mutation sets expires_at itself or the listing still has time left on its term.
+// Status changes other than archiving by expiry clear the listing's expired flag.
+func ListingExpiryHook(term time.Duration) ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.ListingFunc(func(ctx context.Context, m *ent.ListingMutation) (ent.Value, error) {
+			status, statusSet := m.Status()
+			if _, expiredSet := m.Expired(); statusSet && !expiredSet && m.Op().Is(ent.OpUpdateOne) {
+				m.SetExpired(false)
+			}
+			_, expirySet := m.ExpiresAt()
+			if !statusSet || status != listing.StatusPUBLISHED || expirySet {
+				return next.Mutate(ctx, m)
+			}
+
+			now := time.Now()
+			if m.Op().Is(ent.OpUpdateOne) {
+				old, err := m.OldExpiresAt(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if old != nil && old.After(now) {
+					return next.Mutate(ctx, m)
+				}
+				m.ClearExpiryReminderSentAt()
+			}
+
+			m.SetExpiresAt(now.Add(term))
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdateOne)
+}
+
+// AssignMissingExpiryRepo sets an expiry date on published listings that do not have one,
+// such as listings published before expiration existed or through bulk updates.
+func AssignMissingExpiryRepo(entClient *ent.Client, term time.Duration) (int, error) {
+	ctx := systemContext()
+
+	n, err := entClient.Listing.Update().
+		Where(listing.StatusEQ(listing.StatusPUBLISHED), listing.ExpiresAtIsNil()).
+		SetExpiresAt(time.Now().Add(term)).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to assign listing expiry: %w", err)
+	}
+
+	return n, nil
+}
+
+// ArchiveExpiredListingsRepo moves every published listing whose term has ended to ARCHIVED
+// and notifies its realtor. Each listing is archived with a conditional update, so when
+// several replicas run the job only the one that archives a listing notifies about it.
+func ArchiveExpiredListingsRepo(entClient *ent.Client) (int, error) {
+	ctx := systemContext()
+
+	expired, err := entClient.Listing.Query().
+		Where(listing.StatusEQ(listing.StatusPUBLISHED), listing.ExpiresAtLTE(time.Now())).
+		WithRealtor().
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get expired listings: %w", err)
+	}
+
+	archived := 0
+	for _, l := range expired {
+		err := entClient.Listing.UpdateOneID(l.ID).
+			Where(listing.StatusEQ(listing.StatusPUBLISHED)).
+			SetStatus(listing.StatusARCHIVED).
+			SetExpired(true).
+			Exec(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return archived, fmt.Errorf("failed to archive listing %s: %w", l.ID, err)
+		}
+		archived++
+
+		if l.Edges.Realtor == nil {
+			continue
+		}
+		err = NotifyRepo(ctx, entClient, RealtorRecipient(l.Edges.Realtor), NotificationListingExpired,
+			fmt.Sprintf("Your listing %q has expired", l.Title),
+			fmt.Sprintf("The listing at %s expired on %s and was archived. Renew it to publish it again.",
+				l.Address, l.ExpiresAt.Format(time.DateOnly)))
+		if err != nil {
+			log.Printf("Failed to notify realtor about expired listing %s: %v", l.ID, err)
+		}
+	}
+
+	return archived, nil
+}
+
+// SendExpiryRemindersRepo notifies realtors about published listings that expire within
+// the given window. Each listing is reminded at most once per term.
+func SendExpiryRemindersRepo(entClient *ent.Client, within time.Duration) (int, error) {
+	ctx := systemContext()
+	now := time.Now()
+
+	expiring, err := entClient.Listing.Query().
+		Where(
+			listing.StatusEQ(listing.StatusPUBLISHED),
+			listing.ExpiresAtGT(now),
+			listing.ExpiresAtLTE(now.Add(within)),
+			listing.ExpiryReminderSentAtIsNil(),
+		).
+		WithRealtor().
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get expiring listings: %w", err)
+	}
+
+	sent := 0
+	for _, l := range expiring {
+		// Claim the reminder first so that concurrent runs do not send it twice
+		err := entClient.Listing.UpdateOneID(l.ID).
+			Where(listing.ExpiryReminderSentAtIsNil()).
+			SetExpiryReminderSentAt(now).
+			Exec(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return sent, fmt.Errorf("failed to mark reminder for listing %s: %w", l.ID, err)
+		}
+
+		if l.Edges.Realtor == nil {
+			continue
+		}
+		err = NotifyRepo(ctx, entClient, RealtorRecipient(l.Edges.Realtor), NotificationListingExpiring,
+			fmt.Sprintf("Your listing %q expires soon", l.Title),
+			fmt.Sprintf("The listing at %s expires on %s. Renew it to keep it published.",
+				l.Address, l.ExpiresAt.Format(time.DateOnly)))
+		if err != nil {
+			log.Printf("Failed to notify realtor about expiring listing %s: %v", l.ID, err)
+			continue
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// RenewListingRepo extends a listing's term by termDays and records the renewal.
+// The new term starts when the current one ends, or now if it already ended.
+// Published listings and archived listings whose term ended can be renewed. A listing
+// archived because it expired is published again; one archived otherwise, by staff or
+// its unpublish schedule, is submitted for review when a realtor renews it, and the
+// review is returned.
+func RenewListingRepo(ctx context.Context, entClient *ent.Client, id uuid.UUID, termDays int) (*ent.Listing, *ent.ListingReview, error) {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := tx.Listing.Get(ctx, id)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, nil, errors.New("listing not found")
+		}
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	expired := current.ExpiresAt != nil && !current.ExpiresAt.After(now)
+	if current.Status != listing.StatusPUBLISHED && !(current.Status == listing.StatusARCHIVED && expired) {
+		tx.Rollback()
+		return nil, nil, errors.New("only published or expired listings can be renewed")
+	}
+
+	start := now
+	if current.ExpiresAt != nil && current.ExpiresAt.After(now) {
+		start = *current.ExpiresAt
+	}
+	expiresAt := start.AddDate(0, 0, termDays)
+
+	// Only listings archived by their expiry go back on the market without review
+	status := listing.StatusPUBLISHED
+	if current.Status == listing.StatusARCHIVED && !current.Expired && moderated(ctx) {
+		status = listing.StatusIN_REVIEW
+	}
+
+	updated, err := tx.Listing.UpdateOneID(id).
+		SetStatus(status).
+		SetExpiresAt(expiresAt).
+		ClearExpiryReminderSentAt().
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to renew listing: %w", err)
+	}
+
+	var review *ent.ListingReview
+	if status == listing.StatusIN_REVIEW {
+		review, err = openListingReview(ctx, tx.Client(), id)
+		if err != nil {
+			tx.Rollback()
+			return nil, nil, err
+		}
+	}
+
+	_, err = tx.ListingRenewal.Create().
+		SetListingID(id).
+		SetNillablePreviousExpiresAt(current.ExpiresAt).
+		SetExpiresAt(expiresAt).
+		SetTermDays(termDays).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to record renewal: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, nil, errors.New("failed to commit transaction")
+	}
+
+	return updated, review, nil
+}
@@ -1,11 +1,10 @@
 package repositories
 
 import (
-	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/gin-gonic/gin"
+	"ppgroup.ppgroup.com/ent/schematype"
 	"ppgroup.ppgroup.com/internal/services"
 )
 
@@ -36,10 +35,10 @@ func (h *ImageHandler) UploadImages(c *gin.Context) {
 	var uploadedURLs []string
 
 	for _, fileHeader := range files {
-		// Validate file type
-		if !isValidImageType(fileHeader.Filename) {
+		// Validate file type and size
+		if err := services.ValidateMediaFile(schematype.MediaTypeImage, fileHeader.Filename, fileHeader.Size); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{
-				"error": fmt.Sprintf("Invalid file type: %s", fileHeader.Filename),
+				"error": err.Error(),
 			})
 			return
 		}
@@ -67,15 +66,3 @@ func (h *ImageHandler) UploadImages(c *gin.Context) {
 		"urls":    uploadedURLs,
 	})
 }
-
-func isValidImageType(filename string) bool {
-	validTypes := []string{".jpg", ".jpeg", ".png", ".gif", ".webp"}
-	filename = strings.ToLower(filename)
-
-	for _, ext := range validTypes {
-		if strings.HasSuffix(filename, ext) {
-			return true
-		}
-	}
-	return false
-}
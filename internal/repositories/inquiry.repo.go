@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+)
+
+// CreateInquiryRepo records a user's inquiry about a published listing. A user can
+// inquire about each listing once.
+func CreateInquiryRepo(ctx context.Context, entClient *ent.Client, listingID, userID uuid.UUID, message string) (*ent.ListingInquiry, error) {
+	published, err := entClient.Listing.Query().
+		Where(listing.ID(listingID), listing.StatusEQ(listing.StatusPUBLISHED)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !published {
+		return nil, errors.New("listing not found")
+	}
+
+	exists, err := entClient.ListingInquiry.Query().
+		Where(listinginquiry.ListingIDEQ(listingID), listinginquiry.UserIDEQ(userID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("you already inquired about this listing")
+	}
+
+	inquiry, err := entClient.ListingInquiry.Create().
+		SetListingID(listingID).
+		SetUserID(userID).
+		SetMessage(message).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create inquiry: %w", err)
+	}
+
+	return inquiry, nil
+}
+
+// GetListingInquiriesRepo retrieves the inquiries about a listing with their users, oldest first.
+func GetListingInquiriesRepo(entClient *ent.Client, listingID uuid.UUID) ([]*ent.ListingInquiry, error) {
+	ctx := context.Background()
+
+	inquiries, err := entClient.ListingInquiry.Query().
+		Where(listinginquiry.ListingIDEQ(listingID)).
+		WithUser().
+		Order(ent.Asc(listinginquiry.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inquiries: %w", err)
+	}
+
+	// Remove the password hashes from the users
+	for _, inquiry := range inquiries {
+		if inquiry.Edges.User != nil {
+			inquiry.Edges.User.Password = ""
+		}
+	}
+
+	return inquiries, nil
+}
+
+// DecideInquiryRepo approves or rejects an inquiry about a listing.
+func DecideInquiryRepo(ctx context.Context, entClient *ent.Client, listingID, inquiryID uuid.UUID, approve bool) (*ent.ListingInquiry, error) {
+	status := listinginquiry.StatusREJECTED
+	if approve {
+		status = listinginquiry.StatusAPPROVED
+	}
+
+	inquiry, err := entClient.ListingInquiry.UpdateOneID(inquiryID).
+		Where(listinginquiry.ListingIDEQ(listingID)).
+		SetStatus(status).
+		SetDecidedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("inquiry not found")
+		}
+		return nil, fmt.Errorf("failed to update inquiry: %w", err)
+	}
+
+	return inquiry, nil
+}
@@ -4,21 +4,62 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/documentdownload"
+	"ppgroup.ppgroup.com/ent/exchangerate"
 	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingdocument"
+	"ppgroup.ppgroup.com/ent/listinginquiry"
+	"ppgroup.ppgroup.com/ent/listingrenewal"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/schematype"
+	"ppgroup.ppgroup.com/internal/services"
 )
 
 // ListingQueryParams holds parameters for querying listings.
 type ListingQueryParams struct {
-	PageSize  int             `form:"page_size" binding:"omitempty,min=1,max=100"`
-	Cursor    string          `form:"cursor"`
-	SortBy    string          `form:"sort_by" binding:"omitempty,oneof=created_at price sqft"`
-	SortOrder string          `form:"sort_order" binding:"omitempty,oneof=asc desc"`
-	City      string          `form:"city"`
-	MinPrice  decimal.Decimal `form:"min_price" binding:"omitempty,min=0"`
+	PageSize  int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Cursor    string `form:"cursor"`
+	SortBy    string `form:"sort_by" binding:"omitempty,oneof=created_at price sqft"`
+	SortOrder string `form:"sort_order" binding:"omitempty,oneof=asc desc"`
+	City      string `form:"city"`
+	Country   string `form:"country" binding:"omitempty,len=2"`
+	// NeighborhoodID only keeps listings in the neighborhood
+	NeighborhoodID string `form:"neighborhood_id" binding:"omitempty,uuid"`
+	// BrokerageID and TeamID only keep listings of realtors in the brokerage or team
+	BrokerageID string          `form:"brokerage_id" binding:"omitempty,uuid"`
+	TeamID      string          `form:"team_id" binding:"omitempty,uuid"`
+	MinPrice    decimal.Decimal `form:"min_price" binding:"omitempty,min=0"`
+	// Currency is the currency of MinPrice and of price sorting. Listings priced in
+	// other currencies are converted through the exchange-rate table.
+	Currency string `form:"currency" binding:"omitempty,len=3"`
+
+	// Media filters
+	HasVirtualTour bool `form:"has_virtual_tour"`
+	HasVideo       bool `form:"has_video"`
+	HasFloorPlan   bool `form:"has_floor_plan"`
+
+	// Market time filters
+	NewWithinDays   int `form:"new_within_days" binding:"omitempty,min=1"`
+	MinDaysOnMarket int `form:"min_days_on_market" binding:"omitempty,min=0"`
+	MaxDaysOnMarket int `form:"max_days_on_market" binding:"omitempty,min=0"`
+
+	// MaxHOAFee keeps listings whose HOA dues come to at most this much a month, in
+	// Currency when it is given. Listings without HOA dues always match.
+	MaxHOAFee *decimal.Decimal `form:"max_hoa_fee"`
 }
 
 // PaginationMeta holds metadata for paginated results.
@@ -28,6 +69,9 @@ type PaginationMeta struct {
 	Cursor  string
 }
 
+// ErrInvalidPrice is returned for listings priced at zero or less.
+var ErrInvalidPrice = errors.New("price must be positive")
+
 var allowedSortFields = map[string]bool{
 	"price":      true,
 	"city":       true,
@@ -39,58 +83,163 @@ var allowedSortOrders = map[string]bool{
 	"desc": true,
 }
 
-func CreateListingRepo(entClient *ent.Client, data *ent.Listing) error {
-	ctx := context.Background()
-
-	exists, err := entClient.Listing.Query().Where(listing.Or(listing.TitleEQ(data.Title), listing.AddressEQ(data.Address))).Exist(ctx)
+// CreateListingRepo creates a listing and links it to the property at its address.
+// An active listing with the same title or normalized address blocks the creation;
+// listings at nearly the same address are returned as warnings. A listing that realtors
+// create as PUBLISHED is submitted for review instead and goes live once staff approve it.
+func CreateListingRepo(ctx context.Context, entClient *ent.Client, data *ent.Listing) ([]DuplicateMatch, error) {
+	// Only active listings block a new one, so a property can be relisted
+	// after its previous listing was archived.
+	exists, err := entClient.Listing.Query().
+		Where(
+			listing.TitleEQ(data.Title),
+			listing.StatusIn(activeListingStatuses...),
+		).
+		Exist(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if exists {
-		return errors.New("listing with the given title or address already exists")
+		return nil, fmt.Errorf("%w: an active listing with the given title already exists", ErrDuplicateListing)
+	}
+
+	if err := normalizeListingLocale(ctx, entClient, data); err != nil {
+		return nil, err
+	}
+	if !data.Price.IsPositive() {
+		return nil, ErrInvalidPrice
+	}
+	if err := validateCarryingCosts(data); err != nil {
+		return nil, err
+	}
+	if err := requireActiveRealtor(ctx, entClient, data.RealtorID); err != nil {
+		return nil, err
+	}
+
+	warnings, err := checkDuplicateListings(ctx, entClient, data, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if isApprovedStatus(data.Status) && moderated(ctx) {
+		data.Status = listing.StatusIN_REVIEW
 	}
 
 	// Start a transaction
 	tx, err := entClient.Tx(ctx)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if err := lockExchangeRate(ctx, tx.Client(), data.Currency); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	propertyID, err := upsertPropertyForListing(ctx, tx.Client(), data)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
 	}
 
 	// Create a new listing
-	_, err = tx.Listing.Create().
+	created, err := tx.Listing.Create().
 		SetAddress(data.Address).
 		SetTitle(data.Title).
 		SetCity(data.City).
 		SetState(data.State).
 		SetZipCode(data.ZipCode).
+		SetCountry(data.Country).
+		SetNillableLatitude(data.Latitude).
+		SetNillableLongitude(data.Longitude).
 		SetDescription(data.Description).
+		SetSourceLocale(data.SourceLocale).
+		SetTranslations(data.Translations).
 		SetPrice(data.Price).
+		SetCurrency(data.Currency).
 		SetBedroom(data.Bedroom).
 		SetBathroom(data.Bathroom).
 		SetGarage(data.Garage).
 		SetSqft(data.Sqft).
+		SetNillableSqm(nonZero(data.Sqm)).
+		SetAreaUnit(data.AreaUnit).
 		SetTypeOfProperty(data.TypeOfProperty).
 		SetLotSize(data.LotSize).
 		SetPool(data.Pool).
 		SetYearBuilt(data.YearBuilt).
+		SetNillableAnnualPropertyTax(data.AnnualPropertyTax).
+		SetNillableHoaDues(data.HoaDues).
+		SetNillableHoaFrequency(data.HoaFrequency).
+		SetHoaName(data.HoaName).
+		SetNillableSpecialAssessments(data.SpecialAssessments).
+		SetNillableMonthlyUtilities(data.MonthlyUtilities).
 		SetMedia(data.Media).
 		SetStatus(data.Status).
+		SetNillablePublishAt(data.PublishAt).
+		SetNillableUnpublishAt(data.UnpublishAt).
 		SetRealtorID(data.RealtorID).
+		SetPropertyID(propertyID).
 		Save(ctx)
 
 	if err != nil {
 		tx.Rollback()
-		return errors.New("failed to create listing" + err.Error())
+		return nil, fmt.Errorf("failed to create listing: %w", err)
+	}
+
+	if created.Status == listing.StatusIN_REVIEW {
+		if _, err := openListingReview(ctx, tx.Client(), created.ID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
 	}
 
 	// Commit the transaction
 	if err := tx.Commit(); err != nil {
-		return errors.New("failed to commit transaction")
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	return warnings, nil
+}
+
+// normalizeListingLocale validates the country, region, postal code, currency and
+// translations of a listing, rewriting them in canonical form, and fills in its area in
+// both units.
+func normalizeListingLocale(ctx context.Context, entClient *ent.Client, data *ent.Listing) error {
+	location, err := services.ValidateLocation(data.Country, data.State, data.ZipCode)
+	if err != nil {
+		return err
+	}
+	data.Country, data.State, data.ZipCode = location.Country, location.Region, location.PostalCode
+
+	data.SourceLocale, data.Translations, err = services.NormalizeTranslations(data.SourceLocale, data.Translations)
+	if err != nil {
+		return err
+	}
+
+	if data.Currency == "" {
+		data.Currency = "USD"
+	}
+	data.Currency = strings.ToUpper(data.Currency)
+	if _, err := exchangeRates(ctx, entClient, data.Currency); err != nil {
+		return err
+	}
+
+	data.Sqft, data.Sqm = services.ResolveArea(data.Sqft, data.Sqm)
+	if data.AreaUnit == "" {
+		data.AreaUnit = listing.AreaUnitSqft
 	}
 
 	return nil
 }
 
+// nonZero returns a pointer to n, or nil when n is zero, for optional numeric fields.
+func nonZero(n int) *int {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
+
 // GetListingsRepo retrieves a paginated list of listings with optional filtering and sorting.
 //
 // It fetches listings from the database according to the provided query parameters, supporting
@@ -119,8 +268,65 @@ func GetListingsRepo(entClient *ent.Client, params ListingQueryParams) ([]*ent.L
 	if params.City != "" {
 		query = query.Where(listing.CityEQ(params.City))
 	}
+	if params.Country != "" {
+		query = query.Where(listing.CountryEQ(strings.ToUpper(params.Country)))
+	}
+	if params.NeighborhoodID != "" {
+		query = query.Where(listing.NeighborhoodIDEQ(uuid.MustParse(params.NeighborhoodID)))
+	}
+	if params.BrokerageID != "" {
+		query = query.Where(listing.HasRealtorWith(realtor.BrokerageIDEQ(uuid.MustParse(params.BrokerageID))))
+	}
+	if params.TeamID != "" {
+		query = query.Where(listing.HasRealtorWith(realtor.TeamIDEQ(uuid.MustParse(params.TeamID))))
+	}
 	if params.MinPrice.GreaterThan(decimal.NewFromInt(0)) {
-		query = query.Where(listing.PriceGTE(params.MinPrice))
+		if params.Currency == "" {
+			query = query.Where(listing.PriceGTE(params.MinPrice))
+		} else {
+			rates, err := exchangeRates(ctx, entClient, strings.ToUpper(params.Currency))
+			if err != nil {
+				return nil, PaginationMeta{}, err
+			}
+			minPrice := params.MinPrice.Mul(rates[strings.ToUpper(params.Currency)])
+			query = query.Where(predicate.Listing(func(s *sql.Selector) {
+				s.Where(sql.P(func(b *sql.Builder) {
+					writePriceInBase(b, s)
+					b.WriteString(" >= ").Arg(minPrice)
+				}))
+			}))
+		}
+	}
+	if params.MaxHOAFee != nil {
+		var rate *decimal.Decimal
+		if params.Currency != "" {
+			rates, err := exchangeRates(ctx, entClient, strings.ToUpper(params.Currency))
+			if err != nil {
+				return nil, PaginationMeta{}, err
+			}
+			r := rates[strings.ToUpper(params.Currency)]
+			rate = &r
+		}
+		query = query.Where(maxMonthlyHOADuesPredicate(*params.MaxHOAFee, rate))
+	}
+	if params.NewWithinDays > 0 {
+		query = query.Where(listing.ListedAtGTE(time.Now().AddDate(0, 0, -params.NewWithinDays)))
+	}
+	if params.MinDaysOnMarket > 0 {
+		query = query.Where(daysOnMarketPredicate(">=", params.MinDaysOnMarket))
+	}
+	if params.MaxDaysOnMarket > 0 {
+		// Days on market are whole days, so anything short of one more day still matches
+		query = query.Where(daysOnMarketPredicate("<", params.MaxDaysOnMarket+1))
+	}
+	if params.HasVirtualTour {
+		query = query.Where(hasMediaType(schematype.MediaTypeVirtualTour))
+	}
+	if params.HasVideo {
+		query = query.Where(hasMediaType(schematype.MediaTypeVideo))
+	}
+	if params.HasFloorPlan {
+		query = query.Where(hasMediaType(schematype.MediaTypeFloorPlan))
 	}
 
 	// Get total count
@@ -137,7 +343,15 @@ func GetListingsRepo(entClient *ent.Client, params ListingQueryParams) ([]*ent.L
 		}
 		switch params.SortBy {
 		case "price":
-			if order == "asc" {
+			if params.Currency != "" {
+				// Compare prices in different currencies by their value in the base currency
+				query = query.Order(func(s *sql.Selector) {
+					s.OrderExprFunc(func(b *sql.Builder) {
+						writePriceInBase(b, s)
+						b.WriteString(" " + strings.ToUpper(order))
+					})
+				})
+			} else if order == "asc" {
 				query = query.Order(ent.Asc(listing.FieldPrice))
 			} else {
 				query = query.Order(ent.Desc(listing.FieldPrice))
@@ -205,70 +419,299 @@ func GetListingsRepo(entClient *ent.Client, params ListingQueryParams) ([]*ent.L
 	return listings, meta, nil
 }
 
+// GetListingRepo retrieves one listing by ID together with its realtor.
+func GetListingRepo(entClient *ent.Client, id uuid.UUID) (*ent.Listing, error) {
+	ctx := context.Background()
+
+	l, err := entClient.Listing.Query().
+		Where(listing.ID(id)).
+		WithRealtor().
+		WithNeighborhood(func(q *ent.NeighborhoodQuery) {
+			q.Select(neighborhood.FieldID, neighborhood.FieldName, neighborhood.FieldCity,
+				neighborhood.FieldState, neighborhood.FieldCountry)
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("listing not found")
+		}
+		return nil, fmt.Errorf("failed to get listing: %w", err)
+	}
+
+	return l, nil
+}
+
+// writePriceInBase writes the listing's price converted into the base currency using
+// the exchange-rate table.
+func writePriceInBase(b *sql.Builder, s *sql.Selector) {
+	writeAmountInBase(b, s, func(b *sql.Builder) {
+		b.Ident(s.C(listing.FieldPrice))
+	})
+}
+
+// writeAmountInBase writes an amount in the listing's currency, written by amount,
+// converted into the base currency using the exchange-rate table.
+func writeAmountInBase(b *sql.Builder, s *sql.Selector, amount func(*sql.Builder)) {
+	rates := sql.Table(exchangerate.Table)
+	b.WriteString("(")
+	amount(b)
+	b.WriteString(" * (SELECT ").
+		Ident(rates.C(exchangerate.FieldRate)).
+		WriteString(" FROM ").
+		Ident(exchangerate.Table).
+		WriteString(" WHERE ").
+		Ident(rates.C(exchangerate.FieldCurrency)).
+		WriteString(" = ").
+		Ident(s.C(listing.FieldCurrency)).
+		WriteString("))")
+}
+
+// hasMediaType matches listings with at least one media item of the given type,
+// using jsonb containment on the media column.
+func hasMediaType(mediaType schematype.MediaType) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(listing.FieldMedia)).
+				WriteString(" @> ").
+				Arg(fmt.Sprintf(`[{"type": %q}]`, mediaType)).
+				WriteString("::jsonb")
+		}))
+	})
+}
+
 // DeleteListing deletes a listing from the database using the provided ent.Client and Listing data.
 // It takes an ent.Client instance and a Listing entity as input parameters.
 // If the listing with the specified ID does not exist, it returns an error indicating "listing not found".
 // If any other error occurs during the deletion process, it wraps and returns the error.
-// On successful deletion, it returns nil.
+// The rows that reference the listing are deleted with it, see deleteListingTx.
 //
 // Parameters:
 // - entClient: The ent.Client instance used to interact with the database.
 // - data: The Listing entity containing the ID of the listing to be deleted.
 //
 // Returns:
+// - []string: The storage IDs of the listing's documents, whose files the caller removes.
 // - error: An error if the deletion fails or the listing is not found, otherwise nil.
-func DeleteListing(entClient *ent.Client, idStr string) error {
-	ctx := context.Background()
+func DeleteListing(ctx context.Context, entClient *ent.Client, idStr string) ([]string, error) {
 	ID, err := uuid.Parse(idStr)
 	if err != nil {
-		return errors.New("invalid ID format")
+		return nil, errors.New("invalid ID format")
+	}
+
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	err = entClient.Listing.DeleteOneID(ID).Exec(ctx)
+	storageIDs, err := deleteListingTx(ctx, tx, ID)
 	if err != nil {
+		tx.Rollback()
 		if ent.IsNotFound(err) {
-			return errors.New("listing not found")
+			return nil, errors.New("listing not found")
 		}
-		return fmt.Errorf("failed to delete listing: %w", err)
+		return nil, fmt.Errorf("failed to delete listing: %w", err)
 	}
 
-	return nil
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	return storageIDs, nil
 }
 
-func UpdateListingRepo(entClient *ent.Client, data *ent.Listing) error {
-	ctx := context.Background()
+// deleteListingTx deletes a listing in tx together with the rows that reference it: its
+// reviews, renewals, offers, inquiries and documents with their download logs. It returns
+// the storage IDs of the deleted documents so the caller can remove the files once the
+// transaction is committed.
+func deleteListingTx(ctx context.Context, tx *ent.Tx, id uuid.UUID) ([]string, error) {
+	storageIDs, err := tx.ListingDocument.Query().
+		Where(listingdocument.ListingIDEQ(id)).
+		Select(listingdocument.FieldStorageID).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	// The listing itself is deleted last and checked by its policy, which rolls the
+	// transaction back when the viewer may not delete it
+	allowed := privacy.DecisionContext(ctx, privacy.Allow)
+	if _, err := tx.DocumentDownload.Delete().
+		Where(documentdownload.HasDocumentWith(listingdocument.ListingIDEQ(id))).
+		Exec(allowed); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ListingDocument.Delete().Where(listingdocument.ListingIDEQ(id)).Exec(allowed); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ListingInquiry.Delete().Where(listinginquiry.ListingIDEQ(id)).Exec(allowed); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ListingRenewal.Delete().Where(listingrenewal.ListingIDEQ(id)).Exec(allowed); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ListingReview.Delete().Where(listingreview.ListingIDEQ(id)).Exec(allowed); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Offer.Delete().Where(offer.ListingIDEQ(id)).Exec(allowed); err != nil {
+		return nil, err
+	}
+
+	// Status events are removed by the database, see the status_events edge
+	if err := tx.Listing.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return storageIDs, nil
+}
+
+// UpdateListingRepo updates the fields of a listing that differ from the stored ones.
+// When data.Version is set, the update only succeeds while the listing is still at that
+// version and fails with ErrListingVersionConflict otherwise.
+//
+// Changes by realtors that need staff review are not applied directly: publishing a
+// listing submits it for review, and new prices, descriptions, translations and media
+// of a published listing, or of one scheduled for publication, are held in its pending
+// edit. The review is returned when one was opened or updated.
+func UpdateListingRepo(ctx context.Context, entClient *ent.Client, data *ent.Listing) ([]DuplicateMatch, *ent.ListingReview, error) {
 	// Fetch the current listing from the database
 	current, err := entClient.Listing.Get(ctx, data.ID)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	// Check for duplicate title or address if they are changed
-	if data.Title != current.Title || data.Address != current.Address {
+	// A non-zero version must match the stored one, see ListingVersionHook
+	if data.Version != 0 && data.Version != current.Version {
+		return nil, nil, ErrListingVersionConflict
+	}
+
+	// Check for a duplicate title if it is changed
+	if data.Title != current.Title {
 		duplicate, err := entClient.Listing.Query().
 			Where(
-				listing.Or(
-					listing.TitleEQ(data.Title),
-					listing.AddressEQ(data.Address),
-				),
+				listing.TitleEQ(data.Title),
+				listing.StatusIn(activeListingStatuses...),
 				listing.IDNEQ(data.ID),
 			).
 			Exist(ctx)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		if duplicate {
-			return errors.New("another listing with the given title or address already exists")
+			return nil, nil, fmt.Errorf("%w: another active listing with the given title already exists", ErrDuplicateListing)
+		}
+	}
+
+	// Fields added for international listings keep their value when left out
+	if data.Country == "" {
+		data.Country = current.Country
+	}
+	if data.Currency == "" {
+		data.Currency = current.Currency
+	}
+	if data.AreaUnit == "" {
+		data.AreaUnit = current.AreaUnit
+	}
+	if data.SourceLocale == "" {
+		data.SourceLocale = current.SourceLocale
+	}
+	if data.Sqft == current.Sqft && data.Sqm > 0 && data.Sqm != current.Sqm {
+		// Only the square meters changed, so derive the square feet from them
+		data.Sqft = 0
+	}
+	if err := normalizeListingLocale(ctx, entClient, data); err != nil {
+		return nil, nil, err
+	}
+	if !data.Price.Equal(current.Price) && !data.Price.IsPositive() {
+		return nil, nil, ErrInvalidPrice
+	}
+	if err := validateCarryingCosts(data); err != nil {
+		return nil, nil, err
+	}
+	if data.RealtorID != current.RealtorID {
+		if err := requireActiveRealtor(ctx, entClient, data.RealtorID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Hold back the changes that need staff review
+	var changes schematype.ListingChanges
+	if isApprovedStatus(current.Status) && moderated(ctx) {
+		if !data.Price.Equal(current.Price) {
+			price := data.Price
+			changes.Price = &price
+			data.Price = current.Price
+		}
+		if data.Description != current.Description {
+			description := data.Description
+			changes.Description = &description
+			data.Description = current.Description
+		}
+		changes.Media, data.Media = data.Media, nil
+		if !maps.Equal(data.Translations, current.Translations) {
+			changes.Translations = data.Translations
+			if changes.Translations == nil {
+				changes.Translations = map[string]schematype.Translation{}
+			}
+			data.Translations = current.Translations
+		}
+	}
+	if isApprovedStatus(data.Status) && !isApprovedStatus(current.Status) && moderated(ctx) {
+		data.Status = listing.StatusIN_REVIEW
+	}
+	submitted := data.Status != current.Status && data.Status == listing.StatusIN_REVIEW
+	if submitted && isApprovedStatus(current.Status) {
+		return nil, nil, ErrListingNotSubmittable
+	}
+
+	// Check for duplicate addresses when the address changes or the listing becomes active again
+	addressChanged := data.Address != current.Address || data.ZipCode != current.ZipCode ||
+		data.City != current.City || data.Country != current.Country
+	reactivated := data.Status != current.Status && !slices.Contains(activeListingStatuses, current.Status)
+	var warnings []DuplicateMatch
+	if addressChanged || reactivated {
+		warnings, err = checkDuplicateListings(ctx, entClient, data, data.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if data.Currency != current.Currency {
+		if err := lockExchangeRate(ctx, tx.Client(), data.Currency); err != nil {
+			tx.Rollback()
+			return nil, nil, err
 		}
 	}
 
 	// Begin building the update, only setting fields that have changed
-	updater := entClient.Listing.UpdateOneID(data.ID)
+	updater := tx.Listing.UpdateOneID(data.ID)
+	if data.Version != 0 {
+		// Guards against a concurrent update between the read above and this write
+		updater = updater.Where(listing.VersionEQ(data.Version))
+	}
 
 	if data.Title != current.Title {
 		updater = updater.SetTitle(data.Title)
 	}
+	if propertyChanged(data, current) {
+		// The property follows the listing's physical attributes, and a corrected address
+		// points the listing at the property at that address
+		propertyID, err := upsertPropertyForListing(ctx, tx.Client(), data)
+		if err != nil {
+			tx.Rollback()
+			return nil, nil, err
+		}
+		if propertyID != current.PropertyID {
+			updater = updater.SetPropertyID(propertyID)
+		}
+	}
 	if data.Address != current.Address {
 		updater = updater.SetAddress(data.Address)
 	}
@@ -281,8 +724,39 @@ func UpdateListingRepo(entClient *ent.Client, data *ent.Listing) error {
 	if data.ZipCode != current.ZipCode {
 		updater = updater.SetZipCode(data.ZipCode)
 	}
+	if data.Country != current.Country {
+		updater = updater.SetCountry(data.Country)
+	}
+	if data.Currency != current.Currency {
+		updater = updater.SetCurrency(data.Currency)
+	}
+	if data.AreaUnit != current.AreaUnit {
+		updater = updater.SetAreaUnit(data.AreaUnit)
+	}
+	if !sameFloat(data.Latitude, current.Latitude) {
+		updater = updater.SetNillableLatitude(data.Latitude)
+		if data.Latitude == nil {
+			updater = updater.ClearLatitude()
+		}
+	}
+	if !sameFloat(data.Longitude, current.Longitude) {
+		updater = updater.SetNillableLongitude(data.Longitude)
+		if data.Longitude == nil {
+			updater = updater.ClearLongitude()
+		}
+	}
 	if data.Description != current.Description {
-		updater = updater.SetDescription(data.Description)
+		if data.Description == "" {
+			updater = updater.ClearDescription()
+		} else {
+			updater = updater.SetDescription(data.Description)
+		}
+	}
+	if data.SourceLocale != current.SourceLocale {
+		updater = updater.SetSourceLocale(data.SourceLocale)
+	}
+	if !maps.Equal(data.Translations, current.Translations) {
+		updater = updater.SetTranslations(data.Translations)
 	}
 	if !data.Price.Equal(current.Price) {
 		updater = updater.SetPrice(data.Price)
@@ -294,16 +768,24 @@ func UpdateListingRepo(entClient *ent.Client, data *ent.Listing) error {
 		updater = updater.SetBathroom(data.Bathroom)
 	}
 	if data.Garage != current.Garage {
-		updater = updater.SetGarage(data.Garage)
+		if data.Garage == 0 {
+			updater = updater.ClearGarage()
+		} else {
+			updater = updater.SetGarage(data.Garage)
+		}
 	}
 	if data.Sqft != current.Sqft {
-		updater = updater.SetSqft(data.Sqft)
+		updater = updater.SetSqft(data.Sqft).SetNillableSqm(nonZero(data.Sqm))
 	}
 	if data.TypeOfProperty != current.TypeOfProperty {
 		updater = updater.SetTypeOfProperty(data.TypeOfProperty)
 	}
 	if data.LotSize != current.LotSize {
-		updater = updater.SetLotSize(data.LotSize)
+		if data.LotSize == 0 {
+			updater = updater.ClearLotSize()
+		} else {
+			updater = updater.SetLotSize(data.LotSize)
+		}
 	}
 	if data.Pool != current.Pool {
 		updater = updater.SetPool(data.Pool)
@@ -311,9 +793,58 @@ func UpdateListingRepo(entClient *ent.Client, data *ent.Listing) error {
 	if data.YearBuilt != current.YearBuilt {
 		updater = updater.SetYearBuilt(data.YearBuilt)
 	}
+	if !sameDecimal(data.AnnualPropertyTax, current.AnnualPropertyTax) {
+		updater = updater.SetNillableAnnualPropertyTax(data.AnnualPropertyTax)
+		if data.AnnualPropertyTax == nil {
+			updater = updater.ClearAnnualPropertyTax()
+		}
+	}
+	if !sameDecimal(data.HoaDues, current.HoaDues) {
+		updater = updater.SetNillableHoaDues(data.HoaDues)
+		if data.HoaDues == nil {
+			updater = updater.ClearHoaDues()
+		}
+	}
+	if !sameValue(data.HoaFrequency, current.HoaFrequency) {
+		updater = updater.SetNillableHoaFrequency(data.HoaFrequency)
+		if data.HoaFrequency == nil {
+			updater = updater.ClearHoaFrequency()
+		}
+	}
+	if data.HoaName != current.HoaName {
+		if data.HoaName == "" {
+			updater = updater.ClearHoaName()
+		} else {
+			updater = updater.SetHoaName(data.HoaName)
+		}
+	}
+	if !sameDecimal(data.SpecialAssessments, current.SpecialAssessments) {
+		updater = updater.SetNillableSpecialAssessments(data.SpecialAssessments)
+		if data.SpecialAssessments == nil {
+			updater = updater.ClearSpecialAssessments()
+		}
+	}
+	if !sameDecimal(data.MonthlyUtilities, current.MonthlyUtilities) {
+		updater = updater.SetNillableMonthlyUtilities(data.MonthlyUtilities)
+		if data.MonthlyUtilities == nil {
+			updater = updater.ClearMonthlyUtilities()
+		}
+	}
 	if data.Status != current.Status {
 		updater = updater.SetStatus(data.Status)
 	}
+	if !sameTime(data.PublishAt, current.PublishAt) {
+		updater = updater.SetNillablePublishAt(data.PublishAt)
+		if data.PublishAt == nil {
+			updater = updater.ClearPublishAt()
+		}
+	}
+	if !sameTime(data.UnpublishAt, current.UnpublishAt) {
+		updater = updater.SetNillableUnpublishAt(data.UnpublishAt)
+		if data.UnpublishAt == nil {
+			updater = updater.ClearUnpublishAt()
+		}
+	}
 	if data.RealtorID != current.RealtorID {
 		updater = updater.SetRealtorID(data.RealtorID)
 	}
@@ -323,8 +854,28 @@ func UpdateListingRepo(entClient *ent.Client, data *ent.Listing) error {
 
 	_, err = updater.Save(ctx)
 	if err != nil {
-		return err
+		tx.Rollback()
+		if ent.IsNotFound(err) && data.Version != 0 {
+			return nil, nil, ErrListingVersionConflict
+		}
+		return nil, nil, err
 	}
 
-	return nil
+	var review *ent.ListingReview
+	if submitted {
+		review, err = openListingReview(ctx, tx.Client(), data.ID)
+	} else if !changes.IsEmpty() {
+		review, err = proposeListingEdit(ctx, tx.Client(), data.ID, changes)
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, nil, errors.New("failed to commit transaction")
+	}
+
+	return warnings, review, nil
 }
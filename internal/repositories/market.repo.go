@@ -0,0 +1,311 @@
+package repositories
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// ErrInvalidMarketQuery is returned when market statistics are requested without an area.
+var ErrInvalidMarketQuery = errors.New("invalid market statistics query")
+
+// marketStatsView is the materialized view holding the monthly market statistics.
+const marketStatsView = "listing_market_stats"
+
+// marketStatsQuery computes the monthly statistics of every city and ZIP code, overall
+// and per type of property. Rows left out of a grouping hold "" in its columns, so an
+// empty type_of_property means every type. Prices are in the base currency.
+//
+// The status history is folded into the periods each listing spent on the market. Active
+// inventory counts the listings still on the market at the end of the month; days on
+// market are the cumulative days of the listings that left the market in the month.
+const marketStatsQuery = `
+WITH changes AS (
+	SELECT listing_id, changed_at, to_status IN (%[1]s) AS on_market,
+		lag(to_status IN (%[1]s)) OVER (PARTITION BY listing_id ORDER BY changed_at) AS was_on_market
+	FROM listing_status_events
+),
+periods AS (
+	SELECT listing_id, on_market, changed_at AS listed_at,
+		lead(changed_at) OVER (PARTITION BY listing_id ORDER BY changed_at) AS delisted_at
+	FROM changes
+	WHERE on_market IS DISTINCT FROM COALESCE(was_on_market, false)
+),
+market AS (
+	SELECT l.country, l.state, l.city, l.zip_code, l.type_of_property,
+		(l.price * r.rate)::float8 AS price,
+		(l.price * r.rate / l.sqft)::float8 AS price_per_sqft,
+		p.listed_at, p.delisted_at,
+		sum(extract(epoch FROM COALESCE(p.delisted_at, now()) - p.listed_at))
+			OVER (PARTITION BY p.listing_id ORDER BY p.listed_at)::float8 / 86400 AS days_on_market
+	FROM periods p
+	JOIN listings l ON l.id = p.listing_id
+	JOIN exchange_rates r ON r.currency = l.currency
+	WHERE p.on_market
+),
+months AS (
+	SELECT generate_series(date_trunc('month', min(listed_at)), date_trunc('month', now()), interval '1 month') AS month
+	FROM market
+)
+SELECT m.month::date AS month,
+	k.country,
+	COALESCE(k.state, '') AS state,
+	COALESCE(k.city, '') AS city,
+	COALESCE(k.zip_code, '') AS zip_code,
+	COALESCE(k.type_of_property, '') AS type_of_property,
+	count(*) FILTER (WHERE k.listed_at >= m.month) AS new_listings,
+	count(*) FILTER (WHERE k.delisted_at IS NULL OR k.delisted_at >= m.month + interval '1 month') AS active_inventory,
+	round(percentile_cont(0.5) WITHIN GROUP (ORDER BY k.price)::numeric, 2) AS median_price,
+	round(avg(k.price)::numeric, 2) AS average_price,
+	round(percentile_cont(0.5) WITHIN GROUP (ORDER BY k.price_per_sqft)::numeric, 2) AS median_price_per_sqft,
+	round(avg(k.price_per_sqft)::numeric, 2) AS average_price_per_sqft,
+	percentile_cont(0.5) WITHIN GROUP (ORDER BY k.days_on_market)
+		FILTER (WHERE k.delisted_at < m.month + interval '1 month') AS median_days_on_market,
+	now() AS refreshed_at
+FROM months m
+JOIN market k ON k.listed_at < m.month + interval '1 month' AND (k.delisted_at IS NULL OR k.delisted_at >= m.month)
+GROUP BY m.month, k.country, GROUPING SETS (
+	(k.state, k.city), (k.state, k.city, k.type_of_property),
+	(k.zip_code), (k.zip_code, k.type_of_property)
+)`
+
+// MarketStatsParams selects the area, type of property and months of market statistics.
+// An area is either a city with its state or a ZIP code.
+type MarketStatsParams struct {
+	Country        string `form:"country" binding:"omitempty,len=2"`
+	State          string `form:"state"`
+	City           string `form:"city"`
+	ZipCode        string `form:"zip_code"`
+	TypeOfProperty string `form:"type_of_property" binding:"omitempty,oneof=house apartment condo townhouse"`
+	// From and To are inclusive months in YYYY-MM format; the last 12 months by default
+	From string `form:"from"`
+	To   string `form:"to"`
+	// Currency of the prices, the base currency by default
+	Currency string `form:"currency" binding:"omitempty,len=3"`
+}
+
+// MarketStats holds the statistics of one month.
+type MarketStats struct {
+	Month               string          `json:"month"`
+	NewListings         int             `json:"new_listings"`
+	ActiveInventory     int             `json:"active_inventory"`
+	MedianPrice         decimal.Decimal `json:"median_price"`
+	AveragePrice        decimal.Decimal `json:"average_price"`
+	MedianPricePerSqft  decimal.Decimal `json:"median_price_per_sqft"`
+	AveragePricePerSqft decimal.Decimal `json:"average_price_per_sqft"`
+	// MedianDaysOnMarket is nil when no listing left the market in the month
+	MedianDaysOnMarket *float64 `json:"median_days_on_market"`
+}
+
+// MarketStatsMeta describes the statistics returned.
+type MarketStatsMeta struct {
+	Currency    string     `json:"currency"`
+	From        string     `json:"from"`
+	To          string     `json:"to"`
+	RefreshedAt *time.Time `json:"refreshed_at"`
+}
+
+// marketStatsViewVersion is bumped when a migration changes the listing or status history
+// columns the market statistics view reads, so the view is dropped before the schema is
+// migrated. Changes to the view definition itself are picked up without a bump.
+const marketStatsViewVersion = 1
+
+// marketStatsViewStatements create the market statistics view with the unique index that
+// lets it be refreshed without blocking readers.
+func marketStatsViewStatements() []string {
+	return []string{
+		"CREATE MATERIALIZED VIEW " + marketStatsView + " AS " + fmt.Sprintf(marketStatsQuery, marketStatusList()),
+		"CREATE UNIQUE INDEX " + marketStatsView + "_key ON " + marketStatsView +
+			" (month, country, state, city, zip_code, type_of_property)",
+	}
+}
+
+// marketStatsViewMigration names the migration that builds the current market statistics
+// view. The name changes with the view's version and definition, so a deploy that keeps
+// both leaves the view alone.
+func marketStatsViewMigration() string {
+	sum := sha256.Sum256([]byte(strings.Join(marketStatsViewStatements(), ";")))
+	return fmt.Sprintf("market_stats_view_v%d_%x", marketStatsViewVersion, sum[:6])
+}
+
+// DropStaleMarketStatsViewRepo drops the market statistics view before migrations when
+// it was built from an older version or definition, since the view would block changes to
+// the columns it reads. A current view is kept, so readers are not interrupted by a
+// normal start-up.
+func DropStaleMarketStatsViewRepo(entClient *ent.Client) error {
+	ctx := systemContext()
+
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	current, err := lockMigrations(ctx, tx.Client(), marketStatsViewMigration())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if current {
+		return tx.Rollback()
+	}
+	if _, err := tx.ExecContext(ctx, "DROP MATERIALIZED VIEW IF EXISTS "+marketStatsView); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to drop market statistics view: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
+
+// CreateMarketStatsViewRepo creates and fills the market statistics view unless the
+// current version already exists. It runs as a migration, so replicas starting together
+// build the view once.
+func CreateMarketStatsViewRepo(entClient *ent.Client) error {
+	return RunMigrationsRepo(entClient, []Migration{{
+		Name: marketStatsViewMigration(),
+		Apply: func(ctx context.Context, client *ent.Client) error {
+			statements := append([]string{"DROP MATERIALIZED VIEW IF EXISTS " + marketStatsView}, marketStatsViewStatements()...)
+			for _, statement := range statements {
+				if _, err := client.ExecContext(ctx, statement); err != nil {
+					return fmt.Errorf("failed to create market statistics view: %w", err)
+				}
+			}
+			return nil
+		},
+	}})
+}
+
+// marketStatusList returns the market statuses as a SQL list of string literals.
+func marketStatusList() string {
+	quoted := make([]string, 0, len(marketListingStatuses))
+	for _, status := range marketListingStatuses {
+		quoted = append(quoted, "'"+string(status)+"'")
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// RefreshMarketStatsRepo recomputes the market statistics view. Readers keep seeing the
+// previous statistics until the refresh completes.
+func RefreshMarketStatsRepo(entClient *ent.Client) error {
+	_, err := entClient.ExecContext(context.Background(), "REFRESH MATERIALIZED VIEW CONCURRENTLY "+marketStatsView)
+	if err != nil {
+		return fmt.Errorf("failed to refresh market statistics: %w", err)
+	}
+	return nil
+}
+
+// GetMarketStatsRepo reads the monthly statistics of an area from the market statistics
+// view, oldest month first. Months without listings on the market are left out.
+func GetMarketStatsRepo(entClient *ent.Client, baseCurrency string, params MarketStatsParams) ([]MarketStats, *MarketStatsMeta, error) {
+	ctx := context.Background()
+
+	country := strings.ToUpper(params.Country)
+	if country == "" {
+		country = "US"
+	}
+	state, city := strings.ToUpper(strings.TrimSpace(params.State)), strings.TrimSpace(params.City)
+	zipCode := strings.TrimSpace(params.ZipCode)
+	switch {
+	case zipCode != "" && (city != "" || state != ""):
+		return nil, nil, fmt.Errorf("%w: give either a city and state or a ZIP code", ErrInvalidMarketQuery)
+	case zipCode == "" && (city == "" || state == ""):
+		return nil, nil, fmt.Errorf("%w: a city and state or a ZIP code is required", ErrInvalidMarketQuery)
+	}
+	if zipCode != "" {
+		var err error
+		if zipCode, err = services.NormalizePostalCode(country, zipCode); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	to := time.Now().UTC()
+	if params.To != "" {
+		t, err := time.Parse("2006-01", params.To)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: to must be a YYYY-MM month", ErrInvalidMarketQuery)
+		}
+		to = t
+	}
+	to = time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, -11, 0)
+	if params.From != "" {
+		t, err := time.Parse("2006-01", params.From)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: from must be a YYYY-MM month", ErrInvalidMarketQuery)
+		}
+		from = t
+	}
+	if from.After(to) {
+		return nil, nil, fmt.Errorf("%w: from must not be after to", ErrInvalidMarketQuery)
+	}
+
+	// Prices are stored in the base currency
+	currency := strings.ToUpper(params.Currency)
+	if currency == "" {
+		currency = baseCurrency
+	}
+	rates, err := exchangeRates(ctx, entClient, currency)
+	if err != nil {
+		return nil, nil, err
+	}
+	rate := rates[currency]
+
+	query, args := sql.Dialect(dialect.Postgres).
+		Select("month", "new_listings", "active_inventory", "median_price", "average_price",
+			"median_price_per_sqft", "average_price_per_sqft", "median_days_on_market", "refreshed_at").
+		From(sql.Table(marketStatsView)).
+		Where(sql.And(
+			sql.EQ("country", country),
+			sql.EQ("state", state),
+			sql.EqualFold("city", city),
+			sql.EQ("zip_code", zipCode),
+			sql.EQ("type_of_property", params.TypeOfProperty),
+			sql.GTE("month", from),
+			sql.LTE("month", to),
+		)).
+		OrderBy("month").
+		Query()
+
+	rows, err := entClient.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get market statistics: %w", err)
+	}
+	defer rows.Close()
+
+	meta := &MarketStatsMeta{Currency: currency, From: from.Format("2006-01"), To: to.Format("2006-01")}
+	stats := []MarketStats{}
+	for rows.Next() {
+		var s MarketStats
+		var month, refreshedAt time.Time
+		err := rows.Scan(&month, &s.NewListings, &s.ActiveInventory, &s.MedianPrice, &s.AveragePrice,
+			&s.MedianPricePerSqft, &s.AveragePricePerSqft, &s.MedianDaysOnMarket, &refreshedAt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read market statistics: %w", err)
+		}
+		s.Month = month.Format("2006-01")
+		for _, amount := range []*decimal.Decimal{&s.MedianPrice, &s.AveragePrice, &s.MedianPricePerSqft, &s.AveragePricePerSqft} {
+			*amount = amount.Div(rate).Round(2)
+		}
+		meta.RefreshedAt = &refreshedAt
+		stats = append(stats, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read market statistics: %w", err)
+	}
+
+	return stats, meta, nil
+}
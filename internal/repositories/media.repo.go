@@ -0,0 +1,237 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// ErrMediaNotFound is returned when a media item does not exist on the listing.
+var ErrMediaNotFound = errors.New("media item not found")
+
+// NewMediaItem returns a media item with a fresh ID.
+func NewMediaItem(url string, mediaType schematype.MediaType, caption string) schematype.Media {
+	return schematype.Media{
+		ID:      uuid.NewString(),
+		URL:     url,
+		Type:    mediaType,
+		Caption: caption,
+	}
+}
+
+// mutateListingMedia applies fn to a listing's media inside a transaction that holds a row
+// lock on the listing, so concurrent media edits are applied one after another instead of
+// overwriting each other. Items from before media IDs existed are given one first, and the
+// result always has exactly one primary item unless it is empty.
+//
+// When a realtor edits the media of an approved listing, fn is applied to the media of
+// the listing's pending edit instead and the result waits for staff review; the review
+// is returned and the listing itself is left unchanged.
+func mutateListingMedia(ctx context.Context, entClient *ent.Client, listingID uuid.UUID, fn func([]schematype.Media) ([]schematype.Media, error)) ([]schematype.Media, *ent.ListingReview, error) {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := tx.Listing.Query().Where(listing.ID(listingID)).ForUpdate().Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, nil, errors.New("listing not found")
+		}
+		return nil, nil, err
+	}
+
+	base := current.Media
+	review := isApprovedStatus(current.Status) && moderated(ctx)
+	if review {
+		pending, err := pendingListingEdit(ctx, tx.Client(), listingID)
+		if err != nil {
+			tx.Rollback()
+			return nil, nil, err
+		}
+		if pending != nil && pending.Changes.Media != nil {
+			base = pending.Changes.Media
+		}
+	}
+
+	media := make([]schematype.Media, len(base))
+	copy(media, base)
+	for i := range media {
+		if media[i].ID == "" {
+			media[i].ID = uuid.NewString()
+		}
+	}
+
+	media, err = fn(media)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+	normalizePrimaryMedia(media)
+
+	var pending *ent.ListingReview
+	if review {
+		pending, err = proposeListingEdit(ctx, tx.Client(), listingID, schematype.ListingChanges{Media: media})
+		if err != nil {
+			tx.Rollback()
+			return nil, nil, err
+		}
+	} else if err := tx.Listing.UpdateOneID(listingID).SetMedia(media).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to update listing media: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, nil, errors.New("failed to commit transaction")
+	}
+
+	return media, pending, nil
+}
+
+// normalizePrimaryMedia keeps the first primary image and makes the first image primary
+// when none is marked. Only images can be primary.
+func normalizePrimaryMedia(media []schematype.Media) {
+	primary := -1
+	for i := range media {
+		if media[i].IsPrimary && primary == -1 && isImageMedia(media[i]) {
+			primary = i
+			continue
+		}
+		media[i].IsPrimary = false
+	}
+	if primary != -1 {
+		return
+	}
+	for i := range media {
+		if isImageMedia(media[i]) {
+			media[i].IsPrimary = true
+			return
+		}
+	}
+}
+
+// isImageMedia reports whether the item is an image. Items from before media
+// types were enforced have no type and are images.
+func isImageMedia(item schematype.Media) bool {
+	return item.Type == schematype.MediaTypeImage || item.Type == ""
+}
+
+func findMedia(media []schematype.Media, mediaID string) int {
+	for i := range media {
+		if media[i].ID == mediaID {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetListingMediaRepo retrieves the media of a listing.
+func GetListingMediaRepo(entClient *ent.Client, listingID uuid.UUID) ([]schematype.Media, error) {
+	ctx := context.Background()
+
+	l, err := entClient.Listing.Get(ctx, listingID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("listing not found")
+		}
+		return nil, err
+	}
+
+	return l.Media, nil
+}
+
+// AppendListingMediaRepo adds already uploaded media items to the end of a listing's media.
+// Like every media change, it returns the pending review when the change waits for staff.
+func AppendListingMediaRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID, items []schematype.Media) ([]schematype.Media, *ent.ListingReview, error) {
+	return mutateListingMedia(ctx, entClient, listingID, func(media []schematype.Media) ([]schematype.Media, error) {
+		for _, item := range items {
+			if item.ID == "" {
+				item.ID = uuid.NewString()
+			}
+			media = append(media, item)
+		}
+		return media, nil
+	})
+}
+
+// DeleteListingMediaRepo removes one media item from a listing and returns the removed item
+// so the caller can delete its file from storage once the change is committed. While the
+// removal waits for review the file is still in use and no item is returned.
+func DeleteListingMediaRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID, mediaID string) (*schematype.Media, []schematype.Media, *ent.ListingReview, error) {
+	var removed schematype.Media
+	media, review, err := mutateListingMedia(ctx, entClient, listingID, func(media []schematype.Media) ([]schematype.Media, error) {
+		i := findMedia(media, mediaID)
+		if i == -1 {
+			return nil, ErrMediaNotFound
+		}
+		removed = media[i]
+		return append(media[:i], media[i+1:]...), nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if review != nil {
+		return nil, media, review, nil
+	}
+
+	return &removed, media, nil, nil
+}
+
+// ReorderListingMediaRepo rearranges a listing's media in the given order of IDs,
+// which must name every media item exactly once.
+func ReorderListingMediaRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID, order []string) ([]schematype.Media, *ent.ListingReview, error) {
+	return mutateListingMedia(ctx, entClient, listingID, func(media []schematype.Media) ([]schematype.Media, error) {
+		if len(order) != len(media) {
+			return nil, errors.New("order must list every media item exactly once")
+		}
+
+		reordered := make([]schematype.Media, 0, len(media))
+		seen := make(map[string]bool, len(order))
+		for _, id := range order {
+			i := findMedia(media, id)
+			if i == -1 || seen[id] {
+				return nil, errors.New("order must list every media item exactly once")
+			}
+			seen[id] = true
+			reordered = append(reordered, media[i])
+		}
+		return reordered, nil
+	})
+}
+
+// SetPrimaryListingMediaRepo marks one media item as the listing's primary image.
+func SetPrimaryListingMediaRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID, mediaID string) ([]schematype.Media, *ent.ListingReview, error) {
+	return mutateListingMedia(ctx, entClient, listingID, func(media []schematype.Media) ([]schematype.Media, error) {
+		i := findMedia(media, mediaID)
+		if i == -1 {
+			return nil, ErrMediaNotFound
+		}
+		if !isImageMedia(media[i]) {
+			return nil, errors.New("only images can be the primary media")
+		}
+		for j := range media {
+			media[j].IsPrimary = j == i
+		}
+		return media, nil
+	})
+}
+
+// UpdateListingMediaCaptionRepo changes the caption of one media item.
+func UpdateListingMediaCaptionRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID, mediaID, caption string) ([]schematype.Media, *ent.ListingReview, error) {
+	return mutateListingMedia(ctx, entClient, listingID, func(media []schematype.Media) ([]schematype.Media, error) {
+		i := findMedia(media, mediaID)
+		if i == -1 {
+			return nil, ErrMediaNotFound
+		}
+		media[i].Caption = caption
+		return media, nil
+	})
+}
@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"ppgroup.ppgroup.com/ent"
+)
+
+// migrationsTable records the one-off migrations applied to the database. It is not part
+// of the ent schema because it is read before the schema is migrated.
+const migrationsTable = "applied_migrations"
+
+// migrationLockID is the Postgres advisory lock that serializes migrations, so replicas
+// starting together take turns and each migration is applied once.
+const migrationLockID = 4_170_226_001
+
+// Migration is a one-off change to the data that runs once per database instead of on
+// every start-up.
+type Migration struct {
+	// Name identifies the migration and must not change once it has been deployed
+	Name string
+	// Apply makes the change on the client of the migration's transaction
+	Apply func(ctx context.Context, client *ent.Client) error
+}
+
+// Migrations are the one-off migrations, applied in order once the schema is migrated.
+// New migrations are appended; applied ones are never renamed or removed.
+var Migrations = []Migration{
+	{Name: "0001_listing_properties", Apply: linkListingProperties},
+	{Name: "0002_notification_recipients", Apply: linkNotificationRecipients},
+}
+
+// RunMigrationsRepo applies the migrations that have not been applied yet, in order. Each
+// migration runs in its own transaction together with its record, so a failed migration
+// leaves no trace and is tried again on the next start-up.
+func RunMigrationsRepo(entClient *ent.Client, migrations []Migration) error {
+	ctx := systemContext()
+
+	for _, m := range migrations {
+		if err := runMigration(ctx, entClient, m); err != nil {
+			return fmt.Errorf("failed to run migration %s: %w", m.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// runMigration applies one migration unless it was applied before.
+func runMigration(ctx context.Context, entClient *ent.Client, m Migration) error {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	applied, err := lockMigrations(ctx, tx.Client(), m.Name)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if applied {
+		return tx.Rollback()
+	}
+
+	if err := m.Apply(ctx, tx.Client()); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO "+migrationsTable+" (name) VALUES ($1)", m.Name); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to record migration: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
+
+// lockMigrations takes the migration lock until the end of the client's transaction,
+// creating the migrations table on first use, and reports whether the named migration
+// has been applied.
+func lockMigrations(ctx context.Context, client *ent.Client, name string) (bool, error) {
+	if _, err := client.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", migrationLockID); err != nil {
+		return false, fmt.Errorf("failed to lock migrations: %w", err)
+	}
+	_, err := client.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+migrationsTable+
+		" (name text PRIMARY KEY, applied_at timestamptz NOT NULL DEFAULT now())")
+	if err != nil {
+		return false, fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	rows, err := client.QueryContext(ctx, "SELECT EXISTS (SELECT 1 FROM "+migrationsTable+" WHERE name = $1)", name)
+	if err != nil {
+		return false, fmt.Errorf("failed to check migration: %w", err)
+	}
+	defer rows.Close()
+
+	var applied bool
+	if rows.Next() {
+		if err := rows.Scan(&applied); err != nil {
+			return false, fmt.Errorf("failed to check migration: %w", err)
+		}
+	}
+	return applied, rows.Err()
+}
@@ -0,0 +1,570 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/hook"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingreview"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/rule"
+	"ppgroup.ppgroup.com/ent/schematype"
+	"ppgroup.ppgroup.com/internal/viewer"
+)
+
+var (
+	// ErrReviewNotFound is returned when a review does not exist.
+	ErrReviewNotFound = errors.New("review not found")
+	// ErrReviewNotPending is returned when deciding a review that was already decided or withdrawn.
+	ErrReviewNotPending = errors.New("review is no longer pending")
+	// ErrListingNotSubmittable is returned when submitting a listing that is published or already in review.
+	ErrListingNotSubmittable = errors.New("only draft or archived listings can be submitted for review")
+)
+
+// ReviewQueueParams holds the filters of the moderation queue.
+type ReviewQueueParams struct {
+	Status      string `form:"status" binding:"omitempty,oneof=PENDING APPROVED REJECTED WITHDRAWN"`
+	Kind        string `form:"kind" binding:"omitempty,oneof=NEW EDIT"`
+	RealtorID   string `form:"realtor_id" binding:"omitempty,uuid"`
+	BrokerageID string `form:"brokerage_id" binding:"omitempty,uuid"`
+	City        string `form:"city"`
+	Country     string `form:"country" binding:"omitempty,len=2"`
+	// Overdue limits the queue to pending reviews that are past their SLA
+	Overdue  bool `form:"overdue"`
+	Page     int  `form:"page" binding:"omitempty,min=1"`
+	PageSize int  `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// ReviewQueueItem is a review in the moderation queue with its SLA timer.
+// Overdue tells whether a pending review is past its due time, or whether a decided
+// one was decided late. RemainingMinutes is only set on pending reviews and turns
+// negative once they are overdue.
+type ReviewQueueItem struct {
+	*ent.ListingReview
+	DueAt            time.Time `json:"due_at"`
+	Overdue          bool      `json:"overdue"`
+	RemainingMinutes *int      `json:"sla_remaining_minutes,omitempty"`
+}
+
+// ReviewQueueMeta holds the paging of the queue and counts over all pending reviews.
+type ReviewQueueMeta struct {
+	Total    int     `json:"total"`
+	Page     int     `json:"page"`
+	PageSize int     `json:"page_size"`
+	Pending  int     `json:"pending"`
+	Overdue  int     `json:"overdue"`
+	SLAHours float64 `json:"sla_hours"`
+}
+
+// moderated reports whether changes made for the viewer in ctx go through staff review.
+// Staff and system tasks change listings directly.
+func moderated(ctx context.Context) bool {
+	v := viewer.FromContext(ctx)
+	return v != nil && !v.IsStaff
+}
+
+// viewerID returns the ID of the signed-in user in ctx, or nil for system tasks.
+func viewerID(ctx context.Context) *uuid.UUID {
+	if v := viewer.FromContext(ctx); v != nil {
+		return &v.UserID
+	}
+	return nil
+}
+
+// ListingReviewHook withdraws a listing's pending NEW review when the listing leaves
+// IN_REVIEW other than by a decision, for example when the realtor moves it back to
+// DRAFT or staff publish it directly.
+func ListingReviewHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.ListingFunc(func(ctx context.Context, m *ent.ListingMutation) (ent.Value, error) {
+			status, ok := m.Status()
+			if !ok || status == listing.StatusIN_REVIEW {
+				return next.Mutate(ctx, m)
+			}
+
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			err = m.Client().ListingReview.Update().
+				Where(
+					listingreview.ListingIDIn(ids...),
+					listingreview.KindEQ(listingreview.KindNEW),
+					listingreview.StatusEQ(listingreview.StatusPENDING),
+				).
+				SetStatus(listingreview.StatusWITHDRAWN).
+				SetDecidedAt(time.Now()).
+				Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to withdraw listing review: %w", err)
+			}
+			return v, nil
+		})
+	}, ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// openListingReview opens a NEW review for a listing that was just moved to IN_REVIEW.
+// It runs on the client of the transaction that changed the listing.
+func openListingReview(ctx context.Context, client *ent.Client, listingID uuid.UUID) (*ent.ListingReview, error) {
+	review, err := client.ListingReview.Create().
+		SetListingID(listingID).
+		SetKind(listingreview.KindNEW).
+		SetNillableSubmittedBy(viewerID(ctx)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit listing for review: %w", err)
+	}
+	return review, nil
+}
+
+// pendingListingEdit returns the pending EDIT review of a listing, or nil when there is none.
+func pendingListingEdit(ctx context.Context, client *ent.Client, listingID uuid.UUID) (*ent.ListingReview, error) {
+	review, err := client.ListingReview.Query().
+		Where(
+			listingreview.ListingIDEQ(listingID),
+			listingreview.KindEQ(listingreview.KindEDIT),
+			listingreview.StatusEQ(listingreview.StatusPENDING),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return review, err
+}
+
+// proposeListingEdit adds changes to the pending EDIT review of a published listing,
+// opening one when there is none. A listing has at most one pending edit, so further
+// edits are merged into it and keep its place in the queue. It runs on the client of a
+// transaction and locks the listing so concurrent edits are merged one after another.
+func proposeListingEdit(ctx context.Context, client *ent.Client, listingID uuid.UUID, changes schematype.ListingChanges) (*ent.ListingReview, error) {
+	if _, err := client.Listing.Query().Where(listing.ID(listingID)).ForUpdate().OnlyID(ctx); err != nil {
+		return nil, err
+	}
+
+	pending, err := pendingListingEdit(ctx, client, listingID)
+	if err != nil {
+		return nil, err
+	}
+	if pending == nil {
+		review, err := client.ListingReview.Create().
+			SetListingID(listingID).
+			SetKind(listingreview.KindEDIT).
+			SetChanges(changes).
+			SetNillableSubmittedBy(viewerID(ctx)).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to submit changes for review: %w", err)
+		}
+		return review, nil
+	}
+
+	merged := pending.Changes
+	if changes.Price != nil {
+		merged.Price = changes.Price
+	}
+	if changes.Description != nil {
+		merged.Description = changes.Description
+	}
+	if changes.Media != nil {
+		merged.Media = changes.Media
+	}
+	if changes.Translations != nil {
+		merged.Translations = changes.Translations
+	}
+	review, err := pending.Update().SetChanges(merged).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit changes for review: %w", err)
+	}
+	return review, nil
+}
+
+// SubmitListingForReviewRepo puts a draft or archived listing in the moderation queue.
+// The listing stays IN_REVIEW until staff approve or reject it.
+func SubmitListingForReviewRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID) (*ent.ListingReview, error) {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := tx.Listing.Query().Where(listing.ID(listingID)).ForUpdate().Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, errors.New("listing not found")
+		}
+		return nil, err
+	}
+	if current.Status != listing.StatusDRAFT && current.Status != listing.StatusARCHIVED {
+		tx.Rollback()
+		return nil, ErrListingNotSubmittable
+	}
+
+	if err := tx.Listing.UpdateOneID(listingID).SetStatus(listing.StatusIN_REVIEW).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to submit listing for review: %w", err)
+	}
+	review, err := openListingReview(ctx, tx.Client(), listingID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	return review, nil
+}
+
+// requireListingManager fails unless the viewer is staff, the listing's realtor or the
+// lead of that realtor's team. Other viewers are told the listing does not exist.
+func requireListingManager(ctx context.Context, entClient *ent.Client, listingID uuid.UUID) error {
+	managed, err := managesListing(ctx, entClient, listingID)
+	if err != nil {
+		return err
+	}
+	if !managed {
+		return errors.New("listing not found")
+	}
+	return nil
+}
+
+// managesListing reports whether the viewer is staff, the listing's realtor or the lead
+// of that realtor's team.
+func managesListing(ctx context.Context, entClient *ent.Client, listingID uuid.UUID) (bool, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return false, nil
+	}
+	if v.IsStaff {
+		return true, nil
+	}
+	if v.RealtorID == nil {
+		return false, nil
+	}
+
+	return entClient.Listing.Query().
+		Where(listing.ID(listingID), rule.ManagedListings(v)).
+		Exist(ctx)
+}
+
+// GetListingReviewsRepo retrieves the reviews of a listing, newest first, including the
+// reasons of rejected ones. Only staff and the listing's realtor can see them.
+func GetListingReviewsRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID) ([]*ent.ListingReview, error) {
+	if err := requireListingManager(ctx, entClient, listingID); err != nil {
+		return nil, err
+	}
+
+	reviews, err := entClient.ListingReview.Query().
+		Where(listingreview.ListingIDEQ(listingID)).
+		Order(ent.Desc(listingreview.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get listing reviews: %w", err)
+	}
+
+	return reviews, nil
+}
+
+// GetReviewQueueRepo retrieves the moderation queue, oldest submission first, with the
+// SLA timer of every review. Without a status filter only pending reviews are listed.
+func GetReviewQueueRepo(ctx context.Context, entClient *ent.Client, params ReviewQueueParams, sla time.Duration) ([]ReviewQueueItem, ReviewQueueMeta, error) {
+	now := time.Now()
+	overdueBefore := now.Add(-sla)
+
+	status := listingreview.StatusPENDING
+	if params.Status != "" {
+		status = listingreview.Status(params.Status)
+	}
+	query := entClient.ListingReview.Query().Where(listingreview.StatusEQ(status))
+	if params.Kind != "" {
+		query = query.Where(listingreview.KindEQ(listingreview.Kind(params.Kind)))
+	}
+	if params.RealtorID != "" {
+		query = query.Where(listingreview.HasListingWith(listing.RealtorIDEQ(uuid.MustParse(params.RealtorID))))
+	}
+	if params.BrokerageID != "" {
+		brokerageID := uuid.MustParse(params.BrokerageID)
+		query = query.Where(listingreview.HasListingWith(listing.HasRealtorWith(realtor.BrokerageIDEQ(brokerageID))))
+	}
+	if params.City != "" {
+		query = query.Where(listingreview.HasListingWith(listing.CityEqualFold(params.City)))
+	}
+	if params.Country != "" {
+		query = query.Where(listingreview.HasListingWith(listing.CountryEQ(strings.ToUpper(params.Country))))
+	}
+	if params.Overdue {
+		query = query.Where(listingreview.StatusEQ(listingreview.StatusPENDING), listingreview.CreateTimeLTE(overdueBefore))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, ReviewQueueMeta{}, err
+	}
+
+	// Counts over the whole queue, regardless of the filters
+	pending, err := entClient.ListingReview.Query().
+		Where(listingreview.StatusEQ(listingreview.StatusPENDING)).
+		Count(ctx)
+	if err != nil {
+		return nil, ReviewQueueMeta{}, err
+	}
+	overdue, err := entClient.ListingReview.Query().
+		Where(listingreview.StatusEQ(listingreview.StatusPENDING), listingreview.CreateTimeLTE(overdueBefore)).
+		Count(ctx)
+	if err != nil {
+		return nil, ReviewQueueMeta{}, err
+	}
+
+	page := max(params.Page, 1)
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	reviews, err := query.
+		WithListing(func(q *ent.ListingQuery) {
+			q.WithRealtor()
+		}).
+		Order(ent.Asc(listingreview.FieldCreateTime)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, ReviewQueueMeta{}, fmt.Errorf("failed to get review queue: %w", err)
+	}
+
+	items := make([]ReviewQueueItem, 0, len(reviews))
+	for _, review := range reviews {
+		item := ReviewQueueItem{ListingReview: review, DueAt: review.CreateTime.Add(sla)}
+		if review.Status == listingreview.StatusPENDING {
+			remaining := int(math.Floor(item.DueAt.Sub(now).Minutes()))
+			item.RemainingMinutes = &remaining
+			item.Overdue = now.After(item.DueAt)
+		} else if review.DecidedAt != nil {
+			item.Overdue = review.DecidedAt.After(item.DueAt)
+		}
+		items = append(items, item)
+	}
+
+	meta := ReviewQueueMeta{
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+		Pending:  pending,
+		Overdue:  overdue,
+		SLAHours: sla.Hours(),
+	}
+
+	return items, meta, nil
+}
+
+// lockPendingReview loads a review with its listing and realtor inside a transaction,
+// locking the review, and fails unless it is still pending.
+func lockPendingReview(ctx context.Context, tx *ent.Tx, reviewID uuid.UUID) (*ent.ListingReview, *ent.Listing, error) {
+	review, err := tx.ListingReview.Query().Where(listingreview.ID(reviewID)).ForUpdate().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, ErrReviewNotFound
+		}
+		return nil, nil, err
+	}
+	if review.Status != listingreview.StatusPENDING {
+		return nil, nil, ErrReviewNotPending
+	}
+
+	l, err := tx.Listing.Query().Where(listing.ID(review.ListingID)).WithRealtor().ForUpdate().Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return review, l, nil
+}
+
+// ApproveListingReviewRepo approves a pending review. Approving a NEW review publishes
+// the listing, or schedules it when its publish_at is still ahead; approving an EDIT
+// review applies its changes. It returns the media items
+// the approved changes removed, so the caller can delete their files from storage.
+func ApproveListingReviewRepo(ctx context.Context, entClient *ent.Client, reviewID uuid.UUID, note string) (*ent.ListingReview, []schematype.Media, error) {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	review, l, err := lockPendingReview(ctx, tx, reviewID)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+
+	// Decide the review first, so ListingReviewHook does not withdraw it
+	review, err = review.Update().
+		SetStatus(listingreview.StatusAPPROVED).
+		SetDecidedAt(time.Now()).
+		SetNillableDecidedBy(viewerID(ctx)).
+		SetNote(note).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to approve review: %w", err)
+	}
+
+	var removed []schematype.Media
+	updater := tx.Listing.UpdateOneID(l.ID)
+	switch review.Kind {
+	case listingreview.KindNEW:
+		if l.Status != listing.StatusIN_REVIEW {
+			tx.Rollback()
+			return nil, nil, ErrReviewNotPending
+		}
+		updater = updater.SetStatus(listing.StatusPUBLISHED)
+	case listingreview.KindEDIT:
+		changes := review.Changes
+		if changes.Price != nil {
+			updater = updater.SetPrice(*changes.Price)
+		}
+		if changes.Description != nil {
+			if *changes.Description == "" {
+				updater = updater.ClearDescription()
+			} else {
+				updater = updater.SetDescription(*changes.Description)
+			}
+		}
+		if changes.Media != nil {
+			updater = updater.SetMedia(changes.Media)
+			removed = mediaNotIn(l.Media, changes.Media)
+		}
+		if changes.Translations != nil {
+			updater = updater.SetTranslations(changes.Translations)
+		}
+	}
+	updated, err := updater.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to apply review: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, nil, errors.New("failed to commit transaction")
+	}
+
+	subject := fmt.Sprintf("Your listing %q was approved", l.Title)
+	body := "The listing is now published."
+	if updated.Status == listing.StatusSCHEDULED {
+		body = "The listing will be published on " + updated.PublishAt.Format(time.RFC1123) + "."
+	}
+	if review.Kind == listingreview.KindEDIT {
+		subject = fmt.Sprintf("Your changes to %q were approved", l.Title)
+		body = "The changes are now live."
+	}
+	notifyReviewDecision(ctx, entClient, l, NotificationReviewApproved, subject, body, note)
+
+	return review, removed, nil
+}
+
+// RejectListingReviewRepo rejects a pending review with the reasons shown to the realtor.
+// A rejected NEW review moves the listing back to DRAFT; a rejected EDIT review leaves the
+// published listing unchanged. It returns the media items that were only uploaded for the
+// rejected changes, so the caller can delete their files from storage.
+func RejectListingReviewRepo(ctx context.Context, entClient *ent.Client, reviewID uuid.UUID, reasons []string, note string) (*ent.ListingReview, []schematype.Media, error) {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	review, l, err := lockPendingReview(ctx, tx, reviewID)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+
+	review, err = review.Update().
+		SetStatus(listingreview.StatusREJECTED).
+		SetDecidedAt(time.Now()).
+		SetNillableDecidedBy(viewerID(ctx)).
+		SetReasons(reasons).
+		SetNote(note).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to reject review: %w", err)
+	}
+
+	var discarded []schematype.Media
+	switch review.Kind {
+	case listingreview.KindNEW:
+		if l.Status == listing.StatusIN_REVIEW {
+			if err := tx.Listing.UpdateOneID(l.ID).SetStatus(listing.StatusDRAFT).Exec(ctx); err != nil {
+				tx.Rollback()
+				return nil, nil, fmt.Errorf("failed to reject review: %w", err)
+			}
+		}
+	case listingreview.KindEDIT:
+		if review.Changes.Media != nil {
+			discarded = mediaNotIn(review.Changes.Media, l.Media)
+		}
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, nil, errors.New("failed to commit transaction")
+	}
+
+	subject := fmt.Sprintf("Your listing %q was not approved", l.Title)
+	body := "The listing was moved back to draft. Address the reasons below and submit it again:"
+	if review.Kind == listingreview.KindEDIT {
+		subject = fmt.Sprintf("Your changes to %q were not approved", l.Title)
+		body = "The published listing was left unchanged for the following reasons:"
+	}
+	body += "\n- " + strings.Join(reasons, "\n- ")
+	notifyReviewDecision(ctx, entClient, l, NotificationReviewRejected, subject, body, note)
+
+	return review, discarded, nil
+}
+
+// notifyReviewDecision tells the listing's realtor about a decision. A failed
+// notification is only logged since the decision is already committed.
+func notifyReviewDecision(ctx context.Context, entClient *ent.Client, l *ent.Listing, kind, subject, body, note string) {
+	if l.Edges.Realtor == nil {
+		return
+	}
+	if note != "" {
+		body += "\n\nNote from the reviewer: " + note
+	}
+	if err := NotifyRepo(ctx, entClient, RealtorRecipient(l.Edges.Realtor), kind, subject, body); err != nil {
+		log.Printf("Failed to notify realtor about review of listing %s: %v", l.ID, err)
+	}
+}
+
+// mediaNotIn returns the items of media whose file is not used by any item of other.
+func mediaNotIn(media, other []schematype.Media) []schematype.Media {
+	used := make(map[string]bool, len(other))
+	for _, item := range other {
+		used[item.URL] = true
+	}
+	var missing []schematype.Media
+	for _, item := range media {
+		if !used[item.URL] {
+			missing = append(missing, item)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,326 @@
+package repositories
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/hook"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/neighborhood"
+	"ppgroup.ppgroup.com/ent/pointofinterest"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// importBatchSize caps how many rows one upsert statement writes.
+const importBatchSize = 500
+
+// NearbyPlace is a point of interest with its distance from a listing.
+type NearbyPlace struct {
+	ID             uuid.UUID                `json:"id"`
+	Name           string                   `json:"name"`
+	Category       pointofinterest.Category `json:"category"`
+	Subtype        string                   `json:"subtype,omitempty"`
+	Latitude       float64                  `json:"latitude"`
+	Longitude      float64                  `json:"longitude"`
+	DistanceMeters int                      `json:"distance_meters"`
+}
+
+// NearbyPlaces groups the points of interest near a listing by category, nearest first.
+type NearbyPlaces struct {
+	Schools []NearbyPlace `json:"schools"`
+	Parks   []NearbyPlace `json:"parks"`
+	Transit []NearbyPlace `json:"transit"`
+}
+
+// ImportSummary reports the outcome of a dataset import.
+type ImportSummary struct {
+	Imported int `json:"imported"`
+	// Relinked is the number of listings whose neighborhood changed
+	Relinked int `json:"relinked,omitempty"`
+}
+
+// ListingNeighborhoodHook links a listing to the neighborhood containing its coordinates
+// whenever they change, and unlinks it when they are cleared or fall outside every
+// neighborhood. Latitude and longitude must be given together.
+func ListingNeighborhoodHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.ListingFunc(func(ctx context.Context, m *ent.ListingMutation) (ent.Value, error) {
+			_, latSet := m.Latitude()
+			_, lngSet := m.Longitude()
+			if !latSet && !lngSet && !m.LatitudeCleared() && !m.LongitudeCleared() {
+				return next.Mutate(ctx, m)
+			}
+
+			lat, err := mutatedValue(ctx, m, m.Latitude, m.LatitudeCleared, m.OldLatitude)
+			if err != nil {
+				return nil, err
+			}
+			lng, err := mutatedValue(ctx, m, m.Longitude, m.LongitudeCleared, m.OldLongitude)
+			if err != nil {
+				return nil, err
+			}
+			if (lat == nil) != (lng == nil) {
+				return nil, fmt.Errorf("%w: latitude and longitude must be given together", services.ErrInvalidLocation)
+			}
+
+			var id *uuid.UUID
+			if lat != nil {
+				if id, err = neighborhoodAt(ctx, m.Client(), *lat, *lng); err != nil {
+					return nil, err
+				}
+			}
+			if id != nil {
+				m.SetNeighborhoodID(*id)
+			} else {
+				m.ClearNeighborhood()
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdateOne)
+}
+
+// neighborhoodAt returns the ID of the neighborhood whose boundary contains the
+// coordinates, or nil if there is none. Overlapping neighborhoods resolve to the
+// oldest one.
+func neighborhoodAt(ctx context.Context, entClient *ent.Client, lat, lng float64) (*uuid.UUID, error) {
+	candidates, err := entClient.Neighborhood.Query().
+		Where(
+			neighborhood.MinLatitudeLTE(lat), neighborhood.MaxLatitudeGTE(lat),
+			neighborhood.MinLongitudeLTE(lng), neighborhood.MaxLongitudeGTE(lng),
+		).
+		Order(ent.Asc(neighborhood.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find neighborhood: %w", err)
+	}
+
+	for _, n := range candidates {
+		if services.ContainsPoint(n.Boundary, lat, lng) {
+			return &n.ID, nil
+		}
+	}
+	return nil, nil
+}
+
+// ImportNeighborhoodsRepo creates or replaces neighborhoods matched by name, city, state
+// and country, then relinks every listing with coordinates to the neighborhood now
+// containing it.
+func ImportNeighborhoodsRepo(ctx context.Context, entClient *ent.Client, features []services.NeighborhoodFeature) (*ImportSummary, error) {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for batch := range slices.Chunk(features, importBatchSize) {
+		builders := make([]*ent.NeighborhoodCreate, 0, len(batch))
+		for _, f := range batch {
+			minLat, maxLat, minLng, maxLng := services.Bounds(f.Boundary)
+			builders = append(builders, tx.Neighborhood.Create().
+				SetName(f.Name).
+				SetCity(f.City).
+				SetState(f.State).
+				SetCountry(f.Country).
+				SetBoundary(f.Boundary).
+				SetMinLatitude(minLat).
+				SetMaxLatitude(maxLat).
+				SetMinLongitude(minLng).
+				SetMaxLongitude(maxLng))
+		}
+		err := tx.Neighborhood.CreateBulk(builders...).
+			OnConflictColumns(neighborhood.FieldName, neighborhood.FieldCity, neighborhood.FieldState, neighborhood.FieldCountry).
+			UpdateNewValues().
+			Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to import neighborhoods: %w", err)
+		}
+	}
+
+	relinked, err := relinkListingNeighborhoods(ctx, tx.Client())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	return &ImportSummary{Imported: len(features), Relinked: relinked}, nil
+}
+
+// relinkListingNeighborhoods points every listing with coordinates at the neighborhood
+// containing them and returns how many listings changed neighborhood.
+func relinkListingNeighborhoods(ctx context.Context, entClient *ent.Client) (int, error) {
+	neighborhoods, err := entClient.Neighborhood.Query().
+		Order(ent.Asc(neighborhood.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get neighborhoods: %w", err)
+	}
+	listings, err := entClient.Listing.Query().
+		Where(listing.LatitudeNotNil(), listing.LongitudeNotNil()).
+		Select(listing.FieldID, listing.FieldLatitude, listing.FieldLongitude, listing.FieldNeighborhoodID).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get listings: %w", err)
+	}
+
+	moves := make(map[uuid.UUID][]uuid.UUID)
+	for _, l := range listings {
+		target := uuid.Nil
+		for _, n := range neighborhoods {
+			if *l.Latitude >= n.MinLatitude && *l.Latitude <= n.MaxLatitude &&
+				*l.Longitude >= n.MinLongitude && *l.Longitude <= n.MaxLongitude &&
+				services.ContainsPoint(n.Boundary, *l.Latitude, *l.Longitude) {
+				target = n.ID
+				break
+			}
+		}
+		if target != l.NeighborhoodID {
+			moves[target] = append(moves[target], l.ID)
+		}
+	}
+
+	relinked := 0
+	for target, ids := range moves {
+		update := entClient.Listing.Update().Where(listing.IDIn(ids...))
+		if target == uuid.Nil {
+			update = update.ClearNeighborhoodID()
+		} else {
+			update = update.SetNeighborhoodID(target)
+		}
+		n, err := update.Save(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to relink listings: %w", err)
+		}
+		relinked += n
+	}
+	return relinked, nil
+}
+
+// ImportPointsOfInterestRepo creates points of interest, updating the subtype of those
+// already imported with the same category, name and coordinates.
+func ImportPointsOfInterestRepo(ctx context.Context, entClient *ent.Client, features []services.PointFeature) (*ImportSummary, error) {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for batch := range slices.Chunk(features, importBatchSize) {
+		builders := make([]*ent.PointOfInterestCreate, 0, len(batch))
+		for _, f := range batch {
+			builders = append(builders, tx.PointOfInterest.Create().
+				SetName(f.Name).
+				SetCategory(pointofinterest.Category(f.Category)).
+				SetSubtype(f.Subtype).
+				SetLatitude(f.Latitude).
+				SetLongitude(f.Longitude))
+		}
+		err := tx.PointOfInterest.CreateBulk(builders...).
+			OnConflictColumns(pointofinterest.FieldCategory, pointofinterest.FieldName,
+				pointofinterest.FieldLatitude, pointofinterest.FieldLongitude).
+			UpdateNewValues().
+			Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to import points of interest: %w", err)
+		}
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	return &ImportSummary{Imported: len(features)}, nil
+}
+
+// GetNeighborhoodsRepo lists neighborhoods by name, optionally only those in a city.
+// Boundaries are left out to keep the response small.
+func GetNeighborhoodsRepo(ctx context.Context, entClient *ent.Client, city string) ([]*ent.Neighborhood, error) {
+	query := entClient.Neighborhood.Query()
+	if city = strings.TrimSpace(city); city != "" {
+		query = query.Where(neighborhood.CityEqualFold(city))
+	}
+
+	neighborhoods, err := query.
+		Select(neighborhood.FieldID, neighborhood.FieldName, neighborhood.FieldCity,
+			neighborhood.FieldState, neighborhood.FieldCountry).
+		Order(ent.Asc(neighborhood.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get neighborhoods: %w", err)
+	}
+	return neighborhoods, nil
+}
+
+// GetNearbyPlacesRepo returns up to limit schools, parks and transit stops of each kind
+// within radius meters of the coordinates, nearest first.
+func GetNearbyPlacesRepo(ctx context.Context, entClient *ent.Client, lat, lng, radius float64, limit int) (*NearbyPlaces, error) {
+	minLat, maxLat, minLng, maxLng := services.BoundingBox(lat, lng, radius)
+	points, err := entClient.PointOfInterest.Query().
+		Where(
+			pointofinterest.LatitudeGTE(minLat), pointofinterest.LatitudeLTE(maxLat),
+			pointofinterest.LongitudeGTE(minLng), pointofinterest.LongitudeLTE(maxLng),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get points of interest: %w", err)
+	}
+
+	places := make([]NearbyPlace, 0, len(points))
+	for _, p := range points {
+		distance := services.DistanceMeters(lat, lng, p.Latitude, p.Longitude)
+		if distance > radius {
+			continue
+		}
+		places = append(places, NearbyPlace{
+			ID:             p.ID,
+			Name:           p.Name,
+			Category:       p.Category,
+			Subtype:        p.Subtype,
+			Latitude:       p.Latitude,
+			Longitude:      p.Longitude,
+			DistanceMeters: int(distance + 0.5),
+		})
+	}
+	slices.SortFunc(places, func(a, b NearbyPlace) int {
+		return cmp.Compare(a.DistanceMeters, b.DistanceMeters)
+	})
+
+	nearby := &NearbyPlaces{Schools: []NearbyPlace{}, Parks: []NearbyPlace{}, Transit: []NearbyPlace{}}
+	for _, p := range places {
+		var group *[]NearbyPlace
+		switch p.Category {
+		case pointofinterest.CategorySCHOOL:
+			group = &nearby.Schools
+		case pointofinterest.CategoryPARK:
+			group = &nearby.Parks
+		case pointofinterest.CategoryTRANSIT:
+			group = &nearby.Transit
+		}
+		if len(*group) < limit {
+			*group = append(*group, p)
+		}
+	}
+	return nearby, nil
+}
+
+// sameFloat reports whether two optional numbers are both unset or equal.
+func sameFloat(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/notification"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/internal/viewer"
+)
+
+// Notification kinds
+const (
+	NotificationListingExpiring    = "listing_expiring"
+	NotificationListingExpired     = "listing_expired"
+	NotificationReviewApproved     = "listing_review_approved"
+	NotificationReviewRejected     = "listing_review_rejected"
+	NotificationListingPublished   = "listing_published"
+	NotificationListingUnpublished = "listing_unpublished"
+	NotificationOfferSubmitted     = "offer_submitted"
+	NotificationOfferCountered     = "offer_countered"
+	NotificationOfferAccepted      = "offer_accepted"
+	NotificationOfferRejected      = "offer_rejected"
+	NotificationOfferWithdrawn     = "offer_withdrawn"
+	NotificationOfferExpired       = "offer_expired"
+	NotificationListingsReassigned = "listings_reassigned"
+)
+
+// Recipient is who a notification is for. It is delivered to Email and listed for the
+// account with UserID, or for the account linked to the realtor with RealtorID.
+type Recipient struct {
+	Email     string
+	UserID    *uuid.UUID
+	RealtorID *uuid.UUID
+}
+
+// UserRecipient addresses a notification to a user account.
+func UserRecipient(u *ent.User) Recipient {
+	return Recipient{Email: u.Email, UserID: &u.ID}
+}
+
+// RealtorRecipient addresses a notification to a realtor profile, so that it reaches
+// the realtor whether or not an account manages the profile yet.
+func RealtorRecipient(r *ent.Realtor) Recipient {
+	return Recipient{Email: r.Email, RealtorID: &r.ID}
+}
+
+// NotifyRepo stores a notification for the given recipient. Notifications follow changes
+// that were already authorized, so they are stored whoever made the change.
+func NotifyRepo(ctx context.Context, entClient *ent.Client, to Recipient, kind, subject, body string) error {
+	allowed := privacy.DecisionContext(ctx, privacy.Allow)
+	_, err := entClient.Notification.Create().
+		SetRecipientEmail(to.Email).
+		SetNillableUserID(to.UserID).
+		SetNillableRealtorID(to.RealtorID).
+		SetKind(kind).
+		SetSubject(subject).
+		SetBody(body).
+		Save(allowed)
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	log.Printf("Notification %q sent to %s: %s", kind, to.Email, subject)
+	return nil
+}
+
+// GetNotificationsRepo retrieves the notifications for the viewer's account and for the
+// realtor profile linked to it, newest first.
+func GetNotificationsRepo(ctx context.Context, entClient *ent.Client) ([]*ent.Notification, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return nil, privacy.Denyf("sign in to read notifications")
+	}
+
+	recipients := []predicate.Notification{notification.UserIDEQ(v.UserID)}
+	if v.RealtorID != nil {
+		recipients = append(recipients, notification.RealtorIDEQ(*v.RealtorID))
+	}
+
+	notifications, err := entClient.Notification.Query().
+		Where(notification.Or(recipients...)).
+		Order(ent.Desc(notification.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// linkNotificationRecipients links the notifications sent before they were keyed by
+// account to the realtor profile or user they were addressed to. Realtor profiles are
+// matched first, so an account registered with a realtor's email does not receive the
+// realtor's notifications. It runs once, see Migrations.
+func linkNotificationRecipients(ctx context.Context, client *ent.Client) error {
+	_, err := client.ExecContext(ctx, `UPDATE notifications n SET realtor_id = r.id
+		FROM realtors r
+		WHERE n.user_id IS NULL AND n.realtor_id IS NULL AND lower(r.email) = lower(n.recipient_email)`)
+	if err != nil {
+		return fmt.Errorf("failed to link notifications to realtors: %w", err)
+	}
+	_, err = client.ExecContext(ctx, `UPDATE notifications n SET user_id = u.id
+		FROM users u
+		WHERE n.user_id IS NULL AND n.realtor_id IS NULL AND lower(u.email) = lower(n.recipient_email)`)
+	if err != nil {
+		return fmt.Errorf("failed to link notifications to users: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,417 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/rule"
+	"ppgroup.ppgroup.com/internal/viewer"
+)
+
+var (
+	// ErrOfferNotFound is returned for offers that do not exist or the viewer takes no part in.
+	ErrOfferNotFound = errors.New("offer not found")
+	// ErrOfferNotPending is returned when an offer was already answered, withdrawn or has expired.
+	ErrOfferNotPending = errors.New("offer is no longer pending")
+	// ErrNotOfferRecipient is returned when the viewer tries to answer an offer they made, or
+	// withdraw one they received.
+	ErrNotOfferRecipient = errors.New("this side of the negotiation cannot do that")
+	// ErrInvalidOffer is returned for offers with missing or inconsistent terms.
+	ErrInvalidOffer = errors.New("invalid offer")
+	// ErrListingNotOpenForOffers is returned for offers on listings that are not published.
+	ErrListingNotOpenForOffers = errors.New("listing is not open for offers")
+)
+
+// Offer responses
+const (
+	OfferAccept   = "accept"
+	OfferReject   = "reject"
+	OfferCounter  = "counter"
+	OfferWithdraw = "withdraw"
+)
+
+// OfferTerms holds the terms of an offer or counteroffer.
+type OfferTerms struct {
+	Amount        decimal.Decimal `json:"amount" binding:"required"`
+	Contingencies []string        `json:"contingencies" binding:"omitempty,max=20,dive,required,max=200"`
+	ClosingDate   time.Time       `json:"closing_date" binding:"required"`
+	ExpiresAt     time.Time       `json:"expires_at" binding:"required"`
+	Message       string          `json:"message" binding:"max=2000"`
+}
+
+// validate checks the terms and returns their contingencies trimmed and without duplicates.
+func (t OfferTerms) validate() ([]string, error) {
+	now := time.Now()
+	switch {
+	case !t.Amount.IsPositive():
+		return nil, fmt.Errorf("%w: the amount must be positive", ErrInvalidOffer)
+	case !t.ExpiresAt.After(now):
+		return nil, fmt.Errorf("%w: the offer must expire in the future", ErrInvalidOffer)
+	case !t.ClosingDate.After(t.ExpiresAt):
+		return nil, fmt.Errorf("%w: the closing date must be after the offer expires", ErrInvalidOffer)
+	}
+
+	contingencies := []string{}
+	for _, c := range t.Contingencies {
+		if c = strings.TrimSpace(c); c != "" && !slices.Contains(contingencies, c) {
+			contingencies = append(contingencies, c)
+		}
+	}
+	return contingencies, nil
+}
+
+// SubmitOfferRepo records the viewer's offer on a published listing and notifies the
+// buyer and the listing's realtor. A buyer can have one pending offer per listing, and
+// realtors cannot make offers on their own listings.
+func SubmitOfferRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID, terms OfferTerms) (*ent.Offer, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return nil, privacy.Denyf("sign in to make offers")
+	}
+	contingencies, err := terms.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := entClient.Listing.Query().
+		Where(listing.ID(listingID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("listing not found")
+		}
+		return nil, err
+	}
+	if l.Status != listing.StatusPUBLISHED {
+		return nil, ErrListingNotOpenForOffers
+	}
+	if v.IsRealtor(l.RealtorID) {
+		return nil, fmt.Errorf("%w: realtors cannot make offers on their own listings", ErrInvalidOffer)
+	}
+	if v.RealtorID != nil && len(v.LedTeamIDs) > 0 {
+		managed, err := entClient.Listing.Query().
+			Where(listing.ID(l.ID), rule.ManagedListings(v)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if managed {
+			return nil, fmt.Errorf("%w: team leads cannot make offers on their team's listings", ErrInvalidOffer)
+		}
+	}
+
+	open, err := entClient.Offer.Query().
+		Where(offer.ListingIDEQ(listingID), offer.BuyerIDEQ(v.UserID), offer.StatusEQ(offer.StatusPENDING)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if open {
+		return nil, fmt.Errorf("%w: you already have a pending offer on this listing", ErrInvalidOffer)
+	}
+
+	created, err := entClient.Offer.Create().
+		SetListingID(listingID).
+		SetBuyerID(v.UserID).
+		SetSide(offer.SideBUYER).
+		SetMadeBy(v.UserID).
+		SetAmount(terms.Amount).
+		SetCurrency(l.Currency).
+		SetContingencies(contingencies).
+		SetClosingDate(terms.ClosingDate).
+		SetExpiresAt(terms.ExpiresAt).
+		SetMessage(terms.Message).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create offer: %w", err)
+	}
+
+	notifyOfferParties(ctx, entClient, created.ID, NotificationOfferSubmitted,
+		"New offer on %q", "An offer of %s was made and expires on %s.")
+
+	return created, nil
+}
+
+// GetListingOffersRepo retrieves the offers on a listing, oldest first. Staff and the
+// listing's realtor see every offer; buyers see the offers of their own negotiation.
+func GetListingOffersRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID) ([]*ent.Offer, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return nil, errors.New("listing not found")
+	}
+
+	query := entClient.Offer.Query().Where(offer.ListingIDEQ(listingID))
+	if requireListingManager(ctx, entClient, listingID) != nil {
+		query = query.Where(offer.BuyerIDEQ(v.UserID))
+	}
+
+	offers, err := query.Order(ent.Asc(offer.FieldCreateTime)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get offers: %w", err)
+	}
+	return offers, nil
+}
+
+// GetMyOffersRepo retrieves the offers the viewer is the buyer of with their listings,
+// newest first.
+func GetMyOffersRepo(ctx context.Context, entClient *ent.Client) ([]*ent.Offer, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return nil, privacy.Denyf("sign in to see your offers")
+	}
+
+	offers, err := entClient.Offer.Query().
+		Where(offer.BuyerIDEQ(v.UserID)).
+		WithListing().
+		Order(ent.Desc(offer.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get offers: %w", err)
+	}
+	return offers, nil
+}
+
+// RespondToOfferRepo answers a pending offer. The side that received the offer can accept,
+// reject or counter it; the side that made it can withdraw it. Countering chains a new
+// offer with the given terms to the answered one. Accepting puts the listing under
+// contract (PENDING) and rejects the other pending offers on it. Both sides of every
+// affected offer are notified. It returns the answered offer and, for a counter, the new one.
+func RespondToOfferRepo(ctx context.Context, entClient *ent.Client, offerID uuid.UUID, action string, counter *OfferTerms) (*ent.Offer, *ent.Offer, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return nil, nil, ErrOfferNotFound
+	}
+	var contingencies []string
+	if action == OfferCounter {
+		if counter == nil {
+			return nil, nil, fmt.Errorf("%w: a counteroffer needs terms", ErrInvalidOffer)
+		}
+		var err error
+		if contingencies, err = counter.validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := tx.Offer.Query().
+		Where(offer.ID(offerID)).
+		WithListing().
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, nil, ErrOfferNotFound
+		}
+		return nil, nil, err
+	}
+	l := current.Edges.Listing
+
+	// Work out which side of the negotiation the viewer is on
+	isBuyer := current.BuyerID == v.UserID
+	isSeller, err := managesListing(ctx, tx.Client(), l.ID)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+	if !isBuyer && !isSeller {
+		tx.Rollback()
+		return nil, nil, ErrOfferNotFound
+	}
+	madeIt := (current.Side == offer.SideBUYER && isBuyer) || (current.Side == offer.SideSELLER && isSeller)
+	if (action == OfferWithdraw) != madeIt {
+		tx.Rollback()
+		return nil, nil, ErrNotOfferRecipient
+	}
+	if current.Status != offer.StatusPENDING || !current.ExpiresAt.After(time.Now()) {
+		tx.Rollback()
+		return nil, nil, ErrOfferNotPending
+	}
+
+	status := map[string]offer.Status{
+		OfferAccept:   offer.StatusACCEPTED,
+		OfferReject:   offer.StatusREJECTED,
+		OfferCounter:  offer.StatusCOUNTERED,
+		OfferWithdraw: offer.StatusWITHDRAWN,
+	}[action]
+	answered, err := current.Update().
+		SetStatus(status).
+		SetRespondedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to answer offer: %w", err)
+	}
+
+	var countered *ent.Offer
+	var declined []uuid.UUID
+	switch action {
+	case OfferCounter:
+		side := offer.SideSELLER
+		if current.Side == offer.SideSELLER {
+			side = offer.SideBUYER
+		}
+		countered, err = tx.Offer.Create().
+			SetListingID(current.ListingID).
+			SetBuyerID(current.BuyerID).
+			SetPreviousID(current.ID).
+			SetSide(side).
+			SetMadeBy(v.UserID).
+			SetAmount(counter.Amount).
+			SetCurrency(current.Currency).
+			SetContingencies(contingencies).
+			SetClosingDate(counter.ClosingDate).
+			SetExpiresAt(counter.ExpiresAt).
+			SetMessage(counter.Message).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, nil, fmt.Errorf("failed to create counteroffer: %w", err)
+		}
+	case OfferAccept:
+		if l.Status != listing.StatusPUBLISHED {
+			tx.Rollback()
+			return nil, nil, ErrListingNotOpenForOffers
+		}
+		// Going under contract follows from the accepted offer, whichever side accepted it
+		allowed := privacy.DecisionContext(ctx, privacy.Allow)
+		err = tx.Listing.UpdateOneID(l.ID).
+			Where(listing.StatusEQ(listing.StatusPUBLISHED)).
+			SetStatus(listing.StatusPENDING).
+			Exec(allowed)
+		if err != nil {
+			tx.Rollback()
+			if ent.IsNotFound(err) {
+				return nil, nil, ErrListingNotOpenForOffers
+			}
+			return nil, nil, fmt.Errorf("failed to put listing under contract: %w", err)
+		}
+		if declined, err = tx.Offer.Query().
+			Where(offer.ListingIDEQ(l.ID), offer.StatusEQ(offer.StatusPENDING)).
+			IDs(ctx); err != nil {
+			tx.Rollback()
+			return nil, nil, err
+		}
+		if len(declined) > 0 {
+			err = tx.Offer.Update().
+				Where(offer.IDIn(declined...)).
+				SetStatus(offer.StatusREJECTED).
+				SetRespondedAt(time.Now()).
+				Exec(allowed)
+			if err != nil {
+				tx.Rollback()
+				return nil, nil, fmt.Errorf("failed to decline other offers: %w", err)
+			}
+		}
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, nil, errors.New("failed to commit transaction")
+	}
+
+	switch action {
+	case OfferAccept:
+		notifyOfferParties(ctx, entClient, answered.ID, NotificationOfferAccepted,
+			"Offer accepted on %q", "The offer of %s (expiring %s) was accepted. The listing is now under contract.")
+		for _, id := range declined {
+			notifyOfferParties(ctx, entClient, id, NotificationOfferRejected,
+				"Offer declined on %q", "The offer of %s (expiring %s) was declined because another offer was accepted.")
+		}
+	case OfferReject:
+		notifyOfferParties(ctx, entClient, answered.ID, NotificationOfferRejected,
+			"Offer rejected on %q", "The offer of %s (expiring %s) was rejected.")
+	case OfferCounter:
+		notifyOfferParties(ctx, entClient, countered.ID, NotificationOfferCountered,
+			"Counteroffer on %q", "A counteroffer of %s was made and expires on %s.")
+	case OfferWithdraw:
+		notifyOfferParties(ctx, entClient, answered.ID, NotificationOfferWithdrawn,
+			"Offer withdrawn on %q", "The offer of %s (expiring %s) was withdrawn.")
+	}
+
+	return answered, countered, nil
+}
+
+// ExpireOffersRepo marks pending offers whose expiry has passed as EXPIRED and notifies
+// both sides. Each offer is expired with a conditional update, so when several replicas
+// run the job only one notifies about it.
+func ExpireOffersRepo(entClient *ent.Client) (int, error) {
+	ctx := systemContext()
+
+	due, err := entClient.Offer.Query().
+		Where(offer.StatusEQ(offer.StatusPENDING), offer.ExpiresAtLTE(time.Now())).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get expired offers: %w", err)
+	}
+
+	expired := 0
+	for _, id := range due {
+		err := entClient.Offer.UpdateOneID(id).
+			Where(offer.StatusEQ(offer.StatusPENDING)).
+			SetStatus(offer.StatusEXPIRED).
+			Exec(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return expired, fmt.Errorf("failed to expire offer %s: %w", id, err)
+		}
+		expired++
+
+		notifyOfferParties(ctx, entClient, id, NotificationOfferExpired,
+			"Offer expired on %q", "The offer of %s expired on %s without an answer.")
+	}
+
+	return expired, nil
+}
+
+// notifyOfferParties notifies the buyer and the listing's realtor about an offer. The
+// subject is formatted with the listing title, the body with the amount and expiry.
+func notifyOfferParties(ctx context.Context, entClient *ent.Client, offerID uuid.UUID, kind, subject, body string) {
+	o, err := entClient.Offer.Query().
+		Where(offer.ID(offerID)).
+		WithBuyer().
+		WithListing(func(q *ent.ListingQuery) {
+			q.WithRealtor(func(rq *ent.RealtorQuery) { rq.Select(realtor.FieldID, realtor.FieldEmail) })
+		}).
+		Only(ctx)
+	if err != nil {
+		log.Printf("Failed to load offer %s for notifications: %v", offerID, err)
+		return
+	}
+
+	l := o.Edges.Listing
+	var recipients []Recipient
+	if o.Edges.Buyer != nil {
+		recipients = append(recipients, UserRecipient(o.Edges.Buyer))
+	}
+	if l.Edges.Realtor != nil {
+		recipients = append(recipients, RealtorRecipient(l.Edges.Realtor))
+	}
+	amount := o.Amount.StringFixed(2) + " " + o.Currency
+	for _, to := range recipients {
+		err := NotifyRepo(ctx, entClient, to, kind,
+			fmt.Sprintf(subject, l.Title), fmt.Sprintf(body, amount, o.ExpiresAt.Format(time.RFC1123)))
+		if err != nil {
+			log.Printf("Failed to notify about offer %s: %v", offerID, err)
+		}
+	}
+}
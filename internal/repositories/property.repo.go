@@ -0,0 +1,194 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/property"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// activeListingStatuses are the statuses that still occupy a property.
+// A property can only be relisted once its previous listing left these states.
+var activeListingStatuses = []listing.Status{
+	listing.StatusDRAFT,
+	listing.StatusIN_REVIEW,
+	listing.StatusSCHEDULED,
+	listing.StatusPUBLISHED,
+	listing.StatusPENDING,
+}
+
+// upsertPropertyForListing returns the ID of the property at the listing's address,
+// creating it when the address has never been listed before. Addresses are matched on
+// their normalized key, which includes the ZIP code, within the listing's city and
+// country, so "123 Main St" and "123 Main Street" share a property while the same street
+// address in another town does not. The property's physical attributes are refreshed
+// from the listing so they always reflect the latest listing. The caller writes the
+// listing in the same transaction, and the listing policy decides whether that is allowed.
+func upsertPropertyForListing(ctx context.Context, entClient *ent.Client, data *ent.Listing) (uuid.UUID, error) {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	existing, err := entClient.Property.Query().
+		Where(propertyAt(data.Address, data.ZipCode, data.City, data.Country)...).
+		Order(ent.Asc(property.FieldCreateTime)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return uuid.Nil, err
+	}
+
+	if existing == nil {
+		creator := entClient.Property.Create().
+			SetAddress(data.Address).
+			SetCity(data.City).
+			SetState(data.State).
+			SetZipCode(data.ZipCode).
+			SetCountry(data.Country).
+			SetTypeOfProperty(property.TypeOfProperty(data.TypeOfProperty)).
+			SetBedroom(data.Bedroom).
+			SetBathroom(data.Bathroom).
+			SetSqft(data.Sqft).
+			SetNillableSqm(nonZero(data.Sqm)).
+			SetPool(data.Pool).
+			SetYearBuilt(data.YearBuilt)
+		if data.Garage > 0 {
+			creator = creator.SetGarage(data.Garage)
+		}
+		if data.LotSize > 0 {
+			creator = creator.SetLotSize(data.LotSize)
+		}
+
+		created, err := creator.Save(ctx)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to create property: %w", err)
+		}
+		return created.ID, nil
+	}
+
+	updater := existing.Update().
+		SetCity(data.City).
+		SetState(data.State).
+		SetZipCode(data.ZipCode).
+		SetCountry(data.Country).
+		SetTypeOfProperty(property.TypeOfProperty(data.TypeOfProperty)).
+		SetBedroom(data.Bedroom).
+		SetBathroom(data.Bathroom).
+		SetSqft(data.Sqft).
+		SetNillableSqm(nonZero(data.Sqm)).
+		SetPool(data.Pool).
+		SetYearBuilt(data.YearBuilt)
+	if data.Garage > 0 {
+		updater = updater.SetGarage(data.Garage)
+	} else {
+		updater = updater.ClearGarage()
+	}
+	if data.LotSize > 0 {
+		updater = updater.SetLotSize(data.LotSize)
+	} else {
+		updater = updater.ClearLotSize()
+	}
+	if data.Sqm == 0 {
+		updater = updater.ClearSqm()
+	}
+
+	if _, err := updater.Save(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to update property: %w", err)
+	}
+	return existing.ID, nil
+}
+
+// propertyChanged reports whether an update changes the address or the physical
+// attributes that a listing shares with its property.
+func propertyChanged(data, current *ent.Listing) bool {
+	return data.Address != current.Address || data.City != current.City || data.State != current.State ||
+		data.ZipCode != current.ZipCode || data.Country != current.Country ||
+		data.TypeOfProperty != current.TypeOfProperty || data.Bedroom != current.Bedroom ||
+		data.Bathroom != current.Bathroom || data.Garage != current.Garage || data.Sqft != current.Sqft ||
+		data.Sqm != current.Sqm || data.LotSize != current.LotSize || data.Pool != current.Pool ||
+		data.YearBuilt != current.YearBuilt
+}
+
+// propertyAt matches the property at an address. The city and country are left out of
+// the match when empty.
+func propertyAt(address, zipCode, city, country string) []predicate.Property {
+	ps := []predicate.Property{property.AddressKeyEQ(services.NormalizeAddress(address, zipCode).Key())}
+	if city != "" {
+		ps = append(ps, property.CityEqualFold(city))
+	}
+	if country != "" {
+		ps = append(ps, property.CountryEQ(strings.ToUpper(country)))
+	}
+	return ps
+}
+
+// PropertyHistoryParams identifies a property by its address.
+type PropertyHistoryParams struct {
+	Address string `form:"address" binding:"required"`
+	ZipCode string `form:"zip_code" binding:"required"`
+	City    string `form:"city"`
+	Country string `form:"country" binding:"omitempty,len=2"`
+}
+
+// ErrAmbiguousProperty is returned when an address matches properties in several cities
+// or countries and none was given.
+var ErrAmbiguousProperty = errors.New("several properties match the address; give the city and country")
+
+// GetPropertyHistoryRepo retrieves a property by address together with every listing
+// ever created for it, oldest first. Each listing carries its realtor so callers can
+// show who listed the property and how each listing ended.
+func GetPropertyHistoryRepo(entClient *ent.Client, params PropertyHistoryParams) (*ent.Property, error) {
+	ctx := context.Background()
+
+	prop, err := entClient.Property.Query().
+		Where(propertyAt(params.Address, params.ZipCode, params.City, params.Country)...).
+		WithListings(func(q *ent.ListingQuery) {
+			q.WithRealtor(func(rq *ent.RealtorQuery) {
+				rq.Select(realtor.FieldID, realtor.FieldFullName, realtor.FieldEmail, realtor.FieldPhone)
+			})
+			q.Order(ent.Asc(listing.FieldCreateTime))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("property not found")
+		}
+		if ent.IsNotSingular(err) {
+			return nil, ErrAmbiguousProperty
+		}
+		return nil, fmt.Errorf("failed to get property history: %w", err)
+	}
+
+	return prop, nil
+}
+
+// linkListingProperties links every listing that predates the Property entity to a
+// property, creating one property per distinct address. Listings are processed oldest
+// first so that the newest listing's attributes win. It runs once, see Migrations.
+func linkListingProperties(ctx context.Context, client *ent.Client) error {
+	orphans, err := client.Listing.Query().
+		Where(listing.PropertyIDIsNil()).
+		Order(ent.Asc(listing.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load unlinked listings: %w", err)
+	}
+
+	for _, l := range orphans {
+		propertyID, err := upsertPropertyForListing(ctx, client, l)
+		if err != nil {
+			return fmt.Errorf("failed to backfill property for listing %s: %w", l.ID, err)
+		}
+		if err := client.Listing.UpdateOneID(l.ID).SetPropertyID(propertyID).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to link listing %s to property: %w", l.ID, err)
+		}
+	}
+
+	return nil
+}
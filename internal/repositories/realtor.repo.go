@@ -3,11 +3,101 @@ package repositories
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/predicate"
+	"ppgroup.ppgroup.com/ent/privacy"
 	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+	"ppgroup.ppgroup.com/ent/schematype"
+	"ppgroup.ppgroup.com/ent/team"
+	"ppgroup.ppgroup.com/internal/viewer"
 )
 
+var (
+	// ErrRealtorNotFound is returned for realtor IDs that do not exist.
+	ErrRealtorNotFound = errors.New("realtor not found")
+	// ErrInvalidRealtor is returned for realtor profiles with invalid fields.
+	ErrInvalidRealtor = errors.New("invalid realtor")
+	// ErrRealtorExists is returned when another realtor already has the email or phone.
+	ErrRealtorExists = errors.New("realtor with the given email or phone already exists")
+	// ErrRealtorInactive is returned when listings are given to a deactivated realtor.
+	ErrRealtorInactive = errors.New("realtor is deactivated")
+	// ErrReassignmentRequired is returned when a realtor who still has listings is
+	// deactivated or deleted without naming the realtor who takes them over.
+	ErrReassignmentRequired = errors.New("the realtor's listings must be reassigned to another realtor")
+	// ErrNotRealtor is returned for realtor self-service by users without a realtor profile.
+	ErrNotRealtor = errors.New("no realtor profile is linked to your account")
+)
+
+// Limits of a realtor's service areas
+const (
+	maxServiceAreas      = 20
+	maxServiceAreaLength = 100
+)
+
+// RealtorSearchParams holds the filters and paging of the realtor search.
+type RealtorSearchParams struct {
+	// Name matches part of the full name, case-insensitively
+	Name  string `form:"name"`
+	Email string `form:"email"`
+	// Area is a city or ZIP code the realtor serves or has active listings in
+	Area        string `form:"area"`
+	BrokerageID string `form:"brokerage_id" binding:"omitempty,uuid"`
+	TeamID      string `form:"team_id" binding:"omitempty,uuid"`
+	// IncludeInactive also lists deactivated realtors; it is only honored for staff
+	IncludeInactive bool `form:"include_inactive"`
+	Page            int  `form:"page" binding:"omitempty,min=1"`
+	PageSize        int  `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// RealtorListingsParams holds the filters and paging of a realtor's own listings.
+type RealtorListingsParams struct {
+	Status   string `form:"status"`
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// RealtorSearchMeta holds the paging of a realtor search or of a realtor's listings.
+type RealtorSearchMeta struct {
+	Total    int `json:"total"`
+	Page     int `json:"page"`
+	PageSize int `json:"page_size"`
+}
+
+// ReassignmentSummary reports the outcome of deactivating or deleting a realtor.
+type ReassignmentSummary struct {
+	// Reassigned is the number of listings handed over to the other realtor
+	Reassigned int `json:"reassigned"`
+}
+
+// normalizeServiceAreas trims the service areas and drops empty and duplicate ones.
+func normalizeServiceAreas(areas []string) ([]string, error) {
+	normalized := []string{}
+	for _, area := range areas {
+		area = strings.TrimSpace(area)
+		if area == "" || slices.ContainsFunc(normalized, func(a string) bool { return strings.EqualFold(a, area) }) {
+			continue
+		}
+		if len(area) > maxServiceAreaLength {
+			return nil, fmt.Errorf("%w: service areas must be at most %d characters", ErrInvalidRealtor, maxServiceAreaLength)
+		}
+		normalized = append(normalized, area)
+	}
+	if len(normalized) > maxServiceAreas {
+		return nil, fmt.Errorf("%w: a realtor can have at most %d service areas", ErrInvalidRealtor, maxServiceAreas)
+	}
+	return normalized, nil
+}
+
 // CreateRealtorRepository creates a new realtor record in the database.
 // It first checks if a realtor with the given email or phone already exists.
 // If such a realtor exists, it returns an error.
@@ -15,20 +105,27 @@ import (
 // The operation is performed within a transaction to ensure atomicity.
 //
 // Parameters:
-//   - data: CreateRealtorInput containing the details of the realtor to be created.
+//   - data: the details of the realtor to be created.
 //
 // Returns:
 //   - error: An error if the realtor already exists or if the creation fails, otherwise nil.
-func CreateRealtorRepo(entClient *ent.Client, data *ent.Realtor) error {
-	ctx := context.Background()
-
+func CreateRealtorRepo(ctx context.Context, entClient *ent.Client, data *ent.Realtor) error {
 	exists, err := entClient.Realtor.Query().Where(realtor.Or(realtor.EmailEQ(data.Email), realtor.PhoneEQ(data.Phone))).Exist(ctx)
 	if err != nil {
 		return err
 	}
 
 	if exists {
-		return errors.New("realtor with the given email or phone already exists")
+		return ErrRealtorExists
+	}
+
+	serviceAreas, err := normalizeServiceAreas(data.ServiceAreas)
+	if err != nil {
+		return err
+	}
+	brokerageID, err := resolveRealtorBrokerage(ctx, entClient, data.BrokerageID, data.TeamID)
+	if err != nil {
+		return err
 	}
 
 	// Start a transaction
@@ -38,10 +135,19 @@ func CreateRealtorRepo(entClient *ent.Client, data *ent.Realtor) error {
 	}
 
 	// Create a new realtor
-	_, err = tx.Realtor.Create().SetEmail(data.Email).SetFullName(data.FullName).SetPhone(data.Phone).SetIsMvp(data.IsMvp).Save(context.Background())
+	_, err = tx.Realtor.Create().
+		SetEmail(data.Email).
+		SetFullName(data.FullName).
+		SetPhone(data.Phone).
+		SetIsMvp(data.IsMvp).
+		SetDescription(data.Description).
+		SetServiceAreas(serviceAreas).
+		SetNillableBrokerageID(brokerageID).
+		SetNillableTeamID(data.TeamID).
+		Save(ctx)
 	if err != nil {
 		tx.Rollback()
-		return errors.New("failed to create realtor" + err.Error())
+		return fmt.Errorf("failed to create realtor: %w", err)
 	}
 
 	// Commit the transaction
@@ -52,33 +158,460 @@ func CreateRealtorRepo(entClient *ent.Client, data *ent.Realtor) error {
 	return nil
 }
 
-// GetRealtorRepository retrieves a realtor record from the database based on the provided email.
-// It returns a models.Realtor object and an error if any occurred during the query.
-// If the realtor is not found, it returns an error indicating "user not found".
-// If there is any other error during the query, it returns an error indicating "failed to get user".
-func GetRealtorRepo(entClient *ent.Client, email string) (*ent.Realtor, error) {
-	ctx := context.Background()
+// GetRealtorRepo retrieves a realtor by ID.
+func GetRealtorRepo(ctx context.Context, entClient *ent.Client, id uuid.UUID) (*ent.Realtor, error) {
+	r, err := entClient.Realtor.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrRealtorNotFound
+		}
+		return nil, fmt.Errorf("failed to get realtor: %w", err)
+	}
+
+	return r, nil
+}
+
+// RequireRealtorEditorRepo checks that the realtor exists and that the viewer may change
+// their profile, which staff and the realtor themselves can do. It lets callers refuse a
+// change before storing anything for it, such as an uploaded photo.
+func RequireRealtorEditorRepo(ctx context.Context, entClient *ent.Client, id uuid.UUID) error {
+	if _, err := GetRealtorRepo(ctx, entClient, id); err != nil {
+		return err
+	}
+
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return privacy.Denyf("sign in to make changes")
+	}
+	if !v.IsStaff && !v.IsRealtor(id) {
+		return privacy.Denyf("only staff and the realtor can change this profile")
+	}
+	return nil
+}
+
+// GetMyRealtorRepo retrieves the realtor profile linked to the viewer's account.
+func GetMyRealtorRepo(ctx context.Context, entClient *ent.Client) (*ent.Realtor, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil || v.RealtorID == nil {
+		return nil, ErrNotRealtor
+	}
+
+	return GetRealtorRepo(ctx, entClient, *v.RealtorID)
+}
+
+// GetMyRealtorListingsRepo lists the listings of the realtor linked to the viewer's
+// account in every status, most recently updated first.
+func GetMyRealtorListingsRepo(ctx context.Context, entClient *ent.Client, params RealtorListingsParams) ([]Listing, RealtorSearchMeta, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil || v.RealtorID == nil {
+		return nil, RealtorSearchMeta{}, ErrNotRealtor
+	}
+
+	query := entClient.Listing.Query().Where(listing.RealtorIDEQ(*v.RealtorID))
+	return pageRealtorListings(ctx, query, params)
+}
+
+// pageRealtorListings filters listings by status and returns one page of them, most
+// recently updated first.
+func pageRealtorListings(ctx context.Context, query *ent.ListingQuery, params RealtorListingsParams) ([]Listing, RealtorSearchMeta, error) {
+	if params.Status != "" {
+		status := listing.Status(strings.ToUpper(params.Status))
+		if err := listing.StatusValidator(status); err != nil {
+			return nil, RealtorSearchMeta{}, fmt.Errorf("%w: unknown status %s", ErrInvalidRealtor, params.Status)
+		}
+		query = query.Where(listing.StatusEQ(status))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, RealtorSearchMeta{}, err
+	}
+
+	page := max(params.Page, 1)
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	listings, err := query.
+		Order(ent.Desc(listing.FieldUpdateTime), ent.Asc(listing.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, RealtorSearchMeta{}, fmt.Errorf("failed to get realtor listings: %w", err)
+	}
+
+	return WithComputedFields(listings...), RealtorSearchMeta{Total: total, Page: page, PageSize: pageSize}, nil
+}
+
+// SearchRealtorsRepo lists realtors by name, one page at a time. Deactivated realtors are
+// left out unless staff ask for them.
+func SearchRealtorsRepo(ctx context.Context, entClient *ent.Client, params RealtorSearchParams) ([]*ent.Realtor, RealtorSearchMeta, error) {
+	query := entClient.Realtor.Query()
+	if v := viewer.FromContext(ctx); !params.IncludeInactive || v == nil || !v.IsStaff {
+		query = query.Where(realtor.Active(true))
+	}
+	if name := strings.TrimSpace(params.Name); name != "" {
+		query = query.Where(realtor.FullNameContainsFold(name))
+	}
+	if email := strings.TrimSpace(params.Email); email != "" {
+		query = query.Where(realtor.EmailEqualFold(email))
+	}
+	if params.BrokerageID != "" {
+		query = query.Where(realtor.BrokerageIDEQ(uuid.MustParse(params.BrokerageID)))
+	}
+	if params.TeamID != "" {
+		query = query.Where(realtor.TeamIDEQ(uuid.MustParse(params.TeamID)))
+	}
+	if area := strings.TrimSpace(params.Area); area != "" {
+		query = query.Where(realtor.Or(
+			servesArea(area),
+			realtor.HasListingsWith(
+				listing.StatusIn(activeListingStatuses...),
+				listing.Or(listing.CityEqualFold(area), listing.ZipCodeEqualFold(area)),
+			),
+		))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, RealtorSearchMeta{}, err
+	}
+
+	page := max(params.Page, 1)
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	realtors, err := query.
+		Order(ent.Asc(realtor.FieldFullName), ent.Asc(realtor.FieldID)).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, RealtorSearchMeta{}, fmt.Errorf("failed to search realtors: %w", err)
+	}
+
+	return realtors, RealtorSearchMeta{Total: total, Page: page, PageSize: pageSize}, nil
+}
+
+// servesArea matches realtors with the area among their service areas, ignoring case.
+func servesArea(area string) predicate.Realtor {
+	return predicate.Realtor(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("EXISTS (SELECT 1 FROM jsonb_array_elements_text(").
+				Ident(s.C(realtor.FieldServiceAreas)).
+				WriteString(") AS area WHERE lower(area) = lower(").
+				Arg(area).
+				WriteString("))")
+		}))
+	})
+}
+
+// UpdateRealtorRepo updates the fields of a realtor that differ from the stored ones.
+// Realtors can edit their own profile; staff can edit any, including the MVP badge.
+func UpdateRealtorRepo(ctx context.Context, entClient *ent.Client, data *ent.Realtor) (*ent.Realtor, error) {
+	current, err := GetRealtorRepo(ctx, entClient, data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Another realtor may already use the new email or phone
+	var taken []predicate.Realtor
+	if !strings.EqualFold(data.Email, current.Email) {
+		taken = append(taken, realtor.EmailEqualFold(data.Email))
+	}
+	if data.Phone != current.Phone {
+		taken = append(taken, realtor.PhoneEQ(data.Phone))
+	}
+	if len(taken) > 0 {
+		exists, err := entClient.Realtor.Query().
+			Where(realtor.IDNEQ(data.ID), realtor.Or(taken...)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, ErrRealtorExists
+		}
+	}
+
+	serviceAreas, err := normalizeServiceAreas(data.ServiceAreas)
+	if err != nil {
+		return nil, err
+	}
+	brokerageID, err := resolveRealtorBrokerage(ctx, entClient, data.BrokerageID, data.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updater := tx.Realtor.UpdateOneID(data.ID)
+	if data.FullName != current.FullName {
+		updater = updater.SetFullName(data.FullName)
+	}
+	if data.Email != current.Email {
+		updater = updater.SetEmail(data.Email)
+	}
+	if data.Phone != current.Phone {
+		updater = updater.SetPhone(data.Phone)
+	}
+	if data.Description != current.Description {
+		if data.Description == "" {
+			updater = updater.ClearDescription()
+		} else {
+			updater = updater.SetDescription(data.Description)
+		}
+	}
+	if data.IsMvp != current.IsMvp {
+		updater = updater.SetIsMvp(data.IsMvp)
+	}
+	if !slices.Equal(serviceAreas, current.ServiceAreas) {
+		if len(serviceAreas) == 0 {
+			updater = updater.ClearServiceAreas()
+		} else {
+			updater = updater.SetServiceAreas(serviceAreas)
+		}
+	}
+	if !sameValue(brokerageID, current.BrokerageID) {
+		if brokerageID == nil {
+			updater = updater.ClearBrokerageID()
+		} else {
+			updater = updater.SetBrokerageID(*brokerageID)
+		}
+	}
+	teamChanged := !sameValue(data.TeamID, current.TeamID)
+	if teamChanged {
+		if data.TeamID == nil {
+			updater = updater.ClearTeamID()
+		} else {
+			updater = updater.SetTeamID(*data.TeamID)
+		}
+	}
+
+	// A realtor leaving a team no longer leads it
+	if teamChanged && current.TeamID != nil {
+		err := tx.Team.Update().
+			Where(team.ID(*current.TeamID), team.LeadIDEQ(data.ID)).
+			ClearLeadID().
+			Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to clear team lead: %w", err)
+		}
+	}
+
+	updated, err := updater.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to update realtor: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	return updated, nil
+}
+
+// SetRealtorPhotoRepo replaces the realtor's photo, or removes it when photo is nil, and
+// returns the photo it replaced so its files can be deleted. Realtors can change their
+// own photo; staff can change any.
+func SetRealtorPhotoRepo(ctx context.Context, entClient *ent.Client, id uuid.UUID, photo *schematype.Photo) (*ent.Realtor, *schematype.Photo, error) {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Lock the realtor so concurrent uploads each get the photo they replaced
+	current, err := tx.Realtor.Query().
+		Where(realtor.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, nil, ErrRealtorNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get realtor: %w", err)
+	}
+
+	updater := tx.Realtor.UpdateOneID(id)
+	if photo == nil {
+		updater = updater.ClearPhoto()
+	} else {
+		updater = updater.SetPhoto(photo)
+	}
+	updated, err := updater.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, fmt.Errorf("failed to update realtor photo: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, nil, errors.New("failed to commit transaction")
+	}
+
+	return updated, current.Photo, nil
+}
+
+// requireActiveRealtor checks that listings can be given to the realtor.
+func requireActiveRealtor(ctx context.Context, entClient *ent.Client, id uuid.UUID) error {
+	r, err := entClient.Realtor.Query().
+		Where(realtor.ID(id)).
+		Select(realtor.FieldID, realtor.FieldActive).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrRealtorNotFound
+		}
+		return err
+	}
+	if !r.Active {
+		return ErrRealtorInactive
+	}
+	return nil
+}
+
+// DeactivateRealtorRepo deactivates a realtor so they take no new listings. Their active
+// listings are handed over to reassignTo, which is required when there are any; sold and
+// archived listings stay with them. Staff only.
+func DeactivateRealtorRepo(ctx context.Context, entClient *ent.Client, id uuid.UUID, reassignTo *uuid.UUID) (*ent.Realtor, *ReassignmentSummary, error) {
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reassigned, err := reassignRealtorListings(ctx, tx.Client(), id, reassignTo, activeListingStatuses)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
 
-	realtor, err := entClient.Realtor.Query().Where(realtor.EmailEQ(email)).First(ctx)
+	deactivated, err := tx.Realtor.UpdateOneID(id).
+		SetActive(false).
+		SetDeactivatedAt(time.Now()).
+		Save(ctx)
 	if err != nil {
+		tx.Rollback()
 		if ent.IsNotFound(err) {
-			return nil, errors.New("realtor not found")
+			return nil, nil, ErrRealtorNotFound
 		}
-		return nil, errors.New("failed to get realtor")
+		return nil, nil, fmt.Errorf("failed to deactivate realtor: %w", err)
 	}
 
-	return realtor, nil
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, nil, errors.New("failed to commit transaction")
+	}
+
+	notifyListingsReassigned(ctx, entClient, deactivated.FullName, reassignTo, reassigned)
+	return deactivated, &ReassignmentSummary{Reassigned: reassigned}, nil
 }
 
-func GetRealtorsRepo(entClient *ent.Client) ([]*ent.Realtor, error) {
-	ctx := context.Background()
+// DeleteRealtorRepo deletes a realtor after handing all their listings, whatever their
+// status, over to reassignTo, which is required when there are any. Sales where they
+// represented the buyer no longer name a buyer's realtor. Staff only.
+func DeleteRealtorRepo(ctx context.Context, entClient *ent.Client, id uuid.UUID, reassignTo *uuid.UUID) (*ReassignmentSummary, error) {
+	current, err := GetRealtorRepo(ctx, entClient, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// Get all realtors from database
-	realtors, err := entClient.Realtor.Query().All(ctx)
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	reassigned, err := reassignRealtorListings(ctx, tx.Client(), id, reassignTo, nil)
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
 
-	return realtors, nil
+	if _, err := tx.RealtorInvite.Delete().Where(realtorinvite.RealtorIDEQ(id)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to delete realtor invites: %w", err)
+	}
+	if err := tx.Realtor.DeleteOneID(id).Exec(ctx); err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, ErrRealtorNotFound
+		}
+		return nil, fmt.Errorf("failed to delete realtor: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	notifyListingsReassigned(ctx, entClient, current.FullName, reassignTo, reassigned)
+	return &ReassignmentSummary{Reassigned: reassigned}, nil
+}
+
+// reassignRealtorListings hands the realtor's listings in the given statuses, or all of
+// them when statuses is nil, over to the active realtor reassignTo and returns how many
+// moved.
+func reassignRealtorListings(ctx context.Context, entClient *ent.Client, id uuid.UUID, reassignTo *uuid.UUID, statuses []listing.Status) (int, error) {
+	predicates := []predicate.Listing{listing.RealtorIDEQ(id)}
+	if statuses != nil {
+		predicates = append(predicates, listing.StatusIn(statuses...))
+	}
+
+	owned, err := entClient.Listing.Query().Where(predicates...).Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if owned == 0 {
+		return 0, nil
+	}
+	if reassignTo == nil {
+		return 0, fmt.Errorf("%w: %d listings need a new realtor", ErrReassignmentRequired, owned)
+	}
+	if *reassignTo == id {
+		return 0, fmt.Errorf("%w: listings cannot be reassigned to the same realtor", ErrReassignmentRequired)
+	}
+	if err := requireActiveRealtor(ctx, entClient, *reassignTo); err != nil {
+		return 0, fmt.Errorf("cannot reassign listings: %w", err)
+	}
+
+	n, err := entClient.Listing.Update().
+		Where(predicates...).
+		SetRealtorID(*reassignTo).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reassign listings: %w", err)
+	}
+	return n, nil
+}
+
+// notifyListingsReassigned tells the realtor who took over listings about them.
+func notifyListingsReassigned(ctx context.Context, entClient *ent.Client, from string, reassignTo *uuid.UUID, reassigned int) {
+	if reassignTo == nil || reassigned == 0 {
+		return
+	}
+	target, err := entClient.Realtor.Get(ctx, *reassignTo)
+	if err != nil {
+		log.Printf("Failed to load realtor %s for notification: %v", *reassignTo, err)
+		return
+	}
+	err = NotifyRepo(ctx, entClient, RealtorRecipient(target), NotificationListingsReassigned,
+		fmt.Sprintf("%d listings were reassigned to you", reassigned),
+		fmt.Sprintf("You took over %d listings from %s.", reassigned, from))
+	if err != nil {
+		log.Printf("Failed to notify realtor %s about reassigned listings: %v", target.ID, err)
+	}
 }
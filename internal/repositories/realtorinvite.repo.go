@@ -0,0 +1,240 @@
+package repositories
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/privacy"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/ent/realtorinvite"
+	"ppgroup.ppgroup.com/ent/team"
+	"ppgroup.ppgroup.com/internal/viewer"
+)
+
+// realtorInviteTTL is how long an invite to a realtor profile can be accepted.
+const realtorInviteTTL = 7 * 24 * time.Hour
+
+var (
+	// ErrRealtorInviteNotFound is returned for invite tokens that match no invite.
+	ErrRealtorInviteNotFound = errors.New("realtor invite not found")
+	// ErrRealtorInviteClosed is returned for invites that were accepted, revoked or expired.
+	ErrRealtorInviteClosed = errors.New("realtor invite is no longer open")
+	// ErrInvalidRealtorInvite is returned for invites with an invalid email.
+	ErrInvalidRealtorInvite = errors.New("invalid realtor invite")
+	// ErrRealtorLinked is returned when the realtor profile already has an account.
+	ErrRealtorLinked = errors.New("realtor profile is already linked to an account")
+	// ErrAccountLinked is returned when the accepting account already manages a realtor profile.
+	ErrAccountLinked = errors.New("your account is already linked to a realtor profile")
+)
+
+// GetUserRealtorRepo returns the ID of the realtor profile linked to the user and the
+// teams that realtor leads. The ID is nil when the user is not a realtor.
+func GetUserRealtorRepo(ctx context.Context, entClient *ent.Client, userID uuid.UUID) (*uuid.UUID, []uuid.UUID, error) {
+	r, err := entClient.Realtor.Query().
+		Where(realtor.UserIDEQ(userID)).
+		WithLedTeams(func(q *ent.TeamQuery) { q.Select(team.FieldID) }).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get realtor of user: %w", err)
+	}
+
+	ledTeamIDs := make([]uuid.UUID, 0, len(r.Edges.LedTeams))
+	for _, t := range r.Edges.LedTeams {
+		ledTeamIDs = append(ledTeamIDs, t.ID)
+	}
+	return &r.ID, ledTeamIDs, nil
+}
+
+// newRealtorInviteToken returns a random invite token and the hash stored for it.
+func newRealtorInviteToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate invite token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashRealtorInviteToken(token), nil
+}
+
+// hashRealtorInviteToken returns the hex SHA-256 of an invite token.
+func hashRealtorInviteToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// InviteRealtorRepo invites someone to take over a realtor profile that no account
+// manages yet, and returns the invite with its token. Only the token's hash is stored, so
+// the token is returned once for staff to send to the invited email. Without an email the
+// realtor's own is recorded. Earlier pending invites for the realtor are revoked. Staff only.
+func InviteRealtorRepo(ctx context.Context, entClient *ent.Client, realtorID uuid.UUID, email string) (*ent.RealtorInvite, string, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return nil, "", privacy.Denyf("sign in to make changes")
+	}
+
+	r, err := GetRealtorRepo(ctx, entClient, realtorID)
+	if err != nil {
+		return nil, "", err
+	}
+	if !r.Active {
+		return nil, "", ErrRealtorInactive
+	}
+	if r.UserID != nil {
+		return nil, "", ErrRealtorLinked
+	}
+
+	email = strings.TrimSpace(email)
+	if email == "" {
+		email = r.Email
+	}
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return nil, "", fmt.Errorf("%w: %q is not an email address", ErrInvalidRealtorInvite, email)
+	}
+	email = strings.ToLower(email)
+
+	token, tokenHash, err := newRealtorInviteToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = tx.RealtorInvite.Update().
+		Where(realtorinvite.RealtorIDEQ(realtorID), realtorinvite.StatusEQ(realtorinvite.StatusPENDING)).
+		SetStatus(realtorinvite.StatusREVOKED).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", fmt.Errorf("failed to revoke earlier invites: %w", err)
+	}
+
+	invite, err := tx.RealtorInvite.Create().
+		SetRealtorID(realtorID).
+		SetEmail(email).
+		SetTokenHash(tokenHash).
+		SetInvitedBy(v.UserID).
+		SetExpiresAt(time.Now().Add(realtorInviteTTL)).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", fmt.Errorf("failed to create realtor invite: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, "", errors.New("failed to commit transaction")
+	}
+
+	return invite, token, nil
+}
+
+// GetRealtorInvitesRepo retrieves the invites sent for a realtor profile, newest first.
+func GetRealtorInvitesRepo(ctx context.Context, entClient *ent.Client, realtorID uuid.UUID) ([]*ent.RealtorInvite, error) {
+	if _, err := GetRealtorRepo(ctx, entClient, realtorID); err != nil {
+		return nil, err
+	}
+
+	invites, err := entClient.RealtorInvite.Query().
+		Where(realtorinvite.RealtorIDEQ(realtorID)).
+		Order(ent.Desc(realtorinvite.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get realtor invites: %w", err)
+	}
+
+	return invites, nil
+}
+
+// AcceptRealtorInviteRepo links the viewer's account to the realtor profile of the open
+// invite with the given token, and returns the profile.
+func AcceptRealtorInviteRepo(ctx context.Context, entClient *ent.Client, token string) (*ent.Realtor, error) {
+	v := viewer.FromContext(ctx)
+	if v == nil {
+		return nil, ErrRealtorInviteNotFound
+	}
+	if v.RealtorID != nil {
+		return nil, ErrAccountLinked
+	}
+
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lock the invite so it is accepted once
+	invite, err := tx.RealtorInvite.Query().
+		Where(realtorinvite.TokenHashEQ(hashRealtorInviteToken(token))).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return nil, ErrRealtorInviteNotFound
+		}
+		return nil, err
+	}
+	if invite.Status != realtorinvite.StatusPENDING || !invite.ExpiresAt.After(time.Now()) {
+		tx.Rollback()
+		return nil, ErrRealtorInviteClosed
+	}
+
+	r, err := tx.Realtor.Query().
+		Where(realtor.ID(invite.RealtorID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if !r.Active {
+		tx.Rollback()
+		return nil, ErrRealtorInactive
+	}
+	if r.UserID != nil {
+		tx.Rollback()
+		return nil, ErrRealtorLinked
+	}
+
+	// The viewer holds the invite token; only staff may link accounts otherwise
+	allowed := privacy.DecisionContext(ctx, privacy.Allow)
+	linked, err := tx.Realtor.UpdateOneID(r.ID).
+		SetUserID(v.UserID).
+		Save(allowed)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to link realtor profile: %w", err)
+	}
+	err = tx.RealtorInvite.UpdateOneID(invite.ID).
+		SetStatus(realtorinvite.StatusACCEPTED).
+		SetAcceptedBy(v.UserID).
+		SetAcceptedAt(time.Now()).
+		Exec(allowed)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to accept realtor invite: %w", err)
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return nil, errors.New("failed to commit transaction")
+	}
+
+	return linked, nil
+}
@@ -0,0 +1,304 @@
+package repositories
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/hook"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/offer"
+	"ppgroup.ppgroup.com/ent/realtor"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// ErrInvalidSale is returned for sales with missing or inconsistent closing data.
+var ErrInvalidSale = errors.New("invalid sale")
+
+// Similar sales are of the same type of property, have at most compsBedroomSpread
+// bedrooms more or fewer and a living area within compsSqftSpread of the listing's.
+const (
+	compsBedroomSpread = 1
+	compsSqftSpread    = 0.2
+)
+
+// ListingSaleHook keeps the closing data of listings consistent: a listing can only be
+// marked SOLD from PUBLISHED or PENDING, with a positive sold price and a close date that
+// is not in the future, and closing data is only kept while a listing is SOLD.
+func ListingSaleHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.ListingFunc(func(ctx context.Context, m *ent.ListingMutation) (ent.Value, error) {
+			status, statusSet := m.Status()
+			_, priceSet := m.SoldPrice()
+			_, closeSet := m.CloseDate()
+			_, buyerRealtorSet := m.BuyerRealtorID()
+			saleSet := priceSet || closeSet || buyerRealtorSet
+			if !statusSet && !saleSet {
+				return next.Mutate(ctx, m)
+			}
+			if m.Op().Is(ent.OpUpdate) {
+				if saleSet {
+					return nil, fmt.Errorf("%w: sales must be recorded one listing at a time", ErrInvalidSale)
+				}
+				return next.Mutate(ctx, m)
+			}
+
+			var from listing.Status
+			if m.Op().Is(ent.OpUpdateOne) {
+				var err error
+				if from, err = m.OldStatus(ctx); err != nil {
+					return nil, err
+				}
+				if !statusSet {
+					status = from
+				}
+			}
+
+			if status != listing.StatusSOLD {
+				if saleSet {
+					return nil, fmt.Errorf("%w: closing data can only be recorded on sold listings", ErrInvalidSale)
+				}
+				if from == listing.StatusSOLD {
+					m.ClearSoldPrice()
+					m.ClearCloseDate()
+					m.ClearBuyerRealtorID()
+				}
+				return next.Mutate(ctx, m)
+			}
+
+			if from != listing.StatusSOLD && from != listing.StatusPUBLISHED && from != listing.StatusPENDING {
+				return nil, fmt.Errorf("%w: only published listings and listings under contract can be sold", ErrInvalidSale)
+			}
+			price, err := mutatedValue(ctx, m, m.SoldPrice, m.SoldPriceCleared, m.OldSoldPrice)
+			if err != nil {
+				return nil, err
+			}
+			if price == nil || !price.IsPositive() {
+				return nil, fmt.Errorf("%w: a sold listing needs a positive sold price", ErrInvalidSale)
+			}
+			closeDate, err := mutatedValue(ctx, m, m.CloseDate, m.CloseDateCleared, m.OldCloseDate)
+			if err != nil {
+				return nil, err
+			}
+			if closeDate == nil || closeDate.After(time.Now()) {
+				return nil, fmt.Errorf("%w: a sold listing needs a close date that is not in the future", ErrInvalidSale)
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdateOne|ent.OpUpdate)
+}
+
+// SaleInput holds the closing data of a sale.
+type SaleInput struct {
+	// SoldPrice is in the listing's currency; it defaults to the accepted offer's amount
+	SoldPrice      *decimal.Decimal `json:"sold_price"`
+	CloseDate      time.Time        `json:"close_date" binding:"required"`
+	BuyerRealtorID *uuid.UUID       `json:"buyer_realtor_id"`
+}
+
+// MarkListingSoldRepo closes the sale of a published listing or one under contract. Only
+// staff and the listing's realtor can record it.
+func MarkListingSoldRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID, input SaleInput) (*ent.Listing, error) {
+	if err := requireListingManager(ctx, entClient, listingID); err != nil {
+		return nil, err
+	}
+
+	price := input.SoldPrice
+	if price == nil {
+		accepted, err := entClient.Offer.Query().
+			Where(offer.ListingIDEQ(listingID), offer.StatusEQ(offer.StatusACCEPTED)).
+			Order(ent.Desc(offer.FieldRespondedAt)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, fmt.Errorf("%w: a sold price is required when no offer was accepted", ErrInvalidSale)
+			}
+			return nil, err
+		}
+		price = &accepted.Amount
+	}
+	if input.BuyerRealtorID != nil {
+		exists, err := entClient.Realtor.Query().Where(realtor.ID(*input.BuyerRealtorID)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: buyer's realtor not found", ErrInvalidSale)
+		}
+	}
+
+	sold, err := entClient.Listing.UpdateOneID(listingID).
+		SetStatus(listing.StatusSOLD).
+		SetSoldPrice(*price).
+		SetCloseDate(input.CloseDate).
+		SetNillableBuyerRealtorID(input.BuyerRealtorID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("listing not found")
+		}
+		return nil, err
+	}
+
+	return sold, nil
+}
+
+// CompsParams narrows the comparable sales of a listing. Zero values fall back to the
+// configured defaults.
+type CompsParams struct {
+	RadiusMeters int `form:"radius_meters" binding:"omitempty,min=100,max=50000"`
+	Months       int `form:"months" binding:"omitempty,min=1,max=60"`
+	Limit        int `form:"limit" binding:"omitempty,min=1,max=50"`
+}
+
+// Comp is a sold listing similar to the one comps were requested for. Prices are in the
+// currency of that listing.
+type Comp struct {
+	ID               uuid.UUID       `json:"id"`
+	Title            string          `json:"title"`
+	Address          string          `json:"address"`
+	City             string          `json:"city"`
+	ZipCode          string          `json:"zip_code"`
+	Bedroom          int             `json:"bedroom"`
+	Bathroom         float64         `json:"bathroom"`
+	Sqft             int             `json:"sqft"`
+	SoldPrice        decimal.Decimal `json:"sold_price"`
+	SoldPricePerSqft decimal.Decimal `json:"sold_price_per_sqft"`
+	CloseDate        time.Time       `json:"close_date"`
+	// DistanceMeters is nil when the listing has no coordinates and comps come from its ZIP code
+	DistanceMeters *int `json:"distance_meters"`
+}
+
+// CompsStats summarizes the sold price per sqft of every matching sale, not only the
+// comps returned.
+type CompsStats struct {
+	Count                   int              `json:"count"`
+	Currency                string           `json:"currency"`
+	MedianSoldPricePerSqft  *decimal.Decimal `json:"median_sold_price_per_sqft"`
+	AverageSoldPricePerSqft *decimal.Decimal `json:"average_sold_price_per_sqft"`
+	MinSoldPricePerSqft     *decimal.Decimal `json:"min_sold_price_per_sqft"`
+	MaxSoldPricePerSqft     *decimal.Decimal `json:"max_sold_price_per_sqft"`
+	// ListPricePerSqft is the listing's own asking price per sqft, for comparison
+	ListPricePerSqft decimal.Decimal `json:"list_price_per_sqft"`
+}
+
+// GetCompsRepo finds the listings sold in the last params.Months months within
+// params.RadiusMeters of a listing, or in its ZIP code when it has no coordinates, that
+// are similar in type, bedrooms and living area. It returns up to params.Limit of them,
+// nearest (or most recently sold) first, with statistics over all matches.
+func GetCompsRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID, params CompsParams) ([]Comp, *CompsStats, error) {
+	subject, err := entClient.Listing.Get(ctx, listingID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, errors.New("listing not found")
+		}
+		return nil, nil, err
+	}
+
+	query := entClient.Listing.Query().
+		Where(
+			listing.IDNEQ(subject.ID),
+			listing.StatusEQ(listing.StatusSOLD),
+			listing.SoldPriceNotNil(),
+			listing.CloseDateGTE(time.Now().AddDate(0, -params.Months, 0)),
+			listing.TypeOfPropertyEQ(subject.TypeOfProperty),
+			listing.BedroomGTE(subject.Bedroom-compsBedroomSpread),
+			listing.BedroomLTE(subject.Bedroom+compsBedroomSpread),
+			listing.SqftGTE(int(float64(subject.Sqft)*(1-compsSqftSpread))),
+			listing.SqftLTE(int(float64(subject.Sqft)*(1+compsSqftSpread))),
+		)
+	located := subject.Latitude != nil && subject.Longitude != nil
+	radius := float64(params.RadiusMeters)
+	if located {
+		minLat, maxLat, minLng, maxLng := services.BoundingBox(*subject.Latitude, *subject.Longitude, radius)
+		query = query.Where(
+			listing.LatitudeGTE(minLat), listing.LatitudeLTE(maxLat),
+			listing.LongitudeGTE(minLng), listing.LongitudeLTE(maxLng),
+		)
+	} else {
+		query = query.Where(listing.CountryEQ(subject.Country), listing.ZipCodeEQ(subject.ZipCode))
+	}
+	sales, err := query.All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get comparable sales: %w", err)
+	}
+
+	currencies := []string{subject.Currency}
+	for _, s := range sales {
+		currencies = append(currencies, s.Currency)
+	}
+	rates, err := exchangeRates(ctx, entClient, currencies...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comps := make([]Comp, 0, len(sales))
+	for _, s := range sales {
+		c := Comp{
+			ID:        s.ID,
+			Title:     s.Title,
+			Address:   s.Address,
+			City:      s.City,
+			ZipCode:   s.ZipCode,
+			Bedroom:   s.Bedroom,
+			Bathroom:  s.Bathroom,
+			Sqft:      s.Sqft,
+			SoldPrice: s.SoldPrice.Mul(rates[s.Currency]).Div(rates[subject.Currency]).Round(2),
+			CloseDate: *s.CloseDate,
+		}
+		if located {
+			distance := services.DistanceMeters(*subject.Latitude, *subject.Longitude, *s.Latitude, *s.Longitude)
+			if distance > radius {
+				continue
+			}
+			meters := int(distance + 0.5)
+			c.DistanceMeters = &meters
+		}
+		c.SoldPricePerSqft = c.SoldPrice.Div(decimal.NewFromInt(int64(c.Sqft))).Round(2)
+		comps = append(comps, c)
+	}
+
+	stats := &CompsStats{
+		Count:            len(comps),
+		Currency:         subject.Currency,
+		ListPricePerSqft: subject.Price.Div(decimal.NewFromInt(int64(subject.Sqft))).Round(2),
+	}
+	if len(comps) > 0 {
+		perSqft := make([]decimal.Decimal, 0, len(comps))
+		for _, c := range comps {
+			perSqft = append(perSqft, c.SoldPricePerSqft)
+		}
+		slices.SortFunc(perSqft, func(a, b decimal.Decimal) int { return a.Cmp(b) })
+		median := perSqft[len(perSqft)/2]
+		if len(perSqft)%2 == 0 {
+			median = perSqft[len(perSqft)/2-1].Add(median).Div(decimal.NewFromInt(2))
+		}
+		average := decimal.Avg(perSqft[0], perSqft[1:]...)
+		median, average = median.Round(2), average.Round(2)
+		stats.MedianSoldPricePerSqft = &median
+		stats.AverageSoldPricePerSqft = &average
+		stats.MinSoldPricePerSqft = &perSqft[0]
+		stats.MaxSoldPricePerSqft = &perSqft[len(perSqft)-1]
+	}
+
+	slices.SortFunc(comps, func(a, b Comp) int {
+		if a.DistanceMeters != nil && b.DistanceMeters != nil {
+			if c := cmp.Compare(*a.DistanceMeters, *b.DistanceMeters); c != 0 {
+				return c
+			}
+		}
+		return b.CloseDate.Compare(a.CloseDate)
+	})
+	if len(comps) > params.Limit {
+		comps = comps[:params.Limit]
+	}
+
+	return comps, stats, nil
+}
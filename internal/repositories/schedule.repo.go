@@ -0,0 +1,207 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/hook"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ErrInvalidSchedule is returned when a listing's publish_at and unpublish_at do not fit together.
+var ErrInvalidSchedule = errors.New("invalid listing schedule")
+
+// scheduleBatchSize caps how many listings one scheduler transaction claims.
+const scheduleBatchSize = 100
+
+// approvedListingStatuses are the statuses of listings staff have approved, including
+// those that sold since. Realtors' changes to their price, description and media wait for
+// review, see UpdateListingRepo.
+var approvedListingStatuses = []listing.Status{
+	listing.StatusSCHEDULED,
+	listing.StatusPUBLISHED,
+	listing.StatusPENDING,
+	listing.StatusSOLD,
+}
+
+func isApprovedStatus(status listing.Status) bool {
+	return slices.Contains(approvedListingStatuses, status)
+}
+
+// ListingScheduleHook holds back listings that are published before their publish_at:
+// they become SCHEDULED and the scheduler publishes them once the time comes. A listing
+// published at or after its publish_at has the field cleared. It also checks that a
+// listing is not scheduled to come down before it goes up.
+func ListingScheduleHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.ListingFunc(func(ctx context.Context, m *ent.ListingMutation) (ent.Value, error) {
+			publishAt, err := mutatedValue(ctx, m, m.PublishAt, m.PublishAtCleared, m.OldPublishAt)
+			if err != nil {
+				return nil, err
+			}
+			unpublishAt, err := mutatedValue(ctx, m, m.UnpublishAt, m.UnpublishAtCleared, m.OldUnpublishAt)
+			if err != nil {
+				return nil, err
+			}
+			if publishAt != nil && unpublishAt != nil && !unpublishAt.After(*publishAt) {
+				return nil, fmt.Errorf("%w: unpublish_at must be after publish_at", ErrInvalidSchedule)
+			}
+
+			now := time.Now()
+			status, ok := m.Status()
+			switch {
+			case !ok:
+			case status == listing.StatusPUBLISHED && publishAt != nil && publishAt.After(now):
+				m.SetStatus(listing.StatusSCHEDULED)
+			case status == listing.StatusPUBLISHED && publishAt != nil:
+				m.ClearPublishAt()
+			case status == listing.StatusSCHEDULED && (publishAt == nil || !publishAt.After(now)):
+				return nil, fmt.Errorf("%w: a scheduled listing needs a publish_at in the future", ErrInvalidSchedule)
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdateOne)
+}
+
+// mutatedValue returns the value an optional listing field has after the mutation: the
+// new value when it is set, nil when it is cleared, and otherwise the stored one.
+func mutatedValue[T any](ctx context.Context, m *ent.ListingMutation, value func() (T, bool), cleared func() bool, old func(context.Context) (*T, error)) (*T, error) {
+	if t, ok := value(); ok {
+		return &t, nil
+	}
+	if cleared() || !m.Op().Is(ent.OpUpdateOne) {
+		return nil, nil
+	}
+	return old(ctx)
+}
+
+// PublishScheduledListingsRepo publishes the scheduled listings whose publish_at has
+// passed and notifies their realtors.
+func PublishScheduledListingsRepo(entClient *ent.Client) (int, error) {
+	return applyListingSchedule(entClient,
+		[]predicate.Listing{listing.StatusEQ(listing.StatusSCHEDULED), listing.PublishAtLTE(time.Now())},
+		func(u *ent.ListingUpdateOne) *ent.ListingUpdateOne {
+			return u.SetStatus(listing.StatusPUBLISHED).ClearPublishAt()
+		},
+		NotificationListingPublished, "Your listing %q is now live",
+		"The listing at %s was published as scheduled.")
+}
+
+// UnpublishScheduledListingsRepo archives the published listings whose unpublish_at has
+// passed and notifies their realtors.
+func UnpublishScheduledListingsRepo(entClient *ent.Client) (int, error) {
+	return applyListingSchedule(entClient,
+		[]predicate.Listing{listing.StatusEQ(listing.StatusPUBLISHED), listing.UnpublishAtLTE(time.Now())},
+		func(u *ent.ListingUpdateOne) *ent.ListingUpdateOne {
+			return u.SetStatus(listing.StatusARCHIVED).ClearUnpublishAt()
+		},
+		NotificationListingUnpublished, "Your listing %q was taken down",
+		"The listing at %s was unpublished as scheduled.")
+}
+
+// applyListingSchedule applies a due transition to the matching listings in batches.
+// Each batch claims its listings with FOR UPDATE SKIP LOCKED, so replicas running at the
+// same time split the work instead of waiting on each other, and the transition clears
+// the field that made the listing due, so no listing is transitioned or notified twice.
+// Each listing is transitioned under its own savepoint: a listing that fails is logged
+// and skipped for the rest of the run, and is tried again on the next one.
+func applyListingSchedule(entClient *ent.Client, due []predicate.Listing, apply func(*ent.ListingUpdateOne) *ent.ListingUpdateOne, kind, subject, body string) (int, error) {
+	ctx := systemContext()
+
+	applied := 0
+	var failed []uuid.UUID
+	for {
+		// Start a transaction
+		tx, err := entClient.Tx(ctx)
+		if err != nil {
+			return applied, err
+		}
+
+		claimed, err := tx.Listing.Query().
+			Where(due...).
+			Where(listing.IDNotIn(failed...)).
+			WithRealtor().
+			Order(ent.Asc(listing.FieldID)).
+			Limit(scheduleBatchSize).
+			ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+			All(ctx)
+		if err != nil {
+			tx.Rollback()
+			return applied, fmt.Errorf("failed to claim scheduled listings: %w", err)
+		}
+
+		var transitioned []*ent.Listing
+		for _, l := range claimed {
+			if err := applyListingTransition(ctx, tx, l.ID, apply); err != nil {
+				if errors.Is(err, errSavepoint) {
+					tx.Rollback()
+					return applied, err
+				}
+				log.Printf("Failed to apply schedule of listing %s: %v", l.ID, err)
+				failed = append(failed, l.ID)
+				continue
+			}
+			transitioned = append(transitioned, l)
+		}
+
+		// Commit the transaction
+		if err := tx.Commit(); err != nil {
+			return applied, errors.New("failed to commit transaction")
+		}
+		applied += len(transitioned)
+
+		for _, l := range transitioned {
+			if l.Edges.Realtor == nil {
+				continue
+			}
+			err := NotifyRepo(ctx, entClient, RealtorRecipient(l.Edges.Realtor), kind,
+				fmt.Sprintf(subject, l.Title), fmt.Sprintf(body, l.Address))
+			if err != nil {
+				log.Printf("Failed to notify realtor about scheduled listing %s: %v", l.ID, err)
+			}
+		}
+
+		if len(claimed) < scheduleBatchSize {
+			return applied, nil
+		}
+	}
+}
+
+// errSavepoint is returned when the savepoint around a scheduled transition cannot be
+// managed, which leaves the whole transaction unusable.
+var errSavepoint = errors.New("failed to manage schedule savepoint")
+
+// applyListingTransition applies a scheduled transition to one listing under a savepoint,
+// so that a failure only undoes that listing's changes and the transaction stays usable.
+func applyListingTransition(ctx context.Context, tx *ent.Tx, id uuid.UUID, apply func(*ent.ListingUpdateOne) *ent.ListingUpdateOne) error {
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT listing_schedule"); err != nil {
+		return fmt.Errorf("%w: %v", errSavepoint, err)
+	}
+	if err := apply(tx.Listing.UpdateOneID(id)).Exec(ctx); err != nil {
+		if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT listing_schedule"); rbErr != nil {
+			return fmt.Errorf("%w: %v", errSavepoint, rbErr)
+		}
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT listing_schedule"); err != nil {
+		return fmt.Errorf("%w: %v", errSavepoint, err)
+	}
+	return nil
+}
+
+// sameTime reports whether two optional times are both unset or equal.
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
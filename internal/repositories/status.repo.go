@@ -0,0 +1,219 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/hook"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/ent/listingstatusevent"
+	"ppgroup.ppgroup.com/ent/predicate"
+)
+
+// ErrBulkStatusChange is returned when the status of several listings is changed in one
+// statement, which would leave their status history and time on the market incomplete.
+var ErrBulkStatusChange = errors.New("listing statuses must be changed one listing at a time")
+
+// marketListingStatuses are the statuses in which a listing counts as on the market.
+// Time in any other status, such as back in DRAFT or under contract in PENDING, does not
+// add to its days on market.
+var marketListingStatuses = []listing.Status{
+	listing.StatusPUBLISHED,
+}
+
+func isOnMarket(status listing.Status) bool {
+	return slices.Contains(marketListingStatuses, status)
+}
+
+// Listing is a listing with the figures computed from it: its cumulative days on the
+// market and its estimated monthly carrying cost.
+type Listing struct {
+	*ent.Listing
+	DaysOnMarket int `json:"days_on_market"`
+	// EstimatedMonthlyCost is nil when the listing has no carrying costs, see monthlyCarryingCost
+	EstimatedMonthlyCost *decimal.Decimal `json:"estimated_monthly_cost"`
+}
+
+// WithComputedFields adds the days on the market as of now and the estimated monthly
+// carrying cost to each listing.
+func WithComputedFields(listings ...*ent.Listing) []Listing {
+	now := time.Now()
+	result := make([]Listing, 0, len(listings))
+	for _, l := range listings {
+		result = append(result, Listing{
+			Listing:              l,
+			DaysOnMarket:         daysOnMarket(l, now),
+			EstimatedMonthlyCost: monthlyCarryingCost(l),
+		})
+	}
+	return result
+}
+
+// daysOnMarket returns the whole days a listing has spent on the market as of now.
+func daysOnMarket(l *ent.Listing, now time.Time) int {
+	seconds := l.MarketSeconds
+	if l.OnMarketSince != nil {
+		seconds += int64(now.Sub(*l.OnMarketSince).Seconds())
+	}
+	return int(seconds / (24 * 60 * 60))
+}
+
+// daysOnMarketPredicate keeps the listings whose days on the market compare to days with
+// op, computed in the database as of the query.
+func daysOnMarketPredicate(op string, days int) predicate.Listing {
+	return predicate.Listing(func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("(").
+				Ident(s.C(listing.FieldMarketSeconds)).
+				WriteString(" + COALESCE(EXTRACT(EPOCH FROM now() - ").
+				Ident(s.C(listing.FieldOnMarketSince)).
+				WriteString("), 0)) / 86400 ").
+				WriteString(op).
+				WriteString(" ").
+				Arg(days)
+		}))
+	})
+}
+
+// ListingStatusHook records every status transition of a listing as a ListingStatusEvent
+// in the same transaction, and keeps its time on the market: a listing entering a market
+// status starts a period, and leaving one adds the period to market_seconds. It must run
+// after the hooks that adjust the status, so it is registered last.
+func ListingStatusHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.ListingFunc(func(ctx context.Context, m *ent.ListingMutation) (ent.Value, error) {
+			status, ok := m.Status()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			if m.Op().Is(ent.OpUpdate) {
+				return nil, ErrBulkStatusChange
+			}
+
+			var from listing.Status
+			var since, listedAt *time.Time
+			if m.Op().Is(ent.OpUpdateOne) {
+				var err error
+				if from, err = m.OldStatus(ctx); err != nil {
+					return nil, err
+				}
+				if from == status {
+					return next.Mutate(ctx, m)
+				}
+				if since, err = m.OldOnMarketSince(ctx); err != nil {
+					return nil, err
+				}
+				if listedAt, err = m.OldListedAt(ctx); err != nil {
+					return nil, err
+				}
+			}
+
+			now := time.Now()
+			switch {
+			case isOnMarket(status) && since == nil:
+				m.SetOnMarketSince(now)
+				if listedAt == nil {
+					m.SetListedAt(now)
+				}
+			case !isOnMarket(status) && since != nil:
+				m.AddMarketSeconds(int64(now.Sub(*since).Seconds()))
+				m.ClearOnMarketSince()
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			err = m.Client().ListingStatusEvent.Create().
+				SetListingID(v.(*ent.Listing).ID).
+				SetFromStatus(string(from)).
+				SetToStatus(string(status)).
+				SetNillableChangedBy(viewerID(ctx)).
+				SetChangedAt(now).
+				Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to record status change: %w", err)
+			}
+
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpUpdateOne|ent.OpUpdate)
+}
+
+// BackfillListingStatusEventsRepo starts the status history of listings that predate it
+// with their current status as of their creation. Listings on the market count as on it
+// since then. It is safe to run on every start-up.
+func BackfillListingStatusEventsRepo(entClient *ent.Client) error {
+	ctx := systemContext()
+
+	untracked, err := entClient.Listing.Query().
+		Where(listing.Not(listing.HasStatusEvents())).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load listings without status history: %w", err)
+	}
+	if len(untracked) == 0 {
+		return nil
+	}
+
+	// Start a transaction
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range untracked {
+		err := tx.ListingStatusEvent.Create().
+			SetListingID(l.ID).
+			SetToStatus(string(l.Status)).
+			SetChangedAt(l.CreateTime).
+			Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to backfill status history of listing %s: %w", l.ID, err)
+		}
+		if isOnMarket(l.Status) && l.OnMarketSince == nil {
+			err := tx.Listing.UpdateOneID(l.ID).
+				SetListedAt(l.CreateTime).
+				SetOnMarketSince(l.CreateTime).
+				Exec(ctx)
+			if err != nil {
+				tx.Rollback()
+				return fmt.Errorf("failed to backfill market time of listing %s: %w", l.ID, err)
+			}
+		}
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		return errors.New("failed to commit transaction")
+	}
+
+	return nil
+}
+
+// GetListingStatusHistoryRepo retrieves the status transitions of a listing, oldest
+// first. Only staff and the listing's realtor can see them.
+func GetListingStatusHistoryRepo(ctx context.Context, entClient *ent.Client, listingID uuid.UUID) ([]*ent.ListingStatusEvent, error) {
+	if err := requireListingManager(ctx, entClient, listingID); err != nil {
+		return nil, err
+	}
+
+	events, err := entClient.ListingStatusEvent.Query().
+		Where(listingstatusevent.ListingIDEQ(listingID)).
+		Order(ent.Asc(listingstatusevent.FieldChangedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status history: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"ppgroup.ppgroup.com/ent"
+)
+
+func TestDaysOnMarket(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	since := func(d time.Duration) *time.Time {
+		at := now.Add(-d)
+		return &at
+	}
+	const day = 24 * time.Hour
+
+	tests := []struct {
+		name    string
+		listing ent.Listing
+		want    int
+	}{
+		{name: "never on the market", listing: ent.Listing{}, want: 0},
+		{name: "off the market", listing: ent.Listing{MarketSeconds: int64((10*day + time.Hour).Seconds())}, want: 10},
+		{name: "on the market", listing: ent.Listing{OnMarketSince: since(3*day - time.Minute)}, want: 2},
+		{
+			name:    "back on the market",
+			listing: ent.Listing{MarketSeconds: int64((5 * day / 2).Seconds()), OnMarketSince: since(day / 2)},
+			want:    3,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := daysOnMarket(&tt.listing, now); got != tt.want {
+			t.Errorf("%s: daysOnMarket = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"ppgroup.ppgroup.com/ent/privacy"
+)
+
+// systemContext returns a context for maintenance work that runs without a signed-in
+// user, such as background jobs and start-up backfills. It bypasses the privacy policies.
+func systemContext() context.Context {
+	return privacy.DecisionContext(context.Background(), privacy.Allow)
+}
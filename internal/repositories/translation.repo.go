@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/listing"
+	"ppgroup.ppgroup.com/internal/services"
+)
+
+// MissingTranslations lists the enabled locales an active listing is not fully translated
+// into. A translation is incomplete when it lacks the description the source text has.
+type MissingTranslations struct {
+	ListingID    uuid.UUID      `json:"listing_id"`
+	Title        string         `json:"title"`
+	Status       listing.Status `json:"status"`
+	SourceLocale string         `json:"source_locale"`
+	Missing      []string       `json:"missing"`
+	Incomplete   []string       `json:"incomplete,omitempty"`
+}
+
+// GetMissingTranslationsRepo reports the active listings that lack a translation into any
+// of the given locales, oldest first, together with the number of listings missing each
+// locale. A listing is never expected to be translated into its own source locale.
+func GetMissingTranslationsRepo(entClient *ent.Client, locales []string) ([]MissingTranslations, map[string]int, error) {
+	ctx := context.Background()
+
+	normalized := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		locale, err := services.NormalizeLocale(locale)
+		if err != nil {
+			return nil, nil, err
+		}
+		normalized = append(normalized, locale)
+	}
+
+	listings, err := entClient.Listing.Query().
+		Where(listing.StatusIn(activeListingStatuses...)).
+		Order(ent.Asc(listing.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get listings: %w", err)
+	}
+
+	report := []MissingTranslations{}
+	counts := make(map[string]int, len(normalized))
+	for _, locale := range normalized {
+		counts[locale] = 0
+	}
+	for _, l := range listings {
+		entry := MissingTranslations{
+			ListingID:    l.ID,
+			Title:        l.Title,
+			Status:       l.Status,
+			SourceLocale: l.SourceLocale,
+			Missing:      []string{},
+		}
+		for _, locale := range normalized {
+			if locale == l.SourceLocale {
+				continue
+			}
+			t, ok := l.Translations[locale]
+			switch {
+			case !ok:
+				entry.Missing = append(entry.Missing, locale)
+			case t.Description == "" && l.Description != "":
+				entry.Incomplete = append(entry.Incomplete, locale)
+			default:
+				continue
+			}
+			counts[locale]++
+		}
+		if len(entry.Missing) > 0 || len(entry.Incomplete) > 0 {
+			report = append(report, entry)
+		}
+	}
+
+	return report, counts, nil
+}
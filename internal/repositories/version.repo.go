@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"ppgroup.ppgroup.com/ent"
+	"ppgroup.ppgroup.com/ent/hook"
+)
+
+// ErrListingVersionConflict is returned when a listing changed since the version the caller read.
+var ErrListingVersionConflict = errors.New("listing was modified by someone else")
+
+// ListingVersionHook increments the version of every listing a mutation updates, so that
+// clients holding an older version can detect concurrent changes.
+func ListingVersionHook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.ListingFunc(func(ctx context.Context, m *ent.ListingMutation) (ent.Value, error) {
+			if _, set := m.Version(); !set {
+				m.AddVersion(1)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpUpdate|ent.OpUpdateOne)
+}
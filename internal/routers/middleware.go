@@ -6,7 +6,10 @@ import (
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
+	"ppgroup.ppgroup.com/ent"
 	"ppgroup.ppgroup.com/internal/config"
+	"ppgroup.ppgroup.com/internal/repositories"
+	"ppgroup.ppgroup.com/internal/viewer"
 )
 
 func AuthMiddleware() gin.HandlerFunc {
@@ -34,6 +37,33 @@ func AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		// Attach the user to the request context so the ent privacy rules know who is acting
+		entClient := c.MustGet("entClient").(*ent.Client)
+		user, err := repositories.GetUserRepo(entClient, email.(string))
+		if err != nil || !user.IsActive {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+				"error":   "Unauthorized",
+				"message": "account not found or inactive",
+			})
+			return
+		}
+		realtorID, ledTeamIDs, err := repositories.GetUserRealtorRepo(c.Request.Context(), entClient, user.ID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to load account",
+				"message": err.Error(),
+			})
+			return
+		}
+		ctx := viewer.NewContext(c.Request.Context(), &viewer.Viewer{
+			UserID:     user.ID,
+			Email:      user.Email,
+			IsStaff:    user.IsStaff,
+			RealtorID:  realtorID,
+			LedTeamIDs: ledTeamIDs,
+		})
+		c.Request = c.Request.WithContext(ctx)
+
 		c.Set("userEmail", email)
 		c.Next()
 	}
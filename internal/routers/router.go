@@ -16,6 +16,7 @@ func SetupRouter(keys *config.Config, db *config.Database, imageService *service
 	// middleware to set database connection in the context
 	r.Use(func(c *gin.Context) {
 		c.Set("db", db)
+		c.Set("config", keys)
 		c.Set("imageService", imageService)
 		c.Next()
 	})
@@ -24,8 +25,11 @@ func SetupRouter(keys *config.Config, db *config.Database, imageService *service
 	// with the listed HTTP methods and credentials
 	corsConfig := cors.DefaultConfig()
 	corsConfig.AllowOrigins = []string{"http://localhost:3000"}
-	corsConfig.AllowMethods = []string{"GET", "POST", "PATCH", "DELETE"}
+	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
 	corsConfig.AllowCredentials = true
+	// Listing versions travel in ETag and come back in If-Match / If-None-Match
+	corsConfig.AddAllowHeaders("If-Match", "If-None-Match")
+	corsConfig.AddExposeHeaders("ETag")
 	r.Use(cors.New(corsConfig))
 
 	// Set redis session store
@@ -41,7 +45,7 @@ func SetupRouter(keys *config.Config, db *config.Database, imageService *service
 
 	// Image upload route
 	imageHandler := repositories.NewImageHandler(imageService)
-	r.POST("/upload-images", imageHandler.UploadImages)
+	r.POST("/upload-images", AuthMiddleware(), imageHandler.UploadImages)
 
 	public := r.Group("/api/v1")
 	{
@@ -54,19 +58,33 @@ func SetupRouter(keys *config.Config, db *config.Database, imageService *service
 		// Group of realtor routes
 		realtorRoutes := public.Group("/realtors")
 		{
-			realtorRoutes.GET("/:email", api.GetRealtor)
-			realtorRoutes.GET("/all", api.GetRealtors)
-			realtorRoutes.POST("/", api.CreateRealtor)
+			realtorRoutes.GET("/", api.GetRealtors)
+			realtorRoutes.GET("/:id", api.GetRealtor)
+		}
+		// Group of brokerage and team routes
+		brokerageRoutes := public.Group("/brokerages")
+		{
+			brokerageRoutes.GET("/", api.GetBrokerages)
+			brokerageRoutes.GET("/:id", api.GetBrokerage)
 		}
+		public.GET("/teams/:id", api.GetTeam)
 		// Group of listings routes
 		listingRoutes := public.Group("/properties")
 		{
-			listingRoutes.POST("/add", api.CreateListing)          // Multipart form data with file upload
-			listingRoutes.POST("/add-json", api.CreateListingJSON) // JSON format for existing image URLs
-			listingRoutes.DELETE("/", api.DeleteListing)
 			listingRoutes.GET("/buy", api.GetListings)
-			listingRoutes.PATCH("/update", api.UpdateListing)
+			listingRoutes.GET("/history", api.GetPropertyHistory)
+			listingRoutes.GET("/compare", api.CompareListings)
+			listingRoutes.GET("/:id", api.GetListing)
+			listingRoutes.GET("/:id/media", api.GetListingMedia)
+			listingRoutes.GET("/:id/comps", api.GetListingComps)
+			listingRoutes.GET("/:id/documents", api.GetListingDocuments)
+			listingRoutes.POST("/:id/documents/:documentId/link", api.CreateDocumentLink)
 		}
+		// Expiring document download links carry their own authorization
+		public.GET("/documents/download", api.DownloadDocument)
+		public.GET("/exchange-rates", api.GetExchangeRates)
+		public.GET("/neighborhoods", api.GetNeighborhoods)
+		public.GET("/market/stats", api.GetMarketStats)
 	}
 
 	// Private routes
@@ -77,17 +95,93 @@ func SetupRouter(keys *config.Config, db *config.Database, imageService *service
 		userRoutes := private.Group("/users")
 		{
 			userRoutes.GET("/me", api.Dashboard)
+			userRoutes.GET("/me/notifications", api.GetMyNotifications)
+			userRoutes.GET("/me/offers", api.GetMyOffers)
+			userRoutes.GET("/me/realtor", api.GetMyRealtor)
+			userRoutes.PATCH("/me/realtor", api.UpdateMyRealtor)
+			userRoutes.GET("/me/realtor/listings", api.GetMyRealtorListings)
+			userRoutes.POST("/me/realtor-invites/accept", api.AcceptRealtorInvite)
 		}
 		// Group of realtor routes
-		// realtorRoutes := private.Group("/realtors")
+		realtorRoutes := private.Group("/realtors")
+		{
+			realtorRoutes.POST("/", api.CreateRealtor)
+			realtorRoutes.PATCH("/:id", api.UpdateRealtor)
+			realtorRoutes.POST("/:id/deactivate", api.DeactivateRealtor)
+			realtorRoutes.DELETE("/:id", api.DeleteRealtor)
+			realtorRoutes.PUT("/:id/photo", api.UploadRealtorPhoto)
+			realtorRoutes.DELETE("/:id/photo", api.DeleteRealtorPhoto)
+			realtorRoutes.POST("/:id/invites", api.InviteRealtor)
+			realtorRoutes.GET("/:id/invites", api.GetRealtorInvites)
+		}
+		// Group of brokerage and team routes
+		brokerageRoutes := private.Group("/brokerages")
+		{
+			brokerageRoutes.POST("/", api.CreateBrokerage)
+			brokerageRoutes.PATCH("/:id", api.UpdateBrokerage)
+			brokerageRoutes.DELETE("/:id", api.DeleteBrokerage)
+			brokerageRoutes.POST("/:id/teams", api.CreateTeam)
+		}
+		teamRoutes := private.Group("/teams")
+		{
+			teamRoutes.PATCH("/:id", api.UpdateTeam)
+			teamRoutes.DELETE("/:id", api.DeleteTeam)
+			teamRoutes.GET("/:id/listings", api.GetTeamListings)
+		}
+		// Group of listing routes
+		listingRoutes := private.Group("/properties")
+		{
+			listingRoutes.POST("/add", api.CreateListing)          // Multipart form data with file upload
+			listingRoutes.POST("/add-json", api.CreateListingJSON) // JSON format for existing image URLs
+			listingRoutes.DELETE("/", api.DeleteListing)
+			listingRoutes.PATCH("/:id", api.UpdateListing)
+			listingRoutes.POST("/:id/renew", api.RenewListing)
+			listingRoutes.POST("/:id/sold", api.MarkListingSold)
+			listingRoutes.POST("/:id/submit", api.SubmitListingForReview)
+			listingRoutes.GET("/:id/reviews", api.GetListingReviews)
+			listingRoutes.POST("/:id/media", api.AddListingMedia)
+			listingRoutes.PUT("/:id/media/order", api.ReorderListingMedia)
+			listingRoutes.POST("/:id/media/virtual-tours", api.AddListingVirtualTour)
+			listingRoutes.PATCH("/:id/media/:mediaId", api.UpdateListingMedia)
+			listingRoutes.DELETE("/:id/media/:mediaId", api.DeleteListingMedia)
+			listingRoutes.PUT("/:id/media/:mediaId/primary", api.SetPrimaryListingMedia)
+			listingRoutes.POST("/:id/documents", api.UploadListingDocument)
+			listingRoutes.DELETE("/:id/documents/:documentId", api.DeleteListingDocument)
+			listingRoutes.GET("/:id/documents/:documentId/downloads", api.GetDocumentDownloads)
+			listingRoutes.POST("/:id/inquiries", api.CreateListingInquiry)
+			listingRoutes.GET("/:id/inquiries", api.GetListingInquiries)
+			listingRoutes.PUT("/:id/inquiries/:inquiryId", api.DecideListingInquiry)
+			listingRoutes.GET("/:id/status-history", api.GetListingStatusHistory)
+			listingRoutes.POST("/:id/offers", api.SubmitOffer)
+			listingRoutes.GET("/:id/offers", api.GetListingOffers)
+			listingRoutes.GET("/duplicates", api.GetDuplicateListings)
+			listingRoutes.GET("/translations/missing", api.GetMissingTranslations)
+			listingRoutes.POST("/bulk", api.BulkUpdateListings)
+		}
+		// Group of moderation routes
+		moderationRoutes := private.Group("/moderation")
+		{
+			moderationRoutes.GET("/reviews", api.GetReviewQueue)
+			moderationRoutes.POST("/reviews/:reviewId/approve", api.ApproveListingReview)
+			moderationRoutes.POST("/reviews/:reviewId/reject", api.RejectListingReview)
+		}
+		// Group of offer routes
+		offerRoutes := private.Group("/offers")
+		{
+			offerRoutes.POST("/:offerId/accept", api.AcceptOffer)
+			offerRoutes.POST("/:offerId/reject", api.RejectOffer)
+			offerRoutes.POST("/:offerId/counter", api.CounterOffer)
+			offerRoutes.POST("/:offerId/withdraw", api.WithdrawOffer)
+		}
+		// Neighborhood and point-of-interest datasets
+		private.POST("/neighborhoods/import", api.ImportNeighborhoods)
+		private.POST("/points-of-interest/import", api.ImportPointsOfInterest)
+		// Group of exchange-rate routes
+		exchangeRateRoutes := private.Group("/exchange-rates")
 		{
-			// realtorRoutes.POST("/", api.CreateRealtor)
+			exchangeRateRoutes.PUT("/:currency", api.SetExchangeRate)
+			exchangeRateRoutes.DELETE("/:currency", api.DeleteExchangeRate)
 		}
-		// // Group of listing routes
-		// listingRoutes := private.Group("/properties")
-		// {
-		// 	listingRoutes.POST("/", api.CreateListing)
-		// }
 	}
 
 	return r
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+)
+
+// streetAbbreviations maps street suffixes and directionals to their USPS abbreviations.
+var streetAbbreviations = map[string]string{
+	"ALLEY": "ALY", "AVENUE": "AVE", "AV": "AVE", "BOULEVARD": "BLVD", "CIRCLE": "CIR",
+	"COURT": "CT", "CRESCENT": "CRES", "DRIVE": "DR", "EXPRESSWAY": "EXPY", "FREEWAY": "FWY",
+	"HIGHWAY": "HWY", "LANE": "LN", "MOUNT": "MT", "PARKWAY": "PKWY", "PLACE": "PL",
+	"PLAZA": "PLZ", "POINT": "PT", "ROAD": "RD", "SQUARE": "SQ", "STREET": "ST",
+	"TERRACE": "TER", "TRAIL": "TRL", "TURNPIKE": "TPKE", "CENTER": "CTR", "COVE": "CV",
+	"NORTH": "N", "SOUTH": "S", "EAST": "E", "WEST": "W",
+	"NORTHEAST": "NE", "NORTHWEST": "NW", "SOUTHEAST": "SE", "SOUTHWEST": "SW",
+}
+
+// unitDesignators maps secondary unit designators to their USPS abbreviations.
+var unitDesignators = map[string]string{
+	"APARTMENT": "APT", "APT": "APT", "UNIT": "UNIT", "SUITE": "STE", "STE": "STE",
+	"BUILDING": "BLDG", "BLDG": "BLDG", "FLOOR": "FL", "FL": "FL", "ROOM": "RM", "RM": "RM",
+	"LOT": "LOT", "SPACE": "SPC", "SPC": "SPC", "#": "#",
+}
+
+// NormalizedAddress is a street address reduced to a canonical form for comparison.
+// Unit holds only the unit number, so "Apt 4", "Unit 4" and "#4" compare equal.
+type NormalizedAddress struct {
+	Street string
+	Unit   string
+	Zip    string
+}
+
+// NormalizeAddress uppercases an address, strips punctuation, applies USPS street suffix
+// and directional abbreviations and splits off the secondary unit.
+func NormalizeAddress(address, zipCode string) NormalizedAddress {
+	cleaned := strings.Map(func(r rune) rune {
+		switch {
+		case r == '#':
+			return r
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return unicode.ToUpper(r)
+		default:
+			return ' '
+		}
+	}, address)
+	cleaned = strings.ReplaceAll(cleaned, "#", " # ")
+
+	var street, unit []string
+	tokens := strings.Fields(cleaned)
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		// The first token is the house number, never a unit designator
+		if _, ok := unitDesignators[token]; ok && i > 0 {
+			unit = append(unit, tokens[i+1:]...)
+			// Drop repeated designators such as "APT #4"
+			for len(unit) > 0 && unitDesignators[unit[0]] != "" {
+				unit = unit[1:]
+			}
+			break
+		}
+		if abbr, ok := streetAbbreviations[token]; ok && i > 0 {
+			token = abbr
+		}
+		street = append(street, token)
+	}
+
+	// Compare postal codes without spaces and US ZIP codes without their +4 suffix
+	zip := strings.ToUpper(strings.Join(strings.Fields(zipCode), ""))
+	if base, _, found := strings.Cut(zip, "-"); found && len(base) == 5 {
+		zip = base
+	}
+
+	return NormalizedAddress{
+		Street: strings.Join(street, " "),
+		Unit:   strings.Join(unit, ""),
+		Zip:    zip,
+	}
+}
+
+// Key returns the normalized address as a single comparable string.
+func (a NormalizedAddress) Key() string {
+	key := a.Street
+	if a.Unit != "" {
+		key += " #" + a.Unit
+	}
+	return key + "|" + a.Zip
+}
+
+// HouseNumber returns the leading number of the street, if any.
+func (a NormalizedAddress) HouseNumber() string {
+	number, _, _ := strings.Cut(a.Street, " ")
+	return number
+}
+
+// AddressSimilarity returns how alike two normalized streets are, from 0 to 1,
+// based on their edit distance.
+func AddressSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	longest := max(len(a), len(b))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(a, b))/float64(longest)
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,80 @@
+package services
+
+import "testing"
+
+func TestNormalizeAddress(t *testing.T) {
+	tests := []struct {
+		address, zip string
+		want         NormalizedAddress
+	}{
+		{
+			address: "123 North Main Street",
+			zip:     "78701",
+			want:    NormalizedAddress{Street: "123 N MAIN ST", Zip: "78701"},
+		},
+		{
+			address: "123 n. main st., Apt. 4B",
+			zip:     "78701-1234",
+			want:    NormalizedAddress{Street: "123 N MAIN ST", Unit: "4B", Zip: "78701"},
+		},
+		{
+			address: "123 Main St #4B",
+			zip:     " 78701 ",
+			want:    NormalizedAddress{Street: "123 MAIN ST", Unit: "4B", Zip: "78701"},
+		},
+		{
+			address: "123 Main St Apt #4B",
+			zip:     "78701",
+			want:    NormalizedAddress{Street: "123 MAIN ST", Unit: "4B", Zip: "78701"},
+		},
+		{
+			address: "24 Sussex Drive, Suite 200",
+			zip:     "k1a 0b1",
+			want:    NormalizedAddress{Street: "24 SUSSEX DR", Unit: "200", Zip: "K1A0B1"},
+		},
+		{
+			address: "500 Avenue of the Americas",
+			zip:     "10011",
+			want:    NormalizedAddress{Street: "500 AVE OF THE AMERICAS", Zip: "10011"},
+		},
+	}
+
+	for _, tt := range tests {
+		got := NormalizeAddress(tt.address, tt.zip)
+		if got != tt.want {
+			t.Errorf("NormalizeAddress(%q, %q) = %+v, want %+v", tt.address, tt.zip, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizedAddressKey(t *testing.T) {
+	a := NormalizeAddress("123 Main Street, Unit 4", "78701")
+	b := NormalizeAddress("123 main st #4", "78701-0001")
+	if a.Key() != b.Key() {
+		t.Errorf("Key() = %q and %q, want equal keys", a.Key(), b.Key())
+	}
+	if got, want := a.Key(), "123 MAIN ST #4|78701"; got != want {
+		t.Errorf("Key() = %q, want %q", got, want)
+	}
+	if got := a.HouseNumber(); got != "123" {
+		t.Errorf("HouseNumber() = %q, want %q", got, "123")
+	}
+}
+
+func TestAddressSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{a: "123 MAIN ST", b: "123 MAIN ST", want: 1},
+		{a: "", b: "", want: 1},
+		{a: "123 MAIN ST", b: "", want: 0},
+		{a: "123 MAIN ST", b: "123 MAIN SQ", want: 1 - 1.0/11},
+	}
+
+	for _, tt := range tests {
+		if got := AddressSimilarity(tt.a, tt.b); got != tt.want {
+			t.Errorf("AddressSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
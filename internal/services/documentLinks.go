@@ -0,0 +1,96 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// documentExtensions are the file types accepted as listing documents.
+var documentExtensions = []string{".pdf", ".doc", ".docx", ".png", ".jpg", ".jpeg"}
+
+// maxDocumentBytes is the largest listing document that can be uploaded.
+const maxDocumentBytes = 25 << 20
+
+// ErrInvalidDocumentLink is returned for download links that are malformed, tampered with or expired.
+var ErrInvalidDocumentLink = errors.New("download link is invalid or has expired")
+
+// ValidateDocumentFile checks that an uploaded document has an allowed extension and size.
+func ValidateDocumentFile(filename string, size int64) error {
+	ext := strings.ToLower(filepath.Ext(filename))
+	for _, valid := range documentExtensions {
+		if ext == valid {
+			if size > maxDocumentBytes {
+				return fmt.Errorf("%s is too large: documents may be at most %d MB", filename, maxDocumentBytes>>20)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid document type: %s (allowed: %s)", filename, strings.Join(documentExtensions, ", "))
+}
+
+// DocumentLinkSigner issues and verifies expiring download tokens for listing documents.
+// A token binds a document to the email it was issued to, so the download can be audited.
+type DocumentLinkSigner struct {
+	key []byte
+}
+
+// NewDocumentLinkSigner creates a signer from a hex encoded secret such as the session key.
+func NewDocumentLinkSigner(secretHex string) *DocumentLinkSigner {
+	key, err := hex.DecodeString(secretHex)
+	if err != nil {
+		panic("Invalid document link secret: " + err.Error())
+	}
+	return &DocumentLinkSigner{key: key}
+}
+
+// Sign returns a token for downloading documentID that is valid until expiresAt.
+func (s *DocumentLinkSigner) Sign(documentID uuid.UUID, email string, expiresAt time.Time) string {
+	payload := strings.Join([]string{documentID.String(), strconv.FormatInt(expiresAt.Unix(), 10), email}, "|")
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+	return encoded + "." + s.signature(encoded)
+}
+
+// Verify checks a token and returns the document and email it was issued for.
+func (s *DocumentLinkSigner) Verify(token string) (uuid.UUID, string, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.signature(encoded))) {
+		return uuid.Nil, "", ErrInvalidDocumentLink
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return uuid.Nil, "", ErrInvalidDocumentLink
+	}
+
+	parts := strings.SplitN(string(payload), "|", 3)
+	if len(parts) != 3 {
+		return uuid.Nil, "", ErrInvalidDocumentLink
+	}
+
+	documentID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.Nil, "", ErrInvalidDocumentLink
+	}
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return uuid.Nil, "", ErrInvalidDocumentLink
+	}
+
+	return documentID, parts[2], nil
+}
+
+func (s *DocumentLinkSigner) signature(encoded string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte("listing-document:" + encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
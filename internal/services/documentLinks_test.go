@@ -0,0 +1,58 @@
+package services
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const testDocumentLinkSecret = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+
+func TestDocumentLinkSignerRoundTrip(t *testing.T) {
+	signer := NewDocumentLinkSigner(testDocumentLinkSecret)
+	documentID := uuid.New()
+
+	token := signer.Sign(documentID, "buyer|one@example.com", time.Now().Add(time.Hour))
+	gotID, gotEmail, err := signer.Verify(token)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if gotID != documentID || gotEmail != "buyer|one@example.com" {
+		t.Errorf("Verify = %s, %q; want %s, %q", gotID, gotEmail, documentID, "buyer|one@example.com")
+	}
+}
+
+func TestDocumentLinkSignerRejects(t *testing.T) {
+	signer := NewDocumentLinkSigner(testDocumentLinkSecret)
+	documentID := uuid.New()
+	valid := signer.Sign(documentID, "buyer@example.com", time.Now().Add(time.Hour))
+	encoded, signature, _ := strings.Cut(valid, ".")
+
+	// A payload for another document signed with the original signature
+	forged := base64.RawURLEncoding.EncodeToString([]byte(
+		uuid.New().String() + "|" + "9999999999" + "|buyer@example.com"))
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "expired", token: signer.Sign(documentID, "buyer@example.com", time.Now().Add(-time.Second))},
+		{name: "tampered payload", token: forged + "." + signature},
+		{name: "tampered signature", token: encoded + "." + strings.Repeat("A", len(signature))},
+		{name: "missing signature", token: encoded},
+		{name: "empty", token: ""},
+		{name: "other key", token: NewDocumentLinkSigner(strings.Repeat("ff", 32)).Sign(documentID, "buyer@example.com", time.Now().Add(time.Hour))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := signer.Verify(tt.token); !errors.Is(err, ErrInvalidDocumentLink) {
+				t.Errorf("Verify error = %v, want ErrInvalidDocumentLink", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// ErrInvalidDataset is returned for GeoJSON and CSV files that cannot be imported.
+var ErrInvalidDataset = errors.New("invalid dataset")
+
+// earthRadiusMeters is the mean radius of the Earth.
+const earthRadiusMeters = 6371008.8
+
+// DistanceMeters returns the great-circle distance between two coordinates.
+func DistanceMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(a))
+}
+
+// BoundingBox returns the latitudes and longitudes within radius meters of a coordinate.
+// It is meant to narrow database queries before distances are computed.
+func BoundingBox(lat, lng, radius float64) (minLat, maxLat, minLng, maxLng float64) {
+	dLat := radius / earthRadiusMeters * 180 / math.Pi
+	dLng := dLat / math.Max(math.Cos(lat*math.Pi/180), 0.01)
+	return lat - dLat, lat + dLat, lng - dLng, lng + dLng
+}
+
+// ContainsPoint reports whether a coordinate lies inside a multipolygon, outside its holes.
+func ContainsPoint(boundary schematype.MultiPolygon, lat, lng float64) bool {
+	for _, polygon := range boundary {
+		if len(polygon) == 0 || !ringContains(polygon[0], lat, lng) {
+			continue
+		}
+		inHole := slices.ContainsFunc(polygon[1:], func(hole [][2]float64) bool {
+			return ringContains(hole, lat, lng)
+		})
+		if !inHole {
+			return true
+		}
+	}
+	return false
+}
+
+// ringContains is the even-odd ray casting test for a ring of [longitude, latitude] positions.
+func ringContains(ring [][2]float64, lat, lng float64) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		xi, yi := ring[i][0], ring[i][1]
+		xj, yj := ring[j][0], ring[j][1]
+		if (yi > lat) != (yj > lat) && lng < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// Bounds returns the bounding box of a multipolygon.
+func Bounds(boundary schematype.MultiPolygon) (minLat, maxLat, minLng, maxLng float64) {
+	minLat, minLng = math.Inf(1), math.Inf(1)
+	maxLat, maxLng = math.Inf(-1), math.Inf(-1)
+	for _, polygon := range boundary {
+		for _, ring := range polygon {
+			for _, p := range ring {
+				minLng, maxLng = math.Min(minLng, p[0]), math.Max(maxLng, p[0])
+				minLat, maxLat = math.Min(minLat, p[1]), math.Max(maxLat, p[1])
+			}
+		}
+	}
+	return minLat, maxLat, minLng, maxLng
+}
+
+// NeighborhoodFeature is a neighborhood read from a GeoJSON dataset.
+type NeighborhoodFeature struct {
+	Name     string
+	City     string
+	State    string
+	Country  string
+	Boundary schematype.MultiPolygon
+}
+
+// PointFeature is a point of interest read from a GeoJSON or CSV dataset.
+type PointFeature struct {
+	Name      string
+	Category  string
+	Subtype   string
+	Latitude  float64
+	Longitude float64
+}
+
+// geoJSONFeatureCollection is the subset of GeoJSON the importers read.
+type geoJSONFeatureCollection struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Geometry struct {
+			Type        string          `json:"type"`
+			Coordinates json.RawMessage `json:"coordinates"`
+		} `json:"geometry"`
+		Properties map[string]any `json:"properties"`
+	} `json:"features"`
+}
+
+func readFeatureCollection(r io.Reader) (*geoJSONFeatureCollection, error) {
+	var fc geoJSONFeatureCollection
+	if err := json.NewDecoder(r).Decode(&fc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDataset, err)
+	}
+	if fc.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("%w: expected a GeoJSON FeatureCollection", ErrInvalidDataset)
+	}
+	return &fc, nil
+}
+
+// property returns a GeoJSON feature property as a trimmed string.
+func property(properties map[string]any, key string) string {
+	switch v := properties[key].(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+// ParseNeighborhoodsGeoJSON reads neighborhoods from a FeatureCollection of Polygon and
+// MultiPolygon features with name, city, state and optionally country properties.
+func ParseNeighborhoodsGeoJSON(r io.Reader) ([]NeighborhoodFeature, error) {
+	fc, err := readFeatureCollection(r)
+	if err != nil {
+		return nil, err
+	}
+
+	neighborhoods := make([]NeighborhoodFeature, 0, len(fc.Features))
+	for i, f := range fc.Features {
+		n := NeighborhoodFeature{
+			Name:    property(f.Properties, "name"),
+			City:    property(f.Properties, "city"),
+			State:   strings.ToUpper(property(f.Properties, "state")),
+			Country: strings.ToUpper(property(f.Properties, "country")),
+		}
+		if n.Name == "" || n.City == "" || n.State == "" {
+			return nil, fmt.Errorf("%w: feature %d needs name, city and state properties", ErrInvalidDataset, i)
+		}
+		if n.Country == "" {
+			n.Country = "US"
+		}
+
+		switch f.Geometry.Type {
+		case "Polygon":
+			var polygon [][][2]float64
+			err = json.Unmarshal(f.Geometry.Coordinates, &polygon)
+			n.Boundary = schematype.MultiPolygon{polygon}
+		case "MultiPolygon":
+			err = json.Unmarshal(f.Geometry.Coordinates, &n.Boundary)
+		default:
+			return nil, fmt.Errorf("%w: feature %d is a %q, not a polygon", ErrInvalidDataset, i, f.Geometry.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: feature %d: %v", ErrInvalidDataset, i, err)
+		}
+		if !validBoundary(n.Boundary) {
+			return nil, fmt.Errorf("%w: feature %d has an invalid boundary", ErrInvalidDataset, i)
+		}
+		neighborhoods = append(neighborhoods, n)
+	}
+	return neighborhoods, nil
+}
+
+// validBoundary checks that every ring has at least four positions with valid coordinates.
+func validBoundary(boundary schematype.MultiPolygon) bool {
+	if len(boundary) == 0 {
+		return false
+	}
+	for _, polygon := range boundary {
+		if len(polygon) == 0 {
+			return false
+		}
+		for _, ring := range polygon {
+			if len(ring) < 4 {
+				return false
+			}
+			for _, p := range ring {
+				if !validCoordinates(p[1], p[0]) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+func validCoordinates(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// poiCategories maps the category names accepted in datasets to point-of-interest categories.
+var poiCategories = map[string]string{
+	"school": "SCHOOL", "park": "PARK", "transit": "TRANSIT",
+}
+
+func pointFeature(name, category, subtype string, lat, lng float64) (PointFeature, error) {
+	p := PointFeature{
+		Name:      strings.TrimSpace(name),
+		Category:  poiCategories[strings.ToLower(strings.TrimSpace(category))],
+		Subtype:   strings.TrimSpace(subtype),
+		Latitude:  lat,
+		Longitude: lng,
+	}
+	switch {
+	case p.Name == "":
+		return p, errors.New("missing name")
+	case p.Category == "":
+		return p, fmt.Errorf("unknown category %q, expected school, park or transit", category)
+	case !validCoordinates(lat, lng):
+		return p, errors.New("coordinates out of range")
+	}
+	return p, nil
+}
+
+// ParsePointsOfInterestGeoJSON reads points of interest from a FeatureCollection of Point
+// features with name, category (school, park or transit) and optionally subtype properties.
+func ParsePointsOfInterestGeoJSON(r io.Reader) ([]PointFeature, error) {
+	fc, err := readFeatureCollection(r)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]PointFeature, 0, len(fc.Features))
+	for i, f := range fc.Features {
+		var position [2]float64
+		if f.Geometry.Type != "Point" {
+			return nil, fmt.Errorf("%w: feature %d is a %q, not a point", ErrInvalidDataset, i, f.Geometry.Type)
+		}
+		if err := json.Unmarshal(f.Geometry.Coordinates, &position); err != nil {
+			return nil, fmt.Errorf("%w: feature %d: %v", ErrInvalidDataset, i, err)
+		}
+		p, err := pointFeature(property(f.Properties, "name"), property(f.Properties, "category"),
+			property(f.Properties, "subtype"), position[1], position[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: feature %d: %v", ErrInvalidDataset, i, err)
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
+
+// ParsePointsOfInterestCSV reads points of interest from a CSV file with a header row
+// naming the name, category, latitude, longitude and optionally subtype columns.
+func ParsePointsOfInterestCSV(r io.Reader) ([]PointFeature, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDataset, err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: missing header row", ErrInvalidDataset)
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"name", "category", "latitude", "longitude"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%w: missing %s column", ErrInvalidDataset, required)
+		}
+	}
+	column := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+
+	points := make([]PointFeature, 0, len(records)-1)
+	for i, record := range records[1:] {
+		lat, latErr := strconv.ParseFloat(strings.TrimSpace(column(record, "latitude")), 64)
+		lng, lngErr := strconv.ParseFloat(strings.TrimSpace(column(record, "longitude")), 64)
+		if latErr != nil || lngErr != nil {
+			return nil, fmt.Errorf("%w: row %d: invalid coordinates", ErrInvalidDataset, i+2)
+		}
+		p, err := pointFeature(column(record, "name"), column(record, "category"), column(record, "subtype"), lat, lng)
+		if err != nil {
+			return nil, fmt.Errorf("%w: row %d: %v", ErrInvalidDataset, i+2, err)
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
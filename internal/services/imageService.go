@@ -4,16 +4,21 @@ import (
 	"context"
 	"fmt"
 	"mime/multipart"
+	"net/url"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/cloudinary/cloudinary-go/v2"
+	"github.com/cloudinary/cloudinary-go/v2/api"
 	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+	"ppgroup.ppgroup.com/ent/schematype"
 )
 
 type ImageService struct {
 	cloudinary *cloudinary.Cloudinary
+	cloudName  string
 }
 
 func NewImageService(cloudName, apiKey, apiSecret string) *ImageService {
@@ -29,28 +34,238 @@ func NewImageService(cloudName, apiKey, apiSecret string) *ImageService {
 
 	return &ImageService{
 		cloudinary: cld,
+		cloudName:  cloudName,
 	}
 }
 
 func (s *ImageService) UploadImage(ctx context.Context, file multipart.File, filename string) (string, error) {
+	url, _, err := s.UploadMedia(ctx, file, filename, schematype.MediaTypeImage)
+	return url, err
+}
+
+// UploadMedia uploads a listing media file and returns its URL and storage ID, which
+// DeleteMedia takes to remove it again. Videos are stored as Cloudinary video assets so
+// they can be streamed and have poster frames derived from them.
+func (s *ImageService) UploadMedia(ctx context.Context, file multipart.File, filename string, mediaType schematype.MediaType) (string, string, error) {
 	// Reset file pointer to beginning
 	file.Seek(0, 0)
 
 	publicID := generatePublicID(filename)
 
+	resourceType := "image"
+	if mediaType == schematype.MediaTypeVideo {
+		resourceType = "video"
+	}
+
 	result, err := s.cloudinary.Upload.Upload(ctx, file, uploader.UploadParams{
-		PublicID: publicID,
-		Folder:   "real-estate-listings",
+		PublicID:     publicID,
+		Folder:       "real-estate-listings",
+		ResourceType: resourceType,
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to upload image to cloudinary: %w", err)
+		return "", "", fmt.Errorf("failed to upload %s to cloudinary: %w", mediaType, err)
+	}
+
+	if result == nil || result.SecureURL == "" || result.PublicID == "" {
+		return "", "", fmt.Errorf("cloudinary upload failed: invalid response")
+	}
+
+	return result.SecureURL, resourceType + "/" + result.PublicID, nil
+}
+
+// Edge lengths in pixels of the profile photo renditions.
+const (
+	PhotoThumbnailSize = 96
+	PhotoMediumSize    = 320
+	PhotoLargeSize     = 640
+)
+
+// squareCrop crops an upload to a square around the most prominent face, capped at the
+// largest rendition so originals are not stored at full camera resolution.
+var squareCrop = fmt.Sprintf("c_fill,g_auto:faces,ar_1:1,w_%d", PhotoLargeSize*2)
+
+// UploadProfilePhoto uploads a photo cropped to a square and returns its URLs at each
+// rendition size. The renditions are generated on upload so the first request for them
+// does not wait for Cloudinary to derive them.
+func (s *ImageService) UploadProfilePhoto(ctx context.Context, file multipart.File, filename string) (*schematype.Photo, error) {
+	// Reset file pointer to beginning
+	file.Seek(0, 0)
+
+	sizes := []int{PhotoThumbnailSize, PhotoMediumSize, PhotoLargeSize}
+	eager := make([]string, len(sizes))
+	for i, size := range sizes {
+		eager[i] = sizeTransformation(size)
+	}
+
+	result, err := s.cloudinary.Upload.Upload(ctx, file, uploader.UploadParams{
+		PublicID:       generatePublicID(filename),
+		Folder:         "realtor-photos",
+		ResourceType:   "image",
+		Transformation: squareCrop,
+		Eager:          strings.Join(eager, "|"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload photo to cloudinary: %w", err)
 	}
 
 	if result == nil || result.SecureURL == "" {
-		return "", fmt.Errorf("cloudinary upload failed: invalid response")
+		return nil, fmt.Errorf("cloudinary upload failed: invalid response")
+	}
+
+	return &schematype.Photo{
+		URL:       result.SecureURL,
+		Thumbnail: resizedURL(result.SecureURL, PhotoThumbnailSize),
+		Medium:    resizedURL(result.SecureURL, PhotoMediumSize),
+		Large:     resizedURL(result.SecureURL, PhotoLargeSize),
+	}, nil
+}
+
+// sizeTransformation scales a square image to size x size pixels.
+func sizeTransformation(size int) string {
+	return fmt.Sprintf("c_fill,w_%d,h_%d", size, size)
+}
+
+// resizedURL returns the delivery URL of an uploaded image scaled to size x size pixels.
+// Cloudinary applies the transformation placed right after the delivery type.
+func resizedURL(assetURL string, size int) string {
+	return strings.Replace(assetURL, "/upload/", "/upload/"+sizeTransformation(size)+"/", 1)
+}
+
+// VideoPosterURL returns the URL of a still frame of an uploaded video.
+// Cloudinary renders the frame when the video URL is requested with an image extension.
+func VideoPosterURL(videoURL string) string {
+	return strings.TrimSuffix(videoURL, filepath.Ext(videoURL)) + ".jpg"
+}
+
+// DeleteImage removes a previously uploaded asset from Cloudinary by its delivery URL.
+// URLs outside this service's cloud are refused.
+func (s *ImageService) DeleteImage(ctx context.Context, assetURL string) error {
+	resourceType, publicID, err := parseAssetURL(assetURL, s.cloudName)
+	if err != nil {
+		return err
+	}
+
+	return s.destroy(ctx, resourceType, publicID)
+}
+
+// DeleteMedia removes a listing media file by the storage ID UploadMedia returned for it.
+func (s *ImageService) DeleteMedia(ctx context.Context, storageID string) error {
+	resourceType, publicID, ok := strings.Cut(storageID, "/")
+	if !ok || publicID == "" {
+		return fmt.Errorf("invalid media storage ID: %s", storageID)
+	}
+
+	return s.destroy(ctx, resourceType, publicID)
+}
+
+// destroy removes an uploaded asset from Cloudinary.
+func (s *ImageService) destroy(ctx context.Context, resourceType, publicID string) error {
+	result, err := s.cloudinary.Upload.Destroy(ctx, uploader.DestroyParams{
+		PublicID:     publicID,
+		ResourceType: resourceType,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete asset from cloudinary: %w", err)
+	}
+	if result.Error.Message != "" {
+		return fmt.Errorf("failed to delete asset from cloudinary: %s", result.Error.Message)
+	}
+
+	return nil
+}
+
+// UploadDocument uploads a listing document as a private raw asset, which can only be
+// fetched through signed download URLs. It returns the asset's storage ID and format.
+func (s *ImageService) UploadDocument(ctx context.Context, file multipart.File, filename string) (string, string, error) {
+	// Reset file pointer to beginning
+	file.Seek(0, 0)
+
+	// Raw assets keep their extension as part of the public ID
+	publicID := generatePublicID(filename) + strings.ToLower(filepath.Ext(filename))
+
+	result, err := s.cloudinary.Upload.Upload(ctx, file, uploader.UploadParams{
+		PublicID:     publicID,
+		Folder:       "listing-documents",
+		ResourceType: api.File,
+		Type:         api.Private,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to upload document to cloudinary: %w", err)
+	}
+
+	if result == nil || result.PublicID == "" {
+		return "", "", fmt.Errorf("cloudinary upload failed: invalid response")
+	}
+
+	return result.PublicID, result.Format, nil
+}
+
+// DocumentDownloadURL returns a signed URL for a private document that stops working after ttl.
+func (s *ImageService) DocumentDownloadURL(storageID, format string, ttl time.Duration) (string, error) {
+	expiresAt := time.Now().Add(ttl)
+	downloadURL, err := s.cloudinary.Upload.PrivateDownloadURL(uploader.PrivateDownloadURLParams{
+		PublicID:     storageID,
+		Format:       format,
+		DeliveryType: api.Private,
+		Attachment:   "true",
+		ExpiresAt:    &expiresAt,
+		ResourceType: api.File,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign document download URL: %w", err)
+	}
+
+	return downloadURL, nil
+}
+
+// DeleteDocument removes a private document from Cloudinary.
+func (s *ImageService) DeleteDocument(ctx context.Context, storageID string) error {
+	result, err := s.cloudinary.Upload.Destroy(ctx, uploader.DestroyParams{
+		PublicID:     storageID,
+		Type:         api.Private,
+		ResourceType: api.File,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete document from cloudinary: %w", err)
+	}
+	if result.Error.Message != "" {
+		return fmt.Errorf("failed to delete document from cloudinary: %s", result.Error.Message)
+	}
+
+	return nil
+}
+
+var versionSegment = regexp.MustCompile(`^v\d+$`)
+
+// parseAssetURL extracts the resource type and public ID from a delivery URL of the given
+// cloud such as https://res.cloudinary.com/<cloud>/image/upload/v123/real-estate-listings/house_1.jpg
+func parseAssetURL(assetURL, cloudName string) (string, string, error) {
+	u, err := url.Parse(assetURL)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid asset URL: %w", err)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if u.Host != "res.cloudinary.com" || len(segments) < 4 || segments[0] != cloudName {
+		return "", "", fmt.Errorf("not an asset of this service: %s", assetURL)
+	}
+	for i, segment := range segments {
+		if segment != "upload" || i < 2 || i == len(segments)-1 {
+			continue
+		}
+		rest := segments[i+1:]
+		if versionSegment.MatchString(rest[0]) {
+			rest = rest[1:]
+		}
+		if len(rest) == 0 {
+			break
+		}
+		publicID := strings.Join(rest, "/")
+		publicID = strings.TrimSuffix(publicID, filepath.Ext(publicID))
+		return segments[i-1], publicID, nil
 	}
 
-	return result.SecureURL, nil
+	return "", "", fmt.Errorf("not a cloudinary asset URL: %s", assetURL)
 }
 
 func generatePublicID(filename string) string {
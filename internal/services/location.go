@@ -0,0 +1,133 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidLocation is returned for addresses whose region or postal code does not match their country.
+var ErrInvalidLocation = errors.New("invalid location")
+
+// countryRules describes the regions and postal code format of a supported country.
+type countryRules struct {
+	Regions    []string
+	PostalCode *regexp.Regexp
+	// PostalFormat rewrites a valid, space-free postal code into its canonical form
+	PostalFormat func(string) string
+}
+
+// countries lists the supported countries by ISO 3166-1 alpha-2 code. Regions are
+// ISO 3166-2 subdivision codes without the country prefix.
+var countries = map[string]countryRules{
+	"US": {
+		Regions: []string{
+			"AL", "AK", "AZ", "AR", "CA", "CO", "CT", "DE", "DC", "FL", "GA", "HI", "ID", "IL", "IN",
+			"IA", "KS", "KY", "LA", "ME", "MD", "MA", "MI", "MN", "MS", "MO", "MT", "NE", "NV", "NH",
+			"NJ", "NM", "NY", "NC", "ND", "OH", "OK", "OR", "PA", "PR", "RI", "SC", "SD", "TN", "TX",
+			"UT", "VT", "VA", "WA", "WV", "WI", "WY",
+		},
+		PostalCode: regexp.MustCompile(`^\d{5}$`),
+	},
+	"CA": {
+		Regions:    []string{"AB", "BC", "MB", "NB", "NL", "NS", "NT", "NU", "ON", "PE", "QC", "SK", "YT"},
+		PostalCode: regexp.MustCompile(`^[ABCEGHJ-NPRSTVXY]\d[A-Z]\d[A-Z]\d$`),
+		PostalFormat: func(code string) string {
+			return code[:3] + " " + code[3:]
+		},
+	},
+	"MX": {
+		Regions: []string{
+			"AGU", "BCN", "BCS", "CAM", "CHP", "CHH", "CMX", "COA", "COL", "DUR", "GUA", "GRO", "HID",
+			"JAL", "MEX", "MIC", "MOR", "NAY", "NLE", "OAX", "PUE", "QUE", "ROO", "SLP", "SIN", "SON",
+			"TAB", "TAM", "TLA", "VER", "YUC", "ZAC",
+		},
+		PostalCode: regexp.MustCompile(`^\d{5}$`),
+	},
+}
+
+// Location is a validated country, region and postal code in canonical form.
+type Location struct {
+	Country    string
+	Region     string
+	PostalCode string
+}
+
+// ValidateLocation checks a region and postal code against the rules of their country
+// and returns them in canonical form. An empty country defaults to the US.
+func ValidateLocation(country, region, postalCode string) (Location, error) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if country == "" {
+		country = "US"
+	}
+	rules, ok := countries[country]
+	if !ok {
+		return Location{}, fmt.Errorf("%w: unsupported country %q", ErrInvalidLocation, country)
+	}
+
+	region = strings.ToUpper(strings.TrimSpace(region))
+	if !slices.Contains(rules.Regions, region) {
+		return Location{}, fmt.Errorf("%w: %q is not a region of %s", ErrInvalidLocation, region, country)
+	}
+
+	postalCode, err := formatPostalCode(country, rules, postalCode)
+	if err != nil {
+		return Location{}, err
+	}
+
+	return Location{Country: country, Region: region, PostalCode: postalCode}, nil
+}
+
+// NormalizePostalCode checks a postal code against the rules of its country and returns
+// it in canonical form. An empty country defaults to the US.
+func NormalizePostalCode(country, postalCode string) (string, error) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if country == "" {
+		country = "US"
+	}
+	rules, ok := countries[country]
+	if !ok {
+		return "", fmt.Errorf("%w: unsupported country %q", ErrInvalidLocation, country)
+	}
+	return formatPostalCode(country, rules, postalCode)
+}
+
+func formatPostalCode(country string, rules countryRules, postalCode string) (string, error) {
+	postalCode = strings.ToUpper(strings.Join(strings.Fields(postalCode), ""))
+	if !rules.PostalCode.MatchString(postalCode) {
+		return "", fmt.Errorf("%w: %q is not a valid postal code for %s", ErrInvalidLocation, postalCode, country)
+	}
+	if rules.PostalFormat != nil {
+		postalCode = rules.PostalFormat(postalCode)
+	}
+	return postalCode, nil
+}
+
+// sqftPerSqm is the number of square feet in a square meter.
+const sqftPerSqm = 10.7639
+
+// SqmToSqft converts square meters to whole square feet.
+func SqmToSqft(sqm int) int {
+	return int(math.Round(float64(sqm) * sqftPerSqm))
+}
+
+// SqftToSqm converts square feet to whole square meters.
+func SqftToSqm(sqft int) int {
+	return int(math.Round(float64(sqft) / sqftPerSqm))
+}
+
+// ResolveArea fills in whichever of the square feet and square meters is missing.
+// Square feet win when both are given.
+func ResolveArea(sqft, sqm int) (int, int) {
+	switch {
+	case sqft > 0:
+		return sqft, SqftToSqm(sqft)
+	case sqm > 0:
+		return SqmToSqft(sqm), sqm
+	default:
+		return sqft, sqm
+	}
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateLocation(t *testing.T) {
+	tests := []struct {
+		name                        string
+		country, region, postalCode string
+		want                        Location
+		wantErr                     bool
+	}{
+		{name: "US", country: "us", region: "tx", postalCode: "78701", want: Location{"US", "TX", "78701"}},
+		{name: "US by default", region: "NY", postalCode: " 10001 ", want: Location{"US", "NY", "10001"}},
+		{name: "US ZIP too short", country: "US", region: "NY", postalCode: "1000", wantErr: true},
+		{name: "US ZIP+4", country: "US", region: "NY", postalCode: "10001-1234", wantErr: true},
+		{name: "CA spaced", country: "CA", region: "ON", postalCode: "m5v 3l9", want: Location{"CA", "ON", "M5V 3L9"}},
+		{name: "CA unspaced", country: "ca", region: "bc", postalCode: "V6B1A1", want: Location{"CA", "BC", "V6B 1A1"}},
+		{name: "CA extra spaces", country: "CA", region: "QC", postalCode: " H2X  1Y4 ", want: Location{"CA", "QC", "H2X 1Y4"}},
+		{name: "CA invalid first letter", country: "CA", region: "ON", postalCode: "D5V 3L9", wantErr: true},
+		{name: "CA digits only", country: "CA", region: "ON", postalCode: "12345", wantErr: true},
+		{name: "CA US region", country: "CA", region: "NY", postalCode: "M5V 3L9", wantErr: true},
+		{name: "MX", country: "MX", region: "cmx", postalCode: "06600", want: Location{"MX", "CMX", "06600"}},
+		{name: "MX invalid postal code", country: "MX", region: "JAL", postalCode: "4410", wantErr: true},
+		{name: "MX Canadian postal code", country: "MX", region: "JAL", postalCode: "M5V 3L9", wantErr: true},
+		{name: "MX two-letter region", country: "MX", region: "JA", postalCode: "44100", wantErr: true},
+		{name: "unsupported country", country: "FR", region: "IDF", postalCode: "75001", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateLocation(tt.country, tt.region, tt.postalCode)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidLocation) {
+					t.Errorf("ValidateLocation error = %v, want ErrInvalidLocation", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateLocation: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ValidateLocation = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizePostalCode(t *testing.T) {
+	tests := []struct {
+		country, postalCode string
+		want                string
+		wantErr             bool
+	}{
+		{country: "", postalCode: "02134", want: "02134"},
+		{country: "CA", postalCode: "k1a0b1", want: "K1A 0B1"},
+		{country: "MX", postalCode: "64000", want: "64000"},
+		{country: "MX", postalCode: "6400A", wantErr: true},
+		{country: "CA", postalCode: "K1A 0B", wantErr: true},
+		{country: "BR", postalCode: "01310-100", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizePostalCode(tt.country, tt.postalCode)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidLocation) {
+				t.Errorf("NormalizePostalCode(%q, %q) error = %v, want ErrInvalidLocation", tt.country, tt.postalCode, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("NormalizePostalCode(%q, %q) = %q, %v; want %q", tt.country, tt.postalCode, got, err, tt.want)
+		}
+	}
+}
+
+func TestResolveArea(t *testing.T) {
+	tests := []struct {
+		sqft, sqm         int
+		wantSqft, wantSqm int
+	}{
+		{sqft: 1000, wantSqft: 1000, wantSqm: 93},
+		{sqm: 100, wantSqft: 1076, wantSqm: 100},
+		{sqft: 1500, sqm: 10, wantSqft: 1500, wantSqm: 139},
+		{},
+	}
+
+	for _, tt := range tests {
+		sqft, sqm := ResolveArea(tt.sqft, tt.sqm)
+		if sqft != tt.wantSqft || sqm != tt.wantSqm {
+			t.Errorf("ResolveArea(%d, %d) = %d, %d; want %d, %d", tt.sqft, tt.sqm, sqft, sqm, tt.wantSqft, tt.wantSqm)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// mediaRule lists the accepted file extensions and maximum upload size of a media type.
+type mediaRule struct {
+	extensions []string
+	maxBytes   int64
+}
+
+var mediaRules = map[schematype.MediaType]mediaRule{
+	schematype.MediaTypeImage:     {extensions: []string{".jpg", ".jpeg", ".png", ".gif", ".webp"}, maxBytes: 10 << 20},
+	schematype.MediaTypeVideo:     {extensions: []string{".mp4", ".webm"}, maxBytes: 200 << 20},
+	schematype.MediaTypeFloorPlan: {extensions: []string{".pdf", ".svg", ".png"}, maxBytes: 20 << 20},
+}
+
+// virtualTourHosts are the providers whose embed URLs may be attached as virtual tours.
+// Subdomains of these hosts are accepted as well.
+var virtualTourHosts = []string{
+	"matterport.com",
+	"kuula.co",
+	"cloudpano.com",
+	"youriguide.com",
+	"youtube.com",
+	"youtube-nocookie.com",
+	"vimeo.com",
+}
+
+// ParseMediaType converts a form value into a media type, defaulting to image.
+func ParseMediaType(value string) (schematype.MediaType, error) {
+	if value == "" {
+		return schematype.MediaTypeImage, nil
+	}
+
+	mediaType := schematype.MediaType(strings.ToLower(value))
+	switch mediaType {
+	case schematype.MediaTypeImage, schematype.MediaTypeVideo, schematype.MediaTypeVirtualTour, schematype.MediaTypeFloorPlan:
+		return mediaType, nil
+	}
+	return "", fmt.Errorf("invalid media type %q: must be one of image, video, virtual_tour, floor_plan", value)
+}
+
+// ValidateMediaFile checks that an uploaded file has an extension and size allowed for its media type.
+// Virtual tours are links, not files, and are rejected here.
+func ValidateMediaFile(mediaType schematype.MediaType, filename string, size int64) error {
+	rule, ok := mediaRules[mediaType]
+	if !ok {
+		return fmt.Errorf("%s media cannot be uploaded as a file", mediaType)
+	}
+
+	ext := strings.ToLower(filepath.Ext(filename))
+	allowed := false
+	for _, valid := range rule.extensions {
+		if ext == valid {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return fmt.Errorf("invalid file type for %s: %s (allowed: %s)", mediaType, filename, strings.Join(rule.extensions, ", "))
+	}
+
+	if size > rule.maxBytes {
+		return fmt.Errorf("%s is too large: %s files may be at most %d MB", filename, mediaType, rule.maxBytes>>20)
+	}
+
+	return nil
+}
+
+// ValidateVirtualTourURL checks that a virtual tour is an https embed URL from an allowed provider.
+func ValidateVirtualTourURL(tourURL string) error {
+	u, err := url.Parse(tourURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("virtual tour must be an https URL")
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range virtualTourHosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return nil
+		}
+	}
+	return fmt.Errorf("virtual tour provider %s is not allowed", host)
+}
+
+// ValidateMediaItem checks a media item supplied by URL, as in JSON listing input.
+func ValidateMediaItem(item schematype.Media) error {
+	if _, err := ParseMediaType(string(item.Type)); err != nil {
+		return err
+	}
+	if item.Type == schematype.MediaTypeVirtualTour {
+		return ValidateVirtualTourURL(item.URL)
+	}
+	if item.URL == "" {
+		return fmt.Errorf("media URL is required")
+	}
+	return nil
+}
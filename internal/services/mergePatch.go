@@ -0,0 +1,62 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidMergePatch is returned for merge patches that are not JSON objects.
+var ErrInvalidMergePatch = errors.New("merge patch must be a JSON object")
+
+// ParseMergePatch decodes a JSON Merge Patch document (RFC 7396) into its members.
+// Members set to null are kept as nil values so callers can tell clears from absent members.
+func ParseMergePatch(body []byte) (map[string]any, error) {
+	patch, err := decodeObject(body)
+	if err != nil || patch == nil {
+		return nil, ErrInvalidMergePatch
+	}
+	return patch, nil
+}
+
+// ApplyMergePatch applies a JSON Merge Patch to a JSON object document as described in
+// RFC 7396: members set to null are removed, objects are merged recursively and every
+// other value replaces the target's.
+func ApplyMergePatch(target []byte, patch map[string]any) ([]byte, error) {
+	object, err := decodeObject(target)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergeObject(object, patch))
+}
+
+func mergeObject(target, patch map[string]any) map[string]any {
+	if target == nil {
+		target = map[string]any{}
+	}
+	for key, value := range patch {
+		if value == nil {
+			delete(target, key)
+			continue
+		}
+		if patchObject, ok := value.(map[string]any); ok {
+			targetObject, _ := target[key].(map[string]any)
+			target[key] = mergeObject(targetObject, patchObject)
+			continue
+		}
+		target[key] = value
+	}
+	return target
+}
+
+// decodeObject decodes a JSON object keeping numbers exact, so prices survive the round trip.
+func decodeObject(data []byte) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var object map[string]any
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
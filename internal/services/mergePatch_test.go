@@ -0,0 +1,111 @@
+package services
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseMergePatch(t *testing.T) {
+	patch, err := ParseMergePatch([]byte(`{"title":"Loft","garage":null}`))
+	if err != nil {
+		t.Fatalf("ParseMergePatch: %v", err)
+	}
+	if value, ok := patch["garage"]; !ok || value != nil {
+		t.Errorf("garage = %v, %v; want a nil member", value, ok)
+	}
+
+	for _, body := range []string{`null`, `[1,2]`, `"title"`, `{`} {
+		if _, err := ParseMergePatch([]byte(body)); !errors.Is(err, ErrInvalidMergePatch) {
+			t.Errorf("ParseMergePatch(%s) error = %v, want ErrInvalidMergePatch", body, err)
+		}
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		patch  string
+		want   string
+	}{
+		{
+			name:   "replaces values",
+			target: `{"title":"Loft","bedrooms":2}`,
+			patch:  `{"bedrooms":3}`,
+			want:   `{"title":"Loft","bedrooms":3}`,
+		},
+		{
+			name:   "null removes a member",
+			target: `{"title":"Loft","hoa_fee":"250.00"}`,
+			patch:  `{"hoa_fee":null}`,
+			want:   `{"title":"Loft"}`,
+		},
+		{
+			name:   "null for an absent member is a no-op",
+			target: `{"title":"Loft"}`,
+			patch:  `{"hoa_fee":null}`,
+			want:   `{"title":"Loft"}`,
+		},
+		{
+			name:   "objects merge recursively",
+			target: `{"features":{"pool":true,"garage":2}}`,
+			patch:  `{"features":{"garage":null,"fireplace":true}}`,
+			want:   `{"features":{"pool":true,"fireplace":true}}`,
+		},
+		{
+			name:   "object replaces a scalar",
+			target: `{"features":"none"}`,
+			patch:  `{"features":{"pool":true}}`,
+			want:   `{"features":{"pool":true}}`,
+		},
+		{
+			name:   "arrays are replaced",
+			target: `{"tags":["a","b"]}`,
+			patch:  `{"tags":["c"]}`,
+			want:   `{"tags":["c"]}`,
+		},
+		{
+			name:   "numbers stay exact",
+			target: `{"price":"1"}`,
+			patch:  `{"price":1234567890.123456789}`,
+			want:   `{"price":1234567890.123456789}`,
+		},
+		{
+			name:   "null target",
+			target: `null`,
+			patch:  `{"title":"Loft"}`,
+			want:   `{"title":"Loft"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := ParseMergePatch([]byte(tt.patch))
+			if err != nil {
+				t.Fatalf("ParseMergePatch: %v", err)
+			}
+			got, err := ApplyMergePatch([]byte(tt.target), patch)
+			if err != nil {
+				t.Fatalf("ApplyMergePatch: %v", err)
+			}
+			if !jsonEqual(t, got, []byte(tt.want)) {
+				t.Errorf("ApplyMergePatch = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// jsonEqual compares two JSON documents, keeping numbers as written.
+func jsonEqual(t *testing.T, a, b []byte) bool {
+	t.Helper()
+	x, err := decodeObject(a)
+	if err != nil {
+		t.Fatalf("decode %s: %v", a, err)
+	}
+	y, err := decodeObject(b)
+	if err != nil {
+		t.Fatalf("decode %s: %v", b, err)
+	}
+	return reflect.DeepEqual(x, y)
+}
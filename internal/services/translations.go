@@ -0,0 +1,93 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+	"ppgroup.ppgroup.com/ent/schematype"
+)
+
+// ErrInvalidTranslation is returned for unknown locales and incomplete translations.
+var ErrInvalidTranslation = errors.New("invalid translation")
+
+// NormalizeLocale parses a BCP 47 language tag and returns it in canonical form,
+// e.g. "ZH-hant" becomes "zh-Hant".
+func NormalizeLocale(locale string) (string, error) {
+	tag, err := language.Parse(strings.TrimSpace(locale))
+	if err != nil || tag == language.Und {
+		return "", fmt.Errorf("%w: unknown locale %q", ErrInvalidTranslation, locale)
+	}
+	return tag.String(), nil
+}
+
+// NormalizeTranslations canonicalizes the source locale and the locales of the
+// translations. Every translation needs a title, and no translation may be keyed by
+// the source locale since the listing's own title and description are in it.
+func NormalizeTranslations(source string, translations map[string]schematype.Translation) (string, map[string]schematype.Translation, error) {
+	if source == "" {
+		source = "en"
+	}
+	source, err := NormalizeLocale(source)
+	if err != nil {
+		return "", nil, err
+	}
+
+	normalized := make(map[string]schematype.Translation, len(translations))
+	for locale, t := range translations {
+		key, err := NormalizeLocale(locale)
+		if err != nil {
+			return "", nil, err
+		}
+		if key == source {
+			return "", nil, fmt.Errorf("%w: %s is the source locale", ErrInvalidTranslation, key)
+		}
+		if _, dup := normalized[key]; dup {
+			return "", nil, fmt.Errorf("%w: %s is given twice", ErrInvalidTranslation, key)
+		}
+		t.Title = strings.TrimSpace(t.Title)
+		t.Description = strings.TrimSpace(t.Description)
+		if t.Title == "" || len(t.Title) > 255 {
+			return "", nil, fmt.Errorf("%w: the %s title must be 1-255 characters", ErrInvalidTranslation, key)
+		}
+		normalized[key] = t
+	}
+
+	return source, normalized, nil
+}
+
+// PreferredLocales returns the reader's locales in order of preference. An explicit
+// lang parameter wins over the Accept-Language header; invalid values are ignored.
+func PreferredLocales(lang, acceptLanguage string) []language.Tag {
+	if lang != "" {
+		if tag, err := language.Parse(lang); err == nil {
+			return []language.Tag{tag}
+		}
+	}
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return nil
+	}
+	return tags
+}
+
+// BestLocale picks the locale among the source and the available translations that
+// best serves the preferred locales, falling back to the source locale.
+func BestLocale(preferred []language.Tag, source string, available []string) string {
+	if len(preferred) == 0 || len(available) == 0 {
+		return source
+	}
+
+	locales := append([]string{source}, available...)
+	tags := make([]language.Tag, 0, len(locales))
+	for _, locale := range locales {
+		tags = append(tags, language.Make(locale))
+	}
+
+	_, index, confidence := language.NewMatcher(tags).Match(preferred...)
+	if confidence == language.No {
+		return source
+	}
+	return locales[index]
+}
@@ -0,0 +1,44 @@
+package viewer
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Viewer is the signed-in user a request acts for. Ent privacy rules read it from the
+// context to decide who may change what.
+type Viewer struct {
+	UserID  uuid.UUID
+	Email   string
+	IsStaff bool
+	// RealtorID is the realtor profile linked to the user's account, if any
+	RealtorID *uuid.UUID
+	// LedTeamIDs are the teams that realtor leads
+	LedTeamIDs []uuid.UUID
+}
+
+// IsRealtor reports whether the realtor profile with the given ID is linked to the
+// viewer's account.
+func (v *Viewer) IsRealtor(id uuid.UUID) bool {
+	return v.RealtorID != nil && *v.RealtorID == id
+}
+
+// LeadsTeam reports whether the viewer's realtor profile leads the team with the given ID.
+func (v *Viewer) LeadsTeam(id uuid.UUID) bool {
+	return slices.Contains(v.LedTeamIDs, id)
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of parent that carries v.
+func NewContext(parent context.Context, v *Viewer) context.Context {
+	return context.WithValue(parent, contextKey{}, v)
+}
+
+// FromContext returns the viewer stored in ctx, or nil for anonymous requests.
+func FromContext(ctx context.Context) *Viewer {
+	v, _ := ctx.Value(contextKey{}).(*Viewer)
+	return v
+}